@@ -10,12 +10,35 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/aws/copilot-cli/cmd/copilot/template"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/cli"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/trace"
 	"github.com/aws/copilot-cli/internal/pkg/version"
 )
 
+const (
+	traceFlag            = "trace"
+	traceFlagDefault     = "copilot-trace.json"
+	traceFlagDescription = `Optional. Record where the command spends its time and write the spans in OTLP JSON format
+to a file, or send them to a collector's OTLP/HTTP endpoint such as http://localhost:4318/v1/traces.`
+
+	endpointURLFlag            = "endpoint-url"
+	endpointURLFlagDescription = `Optional. Send every AWS API call to this URL instead of the AWS endpoints,
+such as a gateway on your network or a local emulator. Defaults to $COPILOT_ENDPOINT_URL.`
+)
+
+// Tracing of the command's operations, enabled by the --trace flag.
+var (
+	traceDest string
+	tracer    *trace.Tracer
+	rootSpan  *trace.Span
+)
+
+// Endpoint of the AWS API calls, overridden by the --endpoint-url flag.
+var endpointURL string
+
 func init() {
 	color.DisableColorBasedOnEnvVar()
 	cobra.EnableCommandSorting = false // Maintain the order in which we add commands.
@@ -23,9 +46,16 @@ func init() {
 
 func main() {
 	cmd := buildRootCmd()
-	if err := cmd.Execute(); err != nil {
+	err := cmd.Execute()
+	if tracer != nil {
+		rootSpan.End(err)
+		if err := tracer.Export(traceDest); err != nil {
+			log.Warningf("Failed to export trace: %v\n", err)
+		}
+	}
+	if err != nil {
 		log.Errorln(err.Error())
-		os.Exit(1)
+		os.Exit(cli.ExitCode(err))
 	}
 }
 
@@ -39,6 +69,12 @@ func buildRootCmd() *cobra.Command {
 		PersistentPreRun: func(cmd *cobra.Command, args []string) {
 			// If we don't set a Run() function the help menu doesn't show up.
 			// See https://github.com/spf13/cobra/issues/790
+			if traceDest != "" {
+				tracer, rootSpan = trace.Enable(cmd.CommandPath())
+			}
+			if endpointURL != "" {
+				sessions.SetEndpointURL(endpointURL)
+			}
 		},
 		SilenceUsage:  true,
 		SilenceErrors: true,
@@ -48,6 +84,11 @@ func buildRootCmd() *cobra.Command {
 	// version information.
 	cmd.Version = version.Version
 	cmd.SetVersionTemplate("copilot version: {{.Version}}\n")
+	cmd.SetFlagErrorFunc(cli.FlagErrorFunc)
+
+	cmd.PersistentFlags().StringVar(&traceDest, traceFlag, "", traceFlagDescription)
+	cmd.PersistentFlags().Lookup(traceFlag).NoOptDefVal = traceFlagDefault
+	cmd.PersistentFlags().StringVar(&endpointURL, endpointURLFlag, "", endpointURLFlagDescription)
 
 	// NOTE: Order for each grouping below is significant in that it affects help menu output ordering.
 	// "Getting Started" command group.
@@ -59,6 +100,10 @@ func buildRootCmd() *cobra.Command {
 	cmd.AddCommand(cli.BuildEnvCmd())
 	cmd.AddCommand(cli.BuildSvcCmd())
 	cmd.AddCommand(cli.BuildTaskCmd())
+	cmd.AddCommand(cli.BuildShowResourcesCmd())
+	cmd.AddCommand(cli.BuildManifestCmd())
+	cmd.AddCommand(cli.BuildSecurityCmd())
+	cmd.AddCommand(cli.BuildIAMCmd())
 
 	// "Addons" command group
 	cmd.AddCommand(cli.BuildStorageCmd())
@@ -70,6 +115,8 @@ func buildRootCmd() *cobra.Command {
 	// "Release" command group.
 	cmd.AddCommand(cli.BuildPipelineCmd())
 	cmd.AddCommand(cli.BuildDeployCmd())
+	cmd.AddCommand(cli.BuildServeCmd())
+	cmd.AddCommand(cli.BuildCheckUpdateTemplateCmd())
 	cmd.SetUsageTemplate(template.RootUsage)
 
 	return cmd
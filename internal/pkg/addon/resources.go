@@ -0,0 +1,210 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package addon
+
+import (
+	"fmt"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	deletionPolicyRetain   = "Retain"
+	deletionPolicySnapshot = "Snapshot"
+)
+
+// statefulResourceTypes are the types of the resources that hold data, which is lost when the resource is deleted.
+var statefulResourceTypes = map[string]bool{
+	"AWS::DynamoDB::Table":           true,
+	"AWS::S3::Bucket":                true,
+	"AWS::RDS::DBCluster":            true,
+	"AWS::RDS::DBInstance":           true,
+	"AWS::EFS::FileSystem":           true,
+	"AWS::ElastiCache::CacheCluster": true,
+	"AWS::OpenSearchService::Domain": true,
+	"AWS::Elasticsearch::Domain":     true,
+}
+
+// snapshotResourceTypes are the stateful resources that are deleted after a final snapshot instead of being retained.
+var snapshotResourceTypes = map[string]bool{
+	"AWS::RDS::DBCluster":  true,
+	"AWS::RDS::DBInstance": true,
+}
+
+// UnretainedStatefulResources returns the logical IDs, in alphabetical order, of the resources of a CloudFormation template
+// that hold data and would be deleted along with their stack because their DeletionPolicy isn't Retain.
+func UnretainedStatefulResources(template string) ([]string, error) {
+	var tpl struct {
+		Resources map[string]struct {
+			Type           string `yaml:"Type"`
+			DeletionPolicy string `yaml:"DeletionPolicy"`
+		} `yaml:"Resources"`
+	}
+	if err := yaml.Unmarshal([]byte(template), &tpl); err != nil {
+		return nil, fmt.Errorf("unmarshal resources of addons template: %w", err)
+	}
+	var ids []string
+	for id, resource := range tpl.Resources {
+		if statefulResourceTypes[resource.Type] && resource.DeletionPolicy != deletionPolicyRetain {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	return ids, nil
+}
+
+// Buckets returns the logical IDs, in alphabetical order, of the S3 buckets of a CloudFormation template.
+func Buckets(template string) ([]string, error) {
+	var tpl struct {
+		Resources map[string]struct {
+			Type string `yaml:"Type"`
+		} `yaml:"Resources"`
+	}
+	if err := yaml.Unmarshal([]byte(template), &tpl); err != nil {
+		return nil, fmt.Errorf("unmarshal resources of addons template: %w", err)
+	}
+	var ids []string
+	for id, resource := range tpl.Resources {
+		if resource.Type == "AWS::S3::Bucket" {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	return ids, nil
+}
+
+// RetainStatefulResources returns the CloudFormation template with a DeletionPolicy set on the resources that hold data,
+// so that their data outlives their stack. Databases get a Snapshot policy, while the other stateful resources are retained.
+// It also returns the DeletionPolicy that was set on each resource, keyed by logical ID.
+func RetainStatefulResources(template string) (string, map[string]string, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal([]byte(template), &doc); err != nil {
+		return "", nil, fmt.Errorf("unmarshal addons template: %w", err)
+	}
+	if len(doc.Content) == 0 {
+		return template, nil, nil
+	}
+	resources := mappingValue(doc.Content[0], "Resources")
+	if resources == nil || resources.Kind != yaml.MappingNode {
+		return template, nil, nil
+	}
+	policies := make(map[string]string)
+	for i := 0; i+1 < len(resources.Content); i += 2 {
+		id, resource := resources.Content[i].Value, resources.Content[i+1]
+		resourceType := mappingValue(resource, "Type")
+		if resourceType == nil || !statefulResourceTypes[resourceType.Value] {
+			continue
+		}
+		policy := deletionPolicyRetain
+		if snapshotResourceTypes[resourceType.Value] {
+			policy = deletionPolicySnapshot
+		}
+		if current := mappingValue(resource, "DeletionPolicy"); current != nil {
+			if current.Value == policy || current.Value == deletionPolicyRetain {
+				continue
+			}
+			current.SetString(policy)
+		} else {
+			key, value := &yaml.Node{}, &yaml.Node{}
+			key.SetString("DeletionPolicy")
+			value.SetString(policy)
+			resource.Content = append(resource.Content, key, value)
+		}
+		policies[id] = policy
+	}
+	if len(policies) == 0 {
+		return template, nil, nil
+	}
+	out, err := yaml.Marshal(&doc)
+	if err != nil {
+		return "", nil, fmt.Errorf("marshal addons template: %w", err)
+	}
+	return string(out), policies, nil
+}
+
+// mappingValue returns the value of the key in a mapping node, or nil if the node doesn't have the key.
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// Database is an Aurora cluster or a standalone RDS DB instance of an addons template.
+type Database struct {
+	LogicalID string
+	Cluster   bool // True if the database is an Aurora cluster.
+	// SecretLogicalID is the logical ID of the Secrets Manager secret attached to the database, if any.
+	SecretLogicalID string
+}
+
+// Databases returns the Aurora clusters and the RDS DB instances that aren't part of a cluster of a CloudFormation template,
+// in alphabetical order of logical ID, along with the secrets that are attached to them.
+func Databases(template string) ([]Database, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal([]byte(template), &doc); err != nil {
+		return nil, fmt.Errorf("unmarshal addons template: %w", err)
+	}
+	if len(doc.Content) == 0 {
+		return nil, nil
+	}
+	resources := mappingValue(doc.Content[0], "Resources")
+	if resources == nil || resources.Kind != yaml.MappingNode {
+		return nil, nil
+	}
+	secrets := make(map[string]string) // Logical ID of the secret by logical ID of the database.
+	var dbs []Database
+	for i := 0; i+1 < len(resources.Content); i += 2 {
+		id, resource := resources.Content[i].Value, resources.Content[i+1]
+		resourceType := mappingValue(resource, "Type")
+		if resourceType == nil {
+			continue
+		}
+		props := mappingValue(resource, "Properties")
+		switch resourceType.Value {
+		case "AWS::RDS::DBCluster":
+			dbs = append(dbs, Database{LogicalID: id, Cluster: true})
+		case "AWS::RDS::DBInstance":
+			if props != nil && mappingValue(props, "DBClusterIdentifier") != nil {
+				continue // The instance serves a cluster, which is snapshotted instead.
+			}
+			dbs = append(dbs, Database{LogicalID: id})
+		case "AWS::SecretsManager::SecretTargetAttachment":
+			if props == nil {
+				continue
+			}
+			target, secret := refTarget(mappingValue(props, "TargetId")), refTarget(mappingValue(props, "SecretId"))
+			if target != "" && secret != "" {
+				secrets[target] = secret
+			}
+		}
+	}
+	for i := range dbs {
+		dbs[i].SecretLogicalID = secrets[dbs[i].LogicalID]
+	}
+	sort.Slice(dbs, func(i, j int) bool {
+		return dbs[i].LogicalID < dbs[j].LogicalID
+	})
+	return dbs, nil
+}
+
+// refTarget returns the logical ID that a node references with either "!Ref ID" or "Ref: ID", or an empty string.
+func refTarget(node *yaml.Node) string {
+	if node == nil {
+		return ""
+	}
+	if node.Kind == yaml.ScalarNode && node.Tag == "!Ref" {
+		return node.Value
+	}
+	if ref := mappingValue(node, "Ref"); ref != nil && ref.Kind == yaml.ScalarNode {
+		return ref.Value
+	}
+	return ""
+}
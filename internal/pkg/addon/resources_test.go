@@ -0,0 +1,249 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package addon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnretainedStatefulResources(t *testing.T) {
+	testCases := map[string]struct {
+		inTemplate string
+
+		wantedIDs []string
+		wantedErr string
+	}{
+		"returns stateful resources without a Retain deletion policy": {
+			inTemplate: `Parameters:
+  App:
+    Type: String
+Resources:
+  usersTable:
+    Type: AWS::DynamoDB::Table
+    Properties:
+      TableName: !Sub ${App}-users
+  assetsBucket:
+    Type: AWS::S3::Bucket
+    DeletionPolicy: Retain
+  ordersDB:
+    Type: AWS::RDS::DBCluster
+    DeletionPolicy: Snapshot
+  accessPolicy:
+    Type: AWS::IAM::ManagedPolicy
+`,
+			wantedIDs: []string{"ordersDB", "usersTable"},
+		},
+		"returns nothing if every stateful resource is retained": {
+			inTemplate: `Resources:
+  assetsBucket:
+    Type: AWS::S3::Bucket
+    DeletionPolicy: Retain
+`,
+		},
+		"errors if the template is invalid": {
+			inTemplate: `Resources: [`,
+			wantedErr:  "unmarshal resources of addons template: yaml: line 1: did not find expected node content",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ids, err := UnretainedStatefulResources(tc.inTemplate)
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedIDs, ids)
+		})
+	}
+}
+
+func TestBuckets(t *testing.T) {
+	testCases := map[string]struct {
+		inTemplate string
+
+		wantedIDs []string
+		wantedErr string
+	}{
+		"returns the buckets": {
+			inTemplate: `Resources:
+  usersTable:
+    Type: AWS::DynamoDB::Table
+  uploads:
+    Type: AWS::S3::Bucket
+  assets:
+    Type: AWS::S3::Bucket
+  assetsBucketPolicy:
+    Type: AWS::S3::BucketPolicy
+`,
+			wantedIDs: []string{"assets", "uploads"},
+		},
+		"errors if the template is invalid": {
+			inTemplate: `Resources: [`,
+			wantedErr:  "unmarshal resources of addons template: yaml: line 1: did not find expected node content",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ids, err := Buckets(tc.inTemplate)
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedIDs, ids)
+		})
+	}
+}
+
+func TestRetainStatefulResources(t *testing.T) {
+	testCases := map[string]struct {
+		inTemplate string
+
+		wantedTemplate string
+		wantedPolicies map[string]string
+		wantedErr      string
+	}{
+		"retains stateful resources and snapshots databases": {
+			inTemplate: `Resources:
+  usersTable:
+    Type: AWS::DynamoDB::Table
+  assetsBucket:
+    Type: AWS::S3::Bucket
+    DeletionPolicy: Retain
+  ordersDB:
+    Type: AWS::RDS::DBCluster
+    DeletionPolicy: Delete
+  accessPolicy:
+    Type: AWS::IAM::ManagedPolicy
+`,
+			wantedTemplate: `Resources:
+    usersTable:
+        Type: AWS::DynamoDB::Table
+        DeletionPolicy: Retain
+    assetsBucket:
+        Type: AWS::S3::Bucket
+        DeletionPolicy: Retain
+    ordersDB:
+        Type: AWS::RDS::DBCluster
+        DeletionPolicy: Snapshot
+    accessPolicy:
+        Type: AWS::IAM::ManagedPolicy
+`,
+			wantedPolicies: map[string]string{
+				"usersTable": "Retain",
+				"ordersDB":   "Snapshot",
+			},
+		},
+		"returns the template unchanged if every stateful resource is retained": {
+			inTemplate: `Resources:
+  assetsBucket:
+    Type: AWS::S3::Bucket
+    DeletionPolicy: Retain
+`,
+			wantedTemplate: `Resources:
+  assetsBucket:
+    Type: AWS::S3::Bucket
+    DeletionPolicy: Retain
+`,
+		},
+		"errors if the template is invalid": {
+			inTemplate: `Resources: [`,
+			wantedErr:  "unmarshal addons template: yaml: line 1: did not find expected node content",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			template, policies, err := RetainStatefulResources(tc.inTemplate)
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedTemplate, template)
+			require.Equal(t, tc.wantedPolicies, policies)
+		})
+	}
+}
+
+func TestDatabases(t *testing.T) {
+	testCases := map[string]struct {
+		inTemplate string
+
+		wantedDBs []Database
+		wantedErr string
+	}{
+		"returns the clusters and standalone instances with their secrets": {
+			inTemplate: `Resources:
+  ordersCluster:
+    Type: AWS::RDS::DBCluster
+  ordersWriter:
+    Type: AWS::RDS::DBInstance
+    Properties:
+      DBClusterIdentifier: !Ref ordersCluster
+  ordersSecret:
+    Type: AWS::SecretsManager::Secret
+  ordersSecretAttachment:
+    Type: AWS::SecretsManager::SecretTargetAttachment
+    Properties:
+      SecretId: !Ref ordersSecret
+      TargetId: !Ref ordersCluster
+      TargetType: AWS::RDS::DBCluster
+  legacyDB:
+    Type: AWS::RDS::DBInstance
+    Properties:
+      DBInstanceClass: db.t3.micro
+  legacySecretAttachment:
+    Type: AWS::SecretsManager::SecretTargetAttachment
+    Properties:
+      SecretId:
+        Ref: legacySecret
+      TargetId:
+        Ref: legacyDB
+`,
+			wantedDBs: []Database{
+				{
+					LogicalID:       "legacyDB",
+					SecretLogicalID: "legacySecret",
+				},
+				{
+					LogicalID:       "ordersCluster",
+					Cluster:         true,
+					SecretLogicalID: "ordersSecret",
+				},
+			},
+		},
+		"returns nothing if the template has no databases": {
+			inTemplate: `Resources:
+  usersTable:
+    Type: AWS::DynamoDB::Table
+`,
+		},
+		"errors if the template is invalid": {
+			inTemplate: `Resources: [`,
+			wantedErr:  "unmarshal addons template: yaml: line 1: did not find expected node content",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			dbs, err := Databases(tc.inTemplate)
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedDBs, dbs)
+		})
+	}
+}
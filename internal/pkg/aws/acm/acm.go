@@ -0,0 +1,245 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package acm provides a client to make API requests to AWS Certificate Manager.
+package acm
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/aws/copilot-cli/internal/pkg/trace"
+)
+
+const (
+	// idempotencyTokenLength is the maximum length of the token that identifies a certificate request.
+	idempotencyTokenLength = 32
+
+	// ACM fills in the DNS records of a requested certificate within seconds.
+	validationRecordsMaxAttempts = 30
+)
+
+var (
+	// certificatePollInterval is the time to wait between requests for the status of a certificate.
+	certificatePollInterval = 10 * time.Second
+	// certificateIssueTimeout is how long to wait for a certificate to be issued once its validation records exist.
+	// ACM gives up on validating a certificate after 72 hours, but DNS validation usually takes minutes.
+	certificateIssueTimeout = time.Hour
+)
+
+type api interface {
+	DescribeCertificate(input *acm.DescribeCertificateInput) (*acm.DescribeCertificateOutput, error)
+	RequestCertificate(input *acm.RequestCertificateInput) (*acm.RequestCertificateOutput, error)
+}
+
+// ACM wraps an AWS Certificate Manager client.
+type ACM struct {
+	client api
+}
+
+// Certificate contains the expiry and renewal status of an ACM certificate.
+type Certificate struct {
+	ARN           string    `json:"arn"`
+	DomainName    string    `json:"domainName"`
+	Status        string    `json:"status"`
+	NotAfter      time.Time `json:"notAfter"`
+	RenewalStatus string    `json:"renewalStatus,omitempty"`
+	// SubjectAlternativeNames are all the domains the certificate is valid for, including its domain name.
+	SubjectAlternativeNames []string `json:"subjectAlternativeNames,omitempty"`
+	// MissingValidationRecords holds the DNS records that ACM is waiting on to validate or renew the certificate.
+	MissingValidationRecords []ValidationRecord `json:"missingValidationRecords,omitempty"`
+}
+
+// ValidationRecord is a CNAME record used by ACM to prove ownership of a domain.
+type ValidationRecord struct {
+	DomainName string `json:"domainName"`
+	Name       string `json:"name"`
+	Value      string `json:"value"`
+}
+
+// New returns an ACM struct configured against the input session.
+func New(s *session.Session) *ACM {
+	return &ACM{
+		client: acm.New(s),
+	}
+}
+
+// DescribeCertificate returns the expiry and renewal status of the certificate with the given ARN.
+func (a *ACM) DescribeCertificate(arn string) (*Certificate, error) {
+	resp, err := a.client.DescribeCertificate(&acm.DescribeCertificateInput{
+		CertificateArn: aws.String(arn),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe certificate %s: %w", arn, err)
+	}
+	cert := resp.Certificate
+	if cert == nil {
+		return nil, fmt.Errorf("certificate %s not found", arn)
+	}
+	out := &Certificate{
+		ARN:                      aws.StringValue(cert.CertificateArn),
+		DomainName:               aws.StringValue(cert.DomainName),
+		Status:                   aws.StringValue(cert.Status),
+		NotAfter:                 aws.TimeValue(cert.NotAfter),
+		MissingValidationRecords: missingValidationRecords(cert.DomainValidationOptions),
+	}
+	for _, name := range cert.SubjectAlternativeNames {
+		out.SubjectAlternativeNames = append(out.SubjectAlternativeNames, aws.StringValue(name))
+	}
+	if cert.RenewalSummary != nil {
+		out.RenewalStatus = aws.StringValue(cert.RenewalSummary.RenewalStatus)
+		out.MissingValidationRecords = append(out.MissingValidationRecords, missingValidationRecords(cert.RenewalSummary.DomainValidationOptions)...)
+	}
+	return out, nil
+}
+
+// RequestCertificate requests a public certificate for the domain and the alternative names validated with DNS,
+// and returns its ARN. Requesting the same domains again within an hour returns the same certificate.
+func (a *ACM) RequestCertificate(domainName string, alternativeNames []string) (string, error) {
+	in := &acm.RequestCertificateInput{
+		DomainName:       aws.String(domainName),
+		ValidationMethod: aws.String(acm.ValidationMethodDns),
+		IdempotencyToken: aws.String(idempotencyToken(domainName, alternativeNames)),
+	}
+	if len(alternativeNames) != 0 {
+		in.SubjectAlternativeNames = aws.StringSlice(alternativeNames)
+	}
+	resp, err := a.client.RequestCertificate(in)
+	if err != nil {
+		return "", fmt.Errorf("request certificate for %s: %w", domainName, err)
+	}
+	return aws.StringValue(resp.CertificateArn), nil
+}
+
+// ValidationRecords returns the CNAME records to create to validate the domains of the certificate.
+// ACM fills in the records shortly after the certificate is requested, so it waits until every domain has one.
+func (a *ACM) ValidationRecords(arn string) ([]ValidationRecord, error) {
+	for attempt := 0; attempt < validationRecordsMaxAttempts; attempt++ {
+		resp, err := a.client.DescribeCertificate(&acm.DescribeCertificateInput{
+			CertificateArn: aws.String(arn),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("describe certificate %s: %w", arn, err)
+		}
+		// The certificate or its validation options can be missing right after the certificate is requested.
+		if resp.Certificate != nil && hasAllValidationRecords(resp.Certificate.DomainValidationOptions) {
+			return missingValidationRecords(resp.Certificate.DomainValidationOptions), nil
+		}
+		time.Sleep(certificatePollInterval)
+	}
+	return nil, fmt.Errorf("certificate %s has no DNS validation records after %d attempts", arn, validationRecordsMaxAttempts)
+}
+
+// WaitUntilIssued waits until the certificate is issued, calling onStatus each time the status of the certificate changes.
+func (a *ACM) WaitUntilIssued(arn string, onStatus func(status string)) (err error) {
+	span := trace.Start("wait for certificate to be issued", trace.Attr("certificate", arn))
+	defer func() { span.End(err) }()
+
+	var lastStatus string
+	deadline := time.Now().Add(certificateIssueTimeout)
+	for {
+		resp, err := a.client.DescribeCertificate(&acm.DescribeCertificateInput{
+			CertificateArn: aws.String(arn),
+		})
+		if err != nil {
+			return fmt.Errorf("describe certificate %s: %w", arn, err)
+		}
+		if resp.Certificate == nil {
+			if time.Now().After(deadline) {
+				return fmt.Errorf("certificate %s is still not found after %s", arn, certificateIssueTimeout)
+			}
+			time.Sleep(certificatePollInterval)
+			continue
+		}
+		status := aws.StringValue(resp.Certificate.Status)
+		if status != lastStatus && onStatus != nil {
+			onStatus(status)
+		}
+		lastStatus = status
+		switch status {
+		case acm.CertificateStatusIssued:
+			return nil
+		case acm.CertificateStatusPendingValidation:
+		default:
+			if reason := aws.StringValue(resp.Certificate.FailureReason); reason != "" {
+				return fmt.Errorf("certificate %s is %s: %s", arn, status, reason)
+			}
+			return fmt.Errorf("certificate %s is %s", arn, status)
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("certificate %s is still %s after %s", arn, status, certificateIssueTimeout)
+		}
+		time.Sleep(certificatePollInterval)
+	}
+}
+
+// Covers returns true if the certificate is valid for the domain, either by name or with a wildcard.
+func (c *Certificate) Covers(domain string) bool {
+	domain = strings.ToLower(strings.TrimSuffix(domain, "."))
+	for _, name := range append([]string{c.DomainName}, c.SubjectAlternativeNames...) {
+		name = strings.ToLower(name)
+		if name == domain {
+			return true
+		}
+		// A wildcard only matches a single label, so "*.example.com" doesn't cover "example.com" or "a.b.example.com".
+		if strings.HasPrefix(name, "*.") {
+			if i := strings.Index(domain, "."); i > 0 && domain[i:] == name[1:] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// idempotencyToken returns a token that identifies the request of a certificate for the domains.
+func idempotencyToken(domainName string, alternativeNames []string) string {
+	sum := sha256.Sum256([]byte(strings.Join(append([]string{domainName}, alternativeNames...), ",")))
+	return hex.EncodeToString(sum[:])[:idempotencyTokenLength]
+}
+
+// hasAllValidationRecords returns true if ACM has generated the DNS record of every domain validated with DNS.
+func hasAllValidationRecords(validations []*acm.DomainValidation) bool {
+	if len(validations) == 0 {
+		return false
+	}
+	for _, validation := range validations {
+		if validation == nil {
+			return false
+		}
+		if aws.StringValue(validation.ValidationMethod) == acm.ValidationMethodDns && validation.ResourceRecord == nil {
+			return false
+		}
+	}
+	return true
+}
+
+// missingValidationRecords returns the DNS validation records of domains that haven't been validated yet.
+func missingValidationRecords(validations []*acm.DomainValidation) []ValidationRecord {
+	var records []ValidationRecord
+	for _, validation := range validations {
+		if validation == nil {
+			continue
+		}
+		if aws.StringValue(validation.ValidationMethod) != acm.ValidationMethodDns {
+			continue
+		}
+		if aws.StringValue(validation.ValidationStatus) == acm.DomainStatusSuccess {
+			continue
+		}
+		if validation.ResourceRecord == nil {
+			continue
+		}
+		records = append(records, ValidationRecord{
+			DomainName: aws.StringValue(validation.DomainName),
+			Name:       aws.StringValue(validation.ResourceRecord.Name),
+			Value:      aws.StringValue(validation.ResourceRecord.Value),
+		})
+	}
+	return records
+}
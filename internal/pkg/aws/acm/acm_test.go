@@ -0,0 +1,419 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package acm
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/acm/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestACM_DescribeCertificate(t *testing.T) {
+	const mockARN = "arn:aws:acm:us-west-2:123456789012:certificate/abc"
+	notAfter := time.Date(2021, 8, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		mockClient func(m *mocks.Mockapi)
+
+		wanted      *Certificate
+		wantedError error
+	}{
+		"errors if failed to describe the certificate": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeCertificate(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("describe certificate %s: some error", mockARN),
+		},
+		"errors if the certificate is missing": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeCertificate(gomock.Any()).Return(&acm.DescribeCertificateOutput{}, nil)
+			},
+			wantedError: fmt.Errorf("certificate %s not found", mockARN),
+		},
+		"returns an issued certificate": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeCertificate(&acm.DescribeCertificateInput{
+					CertificateArn: aws.String(mockARN),
+				}).Return(&acm.DescribeCertificateOutput{
+					Certificate: &acm.CertificateDetail{
+						CertificateArn:          aws.String(mockARN),
+						DomainName:              aws.String("test.phonetool.com"),
+						Status:                  aws.String(acm.CertificateStatusIssued),
+						NotAfter:                aws.Time(notAfter),
+						SubjectAlternativeNames: aws.StringSlice([]string{"test.phonetool.com", "*.test.phonetool.com"}),
+						DomainValidationOptions: []*acm.DomainValidation{
+							{
+								DomainName:       aws.String("test.phonetool.com"),
+								ValidationMethod: aws.String(acm.ValidationMethodDns),
+								ValidationStatus: aws.String(acm.DomainStatusSuccess),
+							},
+						},
+						RenewalSummary: &acm.RenewalSummary{
+							RenewalStatus: aws.String(acm.RenewalStatusSuccess),
+						},
+					},
+				}, nil)
+			},
+			wanted: &Certificate{
+				ARN:                     mockARN,
+				DomainName:              "test.phonetool.com",
+				Status:                  acm.CertificateStatusIssued,
+				NotAfter:                notAfter,
+				RenewalStatus:           acm.RenewalStatusSuccess,
+				SubjectAlternativeNames: []string{"test.phonetool.com", "*.test.phonetool.com"},
+			},
+		},
+		"returns the validation records that are missing": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeCertificate(gomock.Any()).Return(&acm.DescribeCertificateOutput{
+					Certificate: &acm.CertificateDetail{
+						CertificateArn: aws.String(mockARN),
+						DomainName:     aws.String("test.phonetool.com"),
+						Status:         aws.String(acm.CertificateStatusIssued),
+						NotAfter:       aws.Time(notAfter),
+						DomainValidationOptions: []*acm.DomainValidation{
+							{
+								DomainName:       aws.String("test.phonetool.com"),
+								ValidationMethod: aws.String(acm.ValidationMethodDns),
+								ValidationStatus: aws.String(acm.DomainStatusSuccess),
+							},
+						},
+						RenewalSummary: &acm.RenewalSummary{
+							RenewalStatus: aws.String(acm.RenewalStatusPendingAutoRenewal),
+							DomainValidationOptions: []*acm.DomainValidation{
+								{
+									DomainName:       aws.String("test.phonetool.com"),
+									ValidationMethod: aws.String(acm.ValidationMethodDns),
+									ValidationStatus: aws.String(acm.DomainStatusPendingValidation),
+									ResourceRecord: &acm.ResourceRecord{
+										Name:  aws.String("_x1.test.phonetool.com."),
+										Type:  aws.String(acm.RecordTypeCname),
+										Value: aws.String("_x2.acm-validations.aws."),
+									},
+								},
+								{
+									DomainName:       aws.String("test.phonetool.com"),
+									ValidationMethod: aws.String(acm.ValidationMethodEmail),
+									ValidationStatus: aws.String(acm.DomainStatusPendingValidation),
+								},
+							},
+						},
+					},
+				}, nil)
+			},
+			wanted: &Certificate{
+				ARN:           mockARN,
+				DomainName:    "test.phonetool.com",
+				Status:        acm.CertificateStatusIssued,
+				NotAfter:      notAfter,
+				RenewalStatus: acm.RenewalStatusPendingAutoRenewal,
+				MissingValidationRecords: []ValidationRecord{
+					{
+						DomainName: "test.phonetool.com",
+						Name:       "_x1.test.phonetool.com.",
+						Value:      "_x2.acm-validations.aws.",
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockClient := mocks.NewMockapi(ctrl)
+			tc.mockClient(mockClient)
+			client := ACM{
+				client: mockClient,
+			}
+
+			// WHEN
+			got, err := client.DescribeCertificate(mockARN)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestCertificate_Covers(t *testing.T) {
+	cert := &Certificate{
+		DomainName:              "phonetool.com",
+		SubjectAlternativeNames: []string{"phonetool.com", "*.api.phonetool.com"},
+	}
+	testCases := map[string]struct {
+		inDomain string
+		wanted   bool
+	}{
+		"matches the domain name": {
+			inDomain: "PhoneTool.com.",
+			wanted:   true,
+		},
+		"matches a single label with a wildcard": {
+			inDomain: "v1.api.phonetool.com",
+			wanted:   true,
+		},
+		"doesn't match the parent of a wildcard": {
+			inDomain: "api.phonetool.com",
+		},
+		"doesn't match several labels with a wildcard": {
+			inDomain: "a.v1.api.phonetool.com",
+		},
+		"doesn't match other domains": {
+			inDomain: "www.phonetool.com",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, cert.Covers(tc.inDomain))
+		})
+	}
+}
+
+func TestACM_RequestCertificate(t *testing.T) {
+	testCases := map[string]struct {
+		inAlternativeNames []string
+		mockClient         func(m *mocks.Mockapi)
+
+		wantedARN   string
+		wantedError error
+	}{
+		"errors if failed to request the certificate": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().RequestCertificate(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("request certificate for phonetool.com: some error"),
+		},
+		"requests a certificate validated with DNS": {
+			inAlternativeNames: []string{"*.phonetool.com"},
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().RequestCertificate(gomock.Any()).DoAndReturn(func(in *acm.RequestCertificateInput) (*acm.RequestCertificateOutput, error) {
+					require.Equal(t, "phonetool.com", aws.StringValue(in.DomainName))
+					require.Equal(t, []string{"*.phonetool.com"}, aws.StringValueSlice(in.SubjectAlternativeNames))
+					require.Equal(t, acm.ValidationMethodDns, aws.StringValue(in.ValidationMethod))
+					require.Len(t, aws.StringValue(in.IdempotencyToken), 32)
+					return &acm.RequestCertificateOutput{
+						CertificateArn: aws.String("arn:aws:acm:us-west-2:123456789012:certificate/abc"),
+					}, nil
+				})
+			},
+			wantedARN: "arn:aws:acm:us-west-2:123456789012:certificate/abc",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockClient := mocks.NewMockapi(ctrl)
+			tc.mockClient(mockClient)
+			client := ACM{
+				client: mockClient,
+			}
+
+			// WHEN
+			arn, err := client.RequestCertificate("phonetool.com", tc.inAlternativeNames)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedARN, arn)
+		})
+	}
+}
+
+func TestIdempotencyToken(t *testing.T) {
+	require.Equal(t, idempotencyToken("phonetool.com", nil), idempotencyToken("phonetool.com", nil))
+	require.NotEqual(t, idempotencyToken("phonetool.com", nil), idempotencyToken("phonetool.com", []string{"*.phonetool.com"}))
+}
+
+func TestACM_ValidationRecords(t *testing.T) {
+	const mockARN = "arn:aws:acm:us-west-2:123456789012:certificate/abc"
+	defaultPollInterval := certificatePollInterval
+	certificatePollInterval = 0
+	defer func() { certificatePollInterval = defaultPollInterval }()
+
+	pendingValidation := &acm.DomainValidation{
+		DomainName:       aws.String("phonetool.com"),
+		ValidationMethod: aws.String(acm.ValidationMethodDns),
+		ValidationStatus: aws.String(acm.DomainStatusPendingValidation),
+	}
+	validationWithRecord := &acm.DomainValidation{
+		DomainName:       aws.String("phonetool.com"),
+		ValidationMethod: aws.String(acm.ValidationMethodDns),
+		ValidationStatus: aws.String(acm.DomainStatusPendingValidation),
+		ResourceRecord: &acm.ResourceRecord{
+			Name:  aws.String("_x1.phonetool.com."),
+			Value: aws.String("_x2.acm-validations.aws."),
+		},
+	}
+	testCases := map[string]struct {
+		mockClient func(m *mocks.Mockapi)
+
+		wantedRecords []ValidationRecord
+		wantedError   error
+	}{
+		"errors if failed to describe the certificate": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeCertificate(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("describe certificate %s: some error", mockARN),
+		},
+		"errors if the records are never generated": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeCertificate(gomock.Any()).Return(&acm.DescribeCertificateOutput{
+					Certificate: &acm.CertificateDetail{
+						DomainValidationOptions: []*acm.DomainValidation{pendingValidation},
+					},
+				}, nil).Times(validationRecordsMaxAttempts)
+			},
+			wantedError: fmt.Errorf("certificate %s has no DNS validation records after 30 attempts", mockARN),
+		},
+		"waits until every domain has a validation record": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeCertificate(&acm.DescribeCertificateInput{
+					CertificateArn: aws.String(mockARN),
+				}).Return(&acm.DescribeCertificateOutput{}, nil)
+				m.EXPECT().DescribeCertificate(gomock.Any()).Return(&acm.DescribeCertificateOutput{
+					Certificate: &acm.CertificateDetail{},
+				}, nil)
+				m.EXPECT().DescribeCertificate(gomock.Any()).Return(&acm.DescribeCertificateOutput{
+					Certificate: &acm.CertificateDetail{
+						DomainValidationOptions: []*acm.DomainValidation{nil},
+					},
+				}, nil)
+				m.EXPECT().DescribeCertificate(gomock.Any()).Return(&acm.DescribeCertificateOutput{
+					Certificate: &acm.CertificateDetail{
+						DomainValidationOptions: []*acm.DomainValidation{validationWithRecord},
+					},
+				}, nil)
+			},
+			wantedRecords: []ValidationRecord{
+				{
+					DomainName: "phonetool.com",
+					Name:       "_x1.phonetool.com.",
+					Value:      "_x2.acm-validations.aws.",
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockClient := mocks.NewMockapi(ctrl)
+			tc.mockClient(mockClient)
+			client := ACM{
+				client: mockClient,
+			}
+
+			// WHEN
+			records, err := client.ValidationRecords(mockARN)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedRecords, records)
+		})
+	}
+}
+
+func TestACM_WaitUntilIssued(t *testing.T) {
+	const mockARN = "arn:aws:acm:us-west-2:123456789012:certificate/abc"
+	defaultPollInterval := certificatePollInterval
+	certificatePollInterval = 0
+	defer func() { certificatePollInterval = defaultPollInterval }()
+
+	withStatus := func(status, reason string) *acm.DescribeCertificateOutput {
+		out := &acm.DescribeCertificateOutput{
+			Certificate: &acm.CertificateDetail{
+				Status: aws.String(status),
+			},
+		}
+		if reason != "" {
+			out.Certificate.FailureReason = aws.String(reason)
+		}
+		return out
+	}
+	testCases := map[string]struct {
+		mockClient func(m *mocks.Mockapi)
+
+		wantedStatuses []string
+		wantedError    error
+	}{
+		"errors if failed to describe the certificate": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeCertificate(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("describe certificate %s: some error", mockARN),
+		},
+		"errors if the validation failed": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeCertificate(gomock.Any()).Return(withStatus(acm.CertificateStatusPendingValidation, ""), nil)
+				m.EXPECT().DescribeCertificate(gomock.Any()).Return(withStatus(acm.CertificateStatusFailed, acm.FailureReasonCaaError), nil)
+			},
+			wantedStatuses: []string{acm.CertificateStatusPendingValidation, acm.CertificateStatusFailed},
+			wantedError:    fmt.Errorf("certificate %s is FAILED: CAA_ERROR", mockARN),
+		},
+		"reports each status until the certificate is issued": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeCertificate(gomock.Any()).Return(&acm.DescribeCertificateOutput{}, nil)
+				m.EXPECT().DescribeCertificate(gomock.Any()).Return(withStatus(acm.CertificateStatusPendingValidation, ""), nil).Times(2)
+				m.EXPECT().DescribeCertificate(gomock.Any()).Return(withStatus(acm.CertificateStatusIssued, ""), nil)
+			},
+			wantedStatuses: []string{acm.CertificateStatusPendingValidation, acm.CertificateStatusIssued},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockClient := mocks.NewMockapi(ctrl)
+			tc.mockClient(mockClient)
+			client := ACM{
+				client: mockClient,
+			}
+			var statuses []string
+
+			// WHEN
+			err := client.WaitUntilIssued(mockARN, func(status string) {
+				statuses = append(statuses, status)
+			})
+
+			// THEN
+			require.Equal(t, tc.wantedStatuses, statuses)
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
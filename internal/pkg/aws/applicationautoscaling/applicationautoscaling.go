@@ -0,0 +1,90 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package applicationautoscaling provides a client to make API requests to Application Auto Scaling.
+package applicationautoscaling
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
+)
+
+// fmtECSResourceID is the ID of an ECS service as a scalable target, for example "service/my-cluster/my-service".
+const fmtECSResourceID = "service/%s/%s"
+
+type api interface {
+	DescribeScalableTargets(input *applicationautoscaling.DescribeScalableTargetsInput) (*applicationautoscaling.DescribeScalableTargetsOutput, error)
+	DescribeScalingActivities(input *applicationautoscaling.DescribeScalingActivitiesInput) (*applicationautoscaling.DescribeScalingActivitiesOutput, error)
+}
+
+// ApplicationAutoScaling wraps an Application Auto Scaling client.
+type ApplicationAutoScaling struct {
+	client api
+}
+
+// New returns an ApplicationAutoScaling configured against the input session.
+func New(s *session.Session) *ApplicationAutoScaling {
+	return &ApplicationAutoScaling{
+		client: applicationautoscaling.New(s),
+	}
+}
+
+// ServiceScaling holds the bounds of the desired count of an ECS service and its latest scaling activity.
+type ServiceScaling struct {
+	MinCapacity  int64            `json:"minCapacity"`
+	MaxCapacity  int64            `json:"maxCapacity"`
+	LastActivity *ScalingActivity `json:"lastActivity,omitempty"`
+}
+
+// ScalingActivity is a change of the desired count of a scalable target, such as a scale out triggered by an alarm.
+type ScalingActivity struct {
+	Description string    `json:"description"`
+	Cause       string    `json:"cause"`
+	Status      string    `json:"status"`
+	StartTime   time.Time `json:"startTime"`
+}
+
+// ECSServiceScaling returns how the desired count of the ECS service is scaled, or nil if it isn't autoscaled.
+func (a *ApplicationAutoScaling) ECSServiceScaling(clusterName, serviceName string) (*ServiceScaling, error) {
+	resourceID := fmt.Sprintf(fmtECSResourceID, clusterName, serviceName)
+	targets, err := a.client.DescribeScalableTargets(&applicationautoscaling.DescribeScalableTargetsInput{
+		ServiceNamespace:  aws.String(applicationautoscaling.ServiceNamespaceEcs),
+		ScalableDimension: aws.String(applicationautoscaling.ScalableDimensionEcsServiceDesiredCount),
+		ResourceIds:       aws.StringSlice([]string{resourceID}),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe scalable target %s: %w", resourceID, err)
+	}
+	if len(targets.ScalableTargets) == 0 {
+		return nil, nil
+	}
+	target := targets.ScalableTargets[0]
+	scaling := &ServiceScaling{
+		MinCapacity: aws.Int64Value(target.MinCapacity),
+		MaxCapacity: aws.Int64Value(target.MaxCapacity),
+	}
+	// Activities are returned from the most recent.
+	activities, err := a.client.DescribeScalingActivities(&applicationautoscaling.DescribeScalingActivitiesInput{
+		ServiceNamespace:  aws.String(applicationautoscaling.ServiceNamespaceEcs),
+		ScalableDimension: aws.String(applicationautoscaling.ScalableDimensionEcsServiceDesiredCount),
+		ResourceId:        aws.String(resourceID),
+		MaxResults:        aws.Int64(1),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe scaling activities of %s: %w", resourceID, err)
+	}
+	if len(activities.ScalingActivities) != 0 {
+		activity := activities.ScalingActivities[0]
+		scaling.LastActivity = &ScalingActivity{
+			Description: aws.StringValue(activity.Description),
+			Cause:       aws.StringValue(activity.Cause),
+			Status:      aws.StringValue(activity.StatusCode),
+			StartTime:   aws.TimeValue(activity.StartTime),
+		}
+	}
+	return scaling, nil
+}
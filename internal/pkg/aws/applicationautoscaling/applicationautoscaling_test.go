@@ -0,0 +1,124 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package applicationautoscaling
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
+	"github.com/aws/copilot-cli/internal/pkg/aws/applicationautoscaling/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplicationAutoScaling_ECSServiceScaling(t *testing.T) {
+	startTime := time.Unix(1600000000, 0)
+	testCases := map[string]struct {
+		mockClient func(m *mocks.Mockapi)
+
+		wantedScaling *ServiceScaling
+		wantedErr     string
+	}{
+		"errors if the scalable target can't be described": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeScalableTargets(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: "describe scalable target service/phonetool-test-Cluster/api: some error",
+		},
+		"returns nil if the service isn't autoscaled": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeScalableTargets(&applicationautoscaling.DescribeScalableTargetsInput{
+					ServiceNamespace:  aws.String("ecs"),
+					ScalableDimension: aws.String("ecs:service:DesiredCount"),
+					ResourceIds:       aws.StringSlice([]string{"service/phonetool-test-Cluster/api"}),
+				}).Return(&applicationautoscaling.DescribeScalableTargetsOutput{}, nil)
+				m.EXPECT().DescribeScalingActivities(gomock.Any()).Times(0)
+			},
+		},
+		"errors if the scaling activities can't be described": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeScalableTargets(gomock.Any()).Return(&applicationautoscaling.DescribeScalableTargetsOutput{
+					ScalableTargets: []*applicationautoscaling.ScalableTarget{
+						{MinCapacity: aws.Int64(1), MaxCapacity: aws.Int64(10)},
+					},
+				}, nil)
+				m.EXPECT().DescribeScalingActivities(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: "describe scaling activities of service/phonetool-test-Cluster/api: some error",
+		},
+		"returns the bounds of the desired count without activities": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeScalableTargets(gomock.Any()).Return(&applicationautoscaling.DescribeScalableTargetsOutput{
+					ScalableTargets: []*applicationautoscaling.ScalableTarget{
+						{MinCapacity: aws.Int64(1), MaxCapacity: aws.Int64(10)},
+					},
+				}, nil)
+				m.EXPECT().DescribeScalingActivities(gomock.Any()).Return(&applicationautoscaling.DescribeScalingActivitiesOutput{}, nil)
+			},
+			wantedScaling: &ServiceScaling{
+				MinCapacity: 1,
+				MaxCapacity: 10,
+			},
+		},
+		"returns the latest scaling activity": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeScalableTargets(gomock.Any()).Return(&applicationautoscaling.DescribeScalableTargetsOutput{
+					ScalableTargets: []*applicationautoscaling.ScalableTarget{
+						{MinCapacity: aws.Int64(1), MaxCapacity: aws.Int64(10)},
+					},
+				}, nil)
+				m.EXPECT().DescribeScalingActivities(&applicationautoscaling.DescribeScalingActivitiesInput{
+					ServiceNamespace:  aws.String("ecs"),
+					ScalableDimension: aws.String("ecs:service:DesiredCount"),
+					ResourceId:        aws.String("service/phonetool-test-Cluster/api"),
+					MaxResults:        aws.Int64(1),
+				}).Return(&applicationautoscaling.DescribeScalingActivitiesOutput{
+					ScalingActivities: []*applicationautoscaling.ScalingActivity{
+						{
+							Description: aws.String("Setting desired count to 3."),
+							Cause:       aws.String("monitor alarm TargetTracking-service/phonetool-test-Cluster/api-AlarmHigh in state ALARM triggered policy cpu"),
+							StatusCode:  aws.String("Successful"),
+							StartTime:   aws.Time(startTime),
+						},
+					},
+				}, nil)
+			},
+			wantedScaling: &ServiceScaling{
+				MinCapacity: 1,
+				MaxCapacity: 10,
+				LastActivity: &ScalingActivity{
+					Description: "Setting desired count to 3.",
+					Cause:       "monitor alarm TargetTracking-service/phonetool-test-Cluster/api-AlarmHigh in state ALARM triggered policy cpu",
+					Status:      "Successful",
+					StartTime:   startTime,
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.mockClient(m)
+			client := ApplicationAutoScaling{client: m}
+
+			// WHEN
+			scaling, err := client.ECSServiceScaling("phonetool-test-Cluster", "api")
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedScaling, scaling)
+		})
+	}
+}
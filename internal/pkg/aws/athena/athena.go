@@ -0,0 +1,133 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package athena provides a client to make API requests to Amazon Athena.
+package athena
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/athena"
+)
+
+// queryPollInterval is the time to wait between requests for the state of a query.
+var queryPollInterval = time.Second
+
+type api interface {
+	StartQueryExecution(input *athena.StartQueryExecutionInput) (*athena.StartQueryExecutionOutput, error)
+	GetQueryExecution(input *athena.GetQueryExecutionInput) (*athena.GetQueryExecutionOutput, error)
+	GetQueryResults(input *athena.GetQueryResultsInput) (*athena.GetQueryResultsOutput, error)
+}
+
+// Athena wraps an AWS Athena client.
+type Athena struct {
+	client api
+}
+
+// New returns an Athena client configured against the input session.
+func New(s *session.Session) *Athena {
+	return &Athena{
+		client: athena.New(s),
+	}
+}
+
+// Query runs a SQL query against a database in a workgroup, and waits for its results.
+// The workgroup holds the location where Athena stores the results of the query.
+func (a *Athena) Query(workGroup, database, query string) (*QueryResults, error) {
+	out, err := a.client.StartQueryExecution(&athena.StartQueryExecutionInput{
+		QueryString: aws.String(query),
+		QueryExecutionContext: &athena.QueryExecutionContext{
+			Database: aws.String(database),
+		},
+		WorkGroup: aws.String(workGroup),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("start query on database %s: %w", database, err)
+	}
+	queryID := aws.StringValue(out.QueryExecutionId)
+	if err := a.waitForQuery(queryID); err != nil {
+		return nil, err
+	}
+	return a.queryResults(queryID)
+}
+
+func (a *Athena) waitForQuery(queryID string) error {
+	for {
+		resp, err := a.client.GetQueryExecution(&athena.GetQueryExecutionInput{
+			QueryExecutionId: aws.String(queryID),
+		})
+		if err != nil {
+			return fmt.Errorf("get execution of query %s: %w", queryID, err)
+		}
+		status := resp.QueryExecution.Status
+		switch state := aws.StringValue(status.State); state {
+		case athena.QueryExecutionStateQueued, athena.QueryExecutionStateRunning:
+			time.Sleep(queryPollInterval)
+		case athena.QueryExecutionStateSucceeded:
+			return nil
+		default:
+			if reason := aws.StringValue(status.StateChangeReason); reason != "" {
+				return fmt.Errorf("query %s is %s: %s", queryID, strings.ToLower(state), reason)
+			}
+			return fmt.Errorf("query %s is %s", queryID, strings.ToLower(state))
+		}
+	}
+}
+
+func (a *Athena) queryResults(queryID string) (*QueryResults, error) {
+	out := &QueryResults{}
+	var nextToken *string
+	for {
+		resp, err := a.client.GetQueryResults(&athena.GetQueryResultsInput{
+			QueryExecutionId: aws.String(queryID),
+			NextToken:        nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("get results of query %s: %w", queryID, err)
+		}
+		rows := resp.ResultSet.Rows
+		if nextToken == nil {
+			for _, column := range resp.ResultSet.ResultSetMetadata.ColumnInfo {
+				out.Fields = append(out.Fields, aws.StringValue(column.Name))
+			}
+			// The first row of the results of a SELECT query holds the names of the columns.
+			if len(rows) > 0 && isHeader(rows[0], out.Fields) {
+				rows = rows[1:]
+			}
+		}
+		for _, row := range rows {
+			out.Rows = append(out.Rows, newRow(row, out.Fields))
+		}
+		if resp.NextToken == nil {
+			return out, nil
+		}
+		nextToken = resp.NextToken
+	}
+}
+
+func isHeader(row *athena.Row, fields []string) bool {
+	if len(row.Data) != len(fields) {
+		return false
+	}
+	for i, datum := range row.Data {
+		if aws.StringValue(datum.VarCharValue) != fields[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func newRow(row *athena.Row, fields []string) map[string]string {
+	values := make(map[string]string)
+	for i, datum := range row.Data {
+		if i >= len(fields) {
+			break
+		}
+		values[fields[i]] = aws.StringValue(datum.VarCharValue)
+	}
+	return values
+}
@@ -0,0 +1,181 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package athena
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/athena"
+	"github.com/aws/copilot-cli/internal/pkg/aws/athena/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAthena_Query(t *testing.T) {
+	const (
+		mockWorkGroup = "phonetool-test-access-logs"
+		mockDatabase  = "copilot_phonetool_test"
+		mockQuery     = "SELECT elb_status_code, count(*) AS requests FROM alb_access_logs GROUP BY elb_status_code"
+		mockQueryID   = "abc123"
+	)
+	mockError := errors.New("some error")
+	wantedStartInput := &athena.StartQueryExecutionInput{
+		QueryString: aws.String(mockQuery),
+		QueryExecutionContext: &athena.QueryExecutionContext{
+			Database: aws.String(mockDatabase),
+		},
+		WorkGroup: aws.String(mockWorkGroup),
+	}
+	wantedGetExecutionInput := &athena.GetQueryExecutionInput{
+		QueryExecutionId: aws.String(mockQueryID),
+	}
+	executionInState := func(state, reason string) *athena.GetQueryExecutionOutput {
+		out := &athena.GetQueryExecutionOutput{
+			QueryExecution: &athena.QueryExecution{
+				Status: &athena.QueryExecutionStatus{
+					State: aws.String(state),
+				},
+			},
+		}
+		if reason != "" {
+			out.QueryExecution.Status.StateChangeReason = aws.String(reason)
+		}
+		return out
+	}
+	row := func(values ...string) *athena.Row {
+		r := &athena.Row{}
+		for _, value := range values {
+			r.Data = append(r.Data, &athena.Datum{VarCharValue: aws.String(value)})
+		}
+		return r
+	}
+	metadata := &athena.ResultSetMetadata{
+		ColumnInfo: []*athena.ColumnInfo{
+			{Name: aws.String("elb_status_code")},
+			{Name: aws.String("requests")},
+		},
+	}
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockapi)
+
+		wantResults *QueryResults
+		wantErr     error
+	}{
+		"should return error if fail to start the query": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().StartQueryExecution(wantedStartInput).Return(nil, mockError)
+			},
+
+			wantErr: fmt.Errorf("start query on database %s: %w", mockDatabase, mockError),
+		},
+		"should return error if fail to get the state of the query": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().StartQueryExecution(wantedStartInput).Return(&athena.StartQueryExecutionOutput{
+					QueryExecutionId: aws.String(mockQueryID),
+				}, nil)
+				m.EXPECT().GetQueryExecution(wantedGetExecutionInput).Return(nil, mockError)
+			},
+
+			wantErr: fmt.Errorf("get execution of query %s: %w", mockQueryID, mockError),
+		},
+		"should return error with the reason if the query fails": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().StartQueryExecution(wantedStartInput).Return(&athena.StartQueryExecutionOutput{
+					QueryExecutionId: aws.String(mockQueryID),
+				}, nil)
+				m.EXPECT().GetQueryExecution(wantedGetExecutionInput).Return(
+					executionInState(athena.QueryExecutionStateFailed, "SYNTAX_ERROR: line 1:8"), nil)
+			},
+
+			wantErr: fmt.Errorf("query %s is failed: SYNTAX_ERROR: line 1:8", mockQueryID),
+		},
+		"should return error if fail to get the results": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().StartQueryExecution(wantedStartInput).Return(&athena.StartQueryExecutionOutput{
+					QueryExecutionId: aws.String(mockQueryID),
+				}, nil)
+				m.EXPECT().GetQueryExecution(wantedGetExecutionInput).Return(
+					executionInState(athena.QueryExecutionStateSucceeded, ""), nil)
+				m.EXPECT().GetQueryResults(gomock.Any()).Return(nil, mockError)
+			},
+
+			wantErr: fmt.Errorf("get results of query %s: %w", mockQueryID, mockError),
+		},
+		"should wait for the query to succeed and return all pages of results without the header": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().StartQueryExecution(wantedStartInput).Return(&athena.StartQueryExecutionOutput{
+					QueryExecutionId: aws.String(mockQueryID),
+				}, nil)
+				gomock.InOrder(
+					m.EXPECT().GetQueryExecution(wantedGetExecutionInput).Return(
+						executionInState(athena.QueryExecutionStateQueued, ""), nil),
+					m.EXPECT().GetQueryExecution(wantedGetExecutionInput).Return(
+						executionInState(athena.QueryExecutionStateRunning, ""), nil),
+					m.EXPECT().GetQueryExecution(wantedGetExecutionInput).Return(
+						executionInState(athena.QueryExecutionStateSucceeded, ""), nil),
+					m.EXPECT().GetQueryResults(&athena.GetQueryResultsInput{
+						QueryExecutionId: aws.String(mockQueryID),
+					}).Return(&athena.GetQueryResultsOutput{
+						ResultSet: &athena.ResultSet{
+							ResultSetMetadata: metadata,
+							Rows:              []*athena.Row{row("elb_status_code", "requests"), row("200", "1042")},
+						},
+						NextToken: aws.String("next"),
+					}, nil),
+					m.EXPECT().GetQueryResults(&athena.GetQueryResultsInput{
+						QueryExecutionId: aws.String(mockQueryID),
+						NextToken:        aws.String("next"),
+					}).Return(&athena.GetQueryResultsOutput{
+						ResultSet: &athena.ResultSet{
+							ResultSetMetadata: metadata,
+							Rows:              []*athena.Row{row("502", "3")},
+						},
+					}, nil),
+				)
+			},
+
+			wantResults: &QueryResults{
+				Fields: []string{"elb_status_code", "requests"},
+				Rows: []map[string]string{
+					{"elb_status_code": "200", "requests": "1042"},
+					{"elb_status_code": "502", "requests": "3"},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mocks.NewMockapi(ctrl)
+			tc.setupMocks(mockClient)
+
+			service := Athena{
+				client: mockClient,
+			}
+			queryPollInterval = 0
+
+			// WHEN
+			results, err := service.Query(mockWorkGroup, mockDatabase, mockQuery)
+
+			// THEN
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantResults, results)
+			require.Equal(t, `elb_status_code  requests
+200              1042
+502              3
+`, results.HumanString())
+		})
+	}
+}
@@ -0,0 +1,79 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/aws/athena/athena.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	athena "github.com/aws/aws-sdk-go/service/athena"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// Mockapi is a mock of api interface
+type Mockapi struct {
+	ctrl     *gomock.Controller
+	recorder *MockapiMockRecorder
+}
+
+// MockapiMockRecorder is the mock recorder for Mockapi
+type MockapiMockRecorder struct {
+	mock *Mockapi
+}
+
+// NewMockapi creates a new mock instance
+func NewMockapi(ctrl *gomock.Controller) *Mockapi {
+	mock := &Mockapi{ctrl: ctrl}
+	mock.recorder = &MockapiMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *Mockapi) EXPECT() *MockapiMockRecorder {
+	return m.recorder
+}
+
+// StartQueryExecution mocks base method
+func (m *Mockapi) StartQueryExecution(input *athena.StartQueryExecutionInput) (*athena.StartQueryExecutionOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartQueryExecution", input)
+	ret0, _ := ret[0].(*athena.StartQueryExecutionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartQueryExecution indicates an expected call of StartQueryExecution
+func (mr *MockapiMockRecorder) StartQueryExecution(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartQueryExecution", reflect.TypeOf((*Mockapi)(nil).StartQueryExecution), input)
+}
+
+// GetQueryExecution mocks base method
+func (m *Mockapi) GetQueryExecution(input *athena.GetQueryExecutionInput) (*athena.GetQueryExecutionOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQueryExecution", input)
+	ret0, _ := ret[0].(*athena.GetQueryExecutionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQueryExecution indicates an expected call of GetQueryExecution
+func (mr *MockapiMockRecorder) GetQueryExecution(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQueryExecution", reflect.TypeOf((*Mockapi)(nil).GetQueryExecution), input)
+}
+
+// GetQueryResults mocks base method
+func (m *Mockapi) GetQueryResults(input *athena.GetQueryResultsInput) (*athena.GetQueryResultsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQueryResults", input)
+	ret0, _ := ret[0].(*athena.GetQueryResultsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQueryResults indicates an expected call of GetQueryResults
+func (mr *MockapiMockRecorder) GetQueryResults(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQueryResults", reflect.TypeOf((*Mockapi)(nil).GetQueryResults), input)
+}
@@ -0,0 +1,58 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package athena
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+)
+
+const (
+	queryResultsMinCellWidth = 10
+	queryResultsTabWidth     = 4
+	queryResultsCellPadding  = 2
+	queryResultsPaddingChar  = ' '
+)
+
+// QueryResults represents the results of an Athena query.
+type QueryResults struct {
+	// Fields are the names of the columns in the order that they are selected.
+	Fields []string
+	// Rows are the values of the fields for every result, a null value is empty.
+	Rows []map[string]string
+}
+
+// JSONString returns the stringified query results with json format.
+func (r *QueryResults) JSONString() (string, error) {
+	rows := r.Rows
+	if rows == nil {
+		rows = []map[string]string{}
+	}
+	b, err := json.Marshal(rows)
+	if err != nil {
+		return "", fmt.Errorf("marshal query results: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the stringified query results as a table.
+func (r *QueryResults) HumanString() string {
+	if len(r.Rows) == 0 {
+		return "No results found.\n"
+	}
+	var b strings.Builder
+	writer := tabwriter.NewWriter(&b, queryResultsMinCellWidth, queryResultsTabWidth, queryResultsCellPadding, queryResultsPaddingChar, 0)
+	fmt.Fprintf(writer, "%s\n", strings.Join(r.Fields, "\t"))
+	for _, row := range r.Rows {
+		values := make([]string, len(r.Fields))
+		for i, field := range r.Fields {
+			values[i] = row[field]
+		}
+		fmt.Fprintf(writer, "%s\n", strings.Join(values, "\t"))
+	}
+	writer.Flush()
+	return b.String()
+}
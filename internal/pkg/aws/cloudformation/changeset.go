@@ -9,6 +9,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/trace"
 	"github.com/google/uuid"
 )
 
@@ -87,7 +88,7 @@ func (cs *changeSet) String() string {
 
 // create creates a Change Set and waits until it's created.
 func (cs *changeSet) create(conf *stackConfig) error {
-	_, err := cs.client.CreateChangeSet(&cloudformation.CreateChangeSetInput{
+	in := &cloudformation.CreateChangeSetInput{
 		ChangeSetName: aws.String(cs.name),
 		StackName:     aws.String(cs.stackName),
 		ChangeSetType: aws.String(cs.csType.String()),
@@ -100,14 +101,21 @@ func (cs *changeSet) create(conf *stackConfig) error {
 			cloudformation.CapabilityCapabilityNamedIam,
 			cloudformation.CapabilityCapabilityAutoExpand,
 		}),
-	})
+	}
+	if conf.TemplateURL != "" {
+		in.TemplateBody = nil
+		in.TemplateURL = aws.String(conf.TemplateURL)
+	}
+	_, err := cs.client.CreateChangeSet(in)
 	if err != nil {
 		return fmt.Errorf("create %s: %w", cs, err)
 	}
+	span := trace.Start("wait for change set create", trace.Attr("stack", cs.stackName))
 	err = cs.client.WaitUntilChangeSetCreateCompleteWithContext(context.Background(), &cloudformation.DescribeChangeSetInput{
 		ChangeSetName: aws.String(cs.name),
 		StackName:     aws.String(cs.stackName),
 	}, waiters...)
+	span.End(err)
 	if err != nil {
 		return fmt.Errorf("wait for creation of %s: %w", cs, err)
 	}
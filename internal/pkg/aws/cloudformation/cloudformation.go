@@ -14,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/trace"
 )
 
 var waiters = []request.WaiterOption{
@@ -21,6 +22,12 @@ var waiters = []request.WaiterOption{
 	request.WithWaiterMaxAttempts(1800),                                   // Wait for at most 90 mins for any cfn action.
 }
 
+// The execution of a change set is polled as often and for as long as the waiters.
+const (
+	changeSetExecutionPollDelay   = 3 * time.Second
+	changeSetExecutionMaxAttempts = 1800
+)
+
 // CloudFormation represents a client to make requests to AWS CloudFormation.
 type CloudFormation struct {
 	client api
@@ -74,9 +81,11 @@ func (c *CloudFormation) CreateAndWait(stack *Stack) error {
 
 // WaitForCreate blocks until the stack is created or until the max attempt window expires.
 func (c *CloudFormation) WaitForCreate(stackName string) error {
+	span := trace.Start("wait for stack create", trace.Attr("stack", stackName))
 	err := c.client.WaitUntilStackCreateCompleteWithContext(context.Background(), &cloudformation.DescribeStacksInput{
 		StackName: aws.String(stackName),
 	}, waiters...)
+	span.End(err)
 	if err != nil {
 		return fmt.Errorf("wait until stack %s create is complete: %w", stackName, err)
 	}
@@ -105,15 +114,138 @@ func (c *CloudFormation) UpdateAndWait(stack *Stack) error {
 		return err
 	}
 
+	span := trace.Start("wait for stack update", trace.Attr("stack", stack.Name))
 	err := c.client.WaitUntilStackUpdateCompleteWithContext(context.Background(), &cloudformation.DescribeStacksInput{
 		StackName: aws.String(stack.Name),
 	}, waiters...)
+	span.End(err)
 	if err != nil {
 		return fmt.Errorf("wait until stack %s update is complete: %w", stack.Name, err)
 	}
 	return nil
 }
 
+// WaitForChangeSetExecution blocks until the execution of the change set with the ID completes or until the max attempt window expires.
+// The ID is the ARN of the change set, so the stack of the change set doesn't need to be known.
+func (c *CloudFormation) WaitForChangeSetExecution(changeSetID string) error {
+	span := trace.Start("wait for change set execution", trace.Attr("changeSet", changeSetID))
+	err := c.waitForChangeSetExecution(changeSetID)
+	span.End(err)
+	return err
+}
+
+func (c *CloudFormation) waitForChangeSetExecution(changeSetID string) error {
+	for attempt := 0; attempt < changeSetExecutionMaxAttempts; attempt++ {
+		out, err := c.client.DescribeChangeSet(&cloudformation.DescribeChangeSetInput{
+			ChangeSetName: aws.String(changeSetID),
+		})
+		if err != nil {
+			return fmt.Errorf("describe change set %s: %w", changeSetID, err)
+		}
+		switch status := aws.StringValue(out.ExecutionStatus); status {
+		case cloudformation.ExecutionStatusExecuteComplete:
+			return nil
+		case cloudformation.ExecutionStatusExecuteInProgress:
+			time.Sleep(changeSetExecutionPollDelay)
+		default:
+			return &ErrChangeSetNotExecuted{
+				ID:        changeSetID,
+				StackName: aws.StringValue(out.StackName),
+				Status:    status,
+			}
+		}
+	}
+	return fmt.Errorf("wait until change set %s execution is complete: exceeded %d attempts", changeSetID, changeSetExecutionMaxAttempts)
+}
+
+// CancelUpdate cancels the update of the stack that's in progress, so that CloudFormation rolls the stack back.
+func (c *CloudFormation) CancelUpdate(stackName string) error {
+	_, err := c.client.CancelUpdateStack(&cloudformation.CancelUpdateStackInput{
+		StackName: aws.String(stackName),
+	})
+	if err != nil {
+		return fmt.Errorf("cancel update of stack %s: %w", stackName, err)
+	}
+	return nil
+}
+
+// FailedResources returns the logical IDs of the resources of the stack whose last update failed,
+// such as the resources that couldn't be rolled back when the stack is in UPDATE_ROLLBACK_FAILED.
+func (c *CloudFormation) FailedResources(stackName string) ([]string, error) {
+	out, err := c.client.DescribeStackResources(&cloudformation.DescribeStackResourcesInput{
+		StackName: aws.String(stackName),
+	})
+	if err != nil {
+		if stackDoesNotExist(err) {
+			return nil, &ErrStackNotFound{name: stackName}
+		}
+		return nil, fmt.Errorf("describe resources of stack %s: %w", stackName, err)
+	}
+	var failed []string
+	for _, resource := range out.StackResources {
+		if aws.StringValue(resource.ResourceStatus) == cloudformation.ResourceStatusUpdateFailed {
+			failed = append(failed, aws.StringValue(resource.LogicalResourceId))
+		}
+	}
+	return failed, nil
+}
+
+// PhysicalResourceIDs returns the physical IDs of the resources of the stack, keyed by logical ID.
+// The physical ID of a nested stack is the ID of the stack.
+func (c *CloudFormation) PhysicalResourceIDs(stackName string) (map[string]string, error) {
+	out, err := c.client.DescribeStackResources(&cloudformation.DescribeStackResourcesInput{
+		StackName: aws.String(stackName),
+	})
+	if err != nil {
+		if stackDoesNotExist(err) {
+			return nil, &ErrStackNotFound{name: stackName}
+		}
+		return nil, fmt.Errorf("describe resources of stack %s: %w", stackName, err)
+	}
+	ids := make(map[string]string, len(out.StackResources))
+	for _, resource := range out.StackResources {
+		ids[aws.StringValue(resource.LogicalResourceId)] = aws.StringValue(resource.PhysicalResourceId)
+	}
+	return ids, nil
+}
+
+// ContinueRollbackAndWait continues rolling back a stack in UPDATE_ROLLBACK_FAILED, without rolling back the resources to skip,
+// and blocks until the stack is rolled back or until the max attempt window expires.
+func (c *CloudFormation) ContinueRollbackAndWait(stackName string, resourcesToSkip []string) error {
+	_, err := c.client.ContinueUpdateRollback(&cloudformation.ContinueUpdateRollbackInput{
+		StackName:       aws.String(stackName),
+		ResourcesToSkip: aws.StringSlice(resourcesToSkip),
+	})
+	if err != nil {
+		return fmt.Errorf("continue rollback of stack %s: %w", stackName, err)
+	}
+
+	span := trace.Start("wait for stack rollback", trace.Attr("stack", stackName))
+	err = c.client.WaitUntilStackRollbackCompleteWithContext(context.Background(), &cloudformation.DescribeStacksInput{
+		StackName: aws.String(stackName),
+	}, waiters...)
+	span.End(err)
+	if err != nil {
+		return fmt.Errorf("wait until stack %s rollback is complete: %w", stackName, err)
+	}
+	return nil
+}
+
+// TemplateBody returns the template that the stack was last deployed with.
+func (c *CloudFormation) TemplateBody(stackName string) (string, error) {
+	out, err := c.client.GetTemplate(&cloudformation.GetTemplateInput{
+		StackName:     aws.String(stackName),
+		TemplateStage: aws.String(cloudformation.TemplateStageOriginal),
+	})
+	if err != nil {
+		if stackDoesNotExist(err) {
+			return "", &ErrStackNotFound{name: stackName}
+		}
+		return "", fmt.Errorf("get template of stack %s: %w", stackName, err)
+	}
+	return aws.StringValue(out.TemplateBody), nil
+}
+
 // Delete removes an existing CloudFormation stack.
 // If the stack doesn't exist then do nothing.
 func (c *CloudFormation) Delete(stackName string) error {
@@ -141,9 +273,11 @@ func (c *CloudFormation) DeleteAndWait(stackName string) error {
 		return nil // If the stack is already deleted, don't wait for it.
 	}
 
+	span := trace.Start("wait for stack delete", trace.Attr("stack", stackName))
 	err = c.client.WaitUntilStackDeleteCompleteWithContext(context.Background(), &cloudformation.DescribeStacksInput{
 		StackName: aws.String(stackName),
 	}, waiters...)
+	span.End(err)
 	if err != nil {
 		return fmt.Errorf("wait until stack %s delete is complete: %w", stackName, err)
 	}
@@ -203,7 +337,10 @@ func (c *CloudFormation) create(stack *Stack) error {
 	if err != nil {
 		return err
 	}
-	return cs.createAndExecute(stack.stackConfig)
+	span := trace.Start("create stack", trace.Attr("stack", stack.Name))
+	err = cs.createAndExecute(stack.stackConfig)
+	span.End(err)
+	return err
 }
 
 func (c *CloudFormation) update(stack *Stack) error {
@@ -211,5 +348,8 @@ func (c *CloudFormation) update(stack *Stack) error {
 	if err != nil {
 		return err
 	}
-	return cs.createAndExecute(stack.stackConfig)
+	span := trace.Start("update stack", trace.Attr("stack", stack.Name))
+	err = cs.createAndExecute(stack.stackConfig)
+	span.End(err)
+	return err
 }
@@ -305,6 +305,362 @@ func TestCloudFormation_UpdateAndWait(t *testing.T) {
 	}
 }
 
+func TestCloudFormation_WaitForChangeSetExecution(t *testing.T) {
+	const mockChangeSetID = "arn:aws:cloudformation:us-west-2:123456789012:changeSet/ecscli-1234/5678"
+	testCases := map[string]struct {
+		createMock func(ctrl *gomock.Controller) api
+		wantedErr  error
+	}{
+		"wraps error from DescribeChangeSet": {
+			createMock: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().DescribeChangeSet(&cloudformation.DescribeChangeSetInput{
+					ChangeSetName: aws.String(mockChangeSetID),
+				}).Return(nil, errors.New("some error"))
+				return m
+			},
+			wantedErr: fmt.Errorf("describe change set %s: some error", mockChangeSetID),
+		},
+		"returns nil if the execution is complete": {
+			createMock: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().DescribeChangeSet(gomock.Any()).Return(&cloudformation.DescribeChangeSetOutput{
+					ExecutionStatus: aws.String(cloudformation.ExecutionStatusExecuteComplete),
+				}, nil)
+				return m
+			},
+		},
+		"returns ErrChangeSetNotExecuted if the execution failed": {
+			createMock: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().DescribeChangeSet(gomock.Any()).Return(&cloudformation.DescribeChangeSetOutput{
+					StackName:       aws.String(mockStack.Name),
+					ExecutionStatus: aws.String(cloudformation.ExecutionStatusExecuteFailed),
+				}, nil)
+				return m
+			},
+			wantedErr: fmt.Errorf("change set %s for stack %s failed to execute and the stack was rolled back", mockChangeSetID, mockStack.Name),
+		},
+		"returns ErrChangeSetNotExecuted if the change set is obsolete": {
+			createMock: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().DescribeChangeSet(gomock.Any()).Return(&cloudformation.DescribeChangeSetOutput{
+					StackName:       aws.String(mockStack.Name),
+					ExecutionStatus: aws.String(cloudformation.ExecutionStatusObsolete),
+				}, nil)
+				return m
+			},
+			wantedErr: fmt.Errorf("change set %s for stack %s was not executed, its status is OBSOLETE", mockChangeSetID, mockStack.Name),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := CloudFormation{
+				client: tc.createMock(ctrl),
+			}
+
+			// WHEN
+			err := c.WaitForChangeSetExecution(mockChangeSetID)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCloudFormation_CancelUpdate(t *testing.T) {
+	testCases := map[string]struct {
+		createMock func(ctrl *gomock.Controller) api
+		wantedErr  error
+	}{
+		"wraps error from CancelUpdateStack": {
+			createMock: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().CancelUpdateStack(&cloudformation.CancelUpdateStackInput{
+					StackName: aws.String(mockStack.Name),
+				}).Return(nil, errors.New("some error"))
+				return m
+			},
+			wantedErr: fmt.Errorf("cancel update of stack %s: some error", mockStack.Name),
+		},
+		"cancels the update": {
+			createMock: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().CancelUpdateStack(&cloudformation.CancelUpdateStackInput{
+					StackName: aws.String(mockStack.Name),
+				}).Return(&cloudformation.CancelUpdateStackOutput{}, nil)
+				return m
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := CloudFormation{
+				client: tc.createMock(ctrl),
+			}
+
+			// WHEN
+			err := c.CancelUpdate(mockStack.Name)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCloudFormation_FailedResources(t *testing.T) {
+	testCases := map[string]struct {
+		createMock func(ctrl *gomock.Controller) api
+
+		wantedResources []string
+		wantedErr       error
+	}{
+		"wraps error from DescribeStackResources": {
+			createMock: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().DescribeStackResources(gomock.Any()).Return(nil, errors.New("some error"))
+				return m
+			},
+			wantedErr: fmt.Errorf("describe resources of stack %s: some error", mockStack.Name),
+		},
+		"returns the resources whose update failed": {
+			createMock: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().DescribeStackResources(&cloudformation.DescribeStackResourcesInput{
+					StackName: aws.String(mockStack.Name),
+				}).Return(&cloudformation.DescribeStackResourcesOutput{
+					StackResources: []*cloudformation.StackResource{
+						{
+							LogicalResourceId: aws.String("Service"),
+							ResourceStatus:    aws.String(cloudformation.ResourceStatusUpdateFailed),
+						},
+						{
+							LogicalResourceId: aws.String("TaskDefinition"),
+							ResourceStatus:    aws.String(cloudformation.ResourceStatusUpdateComplete),
+						},
+					},
+				}, nil)
+				return m
+			},
+			wantedResources: []string{"Service"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := CloudFormation{
+				client: tc.createMock(ctrl),
+			}
+
+			// WHEN
+			resources, err := c.FailedResources(mockStack.Name)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedResources, resources)
+		})
+	}
+}
+
+func TestCloudFormation_PhysicalResourceIDs(t *testing.T) {
+	testCases := map[string]struct {
+		createMock func(ctrl *gomock.Controller) api
+
+		wantedIDs map[string]string
+		wantedErr error
+	}{
+		"wraps error from DescribeStackResources": {
+			createMock: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().DescribeStackResources(gomock.Any()).Return(nil, errors.New("some error"))
+				return m
+			},
+			wantedErr: fmt.Errorf("describe resources of stack %s: some error", mockStack.Name),
+		},
+		"returns the physical IDs keyed by logical ID": {
+			createMock: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().DescribeStackResources(&cloudformation.DescribeStackResourcesInput{
+					StackName: aws.String(mockStack.Name),
+				}).Return(&cloudformation.DescribeStackResourcesOutput{
+					StackResources: []*cloudformation.StackResource{
+						{
+							LogicalResourceId:  aws.String("AddonsStack"),
+							PhysicalResourceId: aws.String("arn:aws:cloudformation:us-west-2:1234:stack/addons/abc"),
+						},
+						{
+							LogicalResourceId:  aws.String("Service"),
+							PhysicalResourceId: aws.String("my-svc"),
+						},
+					},
+				}, nil)
+				return m
+			},
+			wantedIDs: map[string]string{
+				"AddonsStack": "arn:aws:cloudformation:us-west-2:1234:stack/addons/abc",
+				"Service":     "my-svc",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := CloudFormation{
+				client: tc.createMock(ctrl),
+			}
+
+			// WHEN
+			ids, err := c.PhysicalResourceIDs(mockStack.Name)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedIDs, ids)
+		})
+	}
+}
+
+func TestCloudFormation_ContinueRollbackAndWait(t *testing.T) {
+	testCases := map[string]struct {
+		createMock func(ctrl *gomock.Controller) api
+		wantedErr  error
+	}{
+		"wraps error from ContinueUpdateRollback": {
+			createMock: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().ContinueUpdateRollback(gomock.Any()).Return(nil, errors.New("some error"))
+				m.EXPECT().WaitUntilStackRollbackCompleteWithContext(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				return m
+			},
+			wantedErr: fmt.Errorf("continue rollback of stack %s: some error", mockStack.Name),
+		},
+		"wraps error from waiting for the rollback": {
+			createMock: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().ContinueUpdateRollback(gomock.Any()).Return(&cloudformation.ContinueUpdateRollbackOutput{}, nil)
+				m.EXPECT().WaitUntilStackRollbackCompleteWithContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("some error"))
+				return m
+			},
+			wantedErr: fmt.Errorf("wait until stack %s rollback is complete: some error", mockStack.Name),
+		},
+		"skips the resources and waits for the rollback": {
+			createMock: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().ContinueUpdateRollback(&cloudformation.ContinueUpdateRollbackInput{
+					StackName:       aws.String(mockStack.Name),
+					ResourcesToSkip: aws.StringSlice([]string{"Service"}),
+				}).Return(&cloudformation.ContinueUpdateRollbackOutput{}, nil)
+				m.EXPECT().WaitUntilStackRollbackCompleteWithContext(gomock.Any(), &cloudformation.DescribeStacksInput{
+					StackName: aws.String(mockStack.Name),
+				}, gomock.Any())
+				return m
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := CloudFormation{
+				client: tc.createMock(ctrl),
+			}
+
+			// WHEN
+			err := c.ContinueRollbackAndWait(mockStack.Name, []string{"Service"})
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCloudFormation_TemplateBody(t *testing.T) {
+	testCases := map[string]struct {
+		createMock func(ctrl *gomock.Controller) api
+		wantedBody string
+		wantedErr  error
+	}{
+		"wraps error from GetTemplate": {
+			createMock: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().GetTemplate(gomock.Any()).Return(nil, errors.New("some error"))
+				return m
+			},
+			wantedErr: fmt.Errorf("get template of stack %s: some error", mockStack.Name),
+		},
+		"returns the template of the deployed stack": {
+			createMock: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().GetTemplate(&cloudformation.GetTemplateInput{
+					StackName:     aws.String(mockStack.Name),
+					TemplateStage: aws.String(cloudformation.TemplateStageOriginal),
+				}).Return(&cloudformation.GetTemplateOutput{
+					TemplateBody: aws.String("Resources: {}"),
+				}, nil)
+				return m
+			},
+			wantedBody: "Resources: {}",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := CloudFormation{
+				client: tc.createMock(ctrl),
+			}
+
+			// WHEN
+			body, err := c.TemplateBody(mockStack.Name)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedBody, body)
+		})
+	}
+}
+
 func TestCloudFormation_Delete(t *testing.T) {
 	testCases := map[string]struct {
 		createMock func(ctrl *gomock.Controller) api
@@ -515,6 +871,39 @@ func TestCloudFormation_Events(t *testing.T) {
 	}
 }
 
+func TestChangeSet_CreateWithTemplateURL(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mocks.NewMockapi(ctrl)
+	m.EXPECT().CreateChangeSet(&cloudformation.CreateChangeSetInput{
+		ChangeSetName: aws.String(mockChangeSetName),
+		StackName:     aws.String(mockStack.Name),
+		ChangeSetType: aws.String(cloudformation.ChangeSetTypeCreate),
+		TemplateURL:   aws.String("https://bucket.s3.us-west-2.amazonaws.com/template.yml"),
+		Capabilities: aws.StringSlice([]string{
+			cloudformation.CapabilityCapabilityIam,
+			cloudformation.CapabilityCapabilityNamedIam,
+			cloudformation.CapabilityCapabilityAutoExpand,
+		}),
+	}).Return(nil, nil)
+	m.EXPECT().WaitUntilChangeSetCreateCompleteWithContext(gomock.Any(), gomock.Any(), gomock.Any())
+	cs := &changeSet{
+		name:      mockChangeSetName,
+		stackName: mockStack.Name,
+		csType:    createChangeSetType,
+		client:    m,
+	}
+	stack := NewStack(mockStack.Name, "template", WithTemplateURL("https://bucket.s3.us-west-2.amazonaws.com/template.yml"))
+
+	// WHEN
+	err := cs.create(stack.stackConfig)
+
+	// THEN
+	require.NoError(t, err)
+}
+
 func addCreateDeployCalls(m *mocks.Mockapi) {
 	addDeployCalls(m, cloudformation.ChangeSetTypeCreate)
 }
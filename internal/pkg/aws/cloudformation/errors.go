@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
 )
 
 // ErrChangeSetEmpty occurs when the change set does not contain any new or updated resources.
@@ -19,6 +20,20 @@ func (e *ErrChangeSetEmpty) Error() string {
 	return fmt.Sprintf("change set with name %s for stack %s has no changes", e.cs.name, e.cs.stackName)
 }
 
+// ErrChangeSetNotExecuted occurs when a change set failed to execute, or was never executed.
+type ErrChangeSetNotExecuted struct {
+	ID        string
+	StackName string
+	Status    string
+}
+
+func (e *ErrChangeSetNotExecuted) Error() string {
+	if e.Status == cloudformation.ExecutionStatusExecuteFailed {
+		return fmt.Sprintf("change set %s for stack %s failed to execute and the stack was rolled back", e.ID, e.StackName)
+	}
+	return fmt.Sprintf("change set %s for stack %s was not executed, its status is %s", e.ID, e.StackName, e.Status)
+}
+
 // ErrStackAlreadyExists occurs when a CloudFormation stack already exists with a given name.
 type ErrStackAlreadyExists struct {
 	Name  string
@@ -8,6 +8,10 @@ import (
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 )
 
+// MaxTemplateBodySize is the maximum size in bytes of a template passed in the body of a request.
+// Larger templates must be uploaded to S3 and deployed with WithTemplateURL.
+const MaxTemplateBodySize = 51200
+
 // Stack represents a AWS CloudFormation stack.
 type Stack struct {
 	Name string
@@ -15,10 +19,11 @@ type Stack struct {
 }
 
 type stackConfig struct {
-	Template   string
-	Parameters []*cloudformation.Parameter
-	Tags       []*cloudformation.Tag
-	RoleARN    *string
+	Template    string
+	TemplateURL string
+	Parameters  []*cloudformation.Parameter
+	Tags        []*cloudformation.Tag
+	RoleARN     *string
 }
 
 // StackOption allows you to initialize a Stack with additional properties.
@@ -73,6 +78,13 @@ func WithRoleARN(roleARN string) StackOption {
 	}
 }
 
+// WithTemplateURL deploys the stack from the template stored at the S3 object URL instead of the template body.
+func WithTemplateURL(url string) StackOption {
+	return func(s *Stack) {
+		s.TemplateURL = url
+	}
+}
+
 // StackEvent represents a stack event for a resource.
 type StackEvent cloudformation.StackEvent
 
@@ -20,7 +20,8 @@ func TestNewStack(t *testing.T) {
 		WithTags(map[string]string{
 			"copilot-application": "phonetool",
 		}),
-		WithRoleARN("arn"))
+		WithRoleARN("arn"),
+		WithTemplateURL("https://bucket.s3.us-west-2.amazonaws.com/hello.yml"))
 
 	// THEN
 	require.Equal(t, "hello", s.Name)
@@ -38,4 +39,5 @@ func TestNewStack(t *testing.T) {
 		},
 	}, s.Tags)
 	require.Equal(t, aws.String("arn"), s.RoleARN)
+	require.Equal(t, "https://bucket.s3.us-west-2.amazonaws.com/hello.yml", s.TemplateURL)
 }
@@ -5,6 +5,7 @@
 package cloudwatch
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -21,10 +22,21 @@ const (
 	cloudwatchResourceType = "cloudwatch:alarm"
 	compositeAlarmType     = "Composite"
 	metricAlarmType        = "Metric"
+
+	// The usage metrics of an account are published every minute, so the latest datapoint is within the last few minutes.
+	usageMetricLookback = 5 * time.Minute
+
+	// Maximum number of alarm names in a DescribeAlarms request, and of alarms in a page of its results.
+	describeAlarmsMaxNames   = 100
+	describeAlarmsMaxRecords = 100
+	// DescribeAlarms can't filter alarms by their dimensions, so at most this many pages of metric alarms are scanned.
+	maxDimensionScanPages = 10
 )
 
 type api interface {
 	DescribeAlarms(input *cloudwatch.DescribeAlarmsInput) (*cloudwatch.DescribeAlarmsOutput, error)
+	DescribeAlarmHistory(input *cloudwatch.DescribeAlarmHistoryInput) (*cloudwatch.DescribeAlarmHistoryOutput, error)
+	GetMetricData(input *cloudwatch.GetMetricDataInput) (*cloudwatch.GetMetricDataOutput, error)
 }
 
 type resourceGetter interface {
@@ -47,6 +59,26 @@ type AlarmStatus struct {
 	UpdatedTimes time.Time `json:"updatedTimes"`
 }
 
+// AlarmHistoryItem is a state transition of a CloudWatch alarm.
+type AlarmHistoryItem struct {
+	Name      string    `json:"name"`
+	OldState  string    `json:"oldState"`
+	NewState  string    `json:"newState"`
+	Reason    string    `json:"reason"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// alarmHistoryData is the subset of the HistoryData of a state update that the CLI uses.
+type alarmHistoryData struct {
+	OldState struct {
+		StateValue string `json:"stateValue"`
+	} `json:"oldState"`
+	NewState struct {
+		StateValue  string `json:"stateValue"`
+		StateReason string `json:"stateReason"`
+	} `json:"newState"`
+}
+
 // New returns a CloudWatch struct configured against the input session.
 func New(s *session.Session) *CloudWatch {
 	return &CloudWatch{
@@ -57,7 +89,7 @@ func New(s *session.Session) *CloudWatch {
 
 // GetAlarmsWithTags returns all the CloudWatch alarms that have the resource tags.
 func (cw *CloudWatch) GetAlarmsWithTags(tags map[string]string) ([]AlarmStatus, error) {
-	var alarmNames []*string
+	var alarmNames []string
 
 	resources, err := cw.rgClient.GetResourcesByTags(cloudwatchResourceType, tags)
 	if err != nil {
@@ -69,30 +101,169 @@ func (cw *CloudWatch) GetAlarmsWithTags(tags map[string]string) ([]AlarmStatus,
 		if err != nil {
 			return nil, err
 		}
-		alarmNames = append(alarmNames, name)
+		alarmNames = append(alarmNames, aws.StringValue(name))
 	}
+	return cw.GetAlarmsWithNames(alarmNames)
+}
 
+// GetAlarmsWithNames returns the CloudWatch alarms with the names.
+func (cw *CloudWatch) GetAlarmsWithNames(names []string) ([]AlarmStatus, error) {
 	// Return an empty array since DescribeAlarms will return all alarms if "AlarmNames" is an empty array.
-	if len(alarmNames) == 0 {
+	if len(names) == 0 {
 		return []AlarmStatus{}, nil
 	}
-	var alarmStatus []AlarmStatus
-	alarmResp := &cloudwatch.DescribeAlarmsOutput{}
-	for {
-		alarmResp, err = cw.cwClient.DescribeAlarms(&cloudwatch.DescribeAlarmsInput{
-			AlarmNames: alarmNames,
-			NextToken:  alarmResp.NextToken,
+	var composites []*cloudwatch.CompositeAlarm
+	var metrics []*cloudwatch.MetricAlarm
+	for start := 0; start < len(names); start += describeAlarmsMaxNames {
+		end := start + describeAlarmsMaxNames
+		if end > len(names) {
+			end = len(names)
+		}
+		batchComposites, batchMetrics, err := cw.describeAlarms(&cloudwatch.DescribeAlarmsInput{
+			AlarmNames: aws.StringSlice(names[start:end]),
 		})
+		if err != nil {
+			return nil, err
+		}
+		composites = append(composites, batchComposites...)
+		metrics = append(metrics, batchMetrics...)
+	}
+	return append(cw.compositeAlarmsStatus(composites), cw.metricAlarmsStatus(metrics)...), nil
+}
+
+// GetAlarmsWithNamePrefix returns the CloudWatch alarms whose name starts with the prefix.
+func (cw *CloudWatch) GetAlarmsWithNamePrefix(prefix string) ([]AlarmStatus, error) {
+	composites, metrics, err := cw.describeAlarms(&cloudwatch.DescribeAlarmsInput{
+		AlarmNamePrefix: aws.String(prefix),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return append(cw.compositeAlarmsStatus(composites), cw.metricAlarmsStatus(metrics)...), nil
+}
+
+// GetAlarmsWithDimensions returns the CloudWatch metric alarms on a metric that has all the dimensions.
+// For example, the dimensions ClusterName and ServiceName match the alarms on the metrics of an ECS service.
+// Only the first maxDimensionScanPages pages of metric alarms of the account are scanned.
+func (cw *CloudWatch) GetAlarmsWithDimensions(dimensions map[string]string) ([]AlarmStatus, error) {
+	in := &cloudwatch.DescribeAlarmsInput{
+		AlarmTypes: aws.StringSlice([]string{cloudwatch.AlarmTypeMetricAlarm}),
+		MaxRecords: aws.Int64(describeAlarmsMaxRecords),
+	}
+	var matched []*cloudwatch.MetricAlarm
+	for page := 0; page < maxDimensionScanPages; page++ {
+		alarmResp, err := cw.cwClient.DescribeAlarms(in)
 		if err != nil {
 			return nil, fmt.Errorf("describe CloudWatch alarms: %w", err)
 		}
-		alarmStatus = append(alarmStatus, cw.compositeAlarmsStatus(alarmResp.CompositeAlarms)...)
-		alarmStatus = append(alarmStatus, cw.metricAlarmsStatus(alarmResp.MetricAlarms)...)
+		for _, alarm := range alarmResp.MetricAlarms {
+			if hasDimensions(alarm, dimensions) {
+				matched = append(matched, alarm)
+			}
+		}
 		if alarmResp.NextToken == nil {
 			break
 		}
+		in.NextToken = alarmResp.NextToken
+	}
+	return cw.metricAlarmsStatus(matched), nil
+}
+
+func (cw *CloudWatch) describeAlarms(in *cloudwatch.DescribeAlarmsInput) ([]*cloudwatch.CompositeAlarm, []*cloudwatch.MetricAlarm, error) {
+	var composites []*cloudwatch.CompositeAlarm
+	var metrics []*cloudwatch.MetricAlarm
+	alarmResp := &cloudwatch.DescribeAlarmsOutput{}
+	for {
+		in.NextToken = alarmResp.NextToken
+		var err error
+		alarmResp, err = cw.cwClient.DescribeAlarms(in)
+		if err != nil {
+			return nil, nil, fmt.Errorf("describe CloudWatch alarms: %w", err)
+		}
+		composites = append(composites, alarmResp.CompositeAlarms...)
+		metrics = append(metrics, alarmResp.MetricAlarms...)
+		if alarmResp.NextToken == nil {
+			break
+		}
+	}
+	return composites, metrics, nil
+}
+
+func hasDimensions(alarm *cloudwatch.MetricAlarm, dimensions map[string]string) bool {
+	alarmDimensions := make(map[string]string)
+	for _, d := range alarm.Dimensions {
+		alarmDimensions[aws.StringValue(d.Name)] = aws.StringValue(d.Value)
+	}
+	for name, value := range dimensions {
+		if v, ok := alarmDimensions[name]; !ok || v != value {
+			return false
+		}
+	}
+	return true
+}
+
+// AlarmHistory returns the state transitions of the alarm since startDate, from the most recent one.
+func (cw *CloudWatch) AlarmHistory(alarmName string, startDate time.Time) ([]AlarmHistoryItem, error) {
+	var items []AlarmHistoryItem
+	historyResp := &cloudwatch.DescribeAlarmHistoryOutput{}
+	for {
+		var err error
+		historyResp, err = cw.cwClient.DescribeAlarmHistory(&cloudwatch.DescribeAlarmHistoryInput{
+			AlarmName:       aws.String(alarmName),
+			HistoryItemType: aws.String(cloudwatch.HistoryItemTypeStateUpdate),
+			ScanBy:          aws.String(cloudwatch.ScanByTimestampDescending),
+			StartDate:       aws.Time(startDate),
+			NextToken:       historyResp.NextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("describe history of CloudWatch alarm %s: %w", alarmName, err)
+		}
+		for _, item := range historyResp.AlarmHistoryItems {
+			var data alarmHistoryData
+			if err := json.Unmarshal([]byte(aws.StringValue(item.HistoryData)), &data); err != nil {
+				return nil, fmt.Errorf("unmarshal history data of CloudWatch alarm %s: %w", alarmName, err)
+			}
+			items = append(items, AlarmHistoryItem{
+				Name:      aws.StringValue(item.AlarmName),
+				OldState:  data.OldState.StateValue,
+				NewState:  data.NewState.StateValue,
+				Reason:    data.NewState.StateReason,
+				Timestamp: aws.TimeValue(item.Timestamp),
+			})
+		}
+		if historyResp.NextToken == nil {
+			break
+		}
+	}
+	return items, nil
+}
+
+// FargateVCPUUsage returns the number of vCPUs used by the Fargate On-Demand tasks of the account that are running,
+// according to the latest datapoint of the AWS/Usage metric. It returns 0 if no task ran in the last few minutes.
+func (cw *CloudWatch) FargateVCPUUsage() (float64, error) {
+	now := time.Now()
+	series, err := cw.MetricData([]MetricQuery{
+		{
+			ID:         "vcpu",
+			Namespace:  "AWS/Usage",
+			MetricName: "ResourceCount",
+			Dimensions: map[string]string{
+				"Service":  "Fargate",
+				"Type":     "Resource",
+				"Resource": "vCPU",
+				"Class":    "Standard/OnDemand",
+			},
+			Stat: cloudwatch.StatisticMaximum,
+		},
+	}, now.Add(-usageMetricLookback), now)
+	if err != nil {
+		return 0, fmt.Errorf("get Fargate vCPU usage: %w", err)
+	}
+	values := series[0].Values
+	if len(values) == 0 {
+		return 0, nil
 	}
-	return alarmStatus, nil
+	return values[len(values)-1], nil
 }
 
 // getAlarmName gets the alarm name given a specific alarm ARN.
@@ -206,3 +206,430 @@ func TestCloudWatch_GetAlarmsWithTags(t *testing.T) {
 
 	}
 }
+
+func TestCloudWatch_AlarmHistory(t *testing.T) {
+	mockTime, _ := time.Parse(time.RFC3339, "2006-01-02T15:04:05+00:00")
+	mockStartDate := mockTime.Add(-24 * time.Hour)
+	mockHistoryData := `{"version":"1.0","oldState":{"stateValue":"OK","stateReason":"Threshold Crossed: no datapoints were received"},"newState":{"stateValue":"ALARM","stateReason":"Threshold Crossed: 1 datapoint [90.0] was greater than the threshold (70.0)."}}`
+
+	testCases := map[string]struct {
+		setupMocks func(m cloudWatchMocks)
+
+		wantErr     error
+		wantHistory []AlarmHistoryItem
+	}{
+		"errors if failed to describe alarm history": {
+			setupMocks: func(m cloudWatchMocks) {
+				m.cw.EXPECT().DescribeAlarmHistory(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+
+			wantErr: errors.New("describe history of CloudWatch alarm mockAlarmName: some error"),
+		},
+		"errors if failed to unmarshal history data": {
+			setupMocks: func(m cloudWatchMocks) {
+				m.cw.EXPECT().DescribeAlarmHistory(gomock.Any()).Return(&cloudwatch.DescribeAlarmHistoryOutput{
+					AlarmHistoryItems: []*cloudwatch.AlarmHistoryItem{
+						{
+							AlarmName:   aws.String("mockAlarmName"),
+							HistoryData: aws.String("badData"),
+						},
+					},
+				}, nil)
+			},
+
+			wantErr: errors.New("unmarshal history data of CloudWatch alarm mockAlarmName: invalid character 'b' looking for beginning of value"),
+		},
+		"returns the state transitions across pages": {
+			setupMocks: func(m cloudWatchMocks) {
+				gomock.InOrder(
+					m.cw.EXPECT().DescribeAlarmHistory(&cloudwatch.DescribeAlarmHistoryInput{
+						AlarmName:       aws.String("mockAlarmName"),
+						HistoryItemType: aws.String(cloudwatch.HistoryItemTypeStateUpdate),
+						ScanBy:          aws.String(cloudwatch.ScanByTimestampDescending),
+						StartDate:       aws.Time(mockStartDate),
+					}).Return(&cloudwatch.DescribeAlarmHistoryOutput{
+						AlarmHistoryItems: []*cloudwatch.AlarmHistoryItem{
+							{
+								AlarmName:   aws.String("mockAlarmName"),
+								HistoryData: aws.String(mockHistoryData),
+								Timestamp:   aws.Time(mockTime),
+							},
+						},
+						NextToken: aws.String("mockNextToken"),
+					}, nil),
+					m.cw.EXPECT().DescribeAlarmHistory(&cloudwatch.DescribeAlarmHistoryInput{
+						AlarmName:       aws.String("mockAlarmName"),
+						HistoryItemType: aws.String(cloudwatch.HistoryItemTypeStateUpdate),
+						ScanBy:          aws.String(cloudwatch.ScanByTimestampDescending),
+						StartDate:       aws.Time(mockStartDate),
+						NextToken:       aws.String("mockNextToken"),
+					}).Return(&cloudwatch.DescribeAlarmHistoryOutput{
+						AlarmHistoryItems: []*cloudwatch.AlarmHistoryItem{
+							{
+								AlarmName:   aws.String("mockAlarmName"),
+								HistoryData: aws.String(`{"oldState":{"stateValue":"INSUFFICIENT_DATA"},"newState":{"stateValue":"OK","stateReason":"Threshold Crossed: no datapoints were received"}}`),
+								Timestamp:   aws.Time(mockStartDate),
+							},
+						},
+					}, nil),
+				)
+			},
+
+			wantHistory: []AlarmHistoryItem{
+				{
+					Name:      "mockAlarmName",
+					OldState:  "OK",
+					NewState:  "ALARM",
+					Reason:    "Threshold Crossed: 1 datapoint [90.0] was greater than the threshold (70.0).",
+					Timestamp: mockTime,
+				},
+				{
+					Name:      "mockAlarmName",
+					OldState:  "INSUFFICIENT_DATA",
+					NewState:  "OK",
+					Reason:    "Threshold Crossed: no datapoints were received",
+					Timestamp: mockStartDate,
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockcwClient := mocks.NewMockapi(ctrl)
+			tc.setupMocks(cloudWatchMocks{
+				cw: mockcwClient,
+			})
+
+			cwSvc := CloudWatch{
+				cwClient: mockcwClient,
+			}
+
+			// WHEN
+			gotHistory, gotErr := cwSvc.AlarmHistory("mockAlarmName", mockStartDate)
+
+			// THEN
+			if tc.wantErr != nil {
+				require.EqualError(t, gotErr, tc.wantErr.Error())
+				return
+			}
+			require.NoError(t, gotErr)
+			require.Equal(t, tc.wantHistory, gotHistory)
+		})
+	}
+}
+
+func TestCloudWatch_GetAlarmsWithNames(t *testing.T) {
+	mockTime, _ := time.Parse(time.RFC3339, "2006-01-02T15:04:05+00:00")
+	var manyNames []string
+	for i := 0; i <= 100; i++ {
+		manyNames = append(manyNames, fmt.Sprintf("mockAlarm%d", i))
+	}
+	testCases := map[string]struct {
+		inNames    []string
+		setupMocks func(m *mocks.Mockapi)
+
+		wantErr         error
+		wantAlarmStatus []AlarmStatus
+	}{
+		"returns no alarms without names": {
+			setupMocks: func(m *mocks.Mockapi) {},
+
+			wantAlarmStatus: []AlarmStatus{},
+		},
+		"errors if failed to describe alarms": {
+			inNames: []string{"mockAlarm"},
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeAlarms(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+
+			wantErr: errors.New("describe CloudWatch alarms: some error"),
+		},
+		"describes at most 100 alarms per request": {
+			inNames: manyNames,
+			setupMocks: func(m *mocks.Mockapi) {
+				gomock.InOrder(
+					m.EXPECT().DescribeAlarms(&cloudwatch.DescribeAlarmsInput{
+						AlarmNames: aws.StringSlice(manyNames[:100]),
+					}).Return(&cloudwatch.DescribeAlarmsOutput{}, nil),
+					m.EXPECT().DescribeAlarms(&cloudwatch.DescribeAlarmsInput{
+						AlarmNames: aws.StringSlice(manyNames[100:]),
+					}).Return(&cloudwatch.DescribeAlarmsOutput{
+						MetricAlarms: []*cloudwatch.MetricAlarm{
+							{
+								AlarmArn:              aws.String("mockArn"),
+								AlarmName:             aws.String("mockAlarm100"),
+								StateValue:            aws.String("OK"),
+								StateUpdatedTimestamp: &mockTime,
+							},
+						},
+					}, nil),
+				)
+			},
+
+			wantAlarmStatus: []AlarmStatus{
+				{
+					Arn:          "mockArn",
+					Name:         "mockAlarm100",
+					Status:       "OK",
+					Type:         "Metric",
+					UpdatedTimes: mockTime,
+				},
+			},
+		},
+		"returns the alarms with the names": {
+			inNames: []string{"mockAlarm"},
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeAlarms(&cloudwatch.DescribeAlarmsInput{
+					AlarmNames: aws.StringSlice([]string{"mockAlarm"}),
+				}).Return(&cloudwatch.DescribeAlarmsOutput{
+					MetricAlarms: []*cloudwatch.MetricAlarm{
+						{
+							AlarmArn:              aws.String("mockArn"),
+							AlarmName:             aws.String("mockAlarm"),
+							StateReason:           aws.String("mockReason"),
+							StateValue:            aws.String("OK"),
+							StateUpdatedTimestamp: &mockTime,
+						},
+					},
+				}, nil)
+			},
+
+			wantAlarmStatus: []AlarmStatus{
+				{
+					Arn:          "mockArn",
+					Name:         "mockAlarm",
+					Reason:       "mockReason",
+					Status:       "OK",
+					Type:         "Metric",
+					UpdatedTimes: mockTime,
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockcwClient := mocks.NewMockapi(ctrl)
+			tc.setupMocks(mockcwClient)
+
+			cwSvc := CloudWatch{
+				cwClient: mockcwClient,
+			}
+
+			// WHEN
+			gotAlarmStatus, gotErr := cwSvc.GetAlarmsWithNames(tc.inNames)
+
+			// THEN
+			if tc.wantErr != nil {
+				require.EqualError(t, gotErr, tc.wantErr.Error())
+				return
+			}
+			require.NoError(t, gotErr)
+			require.Equal(t, tc.wantAlarmStatus, gotAlarmStatus)
+		})
+	}
+}
+
+func TestCloudWatch_GetAlarmsWithNamePrefix(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTime, _ := time.Parse(time.RFC3339, "2006-01-02T15:04:05+00:00")
+	mockcwClient := mocks.NewMockapi(ctrl)
+	mockcwClient.EXPECT().DescribeAlarms(&cloudwatch.DescribeAlarmsInput{
+		AlarmNamePrefix: aws.String("mockApp-mockEnv-mockSvc-"),
+	}).Return(&cloudwatch.DescribeAlarmsOutput{
+		CompositeAlarms: []*cloudwatch.CompositeAlarm{
+			{
+				AlarmArn:              aws.String("mockArn"),
+				AlarmName:             aws.String("mockApp-mockEnv-mockSvc-AddonsStack-HighLatency"),
+				StateReason:           aws.String("mockReason"),
+				StateValue:            aws.String("ALARM"),
+				StateUpdatedTimestamp: &mockTime,
+			},
+		},
+	}, nil)
+	cwSvc := CloudWatch{
+		cwClient: mockcwClient,
+	}
+
+	// WHEN
+	gotAlarmStatus, err := cwSvc.GetAlarmsWithNamePrefix("mockApp-mockEnv-mockSvc-")
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, []AlarmStatus{
+		{
+			Arn:          "mockArn",
+			Name:         "mockApp-mockEnv-mockSvc-AddonsStack-HighLatency",
+			Reason:       "mockReason",
+			Status:       "ALARM",
+			Type:         "Composite",
+			UpdatedTimes: mockTime,
+		},
+	}, gotAlarmStatus)
+}
+
+func TestCloudWatch_GetAlarmsWithDimensions(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTime, _ := time.Parse(time.RFC3339, "2006-01-02T15:04:05+00:00")
+	mockcwClient := mocks.NewMockapi(ctrl)
+	mockcwClient.EXPECT().DescribeAlarms(&cloudwatch.DescribeAlarmsInput{
+		AlarmTypes: aws.StringSlice([]string{cloudwatch.AlarmTypeMetricAlarm}),
+		MaxRecords: aws.Int64(100),
+	}).Return(&cloudwatch.DescribeAlarmsOutput{
+		MetricAlarms: []*cloudwatch.MetricAlarm{
+			{
+				AlarmArn:  aws.String("mockArn1"),
+				AlarmName: aws.String("HighCPU"),
+				Dimensions: []*cloudwatch.Dimension{
+					{Name: aws.String("ClusterName"), Value: aws.String("mockCluster")},
+					{Name: aws.String("ServiceName"), Value: aws.String("mockService")},
+				},
+				StateReason:           aws.String("mockReason"),
+				StateValue:            aws.String("OK"),
+				StateUpdatedTimestamp: &mockTime,
+			},
+			{
+				AlarmArn:  aws.String("mockArn2"),
+				AlarmName: aws.String("OtherServiceHighCPU"),
+				Dimensions: []*cloudwatch.Dimension{
+					{Name: aws.String("ClusterName"), Value: aws.String("mockCluster")},
+					{Name: aws.String("ServiceName"), Value: aws.String("mockOtherService")},
+				},
+				StateUpdatedTimestamp: &mockTime,
+			},
+			{
+				AlarmArn:              aws.String("mockArn3"),
+				AlarmName:             aws.String("ClusterHighCPU"),
+				Dimensions:            []*cloudwatch.Dimension{{Name: aws.String("ClusterName"), Value: aws.String("mockCluster")}},
+				StateUpdatedTimestamp: &mockTime,
+			},
+		},
+	}, nil)
+	cwSvc := CloudWatch{
+		cwClient: mockcwClient,
+	}
+
+	// WHEN
+	gotAlarmStatus, err := cwSvc.GetAlarmsWithDimensions(map[string]string{
+		"ClusterName": "mockCluster",
+		"ServiceName": "mockService",
+	})
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, []AlarmStatus{
+		{
+			Arn:          "mockArn1",
+			Name:         "HighCPU",
+			Reason:       "mockReason",
+			Status:       "OK",
+			Type:         "Metric",
+			UpdatedTimes: mockTime,
+		},
+	}, gotAlarmStatus)
+}
+
+func TestCloudWatch_FargateVCPUUsage(t *testing.T) {
+	mockTime := time.Unix(1600000000, 0)
+	testCases := map[string]struct {
+		mockResult *cloudwatch.MetricDataResult
+		mockErr    error
+
+		wantedUsage float64
+		wantedErr   string
+	}{
+		"returns the latest datapoint": {
+			mockResult: &cloudwatch.MetricDataResult{
+				Id:         aws.String("vcpu"),
+				Timestamps: aws.TimeSlice([]time.Time{mockTime.Add(-time.Minute), mockTime, mockTime.Add(time.Minute)}),
+				Values:     aws.Float64Slice([]float64{10, 12, 14.5}),
+			},
+			wantedUsage: 14.5,
+		},
+		"returns 0 without any datapoint": {
+			wantedUsage: 0,
+		},
+		"wraps the error": {
+			mockErr:   errors.New("some error"),
+			wantedErr: "get Fargate vCPU usage: get metric data: some error",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockcwClient := mocks.NewMockapi(ctrl)
+			mockcwClient.EXPECT().GetMetricData(gomock.Any()).DoAndReturn(func(in *cloudwatch.GetMetricDataInput) (*cloudwatch.GetMetricDataOutput, error) {
+				stat := in.MetricDataQueries[0].MetricStat
+				require.Equal(t, "AWS/Usage", aws.StringValue(stat.Metric.Namespace))
+				require.Equal(t, "ResourceCount", aws.StringValue(stat.Metric.MetricName))
+				require.Contains(t, stat.Metric.Dimensions, &cloudwatch.Dimension{Name: aws.String("Resource"), Value: aws.String("vCPU")})
+				require.Equal(t, "Maximum", aws.StringValue(stat.Stat))
+				require.Equal(t, int64(60), aws.Int64Value(stat.Period))
+				if tc.mockErr != nil {
+					return nil, tc.mockErr
+				}
+				out := &cloudwatch.GetMetricDataOutput{}
+				if tc.mockResult != nil {
+					out.MetricDataResults = []*cloudwatch.MetricDataResult{tc.mockResult}
+				}
+				return out, nil
+			})
+			cwSvc := CloudWatch{
+				cwClient: mockcwClient,
+			}
+
+			// WHEN
+			usage, err := cwSvc.FargateVCPUUsage()
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedUsage, usage)
+		})
+	}
+}
+
+func TestCloudWatch_GetAlarmsWithDimensionsStopsScanning(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockcwClient := mocks.NewMockapi(ctrl)
+	mockcwClient.EXPECT().DescribeAlarms(gomock.Any()).Return(&cloudwatch.DescribeAlarmsOutput{
+		NextToken: aws.String("mockNextToken"),
+	}, nil).Times(10)
+	cwSvc := CloudWatch{
+		cwClient: mockcwClient,
+	}
+
+	// WHEN
+	gotAlarmStatus, err := cwSvc.GetAlarmsWithDimensions(map[string]string{
+		"ClusterName": "mockCluster",
+	})
+
+	// THEN
+	require.NoError(t, err)
+	require.Empty(t, gotAlarmStatus)
+}
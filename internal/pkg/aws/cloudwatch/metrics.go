@@ -0,0 +1,187 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudwatch
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+)
+
+const (
+	// maxMetricDataQueries is the maximum number of metrics that a GetMetricData request can retrieve.
+	maxMetricDataQueries = 500
+	// maxDatapointsPerMetric keeps the number of datapoints of each metric small enough to be displayed.
+	maxDatapointsPerMetric = 1440
+)
+
+// metricPeriods are the periods, from the finest, that the datapoints of a metric can be aggregated by.
+var metricPeriods = []time.Duration{time.Minute, 5 * time.Minute, 15 * time.Minute, time.Hour, 6 * time.Hour, 24 * time.Hour}
+
+// Retention of the datapoints of each period, CloudWatch keeps finer datapoints for less time.
+// See https://docs.aws.amazon.com/AmazonCloudWatch/latest/monitoring/cloudwatch_concepts.html#metrics-retention
+const (
+	oneMinuteRetention   = 15 * 24 * time.Hour
+	fiveMinutesRetention = 63 * 24 * time.Hour
+)
+
+type unitConversion struct {
+	base   string
+	factor float64
+}
+
+// baseUnits maps the units of a metric to the base unit its values are normalized to.
+var baseUnits = map[string]unitConversion{
+	cloudwatch.StandardUnitMicroseconds:    {cloudwatch.StandardUnitSeconds, 1e-6},
+	cloudwatch.StandardUnitMilliseconds:    {cloudwatch.StandardUnitSeconds, 1e-3},
+	cloudwatch.StandardUnitKilobytes:       {cloudwatch.StandardUnitBytes, 1 << 10},
+	cloudwatch.StandardUnitMegabytes:       {cloudwatch.StandardUnitBytes, 1 << 20},
+	cloudwatch.StandardUnitGigabytes:       {cloudwatch.StandardUnitBytes, 1 << 30},
+	cloudwatch.StandardUnitTerabytes:       {cloudwatch.StandardUnitBytes, 1 << 40},
+	cloudwatch.StandardUnitKilobits:        {cloudwatch.StandardUnitBits, 1e3},
+	cloudwatch.StandardUnitMegabits:        {cloudwatch.StandardUnitBits, 1e6},
+	cloudwatch.StandardUnitGigabits:        {cloudwatch.StandardUnitBits, 1e9},
+	cloudwatch.StandardUnitTerabits:        {cloudwatch.StandardUnitBits, 1e12},
+	cloudwatch.StandardUnitKilobytesSecond: {cloudwatch.StandardUnitBytesSecond, 1 << 10},
+	cloudwatch.StandardUnitMegabytesSecond: {cloudwatch.StandardUnitBytesSecond, 1 << 20},
+	cloudwatch.StandardUnitGigabytesSecond: {cloudwatch.StandardUnitBytesSecond, 1 << 30},
+	cloudwatch.StandardUnitTerabytesSecond: {cloudwatch.StandardUnitBytesSecond, 1 << 40},
+	cloudwatch.StandardUnitKilobitsSecond:  {cloudwatch.StandardUnitBitsSecond, 1e3},
+	cloudwatch.StandardUnitMegabitsSecond:  {cloudwatch.StandardUnitBitsSecond, 1e6},
+	cloudwatch.StandardUnitGigabitsSecond:  {cloudwatch.StandardUnitBitsSecond, 1e9},
+	cloudwatch.StandardUnitTerabitsSecond:  {cloudwatch.StandardUnitBitsSecond, 1e12},
+}
+
+// MetricQuery is a statistic of a metric to retrieve the datapoints of.
+type MetricQuery struct {
+	ID         string // Identifies the series of the metric, must start with a lowercase letter.
+	Namespace  string
+	MetricName string
+	Dimensions map[string]string
+	Stat       string // Statistic such as "Average", "Maximum" or "p99".
+	Unit       string // Optional. Unit that the metric is published with, the values are normalized to its base unit.
+}
+
+// MetricSeries is the datapoints of a metric query in chronological order.
+type MetricSeries struct {
+	ID         string
+	Unit       string // Base unit of the values, such as "Bytes" for a metric in "Megabytes".
+	Timestamps []time.Time
+	Values     []float64
+}
+
+// MetricData returns the datapoints of the metrics between start and end, in the order of the queries.
+// The period of the datapoints is the finest one that CloudWatch still keeps for the start time
+// and that doesn't return too many datapoints for the window.
+func (cw *CloudWatch) MetricData(queries []MetricQuery, start, end time.Time) ([]MetricSeries, error) {
+	period := metricPeriod(start, end, time.Now())
+	series := make(map[string]*MetricSeries, len(queries))
+	for _, q := range queries {
+		series[q.ID] = &MetricSeries{
+			ID:   q.ID,
+			Unit: q.Unit,
+		}
+		if conv, ok := baseUnits[q.Unit]; ok {
+			series[q.ID].Unit = conv.base
+		}
+	}
+	// Each request can only retrieve so many metrics, so the queries are sent in chunks.
+	for i := 0; i < len(queries); i += maxMetricDataQueries {
+		j := i + maxMetricDataQueries
+		if j > len(queries) {
+			j = len(queries)
+		}
+		in := &cloudwatch.GetMetricDataInput{
+			StartTime:         aws.Time(start),
+			EndTime:           aws.Time(end),
+			ScanBy:            aws.String(cloudwatch.ScanByTimestampAscending),
+			MetricDataQueries: metricDataQueries(queries[i:j], period),
+		}
+		for {
+			out, err := cw.cwClient.GetMetricData(in)
+			if err != nil {
+				return nil, fmt.Errorf("get metric data: %w", err)
+			}
+			for _, result := range out.MetricDataResults {
+				s, ok := series[aws.StringValue(result.Id)]
+				if !ok {
+					continue
+				}
+				s.Timestamps = append(s.Timestamps, aws.TimeValueSlice(result.Timestamps)...)
+				s.Values = append(s.Values, aws.Float64ValueSlice(result.Values)...)
+			}
+			if out.NextToken == nil {
+				break
+			}
+			in.NextToken = out.NextToken
+		}
+	}
+	all := make([]MetricSeries, len(queries))
+	for i, q := range queries {
+		s := series[q.ID]
+		if conv, ok := baseUnits[q.Unit]; ok {
+			for k := range s.Values {
+				s.Values[k] *= conv.factor
+			}
+		}
+		all[i] = *s
+	}
+	return all, nil
+}
+
+func metricDataQueries(queries []MetricQuery, period int64) []*cloudwatch.MetricDataQuery {
+	out := make([]*cloudwatch.MetricDataQuery, len(queries))
+	for i, q := range queries {
+		var names []string
+		for name := range q.Dimensions {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		var dimensions []*cloudwatch.Dimension
+		for _, name := range names {
+			dimensions = append(dimensions, &cloudwatch.Dimension{
+				Name:  aws.String(name),
+				Value: aws.String(q.Dimensions[name]),
+			})
+		}
+		stat := &cloudwatch.MetricStat{
+			Metric: &cloudwatch.Metric{
+				Namespace:  aws.String(q.Namespace),
+				MetricName: aws.String(q.MetricName),
+				Dimensions: dimensions,
+			},
+			Period: aws.Int64(period),
+			Stat:   aws.String(q.Stat),
+		}
+		if q.Unit != "" {
+			stat.Unit = aws.String(q.Unit)
+		}
+		out[i] = &cloudwatch.MetricDataQuery{
+			Id:         aws.String(q.ID),
+			MetricStat: stat,
+		}
+	}
+	return out
+}
+
+// metricPeriod returns the period in seconds to aggregate the datapoints between start and end by.
+func metricPeriod(start, end, now time.Time) int64 {
+	min := time.Minute
+	switch age := now.Sub(start); {
+	case age > fiveMinutesRetention:
+		min = time.Hour
+	case age > oneMinuteRetention:
+		min = 5 * time.Minute
+	}
+	window := end.Sub(start)
+	for _, period := range metricPeriods {
+		if period >= min && window/period <= maxDatapointsPerMetric {
+			return int64(period.Seconds())
+		}
+	}
+	return int64(metricPeriods[len(metricPeriods)-1].Seconds())
+}
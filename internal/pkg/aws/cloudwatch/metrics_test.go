@@ -0,0 +1,212 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudwatch
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCloudWatch_MetricData(t *testing.T) {
+	end := time.Now().Truncate(time.Minute)
+	start := end.Add(-time.Hour)
+	latencyQuery := MetricQuery{
+		ID:         "latency",
+		Namespace:  "AWS/ApplicationELB",
+		MetricName: "TargetResponseTime",
+		Dimensions: map[string]string{
+			"TargetGroup":  "targetgroup/api/123",
+			"LoadBalancer": "app/phonetool-test/456",
+		},
+		Stat: "p99",
+		Unit: "Milliseconds",
+	}
+	var manyQueries []MetricQuery
+	for i := 0; i < 501; i++ {
+		manyQueries = append(manyQueries, MetricQuery{
+			ID:         fmt.Sprintf("cpu%d", i),
+			Namespace:  "AWS/ECS",
+			MetricName: "CPUUtilization",
+			Stat:       "Average",
+		})
+	}
+	testCases := map[string]struct {
+		inQueries  []MetricQuery
+		mockClient func(m *mocks.Mockapi)
+
+		wantedSeries []MetricSeries
+		wantedErr    string
+	}{
+		"errors if the metric data can't be retrieved": {
+			inQueries: []MetricQuery{latencyQuery},
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetMetricData(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: "get metric data: some error",
+		},
+		"goes through every page of datapoints and normalizes their unit": {
+			inQueries: []MetricQuery{latencyQuery},
+			mockClient: func(m *mocks.Mockapi) {
+				wantedIn := &cloudwatch.GetMetricDataInput{
+					StartTime: aws.Time(start),
+					EndTime:   aws.Time(end),
+					ScanBy:    aws.String("TimestampAscending"),
+					MetricDataQueries: []*cloudwatch.MetricDataQuery{
+						{
+							Id: aws.String("latency"),
+							MetricStat: &cloudwatch.MetricStat{
+								Metric: &cloudwatch.Metric{
+									Namespace:  aws.String("AWS/ApplicationELB"),
+									MetricName: aws.String("TargetResponseTime"),
+									Dimensions: []*cloudwatch.Dimension{
+										{Name: aws.String("LoadBalancer"), Value: aws.String("app/phonetool-test/456")},
+										{Name: aws.String("TargetGroup"), Value: aws.String("targetgroup/api/123")},
+									},
+								},
+								Period: aws.Int64(60),
+								Stat:   aws.String("p99"),
+								Unit:   aws.String("Milliseconds"),
+							},
+						},
+					},
+				}
+				gomock.InOrder(
+					m.EXPECT().GetMetricData(wantedIn).Return(&cloudwatch.GetMetricDataOutput{
+						MetricDataResults: []*cloudwatch.MetricDataResult{
+							{
+								Id:         aws.String("latency"),
+								Timestamps: aws.TimeSlice([]time.Time{start}),
+								Values:     aws.Float64Slice([]float64{250}),
+							},
+						},
+						NextToken: aws.String("page2"),
+					}, nil),
+					m.EXPECT().GetMetricData(gomock.Any()).DoAndReturn(func(in *cloudwatch.GetMetricDataInput) (*cloudwatch.GetMetricDataOutput, error) {
+						require.Equal(t, "page2", aws.StringValue(in.NextToken))
+						return &cloudwatch.GetMetricDataOutput{
+							MetricDataResults: []*cloudwatch.MetricDataResult{
+								{
+									Id:         aws.String("latency"),
+									Timestamps: aws.TimeSlice([]time.Time{start.Add(time.Minute)}),
+									Values:     aws.Float64Slice([]float64{1500}),
+								},
+							},
+						}, nil
+					}),
+				)
+			},
+			wantedSeries: []MetricSeries{
+				{
+					ID:         "latency",
+					Unit:       "Seconds",
+					Timestamps: []time.Time{start, start.Add(time.Minute)},
+					Values:     []float64{0.25, 1.5},
+				},
+			},
+		},
+		"sends the queries in chunks of 500": {
+			inQueries: manyQueries,
+			mockClient: func(m *mocks.Mockapi) {
+				gomock.InOrder(
+					m.EXPECT().GetMetricData(gomock.Any()).DoAndReturn(func(in *cloudwatch.GetMetricDataInput) (*cloudwatch.GetMetricDataOutput, error) {
+						require.Len(t, in.MetricDataQueries, 500)
+						return &cloudwatch.GetMetricDataOutput{}, nil
+					}),
+					m.EXPECT().GetMetricData(gomock.Any()).DoAndReturn(func(in *cloudwatch.GetMetricDataInput) (*cloudwatch.GetMetricDataOutput, error) {
+						require.Len(t, in.MetricDataQueries, 1)
+						require.Equal(t, "cpu500", aws.StringValue(in.MetricDataQueries[0].Id))
+						return &cloudwatch.GetMetricDataOutput{
+							MetricDataResults: []*cloudwatch.MetricDataResult{
+								{
+									Id:         aws.String("cpu500"),
+									Timestamps: aws.TimeSlice([]time.Time{start}),
+									Values:     aws.Float64Slice([]float64{42}),
+								},
+							},
+						}, nil
+					}),
+				)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.mockClient(m)
+			cw := CloudWatch{
+				cwClient: m,
+			}
+
+			// WHEN
+			series, err := cw.MetricData(tc.inQueries, start, end)
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			if tc.wantedSeries != nil {
+				require.Equal(t, tc.wantedSeries, series)
+				return
+			}
+			require.Len(t, series, len(tc.inQueries))
+			require.Equal(t, []float64{42}, series[500].Values)
+		})
+	}
+}
+
+func TestMetricPeriod(t *testing.T) {
+	now := time.Unix(1600000000, 0)
+	testCases := map[string]struct {
+		inStart time.Time
+		inEnd   time.Time
+
+		wantedPeriod int64
+	}{
+		"one minute for the last hour": {
+			inStart:      now.Add(-time.Hour),
+			inEnd:        now,
+			wantedPeriod: 60,
+		},
+		"five minutes for the last 3 days": {
+			inStart:      now.Add(-3 * 24 * time.Hour),
+			inEnd:        now,
+			wantedPeriod: 300,
+		},
+		"five minutes for an hour a month ago": {
+			inStart:      now.Add(-30 * 24 * time.Hour),
+			inEnd:        now.Add(-30*24*time.Hour + time.Hour),
+			wantedPeriod: 300,
+		},
+		"an hour for an hour three months ago": {
+			inStart:      now.Add(-90 * 24 * time.Hour),
+			inEnd:        now.Add(-90*24*time.Hour + time.Hour),
+			wantedPeriod: 3600,
+		},
+		"a day for the last five years": {
+			inStart:      now.Add(-5 * 365 * 24 * time.Hour),
+			inEnd:        now,
+			wantedPeriod: 86400,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wantedPeriod, metricPeriod(tc.inStart, tc.inEnd, now))
+		})
+	}
+}
@@ -49,6 +49,36 @@ func (mr *MockapiMockRecorder) DescribeAlarms(input interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeAlarms", reflect.TypeOf((*Mockapi)(nil).DescribeAlarms), input)
 }
 
+// DescribeAlarmHistory mocks base method
+func (m *Mockapi) DescribeAlarmHistory(input *cloudwatch.DescribeAlarmHistoryInput) (*cloudwatch.DescribeAlarmHistoryOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeAlarmHistory", input)
+	ret0, _ := ret[0].(*cloudwatch.DescribeAlarmHistoryOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeAlarmHistory indicates an expected call of DescribeAlarmHistory
+func (mr *MockapiMockRecorder) DescribeAlarmHistory(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeAlarmHistory", reflect.TypeOf((*Mockapi)(nil).DescribeAlarmHistory), input)
+}
+
+// GetMetricData mocks base method
+func (m *Mockapi) GetMetricData(input *cloudwatch.GetMetricDataInput) (*cloudwatch.GetMetricDataOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMetricData", input)
+	ret0, _ := ret[0].(*cloudwatch.GetMetricDataOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMetricData indicates an expected call of GetMetricData
+func (mr *MockapiMockRecorder) GetMetricData(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMetricData", reflect.TypeOf((*Mockapi)(nil).GetMetricData), input)
+}
+
 // MockresourceGetter is a mock of resourceGetter interface
 type MockresourceGetter struct {
 	ctrl     *gomock.Controller
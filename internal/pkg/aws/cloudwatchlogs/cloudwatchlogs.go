@@ -23,6 +23,9 @@ const (
 	logStreamNamePrefix = "copilot/"
 )
 
+// queryPollInterval is the time to wait between requests for the results of a Logs Insights query.
+var queryPollInterval = SleepDuration
+
 var (
 	fatalCodes   = []string{"FATA", "FATAL", "fatal", "ERR", "ERROR", "error"}
 	warningCodes = []string{"WARN", "warn", "WARNING", "warning"}
@@ -31,6 +34,13 @@ var (
 type api interface {
 	DescribeLogStreams(input *cloudwatchlogs.DescribeLogStreamsInput) (*cloudwatchlogs.DescribeLogStreamsOutput, error)
 	GetLogEvents(input *cloudwatchlogs.GetLogEventsInput) (*cloudwatchlogs.GetLogEventsOutput, error)
+	StartQuery(input *cloudwatchlogs.StartQueryInput) (*cloudwatchlogs.StartQueryOutput, error)
+	GetQueryResults(input *cloudwatchlogs.GetQueryResultsInput) (*cloudwatchlogs.GetQueryResultsOutput, error)
+	FilterLogEvents(input *cloudwatchlogs.FilterLogEventsInput) (*cloudwatchlogs.FilterLogEventsOutput, error)
+	DescribeLogGroups(input *cloudwatchlogs.DescribeLogGroupsInput) (*cloudwatchlogs.DescribeLogGroupsOutput, error)
+	ListTagsLogGroup(input *cloudwatchlogs.ListTagsLogGroupInput) (*cloudwatchlogs.ListTagsLogGroupOutput, error)
+	PutRetentionPolicy(input *cloudwatchlogs.PutRetentionPolicyInput) (*cloudwatchlogs.PutRetentionPolicyOutput, error)
+	DeleteRetentionPolicy(input *cloudwatchlogs.DeleteRetentionPolicyInput) (*cloudwatchlogs.DeleteRetentionPolicyOutput, error)
 }
 
 // CloudWatchLogs wraps an AWS Cloudwatch Logs client.
@@ -99,12 +109,39 @@ func (c *CloudWatchLogs) logStreams(logGroupName string) ([]*string, error) {
 
 // TaskLogEvents returns an array of Cloudwatch Logs events.
 func (c *CloudWatchLogs) TaskLogEvents(logGroupName string, streamLastEventTime map[string]int64, opts ...GetLogEventsOpts) (*LogEventsOutput, error) {
-	var events []*Event
-	var in *cloudwatchlogs.GetLogEventsInput
 	logStreamNames, err := c.logStreams(logGroupName)
 	if err != nil {
 		return nil, err
 	}
+	return c.logEvents(logGroupName, logStreamNames, streamLastEventTime, opts...)
+}
+
+// LogEventsOfTasks returns an array of Cloudwatch Logs events written by the tasks with the IDs,
+// among the log streams that were written to most recently.
+func (c *CloudWatchLogs) LogEventsOfTasks(logGroupName string, taskIDs []string, opts ...GetLogEventsOpts) (*LogEventsOutput, error) {
+	logStreamNames, err := c.logStreams(logGroupName)
+	if err != nil {
+		return nil, err
+	}
+	var taskStreamNames []*string
+	for _, logStreamName := range logStreamNames {
+		// logStreamName example: copilot/{name}/{taskID}
+		for _, taskID := range taskIDs {
+			if strings.HasSuffix(aws.StringValue(logStreamName), "/"+taskID) {
+				taskStreamNames = append(taskStreamNames, logStreamName)
+				break
+			}
+		}
+	}
+	if len(taskStreamNames) == 0 {
+		return nil, fmt.Errorf("no log stream found for tasks %s in log group %s", strings.Join(taskIDs, ", "), logGroupName)
+	}
+	return c.logEvents(logGroupName, taskStreamNames, make(map[string]int64), opts...)
+}
+
+func (c *CloudWatchLogs) logEvents(logGroupName string, logStreamNames []*string, streamLastEventTime map[string]int64, opts ...GetLogEventsOpts) (*LogEventsOutput, error) {
+	var events []*Event
+	var in *cloudwatchlogs.GetLogEventsInput
 	for _, logStreamName := range logStreamNames {
 		in = &cloudwatchlogs.GetLogEventsInput{
 			LogGroupName:  aws.String(logGroupName),
@@ -151,6 +188,60 @@ func (c *CloudWatchLogs) TaskLogEvents(logGroupName string, streamLastEventTime
 	}, nil
 }
 
+// FilterLogEventsInput holds the parameters to search for log events across the log streams of a log group.
+type FilterLogEventsInput struct {
+	LogGroupName        string
+	LogStreamNamePrefix string // Optional. Only search the log streams whose name starts with the prefix.
+	FilterPattern       string // Optional. See https://docs.aws.amazon.com/AmazonCloudWatch/latest/logs/FilterAndPatternSyntax.html
+	StartTime           int64  // Optional. In milliseconds since epoch.
+	EndTime             int64  // Optional. In milliseconds since epoch.
+	Limit               int    // Optional. Maximum number of events to return, all events are returned if it's 0.
+}
+
+// FilteredLogEvents returns the log events of a log group that match the filter in chronological order,
+// going through every page of results until the limit is reached.
+func (c *CloudWatchLogs) FilteredLogEvents(in FilterLogEventsInput) ([]*Event, error) {
+	req := &cloudwatchlogs.FilterLogEventsInput{
+		LogGroupName: aws.String(in.LogGroupName),
+	}
+	if in.LogStreamNamePrefix != "" {
+		req.LogStreamNamePrefix = aws.String(in.LogStreamNamePrefix)
+	}
+	if in.FilterPattern != "" {
+		req.FilterPattern = aws.String(in.FilterPattern)
+	}
+	if in.StartTime != 0 {
+		req.StartTime = aws.Int64(in.StartTime)
+	}
+	if in.EndTime != 0 {
+		req.EndTime = aws.Int64(in.EndTime)
+	}
+	var events []*Event
+	for {
+		if in.Limit != 0 {
+			req.Limit = aws.Int64(int64(in.Limit - len(events)))
+		}
+		out, err := c.client.FilterLogEvents(req)
+		if err != nil {
+			return nil, fmt.Errorf("filter log events of log group %s: %w", in.LogGroupName, err)
+		}
+		for _, event := range out.Events {
+			events = append(events, &Event{
+				LogStreamName: trimLogStreamName(aws.StringValue(event.LogStreamName)),
+				IngestionTime: aws.Int64Value(event.IngestionTime),
+				Message:       aws.StringValue(event.Message),
+				Timestamp:     aws.Int64Value(event.Timestamp),
+			})
+		}
+		if out.NextToken == nil || (in.Limit != 0 && len(events) >= in.Limit) {
+			break
+		}
+		req.NextToken = out.NextToken
+	}
+	sort.SliceStable(events, func(i, j int) bool { return events[i].Timestamp < events[j].Timestamp })
+	return events, nil
+}
+
 // LogGroupExists returns if a log group exists.
 func (c *CloudWatchLogs) LogGroupExists(logGroupName string) (bool, error) {
 	_, err := c.client.DescribeLogStreams(&cloudwatchlogs.DescribeLogStreamsInput{
@@ -169,6 +260,37 @@ func (c *CloudWatchLogs) LogGroupExists(logGroupName string) (bool, error) {
 	return false, nil
 }
 
+// Query runs a Logs Insights query against a log group between startTime and endTime, in milliseconds since epoch,
+// and waits for its results.
+func (c *CloudWatchLogs) Query(logGroupName, query string, startTime, endTime int64) (*QueryResults, error) {
+	out, err := c.client.StartQuery(&cloudwatchlogs.StartQueryInput{
+		LogGroupName: aws.String(logGroupName),
+		QueryString:  aws.String(query),
+		StartTime:    aws.Int64(startTime / 1000),
+		EndTime:      aws.Int64(endTime / 1000),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("start query on log group %s: %w", logGroupName, err)
+	}
+	queryID := aws.StringValue(out.QueryId)
+	for {
+		resp, err := c.client.GetQueryResults(&cloudwatchlogs.GetQueryResultsInput{
+			QueryId: aws.String(queryID),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("get results of query %s: %w", queryID, err)
+		}
+		switch status := aws.StringValue(resp.Status); status {
+		case cloudwatchlogs.QueryStatusScheduled, cloudwatchlogs.QueryStatusRunning:
+			time.Sleep(queryPollInterval)
+		case cloudwatchlogs.QueryStatusComplete:
+			return newQueryResults(resp.Results), nil
+		default:
+			return nil, fmt.Errorf("query %s on log group %s is %s", queryID, logGroupName, strings.ToLower(status))
+		}
+	}
+}
+
 func trimLogStreamName(logStreamName string) string {
 	// logStreamName example: copilot/{name}/1cc0685ad01d4d0f8e4e2c00d1775c56
 	return strings.TrimPrefix(logStreamName, logStreamNamePrefix)
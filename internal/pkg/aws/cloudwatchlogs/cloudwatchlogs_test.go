@@ -294,6 +294,90 @@ func TestLogEvents(t *testing.T) {
 	}
 }
 
+func TestCloudWatchLogs_LogEventsOfTasks(t *testing.T) {
+	testCases := map[string]struct {
+		taskIDs                  []string
+		mockcloudwatchlogsClient func(m *mocks.Mockapi)
+
+		wantLogEvents []*Event
+		wantErr       error
+	}{
+		"only gets the log events of the tasks": {
+			taskIDs: []string{"task1"},
+			mockcloudwatchlogsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeLogStreams(gomock.Any()).Return(&cloudwatchlogs.DescribeLogStreamsOutput{
+					LogStreams: []*cloudwatchlogs.LogStream{
+						{
+							LogStreamName: aws.String("copilot/api/task2"),
+						},
+						{
+							LogStreamName: aws.String("copilot/api/task1"),
+						},
+					},
+				}, nil)
+				m.EXPECT().GetLogEvents(&cloudwatchlogs.GetLogEventsInput{
+					Limit:         aws.Int64(10),
+					LogGroupName:  aws.String("mockLogGroup"),
+					LogStreamName: aws.String("copilot/api/task1"),
+				}).Return(&cloudwatchlogs.GetLogEventsOutput{
+					Events: []*cloudwatchlogs.OutputLogEvent{
+						{
+							Message:   aws.String("panic: runtime error"),
+							Timestamp: aws.Int64(1),
+						},
+					},
+				}, nil)
+			},
+			wantLogEvents: []*Event{
+				{
+					LogStreamName: "api/task1",
+					Message:       "panic: runtime error",
+					Timestamp:     1,
+				},
+			},
+		},
+		"errors if none of the log streams belong to the tasks": {
+			taskIDs: []string{"task1", "task3"},
+			mockcloudwatchlogsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeLogStreams(gomock.Any()).Return(&cloudwatchlogs.DescribeLogStreamsOutput{
+					LogStreams: []*cloudwatchlogs.LogStream{
+						{
+							LogStreamName: aws.String("copilot/api/task2"),
+						},
+					},
+				}, nil)
+			},
+			wantErr: errors.New("no log stream found for tasks task1, task3 in log group mockLogGroup"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockcloudwatchlogsClient := mocks.NewMockapi(ctrl)
+			tc.mockcloudwatchlogsClient(mockcloudwatchlogsClient)
+
+			service := CloudWatchLogs{
+				client: mockcloudwatchlogsClient,
+			}
+
+			// WHEN
+			got, err := service.LogEventsOfTasks("mockLogGroup", tc.taskIDs)
+
+			// THEN
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantLogEvents, got.Events)
+			}
+		})
+	}
+}
+
 func TestLogGroupExists(t *testing.T) {
 	mockError := errors.New("some error")
 	testCases := map[string]struct {
@@ -365,3 +449,261 @@ func TestLogGroupExists(t *testing.T) {
 		})
 	}
 }
+
+func TestCloudWatchLogs_Query(t *testing.T) {
+	const (
+		mockLogGroup = "/copilot/phonetool-test-api"
+		mockQuery    = "stats count(*) as errors by bin(5m)"
+		mockQueryID  = "abc123"
+	)
+	mockError := errors.New("some error")
+	wantedStartQueryInput := &cloudwatchlogs.StartQueryInput{
+		LogGroupName: aws.String(mockLogGroup),
+		QueryString:  aws.String(mockQuery),
+		StartTime:    aws.Int64(1600000000),
+		EndTime:      aws.Int64(1600003600),
+	}
+	wantedGetQueryResultsInput := &cloudwatchlogs.GetQueryResultsInput{
+		QueryId: aws.String(mockQueryID),
+	}
+	testCases := map[string]struct {
+		mockcloudwatchlogsClient func(m *mocks.Mockapi)
+
+		wantResults *QueryResults
+		wantErr     error
+	}{
+		"should return error if fail to start the query": {
+			mockcloudwatchlogsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().StartQuery(wantedStartQueryInput).Return(nil, mockError)
+			},
+
+			wantErr: fmt.Errorf("start query on log group %s: %w", mockLogGroup, mockError),
+		},
+		"should return error if fail to get the query results": {
+			mockcloudwatchlogsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().StartQuery(wantedStartQueryInput).Return(&cloudwatchlogs.StartQueryOutput{
+					QueryId: aws.String(mockQueryID),
+				}, nil)
+				m.EXPECT().GetQueryResults(wantedGetQueryResultsInput).Return(nil, mockError)
+			},
+
+			wantErr: fmt.Errorf("get results of query %s: %w", mockQueryID, mockError),
+		},
+		"should return error if the query fails": {
+			mockcloudwatchlogsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().StartQuery(wantedStartQueryInput).Return(&cloudwatchlogs.StartQueryOutput{
+					QueryId: aws.String(mockQueryID),
+				}, nil)
+				m.EXPECT().GetQueryResults(wantedGetQueryResultsInput).Return(&cloudwatchlogs.GetQueryResultsOutput{
+					Status: aws.String(cloudwatchlogs.QueryStatusFailed),
+				}, nil)
+			},
+
+			wantErr: fmt.Errorf("query %s on log group %s is failed", mockQueryID, mockLogGroup),
+		},
+		"should wait for the query to complete and return its results": {
+			mockcloudwatchlogsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().StartQuery(wantedStartQueryInput).Return(&cloudwatchlogs.StartQueryOutput{
+					QueryId: aws.String(mockQueryID),
+				}, nil)
+				gomock.InOrder(
+					m.EXPECT().GetQueryResults(wantedGetQueryResultsInput).Return(&cloudwatchlogs.GetQueryResultsOutput{
+						Status: aws.String(cloudwatchlogs.QueryStatusRunning),
+					}, nil),
+					m.EXPECT().GetQueryResults(wantedGetQueryResultsInput).Return(&cloudwatchlogs.GetQueryResultsOutput{
+						Status: aws.String(cloudwatchlogs.QueryStatusComplete),
+						Results: [][]*cloudwatchlogs.ResultField{
+							{
+								{Field: aws.String("bin(5m)"), Value: aws.String("2020-09-13 12:25:00.000")},
+								{Field: aws.String("errors"), Value: aws.String("3")},
+							},
+							{
+								{Field: aws.String("@ptr"), Value: aws.String("CmAKJwojMTIz")},
+								{Field: aws.String("bin(5m)"), Value: aws.String("2020-09-13 12:30:00.000")},
+							},
+						},
+					}, nil),
+				)
+			},
+
+			wantResults: &QueryResults{
+				Fields: []string{"bin(5m)", "errors"},
+				Rows: []map[string]string{
+					{"bin(5m)": "2020-09-13 12:25:00.000", "errors": "3"},
+					{"bin(5m)": "2020-09-13 12:30:00.000"},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockcloudwatchlogsClient := mocks.NewMockapi(ctrl)
+			tc.mockcloudwatchlogsClient(mockcloudwatchlogsClient)
+
+			service := CloudWatchLogs{
+				client: mockcloudwatchlogsClient,
+			}
+			queryPollInterval = 0
+
+			// WHEN
+			results, err := service.Query(mockLogGroup, mockQuery, 1600000000000, 1600003600000)
+
+			// THEN
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantResults, results)
+			require.Equal(t, `bin(5m)                  errors
+2020-09-13 12:25:00.000  3
+2020-09-13 12:30:00.000  
+`, results.HumanString())
+		})
+	}
+}
+
+func TestCloudWatchLogs_FilteredLogEvents(t *testing.T) {
+	testCases := map[string]struct {
+		inLimit    int
+		mockClient func(m *mocks.Mockapi)
+
+		wantedEvents []*Event
+		wantedErr    string
+	}{
+		"errors if the log events can't be filtered": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().FilterLogEvents(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: "filter log events of log group mockLogGroup: some error",
+		},
+		"goes through every page of events in chronological order": {
+			mockClient: func(m *mocks.Mockapi) {
+				gomock.InOrder(
+					m.EXPECT().FilterLogEvents(&cloudwatchlogs.FilterLogEventsInput{
+						LogGroupName:        aws.String("mockLogGroup"),
+						LogStreamNamePrefix: aws.String("copilot/api"),
+						FilterPattern:       aws.String("ERROR"),
+						StartTime:           aws.Int64(1000),
+					}).Return(&cloudwatchlogs.FilterLogEventsOutput{
+						Events: []*cloudwatchlogs.FilteredLogEvent{
+							{
+								LogStreamName: aws.String("copilot/api/task2"),
+								Message:       aws.String("ERROR: connection refused"),
+								Timestamp:     aws.Int64(3000),
+							},
+						},
+						NextToken: aws.String("page2"),
+					}, nil),
+					m.EXPECT().FilterLogEvents(&cloudwatchlogs.FilterLogEventsInput{
+						LogGroupName:        aws.String("mockLogGroup"),
+						LogStreamNamePrefix: aws.String("copilot/api"),
+						FilterPattern:       aws.String("ERROR"),
+						StartTime:           aws.Int64(1000),
+						NextToken:           aws.String("page2"),
+					}).Return(&cloudwatchlogs.FilterLogEventsOutput{
+						Events: []*cloudwatchlogs.FilteredLogEvent{
+							{
+								LogStreamName: aws.String("copilot/api/task1"),
+								Message:       aws.String("ERROR: timeout"),
+								Timestamp:     aws.Int64(2000),
+							},
+						},
+					}, nil),
+				)
+			},
+			wantedEvents: []*Event{
+				{
+					LogStreamName: "api/task1",
+					Message:       "ERROR: timeout",
+					Timestamp:     2000,
+				},
+				{
+					LogStreamName: "api/task2",
+					Message:       "ERROR: connection refused",
+					Timestamp:     3000,
+				},
+			},
+		},
+		"stops going through pages once the limit is reached": {
+			inLimit: 2,
+			mockClient: func(m *mocks.Mockapi) {
+				gomock.InOrder(
+					m.EXPECT().FilterLogEvents(gomock.Any()).DoAndReturn(func(in *cloudwatchlogs.FilterLogEventsInput) (*cloudwatchlogs.FilterLogEventsOutput, error) {
+						require.Equal(t, int64(2), aws.Int64Value(in.Limit))
+						return &cloudwatchlogs.FilterLogEventsOutput{
+							Events: []*cloudwatchlogs.FilteredLogEvent{
+								{
+									LogStreamName: aws.String("copilot/api/task1"),
+									Message:       aws.String("ERROR: timeout"),
+									Timestamp:     aws.Int64(2000),
+								},
+							},
+							NextToken: aws.String("page2"),
+						}, nil
+					}),
+					m.EXPECT().FilterLogEvents(gomock.Any()).DoAndReturn(func(in *cloudwatchlogs.FilterLogEventsInput) (*cloudwatchlogs.FilterLogEventsOutput, error) {
+						require.Equal(t, int64(1), aws.Int64Value(in.Limit))
+						return &cloudwatchlogs.FilterLogEventsOutput{
+							Events: []*cloudwatchlogs.FilteredLogEvent{
+								{
+									LogStreamName: aws.String("copilot/api/task2"),
+									Message:       aws.String("ERROR: connection refused"),
+									Timestamp:     aws.Int64(3000),
+								},
+							},
+							NextToken: aws.String("page3"),
+						}, nil
+					}),
+				)
+			},
+			wantedEvents: []*Event{
+				{
+					LogStreamName: "api/task1",
+					Message:       "ERROR: timeout",
+					Timestamp:     2000,
+				},
+				{
+					LogStreamName: "api/task2",
+					Message:       "ERROR: connection refused",
+					Timestamp:     3000,
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.mockClient(m)
+			service := CloudWatchLogs{
+				client: m,
+			}
+
+			// WHEN
+			got, err := service.FilteredLogEvents(FilterLogEventsInput{
+				LogGroupName:        "mockLogGroup",
+				LogStreamNamePrefix: "copilot/api",
+				FilterPattern:       "ERROR",
+				StartTime:           1000,
+				Limit:               tc.inLimit,
+			})
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedEvents, got)
+		})
+	}
+}
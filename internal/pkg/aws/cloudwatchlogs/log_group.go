@@ -0,0 +1,112 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudwatchlogs
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+)
+
+// RetentionDays is the list of number of days that log events can be kept for.
+var RetentionDays = []int{1, 3, 5, 7, 14, 30, 60, 90, 120, 150, 180, 365, 400, 545, 731, 1827, 3653}
+
+// LogGroup represents a CloudWatch Logs log group.
+type LogGroup struct {
+	Name string
+	// RetentionInDays is the number of days that log events are kept for, 0 if they never expire.
+	RetentionInDays int
+	StoredBytes     int64
+}
+
+// LogGroups returns the log groups whose name starts with the prefix and that have all the tags.
+func (c *CloudWatchLogs) LogGroups(prefix string, tags map[string]string) ([]LogGroup, error) {
+	in := &cloudwatchlogs.DescribeLogGroupsInput{}
+	if prefix != "" {
+		in.LogGroupNamePrefix = aws.String(prefix)
+	}
+	var groups []LogGroup
+	for {
+		out, err := c.client.DescribeLogGroups(in)
+		if err != nil {
+			return nil, fmt.Errorf("describe log groups with prefix %s: %w", prefix, err)
+		}
+		for _, group := range out.LogGroups {
+			name := aws.StringValue(group.LogGroupName)
+			ok, err := c.hasTags(name, tags)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				continue
+			}
+			groups = append(groups, LogGroup{
+				Name:            name,
+				RetentionInDays: int(aws.Int64Value(group.RetentionInDays)),
+				StoredBytes:     aws.Int64Value(group.StoredBytes),
+			})
+		}
+		if out.NextToken == nil {
+			break
+		}
+		in.NextToken = out.NextToken
+	}
+	return groups, nil
+}
+
+func (c *CloudWatchLogs) hasTags(logGroupName string, tags map[string]string) (bool, error) {
+	if len(tags) == 0 {
+		return true, nil
+	}
+	out, err := c.client.ListTagsLogGroup(&cloudwatchlogs.ListTagsLogGroupInput{
+		LogGroupName: aws.String(logGroupName),
+	})
+	if err != nil {
+		return false, fmt.Errorf("list tags of log group %s: %w", logGroupName, err)
+	}
+	for k, v := range tags {
+		if aws.StringValue(out.Tags[k]) != v {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// SetRetention keeps the log events of a log group for the number of days, or forever if days is 0.
+func (c *CloudWatchLogs) SetRetention(logGroupName string, days int) error {
+	if days == 0 {
+		if _, err := c.client.DeleteRetentionPolicy(&cloudwatchlogs.DeleteRetentionPolicyInput{
+			LogGroupName: aws.String(logGroupName),
+		}); err != nil {
+			return fmt.Errorf("delete retention policy of log group %s: %w", logGroupName, err)
+		}
+		return nil
+	}
+	if !isValidRetention(days) {
+		values := make([]string, len(RetentionDays))
+		for i, d := range RetentionDays {
+			values[i] = strconv.Itoa(d)
+		}
+		return fmt.Errorf("invalid retention of %d days: must be one of %s", days, strings.Join(values, ", "))
+	}
+	if _, err := c.client.PutRetentionPolicy(&cloudwatchlogs.PutRetentionPolicyInput{
+		LogGroupName:    aws.String(logGroupName),
+		RetentionInDays: aws.Int64(int64(days)),
+	}); err != nil {
+		return fmt.Errorf("put retention policy of %d days on log group %s: %w", days, logGroupName, err)
+	}
+	return nil
+}
+
+func isValidRetention(days int) bool {
+	for _, d := range RetentionDays {
+		if d == days {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,203 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudwatchlogs
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCloudWatchLogs_LogGroups(t *testing.T) {
+	testCases := map[string]struct {
+		inTags     map[string]string
+		mockClient func(m *mocks.Mockapi)
+
+		wantedGroups []LogGroup
+		wantedErr    string
+	}{
+		"errors if the log groups can't be described": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeLogGroups(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: "describe log groups with prefix /copilot/phonetool-test: some error",
+		},
+		"returns every page of log groups with the prefix": {
+			mockClient: func(m *mocks.Mockapi) {
+				gomock.InOrder(
+					m.EXPECT().DescribeLogGroups(&cloudwatchlogs.DescribeLogGroupsInput{
+						LogGroupNamePrefix: aws.String("/copilot/phonetool-test"),
+					}).Return(&cloudwatchlogs.DescribeLogGroupsOutput{
+						LogGroups: []*cloudwatchlogs.LogGroup{
+							{
+								LogGroupName:    aws.String("/copilot/phonetool-test-api"),
+								RetentionInDays: aws.Int64(30),
+								StoredBytes:     aws.Int64(1024),
+							},
+						},
+						NextToken: aws.String("page2"),
+					}, nil),
+					m.EXPECT().DescribeLogGroups(&cloudwatchlogs.DescribeLogGroupsInput{
+						LogGroupNamePrefix: aws.String("/copilot/phonetool-test"),
+						NextToken:          aws.String("page2"),
+					}).Return(&cloudwatchlogs.DescribeLogGroupsOutput{
+						LogGroups: []*cloudwatchlogs.LogGroup{
+							{
+								LogGroupName: aws.String("/copilot/phonetool-test-web"),
+							},
+						},
+					}, nil),
+				)
+				m.EXPECT().ListTagsLogGroup(gomock.Any()).Times(0)
+			},
+			wantedGroups: []LogGroup{
+				{
+					Name:            "/copilot/phonetool-test-api",
+					RetentionInDays: 30,
+					StoredBytes:     1024,
+				},
+				{
+					Name: "/copilot/phonetool-test-web",
+				},
+			},
+		},
+		"errors if the tags of a log group can't be listed": {
+			inTags: map[string]string{"copilot-service": "api"},
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeLogGroups(gomock.Any()).Return(&cloudwatchlogs.DescribeLogGroupsOutput{
+					LogGroups: []*cloudwatchlogs.LogGroup{
+						{
+							LogGroupName: aws.String("/copilot/phonetool-test-api"),
+						},
+					},
+				}, nil)
+				m.EXPECT().ListTagsLogGroup(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: "list tags of log group /copilot/phonetool-test-api: some error",
+		},
+		"only returns the log groups with all the tags": {
+			inTags: map[string]string{"copilot-service": "api"},
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeLogGroups(gomock.Any()).Return(&cloudwatchlogs.DescribeLogGroupsOutput{
+					LogGroups: []*cloudwatchlogs.LogGroup{
+						{
+							LogGroupName: aws.String("/copilot/phonetool-test-api"),
+						},
+						{
+							LogGroupName: aws.String("/copilot/phonetool-test-web"),
+						},
+					},
+				}, nil)
+				m.EXPECT().ListTagsLogGroup(&cloudwatchlogs.ListTagsLogGroupInput{
+					LogGroupName: aws.String("/copilot/phonetool-test-api"),
+				}).Return(&cloudwatchlogs.ListTagsLogGroupOutput{
+					Tags: aws.StringMap(map[string]string{"copilot-service": "api"}),
+				}, nil)
+				m.EXPECT().ListTagsLogGroup(&cloudwatchlogs.ListTagsLogGroupInput{
+					LogGroupName: aws.String("/copilot/phonetool-test-web"),
+				}).Return(&cloudwatchlogs.ListTagsLogGroupOutput{
+					Tags: aws.StringMap(map[string]string{"copilot-service": "web"}),
+				}, nil)
+			},
+			wantedGroups: []LogGroup{
+				{
+					Name: "/copilot/phonetool-test-api",
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.mockClient(m)
+			service := CloudWatchLogs{
+				client: m,
+			}
+
+			// WHEN
+			got, err := service.LogGroups("/copilot/phonetool-test", tc.inTags)
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedGroups, got)
+		})
+	}
+}
+
+func TestCloudWatchLogs_SetRetention(t *testing.T) {
+	testCases := map[string]struct {
+		inDays     int
+		mockClient func(m *mocks.Mockapi)
+
+		wantedErr string
+	}{
+		"errors if the number of days isn't supported": {
+			inDays: 2,
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().PutRetentionPolicy(gomock.Any()).Times(0)
+			},
+			wantedErr: "invalid retention of 2 days: must be one of 1, 3, 5, 7, 14, 30, 60, 90, 120, 150, 180, 365, 400, 545, 731, 1827, 3653",
+		},
+		"errors if the retention policy can't be put": {
+			inDays: 30,
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().PutRetentionPolicy(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: "put retention policy of 30 days on log group /copilot/phonetool-test-api: some error",
+		},
+		"puts a retention policy": {
+			inDays: 30,
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().PutRetentionPolicy(&cloudwatchlogs.PutRetentionPolicyInput{
+					LogGroupName:    aws.String("/copilot/phonetool-test-api"),
+					RetentionInDays: aws.Int64(30),
+				}).Return(&cloudwatchlogs.PutRetentionPolicyOutput{}, nil)
+			},
+		},
+		"keeps log events forever by deleting the retention policy": {
+			inDays: 0,
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DeleteRetentionPolicy(&cloudwatchlogs.DeleteRetentionPolicyInput{
+					LogGroupName: aws.String("/copilot/phonetool-test-api"),
+				}).Return(&cloudwatchlogs.DeleteRetentionPolicyOutput{}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.mockClient(m)
+			service := CloudWatchLogs{
+				client: m,
+			}
+
+			// WHEN
+			err := service.SetRetention("/copilot/phonetool-test-api", tc.inDays)
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -62,3 +62,108 @@ func (mr *MockapiMockRecorder) GetLogEvents(input interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLogEvents", reflect.TypeOf((*Mockapi)(nil).GetLogEvents), input)
 }
+
+// StartQuery mocks base method
+func (m *Mockapi) StartQuery(input *cloudwatchlogs.StartQueryInput) (*cloudwatchlogs.StartQueryOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartQuery", input)
+	ret0, _ := ret[0].(*cloudwatchlogs.StartQueryOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartQuery indicates an expected call of StartQuery
+func (mr *MockapiMockRecorder) StartQuery(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartQuery", reflect.TypeOf((*Mockapi)(nil).StartQuery), input)
+}
+
+// GetQueryResults mocks base method
+func (m *Mockapi) GetQueryResults(input *cloudwatchlogs.GetQueryResultsInput) (*cloudwatchlogs.GetQueryResultsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQueryResults", input)
+	ret0, _ := ret[0].(*cloudwatchlogs.GetQueryResultsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQueryResults indicates an expected call of GetQueryResults
+func (mr *MockapiMockRecorder) GetQueryResults(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQueryResults", reflect.TypeOf((*Mockapi)(nil).GetQueryResults), input)
+}
+
+// FilterLogEvents mocks base method
+func (m *Mockapi) FilterLogEvents(input *cloudwatchlogs.FilterLogEventsInput) (*cloudwatchlogs.FilterLogEventsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilterLogEvents", input)
+	ret0, _ := ret[0].(*cloudwatchlogs.FilterLogEventsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FilterLogEvents indicates an expected call of FilterLogEvents
+func (mr *MockapiMockRecorder) FilterLogEvents(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterLogEvents", reflect.TypeOf((*Mockapi)(nil).FilterLogEvents), input)
+}
+
+// DescribeLogGroups mocks base method
+func (m *Mockapi) DescribeLogGroups(input *cloudwatchlogs.DescribeLogGroupsInput) (*cloudwatchlogs.DescribeLogGroupsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeLogGroups", input)
+	ret0, _ := ret[0].(*cloudwatchlogs.DescribeLogGroupsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeLogGroups indicates an expected call of DescribeLogGroups
+func (mr *MockapiMockRecorder) DescribeLogGroups(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeLogGroups", reflect.TypeOf((*Mockapi)(nil).DescribeLogGroups), input)
+}
+
+// ListTagsLogGroup mocks base method
+func (m *Mockapi) ListTagsLogGroup(input *cloudwatchlogs.ListTagsLogGroupInput) (*cloudwatchlogs.ListTagsLogGroupOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTagsLogGroup", input)
+	ret0, _ := ret[0].(*cloudwatchlogs.ListTagsLogGroupOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTagsLogGroup indicates an expected call of ListTagsLogGroup
+func (mr *MockapiMockRecorder) ListTagsLogGroup(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTagsLogGroup", reflect.TypeOf((*Mockapi)(nil).ListTagsLogGroup), input)
+}
+
+// PutRetentionPolicy mocks base method
+func (m *Mockapi) PutRetentionPolicy(input *cloudwatchlogs.PutRetentionPolicyInput) (*cloudwatchlogs.PutRetentionPolicyOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutRetentionPolicy", input)
+	ret0, _ := ret[0].(*cloudwatchlogs.PutRetentionPolicyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutRetentionPolicy indicates an expected call of PutRetentionPolicy
+func (mr *MockapiMockRecorder) PutRetentionPolicy(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutRetentionPolicy", reflect.TypeOf((*Mockapi)(nil).PutRetentionPolicy), input)
+}
+
+// DeleteRetentionPolicy mocks base method
+func (m *Mockapi) DeleteRetentionPolicy(input *cloudwatchlogs.DeleteRetentionPolicyInput) (*cloudwatchlogs.DeleteRetentionPolicyOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRetentionPolicy", input)
+	ret0, _ := ret[0].(*cloudwatchlogs.DeleteRetentionPolicyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRetentionPolicy indicates an expected call of DeleteRetentionPolicy
+func (mr *MockapiMockRecorder) DeleteRetentionPolicy(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRetentionPolicy", reflect.TypeOf((*Mockapi)(nil).DeleteRetentionPolicy), input)
+}
@@ -0,0 +1,85 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudwatchlogs
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+)
+
+const (
+	// Identifier of a log event returned with the results of a query, it's not meant to be displayed.
+	queryPtrField = "@ptr"
+
+	queryResultsMinCellWidth = 10
+	queryResultsTabWidth     = 4
+	queryResultsCellPadding  = 2
+	queryResultsPaddingChar  = ' '
+)
+
+// QueryResults represents the results of a Logs Insights query.
+type QueryResults struct {
+	// Fields are the names of the columns in the order that they first appear in the results.
+	Fields []string
+	// Rows are the values of the fields for every result, a missing field has an empty value.
+	Rows []map[string]string
+}
+
+func newQueryResults(results [][]*cloudwatchlogs.ResultField) *QueryResults {
+	out := &QueryResults{}
+	seen := make(map[string]bool)
+	for _, result := range results {
+		row := make(map[string]string)
+		for _, field := range result {
+			name := aws.StringValue(field.Field)
+			if name == queryPtrField {
+				continue
+			}
+			if !seen[name] {
+				seen[name] = true
+				out.Fields = append(out.Fields, name)
+			}
+			row[name] = aws.StringValue(field.Value)
+		}
+		out.Rows = append(out.Rows, row)
+	}
+	return out
+}
+
+// JSONString returns the stringified query results with json format.
+func (r *QueryResults) JSONString() (string, error) {
+	rows := r.Rows
+	if rows == nil {
+		rows = []map[string]string{}
+	}
+	b, err := json.Marshal(rows)
+	if err != nil {
+		return "", fmt.Errorf("marshal query results: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the stringified query results as a table.
+func (r *QueryResults) HumanString() string {
+	if len(r.Rows) == 0 {
+		return "No results found.\n"
+	}
+	var b strings.Builder
+	writer := tabwriter.NewWriter(&b, queryResultsMinCellWidth, queryResultsTabWidth, queryResultsCellPadding, queryResultsPaddingChar, 0)
+	fmt.Fprintf(writer, "%s\n", strings.Join(r.Fields, "\t"))
+	for _, row := range r.Rows {
+		values := make([]string, len(r.Fields))
+		for i, field := range r.Fields {
+			values[i] = row[field]
+		}
+		fmt.Fprintf(writer, "%s\n", strings.Join(values, "\t"))
+	}
+	writer.Flush()
+	return b.String()
+}
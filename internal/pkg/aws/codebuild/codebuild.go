@@ -0,0 +1,82 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package codebuild provides a client to make API requests to AWS CodeBuild.
+package codebuild
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/codebuild"
+)
+
+// batchGetBuildsLimit is the maximum number of builds that can be described in one request.
+const batchGetBuildsLimit = 100
+
+// BuildStatusInProgress is the status of a build that hasn't completed yet.
+const BuildStatusInProgress = codebuild.StatusTypeInProgress
+
+type api interface {
+	BatchGetBuilds(input *codebuild.BatchGetBuildsInput) (*codebuild.BatchGetBuildsOutput, error)
+}
+
+// CodeBuild wraps an AWS CodeBuild client.
+type CodeBuild struct {
+	client api
+}
+
+// New returns a CodeBuild configured against the input session.
+func New(s *session.Session) *CodeBuild {
+	return &CodeBuild{
+		client: codebuild.New(s),
+	}
+}
+
+// LogStream is the CloudWatch log stream that a build writes its logs to.
+type LogStream struct {
+	BuildID     string `json:"buildId"`
+	BuildStatus string `json:"buildStatus"`
+	GroupName   string `json:"groupName"`
+	StreamName  string `json:"streamName"`
+}
+
+// LogStreams returns the log streams of the builds, in the same order as the build IDs.
+// Builds that haven't created their log stream yet are skipped.
+func (c *CodeBuild) LogStreams(buildIDs []string) ([]*LogStream, error) {
+	builds := make(map[string]*codebuild.Build)
+	for start := 0; start < len(buildIDs); start += batchGetBuildsLimit {
+		end := start + batchGetBuildsLimit
+		if end > len(buildIDs) {
+			end = len(buildIDs)
+		}
+		out, err := c.client.BatchGetBuilds(&codebuild.BatchGetBuildsInput{
+			Ids: aws.StringSlice(buildIDs[start:end]),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("batch get builds: %w", err)
+		}
+		if len(out.BuildsNotFound) > 0 {
+			return nil, fmt.Errorf("builds %s do not exist", strings.Join(aws.StringValueSlice(out.BuildsNotFound), ", "))
+		}
+		for _, build := range out.Builds {
+			builds[aws.StringValue(build.Id)] = build
+		}
+	}
+	var streams []*LogStream
+	for _, id := range buildIDs {
+		build, ok := builds[id]
+		if !ok || build.Logs == nil || build.Logs.StreamName == nil {
+			continue
+		}
+		streams = append(streams, &LogStream{
+			BuildID:     id,
+			BuildStatus: aws.StringValue(build.BuildStatus),
+			GroupName:   aws.StringValue(build.Logs.GroupName),
+			StreamName:  aws.StringValue(build.Logs.StreamName),
+		})
+	}
+	return streams, nil
+}
@@ -0,0 +1,149 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package codebuild
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/codebuild"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codebuild/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCodeBuild_LogStreams(t *testing.T) {
+	testCases := map[string]struct {
+		inBuildIDs []string
+		mockClient func(m *mocks.Mockapi)
+
+		wantedStreams []*LogStream
+		wantedErr     string
+	}{
+		"errors if the builds can't be described": {
+			inBuildIDs: []string{"build:1"},
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().BatchGetBuilds(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: "batch get builds: some error",
+		},
+		"errors if a build doesn't exist": {
+			inBuildIDs: []string{"build:1", "build:2"},
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().BatchGetBuilds(gomock.Any()).Return(&codebuild.BatchGetBuildsOutput{
+					BuildsNotFound: aws.StringSlice([]string{"build:2"}),
+				}, nil)
+			},
+			wantedErr: "builds build:2 do not exist",
+		},
+		"returns the log streams in the order of the build IDs and skips builds without logs": {
+			inBuildIDs: []string{"build:2", "build:1", "build:3"},
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().BatchGetBuilds(&codebuild.BatchGetBuildsInput{
+					Ids: aws.StringSlice([]string{"build:2", "build:1", "build:3"}),
+				}).Return(&codebuild.BatchGetBuildsOutput{
+					Builds: []*codebuild.Build{
+						{
+							Id:          aws.String("build:1"),
+							BuildStatus: aws.String(codebuild.StatusTypeFailed),
+							Logs: &codebuild.LogsLocation{
+								GroupName:  aws.String("/aws/codebuild/build"),
+								StreamName: aws.String("stream-1"),
+							},
+						},
+						{
+							Id:          aws.String("build:2"),
+							BuildStatus: aws.String(codebuild.StatusTypeInProgress),
+							Logs: &codebuild.LogsLocation{
+								GroupName:  aws.String("/aws/codebuild/build"),
+								StreamName: aws.String("stream-2"),
+							},
+						},
+						{
+							Id:          aws.String("build:3"),
+							BuildStatus: aws.String(codebuild.StatusTypeInProgress),
+							Logs:        &codebuild.LogsLocation{},
+						},
+					},
+				}, nil)
+			},
+			wantedStreams: []*LogStream{
+				{
+					BuildID:     "build:2",
+					BuildStatus: "IN_PROGRESS",
+					GroupName:   "/aws/codebuild/build",
+					StreamName:  "stream-2",
+				},
+				{
+					BuildID:     "build:1",
+					BuildStatus: "FAILED",
+					GroupName:   "/aws/codebuild/build",
+					StreamName:  "stream-1",
+				},
+			},
+		},
+		"describes the builds in batches": {
+			inBuildIDs: func() []string {
+				var ids []string
+				for i := 0; i < batchGetBuildsLimit+1; i++ {
+					ids = append(ids, fmt.Sprintf("build:%d", i))
+				}
+				return ids
+			}(),
+			mockClient: func(m *mocks.Mockapi) {
+				gomock.InOrder(
+					m.EXPECT().BatchGetBuilds(gomock.Any()).DoAndReturn(func(in *codebuild.BatchGetBuildsInput) (*codebuild.BatchGetBuildsOutput, error) {
+						require.Len(t, in.Ids, batchGetBuildsLimit)
+						return &codebuild.BatchGetBuildsOutput{}, nil
+					}),
+					m.EXPECT().BatchGetBuilds(&codebuild.BatchGetBuildsInput{
+						Ids: aws.StringSlice([]string{fmt.Sprintf("build:%d", batchGetBuildsLimit)}),
+					}).Return(&codebuild.BatchGetBuildsOutput{
+						Builds: []*codebuild.Build{
+							{
+								Id:          aws.String(fmt.Sprintf("build:%d", batchGetBuildsLimit)),
+								BuildStatus: aws.String(codebuild.StatusTypeSucceeded),
+								Logs: &codebuild.LogsLocation{
+									GroupName:  aws.String("/aws/codebuild/build"),
+									StreamName: aws.String("stream"),
+								},
+							},
+						},
+					}, nil),
+				)
+			},
+			wantedStreams: []*LogStream{
+				{
+					BuildID:     fmt.Sprintf("build:%d", batchGetBuildsLimit),
+					BuildStatus: "SUCCEEDED",
+					GroupName:   "/aws/codebuild/build",
+					StreamName:  "stream",
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.mockClient(m)
+			client := CodeBuild{client: m}
+
+			// WHEN
+			streams, err := client.LogStreams(tc.inBuildIDs)
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedStreams, streams)
+		})
+	}
+}
@@ -0,0 +1,187 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package codedeploy provides a client to make API requests to AWS CodeDeploy.
+package codedeploy
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/codedeploy"
+)
+
+const (
+	appSpecVersion         = "0.0"
+	appSpecECSResourceType = "AWS::ECS::Service"
+)
+
+// Labels of the task sets of a blue/green deployment.
+const (
+	TaskSetBlue  = codedeploy.TargetLabelBlue  // The original tasks.
+	TaskSetGreen = codedeploy.TargetLabelGreen // The replacement tasks.
+)
+
+type api interface {
+	CreateDeployment(input *codedeploy.CreateDeploymentInput) (*codedeploy.CreateDeploymentOutput, error)
+	GetDeployment(input *codedeploy.GetDeploymentInput) (*codedeploy.GetDeploymentOutput, error)
+	ListDeploymentTargets(input *codedeploy.ListDeploymentTargetsInput) (*codedeploy.ListDeploymentTargetsOutput, error)
+	GetDeploymentTarget(input *codedeploy.GetDeploymentTargetInput) (*codedeploy.GetDeploymentTargetOutput, error)
+}
+
+// CodeDeploy wraps an AWS CodeDeploy client.
+type CodeDeploy struct {
+	client api
+}
+
+// New returns a CodeDeploy configured against the input session.
+func New(s *session.Session) *CodeDeploy {
+	return &CodeDeploy{
+		client: codedeploy.New(s),
+	}
+}
+
+// ECSDeploymentInput holds the configuration of a blue/green deployment of an ECS service.
+type ECSDeploymentInput struct {
+	Application     string
+	DeploymentGroup string
+	TaskDefinition  string // ARN of the task definition of the replacement tasks.
+	ContainerName   string // Container that the load balancer routes traffic to.
+	ContainerPort   int
+}
+
+// appSpec is the AppSpec file of an ECS deployment.
+// See https://docs.aws.amazon.com/codedeploy/latest/userguide/reference-appspec-file-structure-resources.html#reference-appspec-file-structure-resources-ecs
+type appSpec struct {
+	Version   string               `json:"version"`
+	Resources []map[string]ecsSpec `json:"Resources"`
+}
+
+type ecsSpec struct {
+	Type       string            `json:"Type"`
+	Properties ecsSpecProperties `json:"Properties"`
+}
+
+type ecsSpecProperties struct {
+	TaskDefinition   string `json:"TaskDefinition"`
+	LoadBalancerInfo struct {
+		ContainerName string `json:"ContainerName"`
+		ContainerPort int    `json:"ContainerPort"`
+	} `json:"LoadBalancerInfo"`
+}
+
+// CreateECSDeployment starts a blue/green deployment of the task definition to the ECS service of the deployment group,
+// and returns the ID of the deployment.
+func (c *CodeDeploy) CreateECSDeployment(in ECSDeploymentInput) (string, error) {
+	props := ecsSpecProperties{
+		TaskDefinition: in.TaskDefinition,
+	}
+	props.LoadBalancerInfo.ContainerName = in.ContainerName
+	props.LoadBalancerInfo.ContainerPort = in.ContainerPort
+	spec, err := json.Marshal(appSpec{
+		Version: appSpecVersion,
+		Resources: []map[string]ecsSpec{
+			{
+				"TargetService": {
+					Type:       appSpecECSResourceType,
+					Properties: props,
+				},
+			},
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("marshal AppSpec: %w", err)
+	}
+	out, err := c.client.CreateDeployment(&codedeploy.CreateDeploymentInput{
+		ApplicationName:     aws.String(in.Application),
+		DeploymentGroupName: aws.String(in.DeploymentGroup),
+		Revision: &codedeploy.RevisionLocation{
+			RevisionType: aws.String(codedeploy.RevisionLocationTypeAppSpecContent),
+			AppSpecContent: &codedeploy.AppSpecContent{
+				Content: aws.String(string(spec)),
+			},
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("create deployment of application %s: %w", in.Application, err)
+	}
+	return aws.StringValue(out.DeploymentId), nil
+}
+
+// Deployment is the status of a blue/green deployment of an ECS service.
+type Deployment struct {
+	ID           string
+	Status       string
+	ErrorMessage string    // Why the deployment failed, if it did.
+	TaskSets     []TaskSet // Empty until CodeDeploy creates the replacement tasks.
+}
+
+// TaskSet is the original or the replacement set of tasks of a blue/green deployment.
+type TaskSet struct {
+	Label         string  // TaskSetBlue or TaskSetGreen.
+	TrafficWeight float64 // Percentage of the production traffic served by the tasks.
+	DesiredCount  int64
+	RunningCount  int64
+}
+
+// IsDone returns true if the deployment succeeded, failed, or was stopped.
+func (d *Deployment) IsDone() bool {
+	switch d.Status {
+	case codedeploy.DeploymentStatusSucceeded, codedeploy.DeploymentStatusFailed, codedeploy.DeploymentStatusStopped:
+		return true
+	}
+	return false
+}
+
+// Succeeded returns true if the traffic was shifted to the replacement tasks.
+func (d *Deployment) Succeeded() bool {
+	return d.Status == codedeploy.DeploymentStatusSucceeded
+}
+
+// Deployment returns the status of the deployment along with how much traffic each set of tasks serves.
+func (c *CodeDeploy) Deployment(id string) (*Deployment, error) {
+	out, err := c.client.GetDeployment(&codedeploy.GetDeploymentInput{
+		DeploymentId: aws.String(id),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get deployment %s: %w", id, err)
+	}
+	deployment := &Deployment{
+		ID:     id,
+		Status: aws.StringValue(out.DeploymentInfo.Status),
+	}
+	if info := out.DeploymentInfo.ErrorInformation; info != nil {
+		deployment.ErrorMessage = aws.StringValue(info.Message)
+	}
+	targets, err := c.client.ListDeploymentTargets(&codedeploy.ListDeploymentTargetsInput{
+		DeploymentId: aws.String(id),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list targets of deployment %s: %w", id, err)
+	}
+	if len(targets.TargetIds) == 0 {
+		return deployment, nil
+	}
+	// A deployment group of an ECS service has a single target, the service.
+	target, err := c.client.GetDeploymentTarget(&codedeploy.GetDeploymentTargetInput{
+		DeploymentId: aws.String(id),
+		TargetId:     targets.TargetIds[0],
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get target %s of deployment %s: %w", aws.StringValue(targets.TargetIds[0]), id, err)
+	}
+	if target.DeploymentTarget == nil || target.DeploymentTarget.EcsTarget == nil {
+		return deployment, nil
+	}
+	for _, set := range target.DeploymentTarget.EcsTarget.TaskSetsInfo {
+		deployment.TaskSets = append(deployment.TaskSets, TaskSet{
+			Label:         aws.StringValue(set.TaskSetLabel),
+			TrafficWeight: aws.Float64Value(set.TrafficWeight),
+			DesiredCount:  aws.Int64Value(set.DesiredCount),
+			RunningCount:  aws.Int64Value(set.RunningCount),
+		})
+	}
+	return deployment, nil
+}
@@ -0,0 +1,193 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package codedeploy
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/codedeploy"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codedeploy/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCodeDeploy_CreateECSDeployment(t *testing.T) {
+	testCases := map[string]struct {
+		mockClient func(m *mocks.Mockapi)
+
+		wantedID  string
+		wantedErr string
+	}{
+		"errors if the deployment can't be created": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().CreateDeployment(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: "create deployment of application phonetool-test-api: some error",
+		},
+		"creates a deployment with the AppSpec of the task definition": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().CreateDeployment(&codedeploy.CreateDeploymentInput{
+					ApplicationName:     aws.String("phonetool-test-api"),
+					DeploymentGroupName: aws.String("phonetool-test-api-group"),
+					Revision: &codedeploy.RevisionLocation{
+						RevisionType: aws.String("AppSpecContent"),
+						AppSpecContent: &codedeploy.AppSpecContent{
+							Content: aws.String(`{"version":"0.0","Resources":[{"TargetService":{"Type":"AWS::ECS::Service","Properties":{"TaskDefinition":"arn:aws:ecs:us-west-2:123456789012:task-definition/api:2","LoadBalancerInfo":{"ContainerName":"api","ContainerPort":80}}}}]}`),
+						},
+					},
+				}).Return(&codedeploy.CreateDeploymentOutput{
+					DeploymentId: aws.String("d-ABCDEF"),
+				}, nil)
+			},
+			wantedID: "d-ABCDEF",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.mockClient(m)
+			cd := CodeDeploy{client: m}
+
+			// WHEN
+			id, err := cd.CreateECSDeployment(ECSDeploymentInput{
+				Application:     "phonetool-test-api",
+				DeploymentGroup: "phonetool-test-api-group",
+				TaskDefinition:  "arn:aws:ecs:us-west-2:123456789012:task-definition/api:2",
+				ContainerName:   "api",
+				ContainerPort:   80,
+			})
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedID, id)
+		})
+	}
+}
+
+func TestCodeDeploy_Deployment(t *testing.T) {
+	testCases := map[string]struct {
+		mockClient func(m *mocks.Mockapi)
+
+		wantedDeployment *Deployment
+		wantedErr        string
+	}{
+		"errors if the deployment can't be retrieved": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetDeployment(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: "get deployment d-ABCDEF: some error",
+		},
+		"returns the status without task sets before the replacement tasks are created": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetDeployment(&codedeploy.GetDeploymentInput{
+					DeploymentId: aws.String("d-ABCDEF"),
+				}).Return(&codedeploy.GetDeploymentOutput{
+					DeploymentInfo: &codedeploy.DeploymentInfo{
+						Status: aws.String("Created"),
+					},
+				}, nil)
+				m.EXPECT().ListDeploymentTargets(gomock.Any()).Return(&codedeploy.ListDeploymentTargetsOutput{}, nil)
+			},
+			wantedDeployment: &Deployment{
+				ID:     "d-ABCDEF",
+				Status: "Created",
+			},
+		},
+		"returns the traffic weight of each task set": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetDeployment(gomock.Any()).Return(&codedeploy.GetDeploymentOutput{
+					DeploymentInfo: &codedeploy.DeploymentInfo{
+						Status: aws.String("InProgress"),
+					},
+				}, nil)
+				m.EXPECT().ListDeploymentTargets(&codedeploy.ListDeploymentTargetsInput{
+					DeploymentId: aws.String("d-ABCDEF"),
+				}).Return(&codedeploy.ListDeploymentTargetsOutput{
+					TargetIds: aws.StringSlice([]string{"phonetool-test-Cluster:api"}),
+				}, nil)
+				m.EXPECT().GetDeploymentTarget(&codedeploy.GetDeploymentTargetInput{
+					DeploymentId: aws.String("d-ABCDEF"),
+					TargetId:     aws.String("phonetool-test-Cluster:api"),
+				}).Return(&codedeploy.GetDeploymentTargetOutput{
+					DeploymentTarget: &codedeploy.DeploymentTarget{
+						EcsTarget: &codedeploy.ECSTarget{
+							TaskSetsInfo: []*codedeploy.ECSTaskSet{
+								{
+									TaskSetLabel:  aws.String("Blue"),
+									TrafficWeight: aws.Float64(90),
+									DesiredCount:  aws.Int64(2),
+									RunningCount:  aws.Int64(2),
+								},
+								{
+									TaskSetLabel:  aws.String("Green"),
+									TrafficWeight: aws.Float64(10),
+									DesiredCount:  aws.Int64(2),
+									RunningCount:  aws.Int64(1),
+								},
+							},
+						},
+					},
+				}, nil)
+			},
+			wantedDeployment: &Deployment{
+				ID:     "d-ABCDEF",
+				Status: "InProgress",
+				TaskSets: []TaskSet{
+					{Label: TaskSetBlue, TrafficWeight: 90, DesiredCount: 2, RunningCount: 2},
+					{Label: TaskSetGreen, TrafficWeight: 10, DesiredCount: 2, RunningCount: 1},
+				},
+			},
+		},
+		"returns why the deployment failed": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetDeployment(gomock.Any()).Return(&codedeploy.GetDeploymentOutput{
+					DeploymentInfo: &codedeploy.DeploymentInfo{
+						Status: aws.String("Failed"),
+						ErrorInformation: &codedeploy.ErrorInformation{
+							Message: aws.String("The ECS service cannot be updated due to an error"),
+						},
+					},
+				}, nil)
+				m.EXPECT().ListDeploymentTargets(gomock.Any()).Return(&codedeploy.ListDeploymentTargetsOutput{}, nil)
+			},
+			wantedDeployment: &Deployment{
+				ID:           "d-ABCDEF",
+				Status:       "Failed",
+				ErrorMessage: "The ECS service cannot be updated due to an error",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.mockClient(m)
+			cd := CodeDeploy{client: m}
+
+			// WHEN
+			deployment, err := cd.Deployment("d-ABCDEF")
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedDeployment, deployment)
+		})
+	}
+}
@@ -19,11 +19,28 @@ import (
 
 const (
 	pipelineResourceType = "codepipeline:pipeline"
+
+	buildActionProvider = "CodeBuild"
+	executionsPageLimit = 100
+)
+
+// Statuses of a pipeline execution.
+const (
+	ExecutionStatusInProgress = cp.PipelineExecutionStatusInProgress
+	ExecutionStatusStopped    = cp.PipelineExecutionStatusStopped
+	ExecutionStatusStopping   = cp.PipelineExecutionStatusStopping
+	ExecutionStatusSucceeded  = cp.PipelineExecutionStatusSucceeded
+	ExecutionStatusSuperseded = cp.PipelineExecutionStatusSuperseded
+	ExecutionStatusFailed     = cp.PipelineExecutionStatusFailed
 )
 
 type api interface {
 	GetPipeline(*cp.GetPipelineInput) (*cp.GetPipelineOutput, error)
 	GetPipelineState(*cp.GetPipelineStateInput) (*cp.GetPipelineStateOutput, error)
+	ListPipelineExecutions(*cp.ListPipelineExecutionsInput) (*cp.ListPipelineExecutionsOutput, error)
+	ListActionExecutions(*cp.ListActionExecutionsInput) (*cp.ListActionExecutionsOutput, error)
+	PutApprovalResult(*cp.PutApprovalResultInput) (*cp.PutApprovalResultOutput, error)
+	RetryStageExecution(*cp.RetryStageExecutionInput) (*cp.RetryStageExecutionOutput, error)
 }
 
 type resourceGetter interface {
@@ -74,6 +91,31 @@ type StageAction struct {
 	Status string `json:"status"`
 }
 
+// Execution is a run of a pipeline, started by a change to its source or manually.
+type Execution struct {
+	ID        string     `json:"id"`
+	Status    string     `json:"status"`
+	Trigger   string     `json:"trigger"`
+	Revisions []Revision `json:"revisions,omitempty"`
+	StartedAt time.Time  `json:"startedAt"`
+	UpdatedAt time.Time  `json:"updatedAt"`
+}
+
+// Revision is the version of a source, such as a commit, that an execution runs on.
+type Revision struct {
+	ActionName string `json:"actionName"`
+	ID         string `json:"id"`
+	Summary    string `json:"summary,omitempty"`
+}
+
+// Approval is a manual approval action of a pipeline that's waiting for a response.
+type Approval struct {
+	PipelineName string `json:"pipelineName"`
+	StageName    string `json:"stageName"`
+	ActionName   string `json:"actionName"`
+	Token        string `json:"-"`
+}
+
 // AggregateStatus returns the collective status of a stage by looking at each individual action's status.
 // It returns "InProgress" if there are any actions that are in progress.
 // It returns "Failed" if there are actions that failed or were abandoned.
@@ -298,3 +340,174 @@ func fmtStatus(status string) string {
 		return status
 	}
 }
+
+// ListExecutions returns up to max of the most recent executions of a pipeline, the latest first.
+func (c *CodePipeline) ListExecutions(name string, max int) ([]*Execution, error) {
+	var executions []*Execution
+	var nextToken *string
+	for len(executions) < max {
+		resp, err := c.client.ListPipelineExecutions(&cp.ListPipelineExecutionsInput{
+			PipelineName: aws.String(name),
+			MaxResults:   aws.Int64(int64(executionsPageLimit)),
+			NextToken:    nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list executions of pipeline %s: %w", name, err)
+		}
+		for _, summary := range resp.PipelineExecutionSummaries {
+			if len(executions) == max {
+				break
+			}
+			executions = append(executions, newExecution(summary))
+		}
+		if resp.NextToken == nil {
+			break
+		}
+		nextToken = resp.NextToken
+	}
+	return executions, nil
+}
+
+func newExecution(summary *cp.PipelineExecutionSummary) *Execution {
+	var trigger string
+	if summary.Trigger != nil {
+		trigger = aws.StringValue(summary.Trigger.TriggerType)
+	}
+	var revisions []Revision
+	for _, revision := range summary.SourceRevisions {
+		revisions = append(revisions, Revision{
+			ActionName: aws.StringValue(revision.ActionName),
+			ID:         aws.StringValue(revision.RevisionId),
+			Summary:    aws.StringValue(revision.RevisionSummary),
+		})
+	}
+	return &Execution{
+		ID:        aws.StringValue(summary.PipelineExecutionId),
+		Status:    aws.StringValue(summary.Status),
+		Trigger:   trigger,
+		Revisions: revisions,
+		StartedAt: aws.TimeValue(summary.StartTime),
+		UpdatedAt: aws.TimeValue(summary.LastUpdateTime),
+	}
+}
+
+// PendingApprovals returns the manual approval actions of a pipeline that are waiting for a response.
+func (c *CodePipeline) PendingApprovals(name string) ([]*Approval, error) {
+	resp, err := c.client.GetPipelineState(&cp.GetPipelineStateInput{
+		Name: aws.String(name),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get pipeline state %s: %w", name, err)
+	}
+	var approvals []*Approval
+	for _, stage := range resp.StageStates {
+		for _, action := range stage.ActionStates {
+			execution := action.LatestExecution
+			// Only manual approval actions in progress have a token to respond with.
+			if execution == nil || aws.StringValue(execution.Status) != cp.ActionExecutionStatusInProgress || execution.Token == nil {
+				continue
+			}
+			approvals = append(approvals, &Approval{
+				PipelineName: name,
+				StageName:    aws.StringValue(stage.StageName),
+				ActionName:   aws.StringValue(action.ActionName),
+				Token:        aws.StringValue(execution.Token),
+			})
+		}
+	}
+	return approvals, nil
+}
+
+// Approve approves a pending manual approval action so that the pipeline moves on to its next action.
+func (c *CodePipeline) Approve(approval *Approval, summary string) error {
+	return c.putApprovalResult(approval, cp.ApprovalStatusApproved, summary)
+}
+
+// Reject rejects a pending manual approval action, which fails the stage of the action.
+func (c *CodePipeline) Reject(approval *Approval, summary string) error {
+	return c.putApprovalResult(approval, cp.ApprovalStatusRejected, summary)
+}
+
+func (c *CodePipeline) putApprovalResult(approval *Approval, status, summary string) error {
+	_, err := c.client.PutApprovalResult(&cp.PutApprovalResultInput{
+		PipelineName: aws.String(approval.PipelineName),
+		StageName:    aws.String(approval.StageName),
+		ActionName:   aws.String(approval.ActionName),
+		Token:        aws.String(approval.Token),
+		Result: &cp.ApprovalResult{
+			Status:  aws.String(status),
+			Summary: aws.String(summary),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("put approval result for action %s in stage %s of pipeline %s: %w", approval.ActionName, approval.StageName, approval.PipelineName, err)
+	}
+	return nil
+}
+
+// RetryFailedStage re-runs the failed actions of the latest execution of a stage, and returns the ID of the pipeline execution.
+func (c *CodePipeline) RetryFailedStage(pipelineName, stageName string) (string, error) {
+	resp, err := c.client.GetPipelineState(&cp.GetPipelineStateInput{
+		Name: aws.String(pipelineName),
+	})
+	if err != nil {
+		return "", fmt.Errorf("get pipeline state %s: %w", pipelineName, err)
+	}
+	var stage *cp.StageState
+	for _, s := range resp.StageStates {
+		if aws.StringValue(s.StageName) == stageName {
+			stage = s
+			break
+		}
+	}
+	if stage == nil {
+		return "", fmt.Errorf("stage %s does not exist in pipeline %s", stageName, pipelineName)
+	}
+	if stage.LatestExecution == nil || aws.StringValue(stage.LatestExecution.Status) != cp.StageExecutionStatusFailed {
+		return "", fmt.Errorf("stage %s of pipeline %s has not failed", stageName, pipelineName)
+	}
+	out, err := c.client.RetryStageExecution(&cp.RetryStageExecutionInput{
+		PipelineName:        aws.String(pipelineName),
+		StageName:           aws.String(stageName),
+		PipelineExecutionId: stage.LatestExecution.PipelineExecutionId,
+		RetryMode:           aws.String(cp.StageRetryModeFailedActions),
+	})
+	if err != nil {
+		return "", fmt.Errorf("retry stage %s of pipeline %s: %w", stageName, pipelineName, err)
+	}
+	return aws.StringValue(out.PipelineExecutionId), nil
+}
+
+// BuildIDs returns the IDs of the CodeBuild builds run by the actions of a pipeline execution.
+// The IDs are ordered from the most recent action to the oldest.
+func (c *CodePipeline) BuildIDs(pipelineName, executionID string) ([]string, error) {
+	var ids []string
+	var nextToken *string
+	for {
+		resp, err := c.client.ListActionExecutions(&cp.ListActionExecutionsInput{
+			PipelineName: aws.String(pipelineName),
+			Filter: &cp.ActionExecutionFilter{
+				PipelineExecutionId: aws.String(executionID),
+			},
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list action executions of execution %s in pipeline %s: %w", executionID, pipelineName, err)
+		}
+		for _, detail := range resp.ActionExecutionDetails {
+			if detail.Input == nil || detail.Input.ActionTypeId == nil || aws.StringValue(detail.Input.ActionTypeId.Provider) != buildActionProvider {
+				continue
+			}
+			// Actions that haven't started a build yet have no output.
+			if detail.Output == nil || detail.Output.ExecutionResult == nil || detail.Output.ExecutionResult.ExternalExecutionId == nil {
+				continue
+			}
+			ids = append(ids, aws.StringValue(detail.Output.ExecutionResult.ExternalExecutionId))
+		}
+		if resp.NextToken == nil {
+			break
+		}
+		nextToken = resp.NextToken
+	}
+	return ids, nil
+}
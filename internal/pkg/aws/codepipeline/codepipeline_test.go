@@ -505,3 +505,485 @@ func TestCodePipeline_GetPipelineState(t *testing.T) {
 		})
 	}
 }
+
+func TestCodePipeline_ListExecutions(t *testing.T) {
+	mockPipelineName := "pipeline-dinder-badgoose-repo"
+	mockTime := time.Now()
+	mockError := errors.New("mockError")
+
+	tests := map[string]struct {
+		inMax     int
+		callMocks func(m codepipelineMocks)
+
+		expectedOut   []*Execution
+		expectedError error
+	}{
+		"should wrap error from CodePipeline client": {
+			inMax: 5,
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().ListPipelineExecutions(gomock.Any()).Return(nil, mockError)
+			},
+			expectedError: fmt.Errorf("list executions of pipeline %s: %w", mockPipelineName, mockError),
+		},
+		"happy path": {
+			inMax: 5,
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().ListPipelineExecutions(&codepipeline.ListPipelineExecutionsInput{
+					PipelineName: aws.String(mockPipelineName),
+					MaxResults:   aws.Int64(100),
+				}).Return(&codepipeline.ListPipelineExecutionsOutput{
+					PipelineExecutionSummaries: []*codepipeline.PipelineExecutionSummary{
+						{
+							PipelineExecutionId: aws.String("execution-2"),
+							Status:              aws.String(codepipeline.PipelineExecutionStatusInProgress),
+							Trigger:             &codepipeline.ExecutionTrigger{TriggerType: aws.String("Webhook")},
+							SourceRevisions: []*codepipeline.SourceRevision{
+								{
+									ActionName:      aws.String("SourceCodeFor-dinder"),
+									RevisionId:      aws.String("d7f1a3c"),
+									RevisionSummary: aws.String("Fix the menu"),
+								},
+							},
+							StartTime:      &mockTime,
+							LastUpdateTime: &mockTime,
+						},
+						{
+							PipelineExecutionId: aws.String("execution-1"),
+							Status:              aws.String(codepipeline.PipelineExecutionStatusFailed),
+							StartTime:           &mockTime,
+							LastUpdateTime:      &mockTime,
+						},
+					},
+				}, nil)
+			},
+			expectedOut: []*Execution{
+				{
+					ID:      "execution-2",
+					Status:  "InProgress",
+					Trigger: "Webhook",
+					Revisions: []Revision{
+						{
+							ActionName: "SourceCodeFor-dinder",
+							ID:         "d7f1a3c",
+							Summary:    "Fix the menu",
+						},
+					},
+					StartedAt: mockTime,
+					UpdatedAt: mockTime,
+				},
+				{
+					ID:        "execution-1",
+					Status:    "Failed",
+					StartedAt: mockTime,
+					UpdatedAt: mockTime,
+				},
+			},
+		},
+		"should stop paging once max executions are listed": {
+			inMax: 2,
+			callMocks: func(m codepipelineMocks) {
+				gomock.InOrder(
+					m.cp.EXPECT().ListPipelineExecutions(&codepipeline.ListPipelineExecutionsInput{
+						PipelineName: aws.String(mockPipelineName),
+						MaxResults:   aws.Int64(100),
+					}).Return(&codepipeline.ListPipelineExecutionsOutput{
+						PipelineExecutionSummaries: []*codepipeline.PipelineExecutionSummary{
+							{PipelineExecutionId: aws.String("execution-3")},
+						},
+						NextToken: aws.String("token"),
+					}, nil),
+					m.cp.EXPECT().ListPipelineExecutions(&codepipeline.ListPipelineExecutionsInput{
+						PipelineName: aws.String(mockPipelineName),
+						MaxResults:   aws.Int64(100),
+						NextToken:    aws.String("token"),
+					}).Return(&codepipeline.ListPipelineExecutionsOutput{
+						PipelineExecutionSummaries: []*codepipeline.PipelineExecutionSummary{
+							{PipelineExecutionId: aws.String("execution-2")},
+							{PipelineExecutionId: aws.String("execution-1")},
+						},
+						NextToken: aws.String("token2"),
+					}, nil),
+				)
+			},
+			expectedOut: []*Execution{
+				{ID: "execution-3"},
+				{ID: "execution-2"},
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mocks.NewMockapi(ctrl)
+			tc.callMocks(codepipelineMocks{cp: mockClient})
+
+			cp := CodePipeline{
+				client: mockClient,
+			}
+
+			// WHEN
+			actualOut, err := cp.ListExecutions(mockPipelineName, tc.inMax)
+
+			// THEN
+			require.Equal(t, tc.expectedError, err)
+			require.Equal(t, tc.expectedOut, actualOut)
+		})
+	}
+}
+
+func TestCodePipeline_PendingApprovals(t *testing.T) {
+	mockPipelineName := "pipeline-dinder-badgoose-repo"
+	mockError := errors.New("mockError")
+
+	tests := map[string]struct {
+		callMocks func(m codepipelineMocks)
+
+		expectedOut   []*Approval
+		expectedError error
+	}{
+		"should wrap error from CodePipeline client": {
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().GetPipelineState(gomock.Any()).Return(nil, mockError)
+			},
+			expectedError: fmt.Errorf("get pipeline state %s: %w", mockPipelineName, mockError),
+		},
+		"should return the approval actions in progress": {
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().GetPipelineState(&codepipeline.GetPipelineStateInput{
+					Name: aws.String(mockPipelineName),
+				}).Return(&codepipeline.GetPipelineStateOutput{
+					StageStates: []*codepipeline.StageState{
+						{
+							StageName: aws.String("DeployTo-test"),
+							ActionStates: []*codepipeline.ActionState{
+								{
+									ActionName:      aws.String("CreateOrUpdate-test"),
+									LatestExecution: &codepipeline.ActionExecution{Status: aws.String(codepipeline.ActionExecutionStatusInProgress)},
+								},
+								{
+									ActionName: aws.String("ApprovePromotionTo-test"),
+									LatestExecution: &codepipeline.ActionExecution{
+										Status: aws.String(codepipeline.ActionExecutionStatusSucceeded),
+										Token:  aws.String("old-token"),
+									},
+								},
+							},
+						},
+						{
+							StageName: aws.String("DeployTo-prod"),
+							ActionStates: []*codepipeline.ActionState{
+								{
+									ActionName: aws.String("ApprovePromotionTo-prod"),
+									LatestExecution: &codepipeline.ActionExecution{
+										Status: aws.String(codepipeline.ActionExecutionStatusInProgress),
+										Token:  aws.String("token"),
+									},
+								},
+								{
+									ActionName: aws.String("CreateOrUpdate-prod"),
+								},
+							},
+						},
+					},
+				}, nil)
+			},
+			expectedOut: []*Approval{
+				{
+					PipelineName: mockPipelineName,
+					StageName:    "DeployTo-prod",
+					ActionName:   "ApprovePromotionTo-prod",
+					Token:        "token",
+				},
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mocks.NewMockapi(ctrl)
+			tc.callMocks(codepipelineMocks{cp: mockClient})
+
+			cp := CodePipeline{
+				client: mockClient,
+			}
+
+			// WHEN
+			actualOut, err := cp.PendingApprovals(mockPipelineName)
+
+			// THEN
+			require.Equal(t, tc.expectedError, err)
+			require.Equal(t, tc.expectedOut, actualOut)
+		})
+	}
+}
+
+func TestCodePipeline_Approve(t *testing.T) {
+	mockApproval := &Approval{
+		PipelineName: "pipeline-dinder-badgoose-repo",
+		StageName:    "DeployTo-prod",
+		ActionName:   "ApprovePromotionTo-prod",
+		Token:        "token",
+	}
+	mockError := errors.New("mockError")
+
+	tests := map[string]struct {
+		inApprove bool
+		callMocks func(m codepipelineMocks)
+
+		expectedError error
+	}{
+		"should approve the action": {
+			inApprove: true,
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().PutApprovalResult(&codepipeline.PutApprovalResultInput{
+					PipelineName: aws.String("pipeline-dinder-badgoose-repo"),
+					StageName:    aws.String("DeployTo-prod"),
+					ActionName:   aws.String("ApprovePromotionTo-prod"),
+					Token:        aws.String("token"),
+					Result: &codepipeline.ApprovalResult{
+						Status:  aws.String(codepipeline.ApprovalStatusApproved),
+						Summary: aws.String("LGTM"),
+					},
+				}).Return(&codepipeline.PutApprovalResultOutput{}, nil)
+			},
+		},
+		"should reject the action": {
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().PutApprovalResult(&codepipeline.PutApprovalResultInput{
+					PipelineName: aws.String("pipeline-dinder-badgoose-repo"),
+					StageName:    aws.String("DeployTo-prod"),
+					ActionName:   aws.String("ApprovePromotionTo-prod"),
+					Token:        aws.String("token"),
+					Result: &codepipeline.ApprovalResult{
+						Status:  aws.String(codepipeline.ApprovalStatusRejected),
+						Summary: aws.String("LGTM"),
+					},
+				}).Return(&codepipeline.PutApprovalResultOutput{}, nil)
+			},
+		},
+		"should wrap error from CodePipeline client": {
+			inApprove: true,
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().PutApprovalResult(gomock.Any()).Return(nil, mockError)
+			},
+			expectedError: fmt.Errorf("put approval result for action ApprovePromotionTo-prod in stage DeployTo-prod of pipeline pipeline-dinder-badgoose-repo: %w", mockError),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mocks.NewMockapi(ctrl)
+			tc.callMocks(codepipelineMocks{cp: mockClient})
+
+			cp := CodePipeline{
+				client: mockClient,
+			}
+
+			// WHEN
+			var err error
+			if tc.inApprove {
+				err = cp.Approve(mockApproval, "LGTM")
+			} else {
+				err = cp.Reject(mockApproval, "LGTM")
+			}
+
+			// THEN
+			require.Equal(t, tc.expectedError, err)
+		})
+	}
+}
+
+func TestCodePipeline_RetryFailedStage(t *testing.T) {
+	mockPipelineName := "pipeline-dinder-badgoose-repo"
+	mockError := errors.New("mockError")
+	mockState := func(status string) *codepipeline.GetPipelineStateOutput {
+		return &codepipeline.GetPipelineStateOutput{
+			StageStates: []*codepipeline.StageState{
+				{
+					StageName: aws.String("Source"),
+					LatestExecution: &codepipeline.StageExecution{
+						PipelineExecutionId: aws.String("execution-1"),
+						Status:              aws.String(codepipeline.StageExecutionStatusSucceeded),
+					},
+				},
+				{
+					StageName: aws.String("DeployTo-test"),
+					LatestExecution: &codepipeline.StageExecution{
+						PipelineExecutionId: aws.String("execution-1"),
+						Status:              aws.String(status),
+					},
+				},
+			},
+		}
+	}
+
+	tests := map[string]struct {
+		inStageName string
+		callMocks   func(m codepipelineMocks)
+
+		expectedOut   string
+		expectedError error
+	}{
+		"should wrap error from getting the pipeline state": {
+			inStageName: "DeployTo-test",
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().GetPipelineState(gomock.Any()).Return(nil, mockError)
+			},
+			expectedError: fmt.Errorf("get pipeline state %s: %w", mockPipelineName, mockError),
+		},
+		"should return an error if the stage doesn't exist": {
+			inStageName: "DeployTo-prod",
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().GetPipelineState(gomock.Any()).Return(mockState(codepipeline.StageExecutionStatusFailed), nil)
+			},
+			expectedError: fmt.Errorf("stage DeployTo-prod does not exist in pipeline %s", mockPipelineName),
+		},
+		"should return an error if the stage hasn't failed": {
+			inStageName: "DeployTo-test",
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().GetPipelineState(gomock.Any()).Return(mockState(codepipeline.StageExecutionStatusInProgress), nil)
+			},
+			expectedError: fmt.Errorf("stage DeployTo-test of pipeline %s has not failed", mockPipelineName),
+		},
+		"should wrap error from retrying the stage": {
+			inStageName: "DeployTo-test",
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().GetPipelineState(gomock.Any()).Return(mockState(codepipeline.StageExecutionStatusFailed), nil)
+				m.cp.EXPECT().RetryStageExecution(gomock.Any()).Return(nil, mockError)
+			},
+			expectedError: fmt.Errorf("retry stage DeployTo-test of pipeline %s: %w", mockPipelineName, mockError),
+		},
+		"happy path": {
+			inStageName: "DeployTo-test",
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().GetPipelineState(&codepipeline.GetPipelineStateInput{
+					Name: aws.String(mockPipelineName),
+				}).Return(mockState(codepipeline.StageExecutionStatusFailed), nil)
+				m.cp.EXPECT().RetryStageExecution(&codepipeline.RetryStageExecutionInput{
+					PipelineName:        aws.String(mockPipelineName),
+					StageName:           aws.String("DeployTo-test"),
+					PipelineExecutionId: aws.String("execution-1"),
+					RetryMode:           aws.String(codepipeline.StageRetryModeFailedActions),
+				}).Return(&codepipeline.RetryStageExecutionOutput{
+					PipelineExecutionId: aws.String("execution-1"),
+				}, nil)
+			},
+			expectedOut: "execution-1",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mocks.NewMockapi(ctrl)
+			tc.callMocks(codepipelineMocks{cp: mockClient})
+
+			cp := CodePipeline{
+				client: mockClient,
+			}
+
+			// WHEN
+			actualOut, err := cp.RetryFailedStage(mockPipelineName, tc.inStageName)
+
+			// THEN
+			require.Equal(t, tc.expectedError, err)
+			require.Equal(t, tc.expectedOut, actualOut)
+		})
+	}
+}
+
+func TestCodePipeline_BuildIDs(t *testing.T) {
+	mockPipelineName := "pipeline-dinder-badgoose-repo"
+	mockError := errors.New("mockError")
+	mockAction := func(provider, externalID string) *codepipeline.ActionExecutionDetail {
+		detail := &codepipeline.ActionExecutionDetail{
+			Input: &codepipeline.ActionExecutionInput{
+				ActionTypeId: &codepipeline.ActionTypeId{Provider: aws.String(provider)},
+			},
+		}
+		if externalID != "" {
+			detail.Output = &codepipeline.ActionExecutionOutput{
+				ExecutionResult: &codepipeline.ActionExecutionResult{ExternalExecutionId: aws.String(externalID)},
+			}
+		}
+		return detail
+	}
+
+	tests := map[string]struct {
+		callMocks func(m codepipelineMocks)
+
+		expectedOut   []string
+		expectedError error
+	}{
+		"should wrap error from CodePipeline client": {
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().ListActionExecutions(gomock.Any()).Return(nil, mockError)
+			},
+			expectedError: fmt.Errorf("list action executions of execution execution-1 in pipeline %s: %w", mockPipelineName, mockError),
+		},
+		"should return the IDs of the started builds across pages": {
+			callMocks: func(m codepipelineMocks) {
+				gomock.InOrder(
+					m.cp.EXPECT().ListActionExecutions(&codepipeline.ListActionExecutionsInput{
+						PipelineName: aws.String(mockPipelineName),
+						Filter: &codepipeline.ActionExecutionFilter{
+							PipelineExecutionId: aws.String("execution-1"),
+						},
+					}).Return(&codepipeline.ListActionExecutionsOutput{
+						ActionExecutionDetails: []*codepipeline.ActionExecutionDetail{
+							mockAction("CodeBuild", ""),
+							mockAction("CloudFormation", "arn:aws:cloudformation:us-west-2:1234567890:stack/dinder-test"),
+							mockAction("CodeBuild", "BuildProject:build-2"),
+						},
+						NextToken: aws.String("token"),
+					}, nil),
+					m.cp.EXPECT().ListActionExecutions(&codepipeline.ListActionExecutionsInput{
+						PipelineName: aws.String(mockPipelineName),
+						Filter: &codepipeline.ActionExecutionFilter{
+							PipelineExecutionId: aws.String("execution-1"),
+						},
+						NextToken: aws.String("token"),
+					}).Return(&codepipeline.ListActionExecutionsOutput{
+						ActionExecutionDetails: []*codepipeline.ActionExecutionDetail{
+							mockAction("CodeBuild", "BuildProject:build-1"),
+							mockAction("GitHub", "d7f1a3c"),
+						},
+					}, nil),
+				)
+			},
+			expectedOut: []string{"BuildProject:build-2", "BuildProject:build-1"},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mocks.NewMockapi(ctrl)
+			tc.callMocks(codepipelineMocks{cp: mockClient})
+
+			cp := CodePipeline{
+				client: mockClient,
+			}
+
+			// WHEN
+			actualOut, err := cp.BuildIDs(mockPipelineName, "execution-1")
+
+			// THEN
+			require.Equal(t, tc.expectedError, err)
+			require.Equal(t, tc.expectedOut, actualOut)
+		})
+	}
+}
@@ -64,6 +64,66 @@ func (mr *MockapiMockRecorder) GetPipelineState(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPipelineState", reflect.TypeOf((*Mockapi)(nil).GetPipelineState), arg0)
 }
 
+// ListPipelineExecutions mocks base method
+func (m *Mockapi) ListPipelineExecutions(arg0 *codepipeline.ListPipelineExecutionsInput) (*codepipeline.ListPipelineExecutionsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPipelineExecutions", arg0)
+	ret0, _ := ret[0].(*codepipeline.ListPipelineExecutionsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPipelineExecutions indicates an expected call of ListPipelineExecutions
+func (mr *MockapiMockRecorder) ListPipelineExecutions(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPipelineExecutions", reflect.TypeOf((*Mockapi)(nil).ListPipelineExecutions), arg0)
+}
+
+// ListActionExecutions mocks base method
+func (m *Mockapi) ListActionExecutions(arg0 *codepipeline.ListActionExecutionsInput) (*codepipeline.ListActionExecutionsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListActionExecutions", arg0)
+	ret0, _ := ret[0].(*codepipeline.ListActionExecutionsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListActionExecutions indicates an expected call of ListActionExecutions
+func (mr *MockapiMockRecorder) ListActionExecutions(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActionExecutions", reflect.TypeOf((*Mockapi)(nil).ListActionExecutions), arg0)
+}
+
+// PutApprovalResult mocks base method
+func (m *Mockapi) PutApprovalResult(arg0 *codepipeline.PutApprovalResultInput) (*codepipeline.PutApprovalResultOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutApprovalResult", arg0)
+	ret0, _ := ret[0].(*codepipeline.PutApprovalResultOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutApprovalResult indicates an expected call of PutApprovalResult
+func (mr *MockapiMockRecorder) PutApprovalResult(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutApprovalResult", reflect.TypeOf((*Mockapi)(nil).PutApprovalResult), arg0)
+}
+
+// RetryStageExecution mocks base method
+func (m *Mockapi) RetryStageExecution(arg0 *codepipeline.RetryStageExecutionInput) (*codepipeline.RetryStageExecutionOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetryStageExecution", arg0)
+	ret0, _ := ret[0].(*codepipeline.RetryStageExecutionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetryStageExecution indicates an expected call of RetryStageExecution
+func (mr *MockapiMockRecorder) RetryStageExecution(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryStageExecution", reflect.TypeOf((*Mockapi)(nil).RetryStageExecution), arg0)
+}
+
 // MockresourceGetter is a mock of resourceGetter interface
 type MockresourceGetter struct {
 	ctrl     *gomock.Controller
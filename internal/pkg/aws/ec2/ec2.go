@@ -5,29 +5,59 @@
 package ec2
 
 import (
+	"context"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/copilot-cli/internal/pkg/cache"
 )
 
 const (
 	defaultForAZFilterName = "default-for-az"
+	zoneTypeFilterName     = "zone-type"
+	optInStatusFilterName  = "opt-in-status"
+	tagKeyFilterName       = "tag-key"
+	instanceTypeFilterName = "instance-type"
+	prefixListNameFilter   = "prefix-list-name"
+	nameTagKey             = "Name"
+
+	zoneTypeAvailabilityZone = "availability-zone"
+
+	internetGatewayIDPrefix = "igw-"
+
+	vpcEndpointStateAvailable = "available"
+
+	securityGroupAllProtocols = "-1"
+
+	defaultRouteCIDR     = "0.0.0.0/0"
+	defaultIPv6RouteCIDR = "::/0"
+
+	dhcpDomainNameServersKey = "domain-name-servers"
+	amazonProvidedDNS        = "AmazonProvidedDNS"
+	noDHCPOptions            = "default"
 
 	// TagFilterName is the filter name format for tag filters
 	TagFilterName = "tag:%s"
+
+	// CloudFrontOriginFacingPrefixList is the name of the AWS-managed prefix list of the IP addresses
+	// that CloudFront uses to reach origins, such as a load balancer.
+	CloudFrontOriginFacingPrefixList = "com.amazonaws.global.cloudfront.origin-facing"
 )
 
 // ListVPCSubnetsOpts sets up optional parameters for ListVPCSubnets function.
-type ListVPCSubnetsOpts func([]*ec2.Subnet) []*ec2.Subnet
+type ListVPCSubnetsOpts func([]Subnet) []Subnet
 
 // FilterForPublicSubnets is used to filter to get public subnets.
 func FilterForPublicSubnets() ListVPCSubnetsOpts {
-	return func(subnets []*ec2.Subnet) []*ec2.Subnet {
-		var publicSubnets []*ec2.Subnet
+	return func(subnets []Subnet) []Subnet {
+		var publicSubnets []Subnet
 		for _, subnet := range subnets {
-			if aws.BoolValue(subnet.MapPublicIpOnLaunch) {
+			if subnet.IsPublic {
 				publicSubnets = append(publicSubnets, subnet)
 			}
 		}
@@ -37,10 +67,10 @@ func FilterForPublicSubnets() ListVPCSubnetsOpts {
 
 // FilterForPrivateSubnets is used to filter to get private subnets.
 func FilterForPrivateSubnets() ListVPCSubnetsOpts {
-	return func(subnets []*ec2.Subnet) []*ec2.Subnet {
-		var privateSubnets []*ec2.Subnet
+	return func(subnets []Subnet) []Subnet {
+		var privateSubnets []Subnet
 		for _, subnet := range subnets {
-			if !aws.BoolValue(subnet.MapPublicIpOnLaunch) {
+			if !subnet.IsPublic {
 				privateSubnets = append(privateSubnets, subnet)
 			}
 		}
@@ -54,12 +84,33 @@ var (
 		Name:   defaultForAZFilterName,
 		Values: []string{"true"},
 	}
+
+	// FilterForAvailabilityZoneType is a pre-defined filter that excludes Local Zones and Wavelength Zones.
+	FilterForAvailabilityZoneType = Filter{
+		Name:   zoneTypeFilterName,
+		Values: []string{zoneTypeAvailabilityZone},
+	}
+	// FilterForOptedInZones is a pre-defined filter for the zones that the account can launch resources in.
+	FilterForOptedInZones = Filter{
+		Name:   optInStatusFilterName,
+		Values: []string{ec2.AvailabilityZoneOptInStatusOptInNotRequired, ec2.AvailabilityZoneOptInStatusOptedIn},
+	}
 )
 
 type api interface {
-	DescribeSubnets(*ec2.DescribeSubnetsInput) (*ec2.DescribeSubnetsOutput, error)
-	DescribeSecurityGroups(*ec2.DescribeSecurityGroupsInput) (*ec2.DescribeSecurityGroupsOutput, error)
-	DescribeVpcs(input *ec2.DescribeVpcsInput) (*ec2.DescribeVpcsOutput, error)
+	DescribeSubnetsWithContext(aws.Context, *ec2.DescribeSubnetsInput, ...request.Option) (*ec2.DescribeSubnetsOutput, error)
+	DescribeSecurityGroupsWithContext(aws.Context, *ec2.DescribeSecurityGroupsInput, ...request.Option) (*ec2.DescribeSecurityGroupsOutput, error)
+	DescribeVpcsWithContext(aws.Context, *ec2.DescribeVpcsInput, ...request.Option) (*ec2.DescribeVpcsOutput, error)
+	DescribeNatGatewaysWithContext(aws.Context, *ec2.DescribeNatGatewaysInput, ...request.Option) (*ec2.DescribeNatGatewaysOutput, error)
+	DescribeRouteTablesWithContext(aws.Context, *ec2.DescribeRouteTablesInput, ...request.Option) (*ec2.DescribeRouteTablesOutput, error)
+	DescribeAvailabilityZonesWithContext(aws.Context, *ec2.DescribeAvailabilityZonesInput, ...request.Option) (*ec2.DescribeAvailabilityZonesOutput, error)
+	DescribeVpcEndpointsWithContext(aws.Context, *ec2.DescribeVpcEndpointsInput, ...request.Option) (*ec2.DescribeVpcEndpointsOutput, error)
+	DescribeAddressesWithContext(aws.Context, *ec2.DescribeAddressesInput, ...request.Option) (*ec2.DescribeAddressesOutput, error)
+	DescribeInstanceTypeOfferingsWithContext(aws.Context, *ec2.DescribeInstanceTypeOfferingsInput, ...request.Option) (*ec2.DescribeInstanceTypeOfferingsOutput, error)
+	DescribeManagedPrefixListsWithContext(aws.Context, *ec2.DescribeManagedPrefixListsInput, ...request.Option) (*ec2.DescribeManagedPrefixListsOutput, error)
+	DescribeVpcAttributeWithContext(aws.Context, *ec2.DescribeVpcAttributeInput, ...request.Option) (*ec2.DescribeVpcAttributeOutput, error)
+	ModifyVpcAttributeWithContext(aws.Context, *ec2.ModifyVpcAttributeInput, ...request.Option) (*ec2.ModifyVpcAttributeOutput, error)
+	DescribeDhcpOptionsWithContext(aws.Context, *ec2.DescribeDhcpOptionsInput, ...request.Option) (*ec2.DescribeDhcpOptionsOutput, error)
 }
 
 // Filter contains the name and values of a filter.
@@ -74,63 +125,165 @@ type Filter struct {
 // EC2 wraps an AWS EC2 client.
 type EC2 struct {
 	client api
+	cache  *cache.Cache
 }
 
 // New returns a EC2 configured against the input session.
-func New(s *session.Session) *EC2 {
+func New(s *session.Session, opts ...Option) *EC2 {
+	return &EC2{
+		client: newClient(s, opts...),
+	}
+}
+
+// NewWithCache returns a EC2 configured against the input session that caches the VPCs and subnets it lists.
+func NewWithCache(s *session.Session, c *cache.Cache, opts ...Option) *EC2 {
 	return &EC2{
-		client: ec2.New(s),
+		client: newClient(s, opts...),
+		cache:  c.ForSession(s),
 	}
 }
 
+func newClient(s *session.Session, opts ...Option) *ec2.EC2 {
+	client := ec2.New(s)
+	for _, opt := range opts {
+		opt(client)
+	}
+	return client
+}
+
 // ListVPC returns IDs of all VPCs.
-func (c *EC2) ListVPC() ([]string, error) {
-	var vpcs []*ec2.Vpc
-	response, err := c.client.DescribeVpcs(&ec2.DescribeVpcsInput{})
+func (c *EC2) ListVPC(ctx context.Context) ([]string, error) {
+	const cacheKey = "ec2/vpcs"
+	var vpcNames []string
+	if c.cache.Get(cacheKey, &vpcNames) {
+		return vpcNames, nil
+	}
+
+	vpcs, err := c.vpcs(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("describe VPCs: %w", err)
+		return nil, err
+	}
+	for _, vpc := range vpcs {
+		vpcNames = append(vpcNames, aws.StringValue(vpc.VpcId))
 	}
-	vpcs = append(vpcs, response.Vpcs...)
+	c.cache.Put(cacheKey, vpcNames)
+	return vpcNames, nil
+}
 
-	for response.NextToken != nil {
-		response, err = c.client.DescribeVpcs(&ec2.DescribeVpcsInput{
-			NextToken: response.NextToken,
-		})
+// VPC holds the details of a VPC that are shown to users.
+type VPC struct {
+	ID        string
+	Name      string // Value of the "Name" tag, if any.
+	CIDR      string
+	IsDefault bool // Whether it's the default VPC of the region.
+}
+
+// String returns the ID of the VPC along with its name and CIDR range, so that it can be used as a prompt option.
+func (v VPC) String() string {
+	out := v.ID
+	if v.Name != "" {
+		out = fmt.Sprintf("%s (%s)", v.ID, v.Name)
+	}
+	out = fmt.Sprintf("%s: %s", out, v.CIDR)
+	if v.IsDefault {
+		out += ", default"
+	}
+	return out
+}
+
+// FilterForTags returns a filter for the resources tagged with the key and any of the values.
+// Without values, the resources tagged with the key are matched regardless of their value.
+func FilterForTags(key string, values ...string) Filter {
+	if len(values) == 0 {
+		return Filter{
+			Name:   tagKeyFilterName,
+			Values: []string{key},
+		}
+	}
+	return Filter{
+		Name:   fmt.Sprintf(TagFilterName, key),
+		Values: values,
+	}
+}
+
+// ListVPCsDetailed lists the VPCs with optional filters, along with their names, CIDR ranges and whether they are the default VPC.
+func (c *EC2) ListVPCsDetailed(ctx context.Context, filters ...Filter) ([]VPC, error) {
+	// Only cache the unfiltered VPCs since the filters can't be part of the cache key.
+	const cacheKey = "ec2/vpcs/detailed"
+	var respVPCs []*ec2.Vpc
+	if len(filters) != 0 || !c.cache.Get(cacheKey, &respVPCs) {
+		var err error
+		respVPCs, err = c.vpcs(ctx, filters...)
 		if err != nil {
-			return nil, fmt.Errorf("describe VPCs: %w", err)
+			return nil, err
+		}
+		if len(filters) == 0 {
+			c.cache.Put(cacheKey, respVPCs)
 		}
-		vpcs = append(vpcs, response.Vpcs...)
 	}
-	var vpcNames []string
-	for _, vpc := range vpcs {
-		vpcNames = append(vpcNames, aws.StringValue(vpc.VpcId))
+	vpcs := make([]VPC, len(respVPCs))
+	for idx, vpc := range respVPCs {
+		vpcs[idx] = VPC{
+			ID:        aws.StringValue(vpc.VpcId),
+			CIDR:      aws.StringValue(vpc.CidrBlock),
+			IsDefault: aws.BoolValue(vpc.IsDefault),
+		}
+		for _, tag := range vpc.Tags {
+			if aws.StringValue(tag.Key) == nameTagKey {
+				vpcs[idx].Name = aws.StringValue(tag.Value)
+			}
+		}
 	}
+	return vpcs, nil
+}
 
-	return vpcNames, nil
+// Subnet holds the details of a subnet that are shown to users.
+type Subnet struct {
+	ID       string
+	AZ       string
+	CIDR     string
+	Name     string // Value of the "Name" tag, if any.
+	IsPublic bool   // Whether the route table of the subnet routes traffic to an internet gateway.
+}
+
+// String returns the ID of the subnet along with its name, availability zone and CIDR range, so that it can be used as a prompt option.
+func (s Subnet) String() string {
+	if s.Name == "" {
+		return fmt.Sprintf("%s: %s, %s", s.ID, s.AZ, s.CIDR)
+	}
+	return fmt.Sprintf("%s (%s): %s, %s", s.ID, s.Name, s.AZ, s.CIDR)
 }
 
 // ListVPCSubnets lists all subnets given a VPC ID.
-func (c *EC2) ListVPCSubnets(vpcID string, opts ...ListVPCSubnetsOpts) ([]string, error) {
-	respSubnets, err := c.subnets(Filter{
-		Name:   "vpc-id",
-		Values: []string{vpcID},
-	})
+func (c *EC2) ListVPCSubnets(ctx context.Context, vpcID string, opts ...ListVPCSubnetsOpts) ([]string, error) {
+	respSubnets, err := c.vpcSubnets(ctx, vpcID, opts...)
 	if err != nil {
 		return nil, err
 	}
-	for _, opt := range opts {
-		respSubnets = opt(respSubnets)
-	}
 	var subnets []string
 	for _, subnet := range respSubnets {
-		subnets = append(subnets, aws.StringValue(subnet.SubnetId))
+		subnets = append(subnets, subnet.ID)
 	}
 	return subnets, nil
 }
 
+// ListVPCSubnetsDetailed lists all subnets given a VPC ID, along with their availability zones, CIDR ranges and names.
+func (c *EC2) ListVPCSubnetsDetailed(ctx context.Context, vpcID string, opts ...ListVPCSubnetsOpts) ([]Subnet, error) {
+	return c.vpcSubnets(ctx, vpcID, opts...)
+}
+
+// SubnetsDetailed finds the subnets with optional filters, along with their availability zones, CIDR ranges and names.
+func (c *EC2) SubnetsDetailed(ctx context.Context, filters ...Filter) ([]Subnet, error) {
+	subnets, err := c.subnets(ctx, filters...)
+	if err != nil {
+		return nil, err
+	}
+	return c.toSubnets(ctx, subnets)
+}
+
 // SubnetIDs finds the subnet IDs with optional filters.
-func (c *EC2) SubnetIDs(filters ...Filter) ([]string, error) {
-	subnets, err := c.subnets(filters...)
+func (c *EC2) SubnetIDs(ctx context.Context, filters ...Filter) ([]string, error) {
+	subnets, err := c.subnets(ctx, filters...)
 	if err != nil {
 		return nil, err
 	}
@@ -143,44 +296,572 @@ func (c *EC2) SubnetIDs(filters ...Filter) ([]string, error) {
 }
 
 // PublicSubnetIDs finds the public subnet IDs with optional filters.
-func (c *EC2) PublicSubnetIDs(filters ...Filter) ([]string, error) {
-	subnets, err := c.subnets(filters...)
+func (c *EC2) PublicSubnetIDs(ctx context.Context, filters ...Filter) ([]string, error) {
+	respSubnets, err := c.subnets(ctx, filters...)
+	if err != nil {
+		return nil, err
+	}
+	subnets, err := c.toSubnets(ctx, respSubnets)
 	if err != nil {
 		return nil, err
 	}
 
 	var subnetIDs []string
 	for _, subnet := range subnets {
-		if aws.BoolValue(subnet.MapPublicIpOnLaunch) {
-			subnetIDs = append(subnetIDs, aws.StringValue(subnet.SubnetId))
+		if subnet.IsPublic {
+			subnetIDs = append(subnetIDs, subnet.ID)
 		}
 	}
 	return subnetIDs, nil
 }
 
-// SecurityGroups finds the security group IDs with optional filters.
-func (c *EC2) SecurityGroups(filters ...Filter) ([]string, error) {
-	inputFilters := toEC2Filter(filters)
-
-	response, err := c.client.DescribeSecurityGroups(&ec2.DescribeSecurityGroupsInput{
-		Filters: inputFilters,
+// SubnetsAvailableIPs returns the number of available IPv4 addresses in each subnet, keyed by subnet ID.
+// The counts aren't cached since they change as tasks start and stop.
+func (c *EC2) SubnetsAvailableIPs(ctx context.Context, subnetIDs ...string) (map[string]int, error) {
+	subnets, err := c.subnets(ctx, Filter{
+		Name:   "subnet-id",
+		Values: subnetIDs,
 	})
+	if err != nil {
+		return nil, err
+	}
+	ips := make(map[string]int, len(subnets))
+	for _, subnet := range subnets {
+		ips[aws.StringValue(subnet.SubnetId)] = int(aws.Int64Value(subnet.AvailableIpAddressCount))
+	}
+	return ips, nil
+}
+
+// SecurityGroup holds the identifiers of a security group that are shown to users.
+type SecurityGroup struct {
+	ID          string
+	Name        string
+	Description string
+}
 
+// String returns the ID of the security group along with its name and description, so that it can be used as a prompt option.
+func (sg SecurityGroup) String() string {
+	if sg.Description == "" {
+		return fmt.Sprintf("%s (%s)", sg.ID, sg.Name)
+	}
+	return fmt.Sprintf("%s (%s): %s", sg.ID, sg.Name, sg.Description)
+}
+
+// SecurityGroups finds the security group IDs with optional filters.
+func (c *EC2) SecurityGroups(ctx context.Context, filters ...Filter) ([]string, error) {
+	groups, err := c.securityGroups(ctx, filters...)
 	if err != nil {
-		return nil, fmt.Errorf("describe security groups: %w", err)
+		return nil, err
 	}
 
-	securityGroups := make([]string, len(response.SecurityGroups))
-	for idx, sg := range response.SecurityGroups {
+	securityGroups := make([]string, len(groups))
+	for idx, sg := range groups {
 		securityGroups[idx] = aws.StringValue(sg.GroupId)
 	}
 	return securityGroups, nil
 }
 
-func (c *EC2) subnets(filters ...Filter) ([]*ec2.Subnet, error) {
+// DescribeSecurityGroups finds the security groups with optional filters, along with their names and descriptions.
+func (c *EC2) DescribeSecurityGroups(ctx context.Context, filters ...Filter) ([]SecurityGroup, error) {
+	groups, err := c.securityGroups(ctx, filters...)
+	if err != nil {
+		return nil, err
+	}
+
+	securityGroups := make([]SecurityGroup, len(groups))
+	for idx, sg := range groups {
+		securityGroups[idx] = SecurityGroup{
+			ID:          aws.StringValue(sg.GroupId),
+			Name:        aws.StringValue(sg.GroupName),
+			Description: aws.StringValue(sg.Description),
+		}
+	}
+	return securityGroups, nil
+}
+
+// SecurityGroupRule is an inbound or outbound rule of a security group.
+type SecurityGroupRule struct {
+	Protocol      string   // Such as "tcp", or "-1" for all protocols.
+	FromPort      int64    // First port of the range, or -1 for all ports.
+	ToPort        int64    // Last port of the range, or -1 for all ports.
+	CIDRs         []string // IPv4 and IPv6 ranges that the rule allows.
+	GroupIDs      []string // Security groups that the rule allows.
+	PrefixListIDs []string // Prefix lists, such as the ones of VPC endpoints, that the rule allows.
+}
+
+// Ports returns the ports of the rule, such as "80", "8000-8080" or "all".
+func (r SecurityGroupRule) Ports() string {
+	if r.Protocol == securityGroupAllProtocols || r.FromPort == -1 {
+		return "all"
+	}
+	if r.FromPort == r.ToPort {
+		return strconv.FormatInt(r.FromPort, 10)
+	}
+	return fmt.Sprintf("%d-%d", r.FromPort, r.ToPort)
+}
+
+// allowsPort returns true if the rule allows TCP traffic to the port.
+func (r SecurityGroupRule) allowsPort(port int64) bool {
+	if r.Protocol == securityGroupAllProtocols {
+		return true
+	}
+	return r.Protocol == "tcp" && r.FromPort <= port && port <= r.ToPort
+}
+
+// SecurityGroupRules holds the inbound and outbound rules of a security group.
+type SecurityGroupRules struct {
+	GroupID string
+	Ingress []SecurityGroupRule
+	Egress  []SecurityGroupRule
+}
+
+// AllowsIngressFrom returns true if the security group accepts TCP traffic to the port from the source security group,
+// such as the security group of a load balancer.
+func (r *SecurityGroupRules) AllowsIngressFrom(sourceGroupID string, port int64) bool {
+	for _, rule := range r.Ingress {
+		if rule.allowsPort(port) && contains(sourceGroupID, rule.GroupIDs) {
+			return true
+		}
+	}
+	return false
+}
+
+// SecurityGroupRules returns the inbound and outbound rules of the security group.
+func (c *EC2) SecurityGroupRules(ctx context.Context, groupID string) (*SecurityGroupRules, error) {
+	resp, err := c.client.DescribeSecurityGroupsWithContext(ctx, &ec2.DescribeSecurityGroupsInput{
+		GroupIds: aws.StringSlice([]string{groupID}),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe security group %s: %w", groupID, err)
+	}
+	if len(resp.SecurityGroups) == 0 {
+		return nil, fmt.Errorf("security group %s not found", groupID)
+	}
+	sg := resp.SecurityGroups[0]
+	return &SecurityGroupRules{
+		GroupID: groupID,
+		Ingress: toSecurityGroupRules(sg.IpPermissions),
+		Egress:  toSecurityGroupRules(sg.IpPermissionsEgress),
+	}, nil
+}
+
+// ListAvailabilityZones returns the names of the available zones of the region with optional filters.
+func (c *EC2) ListAvailabilityZones(ctx context.Context, filters ...Filter) ([]string, error) {
+	in := &ec2.DescribeAvailabilityZonesInput{
+		Filters: toEC2Filter(append([]Filter{
+			{
+				Name:   "state",
+				Values: []string{ec2.AvailabilityZoneStateAvailable},
+			},
+		}, filters...)),
+	}
+	response, err := c.client.DescribeAvailabilityZonesWithContext(ctx, in)
+	if err != nil {
+		return nil, fmt.Errorf("describe availability zones: %w", err)
+	}
+	zones := make([]string, len(response.AvailabilityZones))
+	for idx, zone := range response.AvailabilityZones {
+		zones[idx] = aws.StringValue(zone.ZoneName)
+	}
+	return zones, nil
+}
+
+// InstanceTypeOfferings returns the names of the availability zones of the region that offer the EC2 instance type.
+func (c *EC2) InstanceTypeOfferings(ctx context.Context, instanceType string) ([]string, error) {
+	in := &ec2.DescribeInstanceTypeOfferingsInput{
+		LocationType: aws.String(ec2.LocationTypeAvailabilityZone),
+		Filters: toEC2Filter([]Filter{
+			{
+				Name:   instanceTypeFilterName,
+				Values: []string{instanceType},
+			},
+		}),
+	}
+	var zones []string
+	for {
+		response, err := c.client.DescribeInstanceTypeOfferingsWithContext(ctx, in)
+		if err != nil {
+			return nil, fmt.Errorf("describe offerings of instance type %s: %w", instanceType, err)
+		}
+		for _, offering := range response.InstanceTypeOfferings {
+			zones = append(zones, aws.StringValue(offering.Location))
+		}
+		if response.NextToken == nil {
+			break
+		}
+		in.NextToken = response.NextToken
+	}
+	return zones, nil
+}
+
+// ValidateInstanceTypeOffered returns an *ErrInstanceTypeNotOffered if the EC2 instance type isn't offered
+// in some of the availability zones, so that capacity can't be launched in all the subnets of an environment.
+func (c *EC2) ValidateInstanceTypeOffered(ctx context.Context, instanceType string, zones ...string) error {
+	offered, err := c.InstanceTypeOfferings(ctx, instanceType)
+	if err != nil {
+		return err
+	}
+	var missing []string
+	for _, zone := range zones {
+		if !contains(zone, offered) {
+			missing = append(missing, zone)
+		}
+	}
+	if len(missing) != 0 {
+		return &ErrInstanceTypeNotOffered{
+			InstanceType: instanceType,
+			Zones:        missing,
+		}
+	}
+	return nil
+}
+
+// ManagedPrefixListID returns the ID of the managed prefix list in the region, such as CloudFrontOriginFacingPrefixList.
+// The IDs of the prefix lists managed by AWS differ between regions.
+func (c *EC2) ManagedPrefixListID(ctx context.Context, name string) (string, error) {
+	response, err := c.client.DescribeManagedPrefixListsWithContext(ctx, &ec2.DescribeManagedPrefixListsInput{
+		Filters: toEC2Filter([]Filter{
+			{
+				Name:   prefixListNameFilter,
+				Values: []string{name},
+			},
+		}),
+	})
+	if err != nil {
+		return "", fmt.Errorf("describe managed prefix list %s: %w", name, err)
+	}
+	if len(response.PrefixLists) == 0 {
+		return "", &ErrPrefixListNotFound{Name: name}
+	}
+	return aws.StringValue(response.PrefixLists[0].PrefixListId), nil
+}
+
+// RequiredVPCEndpointServices are the services that tasks in subnets without internet access need VPC endpoints for,
+// to pull images from ECR, write logs to CloudWatch Logs and read secrets from SSM Parameter Store.
+var RequiredVPCEndpointServices = []string{"ecr.api", "ecr.dkr", "s3", "logs", "ssm"}
+
+// VPCEndpoint holds the details of a VPC endpoint.
+type VPCEndpoint struct {
+	ID          string
+	ServiceName string // For example, "com.amazonaws.us-west-2.ecr.api".
+	Type        string // Either "Interface", "Gateway" or "GatewayLoadBalancer".
+}
+
+// ListVPCEndpoints returns the available VPC endpoints of a VPC.
+func (c *EC2) ListVPCEndpoints(ctx context.Context, vpcID string) ([]VPCEndpoint, error) {
+	in := &ec2.DescribeVpcEndpointsInput{
+		Filters: toEC2Filter([]Filter{
+			{
+				Name:   "vpc-id",
+				Values: []string{vpcID},
+			},
+			{
+				Name:   "vpc-endpoint-state",
+				Values: []string{vpcEndpointStateAvailable},
+			},
+		}),
+	}
+	var endpoints []VPCEndpoint
+	for {
+		response, err := c.client.DescribeVpcEndpointsWithContext(ctx, in)
+		if err != nil {
+			return nil, fmt.Errorf("describe VPC endpoints in VPC %s: %w", vpcID, err)
+		}
+		for _, endpoint := range response.VpcEndpoints {
+			endpoints = append(endpoints, VPCEndpoint{
+				ID:          aws.StringValue(endpoint.VpcEndpointId),
+				ServiceName: aws.StringValue(endpoint.ServiceName),
+				Type:        aws.StringValue(endpoint.VpcEndpointType),
+			})
+		}
+		if response.NextToken == nil {
+			break
+		}
+		in.NextToken = response.NextToken
+	}
+	return endpoints, nil
+}
+
+// HasRequiredEndpoints returns true if there is an endpoint for each of the RequiredVPCEndpointServices.
+// Otherwise, it also returns the services whose endpoints are missing.
+func HasRequiredEndpoints(endpoints []VPCEndpoint) (bool, []string) {
+	var missing []string
+	for _, service := range RequiredVPCEndpointServices {
+		found := false
+		for _, endpoint := range endpoints {
+			// Match the suffix of the service name since its prefix depends on the partition and region.
+			if strings.HasSuffix(endpoint.ServiceName, "."+service) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, service)
+		}
+	}
+	return len(missing) == 0, missing
+}
+
+// EgressIPs returns the public IP addresses of the available NAT gateways in a VPC.
+func (c *EC2) EgressIPs(ctx context.Context, vpcID string) ([]string, error) {
+	gateways, err := c.ListNATGateways(ctx, vpcID)
+	if err != nil {
+		return nil, err
+	}
+	var ips []string
+	for _, gateway := range gateways {
+		ips = append(ips, gateway.PublicIPs...)
+	}
+	return ips, nil
+}
+
+// NATGateway holds the details of an available NAT gateway.
+type NATGateway struct {
+	ID        string
+	SubnetID  string   // Public subnet where the NAT gateway is placed.
+	PublicIPs []string // Elastic IP addresses of the NAT gateway.
+}
+
+// ListNATGateways returns the available NAT gateways of the VPC.
+func (c *EC2) ListNATGateways(ctx context.Context, vpcID string) ([]NATGateway, error) {
+	in := &ec2.DescribeNatGatewaysInput{
+		Filter: toEC2Filter([]Filter{
+			{
+				Name:   "vpc-id",
+				Values: []string{vpcID},
+			},
+			{
+				Name:   "state",
+				Values: []string{ec2.NatGatewayStateAvailable},
+			},
+		}),
+	}
+	var gateways []NATGateway
+	for {
+		response, err := c.client.DescribeNatGatewaysWithContext(ctx, in)
+		if err != nil {
+			return nil, fmt.Errorf("describe NAT gateways in VPC %s: %w", vpcID, err)
+		}
+		for _, gateway := range response.NatGateways {
+			g := NATGateway{
+				ID:       aws.StringValue(gateway.NatGatewayId),
+				SubnetID: aws.StringValue(gateway.SubnetId),
+			}
+			for _, addr := range gateway.NatGatewayAddresses {
+				if addr.PublicIp == nil {
+					continue
+				}
+				g.PublicIPs = append(g.PublicIPs, aws.StringValue(addr.PublicIp))
+			}
+			gateways = append(gateways, g)
+		}
+		if response.NextToken == nil {
+			break
+		}
+		in.NextToken = response.NextToken
+	}
+	return gateways, nil
+}
+
+// ElasticIP holds the details of an Elastic IP address of the account.
+type ElasticIP struct {
+	AllocationID  string
+	PublicIP      string
+	AssociationID string // Empty if the address isn't associated with a network interface.
+}
+
+// ListElasticIPs returns the Elastic IP addresses of the region that can be used in a VPC.
+func (c *EC2) ListElasticIPs(ctx context.Context) ([]ElasticIP, error) {
+	response, err := c.client.DescribeAddressesWithContext(ctx, &ec2.DescribeAddressesInput{
+		Filters: toEC2Filter([]Filter{
+			{
+				Name:   "domain",
+				Values: []string{ec2.DomainTypeVpc},
+			},
+		}),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe Elastic IP addresses: %w", err)
+	}
+	var ips []ElasticIP
+	for _, addr := range response.Addresses {
+		ips = append(ips, ElasticIP{
+			AllocationID:  aws.StringValue(addr.AllocationId),
+			PublicIP:      aws.StringValue(addr.PublicIp),
+			AssociationID: aws.StringValue(addr.AssociationId),
+		})
+	}
+	return ips, nil
+}
+
+// SubnetsWithoutEgress returns the IDs of the subnets whose route table has no default route to the internet,
+// such as private subnets without a route to a NAT gateway. Tasks in those subnets can only reach AWS services
+// through VPC endpoints.
+func (c *EC2) SubnetsWithoutEgress(ctx context.Context, subnetIDs ...string) ([]string, error) {
+	if len(subnetIDs) == 0 {
+		return nil, nil
+	}
+	subnets, err := c.subnets(ctx, Filter{
+		Name:   "subnet-id",
+		Values: subnetIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	tables, err := c.subnetRouteTables(ctx, subnets)
+	if err != nil {
+		return nil, err
+	}
+	var ids []string
+	for _, subnet := range subnets {
+		id := aws.StringValue(subnet.SubnetId)
+		if table, ok := tables[id]; !ok || !routesToInternet(table) {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+// VPCDNSAttributes holds the DNS attributes of a VPC that service discovery relies on.
+type VPCDNSAttributes struct {
+	DNSSupport   bool // Whether the Amazon provided DNS server resolves queries in the VPC.
+	DNSHostnames bool // Whether instances in the VPC get DNS hostnames.
+}
+
+// Enabled returns true if both DNS attributes are enabled.
+func (a VPCDNSAttributes) Enabled() bool {
+	return a.DNSSupport && a.DNSHostnames
+}
+
+// VPCDNSAttributes returns whether DNS support and DNS hostnames are enabled in the VPC.
+func (c *EC2) VPCDNSAttributes(ctx context.Context, vpcID string) (*VPCDNSAttributes, error) {
+	support, err := c.client.DescribeVpcAttributeWithContext(ctx, &ec2.DescribeVpcAttributeInput{
+		VpcId:     aws.String(vpcID),
+		Attribute: aws.String(ec2.VpcAttributeNameEnableDnsSupport),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe DNS support attribute of VPC %s: %w", vpcID, err)
+	}
+	hostnames, err := c.client.DescribeVpcAttributeWithContext(ctx, &ec2.DescribeVpcAttributeInput{
+		VpcId:     aws.String(vpcID),
+		Attribute: aws.String(ec2.VpcAttributeNameEnableDnsHostnames),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe DNS hostnames attribute of VPC %s: %w", vpcID, err)
+	}
+	return &VPCDNSAttributes{
+		DNSSupport:   support.EnableDnsSupport != nil && aws.BoolValue(support.EnableDnsSupport.Value),
+		DNSHostnames: hostnames.EnableDnsHostnames != nil && aws.BoolValue(hostnames.EnableDnsHostnames.Value),
+	}, nil
+}
+
+// EnableVPCDNS enables DNS support and DNS hostnames in the VPC.
+// DNS support is enabled first since DNS hostnames can't be enabled without it.
+func (c *EC2) EnableVPCDNS(ctx context.Context, vpcID string) error {
+	if _, err := c.client.ModifyVpcAttributeWithContext(ctx, &ec2.ModifyVpcAttributeInput{
+		VpcId:            aws.String(vpcID),
+		EnableDnsSupport: &ec2.AttributeBooleanValue{Value: aws.Bool(true)},
+	}); err != nil {
+		return fmt.Errorf("enable DNS support in VPC %s: %w", vpcID, err)
+	}
+	if _, err := c.client.ModifyVpcAttributeWithContext(ctx, &ec2.ModifyVpcAttributeInput{
+		VpcId:              aws.String(vpcID),
+		EnableDnsHostnames: &ec2.AttributeBooleanValue{Value: aws.Bool(true)},
+	}); err != nil {
+		return fmt.Errorf("enable DNS hostnames in VPC %s: %w", vpcID, err)
+	}
+	return nil
+}
+
+// UsesAmazonProvidedDNS returns true if the DHCP options set of the VPC points instances to the Amazon provided DNS server,
+// which resolves the private DNS names of service discovery.
+func (c *EC2) UsesAmazonProvidedDNS(ctx context.Context, vpcID string) (bool, error) {
+	vpcs, err := c.vpcs(ctx, Filter{
+		Name:   "vpc-id",
+		Values: []string{vpcID},
+	})
+	if err != nil {
+		return false, err
+	}
+	if len(vpcs) == 0 {
+		return false, fmt.Errorf("VPC %s not found", vpcID)
+	}
+	optionsID := aws.StringValue(vpcs[0].DhcpOptionsId)
+	if optionsID == noDHCPOptions {
+		// Instances of a VPC without a DHCP options set aren't given any DNS server.
+		return false, nil
+	}
+	out, err := c.client.DescribeDhcpOptionsWithContext(ctx, &ec2.DescribeDhcpOptionsInput{
+		DhcpOptionsIds: aws.StringSlice([]string{optionsID}),
+	})
+	if err != nil {
+		return false, fmt.Errorf("describe DHCP options %s of VPC %s: %w", optionsID, vpcID, err)
+	}
+	for _, options := range out.DhcpOptions {
+		for _, conf := range options.DhcpConfigurations {
+			if aws.StringValue(conf.Key) != dhcpDomainNameServersKey {
+				continue
+			}
+			for _, server := range conf.Values {
+				if aws.StringValue(server.Value) == amazonProvidedDNS {
+					return true, nil
+				}
+			}
+			return false, nil
+		}
+	}
+	// Without domain name servers, instances fall back to the Amazon provided DNS server.
+	return true, nil
+}
+
+func (c *EC2) vpcSubnets(ctx context.Context, vpcID string, opts ...ListVPCSubnetsOpts) ([]Subnet, error) {
+	// Cache the unfiltered subnets since the options can't be part of the cache key.
+	cacheKey := fmt.Sprintf("ec2/vpcs/%s/subnets", vpcID)
+	var respSubnets []*ec2.Subnet
+	if !c.cache.Get(cacheKey, &respSubnets) {
+		var err error
+		respSubnets, err = c.subnets(ctx, Filter{
+			Name:   "vpc-id",
+			Values: []string{vpcID},
+		})
+		if err != nil {
+			return nil, err
+		}
+		c.cache.Put(cacheKey, respSubnets)
+	}
+	subnets, err := c.toSubnets(ctx, respSubnets)
+	if err != nil {
+		return nil, err
+	}
+	for _, opt := range opts {
+		subnets = opt(subnets)
+	}
+	return subnets, nil
+}
+
+func (c *EC2) vpcs(ctx context.Context, filters ...Filter) ([]*ec2.Vpc, error) {
+	in := &ec2.DescribeVpcsInput{
+		Filters: toEC2Filter(filters),
+	}
+	var vpcs []*ec2.Vpc
+	for {
+		response, err := c.client.DescribeVpcsWithContext(ctx, in)
+		if err != nil {
+			return nil, fmt.Errorf("describe VPCs: %w", err)
+		}
+		vpcs = append(vpcs, response.Vpcs...)
+		if response.NextToken == nil {
+			break
+		}
+		in.NextToken = response.NextToken
+	}
+	return vpcs, nil
+}
+
+func (c *EC2) subnets(ctx context.Context, filters ...Filter) ([]*ec2.Subnet, error) {
 	inputFilters := toEC2Filter(filters)
 	var subnets []*ec2.Subnet
-	response, err := c.client.DescribeSubnets(&ec2.DescribeSubnetsInput{
+	response, err := c.client.DescribeSubnetsWithContext(ctx, &ec2.DescribeSubnetsInput{
 		Filters: inputFilters,
 	})
 	if err != nil {
@@ -189,7 +870,7 @@ func (c *EC2) subnets(filters ...Filter) ([]*ec2.Subnet, error) {
 	subnets = append(subnets, response.Subnets...)
 
 	for response.NextToken != nil {
-		response, err = c.client.DescribeSubnets(&ec2.DescribeSubnetsInput{
+		response, err = c.client.DescribeSubnetsWithContext(ctx, &ec2.DescribeSubnetsInput{
 			Filters:   inputFilters,
 			NextToken: response.NextToken,
 		})
@@ -202,6 +883,208 @@ func (c *EC2) subnets(filters ...Filter) ([]*ec2.Subnet, error) {
 	return subnets, nil
 }
 
+func (c *EC2) securityGroups(ctx context.Context, filters ...Filter) ([]*ec2.SecurityGroup, error) {
+	inputFilters := toEC2Filter(filters)
+	var securityGroups []*ec2.SecurityGroup
+	response, err := c.client.DescribeSecurityGroupsWithContext(ctx, &ec2.DescribeSecurityGroupsInput{
+		Filters: inputFilters,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe security groups: %w", err)
+	}
+	securityGroups = append(securityGroups, response.SecurityGroups...)
+
+	for response.NextToken != nil {
+		response, err = c.client.DescribeSecurityGroupsWithContext(ctx, &ec2.DescribeSecurityGroupsInput{
+			Filters:   inputFilters,
+			NextToken: response.NextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("describe security groups: %w", err)
+		}
+		securityGroups = append(securityGroups, response.SecurityGroups...)
+	}
+
+	return securityGroups, nil
+}
+
+// routeTables returns the route tables of the VPCs, they're cached since the public and private subnets of a VPC
+// are usually listed one after the other.
+func (c *EC2) routeTables(ctx context.Context, vpcIDs []string) ([]*ec2.RouteTable, error) {
+	cacheKey := fmt.Sprintf("ec2/vpcs/%s/route-tables", strings.Join(vpcIDs, ","))
+	var routeTables []*ec2.RouteTable
+	if c.cache.Get(cacheKey, &routeTables) {
+		return routeTables, nil
+	}
+	in := &ec2.DescribeRouteTablesInput{
+		Filters: toEC2Filter([]Filter{
+			{
+				Name:   "vpc-id",
+				Values: vpcIDs,
+			},
+		}),
+	}
+	for {
+		response, err := c.client.DescribeRouteTablesWithContext(ctx, in)
+		if err != nil {
+			return nil, fmt.Errorf("describe route tables: %w", err)
+		}
+		routeTables = append(routeTables, response.RouteTables...)
+		if response.NextToken == nil {
+			break
+		}
+		in.NextToken = response.NextToken
+	}
+	c.cache.Put(cacheKey, routeTables)
+	return routeTables, nil
+}
+
+// toSubnets converts the subnets to a format that's shown to users. A subnet is public if its route table routes
+// traffic to an internet gateway, which is either the route table explicitly associated with the subnet or
+// the main route table of its VPC. Whether instances launched in the subnet get a public IP address is ignored,
+// since many VPCs don't assign public IP addresses by default even in their public subnets.
+func (c *EC2) toSubnets(ctx context.Context, subnets []*ec2.Subnet) ([]Subnet, error) {
+	if len(subnets) == 0 {
+		return nil, nil
+	}
+	tables, err := c.subnetRouteTables(ctx, subnets)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []Subnet
+	for _, subnet := range subnets {
+		s := Subnet{
+			ID:   aws.StringValue(subnet.SubnetId),
+			AZ:   aws.StringValue(subnet.AvailabilityZone),
+			CIDR: aws.StringValue(subnet.CidrBlock),
+		}
+		if table, ok := tables[s.ID]; ok {
+			s.IsPublic = routesToInternetGateway(table)
+		}
+		for _, tag := range subnet.Tags {
+			if aws.StringValue(tag.Key) == nameTagKey {
+				s.Name = aws.StringValue(tag.Value)
+			}
+		}
+		out = append(out, s)
+	}
+	return out, nil
+}
+
+// subnetRouteTables returns the route table of each subnet keyed by subnet ID, which is either the route table
+// explicitly associated with the subnet or the main route table of its VPC.
+func (c *EC2) subnetRouteTables(ctx context.Context, subnets []*ec2.Subnet) (map[string]*ec2.RouteTable, error) {
+	var vpcIDs []string
+	seen := make(map[string]bool)
+	for _, subnet := range subnets {
+		vpcID := aws.StringValue(subnet.VpcId)
+		if !seen[vpcID] {
+			seen[vpcID] = true
+			vpcIDs = append(vpcIDs, vpcID)
+		}
+	}
+	routeTables, err := c.routeTables(ctx, vpcIDs)
+	if err != nil {
+		return nil, err
+	}
+	mainTables := make(map[string]*ec2.RouteTable)   // Keyed by VPC ID.
+	subnetTables := make(map[string]*ec2.RouteTable) // Keyed by subnet ID, only for subnets with an explicit route table.
+	for _, table := range routeTables {
+		for _, assoc := range table.Associations {
+			if aws.BoolValue(assoc.Main) {
+				mainTables[aws.StringValue(table.VpcId)] = table
+			}
+			if assoc.SubnetId != nil {
+				subnetTables[aws.StringValue(assoc.SubnetId)] = table
+			}
+		}
+	}
+	tables := make(map[string]*ec2.RouteTable)
+	for _, subnet := range subnets {
+		id := aws.StringValue(subnet.SubnetId)
+		if table, ok := subnetTables[id]; ok {
+			tables[id] = table
+		} else if table, ok := mainTables[aws.StringValue(subnet.VpcId)]; ok {
+			tables[id] = table
+		}
+	}
+	return tables, nil
+}
+
+// routesToInternet returns true if the route table has an active default route through an internet gateway,
+// a NAT gateway, a NAT instance or a transit gateway.
+func routesToInternet(table *ec2.RouteTable) bool {
+	for _, route := range table.Routes {
+		if aws.StringValue(route.DestinationCidrBlock) != defaultRouteCIDR || aws.StringValue(route.State) != ec2.RouteStateActive {
+			continue
+		}
+		if strings.HasPrefix(aws.StringValue(route.GatewayId), internetGatewayIDPrefix) ||
+			route.NatGatewayId != nil || route.InstanceId != nil || route.TransitGatewayId != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// routesToInternetGateway returns true if the route table has an active IPv4 or IPv6 default route through an internet gateway.
+// Routes to a deleted internet gateway are left in the "blackhole" state and don't make a subnet public.
+func routesToInternetGateway(table *ec2.RouteTable) bool {
+	for _, route := range table.Routes {
+		if aws.StringValue(route.State) != ec2.RouteStateActive {
+			continue
+		}
+		if aws.StringValue(route.DestinationCidrBlock) != defaultRouteCIDR &&
+			aws.StringValue(route.DestinationIpv6CidrBlock) != defaultIPv6RouteCIDR {
+			continue
+		}
+		if strings.HasPrefix(aws.StringValue(route.GatewayId), internetGatewayIDPrefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func toSecurityGroupRules(perms []*ec2.IpPermission) []SecurityGroupRule {
+	var rules []SecurityGroupRule
+	for _, perm := range perms {
+		rule := SecurityGroupRule{
+			Protocol: aws.StringValue(perm.IpProtocol),
+			FromPort: -1,
+			ToPort:   -1,
+		}
+		if perm.FromPort != nil {
+			rule.FromPort = aws.Int64Value(perm.FromPort)
+		}
+		if perm.ToPort != nil {
+			rule.ToPort = aws.Int64Value(perm.ToPort)
+		}
+		for _, r := range perm.IpRanges {
+			rule.CIDRs = append(rule.CIDRs, aws.StringValue(r.CidrIp))
+		}
+		for _, r := range perm.Ipv6Ranges {
+			rule.CIDRs = append(rule.CIDRs, aws.StringValue(r.CidrIpv6))
+		}
+		for _, p := range perm.UserIdGroupPairs {
+			rule.GroupIDs = append(rule.GroupIDs, aws.StringValue(p.GroupId))
+		}
+		for _, p := range perm.PrefixListIds {
+			rule.PrefixListIDs = append(rule.PrefixListIDs, aws.StringValue(p.PrefixListId))
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+func contains(s string, items []string) bool {
+	for _, item := range items {
+		if s == item {
+			return true
+		}
+	}
+	return false
+}
+
 func toEC2Filter(filters []Filter) []*ec2.Filter {
 	var ec2Filter []*ec2.Filter
 	for _, filter := range filters {
@@ -4,13 +4,19 @@
 package ec2
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"io/ioutil"
+	"os"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ec2/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/cache"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -28,17 +34,55 @@ var (
 		},
 	}
 
+	// Subnets are classified by their route tables, regardless of whether they assign public IP addresses.
 	subnet1 = &ec2.Subnet{
 		SubnetId:            aws.String("subnet-1"),
-		MapPublicIpOnLaunch: aws.Bool(false),
+		VpcId:               aws.String("vpc-1"),
+		MapPublicIpOnLaunch: aws.Bool(true),
 	}
 	subnet2 = &ec2.Subnet{
 		SubnetId:            aws.String("subnet-2"),
+		VpcId:               aws.String("vpc-1"),
 		MapPublicIpOnLaunch: aws.Bool(true),
 	}
 	subnet3 = &ec2.Subnet{
 		SubnetId:            aws.String("subnet-3"),
-		MapPublicIpOnLaunch: aws.Bool(true),
+		VpcId:               aws.String("vpc-1"),
+		MapPublicIpOnLaunch: aws.Bool(false),
+	}
+
+	// The main route table only has local routes, so subnet-1 is private.
+	routeTables = []*ec2.RouteTable{
+		{
+			RouteTableId: aws.String("rtb-main"),
+			VpcId:        aws.String("vpc-1"),
+			Associations: []*ec2.RouteTableAssociation{
+				{Main: aws.Bool(true)},
+			},
+			Routes: []*ec2.Route{
+				{GatewayId: aws.String("local")},
+			},
+		},
+		{
+			RouteTableId: aws.String("rtb-public"),
+			VpcId:        aws.String("vpc-1"),
+			Associations: []*ec2.RouteTableAssociation{
+				{SubnetId: aws.String("subnet-2")},
+				{SubnetId: aws.String("subnet-3")},
+			},
+			Routes: []*ec2.Route{
+				{GatewayId: aws.String("local")},
+				{DestinationCidrBlock: aws.String("0.0.0.0/0"), GatewayId: aws.String("igw-0123456789abcdef0"), State: aws.String("active")},
+			},
+		},
+	}
+	describeRouteTablesInput = &ec2.DescribeRouteTablesInput{
+		Filters: toEC2Filter([]Filter{
+			{
+				Name:   "vpc-id",
+				Values: []string{"vpc-1"},
+			},
+		}),
 	}
 )
 
@@ -52,13 +96,13 @@ func TestEC2_ListVPC(t *testing.T) {
 	}{
 		"fail to describe vpcs": {
 			mockEC2Client: func(m *mocks.Mockapi) {
-				m.EXPECT().DescribeVpcs(gomock.Any()).Return(nil, errors.New("some error"))
+				m.EXPECT().DescribeVpcsWithContext(gomock.Any(), gomock.Any()).Return(nil, errors.New("some error"))
 			},
 			wantedError: fmt.Errorf("describe VPCs: some error"),
 		},
 		"success": {
 			mockEC2Client: func(m *mocks.Mockapi) {
-				m.EXPECT().DescribeVpcs(&ec2.DescribeVpcsInput{}).Return(&ec2.DescribeVpcsOutput{
+				m.EXPECT().DescribeVpcsWithContext(gomock.Any(), &ec2.DescribeVpcsInput{}).Return(&ec2.DescribeVpcsOutput{
 					Vpcs: []*ec2.Vpc{
 						{
 							VpcId: aws.String("mockVPCID1"),
@@ -66,7 +110,7 @@ func TestEC2_ListVPC(t *testing.T) {
 					},
 					NextToken: aws.String("mockNextToken"),
 				}, nil)
-				m.EXPECT().DescribeVpcs(&ec2.DescribeVpcsInput{
+				m.EXPECT().DescribeVpcsWithContext(gomock.Any(), &ec2.DescribeVpcsInput{
 					NextToken: aws.String("mockNextToken"),
 				}).Return(&ec2.DescribeVpcsOutput{
 					Vpcs: []*ec2.Vpc{
@@ -91,7 +135,7 @@ func TestEC2_ListVPC(t *testing.T) {
 				client: mockAPI,
 			}
 
-			vpcs, err := ec2Client.ListVPC()
+			vpcs, err := ec2Client.ListVPC(context.Background())
 			if tc.wantedError != nil {
 				require.EqualError(t, tc.wantedError, err.Error())
 			} else {
@@ -102,6 +146,98 @@ func TestEC2_ListVPC(t *testing.T) {
 	}
 }
 
+func TestEC2_ListVPCsDetailed(t *testing.T) {
+	teamFilter := FilterForTags("team", "payments")
+	testCases := map[string]struct {
+		inFilters     []Filter
+		mockEC2Client func(m *mocks.Mockapi)
+
+		wantedError error
+		wantedVPCs  []VPC
+	}{
+		"fail to describe vpcs": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeVpcsWithContext(gomock.Any(), gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("describe VPCs: some error"),
+		},
+		"success with tag filters": {
+			inFilters: []Filter{teamFilter},
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeVpcsWithContext(gomock.Any(), &ec2.DescribeVpcsInput{
+					Filters: toEC2Filter([]Filter{teamFilter}),
+				}).Return(&ec2.DescribeVpcsOutput{
+					Vpcs: []*ec2.Vpc{
+						{
+							VpcId:     aws.String("vpc-1"),
+							CidrBlock: aws.String("10.0.0.0/16"),
+							Tags: []*ec2.Tag{
+								{
+									Key:   aws.String("Name"),
+									Value: aws.String("payments-prod"),
+								},
+								{
+									Key:   aws.String("team"),
+									Value: aws.String("payments"),
+								},
+							},
+						},
+						{
+							VpcId:     aws.String("vpc-2"),
+							CidrBlock: aws.String("172.31.0.0/16"),
+							IsDefault: aws.Bool(true),
+						},
+					},
+				}, nil)
+			},
+			wantedVPCs: []VPC{
+				{
+					ID:   "vpc-1",
+					Name: "payments-prod",
+					CIDR: "10.0.0.0/16",
+				},
+				{
+					ID:        "vpc-2",
+					CIDR:      "172.31.0.0/16",
+					IsDefault: true,
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.mockEC2Client(mockAPI)
+
+			ec2Client := EC2{
+				client: mockAPI,
+			}
+
+			vpcs, err := ec2Client.ListVPCsDetailed(context.Background(), tc.inFilters...)
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedVPCs, vpcs)
+			}
+		})
+	}
+}
+
+func TestVPC_String(t *testing.T) {
+	require.Equal(t, "vpc-1 (payments-prod): 10.0.0.0/16", VPC{ID: "vpc-1", Name: "payments-prod", CIDR: "10.0.0.0/16"}.String())
+	require.Equal(t, "vpc-2: 172.31.0.0/16, default", VPC{ID: "vpc-2", CIDR: "172.31.0.0/16", IsDefault: true}.String())
+}
+
+func TestFilterForTags(t *testing.T) {
+	require.Equal(t, Filter{Name: "tag:team", Values: []string{"payments", "billing"}}, FilterForTags("team", "payments", "billing"))
+	require.Equal(t, Filter{Name: "tag-key", Values: []string{"team"}}, FilterForTags("team"))
+}
+
 func TestEC2_ListVPCSubnets(t *testing.T) {
 	const mockVPCID = "mockVPCID"
 	testCases := map[string]struct {
@@ -113,13 +249,22 @@ func TestEC2_ListVPCSubnets(t *testing.T) {
 	}{
 		"fail to describe subnets": {
 			mockEC2Client: func(m *mocks.Mockapi) {
-				m.EXPECT().DescribeSubnets(gomock.Any()).Return(nil, errors.New("error describing subnets"))
+				m.EXPECT().DescribeSubnetsWithContext(gomock.Any(), gomock.Any()).Return(nil, errors.New("error describing subnets"))
 			},
 			wantedError: fmt.Errorf("describe subnets: error describing subnets"),
 		},
+		"fail to describe route tables": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeSubnetsWithContext(gomock.Any(), gomock.Any()).Return(&ec2.DescribeSubnetsOutput{
+					Subnets: []*ec2.Subnet{subnet1},
+				}, nil)
+				m.EXPECT().DescribeRouteTablesWithContext(gomock.Any(), describeRouteTablesInput).Return(nil, errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("describe route tables: some error"),
+		},
 		"success": {
 			mockEC2Client: func(m *mocks.Mockapi) {
-				m.EXPECT().DescribeSubnets(&ec2.DescribeSubnetsInput{
+				m.EXPECT().DescribeSubnetsWithContext(gomock.Any(), &ec2.DescribeSubnetsInput{
 					Filters: toEC2Filter([]Filter{
 						{
 							Name:   "vpc-id",
@@ -132,13 +277,16 @@ func TestEC2_ListVPCSubnets(t *testing.T) {
 						subnet2,
 						subnet3,
 					}}, nil)
+				m.EXPECT().DescribeRouteTablesWithContext(gomock.Any(), describeRouteTablesInput).Return(&ec2.DescribeRouteTablesOutput{
+					RouteTables: routeTables,
+				}, nil)
 			},
 			wantedSubnets: []string{"subnet-1", "subnet-2", "subnet-3"},
 		},
 		"success with filtering": {
 			public: true,
 			mockEC2Client: func(m *mocks.Mockapi) {
-				m.EXPECT().DescribeSubnets(&ec2.DescribeSubnetsInput{
+				m.EXPECT().DescribeSubnetsWithContext(gomock.Any(), &ec2.DescribeSubnetsInput{
 					Filters: toEC2Filter([]Filter{
 						{
 							Name:   "vpc-id",
@@ -151,9 +299,55 @@ func TestEC2_ListVPCSubnets(t *testing.T) {
 						subnet2,
 						subnet3,
 					}}, nil)
+				m.EXPECT().DescribeRouteTablesWithContext(gomock.Any(), describeRouteTablesInput).Return(&ec2.DescribeRouteTablesOutput{
+					RouteTables: routeTables,
+				}, nil)
 			},
 			wantedSubnets: []string{"subnet-2", "subnet-3"},
 		},
+		"success with filtering by the main route table and paginated route tables": {
+			public: true,
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeSubnetsWithContext(gomock.Any(), gomock.Any()).Return(&ec2.DescribeSubnetsOutput{
+					Subnets: []*ec2.Subnet{
+						subnet1,
+						subnet2,
+					}}, nil)
+				m.EXPECT().DescribeRouteTablesWithContext(gomock.Any(), describeRouteTablesInput).Return(&ec2.DescribeRouteTablesOutput{
+					RouteTables: []*ec2.RouteTable{
+						{
+							VpcId: aws.String("vpc-1"),
+							Associations: []*ec2.RouteTableAssociation{
+								{SubnetId: aws.String("subnet-2")},
+							},
+							Routes: []*ec2.Route{
+								{GatewayId: aws.String("local")},
+								{NatGatewayId: aws.String("nat-0123456789abcdef0")},
+							},
+						},
+					},
+					NextToken: aws.String("next"),
+				}, nil)
+				m.EXPECT().DescribeRouteTablesWithContext(gomock.Any(), &ec2.DescribeRouteTablesInput{
+					Filters:   describeRouteTablesInput.Filters,
+					NextToken: aws.String("next"),
+				}).Return(&ec2.DescribeRouteTablesOutput{
+					RouteTables: []*ec2.RouteTable{
+						{
+							VpcId: aws.String("vpc-1"),
+							Associations: []*ec2.RouteTableAssociation{
+								{Main: aws.Bool(true)},
+							},
+							Routes: []*ec2.Route{
+								{GatewayId: aws.String("local")},
+								{DestinationCidrBlock: aws.String("0.0.0.0/0"), GatewayId: aws.String("igw-0123456789abcdef0"), State: aws.String("active")},
+							},
+						},
+					},
+				}, nil)
+			},
+			wantedSubnets: []string{"subnet-1"},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -170,9 +364,9 @@ func TestEC2_ListVPCSubnets(t *testing.T) {
 			var subnets []string
 			var err error
 			if tc.public {
-				subnets, err = ec2Client.ListVPCSubnets(mockVPCID, FilterForPublicSubnets())
+				subnets, err = ec2Client.ListVPCSubnets(context.Background(), mockVPCID, FilterForPublicSubnets())
 			} else {
-				subnets, err = ec2Client.ListVPCSubnets(mockVPCID)
+				subnets, err = ec2Client.ListVPCSubnets(context.Background(), mockVPCID)
 			}
 			if tc.wantedError != nil {
 				require.EqualError(t, tc.wantedError, err.Error())
@@ -183,6 +377,135 @@ func TestEC2_ListVPCSubnets(t *testing.T) {
 		})
 	}
 }
+
+func TestEC2_ListVPCSubnets_cache(t *testing.T) {
+	const mockVPCID = "mockVPCID"
+	dir, err := ioutil.TempDir("", "ec2")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAPI := mocks.NewMockapi(ctrl)
+	mockAPI.EXPECT().DescribeSubnetsWithContext(gomock.Any(), gomock.Any()).Return(&ec2.DescribeSubnetsOutput{
+		Subnets: []*ec2.Subnet{
+			subnet1,
+			subnet2,
+			subnet3,
+		}}, nil).Times(1)
+	mockAPI.EXPECT().DescribeRouteTablesWithContext(gomock.Any(), describeRouteTablesInput).Return(&ec2.DescribeRouteTablesOutput{
+		RouteTables: routeTables,
+	}, nil).Times(1)
+	ec2Client := EC2{
+		client: mockAPI,
+		cache:  cache.New(dir, time.Minute),
+	}
+
+	subnets, err := ec2Client.ListVPCSubnets(context.Background(), mockVPCID)
+	require.NoError(t, err)
+	require.Equal(t, []string{"subnet-1", "subnet-2", "subnet-3"}, subnets)
+
+	// The second call is served from the cache and still applies the filter.
+	subnets, err = ec2Client.ListVPCSubnets(context.Background(), mockVPCID, FilterForPublicSubnets())
+	require.NoError(t, err)
+	require.Equal(t, []string{"subnet-2", "subnet-3"}, subnets)
+}
+
+func TestEC2_ListVPCSubnetsDetailed(t *testing.T) {
+	const mockVPCID = "mockVPCID"
+	testCases := map[string]struct {
+		mockEC2Client func(m *mocks.Mockapi)
+
+		wantedError   error
+		wantedSubnets []Subnet
+	}{
+		"fail to describe subnets": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeSubnetsWithContext(gomock.Any(), gomock.Any()).Return(nil, errors.New("error describing subnets"))
+			},
+			wantedError: fmt.Errorf("describe subnets: error describing subnets"),
+		},
+		"returns the details of the public subnets": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeSubnetsWithContext(gomock.Any(), &ec2.DescribeSubnetsInput{
+					Filters: toEC2Filter([]Filter{
+						{
+							Name:   "vpc-id",
+							Values: []string{mockVPCID},
+						},
+					}),
+				}).Return(&ec2.DescribeSubnetsOutput{
+					Subnets: []*ec2.Subnet{
+						subnet1,
+						{
+							SubnetId:            aws.String("subnet-2"),
+							VpcId:               aws.String("vpc-1"),
+							AvailabilityZone:    aws.String("us-west-2a"),
+							CidrBlock:           aws.String("10.0.0.0/24"),
+							MapPublicIpOnLaunch: aws.Bool(true),
+							Tags: []*ec2.Tag{
+								{
+									Key:   aws.String("Name"),
+									Value: aws.String("public-a"),
+								},
+							},
+						},
+						{
+							SubnetId:            aws.String("subnet-3"),
+							VpcId:               aws.String("vpc-1"),
+							AvailabilityZone:    aws.String("us-west-2b"),
+							CidrBlock:           aws.String("10.0.1.0/24"),
+							MapPublicIpOnLaunch: aws.Bool(false),
+						},
+					}}, nil)
+				m.EXPECT().DescribeRouteTablesWithContext(gomock.Any(), describeRouteTablesInput).Return(&ec2.DescribeRouteTablesOutput{
+					RouteTables: routeTables,
+				}, nil)
+			},
+			wantedSubnets: []Subnet{
+				{
+					ID:       "subnet-2",
+					AZ:       "us-west-2a",
+					CIDR:     "10.0.0.0/24",
+					Name:     "public-a",
+					IsPublic: true,
+				},
+				{
+					ID:       "subnet-3",
+					AZ:       "us-west-2b",
+					CIDR:     "10.0.1.0/24",
+					IsPublic: true,
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.mockEC2Client(mockAPI)
+
+			ec2Client := EC2{
+				client: mockAPI,
+			}
+
+			subnets, err := ec2Client.ListVPCSubnetsDetailed(context.Background(), mockVPCID, FilterForPublicSubnets())
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedSubnets, subnets)
+			}
+		})
+	}
+}
+
+func TestSubnet_String(t *testing.T) {
+	require.Equal(t, "subnet-1 (public-a): us-west-2a, 10.0.0.0/24", Subnet{ID: "subnet-1", Name: "public-a", AZ: "us-west-2a", CIDR: "10.0.0.0/24"}.String())
+	require.Equal(t, "subnet-2: us-west-2b, 10.0.1.0/24", Subnet{ID: "subnet-2", AZ: "us-west-2b", CIDR: "10.0.1.0/24"}.String())
+}
+
 func TestEC2_PublicSubnetIDs(t *testing.T) {
 	testCases := map[string]struct {
 		inFilter []Filter
@@ -195,7 +518,7 @@ func TestEC2_PublicSubnetIDs(t *testing.T) {
 		"fail to get public subnets": {
 			inFilter: inAppEnvFilters,
 			mockEC2Client: func(m *mocks.Mockapi) {
-				m.EXPECT().DescribeSubnets(&ec2.DescribeSubnetsInput{
+				m.EXPECT().DescribeSubnetsWithContext(gomock.Any(), &ec2.DescribeSubnetsInput{
 					Filters: toEC2Filter(inAppEnvFilters),
 				}).Return(nil, errors.New("error describing subnets"))
 			},
@@ -204,7 +527,7 @@ func TestEC2_PublicSubnetIDs(t *testing.T) {
 		"successfully get only public subnets": {
 			inFilter: inAppEnvFilters,
 			mockEC2Client: func(m *mocks.Mockapi) {
-				m.EXPECT().DescribeSubnets(&ec2.DescribeSubnetsInput{
+				m.EXPECT().DescribeSubnetsWithContext(gomock.Any(), &ec2.DescribeSubnetsInput{
 					Filters: toEC2Filter(inAppEnvFilters),
 				}).Return(&ec2.DescribeSubnetsOutput{
 					Subnets: []*ec2.Subnet{
@@ -212,9 +535,52 @@ func TestEC2_PublicSubnetIDs(t *testing.T) {
 						subnet2,
 						subnet3,
 					}}, nil)
+				m.EXPECT().DescribeRouteTablesWithContext(gomock.Any(), describeRouteTablesInput).Return(&ec2.DescribeRouteTablesOutput{
+					RouteTables: routeTables,
+				}, nil)
 			},
 			wantedARNs: []string{"subnet-2", "subnet-3"},
 		},
+		"only subnets with an active default route to an internet gateway are public": {
+			inFilter: inAppEnvFilters,
+			mockEC2Client: func(m *mocks.Mockapi) {
+				subnet := func(id string) *ec2.Subnet {
+					return &ec2.Subnet{SubnetId: aws.String(id), VpcId: aws.String("vpc-1")}
+				}
+				table := func(subnetID string, route *ec2.Route) *ec2.RouteTable {
+					return &ec2.RouteTable{
+						VpcId: aws.String("vpc-1"),
+						Associations: []*ec2.RouteTableAssociation{
+							{SubnetId: aws.String(subnetID)},
+						},
+						Routes: []*ec2.Route{
+							{DestinationCidrBlock: aws.String("10.0.0.0/16"), GatewayId: aws.String("local"), State: aws.String("active")},
+							route,
+						},
+					}
+				}
+				m.EXPECT().DescribeSubnetsWithContext(gomock.Any(), gomock.Any()).Return(&ec2.DescribeSubnetsOutput{
+					Subnets: []*ec2.Subnet{
+						subnet("subnet-ipv4"),
+						subnet("subnet-ipv6"),
+						subnet("subnet-blackhole"),
+						subnet("subnet-partial"),
+						subnet("subnet-nat"),
+					}}, nil)
+				m.EXPECT().DescribeRouteTablesWithContext(gomock.Any(), describeRouteTablesInput).Return(&ec2.DescribeRouteTablesOutput{
+					RouteTables: []*ec2.RouteTable{
+						table("subnet-ipv4", &ec2.Route{DestinationCidrBlock: aws.String("0.0.0.0/0"), GatewayId: aws.String("igw-1"), State: aws.String("active")}),
+						table("subnet-ipv6", &ec2.Route{DestinationIpv6CidrBlock: aws.String("::/0"), GatewayId: aws.String("igw-1"), State: aws.String("active")}),
+						// The internet gateway was deleted.
+						table("subnet-blackhole", &ec2.Route{DestinationCidrBlock: aws.String("0.0.0.0/0"), GatewayId: aws.String("igw-2"), State: aws.String("blackhole")}),
+						// Only some destinations go through the internet gateway.
+						table("subnet-partial", &ec2.Route{DestinationCidrBlock: aws.String("203.0.113.0/24"), GatewayId: aws.String("igw-1"), State: aws.String("active")}),
+						table("subnet-nat", &ec2.Route{DestinationCidrBlock: aws.String("0.0.0.0/0"), NatGatewayId: aws.String("nat-1"), State: aws.String("active")}),
+					},
+				}, nil)
+			},
+			wantedARNs: []string{"subnet-ipv4", "subnet-ipv6"},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -228,7 +594,7 @@ func TestEC2_PublicSubnetIDs(t *testing.T) {
 				client: mockAPI,
 			}
 
-			arns, err := ec2Client.PublicSubnetIDs(tc.inFilter...)
+			arns, err := ec2Client.PublicSubnetIDs(context.Background(), tc.inFilter...)
 			if tc.wantedError != nil {
 				require.EqualError(t, tc.wantedError, err.Error())
 			} else {
@@ -239,6 +605,31 @@ func TestEC2_PublicSubnetIDs(t *testing.T) {
 	}
 }
 
+func TestEC2_SubnetIDs_Canceled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx, cancel := context.WithCancel(context.Background())
+	mockAPI := mocks.NewMockapi(ctrl)
+	mockAPI.EXPECT().DescribeSubnetsWithContext(ctx, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, in *ec2.DescribeSubnetsInput, opts ...request.Option) (*ec2.DescribeSubnetsOutput, error) {
+			cancel() // The user interrupts the command while the first page is being fetched.
+			return &ec2.DescribeSubnetsOutput{
+				NextToken: aws.String("token"),
+			}, nil
+		})
+	mockAPI.EXPECT().DescribeSubnetsWithContext(ctx, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, in *ec2.DescribeSubnetsInput, opts ...request.Option) (*ec2.DescribeSubnetsOutput, error) {
+			return nil, ctx.Err()
+		})
+	ec2Client := EC2{
+		client: mockAPI,
+	}
+
+	_, err := ec2Client.SubnetIDs(ctx, FilterForDefaultVPCSubnets)
+
+	require.True(t, errors.Is(err, context.Canceled))
+}
+
 func TestEC2_SubnetIDs(t *testing.T) {
 	mockNextToken := aws.String("mockNextToken")
 	testCases := map[string]struct {
@@ -252,7 +643,7 @@ func TestEC2_SubnetIDs(t *testing.T) {
 		"failed to get subnets": {
 			inFilter: inAppEnvFilters,
 			mockEC2Client: func(m *mocks.Mockapi) {
-				m.EXPECT().DescribeSubnets(&ec2.DescribeSubnetsInput{
+				m.EXPECT().DescribeSubnetsWithContext(gomock.Any(), &ec2.DescribeSubnetsInput{
 					Filters: toEC2Filter(inAppEnvFilters),
 				}).Return(nil, errors.New("error describing subnets"))
 			},
@@ -261,7 +652,7 @@ func TestEC2_SubnetIDs(t *testing.T) {
 		"successfully get subnets": {
 			inFilter: inAppEnvFilters,
 			mockEC2Client: func(m *mocks.Mockapi) {
-				m.EXPECT().DescribeSubnets(&ec2.DescribeSubnetsInput{
+				m.EXPECT().DescribeSubnetsWithContext(gomock.Any(), &ec2.DescribeSubnetsInput{
 					Filters: toEC2Filter(inAppEnvFilters),
 				}).Return(&ec2.DescribeSubnetsOutput{
 					Subnets: []*ec2.Subnet{
@@ -274,7 +665,7 @@ func TestEC2_SubnetIDs(t *testing.T) {
 		"successfully get subnets with pagination": {
 			inFilter: inAppEnvFilters,
 			mockEC2Client: func(m *mocks.Mockapi) {
-				m.EXPECT().DescribeSubnets(&ec2.DescribeSubnetsInput{
+				m.EXPECT().DescribeSubnetsWithContext(gomock.Any(), &ec2.DescribeSubnetsInput{
 					Filters: toEC2Filter(inAppEnvFilters),
 				}).Return(&ec2.DescribeSubnetsOutput{
 					Subnets: []*ec2.Subnet{
@@ -282,7 +673,7 @@ func TestEC2_SubnetIDs(t *testing.T) {
 					},
 					NextToken: mockNextToken,
 				}, nil)
-				m.EXPECT().DescribeSubnets(&ec2.DescribeSubnetsInput{
+				m.EXPECT().DescribeSubnetsWithContext(gomock.Any(), &ec2.DescribeSubnetsInput{
 					Filters:   toEC2Filter(inAppEnvFilters),
 					NextToken: mockNextToken,
 				}).Return(&ec2.DescribeSubnetsOutput{
@@ -306,7 +697,7 @@ func TestEC2_SubnetIDs(t *testing.T) {
 				client: mockAPI,
 			}
 
-			arns, err := ec2Client.SubnetIDs(tc.inFilter...)
+			arns, err := ec2Client.SubnetIDs(context.Background(), tc.inFilter...)
 			if tc.wantedError != nil {
 				require.EqualError(t, tc.wantedError, err.Error())
 			} else {
@@ -317,6 +708,95 @@ func TestEC2_SubnetIDs(t *testing.T) {
 	}
 }
 
+func TestEC2_SubnetsDetailed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAPI := mocks.NewMockapi(ctrl)
+	mockAPI.EXPECT().DescribeSubnetsWithContext(gomock.Any(), &ec2.DescribeSubnetsInput{
+		Filters: toEC2Filter(inAppEnvFilters),
+	}).Return(&ec2.DescribeSubnetsOutput{
+		Subnets: []*ec2.Subnet{subnet1, subnet2},
+	}, nil)
+	mockAPI.EXPECT().DescribeRouteTablesWithContext(gomock.Any(), describeRouteTablesInput).Return(&ec2.DescribeRouteTablesOutput{
+		RouteTables: routeTables,
+	}, nil)
+	ec2Client := EC2{
+		client: mockAPI,
+	}
+
+	subnets, err := ec2Client.SubnetsDetailed(context.Background(), inAppEnvFilters...)
+
+	require.NoError(t, err)
+	require.Equal(t, []Subnet{{ID: "subnet-1"}, {ID: "subnet-2", IsPublic: true}}, subnets)
+}
+
+func TestEC2_SubnetsAvailableIPs(t *testing.T) {
+	subnetFilter := []Filter{
+		{
+			Name:   "subnet-id",
+			Values: []string{"subnet-1", "subnet-2"},
+		},
+	}
+	testCases := map[string]struct {
+		mockEC2Client func(m *mocks.Mockapi)
+
+		wantedError error
+		wantedIPs   map[string]int
+	}{
+		"fail to describe subnets": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeSubnetsWithContext(gomock.Any(), &ec2.DescribeSubnetsInput{
+					Filters: toEC2Filter(subnetFilter),
+				}).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe subnets: some error"),
+		},
+		"success": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeSubnetsWithContext(gomock.Any(), &ec2.DescribeSubnetsInput{
+					Filters: toEC2Filter(subnetFilter),
+				}).Return(&ec2.DescribeSubnetsOutput{
+					Subnets: []*ec2.Subnet{
+						{
+							SubnetId:                aws.String("subnet-1"),
+							AvailableIpAddressCount: aws.Int64(3),
+						},
+						{
+							SubnetId:                aws.String("subnet-2"),
+							AvailableIpAddressCount: aws.Int64(0),
+						},
+					},
+				}, nil)
+			},
+			wantedIPs: map[string]int{
+				"subnet-1": 3,
+				"subnet-2": 0,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.mockEC2Client(mockAPI)
+
+			ec2Client := EC2{
+				client: mockAPI,
+			}
+
+			ips, err := ec2Client.SubnetsAvailableIPs(context.Background(), "subnet-1", "subnet-2")
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedIPs, ips)
+			}
+		})
+	}
+}
+
 func TestEC2_SecurityGroups(t *testing.T) {
 	testCases := map[string]struct {
 		inFilter []Filter
@@ -329,7 +809,7 @@ func TestEC2_SecurityGroups(t *testing.T) {
 		"failed to get security groups": {
 			inFilter: inAppEnvFilters,
 			mockEC2Client: func(m *mocks.Mockapi) {
-				m.EXPECT().DescribeSecurityGroups(&ec2.DescribeSecurityGroupsInput{
+				m.EXPECT().DescribeSecurityGroupsWithContext(gomock.Any(), &ec2.DescribeSecurityGroupsInput{
 					Filters: toEC2Filter(inAppEnvFilters),
 				}).Return(nil, errors.New("error getting security groups"))
 			},
@@ -339,13 +819,40 @@ func TestEC2_SecurityGroups(t *testing.T) {
 		"get security groups success": {
 			inFilter: inAppEnvFilters,
 			mockEC2Client: func(m *mocks.Mockapi) {
-				m.EXPECT().DescribeSecurityGroups(&ec2.DescribeSecurityGroupsInput{
+				m.EXPECT().DescribeSecurityGroupsWithContext(gomock.Any(), &ec2.DescribeSecurityGroupsInput{
+					Filters: toEC2Filter(inAppEnvFilters),
+				}).Return(&ec2.DescribeSecurityGroupsOutput{
+					SecurityGroups: []*ec2.SecurityGroup{
+						{
+							GroupId: aws.String("sg-1"),
+						},
+						{
+							GroupId: aws.String("sg-2"),
+						},
+					},
+				}, nil)
+			},
+
+			wantedARNs: []string{"sg-1", "sg-2"},
+		},
+		"get security groups across pages": {
+			inFilter: inAppEnvFilters,
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeSecurityGroupsWithContext(gomock.Any(), &ec2.DescribeSecurityGroupsInput{
 					Filters: toEC2Filter(inAppEnvFilters),
 				}).Return(&ec2.DescribeSecurityGroupsOutput{
 					SecurityGroups: []*ec2.SecurityGroup{
 						{
 							GroupId: aws.String("sg-1"),
 						},
+					},
+					NextToken: aws.String("token"),
+				}, nil)
+				m.EXPECT().DescribeSecurityGroupsWithContext(gomock.Any(), &ec2.DescribeSecurityGroupsInput{
+					Filters:   toEC2Filter(inAppEnvFilters),
+					NextToken: aws.String("token"),
+				}).Return(&ec2.DescribeSecurityGroupsOutput{
+					SecurityGroups: []*ec2.SecurityGroup{
 						{
 							GroupId: aws.String("sg-2"),
 						},
@@ -355,6 +862,22 @@ func TestEC2_SecurityGroups(t *testing.T) {
 
 			wantedARNs: []string{"sg-1", "sg-2"},
 		},
+		"failed to get the next page of security groups": {
+			inFilter: inAppEnvFilters,
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeSecurityGroupsWithContext(gomock.Any(), gomock.Any()).Return(&ec2.DescribeSecurityGroupsOutput{
+					SecurityGroups: []*ec2.SecurityGroup{
+						{
+							GroupId: aws.String("sg-1"),
+						},
+					},
+					NextToken: aws.String("token"),
+				}, nil)
+				m.EXPECT().DescribeSecurityGroupsWithContext(gomock.Any(), gomock.Any()).Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("describe security groups: some error"),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -367,7 +890,7 @@ func TestEC2_SecurityGroups(t *testing.T) {
 				client: mockAPI,
 			}
 
-			arns, err := ec2Client.SecurityGroups(inAppEnvFilters...)
+			arns, err := ec2Client.SecurityGroups(context.Background(), inAppEnvFilters...)
 			if tc.wantedError != nil {
 				require.EqualError(t, tc.wantedError, err.Error())
 			} else {
@@ -377,3 +900,1007 @@ func TestEC2_SecurityGroups(t *testing.T) {
 		})
 	}
 }
+
+func TestEC2_SecurityGroupRules(t *testing.T) {
+	testCases := map[string]struct {
+		mockEC2Client func(m *mocks.Mockapi)
+
+		wantedError error
+		wantedRules *SecurityGroupRules
+	}{
+		"failed to describe the security group": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeSecurityGroupsWithContext(gomock.Any(), gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe security group sg-1: some error"),
+		},
+		"security group not found": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeSecurityGroupsWithContext(gomock.Any(), gomock.Any()).Return(&ec2.DescribeSecurityGroupsOutput{}, nil)
+			},
+			wantedError: errors.New("security group sg-1 not found"),
+		},
+		"returns the ingress and egress rules": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeSecurityGroupsWithContext(gomock.Any(), &ec2.DescribeSecurityGroupsInput{
+					GroupIds: aws.StringSlice([]string{"sg-1"}),
+				}).Return(&ec2.DescribeSecurityGroupsOutput{
+					SecurityGroups: []*ec2.SecurityGroup{
+						{
+							GroupId: aws.String("sg-1"),
+							IpPermissions: []*ec2.IpPermission{
+								{
+									IpProtocol: aws.String("tcp"),
+									FromPort:   aws.Int64(8080),
+									ToPort:     aws.Int64(8080),
+									UserIdGroupPairs: []*ec2.UserIdGroupPair{
+										{GroupId: aws.String("sg-lb")},
+									},
+								},
+							},
+							IpPermissionsEgress: []*ec2.IpPermission{
+								{
+									IpProtocol: aws.String("-1"),
+									IpRanges: []*ec2.IpRange{
+										{CidrIp: aws.String("0.0.0.0/0")},
+									},
+									Ipv6Ranges: []*ec2.Ipv6Range{
+										{CidrIpv6: aws.String("::/0")},
+									},
+								},
+							},
+						},
+					},
+				}, nil)
+			},
+			wantedRules: &SecurityGroupRules{
+				GroupID: "sg-1",
+				Ingress: []SecurityGroupRule{
+					{
+						Protocol: "tcp",
+						FromPort: 8080,
+						ToPort:   8080,
+						GroupIDs: []string{"sg-lb"},
+					},
+				},
+				Egress: []SecurityGroupRule{
+					{
+						Protocol: "-1",
+						FromPort: -1,
+						ToPort:   -1,
+						CIDRs:    []string{"0.0.0.0/0", "::/0"},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.mockEC2Client(mockAPI)
+
+			ec2Client := EC2{
+				client: mockAPI,
+			}
+
+			rules, err := ec2Client.SecurityGroupRules(context.Background(), "sg-1")
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedRules, rules)
+			}
+		})
+	}
+}
+
+func TestSecurityGroupRules_AllowsIngressFrom(t *testing.T) {
+	rules := &SecurityGroupRules{
+		GroupID: "sg-1",
+		Ingress: []SecurityGroupRule{
+			{Protocol: "tcp", FromPort: 8000, ToPort: 8080, GroupIDs: []string{"sg-lb"}},
+			{Protocol: "-1", FromPort: -1, ToPort: -1, GroupIDs: []string{"sg-bastion"}},
+			{Protocol: "tcp", FromPort: 443, ToPort: 443, CIDRs: []string{"0.0.0.0/0"}},
+		},
+	}
+
+	require.True(t, rules.AllowsIngressFrom("sg-lb", 8080))
+	require.False(t, rules.AllowsIngressFrom("sg-lb", 9000))
+	require.True(t, rules.AllowsIngressFrom("sg-bastion", 22))
+	require.False(t, rules.AllowsIngressFrom("sg-other", 443))
+	require.Equal(t, "8000-8080", rules.Ingress[0].Ports())
+	require.Equal(t, "all", rules.Ingress[1].Ports())
+	require.Equal(t, "443", rules.Ingress[2].Ports())
+}
+
+func TestEC2_DescribeSecurityGroups(t *testing.T) {
+	testCases := map[string]struct {
+		mockEC2Client func(m *mocks.Mockapi)
+
+		wantedError  error
+		wantedGroups []SecurityGroup
+	}{
+		"failed to get security groups": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeSecurityGroupsWithContext(gomock.Any(), gomock.Any()).Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("describe security groups: some error"),
+		},
+		"returns the names and descriptions of the security groups": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeSecurityGroupsWithContext(gomock.Any(), &ec2.DescribeSecurityGroupsInput{
+					Filters: toEC2Filter(inAppEnvFilters),
+				}).Return(&ec2.DescribeSecurityGroupsOutput{
+					SecurityGroups: []*ec2.SecurityGroup{
+						{
+							GroupId:     aws.String("sg-1"),
+							GroupName:   aws.String("db"),
+							Description: aws.String("Access to the database"),
+						},
+					},
+					NextToken: aws.String("token"),
+				}, nil)
+				m.EXPECT().DescribeSecurityGroupsWithContext(gomock.Any(), &ec2.DescribeSecurityGroupsInput{
+					Filters:   toEC2Filter(inAppEnvFilters),
+					NextToken: aws.String("token"),
+				}).Return(&ec2.DescribeSecurityGroupsOutput{
+					SecurityGroups: []*ec2.SecurityGroup{
+						{
+							GroupId:   aws.String("sg-2"),
+							GroupName: aws.String("default"),
+						},
+					},
+				}, nil)
+			},
+
+			wantedGroups: []SecurityGroup{
+				{
+					ID:          "sg-1",
+					Name:        "db",
+					Description: "Access to the database",
+				},
+				{
+					ID:   "sg-2",
+					Name: "default",
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.mockEC2Client(mockAPI)
+
+			ec2Client := EC2{
+				client: mockAPI,
+			}
+
+			groups, err := ec2Client.DescribeSecurityGroups(context.Background(), inAppEnvFilters...)
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedGroups, groups)
+			}
+		})
+	}
+}
+
+func TestSecurityGroup_String(t *testing.T) {
+	require.Equal(t, "sg-1 (db): Access to the database", SecurityGroup{ID: "sg-1", Name: "db", Description: "Access to the database"}.String())
+	require.Equal(t, "sg-2 (default)", SecurityGroup{ID: "sg-2", Name: "default"}.String())
+}
+
+func TestEC2_ListNATGateways(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAPI := mocks.NewMockapi(ctrl)
+	mockAPI.EXPECT().DescribeNatGatewaysWithContext(gomock.Any(), gomock.Any()).Return(&ec2.DescribeNatGatewaysOutput{
+		NatGateways: []*ec2.NatGateway{
+			{
+				NatGatewayId: aws.String("nat-1"),
+				SubnetId:     aws.String("subnet-public"),
+				NatGatewayAddresses: []*ec2.NatGatewayAddress{
+					{
+						AllocationId: aws.String("eipalloc-1"),
+						PublicIp:     aws.String("3.3.3.3"),
+					},
+				},
+			},
+		},
+	}, nil)
+	ec2Client := EC2{
+		client: mockAPI,
+	}
+
+	gateways, err := ec2Client.ListNATGateways(context.Background(), "vpc-1")
+
+	require.NoError(t, err)
+	require.Equal(t, []NATGateway{
+		{
+			ID:        "nat-1",
+			SubnetID:  "subnet-public",
+			PublicIPs: []string{"3.3.3.3"},
+		},
+	}, gateways)
+}
+
+func TestEC2_ListElasticIPs(t *testing.T) {
+	testCases := map[string]struct {
+		mockEC2Client func(m *mocks.Mockapi)
+
+		wantedError error
+		wantedIPs   []ElasticIP
+	}{
+		"failed to describe addresses": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeAddressesWithContext(gomock.Any(), gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe Elastic IP addresses: some error"),
+		},
+		"success": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeAddressesWithContext(gomock.Any(), &ec2.DescribeAddressesInput{
+					Filters: []*ec2.Filter{
+						{
+							Name:   aws.String("domain"),
+							Values: aws.StringSlice([]string{"vpc"}),
+						},
+					},
+				}).Return(&ec2.DescribeAddressesOutput{
+					Addresses: []*ec2.Address{
+						{
+							AllocationId:  aws.String("eipalloc-1"),
+							PublicIp:      aws.String("3.3.3.3"),
+							AssociationId: aws.String("eipassoc-1"),
+						},
+						{
+							AllocationId: aws.String("eipalloc-2"),
+							PublicIp:     aws.String("4.4.4.4"),
+						},
+					},
+				}, nil)
+			},
+			wantedIPs: []ElasticIP{
+				{
+					AllocationID:  "eipalloc-1",
+					PublicIP:      "3.3.3.3",
+					AssociationID: "eipassoc-1",
+				},
+				{
+					AllocationID: "eipalloc-2",
+					PublicIP:     "4.4.4.4",
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.mockEC2Client(mockAPI)
+
+			ec2Client := EC2{
+				client: mockAPI,
+			}
+
+			ips, err := ec2Client.ListElasticIPs(context.Background())
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedIPs, ips)
+			}
+		})
+	}
+}
+
+func TestEC2_SubnetsWithoutEgress(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAPI := mocks.NewMockapi(ctrl)
+	mockAPI.EXPECT().DescribeSubnetsWithContext(gomock.Any(), &ec2.DescribeSubnetsInput{
+		Filters: toEC2Filter([]Filter{
+			{
+				Name:   "subnet-id",
+				Values: []string{"subnet-nat", "subnet-blackhole", "subnet-main"},
+			},
+		}),
+	}).Return(&ec2.DescribeSubnetsOutput{
+		Subnets: []*ec2.Subnet{
+			{SubnetId: aws.String("subnet-nat"), VpcId: aws.String("vpc-1")},
+			{SubnetId: aws.String("subnet-blackhole"), VpcId: aws.String("vpc-1")},
+			{SubnetId: aws.String("subnet-main"), VpcId: aws.String("vpc-1")},
+		},
+	}, nil)
+	mockAPI.EXPECT().DescribeRouteTablesWithContext(gomock.Any(), gomock.Any()).Return(&ec2.DescribeRouteTablesOutput{
+		RouteTables: []*ec2.RouteTable{
+			{
+				VpcId: aws.String("vpc-1"),
+				Associations: []*ec2.RouteTableAssociation{
+					{SubnetId: aws.String("subnet-nat")},
+				},
+				Routes: []*ec2.Route{
+					{DestinationCidrBlock: aws.String("10.0.0.0/16"), GatewayId: aws.String("local"), State: aws.String("active")},
+					{DestinationCidrBlock: aws.String("0.0.0.0/0"), NatGatewayId: aws.String("nat-1"), State: aws.String("active")},
+				},
+			},
+			{
+				VpcId: aws.String("vpc-1"),
+				Associations: []*ec2.RouteTableAssociation{
+					{SubnetId: aws.String("subnet-blackhole")},
+				},
+				Routes: []*ec2.Route{
+					{DestinationCidrBlock: aws.String("0.0.0.0/0"), NatGatewayId: aws.String("nat-deleted"), State: aws.String("blackhole")},
+				},
+			},
+			{
+				VpcId: aws.String("vpc-1"),
+				Associations: []*ec2.RouteTableAssociation{
+					{Main: aws.Bool(true)},
+				},
+				Routes: []*ec2.Route{
+					{DestinationCidrBlock: aws.String("10.0.0.0/16"), GatewayId: aws.String("local"), State: aws.String("active")},
+				},
+			},
+		},
+	}, nil)
+	ec2Client := EC2{
+		client: mockAPI,
+	}
+
+	ids, err := ec2Client.SubnetsWithoutEgress(context.Background(), "subnet-nat", "subnet-blackhole", "subnet-main")
+
+	require.NoError(t, err)
+	require.Equal(t, []string{"subnet-blackhole", "subnet-main"}, ids)
+}
+
+func TestEC2_EgressIPs(t *testing.T) {
+	mockFilter := []*ec2.Filter{
+		{
+			Name:   aws.String("vpc-id"),
+			Values: aws.StringSlice([]string{"vpc-1"}),
+		},
+		{
+			Name:   aws.String("state"),
+			Values: aws.StringSlice([]string{"available"}),
+		},
+	}
+	testCases := map[string]struct {
+		mockEC2Client func(m *mocks.Mockapi)
+
+		wantedError error
+		wantedIPs   []string
+	}{
+		"failed to describe NAT gateways": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeNatGatewaysWithContext(gomock.Any(), &ec2.DescribeNatGatewaysInput{
+					Filter: mockFilter,
+				}).Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("describe NAT gateways in VPC vpc-1: some error"),
+		},
+		"success with pagination": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeNatGatewaysWithContext(gomock.Any(), &ec2.DescribeNatGatewaysInput{
+					Filter: mockFilter,
+				}).Return(&ec2.DescribeNatGatewaysOutput{
+					NatGateways: []*ec2.NatGateway{
+						{
+							NatGatewayAddresses: []*ec2.NatGatewayAddress{
+								{
+									AllocationId: aws.String("eipalloc-1"),
+									PublicIp:     aws.String("3.3.3.3"),
+								},
+							},
+						},
+					},
+					NextToken: aws.String("mockNextToken"),
+				}, nil)
+				m.EXPECT().DescribeNatGatewaysWithContext(gomock.Any(), &ec2.DescribeNatGatewaysInput{
+					Filter:    mockFilter,
+					NextToken: aws.String("mockNextToken"),
+				}).Return(&ec2.DescribeNatGatewaysOutput{
+					NatGateways: []*ec2.NatGateway{
+						{
+							NatGatewayAddresses: []*ec2.NatGatewayAddress{
+								{
+									PrivateIp: aws.String("10.0.0.5"),
+								},
+								{
+									AllocationId: aws.String("eipalloc-2"),
+									PublicIp:     aws.String("4.4.4.4"),
+								},
+							},
+						},
+					},
+				}, nil)
+			},
+
+			wantedIPs: []string{"3.3.3.3", "4.4.4.4"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.mockEC2Client(mockAPI)
+
+			ec2Client := EC2{
+				client: mockAPI,
+			}
+
+			ips, err := ec2Client.EgressIPs(context.Background(), "vpc-1")
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedIPs, ips)
+			}
+		})
+	}
+}
+
+func TestEC2_ListAvailabilityZones(t *testing.T) {
+	mockFilter := []*ec2.Filter{
+		{
+			Name:   aws.String("state"),
+			Values: aws.StringSlice([]string{"available"}),
+		},
+		{
+			Name:   aws.String("zone-type"),
+			Values: aws.StringSlice([]string{"availability-zone"}),
+		},
+		{
+			Name:   aws.String("opt-in-status"),
+			Values: aws.StringSlice([]string{"opt-in-not-required", "opted-in"}),
+		},
+	}
+	testCases := map[string]struct {
+		mockEC2Client func(m *mocks.Mockapi)
+
+		wantedError error
+		wantedZones []string
+	}{
+		"failed to describe availability zones": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeAvailabilityZonesWithContext(gomock.Any(), &ec2.DescribeAvailabilityZonesInput{
+					Filters: mockFilter,
+				}).Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("describe availability zones: some error"),
+		},
+		"success": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeAvailabilityZonesWithContext(gomock.Any(), &ec2.DescribeAvailabilityZonesInput{
+					Filters: mockFilter,
+				}).Return(&ec2.DescribeAvailabilityZonesOutput{
+					AvailabilityZones: []*ec2.AvailabilityZone{
+						{
+							ZoneName: aws.String("us-west-2a"),
+							ZoneId:   aws.String("usw2-az1"),
+						},
+						{
+							ZoneName: aws.String("us-west-2b"),
+							ZoneId:   aws.String("usw2-az2"),
+						},
+					},
+				}, nil)
+			},
+
+			wantedZones: []string{"us-west-2a", "us-west-2b"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.mockEC2Client(mockAPI)
+
+			ec2Client := EC2{
+				client: mockAPI,
+			}
+
+			zones, err := ec2Client.ListAvailabilityZones(context.Background(), FilterForAvailabilityZoneType, FilterForOptedInZones)
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedZones, zones)
+			}
+		})
+	}
+}
+
+func TestEC2_ValidateInstanceTypeOffered(t *testing.T) {
+	mockInput := func(token *string) *ec2.DescribeInstanceTypeOfferingsInput {
+		return &ec2.DescribeInstanceTypeOfferingsInput{
+			LocationType: aws.String("availability-zone"),
+			Filters: []*ec2.Filter{
+				{
+					Name:   aws.String("instance-type"),
+					Values: aws.StringSlice([]string{"c5.large"}),
+				},
+			},
+			NextToken: token,
+		}
+	}
+	testCases := map[string]struct {
+		inZones       []string
+		mockEC2Client func(m *mocks.Mockapi)
+
+		wantedError error
+	}{
+		"failed to describe the offerings": {
+			inZones: []string{"us-west-2a"},
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeInstanceTypeOfferingsWithContext(gomock.Any(), mockInput(nil)).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe offerings of instance type c5.large: some error"),
+		},
+		"instance type is offered in all the zones across pages": {
+			inZones: []string{"us-west-2a", "us-west-2c"},
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeInstanceTypeOfferingsWithContext(gomock.Any(), mockInput(nil)).Return(&ec2.DescribeInstanceTypeOfferingsOutput{
+					InstanceTypeOfferings: []*ec2.InstanceTypeOffering{
+						{Location: aws.String("us-west-2a")},
+						{Location: aws.String("us-west-2b")},
+					},
+					NextToken: aws.String("token"),
+				}, nil)
+				m.EXPECT().DescribeInstanceTypeOfferingsWithContext(gomock.Any(), mockInput(aws.String("token"))).Return(&ec2.DescribeInstanceTypeOfferingsOutput{
+					InstanceTypeOfferings: []*ec2.InstanceTypeOffering{
+						{Location: aws.String("us-west-2c")},
+					},
+				}, nil)
+			},
+		},
+		"instance type is not offered in some zones": {
+			inZones: []string{"us-west-2a", "us-west-2c", "us-west-2d"},
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeInstanceTypeOfferingsWithContext(gomock.Any(), mockInput(nil)).Return(&ec2.DescribeInstanceTypeOfferingsOutput{
+					InstanceTypeOfferings: []*ec2.InstanceTypeOffering{
+						{Location: aws.String("us-west-2a")},
+					},
+				}, nil)
+			},
+			wantedError: &ErrInstanceTypeNotOffered{
+				InstanceType: "c5.large",
+				Zones:        []string{"us-west-2c", "us-west-2d"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.mockEC2Client(mockAPI)
+
+			ec2Client := EC2{
+				client: mockAPI,
+			}
+
+			err := ec2Client.ValidateInstanceTypeOffered(context.Background(), "c5.large", tc.inZones...)
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestEC2_ManagedPrefixListID(t *testing.T) {
+	mockInput := &ec2.DescribeManagedPrefixListsInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("prefix-list-name"),
+				Values: aws.StringSlice([]string{CloudFrontOriginFacingPrefixList}),
+			},
+		},
+	}
+	testCases := map[string]struct {
+		mockEC2Client func(m *mocks.Mockapi)
+
+		wantedID    string
+		wantedError error
+	}{
+		"failed to describe the prefix lists": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeManagedPrefixListsWithContext(gomock.Any(), mockInput).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe managed prefix list com.amazonaws.global.cloudfront.origin-facing: some error"),
+		},
+		"prefix list is not available in the region": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeManagedPrefixListsWithContext(gomock.Any(), mockInput).Return(&ec2.DescribeManagedPrefixListsOutput{}, nil)
+			},
+			wantedError: &ErrPrefixListNotFound{Name: CloudFrontOriginFacingPrefixList},
+		},
+		"success": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeManagedPrefixListsWithContext(gomock.Any(), mockInput).Return(&ec2.DescribeManagedPrefixListsOutput{
+					PrefixLists: []*ec2.ManagedPrefixList{
+						{
+							PrefixListId:   aws.String("pl-82a045eb"),
+							PrefixListName: aws.String(CloudFrontOriginFacingPrefixList),
+						},
+					},
+				}, nil)
+			},
+			wantedID: "pl-82a045eb",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.mockEC2Client(mockAPI)
+
+			ec2Client := EC2{
+				client: mockAPI,
+			}
+
+			id, err := ec2Client.ManagedPrefixListID(context.Background(), CloudFrontOriginFacingPrefixList)
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedID, id)
+		})
+	}
+}
+
+func TestEC2_ListVPCEndpoints(t *testing.T) {
+	mockFilter := []*ec2.Filter{
+		{
+			Name:   aws.String("vpc-id"),
+			Values: aws.StringSlice([]string{"vpc-1"}),
+		},
+		{
+			Name:   aws.String("vpc-endpoint-state"),
+			Values: aws.StringSlice([]string{"available"}),
+		},
+	}
+	testCases := map[string]struct {
+		mockEC2Client func(m *mocks.Mockapi)
+
+		wantedError     error
+		wantedEndpoints []VPCEndpoint
+	}{
+		"failed to describe VPC endpoints": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeVpcEndpointsWithContext(gomock.Any(), &ec2.DescribeVpcEndpointsInput{
+					Filters: mockFilter,
+				}).Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("describe VPC endpoints in VPC vpc-1: some error"),
+		},
+		"success with pagination": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeVpcEndpointsWithContext(gomock.Any(), &ec2.DescribeVpcEndpointsInput{
+					Filters: mockFilter,
+				}).Return(&ec2.DescribeVpcEndpointsOutput{
+					VpcEndpoints: []*ec2.VpcEndpoint{
+						{
+							VpcEndpointId:   aws.String("vpce-1"),
+							ServiceName:     aws.String("com.amazonaws.us-west-2.ecr.api"),
+							VpcEndpointType: aws.String("Interface"),
+						},
+					},
+					NextToken: aws.String("mockNextToken"),
+				}, nil)
+				m.EXPECT().DescribeVpcEndpointsWithContext(gomock.Any(), &ec2.DescribeVpcEndpointsInput{
+					Filters:   mockFilter,
+					NextToken: aws.String("mockNextToken"),
+				}).Return(&ec2.DescribeVpcEndpointsOutput{
+					VpcEndpoints: []*ec2.VpcEndpoint{
+						{
+							VpcEndpointId:   aws.String("vpce-2"),
+							ServiceName:     aws.String("com.amazonaws.us-west-2.s3"),
+							VpcEndpointType: aws.String("Gateway"),
+						},
+					},
+				}, nil)
+			},
+
+			wantedEndpoints: []VPCEndpoint{
+				{
+					ID:          "vpce-1",
+					ServiceName: "com.amazonaws.us-west-2.ecr.api",
+					Type:        "Interface",
+				},
+				{
+					ID:          "vpce-2",
+					ServiceName: "com.amazonaws.us-west-2.s3",
+					Type:        "Gateway",
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.mockEC2Client(mockAPI)
+
+			ec2Client := EC2{
+				client: mockAPI,
+			}
+
+			endpoints, err := ec2Client.ListVPCEndpoints(context.Background(), "vpc-1")
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedEndpoints, endpoints)
+			}
+		})
+	}
+}
+
+func TestHasRequiredEndpoints(t *testing.T) {
+	testCases := map[string]struct {
+		inEndpoints []VPCEndpoint
+
+		wantedOK      bool
+		wantedMissing []string
+	}{
+		"no endpoints": {
+			wantedMissing: []string{"ecr.api", "ecr.dkr", "s3", "logs", "ssm"},
+		},
+		"some endpoints are missing": {
+			inEndpoints: []VPCEndpoint{
+				{ServiceName: "com.amazonaws.us-west-2.ecr.api"},
+				{ServiceName: "com.amazonaws.us-west-2.ecr.dkr"},
+				{ServiceName: "com.amazonaws.us-west-2.ssmmessages"},
+			},
+			wantedMissing: []string{"s3", "logs", "ssm"},
+		},
+		"all required endpoints exist": {
+			inEndpoints: []VPCEndpoint{
+				{ServiceName: "cn.com.amazonaws.cn-north-1.ecr.api"},
+				{ServiceName: "cn.com.amazonaws.cn-north-1.ecr.dkr"},
+				{ServiceName: "com.amazonaws.cn-north-1.s3"},
+				{ServiceName: "com.amazonaws.cn-north-1.logs"},
+				{ServiceName: "com.amazonaws.cn-north-1.ssm"},
+				{ServiceName: "com.amazonaws.cn-north-1.sqs"},
+			},
+			wantedOK: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ok, missing := HasRequiredEndpoints(tc.inEndpoints)
+
+			require.Equal(t, tc.wantedOK, ok)
+			require.Equal(t, tc.wantedMissing, missing)
+		})
+	}
+}
+
+func TestEC2_VPCDNSAttributes(t *testing.T) {
+	testCases := map[string]struct {
+		mockEC2Client func(m *mocks.Mockapi)
+
+		wantedError error
+		wantedAttrs *VPCDNSAttributes
+	}{
+		"failed to describe DNS support": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeVpcAttributeWithContext(gomock.Any(), gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe DNS support attribute of VPC vpc-1: some error"),
+		},
+		"success": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeVpcAttributeWithContext(gomock.Any(), &ec2.DescribeVpcAttributeInput{
+					VpcId:     aws.String("vpc-1"),
+					Attribute: aws.String(ec2.VpcAttributeNameEnableDnsSupport),
+				}).Return(&ec2.DescribeVpcAttributeOutput{
+					EnableDnsSupport: &ec2.AttributeBooleanValue{Value: aws.Bool(true)},
+				}, nil)
+				m.EXPECT().DescribeVpcAttributeWithContext(gomock.Any(), &ec2.DescribeVpcAttributeInput{
+					VpcId:     aws.String("vpc-1"),
+					Attribute: aws.String(ec2.VpcAttributeNameEnableDnsHostnames),
+				}).Return(&ec2.DescribeVpcAttributeOutput{
+					EnableDnsHostnames: &ec2.AttributeBooleanValue{Value: aws.Bool(false)},
+				}, nil)
+			},
+			wantedAttrs: &VPCDNSAttributes{
+				DNSSupport: true,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.mockEC2Client(mockAPI)
+
+			ec2Client := EC2{
+				client: mockAPI,
+			}
+
+			attrs, err := ec2Client.VPCDNSAttributes(context.Background(), "vpc-1")
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedAttrs, attrs)
+			}
+		})
+	}
+}
+
+func TestEC2_EnableVPCDNS(t *testing.T) {
+	testCases := map[string]struct {
+		mockEC2Client func(m *mocks.Mockapi)
+
+		wantedError error
+	}{
+		"failed to enable DNS support": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().ModifyVpcAttributeWithContext(gomock.Any(), gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("enable DNS support in VPC vpc-1: some error"),
+		},
+		"failed to enable DNS hostnames": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().ModifyVpcAttributeWithContext(gomock.Any(), gomock.Any()).Return(&ec2.ModifyVpcAttributeOutput{}, nil)
+				m.EXPECT().ModifyVpcAttributeWithContext(gomock.Any(), gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("enable DNS hostnames in VPC vpc-1: some error"),
+		},
+		"enables DNS support before DNS hostnames": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				gomock.InOrder(
+					m.EXPECT().ModifyVpcAttributeWithContext(gomock.Any(), &ec2.ModifyVpcAttributeInput{
+						VpcId:            aws.String("vpc-1"),
+						EnableDnsSupport: &ec2.AttributeBooleanValue{Value: aws.Bool(true)},
+					}).Return(&ec2.ModifyVpcAttributeOutput{}, nil),
+					m.EXPECT().ModifyVpcAttributeWithContext(gomock.Any(), &ec2.ModifyVpcAttributeInput{
+						VpcId:              aws.String("vpc-1"),
+						EnableDnsHostnames: &ec2.AttributeBooleanValue{Value: aws.Bool(true)},
+					}).Return(&ec2.ModifyVpcAttributeOutput{}, nil),
+				)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.mockEC2Client(mockAPI)
+
+			ec2Client := EC2{
+				client: mockAPI,
+			}
+
+			err := ec2Client.EnableVPCDNS(context.Background(), "vpc-1")
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestEC2_UsesAmazonProvidedDNS(t *testing.T) {
+	describeVPC := func(m *mocks.Mockapi, optionsID string) {
+		m.EXPECT().DescribeVpcsWithContext(gomock.Any(), &ec2.DescribeVpcsInput{
+			Filters: toEC2Filter([]Filter{
+				{
+					Name:   "vpc-id",
+					Values: []string{"vpc-1"},
+				},
+			}),
+		}).Return(&ec2.DescribeVpcsOutput{
+			Vpcs: []*ec2.Vpc{
+				{VpcId: aws.String("vpc-1"), DhcpOptionsId: aws.String(optionsID)},
+			},
+		}, nil)
+	}
+	dhcpOptions := func(servers ...string) *ec2.DescribeDhcpOptionsOutput {
+		var values []*ec2.AttributeValue
+		for _, server := range servers {
+			values = append(values, &ec2.AttributeValue{Value: aws.String(server)})
+		}
+		return &ec2.DescribeDhcpOptionsOutput{
+			DhcpOptions: []*ec2.DhcpOptions{
+				{
+					DhcpConfigurations: []*ec2.DhcpConfiguration{
+						{Key: aws.String("domain-name"), Values: []*ec2.AttributeValue{{Value: aws.String("corp.example.com")}}},
+						{Key: aws.String("domain-name-servers"), Values: values},
+					},
+				},
+			},
+		}
+	}
+	testCases := map[string]struct {
+		mockEC2Client func(m *mocks.Mockapi)
+
+		wantedError error
+		wantedUses  bool
+	}{
+		"uses the Amazon provided DNS server": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				describeVPC(m, "dopt-1")
+				m.EXPECT().DescribeDhcpOptionsWithContext(gomock.Any(), &ec2.DescribeDhcpOptionsInput{
+					DhcpOptionsIds: aws.StringSlice([]string{"dopt-1"}),
+				}).Return(dhcpOptions("10.0.0.2", "AmazonProvidedDNS"), nil)
+			},
+			wantedUses: true,
+		},
+		"uses custom DNS servers only": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				describeVPC(m, "dopt-1")
+				m.EXPECT().DescribeDhcpOptionsWithContext(gomock.Any(), gomock.Any()).Return(dhcpOptions("10.0.0.2"), nil)
+			},
+		},
+		"has no DHCP options set": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				describeVPC(m, "default")
+				m.EXPECT().DescribeDhcpOptionsWithContext(gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		"failed to describe DHCP options": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				describeVPC(m, "dopt-1")
+				m.EXPECT().DescribeDhcpOptionsWithContext(gomock.Any(), gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe DHCP options dopt-1 of VPC vpc-1: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.mockEC2Client(mockAPI)
+
+			ec2Client := EC2{
+				client: mockAPI,
+			}
+
+			uses, err := ec2Client.UsesAmazonProvidedDNS(context.Background(), "vpc-1")
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedUses, uses)
+			}
+		})
+	}
+}
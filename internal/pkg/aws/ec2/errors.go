@@ -0,0 +1,28 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ec2
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ErrInstanceTypeNotOffered occurs when an EC2 instance type isn't offered in some availability zones.
+type ErrInstanceTypeNotOffered struct {
+	InstanceType string
+	Zones        []string // Availability zones that don't offer the instance type.
+}
+
+func (e *ErrInstanceTypeNotOffered) Error() string {
+	return fmt.Sprintf("instance type %s is not offered in availability zones %s", e.InstanceType, strings.Join(e.Zones, ", "))
+}
+
+// ErrPrefixListNotFound occurs when a managed prefix list isn't available in the region.
+type ErrPrefixListNotFound struct {
+	Name string
+}
+
+func (e *ErrPrefixListNotFound) Error() string {
+	return fmt.Sprintf("managed prefix list %s not found", e.Name)
+}
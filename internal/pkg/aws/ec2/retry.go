@@ -0,0 +1,117 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ec2
+
+import (
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+const (
+	defaultMaxRetries       = 8
+	defaultMinThrottleDelay = 500 * time.Millisecond
+	defaultMaxThrottleDelay = 20 * time.Second
+
+	throttleHandlerName = "copilot.ThrottleHandler"
+)
+
+// Option configures the AWS EC2 client wrapped by EC2.
+type Option func(*ec2.EC2)
+
+// ThrottleEvent describes a throttled request that is about to be retried.
+type ThrottleEvent struct {
+	Operation  string        // Name of the throttled API, such as "DescribeSubnets".
+	Attempt    int           // Number of the upcoming retry, starting at 1.
+	MaxRetries int           // Number of retries before the request fails.
+	Delay      time.Duration // Time to wait before the upcoming retry.
+}
+
+// ThrottleObserver is notified while requests are throttled.
+type ThrottleObserver interface {
+	// Throttled is called each time a throttled request is about to be retried.
+	Throttled(e ThrottleEvent)
+	// Recovered is called once every throttled request completes, whether it succeeded or ran out of retries.
+	Recovered()
+}
+
+// RetryConfig configures how throttled requests are retried.
+// Zero values are replaced with defaults.
+type RetryConfig struct {
+	MaxRetries       int
+	MinThrottleDelay time.Duration // Delay before the first retry, doubled on each retry with jitter.
+	MaxThrottleDelay time.Duration // Upper bound of the delay between two retries.
+	Observer         ThrottleObserver
+}
+
+// WithThrottlingRetries retries throttled requests, such as the ones that fail with RequestLimitExceeded,
+// with exponential backoff and jitter instead of failing after the few retries of the AWS SDK.
+func WithThrottlingRetries(cfg RetryConfig) Option {
+	return func(c *ec2.EC2) {
+		r := &throttlingRetryer{
+			DefaultRetryer: client.DefaultRetryer{
+				NumMaxRetries:    cfg.MaxRetries,
+				MinThrottleDelay: cfg.MinThrottleDelay,
+				MaxThrottleDelay: cfg.MaxThrottleDelay,
+			},
+			observer:  cfg.Observer,
+			throttled: make(map[*request.Request]bool),
+		}
+		if r.NumMaxRetries == 0 {
+			r.NumMaxRetries = defaultMaxRetries
+		}
+		if r.MinThrottleDelay == 0 {
+			r.MinThrottleDelay = defaultMinThrottleDelay
+		}
+		if r.MaxThrottleDelay == 0 {
+			r.MaxThrottleDelay = defaultMaxThrottleDelay
+		}
+		c.Retryer = r
+		c.Handlers.Complete.PushBackNamed(request.NamedHandler{
+			Name: throttleHandlerName,
+			Fn:   r.complete,
+		})
+	}
+}
+
+// throttlingRetryer delays retries like the default retryer of the SDK, and notifies an observer of throttled retries.
+type throttlingRetryer struct {
+	client.DefaultRetryer
+	observer ThrottleObserver
+
+	mu        sync.Mutex
+	throttled map[*request.Request]bool // Requests that were throttled and haven't completed yet.
+}
+
+// RetryRules returns the delay before retrying the request.
+func (r *throttlingRetryer) RetryRules(req *request.Request) time.Duration {
+	delay := r.DefaultRetryer.RetryRules(req)
+	if r.observer == nil || !req.IsErrorThrottle() {
+		return delay
+	}
+	r.mu.Lock()
+	r.throttled[req] = true
+	r.mu.Unlock()
+	r.observer.Throttled(ThrottleEvent{
+		Operation:  req.Operation.Name,
+		Attempt:    req.RetryCount + 1,
+		MaxRetries: req.MaxRetries(),
+		Delay:      delay,
+	})
+	return delay
+}
+
+func (r *throttlingRetryer) complete(req *request.Request) {
+	r.mu.Lock()
+	throttled := r.throttled[req]
+	delete(r.throttled, req)
+	recovered := throttled && len(r.throttled) == 0
+	r.mu.Unlock()
+	if recovered {
+		r.observer.Recovered()
+	}
+}
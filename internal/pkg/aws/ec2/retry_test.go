@@ -0,0 +1,117 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	throttledResponse = `<Response><Errors><Error><Code>RequestLimitExceeded</Code><Message>Request limit exceeded.</Message></Error></Errors><RequestID>1</RequestID></Response>`
+	vpcsResponse      = `<DescribeVpcsResponse><vpcSet><item><vpcId>vpc-1</vpcId></item></vpcSet></DescribeVpcsResponse>`
+)
+
+type fakeThrottleObserver struct {
+	events    []ThrottleEvent
+	recovered int
+}
+
+func (o *fakeThrottleObserver) Throttled(e ThrottleEvent) {
+	o.events = append(o.events, e)
+}
+
+func (o *fakeThrottleObserver) Recovered() {
+	o.recovered++
+}
+
+func TestWithThrottlingRetries(t *testing.T) {
+	testCases := map[string]struct {
+		inThrottledRequests int
+		inMaxRetries        int
+
+		wantedVPCs      []string
+		wantedAttempts  []int
+		wantedRecovered int
+		wantedErr       string
+	}{
+		"retries throttled requests until they succeed": {
+			inThrottledRequests: 3,
+			inMaxRetries:        5,
+
+			wantedVPCs:      []string{"vpc-1"},
+			wantedAttempts:  []int{1, 2, 3},
+			wantedRecovered: 1,
+		},
+		"does not notify the observer if the request isn't throttled": {
+			inMaxRetries: 5,
+
+			wantedVPCs: []string{"vpc-1"},
+		},
+		"fails once the request runs out of retries": {
+			inThrottledRequests: 3,
+			inMaxRetries:        2,
+
+			wantedAttempts:  []int{1, 2},
+			wantedRecovered: 1,
+			wantedErr:       "describe VPCs: RequestLimitExceeded: Request limit exceeded.\n\tstatus code: 503, request id: 1",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			var requests int
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				if requests <= tc.inThrottledRequests {
+					w.WriteHeader(http.StatusServiceUnavailable)
+					fmt.Fprint(w, throttledResponse)
+					return
+				}
+				fmt.Fprint(w, vpcsResponse)
+			}))
+			defer server.Close()
+			sess := session.Must(session.NewSession(&aws.Config{
+				Endpoint:    aws.String(server.URL),
+				Region:      aws.String("us-west-2"),
+				Credentials: credentials.NewStaticCredentials("id", "secret", ""),
+				SleepDelay:  func(time.Duration) {},
+			}))
+			observer := &fakeThrottleObserver{}
+			client := New(sess, WithThrottlingRetries(RetryConfig{
+				MaxRetries: tc.inMaxRetries,
+				Observer:   observer,
+			}))
+
+			// WHEN
+			vpcs, err := client.ListVPC(context.Background())
+
+			// THEN
+			var attempts []int
+			for _, e := range observer.events {
+				require.Equal(t, "DescribeVpcs", e.Operation)
+				require.Equal(t, tc.inMaxRetries, e.MaxRetries)
+				attempts = append(attempts, e.Attempt)
+			}
+			require.Equal(t, tc.wantedAttempts, attempts)
+			require.Equal(t, tc.wantedRecovered, observer.recovered)
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedVPCs, vpcs)
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/request"
+	"sort"
 	"strings"
 	"time"
 
@@ -16,6 +17,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/trace"
 	"github.com/dustin/go-humanize"
 )
 
@@ -24,6 +26,10 @@ const (
 	shortImageDigestLength = 8
 	imageDigestPrefix      = "sha256:"
 
+	describeTasksBatchSize = 100 // Maximum number of tasks that can be described in a single DescribeTasks call.
+
+	deploymentStatusPrimary = "PRIMARY"
+
 	// DesiredStatusStopped represents the desired status "STOPPED" for a task.
 	DesiredStatusStopped = ecs.DesiredStatusStopped
 )
@@ -34,13 +40,17 @@ type api interface {
 	DescribeServices(input *ecs.DescribeServicesInput) (*ecs.DescribeServicesOutput, error)
 	ListTasks(input *ecs.ListTasksInput) (*ecs.ListTasksOutput, error)
 	DescribeClusters(input *ecs.DescribeClustersInput) (*ecs.DescribeClustersOutput, error)
-	RunTask(input *ecs.RunTaskInput) (*ecs.RunTaskOutput, error)
+	ListAccountSettings(input *ecs.ListAccountSettingsInput) (*ecs.ListAccountSettingsOutput, error)
+	RunTaskWithContext(ctx aws.Context, input *ecs.RunTaskInput, opts ...request.Option) (*ecs.RunTaskOutput, error)
+	StopTask(input *ecs.StopTaskInput) (*ecs.StopTaskOutput, error)
+	UpdateService(input *ecs.UpdateServiceInput) (*ecs.UpdateServiceOutput, error)
 	WaitUntilTasksRunning(input *ecs.DescribeTasksInput) error
 }
 
 // ECS wraps an AWS ECS client.
 type ECS struct {
-	client api
+	client     api
+	execClient commandExecuter
 }
 
 // TaskDefinition wraps up ECS TaskDefinition struct.
@@ -52,6 +62,13 @@ type Service ecs.Service
 // Task wraps up ECS Task struct.
 type Task ecs.Task
 
+// ServiceEvent is an event of a service, such as a deployment that completed or a task that couldn't be placed.
+type ServiceEvent struct {
+	ID        string    `json:"id"`
+	CreatedAt time.Time `json:"createdAt"`
+	Message   string    `json:"message"`
+}
+
 // ServiceStatus contains the status info of a service.
 type ServiceStatus struct {
 	DesiredCount     int64     `json:"desiredCount"`
@@ -59,6 +76,8 @@ type ServiceStatus struct {
 	Status           string    `json:"status"`
 	LastDeploymentAt time.Time `json:"lastDeploymentAt"`
 	TaskDefinition   string    `json:"taskDefinition"`
+	// ActiveDeployments is the number of previous deployments that the latest deployment hasn't replaced yet.
+	ActiveDeployments int `json:"activeDeployments,omitempty"`
 }
 
 // TaskStatus contains the status info of a task.
@@ -70,6 +89,24 @@ type TaskStatus struct {
 	StartedAt     time.Time `json:"startedAt"`
 	StoppedAt     time.Time `json:"stoppedAt"`
 	StoppedReason string    `json:"stoppedReason"`
+	// ExitCodes are the exit codes of the containers of the task that exited, keyed by container name.
+	ExitCodes map[string]int64 `json:"exitCodes,omitempty"`
+
+	AvailabilityZone string `json:"availabilityZone,omitempty"`
+	// CapacityProvider is the capacity provider that the task runs on, such as "FARGATE" or "FARGATE_SPOT".
+	CapacityProvider string            `json:"capacityProvider,omitempty"`
+	Containers       []ContainerStatus `json:"containers,omitempty"`
+}
+
+// ContainerStatus contains the status info of a container of a task.
+type ContainerStatus struct {
+	Name       string `json:"name"`
+	LastStatus string `json:"lastStatus"`
+	Health     string `json:"health"`
+	// CPUUtilized and MemoryUtilized are the CPU units and memory in MiB used by the container on average
+	// over the last minutes. They're only known if Container Insights is enabled on the cluster.
+	CPUUtilized    *float64 `json:"cpuUtilized,omitempty"`
+	MemoryUtilized *float64 `json:"memoryUtilized,omitempty"`
 }
 
 // HumanString returns the stringified TaskStatus struct with human readable format.
@@ -116,12 +153,15 @@ type RunTaskInput struct {
 	SecurityGroups []string
 	TaskFamilyName string
 	StartedBy      string
+	EnableExec     bool // Allows opening sessions into the containers of the tasks with ExecuteCommand.
 }
 
 // New returns a Service configured against the input session.
 func New(s *session.Session) *ECS {
+	client := ecs.New(s)
 	return &ECS{
-		client: ecs.New(s),
+		client:     client,
+		execClient: sdkClient{client},
 	}
 }
 
@@ -157,36 +197,138 @@ func (e *ECS) Service(clusterName, serviceName string) (*Service, error) {
 
 // ServiceTasks calls ECS API and returns ECS tasks running in the cluster.
 func (e *ECS) ServiceTasks(clusterName, serviceName string) ([]*Task, error) {
-	var tasks []*Task
+	return e.serviceTasks(clusterName, serviceName, "running", nil)
+}
+
+// StoppedServiceTasks returns the stopped tasks of the service in the cluster, most recently stopped first.
+// ECS only keeps stopped tasks for about an hour.
+func (e *ECS) StoppedServiceTasks(clusterName, serviceName string) ([]*Task, error) {
+	tasks, err := e.serviceTasks(clusterName, serviceName, "stopped", aws.String(ecs.DesiredStatusStopped))
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(tasks, func(i, j int) bool {
+		return aws.TimeValue(tasks[i].StoppedAt).After(aws.TimeValue(tasks[j].StoppedAt))
+	})
+	return tasks, nil
+}
+
+// ServiceEvents returns the events of the service in the cluster that happened after since, oldest first.
+// ECS only keeps the 100 most recent events of a service.
+func (e *ECS) ServiceEvents(clusterName, serviceName string, since time.Time) ([]ServiceEvent, error) {
+	svc, err := e.Service(clusterName, serviceName)
+	if err != nil {
+		return nil, err
+	}
+	var events []ServiceEvent
+	for _, event := range svc.Events {
+		createdAt := aws.TimeValue(event.CreatedAt)
+		if !createdAt.After(since) {
+			continue
+		}
+		events = append(events, ServiceEvent{
+			ID:        aws.StringValue(event.Id),
+			CreatedAt: createdAt,
+			Message:   aws.StringValue(event.Message),
+		})
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].CreatedAt.Before(events[j].CreatedAt)
+	})
+	return events, nil
+}
+
+// serviceTasks returns the tasks of the service with the desired status, which defaults to running if nil.
+func (e *ECS) serviceTasks(clusterName, serviceName, status string, desiredStatus *string) ([]*Task, error) {
+	var taskARNs []*string
 	var err error
 	listTaskResp := &ecs.ListTasksOutput{}
 	for {
 		listTaskResp, err = e.client.ListTasks(&ecs.ListTasksInput{
-			Cluster:     aws.String(clusterName),
-			ServiceName: aws.String(serviceName),
-			NextToken:   listTaskResp.NextToken,
+			Cluster:       aws.String(clusterName),
+			ServiceName:   aws.String(serviceName),
+			DesiredStatus: desiredStatus,
+			NextToken:     listTaskResp.NextToken,
 		})
 		if err != nil {
-			return nil, fmt.Errorf("list running tasks of service %s: %w", serviceName, err)
+			return nil, fmt.Errorf("list %s tasks of service %s: %w", status, serviceName, err)
+		}
+		taskARNs = append(taskARNs, listTaskResp.TaskArns...)
+		if listTaskResp.NextToken == nil {
+			break
 		}
-		descTaskResp, err := e.client.DescribeTasks(&ecs.DescribeTasksInput{
-			Cluster: aws.String(clusterName),
-			Tasks:   listTaskResp.TaskArns,
+	}
+	tasks, err := e.describeTasks(clusterName, aws.StringValueSlice(taskARNs))
+	if err != nil {
+		return nil, fmt.Errorf("describe %s tasks in cluster %s: %w", status, clusterName, err)
+	}
+	return tasks, nil
+}
+
+// RunningTasks returns the tasks in the cluster that were started by startedBy and haven't stopped yet.
+func (e *ECS) RunningTasks(cluster, startedBy string) ([]*Task, error) {
+	var taskARNs []*string
+	var err error
+	listTaskResp := &ecs.ListTasksOutput{}
+	for {
+		listTaskResp, err = e.client.ListTasks(&ecs.ListTasksInput{
+			Cluster:       aws.String(cluster),
+			StartedBy:     aws.String(startedBy),
+			DesiredStatus: aws.String(ecs.DesiredStatusRunning),
+			NextToken:     listTaskResp.NextToken,
 		})
 		if err != nil {
-			return nil, fmt.Errorf("describe running tasks in cluster %s: %w", clusterName, err)
-		}
-		for _, task := range descTaskResp.Tasks {
-			t := Task(*task)
-			tasks = append(tasks, &t)
+			return nil, fmt.Errorf("list running tasks started by %s: %w", startedBy, err)
 		}
+		taskARNs = append(taskARNs, listTaskResp.TaskArns...)
 		if listTaskResp.NextToken == nil {
 			break
 		}
 	}
+	tasks, err := e.describeTasks(cluster, aws.StringValueSlice(taskARNs))
+	if err != nil {
+		return nil, fmt.Errorf("describe running tasks in cluster %s: %w", cluster, err)
+	}
 	return tasks, nil
 }
 
+// StopTasks stops the tasks in the cluster, the reason is shown in the stopped reason of the tasks.
+func (e *ECS) StopTasks(cluster string, taskARNs []string, reason string) error {
+	for _, taskARN := range taskARNs {
+		if _, err := e.client.StopTask(&ecs.StopTaskInput{
+			Cluster: aws.String(cluster),
+			Task:    aws.String(taskARN),
+			Reason:  aws.String(reason),
+		}); err != nil {
+			return fmt.Errorf("stop task %s: %w", taskARN, err)
+		}
+	}
+	return nil
+}
+
+// ForceNewDeployment starts a deployment of the service that replaces all its tasks with the same task definition,
+// and returns the ID of the deployment. The new tasks are placed by the service's placement strategy, which
+// spreads them across availability zones.
+func (e *ECS) ForceNewDeployment(cluster, service string) (string, error) {
+	resp, err := e.client.UpdateService(&ecs.UpdateServiceInput{
+		Cluster:            aws.String(cluster),
+		Service:            aws.String(service),
+		ForceNewDeployment: aws.Bool(true),
+	})
+	if err != nil {
+		return "", fmt.Errorf("force new deployment of service %s: %w", service, err)
+	}
+	if resp.Service == nil {
+		return "", nil
+	}
+	for _, deployment := range resp.Service.Deployments {
+		if aws.StringValue(deployment.Status) == deploymentStatusPrimary {
+			return aws.StringValue(deployment.Id), nil
+		}
+	}
+	return "", nil
+}
+
 // DefaultCluster returns the default cluster ARN in the account and region.
 func (e *ECS) DefaultCluster() (string, error) {
 	resp, err := e.client.DescribeClusters(&ecs.DescribeClustersInput{})
@@ -203,6 +345,50 @@ func (e *ECS) DefaultCluster() (string, error) {
 	return aws.StringValue(cluster.ClusterArn), nil
 }
 
+// ClusterCapacity contains the number of tasks and services in a cluster.
+type ClusterCapacity struct {
+	RunningTasks   int `json:"runningTasks"`
+	PendingTasks   int `json:"pendingTasks"`
+	ActiveServices int `json:"activeServices"`
+}
+
+// ClusterCapacity returns the number of running and pending tasks and of active services in the cluster.
+func (e *ECS) ClusterCapacity(cluster string) (*ClusterCapacity, error) {
+	resp, err := e.client.DescribeClusters(&ecs.DescribeClustersInput{
+		Clusters: aws.StringSlice([]string{cluster}),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe cluster %s: %w", cluster, err)
+	}
+	if len(resp.Clusters) == 0 {
+		return nil, fmt.Errorf("cluster %s not found", cluster)
+	}
+	c := resp.Clusters[0]
+	return &ClusterCapacity{
+		RunningTasks:   int(aws.Int64Value(c.RunningTasksCount)),
+		PendingTasks:   int(aws.Int64Value(c.PendingTasksCount)),
+		ActiveServices: int(aws.Int64Value(c.ActiveServicesCount)),
+	}, nil
+}
+
+// AccountSetting returns the value of the ECS account setting that applies to the caller, such as "enabled" for
+// the "containerInsights" setting. It returns an empty string if the setting isn't set.
+func (e *ECS) AccountSetting(name string) (string, error) {
+	resp, err := e.client.ListAccountSettings(&ecs.ListAccountSettingsInput{
+		Name:              aws.String(name),
+		EffectiveSettings: aws.Bool(true),
+	})
+	if err != nil {
+		return "", fmt.Errorf("list account setting %s: %w", name, err)
+	}
+	for _, setting := range resp.Settings {
+		if aws.StringValue(setting.Name) == name {
+			return aws.StringValue(setting.Value), nil
+		}
+	}
+	return "", nil
+}
+
 // HasDefaultCluster tries to find the default cluster and returns true if there is one.
 func (e *ECS) HasDefaultCluster() (bool, error) {
 	if _, err := e.DefaultCluster(); err != nil {
@@ -217,7 +403,11 @@ func (e *ECS) HasDefaultCluster() (bool, error) {
 // RunTask runs a number of tasks with the task definition and network configurations in a cluster, and returns after
 // the task(s) is running or fails to run, along with task ARNs if possible.
 func (e *ECS) RunTask(input RunTaskInput) ([]*Task, error) {
-	resp, err := e.client.RunTask(&ecs.RunTaskInput{
+	var opts []request.Option
+	if input.EnableExec {
+		opts = append(opts, withExecuteCommandEnabled)
+	}
+	resp, err := e.client.RunTaskWithContext(aws.BackgroundContext(), &ecs.RunTaskInput{
 		Cluster:        aws.String(input.Cluster),
 		Count:          aws.Int64(int64(input.Count)),
 		LaunchType:     aws.String(ecs.LaunchTypeFargate),
@@ -230,7 +420,7 @@ func (e *ECS) RunTask(input RunTaskInput) ([]*Task, error) {
 				SecurityGroups: aws.StringSlice(input.SecurityGroups),
 			},
 		},
-	})
+	}, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("run task(s) %s: %w", input.TaskFamilyName, err)
 	}
@@ -240,10 +430,12 @@ func (e *ECS) RunTask(input RunTaskInput) ([]*Task, error) {
 		taskARNs[idx] = aws.StringValue(task.TaskArn)
 	}
 
+	span := trace.Start("wait for tasks running", trace.Attr("cluster", input.Cluster))
 	waitErr := e.client.WaitUntilTasksRunning(&ecs.DescribeTasksInput{
 		Cluster: aws.String(input.Cluster),
 		Tasks:   aws.StringSlice(taskARNs),
 	})
+	span.End(waitErr)
 
 	if waitErr != nil && !isRequestTimeoutErr(waitErr) {
 		return nil, fmt.Errorf("wait for tasks to be running: %w", err)
@@ -270,18 +462,51 @@ func isRequestTimeoutErr(err error) bool {
 
 // DescribeTasks returns the tasks with the taskARNs in the cluster.
 func (e *ECS) DescribeTasks(cluster string, taskARNs []string) ([]*Task, error) {
-	resp, err := e.client.DescribeTasks(&ecs.DescribeTasksInput{
-		Cluster: aws.String(cluster),
-		Tasks:   aws.StringSlice(taskARNs),
-	})
+	tasks, err := e.describeTasks(cluster, taskARNs)
 	if err != nil {
 		return nil, fmt.Errorf("describe tasks: %w", err)
 	}
+	return tasks, nil
+}
+
+// describeTasks describes the tasks in batches of at most describeTasksBatchSize ARNs, which is the limit of
+// the DescribeTasks API. The batches are described concurrently and the tasks are returned in the order of the ARNs.
+func (e *ECS) describeTasks(cluster string, taskARNs []string) ([]*Task, error) {
+	type result struct {
+		tasks []*ecs.Task
+		err   error
+	}
+	var batches []chan result
+	for start := 0; start < len(taskARNs); start += describeTasksBatchSize {
+		end := start + describeTasksBatchSize
+		if end > len(taskARNs) {
+			end = len(taskARNs)
+		}
+		batch := make(chan result, 1)
+		batches = append(batches, batch)
+		go func(arns []string) {
+			resp, err := e.client.DescribeTasks(&ecs.DescribeTasksInput{
+				Cluster: aws.String(cluster),
+				Tasks:   aws.StringSlice(arns),
+			})
+			if err != nil {
+				batch <- result{err: err}
+				return
+			}
+			batch <- result{tasks: resp.Tasks}
+		}(taskARNs[start:end])
+	}
 
-	tasks := make([]*Task, len(resp.Tasks))
-	for idx, task := range resp.Tasks {
-		t := Task(*task)
-		tasks[idx] = &t
+	var tasks []*Task
+	for _, batch := range batches {
+		res := <-batch
+		if res.err != nil {
+			return nil, res.err
+		}
+		for _, task := range res.tasks {
+			t := Task(*task)
+			tasks = append(tasks, &t)
+		}
 	}
 	return tasks, nil
 }
@@ -305,11 +530,25 @@ func (t *Task) TaskStatus() (*TaskStatus, error) {
 		stoppedReason = aws.StringValue(t.StoppedReason)
 	}
 	var images []Image
+	var containers []ContainerStatus
+	var exitCodes map[string]int64
 	for _, container := range t.Containers {
 		images = append(images, Image{
 			ID:     aws.StringValue(container.Image),
 			Digest: t.imageDigest(aws.StringValue(container.ImageDigest)),
 		})
+		containers = append(containers, ContainerStatus{
+			Name:       aws.StringValue(container.Name),
+			LastStatus: aws.StringValue(container.LastStatus),
+			Health:     aws.StringValue(container.HealthStatus),
+		})
+		if container.ExitCode == nil {
+			continue
+		}
+		if exitCodes == nil {
+			exitCodes = make(map[string]int64)
+		}
+		exitCodes[aws.StringValue(container.Name)] = aws.Int64Value(container.ExitCode)
 	}
 	return &TaskStatus{
 		Health:        aws.StringValue(t.HealthStatus),
@@ -319,6 +558,11 @@ func (t *Task) TaskStatus() (*TaskStatus, error) {
 		StartedAt:     startedAt,
 		StoppedAt:     stoppedAt,
 		StoppedReason: stoppedReason,
+		ExitCodes:     exitCodes,
+
+		AvailabilityZone: aws.StringValue(t.AvailabilityZone),
+		CapacityProvider: aws.StringValue(t.CapacityProviderName),
+		Containers:       containers,
 	}, nil
 }
 
@@ -345,11 +589,12 @@ func (t *Task) imageDigest(imageDigest string) string {
 // ServiceStatus returns the status of the running service.
 func (s *Service) ServiceStatus() ServiceStatus {
 	return ServiceStatus{
-		Status:           aws.StringValue(s.Status),
-		DesiredCount:     aws.Int64Value(s.DesiredCount),
-		RunningCount:     aws.Int64Value(s.RunningCount),
-		LastDeploymentAt: *s.Deployments[0].UpdatedAt, // FIXME Service assumed to have at least one deployment
-		TaskDefinition:   aws.StringValue(s.Deployments[0].TaskDefinition),
+		Status:            aws.StringValue(s.Status),
+		DesiredCount:      aws.Int64Value(s.DesiredCount),
+		RunningCount:      aws.Int64Value(s.RunningCount),
+		LastDeploymentAt:  *s.Deployments[0].UpdatedAt, // FIXME Service assumed to have at least one deployment
+		TaskDefinition:    aws.StringValue(s.Deployments[0].TaskDefinition),
+		ActiveDeployments: len(s.Deployments) - 1,
 	}
 }
 
@@ -362,6 +607,15 @@ func (t *TaskDefinition) EnvironmentVariables() map[string]string {
 	return envs
 }
 
+// Secrets returns the secrets of the task definition, keyed by name, along with where their values are referenced from.
+func (t *TaskDefinition) Secrets() map[string]string {
+	secrets := make(map[string]string)
+	for _, secret := range t.ContainerDefinitions[0].Secrets {
+		secrets[aws.StringValue(secret.Name)] = aws.StringValue(secret.ValueFrom)
+	}
+	return secrets
+}
+
 // ServiceArn is the arn of an ECS service.
 type ServiceArn string
 
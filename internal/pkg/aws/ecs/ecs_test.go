@@ -263,29 +263,272 @@ func TestECS_Tasks(t *testing.T) {
 					NextToken: aws.String("mockNextToken"),
 					TaskArns:  aws.StringSlice([]string{"mockTaskArn1"}),
 				}, nil)
+				m.EXPECT().ListTasks(&ecs.ListTasksInput{
+					Cluster:     aws.String("mockCluster"),
+					ServiceName: aws.String("mockService"),
+					NextToken:   aws.String("mockNextToken"),
+				}).Return(&ecs.ListTasksOutput{
+					NextToken: nil,
+					TaskArns:  aws.StringSlice([]string{"mockTaskArn2"}),
+				}, nil)
 				m.EXPECT().DescribeTasks(&ecs.DescribeTasksInput{
 					Cluster: aws.String("mockCluster"),
-					Tasks:   aws.StringSlice([]string{"mockTaskArn1"}),
+					Tasks:   aws.StringSlice([]string{"mockTaskArn1", "mockTaskArn2"}),
 				}).Return(&ecs.DescribeTasksOutput{
 					Tasks: []*ecs.Task{
 						{
 							TaskArn: aws.String("mockTaskArn1"),
 						},
+						{
+							TaskArn: aws.String("mockTaskArn2"),
+						},
 					},
 				}, nil)
+			},
+			wantTasks: []*Task{
+				{
+					TaskArn: aws.String("mockTaskArn1"),
+				},
+				{
+					TaskArn: aws.String("mockTaskArn2"),
+				},
+			},
+		},
+		"success without running tasks": {
+			clusterName: "mockCluster",
+			serviceName: "mockService",
+			mockECSClient: func(m *mocks.Mockapi) {
 				m.EXPECT().ListTasks(&ecs.ListTasksInput{
 					Cluster:     aws.String("mockCluster"),
 					ServiceName: aws.String("mockService"),
-					NextToken:   aws.String("mockNextToken"),
+				}).Return(&ecs.ListTasksOutput{}, nil)
+				m.EXPECT().DescribeTasks(gomock.Any()).Times(0)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECSClient := mocks.NewMockapi(ctrl)
+			tc.mockECSClient(mockECSClient)
+
+			service := ECS{
+				client: mockECSClient,
+			}
+
+			gotTasks, gotErr := service.ServiceTasks(tc.clusterName, tc.serviceName)
+
+			if gotErr != nil {
+				require.EqualError(t, tc.wantErr, gotErr.Error())
+			} else {
+				require.Equal(t, tc.wantTasks, gotTasks)
+			}
+		})
+
+	}
+}
+
+func TestECS_StoppedServiceTasks(t *testing.T) {
+	earlier := time.Date(2020, 10, 15, 10, 0, 0, 0, time.UTC)
+	later := earlier.Add(time.Minute)
+	testCases := map[string]struct {
+		mockECSClient func(m *mocks.Mockapi)
+
+		wantErr   error
+		wantTasks []*Task
+	}{
+		"errors if failed to list stopped tasks": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListTasks(&ecs.ListTasksInput{
+					Cluster:       aws.String("mockCluster"),
+					ServiceName:   aws.String("mockService"),
+					DesiredStatus: aws.String(ecs.DesiredStatusStopped),
+				}).Return(nil, errors.New("some error"))
+			},
+			wantErr: fmt.Errorf("list stopped tasks of service mockService: some error"),
+		},
+		"returns the most recently stopped tasks first": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListTasks(&ecs.ListTasksInput{
+					Cluster:       aws.String("mockCluster"),
+					ServiceName:   aws.String("mockService"),
+					DesiredStatus: aws.String(ecs.DesiredStatusStopped),
 				}).Return(&ecs.ListTasksOutput{
-					NextToken: nil,
-					TaskArns:  aws.StringSlice([]string{"mockTaskArn2"}),
+					TaskArns: aws.StringSlice([]string{"mockTaskArn1", "mockTaskArn2"}),
+				}, nil)
+				m.EXPECT().DescribeTasks(&ecs.DescribeTasksInput{
+					Cluster: aws.String("mockCluster"),
+					Tasks:   aws.StringSlice([]string{"mockTaskArn1", "mockTaskArn2"}),
+				}).Return(&ecs.DescribeTasksOutput{
+					Tasks: []*ecs.Task{
+						{TaskArn: aws.String("mockTaskArn1"), StoppedAt: aws.Time(earlier)},
+						{TaskArn: aws.String("mockTaskArn2"), StoppedAt: aws.Time(later)},
+					},
+				}, nil)
+			},
+			wantTasks: []*Task{
+				{TaskArn: aws.String("mockTaskArn2"), StoppedAt: aws.Time(later)},
+				{TaskArn: aws.String("mockTaskArn1"), StoppedAt: aws.Time(earlier)},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECSClient := mocks.NewMockapi(ctrl)
+			tc.mockECSClient(mockECSClient)
+
+			service := ECS{
+				client: mockECSClient,
+			}
+
+			gotTasks, gotErr := service.StoppedServiceTasks("mockCluster", "mockService")
+
+			if tc.wantErr != nil {
+				require.EqualError(t, gotErr, tc.wantErr.Error())
+			} else {
+				require.NoError(t, gotErr)
+				require.Equal(t, tc.wantTasks, gotTasks)
+			}
+		})
+	}
+}
+
+func TestECS_ServiceEvents(t *testing.T) {
+	since := time.Date(2020, 10, 15, 10, 0, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		mockECSClient func(m *mocks.Mockapi)
+
+		wantErr    error
+		wantEvents []ServiceEvent
+	}{
+		"errors if failed to describe the service": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeServices(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantErr: fmt.Errorf("describe service mockService: some error"),
+		},
+		"returns the events after since, oldest first": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeServices(&ecs.DescribeServicesInput{
+					Cluster:  aws.String("mockCluster"),
+					Services: aws.StringSlice([]string{"mockService"}),
+				}).Return(&ecs.DescribeServicesOutput{
+					Services: []*ecs.Service{
+						{
+							ServiceName: aws.String("mockService"),
+							Events: []*ecs.ServiceEvent{
+								{
+									Id:        aws.String("3"),
+									CreatedAt: aws.Time(since.Add(2 * time.Minute)),
+									Message:   aws.String("(service mockService) was unable to place a task because no container instance met all of its requirements."),
+								},
+								{
+									Id:        aws.String("2"),
+									CreatedAt: aws.Time(since.Add(time.Minute)),
+									Message:   aws.String("(service mockService) has started 1 tasks: (task 8c38184)."),
+								},
+								{
+									Id:        aws.String("1"),
+									CreatedAt: aws.Time(since),
+									Message:   aws.String("(service mockService) has reached a steady state."),
+								},
+							},
+						},
+					},
+				}, nil)
+			},
+			wantEvents: []ServiceEvent{
+				{
+					ID:        "2",
+					CreatedAt: since.Add(time.Minute),
+					Message:   "(service mockService) has started 1 tasks: (task 8c38184).",
+				},
+				{
+					ID:        "3",
+					CreatedAt: since.Add(2 * time.Minute),
+					Message:   "(service mockService) was unable to place a task because no container instance met all of its requirements.",
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECSClient := mocks.NewMockapi(ctrl)
+			tc.mockECSClient(mockECSClient)
+
+			service := ECS{
+				client: mockECSClient,
+			}
+
+			gotEvents, gotErr := service.ServiceEvents("mockCluster", "mockService", since)
+
+			if tc.wantErr != nil {
+				require.EqualError(t, gotErr, tc.wantErr.Error())
+			} else {
+				require.NoError(t, gotErr)
+				require.Equal(t, tc.wantEvents, gotEvents)
+			}
+		})
+	}
+}
+
+func TestECS_RunningTasks(t *testing.T) {
+	testCases := map[string]struct {
+		mockECSClient func(m *mocks.Mockapi)
+
+		wantErr   error
+		wantTasks []*Task
+	}{
+		"errors if failed to list running tasks": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListTasks(&ecs.ListTasksInput{
+					Cluster:       aws.String("mockCluster"),
+					StartedBy:     aws.String("copilot-task"),
+					DesiredStatus: aws.String("RUNNING"),
+				}).Return(nil, errors.New("some error"))
+			},
+			wantErr: fmt.Errorf("list running tasks started by copilot-task: some error"),
+		},
+		"success with pagination": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListTasks(&ecs.ListTasksInput{
+					Cluster:       aws.String("mockCluster"),
+					StartedBy:     aws.String("copilot-task"),
+					DesiredStatus: aws.String("RUNNING"),
+				}).Return(&ecs.ListTasksOutput{
+					NextToken: aws.String("mockNextToken"),
+					TaskArns:  aws.StringSlice([]string{"mockTaskArn1"}),
+				}, nil)
+				m.EXPECT().ListTasks(&ecs.ListTasksInput{
+					Cluster:       aws.String("mockCluster"),
+					StartedBy:     aws.String("copilot-task"),
+					DesiredStatus: aws.String("RUNNING"),
+					NextToken:     aws.String("mockNextToken"),
+				}).Return(&ecs.ListTasksOutput{
+					TaskArns: aws.StringSlice([]string{"mockTaskArn2"}),
 				}, nil)
 				m.EXPECT().DescribeTasks(&ecs.DescribeTasksInput{
 					Cluster: aws.String("mockCluster"),
-					Tasks:   aws.StringSlice([]string{"mockTaskArn2"}),
+					Tasks:   aws.StringSlice([]string{"mockTaskArn1", "mockTaskArn2"}),
 				}).Return(&ecs.DescribeTasksOutput{
 					Tasks: []*ecs.Task{
+						{
+							TaskArn: aws.String("mockTaskArn1"),
+						},
 						{
 							TaskArn: aws.String("mockTaskArn2"),
 						},
@@ -316,15 +559,141 @@ func TestECS_Tasks(t *testing.T) {
 				client: mockECSClient,
 			}
 
-			gotTasks, gotErr := service.ServiceTasks(tc.clusterName, tc.serviceName)
+			// WHEN
+			gotTasks, gotErr := service.RunningTasks("mockCluster", "copilot-task")
 
-			if gotErr != nil {
-				require.EqualError(t, tc.wantErr, gotErr.Error())
-			} else {
-				require.Equal(t, tc.wantTasks, gotTasks)
+			// THEN
+			if tc.wantErr != nil {
+				require.EqualError(t, gotErr, tc.wantErr.Error())
+				return
 			}
+			require.NoError(t, gotErr)
+			require.Equal(t, tc.wantTasks, gotTasks)
 		})
+	}
+}
 
+func TestECS_StopTasks(t *testing.T) {
+	testCases := map[string]struct {
+		mockECSClient func(m *mocks.Mockapi)
+
+		wantErr error
+	}{
+		"errors if failed to stop a task": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().StopTask(&ecs.StopTaskInput{
+					Cluster: aws.String("mockCluster"),
+					Task:    aws.String("mockTaskArn1"),
+					Reason:  aws.String("some reason"),
+				}).Return(nil, errors.New("some error"))
+			},
+			wantErr: fmt.Errorf("stop task mockTaskArn1: some error"),
+		},
+		"success": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().StopTask(&ecs.StopTaskInput{
+					Cluster: aws.String("mockCluster"),
+					Task:    aws.String("mockTaskArn1"),
+					Reason:  aws.String("some reason"),
+				}).Return(&ecs.StopTaskOutput{}, nil)
+				m.EXPECT().StopTask(&ecs.StopTaskInput{
+					Cluster: aws.String("mockCluster"),
+					Task:    aws.String("mockTaskArn2"),
+					Reason:  aws.String("some reason"),
+				}).Return(&ecs.StopTaskOutput{}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECSClient := mocks.NewMockapi(ctrl)
+			tc.mockECSClient(mockECSClient)
+
+			service := ECS{
+				client: mockECSClient,
+			}
+
+			// WHEN
+			err := service.StopTasks("mockCluster", []string{"mockTaskArn1", "mockTaskArn2"}, "some reason")
+
+			// THEN
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestECS_ForceNewDeployment(t *testing.T) {
+	mockInput := &ecs.UpdateServiceInput{
+		Cluster:            aws.String("mockCluster"),
+		Service:            aws.String("mockService"),
+		ForceNewDeployment: aws.Bool(true),
+	}
+	testCases := map[string]struct {
+		mockECSClient func(m *mocks.Mockapi)
+
+		wantID  string
+		wantErr error
+	}{
+		"errors if failed to update the service": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().UpdateService(mockInput).Return(nil, errors.New("some error"))
+			},
+			wantErr: fmt.Errorf("force new deployment of service mockService: some error"),
+		},
+		"returns the ID of the primary deployment": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().UpdateService(mockInput).Return(&ecs.UpdateServiceOutput{
+					Service: &ecs.Service{
+						Deployments: []*ecs.Deployment{
+							{
+								Id:     aws.String("ecs-svc/2"),
+								Status: aws.String("PRIMARY"),
+							},
+							{
+								Id:     aws.String("ecs-svc/1"),
+								Status: aws.String("ACTIVE"),
+							},
+						},
+					},
+				}, nil)
+			},
+			wantID: "ecs-svc/2",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECSClient := mocks.NewMockapi(ctrl)
+			tc.mockECSClient(mockECSClient)
+
+			service := ECS{
+				client: mockECSClient,
+			}
+
+			// WHEN
+			id, err := service.ForceNewDeployment("mockCluster", "mockService")
+
+			// THEN
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantID, id)
+		})
 	}
 }
 
@@ -386,6 +755,134 @@ func TestECS_DefaultCluster(t *testing.T) {
 	}
 }
 
+func TestECS_ClusterCapacity(t *testing.T) {
+	testCases := map[string]struct {
+		mockECSClient func(m *mocks.Mockapi)
+
+		wantedCapacity *ClusterCapacity
+		wantedError    error
+	}{
+		"errors if failed to describe the cluster": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeClusters(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe cluster mockCluster: some error"),
+		},
+		"errors if the cluster doesn't exist": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeClusters(gomock.Any()).Return(&ecs.DescribeClustersOutput{}, nil)
+			},
+			wantedError: errors.New("cluster mockCluster not found"),
+		},
+		"returns the number of tasks and services": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeClusters(&ecs.DescribeClustersInput{
+					Clusters: aws.StringSlice([]string{"mockCluster"}),
+				}).Return(&ecs.DescribeClustersOutput{
+					Clusters: []*ecs.Cluster{
+						{
+							ClusterName:         aws.String("mockCluster"),
+							RunningTasksCount:   aws.Int64(3),
+							PendingTasksCount:   aws.Int64(1),
+							ActiveServicesCount: aws.Int64(2),
+						},
+					},
+				}, nil)
+			},
+			wantedCapacity: &ClusterCapacity{
+				RunningTasks:   3,
+				PendingTasks:   1,
+				ActiveServices: 2,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockECSClient := mocks.NewMockapi(ctrl)
+			tc.mockECSClient(mockECSClient)
+			service := ECS{
+				client: mockECSClient,
+			}
+
+			// WHEN
+			capacity, err := service.ClusterCapacity("mockCluster")
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedCapacity, capacity)
+		})
+	}
+}
+
+func TestECS_AccountSetting(t *testing.T) {
+	testCases := map[string]struct {
+		mockECSClient func(m *mocks.Mockapi)
+
+		wantedValue string
+		wantedError error
+	}{
+		"errors if failed to list the account settings": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListAccountSettings(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("list account setting containerInsights: some error"),
+		},
+		"returns an empty value if the setting isn't set": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListAccountSettings(gomock.Any()).Return(&ecs.ListAccountSettingsOutput{}, nil)
+			},
+		},
+		"returns the effective value of the setting": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListAccountSettings(&ecs.ListAccountSettingsInput{
+					Name:              aws.String("containerInsights"),
+					EffectiveSettings: aws.Bool(true),
+				}).Return(&ecs.ListAccountSettingsOutput{
+					Settings: []*ecs.Setting{
+						{
+							Name:  aws.String("containerInsights"),
+							Value: aws.String("enabled"),
+						},
+					},
+				}, nil)
+			},
+			wantedValue: "enabled",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockECSClient := mocks.NewMockapi(ctrl)
+			tc.mockECSClient(mockECSClient)
+			service := ECS{
+				client: mockECSClient,
+			}
+
+			// WHEN
+			value, err := service.AccountSetting("containerInsights")
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedValue, value)
+		})
+	}
+}
+
 func TestECS_HasDefaultCluster(t *testing.T) {
 	testCases := map[string] struct{
 		mockECSClient func(m *mocks.Mockapi)
@@ -455,6 +952,7 @@ func TestECS_RunTask(t *testing.T) {
 		securityGroups []string
 		taskFamilyName string
 		startedBy      string
+		enableExec     bool
 	}
 
 	runTaskInput := input{
@@ -477,7 +975,7 @@ func TestECS_RunTask(t *testing.T) {
 		"run task success": {
 			input: runTaskInput,
 			mockECSClient: func(m *mocks.Mockapi) {
-				m.EXPECT().RunTask(&ecs.RunTaskInput{
+				m.EXPECT().RunTaskWithContext(gomock.Any(), &ecs.RunTaskInput{
 					Cluster:        aws.String("my-cluster"),
 					Count:          aws.Int64(3),
 					LaunchType:     aws.String(ecs.LaunchTypeFargate),
@@ -542,7 +1040,7 @@ func TestECS_RunTask(t *testing.T) {
 			input: runTaskInput,
 
 			mockECSClient: func(m *mocks.Mockapi) {
-				m.EXPECT().RunTask(&ecs.RunTaskInput{
+				m.EXPECT().RunTaskWithContext(gomock.Any(), &ecs.RunTaskInput{
 					Cluster:        aws.String("my-cluster"),
 					Count:          aws.Int64(3),
 					LaunchType:     aws.String(ecs.LaunchTypeFargate),
@@ -561,6 +1059,22 @@ func TestECS_RunTask(t *testing.T) {
 			},
 			wantedError: errors.New("run task(s) my-task: error"),
 		},
+		"run task with exec enabled": {
+			input: input{
+				cluster:        "my-cluster",
+				count:          1,
+				taskFamilyName: "my-task",
+				startedBy:      "task",
+				enableExec:     true,
+			},
+
+			mockECSClient: func(m *mocks.Mockapi) {
+				// The option that enables exec is the only variadic argument.
+				m.EXPECT().RunTaskWithContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(&ecs.RunTaskOutput{}, errors.New("error"))
+			},
+			wantedError: errors.New("run task(s) my-task: error"),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -582,6 +1096,7 @@ func TestECS_RunTask(t *testing.T) {
 				Subnets:        tc.subnets,
 				SecurityGroups: tc.securityGroups,
 				StartedBy:      tc.startedBy,
+				EnableExec:     tc.enableExec,
 			})
 
 			if tc.wantedError != nil {
@@ -596,7 +1111,12 @@ func TestECS_RunTask(t *testing.T) {
 func TestECS_DescribeTasks(t *testing.T) {
 	inCluster := "my-cluster"
 	inTaskARNs := []string{"task-1", "task-2", "task-3"}
+	var manyTaskARNs []string
+	for i := 0; i < 150; i++ {
+		manyTaskARNs = append(manyTaskARNs, fmt.Sprintf("task-%d", i))
+	}
 	testCases := map[string]struct {
+		inTaskARNs  []string
 		mockAPI     func(m *mocks.Mockapi)
 		wantedError error
 		wantedTasks []*Task
@@ -641,6 +1161,30 @@ func TestECS_DescribeTasks(t *testing.T) {
 				},
 			},
 		},
+		"describes more than 100 tasks in batches": {
+			inTaskARNs: manyTaskARNs,
+			mockAPI: func(m *mocks.Mockapi) {
+				for _, batch := range [][]string{manyTaskARNs[:100], manyTaskARNs[100:]} {
+					var tasks []*ecs.Task
+					for _, arn := range batch {
+						tasks = append(tasks, &ecs.Task{TaskArn: aws.String(arn)})
+					}
+					m.EXPECT().DescribeTasks(&ecs.DescribeTasksInput{
+						Cluster: aws.String(inCluster),
+						Tasks:   aws.StringSlice(batch),
+					}).Return(&ecs.DescribeTasksOutput{
+						Tasks: tasks,
+					}, nil)
+				}
+			},
+			wantedTasks: func() []*Task {
+				var tasks []*Task
+				for _, arn := range manyTaskARNs {
+					tasks = append(tasks, &Task{TaskArn: aws.String(arn)})
+				}
+				return tasks
+			}(),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -655,7 +1199,11 @@ func TestECS_DescribeTasks(t *testing.T) {
 				client: mockAPI,
 			}
 
-			tasks, err := ecs.DescribeTasks(inCluster, inTaskARNs)
+			taskARNs := inTaskARNs
+			if tc.inTaskARNs != nil {
+				taskARNs = tc.inTaskARNs
+			}
+			tasks, err := ecs.DescribeTasks(inCluster, taskARNs)
 			if tc.wantedError != nil {
 				require.EqualError(t, tc.wantedError, err.Error())
 			} else {
@@ -713,6 +1261,25 @@ func TestTaskDefinition_EnvVars(t *testing.T) {
 	}
 }
 
+func TestTaskDefinition_Secrets(t *testing.T) {
+	taskDefinition := TaskDefinition{
+		ContainerDefinitions: []*ecs.ContainerDefinition{
+			{
+				Secrets: []*ecs.Secret{
+					{
+						Name:      aws.String("GITHUB_TOKEN"),
+						ValueFrom: aws.String("/copilot/phonetool/test/secrets/github-token"),
+					},
+				},
+			},
+		},
+	}
+
+	require.Equal(t, map[string]string{
+		"GITHUB_TOKEN": "/copilot/phonetool/test/secrets/github-token",
+	}, taskDefinition.Secrets())
+}
+
 func TestTask_TaskStatus(t *testing.T) {
 	startTime, _ := time.Parse(time.RFC3339, "2006-01-02T15:04:05+00:00")
 	stopTime, _ := time.Parse(time.RFC3339, "2006-01-02T16:04:05+00:00")
@@ -725,6 +1292,7 @@ func TestTask_TaskStatus(t *testing.T) {
 		startedAt     time.Time
 		stoppedAt     time.Time
 		stoppedReason *string
+		capacity      *string
 
 		wantTaskStatus *TaskStatus
 		wantErr        error
@@ -737,8 +1305,10 @@ func TestTask_TaskStatus(t *testing.T) {
 			taskArn: aws.String("arn:aws:ecs:us-west-2:123456789:task/my-project-test-Cluster-9F7Y0RLP60R7/4082490ee6c245e09d2145010aa1ba8d"),
 			containers: []*ecs.Container{
 				{
+					Name:        aws.String("frontend"),
 					Image:       aws.String("mockImageArn"),
 					ImageDigest: aws.String("sha256:" + mockImageDigest),
+					LastStatus:  aws.String("PENDING"),
 				},
 			},
 			health:     aws.String("HEALTHY"),
@@ -754,19 +1324,29 @@ func TestTask_TaskStatus(t *testing.T) {
 					},
 				},
 				LastStatus: "UNKNOWN",
+				Containers: []ContainerStatus{
+					{
+						Name:       "frontend",
+						LastStatus: "PENDING",
+					},
+				},
 			},
 		},
 		"success with a running task": {
 			taskArn: aws.String("arn:aws:ecs:us-west-2:123456789:task/my-project-test-Cluster-9F7Y0RLP60R7/4082490ee6c245e09d2145010aa1ba8d"),
 			containers: []*ecs.Container{
 				{
-					Image:       aws.String("mockImageArn"),
-					ImageDigest: aws.String("sha256:" + mockImageDigest),
+					Name:         aws.String("frontend"),
+					Image:        aws.String("mockImageArn"),
+					ImageDigest:  aws.String("sha256:" + mockImageDigest),
+					LastStatus:   aws.String("RUNNING"),
+					HealthStatus: aws.String("HEALTHY"),
 				},
 			},
 			health:     aws.String("HEALTHY"),
 			lastStatus: aws.String("UNKNOWN"),
 			startedAt:  startTime,
+			capacity:   aws.String("FARGATE_SPOT"),
 
 			wantTaskStatus: &TaskStatus{
 				Health: "HEALTHY",
@@ -777,16 +1357,30 @@ func TestTask_TaskStatus(t *testing.T) {
 						ID:     "mockImageArn",
 					},
 				},
-				LastStatus: "UNKNOWN",
-				StartedAt:  startTime,
+				LastStatus:       "UNKNOWN",
+				StartedAt:        startTime,
+				CapacityProvider: "FARGATE_SPOT",
+				Containers: []ContainerStatus{
+					{
+						Name:       "frontend",
+						LastStatus: "RUNNING",
+						Health:     "HEALTHY",
+					},
+				},
 			},
 		},
 		"success with a stopped task": {
 			taskArn: aws.String("arn:aws:ecs:us-west-2:123456789:task/my-project-test-Cluster-9F7Y0RLP60R7/4082490ee6c245e09d2145010aa1ba8d"),
 			containers: []*ecs.Container{
 				{
+					Name:        aws.String("frontend"),
 					Image:       aws.String("mockImageArn"),
 					ImageDigest: aws.String("sha256:" + mockImageDigest),
+					ExitCode:    aws.Int64(137),
+				},
+				{
+					Name:  aws.String("sidecar"),
+					Image: aws.String("mockSidecarImage"),
 				},
 			},
 			health:        aws.String("HEALTHY"),
@@ -803,11 +1397,25 @@ func TestTask_TaskStatus(t *testing.T) {
 						Digest: mockImageDigest,
 						ID:     "mockImageArn",
 					},
+					{
+						ID: "mockSidecarImage",
+					},
 				},
 				LastStatus:    "UNKNOWN",
 				StartedAt:     startTime,
 				StoppedAt:     stopTime,
 				StoppedReason: "some reason",
+				ExitCodes: map[string]int64{
+					"frontend": 137,
+				},
+				Containers: []ContainerStatus{
+					{
+						Name: "frontend",
+					},
+					{
+						Name: "sidecar",
+					},
+				},
 			},
 		},
 	}
@@ -826,6 +1434,8 @@ func TestTask_TaskStatus(t *testing.T) {
 				StartedAt:     &tc.startedAt,
 				StoppedAt:     &tc.stoppedAt,
 				StoppedReason: tc.stoppedReason,
+
+				CapacityProviderName: tc.capacity,
 			}
 
 			gotTaskStatus, gotErr := task.TaskStatus()
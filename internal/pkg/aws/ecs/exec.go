@@ -0,0 +1,127 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ecs
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/ecs"
+)
+
+const executeCommandOperation = "ExecuteCommand"
+
+// ExecuteCommandInput holds the fields to run a command in a container of a running task.
+type ExecuteCommandInput struct {
+	Cluster   string
+	Task      string
+	Container string
+	Command   string
+}
+
+// Session is an SSM Session Manager session opened by ExecuteCommand.
+// The field names are the ones expected by the session-manager-plugin.
+type Session struct {
+	SessionID  string `json:"SessionId"`
+	StreamURL  string `json:"StreamUrl"`
+	TokenValue string `json:"TokenValue"`
+}
+
+// ExecuteCommand starts an interactive command in a container of a running task, and returns
+// the SSM session to connect to with the session-manager-plugin.
+func (e *ECS) ExecuteCommand(in ExecuteCommandInput) (*Session, error) {
+	session, err := e.execClient.ExecuteCommand(&in)
+	if err != nil {
+		return nil, fmt.Errorf("execute command %s in container %s of task %s: %w", in.Command, in.Container, in.Task, err)
+	}
+	return session, nil
+}
+
+// commandExecuter calls the ExecuteCommand API, which isn't modeled by the vendored SDK.
+type commandExecuter interface {
+	ExecuteCommand(in *ExecuteCommandInput) (*Session, error)
+}
+
+// sdkClient adds the ExecuteCommand API to the ECS client of the SDK.
+type sdkClient struct {
+	*ecs.ECS
+}
+
+// executeCommandInput and executeCommandOutput are the wire shapes of the ExecuteCommand API.
+type executeCommandInput struct {
+	_ struct{} `type:"structure"`
+
+	Cluster     *string `locationName:"cluster" type:"string"`
+	Command     *string `locationName:"command" type:"string" required:"true"`
+	Container   *string `locationName:"container" type:"string"`
+	Interactive *bool   `locationName:"interactive" type:"boolean" required:"true"`
+	Task        *string `locationName:"task" type:"string" required:"true"`
+}
+
+type executeCommandOutput struct {
+	_ struct{} `type:"structure"`
+
+	Session *executeCommandSession `locationName:"session" type:"structure"`
+}
+
+type executeCommandSession struct {
+	_ struct{} `type:"structure"`
+
+	SessionID  *string `locationName:"sessionId" type:"string"`
+	StreamURL  *string `locationName:"streamUrl" type:"string"`
+	TokenValue *string `locationName:"tokenValue" type:"string" sensitive:"true"`
+}
+
+// ExecuteCommand calls the ExecuteCommand API of Amazon ECS with an interactive command.
+func (c sdkClient) ExecuteCommand(in *ExecuteCommandInput) (*Session, error) {
+	out := &executeCommandOutput{}
+	req := c.NewRequest(&request.Operation{
+		Name:       executeCommandOperation,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}, &executeCommandInput{
+		Cluster:     aws.String(in.Cluster),
+		Command:     aws.String(in.Command),
+		Container:   aws.String(in.Container),
+		Interactive: aws.Bool(true),
+		Task:        aws.String(in.Task),
+	}, out)
+	if err := req.Send(); err != nil {
+		return nil, err
+	}
+	if out.Session == nil {
+		return nil, fmt.Errorf("no session returned")
+	}
+	return &Session{
+		SessionID:  aws.StringValue(out.Session.SessionID),
+		StreamURL:  aws.StringValue(out.Session.StreamURL),
+		TokenValue: aws.StringValue(out.Session.TokenValue),
+	}, nil
+}
+
+// withExecuteCommandEnabled sets "enableExecuteCommand" in the body of a RunTask request,
+// which isn't modeled by the vendored SDK either.
+func withExecuteCommandEnabled(r *request.Request) {
+	r.Handlers.Build.PushBack(func(r *request.Request) {
+		if r.Error != nil {
+			return
+		}
+		body := make(map[string]interface{})
+		dec := json.NewDecoder(r.GetBody())
+		dec.UseNumber()
+		if err := dec.Decode(&body); err != nil {
+			r.Error = fmt.Errorf("decode body of %s request: %w", r.Operation.Name, err)
+			return
+		}
+		body["enableExecuteCommand"] = true
+		data, err := json.Marshal(body)
+		if err != nil {
+			r.Error = fmt.Errorf("encode body of %s request: %w", r.Operation.Name, err)
+			return
+		}
+		r.SetBufferBody(data)
+	})
+}
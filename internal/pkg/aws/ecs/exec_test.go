@@ -0,0 +1,147 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ecs
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeCommandExecuter returns a canned session or error.
+type fakeCommandExecuter struct {
+	in      *ExecuteCommandInput
+	session *Session
+	err     error
+}
+
+func (f *fakeCommandExecuter) ExecuteCommand(in *ExecuteCommandInput) (*Session, error) {
+	f.in = in
+	return f.session, f.err
+}
+
+func TestECS_ExecuteCommand(t *testing.T) {
+	in := ExecuteCommandInput{
+		Cluster:   "my-cluster",
+		Task:      "arn:aws:ecs:us-west-2:1234:task/my-cluster/abc",
+		Container: "api",
+		Command:   "/bin/sh",
+	}
+	testCases := map[string]struct {
+		client *fakeCommandExecuter
+
+		wantedSession *Session
+		wantedErr     string
+	}{
+		"returns the session": {
+			client:        &fakeCommandExecuter{session: &Session{SessionID: "ecs-execute-command-123"}},
+			wantedSession: &Session{SessionID: "ecs-execute-command-123"},
+		},
+		"wraps the error": {
+			client:    &fakeCommandExecuter{err: errors.New("some error")},
+			wantedErr: "execute command /bin/sh in container api of task arn:aws:ecs:us-west-2:1234:task/my-cluster/abc: some error",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			e := ECS{
+				execClient: tc.client,
+			}
+
+			session, err := e.ExecuteCommand(in)
+
+			require.Equal(t, &in, tc.client.in)
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedSession, session)
+		})
+	}
+}
+
+func TestSDKClient_ExecuteCommand(t *testing.T) {
+	// GIVEN
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "AmazonEC2ContainerServiceV20141113.ExecuteCommand", r.Header.Get("X-Amz-Target"))
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		require.Equal(t, map[string]interface{}{
+			"cluster":     "my-cluster",
+			"command":     "/bin/sh",
+			"container":   "api",
+			"interactive": true,
+			"task":        "abc",
+		}, body)
+		w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+		w.Write([]byte(`{"session":{"sessionId":"ecs-execute-command-123","streamUrl":"wss://ssmmessages","tokenValue":"token"}}`))
+	}))
+	defer server.Close()
+	sess := session.Must(session.NewSession(&aws.Config{
+		Endpoint:    aws.String(server.URL),
+		Region:      aws.String("us-west-2"),
+		Credentials: credentials.NewStaticCredentials("id", "secret", ""),
+	}))
+	client := sdkClient{ecs.New(sess)}
+
+	// WHEN
+	got, err := client.ExecuteCommand(&ExecuteCommandInput{
+		Cluster:   "my-cluster",
+		Task:      "abc",
+		Container: "api",
+		Command:   "/bin/sh",
+	})
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, &Session{
+		SessionID:  "ecs-execute-command-123",
+		StreamURL:  "wss://ssmmessages",
+		TokenValue: "token",
+	}, got)
+}
+
+func TestWithExecuteCommandEnabled(t *testing.T) {
+	// GIVEN
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "AmazonEC2ContainerServiceV20141113.RunTask", r.Header.Get("X-Amz-Target"))
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		require.Equal(t, map[string]interface{}{
+			"cluster":              "my-cluster",
+			"count":                float64(2),
+			"enableExecuteCommand": true,
+			"taskDefinition":       "copilot-db-migrate",
+		}, body)
+		w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+		w.Write([]byte(`{"tasks":[{"taskArn":"task-1"}]}`))
+	}))
+	defer server.Close()
+	sess := session.Must(session.NewSession(&aws.Config{
+		Endpoint:    aws.String(server.URL),
+		Region:      aws.String("us-west-2"),
+		Credentials: credentials.NewStaticCredentials("id", "secret", ""),
+	}))
+
+	// WHEN
+	out, err := ecs.New(sess).RunTaskWithContext(aws.BackgroundContext(), &ecs.RunTaskInput{
+		Cluster:        aws.String("my-cluster"),
+		Count:          aws.Int64(2),
+		TaskDefinition: aws.String("copilot-db-migrate"),
+	}, withExecuteCommandEnabled)
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, "task-1", aws.StringValue(out.Tasks[0].TaskArn))
+}
@@ -5,6 +5,8 @@
 package mocks
 
 import (
+	aws "github.com/aws/aws-sdk-go/aws"
+	request "github.com/aws/aws-sdk-go/aws/request"
 	ecs "github.com/aws/aws-sdk-go/service/ecs"
 	gomock "github.com/golang/mock/gomock"
 	reflect "reflect"
@@ -108,19 +110,69 @@ func (mr *MockapiMockRecorder) DescribeClusters(input interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeClusters", reflect.TypeOf((*Mockapi)(nil).DescribeClusters), input)
 }
 
-// RunTask mocks base method
-func (m *Mockapi) RunTask(input *ecs.RunTaskInput) (*ecs.RunTaskOutput, error) {
+// ListAccountSettings mocks base method
+func (m *Mockapi) ListAccountSettings(input *ecs.ListAccountSettingsInput) (*ecs.ListAccountSettingsOutput, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RunTask", input)
+	ret := m.ctrl.Call(m, "ListAccountSettings", input)
+	ret0, _ := ret[0].(*ecs.ListAccountSettingsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccountSettings indicates an expected call of ListAccountSettings
+func (mr *MockapiMockRecorder) ListAccountSettings(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccountSettings", reflect.TypeOf((*Mockapi)(nil).ListAccountSettings), input)
+}
+
+// RunTaskWithContext mocks base method
+func (m *Mockapi) RunTaskWithContext(ctx aws.Context, input *ecs.RunTaskInput, opts ...request.Option) (*ecs.RunTaskOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RunTaskWithContext", varargs...)
 	ret0, _ := ret[0].(*ecs.RunTaskOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// RunTask indicates an expected call of RunTask
-func (mr *MockapiMockRecorder) RunTask(input interface{}) *gomock.Call {
+// RunTaskWithContext indicates an expected call of RunTaskWithContext
+func (mr *MockapiMockRecorder) RunTaskWithContext(ctx, input interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunTaskWithContext", reflect.TypeOf((*Mockapi)(nil).RunTaskWithContext), varargs...)
+}
+
+// StopTask mocks base method
+func (m *Mockapi) StopTask(input *ecs.StopTaskInput) (*ecs.StopTaskOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopTask", input)
+	ret0, _ := ret[0].(*ecs.StopTaskOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StopTask indicates an expected call of StopTask
+func (mr *MockapiMockRecorder) StopTask(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopTask", reflect.TypeOf((*Mockapi)(nil).StopTask), input)
+}
+
+// UpdateService mocks base method
+func (m *Mockapi) UpdateService(input *ecs.UpdateServiceInput) (*ecs.UpdateServiceOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateService", input)
+	ret0, _ := ret[0].(*ecs.UpdateServiceOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateService indicates an expected call of UpdateService
+func (mr *MockapiMockRecorder) UpdateService(input interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunTask", reflect.TypeOf((*Mockapi)(nil).RunTask), input)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateService", reflect.TypeOf((*Mockapi)(nil).UpdateService), input)
 }
 
 // WaitUntilTasksRunning mocks base method
@@ -0,0 +1,170 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ecs
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+)
+
+// Actions of a KeyValueChange.
+const (
+	ChangeAdded    = "added"
+	ChangeRemoved  = "removed"
+	ChangeModified = "modified"
+)
+
+// ValueChange is the old and new values of a field of a task definition.
+type ValueChange struct {
+	Old string `json:"old"`
+	New string `json:"new"`
+}
+
+// KeyValueChange is a change to a named environment variable or secret of a task definition.
+type KeyValueChange struct {
+	Key    string `json:"key"`
+	Action string `json:"action"` // One of "added", "removed" or "modified".
+	Old    string `json:"old,omitempty"`
+	New    string `json:"new,omitempty"`
+}
+
+// TaskDefinitionDiff is the difference between two revisions of a task definition.
+// A nil field means that the field didn't change.
+type TaskDefinitionDiff struct {
+	Image   *ValueChange     `json:"image,omitempty"`
+	CPU     *ValueChange     `json:"cpu,omitempty"`
+	Memory  *ValueChange     `json:"memory,omitempty"`
+	EnvVars []KeyValueChange `json:"environmentVariables,omitempty"`
+	Secrets []KeyValueChange `json:"secrets,omitempty"`
+}
+
+// DiffTaskDefinitions describes two task definitions and returns the changes from the old one to the new one.
+func (e *ECS) DiffTaskDefinitions(oldTaskDef, newTaskDef string) (*TaskDefinitionDiff, error) {
+	from, err := e.TaskDefinition(oldTaskDef)
+	if err != nil {
+		return nil, err
+	}
+	to, err := e.TaskDefinition(newTaskDef)
+	if err != nil {
+		return nil, err
+	}
+	return from.Diff(to), nil
+}
+
+// Diff returns the changes from the task definition to the other task definition.
+func (t *TaskDefinition) Diff(other *TaskDefinition) *TaskDefinitionDiff {
+	return &TaskDefinitionDiff{
+		Image:   valueChange(t.image(), other.image()),
+		CPU:     valueChange(aws.StringValue(t.Cpu), aws.StringValue(other.Cpu)),
+		Memory:  valueChange(aws.StringValue(t.Memory), aws.StringValue(other.Memory)),
+		EnvVars: keyValueChanges(t.containerEnvironmentVariables(), other.containerEnvironmentVariables()),
+		Secrets: keyValueChanges(t.containerSecrets(), other.containerSecrets()),
+	}
+}
+
+// IsEmpty returns true if the task definitions are the same.
+func (d *TaskDefinitionDiff) IsEmpty() bool {
+	return d.Image == nil && d.CPU == nil && d.Memory == nil && len(d.EnvVars) == 0 && len(d.Secrets) == 0
+}
+
+// HumanString returns the changes with one line per change.
+// Example output:
+//
+//	Image    nginx:1.18 -> nginx:1.19
+//	CPU      256 -> 512
+//	+ LOG_LEVEL=debug
+//	~ DB_NAME: orders -> payments
+//	- FEATURE_FLAG
+func (d *TaskDefinitionDiff) HumanString() string {
+	var b strings.Builder
+	for _, field := range []struct {
+		name   string
+		change *ValueChange
+	}{
+		{"Image", d.Image},
+		{"CPU", d.CPU},
+		{"Memory", d.Memory},
+	} {
+		if field.change == nil {
+			continue
+		}
+		fmt.Fprintf(&b, "  %-8s %s -> %s\n", field.name, valueOrDash(field.change.Old), valueOrDash(field.change.New))
+	}
+	for _, change := range append(append([]KeyValueChange{}, d.EnvVars...), d.Secrets...) {
+		switch change.Action {
+		case ChangeAdded:
+			fmt.Fprintf(&b, "  + %s=%s\n", change.Key, change.New)
+		case ChangeRemoved:
+			fmt.Fprintf(&b, "  - %s\n", change.Key)
+		default:
+			fmt.Fprintf(&b, "  ~ %s: %s -> %s\n", change.Key, change.Old, change.New)
+		}
+	}
+	return b.String()
+}
+
+func (t *TaskDefinition) image() string {
+	if len(t.ContainerDefinitions) == 0 {
+		return ""
+	}
+	return aws.StringValue(t.ContainerDefinitions[0].Image)
+}
+
+func (t *TaskDefinition) containerEnvironmentVariables() map[string]string {
+	if len(t.ContainerDefinitions) == 0 {
+		return nil
+	}
+	return t.EnvironmentVariables()
+}
+
+func (t *TaskDefinition) containerSecrets() map[string]string {
+	if len(t.ContainerDefinitions) == 0 {
+		return nil
+	}
+	return t.Secrets()
+}
+
+func valueChange(from, to string) *ValueChange {
+	if from == to {
+		return nil
+	}
+	return &ValueChange{
+		Old: from,
+		New: to,
+	}
+}
+
+// keyValueChanges returns the changes from the old to the new key-value pairs, sorted by key.
+func keyValueChanges(from, to map[string]string) []KeyValueChange {
+	var changes []KeyValueChange
+	for key, oldValue := range from {
+		newValue, ok := to[key]
+		if !ok {
+			changes = append(changes, KeyValueChange{Key: key, Action: ChangeRemoved, Old: oldValue})
+			continue
+		}
+		if newValue != oldValue {
+			changes = append(changes, KeyValueChange{Key: key, Action: ChangeModified, Old: oldValue, New: newValue})
+		}
+	}
+	for key, newValue := range to {
+		if _, ok := from[key]; !ok {
+			changes = append(changes, KeyValueChange{Key: key, Action: ChangeAdded, New: newValue})
+		}
+	}
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].Key < changes[j].Key
+	})
+	return changes
+}
+
+func valueOrDash(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
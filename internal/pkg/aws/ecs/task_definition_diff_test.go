@@ -0,0 +1,149 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ecs
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestECS_DiffTaskDefinitions(t *testing.T) {
+	oldTaskDef := &ecs.TaskDefinition{
+		Cpu:    aws.String("256"),
+		Memory: aws.String("512"),
+		ContainerDefinitions: []*ecs.ContainerDefinition{
+			{
+				Image: aws.String("nginx:1.18"),
+				Environment: []*ecs.KeyValuePair{
+					{Name: aws.String("DB_NAME"), Value: aws.String("orders")},
+					{Name: aws.String("FEATURE_FLAG"), Value: aws.String("on")},
+				},
+				Secrets: []*ecs.Secret{
+					{Name: aws.String("GITHUB_TOKEN"), ValueFrom: aws.String("/copilot/token")},
+				},
+			},
+		},
+	}
+	newTaskDef := &ecs.TaskDefinition{
+		Cpu:    aws.String("512"),
+		Memory: aws.String("512"),
+		ContainerDefinitions: []*ecs.ContainerDefinition{
+			{
+				Image: aws.String("nginx:1.19"),
+				Environment: []*ecs.KeyValuePair{
+					{Name: aws.String("DB_NAME"), Value: aws.String("payments")},
+					{Name: aws.String("LOG_LEVEL"), Value: aws.String("debug")},
+				},
+				Secrets: []*ecs.Secret{
+					{Name: aws.String("GITHUB_TOKEN"), ValueFrom: aws.String("/copilot/token")},
+				},
+			},
+		},
+	}
+	testCases := map[string]struct {
+		mockECSClient func(m *mocks.Mockapi)
+
+		wantedDiff *TaskDefinitionDiff
+		wantedErr  string
+	}{
+		"returns the changes between the two revisions": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeTaskDefinition(&ecs.DescribeTaskDefinitionInput{
+					TaskDefinition: aws.String("api:1"),
+				}).Return(&ecs.DescribeTaskDefinitionOutput{TaskDefinition: oldTaskDef}, nil)
+				m.EXPECT().DescribeTaskDefinition(&ecs.DescribeTaskDefinitionInput{
+					TaskDefinition: aws.String("api:2"),
+				}).Return(&ecs.DescribeTaskDefinitionOutput{TaskDefinition: newTaskDef}, nil)
+			},
+			wantedDiff: &TaskDefinitionDiff{
+				Image: &ValueChange{Old: "nginx:1.18", New: "nginx:1.19"},
+				CPU:   &ValueChange{Old: "256", New: "512"},
+				EnvVars: []KeyValueChange{
+					{Key: "DB_NAME", Action: ChangeModified, Old: "orders", New: "payments"},
+					{Key: "FEATURE_FLAG", Action: ChangeRemoved, Old: "on"},
+					{Key: "LOG_LEVEL", Action: ChangeAdded, New: "debug"},
+				},
+			},
+		},
+		"errors if a revision can't be described": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeTaskDefinition(gomock.Any()).Return(&ecs.DescribeTaskDefinitionOutput{TaskDefinition: oldTaskDef}, nil)
+				m.EXPECT().DescribeTaskDefinition(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: "describe task definition api:2: some error",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECSClient := mocks.NewMockapi(ctrl)
+			tc.mockECSClient(mockECSClient)
+
+			service := ECS{
+				client: mockECSClient,
+			}
+
+			// WHEN
+			diff, err := service.DiffTaskDefinitions("api:1", "api:2")
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedDiff, diff)
+		})
+	}
+}
+
+func TestTaskDefinitionDiff_HumanString(t *testing.T) {
+	testCases := map[string]struct {
+		diff *TaskDefinitionDiff
+
+		wantedEmpty  bool
+		wantedString string
+	}{
+		"no changes": {
+			diff:        &TaskDefinitionDiff{},
+			wantedEmpty: true,
+		},
+		"changes to fields, environment variables and secrets": {
+			diff: &TaskDefinitionDiff{
+				Image:  &ValueChange{Old: "nginx:1.18", New: "nginx:1.19"},
+				Memory: &ValueChange{Old: "", New: "1024"},
+				EnvVars: []KeyValueChange{
+					{Key: "DB_NAME", Action: ChangeModified, Old: "orders", New: "payments"},
+					{Key: "LOG_LEVEL", Action: ChangeAdded, New: "debug"},
+				},
+				Secrets: []KeyValueChange{
+					{Key: "GITHUB_TOKEN", Action: ChangeRemoved, Old: "/copilot/token"},
+				},
+			},
+			wantedString: `  Image    nginx:1.18 -> nginx:1.19
+  Memory   - -> 1024
+  ~ DB_NAME: orders -> payments
+  + LOG_LEVEL=debug
+  - GITHUB_TOKEN
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wantedEmpty, tc.diff.IsEmpty())
+			require.Equal(t, tc.wantedString, tc.diff.HumanString())
+		})
+	}
+}
@@ -0,0 +1,227 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package elbv2 provides a client to make API requests to Elastic Load Balancing.
+package elbv2
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+)
+
+const (
+	actionTypeForward      = elbv2.ActionTypeEnumForward
+	conditionPathPattern   = "path-pattern"
+	conditionHostHeader    = "host-header"
+	listenerRulesPageLimit = 100
+)
+
+// Health states of the targets of a target group.
+const (
+	TargetHealthStateHealthy     = elbv2.TargetHealthStateEnumHealthy
+	TargetHealthStateUnhealthy   = elbv2.TargetHealthStateEnumUnhealthy
+	TargetHealthStateInitial     = elbv2.TargetHealthStateEnumInitial
+	TargetHealthStateDraining    = elbv2.TargetHealthStateEnumDraining
+	TargetHealthStateUnused      = elbv2.TargetHealthStateEnumUnused
+	TargetHealthStateUnavailable = elbv2.TargetHealthStateEnumUnavailable
+)
+
+type api interface {
+	DescribeTargetHealth(input *elbv2.DescribeTargetHealthInput) (*elbv2.DescribeTargetHealthOutput, error)
+	DescribeRules(input *elbv2.DescribeRulesInput) (*elbv2.DescribeRulesOutput, error)
+	DeregisterTargets(input *elbv2.DeregisterTargetsInput) (*elbv2.DeregisterTargetsOutput, error)
+}
+
+// ELBV2 wraps an Elastic Load Balancing (v2) client.
+type ELBV2 struct {
+	client api
+}
+
+// New returns an ELBV2 configured against the input session.
+func New(s *session.Session) *ELBV2 {
+	return &ELBV2{
+		client: elbv2.New(s),
+	}
+}
+
+// Target is a target registered with a target group, such as the IP address of a task.
+type Target struct {
+	ID   string `json:"id"`
+	Port int64  `json:"port"`
+}
+
+// TargetHealth holds the health of a target as seen by the load balancer.
+type TargetHealth struct {
+	Target
+	State       string `json:"state"`
+	Reason      string `json:"reason,omitempty"`      // Reason code of the state if it's not healthy, such as "Target.FailedHealthChecks".
+	Description string `json:"description,omitempty"` // Description of the reason.
+}
+
+// IsHealthy returns true if the target passes the health checks of the load balancer.
+func (t *TargetHealth) IsHealthy() bool {
+	return t.State == TargetHealthStateHealthy
+}
+
+// Rule is a rule of a listener that routes requests matching its conditions to target groups.
+type Rule struct {
+	ARN             string   `json:"arn"`
+	Priority        int      `json:"priority"` // 0 for the default rule.
+	IsDefault       bool     `json:"isDefault"`
+	PathPatterns    []string `json:"pathPatterns,omitempty"`
+	HostHeaders     []string `json:"hostHeaders,omitempty"`
+	TargetGroupARNs []string `json:"targetGroupArns,omitempty"` // Target groups that the rule forwards requests to.
+}
+
+// TargetsHealth returns the health of the targets registered with the target group.
+func (e *ELBV2) TargetsHealth(targetGroupARN string) ([]*TargetHealth, error) {
+	out, err := e.client.DescribeTargetHealth(&elbv2.DescribeTargetHealthInput{
+		TargetGroupArn: aws.String(targetGroupARN),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe health of targets in target group %s: %w", targetGroupARN, err)
+	}
+	var health []*TargetHealth
+	for _, desc := range out.TargetHealthDescriptions {
+		h := &TargetHealth{}
+		if desc.Target != nil {
+			h.Target = Target{
+				ID:   aws.StringValue(desc.Target.Id),
+				Port: aws.Int64Value(desc.Target.Port),
+			}
+		}
+		if desc.TargetHealth != nil {
+			h.State = aws.StringValue(desc.TargetHealth.State)
+			h.Reason = aws.StringValue(desc.TargetHealth.Reason)
+			h.Description = aws.StringValue(desc.TargetHealth.Description)
+		}
+		health = append(health, h)
+	}
+	return health, nil
+}
+
+// ListenerRules returns the rules of the listener ordered by priority, with the default rule last.
+func (e *ELBV2) ListenerRules(listenerARN string) ([]*Rule, error) {
+	var rules []*Rule
+	in := &elbv2.DescribeRulesInput{
+		ListenerArn: aws.String(listenerARN),
+		PageSize:    aws.Int64(listenerRulesPageLimit),
+	}
+	for {
+		out, err := e.client.DescribeRules(in)
+		if err != nil {
+			return nil, fmt.Errorf("describe rules of listener %s: %w", listenerARN, err)
+		}
+		for _, r := range out.Rules {
+			rule, err := newRule(r)
+			if err != nil {
+				return nil, err
+			}
+			rules = append(rules, rule)
+		}
+		if out.NextMarker == nil {
+			break
+		}
+		in.Marker = out.NextMarker
+	}
+	sort.SliceStable(rules, func(i, j int) bool {
+		if rules[i].IsDefault != rules[j].IsDefault {
+			return !rules[i].IsDefault
+		}
+		return rules[i].Priority < rules[j].Priority
+	})
+	return rules, nil
+}
+
+// ListenerRulesForTargetGroup returns the rules of the listener that forward requests to the target group,
+// ordered by priority.
+func (e *ELBV2) ListenerRulesForTargetGroup(listenerARN, targetGroupARN string) ([]*Rule, error) {
+	rules, err := e.ListenerRules(listenerARN)
+	if err != nil {
+		return nil, err
+	}
+	var matched []*Rule
+	for _, rule := range rules {
+		for _, arn := range rule.TargetGroupARNs {
+			if arn == targetGroupARN {
+				matched = append(matched, rule)
+				break
+			}
+		}
+	}
+	return matched, nil
+}
+
+// DeregisterTargets deregisters the targets from the target group, the load balancer drains their connections.
+func (e *ELBV2) DeregisterTargets(targetGroupARN string, targets []Target) error {
+	if len(targets) == 0 {
+		return nil
+	}
+	var descs []*elbv2.TargetDescription
+	for _, target := range targets {
+		desc := &elbv2.TargetDescription{
+			Id: aws.String(target.ID),
+		}
+		if target.Port != 0 {
+			desc.Port = aws.Int64(target.Port)
+		}
+		descs = append(descs, desc)
+	}
+	if _, err := e.client.DeregisterTargets(&elbv2.DeregisterTargetsInput{
+		TargetGroupArn: aws.String(targetGroupARN),
+		Targets:        descs,
+	}); err != nil {
+		return fmt.Errorf("deregister targets from target group %s: %w", targetGroupARN, err)
+	}
+	return nil
+}
+
+func newRule(r *elbv2.Rule) (*Rule, error) {
+	rule := &Rule{
+		ARN:       aws.StringValue(r.RuleArn),
+		IsDefault: aws.BoolValue(r.IsDefault),
+	}
+	if !rule.IsDefault {
+		priority, err := strconv.Atoi(aws.StringValue(r.Priority))
+		if err != nil {
+			return nil, fmt.Errorf("parse priority %s of rule %s: %w", aws.StringValue(r.Priority), rule.ARN, err)
+		}
+		rule.Priority = priority
+	}
+	for _, cond := range r.Conditions {
+		switch aws.StringValue(cond.Field) {
+		case conditionPathPattern:
+			if cond.PathPatternConfig != nil {
+				rule.PathPatterns = append(rule.PathPatterns, aws.StringValueSlice(cond.PathPatternConfig.Values)...)
+			} else {
+				rule.PathPatterns = append(rule.PathPatterns, aws.StringValueSlice(cond.Values)...)
+			}
+		case conditionHostHeader:
+			if cond.HostHeaderConfig != nil {
+				rule.HostHeaders = append(rule.HostHeaders, aws.StringValueSlice(cond.HostHeaderConfig.Values)...)
+			} else {
+				rule.HostHeaders = append(rule.HostHeaders, aws.StringValueSlice(cond.Values)...)
+			}
+		}
+	}
+	for _, action := range r.Actions {
+		if aws.StringValue(action.Type) != actionTypeForward {
+			continue
+		}
+		if action.ForwardConfig != nil && len(action.ForwardConfig.TargetGroups) != 0 {
+			for _, tg := range action.ForwardConfig.TargetGroups {
+				rule.TargetGroupARNs = append(rule.TargetGroupARNs, aws.StringValue(tg.TargetGroupArn))
+			}
+			continue
+		}
+		if action.TargetGroupArn != nil {
+			rule.TargetGroupARNs = append(rule.TargetGroupARNs, aws.StringValue(action.TargetGroupArn))
+		}
+	}
+	return rule, nil
+}
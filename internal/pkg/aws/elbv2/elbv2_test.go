@@ -0,0 +1,311 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package elbv2
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	mockTargetGroupARN = "arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/api/73e2d6bc24d8a067"
+	mockListenerARN    = "arn:aws:elasticloadbalancing:us-west-2:123456789012:listener/app/phonetool-test/50dc6c495c0c9188/f2f7dc8efc522ab2"
+)
+
+func TestELBV2_TargetsHealth(t *testing.T) {
+	testCases := map[string]struct {
+		mockClient func(m *mocks.Mockapi)
+
+		wantedHealth []*TargetHealth
+		wantedErr    string
+	}{
+		"errors if the health of the targets can't be described": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeTargetHealth(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: "describe health of targets in target group " + mockTargetGroupARN + ": some error",
+		},
+		"returns the health of each target": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeTargetHealth(&elbv2.DescribeTargetHealthInput{
+					TargetGroupArn: aws.String(mockTargetGroupARN),
+				}).Return(&elbv2.DescribeTargetHealthOutput{
+					TargetHealthDescriptions: []*elbv2.TargetHealthDescription{
+						{
+							Target:       &elbv2.TargetDescription{Id: aws.String("10.0.0.1"), Port: aws.Int64(80)},
+							TargetHealth: &elbv2.TargetHealth{State: aws.String("healthy")},
+						},
+						{
+							Target: &elbv2.TargetDescription{Id: aws.String("10.0.0.2"), Port: aws.Int64(80)},
+							TargetHealth: &elbv2.TargetHealth{
+								State:       aws.String("unhealthy"),
+								Reason:      aws.String("Target.ResponseCodeMismatch"),
+								Description: aws.String("Health checks failed with these codes: [503]"),
+							},
+						},
+					},
+				}, nil)
+			},
+			wantedHealth: []*TargetHealth{
+				{
+					Target: Target{ID: "10.0.0.1", Port: 80},
+					State:  "healthy",
+				},
+				{
+					Target:      Target{ID: "10.0.0.2", Port: 80},
+					State:       "unhealthy",
+					Reason:      "Target.ResponseCodeMismatch",
+					Description: "Health checks failed with these codes: [503]",
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.mockClient(m)
+			client := ELBV2{client: m}
+
+			// WHEN
+			health, err := client.TargetsHealth(mockTargetGroupARN)
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedHealth, health)
+		})
+	}
+}
+
+func TestELBV2_ListenerRules(t *testing.T) {
+	defaultRule := &elbv2.Rule{
+		RuleArn:   aws.String("defaultRuleArn"),
+		Priority:  aws.String("default"),
+		IsDefault: aws.Bool(true),
+		Actions: []*elbv2.Action{
+			{Type: aws.String("fixed-response")},
+		},
+	}
+	apiRule := &elbv2.Rule{
+		RuleArn:  aws.String("apiRuleArn"),
+		Priority: aws.String("2"),
+		Conditions: []*elbv2.RuleCondition{
+			{
+				Field:             aws.String("path-pattern"),
+				PathPatternConfig: &elbv2.PathPatternConditionConfig{Values: aws.StringSlice([]string{"/api*"})},
+			},
+		},
+		Actions: []*elbv2.Action{
+			{Type: aws.String("forward"), TargetGroupArn: aws.String(mockTargetGroupARN)},
+		},
+	}
+	frontendRule := &elbv2.Rule{
+		RuleArn:  aws.String("frontendRuleArn"),
+		Priority: aws.String("1"),
+		Conditions: []*elbv2.RuleCondition{
+			{
+				Field:  aws.String("host-header"),
+				Values: aws.StringSlice([]string{"phonetool.com"}),
+			},
+		},
+		Actions: []*elbv2.Action{
+			{
+				Type: aws.String("forward"),
+				ForwardConfig: &elbv2.ForwardActionConfig{
+					TargetGroups: []*elbv2.TargetGroupTuple{
+						{TargetGroupArn: aws.String("frontendTargetGroupArn")},
+					},
+				},
+			},
+		},
+	}
+	testCases := map[string]struct {
+		mockClient func(m *mocks.Mockapi)
+
+		wantedRules []*Rule
+		wantedErr   string
+	}{
+		"errors if the rules can't be described": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeRules(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: "describe rules of listener " + mockListenerARN + ": some error",
+		},
+		"errors if the priority of a rule is invalid": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeRules(gomock.Any()).Return(&elbv2.DescribeRulesOutput{
+					Rules: []*elbv2.Rule{
+						{RuleArn: aws.String("badRuleArn"), Priority: aws.String("first")},
+					},
+				}, nil)
+			},
+			wantedErr: `parse priority first of rule badRuleArn: strconv.Atoi: parsing "first": invalid syntax`,
+		},
+		"returns the rules of every page by priority with the default rule last": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeRules(&elbv2.DescribeRulesInput{
+					ListenerArn: aws.String(mockListenerARN),
+					PageSize:    aws.Int64(100),
+				}).Return(&elbv2.DescribeRulesOutput{
+					Rules:      []*elbv2.Rule{defaultRule, apiRule},
+					NextMarker: aws.String("next"),
+				}, nil)
+				m.EXPECT().DescribeRules(&elbv2.DescribeRulesInput{
+					ListenerArn: aws.String(mockListenerARN),
+					PageSize:    aws.Int64(100),
+					Marker:      aws.String("next"),
+				}).Return(&elbv2.DescribeRulesOutput{
+					Rules: []*elbv2.Rule{frontendRule},
+				}, nil)
+			},
+			wantedRules: []*Rule{
+				{
+					ARN:             "frontendRuleArn",
+					Priority:        1,
+					HostHeaders:     []string{"phonetool.com"},
+					TargetGroupARNs: []string{"frontendTargetGroupArn"},
+				},
+				{
+					ARN:             "apiRuleArn",
+					Priority:        2,
+					PathPatterns:    []string{"/api*"},
+					TargetGroupARNs: []string{mockTargetGroupARN},
+				},
+				{
+					ARN:       "defaultRuleArn",
+					IsDefault: true,
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.mockClient(m)
+			client := ELBV2{client: m}
+
+			// WHEN
+			rules, err := client.ListenerRules(mockListenerARN)
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedRules, rules)
+		})
+	}
+}
+
+func TestELBV2_ListenerRulesForTargetGroup(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	m := mocks.NewMockapi(ctrl)
+	m.EXPECT().DescribeRules(gomock.Any()).Return(&elbv2.DescribeRulesOutput{
+		Rules: []*elbv2.Rule{
+			{
+				RuleArn:  aws.String("frontendRuleArn"),
+				Priority: aws.String("1"),
+				Actions: []*elbv2.Action{
+					{Type: aws.String("forward"), TargetGroupArn: aws.String("frontendTargetGroupArn")},
+				},
+			},
+			{
+				RuleArn:  aws.String("apiRuleArn"),
+				Priority: aws.String("2"),
+				Actions: []*elbv2.Action{
+					{Type: aws.String("forward"), TargetGroupArn: aws.String(mockTargetGroupARN)},
+				},
+			},
+		},
+	}, nil)
+	client := ELBV2{client: m}
+
+	// WHEN
+	rules, err := client.ListenerRulesForTargetGroup(mockListenerARN, mockTargetGroupARN)
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, []*Rule{
+		{
+			ARN:             "apiRuleArn",
+			Priority:        2,
+			TargetGroupARNs: []string{mockTargetGroupARN},
+		},
+	}, rules)
+}
+
+func TestELBV2_DeregisterTargets(t *testing.T) {
+	testCases := map[string]struct {
+		inTargets  []Target
+		mockClient func(m *mocks.Mockapi)
+
+		wantedErr string
+	}{
+		"does nothing without targets": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DeregisterTargets(gomock.Any()).Times(0)
+			},
+		},
+		"errors if the targets can't be deregistered": {
+			inTargets: []Target{{ID: "10.0.0.1", Port: 80}},
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DeregisterTargets(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: "deregister targets from target group " + mockTargetGroupARN + ": some error",
+		},
+		"deregisters the targets": {
+			inTargets: []Target{{ID: "10.0.0.1", Port: 80}, {ID: "10.0.0.2"}},
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DeregisterTargets(&elbv2.DeregisterTargetsInput{
+					TargetGroupArn: aws.String(mockTargetGroupARN),
+					Targets: []*elbv2.TargetDescription{
+						{Id: aws.String("10.0.0.1"), Port: aws.Int64(80)},
+						{Id: aws.String("10.0.0.2")},
+					},
+				}).Return(&elbv2.DeregisterTargetsOutput{}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.mockClient(m)
+			client := ELBV2{client: m}
+
+			// WHEN
+			err := client.DeregisterTargets(mockTargetGroupARN, tc.inTargets)
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
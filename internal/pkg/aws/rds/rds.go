@@ -0,0 +1,346 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package rds provides a client to make API requests to Amazon Relational Database Service.
+package rds
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/aws/copilot-cli/internal/pkg/trace"
+)
+
+var waiters = []request.WaiterOption{
+	request.WithWaiterDelay(request.ConstantWaiterDelay(30 * time.Second)), // Poll every 30 seconds, snapshots and restores take minutes.
+	request.WithWaiterMaxAttempts(240),                                     // Wait for at most 2 hours.
+}
+
+type api interface {
+	DescribeDBClusters(*rds.DescribeDBClustersInput) (*rds.DescribeDBClustersOutput, error)
+	DescribeDBInstances(*rds.DescribeDBInstancesInput) (*rds.DescribeDBInstancesOutput, error)
+	CreateDBClusterSnapshot(*rds.CreateDBClusterSnapshotInput) (*rds.CreateDBClusterSnapshotOutput, error)
+	CreateDBSnapshot(*rds.CreateDBSnapshotInput) (*rds.CreateDBSnapshotOutput, error)
+	DescribeDBClusterSnapshots(*rds.DescribeDBClusterSnapshotsInput) (*rds.DescribeDBClusterSnapshotsOutput, error)
+	DescribeDBSnapshots(*rds.DescribeDBSnapshotsInput) (*rds.DescribeDBSnapshotsOutput, error)
+	RestoreDBClusterFromSnapshot(*rds.RestoreDBClusterFromSnapshotInput) (*rds.RestoreDBClusterFromSnapshotOutput, error)
+	RestoreDBInstanceFromDBSnapshot(*rds.RestoreDBInstanceFromDBSnapshotInput) (*rds.RestoreDBInstanceFromDBSnapshotOutput, error)
+	CreateDBInstance(*rds.CreateDBInstanceInput) (*rds.CreateDBInstanceOutput, error)
+	WaitUntilDBClusterSnapshotAvailableWithContext(aws.Context, *rds.DescribeDBClusterSnapshotsInput, ...request.WaiterOption) error
+	WaitUntilDBSnapshotAvailableWithContext(aws.Context, *rds.DescribeDBSnapshotsInput, ...request.WaiterOption) error
+	WaitUntilDBInstanceAvailableWithContext(aws.Context, *rds.DescribeDBInstancesInput, ...request.WaiterOption) error
+}
+
+// Database is an Aurora cluster or an RDS DB instance.
+type Database struct {
+	ID      string
+	Cluster bool              // True if the database is an Aurora cluster, false if it's a DB instance.
+	Tags    map[string]string // Tags to set on the snapshots of the database and the databases restored from them.
+}
+
+// Endpoint is the address that clients connect to a database with.
+type Endpoint struct {
+	Host string
+	Port int64
+}
+
+// Snapshot is a snapshot of a database.
+type Snapshot struct {
+	ID        string
+	Database  Database
+	Type      string // Either "manual" or "automated".
+	Status    string
+	CreatedAt time.Time
+}
+
+// RDS wraps an Amazon Relational Database Service client.
+type RDS struct {
+	client api
+}
+
+// New returns a RDS struct configured against the input session.
+func New(s *session.Session) *RDS {
+	return &RDS{
+		client: rds.New(s),
+	}
+}
+
+// CreateSnapshot creates a manual snapshot of the database and waits until it's available.
+func (r *RDS) CreateSnapshot(db Database, snapshotID string) error {
+	span := trace.Start("create database snapshot", trace.Attr("database", db.ID))
+	err := r.createSnapshot(db, snapshotID)
+	span.End(err)
+	return err
+}
+
+func (r *RDS) createSnapshot(db Database, snapshotID string) error {
+	if db.Cluster {
+		if _, err := r.client.CreateDBClusterSnapshot(&rds.CreateDBClusterSnapshotInput{
+			DBClusterIdentifier:         aws.String(db.ID),
+			DBClusterSnapshotIdentifier: aws.String(snapshotID),
+			Tags:                        tags(db.Tags),
+		}); err != nil {
+			return fmt.Errorf("create snapshot %s of cluster %s: %w", snapshotID, db.ID, err)
+		}
+		if err := r.client.WaitUntilDBClusterSnapshotAvailableWithContext(context.Background(), &rds.DescribeDBClusterSnapshotsInput{
+			DBClusterSnapshotIdentifier: aws.String(snapshotID),
+		}, waiters...); err != nil {
+			return fmt.Errorf("wait until snapshot %s of cluster %s is available: %w", snapshotID, db.ID, err)
+		}
+		return nil
+	}
+	if _, err := r.client.CreateDBSnapshot(&rds.CreateDBSnapshotInput{
+		DBInstanceIdentifier: aws.String(db.ID),
+		DBSnapshotIdentifier: aws.String(snapshotID),
+		Tags:                 tags(db.Tags),
+	}); err != nil {
+		return fmt.Errorf("create snapshot %s of DB instance %s: %w", snapshotID, db.ID, err)
+	}
+	if err := r.client.WaitUntilDBSnapshotAvailableWithContext(context.Background(), &rds.DescribeDBSnapshotsInput{
+		DBSnapshotIdentifier: aws.String(snapshotID),
+	}, waiters...); err != nil {
+		return fmt.Errorf("wait until snapshot %s of DB instance %s is available: %w", snapshotID, db.ID, err)
+	}
+	return nil
+}
+
+// Snapshots returns the manual and automated snapshots of the database, most recent first.
+func (r *RDS) Snapshots(db Database) ([]Snapshot, error) {
+	var snapshots []Snapshot
+	if db.Cluster {
+		var marker *string
+		for {
+			out, err := r.client.DescribeDBClusterSnapshots(&rds.DescribeDBClusterSnapshotsInput{
+				DBClusterIdentifier: aws.String(db.ID),
+				Marker:              marker,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("list snapshots of cluster %s: %w", db.ID, err)
+			}
+			for _, snapshot := range out.DBClusterSnapshots {
+				snapshots = append(snapshots, Snapshot{
+					ID:        aws.StringValue(snapshot.DBClusterSnapshotIdentifier),
+					Database:  db,
+					Type:      aws.StringValue(snapshot.SnapshotType),
+					Status:    aws.StringValue(snapshot.Status),
+					CreatedAt: aws.TimeValue(snapshot.SnapshotCreateTime),
+				})
+			}
+			if marker = out.Marker; marker == nil {
+				break
+			}
+		}
+	} else {
+		var marker *string
+		for {
+			out, err := r.client.DescribeDBSnapshots(&rds.DescribeDBSnapshotsInput{
+				DBInstanceIdentifier: aws.String(db.ID),
+				Marker:               marker,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("list snapshots of DB instance %s: %w", db.ID, err)
+			}
+			for _, snapshot := range out.DBSnapshots {
+				snapshots = append(snapshots, Snapshot{
+					ID:        aws.StringValue(snapshot.DBSnapshotIdentifier),
+					Database:  db,
+					Type:      aws.StringValue(snapshot.SnapshotType),
+					Status:    aws.StringValue(snapshot.Status),
+					CreatedAt: aws.TimeValue(snapshot.SnapshotCreateTime),
+				})
+			}
+			if marker = out.Marker; marker == nil {
+				break
+			}
+		}
+	}
+	sort.SliceStable(snapshots, func(i, j int) bool {
+		return snapshots[i].CreatedAt.After(snapshots[j].CreatedAt)
+	})
+	return snapshots, nil
+}
+
+// Restore restores the snapshot into a new database with the ID, and waits until the new database is available.
+// The new database is placed in the same subnets and security groups as the database of the snapshot, and a restored
+// cluster gets DB instances of the same classes as the instances of the original cluster.
+func (r *RDS) Restore(snapshot Snapshot, newID string) (*Endpoint, error) {
+	span := trace.Start("restore database snapshot", trace.Attr("snapshot", snapshot.ID))
+	var endpoint *Endpoint
+	var err error
+	if snapshot.Database.Cluster {
+		endpoint, err = r.restoreCluster(snapshot, newID)
+	} else {
+		endpoint, err = r.restoreInstance(snapshot, newID)
+	}
+	span.End(err)
+	return endpoint, err
+}
+
+func (r *RDS) restoreCluster(snapshot Snapshot, newID string) (*Endpoint, error) {
+	cluster, err := r.cluster(snapshot.Database.ID)
+	if err != nil {
+		return nil, err
+	}
+	var sgIDs []*string
+	for _, sg := range cluster.VpcSecurityGroups {
+		sgIDs = append(sgIDs, sg.VpcSecurityGroupId)
+	}
+	out, err := r.client.RestoreDBClusterFromSnapshot(&rds.RestoreDBClusterFromSnapshotInput{
+		DBClusterIdentifier:  aws.String(newID),
+		SnapshotIdentifier:   aws.String(snapshot.ID),
+		Engine:               cluster.Engine,
+		EngineVersion:        cluster.EngineVersion,
+		EngineMode:           cluster.EngineMode,
+		DBSubnetGroupName:    cluster.DBSubnetGroup,
+		VpcSecurityGroupIds:  sgIDs,
+		ScalingConfiguration: scalingConfiguration(cluster.ScalingConfigurationInfo),
+		Tags:                 tags(snapshot.Database.Tags),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("restore snapshot %s into cluster %s: %w", snapshot.ID, newID, err)
+	}
+	// Serverless clusters don't have DB instances, while provisioned clusters need instances to serve requests.
+	var instanceIDs []string
+	for i, member := range cluster.DBClusterMembers {
+		instance, err := r.instance(aws.StringValue(member.DBInstanceIdentifier))
+		if err != nil {
+			return nil, err
+		}
+		instanceID := fmt.Sprintf("%s-%d", newID, i+1)
+		if _, err := r.client.CreateDBInstance(&rds.CreateDBInstanceInput{
+			DBClusterIdentifier:  aws.String(newID),
+			DBInstanceIdentifier: aws.String(instanceID),
+			DBInstanceClass:      instance.DBInstanceClass,
+			Engine:               cluster.Engine,
+			Tags:                 tags(snapshot.Database.Tags),
+		}); err != nil {
+			return nil, fmt.Errorf("create DB instance %s in cluster %s: %w", instanceID, newID, err)
+		}
+		instanceIDs = append(instanceIDs, instanceID)
+	}
+	for _, id := range instanceIDs {
+		if err := r.waitForInstance(id); err != nil {
+			return nil, err
+		}
+	}
+	return &Endpoint{
+		Host: aws.StringValue(out.DBCluster.Endpoint),
+		Port: aws.Int64Value(out.DBCluster.Port),
+	}, nil
+}
+
+func (r *RDS) restoreInstance(snapshot Snapshot, newID string) (*Endpoint, error) {
+	instance, err := r.instance(snapshot.Database.ID)
+	if err != nil {
+		return nil, err
+	}
+	var sgIDs []*string
+	for _, sg := range instance.VpcSecurityGroups {
+		sgIDs = append(sgIDs, sg.VpcSecurityGroupId)
+	}
+	var subnetGroup *string
+	if instance.DBSubnetGroup != nil {
+		subnetGroup = instance.DBSubnetGroup.DBSubnetGroupName
+	}
+	if _, err := r.client.RestoreDBInstanceFromDBSnapshot(&rds.RestoreDBInstanceFromDBSnapshotInput{
+		DBInstanceIdentifier: aws.String(newID),
+		DBSnapshotIdentifier: aws.String(snapshot.ID),
+		DBInstanceClass:      instance.DBInstanceClass,
+		DBSubnetGroupName:    subnetGroup,
+		VpcSecurityGroupIds:  sgIDs,
+		MultiAZ:              instance.MultiAZ,
+		Tags:                 tags(snapshot.Database.Tags),
+	}); err != nil {
+		return nil, fmt.Errorf("restore snapshot %s into DB instance %s: %w", snapshot.ID, newID, err)
+	}
+	if err := r.waitForInstance(newID); err != nil {
+		return nil, err
+	}
+	// The endpoint of a DB instance is only known once it's available.
+	restored, err := r.instance(newID)
+	if err != nil {
+		return nil, err
+	}
+	if restored.Endpoint == nil {
+		return nil, fmt.Errorf("DB instance %s doesn't have an endpoint", newID)
+	}
+	return &Endpoint{
+		Host: aws.StringValue(restored.Endpoint.Address),
+		Port: aws.Int64Value(restored.Endpoint.Port),
+	}, nil
+}
+
+func (r *RDS) cluster(id string) (*rds.DBCluster, error) {
+	out, err := r.client.DescribeDBClusters(&rds.DescribeDBClustersInput{
+		DBClusterIdentifier: aws.String(id),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe cluster %s: %w", id, err)
+	}
+	if len(out.DBClusters) == 0 {
+		return nil, fmt.Errorf("cluster %s not found", id)
+	}
+	return out.DBClusters[0], nil
+}
+
+func (r *RDS) instance(id string) (*rds.DBInstance, error) {
+	out, err := r.client.DescribeDBInstances(&rds.DescribeDBInstancesInput{
+		DBInstanceIdentifier: aws.String(id),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe DB instance %s: %w", id, err)
+	}
+	if len(out.DBInstances) == 0 {
+		return nil, fmt.Errorf("DB instance %s not found", id)
+	}
+	return out.DBInstances[0], nil
+}
+
+func (r *RDS) waitForInstance(id string) error {
+	err := r.client.WaitUntilDBInstanceAvailableWithContext(context.Background(), &rds.DescribeDBInstancesInput{
+		DBInstanceIdentifier: aws.String(id),
+	}, waiters...)
+	if err != nil {
+		return fmt.Errorf("wait until DB instance %s is available: %w", id, err)
+	}
+	return nil
+}
+
+// scalingConfiguration returns the capacity settings of a restored serverless cluster from the settings of the original cluster.
+func scalingConfiguration(info *rds.ScalingConfigurationInfo) *rds.ScalingConfiguration {
+	if info == nil {
+		return nil
+	}
+	return &rds.ScalingConfiguration{
+		AutoPause:             info.AutoPause,
+		MinCapacity:           info.MinCapacity,
+		MaxCapacity:           info.MaxCapacity,
+		SecondsUntilAutoPause: info.SecondsUntilAutoPause,
+		TimeoutAction:         info.TimeoutAction,
+	}
+}
+
+func tags(in map[string]string) []*rds.Tag {
+	if len(in) == 0 {
+		return nil
+	}
+	var keys []string
+	for k := range in {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var out []*rds.Tag
+	for _, k := range keys {
+		out = append(out, &rds.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(in[k]),
+		})
+	}
+	return out
+}
@@ -0,0 +1,373 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package rds
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/aws/copilot-cli/internal/pkg/aws/rds/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRDS_CreateSnapshot(t *testing.T) {
+	testCases := map[string]struct {
+		inDB       Database
+		setupMocks func(m *mocks.Mockapi)
+
+		wantedErr string
+	}{
+		"creates a tagged snapshot of a cluster and waits for it": {
+			inDB: Database{ID: "orders", Cluster: true, Tags: map[string]string{
+				"copilot-environment": "test",
+				"copilot-application": "phonetool",
+			}},
+			setupMocks: func(m *mocks.Mockapi) {
+				gomock.InOrder(
+					m.EXPECT().CreateDBClusterSnapshot(&rds.CreateDBClusterSnapshotInput{
+						DBClusterIdentifier:         aws.String("orders"),
+						DBClusterSnapshotIdentifier: aws.String("orders-backup"),
+						Tags: []*rds.Tag{
+							{Key: aws.String("copilot-application"), Value: aws.String("phonetool")},
+							{Key: aws.String("copilot-environment"), Value: aws.String("test")},
+						},
+					}).Return(&rds.CreateDBClusterSnapshotOutput{}, nil),
+					m.EXPECT().WaitUntilDBClusterSnapshotAvailableWithContext(gomock.Any(), &rds.DescribeDBClusterSnapshotsInput{
+						DBClusterSnapshotIdentifier: aws.String("orders-backup"),
+					}, gomock.Any()).Return(nil),
+				)
+			},
+		},
+		"creates a snapshot of a DB instance and waits for it": {
+			inDB: Database{ID: "orders"},
+			setupMocks: func(m *mocks.Mockapi) {
+				gomock.InOrder(
+					m.EXPECT().CreateDBSnapshot(&rds.CreateDBSnapshotInput{
+						DBInstanceIdentifier: aws.String("orders"),
+						DBSnapshotIdentifier: aws.String("orders-backup"),
+					}).Return(&rds.CreateDBSnapshotOutput{}, nil),
+					m.EXPECT().WaitUntilDBSnapshotAvailableWithContext(gomock.Any(), &rds.DescribeDBSnapshotsInput{
+						DBSnapshotIdentifier: aws.String("orders-backup"),
+					}, gomock.Any()).Return(nil),
+				)
+			},
+		},
+		"wraps the error from creating the snapshot": {
+			inDB: Database{ID: "orders", Cluster: true},
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().CreateDBClusterSnapshot(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: "create snapshot orders-backup of cluster orders: some error",
+		},
+		"wraps the error from waiting for the snapshot": {
+			inDB: Database{ID: "orders"},
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().CreateDBSnapshot(gomock.Any()).Return(&rds.CreateDBSnapshotOutput{}, nil)
+				m.EXPECT().WaitUntilDBSnapshotAvailableWithContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedErr: "wait until snapshot orders-backup of DB instance orders is available: some error",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.setupMocks(m)
+			client := RDS{client: m}
+
+			// WHEN
+			err := client.CreateSnapshot(tc.inDB, "orders-backup")
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestRDS_Snapshots(t *testing.T) {
+	older := time.Date(2020, 10, 1, 0, 0, 0, 0, time.UTC)
+	newer := time.Date(2020, 10, 2, 0, 0, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		inDB       Database
+		setupMocks func(m *mocks.Mockapi)
+
+		wantedSnapshots []Snapshot
+		wantedErr       string
+	}{
+		"returns the snapshots of a cluster across pages, most recent first": {
+			inDB: Database{ID: "orders", Cluster: true},
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeDBClusterSnapshots(&rds.DescribeDBClusterSnapshotsInput{
+					DBClusterIdentifier: aws.String("orders"),
+				}).Return(&rds.DescribeDBClusterSnapshotsOutput{
+					DBClusterSnapshots: []*rds.DBClusterSnapshot{
+						{
+							DBClusterSnapshotIdentifier: aws.String("rds:orders-2020-10-01"),
+							SnapshotType:                aws.String("automated"),
+							Status:                      aws.String("available"),
+							SnapshotCreateTime:          aws.Time(older),
+						},
+					},
+					Marker: aws.String("next"),
+				}, nil)
+				m.EXPECT().DescribeDBClusterSnapshots(&rds.DescribeDBClusterSnapshotsInput{
+					DBClusterIdentifier: aws.String("orders"),
+					Marker:              aws.String("next"),
+				}).Return(&rds.DescribeDBClusterSnapshotsOutput{
+					DBClusterSnapshots: []*rds.DBClusterSnapshot{
+						{
+							DBClusterSnapshotIdentifier: aws.String("orders-backup"),
+							SnapshotType:                aws.String("manual"),
+							Status:                      aws.String("available"),
+							SnapshotCreateTime:          aws.Time(newer),
+						},
+					},
+				}, nil)
+			},
+			wantedSnapshots: []Snapshot{
+				{
+					ID:        "orders-backup",
+					Database:  Database{ID: "orders", Cluster: true},
+					Type:      "manual",
+					Status:    "available",
+					CreatedAt: newer,
+				},
+				{
+					ID:        "rds:orders-2020-10-01",
+					Database:  Database{ID: "orders", Cluster: true},
+					Type:      "automated",
+					Status:    "available",
+					CreatedAt: older,
+				},
+			},
+		},
+		"returns the snapshots of a DB instance": {
+			inDB: Database{ID: "orders"},
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeDBSnapshots(&rds.DescribeDBSnapshotsInput{
+					DBInstanceIdentifier: aws.String("orders"),
+				}).Return(&rds.DescribeDBSnapshotsOutput{
+					DBSnapshots: []*rds.DBSnapshot{
+						{
+							DBSnapshotIdentifier: aws.String("orders-backup"),
+							SnapshotType:         aws.String("manual"),
+							Status:               aws.String("creating"),
+							SnapshotCreateTime:   aws.Time(newer),
+						},
+					},
+				}, nil)
+			},
+			wantedSnapshots: []Snapshot{
+				{
+					ID:        "orders-backup",
+					Database:  Database{ID: "orders"},
+					Type:      "manual",
+					Status:    "creating",
+					CreatedAt: newer,
+				},
+			},
+		},
+		"wraps the error from listing snapshots": {
+			inDB: Database{ID: "orders"},
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeDBSnapshots(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: "list snapshots of DB instance orders: some error",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.setupMocks(m)
+			client := RDS{client: m}
+
+			// WHEN
+			snapshots, err := client.Snapshots(tc.inDB)
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedSnapshots, snapshots)
+		})
+	}
+}
+
+func TestRDS_Restore(t *testing.T) {
+	testCases := map[string]struct {
+		inSnapshot Snapshot
+		setupMocks func(m *mocks.Mockapi)
+
+		wantedEndpoint *Endpoint
+		wantedErr      string
+	}{
+		"restores a tagged cluster with instances of the same classes": {
+			inSnapshot: Snapshot{ID: "orders-backup", Database: Database{ID: "orders", Cluster: true, Tags: map[string]string{
+				"copilot-application": "phonetool",
+			}}},
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeDBClusters(&rds.DescribeDBClustersInput{
+					DBClusterIdentifier: aws.String("orders"),
+				}).Return(&rds.DescribeDBClustersOutput{
+					DBClusters: []*rds.DBCluster{
+						{
+							Engine:        aws.String("aurora-postgresql"),
+							EngineVersion: aws.String("11.7"),
+							EngineMode:    aws.String("provisioned"),
+							DBSubnetGroup: aws.String("orders-subnets"),
+							VpcSecurityGroups: []*rds.VpcSecurityGroupMembership{
+								{VpcSecurityGroupId: aws.String("sg-1")},
+							},
+							DBClusterMembers: []*rds.DBClusterMember{
+								{DBInstanceIdentifier: aws.String("orders-writer")},
+							},
+						},
+					},
+				}, nil)
+				m.EXPECT().RestoreDBClusterFromSnapshot(&rds.RestoreDBClusterFromSnapshotInput{
+					DBClusterIdentifier: aws.String("orders-restored"),
+					SnapshotIdentifier:  aws.String("orders-backup"),
+					Engine:              aws.String("aurora-postgresql"),
+					EngineVersion:       aws.String("11.7"),
+					EngineMode:          aws.String("provisioned"),
+					DBSubnetGroupName:   aws.String("orders-subnets"),
+					VpcSecurityGroupIds: aws.StringSlice([]string{"sg-1"}),
+					Tags: []*rds.Tag{
+						{Key: aws.String("copilot-application"), Value: aws.String("phonetool")},
+					},
+				}).Return(&rds.RestoreDBClusterFromSnapshotOutput{
+					DBCluster: &rds.DBCluster{
+						Endpoint: aws.String("orders-restored.cluster-abc.us-west-2.rds.amazonaws.com"),
+						Port:     aws.Int64(5432),
+					},
+				}, nil)
+				m.EXPECT().DescribeDBInstances(&rds.DescribeDBInstancesInput{
+					DBInstanceIdentifier: aws.String("orders-writer"),
+				}).Return(&rds.DescribeDBInstancesOutput{
+					DBInstances: []*rds.DBInstance{
+						{DBInstanceClass: aws.String("db.r5.large")},
+					},
+				}, nil)
+				m.EXPECT().CreateDBInstance(&rds.CreateDBInstanceInput{
+					DBClusterIdentifier:  aws.String("orders-restored"),
+					DBInstanceIdentifier: aws.String("orders-restored-1"),
+					DBInstanceClass:      aws.String("db.r5.large"),
+					Engine:               aws.String("aurora-postgresql"),
+					Tags: []*rds.Tag{
+						{Key: aws.String("copilot-application"), Value: aws.String("phonetool")},
+					},
+				}).Return(&rds.CreateDBInstanceOutput{}, nil)
+				m.EXPECT().WaitUntilDBInstanceAvailableWithContext(gomock.Any(), &rds.DescribeDBInstancesInput{
+					DBInstanceIdentifier: aws.String("orders-restored-1"),
+				}, gomock.Any()).Return(nil)
+			},
+			wantedEndpoint: &Endpoint{
+				Host: "orders-restored.cluster-abc.us-west-2.rds.amazonaws.com",
+				Port: 5432,
+			},
+		},
+		"restores a DB instance in the same subnets and security groups": {
+			inSnapshot: Snapshot{ID: "orders-backup", Database: Database{ID: "orders"}},
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeDBInstances(&rds.DescribeDBInstancesInput{
+					DBInstanceIdentifier: aws.String("orders"),
+				}).Return(&rds.DescribeDBInstancesOutput{
+					DBInstances: []*rds.DBInstance{
+						{
+							DBInstanceClass: aws.String("db.t3.micro"),
+							DBSubnetGroup:   &rds.DBSubnetGroup{DBSubnetGroupName: aws.String("orders-subnets")},
+							VpcSecurityGroups: []*rds.VpcSecurityGroupMembership{
+								{VpcSecurityGroupId: aws.String("sg-1")},
+							},
+							MultiAZ: aws.Bool(true),
+						},
+					},
+				}, nil)
+				m.EXPECT().RestoreDBInstanceFromDBSnapshot(&rds.RestoreDBInstanceFromDBSnapshotInput{
+					DBInstanceIdentifier: aws.String("orders-restored"),
+					DBSnapshotIdentifier: aws.String("orders-backup"),
+					DBInstanceClass:      aws.String("db.t3.micro"),
+					DBSubnetGroupName:    aws.String("orders-subnets"),
+					VpcSecurityGroupIds:  aws.StringSlice([]string{"sg-1"}),
+					MultiAZ:              aws.Bool(true),
+				}).Return(&rds.RestoreDBInstanceFromDBSnapshotOutput{}, nil)
+				m.EXPECT().WaitUntilDBInstanceAvailableWithContext(gomock.Any(), &rds.DescribeDBInstancesInput{
+					DBInstanceIdentifier: aws.String("orders-restored"),
+				}, gomock.Any()).Return(nil)
+				m.EXPECT().DescribeDBInstances(&rds.DescribeDBInstancesInput{
+					DBInstanceIdentifier: aws.String("orders-restored"),
+				}).Return(&rds.DescribeDBInstancesOutput{
+					DBInstances: []*rds.DBInstance{
+						{
+							Endpoint: &rds.Endpoint{
+								Address: aws.String("orders-restored.abc.us-west-2.rds.amazonaws.com"),
+								Port:    aws.Int64(3306),
+							},
+						},
+					},
+				}, nil)
+			},
+			wantedEndpoint: &Endpoint{
+				Host: "orders-restored.abc.us-west-2.rds.amazonaws.com",
+				Port: 3306,
+			},
+		},
+		"wraps the error from restoring a cluster": {
+			inSnapshot: Snapshot{ID: "orders-backup", Database: Database{ID: "orders", Cluster: true}},
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeDBClusters(gomock.Any()).Return(&rds.DescribeDBClustersOutput{
+					DBClusters: []*rds.DBCluster{{}},
+				}, nil)
+				m.EXPECT().RestoreDBClusterFromSnapshot(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: "restore snapshot orders-backup into cluster orders-restored: some error",
+		},
+		"errors if the database of the snapshot doesn't exist": {
+			inSnapshot: Snapshot{ID: "orders-backup", Database: Database{ID: "orders"}},
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeDBInstances(gomock.Any()).Return(&rds.DescribeDBInstancesOutput{}, nil)
+			},
+			wantedErr: "DB instance orders not found",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.setupMocks(m)
+			client := RDS{client: m}
+
+			// WHEN
+			endpoint, err := client.Restore(tc.inSnapshot, "orders-restored")
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedEndpoint, endpoint)
+		})
+	}
+}
@@ -74,6 +74,20 @@ func TestResourceGroups_GetResourcesByTags(t *testing.T) {
 			},
 			expectedErr: nil,
 		},
+		"returns resources of any type if the resource type is empty": {
+			inTags: testTags,
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().GetResources(&rgapi.GetResourcesInput{
+					TagFilters: mockRequest.TagFilters,
+				}).Return(mockResponse, nil)
+			},
+			expectedOut: []*Resource{
+				{
+					ARN:  testArn,
+					Tags: testTags,
+				},
+			},
+		},
 		"wraps error from API call": {
 			inTags:         testTags,
 			inResourceType: testResourceType,
@@ -47,3 +47,62 @@ func (mr *MockapiMockRecorder) ListHostedZonesByName(in interface{}) *gomock.Cal
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHostedZonesByName", reflect.TypeOf((*Mockapi)(nil).ListHostedZonesByName), in)
 }
+
+// GetHostedZone mocks base method
+func (m *Mockapi) GetHostedZone(in *route53.GetHostedZoneInput) (*route53.GetHostedZoneOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHostedZone", in)
+	ret0, _ := ret[0].(*route53.GetHostedZoneOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHostedZone indicates an expected call of GetHostedZone
+func (mr *MockapiMockRecorder) GetHostedZone(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHostedZone", reflect.TypeOf((*Mockapi)(nil).GetHostedZone), in)
+}
+
+// ChangeResourceRecordSets mocks base method
+func (m *Mockapi) ChangeResourceRecordSets(in *route53.ChangeResourceRecordSetsInput) (*route53.ChangeResourceRecordSetsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeResourceRecordSets", in)
+	ret0, _ := ret[0].(*route53.ChangeResourceRecordSetsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeResourceRecordSets indicates an expected call of ChangeResourceRecordSets
+func (mr *MockapiMockRecorder) ChangeResourceRecordSets(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeResourceRecordSets", reflect.TypeOf((*Mockapi)(nil).ChangeResourceRecordSets), in)
+}
+
+// WaitUntilResourceRecordSetsChanged mocks base method
+func (m *Mockapi) WaitUntilResourceRecordSetsChanged(in *route53.GetChangeInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitUntilResourceRecordSetsChanged", in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WaitUntilResourceRecordSetsChanged indicates an expected call of WaitUntilResourceRecordSetsChanged
+func (mr *MockapiMockRecorder) WaitUntilResourceRecordSetsChanged(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitUntilResourceRecordSetsChanged", reflect.TypeOf((*Mockapi)(nil).WaitUntilResourceRecordSetsChanged), in)
+}
+
+// CreateHealthCheck mocks base method
+func (m *Mockapi) CreateHealthCheck(in *route53.CreateHealthCheckInput) (*route53.CreateHealthCheckOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateHealthCheck", in)
+	ret0, _ := ret[0].(*route53.CreateHealthCheckOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateHealthCheck indicates an expected call of CreateHealthCheck
+func (mr *MockapiMockRecorder) CreateHealthCheck(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateHealthCheck", reflect.TypeOf((*Mockapi)(nil).CreateHealthCheck), in)
+}
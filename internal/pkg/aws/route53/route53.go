@@ -6,10 +6,12 @@ package route53
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/copilot-cli/internal/pkg/trace"
 )
 
 const (
@@ -17,10 +19,51 @@ const (
 	// > To view limits and request higher limits for Route 53, you must change the Region to US East (N. Virginia).
 	// So we have to set the region to us-east-1 to be able to find out if a domain name exists in the account.
 	route53Region = "us-east-1"
+
+	hostedZoneIDPrefix = "/hostedzone/"
+
+	// TTL in seconds of the records that validate the domains of ACM certificates.
+	validationRecordTTL = 300
 )
 
 type api interface {
 	ListHostedZonesByName(in *route53.ListHostedZonesByNameInput) (*route53.ListHostedZonesByNameOutput, error)
+	GetHostedZone(in *route53.GetHostedZoneInput) (*route53.GetHostedZoneOutput, error)
+	ChangeResourceRecordSets(in *route53.ChangeResourceRecordSetsInput) (*route53.ChangeResourceRecordSetsOutput, error)
+	WaitUntilResourceRecordSetsChanged(in *route53.GetChangeInput) error
+	CreateHealthCheck(in *route53.CreateHealthCheckInput) (*route53.CreateHealthCheckOutput, error)
+}
+
+// AliasRecord is an A record in a hosted zone that routes traffic to an AWS resource such as a load balancer.
+type AliasRecord struct {
+	HostedZoneID       string
+	Name               string
+	TargetDNSName      string
+	TargetHostedZoneID string
+
+	// SetIdentifier distinguishes records with the same name that are part of a routing policy.
+	// The remaining fields are only used when it is set.
+	SetIdentifier string
+	Weight        *int64 // Weighted routing.
+	Region        string // Latency routing.
+	Failover      string // Failover routing, either PRIMARY or SECONDARY.
+	HealthCheckID string
+}
+
+// CNAMERecord is a CNAME record in a hosted zone, such as the record that validates a domain of an ACM certificate.
+type CNAMERecord struct {
+	Name  string
+	Value string
+}
+
+// HealthCheck is an HTTP health check against an endpoint.
+type HealthCheck struct {
+	// CallerReference identifies the health check so that it's only created once.
+	CallerReference string
+	DNSName         string
+	Path            string
+	Port            int64
+	HTTPS           bool // Requests the path over HTTPS with SNI instead of HTTP.
 }
 
 // Route53 wraps an Route53 client.
@@ -57,6 +100,190 @@ func (r *Route53) DomainExists(domainName string) (bool, error) {
 	}
 }
 
+// HostedZoneName returns the domain name of the hosted zone without the trailing dot.
+func (r *Route53) HostedZoneName(hostedZoneID string) (string, error) {
+	resp, err := r.client.GetHostedZone(&route53.GetHostedZoneInput{
+		Id: aws.String(hostedZoneID),
+	})
+	if err != nil {
+		return "", fmt.Errorf("get hosted zone %s: %w", hostedZoneID, err)
+	}
+	return strings.TrimSuffix(aws.StringValue(resp.HostedZone.Name), "."), nil
+}
+
+// PublicHostedZoneID returns the ID of the public hosted zone that the domain belongs to,
+// which is the hosted zone of the closest parent domain, such as "example.com" for "api.example.com".
+func (r *Route53) PublicHostedZoneID(domainName string) (string, error) {
+	labels := strings.Split(strings.TrimSuffix(domainName, "."), ".")
+	for i := 0; i < len(labels)-1; i++ {
+		name := strings.Join(labels[i:], ".") + "."
+		resp, err := r.client.ListHostedZonesByName(&route53.ListHostedZonesByNameInput{
+			DNSName: aws.String(name),
+		})
+		if err != nil {
+			return "", fmt.Errorf("list hosted zones for %s: %w", name, err)
+		}
+		for _, zone := range resp.HostedZones {
+			if aws.StringValue(zone.Name) != name {
+				// Hosted zones are listed in order from the name, so there are no more zones with that name.
+				break
+			}
+			if zone.Config != nil && aws.BoolValue(zone.Config.PrivateZone) {
+				continue
+			}
+			return strings.TrimPrefix(aws.StringValue(zone.Id), hostedZoneIDPrefix), nil
+		}
+	}
+	return "", fmt.Errorf("no public hosted zone found for domain %s", domainName)
+}
+
+// UpsertCNAMERecords creates or updates the CNAME records and waits until the change has propagated
+// to all Route 53 authoritative name servers.
+func (r *Route53) UpsertCNAMERecords(hostedZoneID string, records []CNAMERecord) error {
+	if len(records) == 0 {
+		return nil
+	}
+	var changes []*route53.Change
+	seen := make(map[string]bool)
+	for _, record := range records {
+		// Domains that share a validation record, such as "example.com" and "*.example.com", can only upsert it once per batch.
+		if seen[record.Name] {
+			continue
+		}
+		seen[record.Name] = true
+		changes = append(changes, &route53.Change{
+			Action: aws.String(route53.ChangeActionUpsert),
+			ResourceRecordSet: &route53.ResourceRecordSet{
+				Name: aws.String(record.Name),
+				Type: aws.String(route53.RRTypeCname),
+				TTL:  aws.Int64(validationRecordTTL),
+				ResourceRecords: []*route53.ResourceRecord{
+					{Value: aws.String(record.Value)},
+				},
+			},
+		})
+	}
+	resp, err := r.client.ChangeResourceRecordSets(&route53.ChangeResourceRecordSetsInput{
+		HostedZoneId: aws.String(hostedZoneID),
+		ChangeBatch: &route53.ChangeBatch{
+			Comment: aws.String("CNAME records managed by copilot"),
+			Changes: changes,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("upsert CNAME records in hosted zone %s: %w", hostedZoneID, err)
+	}
+	span := trace.Start("wait for CNAME records change", trace.Attr("hostedZone", hostedZoneID))
+	err = r.client.WaitUntilResourceRecordSetsChanged(&route53.GetChangeInput{
+		Id: resp.ChangeInfo.Id,
+	})
+	span.End(err)
+	if err != nil {
+		return fmt.Errorf("wait for CNAME records in hosted zone %s to propagate: %w", hostedZoneID, err)
+	}
+	return nil
+}
+
+// UpsertAliasRecord creates or updates the alias record and waits until the change has propagated
+// to all Route 53 authoritative name servers.
+func (r *Route53) UpsertAliasRecord(record AliasRecord) error {
+	resp, err := r.client.ChangeResourceRecordSets(&route53.ChangeResourceRecordSetsInput{
+		HostedZoneId: aws.String(record.HostedZoneID),
+		ChangeBatch: &route53.ChangeBatch{
+			Comment: aws.String("Alias record managed by copilot"),
+			Changes: []*route53.Change{
+				{
+					Action:            aws.String(route53.ChangeActionUpsert),
+					ResourceRecordSet: aliasRecordSet(record),
+				},
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("upsert alias record %s in hosted zone %s: %w", record.Name, record.HostedZoneID, err)
+	}
+	span := trace.Start("wait for alias record change", trace.Attr("record", record.Name))
+	err = r.client.WaitUntilResourceRecordSetsChanged(&route53.GetChangeInput{
+		Id: resp.ChangeInfo.Id,
+	})
+	span.End(err)
+	if err != nil {
+		return fmt.Errorf("wait for alias record %s to propagate: %w", record.Name, err)
+	}
+	return nil
+}
+
+// DeleteAliasRecord deletes the alias record, which must match the record in the hosted zone exactly.
+// It doesn't wait for the change to propagate.
+func (r *Route53) DeleteAliasRecord(record AliasRecord) error {
+	_, err := r.client.ChangeResourceRecordSets(&route53.ChangeResourceRecordSetsInput{
+		HostedZoneId: aws.String(record.HostedZoneID),
+		ChangeBatch: &route53.ChangeBatch{
+			Comment: aws.String("Alias record managed by copilot"),
+			Changes: []*route53.Change{
+				{
+					Action:            aws.String(route53.ChangeActionDelete),
+					ResourceRecordSet: aliasRecordSet(record),
+				},
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("delete alias record %s in hosted zone %s: %w", record.Name, record.HostedZoneID, err)
+	}
+	return nil
+}
+
+// aliasRecordSet converts the alias record into a resource record set.
+func aliasRecordSet(record AliasRecord) *route53.ResourceRecordSet {
+	recordSet := &route53.ResourceRecordSet{
+		Name: aws.String(record.Name),
+		Type: aws.String(route53.RRTypeA),
+		AliasTarget: &route53.AliasTarget{
+			DNSName:              aws.String(record.TargetDNSName),
+			HostedZoneId:         aws.String(record.TargetHostedZoneID),
+			EvaluateTargetHealth: aws.Bool(true),
+		},
+	}
+	if record.SetIdentifier != "" {
+		recordSet.SetIdentifier = aws.String(record.SetIdentifier)
+		recordSet.Weight = record.Weight
+		if record.Region != "" {
+			recordSet.Region = aws.String(record.Region)
+		}
+		if record.Failover != "" {
+			recordSet.Failover = aws.String(record.Failover)
+		}
+		if record.HealthCheckID != "" {
+			recordSet.HealthCheckId = aws.String(record.HealthCheckID)
+		}
+	}
+	return recordSet
+}
+
+// CreateHealthCheck creates an HTTP or HTTPS health check and returns its ID.
+// If a health check with the same caller reference and settings already exists, its ID is returned instead.
+func (r *Route53) CreateHealthCheck(hc HealthCheck) (string, error) {
+	conf := &route53.HealthCheckConfig{
+		Type:                     aws.String(route53.HealthCheckTypeHttp),
+		FullyQualifiedDomainName: aws.String(hc.DNSName),
+		ResourcePath:             aws.String(hc.Path),
+		Port:                     aws.Int64(hc.Port),
+	}
+	if hc.HTTPS {
+		conf.Type = aws.String(route53.HealthCheckTypeHttps)
+		conf.EnableSNI = aws.Bool(true)
+	}
+	resp, err := r.client.CreateHealthCheck(&route53.CreateHealthCheckInput{
+		CallerReference:   aws.String(hc.CallerReference),
+		HealthCheckConfig: conf,
+	})
+	if err != nil {
+		return "", fmt.Errorf("create health check for %s%s: %w", hc.DNSName, hc.Path, err)
+	}
+	return aws.StringValue(resp.HealthCheck.Id), nil
+}
+
 // hostedZoneExists checks if certain domain exists in any of the hosted zones.
 func hostedZoneExists(hostedZones []*route53.HostedZone, domain string) bool {
 	for _, hostedZone := range hostedZones {
@@ -160,3 +160,510 @@ func TestRoute53_DomainExists(t *testing.T) {
 
 	}
 }
+
+func TestRoute53_HostedZoneName(t *testing.T) {
+	testCases := map[string]struct {
+		mockRoute53Client func(m *mocks.Mockapi)
+
+		wantName string
+		wantErr  error
+	}{
+		"trims the trailing dot of the zone name": {
+			mockRoute53Client: func(m *mocks.Mockapi) {
+				m.EXPECT().GetHostedZone(&route53.GetHostedZoneInput{
+					Id: aws.String("mockZoneID"),
+				}).Return(&route53.GetHostedZoneOutput{
+					HostedZone: &route53.HostedZone{
+						Name: aws.String("example.com."),
+					},
+				}, nil)
+			},
+			wantName: "example.com",
+		},
+		"failed to get the hosted zone": {
+			mockRoute53Client: func(m *mocks.Mockapi) {
+				m.EXPECT().GetHostedZone(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantErr: fmt.Errorf("get hosted zone mockZoneID: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRoute53Client := mocks.NewMockapi(ctrl)
+			tc.mockRoute53Client(mockRoute53Client)
+
+			service := Route53{
+				client: mockRoute53Client,
+			}
+
+			// WHEN
+			gotName, gotErr := service.HostedZoneName("mockZoneID")
+
+			// THEN
+			if tc.wantErr != nil {
+				require.EqualError(t, gotErr, tc.wantErr.Error())
+			} else {
+				require.NoError(t, gotErr)
+				require.Equal(t, tc.wantName, gotName)
+			}
+		})
+	}
+}
+
+func TestRoute53_UpsertAliasRecord(t *testing.T) {
+	record := AliasRecord{
+		HostedZoneID:       "mockZoneID",
+		Name:               "api.example.com",
+		TargetDNSName:      "lb.us-west-2.elb.amazonaws.com",
+		TargetHostedZoneID: "mockLBZoneID",
+	}
+	testCases := map[string]struct {
+		mockRoute53Client func(m *mocks.Mockapi)
+
+		wantErr error
+	}{
+		"upserts the record and waits for it to propagate": {
+			mockRoute53Client: func(m *mocks.Mockapi) {
+				m.EXPECT().ChangeResourceRecordSets(&route53.ChangeResourceRecordSetsInput{
+					HostedZoneId: aws.String("mockZoneID"),
+					ChangeBatch: &route53.ChangeBatch{
+						Comment: aws.String("Alias record managed by copilot"),
+						Changes: []*route53.Change{
+							{
+								Action: aws.String(route53.ChangeActionUpsert),
+								ResourceRecordSet: &route53.ResourceRecordSet{
+									Name: aws.String("api.example.com"),
+									Type: aws.String(route53.RRTypeA),
+									AliasTarget: &route53.AliasTarget{
+										DNSName:              aws.String("lb.us-west-2.elb.amazonaws.com"),
+										HostedZoneId:         aws.String("mockLBZoneID"),
+										EvaluateTargetHealth: aws.Bool(true),
+									},
+								},
+							},
+						},
+					},
+				}).Return(&route53.ChangeResourceRecordSetsOutput{
+					ChangeInfo: &route53.ChangeInfo{
+						Id: aws.String("mockChangeID"),
+					},
+				}, nil)
+				m.EXPECT().WaitUntilResourceRecordSetsChanged(&route53.GetChangeInput{
+					Id: aws.String("mockChangeID"),
+				}).Return(nil)
+			},
+		},
+		"failed to upsert the record": {
+			mockRoute53Client: func(m *mocks.Mockapi) {
+				m.EXPECT().ChangeResourceRecordSets(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantErr: fmt.Errorf("upsert alias record api.example.com in hosted zone mockZoneID: some error"),
+		},
+		"failed to wait for the record to propagate": {
+			mockRoute53Client: func(m *mocks.Mockapi) {
+				m.EXPECT().ChangeResourceRecordSets(gomock.Any()).Return(&route53.ChangeResourceRecordSetsOutput{
+					ChangeInfo: &route53.ChangeInfo{
+						Id: aws.String("mockChangeID"),
+					},
+				}, nil)
+				m.EXPECT().WaitUntilResourceRecordSetsChanged(gomock.Any()).Return(errors.New("some error"))
+			},
+			wantErr: fmt.Errorf("wait for alias record api.example.com to propagate: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRoute53Client := mocks.NewMockapi(ctrl)
+			tc.mockRoute53Client(mockRoute53Client)
+
+			service := Route53{
+				client: mockRoute53Client,
+			}
+
+			// WHEN
+			gotErr := service.UpsertAliasRecord(record)
+
+			// THEN
+			if tc.wantErr != nil {
+				require.EqualError(t, gotErr, tc.wantErr.Error())
+			} else {
+				require.NoError(t, gotErr)
+			}
+		})
+	}
+}
+
+func TestRoute53_UpsertAliasRecordWithRoutingPolicy(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockRoute53Client := mocks.NewMockapi(ctrl)
+	mockRoute53Client.EXPECT().ChangeResourceRecordSets(gomock.Any()).DoAndReturn(func(in *route53.ChangeResourceRecordSetsInput) (*route53.ChangeResourceRecordSetsOutput, error) {
+		require.Equal(t, &route53.ResourceRecordSet{
+			Name:          aws.String("api.example.com"),
+			Type:          aws.String(route53.RRTypeA),
+			SetIdentifier: aws.String("test"),
+			Weight:        aws.Int64(80),
+			HealthCheckId: aws.String("mockHealthCheckID"),
+			AliasTarget: &route53.AliasTarget{
+				DNSName:              aws.String("lb.us-west-2.elb.amazonaws.com"),
+				HostedZoneId:         aws.String("mockLBZoneID"),
+				EvaluateTargetHealth: aws.Bool(true),
+			},
+		}, in.ChangeBatch.Changes[0].ResourceRecordSet)
+		return &route53.ChangeResourceRecordSetsOutput{
+			ChangeInfo: &route53.ChangeInfo{
+				Id: aws.String("mockChangeID"),
+			},
+		}, nil
+	})
+	mockRoute53Client.EXPECT().WaitUntilResourceRecordSetsChanged(gomock.Any()).Return(nil)
+	service := Route53{
+		client: mockRoute53Client,
+	}
+
+	// WHEN
+	err := service.UpsertAliasRecord(AliasRecord{
+		HostedZoneID:       "mockZoneID",
+		Name:               "api.example.com",
+		TargetDNSName:      "lb.us-west-2.elb.amazonaws.com",
+		TargetHostedZoneID: "mockLBZoneID",
+		SetIdentifier:      "test",
+		Weight:             aws.Int64(80),
+		HealthCheckID:      "mockHealthCheckID",
+	})
+
+	// THEN
+	require.NoError(t, err)
+}
+
+func TestRoute53_CreateHealthCheck(t *testing.T) {
+	hc := HealthCheck{
+		CallerReference: "mockReference",
+		DNSName:         "lb.us-west-2.elb.amazonaws.com",
+		Path:            "/healthz",
+		Port:            80,
+	}
+	testCases := map[string]struct {
+		inHTTPS           bool
+		mockRoute53Client func(m *mocks.Mockapi)
+
+		wantID  string
+		wantErr error
+	}{
+		"returns the id of the HTTPS health check": {
+			inHTTPS: true,
+			mockRoute53Client: func(m *mocks.Mockapi) {
+				m.EXPECT().CreateHealthCheck(&route53.CreateHealthCheckInput{
+					CallerReference: aws.String("mockReference"),
+					HealthCheckConfig: &route53.HealthCheckConfig{
+						Type:                     aws.String(route53.HealthCheckTypeHttps),
+						FullyQualifiedDomainName: aws.String("lb.us-west-2.elb.amazonaws.com"),
+						ResourcePath:             aws.String("/healthz"),
+						Port:                     aws.Int64(80),
+						EnableSNI:                aws.Bool(true),
+					},
+				}).Return(&route53.CreateHealthCheckOutput{
+					HealthCheck: &route53.HealthCheck{
+						Id: aws.String("mockHealthCheckID"),
+					},
+				}, nil)
+			},
+			wantID: "mockHealthCheckID",
+		},
+		"returns the id of the health check": {
+			mockRoute53Client: func(m *mocks.Mockapi) {
+				m.EXPECT().CreateHealthCheck(&route53.CreateHealthCheckInput{
+					CallerReference: aws.String("mockReference"),
+					HealthCheckConfig: &route53.HealthCheckConfig{
+						Type:                     aws.String(route53.HealthCheckTypeHttp),
+						FullyQualifiedDomainName: aws.String("lb.us-west-2.elb.amazonaws.com"),
+						ResourcePath:             aws.String("/healthz"),
+						Port:                     aws.Int64(80),
+					},
+				}).Return(&route53.CreateHealthCheckOutput{
+					HealthCheck: &route53.HealthCheck{
+						Id: aws.String("mockHealthCheckID"),
+					},
+				}, nil)
+			},
+			wantID: "mockHealthCheckID",
+		},
+		"failed to create the health check": {
+			mockRoute53Client: func(m *mocks.Mockapi) {
+				m.EXPECT().CreateHealthCheck(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantErr: fmt.Errorf("create health check for lb.us-west-2.elb.amazonaws.com/healthz: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRoute53Client := mocks.NewMockapi(ctrl)
+			tc.mockRoute53Client(mockRoute53Client)
+
+			service := Route53{
+				client: mockRoute53Client,
+			}
+
+			in := hc
+			in.HTTPS = tc.inHTTPS
+
+			// WHEN
+			gotID, gotErr := service.CreateHealthCheck(in)
+
+			// THEN
+			if tc.wantErr != nil {
+				require.EqualError(t, gotErr, tc.wantErr.Error())
+			} else {
+				require.NoError(t, gotErr)
+				require.Equal(t, tc.wantID, gotID)
+			}
+		})
+	}
+}
+
+func TestRoute53_PublicHostedZoneID(t *testing.T) {
+	testCases := map[string]struct {
+		mockRoute53Client func(m *mocks.Mockapi)
+
+		wantID  string
+		wantErr error
+	}{
+		"failed to list hosted zones": {
+			mockRoute53Client: func(m *mocks.Mockapi) {
+				m.EXPECT().ListHostedZonesByName(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantErr: fmt.Errorf("list hosted zones for api.example.com.: some error"),
+		},
+		"returns the public hosted zone of the closest parent domain": {
+			mockRoute53Client: func(m *mocks.Mockapi) {
+				m.EXPECT().ListHostedZonesByName(&route53.ListHostedZonesByNameInput{
+					DNSName: aws.String("api.example.com."),
+				}).Return(&route53.ListHostedZonesByNameOutput{
+					HostedZones: []*route53.HostedZone{
+						{Id: aws.String("/hostedzone/other"), Name: aws.String("example.net.")},
+					},
+				}, nil)
+				m.EXPECT().ListHostedZonesByName(&route53.ListHostedZonesByNameInput{
+					DNSName: aws.String("example.com."),
+				}).Return(&route53.ListHostedZonesByNameOutput{
+					HostedZones: []*route53.HostedZone{
+						{Id: aws.String("/hostedzone/private"), Name: aws.String("example.com."), Config: &route53.HostedZoneConfig{PrivateZone: aws.Bool(true)}},
+						{Id: aws.String("/hostedzone/public"), Name: aws.String("example.com."), Config: &route53.HostedZoneConfig{PrivateZone: aws.Bool(false)}},
+					},
+				}, nil)
+			},
+			wantID: "public",
+		},
+		"errors if there are no public hosted zones for the domain": {
+			mockRoute53Client: func(m *mocks.Mockapi) {
+				m.EXPECT().ListHostedZonesByName(gomock.Any()).Return(&route53.ListHostedZonesByNameOutput{}, nil).Times(2)
+			},
+			wantErr: fmt.Errorf("no public hosted zone found for domain api.example.com"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRoute53Client := mocks.NewMockapi(ctrl)
+			tc.mockRoute53Client(mockRoute53Client)
+
+			service := Route53{
+				client: mockRoute53Client,
+			}
+
+			// WHEN
+			id, err := service.PublicHostedZoneID("api.example.com")
+
+			// THEN
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantID, id)
+		})
+	}
+}
+
+func TestRoute53_UpsertCNAMERecords(t *testing.T) {
+	records := []CNAMERecord{
+		{Name: "_x1.example.com.", Value: "_x2.acm-validations.aws."},
+		{Name: "_x1.example.com.", Value: "_x2.acm-validations.aws."},
+		{Name: "_x3.api.example.com.", Value: "_x4.acm-validations.aws."},
+	}
+	testCases := map[string]struct {
+		mockRoute53Client func(m *mocks.Mockapi)
+
+		wantErr error
+	}{
+		"upserts each record once and waits for them to propagate": {
+			mockRoute53Client: func(m *mocks.Mockapi) {
+				m.EXPECT().ChangeResourceRecordSets(&route53.ChangeResourceRecordSetsInput{
+					HostedZoneId: aws.String("mockZoneID"),
+					ChangeBatch: &route53.ChangeBatch{
+						Comment: aws.String("CNAME records managed by copilot"),
+						Changes: []*route53.Change{
+							{
+								Action: aws.String(route53.ChangeActionUpsert),
+								ResourceRecordSet: &route53.ResourceRecordSet{
+									Name:            aws.String("_x1.example.com."),
+									Type:            aws.String(route53.RRTypeCname),
+									TTL:             aws.Int64(300),
+									ResourceRecords: []*route53.ResourceRecord{{Value: aws.String("_x2.acm-validations.aws.")}},
+								},
+							},
+							{
+								Action: aws.String(route53.ChangeActionUpsert),
+								ResourceRecordSet: &route53.ResourceRecordSet{
+									Name:            aws.String("_x3.api.example.com."),
+									Type:            aws.String(route53.RRTypeCname),
+									TTL:             aws.Int64(300),
+									ResourceRecords: []*route53.ResourceRecord{{Value: aws.String("_x4.acm-validations.aws.")}},
+								},
+							},
+						},
+					},
+				}).Return(&route53.ChangeResourceRecordSetsOutput{
+					ChangeInfo: &route53.ChangeInfo{
+						Id: aws.String("mockChangeID"),
+					},
+				}, nil)
+				m.EXPECT().WaitUntilResourceRecordSetsChanged(&route53.GetChangeInput{
+					Id: aws.String("mockChangeID"),
+				}).Return(nil)
+			},
+		},
+		"failed to upsert the records": {
+			mockRoute53Client: func(m *mocks.Mockapi) {
+				m.EXPECT().ChangeResourceRecordSets(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantErr: fmt.Errorf("upsert CNAME records in hosted zone mockZoneID: some error"),
+		},
+		"failed to wait for the records to propagate": {
+			mockRoute53Client: func(m *mocks.Mockapi) {
+				m.EXPECT().ChangeResourceRecordSets(gomock.Any()).Return(&route53.ChangeResourceRecordSetsOutput{
+					ChangeInfo: &route53.ChangeInfo{
+						Id: aws.String("mockChangeID"),
+					},
+				}, nil)
+				m.EXPECT().WaitUntilResourceRecordSetsChanged(gomock.Any()).Return(errors.New("some error"))
+			},
+			wantErr: fmt.Errorf("wait for CNAME records in hosted zone mockZoneID to propagate: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRoute53Client := mocks.NewMockapi(ctrl)
+			tc.mockRoute53Client(mockRoute53Client)
+
+			service := Route53{
+				client: mockRoute53Client,
+			}
+
+			// WHEN
+			gotErr := service.UpsertCNAMERecords("mockZoneID", records)
+
+			// THEN
+			if tc.wantErr != nil {
+				require.EqualError(t, gotErr, tc.wantErr.Error())
+			} else {
+				require.NoError(t, gotErr)
+			}
+		})
+	}
+}
+
+func TestRoute53_DeleteAliasRecord(t *testing.T) {
+	record := AliasRecord{
+		HostedZoneID:       "mockZoneID",
+		Name:               "api.example.com",
+		TargetDNSName:      "lb.us-west-2.elb.amazonaws.com",
+		TargetHostedZoneID: "mockLBZoneID",
+	}
+	testCases := map[string]struct {
+		mockRoute53Client func(m *mocks.Mockapi)
+
+		wantErr error
+	}{
+		"deletes the record": {
+			mockRoute53Client: func(m *mocks.Mockapi) {
+				m.EXPECT().ChangeResourceRecordSets(&route53.ChangeResourceRecordSetsInput{
+					HostedZoneId: aws.String("mockZoneID"),
+					ChangeBatch: &route53.ChangeBatch{
+						Comment: aws.String("Alias record managed by copilot"),
+						Changes: []*route53.Change{
+							{
+								Action: aws.String(route53.ChangeActionDelete),
+								ResourceRecordSet: &route53.ResourceRecordSet{
+									Name: aws.String("api.example.com"),
+									Type: aws.String(route53.RRTypeA),
+									AliasTarget: &route53.AliasTarget{
+										DNSName:              aws.String("lb.us-west-2.elb.amazonaws.com"),
+										HostedZoneId:         aws.String("mockLBZoneID"),
+										EvaluateTargetHealth: aws.Bool(true),
+									},
+								},
+							},
+						},
+					},
+				}).Return(&route53.ChangeResourceRecordSetsOutput{}, nil)
+			},
+		},
+		"failed to delete the record": {
+			mockRoute53Client: func(m *mocks.Mockapi) {
+				m.EXPECT().ChangeResourceRecordSets(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantErr: fmt.Errorf("delete alias record api.example.com in hosted zone mockZoneID: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRoute53Client := mocks.NewMockapi(ctrl)
+			tc.mockRoute53Client(mockRoute53Client)
+
+			service := Route53{
+				client: mockRoute53Client,
+			}
+
+			// WHEN
+			gotErr := service.DeleteAliasRecord(record)
+
+			// THEN
+			if tc.wantErr != nil {
+				require.EqualError(t, gotErr, tc.wantErr.Error())
+			} else {
+				require.NoError(t, gotErr)
+			}
+		})
+	}
+}
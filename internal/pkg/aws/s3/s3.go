@@ -7,18 +7,24 @@ package s3
 import (
 	"fmt"
 	"io"
+	"mime"
 	"path"
 	"strconv"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/aws/copilot-cli/internal/pkg/trace"
 )
 
 const (
 	artifactDirName = "manual"
+
+	deleteObjectsBatchSize = 1000 // Maximum number of keys that can be deleted in a single DeleteObjects call.
+	uploadPartConcurrency  = 5    // Number of parts of a large object that are uploaded in parallel.
 )
 
 type s3ManagerApi interface {
@@ -28,6 +34,15 @@ type s3ManagerApi interface {
 type s3Api interface {
 	ListObjectVersions(input *s3.ListObjectVersionsInput) (*s3.ListObjectVersionsOutput, error)
 	DeleteObjects(input *s3.DeleteObjectsInput) (*s3.DeleteObjectsOutput, error)
+	GetObjectRequest(input *s3.GetObjectInput) (req *request.Request, output *s3.GetObjectOutput)
+	ListObjectsV2(input *s3.ListObjectsV2Input) (*s3.ListObjectsV2Output, error)
+}
+
+// Object is an object stored in a bucket.
+type Object struct {
+	Key          string
+	Size         int64
+	LastModified time.Time
 }
 
 // S3 wraps an Amazon Simple Storage Service client.
@@ -47,18 +62,121 @@ func New(s *session.Session) *S3 {
 // PutArtifact uploads data to a S3 bucket under a random path that ends with
 // the file name and returns its url.
 func (s *S3) PutArtifact(bucket, fileName string, data io.Reader) (string, error) {
+	_, location, err := s.putArtifact(bucket, fileName, data)
+	return location, err
+}
+
+// PutArtifactWithPresignedURL uploads data to a S3 bucket like PutArtifact, and returns a pre-signed URL
+// to download it that expires after the duration.
+// The pre-signed URL lets principals that aren't allowed to read from the bucket, such as CloudFormation in
+// another account, download the artifact.
+func (s *S3) PutArtifactWithPresignedURL(bucket, fileName string, data io.Reader, expiry time.Duration) (string, error) {
+	key, _, err := s.putArtifact(bucket, fileName, data)
+	if err != nil {
+		return "", err
+	}
+	req, _ := s.s3Client.GetObjectRequest(&s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	url, err := req.Presign(expiry)
+	if err != nil {
+		return "", fmt.Errorf("pre-sign url of %s in bucket %s: %w", key, bucket, err)
+	}
+	return url, nil
+}
+
+func (s *S3) putArtifact(bucket, fileName string, data io.Reader) (key, location string, err error) {
 	id := time.Now().Unix()
-	key := path.Join(artifactDirName, strconv.FormatInt(id, 10), fileName)
+	key = path.Join(artifactDirName, strconv.FormatInt(id, 10), fileName)
+	span := trace.Start("upload artifact", trace.Attr("bucket", bucket), trace.Attr("key", key))
 	resp, err := s.s3Manager.Upload(&s3manager.UploadInput{
 		Body:   data,
 		Bucket: aws.String(bucket),
 		Key:    aws.String(key),
 	})
+	span.End(err)
 	if err != nil {
-		return "", fmt.Errorf("put %s to bucket %s: %w", key, bucket, err)
+		return "", "", fmt.Errorf("put %s to bucket %s: %w", key, bucket, err)
 	}
 
-	return resp.Location, nil
+	return key, resp.Location, nil
+}
+
+// Objects returns the current version of the objects of the bucket, keyed by object key.
+func (s *S3) Objects(bucket string) (map[string]Object, error) {
+	objects := make(map[string]Object)
+	in := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+	}
+	for {
+		resp, err := s.s3Client.ListObjectsV2(in)
+		if err != nil {
+			return nil, fmt.Errorf("list objects of bucket %s: %w", bucket, err)
+		}
+		for _, object := range resp.Contents {
+			key := aws.StringValue(object.Key)
+			objects[key] = Object{
+				Key:          key,
+				Size:         aws.Int64Value(object.Size),
+				LastModified: aws.TimeValue(object.LastModified),
+			}
+		}
+		if !aws.BoolValue(resp.IsTruncated) {
+			return objects, nil
+		}
+		in.ContinuationToken = resp.NextContinuationToken
+	}
+}
+
+// UploadObject uploads data to the bucket under the key, with the content type of the key's extension.
+// Large objects are uploaded in parts, several parts at a time.
+func (s *S3) UploadObject(bucket, key string, data io.Reader) error {
+	in := &s3manager.UploadInput{
+		Body:   data,
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	}
+	if contentType := mime.TypeByExtension(path.Ext(key)); contentType != "" {
+		in.ContentType = aws.String(contentType)
+	}
+	if _, err := s.s3Manager.Upload(in, func(u *s3manager.Uploader) {
+		u.Concurrency = uploadPartConcurrency
+	}); err != nil {
+		return fmt.Errorf("upload %s to bucket %s: %w", key, bucket, err)
+	}
+	return nil
+}
+
+// DeleteObjects deletes the current version of the objects with the keys from the bucket.
+func (s *S3) DeleteObjects(bucket string, keys []string) error {
+	for start := 0; start < len(keys); start += deleteObjectsBatchSize {
+		end := start + deleteObjectsBatchSize
+		if end > len(keys) {
+			end = len(keys)
+		}
+		var objects []*s3.ObjectIdentifier
+		for _, key := range keys[start:end] {
+			objects = append(objects, &s3.ObjectIdentifier{
+				Key: aws.String(key),
+			})
+		}
+		resp, err := s.s3Client.DeleteObjects(&s3.DeleteObjectsInput{
+			Bucket: aws.String(bucket),
+			Delete: &s3.Delete{
+				Objects: objects,
+				Quiet:   aws.Bool(true),
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("delete objects from bucket %s: %w", bucket, err)
+		}
+		if len(resp.Errors) > 0 {
+			failed := resp.Errors[0]
+			return fmt.Errorf("delete %s from bucket %s: %s", aws.StringValue(failed.Key), bucket, aws.StringValue(failed.Message))
+		}
+	}
+	return nil
 }
 
 // EmptyBucket deletes all objects within the bucket.
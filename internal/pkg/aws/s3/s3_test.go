@@ -8,10 +8,13 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3manager"
 	"github.com/aws/copilot-cli/internal/pkg/aws/s3/mocks"
@@ -89,6 +92,73 @@ func TestS3_PutArtifact(t *testing.T) {
 	}
 }
 
+func TestS3_PutArtifactWithPresignedURL(t *testing.T) {
+	timeNow := strconv.FormatInt(time.Now().Unix(), 10)
+	key := fmt.Sprintf("manual/%s/api.stack.yml", timeNow)
+	// Pre-signing a request doesn't call AWS, so the requests are created by a client with static credentials.
+	sess := session.Must(session.NewSession(&aws.Config{
+		Region:      aws.String("us-west-2"),
+		Credentials: credentials.NewStaticCredentials("AKID", "SECRET", ""),
+	}))
+	client := s3.New(sess)
+	testCases := map[string]struct {
+		setupMocks func(manager *mocks.Mocks3ManagerApi, api *mocks.Mocks3Api)
+
+		wantedURLPrefix string
+		wantedErr       error
+	}{
+		"should return error if fail to upload": {
+			setupMocks: func(manager *mocks.Mocks3ManagerApi, api *mocks.Mocks3Api) {
+				manager.EXPECT().Upload(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+
+			wantedErr: fmt.Errorf("put %s to bucket mockBucket: some error", key),
+		},
+		"should upload the artifact and return a pre-signed url": {
+			setupMocks: func(manager *mocks.Mocks3ManagerApi, api *mocks.Mocks3Api) {
+				manager.EXPECT().Upload(gomock.Any()).Return(&s3manager.UploadOutput{
+					Location: fmt.Sprintf("https://mockBucket.s3.us-west-2.amazonaws.com/%s", key),
+				}, nil)
+				api.EXPECT().GetObjectRequest(&s3.GetObjectInput{
+					Bucket: aws.String("mockBucket"),
+					Key:    aws.String(key),
+				}).DoAndReturn(client.GetObjectRequest)
+			},
+
+			wantedURLPrefix: fmt.Sprintf("https://s3.us-west-2.amazonaws.com/mockBucket/%s?X-Amz-Algorithm=AWS4-HMAC-SHA256", key),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockS3ManagerClient := mocks.NewMocks3ManagerApi(ctrl)
+			mockS3Client := mocks.NewMocks3Api(ctrl)
+			tc.setupMocks(mockS3ManagerClient, mockS3Client)
+
+			service := S3{
+				s3Manager: mockS3ManagerClient,
+				s3Client:  mockS3Client,
+			}
+
+			// WHEN
+			url, err := service.PutArtifactWithPresignedURL("mockBucket", "api.stack.yml", &bytes.Buffer{}, time.Hour)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, strings.HasPrefix(url, tc.wantedURLPrefix), "url %s should start with %s", url, tc.wantedURLPrefix)
+			require.Contains(t, url, "X-Amz-Expires=3600")
+		})
+	}
+}
+
 func TestS3_EmptyBucket(t *testing.T) {
 	batchObject1 := make([]*s3.ObjectVersion, 1000)
 	batchObject2 := make([]*s3.ObjectVersion, 10)
@@ -257,3 +327,194 @@ func TestS3_EmptyBucket(t *testing.T) {
 
 	}
 }
+
+func TestS3_Objects(t *testing.T) {
+	lastModified := time.Date(2020, 10, 15, 9, 30, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		mockS3Client func(m *mocks.Mocks3Api)
+
+		wantedObjects map[string]Object
+		wantedErr     error
+	}{
+		"returns the objects of every page": {
+			mockS3Client: func(m *mocks.Mocks3Api) {
+				m.EXPECT().ListObjectsV2(&s3.ListObjectsV2Input{
+					Bucket: aws.String("mockBucket"),
+				}).Return(&s3.ListObjectsV2Output{
+					Contents: []*s3.Object{
+						{Key: aws.String("index.html"), Size: aws.Int64(512), LastModified: aws.Time(lastModified)},
+					},
+					IsTruncated:           aws.Bool(true),
+					NextContinuationToken: aws.String("token"),
+				}, nil)
+				m.EXPECT().ListObjectsV2(&s3.ListObjectsV2Input{
+					Bucket:            aws.String("mockBucket"),
+					ContinuationToken: aws.String("token"),
+				}).Return(&s3.ListObjectsV2Output{
+					Contents: []*s3.Object{
+						{Key: aws.String("css/main.css"), Size: aws.Int64(128), LastModified: aws.Time(lastModified)},
+					},
+				}, nil)
+			},
+			wantedObjects: map[string]Object{
+				"index.html":   {Key: "index.html", Size: 512, LastModified: lastModified},
+				"css/main.css": {Key: "css/main.css", Size: 128, LastModified: lastModified},
+			},
+		},
+		"wraps the error from listing the objects": {
+			mockS3Client: func(m *mocks.Mocks3Api) {
+				m.EXPECT().ListObjectsV2(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("list objects of bucket mockBucket: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockS3Client := mocks.NewMocks3Api(ctrl)
+			tc.mockS3Client(mockS3Client)
+			service := S3{
+				s3Client: mockS3Client,
+			}
+
+			// WHEN
+			objects, err := service.Objects("mockBucket")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedObjects, objects)
+		})
+	}
+}
+
+func TestS3_UploadObject(t *testing.T) {
+	testCases := map[string]struct {
+		inKey               string
+		mockS3ManagerClient func(m *mocks.Mocks3ManagerApi)
+
+		wantedErr error
+	}{
+		"sets the content type of the extension": {
+			inKey: "index.html",
+			mockS3ManagerClient: func(m *mocks.Mocks3ManagerApi) {
+				m.EXPECT().Upload(gomock.Any(), gomock.Any()).DoAndReturn(func(in *s3manager.UploadInput, _ ...func(*s3manager.Uploader)) (*s3manager.UploadOutput, error) {
+					require.Equal(t, "index.html", aws.StringValue(in.Key))
+					require.Equal(t, "text/html; charset=utf-8", aws.StringValue(in.ContentType))
+					return &s3manager.UploadOutput{}, nil
+				})
+			},
+		},
+		"leaves the content type unset for unknown extensions": {
+			inKey: "data/dump",
+			mockS3ManagerClient: func(m *mocks.Mocks3ManagerApi) {
+				m.EXPECT().Upload(gomock.Any(), gomock.Any()).DoAndReturn(func(in *s3manager.UploadInput, _ ...func(*s3manager.Uploader)) (*s3manager.UploadOutput, error) {
+					require.Nil(t, in.ContentType)
+					return &s3manager.UploadOutput{}, nil
+				})
+			},
+		},
+		"wraps the error from uploading": {
+			inKey: "index.html",
+			mockS3ManagerClient: func(m *mocks.Mocks3ManagerApi) {
+				m.EXPECT().Upload(gomock.Any(), gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("upload index.html to bucket mockBucket: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockS3ManagerClient := mocks.NewMocks3ManagerApi(ctrl)
+			tc.mockS3ManagerClient(mockS3ManagerClient)
+			service := S3{
+				s3Manager: mockS3ManagerClient,
+			}
+
+			// WHEN
+			err := service.UploadObject("mockBucket", tc.inKey, strings.NewReader("data"))
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestS3_DeleteObjects(t *testing.T) {
+	var manyKeys []string
+	for i := 0; i < 1001; i++ {
+		manyKeys = append(manyKeys, fmt.Sprintf("key-%d", i))
+	}
+	testCases := map[string]struct {
+		inKeys       []string
+		mockS3Client func(m *mocks.Mocks3Api)
+
+		wantedErr error
+	}{
+		"does nothing without keys": {
+			mockS3Client: func(m *mocks.Mocks3Api) {
+				m.EXPECT().DeleteObjects(gomock.Any()).Times(0)
+			},
+		},
+		"deletes the keys in batches": {
+			inKeys: manyKeys,
+			mockS3Client: func(m *mocks.Mocks3Api) {
+				m.EXPECT().DeleteObjects(gomock.Any()).DoAndReturn(func(in *s3.DeleteObjectsInput) (*s3.DeleteObjectsOutput, error) {
+					require.Len(t, in.Delete.Objects, 1000)
+					return &s3.DeleteObjectsOutput{}, nil
+				})
+				m.EXPECT().DeleteObjects(gomock.Any()).DoAndReturn(func(in *s3.DeleteObjectsInput) (*s3.DeleteObjectsOutput, error) {
+					require.Equal(t, "key-1000", aws.StringValue(in.Delete.Objects[0].Key))
+					return &s3.DeleteObjectsOutput{}, nil
+				})
+			},
+		},
+		"returns the first object that failed to be deleted": {
+			inKeys: []string{"index.html"},
+			mockS3Client: func(m *mocks.Mocks3Api) {
+				m.EXPECT().DeleteObjects(gomock.Any()).Return(&s3.DeleteObjectsOutput{
+					Errors: []*s3.Error{
+						{Key: aws.String("index.html"), Message: aws.String("Access Denied")},
+					},
+				}, nil)
+			},
+			wantedErr: errors.New("delete index.html from bucket mockBucket: Access Denied"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockS3Client := mocks.NewMocks3Api(ctrl)
+			tc.mockS3Client(mockS3Client)
+			service := S3{
+				s3Client: mockS3Client,
+			}
+
+			// WHEN
+			err := service.DeleteObjects("mockBucket", tc.inKeys)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -5,11 +5,13 @@
 package secretsmanager
 
 import (
+	"encoding/json"
 	"fmt"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/secretsmanager"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 )
@@ -17,6 +19,8 @@ import (
 type api interface {
 	CreateSecret(*secretsmanager.CreateSecretInput) (*secretsmanager.CreateSecretOutput, error)
 	DeleteSecret(*secretsmanager.DeleteSecretInput) (*secretsmanager.DeleteSecretOutput, error)
+	GetSecretValue(*secretsmanager.GetSecretValueInput) (*secretsmanager.GetSecretValueOutput, error)
+	PutSecretValue(*secretsmanager.PutSecretValueInput) (*secretsmanager.PutSecretValueOutput, error)
 }
 
 // SecretsManager wraps the AWS SecretManager client.
@@ -40,6 +44,14 @@ func New() (*SecretsManager, error) {
 	}, nil
 }
 
+// NewWithSession returns a SecretsManager configured against the input session.
+func NewWithSession(s *session.Session) *SecretsManager {
+	return &SecretsManager{
+		secretsManager: secretsmanager.New(s),
+		sessionRegion:  aws.StringValue(s.Config.Region),
+	}
+}
+
 var secretTags = func() []*secretsmanager.Tag {
 	timestamp := time.Now().UTC().Format(time.UnixDate)
 	return []*secretsmanager.Tag{
@@ -88,6 +100,35 @@ func (s *SecretsManager) DeleteSecret(secretName string) error {
 	return nil
 }
 
+// UpdateSecretFields sets the fields of a secret whose value is a JSON object, such as the host of the secret
+// of a database, and keeps its other fields.
+func (s *SecretsManager) UpdateSecretFields(secretID string, fields map[string]interface{}) error {
+	out, err := s.secretsManager.GetSecretValue(&secretsmanager.GetSecretValueInput{
+		SecretId: aws.String(secretID),
+	})
+	if err != nil {
+		return fmt.Errorf("get value of secret %s: %w", secretID, err)
+	}
+	value := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(aws.StringValue(out.SecretString)), &value); err != nil {
+		return fmt.Errorf("unmarshal value of secret %s: %w", secretID, err)
+	}
+	for k, v := range fields {
+		value[k] = v
+	}
+	updated, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("marshal value of secret %s: %w", secretID, err)
+	}
+	if _, err := s.secretsManager.PutSecretValue(&secretsmanager.PutSecretValueInput{
+		SecretId:     aws.String(secretID),
+		SecretString: aws.String(string(updated)),
+	}); err != nil {
+		return fmt.Errorf("update value of secret %s: %w", secretID, err)
+	}
+	return nil
+}
+
 // ErrSecretAlreadyExists occurs if a secret with the same name already exists.
 type ErrSecretAlreadyExists struct {
 	secretName string
@@ -105,3 +105,65 @@ func TestSecretsManager_CreateSecret(t *testing.T) {
 		})
 	}
 }
+
+func TestSecretsManager_UpdateSecretFields(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockapi)
+
+		wantedErr string
+	}{
+		"sets the fields and keeps the other fields of the secret": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().GetSecretValue(&secretsmanager.GetSecretValueInput{
+					SecretId: aws.String("orders-secret"),
+				}).Return(&secretsmanager.GetSecretValueOutput{
+					SecretString: aws.String(`{"host":"old-host","password":"H0NK","port":5432}`),
+				}, nil)
+				m.EXPECT().PutSecretValue(&secretsmanager.PutSecretValueInput{
+					SecretId:     aws.String("orders-secret"),
+					SecretString: aws.String(`{"host":"new-host","password":"H0NK","port":5432}`),
+				}).Return(&secretsmanager.PutSecretValueOutput{}, nil)
+			},
+		},
+		"errors if the secret isn't a JSON object": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().GetSecretValue(gomock.Any()).Return(&secretsmanager.GetSecretValueOutput{
+					SecretString: aws.String("H0NK"),
+				}, nil)
+			},
+			wantedErr: "unmarshal value of secret orders-secret: invalid character 'H' looking for beginning of value",
+		},
+		"wraps the error from updating the secret": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().GetSecretValue(gomock.Any()).Return(&secretsmanager.GetSecretValueOutput{
+					SecretString: aws.String(`{}`),
+				}, nil)
+				m.EXPECT().PutSecretValue(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: "update value of secret orders-secret: some error",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.setupMocks(m)
+			sm := SecretsManager{
+				secretsManager: m,
+			}
+
+			// WHEN
+			err := sm.UpdateSecretFields("orders-secret", map[string]interface{}{"host": "new-host"})
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,18 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package securityhub
+
+// ErrNotEnabled occurs when Security Hub isn't enabled in the account and region of the session.
+type ErrNotEnabled struct {
+	parentErr error
+}
+
+func (e *ErrNotEnabled) Error() string {
+	return "Security Hub is not enabled in this account and region"
+}
+
+// Unwrap returns the original error from Security Hub.
+func (e *ErrNotEnabled) Unwrap() error {
+	return e.parentErr
+}
@@ -0,0 +1,135 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package securityhub provides a client to make API requests to AWS Security Hub.
+package securityhub
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/securityhub"
+)
+
+const (
+	// Security Hub accepts up to 20 values for each field of the filters.
+	maxFilterValues   = 20
+	maxFindingsPerReq = 100
+
+	// Name of the product that generated the finding, such as "GuardDuty" or "Inspector".
+	productNameField = "aws/securityhub/ProductName"
+)
+
+type api interface {
+	GetFindings(input *securityhub.GetFindingsInput) (*securityhub.GetFindingsOutput, error)
+}
+
+// SecurityHub wraps an AWS Security Hub client.
+type SecurityHub struct {
+	client api
+}
+
+// Finding is a security issue detected on a resource by Security Hub or by an integrated product such as GuardDuty.
+type Finding struct {
+	ID          string    `json:"id"`
+	Title       string    `json:"title"`
+	Severity    string    `json:"severity"`
+	Product     string    `json:"product"`
+	ResourceIDs []string  `json:"resourceIDs"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+// New returns a SecurityHub struct configured against the input session.
+func New(s *session.Session) *SecurityHub {
+	return &SecurityHub{
+		client: securityhub.New(s),
+	}
+}
+
+// OpenFindings returns the active findings that aren't resolved or suppressed on the resources with the given ARNs.
+func (s *SecurityHub) OpenFindings(resourceARNs []string) ([]Finding, error) {
+	var findings []Finding
+	seen := make(map[string]bool)
+	for start := 0; start < len(resourceARNs); start += maxFilterValues {
+		end := start + maxFilterValues
+		if end > len(resourceARNs) {
+			end = len(resourceARNs)
+		}
+		in := &securityhub.GetFindingsInput{
+			Filters:    openFindingsFilters(resourceARNs[start:end]),
+			MaxResults: aws.Int64(maxFindingsPerReq),
+		}
+		for {
+			resp, err := s.client.GetFindings(in)
+			if err != nil {
+				if aerr, ok := err.(awserr.Error); ok && aerr.Code() == securityhub.ErrCodeInvalidAccessException {
+					return nil, &ErrNotEnabled{parentErr: err}
+				}
+				return nil, fmt.Errorf("get findings: %w", err)
+			}
+			for _, finding := range resp.Findings {
+				// A finding on several resources is returned once for each batch of resources that it matches.
+				if id := aws.StringValue(finding.Id); !seen[id] {
+					seen[id] = true
+					findings = append(findings, toFinding(finding))
+				}
+			}
+			if resp.NextToken == nil {
+				break
+			}
+			in.NextToken = resp.NextToken
+		}
+	}
+	return findings, nil
+}
+
+func openFindingsFilters(resourceARNs []string) *securityhub.AwsSecurityFindingFilters {
+	filters := &securityhub.AwsSecurityFindingFilters{
+		RecordState: []*securityhub.StringFilter{
+			{
+				Comparison: aws.String(securityhub.StringFilterComparisonEquals),
+				Value:      aws.String(securityhub.RecordStateActive),
+			},
+		},
+		WorkflowStatus: []*securityhub.StringFilter{
+			{
+				Comparison: aws.String(securityhub.StringFilterComparisonEquals),
+				Value:      aws.String(securityhub.WorkflowStatusNew),
+			},
+			{
+				Comparison: aws.String(securityhub.StringFilterComparisonEquals),
+				Value:      aws.String(securityhub.WorkflowStatusNotified),
+			},
+		},
+	}
+	for _, arn := range resourceARNs {
+		// Findings on a task definition or an image refer to a revision or a digest of the resource.
+		filters.ResourceId = append(filters.ResourceId, &securityhub.StringFilter{
+			Comparison: aws.String(securityhub.StringFilterComparisonPrefix),
+			Value:      aws.String(arn),
+		})
+	}
+	return filters
+}
+
+func toFinding(finding *securityhub.AwsSecurityFinding) Finding {
+	out := Finding{
+		ID:      aws.StringValue(finding.Id),
+		Title:   aws.StringValue(finding.Title),
+		Product: aws.StringValue(finding.ProductFields[productNameField]),
+	}
+	if finding.Severity != nil {
+		out.Severity = aws.StringValue(finding.Severity.Label)
+	}
+	for _, resource := range finding.Resources {
+		out.ResourceIDs = append(out.ResourceIDs, aws.StringValue(resource.Id))
+	}
+	// Timestamps are in the ISO 8601 format, a finding that can't be parsed is kept with a zero time.
+	if updatedAt, err := time.Parse(time.RFC3339, aws.StringValue(finding.UpdatedAt)); err == nil {
+		out.UpdatedAt = updatedAt
+	}
+	return out
+}
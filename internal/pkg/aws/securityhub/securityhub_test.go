@@ -0,0 +1,183 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package securityhub
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/aws/copilot-cli/internal/pkg/aws/securityhub/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecurityHub_OpenFindings(t *testing.T) {
+	const mockServiceARN = "arn:aws:ecs:us-west-2:123456789012:service/phonetool-test-Cluster/phonetool-test-frontend"
+	mockError := errors.New("some error")
+	finding := func(id, severity string, resourceIDs ...string) *securityhub.AwsSecurityFinding {
+		f := &securityhub.AwsSecurityFinding{
+			Id:    aws.String(id),
+			Title: aws.String(fmt.Sprintf("title of %s", id)),
+			ProductFields: map[string]*string{
+				"aws/securityhub/ProductName": aws.String("GuardDuty"),
+			},
+			Severity: &securityhub.Severity{
+				Label: aws.String(severity),
+			},
+			UpdatedAt: aws.String("2020-11-02T10:30:00.000Z"),
+		}
+		for _, resourceID := range resourceIDs {
+			f.Resources = append(f.Resources, &securityhub.Resource{Id: aws.String(resourceID)})
+		}
+		return f
+	}
+	resourceIDFilter := func(arns ...string) []*securityhub.StringFilter {
+		var filters []*securityhub.StringFilter
+		for _, arn := range arns {
+			filters = append(filters, &securityhub.StringFilter{
+				Comparison: aws.String("PREFIX"),
+				Value:      aws.String(arn),
+			})
+		}
+		return filters
+	}
+	manyARNs := make([]string, 21)
+	for i := range manyARNs {
+		manyARNs[i] = fmt.Sprintf("arn:aws:logs:us-west-2:123456789012:log-group:%d", i)
+	}
+	testCases := map[string]struct {
+		inResourceARNs []string
+		setupMocks     func(m *mocks.Mockapi)
+
+		wantedFindings []Finding
+		wantedErr      error
+	}{
+		"should return ErrNotEnabled if Security Hub isn't enabled": {
+			inResourceARNs: []string{mockServiceARN},
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().GetFindings(gomock.Any()).Return(nil,
+					awserr.New(securityhub.ErrCodeInvalidAccessException, "Account 123456789012 is not subscribed to AWS Security Hub", nil))
+			},
+
+			wantedErr: errors.New("Security Hub is not enabled in this account and region"),
+		},
+		"should wrap other errors": {
+			inResourceARNs: []string{mockServiceARN},
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().GetFindings(gomock.Any()).Return(nil, mockError)
+			},
+
+			wantedErr: errors.New("get findings: some error"),
+		},
+		"should return the open findings of all pages": {
+			inResourceARNs: []string{mockServiceARN},
+			setupMocks: func(m *mocks.Mockapi) {
+				filters := &securityhub.AwsSecurityFindingFilters{
+					RecordState: []*securityhub.StringFilter{
+						{Comparison: aws.String("EQUALS"), Value: aws.String("ACTIVE")},
+					},
+					WorkflowStatus: []*securityhub.StringFilter{
+						{Comparison: aws.String("EQUALS"), Value: aws.String("NEW")},
+						{Comparison: aws.String("EQUALS"), Value: aws.String("NOTIFIED")},
+					},
+					ResourceId: resourceIDFilter(mockServiceARN),
+				}
+				m.EXPECT().GetFindings(&securityhub.GetFindingsInput{
+					Filters:    filters,
+					MaxResults: aws.Int64(100),
+				}).Return(&securityhub.GetFindingsOutput{
+					Findings:  []*securityhub.AwsSecurityFinding{finding("1", "CRITICAL", mockServiceARN)},
+					NextToken: aws.String("next"),
+				}, nil)
+				m.EXPECT().GetFindings(&securityhub.GetFindingsInput{
+					Filters:    filters,
+					MaxResults: aws.Int64(100),
+					NextToken:  aws.String("next"),
+				}).Return(&securityhub.GetFindingsOutput{
+					Findings: []*securityhub.AwsSecurityFinding{finding("2", "LOW", mockServiceARN)},
+				}, nil)
+			},
+
+			wantedFindings: []Finding{
+				{
+					ID:          "1",
+					Title:       "title of 1",
+					Severity:    "CRITICAL",
+					Product:     "GuardDuty",
+					ResourceIDs: []string{mockServiceARN},
+					UpdatedAt:   time.Date(2020, 11, 2, 10, 30, 0, 0, time.UTC),
+				},
+				{
+					ID:          "2",
+					Title:       "title of 2",
+					Severity:    "LOW",
+					Product:     "GuardDuty",
+					ResourceIDs: []string{mockServiceARN},
+					UpdatedAt:   time.Date(2020, 11, 2, 10, 30, 0, 0, time.UTC),
+				},
+			},
+		},
+		"should batch the resources and return each finding once": {
+			inResourceARNs: manyARNs,
+			setupMocks: func(m *mocks.Mockapi) {
+				gomock.InOrder(
+					m.EXPECT().GetFindings(gomock.Any()).DoAndReturn(func(in *securityhub.GetFindingsInput) (*securityhub.GetFindingsOutput, error) {
+						require.Equal(t, resourceIDFilter(manyARNs[:20]...), in.Filters.ResourceId)
+						return &securityhub.GetFindingsOutput{
+							Findings: []*securityhub.AwsSecurityFinding{finding("1", "HIGH", manyARNs[0], manyARNs[20])},
+						}, nil
+					}),
+					m.EXPECT().GetFindings(gomock.Any()).DoAndReturn(func(in *securityhub.GetFindingsInput) (*securityhub.GetFindingsOutput, error) {
+						require.Equal(t, resourceIDFilter(manyARNs[20]), in.Filters.ResourceId)
+						return &securityhub.GetFindingsOutput{
+							Findings: []*securityhub.AwsSecurityFinding{finding("1", "HIGH", manyARNs[0], manyARNs[20])},
+						}, nil
+					}),
+				)
+			},
+
+			wantedFindings: []Finding{
+				{
+					ID:          "1",
+					Title:       "title of 1",
+					Severity:    "HIGH",
+					Product:     "GuardDuty",
+					ResourceIDs: []string{manyARNs[0], manyARNs[20]},
+					UpdatedAt:   time.Date(2020, 11, 2, 10, 30, 0, 0, time.UTC),
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mocks.NewMockapi(ctrl)
+			tc.setupMocks(mockClient)
+
+			hub := SecurityHub{
+				client: mockClient,
+			}
+
+			// WHEN
+			findings, err := hub.OpenFindings(tc.inResourceARNs)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedFindings, findings)
+		})
+	}
+}
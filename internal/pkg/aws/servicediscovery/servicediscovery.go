@@ -0,0 +1,217 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package servicediscovery provides a client to make API requests to AWS Cloud Map.
+package servicediscovery
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/servicediscovery"
+)
+
+// Attributes that ECS sets on the instances it registers for a task.
+const (
+	instanceIPv4Attribute = "AWS_INSTANCE_IPV4"
+	instancePortAttribute = "AWS_INSTANCE_PORT"
+)
+
+// Health statuses of an instance.
+const (
+	HealthStatusHealthy   = servicediscovery.HealthStatusHealthy
+	HealthStatusUnhealthy = servicediscovery.HealthStatusUnhealthy
+	HealthStatusUnknown   = servicediscovery.HealthStatusUnknown
+)
+
+type api interface {
+	ListNamespaces(input *servicediscovery.ListNamespacesInput) (*servicediscovery.ListNamespacesOutput, error)
+	ListServices(input *servicediscovery.ListServicesInput) (*servicediscovery.ListServicesOutput, error)
+	ListInstances(input *servicediscovery.ListInstancesInput) (*servicediscovery.ListInstancesOutput, error)
+	GetInstancesHealthStatus(input *servicediscovery.GetInstancesHealthStatusInput) (*servicediscovery.GetInstancesHealthStatusOutput, error)
+	DeregisterInstance(input *servicediscovery.DeregisterInstanceInput) (*servicediscovery.DeregisterInstanceOutput, error)
+}
+
+// ServiceDiscovery wraps an AWS Cloud Map client.
+type ServiceDiscovery struct {
+	client api
+}
+
+// New returns a ServiceDiscovery configured against the input session.
+func New(s *session.Session) *ServiceDiscovery {
+	return &ServiceDiscovery{
+		client: servicediscovery.New(s),
+	}
+}
+
+// Namespace is a Cloud Map namespace, such as the private DNS namespace of an environment.
+type Namespace struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// Service is a Cloud Map service that the tasks of an ECS service register with.
+type Service struct {
+	ID            string `json:"id"`
+	Name          string `json:"name"`
+	InstanceCount int    `json:"instanceCount"`
+}
+
+// Instance is an instance registered with a Cloud Map service. The ID of an instance registered by ECS is the ID of its task.
+type Instance struct {
+	ID         string            `json:"id"`
+	IPv4       string            `json:"ipv4"`
+	Port       int               `json:"port,omitempty"`
+	Health     string            `json:"health"`
+	Attributes map[string]string `json:"attributes"`
+}
+
+// Namespaces returns all the namespaces of the account in the region.
+func (sd *ServiceDiscovery) Namespaces() ([]*Namespace, error) {
+	var namespaces []*Namespace
+	var nextToken *string
+	for {
+		out, err := sd.client.ListNamespaces(&servicediscovery.ListNamespacesInput{
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list namespaces: %w", err)
+		}
+		for _, ns := range out.Namespaces {
+			namespaces = append(namespaces, &Namespace{
+				ID:   aws.StringValue(ns.Id),
+				Name: aws.StringValue(ns.Name),
+				Type: aws.StringValue(ns.Type),
+			})
+		}
+		if out.NextToken == nil {
+			break
+		}
+		nextToken = out.NextToken
+	}
+	return namespaces, nil
+}
+
+// Services returns the services of a namespace.
+func (sd *ServiceDiscovery) Services(namespaceID string) ([]*Service, error) {
+	var services []*Service
+	var nextToken *string
+	for {
+		out, err := sd.client.ListServices(&servicediscovery.ListServicesInput{
+			Filters: []*servicediscovery.ServiceFilter{
+				{
+					Name:      aws.String(servicediscovery.ServiceFilterNameNamespaceId),
+					Condition: aws.String(servicediscovery.FilterConditionEq),
+					Values:    aws.StringSlice([]string{namespaceID}),
+				},
+			},
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list services in namespace %s: %w", namespaceID, err)
+		}
+		for _, svc := range out.Services {
+			services = append(services, &Service{
+				ID:            aws.StringValue(svc.Id),
+				Name:          aws.StringValue(svc.Name),
+				InstanceCount: int(aws.Int64Value(svc.InstanceCount)),
+			})
+		}
+		if out.NextToken == nil {
+			break
+		}
+		nextToken = out.NextToken
+	}
+	return services, nil
+}
+
+// Instances returns the instances registered with a service along with their health, sorted by ID.
+// Instances that Cloud Map has no health status for yet are UNKNOWN.
+func (sd *ServiceDiscovery) Instances(serviceID string) ([]*Instance, error) {
+	var instances []*Instance
+	var nextToken *string
+	for {
+		out, err := sd.client.ListInstances(&servicediscovery.ListInstancesInput{
+			ServiceId: aws.String(serviceID),
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list instances of service %s: %w", serviceID, err)
+		}
+		for _, summary := range out.Instances {
+			instances = append(instances, newInstance(summary))
+		}
+		if out.NextToken == nil {
+			break
+		}
+		nextToken = out.NextToken
+	}
+	if len(instances) == 0 {
+		return nil, nil
+	}
+	health, err := sd.instancesHealth(serviceID)
+	if err != nil {
+		return nil, err
+	}
+	for _, instance := range instances {
+		instance.Health = HealthStatusUnknown
+		if status, ok := health[instance.ID]; ok {
+			instance.Health = status
+		}
+	}
+	sort.SliceStable(instances, func(i, j int) bool {
+		return instances[i].ID < instances[j].ID
+	})
+	return instances, nil
+}
+
+func newInstance(summary *servicediscovery.InstanceSummary) *Instance {
+	attributes := aws.StringValueMap(summary.Attributes)
+	// The port is only set for services with SRV records.
+	port, _ := strconv.Atoi(attributes[instancePortAttribute])
+	return &Instance{
+		ID:         aws.StringValue(summary.Id),
+		IPv4:       attributes[instanceIPv4Attribute],
+		Port:       port,
+		Attributes: attributes,
+	}
+}
+
+func (sd *ServiceDiscovery) instancesHealth(serviceID string) (map[string]string, error) {
+	health := make(map[string]string)
+	var nextToken *string
+	for {
+		out, err := sd.client.GetInstancesHealthStatus(&servicediscovery.GetInstancesHealthStatusInput{
+			ServiceId: aws.String(serviceID),
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("get health status of instances of service %s: %w", serviceID, err)
+		}
+		for id, status := range out.Status {
+			health[id] = aws.StringValue(status)
+		}
+		if out.NextToken == nil {
+			break
+		}
+		nextToken = out.NextToken
+	}
+	return health, nil
+}
+
+// DeregisterInstance removes an instance from a service, such as the instance of a task that stopped without deregistering.
+// Cloud Map deletes the DNS records of the instance asynchronously.
+func (sd *ServiceDiscovery) DeregisterInstance(serviceID, instanceID string) error {
+	_, err := sd.client.DeregisterInstance(&servicediscovery.DeregisterInstanceInput{
+		ServiceId:  aws.String(serviceID),
+		InstanceId: aws.String(instanceID),
+	})
+	if err != nil {
+		return fmt.Errorf("deregister instance %s from service %s: %w", instanceID, serviceID, err)
+	}
+	return nil
+}
@@ -0,0 +1,337 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package servicediscovery
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicediscovery"
+	"github.com/aws/copilot-cli/internal/pkg/aws/servicediscovery/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	mockNamespaceID = "ns-kj5hl7zpusdcg6jy"
+	mockServiceID   = "srv-utcrh6wavdkggqtk"
+)
+
+func TestServiceDiscovery_Namespaces(t *testing.T) {
+	testCases := map[string]struct {
+		mockClient func(m *mocks.Mockapi)
+
+		wantedNamespaces []*Namespace
+		wantedErr        string
+	}{
+		"errors if the namespaces can't be listed": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListNamespaces(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: "list namespaces: some error",
+		},
+		"returns the namespaces across pages": {
+			mockClient: func(m *mocks.Mockapi) {
+				gomock.InOrder(
+					m.EXPECT().ListNamespaces(&servicediscovery.ListNamespacesInput{}).Return(&servicediscovery.ListNamespacesOutput{
+						Namespaces: []*servicediscovery.NamespaceSummary{
+							{
+								Id:   aws.String(mockNamespaceID),
+								Name: aws.String("phonetool.local"),
+								Type: aws.String(servicediscovery.NamespaceTypeDnsPrivate),
+							},
+						},
+						NextToken: aws.String("token"),
+					}, nil),
+					m.EXPECT().ListNamespaces(&servicediscovery.ListNamespacesInput{
+						NextToken: aws.String("token"),
+					}).Return(&servicediscovery.ListNamespacesOutput{
+						Namespaces: []*servicediscovery.NamespaceSummary{
+							{
+								Id:   aws.String("ns-4ygrxqhbkdztpfly"),
+								Name: aws.String("example.com"),
+								Type: aws.String(servicediscovery.NamespaceTypeDnsPublic),
+							},
+						},
+					}, nil),
+				)
+			},
+			wantedNamespaces: []*Namespace{
+				{
+					ID:   mockNamespaceID,
+					Name: "phonetool.local",
+					Type: "DNS_PRIVATE",
+				},
+				{
+					ID:   "ns-4ygrxqhbkdztpfly",
+					Name: "example.com",
+					Type: "DNS_PUBLIC",
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.mockClient(m)
+			client := ServiceDiscovery{client: m}
+
+			// WHEN
+			namespaces, err := client.Namespaces()
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedNamespaces, namespaces)
+		})
+	}
+}
+
+func TestServiceDiscovery_Services(t *testing.T) {
+	testCases := map[string]struct {
+		mockClient func(m *mocks.Mockapi)
+
+		wantedServices []*Service
+		wantedErr      string
+	}{
+		"errors if the services can't be listed": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListServices(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: "list services in namespace " + mockNamespaceID + ": some error",
+		},
+		"returns the services of the namespace": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListServices(&servicediscovery.ListServicesInput{
+					Filters: []*servicediscovery.ServiceFilter{
+						{
+							Name:      aws.String("NAMESPACE_ID"),
+							Condition: aws.String("EQ"),
+							Values:    aws.StringSlice([]string{mockNamespaceID}),
+						},
+					},
+				}).Return(&servicediscovery.ListServicesOutput{
+					Services: []*servicediscovery.ServiceSummary{
+						{
+							Id:            aws.String(mockServiceID),
+							Name:          aws.String("api"),
+							InstanceCount: aws.Int64(2),
+						},
+					},
+				}, nil)
+			},
+			wantedServices: []*Service{
+				{
+					ID:            mockServiceID,
+					Name:          "api",
+					InstanceCount: 2,
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.mockClient(m)
+			client := ServiceDiscovery{client: m}
+
+			// WHEN
+			services, err := client.Services(mockNamespaceID)
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedServices, services)
+		})
+	}
+}
+
+func TestServiceDiscovery_Instances(t *testing.T) {
+	testCases := map[string]struct {
+		mockClient func(m *mocks.Mockapi)
+
+		wantedInstances []*Instance
+		wantedErr       string
+	}{
+		"errors if the instances can't be listed": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListInstances(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: "list instances of service " + mockServiceID + ": some error",
+		},
+		"returns nil without getting the health if there are no instances": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListInstances(gomock.Any()).Return(&servicediscovery.ListInstancesOutput{}, nil)
+				m.EXPECT().GetInstancesHealthStatus(gomock.Any()).Times(0)
+			},
+		},
+		"errors if the health of the instances can't be retrieved": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListInstances(gomock.Any()).Return(&servicediscovery.ListInstancesOutput{
+					Instances: []*servicediscovery.InstanceSummary{
+						{Id: aws.String("task-1")},
+					},
+				}, nil)
+				m.EXPECT().GetInstancesHealthStatus(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: "get health status of instances of service " + mockServiceID + ": some error",
+		},
+		"returns the instances with their health sorted by ID": {
+			mockClient: func(m *mocks.Mockapi) {
+				gomock.InOrder(
+					m.EXPECT().ListInstances(&servicediscovery.ListInstancesInput{
+						ServiceId: aws.String(mockServiceID),
+					}).Return(&servicediscovery.ListInstancesOutput{
+						Instances: []*servicediscovery.InstanceSummary{
+							{
+								Id: aws.String("task-2"),
+								Attributes: map[string]*string{
+									"AWS_INSTANCE_IPV4": aws.String("10.0.1.12"),
+									"AWS_INSTANCE_PORT": aws.String("8080"),
+								},
+							},
+						},
+						NextToken: aws.String("token"),
+					}, nil),
+					m.EXPECT().ListInstances(&servicediscovery.ListInstancesInput{
+						ServiceId: aws.String(mockServiceID),
+						NextToken: aws.String("token"),
+					}).Return(&servicediscovery.ListInstancesOutput{
+						Instances: []*servicediscovery.InstanceSummary{
+							{
+								Id: aws.String("task-1"),
+								Attributes: map[string]*string{
+									"AWS_INSTANCE_IPV4": aws.String("10.0.0.34"),
+								},
+							},
+							{
+								Id: aws.String("task-3"),
+							},
+						},
+					}, nil),
+					m.EXPECT().GetInstancesHealthStatus(&servicediscovery.GetInstancesHealthStatusInput{
+						ServiceId: aws.String(mockServiceID),
+					}).Return(&servicediscovery.GetInstancesHealthStatusOutput{
+						Status: map[string]*string{
+							"task-1": aws.String(servicediscovery.HealthStatusUnhealthy),
+						},
+						NextToken: aws.String("token"),
+					}, nil),
+					m.EXPECT().GetInstancesHealthStatus(&servicediscovery.GetInstancesHealthStatusInput{
+						ServiceId: aws.String(mockServiceID),
+						NextToken: aws.String("token"),
+					}).Return(&servicediscovery.GetInstancesHealthStatusOutput{
+						Status: map[string]*string{
+							"task-2": aws.String(servicediscovery.HealthStatusHealthy),
+						},
+					}, nil),
+				)
+			},
+			wantedInstances: []*Instance{
+				{
+					ID:     "task-1",
+					IPv4:   "10.0.0.34",
+					Health: "UNHEALTHY",
+					Attributes: map[string]string{
+						"AWS_INSTANCE_IPV4": "10.0.0.34",
+					},
+				},
+				{
+					ID:     "task-2",
+					IPv4:   "10.0.1.12",
+					Port:   8080,
+					Health: "HEALTHY",
+					Attributes: map[string]string{
+						"AWS_INSTANCE_IPV4": "10.0.1.12",
+						"AWS_INSTANCE_PORT": "8080",
+					},
+				},
+				{
+					ID:         "task-3",
+					Health:     "UNKNOWN",
+					Attributes: map[string]string{},
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.mockClient(m)
+			client := ServiceDiscovery{client: m}
+
+			// WHEN
+			instances, err := client.Instances(mockServiceID)
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedInstances, instances)
+		})
+	}
+}
+
+func TestServiceDiscovery_DeregisterInstance(t *testing.T) {
+	testCases := map[string]struct {
+		mockClient func(m *mocks.Mockapi)
+
+		wantedErr string
+	}{
+		"errors if the instance can't be deregistered": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DeregisterInstance(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: "deregister instance task-1 from service " + mockServiceID + ": some error",
+		},
+		"deregisters the instance": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DeregisterInstance(&servicediscovery.DeregisterInstanceInput{
+					ServiceId:  aws.String(mockServiceID),
+					InstanceId: aws.String("task-1"),
+				}).Return(&servicediscovery.DeregisterInstanceOutput{
+					OperationId: aws.String("operation"),
+				}, nil)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.mockClient(m)
+			client := ServiceDiscovery{client: m}
+
+			// WHEN
+			err := client.DeregisterInstance(mockServiceID, "task-1")
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
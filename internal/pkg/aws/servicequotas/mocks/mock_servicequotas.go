@@ -0,0 +1,64 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/aws/servicequotas/servicequotas.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	servicequotas "github.com/aws/aws-sdk-go/service/servicequotas"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// Mockapi is a mock of api interface
+type Mockapi struct {
+	ctrl     *gomock.Controller
+	recorder *MockapiMockRecorder
+}
+
+// MockapiMockRecorder is the mock recorder for Mockapi
+type MockapiMockRecorder struct {
+	mock *Mockapi
+}
+
+// NewMockapi creates a new mock instance
+func NewMockapi(ctrl *gomock.Controller) *Mockapi {
+	mock := &Mockapi{ctrl: ctrl}
+	mock.recorder = &MockapiMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *Mockapi) EXPECT() *MockapiMockRecorder {
+	return m.recorder
+}
+
+// GetServiceQuota mocks base method
+func (m *Mockapi) GetServiceQuota(input *servicequotas.GetServiceQuotaInput) (*servicequotas.GetServiceQuotaOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServiceQuota", input)
+	ret0, _ := ret[0].(*servicequotas.GetServiceQuotaOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetServiceQuota indicates an expected call of GetServiceQuota
+func (mr *MockapiMockRecorder) GetServiceQuota(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceQuota", reflect.TypeOf((*Mockapi)(nil).GetServiceQuota), input)
+}
+
+// GetAWSDefaultServiceQuota mocks base method
+func (m *Mockapi) GetAWSDefaultServiceQuota(input *servicequotas.GetAWSDefaultServiceQuotaInput) (*servicequotas.GetAWSDefaultServiceQuotaOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAWSDefaultServiceQuota", input)
+	ret0, _ := ret[0].(*servicequotas.GetAWSDefaultServiceQuotaOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAWSDefaultServiceQuota indicates an expected call of GetAWSDefaultServiceQuota
+func (mr *MockapiMockRecorder) GetAWSDefaultServiceQuota(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAWSDefaultServiceQuota", reflect.TypeOf((*Mockapi)(nil).GetAWSDefaultServiceQuota), input)
+}
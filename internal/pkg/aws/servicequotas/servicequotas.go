@@ -0,0 +1,71 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package servicequotas provides a client to make API requests to AWS Service Quotas.
+package servicequotas
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+)
+
+const (
+	fargateServiceCode = "fargate"
+	// fargateVCPUQuotaCode is the code of the quota on the number of vCPUs of the Fargate On-Demand tasks running concurrently.
+	fargateVCPUQuotaCode = "L-3032A538"
+
+	fmtQuotaConsoleURL = "https://console.aws.amazon.com/servicequotas/home?region=%s#!/services/%s/quotas/%s"
+)
+
+type api interface {
+	GetServiceQuota(input *servicequotas.GetServiceQuotaInput) (*servicequotas.GetServiceQuotaOutput, error)
+	GetAWSDefaultServiceQuota(input *servicequotas.GetAWSDefaultServiceQuotaInput) (*servicequotas.GetAWSDefaultServiceQuotaOutput, error)
+}
+
+// ServiceQuotas wraps an AWS Service Quotas client.
+type ServiceQuotas struct {
+	client api
+}
+
+// New returns a ServiceQuotas configured against the input session.
+func New(s *session.Session) *ServiceQuotas {
+	return &ServiceQuotas{
+		client: servicequotas.New(s),
+	}
+}
+
+// FargateVCPUQuota returns the maximum number of vCPUs that the Fargate On-Demand tasks of the account can use concurrently.
+func (s *ServiceQuotas) FargateVCPUQuota() (float64, error) {
+	return s.quota(fargateServiceCode, fargateVCPUQuotaCode)
+}
+
+// FargateVCPUQuotaURL returns the URL of the console page to request an increase of the Fargate vCPU quota.
+func FargateVCPUQuotaURL(region string) string {
+	return fmt.Sprintf(fmtQuotaConsoleURL, region, fargateServiceCode, fargateVCPUQuotaCode)
+}
+
+// quota returns the applied value of the quota, or its default value if the account never had it adjusted.
+func (s *ServiceQuotas) quota(serviceCode, quotaCode string) (float64, error) {
+	out, err := s.client.GetServiceQuota(&servicequotas.GetServiceQuotaInput{
+		ServiceCode: aws.String(serviceCode),
+		QuotaCode:   aws.String(quotaCode),
+	})
+	if err == nil {
+		return aws.Float64Value(out.Quota.Value), nil
+	}
+	if aerr, ok := err.(awserr.Error); !ok || aerr.Code() != servicequotas.ErrCodeNoSuchResourceException {
+		return 0, fmt.Errorf("get quota %s of service %s: %w", quotaCode, serviceCode, err)
+	}
+	def, err := s.client.GetAWSDefaultServiceQuota(&servicequotas.GetAWSDefaultServiceQuotaInput{
+		ServiceCode: aws.String(serviceCode),
+		QuotaCode:   aws.String(quotaCode),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("get default quota %s of service %s: %w", quotaCode, serviceCode, err)
+	}
+	return aws.Float64Value(def.Quota.Value), nil
+}
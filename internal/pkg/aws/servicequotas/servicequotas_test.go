@@ -0,0 +1,94 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package servicequotas
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/aws/copilot-cli/internal/pkg/aws/servicequotas/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServiceQuotas_FargateVCPUQuota(t *testing.T) {
+	mockError := errors.New("some error")
+	mockInput := &servicequotas.GetServiceQuotaInput{
+		ServiceCode: aws.String("fargate"),
+		QuotaCode:   aws.String("L-3032A538"),
+	}
+	mockDefaultInput := &servicequotas.GetAWSDefaultServiceQuotaInput{
+		ServiceCode: aws.String("fargate"),
+		QuotaCode:   aws.String("L-3032A538"),
+	}
+	testCases := map[string]struct {
+		callMock func(m *mocks.Mockapi)
+
+		wantedQuota float64
+		wantedErr   error
+	}{
+		"returns the applied quota": {
+			callMock: func(m *mocks.Mockapi) {
+				m.EXPECT().GetServiceQuota(mockInput).Return(&servicequotas.GetServiceQuotaOutput{
+					Quota: &servicequotas.ServiceQuota{Value: aws.Float64(256)},
+				}, nil)
+			},
+			wantedQuota: 256,
+		},
+		"returns the default quota if the quota was never applied": {
+			callMock: func(m *mocks.Mockapi) {
+				m.EXPECT().GetServiceQuota(mockInput).Return(nil, awserr.New(servicequotas.ErrCodeNoSuchResourceException, "not found", nil))
+				m.EXPECT().GetAWSDefaultServiceQuota(mockDefaultInput).Return(&servicequotas.GetAWSDefaultServiceQuotaOutput{
+					Quota: &servicequotas.ServiceQuota{Value: aws.Float64(4000)},
+				}, nil)
+			},
+			wantedQuota: 4000,
+		},
+		"wraps the error of the applied quota": {
+			callMock: func(m *mocks.Mockapi) {
+				m.EXPECT().GetServiceQuota(mockInput).Return(nil, mockError)
+			},
+			wantedErr: fmt.Errorf("get quota L-3032A538 of service fargate: %w", mockError),
+		},
+		"wraps the error of the default quota": {
+			callMock: func(m *mocks.Mockapi) {
+				m.EXPECT().GetServiceQuota(mockInput).Return(nil, awserr.New(servicequotas.ErrCodeNoSuchResourceException, "not found", nil))
+				m.EXPECT().GetAWSDefaultServiceQuota(mockDefaultInput).Return(nil, mockError)
+			},
+			wantedErr: fmt.Errorf("get default quota L-3032A538 of service fargate: %w", mockError),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mocks.NewMockapi(ctrl)
+			tc.callMock(mockClient)
+
+			sq := ServiceQuotas{client: mockClient}
+
+			// WHEN
+			quota, err := sq.FargateVCPUQuota()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedQuota, quota)
+		})
+	}
+}
+
+func TestFargateVCPUQuotaURL(t *testing.T) {
+	require.Equal(t, "https://console.aws.amazon.com/servicequotas/home?region=us-west-2#!/services/fargate/quotas/L-3032A538", FargateVCPUQuotaURL("us-west-2"))
+}
@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"os"
 	"runtime"
 	"sync"
 	"time"
@@ -24,6 +25,13 @@ import (
 const (
 	userAgentHeader = "User-Agent"
 
+	// caBundleEnvVar is the path to a PEM file of certificates trusted by every session, such as the CA of a proxy
+	// that intercepts TLS traffic. It takes precedence over the SDK's AWS_CA_BUNDLE environment variable.
+	caBundleEnvVar = "COPILOT_CA_BUNDLE"
+	// endpointURLEnvVar is the URL that every AWS API call is sent to instead of the service's regional endpoint,
+	// such as a gateway that forwards requests to AWS or a local emulator. The --endpoint-url flag takes precedence.
+	endpointURLEnvVar = "COPILOT_ENDPOINT_URL"
+
 	credsTimeout  = 10 * time.Second
 	clientTimeout = 30 * time.Second
 )
@@ -37,6 +45,16 @@ type Provider struct {
 var instance *Provider
 var once sync.Once
 
+var lookupEnv = os.LookupEnv
+
+// endpointURL overrides the endpoint of every session, set by the --endpoint-url flag.
+var endpointURL string
+
+// SetEndpointURL sends the requests of the sessions created from now on to the URL instead of the AWS endpoints.
+func SetEndpointURL(url string) {
+	endpointURL = url
+}
+
 // NewProvider returns a session Provider singleton.
 func NewProvider() *Provider {
 	once.Do(func() {
@@ -51,43 +69,32 @@ func (p *Provider) Default() (*session.Session, error) {
 		return p.defaultSess, nil
 	}
 
-	sess, err := session.NewSessionWithOptions(session.Options{
+	sess, err := newSession(session.Options{
 		Config:            *newConfig(),
 		SharedConfigState: session.SharedConfigEnable,
 	})
 	if err != nil {
 		return nil, err
 	}
-	sess.Handlers.Build.PushBackNamed(userAgentHandler())
 	p.defaultSess = sess
 	return sess, nil
 }
 
 // DefaultWithRegion returns a session configured against the "default" AWS profile and the input region.
 func (p *Provider) DefaultWithRegion(region string) (*session.Session, error) {
-	sess, err := session.NewSessionWithOptions(session.Options{
+	return newSession(session.Options{
 		Config:            *newConfig().WithRegion(region),
 		SharedConfigState: session.SharedConfigEnable,
 	})
-	if err != nil {
-		return nil, err
-	}
-	sess.Handlers.Build.PushBackNamed(userAgentHandler())
-	return sess, nil
 }
 
 // FromProfile returns a session configured against the input profile name.
 func (p *Provider) FromProfile(name string) (*session.Session, error) {
-	sess, err := session.NewSessionWithOptions(session.Options{
+	return newSession(session.Options{
 		Config:            *newConfig(),
 		SharedConfigState: session.SharedConfigEnable,
 		Profile:           name,
 	})
-	if err != nil {
-		return nil, err
-	}
-	sess.Handlers.Build.PushBackNamed(userAgentHandler())
-	return sess, nil
 }
 
 // FromRole returns a session configured against the input role and region.
@@ -98,16 +105,11 @@ func (p *Provider) FromRole(roleARN string, region string) (*session.Session, er
 	}
 
 	creds := stscreds.NewCredentials(defaultSession, roleARN)
-	sess, err := session.NewSession(
-		newConfig().
+	return newSession(session.Options{
+		Config: *newConfig().
 			WithCredentials(creds).
 			WithRegion(region),
-	)
-	if err != nil {
-		return nil, err
-	}
-	sess.Handlers.Build.PushBackNamed(userAgentHandler())
-	return sess, nil
+	})
 }
 
 // AreCredsFromEnvVars returns true if the session's credentials provider is environment variables, false otherwise.
@@ -131,10 +133,40 @@ func creds(sess *session.Session) (credentials.Value, error) {
 	return v, nil
 }
 
+// newSession returns a session that trusts the CA bundle from the environment, sends requests to the overridden
+// endpoint if any, and sets a custom user agent.
+func newSession(opts session.Options) (*session.Session, error) {
+	url := endpointURL
+	if url == "" {
+		url, _ = lookupEnv(endpointURLEnvVar)
+	}
+	if url != "" {
+		opts.Config.Endpoint = aws.String(url)
+	}
+	if path, ok := lookupEnv(caBundleEnvVar); ok && path != "" {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, fmt.Errorf("open CA bundle %s set by %s: %w", path, caBundleEnvVar, err)
+		}
+		defer f.Close()
+		opts.CustomCABundle = f
+	}
+	sess, err := session.NewSessionWithOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+	sess.Handlers.Build.PushBackNamed(userAgentHandler())
+	return sess, nil
+}
+
 // newConfig returns a config with an end-to-end request timeout and verbose credentials errors.
+// Requests go through the proxy set by the HTTPS_PROXY and NO_PROXY environment variables.
 func newConfig() *aws.Config {
+	t := http.DefaultTransport.(*http.Transport).Clone()
+	t.Proxy = http.ProxyFromEnvironment
 	c := &http.Client{
-		Timeout: clientTimeout,
+		Timeout:   clientTimeout,
+		Transport: t,
 	}
 	return aws.NewConfig().
 		WithHTTPClient(c).
@@ -4,7 +4,13 @@
 package sessions
 
 import (
+	"encoding/pem"
 	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -89,3 +95,85 @@ func TestAreCredsFromEnvVars(t *testing.T) {
 		})
 	}
 }
+
+func TestNewSession(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+	dir, err := ioutil.TempDir("", "sessions")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	bundlePath := filepath.Join(dir, "ca.pem")
+	err = ioutil.WriteFile(bundlePath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}), 0644)
+	require.NoError(t, err)
+
+	testCases := map[string]struct {
+		inEnv         map[string]string
+		inEndpointURL string
+
+		wantedTrustServer bool
+		wantedEndpoint    string
+		wantedErr         error
+	}{
+		"uses the system certificates if no CA bundle is set": {},
+		"trusts the certificates of the CA bundle": {
+			inEnv: map[string]string{
+				caBundleEnvVar: bundlePath,
+			},
+			wantedTrustServer: true,
+		},
+		"returns a wrapped error if the CA bundle doesn't exist": {
+			inEnv: map[string]string{
+				caBundleEnvVar: "/not/a/file.pem",
+			},
+			wantedErr: errors.New("open CA bundle /not/a/file.pem set by COPILOT_CA_BUNDLE: open /not/a/file.pem: no such file or directory"),
+		},
+		"sends requests to the endpoint URL from the environment": {
+			inEnv: map[string]string{
+				endpointURLEnvVar: "https://aws-gateway.example.com",
+			},
+			wantedEndpoint: "https://aws-gateway.example.com",
+		},
+		"the endpoint URL flag takes precedence over the environment": {
+			inEnv: map[string]string{
+				endpointURLEnvVar: "https://aws-gateway.example.com",
+			},
+			inEndpointURL:  "http://localhost:4566",
+			wantedEndpoint: "http://localhost:4566",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			lookupEnv = func(key string) (string, bool) {
+				v, ok := tc.inEnv[key]
+				return v, ok
+			}
+			defer func() { lookupEnv = os.LookupEnv }()
+			SetEndpointURL(tc.inEndpointURL)
+			defer SetEndpointURL("")
+
+			// WHEN
+			sess, err := newSession(session.Options{
+				Config: *newConfig().WithRegion("us-west-2"),
+			})
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedEndpoint, aws.StringValue(sess.Config.Endpoint))
+			transport, ok := sess.Config.HTTPClient.Transport.(*http.Transport)
+			require.True(t, ok)
+			require.NotNil(t, transport.Proxy, "requests should honor the proxy environment variables")
+			_, err = sess.Config.HTTPClient.Get(server.URL)
+			if tc.wantedTrustServer {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
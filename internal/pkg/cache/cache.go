@@ -0,0 +1,140 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package cache provides an opt-in local cache for the results of expensive read-only calls.
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/spf13/afero"
+)
+
+const (
+	// TTLEnvVar is the environment variable that enables the cache. Its value is the duration of the cached entries,
+	// for example "5m".
+	TTLEnvVar = "COPILOT_CACHE_TTL"
+
+	dirName = "copilot"
+)
+
+var lookupEnv = os.LookupEnv
+
+// Cache stores JSON serialized values on disk until they expire.
+// A nil *Cache is a disabled cache: Get always misses and Put is a no-op.
+type Cache struct {
+	fs     *afero.Afero
+	dir    string
+	ttl    time.Duration
+	prefix string
+
+	now func() time.Time
+}
+
+type entry struct {
+	ExpiresAt time.Time       `json:"expiresAt"`
+	Value     json.RawMessage `json:"value"`
+}
+
+// New returns a cache whose entries are stored under dir and expire after ttl.
+func New(dir string, ttl time.Duration) *Cache {
+	return &Cache{
+		fs:  &afero.Afero{Fs: afero.NewOsFs()},
+		dir: dir,
+		ttl: ttl,
+		now: time.Now,
+	}
+}
+
+// NewFromEnv returns a cache in the user's cache directory if the COPILOT_CACHE_TTL environment variable is set.
+// Otherwise, it returns nil which disables caching.
+func NewFromEnv() (*Cache, error) {
+	val, ok := lookupEnv(TTLEnvVar)
+	if !ok || val == "" {
+		return nil, nil
+	}
+	ttl, err := time.ParseDuration(val)
+	if err != nil {
+		return nil, fmt.Errorf("parse %s %s: %w", TTLEnvVar, val, err)
+	}
+	if ttl <= 0 {
+		return nil, nil
+	}
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return nil, fmt.Errorf("get user cache directory: %w", err)
+	}
+	return New(filepath.Join(dir, dirName), ttl), nil
+}
+
+// ForSession returns a cache whose keys are scoped to the credentials and region of the session,
+// so that results from different accounts or regions never collide.
+// If the credentials can't be retrieved, the returned cache is disabled.
+func (c *Cache) ForSession(sess *session.Session) *Cache {
+	if c == nil {
+		return nil
+	}
+	creds, err := sess.Config.Credentials.Get()
+	if err != nil {
+		return nil
+	}
+	scoped := *c
+	scoped.prefix = c.prefix + strings.Join([]string{creds.AccessKeyID, aws.StringValue(sess.Config.Region)}, "/") + "/"
+	return &scoped
+}
+
+// Get unmarshals the unexpired value stored under key into v and returns true.
+// It returns false if there is no such value.
+func (c *Cache) Get(key string, v interface{}) bool {
+	if c == nil {
+		return false
+	}
+	data, err := c.fs.ReadFile(c.path(key))
+	if err != nil {
+		return false
+	}
+	var e entry
+	if err := json.Unmarshal(data, &e); err != nil {
+		return false
+	}
+	if !c.now().Before(e.ExpiresAt) {
+		return false
+	}
+	return json.Unmarshal(e.Value, v) == nil
+}
+
+// Put stores v under key. Caching is best-effort, so failures to write the value are ignored.
+func (c *Cache) Put(key string, v interface{}) {
+	if c == nil {
+		return
+	}
+	value, err := json.Marshal(v)
+	if err != nil {
+		return
+	}
+	data, err := json.Marshal(entry{
+		ExpiresAt: c.now().Add(c.ttl),
+		Value:     value,
+	})
+	if err != nil {
+		return
+	}
+	if err := c.fs.MkdirAll(c.dir, 0700); err != nil {
+		return
+	}
+	c.fs.WriteFile(c.path(key), data, 0600)
+}
+
+func (c *Cache) path(key string) string {
+	sum := sha256.Sum256([]byte(c.prefix + key))
+	return filepath.Join(c.dir, hex.EncodeToString(sum[:])+".json")
+}
@@ -0,0 +1,160 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cache
+
+import (
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCache_GetPut(t *testing.T) {
+	now := time.Date(2020, 8, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		inElapsed time.Duration
+		inKey     string
+
+		wantedHit   bool
+		wantedValue []string
+	}{
+		"returns the value before it expires": {
+			inElapsed:   time.Minute,
+			inKey:       "vpcs",
+			wantedHit:   true,
+			wantedValue: []string{"vpc-1", "vpc-2"},
+		},
+		"misses once the value expires": {
+			inElapsed: 5 * time.Minute,
+			inKey:     "vpcs",
+		},
+		"misses for a different key": {
+			inKey: "subnets",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			c := &Cache{
+				fs:  &afero.Afero{Fs: afero.NewMemMapFs()},
+				dir: "/cache",
+				ttl: 5 * time.Minute,
+				now: func() time.Time { return now },
+			}
+			c.Put("vpcs", []string{"vpc-1", "vpc-2"})
+			c.now = func() time.Time { return now.Add(tc.inElapsed) }
+
+			// WHEN
+			var got []string
+			hit := c.Get(tc.inKey, &got)
+
+			// THEN
+			require.Equal(t, tc.wantedHit, hit)
+			require.Equal(t, tc.wantedValue, got)
+		})
+	}
+}
+
+func TestCache_Nil(t *testing.T) {
+	var c *Cache
+	c.Put("vpcs", []string{"vpc-1"})
+
+	var got []string
+	require.False(t, c.Get("vpcs", &got))
+	require.Nil(t, c.ForSession(&session.Session{}))
+}
+
+func TestCache_ForSession(t *testing.T) {
+	newSess := func(accessKeyID, region string) *session.Session {
+		return &session.Session{
+			Config: &aws.Config{
+				Credentials: credentials.NewStaticCredentials(accessKeyID, "secret", ""),
+				Region:      aws.String(region),
+			},
+		}
+	}
+	c := &Cache{
+		fs:  &afero.Afero{Fs: afero.NewMemMapFs()},
+		dir: "/cache",
+		ttl: 5 * time.Minute,
+		now: time.Now,
+	}
+	c.ForSession(newSess("AKID1", "us-west-2")).Put("vpcs", []string{"vpc-1"})
+
+	var got []string
+	require.True(t, c.ForSession(newSess("AKID1", "us-west-2")).Get("vpcs", &got))
+	require.Equal(t, []string{"vpc-1"}, got)
+	require.False(t, c.ForSession(newSess("AKID2", "us-west-2")).Get("vpcs", &got), "different credentials should not share entries")
+	require.False(t, c.ForSession(newSess("AKID1", "us-east-1")).Get("vpcs", &got), "different regions should not share entries")
+	require.Nil(t, c.ForSession(&session.Session{
+		Config: &aws.Config{
+			Credentials: credentials.NewCredentials(errProvider{}),
+		},
+	}), "the cache should be disabled if the credentials can't be retrieved")
+}
+
+func TestNewFromEnv(t *testing.T) {
+	testCases := map[string]struct {
+		inEnv map[string]string
+
+		wantedEnabled bool
+		wantedTTL     time.Duration
+		wantedErr     error
+	}{
+		"disabled by default": {},
+		"enabled with a TTL": {
+			inEnv:         map[string]string{TTLEnvVar: "10m"},
+			wantedEnabled: true,
+			wantedTTL:     10 * time.Minute,
+		},
+		"errors on an invalid TTL": {
+			inEnv:     map[string]string{TTLEnvVar: "ten"},
+			wantedErr: errors.New(`parse COPILOT_CACHE_TTL ten: time: invalid duration "ten"`),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			lookupEnv = func(key string) (string, bool) {
+				v, ok := tc.inEnv[key]
+				return v, ok
+			}
+			defer func() { lookupEnv = os.LookupEnv }()
+
+			// WHEN
+			c, err := NewFromEnv()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			if !tc.wantedEnabled {
+				require.Nil(t, c)
+				return
+			}
+			require.Equal(t, tc.wantedTTL, c.ttl)
+		})
+	}
+}
+
+type errProvider struct{}
+
+func (errProvider) Retrieve() (credentials.Value, error) {
+	return credentials.Value{}, errors.New("some error")
+}
+
+func (errProvider) IsExpired() bool {
+	return true
+}
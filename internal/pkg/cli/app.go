@@ -23,6 +23,7 @@ Applications are a collection of services and environments.`,
 	cmd.AddCommand(BuildAppInitCommand())
 	cmd.AddCommand(BuildAppListCommand())
 	cmd.AddCommand(BuildAppShowCmd())
+	cmd.AddCommand(BuildAppGraphCmd())
 	cmd.AddCommand(BuildAppDeleteCommand())
 
 	cmd.SetUsageTemplate(template.Usage)
@@ -0,0 +1,166 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/cobra"
+)
+
+const (
+	appGraphNamePrompt     = "Which application would you like to graph?"
+	appGraphNameHelpPrompt = "An application is a collection of related services."
+)
+
+const (
+	dotGraphFormat     = "dot"
+	mermaidGraphFormat = "mermaid"
+)
+
+var graphFormats = []string{dotGraphFormat, mermaidGraphFormat}
+
+type graphAppVars struct {
+	*GlobalOpts
+	format  string
+	noCache bool
+}
+
+type graphAppOpts struct {
+	graphAppVars
+
+	w           io.Writer
+	store       store
+	sel         appSelector
+	grapher     appGrapher
+	initGrapher func(*graphAppOpts) error // Overriden in tests.
+}
+
+func newGraphAppOpts(vars graphAppVars) (*graphAppOpts, error) {
+	c, err := newLocalCache(vars.noCache)
+	if err != nil {
+		return nil, err
+	}
+	store, err := config.NewCachedStore(c)
+	if err != nil {
+		return nil, fmt.Errorf("new config store: %w", err)
+	}
+	deployStore, err := deploy.NewStore(store)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace: %w", err)
+	}
+
+	return &graphAppOpts{
+		graphAppVars: vars,
+		store:        store,
+		w:            log.OutputWriter,
+		sel:          selector.NewSelect(vars.prompt, store),
+		initGrapher: func(o *graphAppOpts) error {
+			g, err := describe.NewAppGrapher(describe.NewAppGraphConfig{
+				App:          o.AppName(),
+				ConfigStore:  store,
+				DeployStore:  deployStore,
+				Dependencies: workspaceDependencies(ws, o.AppName()),
+				Cache:        c,
+			})
+			if err != nil {
+				return fmt.Errorf("creating grapher for application %s: %w", o.AppName(), err)
+			}
+			o.grapher = g
+			return nil
+		},
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *graphAppOpts) Validate() error {
+	if o.AppName() != "" {
+		if _, err := o.store.GetApplication(o.AppName()); err != nil {
+			return fmt.Errorf("get application %s: %w", o.AppName(), err)
+		}
+	}
+	for _, format := range graphFormats {
+		if o.format == format {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid format %s: must be one of %s", o.format, prettify(graphFormats))
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *graphAppOpts) Ask() error {
+	if o.AppName() != "" {
+		return nil
+	}
+	name, err := o.sel.Application(appGraphNamePrompt, appGraphNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = name
+	return nil
+}
+
+// Execute writes the topology of the application in the requested format.
+func (o *graphAppOpts) Execute() error {
+	if err := o.initGrapher(o); err != nil {
+		return err
+	}
+	graph, err := o.grapher.Graph()
+	if err != nil {
+		return fmt.Errorf("graph application %s: %w", o.AppName(), err)
+	}
+	if o.format == mermaidGraphFormat {
+		fmt.Fprint(o.w, graph.Mermaid())
+		return nil
+	}
+	fmt.Fprint(o.w, graph.DOT())
+	return nil
+}
+
+// BuildAppGraphCmd builds the command for graphing the topology of an application.
+func BuildAppGraphCmd() *cobra.Command {
+	vars := graphAppVars{
+		GlobalOpts: NewGlobalOpts(),
+	}
+	cmd := &cobra.Command{
+		Use:   "graph",
+		Short: "Graphs the environments, services and addons of an application.",
+		Long: `Graphs the environments, services and addons of an application.
+The services deployed in each environment are linked to the services they depend on,
+and to the queues, topics and datastores created by their addons.`,
+		Example: `
+  Writes the topology of the application "my-app" as a Graphviz DOT graph
+  /code $ copilot app graph -n my-app | dot -Tsvg > my-app.svg
+  Writes the topology as a Mermaid flowchart to embed in your docs
+  /code $ copilot app graph -n my-app --format mermaid`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newGraphAppOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, nameFlag, nameFlagShort, "" /* default */, appFlagDescription)
+	cmd.Flags().StringVar(&vars.format, graphFormatFlag, dotGraphFormat, graphFormatFlagDescription)
+	cmd.Flags().BoolVar(&vars.noCache, noCacheFlag, false, noCacheFlagDescription)
+	return cmd
+}
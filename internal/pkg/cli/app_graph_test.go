@@ -0,0 +1,225 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGraphAppOpts_Validate(t *testing.T) {
+	testError := errors.New("some error")
+	testCases := map[string]struct {
+		inAppName  string
+		inFormat   string
+		setupMocks func(m *mocks.Mockstore)
+
+		wantedError error
+	}{
+		"invalid app name": {
+			inAppName: "my-app",
+			inFormat:  dotGraphFormat,
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(nil, testError)
+			},
+
+			wantedError: fmt.Errorf("get application %s: %w", "my-app", testError),
+		},
+		"invalid format": {
+			inFormat:   "svg",
+			setupMocks: func(m *mocks.Mockstore) {},
+
+			wantedError: errors.New(`invalid format svg: must be one of "dot", "mermaid"`),
+		},
+		"valid app name and format": {
+			inAppName: "my-app",
+			inFormat:  mermaidGraphFormat,
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockstore(ctrl)
+			tc.setupMocks(mockStore)
+
+			opts := &graphAppOpts{
+				graphAppVars: graphAppVars{
+					GlobalOpts: &GlobalOpts{
+						appName: tc.inAppName,
+					},
+					format: tc.inFormat,
+				},
+				store: mockStore,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestGraphAppOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inAppName  string
+		setupMocks func(m *mocks.MockappSelector)
+
+		wantedApp   string
+		wantedError error
+	}{
+		"doesn't prompt if the application is provided": {
+			inAppName:  "my-app",
+			setupMocks: func(m *mocks.MockappSelector) {},
+
+			wantedApp: "my-app",
+		},
+		"prompts for the application": {
+			setupMocks: func(m *mocks.MockappSelector) {
+				m.EXPECT().Application(appGraphNamePrompt, appGraphNameHelpPrompt).Return("my-app", nil)
+			},
+
+			wantedApp: "my-app",
+		},
+		"returns error if fail to select the application": {
+			setupMocks: func(m *mocks.MockappSelector) {
+				m.EXPECT().Application(appGraphNamePrompt, appGraphNameHelpPrompt).Return("", errors.New("some error"))
+			},
+
+			wantedError: errors.New("select application: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSel := mocks.NewMockappSelector(ctrl)
+			tc.setupMocks(mockSel)
+
+			opts := &graphAppOpts{
+				graphAppVars: graphAppVars{
+					GlobalOpts: &GlobalOpts{
+						appName: tc.inAppName,
+					},
+				},
+				sel: mockSel,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedApp, opts.AppName())
+		})
+	}
+}
+
+func TestGraphAppOpts_Execute(t *testing.T) {
+	mockGraph := &describe.AppGraph{
+		App: "my-app",
+		Environments: []*describe.GraphEnvironment{
+			{
+				Name: "test",
+				Services: []*describe.GraphService{
+					{Name: "api", Type: "Backend Service"},
+				},
+			},
+		},
+	}
+	testCases := map[string]struct {
+		inFormat   string
+		setupMocks func(m *mocks.MockappGrapher)
+
+		wantedContent string
+		wantedError   error
+	}{
+		"returns error if fail to graph the application": {
+			inFormat: dotGraphFormat,
+			setupMocks: func(m *mocks.MockappGrapher) {
+				m.EXPECT().Graph().Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("graph application my-app: some error"),
+		},
+		"writes the graph in DOT": {
+			inFormat: dotGraphFormat,
+			setupMocks: func(m *mocks.MockappGrapher) {
+				m.EXPECT().Graph().Return(mockGraph, nil)
+			},
+
+			wantedContent: mockGraph.DOT(),
+		},
+		"writes the graph in Mermaid": {
+			inFormat: mermaidGraphFormat,
+			setupMocks: func(m *mocks.MockappGrapher) {
+				m.EXPECT().Graph().Return(mockGraph, nil)
+			},
+
+			wantedContent: mockGraph.Mermaid(),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			b := &bytes.Buffer{}
+			mockGrapher := mocks.NewMockappGrapher(ctrl)
+			tc.setupMocks(mockGrapher)
+
+			opts := &graphAppOpts{
+				graphAppVars: graphAppVars{
+					GlobalOpts: &GlobalOpts{
+						appName: "my-app",
+					},
+					format: tc.inFormat,
+				},
+				w:           b,
+				grapher:     mockGrapher,
+				initGrapher: func(*graphAppOpts) error { return nil },
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, b.String())
+		})
+	}
+}
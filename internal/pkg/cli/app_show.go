@@ -23,6 +23,7 @@ const (
 type showAppVars struct {
 	*GlobalOpts
 	shouldOutputJSON bool
+	noCache          bool
 }
 
 type showAppOpts struct {
@@ -34,7 +35,11 @@ type showAppOpts struct {
 }
 
 func newShowAppOpts(vars showAppVars) (*showAppOpts, error) {
-	store, err := config.NewStore()
+	c, err := newLocalCache(vars.noCache)
+	if err != nil {
+		return nil, err
+	}
+	store, err := config.NewCachedStore(c)
 	if err != nil {
 		return nil, fmt.Errorf("new config store: %w", err)
 	}
@@ -167,6 +172,7 @@ func BuildAppShowCmd() *cobra.Command {
 	}
 	// The flags bound by viper are available to all sub-commands through viper.GetString({flagName})
 	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	cmd.Flags().BoolVar(&vars.noCache, noCacheFlag, false, noCacheFlagDescription)
 	cmd.Flags().StringVarP(&vars.appName, nameFlag, nameFlagShort, "" /* default */, appFlagDescription)
 	return cmd
 }
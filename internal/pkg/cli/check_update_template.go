@@ -0,0 +1,239 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/aws/copilot-cli/cmd/copilot/template"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/cli/group"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+const (
+	checkUpdateTemplateAppNamePrompt     = "Which application is the service in?"
+	checkUpdateTemplateAppNameHelpPrompt = "An application groups all of your services together."
+	checkUpdateTemplateSvcNamePrompt     = "Which deployed service would you like to check?"
+	checkUpdateTemplateSvcNameHelpPrompt = "The template that the service was deployed with is compared with the one generated by this version of Copilot."
+
+	// The image of the service is a parameter of its stack, so the tag doesn't change the rendered template.
+	checkUpdateTemplateImageTag = "latest"
+)
+
+type checkUpdateTemplateVars struct {
+	*GlobalOpts
+	shouldOutputJSON bool
+	svcName          string
+	envName          string
+	templatePath     string
+}
+
+type checkUpdateTemplateOpts struct {
+	checkUpdateTemplateVars
+
+	w              io.Writer
+	fs             afero.Fs
+	store          store
+	sel            deploySelector
+	tplGetter      serviceTemplateGetter
+	initClients    func(*checkUpdateTemplateOpts) error
+	renderTemplate func(*checkUpdateTemplateOpts) (string, error) // Overriden in tests.
+
+	// Cached variables.
+	targetEnv *config.Environment
+}
+
+func newCheckUpdateTemplateOpts(vars checkUpdateTemplateVars) (*checkUpdateTemplateOpts, error) {
+	configStore, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("connect to environment datastore: %w", err)
+	}
+	deployStore, err := deploy.NewStore(configStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	return &checkUpdateTemplateOpts{
+		checkUpdateTemplateVars: vars,
+		w:                       log.OutputWriter,
+		fs:                      &afero.Afero{Fs: afero.NewOsFs()},
+		store:                   configStore,
+		sel:                     selector.NewDeploySelect(vars.prompt, configStore, deployStore),
+		initClients: func(o *checkUpdateTemplateOpts) error {
+			env, err := o.store.GetEnvironment(o.AppName(), o.envName)
+			if err != nil {
+				return fmt.Errorf("get environment %s: %w", o.envName, err)
+			}
+			o.targetEnv = env
+			sess, err := sessions.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
+			if err != nil {
+				return fmt.Errorf("assume role for environment %s: %w", env.Name, err)
+			}
+			o.tplGetter = cloudformation.New(sess)
+			return nil
+		},
+		renderTemplate: func(o *checkUpdateTemplateOpts) (string, error) {
+			pkg, err := newPackageSvcOpts(packageSvcVars{
+				GlobalOpts: o.GlobalOpts,
+				Name:       o.svcName,
+				EnvName:    o.envName,
+				Tag:        checkUpdateTemplateImageTag,
+			})
+			if err != nil {
+				return "", err
+			}
+			tpls, err := pkg.getSvcTemplates(o.targetEnv)
+			if err != nil {
+				return "", err
+			}
+			return tpls.stack, nil
+		},
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *checkUpdateTemplateOpts) Validate() error {
+	if o.AppName() != "" {
+		if _, err := o.store.GetApplication(o.AppName()); err != nil {
+			return err
+		}
+	}
+	if o.svcName != "" {
+		if _, err := o.store.GetService(o.AppName(), o.svcName); err != nil {
+			return err
+		}
+	}
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.AppName(), o.envName); err != nil {
+			return err
+		}
+	}
+	if o.templatePath != "" {
+		if _, err := o.fs.Stat(o.templatePath); err != nil {
+			return fmt.Errorf("check template %s: %w", o.templatePath, err)
+		}
+	}
+	return nil
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *checkUpdateTemplateOpts) Ask() error {
+	if o.AppName() == "" {
+		app, err := o.sel.Application(checkUpdateTemplateAppNamePrompt, checkUpdateTemplateAppNameHelpPrompt)
+		if err != nil {
+			return fmt.Errorf("select application: %w", err)
+		}
+		o.appName = app
+	}
+	deployedService, err := o.sel.DeployedService(checkUpdateTemplateSvcNamePrompt, checkUpdateTemplateSvcNameHelpPrompt, o.AppName(), selector.WithEnv(o.envName), selector.WithSvc(o.svcName))
+	if err != nil {
+		return fmt.Errorf("select deployed services for application %s: %w", o.AppName(), err)
+	}
+	o.svcName = deployedService.Svc
+	o.envName = deployedService.Env
+	return nil
+}
+
+// Execute displays the resources of the service stack that change when the service is deployed with this version of Copilot,
+// categorized by their impact on the service.
+func (o *checkUpdateTemplateOpts) Execute() error {
+	if err := o.initClients(o); err != nil {
+		return err
+	}
+	current, err := o.currentTemplate()
+	if err != nil {
+		return err
+	}
+	rendered, err := o.renderTemplate(o)
+	if err != nil {
+		return fmt.Errorf("render template of service %s: %w", o.svcName, err)
+	}
+	changes, err := describe.CompareTemplates(current, rendered)
+	if err != nil {
+		return fmt.Errorf("compare templates of service %s: %w", o.svcName, err)
+	}
+	report := &describe.TemplateChanges{
+		Service:     o.svcName,
+		Environment: o.envName,
+		Changes:     changes,
+	}
+	if o.shouldOutputJSON {
+		data, err := report.JSONString()
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(o.w, data)
+	} else {
+		fmt.Fprintf(o.w, report.HumanString())
+	}
+	return nil
+}
+
+// currentTemplate returns the template provided with the template flag, or the template that the service was last deployed with.
+func (o *checkUpdateTemplateOpts) currentTemplate() (string, error) {
+	if o.templatePath != "" {
+		data, err := afero.ReadFile(o.fs, o.templatePath)
+		if err != nil {
+			return "", fmt.Errorf("read template %s: %w", o.templatePath, err)
+		}
+		return string(data), nil
+	}
+	tpl, err := o.tplGetter.ServiceTemplate(o.AppName(), o.envName, o.svcName)
+	if err != nil {
+		return "", fmt.Errorf("get deployed template of service %s in environment %s: %w", o.svcName, o.envName, err)
+	}
+	return tpl, nil
+}
+
+// BuildCheckUpdateTemplateCmd builds the command for checking how the template of a service changes with this version of Copilot.
+func BuildCheckUpdateTemplateCmd() *cobra.Command {
+	vars := checkUpdateTemplateVars{
+		GlobalOpts: NewGlobalOpts(),
+	}
+	cmd := &cobra.Command{
+		Use:   "check-update-template",
+		Short: "Checks how the CloudFormation template of a service changes with this version of Copilot.",
+		Long: `Checks how the CloudFormation template of a deployed service changes with this version of Copilot.
+The resources that change are categorized as benign, disruptive if they interrupt the service,
+or replacement if CloudFormation replaces them, so that upgrades can be planned before deploying.`,
+
+		Example: `
+  Checks the changes to the "api" service in the "prod" environment before upgrading it.
+  /code $ copilot check-update-template -s api -e prod
+
+  Compares with a template previously written by "copilot svc package" instead of the deployed one.
+  /code $ copilot check-update-template -s api -e prod --template ./infrastructure/api.stack.yml`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newCheckUpdateTemplateOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, "", appFlagDescription)
+	cmd.Flags().StringVarP(&vars.svcName, svcFlag, svcFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVar(&vars.templatePath, templateFileFlag, "", templateFileFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	cmd.SetUsageTemplate(template.Usage)
+	cmd.Annotations = map[string]string{
+		"group": group.Release,
+	}
+	return cmd
+}
@@ -0,0 +1,157 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckUpdateTemplateOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inTemplatePath string
+
+		wantedErr string
+	}{
+		"errors if the template doesn't exist": {
+			inTemplatePath: "infrastructure/web.stack.yml",
+			wantedErr:      "check template infrastructure/web.stack.yml: open infrastructure/web.stack.yml: file does not exist",
+		},
+		"succeeds if the template exists": {
+			inTemplatePath: "infrastructure/api.stack.yml",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			require.NoError(t, afero.WriteFile(fs, "infrastructure/api.stack.yml", []byte("Resources: {}"), 0644))
+			opts := &checkUpdateTemplateOpts{
+				checkUpdateTemplateVars: checkUpdateTemplateVars{
+					GlobalOpts:   &GlobalOpts{},
+					templatePath: tc.inTemplatePath,
+				},
+				fs: fs,
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCheckUpdateTemplateOpts_Execute(t *testing.T) {
+	const (
+		deployedTpl = `Resources:
+  LogGroup:
+    Type: AWS::Logs::LogGroup
+    Properties:
+      LogGroupName: /copilot/phonetool-prod-api
+`
+		renamedTpl = `Resources:
+  LogGroup:
+    Type: AWS::Logs::LogGroup
+    Properties:
+      LogGroupName: /copilot/api
+`
+	)
+	testCases := map[string]struct {
+		inTemplatePath string
+		inJSON         bool
+		setupMocks     func(m *mocks.MockserviceTemplateGetter)
+		renderedTpl    string
+		renderErr      error
+
+		wantedOutput string
+		wantedErr    string
+	}{
+		"errors if the deployed template can't be retrieved": {
+			setupMocks: func(m *mocks.MockserviceTemplateGetter) {
+				m.EXPECT().ServiceTemplate("phonetool", "prod", "api").Return("", errors.New("some error"))
+			},
+			wantedErr: "get deployed template of service api in environment prod: some error",
+		},
+		"errors if the template can't be rendered": {
+			setupMocks: func(m *mocks.MockserviceTemplateGetter) {
+				m.EXPECT().ServiceTemplate("phonetool", "prod", "api").Return(deployedTpl, nil)
+			},
+			renderErr: errors.New("some error"),
+			wantedErr: "render template of service api: some error",
+		},
+		"reports that the template doesn't change": {
+			setupMocks: func(m *mocks.MockserviceTemplateGetter) {
+				m.EXPECT().ServiceTemplate("phonetool", "prod", "api").Return(deployedTpl, nil)
+			},
+			renderedTpl:  deployedTpl,
+			wantedOutput: "The template of service api in environment prod doesn't change with this version of Copilot.\n",
+		},
+		"compares with the template file instead of the deployed template": {
+			inTemplatePath: "infrastructure/api.stack.yml",
+			inJSON:         true,
+			setupMocks: func(m *mocks.MockserviceTemplateGetter) {
+				m.EXPECT().ServiceTemplate(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			renderedTpl:  renamedTpl,
+			wantedOutput: "{\"service\":\"api\",\"environment\":\"prod\",\"changes\":[{\"logicalID\":\"LogGroup\",\"type\":\"AWS::Logs::LogGroup\",\"action\":\"Modify\",\"category\":\"replacement\",\"properties\":[\"LogGroupName\"]}]}\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			tplGetter := mocks.NewMockserviceTemplateGetter(ctrl)
+			tc.setupMocks(tplGetter)
+			fs := afero.NewMemMapFs()
+			require.NoError(t, afero.WriteFile(fs, "infrastructure/api.stack.yml", []byte(deployedTpl), 0644))
+			b := &bytes.Buffer{}
+
+			opts := &checkUpdateTemplateOpts{
+				checkUpdateTemplateVars: checkUpdateTemplateVars{
+					svcName:          "api",
+					envName:          "prod",
+					templatePath:     tc.inTemplatePath,
+					shouldOutputJSON: tc.inJSON,
+					GlobalOpts: &GlobalOpts{
+						appName: "phonetool",
+					},
+				},
+				w:         b,
+				fs:        fs,
+				tplGetter: tplGetter,
+				initClients: func(o *checkUpdateTemplateOpts) error {
+					o.targetEnv = &config.Environment{Name: "prod"}
+					return nil
+				},
+				renderTemplate: func(o *checkUpdateTemplateOpts) (string, error) {
+					return tc.renderedTpl, tc.renderErr
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedOutput, b.String())
+		})
+	}
+}
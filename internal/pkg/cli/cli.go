@@ -5,12 +5,16 @@
 package cli
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
+	"os/signal"
 	"path/filepath"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/copilot-cli/internal/pkg/cache"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
@@ -78,6 +82,11 @@ func (e *errReservedArg) Error() string {
 	return fmt.Sprintf(`argument %s is a reserved keyword, please use a different value`, color.HighlightUserInput(e.val))
 }
 
+// ExitCode returns the exit status of the CLI for the error.
+func (e *errReservedArg) ExitCode() int {
+	return ExitCodeUserError
+}
+
 // reservedArgs returns an error if the arguments contain any reserved keywords.
 func reservedArgs(cmd *cobra.Command, args []string) error {
 	if len(args) != 1 {
@@ -127,6 +136,89 @@ func (s *svcEnv) String() string {
 	return fmt.Sprintf("%s (%s)", s.svcName, s.envName)
 }
 
+// newLocalCache returns the local cache of read-only calls enabled by the COPILOT_CACHE_TTL environment variable.
+// It returns nil, which disables caching, if the cache isn't enabled or if the command is run with --no-cache.
+func newLocalCache(noCache bool) (*cache.Cache, error) {
+	if noCache {
+		return nil, nil
+	}
+	c, err := cache.NewFromEnv()
+	if err != nil {
+		return nil, fmt.Errorf("initialize local cache: %w", err)
+	}
+	return c, nil
+}
+
+// svcDependencies returns the services that each of the workspace services depends on,
+// as declared by the "depends_on" field of their manifests.
+func svcDependencies(ws svcManifestReader, names []string) (map[string][]string, error) {
+	type dependent interface {
+		Dependencies() []string
+	}
+	deps := make(map[string][]string)
+	for _, name := range names {
+		raw, err := ws.ReadServiceManifest(name)
+		if err != nil {
+			return nil, fmt.Errorf("read service %s manifest from workspace: %w", name, err)
+		}
+		// Only the dependencies are needed, so fields that aren't recognized are ignored.
+		mft, err := manifest.UnmarshalServiceLax(raw)
+		if err != nil {
+			return nil, fmt.Errorf("unmarshal service %s manifest: %w", name, err)
+		}
+		if d, ok := mft.(dependent); ok && len(d.Dependencies()) != 0 {
+			deps[name] = d.Dependencies()
+		}
+	}
+	return deps, nil
+}
+
+// workspaceDependencies returns the services that each service in the workspace depends on.
+// The dependencies are only known if the command is run from the application's workspace, otherwise it returns nil.
+func workspaceDependencies(ws wsAppSvcReader, app string) map[string][]string {
+	summary, err := ws.Summary()
+	if err != nil || summary.Application != app {
+		return nil
+	}
+	names, err := ws.ServiceNames()
+	if err != nil {
+		return nil
+	}
+	deps, err := svcDependencies(ws, names)
+	if err != nil {
+		return nil
+	}
+	return deps
+}
+
+// workspaceAlarmNames returns the names of the alarms associated with the service by the "alarms" field of its manifest.
+// The alarms are only known if the command is run from the application's workspace, otherwise it returns nil.
+func workspaceAlarmNames(ws wsAppSvcReader, app, svc string) []string {
+	type alarmed interface {
+		AlarmNames() []string
+	}
+	summary, err := ws.Summary()
+	if err != nil || summary.Application != app {
+		return nil
+	}
+	names, err := ws.ServiceNames()
+	if err != nil || !contains(svc, names) {
+		return nil
+	}
+	raw, err := ws.ReadServiceManifest(svc)
+	if err != nil {
+		return nil
+	}
+	mft, err := manifest.UnmarshalServiceLax(raw)
+	if err != nil {
+		return nil
+	}
+	if a, ok := mft.(alarmed); ok {
+		return a.AlarmNames()
+	}
+	return nil
+}
+
 // relPath returns the path relative to the current working directory.
 func relPath(fullPath string) (string, error) {
 	wkdir, err := os.Getwd()
@@ -139,3 +231,22 @@ func relPath(fullPath string) (string, error) {
 	}
 	return path, nil
 }
+
+// interruptContext returns a context that is canceled when the user hits Ctrl-C.
+// The returned function must be called to stop listening for the interrupt.
+func interruptContext() (context.Context, func()) {
+	ctx, cancel := context.WithCancel(context.Background())
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, os.Interrupt)
+	go func() {
+		select {
+		case <-sigs:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	return ctx, func() {
+		signal.Stop(sigs)
+		cancel()
+	}
+}
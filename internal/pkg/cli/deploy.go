@@ -18,7 +18,11 @@ func BuildDeployCmd() *cobra.Command {
 	deployCmd.Long = `Command for deploying services to your environments.`
 	deployCmd.Example = `
 	Deploys a service named "frontend" to a "test" environment.
-	/code $ copilot deploy --name frontend --env test`
+	/code $ copilot deploy --name frontend --env test
+	Starts the deployment without waiting for it, and waits for it later.
+	/code $ id=$(copilot deploy --name frontend --env test --no-wait)
+	/code $ copilot deploy wait $id`
+	deployCmd.AddCommand(buildDeployWaitCmd())
 
 	deployCmd.SetUsageTemplate(template.Usage)
 
@@ -0,0 +1,134 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/spf13/cobra"
+)
+
+// The IDs of the deployments started with --no-wait are the ARNs of their change sets.
+const changeSetResourcePrefix = "changeSet/"
+
+type waitDeployVars struct {
+	*GlobalOpts
+	deploymentID string
+}
+
+type waitDeployOpts struct {
+	waitDeployVars
+
+	store     store
+	spinner   progress
+	newWaiter func(env *config.Environment) (deploymentWaiter, error)
+}
+
+func newWaitDeployOpts(vars waitDeployVars) (*waitDeployOpts, error) {
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("new config store: %w", err)
+	}
+	return &waitDeployOpts{
+		waitDeployVars: vars,
+
+		store:   store,
+		spinner: termprogress.NewSpinner(),
+		newWaiter: func(env *config.Environment) (deploymentWaiter, error) {
+			sess, err := sessions.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
+			if err != nil {
+				return nil, fmt.Errorf("assume role for environment %s: %w", env.Name, err)
+			}
+			return cloudformation.New(sess), nil
+		},
+	}, nil
+}
+
+// Validate returns an error if the user inputs are invalid.
+func (o *waitDeployOpts) Validate() error {
+	if o.AppName() == "" {
+		return errNoAppInWorkspace
+	}
+	parsed, err := arn.Parse(o.deploymentID)
+	if err != nil || parsed.Service != "cloudformation" || !strings.HasPrefix(parsed.Resource, changeSetResourcePrefix) {
+		return fmt.Errorf("%s is not the ID of a deployment started with --%s", o.deploymentID, noWaitFlag)
+	}
+	return nil
+}
+
+// Execute waits until the deployment is done.
+func (o *waitDeployOpts) Execute() error {
+	env, err := o.deploymentEnv()
+	if err != nil {
+		return err
+	}
+	waiter, err := o.newWaiter(env)
+	if err != nil {
+		return err
+	}
+	o.spinner.Start(fmt.Sprintf("Waiting for the deployment to %s to be done.", color.HighlightUserInput(env.Name)))
+	if err := waiter.WaitForDeployment(o.deploymentID); err != nil {
+		o.spinner.Stop(log.Serrorf("Deployment to %s failed.\n", env.Name))
+		return fmt.Errorf("wait for deployment %s: %w", o.deploymentID, err)
+	}
+	o.spinner.Stop(log.Ssuccessf("Deployment to %s is done.\n", color.HighlightUserInput(env.Name)))
+	return nil
+}
+
+// deploymentEnv returns the environment of the application in the account and region of the deployment.
+func (o *waitDeployOpts) deploymentEnv() (*config.Environment, error) {
+	parsed, err := arn.Parse(o.deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("parse deployment ID %s: %w", o.deploymentID, err)
+	}
+	envs, err := o.store.ListEnvironments(o.AppName())
+	if err != nil {
+		return nil, fmt.Errorf("list environments of application %s: %w", o.AppName(), err)
+	}
+	for _, env := range envs {
+		if env.AccountID == parsed.AccountID && env.Region == parsed.Region {
+			return env, nil
+		}
+	}
+	return nil, fmt.Errorf("no environment of application %s is in account %s and region %s", o.AppName(), parsed.AccountID, parsed.Region)
+}
+
+// buildDeployWaitCmd builds the command to wait for a deployment started with --no-wait.
+func buildDeployWaitCmd() *cobra.Command {
+	vars := waitDeployVars{
+		GlobalOpts: NewGlobalOpts(),
+	}
+	cmd := &cobra.Command{
+		Use:   "wait <id>",
+		Short: "Waits for a deployment started with --no-wait to be done.",
+		Long: `Waits for a deployment started with --no-wait to be done.
+Exits with an error if the deployment failed and was rolled back.`,
+		Example: `
+  Starts deploying the service "frontend" to the "test" environment, and waits for it later.
+  /code $ id=$(copilot deploy --name frontend --env test --no-wait)
+  /code $ copilot deploy wait $id`,
+		Args: cobra.ExactArgs(1),
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			vars.deploymentID = args[0]
+			opts, err := newWaitDeployOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, "", appFlagDescription)
+	return cmd
+}
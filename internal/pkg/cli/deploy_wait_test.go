@@ -0,0 +1,154 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+const mockDeploymentID = "arn:aws:cloudformation:us-west-2:123456789012:changeSet/ecscli-1234/5678"
+
+func TestWaitDeployOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inAppName      string
+		inDeploymentID string
+
+		wantedError error
+	}{
+		"errors without an application": {
+			inDeploymentID: mockDeploymentID,
+
+			wantedError: errNoAppInWorkspace,
+		},
+		"errors if the ID is not an ARN": {
+			inAppName:      "phonetool",
+			inDeploymentID: "ecscli-1234",
+
+			wantedError: errors.New("ecscli-1234 is not the ID of a deployment started with --no-wait"),
+		},
+		"errors if the ID is the ARN of a stack": {
+			inAppName:      "phonetool",
+			inDeploymentID: "arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-test-frontend/5678",
+
+			wantedError: errors.New("arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-test-frontend/5678 is not the ID of a deployment started with --no-wait"),
+		},
+		"valid change set ID": {
+			inAppName:      "phonetool",
+			inDeploymentID: mockDeploymentID,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			opts := &waitDeployOpts{
+				waitDeployVars: waitDeployVars{
+					GlobalOpts:   &GlobalOpts{appName: tc.inAppName},
+					deploymentID: tc.inDeploymentID,
+				},
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestWaitDeployOpts_Execute(t *testing.T) {
+	testEnv := &config.Environment{
+		Name:      "test",
+		AccountID: "123456789012",
+		Region:    "us-west-2",
+	}
+	testCases := map[string]struct {
+		setupMocks func(m waitDeployMocks)
+
+		wantedError error
+	}{
+		"errors if no environment is in the account and region of the deployment": {
+			setupMocks: func(m waitDeployMocks) {
+				m.store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{
+					{Name: "prod", AccountID: "123456789012", Region: "us-east-1"},
+				}, nil)
+			},
+
+			wantedError: errors.New("no environment of application phonetool is in account 123456789012 and region us-west-2"),
+		},
+		"errors if the deployment failed": {
+			setupMocks: func(m waitDeployMocks) {
+				m.store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{testEnv}, nil)
+				m.spinner.EXPECT().Start("Waiting for the deployment to test to be done.")
+				m.waiter.EXPECT().WaitForDeployment(mockDeploymentID).Return(errors.New("some error"))
+				m.spinner.EXPECT().Stop(gomock.Any())
+			},
+
+			wantedError: errors.New("wait for deployment " + mockDeploymentID + ": some error"),
+		},
+		"waits for the deployment in the environment": {
+			setupMocks: func(m waitDeployMocks) {
+				m.store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{testEnv}, nil)
+				m.spinner.EXPECT().Start("Waiting for the deployment to test to be done.")
+				m.waiter.EXPECT().WaitForDeployment(mockDeploymentID).Return(nil)
+				m.spinner.EXPECT().Stop(gomock.Any())
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := waitDeployMocks{
+				store:   mocks.NewMockstore(ctrl),
+				spinner: mocks.NewMockprogress(ctrl),
+				waiter:  mocks.NewMockdeploymentWaiter(ctrl),
+			}
+			tc.setupMocks(m)
+
+			opts := &waitDeployOpts{
+				waitDeployVars: waitDeployVars{
+					GlobalOpts:   &GlobalOpts{appName: "phonetool"},
+					deploymentID: mockDeploymentID,
+				},
+				store:   m.store,
+				spinner: m.spinner,
+				newWaiter: func(env *config.Environment) (deploymentWaiter, error) {
+					require.Equal(t, testEnv, env)
+					return m.waiter, nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+type waitDeployMocks struct {
+	store   *mocks.Mockstore
+	spinner *mocks.Mockprogress
+	waiter  *mocks.MockdeploymentWaiter
+}
@@ -28,6 +28,9 @@ Environments are deployment stages shared between services.`,
 	cmd.AddCommand(BuildEnvListCmd())
 	cmd.AddCommand(BuildEnvDeleteCmd())
 	cmd.AddCommand(BuildEnvShowCmd())
+	cmd.AddCommand(BuildEnvStatusCmd())
+	cmd.AddCommand(BuildEnvGCCmd())
+	cmd.AddCommand(BuildEnvDeployCmd())
 	cmd.SetUsageTemplate(template.Usage)
 	cmd.Annotations = map[string]string{
 		"group": group.Develop,
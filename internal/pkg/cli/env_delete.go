@@ -50,6 +50,7 @@ type deleteEnvVars struct {
 	EnvName          string
 	EnvProfile       string
 	SkipConfirmation bool
+	Confirm          []string
 }
 
 type deleteEnvOpts struct {
@@ -58,6 +59,7 @@ type deleteEnvOpts struct {
 	store         environmentStore
 	rgClient      resourceGetter
 	deployClient  environmentDeployer
+	svcDeleter    svcDeleter
 	profileConfig profileNames
 	prog          progress
 	sel           configSelector
@@ -88,7 +90,9 @@ func newDeleteEnvOpts(vars deleteEnvVars) (*deleteEnvOpts, error) {
 				return fmt.Errorf("cannot create session from profile %s: %w", o.EnvProfile, err)
 			}
 			o.rgClient = resourcegroupstaggingapi.New(profileSess)
-			o.deployClient = cloudformation.New(profileSess)
+			cfClient := cloudformation.New(profileSess)
+			o.deployClient = cfClient
+			o.svcDeleter = cfClient
 			return nil
 		},
 	}, nil
@@ -132,10 +136,17 @@ func (o *deleteEnvOpts) Ask() error {
 // The environment is removed from the store only if other delete operations succeed.
 // Execute assumes that Validate is invoked first.
 func (o *deleteEnvOpts) Execute() error {
+	env, err := o.store.GetEnvironment(o.AppName(), o.EnvName)
+	if err != nil {
+		return fmt.Errorf("get environment %s configuration: %w", o.EnvName, err)
+	}
+	if err := confirmProtectedEnv(o.prompt, env, o.Confirm); err != nil {
+		return err
+	}
 	if err := o.initProfileClients(o); err != nil {
 		return err
 	}
-	if err := o.validateNoRunningServices(); err != nil {
+	if err := o.ensureNoRunningServices(); err != nil {
 		return err
 	}
 
@@ -159,7 +170,9 @@ func (o *deleteEnvOpts) validateEnvName() error {
 	return nil
 }
 
-func (o *deleteEnvOpts) validateNoRunningServices() error {
+// ensureNoRunningServices returns an error if services are still deployed in the environment.
+// Services in an ephemeral environment, such as a preview or expiring environment, are deleted instead.
+func (o *deleteEnvOpts) ensureNoRunningServices() error {
 	stacks, err := o.rgClient.GetResources(&resourcegroupstaggingapi.GetResourcesInput{
 		ResourceTypeFilters: []*string{aws.String("cloudformation")},
 		TagFilters: []*resourcegroupstaggingapi.TagFilter{
@@ -190,11 +203,34 @@ func (o *deleteEnvOpts) validateNoRunningServices() error {
 				svcNames = append(svcNames, *t.Value)
 			}
 		}
+		env, err := o.store.GetEnvironment(o.AppName(), o.EnvName)
+		if err != nil {
+			return fmt.Errorf("get environment %s configuration: %w", o.EnvName, err)
+		}
+		if env.IsEphemeral() {
+			return o.deleteServices(svcNames)
+		}
 		return fmt.Errorf("service '%s' still exist within the environment %s", strings.Join(svcNames, ", "), o.EnvName)
 	}
 	return nil
 }
 
+func (o *deleteEnvOpts) deleteServices(svcNames []string) error {
+	for _, svc := range svcNames {
+		o.prog.Start(fmt.Sprintf(fmtSvcDeleteStart, svc, o.EnvName))
+		if err := o.svcDeleter.DeleteService(deploy.DeleteServiceInput{
+			Name:    svc,
+			EnvName: o.EnvName,
+			AppName: o.AppName(),
+		}); err != nil {
+			o.prog.Stop(log.Serrorf(fmtSvcDeleteFailed, svc, o.EnvName, err))
+			return fmt.Errorf("delete service %s from environment %s: %w", svc, o.EnvName, err)
+		}
+		o.prog.Stop(log.Ssuccessf(fmtSvcDeleteComplete, svc, o.EnvName))
+	}
+	return nil
+}
+
 func (o *deleteEnvOpts) askEnvName() error {
 	if o.EnvName != "" {
 		return nil
@@ -281,5 +317,6 @@ func BuildEnvDeleteCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.EnvName, nameFlag, nameFlagShort, "", envFlagDescription)
 	cmd.Flags().StringVar(&vars.EnvProfile, profileFlag, "", profileFlagDescription)
 	cmd.Flags().BoolVar(&vars.SkipConfirmation, yesFlag, false, yesFlagDescription)
+	cmd.Flags().StringSliceVar(&vars.Confirm, confirmFlag, nil, confirmFlagDescription)
 	return cmd
 }
@@ -227,6 +227,7 @@ func TestDeleteEnvOpts_Execute(t *testing.T) {
 		mockProg   func(ctrl *gomock.Controller) *mocks.Mockprogress
 		mockDeploy func(ctrl *gomock.Controller) *mocks.MockenvironmentDeployer
 		mockStore  func(ctrl *gomock.Controller) *mocks.MockenvironmentStore
+		mockSvc    func(ctrl *gomock.Controller) *mocks.MocksvcDeleter
 
 		wantedError error
 	}{
@@ -243,7 +244,9 @@ func TestDeleteEnvOpts_Execute(t *testing.T) {
 				return nil
 			},
 			mockStore: func(ctrl *gomock.Controller) *mocks.MockenvironmentStore {
-				return nil
+				store := mocks.NewMockenvironmentStore(ctrl)
+				store.EXPECT().GetEnvironment(testApp, testEnv).Return(&config.Environment{Name: testEnv}, nil)
+				return store
 			},
 			wantedError: errors.New("find service cloudformation stacks: some error"),
 		},
@@ -275,10 +278,58 @@ func TestDeleteEnvOpts_Execute(t *testing.T) {
 				return nil
 			},
 			mockStore: func(ctrl *gomock.Controller) *mocks.MockenvironmentStore {
-				return nil
+				store := mocks.NewMockenvironmentStore(ctrl)
+				store.EXPECT().GetEnvironment(testApp, testEnv).Return(&config.Environment{Name: testEnv}, nil).Times(2)
+				return store
 			},
 			wantedError: errors.New("service 'frontend, backend' still exist within the environment test"),
 		},
+		"deletes running services in a preview environment": {
+			mockRG: func(ctrl *gomock.Controller) *mocks.MockresourceGetter {
+				rg := mocks.NewMockresourceGetter(ctrl)
+				rg.EXPECT().GetResources(gomock.Any()).Return(&resourcegroupstaggingapi.GetResourcesOutput{
+					ResourceTagMappingList: []*resourcegroupstaggingapi.ResourceTagMapping{
+						{
+							Tags: []*resourcegroupstaggingapi.Tag{
+								{
+									Key:   aws.String(deploy.ServiceTagKey),
+									Value: aws.String("frontend"),
+								},
+							},
+						},
+					},
+				}, nil)
+				return rg
+			},
+			mockProg: func(ctrl *gomock.Controller) *mocks.Mockprogress {
+				prog := mocks.NewMockprogress(ctrl)
+				prog.EXPECT().Start(fmt.Sprintf(fmtSvcDeleteStart, "frontend", testEnv))
+				prog.EXPECT().Stop(log.Ssuccessf(fmtSvcDeleteComplete, "frontend", testEnv))
+				prog.EXPECT().Start(fmt.Sprintf(fmtDeleteEnvStart, testEnv, testApp))
+				prog.EXPECT().Stop(log.Ssuccessf(fmtDeleteEnvComplete, testEnv, testApp))
+				return prog
+			},
+			mockDeploy: func(ctrl *gomock.Controller) *mocks.MockenvironmentDeployer {
+				deploy := mocks.NewMockenvironmentDeployer(ctrl)
+				deploy.EXPECT().DeleteEnvironment(testApp, testEnv).Return(nil)
+				return deploy
+			},
+			mockStore: func(ctrl *gomock.Controller) *mocks.MockenvironmentStore {
+				store := mocks.NewMockenvironmentStore(ctrl)
+				store.EXPECT().GetEnvironment(testApp, testEnv).Return(&config.Environment{Name: testEnv, Preview: true}, nil).Times(2)
+				store.EXPECT().DeleteEnvironment(testApp, testEnv).Return(nil)
+				return store
+			},
+			mockSvc: func(ctrl *gomock.Controller) *mocks.MocksvcDeleter {
+				svc := mocks.NewMocksvcDeleter(ctrl)
+				svc.EXPECT().DeleteService(deploy.DeleteServiceInput{
+					Name:    "frontend",
+					EnvName: testEnv,
+					AppName: testApp,
+				}).Return(nil)
+				return svc
+			},
+		},
 		"error from delete stack": {
 			mockRG: func(ctrl *gomock.Controller) *mocks.MockresourceGetter {
 				rg := mocks.NewMockresourceGetter(ctrl)
@@ -298,7 +349,9 @@ func TestDeleteEnvOpts_Execute(t *testing.T) {
 				return deploy
 			},
 			mockStore: func(ctrl *gomock.Controller) *mocks.MockenvironmentStore {
-				return mocks.NewMockenvironmentStore(ctrl)
+				store := mocks.NewMockenvironmentStore(ctrl)
+				store.EXPECT().GetEnvironment(testApp, testEnv).Return(&config.Environment{Name: testEnv}, nil)
+				return store
 			},
 		},
 		"deletes from store if stack deletion succeeds": {
@@ -321,6 +374,7 @@ func TestDeleteEnvOpts_Execute(t *testing.T) {
 			},
 			mockStore: func(ctrl *gomock.Controller) *mocks.MockenvironmentStore {
 				store := mocks.NewMockenvironmentStore(ctrl)
+				store.EXPECT().GetEnvironment(testApp, testEnv).Return(&config.Environment{Name: testEnv}, nil)
 				store.EXPECT().DeleteEnvironment(testApp, testEnv).Return(nil)
 				return store
 			},
@@ -347,6 +401,9 @@ func TestDeleteEnvOpts_Execute(t *testing.T) {
 					return nil
 				},
 			}
+			if tc.mockSvc != nil {
+				opts.svcDeleter = tc.mockSvc(ctrl)
+			}
 
 			// WHEN
 			err := opts.Execute()
@@ -0,0 +1,460 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awscfn "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/profile"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/tags"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/cobra"
+)
+
+const (
+	envDeployNamePrompt = "Which environment would you like to deploy?"
+
+	fmtEnvDeployProfilePrompt  = "Which named profile should we use to deploy %s?"
+	envDeployProfileHelpPrompt = "This is usually the same AWS CLI named profile used to create the environment."
+)
+
+const (
+	defaultFlowLogsAggregationInterval = 600
+	defaultFlowLogsTrafficType         = "ALL"
+)
+
+const (
+	fmtEnvDeployStart    = "Deploying environment %s."
+	fmtEnvDeployFailed   = "Failed to deploy environment %s.\n"
+	fmtEnvDeployComplete = "Deployed environment %s.\n"
+	fmtEnvDeployNoChange = "Environment %s is already up to date.\n"
+)
+
+type deployEnvVars struct {
+	*GlobalOpts
+	name    string
+	profile string
+	dryRun  bool
+	confirm []string
+}
+
+type deployEnvOpts struct {
+	deployEnvVars
+
+	store         store
+	ws            wsEnvironmentManifestReader
+	identity      identityService
+	profileConfig profileNames
+	sel           configSelector
+	prog          progress
+	w             io.Writer
+
+	newEnvDeployer      func(profile string) (environmentStackUpdater, error)
+	newPrefixListGetter func(profile, region string) (prefixListGetter, error)
+	renderTemplate      func(in *deploy.CreateEnvironmentInput) (string, error)
+}
+
+func newDeployEnvOpts(vars deployEnvVars) (*deployEnvOpts, error) {
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("connect to copilot config store: %w", err)
+	}
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace: %w", err)
+	}
+	cfg, err := profile.NewConfig()
+	if err != nil {
+		return nil, err
+	}
+	defaultSession, err := sessions.NewProvider().Default()
+	if err != nil {
+		return nil, err
+	}
+	return &deployEnvOpts{
+		deployEnvVars: vars,
+		store:         store,
+		ws:            ws,
+		identity:      identity.New(defaultSession),
+		profileConfig: cfg,
+		sel:           selector.NewConfigSelect(vars.prompt, store),
+		prog:          termprogress.NewSpinner(),
+		w:             log.OutputWriter,
+		newEnvDeployer: func(profile string) (environmentStackUpdater, error) {
+			sess, err := sessions.NewProvider().FromProfile(profile)
+			if err != nil {
+				return nil, fmt.Errorf("cannot create session from profile %s: %w", profile, err)
+			}
+			return cloudformation.New(sess), nil
+		},
+		newPrefixListGetter: func(profile, region string) (prefixListGetter, error) {
+			sess, err := sessions.NewProvider().FromProfile(profile)
+			if err != nil {
+				return nil, fmt.Errorf("cannot create session from profile %s: %w", profile, err)
+			}
+			return ec2.New(sess.Copy(&aws.Config{
+				Region: aws.String(region),
+			})), nil
+		},
+		renderTemplate: func(in *deploy.CreateEnvironmentInput) (string, error) {
+			return stack.NewEnvStackConfig(in).Template()
+		},
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *deployEnvOpts) Validate() error {
+	if o.AppName() == "" {
+		return errNoAppInWorkspace
+	}
+	if o.name == "" {
+		return nil
+	}
+	if _, err := o.store.GetEnvironment(o.AppName(), o.name); err != nil {
+		return fmt.Errorf("get environment %s configuration: %w", o.name, err)
+	}
+	return nil
+}
+
+// Ask prompts for fields that are required but not passed in.
+func (o *deployEnvOpts) Ask() error {
+	if err := o.askEnvName(); err != nil {
+		return err
+	}
+	return o.askProfile()
+}
+
+// Execute renders the environment stack with the manifest in the workspace, and either prints the changes
+// to the deployed template or updates the stack.
+func (o *deployEnvOpts) Execute() error {
+	raw, err := o.ws.ReadEnvironmentManifest(o.name)
+	if err != nil {
+		return fmt.Errorf("read manifest of environment %s: %w", o.name, err)
+	}
+	mft, err := manifest.UnmarshalEnvironment(raw)
+	if err != nil {
+		return fmt.Errorf("unmarshal manifest of environment %s: %w", o.name, err)
+	}
+	if mft.Name != nil && aws.StringValue(mft.Name) != o.name {
+		return fmt.Errorf("manifest of environment %s is named %s", o.name, aws.StringValue(mft.Name))
+	}
+	app, err := o.store.GetApplication(o.AppName())
+	if err != nil {
+		return fmt.Errorf("get application %s configuration: %w", o.AppName(), err)
+	}
+	env, err := o.store.GetEnvironment(o.AppName(), o.name)
+	if err != nil {
+		return fmt.Errorf("get environment %s configuration: %w", o.name, err)
+	}
+	if !o.dryRun {
+		if err := confirmProtectedEnv(o.prompt, env, o.confirm); err != nil {
+			return err
+		}
+	}
+	caller, err := o.identity.Get()
+	if err != nil {
+		return fmt.Errorf("get identity: %w", err)
+	}
+	conf, err := envCustomConfig(env, mft)
+	if err != nil {
+		return err
+	}
+	in := envDeployInput(app, env, conf, caller.RootUserARN)
+	if conf.CloudFrontOnly {
+		id, err := o.cloudFrontPrefixListID(env.Region)
+		if err != nil {
+			return err
+		}
+		in.CloudFrontPrefixListID = id
+	}
+	deployer, err := o.newEnvDeployer(o.profile)
+	if err != nil {
+		return err
+	}
+	if o.dryRun {
+		return o.printDiff(deployer, in)
+	}
+
+	o.prog.Start(fmt.Sprintf(fmtEnvDeployStart, color.HighlightUserInput(o.name)))
+	if err := deployer.UpdateEnvironment(in); err != nil {
+		var errNoChanges *awscfn.ErrChangeSetEmpty
+		if !errors.As(err, &errNoChanges) {
+			o.prog.Stop(log.Serrorf(fmtEnvDeployFailed, color.HighlightUserInput(o.name)))
+			return fmt.Errorf("deploy environment %s: %w", o.name, err)
+		}
+		o.prog.Stop(log.Ssuccessf(fmtEnvDeployNoChange, color.HighlightUserInput(o.name)))
+	} else {
+		o.prog.Stop(log.Ssuccessf(fmtEnvDeployComplete, color.HighlightUserInput(o.name)))
+	}
+	env.CustomConfig = conf
+	if mft.Protected != nil {
+		env.Protected = aws.BoolValue(mft.Protected)
+	}
+	if err := o.store.UpdateEnvironment(env); err != nil {
+		return fmt.Errorf("update environment %s configuration: %w", o.name, err)
+	}
+	return nil
+}
+
+// cloudFrontPrefixListID returns the ID of the prefix list of CloudFront's origin-facing servers in the region.
+func (o *deployEnvOpts) cloudFrontPrefixListID(region string) (string, error) {
+	getter, err := o.newPrefixListGetter(o.profile, region)
+	if err != nil {
+		return "", err
+	}
+	id, err := getter.ManagedPrefixListID(context.Background(), ec2.CloudFrontOriginFacingPrefixList)
+	if err != nil {
+		return "", fmt.Errorf("get prefix list of CloudFront in region %s: %w", region, err)
+	}
+	return id, nil
+}
+
+func (o *deployEnvOpts) printDiff(deployer environmentStackUpdater, in *deploy.CreateEnvironmentInput) error {
+	deployed, err := deployer.EnvironmentTemplate(o.AppName(), o.name)
+	if err != nil {
+		return fmt.Errorf("get template of environment %s: %w", o.name, err)
+	}
+	rendered, err := o.renderTemplate(in)
+	if err != nil {
+		return fmt.Errorf("render template of environment %s: %w", o.name, err)
+	}
+	if deployed == rendered {
+		log.Infof("No changes to environment %s.\n", color.HighlightUserInput(o.name))
+		return nil
+	}
+	fmt.Fprint(o.w, lineDiff(deployed, rendered))
+	return nil
+}
+
+func (o *deployEnvOpts) askEnvName() error {
+	if o.name != "" {
+		return nil
+	}
+	env, err := o.sel.Environment(envDeployNamePrompt, "", o.AppName())
+	if err != nil {
+		return fmt.Errorf("select environment to deploy: %w", err)
+	}
+	o.name = env
+	return nil
+}
+
+func (o *deployEnvOpts) askProfile() error {
+	if o.profile != "" {
+		return nil
+	}
+	names := o.profileConfig.Names()
+	if len(names) == 0 {
+		return errNamedProfilesNotFound
+	}
+	if len(names) == 1 {
+		o.profile = names[0]
+		log.Infof("Only found one profile, defaulting to: %s\n", color.HighlightUserInput(o.profile))
+		return nil
+	}
+	profile, err := o.prompt.SelectOne(
+		fmt.Sprintf(fmtEnvDeployProfilePrompt, color.HighlightUserInput(o.name)),
+		envDeployProfileHelpPrompt,
+		names)
+	if err != nil {
+		return fmt.Errorf("get the profile name: %w", err)
+	}
+	o.profile = profile
+	return nil
+}
+
+// envCustomConfig returns the configuration that the environment was created with, overridden by the fields set in the manifest.
+func envCustomConfig(env *config.Environment, mft *manifest.Environment) (*config.CustomizeEnv, error) {
+	conf := &config.CustomizeEnv{}
+	if env.CustomConfig != nil {
+		*conf = *env.CustomConfig
+	}
+	if vpc := mft.Network.VPC; vpc != nil {
+		if vpc.IsImported() != (conf.ImportVPC != nil) {
+			return nil, fmt.Errorf("cannot change whether the VPC of environment %s is imported", env.Name)
+		}
+		if vpc.IsImported() {
+			conf.ImportVPC = &config.ImportVPC{
+				ID:               aws.StringValue(vpc.ID),
+				PublicSubnetIDs:  vpc.PublicSubnets,
+				PrivateSubnetIDs: vpc.PrivateSubnets,
+			}
+		} else {
+			conf.VPCConfig = &config.AdjustVPC{
+				CIDR:               stack.DefaultVPCCIDR,
+				PublicSubnetCIDRs:  strings.Split(stack.DefaultPublicSubnetCIDRs, ","),
+				PrivateSubnetCIDRs: strings.Split(stack.DefaultPrivateSubnetCIDRs, ","),
+			}
+			if vpc.CIDR != nil {
+				conf.VPCConfig.CIDR = aws.StringValue(vpc.CIDR)
+			}
+			if len(vpc.PublicSubnets) > 0 {
+				conf.VPCConfig.PublicSubnetCIDRs = vpc.PublicSubnets
+			}
+			if len(vpc.PrivateSubnets) > 0 {
+				conf.VPCConfig.PrivateSubnetCIDRs = vpc.PrivateSubnets
+			}
+		}
+	}
+	if mft.Network.EgressEIPs != nil {
+		if conf.ImportVPC != nil {
+			return nil, fmt.Errorf("cannot attach Elastic IPs to the imported VPC of environment %s", env.Name)
+		}
+		conf.EgressEIPAllocationIDs = mft.Network.EgressEIPs
+	}
+	if mft.Network.FlowLogs != nil {
+		conf.FlowLogs = envFlowLogs(conf.FlowLogs, mft.Network.FlowLogs)
+	}
+	if mft.HTTP.Certificates != nil {
+		if err := validateCertARNs(mft.HTTP.Certificates); err != nil {
+			return nil, fmt.Errorf("validate certificates of environment %s: %w", env.Name, err)
+		}
+		conf.ImportCertARNs = mft.HTTP.Certificates
+	}
+	if mft.HTTP.AccessLogs != nil {
+		conf.AccessLogs = aws.BoolValue(mft.HTTP.AccessLogs)
+	}
+	if mft.HTTP.CloudFrontOnly != nil {
+		conf.CloudFrontOnly = aws.BoolValue(mft.HTTP.CloudFrontOnly)
+	}
+	if conf.CloudFrontOnly && conf.GlobalAccelerator != nil {
+		return nil, fmt.Errorf("cannot restrict the load balancer of environment %s to CloudFront since it's behind a Global Accelerator", env.Name)
+	}
+	if mft.Observability.ContainerInsights != nil {
+		conf.ContainerInsights = aws.BoolValue(mft.Observability.ContainerInsights)
+	}
+	return conf, nil
+}
+
+// envFlowLogs returns the flow logs settings of the manifest applied on top of the current ones, or nil if they're disabled.
+// Flow logs are enabled as soon as the manifest configures them, unless "enabled" is false.
+func envFlowLogs(current *config.FlowLogs, mft *manifest.EnvironmentFlowLogs) *config.FlowLogs {
+	if mft.Enabled != nil && !aws.BoolValue(mft.Enabled) {
+		return nil
+	}
+	flowLogs := &config.FlowLogs{
+		Destination:         manifest.FlowLogsDestinationCloudWatch,
+		AggregationInterval: defaultFlowLogsAggregationInterval,
+		TrafficType:         defaultFlowLogsTrafficType,
+	}
+	if current != nil {
+		*flowLogs = *current
+	}
+	if mft.Destination != nil {
+		flowLogs.Destination = aws.StringValue(mft.Destination)
+	}
+	if mft.AggregationInterval != nil {
+		flowLogs.AggregationInterval = aws.IntValue(mft.AggregationInterval)
+	}
+	if mft.TrafficType != nil {
+		flowLogs.TrafficType = aws.StringValue(mft.TrafficType)
+	}
+	return flowLogs
+}
+
+// envDeployInput returns the input to update the stack of an existing environment with the configuration.
+func envDeployInput(app *config.Application, env *config.Environment, conf *config.CustomizeEnv, toolsAccountPrincipalARN string) *deploy.CreateEnvironmentInput {
+	in := &deploy.CreateEnvironmentInput{
+		Name:                     env.Name,
+		AppName:                  app.Name,
+		Prod:                     env.Prod,
+		PublicLoadBalancer:       true,
+		ToolsAccountPrincipalARN: toolsAccountPrincipalARN,
+		AppDNSName:               app.Domain,
+		AdditionalTags:           app.Tags,
+		EgressEIPAllocationIDs:   conf.EgressEIPAllocationIDs,
+		ContainerInsights:        conf.ContainerInsights,
+		ImportCertARNs:           conf.ImportCertARNs,
+		AccessLogs:               conf.AccessLogs,
+	}
+	if env.ExpiresAt != nil {
+		in.AdditionalTags = tags.Merge(app.Tags, map[string]string{
+			deploy.EnvExpiryTagKey: env.ExpiresAt.Format(time.RFC3339),
+		})
+	}
+	if conf.ImportVPC != nil {
+		in.ImportVPCConfig = &deploy.ImportVPCConfig{
+			ID:               conf.ImportVPC.ID,
+			PublicSubnetIDs:  conf.ImportVPC.PublicSubnetIDs,
+			PrivateSubnetIDs: conf.ImportVPC.PrivateSubnetIDs,
+		}
+	}
+	if conf.VPCConfig != nil {
+		in.AdjustVPCConfig = &deploy.AdjustVPCConfig{
+			CIDR:               conf.VPCConfig.CIDR,
+			PublicSubnetCIDRs:  conf.VPCConfig.PublicSubnetCIDRs,
+			PrivateSubnetCIDRs: conf.VPCConfig.PrivateSubnetCIDRs,
+		}
+	}
+	if conf.GlobalAccelerator != nil {
+		in.GlobalAcceleratorConfig = &deploy.GlobalAcceleratorConfig{
+			HealthCheckPath:            conf.GlobalAccelerator.HealthCheckPath,
+			HealthCheckIntervalSeconds: conf.GlobalAccelerator.HealthCheckInterval,
+		}
+	}
+	if conf.FlowLogs != nil {
+		in.FlowLogsConfig = &deploy.FlowLogsConfig{
+			Destination:                conf.FlowLogs.Destination,
+			AggregationIntervalSeconds: conf.FlowLogs.AggregationInterval,
+			TrafficType:                conf.FlowLogs.TrafficType,
+		}
+	}
+	return in
+}
+
+// BuildEnvDeployCmd builds the command to deploy the manifest of an environment.
+func BuildEnvDeployCmd() *cobra.Command {
+	vars := deployEnvVars{
+		GlobalOpts: NewGlobalOpts(),
+	}
+	cmd := &cobra.Command{
+		Use:   "deploy",
+		Short: "Deploys the manifest of an environment in your workspace.",
+		Long: `Deploys the manifest of an environment in your workspace.
+The manifest of an environment is under copilot/environments/{name}.yml.
+Fields that are not set keep the configuration that the environment was created with.`,
+		Example: `
+  Prints the changes to the "test" environment's stack without deploying them.
+  /code $ copilot env deploy --name test --profile default --dry-run
+
+  Deploys the "test" environment.
+  /code $ copilot env deploy --name test --profile default`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newDeployEnvOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVar(&vars.profile, profileFlag, "", profileFlagDescription)
+	cmd.Flags().BoolVar(&vars.dryRun, dryRunFlag, false, envDeployDryRunFlagDescription)
+	cmd.Flags().StringSliceVar(&vars.confirm, confirmFlag, nil, confirmFlagDescription)
+	return cmd
+}
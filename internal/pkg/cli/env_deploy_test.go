@@ -0,0 +1,426 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awscfn "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type deployEnvMocks struct {
+	store    *mocks.Mockstore
+	ws       *mocks.MockwsEnvironmentManifestReader
+	identity *mocks.MockidentityService
+	deployer *mocks.MockenvironmentStackUpdater
+	prog     *mocks.Mockprogress
+	prefixes *mocks.MockprefixListGetter
+}
+
+func TestDeployEnvOpts_Execute(t *testing.T) {
+	const (
+		testAppName = "phonetool"
+		testEnvName = "test"
+	)
+	testApp := &config.Application{Name: testAppName, Domain: "example.com"}
+	testEnv := func() *config.Environment {
+		return &config.Environment{
+			App:  testAppName,
+			Name: testEnvName,
+			CustomConfig: &config.CustomizeEnv{
+				VPCConfig: &config.AdjustVPC{
+					CIDR:               "10.1.0.0/16",
+					PublicSubnetCIDRs:  []string{"10.1.0.0/24", "10.1.1.0/24"},
+					PrivateSubnetCIDRs: []string{"10.1.2.0/24", "10.1.3.0/24"},
+				},
+			},
+		}
+	}
+	wantedInput := &deploy.CreateEnvironmentInput{
+		Name:                     testEnvName,
+		AppName:                  testAppName,
+		PublicLoadBalancer:       true,
+		ToolsAccountPrincipalARN: "arn:aws:iam::123456789012:root",
+		AppDNSName:               "example.com",
+		AdjustVPCConfig: &deploy.AdjustVPCConfig{
+			CIDR:               "10.1.0.0/16",
+			PublicSubnetCIDRs:  []string{"10.1.0.0/24", "10.1.1.0/24"},
+			PrivateSubnetCIDRs: []string{"10.1.2.0/24", "10.1.3.0/24"},
+		},
+		ContainerInsights: true,
+	}
+	mockManifest := []byte(`name: test
+observability:
+  container_insights: true`)
+	expectReadConfig := func(m *deployEnvMocks) {
+		m.ws.EXPECT().ReadEnvironmentManifest(testEnvName).Return(mockManifest, nil)
+		m.store.EXPECT().GetApplication(testAppName).Return(testApp, nil)
+		m.store.EXPECT().GetEnvironment(testAppName, testEnvName).Return(testEnv(), nil)
+		m.identity.EXPECT().Get().Return(identity.Caller{RootUserARN: "arn:aws:iam::123456789012:root"}, nil)
+	}
+	testCases := map[string]struct {
+		inDryRun      bool
+		setupMocks    func(m *deployEnvMocks)
+		inRenderedTpl string
+
+		wantedOutput string
+		wantedErr    error
+	}{
+		"errors if the manifest isn't in the workspace": {
+			setupMocks: func(m *deployEnvMocks) {
+				m.ws.EXPECT().ReadEnvironmentManifest(testEnvName).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("read manifest of environment test: some error"),
+		},
+		"errors if the manifest is named after another environment": {
+			setupMocks: func(m *deployEnvMocks) {
+				m.ws.EXPECT().ReadEnvironmentManifest(testEnvName).Return([]byte("name: prod"), nil)
+			},
+			wantedErr: errors.New("manifest of environment test is named prod"),
+		},
+		"prints the changes to the template on a dry run": {
+			inDryRun: true,
+			setupMocks: func(m *deployEnvMocks) {
+				expectReadConfig(m)
+				m.deployer.EXPECT().EnvironmentTemplate(testAppName, testEnvName).Return("Resources:\n  Cluster: {}\n", nil)
+				m.deployer.EXPECT().UpdateEnvironment(gomock.Any()).Times(0)
+				m.store.EXPECT().UpdateEnvironment(gomock.Any()).Times(0)
+			},
+			inRenderedTpl: "Resources:\n  Cluster:\n    ClusterSettings: []\n",
+
+			wantedOutput: "  Resources:\n+   Cluster:\n+     ClusterSettings: []\n-   Cluster: {}\n\n",
+		},
+		"wraps the error if the deployment fails": {
+			setupMocks: func(m *deployEnvMocks) {
+				expectReadConfig(m)
+				m.prog.EXPECT().Start(gomock.Any())
+				m.deployer.EXPECT().UpdateEnvironment(wantedInput).Return(errors.New("some error"))
+				m.prog.EXPECT().Stop(gomock.Any())
+			},
+			wantedErr: errors.New("deploy environment test: some error"),
+		},
+		"updates the stored configuration even if the stack doesn't change": {
+			setupMocks: func(m *deployEnvMocks) {
+				expectReadConfig(m)
+				m.prog.EXPECT().Start(gomock.Any())
+				m.deployer.EXPECT().UpdateEnvironment(wantedInput).Return(&awscfn.ErrChangeSetEmpty{})
+				m.prog.EXPECT().Stop(gomock.Any())
+				m.store.EXPECT().UpdateEnvironment(gomock.Any()).Return(nil)
+			},
+		},
+		"restricts the ingress of the load balancer to CloudFront": {
+			setupMocks: func(m *deployEnvMocks) {
+				m.ws.EXPECT().ReadEnvironmentManifest(testEnvName).Return([]byte(`http:
+  cloudfront_only: true`), nil)
+				m.store.EXPECT().GetApplication(testAppName).Return(testApp, nil)
+				env := testEnv()
+				env.Region = "us-west-2"
+				m.store.EXPECT().GetEnvironment(testAppName, testEnvName).Return(env, nil)
+				m.identity.EXPECT().Get().Return(identity.Caller{RootUserARN: "arn:aws:iam::123456789012:root"}, nil)
+				m.prefixes.EXPECT().ManagedPrefixListID(gomock.Any(), "com.amazonaws.global.cloudfront.origin-facing").Return("pl-82a045eb", nil)
+				m.prog.EXPECT().Start(gomock.Any())
+				m.deployer.EXPECT().UpdateEnvironment(gomock.Any()).DoAndReturn(func(in *deploy.CreateEnvironmentInput) error {
+					require.Equal(t, "pl-82a045eb", in.CloudFrontPrefixListID)
+					return nil
+				})
+				m.prog.EXPECT().Stop(gomock.Any())
+				m.store.EXPECT().UpdateEnvironment(gomock.Any()).DoAndReturn(func(env *config.Environment) error {
+					require.True(t, env.CustomConfig.CloudFrontOnly)
+					return nil
+				})
+			},
+		},
+		"wraps the error if the prefix list of CloudFront can't be found": {
+			setupMocks: func(m *deployEnvMocks) {
+				m.ws.EXPECT().ReadEnvironmentManifest(testEnvName).Return([]byte(`http:
+  cloudfront_only: true`), nil)
+				m.store.EXPECT().GetApplication(testAppName).Return(testApp, nil)
+				env := testEnv()
+				env.Region = "us-west-2"
+				m.store.EXPECT().GetEnvironment(testAppName, testEnvName).Return(env, nil)
+				m.identity.EXPECT().Get().Return(identity.Caller{RootUserARN: "arn:aws:iam::123456789012:root"}, nil)
+				m.prefixes.EXPECT().ManagedPrefixListID(gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("get prefix list of CloudFront in region us-west-2: some error"),
+		},
+		"wraps the error if the configuration of the environment can't be stored": {
+			setupMocks: func(m *deployEnvMocks) {
+				expectReadConfig(m)
+				m.prog.EXPECT().Start(gomock.Any())
+				m.deployer.EXPECT().UpdateEnvironment(wantedInput).Return(nil)
+				m.prog.EXPECT().Stop(gomock.Any())
+				env := testEnv()
+				env.CustomConfig.ContainerInsights = true
+				m.store.EXPECT().UpdateEnvironment(env).Return(errors.New("some error"))
+			},
+			wantedErr: errors.New("update environment test configuration: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := &deployEnvMocks{
+				store:    mocks.NewMockstore(ctrl),
+				ws:       mocks.NewMockwsEnvironmentManifestReader(ctrl),
+				identity: mocks.NewMockidentityService(ctrl),
+				deployer: mocks.NewMockenvironmentStackUpdater(ctrl),
+				prog:     mocks.NewMockprogress(ctrl),
+				prefixes: mocks.NewMockprefixListGetter(ctrl),
+			}
+			tc.setupMocks(m)
+			buf := new(bytes.Buffer)
+			opts := &deployEnvOpts{
+				deployEnvVars: deployEnvVars{
+					GlobalOpts: &GlobalOpts{appName: testAppName},
+					name:       testEnvName,
+					profile:    "default",
+					dryRun:     tc.inDryRun,
+				},
+				store:    m.store,
+				ws:       m.ws,
+				identity: m.identity,
+				prog:     m.prog,
+				w:        buf,
+				newEnvDeployer: func(profile string) (environmentStackUpdater, error) {
+					return m.deployer, nil
+				},
+				newPrefixListGetter: func(profile, region string) (prefixListGetter, error) {
+					require.Equal(t, "us-west-2", region)
+					return m.prefixes, nil
+				},
+				renderTemplate: func(in *deploy.CreateEnvironmentInput) (string, error) {
+					require.Equal(t, wantedInput, in)
+					return tc.inRenderedTpl, nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedOutput, buf.String())
+		})
+	}
+}
+
+func TestEnvCustomConfig(t *testing.T) {
+	testCases := map[string]struct {
+		inConfig   *config.CustomizeEnv
+		inManifest *manifest.Environment
+
+		wantedConfig *config.CustomizeEnv
+		wantedErr    error
+	}{
+		"keeps the configuration the environment was created with": {
+			inConfig: &config.CustomizeEnv{
+				ImportVPC: &config.ImportVPC{
+					ID: "vpc-0123",
+				},
+			},
+			inManifest: &manifest.Environment{},
+			wantedConfig: &config.CustomizeEnv{
+				ImportVPC: &config.ImportVPC{
+					ID: "vpc-0123",
+				},
+			},
+		},
+		"defaults the CIDR ranges of the VPC": {
+			inManifest: &manifest.Environment{
+				Network: manifest.EnvironmentNetwork{
+					VPC: &manifest.EnvironmentVPC{
+						CIDR: aws.String("10.1.0.0/16"),
+					},
+					EgressEIPs: []string{"eipalloc-1", "eipalloc-2"},
+				},
+			},
+			wantedConfig: &config.CustomizeEnv{
+				VPCConfig: &config.AdjustVPC{
+					CIDR:               "10.1.0.0/16",
+					PublicSubnetCIDRs:  []string{"10.0.0.0/24", "10.0.1.0/24"},
+					PrivateSubnetCIDRs: []string{"10.0.2.0/24", "10.0.3.0/24"},
+				},
+				EgressEIPAllocationIDs: []string{"eipalloc-1", "eipalloc-2"},
+			},
+		},
+		"errors if an imported VPC would be replaced": {
+			inConfig: &config.CustomizeEnv{
+				ImportVPC: &config.ImportVPC{
+					ID: "vpc-0123",
+				},
+			},
+			inManifest: &manifest.Environment{
+				Network: manifest.EnvironmentNetwork{
+					VPC: &manifest.EnvironmentVPC{
+						CIDR: aws.String("10.1.0.0/16"),
+					},
+				},
+			},
+			wantedErr: fmt.Errorf("cannot change whether the VPC of environment test is imported"),
+		},
+		"errors if Elastic IPs are attached to an imported VPC": {
+			inConfig: &config.CustomizeEnv{
+				ImportVPC: &config.ImportVPC{
+					ID: "vpc-0123",
+				},
+			},
+			inManifest: &manifest.Environment{
+				Network: manifest.EnvironmentNetwork{
+					EgressEIPs: []string{"eipalloc-1"},
+				},
+			},
+			wantedErr: fmt.Errorf("cannot attach Elastic IPs to the imported VPC of environment test"),
+		},
+		"replaces the imported certificates": {
+			inConfig: &config.CustomizeEnv{
+				ImportCertARNs: []string{"arn:aws:acm:us-west-2:123456789012:certificate/old"},
+			},
+			inManifest: &manifest.Environment{
+				HTTP: manifest.EnvironmentHTTP{
+					Certificates: []string{"arn:aws:acm:us-west-2:123456789012:certificate/new"},
+				},
+			},
+			wantedConfig: &config.CustomizeEnv{
+				ImportCertARNs: []string{"arn:aws:acm:us-west-2:123456789012:certificate/new"},
+			},
+		},
+		"stores the access logs of the load balancer": {
+			inManifest: &manifest.Environment{
+				HTTP: manifest.EnvironmentHTTP{
+					AccessLogs: aws.Bool(true),
+				},
+			},
+			wantedConfig: &config.CustomizeEnv{
+				AccessLogs: true,
+			},
+		},
+		"restricts the load balancer to CloudFront": {
+			inConfig: &config.CustomizeEnv{
+				AccessLogs: true,
+			},
+			inManifest: &manifest.Environment{
+				HTTP: manifest.EnvironmentHTTP{
+					CloudFrontOnly: aws.Bool(true),
+				},
+			},
+			wantedConfig: &config.CustomizeEnv{
+				AccessLogs:     true,
+				CloudFrontOnly: true,
+			},
+		},
+		"errors if the load balancer is restricted to CloudFront behind a Global Accelerator": {
+			inConfig: &config.CustomizeEnv{
+				GlobalAccelerator: &config.GlobalAccelerator{
+					HealthCheckPath: "/",
+				},
+			},
+			inManifest: &manifest.Environment{
+				HTTP: manifest.EnvironmentHTTP{
+					CloudFrontOnly: aws.Bool(true),
+				},
+			},
+			wantedErr: errors.New("cannot restrict the load balancer of environment test to CloudFront since it's behind a Global Accelerator"),
+		},
+		"enables the flow logs of the VPC with default settings": {
+			inManifest: &manifest.Environment{
+				Network: manifest.EnvironmentNetwork{
+					FlowLogs: &manifest.EnvironmentFlowLogs{
+						TrafficType: aws.String("REJECT"),
+					},
+				},
+			},
+			wantedConfig: &config.CustomizeEnv{
+				FlowLogs: &config.FlowLogs{
+					Destination:         "cloudwatch",
+					AggregationInterval: 600,
+					TrafficType:         "REJECT",
+				},
+			},
+		},
+		"keeps the flow logs settings that the manifest doesn't override": {
+			inConfig: &config.CustomizeEnv{
+				FlowLogs: &config.FlowLogs{
+					Destination:         "s3",
+					AggregationInterval: 60,
+					TrafficType:         "ALL",
+				},
+			},
+			inManifest: &manifest.Environment{
+				Network: manifest.EnvironmentNetwork{
+					FlowLogs: &manifest.EnvironmentFlowLogs{
+						AggregationInterval: aws.Int(600),
+					},
+				},
+			},
+			wantedConfig: &config.CustomizeEnv{
+				FlowLogs: &config.FlowLogs{
+					Destination:         "s3",
+					AggregationInterval: 600,
+					TrafficType:         "ALL",
+				},
+			},
+		},
+		"disables the flow logs of the VPC": {
+			inConfig: &config.CustomizeEnv{
+				FlowLogs: &config.FlowLogs{
+					Destination:         "s3",
+					AggregationInterval: 60,
+					TrafficType:         "ALL",
+				},
+			},
+			inManifest: &manifest.Environment{
+				Network: manifest.EnvironmentNetwork{
+					FlowLogs: &manifest.EnvironmentFlowLogs{
+						Enabled: aws.Bool(false),
+					},
+				},
+			},
+			wantedConfig: &config.CustomizeEnv{},
+		},
+		"errors if a certificate isn't an ACM certificate ARN": {
+			inManifest: &manifest.Environment{
+				HTTP: manifest.EnvironmentHTTP{
+					Certificates: []string{"arn:aws:iam::123456789012:server-certificate/test"},
+				},
+			},
+			wantedErr: fmt.Errorf("validate certificates of environment test: arn:aws:iam::123456789012:server-certificate/test is not an ACM certificate ARN"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			conf, err := envCustomConfig(&config.Environment{
+				Name:         "test",
+				CustomConfig: tc.inConfig,
+			}, tc.inManifest)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedConfig, conf)
+		})
+	}
+}
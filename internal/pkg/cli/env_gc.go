@@ -0,0 +1,222 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/profile"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	envGCAppNamePrompt     = "Which application's expired environments would you like to delete?"
+	envGCAppNameHelpPrompt = "An application is a collection of related services."
+	envGCProfilePrompt     = "Which named profile should we use to delete the expired environments?"
+
+	fmtEnvGCConfirmPrompt = "Are you sure you want to delete expired environments %s from application %s?"
+)
+
+var (
+	errEnvGCCancelled = errors.New("env gc cancelled - no changes made")
+)
+
+type gcEnvVars struct {
+	*GlobalOpts
+	EnvProfile       string
+	SkipConfirmation bool
+	DryRun           bool
+}
+
+type gcEnvOpts struct {
+	gcEnvVars
+
+	// Interfaces for dependencies.
+	store         environmentLister
+	profileConfig profileNames
+	sel           appSelector
+	w             io.Writer
+
+	now           func() time.Time
+	newEnvDeleter func(envName, profile string) (executor, error)
+
+	// Cached variables.
+	expiredEnvs []*config.Environment
+}
+
+func newGCEnvOpts(vars gcEnvVars) (*gcEnvOpts, error) {
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("connect to copilot config store: %w", err)
+	}
+	cfg, err := profile.NewConfig()
+	if err != nil {
+		return nil, fmt.Errorf("read named profiles: %w", err)
+	}
+	return &gcEnvOpts{
+		gcEnvVars:     vars,
+		store:         store,
+		profileConfig: cfg,
+		sel:           selector.NewSelect(vars.prompt, store),
+		w:             os.Stdout,
+		now:           time.Now,
+		newEnvDeleter: func(envName, profile string) (executor, error) {
+			return newDeleteEnvOpts(deleteEnvVars{
+				GlobalOpts:       vars.GlobalOpts,
+				EnvName:          envName,
+				EnvProfile:       profile,
+				SkipConfirmation: true,
+			})
+		},
+	}, nil
+}
+
+// Ask prompts for fields that are required but not passed in.
+func (o *gcEnvOpts) Ask() error {
+	if err := o.askAppName(); err != nil {
+		return err
+	}
+	envs, err := o.listExpiredEnvs()
+	if err != nil {
+		return err
+	}
+	o.expiredEnvs = envs
+	if len(o.expiredEnvs) == 0 || o.DryRun {
+		return nil
+	}
+	if err := o.askProfile(); err != nil {
+		return err
+	}
+
+	if o.SkipConfirmation {
+		return nil
+	}
+	var names []string
+	for _, env := range o.expiredEnvs {
+		names = append(names, env.Name)
+	}
+	deleteConfirmed, err := o.prompt.Confirm(fmt.Sprintf(fmtEnvGCConfirmPrompt, strings.Join(names, ", "), o.AppName()), "")
+	if err != nil {
+		return fmt.Errorf("confirm to delete expired environments: %w", err)
+	}
+	if !deleteConfirmed {
+		return errEnvGCCancelled
+	}
+	return nil
+}
+
+// Execute deletes every expired environment of the application along with its services.
+func (o *gcEnvOpts) Execute() error {
+	if len(o.expiredEnvs) == 0 {
+		log.Infof("No expired environments found in application %s.\n", color.HighlightUserInput(o.AppName()))
+		return nil
+	}
+	if o.DryRun {
+		for _, env := range o.expiredEnvs {
+			fmt.Fprintf(o.w, "%s (expired at %s)\n", env.Name, env.ExpiresAt.Format(time.RFC3339))
+		}
+		return nil
+	}
+	for _, env := range o.expiredEnvs {
+		deleter, err := o.newEnvDeleter(env.Name, o.EnvProfile)
+		if err != nil {
+			return err
+		}
+		if err := deleter.Execute(); err != nil {
+			return fmt.Errorf("delete environment %s: %w", env.Name, err)
+		}
+	}
+	return nil
+}
+
+func (o *gcEnvOpts) askAppName() error {
+	if o.AppName() != "" {
+		return nil
+	}
+	app, err := o.sel.Application(envGCAppNamePrompt, envGCAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *gcEnvOpts) askProfile() error {
+	if o.EnvProfile != "" {
+		return nil
+	}
+
+	names := o.profileConfig.Names()
+	if len(names) == 0 {
+		return errNamedProfilesNotFound
+	}
+	if len(names) == 1 {
+		o.EnvProfile = names[0]
+		log.Infof("Only found one profile, defaulting to: %s\n", color.HighlightUserInput(o.EnvProfile))
+		return nil
+	}
+
+	profile, err := o.prompt.SelectOne(envGCProfilePrompt, envDeleteProfileHelpPrompt, names)
+	if err != nil {
+		return fmt.Errorf("get the profile name: %w", err)
+	}
+	o.EnvProfile = profile
+	return nil
+}
+
+func (o *gcEnvOpts) listExpiredEnvs() ([]*config.Environment, error) {
+	envs, err := o.store.ListEnvironments(o.AppName())
+	if err != nil {
+		return nil, fmt.Errorf("list environments in application %s: %w", o.AppName(), err)
+	}
+	now := o.now()
+	var expired []*config.Environment
+	for _, env := range envs {
+		if env.IsExpired(now) {
+			expired = append(expired, env)
+		}
+	}
+	return expired, nil
+}
+
+// BuildEnvGCCmd builds the command to delete expired environments.
+func BuildEnvGCCmd() *cobra.Command {
+	vars := gcEnvVars{
+		GlobalOpts: NewGlobalOpts(),
+	}
+	cmd := &cobra.Command{
+		Use:   "gc",
+		Short: "Deletes the expired environments of your application.",
+		Long: `Deletes the expired environments of your application along with their services.
+Environments expire once the duration passed to "env init --ttl" elapses.`,
+		Example: `
+  Lists the expired environments without deleting them.
+  /code $ copilot env gc --dry-run
+  Deletes the expired environments using your "default" AWS profile without prompting.
+  /code $ copilot env gc --profile default --yes`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newGCEnvOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVar(&vars.EnvProfile, profileFlag, "", profileFlagDescription)
+	cmd.Flags().BoolVar(&vars.SkipConfirmation, yesFlag, false, yesFlagDescription)
+	cmd.Flags().BoolVar(&vars.DryRun, dryRunFlag, false, dryRunFlagDescription)
+	return cmd
+}
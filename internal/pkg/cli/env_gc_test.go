@@ -0,0 +1,231 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGCEnvOpts_Ask(t *testing.T) {
+	const testApp = "phonetool"
+	now := time.Date(2020, 8, 1, 12, 0, 0, 0, time.UTC)
+	expired := now.Add(-time.Hour)
+	notExpired := now.Add(time.Hour)
+
+	testCases := map[string]struct {
+		inAppName          string
+		inProfile          string
+		inSkipConfirmation bool
+		inDryRun           bool
+
+		mockDependencies func(ctrl *gomock.Controller, o *gcEnvOpts)
+
+		wantedEnvs    []string
+		wantedProfile string
+		wantedError   error
+	}{
+		"prompts for application, profile and confirmation": {
+			mockDependencies: func(ctrl *gomock.Controller, o *gcEnvOpts) {
+				mockSelector := mocks.NewMockappSelector(ctrl)
+				mockSelector.EXPECT().Application(envGCAppNamePrompt, envGCAppNameHelpPrompt).Return(testApp, nil)
+				mockStore := mocks.NewMockenvironmentLister(ctrl)
+				mockStore.EXPECT().ListEnvironments(testApp).Return([]*config.Environment{
+					{Name: "test"},
+					{Name: "pr-1", ExpiresAt: &expired},
+					{Name: "pr-2", ExpiresAt: &notExpired},
+				}, nil)
+				mockCfg := mocks.NewMockprofileNames(ctrl)
+				mockCfg.EXPECT().Names().Return([]string{"default", "sandbox"})
+				mockPrompter := mocks.NewMockprompter(ctrl)
+				mockPrompter.EXPECT().SelectOne(envGCProfilePrompt, envDeleteProfileHelpPrompt, []string{"default", "sandbox"}).Return("sandbox", nil)
+				mockPrompter.EXPECT().Confirm(fmt.Sprintf(fmtEnvGCConfirmPrompt, "pr-1", testApp), "").Return(true, nil)
+
+				o.sel = mockSelector
+				o.store = mockStore
+				o.profileConfig = mockCfg
+				o.prompt = mockPrompter
+			},
+			wantedEnvs:    []string{"pr-1"},
+			wantedProfile: "sandbox",
+		},
+		"skips prompting when there are no expired environments": {
+			inAppName: testApp,
+			mockDependencies: func(ctrl *gomock.Controller, o *gcEnvOpts) {
+				mockStore := mocks.NewMockenvironmentLister(ctrl)
+				mockStore.EXPECT().ListEnvironments(testApp).Return([]*config.Environment{
+					{Name: "pr-2", ExpiresAt: &notExpired},
+				}, nil)
+				o.store = mockStore
+			},
+		},
+		"skips prompting for profile and confirmation on dry run": {
+			inAppName: testApp,
+			inDryRun:  true,
+			mockDependencies: func(ctrl *gomock.Controller, o *gcEnvOpts) {
+				mockStore := mocks.NewMockenvironmentLister(ctrl)
+				mockStore.EXPECT().ListEnvironments(testApp).Return([]*config.Environment{
+					{Name: "pr-1", ExpiresAt: &expired},
+				}, nil)
+				o.store = mockStore
+			},
+			wantedEnvs: []string{"pr-1"},
+		},
+		"errors if failed to list environments": {
+			inAppName: testApp,
+			mockDependencies: func(ctrl *gomock.Controller, o *gcEnvOpts) {
+				mockStore := mocks.NewMockenvironmentLister(ctrl)
+				mockStore.EXPECT().ListEnvironments(testApp).Return(nil, errors.New("some error"))
+				o.store = mockStore
+			},
+			wantedError: errors.New("list environments in application phonetool: some error"),
+		},
+		"errors if deletion is not confirmed": {
+			inAppName: testApp,
+			inProfile: "default",
+			mockDependencies: func(ctrl *gomock.Controller, o *gcEnvOpts) {
+				mockStore := mocks.NewMockenvironmentLister(ctrl)
+				mockStore.EXPECT().ListEnvironments(testApp).Return([]*config.Environment{
+					{Name: "pr-1", ExpiresAt: &expired},
+				}, nil)
+				mockPrompter := mocks.NewMockprompter(ctrl)
+				mockPrompter.EXPECT().Confirm(fmt.Sprintf(fmtEnvGCConfirmPrompt, "pr-1", testApp), "").Return(false, nil)
+				o.store = mockStore
+				o.prompt = mockPrompter
+			},
+			wantedError: errEnvGCCancelled,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			opts := &gcEnvOpts{
+				gcEnvVars: gcEnvVars{
+					GlobalOpts: &GlobalOpts{
+						appName: tc.inAppName,
+					},
+					EnvProfile:       tc.inProfile,
+					SkipConfirmation: tc.inSkipConfirmation,
+					DryRun:           tc.inDryRun,
+				},
+				now: func() time.Time {
+					return now
+				},
+			}
+			tc.mockDependencies(ctrl, opts)
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			var names []string
+			for _, env := range opts.expiredEnvs {
+				names = append(names, env.Name)
+			}
+			require.Equal(t, tc.wantedEnvs, names)
+			if tc.wantedProfile != "" {
+				require.Equal(t, tc.wantedProfile, opts.EnvProfile)
+			}
+		})
+	}
+}
+
+func TestGCEnvOpts_Execute(t *testing.T) {
+	expired := time.Date(2020, 8, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		inEnvs   []*config.Environment
+		inDryRun bool
+
+		mockDeleter func(m *mocks.Mockexecutor)
+
+		wantedDeleted []string
+		wantedOutput  string
+		wantedError   error
+	}{
+		"lists expired environments on dry run": {
+			inEnvs:       []*config.Environment{{Name: "pr-1", ExpiresAt: &expired}},
+			inDryRun:     true,
+			mockDeleter:  func(m *mocks.Mockexecutor) {},
+			wantedOutput: "pr-1 (expired at 2020-08-01T12:00:00Z)\n",
+		},
+		"deletes every expired environment": {
+			inEnvs: []*config.Environment{
+				{Name: "pr-1", ExpiresAt: &expired},
+				{Name: "pr-2", ExpiresAt: &expired},
+			},
+			mockDeleter: func(m *mocks.Mockexecutor) {
+				m.EXPECT().Execute().Return(nil).Times(2)
+			},
+			wantedDeleted: []string{"pr-1", "pr-2"},
+		},
+		"stops at the first failed deletion": {
+			inEnvs: []*config.Environment{
+				{Name: "pr-1", ExpiresAt: &expired},
+				{Name: "pr-2", ExpiresAt: &expired},
+			},
+			mockDeleter: func(m *mocks.Mockexecutor) {
+				m.EXPECT().Execute().Return(errors.New("some error"))
+			},
+			wantedDeleted: []string{"pr-1"},
+			wantedError:   errors.New("delete environment pr-1: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockDeleter := mocks.NewMockexecutor(ctrl)
+			tc.mockDeleter(mockDeleter)
+			b := &bytes.Buffer{}
+			var deleted []string
+			opts := &gcEnvOpts{
+				gcEnvVars: gcEnvVars{
+					GlobalOpts: &GlobalOpts{
+						appName: "phonetool",
+					},
+					EnvProfile: "default",
+					DryRun:     tc.inDryRun,
+				},
+				w: b,
+				newEnvDeleter: func(envName, profile string) (executor, error) {
+					require.Equal(t, "default", profile)
+					deleted = append(deleted, envName)
+					return mockDeleter, nil
+				},
+				expiredEnvs: tc.inEnvs,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.wantedDeleted, deleted)
+			require.Equal(t, tc.wantedOutput, b.String())
+		})
+	}
+}
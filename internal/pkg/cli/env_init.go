@@ -4,12 +4,16 @@
 package cli
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
@@ -17,6 +21,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/aws/profile"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/tags"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	deploycfn "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
@@ -26,6 +31,7 @@ import (
 	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 )
@@ -50,19 +56,35 @@ const (
 	envInitPrivateCIDRPrompt     = "What CIDR would you like to use for your private subnets?"
 	envInitPrivateCIDRPromptHelp = "CIDRs used for your private subnets. For example: 10.1.2.0/24,10.1.3.0/24"
 
-	fmtEnvInitProfilePrompt  = "Which named profile should we use to create %s?"
-	fmtDeployEnvStart        = "Proposing infrastructure changes for the %s environment."
-	fmtDeployEnvComplete     = "Environment %s already exists in application %s.\n"
-	fmtDeployEnvFailed       = "Failed to accept changes for the %s environment.\n"
-	fmtDNSDelegationStart    = "Sharing DNS permissions for this application to account %s."
-	fmtDNSDelegationFailed   = "Failed to grant DNS permissions to account %s.\n"
-	fmtDNSDelegationComplete = "Shared DNS permissions for this application to account %s.\n"
-	fmtStreamEnvStart        = "Creating the infrastructure for the %s environment."
-	fmtStreamEnvFailed       = "Failed to create the infrastructure for the %s environment.\n"
-	fmtStreamEnvComplete     = "Created the infrastructure for the %s environment.\n"
-	fmtAddEnvToAppStart      = "Linking account %s and region %s to application %s."
-	fmtAddEnvToAppFailed     = "Failed to link account %s and region %s to application %s.\n"
-	fmtAddEnvToAppComplete   = "Linked account %s and region %s to application %s.\n"
+	defaultAcceleratorHealthCheckPath     = "/"
+	defaultAcceleratorHealthCheckInterval = 30
+
+	fmtEnvInitProfilePrompt    = "Which named profile should we use to create %s?"
+	fmtEnvInitRegionPrompt     = "Which region should we create %s in?"
+	envInitRegionHelpPrompt    = "The AWS region where the environment will be created. For example: us-west-2"
+	fmtEnvInitCloneImportVPC   = "Environment %s imports VPC %s, which isn't available in the account and region of %s.\n"
+	fmtEnvInitCloneImportCerts = "Environment %s imports certificates that aren't available in the account and region of %s, use --import-cert-arns to import others.\n"
+	fmtEnvInitMissingEndpoints = "VPC %s has no public subnets imported and is missing the VPC endpoints for %s, services may fail to pull images, write logs or read secrets.\n"
+	fmtEnvInitNoEgress         = "Private subnets %s have no route to the internet, services in them can only reach AWS through VPC endpoints.\n"
+	fmtDeployEnvStart          = "Proposing infrastructure changes for the %s environment."
+	fmtDeployEnvComplete       = "Environment %s already exists in application %s.\n"
+	fmtDeployEnvFailed         = "Failed to accept changes for the %s environment.\n"
+	fmtDNSDelegationStart      = "Sharing DNS permissions for this application to account %s."
+	fmtDNSDelegationFailed     = "Failed to grant DNS permissions to account %s.\n"
+	fmtDNSDelegationComplete   = "Shared DNS permissions for this application to account %s.\n"
+	fmtStreamEnvStart          = "Creating the infrastructure for the %s environment."
+	fmtStreamEnvFailed         = "Failed to create the infrastructure for the %s environment.\n"
+	fmtStreamEnvComplete       = "Created the infrastructure for the %s environment.\n"
+	fmtAddEnvToAppStart        = "Linking account %s and region %s to application %s."
+	fmtAddEnvToAppFailed       = "Failed to link account %s and region %s to application %s.\n"
+	fmtAddEnvToAppComplete     = "Linked account %s and region %s to application %s.\n"
+)
+
+const (
+	fmtEnvInitEnableVPCDNS  = "VPC %s has DNS support or DNS hostnames disabled, which breaks service discovery. Enable both?"
+	envInitEnableVPCDNSHelp = `Services find each other with private DNS names that the Amazon provided DNS server resolves,
+which requires the enableDnsSupport and enableDnsHostnames attributes of the VPC.`
+	fmtEnvInitCustomDNS = "The DHCP options of VPC %s don't include AmazonProvidedDNS, services can only find each other if your DNS servers forward queries to it.\n"
 )
 
 var (
@@ -78,6 +100,7 @@ type importVPCVars struct {
 	ID               string
 	PublicSubnetIDs  []string
 	PrivateSubnetIDs []string
+	Tags             map[string]string // Tags to scope the VPCs to select from, they don't import a VPC on their own.
 }
 
 func (v importVPCVars) isSet() bool {
@@ -100,6 +123,16 @@ func (v adjustVPCVars) isSet() bool {
 	return len(v.PublicSubnetCIDRs) != 0 || len(v.PrivateSubnetCIDRs) != 0
 }
 
+type globalAcceleratorVars struct {
+	Enable              bool
+	HealthCheckPath     string
+	HealthCheckInterval int
+}
+
+func (v globalAcceleratorVars) isSet() bool {
+	return v.HealthCheckPath != "" || v.HealthCheckInterval != 0
+}
+
 type tempCredsVars struct {
 	AccessKeyID     string
 	SecretAccessKey string
@@ -111,13 +144,26 @@ type initEnvVars struct {
 	Name              string // Name for the environment.
 	Profile           string // The named profile to use for credential retrieval. Mutually exclusive with TempCreds.
 	IsProduction      bool   // True means retain resources even after deletion.
+	Protected         bool   // True means deploying to or deleting from the environment requires confirming its name.
 	NoCustomResources bool   // True means no importing an existing VPC or adjusting a VPC.
 
 	ImportVPC importVPCVars // Existing VPC resources to use instead of creating new ones.
 	AdjustVPC adjustVPCVars // Configure parameters for VPC resources generated while initializing an environment.
 
+	GlobalAccelerator globalAcceleratorVars // Static IP addresses in front of the public load balancer.
+	EgressEIPs        []string              // Allocation IDs of the Elastic IPs attached to the NAT gateways.
+	ImportCertARNs    []string              // Existing ACM certificates to serve on the HTTPS listener.
+
 	TempCreds tempCredsVars // Temporary credentials to initialize the environment. Mutually exclusive with the Profile.
 	Region    string        // The region to create the environment in.
+
+	Preview    string   // Name of the ephemeral preview environment. Mutually exclusive with the Name.
+	CloneFrom  string   // Name of an existing environment to copy the configuration from.
+	DeploySvcs []string // Services to deploy to the preview environment once it's created.
+
+	TTL time.Duration // Duration after which the environment expires. Zero means the environment never expires.
+
+	NoCache bool // True means the VPCs and subnets are listed without the local cache.
 }
 
 type initEnvOpts struct {
@@ -132,9 +178,20 @@ type initEnvOpts struct {
 	profileConfig profileNames
 	prog          progress
 	sel           ec2Selector
+	subnets       subnetsDescriber
+	endpoints     vpcEndpointsLister
+	egress        vpcEgressDescriber
+	vpcDNS        vpcDNSConfigurer
+	ws            svcManifestReader // Only set if services are deployed to the preview environment.
 
 	// Initialize clients after Ask().
 	configureRuntimeClients func(*initEnvOpts) error
+	// Initialize the commands that deploy services to the preview environment.
+	newSvcDeployer func(svcName, envName string) (actionCommand, error)
+
+	// Cached variables.
+	cloneSource *config.Environment
+	expiresAt   *time.Time
 }
 
 func configureInitEnvFromFlags(o *initEnvOpts) error {
@@ -158,9 +215,20 @@ func configureInitEnvFromFlags(o *initEnvOpts) error {
 		sess.Config.Region = &o.Region
 	}
 
+	c, err := newLocalCache(o.NoCache)
+	if err != nil {
+		return err
+	}
 	o.envIdentity = identity.New(sess)
 	o.envDeployer = deploycfn.New(sess)
-	o.sel = selector.NewEC2Select(o.prompt, ec2.New(sess))
+	ec2Client := ec2.NewWithCache(sess, c, ec2.WithThrottlingRetries(ec2.RetryConfig{
+		Observer: &throttleProgress{prog: o.prog},
+	}))
+	o.sel = selector.NewEC2Select(o.prompt, ec2Client)
+	o.subnets = ec2Client
+	o.endpoints = ec2Client
+	o.egress = ec2Client
+	o.vpcDNS = ec2Client
 	return nil
 }
 
@@ -188,7 +256,7 @@ func newInitEnvOpts(vars initEnvVars) (*initEnvOpts, error) {
 		return nil, fmt.Errorf("read named profiles: %w", err)
 	}
 
-	return &initEnvOpts{
+	opts := &initEnvOpts{
 		initEnvVars:             vars,
 		store:                   store,
 		appDeployer:             deploycfn.New(defaultSession),
@@ -196,7 +264,23 @@ func newInitEnvOpts(vars initEnvVars) (*initEnvOpts, error) {
 		profileConfig:           cfg,
 		prog:                    termprogress.NewSpinner(),
 		configureRuntimeClients: configureInitEnvFromFlags,
-	}, nil
+		newSvcDeployer: func(svcName, envName string) (actionCommand, error) {
+			return newSvcDeployOpts(deploySvcVars{
+				GlobalOpts:        vars.GlobalOpts,
+				Name:              svcName,
+				EnvName:           envName,
+				RollbackOnFailure: true,
+			})
+		},
+	}
+	if len(vars.DeploySvcs) != 0 {
+		ws, err := workspace.New()
+		if err != nil {
+			return nil, fmt.Errorf("new workspace: %w", err)
+		}
+		opts.ws = ws
+	}
+	return opts, nil
 }
 
 // Validate returns an error if the values passed by flags are invalid.
@@ -212,6 +296,24 @@ func (o *initEnvOpts) Validate() error {
 	if err := o.validateCustomizedResources(); err != nil {
 		return err
 	}
+	if err := o.validatePreview(); err != nil {
+		return err
+	}
+	if err := o.validateCloneFrom(); err != nil {
+		return err
+	}
+	if o.TTL < 0 {
+		return fmt.Errorf("--%s must be a positive duration", ttlFlag)
+	}
+	if err := o.validateGlobalAccelerator(); err != nil {
+		return err
+	}
+	if err := o.validateEgressEIPs(); err != nil {
+		return err
+	}
+	if err := validateCertARNs(o.ImportCertARNs); err != nil {
+		return fmt.Errorf("validate --%s: %w", importCertARNsFlag, err)
+	}
 	return o.validateCredentials()
 }
 
@@ -223,9 +325,15 @@ func (o *initEnvOpts) Ask() error {
 	if err := o.askEnvProfile(); err != nil {
 		return err
 	}
+	if err := o.askRegion(); err != nil {
+		return err
+	}
 	if err := o.configureRuntimeClients(o); err != nil {
 		return err
 	}
+	if o.cloneSource != nil {
+		return o.cloneEnvConfig()
+	}
 	return o.askCustomizedResources()
 }
 
@@ -237,6 +345,28 @@ func (o *initEnvOpts) Execute() error {
 		return err
 	}
 
+	ctx := context.Background()
+	if err := o.validateEgressEIPSubnets(); err != nil {
+		return err
+	}
+	if err := o.validateImportedSubnetAZs(ctx); err != nil {
+		return err
+	}
+	if err := o.validateImportedVPCDNS(ctx); err != nil {
+		return err
+	}
+	if err := o.warnMissingVPCEndpoints(ctx); err != nil {
+		return err
+	}
+	if err := o.warnPrivateSubnetsWithoutEgress(ctx); err != nil {
+		return err
+	}
+
+	if o.TTL > 0 {
+		expiresAt := time.Now().Add(o.TTL).UTC().Truncate(time.Second)
+		o.expiresAt = &expiresAt
+	}
+
 	if app.RequiresDNSDelegation() {
 		if err := o.delegateDNSFromApp(app); err != nil {
 			return fmt.Errorf("granting DNS permissions: %w", err)
@@ -254,6 +384,10 @@ func (o *initEnvOpts) Execute() error {
 		return fmt.Errorf("get environment struct for %s: %w", o.Name, err)
 	}
 	env.Prod = o.IsProduction
+	env.Protected = o.Protected
+	env.Preview = o.Preview != ""
+	env.CustomConfig = o.customConfig()
+	env.ExpiresAt = o.expiresAt
 
 	// 3. Add the stack set instance to the app stackset.
 	if err := o.addToStackset(app, env); err != nil {
@@ -266,12 +400,20 @@ func (o *initEnvOpts) Execute() error {
 	}
 	log.Successf("Created environment %s in region %s under application %s.\n",
 		color.HighlightUserInput(env.Name), color.Emphasize(env.Region), color.HighlightUserInput(env.App))
-	return nil
+
+	// 5. Deploy the selected services to the preview environment.
+	return o.deployPreviewSvcs()
 }
 
 // RecommendedActions returns follow-up actions the user can take after successfully executing the command.
 func (o *initEnvOpts) RecommendedActions() []string {
-	return nil
+	if o.Preview == "" {
+		return nil
+	}
+	return []string{
+		fmt.Sprintf("Run %s once the pull request is closed to delete the preview environment and its services.",
+			color.HighlightCode(fmt.Sprintf("copilot env delete --name %s --yes", o.Name))),
+	}
 }
 
 func (o *initEnvOpts) validateCustomizedResources() error {
@@ -284,10 +426,270 @@ func (o *initEnvOpts) validateCustomizedResources() error {
 	return nil
 }
 
+func (o *initEnvOpts) validateGlobalAccelerator() error {
+	if !o.GlobalAccelerator.Enable {
+		if o.GlobalAccelerator.isSet() {
+			return fmt.Errorf("--%s and --%s can only be used with --%s",
+				acceleratorHealthCheckPathFlag, acceleratorHealthCheckIntervalFlag, globalAcceleratorFlag)
+		}
+		return nil
+	}
+	if path := o.GlobalAccelerator.HealthCheckPath; path != "" && !strings.HasPrefix(path, "/") {
+		return fmt.Errorf("--%s %s must start with /", acceleratorHealthCheckPathFlag, path)
+	}
+	switch o.GlobalAccelerator.HealthCheckInterval {
+	case 0, 10, 30:
+		return nil
+	default:
+		return fmt.Errorf("--%s must be either 10 or 30 seconds", acceleratorHealthCheckIntervalFlag)
+	}
+}
+
+func (o *initEnvOpts) validateEgressEIPs() error {
+	if len(o.EgressEIPs) == 0 {
+		return nil
+	}
+	if o.ImportVPC.isSet() {
+		return fmt.Errorf("cannot specify both --%s and import vpc flags", egressEIPsFlag)
+	}
+	for _, id := range o.EgressEIPs {
+		if !strings.HasPrefix(id, "eipalloc-") {
+			return fmt.Errorf("--%s %s must be an Elastic IP allocation ID (e.g. eipalloc-0123456789abcdef0)", egressEIPsFlag, id)
+		}
+	}
+	return nil
+}
+
+// validateEgressEIPSubnets returns an error if the number of Elastic IPs doesn't match the
+// number of availability zones of the generated VPC, once its configuration is known.
+func (o *initEnvOpts) validateEgressEIPSubnets() error {
+	if len(o.EgressEIPs) == 0 {
+		return nil
+	}
+	if o.importVPCConfig() != nil {
+		return fmt.Errorf("--%s cannot be used with an imported VPC", egressEIPsFlag)
+	}
+	publicCIDRs, privateCIDRs := strings.Split(stack.DefaultPublicSubnetCIDRs, ","), strings.Split(stack.DefaultPrivateSubnetCIDRs, ",")
+	if conf := o.adjustVPCConfig(); conf != nil {
+		publicCIDRs, privateCIDRs = conf.PublicSubnetCIDRs, conf.PrivateSubnetCIDRs
+	}
+	if len(o.EgressEIPs) != len(publicCIDRs) || len(o.EgressEIPs) != len(privateCIDRs) {
+		return fmt.Errorf("--%s requires one Elastic IP per availability zone: got %d for %d public and %d private subnets",
+			egressEIPsFlag, len(o.EgressEIPs), len(publicCIDRs), len(privateCIDRs))
+	}
+	return nil
+}
+
+// validateImportedSubnetAZs returns an error if the imported public or private subnets don't span
+// at least two availability zones, since load balancers and services need to be spread across them.
+// Subnets in Local Zones or Wavelength Zones don't count as availability zones.
+func (o *initEnvOpts) validateImportedSubnetAZs(ctx context.Context) error {
+	conf := o.importVPCConfig()
+	if conf == nil {
+		return nil
+	}
+	zones, err := o.subnets.ListAvailabilityZones(ctx, ec2.FilterForAvailabilityZoneType, ec2.FilterForOptedInZones)
+	if err != nil {
+		return fmt.Errorf("list availability zones: %w", err)
+	}
+	subnetIDs := append(append([]string{}, conf.PublicSubnetIDs...), conf.PrivateSubnetIDs...)
+	subnets, err := o.subnets.SubnetsDetailed(ctx, ec2.Filter{
+		Name:   "subnet-id",
+		Values: subnetIDs,
+	})
+	if err != nil {
+		return fmt.Errorf("describe imported subnets: %w", err)
+	}
+	subnetAZs := make(map[string]string)
+	for _, subnet := range subnets {
+		subnetAZs[subnet.ID] = subnet.AZ
+	}
+	isAZ := make(map[string]bool)
+	for _, zone := range zones {
+		isAZ[zone] = true
+	}
+	for _, imported := range []struct {
+		kind string
+		ids  []string
+	}{
+		{kind: "public", ids: conf.PublicSubnetIDs},
+		{kind: "private", ids: conf.PrivateSubnetIDs},
+	} {
+		if len(imported.ids) == 0 {
+			continue
+		}
+		var spanned []string
+		for _, id := range imported.ids {
+			az, ok := subnetAZs[id]
+			if !ok {
+				return fmt.Errorf("imported %s subnet %s is not found", imported.kind, id)
+			}
+			if !isAZ[az] {
+				return fmt.Errorf("imported %s subnet %s is in %s, which is not an available availability zone", imported.kind, id, az)
+			}
+			if !contains(az, spanned) {
+				spanned = append(spanned, az)
+			}
+		}
+		if len(spanned) < 2 {
+			return fmt.Errorf("imported %s subnets must span at least 2 availability zones: got %s", imported.kind, strings.Join(spanned, ", "))
+		}
+	}
+	return nil
+}
+
+// validateImportedVPCDNS returns an error if the imported VPC has DNS support or DNS hostnames disabled,
+// unless the user agrees to enable them, since service discovery silently fails without them.
+// It logs a warning if the DHCP options of the VPC use other DNS servers than the Amazon provided one.
+func (o *initEnvOpts) validateImportedVPCDNS(ctx context.Context) error {
+	conf := o.importVPCConfig()
+	if conf == nil {
+		return nil
+	}
+	attrs, err := o.vpcDNS.VPCDNSAttributes(ctx, conf.ID)
+	if err != nil {
+		return fmt.Errorf("get DNS attributes of VPC %s: %w", conf.ID, err)
+	}
+	if !attrs.Enabled() {
+		enable, err := o.prompt.Confirm(fmt.Sprintf(fmtEnvInitEnableVPCDNS, color.HighlightUserInput(conf.ID)), envInitEnableVPCDNSHelp)
+		if err != nil {
+			return fmt.Errorf("confirm enabling DNS in VPC %s: %w", conf.ID, err)
+		}
+		if !enable {
+			return fmt.Errorf("VPC %s must have enableDnsSupport and enableDnsHostnames set to true for service discovery", conf.ID)
+		}
+		if err := o.vpcDNS.EnableVPCDNS(ctx, conf.ID); err != nil {
+			return err
+		}
+		log.Successf("Enabled DNS support and DNS hostnames in VPC %s.\n", color.HighlightUserInput(conf.ID))
+	}
+	usesAmazonDNS, err := o.vpcDNS.UsesAmazonProvidedDNS(ctx, conf.ID)
+	if err != nil {
+		return fmt.Errorf("get DNS servers of VPC %s: %w", conf.ID, err)
+	}
+	if !usesAmazonDNS {
+		log.Warningf(fmtEnvInitCustomDNS, color.HighlightUserInput(conf.ID))
+	}
+	return nil
+}
+
+// warnMissingVPCEndpoints logs a warning if the imported VPC has no public subnets, so services run without
+// internet access, and it's missing the VPC endpoints that tasks need to start.
+func (o *initEnvOpts) warnMissingVPCEndpoints(ctx context.Context) error {
+	conf := o.importVPCConfig()
+	if conf == nil || len(conf.PublicSubnetIDs) != 0 {
+		return nil
+	}
+	endpoints, err := o.endpoints.ListVPCEndpoints(ctx, conf.ID)
+	if err != nil {
+		return fmt.Errorf("list VPC endpoints: %w", err)
+	}
+	if ok, missing := ec2.HasRequiredEndpoints(endpoints); !ok {
+		log.Warningf(fmtEnvInitMissingEndpoints, color.HighlightUserInput(conf.ID), strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// warnPrivateSubnetsWithoutEgress logs a warning if some of the imported private subnets have no route to the internet,
+// along with the NAT gateways of the VPC to route their traffic to, or the unassociated Elastic IPs to create one.
+func (o *initEnvOpts) warnPrivateSubnetsWithoutEgress(ctx context.Context) error {
+	conf := o.importVPCConfig()
+	if conf == nil || len(conf.PrivateSubnetIDs) == 0 {
+		return nil
+	}
+	subnets, err := o.egress.SubnetsWithoutEgress(ctx, conf.PrivateSubnetIDs...)
+	if err != nil {
+		return fmt.Errorf("check routes of private subnets: %w", err)
+	}
+	if len(subnets) == 0 {
+		return nil
+	}
+	log.Warningf(fmtEnvInitNoEgress, color.HighlightUserInput(strings.Join(subnets, ", ")))
+
+	gateways, err := o.egress.ListNATGateways(ctx, conf.ID)
+	if err != nil {
+		return fmt.Errorf("list NAT gateways: %w", err)
+	}
+	if len(gateways) != 0 {
+		var ids []string
+		for _, gateway := range gateways {
+			ids = append(ids, gateway.ID)
+		}
+		log.Infof("Add a route from 0.0.0.0/0 to one of the NAT gateways %s of VPC %s to their route tables.\n", strings.Join(ids, ", "), conf.ID)
+		return nil
+	}
+	ips, err := o.egress.ListElasticIPs(ctx)
+	if err != nil {
+		return fmt.Errorf("list Elastic IPs: %w", err)
+	}
+	var available []string
+	for _, ip := range ips {
+		if ip.AssociationID == "" {
+			available = append(available, ip.AllocationID)
+		}
+	}
+	if len(available) == 0 {
+		log.Infof("VPC %s has no NAT gateway, create one in a public subnet and add a route from 0.0.0.0/0 to it in their route tables.\n", conf.ID)
+		return nil
+	}
+	log.Infof("VPC %s has no NAT gateway, create one in a public subnet with one of the unassociated Elastic IPs %s, and add a route from 0.0.0.0/0 to it in their route tables.\n",
+		conf.ID, strings.Join(available, ", "))
+	return nil
+}
+
+// validateCertARNs returns an error if any of the ARNs doesn't refer to an ACM certificate.
+func validateCertARNs(arns []string) error {
+	for _, certARN := range arns {
+		parsed, err := arn.Parse(certARN)
+		if err != nil || parsed.Service != "acm" || !strings.HasPrefix(parsed.Resource, "certificate/") {
+			return fmt.Errorf("%s is not an ACM certificate ARN", certARN)
+		}
+	}
+	return nil
+}
+
+func (o *initEnvOpts) validatePreview() error {
+	if o.Preview == "" {
+		if len(o.DeploySvcs) != 0 {
+			return fmt.Errorf("--%s can only be used with --%s", deploySvcsFlag, previewFlag)
+		}
+		return nil
+	}
+	if o.Name != "" && o.Name != o.Preview {
+		return fmt.Errorf("cannot specify both --%s and --%s", nameFlag, previewFlag)
+	}
+	if o.IsProduction {
+		return fmt.Errorf("cannot specify both --%s and --%s", prodEnvFlag, previewFlag)
+	}
+	if o.Protected {
+		return fmt.Errorf("cannot specify both --%s and --%s", protectedFlag, previewFlag)
+	}
+	return validateEnvironmentName(o.Preview)
+}
+
+func (o *initEnvOpts) validateCloneFrom() error {
+	if o.CloneFrom == "" {
+		return nil
+	}
+	if o.ImportVPC.isSet() || o.AdjustVPC.isSet() || o.NoCustomResources {
+		return fmt.Errorf("cannot import or configure vpc if --%s is set", fromFlag)
+	}
+	env, err := o.store.GetEnvironment(o.AppName(), o.CloneFrom)
+	if err != nil {
+		return fmt.Errorf("get environment %s configuration: %w", o.CloneFrom, err)
+	}
+	o.cloneSource = env
+	return nil
+}
+
 func (o *initEnvOpts) askEnvName() error {
 	if o.Name != "" {
 		return nil
 	}
+	if o.Preview != "" {
+		o.Name = o.Preview
+		return nil
+	}
 
 	envName, err := o.prompt.Get(envInitNamePrompt, envInitNameHelpPrompt, validateEnvironmentName)
 	if err != nil {
@@ -319,6 +721,29 @@ func (o *initEnvOpts) askEnvProfile() error {
 	return nil
 }
 
+// askRegion prompts for the region of an environment that's cloned from another environment.
+// Otherwise, the region of the named profile is used.
+func (o *initEnvOpts) askRegion() error {
+	if o.cloneSource == nil || o.Region != "" {
+		return nil
+	}
+	if o.Preview != "" {
+		// Preview environments are created next to the environment they're cloned from.
+		o.Region = o.cloneSource.Region
+		return nil
+	}
+	region, err := o.prompt.Get(
+		fmt.Sprintf(fmtEnvInitRegionPrompt, color.HighlightUserInput(o.Name)),
+		envInitRegionHelpPrompt,
+		nil, /* no validation */
+		prompt.WithDefaultInput(o.cloneSource.Region))
+	if err != nil {
+		return fmt.Errorf("get the region: %w", err)
+	}
+	o.Region = region
+	return nil
+}
+
 func (o *initEnvOpts) askCustomizedResources() error {
 	if o.NoCustomResources {
 		return nil
@@ -341,8 +766,9 @@ func (o *initEnvOpts) askCustomizedResources() error {
 }
 
 func (o *initEnvOpts) askImportResources() error {
+	ctx := context.Background()
 	if o.ImportVPC.ID == "" {
-		vpcID, err := o.sel.VPC(envInitVPCSelectPrompt, "")
+		vpcID, err := o.sel.VPC(ctx, envInitVPCSelectPrompt, "", o.vpcTagFilters()...)
 		if err != nil {
 			if err == selector.ErrVPCNotFound {
 				log.Errorf(`No existing VPCs were found. You can either:
@@ -355,7 +781,7 @@ func (o *initEnvOpts) askImportResources() error {
 		o.ImportVPC.ID = vpcID
 	}
 	if o.ImportVPC.PublicSubnetIDs == nil {
-		publicSubnets, err := o.sel.PublicSubnets(envInitPublicSubnetsSelectPrompt, "", o.ImportVPC.ID)
+		publicSubnets, err := o.sel.PublicSubnets(ctx, envInitPublicSubnetsSelectPrompt, "", o.ImportVPC.ID)
 		if err != nil {
 			if err == selector.ErrSubnetsNotFound {
 				log.Errorf(`No existing public subnets were found in VPC %s. You can either:
@@ -367,7 +793,7 @@ func (o *initEnvOpts) askImportResources() error {
 		o.ImportVPC.PublicSubnetIDs = publicSubnets
 	}
 	if o.ImportVPC.PrivateSubnetIDs == nil {
-		privateSubnets, err := o.sel.PrivateSubnets(envInitPrivateSubnetsSelectPrompt, "", o.ImportVPC.ID)
+		privateSubnets, err := o.sel.PrivateSubnets(ctx, envInitPrivateSubnetsSelectPrompt, "", o.ImportVPC.ID)
 		if err != nil {
 			if err == selector.ErrSubnetsNotFound {
 				log.Errorf(`No existing private subnets were found in VPC %s. You can either:
@@ -381,6 +807,24 @@ func (o *initEnvOpts) askImportResources() error {
 	return nil
 }
 
+// vpcTagFilters returns the filters for the VPCs tagged with --import-vpc-tags, sorted by tag key.
+func (o *initEnvOpts) vpcTagFilters() []ec2.Filter {
+	keys := make([]string, 0, len(o.ImportVPC.Tags))
+	for key := range o.ImportVPC.Tags {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	var filters []ec2.Filter
+	for _, key := range keys {
+		if value := o.ImportVPC.Tags[key]; value != "" {
+			filters = append(filters, ec2.FilterForTags(key, value))
+			continue
+		}
+		filters = append(filters, ec2.FilterForTags(key))
+	}
+	return filters
+}
+
 func (o *initEnvOpts) askAdjustResources() error {
 	if o.AdjustVPC.CIDR.String() == emptyIPNet.String() {
 		vpcCIDRString, err := o.prompt.Get(envInitVPCCIDRPrompt, envInitVPCCIDRPromptHelp, validateCIDR,
@@ -413,6 +857,112 @@ func (o *initEnvOpts) askAdjustResources() error {
 	return nil
 }
 
+// cloneEnvConfig copies the configuration of the source environment.
+// Imported VPC resources are prompted for again if the new environment lives in a different account or region.
+func (o *initEnvOpts) cloneEnvConfig() error {
+	if o.Preview == "" && o.cloneSource.Prod {
+		o.IsProduction = true
+	}
+	if o.Preview == "" && o.cloneSource.Protected {
+		o.Protected = true
+	}
+	conf := o.cloneSource.CustomConfig
+	if conf == nil {
+		// The source environment uses the default configuration.
+		o.NoCustomResources = true
+		return nil
+	}
+	if conf.GlobalAccelerator != nil && !o.GlobalAccelerator.Enable {
+		o.GlobalAccelerator = globalAcceleratorVars{
+			Enable:              true,
+			HealthCheckPath:     conf.GlobalAccelerator.HealthCheckPath,
+			HealthCheckInterval: conf.GlobalAccelerator.HealthCheckInterval,
+		}
+	}
+	if len(conf.ImportCertARNs) > 0 && len(o.ImportCertARNs) == 0 {
+		// Certificates are regional, so they're only reused if the new environment is in the same account and region.
+		sameLocation, err := o.isSameLocationAsCloneSource()
+		if err != nil {
+			return err
+		}
+		if sameLocation {
+			o.ImportCertARNs = conf.ImportCertARNs
+		} else {
+			log.Warningf(fmtEnvInitCloneImportCerts, color.HighlightUserInput(o.CloneFrom), color.HighlightUserInput(o.Name))
+		}
+	}
+	if conf.ImportVPC != nil {
+		sameLocation, err := o.isSameLocationAsCloneSource()
+		if err != nil {
+			return err
+		}
+		if !sameLocation {
+			log.Infof(fmtEnvInitCloneImportVPC, color.HighlightUserInput(o.CloneFrom), conf.ImportVPC.ID, color.HighlightUserInput(o.Name))
+			return o.askImportResources()
+		}
+		o.ImportVPC = importVPCVars{
+			ID:               conf.ImportVPC.ID,
+			PublicSubnetIDs:  conf.ImportVPC.PublicSubnetIDs,
+			PrivateSubnetIDs: conf.ImportVPC.PrivateSubnetIDs,
+		}
+	}
+	if conf.VPCConfig != nil {
+		_, vpcCIDR, err := net.ParseCIDR(conf.VPCConfig.CIDR)
+		if err != nil {
+			return fmt.Errorf("parse VPC CIDR of environment %s: %w", o.CloneFrom, err)
+		}
+		o.AdjustVPC = adjustVPCVars{
+			CIDR:               *vpcCIDR,
+			PublicSubnetCIDRs:  conf.VPCConfig.PublicSubnetCIDRs,
+			PrivateSubnetCIDRs: conf.VPCConfig.PrivateSubnetCIDRs,
+		}
+	}
+	return nil
+}
+
+func (o *initEnvOpts) isSameLocationAsCloneSource() (bool, error) {
+	if o.Region != o.cloneSource.Region {
+		return false, nil
+	}
+	caller, err := o.envIdentity.Get()
+	if err != nil {
+		return false, fmt.Errorf("get identity: %w", err)
+	}
+	return caller.Account == o.cloneSource.AccountID, nil
+}
+
+func (o *initEnvOpts) customConfig() *config.CustomizeEnv {
+	importVPC, adjustVPC, accelerator := o.importVPCConfig(), o.adjustVPCConfig(), o.globalAcceleratorConfig()
+	if importVPC == nil && adjustVPC == nil && accelerator == nil && len(o.EgressEIPs) == 0 && len(o.ImportCertARNs) == 0 {
+		return nil
+	}
+	conf := &config.CustomizeEnv{
+		EgressEIPAllocationIDs: o.EgressEIPs,
+		ImportCertARNs:         o.ImportCertARNs,
+	}
+	if importVPC != nil {
+		conf.ImportVPC = &config.ImportVPC{
+			ID:               importVPC.ID,
+			PublicSubnetIDs:  importVPC.PublicSubnetIDs,
+			PrivateSubnetIDs: importVPC.PrivateSubnetIDs,
+		}
+	}
+	if adjustVPC != nil {
+		conf.VPCConfig = &config.AdjustVPC{
+			CIDR:               adjustVPC.CIDR,
+			PublicSubnetCIDRs:  adjustVPC.PublicSubnetCIDRs,
+			PrivateSubnetCIDRs: adjustVPC.PrivateSubnetCIDRs,
+		}
+	}
+	if accelerator != nil {
+		conf.GlobalAccelerator = &config.GlobalAccelerator{
+			HealthCheckPath:     accelerator.HealthCheckPath,
+			HealthCheckInterval: accelerator.HealthCheckIntervalSeconds,
+		}
+	}
+	return conf
+}
+
 func (o *initEnvOpts) importVPCConfig() *deploy.ImportVPCConfig {
 	if o.NoCustomResources || !o.ImportVPC.isSet() {
 		return nil
@@ -435,6 +985,33 @@ func (o *initEnvOpts) adjustVPCConfig() *deploy.AdjustVPCConfig {
 	}
 }
 
+func (o *initEnvOpts) globalAcceleratorConfig() *deploy.GlobalAcceleratorConfig {
+	if !o.GlobalAccelerator.Enable {
+		return nil
+	}
+	conf := &deploy.GlobalAcceleratorConfig{
+		HealthCheckPath:            defaultAcceleratorHealthCheckPath,
+		HealthCheckIntervalSeconds: defaultAcceleratorHealthCheckInterval,
+	}
+	if o.GlobalAccelerator.HealthCheckPath != "" {
+		conf.HealthCheckPath = o.GlobalAccelerator.HealthCheckPath
+	}
+	if o.GlobalAccelerator.HealthCheckInterval != 0 {
+		conf.HealthCheckIntervalSeconds = o.GlobalAccelerator.HealthCheckInterval
+	}
+	return conf
+}
+
+// envTags returns the tags to apply to the environment stack.
+func (o *initEnvOpts) envTags(appTags map[string]string) map[string]string {
+	if o.expiresAt == nil {
+		return appTags
+	}
+	return tags.Merge(appTags, map[string]string{
+		deploy.EnvExpiryTagKey: o.expiresAt.Format(time.RFC3339),
+	})
+}
+
 func (o *initEnvOpts) deployEnv(app *config.Application) error {
 	caller, err := o.identity.Get()
 	if err != nil {
@@ -447,9 +1024,12 @@ func (o *initEnvOpts) deployEnv(app *config.Application) error {
 		PublicLoadBalancer:       true, // TODO: configure this based on user input or service Type needs?
 		ToolsAccountPrincipalARN: caller.RootUserARN,
 		AppDNSName:               app.Domain,
-		AdditionalTags:           app.Tags,
+		AdditionalTags:           o.envTags(app.Tags),
 		AdjustVPCConfig:          o.adjustVPCConfig(),
 		ImportVPCConfig:          o.importVPCConfig(),
+		GlobalAcceleratorConfig:  o.globalAcceleratorConfig(),
+		EgressEIPAllocationIDs:   o.EgressEIPs,
+		ImportCertARNs:           o.ImportCertARNs,
 	}
 
 	o.prog.Start(fmt.Sprintf(fmtDeployEnvStart, color.HighlightUserInput(o.Name)))
@@ -492,6 +1072,36 @@ func (o *initEnvOpts) addToStackset(app *config.Application, env *config.Environ
 	return nil
 }
 
+func (o *initEnvOpts) deployPreviewSvcs() error {
+	if len(o.DeploySvcs) == 0 {
+		return nil
+	}
+	deps, err := svcDependencies(o.ws, o.DeploySvcs)
+	if err != nil {
+		return err
+	}
+	svcs, err := deploy.SortByDependencies(o.DeploySvcs, deps)
+	if err != nil {
+		return err
+	}
+	for _, svc := range svcs {
+		cmd, err := o.newSvcDeployer(svc, o.Name)
+		if err != nil {
+			return err
+		}
+		if err := cmd.Validate(); err != nil {
+			return fmt.Errorf("validate deployment of service %s: %w", svc, err)
+		}
+		if err := cmd.Ask(); err != nil {
+			return fmt.Errorf("ask for deployment of service %s: %w", svc, err)
+		}
+		if err := cmd.Execute(); err != nil {
+			return fmt.Errorf("deploy service %s to environment %s: %w", svc, o.Name, err)
+		}
+	}
+	return nil
+}
+
 func (o *initEnvOpts) delegateDNSFromApp(app *config.Application) error {
 	envAccount, err := o.envIdentity.Get()
 	if err != nil {
@@ -539,8 +1149,12 @@ func (o *initEnvOpts) humanizeEnvironmentEvents(resourceEvents []deploy.Resource
 			return strings.Contains(event.LogicalName, "LoadBalancer") ||
 				strings.Contains(event.Type, "ElasticLoadBalancingV2")
 		},
+		textGlobalAccelerator: func(event deploy.Resource) bool {
+			return strings.HasPrefix(event.Type, "AWS::GlobalAccelerator::")
+		},
 	}
-	return termprogress.HumanizeResourceEvents(o.envProgressOrder(), resourceEvents, matcher, defaultResourceCounts)
+	rows := termprogress.HumanizeResourceEvents(o.envProgressOrder(), resourceEvents, matcher, defaultResourceCounts)
+	return append(rows, termprogress.HumanizeNestedStackEvents(resourceEvents)...)
 }
 
 func (o *initEnvOpts) envProgressOrder() (order []termprogress.Text) {
@@ -548,6 +1162,9 @@ func (o *initEnvOpts) envProgressOrder() (order []termprogress.Text) {
 		order = append(order, []termprogress.Text{textVPC, textInternetGateway, textPublicSubnets, textPrivateSubnets, textRouteTables}...)
 	}
 	order = append(order, []termprogress.Text{textECSCluster, textALB}...)
+	if o.GlobalAccelerator.Enable {
+		order = append(order, textGlobalAccelerator)
+	}
 	return
 }
 
@@ -580,6 +1197,9 @@ func BuildEnvInitCmd() *cobra.Command {
   Creates a prod-iad environment using your "prod-admin" AWS profile.
   /code $ copilot env init --name prod-iad --profile prod-admin --prod
 
+  Creates a prod environment that requires confirming its name to deploy to it or delete from it.
+  /code $ copilot env init --name prod --profile prod-admin --prod --protected
+
   Creates a test environment using default environment configuration.
   /code $ copilot env init --name test --no-custom-resources
 
@@ -591,7 +1211,30 @@ func BuildEnvInitCmd() *cobra.Command {
   Creates an environment with overrided CIDRs.
   /code $ copilot env init --override-vpc-cidr 10.1.0.0/16 \
   /code --override-public-cidrs 10.1.0.0/24,10.1.1.0/24 \
-  /code --override-private-cidrs 10.1.2.0/24,10.1.3.0/24`,
+  /code --override-private-cidrs 10.1.2.0/24,10.1.3.0/24
+
+  Creates a prod-pdx environment with the same configuration as "prod-iad" in another region.
+  /code $ copilot env init --name prod-pdx --from prod-iad --profile prod-admin --region us-west-2
+
+  Creates a preview environment for pull request 123 with the same configuration as "test"
+  and deploys the "frontend" and "api" services to it.
+  /code $ copilot env init --preview pr-123 --from test --profile default \
+  /code --deploy-svcs frontend,api
+
+  Creates a sandbox environment that can be deleted by "copilot env gc" after 3 days.
+  /code $ copilot env init --name sandbox --profile default --ttl 72h
+
+  Creates an environment whose private subnets reach the internet from two pre-allocated Elastic IPs.
+  /code $ copilot env init --name prod --profile prod-admin --prod \
+  /code --egress-eips eipalloc-0a1b2c3d4e5f60718,eipalloc-0f1e2d3c4b5a69788
+
+  Creates an environment whose HTTPS listener serves two existing ACM certificates.
+  /code $ copilot env init --name prod --profile prod-admin --prod \
+  /code --import-cert-arns arn:aws:acm:us-west-2:123456789012:certificate/example1,arn:aws:acm:us-west-2:123456789012:certificate/example2
+
+  Creates a prod environment whose load balancer is reachable from static IP addresses.
+  /code $ copilot env init --name prod --profile prod-admin --prod \
+  /code --global-accelerator --accelerator-health-check-path /healthz`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newInitEnvOpts(vars)
 			if err != nil {
@@ -609,37 +1252,72 @@ func BuildEnvInitCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.Name, nameFlag, nameFlagShort, "", envFlagDescription)
 	cmd.Flags().StringVar(&vars.Profile, profileFlag, "", profileFlagDescription)
 	cmd.Flags().BoolVar(&vars.IsProduction, prodEnvFlag, false, prodEnvFlagDescription)
+	cmd.Flags().BoolVar(&vars.Protected, protectedFlag, false, protectedFlagDescription)
 	cmd.Flags().StringVar(&vars.ImportVPC.ID, vpcIDFlag, "", vpcIDFlagDescription)
+	cmd.Flags().StringToStringVar(&vars.ImportVPC.Tags, vpcTagsFlag, nil, vpcTagsFlagDescription)
 	cmd.Flags().StringSliceVar(&vars.ImportVPC.PublicSubnetIDs, publicSubnetsFlag, nil, publicSubnetsFlagDescription)
 	cmd.Flags().StringSliceVar(&vars.ImportVPC.PrivateSubnetIDs, privateSubnetsFlag, nil, privateSubnetsFlagDescription)
 	cmd.Flags().IPNetVar(&vars.AdjustVPC.CIDR, vpcCIDRFlag, net.IPNet{}, vpcCIDRFlagDescription)
 	// TODO: use IPNetSliceVar when it is available (https://github.com/spf13/pflag/issues/273).
 	cmd.Flags().StringSliceVar(&vars.AdjustVPC.PublicSubnetCIDRs, publicSubnetCIDRsFlag, nil, publicSubnetCIDRsFlagDescription)
 	cmd.Flags().StringSliceVar(&vars.AdjustVPC.PrivateSubnetCIDRs, privateSubnetCIDRsFlag, nil, privateSubnetCIDRsFlagDescription)
+	cmd.Flags().StringSliceVar(&vars.EgressEIPs, egressEIPsFlag, nil, egressEIPsFlagDescription)
+	cmd.Flags().StringSliceVar(&vars.ImportCertARNs, importCertARNsFlag, nil, importCertARNsFlagDescription)
 	cmd.Flags().BoolVar(&vars.NoCustomResources, noCustomResourcesFlag, false, noCustomResourcesFlagDescription)
+	cmd.Flags().StringVar(&vars.Preview, previewFlag, "", previewFlagDescription)
+	cmd.Flags().StringVar(&vars.Region, regionFlag, "", envRegionTokenFlagDescription)
+	cmd.Flags().StringVar(&vars.CloneFrom, fromFlag, "", fromFlagDescription)
+	// --clone-from is kept as an alias of --from.
+	cmd.Flags().StringVar(&vars.CloneFrom, cloneFromFlag, "", fromFlagDescription)
+	_ = cmd.Flags().MarkHidden(cloneFromFlag)
+	cmd.Flags().StringSliceVar(&vars.DeploySvcs, deploySvcsFlag, nil, deploySvcsFlagDescription)
+	cmd.Flags().DurationVar(&vars.TTL, ttlFlag, 0, ttlFlagDescription)
+	cmd.Flags().BoolVar(&vars.GlobalAccelerator.Enable, globalAcceleratorFlag, false, globalAcceleratorFlagDescription)
+	cmd.Flags().StringVar(&vars.GlobalAccelerator.HealthCheckPath, acceleratorHealthCheckPathFlag, "", acceleratorHealthCheckPathFlagDescription)
+	cmd.Flags().IntVar(&vars.GlobalAccelerator.HealthCheckInterval, acceleratorHealthCheckIntervalFlag, 0, acceleratorHealthCheckIntervalFlagDescription)
+	cmd.Flags().BoolVar(&vars.NoCache, noCacheFlag, false, noCacheFlagDescription)
 
 	flags := pflag.NewFlagSet("Required", pflag.ContinueOnError)
 	flags.AddFlag(cmd.Flags().Lookup(nameFlag))
 	flags.AddFlag(cmd.Flags().Lookup(profileFlag))
 	flags.AddFlag(cmd.Flags().Lookup(noCustomResourcesFlag))
 	flags.AddFlag(cmd.Flags().Lookup(prodEnvFlag))
+	flags.AddFlag(cmd.Flags().Lookup(protectedFlag))
+	flags.AddFlag(cmd.Flags().Lookup(regionFlag))
+	flags.AddFlag(cmd.Flags().Lookup(fromFlag))
+	flags.AddFlag(cmd.Flags().Lookup(noCacheFlag))
 
 	resourcesImportFlag := pflag.NewFlagSet("Import Existing Resources", pflag.ContinueOnError)
 	resourcesImportFlag.AddFlag(cmd.Flags().Lookup(vpcIDFlag))
+	resourcesImportFlag.AddFlag(cmd.Flags().Lookup(vpcTagsFlag))
 	resourcesImportFlag.AddFlag(cmd.Flags().Lookup(publicSubnetsFlag))
 	resourcesImportFlag.AddFlag(cmd.Flags().Lookup(privateSubnetsFlag))
+	resourcesImportFlag.AddFlag(cmd.Flags().Lookup(importCertARNsFlag))
+
+	previewFlags := pflag.NewFlagSet("Preview Environment", pflag.ContinueOnError)
+	previewFlags.AddFlag(cmd.Flags().Lookup(previewFlag))
+	previewFlags.AddFlag(cmd.Flags().Lookup(deploySvcsFlag))
+	previewFlags.AddFlag(cmd.Flags().Lookup(ttlFlag))
 
 	resourcesConfigFlag := pflag.NewFlagSet("Configure Default Resources", pflag.ContinueOnError)
 	resourcesConfigFlag.AddFlag(cmd.Flags().Lookup(vpcCIDRFlag))
 	resourcesConfigFlag.AddFlag(cmd.Flags().Lookup(publicSubnetCIDRsFlag))
 	resourcesConfigFlag.AddFlag(cmd.Flags().Lookup(privateSubnetCIDRsFlag))
+	resourcesConfigFlag.AddFlag(cmd.Flags().Lookup(egressEIPsFlag))
+
+	acceleratorFlags := pflag.NewFlagSet("Global Accelerator", pflag.ContinueOnError)
+	acceleratorFlags.AddFlag(cmd.Flags().Lookup(globalAcceleratorFlag))
+	acceleratorFlags.AddFlag(cmd.Flags().Lookup(acceleratorHealthCheckPathFlag))
+	acceleratorFlags.AddFlag(cmd.Flags().Lookup(acceleratorHealthCheckIntervalFlag))
 
 	cmd.Annotations = map[string]string{
 		// The order of the sections we want to display.
-		"sections":                    "Flags,Import Existing Resources,Configure Default Resources",
+		"sections":                    "Flags,Import Existing Resources,Configure Default Resources,Global Accelerator,Preview Environment",
 		"Flags":                       flags.FlagUsages(),
 		"Import Existing Resources":   resourcesImportFlag.FlagUsages(),
 		"Configure Default Resources": resourcesConfigFlag.FlagUsages(),
+		"Global Accelerator":          acceleratorFlags.FlagUsages(),
+		"Preview Environment":         previewFlags.FlagUsages(),
 	}
 
 	cmd.SetUsageTemplate(`{{h1 "Usage"}}{{if .Runnable}}
@@ -8,8 +8,10 @@ import (
 	"fmt"
 	"net"
 	"testing"
+	"time"
 
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
@@ -22,9 +24,10 @@ import (
 )
 
 type initEnvMocks struct {
-	prompt *mocks.Mockprompter
-	sel    *mocks.Mockec2Selector
-	config *mocks.MockprofileNames
+	prompt   *mocks.Mockprompter
+	sel      *mocks.Mockec2Selector
+	config   *mocks.MockprofileNames
+	identity *mocks.MockidentityService
 }
 
 func TestInitEnvOpts_Validate(t *testing.T) {
@@ -42,6 +45,16 @@ func TestInitEnvOpts_Validate(t *testing.T) {
 		inSecretAccessKey string
 		inSessionToken    string
 
+		inProd        bool
+		inPreview     string
+		inCloneFrom   string
+		inDeploySvcs  []string
+		inTTL         time.Duration
+		inAccelerator globalAcceleratorVars
+		inEgressEIPs  []string
+		inCertARNs    []string
+		expectStore   func(m *mocks.Mockstore)
+
 		wantedErrMsg string
 	}{
 		"valid environment creation": {
@@ -105,14 +118,137 @@ func TestInitEnvOpts_Validate(t *testing.T) {
 
 			wantedErrMsg: "cannot specify both --profile and --aws-session-token",
 		},
+		"should err if ttl is negative": {
+			inAppName: "phonetool",
+			inEnvName: "test",
+			inTTL:     -time.Hour,
+
+			wantedErrMsg: "--ttl must be a positive duration",
+		},
+		"should err if accelerator health check is set without global accelerator": {
+			inAppName:     "phonetool",
+			inEnvName:     "test",
+			inAccelerator: globalAcceleratorVars{HealthCheckPath: "/healthz"},
+
+			wantedErrMsg: "--accelerator-health-check-path and --accelerator-health-check-interval can only be used with --global-accelerator",
+		},
+		"should err if accelerator health check path is relative": {
+			inAppName:     "phonetool",
+			inEnvName:     "test",
+			inAccelerator: globalAcceleratorVars{Enable: true, HealthCheckPath: "healthz"},
+
+			wantedErrMsg: "--accelerator-health-check-path healthz must start with /",
+		},
+		"should err if accelerator health check interval is invalid": {
+			inAppName:     "phonetool",
+			inEnvName:     "test",
+			inAccelerator: globalAcceleratorVars{Enable: true, HealthCheckInterval: 20},
+
+			wantedErrMsg: "--accelerator-health-check-interval must be either 10 or 30 seconds",
+		},
+		"valid environment creation with global accelerator": {
+			inAppName:     "phonetool",
+			inEnvName:     "test",
+			inAccelerator: globalAcceleratorVars{Enable: true, HealthCheckPath: "/healthz", HealthCheckInterval: 10},
+		},
+		"should err if egress eips are set with an imported vpc": {
+			inAppName:    "phonetool",
+			inEnvName:    "test",
+			inVPCID:      "mockID",
+			inEgressEIPs: []string{"eipalloc-1", "eipalloc-2"},
+
+			wantedErrMsg: "cannot specify both --egress-eips and import vpc flags",
+		},
+		"should err if egress eips are not allocation IDs": {
+			inAppName:    "phonetool",
+			inEnvName:    "test",
+			inEgressEIPs: []string{"3.3.3.3"},
+
+			wantedErrMsg: "--egress-eips 3.3.3.3 must be an Elastic IP allocation ID (e.g. eipalloc-0123456789abcdef0)",
+		},
+		"should err if imported certificates are not ACM certificate ARNs": {
+			inAppName:  "phonetool",
+			inEnvName:  "test",
+			inCertARNs: []string{"arn:aws:acm:us-west-2:123456789012:certificate/1", "arn:aws:iam::123456789012:server-certificate/test"},
+
+			wantedErrMsg: "validate --import-cert-arns: arn:aws:iam::123456789012:server-certificate/test is not an ACM certificate ARN",
+		},
+		"should err if deploy svcs is set without preview": {
+			inAppName:    "phonetool",
+			inEnvName:    "test",
+			inDeploySvcs: []string{"frontend"},
+
+			wantedErrMsg: "--deploy-svcs can only be used with --preview",
+		},
+		"should err if both name and preview are set": {
+			inAppName: "phonetool",
+			inEnvName: "test",
+			inPreview: "pr-123",
+
+			wantedErrMsg: "cannot specify both --name and --preview",
+		},
+		"should err if preview environment is production": {
+			inAppName: "phonetool",
+			inPreview: "pr-123",
+			inProd:    true,
+
+			wantedErrMsg: "cannot specify both --prod and --preview",
+		},
+		"should err if preview name is invalid": {
+			inAppName: "phonetool",
+			inPreview: "123",
+
+			wantedErrMsg: fmt.Sprintf("environment name 123 is invalid: %s", errValueBadFormat),
+		},
+		"should err if cloning and configuring vpc": {
+			inAppName:   "phonetool",
+			inPreview:   "pr-123",
+			inCloneFrom: "test",
+			inVPCID:     "mockID",
+
+			wantedErrMsg: "cannot import or configure vpc if --from is set",
+		},
+		"should err if environment to clone does not exist": {
+			inAppName:   "phonetool",
+			inPreview:   "pr-123",
+			inCloneFrom: "test",
+			expectStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(nil, errors.New("some error"))
+			},
+
+			wantedErrMsg: "get environment test configuration: some error",
+		},
+		"valid preview environment creation": {
+			inAppName:    "phonetool",
+			inPreview:    "pr-123",
+			inCloneFrom:  "test",
+			inDeploySvcs: []string{"frontend"},
+			expectStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{Name: "test"}, nil)
+			},
+		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
 			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockStore := mocks.NewMockstore(ctrl)
+			if tc.expectStore != nil {
+				tc.expectStore(mockStore)
+			}
 			opts := &initEnvOpts{
 				initEnvVars: initEnvVars{
 					Name:              tc.inEnvName,
+					IsProduction:      tc.inProd,
+					Preview:           tc.inPreview,
+					CloneFrom:         tc.inCloneFrom,
+					DeploySvcs:        tc.inDeploySvcs,
+					TTL:               tc.inTTL,
+					GlobalAccelerator: tc.inAccelerator,
+					EgressEIPs:        tc.inEgressEIPs,
+					ImportCertARNs:    tc.inCertARNs,
 					NoCustomResources: tc.inNoCustomResources,
 					AdjustVPC: adjustVPCVars{
 						PublicSubnetCIDRs: tc.inPublicCIDRs,
@@ -130,6 +266,7 @@ func TestInitEnvOpts_Validate(t *testing.T) {
 						SessionToken:    tc.inSessionToken,
 					},
 				},
+				store: mockStore,
 			}
 
 			// WHEN
@@ -158,11 +295,90 @@ func TestInitEnvOpts_Ask(t *testing.T) {
 		inDefault       bool
 		inImportVPCVars importVPCVars
 		inAdjustVPCVars adjustVPCVars
+		inRegion        string
+		inPreview       string
+		inCloneSource   *config.Environment
 
 		setupMocks func(mocks initEnvMocks)
 
 		wantedError error
 	}{
+		"skips prompting for customized resources when cloning an environment": {
+			inEnv:     mockEnv,
+			inProfile: mockProfile,
+			inCloneSource: &config.Environment{
+				Name:   "staging",
+				Region: "us-west-2",
+				CustomConfig: &config.CustomizeEnv{
+					VPCConfig: &config.AdjustVPC{
+						CIDR:               mockVPCCIDR,
+						PublicSubnetCIDRs:  []string{"10.10.10.10/24"},
+						PrivateSubnetCIDRs: []string{"10.10.10.10/24"},
+					},
+				},
+			},
+			setupMocks: func(m initEnvMocks) {
+				m.prompt.EXPECT().Get(fmt.Sprintf(fmtEnvInitRegionPrompt, mockEnv), envInitRegionHelpPrompt, nil, gomock.Any()).
+					Return("us-east-1", nil)
+			},
+		},
+		"fail to get the region when cloning an environment": {
+			inEnv:         mockEnv,
+			inProfile:     mockProfile,
+			inCloneSource: &config.Environment{Name: "staging", Region: "us-west-2"},
+			setupMocks: func(m initEnvMocks) {
+				m.prompt.EXPECT().Get(fmt.Sprintf(fmtEnvInitRegionPrompt, mockEnv), envInitRegionHelpPrompt, nil, gomock.Any()).
+					Return("", mockErr)
+			},
+			wantedError: fmt.Errorf("get the region: some error"),
+		},
+		"reuses the imported VPC when cloning a preview environment in the same account": {
+			inEnv:     mockEnv,
+			inProfile: mockProfile,
+			inPreview: mockEnv,
+			inCloneSource: &config.Environment{
+				Name:      "staging",
+				Region:    "us-west-2",
+				AccountID: "1234",
+				CustomConfig: &config.CustomizeEnv{
+					ImportVPC: &config.ImportVPC{
+						ID:               "mockVPCID",
+						PublicSubnetIDs:  []string{"mockPublicSubnetID"},
+						PrivateSubnetIDs: []string{"mockPrivateSubnetID"},
+					},
+				},
+			},
+			setupMocks: func(m initEnvMocks) {
+				m.identity.EXPECT().Get().Return(identity.Caller{Account: "1234"}, nil)
+			},
+		},
+		"prompts for VPC resources when cloning an imported VPC into another account": {
+			inEnv:     mockEnv,
+			inProfile: mockProfile,
+			inRegion:  "us-west-2",
+			inCloneSource: &config.Environment{
+				Name:      "staging",
+				Region:    "us-west-2",
+				AccountID: "1234",
+				CustomConfig: &config.CustomizeEnv{
+					ImportVPC: &config.ImportVPC{
+						ID:               "mockVPCID",
+						PublicSubnetIDs:  []string{"mockPublicSubnetID"},
+						PrivateSubnetIDs: []string{"mockPrivateSubnetID"},
+					},
+				},
+			},
+			setupMocks: func(m initEnvMocks) {
+				gomock.InOrder(
+					m.identity.EXPECT().Get().Return(identity.Caller{Account: "5678"}, nil),
+					m.sel.EXPECT().VPC(gomock.Any(), envInitVPCSelectPrompt, "").Return("mockVPCID2", nil),
+					m.sel.EXPECT().PublicSubnets(gomock.Any(), envInitPublicSubnetsSelectPrompt, "", "mockVPCID2").
+						Return([]string{"mockPublicSubnetID2"}, nil),
+					m.sel.EXPECT().PrivateSubnets(gomock.Any(), envInitPrivateSubnetsSelectPrompt, "", "mockVPCID2").
+						Return([]string{"mockPrivateSubnetID2"}, nil),
+				)
+			},
+		},
 		"fail to get env name": {
 			setupMocks: func(m initEnvMocks) {
 				gomock.InOrder(
@@ -255,7 +471,7 @@ func TestInitEnvOpts_Ask(t *testing.T) {
 						Return(mockProfile, nil),
 					m.prompt.EXPECT().SelectOne(envInitDefaultEnvConfirmPrompt, "", envInitCustomizedEnvTypes).
 						Return(envInitImportEnvResourcesSelectOption, nil),
-					m.sel.EXPECT().VPC(envInitVPCSelectPrompt, "").Return("", mockErr),
+					m.sel.EXPECT().VPC(gomock.Any(), envInitVPCSelectPrompt, "").Return("", mockErr),
 				)
 			},
 			wantedError: fmt.Errorf("select VPC: some error"),
@@ -272,13 +488,37 @@ func TestInitEnvOpts_Ask(t *testing.T) {
 						Return(mockProfile, nil),
 					m.prompt.EXPECT().SelectOne(envInitDefaultEnvConfirmPrompt, "", envInitCustomizedEnvTypes).
 						Return(envInitImportEnvResourcesSelectOption, nil),
-					m.sel.EXPECT().VPC(envInitVPCSelectPrompt, "").Return("mockVPC", nil),
-					m.sel.EXPECT().PublicSubnets(envInitPublicSubnetsSelectPrompt, "", "mockVPC").
+					m.sel.EXPECT().VPC(gomock.Any(), envInitVPCSelectPrompt, "").Return("mockVPC", nil),
+					m.sel.EXPECT().PublicSubnets(gomock.Any(), envInitPublicSubnetsSelectPrompt, "", "mockVPC").
 						Return(nil, mockErr),
 				)
 			},
 			wantedError: fmt.Errorf("select public subnets: some error"),
 		},
+		"only shows the VPCs with the tags to import": {
+			inImportVPCVars: importVPCVars{
+				Tags: map[string]string{"team": "payments", "env": ""},
+			},
+			setupMocks: func(m initEnvMocks) {
+				gomock.InOrder(
+					m.prompt.EXPECT().
+						Get(envInitNamePrompt, envInitNameHelpPrompt, gomock.Any()).
+						Return(mockEnv, nil),
+					m.config.EXPECT().Names().Return([]string{mockProfile}),
+					m.prompt.EXPECT().
+						SelectOne(fmt.Sprintf(fmtEnvInitProfilePrompt, mockEnv), envInitProfileHelpPrompt, gomock.Any()).
+						Return(mockProfile, nil),
+					m.prompt.EXPECT().SelectOne(envInitDefaultEnvConfirmPrompt, "", envInitCustomizedEnvTypes).
+						Return(envInitImportEnvResourcesSelectOption, nil),
+					m.sel.EXPECT().VPC(gomock.Any(), envInitVPCSelectPrompt, "", ec2.FilterForTags("env"), ec2.FilterForTags("team", "payments")).
+						Return("mockVPC", nil),
+					m.sel.EXPECT().PublicSubnets(gomock.Any(), envInitPublicSubnetsSelectPrompt, "", "mockVPC").
+						Return([]string{"mockPublicSubnet"}, nil),
+					m.sel.EXPECT().PrivateSubnets(gomock.Any(), envInitPrivateSubnetsSelectPrompt, "", "mockVPC").
+						Return([]string{"mockPrivateSubnet"}, nil),
+				)
+			},
+		},
 		"fail to select private subnets": {
 			setupMocks: func(m initEnvMocks) {
 				gomock.InOrder(
@@ -291,10 +531,10 @@ func TestInitEnvOpts_Ask(t *testing.T) {
 						Return(mockProfile, nil),
 					m.prompt.EXPECT().SelectOne(envInitDefaultEnvConfirmPrompt, "", envInitCustomizedEnvTypes).
 						Return(envInitImportEnvResourcesSelectOption, nil),
-					m.sel.EXPECT().VPC(envInitVPCSelectPrompt, "").Return("mockVPC", nil),
-					m.sel.EXPECT().PublicSubnets(envInitPublicSubnetsSelectPrompt, "", "mockVPC").
+					m.sel.EXPECT().VPC(gomock.Any(), envInitVPCSelectPrompt, "").Return("mockVPC", nil),
+					m.sel.EXPECT().PublicSubnets(gomock.Any(), envInitPublicSubnetsSelectPrompt, "", "mockVPC").
 						Return([]string{"mockPublicSubnet"}, nil),
-					m.sel.EXPECT().PrivateSubnets(envInitPrivateSubnetsSelectPrompt, "", "mockVPC").
+					m.sel.EXPECT().PrivateSubnets(gomock.Any(), envInitPrivateSubnetsSelectPrompt, "", "mockVPC").
 						Return(nil, mockErr),
 				)
 			},
@@ -312,10 +552,10 @@ func TestInitEnvOpts_Ask(t *testing.T) {
 						Return(mockProfile, nil),
 					m.prompt.EXPECT().SelectOne(envInitDefaultEnvConfirmPrompt, "", envInitCustomizedEnvTypes).
 						Return(envInitImportEnvResourcesSelectOption, nil),
-					m.sel.EXPECT().VPC(envInitVPCSelectPrompt, "").Return("mockVPC", nil),
-					m.sel.EXPECT().PublicSubnets(envInitPublicSubnetsSelectPrompt, "", "mockVPC").
+					m.sel.EXPECT().VPC(gomock.Any(), envInitVPCSelectPrompt, "").Return("mockVPC", nil),
+					m.sel.EXPECT().PublicSubnets(gomock.Any(), envInitPublicSubnetsSelectPrompt, "", "mockVPC").
 						Return([]string{"mockPublicSubnet"}, nil),
-					m.sel.EXPECT().PrivateSubnets(envInitPrivateSubnetsSelectPrompt, "", "mockVPC").
+					m.sel.EXPECT().PrivateSubnets(gomock.Any(), envInitPrivateSubnetsSelectPrompt, "", "mockVPC").
 						Return([]string{"mockPrivateSubnet"}, nil),
 				)
 			},
@@ -454,11 +694,13 @@ func TestInitEnvOpts_Ask(t *testing.T) {
 			mockPrompter := mocks.NewMockprompter(ctrl)
 			mockCfg := mocks.NewMockprofileNames(ctrl)
 			mockSel := mocks.NewMockec2Selector(ctrl)
+			mockIdentity := mocks.NewMockidentityService(ctrl)
 
 			mocks := initEnvMocks{
-				prompt: mockPrompter,
-				config: mockCfg,
-				sel:    mockSel,
+				prompt:   mockPrompter,
+				config:   mockCfg,
+				sel:      mockSel,
+				identity: mockIdentity,
 			}
 
 			tc.setupMocks(mocks)
@@ -470,12 +712,16 @@ func TestInitEnvOpts_Ask(t *testing.T) {
 					NoCustomResources: tc.inDefault,
 					AdjustVPC:         tc.inAdjustVPCVars,
 					ImportVPC:         tc.inImportVPCVars,
+					Region:            tc.inRegion,
+					Preview:           tc.inPreview,
 					GlobalOpts: &GlobalOpts{
 						prompt: mockPrompter,
 					},
 				},
 				profileConfig: mockCfg,
 				sel:           mockSel,
+				envIdentity:   mockIdentity,
+				cloneSource:   tc.inCloneSource,
 				configureRuntimeClients: func(o *initEnvOpts) error {
 					return nil
 				},
@@ -497,14 +743,26 @@ func TestInitEnvOpts_Ask(t *testing.T) {
 
 func TestInitEnvOpts_Execute(t *testing.T) {
 	testCases := map[string]struct {
-		inAppName string
-		inEnvName string
-		inProd    bool
+		inAppName     string
+		inEnvName     string
+		inProd        bool
+		inPreview     string
+		inDeploySvcs  []string
+		inAccelerator globalAcceleratorVars
+		inEgressEIPs  []string
+		inCertARNs    []string
+		inImportVPC   importVPCVars
 
-		expectstore    func(m *mocks.Mockstore)
-		expectDeployer func(m *mocks.Mockdeployer)
-		expectIdentity func(m *mocks.MockidentityService)
-		expectProgress func(m *mocks.Mockprogress)
+		expectstore     func(m *mocks.Mockstore)
+		expectDeployer  func(m *mocks.Mockdeployer)
+		expectIdentity  func(m *mocks.MockidentityService)
+		expectProgress  func(m *mocks.Mockprogress)
+		expectSvcCmd    func(m *mocks.MockactionCommand)
+		expectSubnets   func(m *mocks.MocksubnetsDescriber)
+		expectEndpoints func(m *mocks.MockvpcEndpointsLister)
+		expectEgress    func(m *mocks.MockvpcEgressDescriber)
+		expectVPCDNS    func(m *mocks.MockvpcDNSConfigurer)
+		expectPrompt    func(m *mocks.Mockprompter)
 
 		wantedErrorS string
 	}{
@@ -782,6 +1040,48 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 				m.EXPECT().AddEnvToApp(gomock.Any(), gomock.Any()).Return(nil)
 			},
 		},
+		"success with a preview environment and deployed services": {
+			inAppName:    "phonetool",
+			inEnvName:    "pr-123",
+			inPreview:    "pr-123",
+			inDeploySvcs: []string{"frontend"},
+
+			expectstore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.EXPECT().CreateEnvironment(&config.Environment{
+					App:       "phonetool",
+					Name:      "pr-123",
+					AccountID: "1234",
+					Region:    "mars-1",
+					Preview:   true,
+				}).Return(nil)
+			},
+			expectIdentity: func(m *mocks.MockidentityService) {
+				m.EXPECT().Get().Return(identity.Caller{RootUserARN: "some arn"}, nil)
+			},
+			expectProgress: func(m *mocks.Mockprogress) {
+				m.EXPECT().Start(fmt.Sprintf(fmtDeployEnvStart, "pr-123"))
+				m.EXPECT().Stop(log.Ssuccessf(fmtDeployEnvComplete, "pr-123", "phonetool"))
+				m.EXPECT().Start(fmt.Sprintf(fmtAddEnvToAppStart, "1234", "mars-1", "phonetool"))
+				m.EXPECT().Stop(log.Ssuccessf(fmtAddEnvToAppComplete, "1234", "mars-1", "phonetool"))
+			},
+			expectDeployer: func(m *mocks.Mockdeployer) {
+				m.EXPECT().DeployEnvironment(gomock.Any()).Return(&cloudformation.ErrStackAlreadyExists{})
+				m.EXPECT().GetEnvironment("phonetool", "pr-123").Return(&config.Environment{
+					AccountID: "1234",
+					Region:    "mars-1",
+					Name:      "pr-123",
+					App:       "phonetool",
+				}, nil)
+				m.EXPECT().AddEnvToApp(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			expectSvcCmd: func(m *mocks.MockactionCommand) {
+				m.EXPECT().Validate().Return(nil)
+				m.EXPECT().Ask().Return(nil)
+				m.EXPECT().Execute().Return(errors.New("some error"))
+			},
+			wantedErrorS: "deploy service frontend to environment pr-123: some error",
+		},
 		"skips creating stack if environment stack already exists": {
 			inAppName: "phonetool",
 			inEnvName: "test",
@@ -820,6 +1120,399 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 				m.EXPECT().AddEnvToApp(gomock.Any(), gomock.Any()).Return(nil)
 			},
 		},
+		"errors if there is not one egress eip per availability zone": {
+			inAppName:    "phonetool",
+			inEnvName:    "test",
+			inEgressEIPs: []string{"eipalloc-1"},
+
+			expectstore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+			},
+			wantedErrorS: "--egress-eips requires one Elastic IP per availability zone: got 1 for 2 public and 2 private subnets",
+		},
+		"deploys NAT gateways with the egress eips": {
+			inAppName:    "phonetool",
+			inEnvName:    "test",
+			inEgressEIPs: []string{"eipalloc-1", "eipalloc-2"},
+
+			expectstore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+			},
+			expectIdentity: func(m *mocks.MockidentityService) {
+				m.EXPECT().Get().Return(identity.Caller{RootUserARN: "some arn"}, nil)
+			},
+			expectProgress: func(m *mocks.Mockprogress) {
+				m.EXPECT().Start(fmt.Sprintf(fmtDeployEnvStart, "test"))
+				m.EXPECT().Stop(log.Serrorf(fmtDeployEnvFailed, "test"))
+			},
+			expectDeployer: func(m *mocks.Mockdeployer) {
+				m.EXPECT().DeployEnvironment(&deploy.CreateEnvironmentInput{
+					Name:                     "test",
+					AppName:                  "phonetool",
+					PublicLoadBalancer:       true,
+					ToolsAccountPrincipalARN: "some arn",
+					EgressEIPAllocationIDs:   []string{"eipalloc-1", "eipalloc-2"},
+				}).Return(errors.New("some deploy error"))
+			},
+			wantedErrorS: "some deploy error",
+		},
+		"errors if fail to list availability zones for the imported subnets": {
+			inAppName: "phonetool",
+			inEnvName: "test",
+			inImportVPC: importVPCVars{
+				ID:               "vpc-1",
+				PublicSubnetIDs:  []string{"subnet-1", "subnet-2"},
+				PrivateSubnetIDs: []string{"subnet-3", "subnet-4"},
+			},
+
+			expectstore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+			},
+			expectSubnets: func(m *mocks.MocksubnetsDescriber) {
+				m.EXPECT().ListAvailabilityZones(gomock.Any(), ec2.FilterForAvailabilityZoneType, ec2.FilterForOptedInZones).Return(nil, errors.New("some error"))
+			},
+			wantedErrorS: "list availability zones: some error",
+		},
+		"errors if an imported subnet is in a local zone": {
+			inAppName: "phonetool",
+			inEnvName: "test",
+			inImportVPC: importVPCVars{
+				ID:               "vpc-1",
+				PublicSubnetIDs:  []string{"subnet-1", "subnet-2"},
+				PrivateSubnetIDs: []string{"subnet-3", "subnet-4"},
+			},
+
+			expectstore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+			},
+			expectSubnets: func(m *mocks.MocksubnetsDescriber) {
+				m.EXPECT().ListAvailabilityZones(gomock.Any(), gomock.Any(), gomock.Any()).Return([]string{"us-west-2a", "us-west-2b"}, nil)
+				m.EXPECT().SubnetsDetailed(gomock.Any(), ec2.Filter{
+					Name:   "subnet-id",
+					Values: []string{"subnet-1", "subnet-2", "subnet-3", "subnet-4"},
+				}).Return([]ec2.Subnet{
+					{ID: "subnet-1", AZ: "us-west-2a"},
+					{ID: "subnet-2", AZ: "us-west-2-lax-1a"},
+					{ID: "subnet-3", AZ: "us-west-2a"},
+					{ID: "subnet-4", AZ: "us-west-2b"},
+				}, nil)
+			},
+			wantedErrorS: "imported public subnet subnet-2 is in us-west-2-lax-1a, which is not an available availability zone",
+		},
+		"errors if the imported private subnets are in a single availability zone": {
+			inAppName: "phonetool",
+			inEnvName: "test",
+			inImportVPC: importVPCVars{
+				ID:               "vpc-1",
+				PublicSubnetIDs:  []string{"subnet-1", "subnet-2"},
+				PrivateSubnetIDs: []string{"subnet-3", "subnet-4"},
+			},
+
+			expectstore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+			},
+			expectSubnets: func(m *mocks.MocksubnetsDescriber) {
+				m.EXPECT().ListAvailabilityZones(gomock.Any(), gomock.Any(), gomock.Any()).Return([]string{"us-west-2a", "us-west-2b"}, nil)
+				m.EXPECT().SubnetsDetailed(gomock.Any(), gomock.Any()).Return([]ec2.Subnet{
+					{ID: "subnet-1", AZ: "us-west-2a"},
+					{ID: "subnet-2", AZ: "us-west-2b"},
+					{ID: "subnet-3", AZ: "us-west-2a"},
+					{ID: "subnet-4", AZ: "us-west-2a"},
+				}, nil)
+			},
+			wantedErrorS: "imported private subnets must span at least 2 availability zones: got us-west-2a",
+		},
+		"deploys the imported subnets that span multiple availability zones": {
+			inAppName: "phonetool",
+			inEnvName: "test",
+			inImportVPC: importVPCVars{
+				ID:               "vpc-1",
+				PublicSubnetIDs:  []string{"subnet-1", "subnet-2"},
+				PrivateSubnetIDs: []string{"subnet-3", "subnet-4"},
+			},
+
+			expectstore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+			},
+			expectSubnets: func(m *mocks.MocksubnetsDescriber) {
+				m.EXPECT().ListAvailabilityZones(gomock.Any(), gomock.Any(), gomock.Any()).Return([]string{"us-west-2a", "us-west-2b"}, nil)
+				m.EXPECT().SubnetsDetailed(gomock.Any(), gomock.Any()).Return([]ec2.Subnet{
+					{ID: "subnet-1", AZ: "us-west-2a"},
+					{ID: "subnet-2", AZ: "us-west-2b"},
+					{ID: "subnet-3", AZ: "us-west-2a"},
+					{ID: "subnet-4", AZ: "us-west-2b"},
+				}, nil)
+			},
+			expectIdentity: func(m *mocks.MockidentityService) {
+				m.EXPECT().Get().Return(identity.Caller{RootUserARN: "some arn"}, nil)
+			},
+			expectProgress: func(m *mocks.Mockprogress) {
+				m.EXPECT().Start(fmt.Sprintf(fmtDeployEnvStart, "test"))
+				m.EXPECT().Stop(log.Serrorf(fmtDeployEnvFailed, "test"))
+			},
+			expectDeployer: func(m *mocks.Mockdeployer) {
+				m.EXPECT().DeployEnvironment(gomock.Any()).Return(errors.New("some deploy error"))
+			},
+			wantedErrorS: "some deploy error",
+		},
+		"errors if the user doesn't enable DNS in an imported VPC": {
+			inAppName: "phonetool",
+			inEnvName: "test",
+			inImportVPC: importVPCVars{
+				ID:               "vpc-1",
+				PublicSubnetIDs:  []string{},
+				PrivateSubnetIDs: []string{"subnet-3", "subnet-4"},
+			},
+
+			expectstore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+			},
+			expectSubnets: func(m *mocks.MocksubnetsDescriber) {
+				m.EXPECT().ListAvailabilityZones(gomock.Any(), gomock.Any(), gomock.Any()).Return([]string{"us-west-2a", "us-west-2b"}, nil)
+				m.EXPECT().SubnetsDetailed(gomock.Any(), gomock.Any()).Return([]ec2.Subnet{
+					{ID: "subnet-3", AZ: "us-west-2a"},
+					{ID: "subnet-4", AZ: "us-west-2b"},
+				}, nil)
+			},
+			expectVPCDNS: func(m *mocks.MockvpcDNSConfigurer) {
+				m.EXPECT().VPCDNSAttributes(gomock.Any(), "vpc-1").Return(&ec2.VPCDNSAttributes{DNSSupport: true}, nil)
+				m.EXPECT().EnableVPCDNS(gomock.Any(), gomock.Any()).Times(0)
+			},
+			expectPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Confirm(gomock.Any(), envInitEnableVPCDNSHelp).Return(false, nil)
+			},
+			wantedErrorS: "VPC vpc-1 must have enableDnsSupport and enableDnsHostnames set to true for service discovery",
+		},
+		"errors if fail to enable DNS in an imported VPC": {
+			inAppName: "phonetool",
+			inEnvName: "test",
+			inImportVPC: importVPCVars{
+				ID:               "vpc-1",
+				PublicSubnetIDs:  []string{},
+				PrivateSubnetIDs: []string{"subnet-3", "subnet-4"},
+			},
+
+			expectstore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+			},
+			expectSubnets: func(m *mocks.MocksubnetsDescriber) {
+				m.EXPECT().ListAvailabilityZones(gomock.Any(), gomock.Any(), gomock.Any()).Return([]string{"us-west-2a", "us-west-2b"}, nil)
+				m.EXPECT().SubnetsDetailed(gomock.Any(), gomock.Any()).Return([]ec2.Subnet{
+					{ID: "subnet-3", AZ: "us-west-2a"},
+					{ID: "subnet-4", AZ: "us-west-2b"},
+				}, nil)
+			},
+			expectVPCDNS: func(m *mocks.MockvpcDNSConfigurer) {
+				m.EXPECT().VPCDNSAttributes(gomock.Any(), "vpc-1").Return(&ec2.VPCDNSAttributes{}, nil)
+				m.EXPECT().EnableVPCDNS(gomock.Any(), "vpc-1").Return(errors.New("enable DNS support in VPC vpc-1: some error"))
+			},
+			expectPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Confirm(gomock.Any(), gomock.Any()).Return(true, nil)
+			},
+			wantedErrorS: "enable DNS support in VPC vpc-1: some error",
+		},
+		"errors if fail to list the VPC endpoints of an imported VPC without public subnets": {
+			inAppName: "phonetool",
+			inEnvName: "test",
+			inImportVPC: importVPCVars{
+				ID:               "vpc-1",
+				PublicSubnetIDs:  []string{},
+				PrivateSubnetIDs: []string{"subnet-3", "subnet-4"},
+			},
+
+			expectstore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+			},
+			expectSubnets: func(m *mocks.MocksubnetsDescriber) {
+				m.EXPECT().ListAvailabilityZones(gomock.Any(), gomock.Any(), gomock.Any()).Return([]string{"us-west-2a", "us-west-2b"}, nil)
+				m.EXPECT().SubnetsDetailed(gomock.Any(), gomock.Any()).Return([]ec2.Subnet{
+					{ID: "subnet-3", AZ: "us-west-2a"},
+					{ID: "subnet-4", AZ: "us-west-2b"},
+				}, nil)
+			},
+			expectEndpoints: func(m *mocks.MockvpcEndpointsLister) {
+				m.EXPECT().ListVPCEndpoints(gomock.Any(), "vpc-1").Return(nil, errors.New("some error"))
+			},
+			wantedErrorS: "list VPC endpoints: some error",
+		},
+		"deploys an imported VPC without public subnets that is missing VPC endpoints": {
+			inAppName: "phonetool",
+			inEnvName: "test",
+			inImportVPC: importVPCVars{
+				ID:               "vpc-1",
+				PublicSubnetIDs:  []string{},
+				PrivateSubnetIDs: []string{"subnet-3", "subnet-4"},
+			},
+
+			expectstore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+			},
+			expectSubnets: func(m *mocks.MocksubnetsDescriber) {
+				m.EXPECT().ListAvailabilityZones(gomock.Any(), gomock.Any(), gomock.Any()).Return([]string{"us-west-2a", "us-west-2b"}, nil)
+				m.EXPECT().SubnetsDetailed(gomock.Any(), gomock.Any()).Return([]ec2.Subnet{
+					{ID: "subnet-3", AZ: "us-west-2a"},
+					{ID: "subnet-4", AZ: "us-west-2b"},
+				}, nil)
+			},
+			expectEndpoints: func(m *mocks.MockvpcEndpointsLister) {
+				m.EXPECT().ListVPCEndpoints(gomock.Any(), "vpc-1").Return([]ec2.VPCEndpoint{
+					{ID: "vpce-1", ServiceName: "com.amazonaws.us-west-2.s3", Type: "Gateway"},
+				}, nil)
+			},
+			expectIdentity: func(m *mocks.MockidentityService) {
+				m.EXPECT().Get().Return(identity.Caller{RootUserARN: "some arn"}, nil)
+			},
+			expectProgress: func(m *mocks.Mockprogress) {
+				m.EXPECT().Start(fmt.Sprintf(fmtDeployEnvStart, "test"))
+				m.EXPECT().Stop(log.Serrorf(fmtDeployEnvFailed, "test"))
+			},
+			expectDeployer: func(m *mocks.Mockdeployer) {
+				m.EXPECT().DeployEnvironment(gomock.Any()).Return(errors.New("some deploy error"))
+			},
+			wantedErrorS: "some deploy error",
+		},
+		"returns error if the routes of the imported private subnets can't be checked": {
+			inAppName: "phonetool",
+			inEnvName: "test",
+			inImportVPC: importVPCVars{
+				ID:               "vpc-1",
+				PublicSubnetIDs:  []string{"subnet-1", "subnet-2"},
+				PrivateSubnetIDs: []string{"subnet-3", "subnet-4"},
+			},
+
+			expectstore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+			},
+			expectSubnets: func(m *mocks.MocksubnetsDescriber) {
+				m.EXPECT().ListAvailabilityZones(gomock.Any(), gomock.Any(), gomock.Any()).Return([]string{"us-west-2a", "us-west-2b"}, nil)
+				m.EXPECT().SubnetsDetailed(gomock.Any(), gomock.Any()).Return([]ec2.Subnet{
+					{ID: "subnet-1", AZ: "us-west-2a"},
+					{ID: "subnet-2", AZ: "us-west-2b"},
+					{ID: "subnet-3", AZ: "us-west-2a"},
+					{ID: "subnet-4", AZ: "us-west-2b"},
+				}, nil)
+			},
+			expectEgress: func(m *mocks.MockvpcEgressDescriber) {
+				m.EXPECT().SubnetsWithoutEgress(gomock.Any(), "subnet-3", "subnet-4").Return(nil, errors.New("some error"))
+			},
+			wantedErrorS: "check routes of private subnets: some error",
+		},
+		"deploys an imported VPC whose private subnets have no route to the internet": {
+			inAppName: "phonetool",
+			inEnvName: "test",
+			inImportVPC: importVPCVars{
+				ID:               "vpc-1",
+				PublicSubnetIDs:  []string{"subnet-1", "subnet-2"},
+				PrivateSubnetIDs: []string{"subnet-3", "subnet-4"},
+			},
+
+			expectstore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+			},
+			expectSubnets: func(m *mocks.MocksubnetsDescriber) {
+				m.EXPECT().ListAvailabilityZones(gomock.Any(), gomock.Any(), gomock.Any()).Return([]string{"us-west-2a", "us-west-2b"}, nil)
+				m.EXPECT().SubnetsDetailed(gomock.Any(), gomock.Any()).Return([]ec2.Subnet{
+					{ID: "subnet-1", AZ: "us-west-2a"},
+					{ID: "subnet-2", AZ: "us-west-2b"},
+					{ID: "subnet-3", AZ: "us-west-2a"},
+					{ID: "subnet-4", AZ: "us-west-2b"},
+				}, nil)
+			},
+			expectEgress: func(m *mocks.MockvpcEgressDescriber) {
+				m.EXPECT().SubnetsWithoutEgress(gomock.Any(), "subnet-3", "subnet-4").Return([]string{"subnet-4"}, nil)
+				m.EXPECT().ListNATGateways(gomock.Any(), "vpc-1").Return(nil, nil)
+				m.EXPECT().ListElasticIPs(gomock.Any()).Return([]ec2.ElasticIP{
+					{AllocationID: "eipalloc-1", AssociationID: "eipassoc-1"},
+					{AllocationID: "eipalloc-2"},
+				}, nil)
+			},
+			expectIdentity: func(m *mocks.MockidentityService) {
+				m.EXPECT().Get().Return(identity.Caller{RootUserARN: "some arn"}, nil)
+			},
+			expectProgress: func(m *mocks.Mockprogress) {
+				m.EXPECT().Start(fmt.Sprintf(fmtDeployEnvStart, "test"))
+				m.EXPECT().Stop(log.Serrorf(fmtDeployEnvFailed, "test"))
+			},
+			expectDeployer: func(m *mocks.Mockdeployer) {
+				m.EXPECT().DeployEnvironment(gomock.Any()).Return(errors.New("some deploy error"))
+			},
+			wantedErrorS: "some deploy error",
+		},
+		"deploys the HTTPS listener with the imported certificates": {
+			inAppName:  "phonetool",
+			inEnvName:  "test",
+			inCertARNs: []string{"arn:aws:acm:us-west-2:123456789012:certificate/1"},
+
+			expectstore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+			},
+			expectIdentity: func(m *mocks.MockidentityService) {
+				m.EXPECT().Get().Return(identity.Caller{RootUserARN: "some arn"}, nil)
+			},
+			expectProgress: func(m *mocks.Mockprogress) {
+				m.EXPECT().Start(fmt.Sprintf(fmtDeployEnvStart, "test"))
+				m.EXPECT().Stop(log.Serrorf(fmtDeployEnvFailed, "test"))
+			},
+			expectDeployer: func(m *mocks.Mockdeployer) {
+				m.EXPECT().DeployEnvironment(&deploy.CreateEnvironmentInput{
+					Name:                     "test",
+					AppName:                  "phonetool",
+					PublicLoadBalancer:       true,
+					ToolsAccountPrincipalARN: "some arn",
+					ImportCertARNs:           []string{"arn:aws:acm:us-west-2:123456789012:certificate/1"},
+				}).Return(errors.New("some deploy error"))
+			},
+			wantedErrorS: "some deploy error",
+		},
+		"stores the global accelerator configuration": {
+			inAppName:     "phonetool",
+			inEnvName:     "test",
+			inAccelerator: globalAcceleratorVars{Enable: true, HealthCheckPath: "/healthz"},
+
+			expectstore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.EXPECT().CreateEnvironment(&config.Environment{
+					App:       "phonetool",
+					Name:      "test",
+					AccountID: "1234",
+					Region:    "mars-1",
+					CustomConfig: &config.CustomizeEnv{
+						GlobalAccelerator: &config.GlobalAccelerator{
+							HealthCheckPath:     "/healthz",
+							HealthCheckInterval: 30,
+						},
+					},
+				}).Return(nil)
+			},
+			expectIdentity: func(m *mocks.MockidentityService) {
+				m.EXPECT().Get().Return(identity.Caller{RootUserARN: "some arn"}, nil)
+			},
+			expectProgress: func(m *mocks.Mockprogress) {
+				m.EXPECT().Start(fmt.Sprintf(fmtDeployEnvStart, "test"))
+				m.EXPECT().Stop(log.Ssuccessf(fmtDeployEnvComplete, "test", "phonetool"))
+				m.EXPECT().Start(fmt.Sprintf(fmtAddEnvToAppStart, "1234", "mars-1", "phonetool"))
+				m.EXPECT().Stop(log.Ssuccessf(fmtAddEnvToAppComplete, "1234", "mars-1", "phonetool"))
+			},
+			expectDeployer: func(m *mocks.Mockdeployer) {
+				m.EXPECT().DeployEnvironment(&deploy.CreateEnvironmentInput{
+					Name:                     "test",
+					AppName:                  "phonetool",
+					PublicLoadBalancer:       true,
+					ToolsAccountPrincipalARN: "some arn",
+					GlobalAcceleratorConfig: &deploy.GlobalAcceleratorConfig{
+						HealthCheckPath:            "/healthz",
+						HealthCheckIntervalSeconds: 30,
+					},
+				}).Return(&cloudformation.ErrStackAlreadyExists{})
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{
+					AccountID: "1234",
+					Region:    "mars-1",
+					Name:      "test",
+					App:       "phonetool",
+				}, nil)
+				m.EXPECT().AddEnvToApp(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
 		"failed to delegate DNS (app has Domain and env and apps are different)": {
 			inAppName: "phonetool",
 			inEnvName: "test",
@@ -902,12 +1595,43 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 			mockDeployer := mocks.NewMockdeployer(ctrl)
 			mockIdentity := mocks.NewMockidentityService(ctrl)
 			mockProgress := mocks.NewMockprogress(ctrl)
+			mockSvcCmd := mocks.NewMockactionCommand(ctrl)
+			mockWS := mocks.NewMocksvcManifestReader(ctrl)
+			mockSubnets := mocks.NewMocksubnetsDescriber(ctrl)
+			if tc.expectSubnets != nil {
+				tc.expectSubnets(mockSubnets)
+			}
+			mockEndpoints := mocks.NewMockvpcEndpointsLister(ctrl)
+			if tc.expectEndpoints != nil {
+				tc.expectEndpoints(mockEndpoints)
+			}
+			mockEgress := mocks.NewMockvpcEgressDescriber(ctrl)
+			if tc.expectEgress != nil {
+				tc.expectEgress(mockEgress)
+			} else {
+				mockEgress.EXPECT().SubnetsWithoutEgress(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+			}
+			mockVPCDNS := mocks.NewMockvpcDNSConfigurer(ctrl)
+			if tc.expectVPCDNS != nil {
+				tc.expectVPCDNS(mockVPCDNS)
+			} else {
+				mockVPCDNS.EXPECT().VPCDNSAttributes(gomock.Any(), gomock.Any()).Return(&ec2.VPCDNSAttributes{DNSSupport: true, DNSHostnames: true}, nil).AnyTimes()
+				mockVPCDNS.EXPECT().UsesAmazonProvidedDNS(gomock.Any(), gomock.Any()).Return(true, nil).AnyTimes()
+			}
+			mockPrompt := mocks.NewMockprompter(ctrl)
+			if tc.expectPrompt != nil {
+				tc.expectPrompt(mockPrompt)
+			}
+			if tc.expectSvcCmd != nil {
+				tc.expectSvcCmd(mockSvcCmd)
+			}
 			if tc.expectstore != nil {
 				tc.expectstore(mockstore)
 			}
 			if tc.expectDeployer != nil {
 				tc.expectDeployer(mockDeployer)
 			}
+			mockWS.EXPECT().ReadServiceManifest(gomock.Any()).Return([]byte("type: Backend Service"), nil).AnyTimes()
 			if tc.expectIdentity != nil {
 				tc.expectIdentity(mockIdentity)
 			}
@@ -917,9 +1641,15 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 
 			opts := &initEnvOpts{
 				initEnvVars: initEnvVars{
-					Name:         tc.inEnvName,
-					GlobalOpts:   &GlobalOpts{appName: tc.inAppName},
-					IsProduction: tc.inProd,
+					Name:              tc.inEnvName,
+					GlobalOpts:        &GlobalOpts{appName: tc.inAppName, prompt: mockPrompt},
+					IsProduction:      tc.inProd,
+					Preview:           tc.inPreview,
+					DeploySvcs:        tc.inDeploySvcs,
+					GlobalAccelerator: tc.inAccelerator,
+					EgressEIPs:        tc.inEgressEIPs,
+					ImportCertARNs:    tc.inCertARNs,
+					ImportVPC:         tc.inImportVPC,
 				},
 				store:       mockstore,
 				envDeployer: mockDeployer,
@@ -927,9 +1657,17 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 				identity:    mockIdentity,
 				envIdentity: mockIdentity,
 				prog:        mockProgress,
+				subnets:     mockSubnets,
+				endpoints:   mockEndpoints,
+				egress:      mockEgress,
+				vpcDNS:      mockVPCDNS,
 				configureRuntimeClients: func(o *initEnvOpts) error {
 					return nil
 				},
+				ws: mockWS,
+				newSvcDeployer: func(svcName, envName string) (actionCommand, error) {
+					return mockSvcCmd, nil
+				},
 			}
 
 			// WHEN
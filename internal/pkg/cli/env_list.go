@@ -93,6 +93,8 @@ func (o *listEnvOpts) humanOutput(envs []*config.Environment) string {
 	for _, env := range envs {
 		if env.Prod {
 			fmt.Fprintf(b, "%s (prod)\n", color.Prod(env.Name))
+		} else if env.Preview {
+			fmt.Fprintf(b, "%s (preview)\n", env.Name)
 		} else {
 			fmt.Fprintln(b, env.Name)
 		}
@@ -196,6 +196,29 @@ func TestEnvList_Execute(t *testing.T) {
 			},
 			expectedContent: "test\ntest2 (prod)\n",
 		},
+		"with preview envs": {
+			listOpts: listEnvOpts{
+				listEnvVars: listEnvVars{
+					GlobalOpts: &GlobalOpts{
+						appName: "coolapp",
+					},
+				},
+				store: mockstore,
+			},
+			mocking: func() {
+				mockstore.EXPECT().
+					GetApplication(gomock.Eq("coolapp")).
+					Return(&config.Application{}, nil)
+				mockstore.
+					EXPECT().
+					ListEnvironments(gomock.Eq("coolapp")).
+					Return([]*config.Environment{
+						{Name: "test"},
+						{Name: "pr-123", Preview: true},
+					}, nil)
+			},
+			expectedContent: "test\npr-123 (preview)\n",
+		},
 	}
 
 	for name, tc := range testCases {
@@ -4,6 +4,7 @@
 package cli
 
 import (
+	"context"
 	"fmt"
 	"io"
 
@@ -27,6 +28,9 @@ type showEnvVars struct {
 	*GlobalOpts
 	shouldOutputJSON      bool
 	shouldOutputResources bool
+	shouldOutputEgressIPs bool
+	shouldOutputCapacity  bool
+	noCache               bool
 	envName               string
 }
 
@@ -41,7 +45,11 @@ type showEnvOpts struct {
 }
 
 func newShowEnvOpts(vars showEnvVars) (*showEnvOpts, error) {
-	configStore, err := config.NewStore()
+	c, err := newLocalCache(vars.noCache)
+	if err != nil {
+		return nil, err
+	}
+	configStore, err := config.NewCachedStore(c)
 	if err != nil {
 		return nil, fmt.Errorf("connect to copilot config store: %w", err)
 	}
@@ -63,6 +71,9 @@ func newShowEnvOpts(vars showEnvVars) (*showEnvOpts, error) {
 			ConfigStore:     configStore,
 			DeployStore:     deployStore,
 			EnableResources: opts.shouldOutputResources,
+			EnableEgressIPs: opts.shouldOutputEgressIPs,
+			EnableCapacity:  opts.shouldOutputCapacity,
+			Cache:           c,
 		})
 		if err != nil {
 			return fmt.Errorf("creating describer for environment %s in application %s: %w", opts.envName, opts.AppName(), err)
@@ -102,7 +113,7 @@ func (o *showEnvOpts) Execute() error {
 	if err := o.initEnvDescriber(); err != nil {
 		return err
 	}
-	env, err := o.describer.Describe()
+	env, err := o.describer.Describe(context.Background())
 	if err != nil {
 		return fmt.Errorf("describe environment %s: %w", o.envName, err)
 	}
@@ -157,7 +168,13 @@ func BuildEnvShowCmd() *cobra.Command {
 
 		Example: `
   Shows info about the environment "test".
-  /code $ copilot env show -n test`,
+  /code $ copilot env show -n test
+
+  Shows the IP addresses that traffic from the "prod" environment's private subnets comes from.
+  /code $ copilot env show -n prod --egress-ips
+
+  Shows how many tasks run in the "prod" environment and how much of the account's Fargate vCPU quota is used.
+  /code $ copilot env show -n prod --capacity`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newShowEnvOpts(vars)
 			if err != nil {
@@ -175,6 +192,9 @@ func BuildEnvShowCmd() *cobra.Command {
 	// The flags bound by viper are available to all sub-commands through viper.GetString({flagName})
 	cmd.Flags().StringVarP(&vars.envName, nameFlag, nameFlagShort, "", envFlagDescription)
 	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	cmd.Flags().BoolVar(&vars.noCache, noCacheFlag, false, noCacheFlagDescription)
 	cmd.Flags().BoolVar(&vars.shouldOutputResources, resourcesFlag, false, envResourcesFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputEgressIPs, egressIPsFlag, false, egressIPsFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputCapacity, capacityFlag, false, capacityFlagDescription)
 	return cmd
 }
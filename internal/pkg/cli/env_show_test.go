@@ -265,7 +265,7 @@ func TestEnvShow_Execute(t *testing.T) {
 			inputEnv: "testEnv",
 			setupMocks: func(m showEnvMocks) {
 				gomock.InOrder(
-					m.describer.EXPECT().Describe().Return(nil, mockError),
+					m.describer.EXPECT().Describe(gomock.Any()).Return(nil, mockError),
 				)
 			},
 
@@ -276,7 +276,7 @@ func TestEnvShow_Execute(t *testing.T) {
 			shouldOutputJSON: true,
 			setupMocks: func(m showEnvMocks) {
 				gomock.InOrder(
-					m.describer.EXPECT().Describe().Return(&mockEnvDescription, mockError),
+					m.describer.EXPECT().Describe(gomock.Any()).Return(&mockEnvDescription, mockError),
 				)
 			},
 
@@ -286,7 +286,7 @@ func TestEnvShow_Execute(t *testing.T) {
 			inputEnv: "testEnv",
 			setupMocks: func(m showEnvMocks) {
 				gomock.InOrder(
-					m.describer.EXPECT().Describe().Return(&mockEnvDescription, nil),
+					m.describer.EXPECT().Describe(gomock.Any()).Return(&mockEnvDescription, nil),
 				)
 			},
 
@@ -297,7 +297,7 @@ func TestEnvShow_Execute(t *testing.T) {
 			shouldOutputJSON: true,
 			setupMocks: func(m showEnvMocks) {
 				gomock.InOrder(
-					m.describer.EXPECT().Describe().Return(&mockEnvDescription, nil),
+					m.describer.EXPECT().Describe(gomock.Any()).Return(&mockEnvDescription, nil),
 				)
 			},
 
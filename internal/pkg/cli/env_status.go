@@ -0,0 +1,221 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/cobra"
+)
+
+const (
+	envStatusAppNamePrompt     = "Which application is the environment in?"
+	envStatusAppNameHelpPrompt = "An application is a collection of related services."
+	envStatusNamePrompt        = "Which environment of %s would you like to check the status of?"
+	envStatusHelpPrompt        = "Displays the health of each service deployed in the environment."
+)
+
+type errEnvUnhealthy struct {
+	env string
+}
+
+func (e *errEnvUnhealthy) Error() string {
+	return fmt.Sprintf("environment %s is unhealthy", e.env)
+}
+
+// ExitCode returns the exit status of the CLI for the error.
+func (e *errEnvUnhealthy) ExitCode() int {
+	return ExitCodeUnhealthy
+}
+
+type envStatusVars struct {
+	*GlobalOpts
+	shouldOutputJSON bool
+	shouldCheck      bool
+	noCache          bool
+	envName          string
+}
+
+type envStatusOpts struct {
+	envStatusVars
+
+	w                  io.Writer
+	store              store
+	deployStore        deployedEnvironmentLister
+	ws                 wsAppSvcReader
+	sel                configSelector
+	newStatusDescriber func(o *envStatusOpts, svc string) (statusDescriber, error)
+	now                func() time.Time
+}
+
+func newEnvStatusOpts(vars envStatusVars) (*envStatusOpts, error) {
+	c, err := newLocalCache(vars.noCache)
+	if err != nil {
+		return nil, err
+	}
+	configStore, err := config.NewCachedStore(c)
+	if err != nil {
+		return nil, fmt.Errorf("connect to copilot config store: %w", err)
+	}
+	deployStore, err := deploy.NewStore(configStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to copilot deploy store: %w", err)
+	}
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace: %w", err)
+	}
+	return &envStatusOpts{
+		envStatusVars: vars,
+		store:         configStore,
+		deployStore:   deployStore,
+		ws:            ws,
+		w:             log.OutputWriter,
+		sel:           selector.NewConfigSelect(vars.prompt, configStore),
+		newStatusDescriber: func(o *envStatusOpts, svc string) (statusDescriber, error) {
+			d, err := describe.NewServiceStatus(&describe.NewServiceStatusConfig{
+				App:         o.AppName(),
+				Env:         o.envName,
+				Svc:         svc,
+				AlarmNames:  workspaceAlarmNames(o.ws, o.AppName(), svc),
+				ConfigStore: configStore,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("creating status describer for service %s in application %s: %w", svc, o.AppName(), err)
+			}
+			return d, nil
+		},
+		now: time.Now,
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *envStatusOpts) Validate() error {
+	if o.AppName() != "" {
+		if _, err := o.store.GetApplication(o.AppName()); err != nil {
+			return err
+		}
+	}
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.AppName(), o.envName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *envStatusOpts) Ask() error {
+	if err := o.askApp(); err != nil {
+		return err
+	}
+	return o.askEnvName()
+}
+
+// Execute displays the health of the services deployed in the environment.
+// If the check flag is set, it returns an error when any of the services is unhealthy.
+func (o *envStatusOpts) Execute() error {
+	svcs, err := o.deployStore.ListDeployedServices(o.AppName(), o.envName)
+	if err != nil {
+		return fmt.Errorf("list services deployed in environment %s: %w", o.envName, err)
+	}
+	statuses := make(map[string]*describe.ServiceStatusDesc)
+	for _, svc := range svcs {
+		d, err := o.newStatusDescriber(o, svc)
+		if err != nil {
+			return err
+		}
+		status, err := d.Describe()
+		if err != nil {
+			return fmt.Errorf("describe status of service %s: %w", svc, err)
+		}
+		statuses[svc] = status
+	}
+	envStatus := describe.NewEnvStatusDesc(o.envName, statuses, o.now())
+	if o.shouldOutputJSON {
+		data, err := envStatus.JSONString()
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(o.w, data)
+	} else {
+		fmt.Fprintf(o.w, envStatus.HumanString())
+	}
+	if o.shouldCheck && !envStatus.Healthy {
+		return &errEnvUnhealthy{env: o.envName}
+	}
+	return nil
+}
+
+func (o *envStatusOpts) askApp() error {
+	if o.AppName() != "" {
+		return nil
+	}
+	app, err := o.sel.Application(envStatusAppNamePrompt, envStatusAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *envStatusOpts) askEnvName() error {
+	if o.envName != "" {
+		return nil
+	}
+	env, err := o.sel.Environment(fmt.Sprintf(envStatusNamePrompt, color.HighlightUserInput(o.AppName())), envStatusHelpPrompt, o.AppName())
+	if err != nil {
+		return fmt.Errorf("select environment for application %s: %w", o.AppName(), err)
+	}
+	o.envName = env
+	return nil
+}
+
+// BuildEnvStatusCmd builds the command for showing the health of the services in an environment.
+func BuildEnvStatusCmd() *cobra.Command {
+	vars := envStatusVars{
+		GlobalOpts: NewGlobalOpts(),
+	}
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "Shows the health of the services in an environment.",
+		Long: `Shows the health of the services in an environment.
+A service is healthy if all of its tasks are running, none of its alarms are in ALARM,
+and its latest deployment isn't stuck.`,
+
+		Example: `
+  Shows the health of the services in the "test" environment.
+  /code $ copilot env status -n test
+
+  Fails a CI stage if any service in the "prod" environment is unhealthy.
+  /code $ copilot env status -n prod --check`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newEnvStatusOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.envName, nameFlag, nameFlagShort, "", envFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	cmd.Flags().BoolVar(&vars.noCache, noCacheFlag, false, noCacheFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldCheck, checkFlag, false, checkFlagDescription)
+	return cmd
+}
@@ -0,0 +1,214 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvStatus_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inApp      string
+		inEnv      string
+		setupMocks func(m *mocks.MockconfigSelector)
+
+		wantedApp   string
+		wantedEnv   string
+		wantedError error
+	}{
+		"doesn't prompt if the application and environment are provided": {
+			inApp:      "my-app",
+			inEnv:      "test",
+			setupMocks: func(m *mocks.MockconfigSelector) {},
+
+			wantedApp: "my-app",
+			wantedEnv: "test",
+		},
+		"prompts for the application and environment": {
+			setupMocks: func(m *mocks.MockconfigSelector) {
+				gomock.InOrder(
+					m.EXPECT().Application(envStatusAppNamePrompt, envStatusAppNameHelpPrompt).Return("my-app", nil),
+					m.EXPECT().Environment(fmt.Sprintf(envStatusNamePrompt, color.HighlightUserInput("my-app")), envStatusHelpPrompt, "my-app").Return("test", nil),
+				)
+			},
+
+			wantedApp: "my-app",
+			wantedEnv: "test",
+		},
+		"returns error if fail to select the environment": {
+			inApp: "my-app",
+			setupMocks: func(m *mocks.MockconfigSelector) {
+				m.EXPECT().Environment(fmt.Sprintf(envStatusNamePrompt, color.HighlightUserInput("my-app")), envStatusHelpPrompt, "my-app").Return("", errors.New("some error"))
+			},
+
+			wantedError: errors.New("select environment for application my-app: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSel := mocks.NewMockconfigSelector(ctrl)
+			tc.setupMocks(mockSel)
+
+			opts := &envStatusOpts{
+				envStatusVars: envStatusVars{
+					GlobalOpts: &GlobalOpts{
+						appName: tc.inApp,
+					},
+					envName: tc.inEnv,
+				},
+				sel: mockSel,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedApp, opts.AppName())
+			require.Equal(t, tc.wantedEnv, opts.envName)
+		})
+	}
+}
+
+func TestEnvStatus_Execute(t *testing.T) {
+	now, _ := time.Parse(time.RFC3339, "2020-01-01T00:00:00+00:00")
+	healthy := &describe.ServiceStatusDesc{
+		Service: ecs.ServiceStatus{
+			Status:       "ACTIVE",
+			DesiredCount: 1,
+			RunningCount: 1,
+		},
+	}
+	inAlarm := &describe.ServiceStatusDesc{
+		Service: ecs.ServiceStatus{
+			Status:       "ACTIVE",
+			DesiredCount: 1,
+			RunningCount: 1,
+		},
+		Alarms: []cloudwatch.AlarmStatus{
+			{Name: "mockAlarm", Status: "ALARM"},
+		},
+	}
+	testCases := map[string]struct {
+		shouldCheck bool
+		setupMocks  func(lister *mocks.MockdeployedEnvironmentLister, describer *mocks.MockstatusDescriber)
+
+		wantedJSON  string
+		wantedError error
+	}{
+		"returns error if fail to list deployed services": {
+			setupMocks: func(lister *mocks.MockdeployedEnvironmentLister, describer *mocks.MockstatusDescriber) {
+				lister.EXPECT().ListDeployedServices("my-app", "test").Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("list services deployed in environment test: some error"),
+		},
+		"returns error if fail to describe a service": {
+			setupMocks: func(lister *mocks.MockdeployedEnvironmentLister, describer *mocks.MockstatusDescriber) {
+				lister.EXPECT().ListDeployedServices("my-app", "test").Return([]string{"frontend"}, nil)
+				describer.EXPECT().Describe().Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("describe status of service frontend: some error"),
+		},
+		"succeeds without check if a service is unhealthy": {
+			setupMocks: func(lister *mocks.MockdeployedEnvironmentLister, describer *mocks.MockstatusDescriber) {
+				lister.EXPECT().ListDeployedServices("my-app", "test").Return([]string{"frontend"}, nil)
+				describer.EXPECT().Describe().Return(inAlarm, nil)
+			},
+
+			wantedJSON: `{"environment":"test","healthy":false,"services":[{"name":"frontend","healthy":false,"issues":["alarm mockAlarm is in ALARM"]}]}` + "\n",
+		},
+		"returns unhealthy error with check if a service is unhealthy": {
+			shouldCheck: true,
+			setupMocks: func(lister *mocks.MockdeployedEnvironmentLister, describer *mocks.MockstatusDescriber) {
+				lister.EXPECT().ListDeployedServices("my-app", "test").Return([]string{"frontend", "api"}, nil)
+				describer.EXPECT().Describe().Return(inAlarm, nil)
+				describer.EXPECT().Describe().Return(healthy, nil)
+			},
+
+			wantedJSON:  `{"environment":"test","healthy":false,"services":[{"name":"api","healthy":true},{"name":"frontend","healthy":false,"issues":["alarm mockAlarm is in ALARM"]}]}` + "\n",
+			wantedError: &errEnvUnhealthy{env: "test"},
+		},
+		"succeeds with check if all services are healthy": {
+			shouldCheck: true,
+			setupMocks: func(lister *mocks.MockdeployedEnvironmentLister, describer *mocks.MockstatusDescriber) {
+				lister.EXPECT().ListDeployedServices("my-app", "test").Return([]string{"frontend"}, nil)
+				describer.EXPECT().Describe().Return(healthy, nil)
+			},
+
+			wantedJSON: `{"environment":"test","healthy":true,"services":[{"name":"frontend","healthy":true}]}` + "\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			b := &bytes.Buffer{}
+			mockLister := mocks.NewMockdeployedEnvironmentLister(ctrl)
+			mockDescriber := mocks.NewMockstatusDescriber(ctrl)
+			tc.setupMocks(mockLister, mockDescriber)
+
+			opts := &envStatusOpts{
+				envStatusVars: envStatusVars{
+					GlobalOpts: &GlobalOpts{
+						appName: "my-app",
+					},
+					envName:          "test",
+					shouldCheck:      tc.shouldCheck,
+					shouldOutputJSON: true,
+				},
+				w:           b,
+				deployStore: mockLister,
+				newStatusDescriber: func(*envStatusOpts, string) (statusDescriber, error) {
+					return mockDescriber, nil
+				},
+				now: func() time.Time { return now },
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			require.Equal(t, tc.wantedJSON, b.String())
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestErrEnvUnhealthy_ExitCode(t *testing.T) {
+	err := fmt.Errorf("run: %w", &errEnvUnhealthy{env: "test"})
+
+	var coder interface{ ExitCode() int }
+	require.True(t, errors.As(err, &coder))
+	require.Equal(t, 2, coder.ExitCode())
+}
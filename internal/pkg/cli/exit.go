@@ -0,0 +1,99 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"errors"
+
+	"github.com/AlecAivazis/survey/v2/terminal"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/spf13/cobra"
+)
+
+// Exit statuses of the CLI when a command fails.
+// Scripts and IDE plugins rely on them to tell failures apart, so the values must not change.
+const (
+	ExitCodeError      = 1 // The command failed for a reason that doesn't fall in any other category.
+	ExitCodeUnhealthy  = 2 // "env status --check" found an unhealthy environment.
+	ExitCodeUserError  = 3 // The command was invoked incorrectly, such as with an unknown flag, or a prompt was canceled.
+	ExitCodeValidation = 4 // A value provided to the command is invalid.
+	ExitCodeAWSError   = 5 // A request to AWS failed.
+	ExitCodeTimeout    = 6 // An operation, such as a deployment, didn't complete in time.
+)
+
+// ExitCode returns the status that the CLI exits with for the error of a command.
+// Errors can choose their status with an ExitCode method, otherwise the status is inferred from the errors they wrap.
+func ExitCode(err error) int {
+	if err == nil {
+		return 0
+	}
+	var coder interface{ ExitCode() int }
+	if errors.As(err, &coder) {
+		return coder.ExitCode()
+	}
+	if isTimeoutErr(err) {
+		return ExitCodeTimeout
+	}
+	var aerr awserr.Error
+	if errors.As(err, &aerr) {
+		return ExitCodeAWSError
+	}
+	if errors.Is(err, terminal.InterruptErr) {
+		return ExitCodeUserError
+	}
+	return ExitCodeError
+}
+
+// FlagErrorFunc marks the errors of parsing the flags of a command as user errors.
+func FlagErrorFunc(_ *cobra.Command, err error) error {
+	return &errUserInput{err: err}
+}
+
+func isTimeoutErr(err error) bool {
+	var errTimeout *deploy.ErrDeploymentTimeout
+	if errors.As(err, &errTimeout) {
+		return true
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	var aerr awserr.Error
+	if errors.As(err, &aerr) {
+		return aerr.Code() == request.WaiterResourceNotReadyErrorCode
+	}
+	return false
+}
+
+// errUserInput wraps an error caused by how the command was invoked.
+type errUserInput struct {
+	err error
+}
+
+func (e *errUserInput) Error() string {
+	return e.err.Error()
+}
+
+func (e *errUserInput) Unwrap() error {
+	return e.err
+}
+
+// ExitCode returns the exit status of the CLI for the error.
+func (e *errUserInput) ExitCode() int {
+	return ExitCodeUserError
+}
+
+// errInvalidValue is the error of a value that failed validation.
+type errInvalidValue string
+
+func (e errInvalidValue) Error() string {
+	return string(e)
+}
+
+// ExitCode returns the exit status of the CLI for the error.
+func (e errInvalidValue) ExitCode() int {
+	return ExitCodeValidation
+}
@@ -0,0 +1,75 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/AlecAivazis/survey/v2/terminal"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExitCode(t *testing.T) {
+	testCases := map[string]struct {
+		inErr error
+
+		wantedCode int
+	}{
+		"no error": {
+			wantedCode: 0,
+		},
+		"unclassified error": {
+			inErr:      errors.New("some error"),
+			wantedCode: ExitCodeError,
+		},
+		"error that sets its own exit code": {
+			inErr:      fmt.Errorf("check environment: %w", &errEnvUnhealthy{env: "test"}),
+			wantedCode: ExitCodeUnhealthy,
+		},
+		"invalid flag": {
+			inErr:      FlagErrorFunc(nil, errors.New("unknown flag: --foo")),
+			wantedCode: ExitCodeUserError,
+		},
+		"reserved argument": {
+			inErr:      &errReservedArg{val: "local"},
+			wantedCode: ExitCodeUserError,
+		},
+		"prompt interrupted": {
+			inErr:      fmt.Errorf("select service: %w", terminal.InterruptErr),
+			wantedCode: ExitCodeUserError,
+		},
+		"invalid value": {
+			inErr:      fmt.Errorf("application name %s is invalid: %w", "A", errValueBadFormat),
+			wantedCode: ExitCodeValidation,
+		},
+		"aws error": {
+			inErr:      fmt.Errorf("get application: %w", awserr.New("AccessDeniedException", "denied", nil)),
+			wantedCode: ExitCodeAWSError,
+		},
+		"waiter timed out": {
+			inErr:      fmt.Errorf("wait for stack: %w", awserr.New(request.WaiterResourceNotReadyErrorCode, "exceeded wait attempts", nil)),
+			wantedCode: ExitCodeTimeout,
+		},
+		"deployment timed out": {
+			inErr:      fmt.Errorf("deploy service: %w", &deploy.ErrDeploymentTimeout{}),
+			wantedCode: ExitCodeTimeout,
+		},
+		"context deadline exceeded": {
+			inErr:      fmt.Errorf("list subnets: %w", context.DeadlineExceeded),
+			wantedCode: ExitCodeTimeout,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wantedCode, ExitCode(tc.inErr))
+		})
+	}
+}
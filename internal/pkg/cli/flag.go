@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 )
@@ -28,11 +29,16 @@ const (
 	imageTagFlag          = "tag"
 	resourceTagsFlag      = "resource-tags"
 	stackOutputDirFlag    = "output-dir"
+	mergeStrategyFlag     = "strategy"
 	limitFlag             = "limit"
 	followFlag            = "follow"
 	sinceFlag             = "since"
 	startTimeFlag         = "start-time"
 	endTimeFlag           = "end-time"
+	insightsFlag          = "insights"
+	previousFlag          = "previous"
+	accessFlag            = "access"
+	queryFlag             = "query"
 	envProfilesFlag       = "env-profiles"
 	prodEnvFlag           = "prod"
 	deployFlag            = "deploy"
@@ -57,6 +63,7 @@ const (
 	countFlag          = "count"
 	cpuFlag            = "cpu"
 	memoryFlag         = "memory"
+	computeFlag        = "compute"
 	imageFlag          = "image"
 	taskRoleFlag       = "task-role"
 	executionRoleFlag  = "execution-role"
@@ -65,8 +72,10 @@ const (
 	envVarsFlag        = "env-vars"
 	commandFlag        = "command"
 	taskDefaultFlag    = "default"
+	taskPresetFlag     = "preset"
 
 	vpcIDFlag          = "import-vpc-id"
+	vpcTagsFlag        = "import-vpc-tags"
 	publicSubnetsFlag  = "import-public-subnets"
 	privateSubnetsFlag = "import-private-subnets"
 
@@ -74,8 +83,50 @@ const (
 	publicSubnetCIDRsFlag  = "override-public-cidrs"
 	privateSubnetCIDRsFlag = "override-private-cidrs"
 
+	egressEIPsFlag        = "egress-eips"
+	importCertARNsFlag    = "import-cert-arns"
 	noCustomResourcesFlag = "no-custom-resources"
 
+	fromFlag       = "from"
+	previewFlag    = "preview"
+	cloneFromFlag  = "clone-from"
+	deploySvcsFlag = "deploy-svcs"
+	ttlFlag        = "ttl"
+	dryRunFlag     = "dry-run"
+	probeFlag      = "probe"
+	alarmsFlag     = "alarms"
+	checkFlag      = "check"
+	laxFlag        = "lax"
+	noWaitFlag     = "no-wait"
+	sortFlag       = "sort"
+	protectedFlag  = "protected"
+	confirmFlag    = "confirm"
+	forceFlag      = "force"
+	retainDataFlag = "retain-data"
+
+	rollbackOnFailureFlag = "rollback-on-failure"
+
+	globalAcceleratorFlag              = "global-accelerator"
+	acceleratorHealthCheckPathFlag     = "accelerator-health-check-path"
+	acceleratorHealthCheckIntervalFlag = "accelerator-health-check-interval"
+	egressIPsFlag                      = "egress-ips"
+	capacityFlag                       = "capacity"
+	noCacheFlag                        = "no-cache"
+	compareEnvFlag                     = "compare-env"
+	graphFormatFlag                    = "format"
+	stoppedTasksFlag                   = "stopped-tasks"
+	databaseFlag                       = "db"
+	listSnapshotsFlag                  = "list"
+	snapshotFlag                       = "snapshot"
+	taskIDFlag                         = "task-id"
+	containerFlag                      = "container"
+	allTasksFlag                       = "all-tasks"
+	percentFlag                        = "percent"
+	includeFlag                        = "include"
+	excludeFlag                        = "exclude"
+	deleteFlag                         = "delete"
+	templateFileFlag                   = "template"
+
 	accessKeyIDFlag     = "aws-access-key-id"
 	secretAccessKeyFlag = "aws-secret-access-key"
 	sessionTokenFlag    = "aws-session-token"
@@ -115,6 +166,18 @@ Cannot be specified with '%s', '%s' or '%s'.`, appFlag, envFlag, subnetsFlag)
 Cannot be specified with '%s', '%s' or '%s'`, taskDefaultFlag, subnetsFlag, securityGroupsFlag)
 	taskAppFlagDescription = fmt.Sprintf(`Optional. Name of the application.
 Cannot be specified with '%s', '%s' or '%s'`, taskDefaultFlag, subnetsFlag, securityGroupsFlag)
+	graphFormatFlagDescription = fmt.Sprintf(`Optional. Format of the graph. Must be one of:
+%s`, prettify(graphFormats))
+	insightsFlagDescription = fmt.Sprintf(`Optional. Runs a CloudWatch Logs Insights query and displays its results.
+Either a query or the name of a saved query: %s.
+Defaults to the logs of the last hour. Only one of insights / follow may be used.`, prettify(insightsQueryNames()))
+	queryFlagDescription = fmt.Sprintf(`Optional. Runs an Athena query on the access logs instead of returning the latest requests.
+Either a SQL query or the name of a built-in query: %s.
+Must be used with --%s.`, prettify(accessLogsQueryNames()), accessFlag)
+	sortTasksFlagDescription = fmt.Sprintf(`Optional. Column to sort the tasks by, in ascending order. Must be one of:
+%s`, prettify(describe.TaskSortColumns))
+	computeFlagDescription = fmt.Sprintf(`Optional. Preset for the CPU units and memory of each task. Must be one of:
+%s`, prettify(manifest.ComputePresets))
 )
 
 const (
@@ -131,6 +194,10 @@ const (
 	resourceTagsFlagDescription = `Optional. Labels with a key and value separated with commas.
 Allows you to categorize resources.`
 	stackOutputDirFlagDescription = "Optional. Writes the stack template and template configuration to a directory."
+	mergeStrategyFlagDescription  = `Optional. How to resolve the lines of the stack template in the output directory
+that you edited and that this version of Copilot also changes.
+Must be one of "merge" to surround them with conflict markers, "ours" to keep your edits,
+or "theirs" to keep the regenerated lines.`
 	prodEnvFlagDescription        = "If the environment contains production services."
 	limitFlagDescription          = "Optional. The maximum number of log events returned."
 	followFlagDescription         = "Optional. Specifies if the logs should be streamed."
@@ -140,11 +207,19 @@ Defaults to all logs. Only one of start-time / since may be used.`
 Defaults to all logs. Only one of start-time / since may be used.`
 	endTimeFlagDescription = `Optional. Only return logs before a specific date (RFC3339).
 Defaults to all logs. Only one of end-time / follow may be used.`
+	previousFlagDescription = `Optional. Returns the logs of the tasks that stopped most recently,
+unless they were stopped by scaling in. Only one of previous / follow / insights may be used.`
+	accessFlagDescription = `Optional. Returns the requests to the service from the access logs of the load balancer.
+Defaults to the last hour. Only one of access / previous / follow / insights may be used.`
 	deployTestFlagDescription        = `Deploy your service to a "test" environment.`
 	githubURLFlagDescription         = "GitHub repository URL for your service."
 	githubAccessTokenFlagDescription = "GitHub personal access token for your repository."
 	gitBranchFlagDescription         = "Branch used to trigger your pipeline."
 	pipelineEnvsFlagDescription      = "Environments to add to the pipeline."
+	pipelineInitNameFlagDescription  = `Optional. Name of an additional pipeline for the workspace.
+The manifest is written to copilot/pipelines/{name}.yml instead of copilot/pipeline.yml.`
+	pipelineManifestFlagDescription = `Optional. Name of the pipeline manifest under copilot/pipelines/.
+Defaults to copilot/pipeline.yml.`
 	domainNameFlagDescription        = "Optional. Your existing custom domain name."
 	envResourcesFlagDescription      = "Optional. Show the resources in your environment."
 	svcResourcesFlagDescription      = "Optional. Show the resources in your service."
@@ -153,6 +228,7 @@ Defaults to all logs. Only one of end-time / follow may be used.`
 	envProfilesFlagDescription       = "Optional. Environments and the profile to use to delete the environment."
 	deleteSecretFlagDescription      = "Deletes AWS Secrets Manager secret associated with a pipeline source repository."
 	svcPortFlagDescription           = "Optional. The port on which your service listens."
+	servePortFlagDescription         = "Optional. The local port on which the API listens."
 
 	storageFlagDescription             = "Name of the storage resource to create."
 	storageServiceFlagDescription      = "Name of the service to associate with storage."
@@ -177,8 +253,16 @@ Must be of the format '<keyName>:<dataType>'.`
 Tasks with the same group name share the same set of resources. 
 (default directory name)`
 	taskImageTagFlagDescription   = `Optional. The container image tag in addition to "latest".`
+	taskListGroupFlagDescription  = "Optional. Only list the tasks of this task group."
+	taskTargetGroupFlagDescription = "The group name of the tasks."
+	taskTargetEnvFlagDescription   = `Optional. Name of the environment that the tasks run in.
+Defaults to the tasks in the "default" cluster.`
+	taskPresetFlagDescription = `Optional. Name of a task preset under copilot/tasks/ to run.
+Flags that are specified override the values of the preset.`
 
 	vpcIDFlagDescription          = "Optional. Use an existing VPC ID."
+	vpcTagsFlagDescription        = `Optional. Only show the VPCs with these tags when selecting the VPC to import,
+for example team=payments. An empty value matches any value of the tag.`
 	publicSubnetsFlagDescription  = "Optional. Use existing public subnet IDs."
 	privateSubnetsFlagDescription = "Optional. Use existing private subnet IDs."
 
@@ -186,10 +270,69 @@ Tasks with the same group name share the same set of resources.
 	publicSubnetCIDRsFlagDescription  = "Optional. CIDR to use for public subnets (default 10.0.0.0/24,10.0.1.0/24)."
 	privateSubnetCIDRsFlagDescription = "Optional. CIDR to use for private subnets (default 10.0.2.0/24,10.0.3.0/24)."
 
+	egressEIPsFlagDescription = `Optional. Allocation IDs of existing Elastic IPs to attach to NAT gateways,
+one per public subnet. Traffic out of the private subnets uses these IPs.`
+	importCertARNsFlagDescription = `Optional. ARNs of existing ACM certificates to serve on the HTTPS listener.
+The first certificate is the default, the others are selected by SNI.`
 	noCustomResourcesFlagDescription = "Optional. Skip prompting and use default environment configuration."
 
+	previewFlagDescription    = "Optional. Name of an ephemeral preview environment, usually keyed by a pull request (e.g. pr-123)."
+	fromFlagDescription       = `Optional. Name of an existing environment to copy the configuration from.
+Only the profile and region of the new environment are prompted for.`
+	deploySvcsFlagDescription = "Optional. Services in your workspace to deploy to the preview environment once it's created."
+	ttlFlagDescription        = "Optional. Duration after which the environment expires and can be deleted by env gc (e.g. 72h)."
+	dryRunFlagDescription     = "Optional. List the expired environments without deleting them."
+	probeFlagDescription      = `Optional. Send requests to the service's public endpoint and health check path,
+and report their status codes and latency along with the health of each load balancer target.`
+	alarmsFlagDescription = "Optional. Show the state changes of the service's alarms in the last 24 hours."
+	checkFlagDescription  = `Optional. Exit with status 2 if any service is unhealthy, so that the command
+can be used as a health probe.`
+	showResourcesSvcFlagDescription = "Optional. Name of the service. Defaults to the resources of the environment."
+	laxFlagDescription              = `Optional. Ignore the fields of the manifest that aren't recognized,
+such as fields added by a newer version of Copilot.`
+	noWaitFlagDescription = `Optional. Return as soon as the deployment starts instead of waiting until it's done.
+Writes the ID of the deployment to wait for it with "copilot deploy wait <id>".`
+	manifestUpgradeNameFlagDescription   = "Optional. Name of the service. Defaults to all the services in the workspace."
+	manifestUpgradeDryRunFlagDescription = "Optional. Print the changes to the manifests without rewriting them."
+	envDeployDryRunFlagDescription       = "Optional. Print the changes to the environment's stack template without deploying them."
+	protectedFlagDescription             = `Optional. Require typing the name of the environment, or passing it with --confirm,
+to deploy to or delete from the environment.`
+	confirmFlagDescription = `Optional. Names of the protected environments to act on
+without being prompted to type their name.`
+	forceFlagDescription = `Optional. Recover the service stack if it's stuck in UPDATE_ROLLBACK_FAILED
+by continuing its rollback or recreating it before deploying.`
+	rollbackOnFailureFlagDescription = `Optional. Roll back to the last successful deployment when the tasks
+keep failing to start. Set to false to keep the failed deployment.`
+
+	globalAcceleratorFlagDescription = `Optional. Provision AWS Global Accelerator in front of the public load balancer
+to serve your services from static anycast IP addresses.`
+	acceleratorHealthCheckPathFlagDescription     = "Optional. Path of the load balancer that Global Accelerator health checks."
+	acceleratorHealthCheckIntervalFlagDescription = "Optional. Seconds between Global Accelerator health checks, either 10 or 30."
+	egressIPsFlagDescription                      = "Optional. Show the public IP addresses of the environment's NAT gateways."
+	capacityFlagDescription                       = "Optional. Show the tasks in the environment's cluster and the Fargate vCPUs used by the account out of its quota."
+	noCacheFlagDescription                        = "Optional. Ignore the local cache enabled by COPILOT_CACHE_TTL and call AWS directly."
+	compareEnvFlagDescription                     = "Name of the environment to compare the service's configuration with."
+	policyEnvsFlagDescription                     = "Optional. Environments to generate policies for. Defaults to all the environments."
+	policyOutputDirFlagDescription                = "Optional. Directory to write the policies to."
+	stoppedTasksFlagDescription                   = "Optional. Show the tasks that stopped in the last hour, with their stopped reason and exit codes."
+	retainDataFlagDescription                     = "Optional. Keep the data of the service's addons, such as tables, buckets and databases, without being prompted."
+	databaseFlagDescription                       = "Optional. Logical ID of the Aurora cluster or RDS DB instance in the addons template."
+	listSnapshotsFlagDescription                  = "Optional. List the snapshots of the database instead of creating one."
+	snapshotFlagDescription                       = "Optional. Identifier of the snapshot to restore."
+	taskIDFlagDescription                         = "Optional. ID, or prefix of the ID, of the task to exec into. Prompted if the service has several tasks."
+	taskExecTaskIDFlagDescription                 = "Optional. ID, or prefix of the ID, of the task to exec into. Prompted if the group has several running tasks."
+	containerFlagDescription                      = "Optional. Name of the container to exec into. Defaults to the main container of the service."
+	execCommandFlagDescription                    = "Optional. The command to run in the container."
+	allTasksFlagDescription                       = "Optional. Run the command in every running task of the service instead of opening a session."
+	percentFlagDescription                        = "Optional. Run the command in this percentage of the running tasks of the service, from 1 to 100."
+	includeFlagDescription                        = "Optional. Only upload the files that match one of these patterns, such as \"*.html\"."
+	excludeFlagDescription                        = "Optional. Skip the files that match one of these patterns, such as \".git/*\"."
+	deleteFlagDescription                         = "Optional. Delete the objects of the bucket that don't exist in the local directory."
+	templateFileFlagDescription                   = `Optional. Path to a template generated with "copilot svc package" to compare with.
+Defaults to the template that the service was last deployed with.`
+
 	accessKeyIDFlagDescription     = "Optional. An AWS access key."
 	secretAccessKeyFlagDescription = "Optional. An AWS secret access key."
 	sessionTokenFlagDescription    = "Optional. An AWS session token for temporary credentials."
-	envRegionTokenFlagDescription  = "Options. An AWS region where the environment will be created."
+	envRegionTokenFlagDescription  = "Optional. An AWS region where the environment will be created."
 )
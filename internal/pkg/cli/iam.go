@@ -0,0 +1,33 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"github.com/aws/copilot-cli/cmd/copilot/template"
+	"github.com/aws/copilot-cli/internal/pkg/cli/group"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// BuildIAMCmd is the top level command for IAM.
+func BuildIAMCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "iam",
+		Short: "Commands for the IAM permissions of your application.",
+		Long: `Commands for the IAM permissions of your application.
+Generate least-privilege policies that restrict who may deploy to which environments.`,
+	}
+	// The flags bound by viper are available to all sub-commands through viper.GetString({flagName})
+	cmd.PersistentFlags().StringP(appFlag, appFlagShort, "" /* default */, appFlagDescription)
+	viper.BindPFlag(appFlag, cmd.PersistentFlags().Lookup(appFlag))
+
+	cmd.AddCommand(BuildIAMGeneratePoliciesCmd())
+
+	cmd.SetUsageTemplate(template.Usage)
+
+	cmd.Annotations = map[string]string{
+		"group": group.Develop,
+	}
+	return cmd
+}
@@ -0,0 +1,169 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/iam"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+const (
+	iamGeneratePoliciesAppNamePrompt     = "Which application would you like to generate policies for?"
+	iamGeneratePoliciesAppNameHelpPrompt = "A deployer policy is generated for each environment of the application."
+
+	defaultPolicyOutputDir = "iam-policies"
+	fmtDeployerPolicyFile  = "%s-%s-deployer.json"
+)
+
+type generateIAMPoliciesVars struct {
+	*GlobalOpts
+	envNames  []string
+	outputDir string
+}
+
+type generateIAMPoliciesOpts struct {
+	generateIAMPoliciesVars
+
+	store store
+	fs    afero.Fs
+	sel   appSelector
+}
+
+func newGenerateIAMPoliciesOpts(vars generateIAMPoliciesVars) (*generateIAMPoliciesOpts, error) {
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("new config store: %w", err)
+	}
+	return &generateIAMPoliciesOpts{
+		generateIAMPoliciesVars: vars,
+		store:                   store,
+		fs:                      &afero.Afero{Fs: afero.NewOsFs()},
+		sel:                     selector.NewSelect(vars.prompt, store),
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *generateIAMPoliciesOpts) Validate() error {
+	if o.AppName() == "" {
+		return nil
+	}
+	if _, err := o.store.GetApplication(o.AppName()); err != nil {
+		return fmt.Errorf("get application %s: %w", o.AppName(), err)
+	}
+	for _, env := range o.envNames {
+		if _, err := o.store.GetEnvironment(o.AppName(), env); err != nil {
+			return fmt.Errorf("get environment %s: %w", env, err)
+		}
+	}
+	return nil
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *generateIAMPoliciesOpts) Ask() error {
+	if o.AppName() != "" {
+		return nil
+	}
+	name, err := o.sel.Application(iamGeneratePoliciesAppNamePrompt, iamGeneratePoliciesAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = name
+	return nil
+}
+
+// Execute writes a deployer policy for each environment of the application to the output directory.
+func (o *generateIAMPoliciesOpts) Execute() error {
+	app, err := o.store.GetApplication(o.AppName())
+	if err != nil {
+		return fmt.Errorf("get application %s: %w", o.AppName(), err)
+	}
+	envs, err := o.targetEnvs()
+	if err != nil {
+		return err
+	}
+	if err := o.fs.MkdirAll(o.outputDir, 0755); err != nil {
+		return fmt.Errorf("create directory %s: %w", o.outputDir, err)
+	}
+	for _, env := range envs {
+		policy := iam.NewDeployerPolicy(iam.DeployerPolicyInput{
+			App:            app.Name,
+			AppAccountID:   app.AccountID,
+			Env:            env.Name,
+			EnvRegion:      env.Region,
+			ManagerRoleARN: env.ManagerRoleARN,
+		})
+		doc, err := policy.JSONString()
+		if err != nil {
+			return fmt.Errorf("marshal deployer policy for environment %s: %w", env.Name, err)
+		}
+		path := filepath.Join(o.outputDir, fmt.Sprintf(fmtDeployerPolicyFile, app.Name, env.Name))
+		if err := afero.WriteFile(o.fs, path, []byte(doc), 0644); err != nil {
+			return fmt.Errorf("write deployer policy to %s: %w", path, err)
+		}
+		log.Successf("Wrote the deployer policy for environment %s to %s\n", env.Name, path)
+	}
+	return nil
+}
+
+func (o *generateIAMPoliciesOpts) targetEnvs() ([]*config.Environment, error) {
+	if len(o.envNames) == 0 {
+		envs, err := o.store.ListEnvironments(o.AppName())
+		if err != nil {
+			return nil, fmt.Errorf("list environments of application %s: %w", o.AppName(), err)
+		}
+		return envs, nil
+	}
+	var envs []*config.Environment
+	for _, name := range o.envNames {
+		env, err := o.store.GetEnvironment(o.AppName(), name)
+		if err != nil {
+			return nil, fmt.Errorf("get environment %s: %w", name, err)
+		}
+		envs = append(envs, env)
+	}
+	return envs, nil
+}
+
+// BuildIAMGeneratePoliciesCmd builds the command for generating least-privilege deployer policies.
+func BuildIAMGeneratePoliciesCmd() *cobra.Command {
+	vars := generateIAMPoliciesVars{
+		GlobalOpts: NewGlobalOpts(),
+	}
+	cmd := &cobra.Command{
+		Use:   "generate-policies",
+		Short: "Generates IAM policies that restrict deployments to specific environments.",
+		Long: `Generates IAM policies that restrict deployments to specific environments.
+Writes a deployer policy for each environment that can only assume the role of that environment,
+so that platform teams can attach it to the IAM roles or groups of the team that owns the environment.`,
+
+		Example: `
+  Writes the deployer policies of every environment of the "my-app" application to the "iam-policies" directory.
+  /code $ copilot iam generate-policies -a my-app
+  Writes the deployer policy of the "prod" environment to the "policies" directory.
+  /code $ copilot iam generate-policies -e prod --output-dir policies`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newGenerateIAMPoliciesOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringSliceVarP(&vars.envNames, envsFlag, envsFlagShort, nil, policyEnvsFlagDescription)
+	cmd.Flags().StringVar(&vars.outputDir, stackOutputDirFlag, defaultPolicyOutputDir, policyOutputDirFlagDescription)
+	return cmd
+}
@@ -0,0 +1,145 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateIAMPoliciesOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inAppName  string
+		inEnvNames []string
+		setupMocks func(m *mocks.Mockstore)
+
+		wantedErr string
+	}{
+		"skips validation if the application isn't set": {
+			setupMocks: func(m *mocks.Mockstore) {},
+		},
+		"errors if the application doesn't exist": {
+			inAppName: "phonetool",
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(nil, errors.New("some error"))
+			},
+			wantedErr: "get application phonetool: some error",
+		},
+		"errors if an environment doesn't exist": {
+			inAppName:  "phonetool",
+			inEnvNames: []string{"test", "prod"},
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{}, nil)
+				m.EXPECT().GetEnvironment("phonetool", "prod").Return(nil, errors.New("some error"))
+			},
+			wantedErr: "get environment prod: some error",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockStore := mocks.NewMockstore(ctrl)
+			tc.setupMocks(mockStore)
+			opts := &generateIAMPoliciesOpts{
+				generateIAMPoliciesVars: generateIAMPoliciesVars{
+					GlobalOpts: &GlobalOpts{appName: tc.inAppName},
+					envNames:   tc.inEnvNames,
+				},
+				store: mockStore,
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestGenerateIAMPoliciesOpts_Execute(t *testing.T) {
+	testEnv := &config.Environment{
+		Name:           "test",
+		Region:         "us-west-2",
+		ManagerRoleARN: "arn:aws:iam::222222222222:role/phonetool-test-EnvManagerRole",
+	}
+	prodEnv := &config.Environment{
+		Name:           "prod",
+		Region:         "us-east-1",
+		ManagerRoleARN: "arn:aws:iam::333333333333:role/phonetool-prod-EnvManagerRole",
+	}
+	testCases := map[string]struct {
+		inEnvNames []string
+		setupMocks func(m *mocks.Mockstore)
+
+		wantedFiles []string
+		wantedErr   string
+	}{
+		"writes a policy for every environment by default": {
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool", AccountID: "111111111111"}, nil)
+				m.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{testEnv, prodEnv}, nil)
+			},
+			wantedFiles: []string{"policies/phonetool-test-deployer.json", "policies/phonetool-prod-deployer.json"},
+		},
+		"writes a policy for the selected environments only": {
+			inEnvNames: []string{"prod"},
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool", AccountID: "111111111111"}, nil)
+				m.EXPECT().GetEnvironment("phonetool", "prod").Return(prodEnv, nil)
+			},
+			wantedFiles: []string{"policies/phonetool-prod-deployer.json"},
+		},
+		"errors if the environments can't be listed": {
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.EXPECT().ListEnvironments("phonetool").Return(nil, errors.New("some error"))
+			},
+			wantedErr: "list environments of application phonetool: some error",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockStore := mocks.NewMockstore(ctrl)
+			tc.setupMocks(mockStore)
+			fs := afero.NewMemMapFs()
+			opts := &generateIAMPoliciesOpts{
+				generateIAMPoliciesVars: generateIAMPoliciesVars{
+					GlobalOpts: &GlobalOpts{appName: "phonetool"},
+					envNames:   tc.inEnvNames,
+					outputDir:  "policies",
+				},
+				store: mockStore,
+				fs:    fs,
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			for _, path := range tc.wantedFiles {
+				content, err := afero.ReadFile(fs, path)
+				require.NoError(t, err)
+				require.Contains(t, string(content), `"Sid": "AssumeEnvironmentManagerRole"`)
+			}
+		})
+	}
+}
@@ -138,9 +138,10 @@ func newInitOpts(vars initVars) (*initOpts, error) {
 
 	deploySvcCmd := &deploySvcOpts{
 		deploySvcVars: deploySvcVars{
-			EnvName:    defaultEnvironmentName,
-			ImageTag:   vars.imageTag,
-			GlobalOpts: NewGlobalOpts(),
+			EnvName:           defaultEnvironmentName,
+			ImageTag:          vars.imageTag,
+			GlobalOpts:        NewGlobalOpts(),
+			RollbackOnFailure: true,
 		},
 
 		store:        ssm,
@@ -4,20 +4,35 @@
 package cli
 
 import (
+	"context"
 	"encoding"
 	"io"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws/session"
+	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/acm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/applicationautoscaling"
+	"github.com/aws/copilot-cli/internal/pkg/aws/athena"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codedeploy"
 	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
+	"github.com/aws/copilot-cli/internal/pkg/aws/rds"
+	"github.com/aws/copilot-cli/internal/pkg/aws/route53"
+	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/aws/copilot-cli/internal/pkg/docker"
 	"github.com/aws/copilot-cli/internal/pkg/docker/dockerfile"
+	"github.com/aws/copilot-cli/internal/pkg/policy"
+	"github.com/aws/copilot-cli/internal/pkg/probe"
 	"github.com/aws/copilot-cli/internal/pkg/repository"
 	"github.com/aws/copilot-cli/internal/pkg/task"
 	"github.com/aws/copilot-cli/internal/pkg/term/command"
@@ -93,6 +108,7 @@ type environmentStore interface {
 	environmentGetter
 	environmentLister
 	environmentDeleter
+	environmentUpdater
 }
 
 type environmentCreator interface {
@@ -111,6 +127,10 @@ type environmentDeleter interface {
 	DeleteEnvironment(appName, environmentName string) error
 }
 
+type environmentUpdater interface {
+	UpdateEnvironment(env *config.Environment) error
+}
+
 type store interface {
 	applicationStore
 	environmentStore
@@ -140,6 +160,7 @@ type secretDeleter interface {
 
 type imageBuilderPusher interface {
 	BuildAndPush(docker repository.ContainerLoginBuildPusher, args *docker.BuildArguments) error
+	BuildCheckAndPush(docker repository.ContainerLoginBuildPusher, args *docker.BuildArguments, checker repository.ImageChecker) error
 }
 
 type repositoryURIGetter interface {
@@ -153,13 +174,35 @@ type repositoryService interface {
 
 type cwlogService interface {
 	TaskLogEvents(logGroupName string, streamLastEventTime map[string]int64, opts ...cloudwatchlogs.GetLogEventsOpts) (*cloudwatchlogs.LogEventsOutput, error)
+	LogEventsOfTasks(logGroupName string, taskIDs []string, opts ...cloudwatchlogs.GetLogEventsOpts) (*cloudwatchlogs.LogEventsOutput, error)
 	LogGroupExists(logGroupName string) (bool, error)
+	Query(logGroupName, query string, startTime, endTime int64) (*cloudwatchlogs.QueryResults, error)
+}
+
+type accessLogsQuerier interface {
+	Query(workGroup, database, query string) (*athena.QueryResults, error)
+}
+
+type svcStackResourcesDescriber interface {
+	ServiceStackResources() ([]*sdkcloudformation.StackResource, error)
 }
 
 type templater interface {
 	Template() (string, error)
 }
 
+type policyFilesReader interface {
+	ReadPoliciesDir() (*workspace.PolicyFiles, error)
+}
+
+type cveAllowlistReader interface {
+	ReadCVEAllowlist() ([]string, error)
+}
+
+type policyChecker interface {
+	Check(template string, rulesFiles []string) ([]policy.Violation, error)
+}
+
 type stackSerializer interface {
 	templater
 	SerializedParameters() (string, error)
@@ -213,11 +256,22 @@ type svcManifestWriter interface {
 
 type wsPipelineManifestReader interface {
 	ReadPipelineManifest() ([]byte, error)
+	ReadNamedPipelineManifest(name string) ([]byte, error)
+	PipelineManifestNames() ([]string, error)
+}
+
+type wsTaskPresetReader interface {
+	ReadTaskPreset(name string) ([]byte, error)
+}
+
+type wsEnvironmentManifestReader interface {
+	ReadEnvironmentManifest(name string) ([]byte, error)
 }
 
 type wsPipelineWriter interface {
 	WritePipelineBuildspec(marshaler encoding.BinaryMarshaler) (string, error)
 	WritePipelineManifest(marshaler encoding.BinaryMarshaler) (string, error)
+	WriteNamedPipelineManifest(marshaler encoding.BinaryMarshaler, name string) (string, error)
 }
 
 type wsServiceLister interface {
@@ -229,6 +283,17 @@ type wsSvcReader interface {
 	svcManifestReader
 }
 
+type wsSvcManifestUpgrader interface {
+	wsServiceLister
+	ReadRawServiceManifest(name string) ([]byte, error)
+	OverwriteServiceManifest(data []byte, name string) (string, error)
+}
+
+type wsAppSvcReader interface {
+	wsSvcReader
+	Summary() (*workspace.Summary, error)
+}
+
 type wsSvcDirReader interface {
 	wsSvcReader
 	CopilotDirPath() (string, error)
@@ -236,6 +301,7 @@ type wsSvcDirReader interface {
 
 type wsPipelineReader interface {
 	wsServiceLister
+	svcManifestReader
 	wsPipelineManifestReader
 }
 
@@ -251,6 +317,7 @@ type wsAddonManager interface {
 
 type artifactUploader interface {
 	PutArtifact(bucket, fileName string, data io.Reader) (string, error)
+	PutArtifactWithPresignedURL(bucket, fileName string, data io.Reader, expiry time.Duration) (string, error)
 }
 
 type bucketEmptier interface {
@@ -265,10 +332,62 @@ type environmentDeployer interface {
 	GetEnvironment(appName, envName string) (*config.Environment, error)
 }
 
+type environmentStackUpdater interface {
+	UpdateEnvironment(env *deploy.CreateEnvironmentInput) error
+	EnvironmentTemplate(appName, envName string) (string, error)
+}
+
+type serviceTemplateGetter interface {
+	ServiceTemplate(app, env, svc string) (string, error)
+}
+
+type prefixListGetter interface {
+	ManagedPrefixListID(ctx context.Context, name string) (string, error)
+}
+
 type svcDeleter interface {
 	DeleteService(in deploy.DeleteServiceInput) error
 }
 
+type svcStackRecoverer interface {
+	ServiceRollbackFailedResources(stackName string) (resources []string, stuck bool, err error)
+	ContinueServiceRollback(stackName string, resourcesToSkip []string) error
+	svcDeleter
+}
+
+type svcAddonsRetainer interface {
+	ServiceStatefulAddons(in deploy.DeleteServiceInput) ([]string, error)
+	RetainServiceStatefulAddons(in deploy.DeleteServiceInput) ([]deploy.RetainedResource, error)
+}
+
+type addonDatabasesLister interface {
+	ServiceAddonDatabases(app, env, svc string) ([]deploy.AddonDatabase, error)
+}
+
+type dbSnapshotter interface {
+	CreateSnapshot(db rds.Database, snapshotID string) error
+	Snapshots(db rds.Database) ([]rds.Snapshot, error)
+}
+
+type dbRestorer interface {
+	Snapshots(db rds.Database) ([]rds.Snapshot, error)
+	Restore(snapshot rds.Snapshot, newID string) (*rds.Endpoint, error)
+}
+
+type addonBucketsLister interface {
+	ServiceAddonBuckets(app, env, svc string) (map[string]string, error)
+}
+
+type bucketSyncer interface {
+	Objects(bucket string) (map[string]s3.Object, error)
+	UploadObject(bucket, key string, data io.Reader) error
+	DeleteObjects(bucket string, keys []string) error
+}
+
+type secretFieldsUpdater interface {
+	UpdateSecretFields(secretID string, fields map[string]interface{}) error
+}
+
 type svcRemoverFromApp interface {
 	RemoveServiceFromApp(app *config.Application, svcName string) error
 }
@@ -305,7 +424,15 @@ type taskDeployer interface {
 }
 
 type taskRunner interface {
-	Run() ([]*task.Task, error)
+	Run(ctx context.Context) ([]*task.Task, error)
+}
+
+type runningTasksLister interface {
+	RunningTasks(cluster, startedBy string) ([]*ecs.Task, error)
+}
+
+type tasksStopper interface {
+	StopTasks(cluster string, taskARNs []string, reason string) error
 }
 
 type defaultClusterGetter interface {
@@ -322,6 +449,20 @@ type domainValidator interface {
 	DomainExists(domainName string) (bool, error)
 }
 
+type aliasRecordDeployer interface {
+	HostedZoneName(hostedZoneID string) (string, error)
+	UpsertAliasRecord(record route53.AliasRecord) error
+	CreateHealthCheck(hc route53.HealthCheck) (string, error)
+}
+
+type certDescriber interface {
+	DescribeCertificate(arn string) (*acm.Certificate, error)
+}
+
+type envOutputsGetter interface {
+	EnvOutputs() (map[string]string, error)
+}
+
 type dockerfileParser interface {
 	GetExposedPorts() ([]uint16, error)
 	GetHealthCheck() (*dockerfile.HealthCheck, error)
@@ -331,12 +472,91 @@ type serviceArnGetter interface {
 	GetServiceArn() (*ecs.ServiceArn, error)
 }
 
+type serviceDeploymentForcer interface {
+	ForceNewDeployment(cluster, service string) (string, error)
+}
+
+type serviceTaskExecuter interface {
+	ServiceTasks(clusterName, serviceName string) ([]*ecs.Task, error)
+	ExecuteCommand(in ecs.ExecuteCommandInput) (*ecs.Session, error)
+}
+
+type taskExecuter interface {
+	DescribeTasks(cluster string, taskARNs []string) ([]*ecs.Task, error)
+	ExecuteCommand(in ecs.ExecuteCommandInput) (*ecs.Session, error)
+}
+
 type statusDescriber interface {
 	Describe() (*describe.ServiceStatusDesc, error)
+	AlarmHistory(alarms []cloudwatch.AlarmStatus, startDate time.Time) ([]cloudwatch.AlarmHistoryItem, error)
+	StoppedTaskStatuses() ([]ecs.TaskStatus, error)
+	Autoscaling() (*applicationautoscaling.ServiceScaling, error)
+}
+
+type securityStatusDescriber interface {
+	Describe() (*describe.SecurityStatusDesc, error)
+}
+
+type stoppedTasksGetter interface {
+	StoppedTasks() ([]*ecs.Task, error)
+}
+
+type serviceEventsGetter interface {
+	ServiceEvents(since time.Time) ([]ecs.ServiceEvent, error)
+}
+
+type deploymentWaiter interface {
+	WaitForDeployment(changeSetID string) error
+}
+
+type runningTasksGetter interface {
+	RunningTasks() ([]*ecs.Task, error)
+}
+
+type svcOutputsGetter interface {
+	Outputs() (map[string]string, error)
+}
+
+type blueGreenDeployer interface {
+	CreateECSDeployment(in codedeploy.ECSDeploymentInput) (string, error)
+	Deployment(id string) (*codedeploy.Deployment, error)
+}
+
+type fargateQuotaGetter interface {
+	FargateVCPUQuota() (float64, error)
+}
+
+type fargateUsageGetter interface {
+	FargateVCPUUsage() (float64, error)
+}
+
+type svcDiffer interface {
+	Diff(fromEnv, toEnv string) (*describe.ServiceDiff, error)
+}
+
+type appGrapher interface {
+	Graph() (*describe.AppGraph, error)
+}
+
+type webSvcURIDescriber interface {
+	URI(envName string) (string, error)
+	HealthCheckURI(envName string) (string, error)
+}
+
+type prober interface {
+	Probe(url string) probe.Result
+}
+
+type targetsHealthDescriber interface {
+	TargetsHealth(targetGroupARN string) ([]*elbv2.TargetHealth, error)
 }
 
 type envDescriber interface {
-	Describe() (*describe.EnvDescription, error)
+	Describe(ctx context.Context) (*describe.EnvDescription, error)
+}
+
+type stackResourcesDescriber interface {
+	Describe() (*describe.StackResourcesDesc, error)
 }
 
 type resourceGroupsClient interface {
@@ -386,7 +606,32 @@ type wsSelector interface {
 }
 
 type ec2Selector interface {
-	VPC(prompt, help string) (string, error)
-	PublicSubnets(prompt, help, vpcID string) ([]string, error)
-	PrivateSubnets(prompt, help, vpcID string) ([]string, error)
+	VPC(ctx context.Context, prompt, help string, filters ...ec2.Filter) (string, error)
+	PublicSubnets(ctx context.Context, prompt, help, vpcID string) ([]string, error)
+	PrivateSubnets(ctx context.Context, prompt, help, vpcID string) ([]string, error)
+}
+
+type subnetsDescriber interface {
+	SubnetsDetailed(ctx context.Context, filters ...ec2.Filter) ([]ec2.Subnet, error)
+	ListAvailabilityZones(ctx context.Context, filters ...ec2.Filter) ([]string, error)
+}
+
+type subnetIPsGetter interface {
+	SubnetsAvailableIPs(ctx context.Context, subnetIDs ...string) (map[string]int, error)
+}
+
+type vpcEndpointsLister interface {
+	ListVPCEndpoints(ctx context.Context, vpcID string) ([]ec2.VPCEndpoint, error)
+}
+
+type vpcDNSConfigurer interface {
+	VPCDNSAttributes(ctx context.Context, vpcID string) (*ec2.VPCDNSAttributes, error)
+	EnableVPCDNS(ctx context.Context, vpcID string) error
+	UsesAmazonProvidedDNS(ctx context.Context, vpcID string) (bool, error)
+}
+
+type vpcEgressDescriber interface {
+	SubnetsWithoutEgress(ctx context.Context, subnetIDs ...string) ([]string, error)
+	ListNATGateways(ctx context.Context, vpcID string) ([]ec2.NATGateway, error)
+	ListElasticIPs(ctx context.Context) ([]ec2.ElasticIP, error)
 }
@@ -0,0 +1,29 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"github.com/aws/copilot-cli/cmd/copilot/template"
+	"github.com/aws/copilot-cli/internal/pkg/cli/group"
+	"github.com/spf13/cobra"
+)
+
+// BuildManifestCmd is the top level command for manifests.
+func BuildManifestCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "manifest",
+		Short: "Commands for working with the manifests of services.",
+		Long: `Commands for working with the manifests of services.
+Keep your manifests up to date with the latest schema of Copilot.`,
+	}
+
+	cmd.AddCommand(BuildManifestUpgradeCmd())
+
+	cmd.SetUsageTemplate(template.Usage)
+
+	cmd.Annotations = map[string]string{
+		"group": group.Develop,
+	}
+	return cmd
+}
@@ -0,0 +1,210 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// diffContextLines is the number of unchanged lines shown around the changed lines of a manifest.
+	diffContextLines = 2
+)
+
+type upgradeManifestVars struct {
+	svcName string
+	dryRun  bool
+}
+
+type upgradeManifestOpts struct {
+	upgradeManifestVars
+
+	ws wsSvcManifestUpgrader
+	w  io.Writer
+}
+
+func newUpgradeManifestOpts(vars upgradeManifestVars) (*upgradeManifestOpts, error) {
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace: %w", err)
+	}
+	return &upgradeManifestOpts{
+		upgradeManifestVars: vars,
+		ws:                  ws,
+		w:                   log.OutputWriter,
+	}, nil
+}
+
+// Validate returns an error if the service isn't in the workspace.
+func (o *upgradeManifestOpts) Validate() error {
+	if o.svcName == "" {
+		return nil
+	}
+	names, err := o.ws.ServiceNames()
+	if err != nil {
+		return fmt.Errorf("list services in the workspace: %w", err)
+	}
+	if !contains(o.svcName, names) {
+		return fmt.Errorf("service %s not found in the workspace", o.svcName)
+	}
+	return nil
+}
+
+// Execute upgrades the manifest of the service, or of every service in the workspace,
+// to the latest version of the schema and prints the changes.
+func (o *upgradeManifestOpts) Execute() error {
+	names := []string{o.svcName}
+	if o.svcName == "" {
+		all, err := o.ws.ServiceNames()
+		if err != nil {
+			return fmt.Errorf("list services in the workspace: %w", err)
+		}
+		names = all
+	}
+	for _, name := range names {
+		if err := o.upgrade(name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (o *upgradeManifestOpts) upgrade(svc string) error {
+	raw, err := o.ws.ReadRawServiceManifest(svc)
+	if err != nil {
+		return fmt.Errorf("read service %s manifest from workspace: %w", svc, err)
+	}
+	upgrade, err := manifest.UpgradeService(raw)
+	if err != nil {
+		return fmt.Errorf("upgrade service %s manifest: %w", svc, err)
+	}
+	if upgrade.From == upgrade.To {
+		log.Infof("Manifest of service %s is already at the latest version %d.\n", color.HighlightUserInput(svc), upgrade.To)
+		return nil
+	}
+	fmt.Fprintf(o.w, color.Bold.Sprintf("Service %s: version %d -> %d\n", svc, upgrade.From, upgrade.To))
+	for _, change := range upgrade.Changes {
+		fmt.Fprintf(o.w, "  - %s\n", change)
+	}
+	fmt.Fprint(o.w, lineDiff(string(raw), string(upgrade.Manifest)))
+	if o.dryRun {
+		return nil
+	}
+	path, err := o.ws.OverwriteServiceManifest(upgrade.Manifest, svc)
+	if err != nil {
+		return fmt.Errorf("write service %s manifest: %w", svc, err)
+	}
+	log.Successf("Upgraded manifest %s to version %d.\n", color.HighlightResource(path), upgrade.To)
+	return nil
+}
+
+// lineDiff returns the lines removed from before prefixed with "-", and the lines added in after prefixed with "+",
+// surrounded by a few unchanged lines.
+func lineDiff(before, after string) string {
+	a, b := strings.Split(before, "\n"), strings.Split(after, "\n")
+	// lcs[i][j] is the length of the longest common subsequence of a[i:] and b[j:].
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+	type line struct {
+		op   byte
+		text string
+	}
+	var lines []line
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			lines = append(lines, line{' ', a[i]})
+			i++
+			j++
+		case j < len(b) && (i == len(a) || lcs[i][j+1] >= lcs[i+1][j]):
+			lines = append(lines, line{'+', b[j]})
+			j++
+		default:
+			lines = append(lines, line{'-', a[i]})
+			i++
+		}
+	}
+	show := make([]bool, len(lines))
+	for k, l := range lines {
+		if l.op == ' ' {
+			continue
+		}
+		for c := k - diffContextLines; c <= k+diffContextLines; c++ {
+			if c >= 0 && c < len(lines) {
+				show[c] = true
+			}
+		}
+	}
+	var sb strings.Builder
+	for k, l := range lines {
+		if !show[k] {
+			if k > 0 && show[k-1] {
+				sb.WriteString("  ...\n")
+			}
+			continue
+		}
+		text := strings.TrimRight(fmt.Sprintf("%c %s", l.op, l.text), " ")
+		switch l.op {
+		case '+':
+			text = color.Green.Sprint(text)
+		case '-':
+			text = color.Red.Sprint(text)
+		}
+		sb.WriteString(text + "\n")
+	}
+	return sb.String()
+}
+
+// BuildManifestUpgradeCmd builds the command for upgrading service manifests to the latest version of the schema.
+func BuildManifestUpgradeCmd() *cobra.Command {
+	vars := upgradeManifestVars{}
+	cmd := &cobra.Command{
+		Use:   "upgrade",
+		Short: "Upgrades the manifests of services to the latest version of the schema.",
+		Long: `Upgrades the manifests of services in the workspace to the latest version of the schema.
+The changes made to each manifest are printed before the manifest is rewritten.`,
+
+		Example: `
+  Upgrades the manifests of all the services in the workspace.
+  /code $ copilot manifest upgrade
+
+  Shows the changes to the manifest of the "api" service without rewriting it.
+  /code $ copilot manifest upgrade -n api --dry-run`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newUpgradeManifestOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.svcName, nameFlag, nameFlagShort, "", manifestUpgradeNameFlagDescription)
+	cmd.Flags().BoolVar(&vars.dryRun, dryRunFlag, false, manifestUpgradeDryRunFlagDescription)
+	return cmd
+}
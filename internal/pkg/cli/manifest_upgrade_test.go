@@ -0,0 +1,166 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpgradeManifestOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inSvc      string
+		setupMocks func(m *mocks.MockwsSvcManifestUpgrader)
+
+		wantedError error
+	}{
+		"skips validation if no service is provided": {
+			setupMocks: func(m *mocks.MockwsSvcManifestUpgrader) {},
+		},
+		"errors if the service isn't in the workspace": {
+			inSvc: "api",
+			setupMocks: func(m *mocks.MockwsSvcManifestUpgrader) {
+				m.EXPECT().ServiceNames().Return([]string{"frontend"}, nil)
+			},
+			wantedError: errors.New("service api not found in the workspace"),
+		},
+		"succeeds if the service is in the workspace": {
+			inSvc: "api",
+			setupMocks: func(m *mocks.MockwsSvcManifestUpgrader) {
+				m.EXPECT().ServiceNames().Return([]string{"frontend", "api"}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockWs := mocks.NewMockwsSvcManifestUpgrader(ctrl)
+			tc.setupMocks(mockWs)
+			opts := &upgradeManifestOpts{
+				upgradeManifestVars: upgradeManifestVars{
+					svcName: tc.inSvc,
+				},
+				ws: mockWs,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestUpgradeManifestOpts_Execute(t *testing.T) {
+	const (
+		unversioned = `name: api
+type: Backend Service
+image:
+  port: 8080
+`
+		upgraded = `# The version of the manifest's schema. Run "copilot manifest upgrade" to upgrade the manifest to the latest version.
+version: 1
+
+name: api
+type: Backend Service
+image:
+  port: 8080
+`
+		wantedOutput = `Service api: version 0 -> 1
+  - Set the version of the manifest's schema.
++ # The version of the manifest's schema. Run "copilot manifest upgrade" to upgrade the manifest to the latest version.
++ version: 1
++
+  name: api
+  type: Backend Service
+  ...
+`
+	)
+	testCases := map[string]struct {
+		inSvc      string
+		inDryRun   bool
+		setupMocks func(m *mocks.MockwsSvcManifestUpgrader)
+
+		wantedOutput string
+		wantedError  error
+	}{
+		"upgrades the manifests of every service": {
+			setupMocks: func(m *mocks.MockwsSvcManifestUpgrader) {
+				m.EXPECT().ServiceNames().Return([]string{"api", "frontend"}, nil)
+				m.EXPECT().ReadRawServiceManifest("api").Return([]byte(unversioned), nil)
+				m.EXPECT().OverwriteServiceManifest([]byte(upgraded), "api").Return("/copilot/api/manifest.yml", nil)
+				m.EXPECT().ReadRawServiceManifest("frontend").Return([]byte("version: 1\nname: frontend\n"), nil)
+			},
+			wantedOutput: wantedOutput,
+		},
+		"doesn't rewrite the manifest on a dry run": {
+			inSvc:    "api",
+			inDryRun: true,
+			setupMocks: func(m *mocks.MockwsSvcManifestUpgrader) {
+				m.EXPECT().ReadRawServiceManifest("api").Return([]byte(unversioned), nil)
+				m.EXPECT().OverwriteServiceManifest(gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedOutput: wantedOutput,
+		},
+		"errors if the manifest is of a newer version": {
+			inSvc: "api",
+			setupMocks: func(m *mocks.MockwsSvcManifestUpgrader) {
+				m.EXPECT().ReadRawServiceManifest("api").Return([]byte("version: 2\nname: api\n"), nil)
+			},
+			wantedError: errors.New("upgrade service api manifest: manifest version 2 is newer than the latest version 1 supported by this version of Copilot, please upgrade Copilot"),
+		},
+		"errors if the manifest can't be written": {
+			inSvc: "api",
+			setupMocks: func(m *mocks.MockwsSvcManifestUpgrader) {
+				m.EXPECT().ReadRawServiceManifest("api").Return([]byte(unversioned), nil)
+				m.EXPECT().OverwriteServiceManifest(gomock.Any(), "api").Return("", errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("write service api manifest: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockWs := mocks.NewMockwsSvcManifestUpgrader(ctrl)
+			tc.setupMocks(mockWs)
+			b := &bytes.Buffer{}
+			opts := &upgradeManifestOpts{
+				upgradeManifestVars: upgradeManifestVars{
+					svcName: tc.inSvc,
+					dryRun:  tc.inDryRun,
+				},
+				ws: mockWs,
+				w:  b,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedOutput, b.String())
+			}
+		})
+	}
+}
@@ -5,18 +5,32 @@
 package mocks
 
 import (
+	context "context"
 	encoding "encoding"
 	session "github.com/aws/aws-sdk-go/aws/session"
-	cloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	cloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
+	acm "github.com/aws/copilot-cli/internal/pkg/aws/acm"
+	applicationautoscaling "github.com/aws/copilot-cli/internal/pkg/aws/applicationautoscaling"
+	athena "github.com/aws/copilot-cli/internal/pkg/aws/athena"
+	cloudformation0 "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	cloudwatch "github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
 	cloudwatchlogs "github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	codedeploy "github.com/aws/copilot-cli/internal/pkg/aws/codedeploy"
 	codepipeline "github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	ec2 "github.com/aws/copilot-cli/internal/pkg/aws/ec2"
 	ecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	elbv2 "github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
+	rds "github.com/aws/copilot-cli/internal/pkg/aws/rds"
+	route53 "github.com/aws/copilot-cli/internal/pkg/aws/route53"
+	s3 "github.com/aws/copilot-cli/internal/pkg/aws/s3"
 	config "github.com/aws/copilot-cli/internal/pkg/config"
 	deploy "github.com/aws/copilot-cli/internal/pkg/deploy"
 	stack "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	describe "github.com/aws/copilot-cli/internal/pkg/describe"
 	docker "github.com/aws/copilot-cli/internal/pkg/docker"
 	dockerfile "github.com/aws/copilot-cli/internal/pkg/docker/dockerfile"
+	policy "github.com/aws/copilot-cli/internal/pkg/policy"
+	probe "github.com/aws/copilot-cli/internal/pkg/probe"
 	repository "github.com/aws/copilot-cli/internal/pkg/repository"
 	task "github.com/aws/copilot-cli/internal/pkg/task"
 	command "github.com/aws/copilot-cli/internal/pkg/term/command"
@@ -25,6 +39,7 @@ import (
 	gomock "github.com/golang/mock/gomock"
 	io "io"
 	reflect "reflect"
+	time "time"
 )
 
 // MockactionCommand is a mock of actionCommand interface
@@ -649,6 +664,20 @@ func (mr *MockenvironmentStoreMockRecorder) DeleteEnvironment(appName, environme
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEnvironment", reflect.TypeOf((*MockenvironmentStore)(nil).DeleteEnvironment), appName, environmentName)
 }
 
+// UpdateEnvironment mocks base method
+func (m *MockenvironmentStore) UpdateEnvironment(env *config.Environment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEnvironment", env)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEnvironment indicates an expected call of UpdateEnvironment
+func (mr *MockenvironmentStoreMockRecorder) UpdateEnvironment(env interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEnvironment", reflect.TypeOf((*MockenvironmentStore)(nil).UpdateEnvironment), env)
+}
+
 // MockenvironmentCreator is a mock of environmentCreator interface
 type MockenvironmentCreator struct {
 	ctrl     *gomock.Controller
@@ -799,6 +828,43 @@ func (mr *MockenvironmentDeleterMockRecorder) DeleteEnvironment(appName, environ
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEnvironment", reflect.TypeOf((*MockenvironmentDeleter)(nil).DeleteEnvironment), appName, environmentName)
 }
 
+// MockenvironmentUpdater is a mock of environmentUpdater interface
+type MockenvironmentUpdater struct {
+	ctrl     *gomock.Controller
+	recorder *MockenvironmentUpdaterMockRecorder
+}
+
+// MockenvironmentUpdaterMockRecorder is the mock recorder for MockenvironmentUpdater
+type MockenvironmentUpdaterMockRecorder struct {
+	mock *MockenvironmentUpdater
+}
+
+// NewMockenvironmentUpdater creates a new mock instance
+func NewMockenvironmentUpdater(ctrl *gomock.Controller) *MockenvironmentUpdater {
+	mock := &MockenvironmentUpdater{ctrl: ctrl}
+	mock.recorder = &MockenvironmentUpdaterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockenvironmentUpdater) EXPECT() *MockenvironmentUpdaterMockRecorder {
+	return m.recorder
+}
+
+// UpdateEnvironment mocks base method
+func (m *MockenvironmentUpdater) UpdateEnvironment(env *config.Environment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEnvironment", env)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEnvironment indicates an expected call of UpdateEnvironment
+func (mr *MockenvironmentUpdaterMockRecorder) UpdateEnvironment(env interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEnvironment", reflect.TypeOf((*MockenvironmentUpdater)(nil).UpdateEnvironment), env)
+}
+
 // Mockstore is a mock of store interface
 type Mockstore struct {
 	ctrl     *gomock.Controller
@@ -938,6 +1004,20 @@ func (mr *MockstoreMockRecorder) DeleteEnvironment(appName, environmentName inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEnvironment", reflect.TypeOf((*Mockstore)(nil).DeleteEnvironment), appName, environmentName)
 }
 
+// UpdateEnvironment mocks base method
+func (m *Mockstore) UpdateEnvironment(env *config.Environment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEnvironment", env)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEnvironment indicates an expected call of UpdateEnvironment
+func (mr *MockstoreMockRecorder) UpdateEnvironment(env interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEnvironment", reflect.TypeOf((*Mockstore)(nil).UpdateEnvironment), env)
+}
+
 // CreateService mocks base method
 func (m *Mockstore) CreateService(svc *config.Service) error {
 	m.ctrl.T.Helper()
@@ -1228,6 +1308,20 @@ func (mr *MockimageBuilderPusherMockRecorder) BuildAndPush(docker, args interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildAndPush", reflect.TypeOf((*MockimageBuilderPusher)(nil).BuildAndPush), docker, args)
 }
 
+// BuildCheckAndPush mocks base method
+func (m *MockimageBuilderPusher) BuildCheckAndPush(docker repository.ContainerLoginBuildPusher, args *docker.BuildArguments, checker repository.ImageChecker) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BuildCheckAndPush", docker, args, checker)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BuildCheckAndPush indicates an expected call of BuildCheckAndPush
+func (mr *MockimageBuilderPusherMockRecorder) BuildCheckAndPush(docker, args, checker interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildCheckAndPush", reflect.TypeOf((*MockimageBuilderPusher)(nil).BuildCheckAndPush), docker, args, checker)
+}
+
 // MockrepositoryURIGetter is a mock of repositoryURIGetter interface
 type MockrepositoryURIGetter struct {
 	ctrl     *gomock.Controller
@@ -1316,6 +1410,20 @@ func (mr *MockrepositoryServiceMockRecorder) BuildAndPush(docker, args interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildAndPush", reflect.TypeOf((*MockrepositoryService)(nil).BuildAndPush), docker, args)
 }
 
+// BuildCheckAndPush mocks base method
+func (m *MockrepositoryService) BuildCheckAndPush(docker repository.ContainerLoginBuildPusher, args *docker.BuildArguments, checker repository.ImageChecker) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BuildCheckAndPush", docker, args, checker)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BuildCheckAndPush indicates an expected call of BuildCheckAndPush
+func (mr *MockrepositoryServiceMockRecorder) BuildCheckAndPush(docker, args, checker interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildCheckAndPush", reflect.TypeOf((*MockrepositoryService)(nil).BuildCheckAndPush), docker, args, checker)
+}
+
 // MockcwlogService is a mock of cwlogService interface
 type MockcwlogService struct {
 	ctrl     *gomock.Controller
@@ -1359,6 +1467,26 @@ func (mr *MockcwlogServiceMockRecorder) TaskLogEvents(logGroupName, streamLastEv
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TaskLogEvents", reflect.TypeOf((*MockcwlogService)(nil).TaskLogEvents), varargs...)
 }
 
+// LogEventsOfTasks mocks base method
+func (m *MockcwlogService) LogEventsOfTasks(logGroupName string, taskIDs []string, opts ...cloudwatchlogs.GetLogEventsOpts) (*cloudwatchlogs.LogEventsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{logGroupName, taskIDs}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "LogEventsOfTasks", varargs...)
+	ret0, _ := ret[0].(*cloudwatchlogs.LogEventsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LogEventsOfTasks indicates an expected call of LogEventsOfTasks
+func (mr *MockcwlogServiceMockRecorder) LogEventsOfTasks(logGroupName, taskIDs interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{logGroupName, taskIDs}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogEventsOfTasks", reflect.TypeOf((*MockcwlogService)(nil).LogEventsOfTasks), varargs...)
+}
+
 // LogGroupExists mocks base method
 func (m *MockcwlogService) LogGroupExists(logGroupName string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -1374,6 +1502,97 @@ func (mr *MockcwlogServiceMockRecorder) LogGroupExists(logGroupName interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogGroupExists", reflect.TypeOf((*MockcwlogService)(nil).LogGroupExists), logGroupName)
 }
 
+// Query mocks base method
+func (m *MockcwlogService) Query(logGroupName, query string, startTime, endTime int64) (*cloudwatchlogs.QueryResults, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Query", logGroupName, query, startTime, endTime)
+	ret0, _ := ret[0].(*cloudwatchlogs.QueryResults)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Query indicates an expected call of Query
+func (mr *MockcwlogServiceMockRecorder) Query(logGroupName, query, startTime, endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Query", reflect.TypeOf((*MockcwlogService)(nil).Query), logGroupName, query, startTime, endTime)
+}
+
+// MockaccessLogsQuerier is a mock of accessLogsQuerier interface
+type MockaccessLogsQuerier struct {
+	ctrl     *gomock.Controller
+	recorder *MockaccessLogsQuerierMockRecorder
+}
+
+// MockaccessLogsQuerierMockRecorder is the mock recorder for MockaccessLogsQuerier
+type MockaccessLogsQuerierMockRecorder struct {
+	mock *MockaccessLogsQuerier
+}
+
+// NewMockaccessLogsQuerier creates a new mock instance
+func NewMockaccessLogsQuerier(ctrl *gomock.Controller) *MockaccessLogsQuerier {
+	mock := &MockaccessLogsQuerier{ctrl: ctrl}
+	mock.recorder = &MockaccessLogsQuerierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockaccessLogsQuerier) EXPECT() *MockaccessLogsQuerierMockRecorder {
+	return m.recorder
+}
+
+// Query mocks base method
+func (m *MockaccessLogsQuerier) Query(workGroup, database, query string) (*athena.QueryResults, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Query", workGroup, database, query)
+	ret0, _ := ret[0].(*athena.QueryResults)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Query indicates an expected call of Query
+func (mr *MockaccessLogsQuerierMockRecorder) Query(workGroup, database, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Query", reflect.TypeOf((*MockaccessLogsQuerier)(nil).Query), workGroup, database, query)
+}
+
+// MocksvcStackResourcesDescriber is a mock of svcStackResourcesDescriber interface
+type MocksvcStackResourcesDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MocksvcStackResourcesDescriberMockRecorder
+}
+
+// MocksvcStackResourcesDescriberMockRecorder is the mock recorder for MocksvcStackResourcesDescriber
+type MocksvcStackResourcesDescriberMockRecorder struct {
+	mock *MocksvcStackResourcesDescriber
+}
+
+// NewMocksvcStackResourcesDescriber creates a new mock instance
+func NewMocksvcStackResourcesDescriber(ctrl *gomock.Controller) *MocksvcStackResourcesDescriber {
+	mock := &MocksvcStackResourcesDescriber{ctrl: ctrl}
+	mock.recorder = &MocksvcStackResourcesDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MocksvcStackResourcesDescriber) EXPECT() *MocksvcStackResourcesDescriberMockRecorder {
+	return m.recorder
+}
+
+// ServiceStackResources mocks base method
+func (m *MocksvcStackResourcesDescriber) ServiceStackResources() ([]*cloudformation.StackResource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceStackResources")
+	ret0, _ := ret[0].([]*cloudformation.StackResource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ServiceStackResources indicates an expected call of ServiceStackResources
+func (mr *MocksvcStackResourcesDescriberMockRecorder) ServiceStackResources() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceStackResources", reflect.TypeOf((*MocksvcStackResourcesDescriber)(nil).ServiceStackResources))
+}
+
 // Mocktemplater is a mock of templater interface
 type Mocktemplater struct {
 	ctrl     *gomock.Controller
@@ -1412,6 +1631,120 @@ func (mr *MocktemplaterMockRecorder) Template() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Template", reflect.TypeOf((*Mocktemplater)(nil).Template))
 }
 
+// MockpolicyFilesReader is a mock of policyFilesReader interface
+type MockpolicyFilesReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockpolicyFilesReaderMockRecorder
+}
+
+// MockpolicyFilesReaderMockRecorder is the mock recorder for MockpolicyFilesReader
+type MockpolicyFilesReaderMockRecorder struct {
+	mock *MockpolicyFilesReader
+}
+
+// NewMockpolicyFilesReader creates a new mock instance
+func NewMockpolicyFilesReader(ctrl *gomock.Controller) *MockpolicyFilesReader {
+	mock := &MockpolicyFilesReader{ctrl: ctrl}
+	mock.recorder = &MockpolicyFilesReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockpolicyFilesReader) EXPECT() *MockpolicyFilesReaderMockRecorder {
+	return m.recorder
+}
+
+// ReadPoliciesDir mocks base method
+func (m *MockpolicyFilesReader) ReadPoliciesDir() (*workspace.PolicyFiles, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadPoliciesDir")
+	ret0, _ := ret[0].(*workspace.PolicyFiles)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadPoliciesDir indicates an expected call of ReadPoliciesDir
+func (mr *MockpolicyFilesReaderMockRecorder) ReadPoliciesDir() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadPoliciesDir", reflect.TypeOf((*MockpolicyFilesReader)(nil).ReadPoliciesDir))
+}
+
+// MockcveAllowlistReader is a mock of cveAllowlistReader interface
+type MockcveAllowlistReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockcveAllowlistReaderMockRecorder
+}
+
+// MockcveAllowlistReaderMockRecorder is the mock recorder for MockcveAllowlistReader
+type MockcveAllowlistReaderMockRecorder struct {
+	mock *MockcveAllowlistReader
+}
+
+// NewMockcveAllowlistReader creates a new mock instance
+func NewMockcveAllowlistReader(ctrl *gomock.Controller) *MockcveAllowlistReader {
+	mock := &MockcveAllowlistReader{ctrl: ctrl}
+	mock.recorder = &MockcveAllowlistReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockcveAllowlistReader) EXPECT() *MockcveAllowlistReaderMockRecorder {
+	return m.recorder
+}
+
+// ReadCVEAllowlist mocks base method
+func (m *MockcveAllowlistReader) ReadCVEAllowlist() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadCVEAllowlist")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadCVEAllowlist indicates an expected call of ReadCVEAllowlist
+func (mr *MockcveAllowlistReaderMockRecorder) ReadCVEAllowlist() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadCVEAllowlist", reflect.TypeOf((*MockcveAllowlistReader)(nil).ReadCVEAllowlist))
+}
+
+// MockpolicyChecker is a mock of policyChecker interface
+type MockpolicyChecker struct {
+	ctrl     *gomock.Controller
+	recorder *MockpolicyCheckerMockRecorder
+}
+
+// MockpolicyCheckerMockRecorder is the mock recorder for MockpolicyChecker
+type MockpolicyCheckerMockRecorder struct {
+	mock *MockpolicyChecker
+}
+
+// NewMockpolicyChecker creates a new mock instance
+func NewMockpolicyChecker(ctrl *gomock.Controller) *MockpolicyChecker {
+	mock := &MockpolicyChecker{ctrl: ctrl}
+	mock.recorder = &MockpolicyCheckerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockpolicyChecker) EXPECT() *MockpolicyCheckerMockRecorder {
+	return m.recorder
+}
+
+// Check mocks base method
+func (m *MockpolicyChecker) Check(template string, rulesFiles []string) ([]policy.Violation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Check", template, rulesFiles)
+	ret0, _ := ret[0].([]policy.Violation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Check indicates an expected call of Check
+func (mr *MockpolicyCheckerMockRecorder) Check(template, rulesFiles interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Check", reflect.TypeOf((*MockpolicyChecker)(nil).Check), template, rulesFiles)
+}
+
 // MockstackSerializer is a mock of stackSerializer interface
 type MockstackSerializer struct {
 	ctrl     *gomock.Controller
@@ -1952,6 +2285,112 @@ func (mr *MockwsPipelineManifestReaderMockRecorder) ReadPipelineManifest() *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadPipelineManifest", reflect.TypeOf((*MockwsPipelineManifestReader)(nil).ReadPipelineManifest))
 }
 
+// ReadNamedPipelineManifest mocks base method
+func (m *MockwsPipelineManifestReader) ReadNamedPipelineManifest(name string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadNamedPipelineManifest", name)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadNamedPipelineManifest indicates an expected call of ReadNamedPipelineManifest
+func (mr *MockwsPipelineManifestReaderMockRecorder) ReadNamedPipelineManifest(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadNamedPipelineManifest", reflect.TypeOf((*MockwsPipelineManifestReader)(nil).ReadNamedPipelineManifest), name)
+}
+
+// PipelineManifestNames mocks base method
+func (m *MockwsPipelineManifestReader) PipelineManifestNames() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PipelineManifestNames")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PipelineManifestNames indicates an expected call of PipelineManifestNames
+func (mr *MockwsPipelineManifestReaderMockRecorder) PipelineManifestNames() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PipelineManifestNames", reflect.TypeOf((*MockwsPipelineManifestReader)(nil).PipelineManifestNames))
+}
+
+// MockwsTaskPresetReader is a mock of wsTaskPresetReader interface
+type MockwsTaskPresetReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockwsTaskPresetReaderMockRecorder
+}
+
+// MockwsTaskPresetReaderMockRecorder is the mock recorder for MockwsTaskPresetReader
+type MockwsTaskPresetReaderMockRecorder struct {
+	mock *MockwsTaskPresetReader
+}
+
+// NewMockwsTaskPresetReader creates a new mock instance
+func NewMockwsTaskPresetReader(ctrl *gomock.Controller) *MockwsTaskPresetReader {
+	mock := &MockwsTaskPresetReader{ctrl: ctrl}
+	mock.recorder = &MockwsTaskPresetReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockwsTaskPresetReader) EXPECT() *MockwsTaskPresetReaderMockRecorder {
+	return m.recorder
+}
+
+// ReadTaskPreset mocks base method
+func (m *MockwsTaskPresetReader) ReadTaskPreset(name string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadTaskPreset", name)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadTaskPreset indicates an expected call of ReadTaskPreset
+func (mr *MockwsTaskPresetReaderMockRecorder) ReadTaskPreset(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadTaskPreset", reflect.TypeOf((*MockwsTaskPresetReader)(nil).ReadTaskPreset), name)
+}
+
+// MockwsEnvironmentManifestReader is a mock of wsEnvironmentManifestReader interface
+type MockwsEnvironmentManifestReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockwsEnvironmentManifestReaderMockRecorder
+}
+
+// MockwsEnvironmentManifestReaderMockRecorder is the mock recorder for MockwsEnvironmentManifestReader
+type MockwsEnvironmentManifestReaderMockRecorder struct {
+	mock *MockwsEnvironmentManifestReader
+}
+
+// NewMockwsEnvironmentManifestReader creates a new mock instance
+func NewMockwsEnvironmentManifestReader(ctrl *gomock.Controller) *MockwsEnvironmentManifestReader {
+	mock := &MockwsEnvironmentManifestReader{ctrl: ctrl}
+	mock.recorder = &MockwsEnvironmentManifestReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockwsEnvironmentManifestReader) EXPECT() *MockwsEnvironmentManifestReaderMockRecorder {
+	return m.recorder
+}
+
+// ReadEnvironmentManifest mocks base method
+func (m *MockwsEnvironmentManifestReader) ReadEnvironmentManifest(name string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadEnvironmentManifest", name)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadEnvironmentManifest indicates an expected call of ReadEnvironmentManifest
+func (mr *MockwsEnvironmentManifestReaderMockRecorder) ReadEnvironmentManifest(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadEnvironmentManifest", reflect.TypeOf((*MockwsEnvironmentManifestReader)(nil).ReadEnvironmentManifest), name)
+}
+
 // MockwsPipelineWriter is a mock of wsPipelineWriter interface
 type MockwsPipelineWriter struct {
 	ctrl     *gomock.Controller
@@ -2005,6 +2444,21 @@ func (mr *MockwsPipelineWriterMockRecorder) WritePipelineManifest(marshaler inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WritePipelineManifest", reflect.TypeOf((*MockwsPipelineWriter)(nil).WritePipelineManifest), marshaler)
 }
 
+// WriteNamedPipelineManifest mocks base method
+func (m *MockwsPipelineWriter) WriteNamedPipelineManifest(marshaler encoding.BinaryMarshaler, name string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteNamedPipelineManifest", marshaler, name)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WriteNamedPipelineManifest indicates an expected call of WriteNamedPipelineManifest
+func (mr *MockwsPipelineWriterMockRecorder) WriteNamedPipelineManifest(marshaler, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteNamedPipelineManifest", reflect.TypeOf((*MockwsPipelineWriter)(nil).WriteNamedPipelineManifest), marshaler, name)
+}
+
 // MockwsServiceLister is a mock of wsServiceLister interface
 type MockwsServiceLister struct {
 	ctrl     *gomock.Controller
@@ -2096,31 +2550,31 @@ func (mr *MockwsSvcReaderMockRecorder) ReadServiceManifest(svcName interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadServiceManifest", reflect.TypeOf((*MockwsSvcReader)(nil).ReadServiceManifest), svcName)
 }
 
-// MockwsSvcDirReader is a mock of wsSvcDirReader interface
-type MockwsSvcDirReader struct {
+// MockwsSvcManifestUpgrader is a mock of wsSvcManifestUpgrader interface
+type MockwsSvcManifestUpgrader struct {
 	ctrl     *gomock.Controller
-	recorder *MockwsSvcDirReaderMockRecorder
+	recorder *MockwsSvcManifestUpgraderMockRecorder
 }
 
-// MockwsSvcDirReaderMockRecorder is the mock recorder for MockwsSvcDirReader
-type MockwsSvcDirReaderMockRecorder struct {
-	mock *MockwsSvcDirReader
+// MockwsSvcManifestUpgraderMockRecorder is the mock recorder for MockwsSvcManifestUpgrader
+type MockwsSvcManifestUpgraderMockRecorder struct {
+	mock *MockwsSvcManifestUpgrader
 }
 
-// NewMockwsSvcDirReader creates a new mock instance
-func NewMockwsSvcDirReader(ctrl *gomock.Controller) *MockwsSvcDirReader {
-	mock := &MockwsSvcDirReader{ctrl: ctrl}
-	mock.recorder = &MockwsSvcDirReaderMockRecorder{mock}
+// NewMockwsSvcManifestUpgrader creates a new mock instance
+func NewMockwsSvcManifestUpgrader(ctrl *gomock.Controller) *MockwsSvcManifestUpgrader {
+	mock := &MockwsSvcManifestUpgrader{ctrl: ctrl}
+	mock.recorder = &MockwsSvcManifestUpgraderMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockwsSvcDirReader) EXPECT() *MockwsSvcDirReaderMockRecorder {
+func (m *MockwsSvcManifestUpgrader) EXPECT() *MockwsSvcManifestUpgraderMockRecorder {
 	return m.recorder
 }
 
 // ServiceNames mocks base method
-func (m *MockwsSvcDirReader) ServiceNames() ([]string, error) {
+func (m *MockwsSvcManifestUpgrader) ServiceNames() ([]string, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ServiceNames")
 	ret0, _ := ret[0].([]string)
@@ -2129,9 +2583,145 @@ func (m *MockwsSvcDirReader) ServiceNames() ([]string, error) {
 }
 
 // ServiceNames indicates an expected call of ServiceNames
-func (mr *MockwsSvcDirReaderMockRecorder) ServiceNames() *gomock.Call {
+func (mr *MockwsSvcManifestUpgraderMockRecorder) ServiceNames() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceNames", reflect.TypeOf((*MockwsSvcDirReader)(nil).ServiceNames))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceNames", reflect.TypeOf((*MockwsSvcManifestUpgrader)(nil).ServiceNames))
+}
+
+// ReadRawServiceManifest mocks base method
+func (m *MockwsSvcManifestUpgrader) ReadRawServiceManifest(name string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadRawServiceManifest", name)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadRawServiceManifest indicates an expected call of ReadRawServiceManifest
+func (mr *MockwsSvcManifestUpgraderMockRecorder) ReadRawServiceManifest(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadRawServiceManifest", reflect.TypeOf((*MockwsSvcManifestUpgrader)(nil).ReadRawServiceManifest), name)
+}
+
+// OverwriteServiceManifest mocks base method
+func (m *MockwsSvcManifestUpgrader) OverwriteServiceManifest(data []byte, name string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OverwriteServiceManifest", data, name)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OverwriteServiceManifest indicates an expected call of OverwriteServiceManifest
+func (mr *MockwsSvcManifestUpgraderMockRecorder) OverwriteServiceManifest(data, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OverwriteServiceManifest", reflect.TypeOf((*MockwsSvcManifestUpgrader)(nil).OverwriteServiceManifest), data, name)
+}
+
+// MockwsAppSvcReader is a mock of wsAppSvcReader interface
+type MockwsAppSvcReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockwsAppSvcReaderMockRecorder
+}
+
+// MockwsAppSvcReaderMockRecorder is the mock recorder for MockwsAppSvcReader
+type MockwsAppSvcReaderMockRecorder struct {
+	mock *MockwsAppSvcReader
+}
+
+// NewMockwsAppSvcReader creates a new mock instance
+func NewMockwsAppSvcReader(ctrl *gomock.Controller) *MockwsAppSvcReader {
+	mock := &MockwsAppSvcReader{ctrl: ctrl}
+	mock.recorder = &MockwsAppSvcReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockwsAppSvcReader) EXPECT() *MockwsAppSvcReaderMockRecorder {
+	return m.recorder
+}
+
+// ServiceNames mocks base method
+func (m *MockwsAppSvcReader) ServiceNames() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceNames")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ServiceNames indicates an expected call of ServiceNames
+func (mr *MockwsAppSvcReaderMockRecorder) ServiceNames() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceNames", reflect.TypeOf((*MockwsAppSvcReader)(nil).ServiceNames))
+}
+
+// ReadServiceManifest mocks base method
+func (m *MockwsAppSvcReader) ReadServiceManifest(svcName string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadServiceManifest", svcName)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadServiceManifest indicates an expected call of ReadServiceManifest
+func (mr *MockwsAppSvcReaderMockRecorder) ReadServiceManifest(svcName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadServiceManifest", reflect.TypeOf((*MockwsAppSvcReader)(nil).ReadServiceManifest), svcName)
+}
+
+// Summary mocks base method
+func (m *MockwsAppSvcReader) Summary() (*workspace.Summary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Summary")
+	ret0, _ := ret[0].(*workspace.Summary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Summary indicates an expected call of Summary
+func (mr *MockwsAppSvcReaderMockRecorder) Summary() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Summary", reflect.TypeOf((*MockwsAppSvcReader)(nil).Summary))
+}
+
+// MockwsSvcDirReader is a mock of wsSvcDirReader interface
+type MockwsSvcDirReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockwsSvcDirReaderMockRecorder
+}
+
+// MockwsSvcDirReaderMockRecorder is the mock recorder for MockwsSvcDirReader
+type MockwsSvcDirReaderMockRecorder struct {
+	mock *MockwsSvcDirReader
+}
+
+// NewMockwsSvcDirReader creates a new mock instance
+func NewMockwsSvcDirReader(ctrl *gomock.Controller) *MockwsSvcDirReader {
+	mock := &MockwsSvcDirReader{ctrl: ctrl}
+	mock.recorder = &MockwsSvcDirReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockwsSvcDirReader) EXPECT() *MockwsSvcDirReaderMockRecorder {
+	return m.recorder
+}
+
+// ServiceNames mocks base method
+func (m *MockwsSvcDirReader) ServiceNames() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceNames")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ServiceNames indicates an expected call of ServiceNames
+func (mr *MockwsSvcDirReaderMockRecorder) ServiceNames() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceNames", reflect.TypeOf((*MockwsSvcDirReader)(nil).ServiceNames))
 }
 
 // ReadServiceManifest mocks base method
@@ -2202,6 +2792,21 @@ func (mr *MockwsPipelineReaderMockRecorder) ServiceNames() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceNames", reflect.TypeOf((*MockwsPipelineReader)(nil).ServiceNames))
 }
 
+// ReadServiceManifest mocks base method
+func (m *MockwsPipelineReader) ReadServiceManifest(svcName string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadServiceManifest", svcName)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadServiceManifest indicates an expected call of ReadServiceManifest
+func (mr *MockwsPipelineReaderMockRecorder) ReadServiceManifest(svcName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadServiceManifest", reflect.TypeOf((*MockwsPipelineReader)(nil).ReadServiceManifest), svcName)
+}
+
 // ReadPipelineManifest mocks base method
 func (m *MockwsPipelineReader) ReadPipelineManifest() ([]byte, error) {
 	m.ctrl.T.Helper()
@@ -2217,6 +2822,36 @@ func (mr *MockwsPipelineReaderMockRecorder) ReadPipelineManifest() *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadPipelineManifest", reflect.TypeOf((*MockwsPipelineReader)(nil).ReadPipelineManifest))
 }
 
+// ReadNamedPipelineManifest mocks base method
+func (m *MockwsPipelineReader) ReadNamedPipelineManifest(name string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadNamedPipelineManifest", name)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadNamedPipelineManifest indicates an expected call of ReadNamedPipelineManifest
+func (mr *MockwsPipelineReaderMockRecorder) ReadNamedPipelineManifest(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadNamedPipelineManifest", reflect.TypeOf((*MockwsPipelineReader)(nil).ReadNamedPipelineManifest), name)
+}
+
+// PipelineManifestNames mocks base method
+func (m *MockwsPipelineReader) PipelineManifestNames() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PipelineManifestNames")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PipelineManifestNames indicates an expected call of PipelineManifestNames
+func (mr *MockwsPipelineReaderMockRecorder) PipelineManifestNames() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PipelineManifestNames", reflect.TypeOf((*MockwsPipelineReader)(nil).PipelineManifestNames))
+}
+
 // MockwsAppManager is a mock of wsAppManager interface
 type MockwsAppManager struct {
 	ctrl     *gomock.Controller
@@ -2375,6 +3010,21 @@ func (mr *MockartifactUploaderMockRecorder) PutArtifact(bucket, fileName, data i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutArtifact", reflect.TypeOf((*MockartifactUploader)(nil).PutArtifact), bucket, fileName, data)
 }
 
+// PutArtifactWithPresignedURL mocks base method
+func (m *MockartifactUploader) PutArtifactWithPresignedURL(bucket, fileName string, data io.Reader, expiry time.Duration) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutArtifactWithPresignedURL", bucket, fileName, data, expiry)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutArtifactWithPresignedURL indicates an expected call of PutArtifactWithPresignedURL
+func (mr *MockartifactUploaderMockRecorder) PutArtifactWithPresignedURL(bucket, fileName, data, expiry interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutArtifactWithPresignedURL", reflect.TypeOf((*MockartifactUploader)(nil).PutArtifactWithPresignedURL), bucket, fileName, data, expiry)
+}
+
 // MockbucketEmptier is a mock of bucketEmptier interface
 type MockbucketEmptier struct {
 	ctrl     *gomock.Controller
@@ -2493,658 +3143,674 @@ func (mr *MockenvironmentDeployerMockRecorder) GetEnvironment(appName, envName i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEnvironment", reflect.TypeOf((*MockenvironmentDeployer)(nil).GetEnvironment), appName, envName)
 }
 
-// MocksvcDeleter is a mock of svcDeleter interface
-type MocksvcDeleter struct {
+// MockenvironmentStackUpdater is a mock of environmentStackUpdater interface
+type MockenvironmentStackUpdater struct {
 	ctrl     *gomock.Controller
-	recorder *MocksvcDeleterMockRecorder
+	recorder *MockenvironmentStackUpdaterMockRecorder
 }
 
-// MocksvcDeleterMockRecorder is the mock recorder for MocksvcDeleter
-type MocksvcDeleterMockRecorder struct {
-	mock *MocksvcDeleter
+// MockenvironmentStackUpdaterMockRecorder is the mock recorder for MockenvironmentStackUpdater
+type MockenvironmentStackUpdaterMockRecorder struct {
+	mock *MockenvironmentStackUpdater
 }
 
-// NewMocksvcDeleter creates a new mock instance
-func NewMocksvcDeleter(ctrl *gomock.Controller) *MocksvcDeleter {
-	mock := &MocksvcDeleter{ctrl: ctrl}
-	mock.recorder = &MocksvcDeleterMockRecorder{mock}
+// NewMockenvironmentStackUpdater creates a new mock instance
+func NewMockenvironmentStackUpdater(ctrl *gomock.Controller) *MockenvironmentStackUpdater {
+	mock := &MockenvironmentStackUpdater{ctrl: ctrl}
+	mock.recorder = &MockenvironmentStackUpdaterMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *MocksvcDeleter) EXPECT() *MocksvcDeleterMockRecorder {
+func (m *MockenvironmentStackUpdater) EXPECT() *MockenvironmentStackUpdaterMockRecorder {
 	return m.recorder
 }
 
-// DeleteService mocks base method
-func (m *MocksvcDeleter) DeleteService(in deploy.DeleteServiceInput) error {
+// UpdateEnvironment mocks base method
+func (m *MockenvironmentStackUpdater) UpdateEnvironment(env *deploy.CreateEnvironmentInput) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteService", in)
+	ret := m.ctrl.Call(m, "UpdateEnvironment", env)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteService indicates an expected call of DeleteService
-func (mr *MocksvcDeleterMockRecorder) DeleteService(in interface{}) *gomock.Call {
+// UpdateEnvironment indicates an expected call of UpdateEnvironment
+func (mr *MockenvironmentStackUpdaterMockRecorder) UpdateEnvironment(env interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteService", reflect.TypeOf((*MocksvcDeleter)(nil).DeleteService), in)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEnvironment", reflect.TypeOf((*MockenvironmentStackUpdater)(nil).UpdateEnvironment), env)
 }
 
-// MocksvcRemoverFromApp is a mock of svcRemoverFromApp interface
-type MocksvcRemoverFromApp struct {
+// EnvironmentTemplate mocks base method
+func (m *MockenvironmentStackUpdater) EnvironmentTemplate(appName, envName string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnvironmentTemplate", appName, envName)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnvironmentTemplate indicates an expected call of EnvironmentTemplate
+func (mr *MockenvironmentStackUpdaterMockRecorder) EnvironmentTemplate(appName, envName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnvironmentTemplate", reflect.TypeOf((*MockenvironmentStackUpdater)(nil).EnvironmentTemplate), appName, envName)
+}
+
+// MockserviceTemplateGetter is a mock of serviceTemplateGetter interface
+type MockserviceTemplateGetter struct {
 	ctrl     *gomock.Controller
-	recorder *MocksvcRemoverFromAppMockRecorder
+	recorder *MockserviceTemplateGetterMockRecorder
 }
 
-// MocksvcRemoverFromAppMockRecorder is the mock recorder for MocksvcRemoverFromApp
-type MocksvcRemoverFromAppMockRecorder struct {
-	mock *MocksvcRemoverFromApp
+// MockserviceTemplateGetterMockRecorder is the mock recorder for MockserviceTemplateGetter
+type MockserviceTemplateGetterMockRecorder struct {
+	mock *MockserviceTemplateGetter
 }
 
-// NewMocksvcRemoverFromApp creates a new mock instance
-func NewMocksvcRemoverFromApp(ctrl *gomock.Controller) *MocksvcRemoverFromApp {
-	mock := &MocksvcRemoverFromApp{ctrl: ctrl}
-	mock.recorder = &MocksvcRemoverFromAppMockRecorder{mock}
+// NewMockserviceTemplateGetter creates a new mock instance
+func NewMockserviceTemplateGetter(ctrl *gomock.Controller) *MockserviceTemplateGetter {
+	mock := &MockserviceTemplateGetter{ctrl: ctrl}
+	mock.recorder = &MockserviceTemplateGetterMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *MocksvcRemoverFromApp) EXPECT() *MocksvcRemoverFromAppMockRecorder {
+func (m *MockserviceTemplateGetter) EXPECT() *MockserviceTemplateGetterMockRecorder {
 	return m.recorder
 }
 
-// RemoveServiceFromApp mocks base method
-func (m *MocksvcRemoverFromApp) RemoveServiceFromApp(app *config.Application, svcName string) error {
+// ServiceTemplate mocks base method
+func (m *MockserviceTemplateGetter) ServiceTemplate(app, env, svc string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RemoveServiceFromApp", app, svcName)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ServiceTemplate", app, env, svc)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// RemoveServiceFromApp indicates an expected call of RemoveServiceFromApp
-func (mr *MocksvcRemoverFromAppMockRecorder) RemoveServiceFromApp(app, svcName interface{}) *gomock.Call {
+// ServiceTemplate indicates an expected call of ServiceTemplate
+func (mr *MockserviceTemplateGetterMockRecorder) ServiceTemplate(app, env, svc interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveServiceFromApp", reflect.TypeOf((*MocksvcRemoverFromApp)(nil).RemoveServiceFromApp), app, svcName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceTemplate", reflect.TypeOf((*MockserviceTemplateGetter)(nil).ServiceTemplate), app, env, svc)
 }
 
-// MockimageRemover is a mock of imageRemover interface
-type MockimageRemover struct {
+// MockprefixListGetter is a mock of prefixListGetter interface
+type MockprefixListGetter struct {
 	ctrl     *gomock.Controller
-	recorder *MockimageRemoverMockRecorder
+	recorder *MockprefixListGetterMockRecorder
 }
 
-// MockimageRemoverMockRecorder is the mock recorder for MockimageRemover
-type MockimageRemoverMockRecorder struct {
-	mock *MockimageRemover
+// MockprefixListGetterMockRecorder is the mock recorder for MockprefixListGetter
+type MockprefixListGetterMockRecorder struct {
+	mock *MockprefixListGetter
 }
 
-// NewMockimageRemover creates a new mock instance
-func NewMockimageRemover(ctrl *gomock.Controller) *MockimageRemover {
-	mock := &MockimageRemover{ctrl: ctrl}
-	mock.recorder = &MockimageRemoverMockRecorder{mock}
+// NewMockprefixListGetter creates a new mock instance
+func NewMockprefixListGetter(ctrl *gomock.Controller) *MockprefixListGetter {
+	mock := &MockprefixListGetter{ctrl: ctrl}
+	mock.recorder = &MockprefixListGetterMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockimageRemover) EXPECT() *MockimageRemoverMockRecorder {
+func (m *MockprefixListGetter) EXPECT() *MockprefixListGetterMockRecorder {
 	return m.recorder
 }
 
-// ClearRepository mocks base method
-func (m *MockimageRemover) ClearRepository(repoName string) error {
+// ManagedPrefixListID mocks base method
+func (m *MockprefixListGetter) ManagedPrefixListID(ctx context.Context, name string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ClearRepository", repoName)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ManagedPrefixListID", ctx, name)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// ClearRepository indicates an expected call of ClearRepository
-func (mr *MockimageRemoverMockRecorder) ClearRepository(repoName interface{}) *gomock.Call {
+// ManagedPrefixListID indicates an expected call of ManagedPrefixListID
+func (mr *MockprefixListGetterMockRecorder) ManagedPrefixListID(ctx, name interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearRepository", reflect.TypeOf((*MockimageRemover)(nil).ClearRepository), repoName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ManagedPrefixListID", reflect.TypeOf((*MockprefixListGetter)(nil).ManagedPrefixListID), ctx, name)
 }
 
-// MockpipelineDeployer is a mock of pipelineDeployer interface
-type MockpipelineDeployer struct {
+// MocksvcDeleter is a mock of svcDeleter interface
+type MocksvcDeleter struct {
 	ctrl     *gomock.Controller
-	recorder *MockpipelineDeployerMockRecorder
+	recorder *MocksvcDeleterMockRecorder
 }
 
-// MockpipelineDeployerMockRecorder is the mock recorder for MockpipelineDeployer
-type MockpipelineDeployerMockRecorder struct {
-	mock *MockpipelineDeployer
+// MocksvcDeleterMockRecorder is the mock recorder for MocksvcDeleter
+type MocksvcDeleterMockRecorder struct {
+	mock *MocksvcDeleter
 }
 
-// NewMockpipelineDeployer creates a new mock instance
-func NewMockpipelineDeployer(ctrl *gomock.Controller) *MockpipelineDeployer {
-	mock := &MockpipelineDeployer{ctrl: ctrl}
-	mock.recorder = &MockpipelineDeployerMockRecorder{mock}
+// NewMocksvcDeleter creates a new mock instance
+func NewMocksvcDeleter(ctrl *gomock.Controller) *MocksvcDeleter {
+	mock := &MocksvcDeleter{ctrl: ctrl}
+	mock.recorder = &MocksvcDeleterMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockpipelineDeployer) EXPECT() *MockpipelineDeployerMockRecorder {
+func (m *MocksvcDeleter) EXPECT() *MocksvcDeleterMockRecorder {
 	return m.recorder
 }
 
-// CreatePipeline mocks base method
-func (m *MockpipelineDeployer) CreatePipeline(env *deploy.CreatePipelineInput) error {
+// DeleteService mocks base method
+func (m *MocksvcDeleter) DeleteService(in deploy.DeleteServiceInput) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreatePipeline", env)
+	ret := m.ctrl.Call(m, "DeleteService", in)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// CreatePipeline indicates an expected call of CreatePipeline
-func (mr *MockpipelineDeployerMockRecorder) CreatePipeline(env interface{}) *gomock.Call {
+// DeleteService indicates an expected call of DeleteService
+func (mr *MocksvcDeleterMockRecorder) DeleteService(in interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePipeline", reflect.TypeOf((*MockpipelineDeployer)(nil).CreatePipeline), env)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteService", reflect.TypeOf((*MocksvcDeleter)(nil).DeleteService), in)
 }
 
-// UpdatePipeline mocks base method
-func (m *MockpipelineDeployer) UpdatePipeline(env *deploy.CreatePipelineInput) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdatePipeline", env)
-	ret0, _ := ret[0].(error)
-	return ret0
+// MocksvcStackRecoverer is a mock of svcStackRecoverer interface
+type MocksvcStackRecoverer struct {
+	ctrl     *gomock.Controller
+	recorder *MocksvcStackRecovererMockRecorder
 }
 
-// UpdatePipeline indicates an expected call of UpdatePipeline
-func (mr *MockpipelineDeployerMockRecorder) UpdatePipeline(env interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePipeline", reflect.TypeOf((*MockpipelineDeployer)(nil).UpdatePipeline), env)
+// MocksvcStackRecovererMockRecorder is the mock recorder for MocksvcStackRecoverer
+type MocksvcStackRecovererMockRecorder struct {
+	mock *MocksvcStackRecoverer
 }
 
-// PipelineExists mocks base method
-func (m *MockpipelineDeployer) PipelineExists(env *deploy.CreatePipelineInput) (bool, error) {
+// NewMocksvcStackRecoverer creates a new mock instance
+func NewMocksvcStackRecoverer(ctrl *gomock.Controller) *MocksvcStackRecoverer {
+	mock := &MocksvcStackRecoverer{ctrl: ctrl}
+	mock.recorder = &MocksvcStackRecovererMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MocksvcStackRecoverer) EXPECT() *MocksvcStackRecovererMockRecorder {
+	return m.recorder
+}
+
+// ServiceRollbackFailedResources mocks base method
+func (m *MocksvcStackRecoverer) ServiceRollbackFailedResources(stackName string) ([]string, bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PipelineExists", env)
-	ret0, _ := ret[0].(bool)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "ServiceRollbackFailedResources", stackName)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
-// PipelineExists indicates an expected call of PipelineExists
-func (mr *MockpipelineDeployerMockRecorder) PipelineExists(env interface{}) *gomock.Call {
+// ServiceRollbackFailedResources indicates an expected call of ServiceRollbackFailedResources
+func (mr *MocksvcStackRecovererMockRecorder) ServiceRollbackFailedResources(stackName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PipelineExists", reflect.TypeOf((*MockpipelineDeployer)(nil).PipelineExists), env)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceRollbackFailedResources", reflect.TypeOf((*MocksvcStackRecoverer)(nil).ServiceRollbackFailedResources), stackName)
 }
 
-// DeletePipeline mocks base method
-func (m *MockpipelineDeployer) DeletePipeline(pipelineName string) error {
+// ContinueServiceRollback mocks base method
+func (m *MocksvcStackRecoverer) ContinueServiceRollback(stackName string, resourcesToSkip []string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeletePipeline", pipelineName)
+	ret := m.ctrl.Call(m, "ContinueServiceRollback", stackName, resourcesToSkip)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeletePipeline indicates an expected call of DeletePipeline
-func (mr *MockpipelineDeployerMockRecorder) DeletePipeline(pipelineName interface{}) *gomock.Call {
+// ContinueServiceRollback indicates an expected call of ContinueServiceRollback
+func (mr *MocksvcStackRecovererMockRecorder) ContinueServiceRollback(stackName, resourcesToSkip interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePipeline", reflect.TypeOf((*MockpipelineDeployer)(nil).DeletePipeline), pipelineName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContinueServiceRollback", reflect.TypeOf((*MocksvcStackRecoverer)(nil).ContinueServiceRollback), stackName, resourcesToSkip)
 }
 
-// AddPipelineResourcesToApp mocks base method
-func (m *MockpipelineDeployer) AddPipelineResourcesToApp(app *config.Application, region string) error {
+// DeleteService mocks base method
+func (m *MocksvcStackRecoverer) DeleteService(in deploy.DeleteServiceInput) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddPipelineResourcesToApp", app, region)
+	ret := m.ctrl.Call(m, "DeleteService", in)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// AddPipelineResourcesToApp indicates an expected call of AddPipelineResourcesToApp
-func (mr *MockpipelineDeployerMockRecorder) AddPipelineResourcesToApp(app, region interface{}) *gomock.Call {
+// DeleteService indicates an expected call of DeleteService
+func (mr *MocksvcStackRecovererMockRecorder) DeleteService(in interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPipelineResourcesToApp", reflect.TypeOf((*MockpipelineDeployer)(nil).AddPipelineResourcesToApp), app, region)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteService", reflect.TypeOf((*MocksvcStackRecoverer)(nil).DeleteService), in)
 }
 
-// GetAppResourcesByRegion mocks base method
-func (m *MockpipelineDeployer) GetAppResourcesByRegion(app *config.Application, region string) (*stack.AppRegionalResources, error) {
+// MocksvcAddonsRetainer is a mock of svcAddonsRetainer interface
+type MocksvcAddonsRetainer struct {
+	ctrl     *gomock.Controller
+	recorder *MocksvcAddonsRetainerMockRecorder
+}
+
+// MocksvcAddonsRetainerMockRecorder is the mock recorder for MocksvcAddonsRetainer
+type MocksvcAddonsRetainerMockRecorder struct {
+	mock *MocksvcAddonsRetainer
+}
+
+// NewMocksvcAddonsRetainer creates a new mock instance
+func NewMocksvcAddonsRetainer(ctrl *gomock.Controller) *MocksvcAddonsRetainer {
+	mock := &MocksvcAddonsRetainer{ctrl: ctrl}
+	mock.recorder = &MocksvcAddonsRetainerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MocksvcAddonsRetainer) EXPECT() *MocksvcAddonsRetainerMockRecorder {
+	return m.recorder
+}
+
+// ServiceStatefulAddons mocks base method
+func (m *MocksvcAddonsRetainer) ServiceStatefulAddons(in deploy.DeleteServiceInput) ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAppResourcesByRegion", app, region)
-	ret0, _ := ret[0].(*stack.AppRegionalResources)
+	ret := m.ctrl.Call(m, "ServiceStatefulAddons", in)
+	ret0, _ := ret[0].([]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAppResourcesByRegion indicates an expected call of GetAppResourcesByRegion
-func (mr *MockpipelineDeployerMockRecorder) GetAppResourcesByRegion(app, region interface{}) *gomock.Call {
+// ServiceStatefulAddons indicates an expected call of ServiceStatefulAddons
+func (mr *MocksvcAddonsRetainerMockRecorder) ServiceStatefulAddons(in interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppResourcesByRegion", reflect.TypeOf((*MockpipelineDeployer)(nil).GetAppResourcesByRegion), app, region)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceStatefulAddons", reflect.TypeOf((*MocksvcAddonsRetainer)(nil).ServiceStatefulAddons), in)
 }
 
-// GetRegionalAppResources mocks base method
-func (m *MockpipelineDeployer) GetRegionalAppResources(app *config.Application) ([]*stack.AppRegionalResources, error) {
+// RetainServiceStatefulAddons mocks base method
+func (m *MocksvcAddonsRetainer) RetainServiceStatefulAddons(in deploy.DeleteServiceInput) ([]deploy.RetainedResource, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetRegionalAppResources", app)
-	ret0, _ := ret[0].([]*stack.AppRegionalResources)
+	ret := m.ctrl.Call(m, "RetainServiceStatefulAddons", in)
+	ret0, _ := ret[0].([]deploy.RetainedResource)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetRegionalAppResources indicates an expected call of GetRegionalAppResources
-func (mr *MockpipelineDeployerMockRecorder) GetRegionalAppResources(app interface{}) *gomock.Call {
+// RetainServiceStatefulAddons indicates an expected call of RetainServiceStatefulAddons
+func (mr *MocksvcAddonsRetainerMockRecorder) RetainServiceStatefulAddons(in interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegionalAppResources", reflect.TypeOf((*MockpipelineDeployer)(nil).GetRegionalAppResources), app)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetainServiceStatefulAddons", reflect.TypeOf((*MocksvcAddonsRetainer)(nil).RetainServiceStatefulAddons), in)
 }
 
-// MockappDeployer is a mock of appDeployer interface
-type MockappDeployer struct {
+// MockaddonDatabasesLister is a mock of addonDatabasesLister interface
+type MockaddonDatabasesLister struct {
 	ctrl     *gomock.Controller
-	recorder *MockappDeployerMockRecorder
+	recorder *MockaddonDatabasesListerMockRecorder
 }
 
-// MockappDeployerMockRecorder is the mock recorder for MockappDeployer
-type MockappDeployerMockRecorder struct {
-	mock *MockappDeployer
+// MockaddonDatabasesListerMockRecorder is the mock recorder for MockaddonDatabasesLister
+type MockaddonDatabasesListerMockRecorder struct {
+	mock *MockaddonDatabasesLister
 }
 
-// NewMockappDeployer creates a new mock instance
-func NewMockappDeployer(ctrl *gomock.Controller) *MockappDeployer {
-	mock := &MockappDeployer{ctrl: ctrl}
-	mock.recorder = &MockappDeployerMockRecorder{mock}
+// NewMockaddonDatabasesLister creates a new mock instance
+func NewMockaddonDatabasesLister(ctrl *gomock.Controller) *MockaddonDatabasesLister {
+	mock := &MockaddonDatabasesLister{ctrl: ctrl}
+	mock.recorder = &MockaddonDatabasesListerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockappDeployer) EXPECT() *MockappDeployerMockRecorder {
+func (m *MockaddonDatabasesLister) EXPECT() *MockaddonDatabasesListerMockRecorder {
 	return m.recorder
 }
 
-// DeployApp mocks base method
-func (m *MockappDeployer) DeployApp(in *deploy.CreateAppInput) error {
+// ServiceAddonDatabases mocks base method
+func (m *MockaddonDatabasesLister) ServiceAddonDatabases(app, env, svc string) ([]deploy.AddonDatabase, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeployApp", in)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ServiceAddonDatabases", app, env, svc)
+	ret0, _ := ret[0].([]deploy.AddonDatabase)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeployApp indicates an expected call of DeployApp
-func (mr *MockappDeployerMockRecorder) DeployApp(in interface{}) *gomock.Call {
+// ServiceAddonDatabases indicates an expected call of ServiceAddonDatabases
+func (mr *MockaddonDatabasesListerMockRecorder) ServiceAddonDatabases(app, env, svc interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployApp", reflect.TypeOf((*MockappDeployer)(nil).DeployApp), in)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceAddonDatabases", reflect.TypeOf((*MockaddonDatabasesLister)(nil).ServiceAddonDatabases), app, env, svc)
 }
 
-// AddServiceToApp mocks base method
-func (m *MockappDeployer) AddServiceToApp(app *config.Application, svcName string) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddServiceToApp", app, svcName)
-	ret0, _ := ret[0].(error)
-	return ret0
+// MockdbSnapshotter is a mock of dbSnapshotter interface
+type MockdbSnapshotter struct {
+	ctrl     *gomock.Controller
+	recorder *MockdbSnapshotterMockRecorder
 }
 
-// AddServiceToApp indicates an expected call of AddServiceToApp
-func (mr *MockappDeployerMockRecorder) AddServiceToApp(app, svcName interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddServiceToApp", reflect.TypeOf((*MockappDeployer)(nil).AddServiceToApp), app, svcName)
+// MockdbSnapshotterMockRecorder is the mock recorder for MockdbSnapshotter
+type MockdbSnapshotterMockRecorder struct {
+	mock *MockdbSnapshotter
 }
 
-// AddEnvToApp mocks base method
-func (m *MockappDeployer) AddEnvToApp(app *config.Application, env *config.Environment) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddEnvToApp", app, env)
-	ret0, _ := ret[0].(error)
-	return ret0
+// NewMockdbSnapshotter creates a new mock instance
+func NewMockdbSnapshotter(ctrl *gomock.Controller) *MockdbSnapshotter {
+	mock := &MockdbSnapshotter{ctrl: ctrl}
+	mock.recorder = &MockdbSnapshotterMockRecorder{mock}
+	return mock
 }
 
-// AddEnvToApp indicates an expected call of AddEnvToApp
-func (mr *MockappDeployerMockRecorder) AddEnvToApp(app, env interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddEnvToApp", reflect.TypeOf((*MockappDeployer)(nil).AddEnvToApp), app, env)
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockdbSnapshotter) EXPECT() *MockdbSnapshotterMockRecorder {
+	return m.recorder
 }
 
-// DelegateDNSPermissions mocks base method
-func (m *MockappDeployer) DelegateDNSPermissions(app *config.Application, accountID string) error {
+// CreateSnapshot mocks base method
+func (m *MockdbSnapshotter) CreateSnapshot(db rds.Database, snapshotID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DelegateDNSPermissions", app, accountID)
+	ret := m.ctrl.Call(m, "CreateSnapshot", db, snapshotID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DelegateDNSPermissions indicates an expected call of DelegateDNSPermissions
-func (mr *MockappDeployerMockRecorder) DelegateDNSPermissions(app, accountID interface{}) *gomock.Call {
+// CreateSnapshot indicates an expected call of CreateSnapshot
+func (mr *MockdbSnapshotterMockRecorder) CreateSnapshot(db, snapshotID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DelegateDNSPermissions", reflect.TypeOf((*MockappDeployer)(nil).DelegateDNSPermissions), app, accountID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSnapshot", reflect.TypeOf((*MockdbSnapshotter)(nil).CreateSnapshot), db, snapshotID)
 }
 
-// DeleteApp mocks base method
-func (m *MockappDeployer) DeleteApp(name string) error {
+// Snapshots mocks base method
+func (m *MockdbSnapshotter) Snapshots(db rds.Database) ([]rds.Snapshot, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteApp", name)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "Snapshots", db)
+	ret0, _ := ret[0].([]rds.Snapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteApp indicates an expected call of DeleteApp
-func (mr *MockappDeployerMockRecorder) DeleteApp(name interface{}) *gomock.Call {
+// Snapshots indicates an expected call of Snapshots
+func (mr *MockdbSnapshotterMockRecorder) Snapshots(db interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteApp", reflect.TypeOf((*MockappDeployer)(nil).DeleteApp), name)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Snapshots", reflect.TypeOf((*MockdbSnapshotter)(nil).Snapshots), db)
 }
 
-// MockappResourcesGetter is a mock of appResourcesGetter interface
-type MockappResourcesGetter struct {
+// MockdbRestorer is a mock of dbRestorer interface
+type MockdbRestorer struct {
 	ctrl     *gomock.Controller
-	recorder *MockappResourcesGetterMockRecorder
+	recorder *MockdbRestorerMockRecorder
 }
 
-// MockappResourcesGetterMockRecorder is the mock recorder for MockappResourcesGetter
-type MockappResourcesGetterMockRecorder struct {
-	mock *MockappResourcesGetter
+// MockdbRestorerMockRecorder is the mock recorder for MockdbRestorer
+type MockdbRestorerMockRecorder struct {
+	mock *MockdbRestorer
 }
 
-// NewMockappResourcesGetter creates a new mock instance
-func NewMockappResourcesGetter(ctrl *gomock.Controller) *MockappResourcesGetter {
-	mock := &MockappResourcesGetter{ctrl: ctrl}
-	mock.recorder = &MockappResourcesGetterMockRecorder{mock}
+// NewMockdbRestorer creates a new mock instance
+func NewMockdbRestorer(ctrl *gomock.Controller) *MockdbRestorer {
+	mock := &MockdbRestorer{ctrl: ctrl}
+	mock.recorder = &MockdbRestorerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockappResourcesGetter) EXPECT() *MockappResourcesGetterMockRecorder {
+func (m *MockdbRestorer) EXPECT() *MockdbRestorerMockRecorder {
 	return m.recorder
 }
 
-// GetAppResourcesByRegion mocks base method
-func (m *MockappResourcesGetter) GetAppResourcesByRegion(app *config.Application, region string) (*stack.AppRegionalResources, error) {
+// Snapshots mocks base method
+func (m *MockdbRestorer) Snapshots(db rds.Database) ([]rds.Snapshot, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAppResourcesByRegion", app, region)
-	ret0, _ := ret[0].(*stack.AppRegionalResources)
+	ret := m.ctrl.Call(m, "Snapshots", db)
+	ret0, _ := ret[0].([]rds.Snapshot)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAppResourcesByRegion indicates an expected call of GetAppResourcesByRegion
-func (mr *MockappResourcesGetterMockRecorder) GetAppResourcesByRegion(app, region interface{}) *gomock.Call {
+// Snapshots indicates an expected call of Snapshots
+func (mr *MockdbRestorerMockRecorder) Snapshots(db interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppResourcesByRegion", reflect.TypeOf((*MockappResourcesGetter)(nil).GetAppResourcesByRegion), app, region)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Snapshots", reflect.TypeOf((*MockdbRestorer)(nil).Snapshots), db)
 }
 
-// GetRegionalAppResources mocks base method
-func (m *MockappResourcesGetter) GetRegionalAppResources(app *config.Application) ([]*stack.AppRegionalResources, error) {
+// Restore mocks base method
+func (m *MockdbRestorer) Restore(snapshot rds.Snapshot, newID string) (*rds.Endpoint, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetRegionalAppResources", app)
-	ret0, _ := ret[0].([]*stack.AppRegionalResources)
+	ret := m.ctrl.Call(m, "Restore", snapshot, newID)
+	ret0, _ := ret[0].(*rds.Endpoint)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetRegionalAppResources indicates an expected call of GetRegionalAppResources
-func (mr *MockappResourcesGetterMockRecorder) GetRegionalAppResources(app interface{}) *gomock.Call {
+// Restore indicates an expected call of Restore
+func (mr *MockdbRestorerMockRecorder) Restore(snapshot, newID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegionalAppResources", reflect.TypeOf((*MockappResourcesGetter)(nil).GetRegionalAppResources), app)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockdbRestorer)(nil).Restore), snapshot, newID)
 }
 
-// MocktaskDeployer is a mock of taskDeployer interface
-type MocktaskDeployer struct {
+// MockaddonBucketsLister is a mock of addonBucketsLister interface
+type MockaddonBucketsLister struct {
 	ctrl     *gomock.Controller
-	recorder *MocktaskDeployerMockRecorder
+	recorder *MockaddonBucketsListerMockRecorder
 }
 
-// MocktaskDeployerMockRecorder is the mock recorder for MocktaskDeployer
-type MocktaskDeployerMockRecorder struct {
-	mock *MocktaskDeployer
+// MockaddonBucketsListerMockRecorder is the mock recorder for MockaddonBucketsLister
+type MockaddonBucketsListerMockRecorder struct {
+	mock *MockaddonBucketsLister
 }
 
-// NewMocktaskDeployer creates a new mock instance
-func NewMocktaskDeployer(ctrl *gomock.Controller) *MocktaskDeployer {
-	mock := &MocktaskDeployer{ctrl: ctrl}
-	mock.recorder = &MocktaskDeployerMockRecorder{mock}
+// NewMockaddonBucketsLister creates a new mock instance
+func NewMockaddonBucketsLister(ctrl *gomock.Controller) *MockaddonBucketsLister {
+	mock := &MockaddonBucketsLister{ctrl: ctrl}
+	mock.recorder = &MockaddonBucketsListerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *MocktaskDeployer) EXPECT() *MocktaskDeployerMockRecorder {
+func (m *MockaddonBucketsLister) EXPECT() *MockaddonBucketsListerMockRecorder {
 	return m.recorder
 }
 
-// DeployTask mocks base method
-func (m *MocktaskDeployer) DeployTask(input *deploy.CreateTaskResourcesInput, opts ...cloudformation.StackOption) error {
+// ServiceAddonBuckets mocks base method
+func (m *MockaddonBucketsLister) ServiceAddonBuckets(app, env, svc string) (map[string]string, error) {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{input}
-	for _, a := range opts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "DeployTask", varargs...)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ServiceAddonBuckets", app, env, svc)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeployTask indicates an expected call of DeployTask
-func (mr *MocktaskDeployerMockRecorder) DeployTask(input interface{}, opts ...interface{}) *gomock.Call {
+// ServiceAddonBuckets indicates an expected call of ServiceAddonBuckets
+func (mr *MockaddonBucketsListerMockRecorder) ServiceAddonBuckets(app, env, svc interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{input}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployTask", reflect.TypeOf((*MocktaskDeployer)(nil).DeployTask), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceAddonBuckets", reflect.TypeOf((*MockaddonBucketsLister)(nil).ServiceAddonBuckets), app, env, svc)
 }
 
-// MocktaskRunner is a mock of taskRunner interface
-type MocktaskRunner struct {
+// MockbucketSyncer is a mock of bucketSyncer interface
+type MockbucketSyncer struct {
 	ctrl     *gomock.Controller
-	recorder *MocktaskRunnerMockRecorder
+	recorder *MockbucketSyncerMockRecorder
 }
 
-// MocktaskRunnerMockRecorder is the mock recorder for MocktaskRunner
-type MocktaskRunnerMockRecorder struct {
-	mock *MocktaskRunner
+// MockbucketSyncerMockRecorder is the mock recorder for MockbucketSyncer
+type MockbucketSyncerMockRecorder struct {
+	mock *MockbucketSyncer
 }
 
-// NewMocktaskRunner creates a new mock instance
-func NewMocktaskRunner(ctrl *gomock.Controller) *MocktaskRunner {
-	mock := &MocktaskRunner{ctrl: ctrl}
-	mock.recorder = &MocktaskRunnerMockRecorder{mock}
+// NewMockbucketSyncer creates a new mock instance
+func NewMockbucketSyncer(ctrl *gomock.Controller) *MockbucketSyncer {
+	mock := &MockbucketSyncer{ctrl: ctrl}
+	mock.recorder = &MockbucketSyncerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *MocktaskRunner) EXPECT() *MocktaskRunnerMockRecorder {
+func (m *MockbucketSyncer) EXPECT() *MockbucketSyncerMockRecorder {
 	return m.recorder
 }
 
-// Run mocks base method
-func (m *MocktaskRunner) Run() ([]*task.Task, error) {
+// Objects mocks base method
+func (m *MockbucketSyncer) Objects(bucket string) (map[string]s3.Object, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Run")
-	ret0, _ := ret[0].([]*task.Task)
+	ret := m.ctrl.Call(m, "Objects", bucket)
+	ret0, _ := ret[0].(map[string]s3.Object)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Run indicates an expected call of Run
-func (mr *MocktaskRunnerMockRecorder) Run() *gomock.Call {
+// Objects indicates an expected call of Objects
+func (mr *MockbucketSyncerMockRecorder) Objects(bucket interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MocktaskRunner)(nil).Run))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Objects", reflect.TypeOf((*MockbucketSyncer)(nil).Objects), bucket)
 }
 
-// MockdefaultClusterGetter is a mock of defaultClusterGetter interface
-type MockdefaultClusterGetter struct {
+// UploadObject mocks base method
+func (m *MockbucketSyncer) UploadObject(bucket, key string, data io.Reader) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadObject", bucket, key, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UploadObject indicates an expected call of UploadObject
+func (mr *MockbucketSyncerMockRecorder) UploadObject(bucket, key, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadObject", reflect.TypeOf((*MockbucketSyncer)(nil).UploadObject), bucket, key, data)
+}
+
+// DeleteObjects mocks base method
+func (m *MockbucketSyncer) DeleteObjects(bucket string, keys []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteObjects", bucket, keys)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteObjects indicates an expected call of DeleteObjects
+func (mr *MockbucketSyncerMockRecorder) DeleteObjects(bucket, keys interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteObjects", reflect.TypeOf((*MockbucketSyncer)(nil).DeleteObjects), bucket, keys)
+}
+
+// MocksecretFieldsUpdater is a mock of secretFieldsUpdater interface
+type MocksecretFieldsUpdater struct {
 	ctrl     *gomock.Controller
-	recorder *MockdefaultClusterGetterMockRecorder
+	recorder *MocksecretFieldsUpdaterMockRecorder
 }
 
-// MockdefaultClusterGetterMockRecorder is the mock recorder for MockdefaultClusterGetter
-type MockdefaultClusterGetterMockRecorder struct {
-	mock *MockdefaultClusterGetter
+// MocksecretFieldsUpdaterMockRecorder is the mock recorder for MocksecretFieldsUpdater
+type MocksecretFieldsUpdaterMockRecorder struct {
+	mock *MocksecretFieldsUpdater
 }
 
-// NewMockdefaultClusterGetter creates a new mock instance
-func NewMockdefaultClusterGetter(ctrl *gomock.Controller) *MockdefaultClusterGetter {
-	mock := &MockdefaultClusterGetter{ctrl: ctrl}
-	mock.recorder = &MockdefaultClusterGetterMockRecorder{mock}
+// NewMocksecretFieldsUpdater creates a new mock instance
+func NewMocksecretFieldsUpdater(ctrl *gomock.Controller) *MocksecretFieldsUpdater {
+	mock := &MocksecretFieldsUpdater{ctrl: ctrl}
+	mock.recorder = &MocksecretFieldsUpdaterMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockdefaultClusterGetter) EXPECT() *MockdefaultClusterGetterMockRecorder {
+func (m *MocksecretFieldsUpdater) EXPECT() *MocksecretFieldsUpdaterMockRecorder {
 	return m.recorder
 }
 
-// HasDefaultCluster mocks base method
-func (m *MockdefaultClusterGetter) HasDefaultCluster() (bool, error) {
+// UpdateSecretFields mocks base method
+func (m *MocksecretFieldsUpdater) UpdateSecretFields(secretID string, fields map[string]interface{}) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "HasDefaultCluster")
-	ret0, _ := ret[0].(bool)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "UpdateSecretFields", secretID, fields)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// HasDefaultCluster indicates an expected call of HasDefaultCluster
-func (mr *MockdefaultClusterGetterMockRecorder) HasDefaultCluster() *gomock.Call {
+// UpdateSecretFields indicates an expected call of UpdateSecretFields
+func (mr *MocksecretFieldsUpdaterMockRecorder) UpdateSecretFields(secretID, fields interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasDefaultCluster", reflect.TypeOf((*MockdefaultClusterGetter)(nil).HasDefaultCluster))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSecretFields", reflect.TypeOf((*MocksecretFieldsUpdater)(nil).UpdateSecretFields), secretID, fields)
 }
 
-// Mockdeployer is a mock of deployer interface
-type Mockdeployer struct {
+// MocksvcRemoverFromApp is a mock of svcRemoverFromApp interface
+type MocksvcRemoverFromApp struct {
 	ctrl     *gomock.Controller
-	recorder *MockdeployerMockRecorder
+	recorder *MocksvcRemoverFromAppMockRecorder
 }
 
-// MockdeployerMockRecorder is the mock recorder for Mockdeployer
-type MockdeployerMockRecorder struct {
-	mock *Mockdeployer
+// MocksvcRemoverFromAppMockRecorder is the mock recorder for MocksvcRemoverFromApp
+type MocksvcRemoverFromAppMockRecorder struct {
+	mock *MocksvcRemoverFromApp
 }
 
-// NewMockdeployer creates a new mock instance
-func NewMockdeployer(ctrl *gomock.Controller) *Mockdeployer {
-	mock := &Mockdeployer{ctrl: ctrl}
-	mock.recorder = &MockdeployerMockRecorder{mock}
+// NewMocksvcRemoverFromApp creates a new mock instance
+func NewMocksvcRemoverFromApp(ctrl *gomock.Controller) *MocksvcRemoverFromApp {
+	mock := &MocksvcRemoverFromApp{ctrl: ctrl}
+	mock.recorder = &MocksvcRemoverFromAppMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *Mockdeployer) EXPECT() *MockdeployerMockRecorder {
+func (m *MocksvcRemoverFromApp) EXPECT() *MocksvcRemoverFromAppMockRecorder {
 	return m.recorder
 }
 
-// DeployEnvironment mocks base method
-func (m *Mockdeployer) DeployEnvironment(env *deploy.CreateEnvironmentInput) error {
+// RemoveServiceFromApp mocks base method
+func (m *MocksvcRemoverFromApp) RemoveServiceFromApp(app *config.Application, svcName string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeployEnvironment", env)
+	ret := m.ctrl.Call(m, "RemoveServiceFromApp", app, svcName)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeployEnvironment indicates an expected call of DeployEnvironment
-func (mr *MockdeployerMockRecorder) DeployEnvironment(env interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployEnvironment", reflect.TypeOf((*Mockdeployer)(nil).DeployEnvironment), env)
-}
-
-// StreamEnvironmentCreation mocks base method
-func (m *Mockdeployer) StreamEnvironmentCreation(env *deploy.CreateEnvironmentInput) (<-chan []deploy.ResourceEvent, <-chan deploy.CreateEnvironmentResponse) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "StreamEnvironmentCreation", env)
-	ret0, _ := ret[0].(<-chan []deploy.ResourceEvent)
-	ret1, _ := ret[1].(<-chan deploy.CreateEnvironmentResponse)
-	return ret0, ret1
-}
-
-// StreamEnvironmentCreation indicates an expected call of StreamEnvironmentCreation
-func (mr *MockdeployerMockRecorder) StreamEnvironmentCreation(env interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamEnvironmentCreation", reflect.TypeOf((*Mockdeployer)(nil).StreamEnvironmentCreation), env)
-}
-
-// DeleteEnvironment mocks base method
-func (m *Mockdeployer) DeleteEnvironment(appName, envName string) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteEnvironment", appName, envName)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// DeleteEnvironment indicates an expected call of DeleteEnvironment
-func (mr *MockdeployerMockRecorder) DeleteEnvironment(appName, envName interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEnvironment", reflect.TypeOf((*Mockdeployer)(nil).DeleteEnvironment), appName, envName)
-}
-
-// GetEnvironment mocks base method
-func (m *Mockdeployer) GetEnvironment(appName, envName string) (*config.Environment, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetEnvironment", appName, envName)
-	ret0, _ := ret[0].(*config.Environment)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// GetEnvironment indicates an expected call of GetEnvironment
-func (mr *MockdeployerMockRecorder) GetEnvironment(appName, envName interface{}) *gomock.Call {
+// RemoveServiceFromApp indicates an expected call of RemoveServiceFromApp
+func (mr *MocksvcRemoverFromAppMockRecorder) RemoveServiceFromApp(app, svcName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEnvironment", reflect.TypeOf((*Mockdeployer)(nil).GetEnvironment), appName, envName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveServiceFromApp", reflect.TypeOf((*MocksvcRemoverFromApp)(nil).RemoveServiceFromApp), app, svcName)
 }
 
-// DeployApp mocks base method
-func (m *Mockdeployer) DeployApp(in *deploy.CreateAppInput) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeployApp", in)
-	ret0, _ := ret[0].(error)
-	return ret0
+// MockimageRemover is a mock of imageRemover interface
+type MockimageRemover struct {
+	ctrl     *gomock.Controller
+	recorder *MockimageRemoverMockRecorder
 }
 
-// DeployApp indicates an expected call of DeployApp
-func (mr *MockdeployerMockRecorder) DeployApp(in interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployApp", reflect.TypeOf((*Mockdeployer)(nil).DeployApp), in)
+// MockimageRemoverMockRecorder is the mock recorder for MockimageRemover
+type MockimageRemoverMockRecorder struct {
+	mock *MockimageRemover
 }
 
-// AddServiceToApp mocks base method
-func (m *Mockdeployer) AddServiceToApp(app *config.Application, svcName string) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddServiceToApp", app, svcName)
-	ret0, _ := ret[0].(error)
-	return ret0
+// NewMockimageRemover creates a new mock instance
+func NewMockimageRemover(ctrl *gomock.Controller) *MockimageRemover {
+	mock := &MockimageRemover{ctrl: ctrl}
+	mock.recorder = &MockimageRemoverMockRecorder{mock}
+	return mock
 }
 
-// AddServiceToApp indicates an expected call of AddServiceToApp
-func (mr *MockdeployerMockRecorder) AddServiceToApp(app, svcName interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddServiceToApp", reflect.TypeOf((*Mockdeployer)(nil).AddServiceToApp), app, svcName)
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockimageRemover) EXPECT() *MockimageRemoverMockRecorder {
+	return m.recorder
 }
 
-// AddEnvToApp mocks base method
-func (m *Mockdeployer) AddEnvToApp(app *config.Application, env *config.Environment) error {
+// ClearRepository mocks base method
+func (m *MockimageRemover) ClearRepository(repoName string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddEnvToApp", app, env)
+	ret := m.ctrl.Call(m, "ClearRepository", repoName)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// AddEnvToApp indicates an expected call of AddEnvToApp
-func (mr *MockdeployerMockRecorder) AddEnvToApp(app, env interface{}) *gomock.Call {
+// ClearRepository indicates an expected call of ClearRepository
+func (mr *MockimageRemoverMockRecorder) ClearRepository(repoName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddEnvToApp", reflect.TypeOf((*Mockdeployer)(nil).AddEnvToApp), app, env)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearRepository", reflect.TypeOf((*MockimageRemover)(nil).ClearRepository), repoName)
 }
 
-// DelegateDNSPermissions mocks base method
-func (m *Mockdeployer) DelegateDNSPermissions(app *config.Application, accountID string) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DelegateDNSPermissions", app, accountID)
-	ret0, _ := ret[0].(error)
-	return ret0
+// MockpipelineDeployer is a mock of pipelineDeployer interface
+type MockpipelineDeployer struct {
+	ctrl     *gomock.Controller
+	recorder *MockpipelineDeployerMockRecorder
 }
 
-// DelegateDNSPermissions indicates an expected call of DelegateDNSPermissions
-func (mr *MockdeployerMockRecorder) DelegateDNSPermissions(app, accountID interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DelegateDNSPermissions", reflect.TypeOf((*Mockdeployer)(nil).DelegateDNSPermissions), app, accountID)
+// MockpipelineDeployerMockRecorder is the mock recorder for MockpipelineDeployer
+type MockpipelineDeployerMockRecorder struct {
+	mock *MockpipelineDeployer
 }
 
-// DeleteApp mocks base method
-func (m *Mockdeployer) DeleteApp(name string) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteApp", name)
-	ret0, _ := ret[0].(error)
-	return ret0
+// NewMockpipelineDeployer creates a new mock instance
+func NewMockpipelineDeployer(ctrl *gomock.Controller) *MockpipelineDeployer {
+	mock := &MockpipelineDeployer{ctrl: ctrl}
+	mock.recorder = &MockpipelineDeployerMockRecorder{mock}
+	return mock
 }
 
-// DeleteApp indicates an expected call of DeleteApp
-func (mr *MockdeployerMockRecorder) DeleteApp(name interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteApp", reflect.TypeOf((*Mockdeployer)(nil).DeleteApp), name)
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockpipelineDeployer) EXPECT() *MockpipelineDeployerMockRecorder {
+	return m.recorder
 }
 
 // CreatePipeline mocks base method
-func (m *Mockdeployer) CreatePipeline(env *deploy.CreatePipelineInput) error {
+func (m *MockpipelineDeployer) CreatePipeline(env *deploy.CreatePipelineInput) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreatePipeline", env)
 	ret0, _ := ret[0].(error)
@@ -3152,13 +3818,13 @@ func (m *Mockdeployer) CreatePipeline(env *deploy.CreatePipelineInput) error {
 }
 
 // CreatePipeline indicates an expected call of CreatePipeline
-func (mr *MockdeployerMockRecorder) CreatePipeline(env interface{}) *gomock.Call {
+func (mr *MockpipelineDeployerMockRecorder) CreatePipeline(env interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePipeline", reflect.TypeOf((*Mockdeployer)(nil).CreatePipeline), env)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePipeline", reflect.TypeOf((*MockpipelineDeployer)(nil).CreatePipeline), env)
 }
 
 // UpdatePipeline mocks base method
-func (m *Mockdeployer) UpdatePipeline(env *deploy.CreatePipelineInput) error {
+func (m *MockpipelineDeployer) UpdatePipeline(env *deploy.CreatePipelineInput) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "UpdatePipeline", env)
 	ret0, _ := ret[0].(error)
@@ -3166,13 +3832,13 @@ func (m *Mockdeployer) UpdatePipeline(env *deploy.CreatePipelineInput) error {
 }
 
 // UpdatePipeline indicates an expected call of UpdatePipeline
-func (mr *MockdeployerMockRecorder) UpdatePipeline(env interface{}) *gomock.Call {
+func (mr *MockpipelineDeployerMockRecorder) UpdatePipeline(env interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePipeline", reflect.TypeOf((*Mockdeployer)(nil).UpdatePipeline), env)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePipeline", reflect.TypeOf((*MockpipelineDeployer)(nil).UpdatePipeline), env)
 }
 
 // PipelineExists mocks base method
-func (m *Mockdeployer) PipelineExists(env *deploy.CreatePipelineInput) (bool, error) {
+func (m *MockpipelineDeployer) PipelineExists(env *deploy.CreatePipelineInput) (bool, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "PipelineExists", env)
 	ret0, _ := ret[0].(bool)
@@ -3181,13 +3847,13 @@ func (m *Mockdeployer) PipelineExists(env *deploy.CreatePipelineInput) (bool, er
 }
 
 // PipelineExists indicates an expected call of PipelineExists
-func (mr *MockdeployerMockRecorder) PipelineExists(env interface{}) *gomock.Call {
+func (mr *MockpipelineDeployerMockRecorder) PipelineExists(env interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PipelineExists", reflect.TypeOf((*Mockdeployer)(nil).PipelineExists), env)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PipelineExists", reflect.TypeOf((*MockpipelineDeployer)(nil).PipelineExists), env)
 }
 
 // DeletePipeline mocks base method
-func (m *Mockdeployer) DeletePipeline(pipelineName string) error {
+func (m *MockpipelineDeployer) DeletePipeline(pipelineName string) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "DeletePipeline", pipelineName)
 	ret0, _ := ret[0].(error)
@@ -3195,13 +3861,13 @@ func (m *Mockdeployer) DeletePipeline(pipelineName string) error {
 }
 
 // DeletePipeline indicates an expected call of DeletePipeline
-func (mr *MockdeployerMockRecorder) DeletePipeline(pipelineName interface{}) *gomock.Call {
+func (mr *MockpipelineDeployerMockRecorder) DeletePipeline(pipelineName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePipeline", reflect.TypeOf((*Mockdeployer)(nil).DeletePipeline), pipelineName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePipeline", reflect.TypeOf((*MockpipelineDeployer)(nil).DeletePipeline), pipelineName)
 }
 
 // AddPipelineResourcesToApp mocks base method
-func (m *Mockdeployer) AddPipelineResourcesToApp(app *config.Application, region string) error {
+func (m *MockpipelineDeployer) AddPipelineResourcesToApp(app *config.Application, region string) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "AddPipelineResourcesToApp", app, region)
 	ret0, _ := ret[0].(error)
@@ -3209,13 +3875,13 @@ func (m *Mockdeployer) AddPipelineResourcesToApp(app *config.Application, region
 }
 
 // AddPipelineResourcesToApp indicates an expected call of AddPipelineResourcesToApp
-func (mr *MockdeployerMockRecorder) AddPipelineResourcesToApp(app, region interface{}) *gomock.Call {
+func (mr *MockpipelineDeployerMockRecorder) AddPipelineResourcesToApp(app, region interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPipelineResourcesToApp", reflect.TypeOf((*Mockdeployer)(nil).AddPipelineResourcesToApp), app, region)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPipelineResourcesToApp", reflect.TypeOf((*MockpipelineDeployer)(nil).AddPipelineResourcesToApp), app, region)
 }
 
 // GetAppResourcesByRegion mocks base method
-func (m *Mockdeployer) GetAppResourcesByRegion(app *config.Application, region string) (*stack.AppRegionalResources, error) {
+func (m *MockpipelineDeployer) GetAppResourcesByRegion(app *config.Application, region string) (*stack.AppRegionalResources, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetAppResourcesByRegion", app, region)
 	ret0, _ := ret[0].(*stack.AppRegionalResources)
@@ -3224,13 +3890,13 @@ func (m *Mockdeployer) GetAppResourcesByRegion(app *config.Application, region s
 }
 
 // GetAppResourcesByRegion indicates an expected call of GetAppResourcesByRegion
-func (mr *MockdeployerMockRecorder) GetAppResourcesByRegion(app, region interface{}) *gomock.Call {
+func (mr *MockpipelineDeployerMockRecorder) GetAppResourcesByRegion(app, region interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppResourcesByRegion", reflect.TypeOf((*Mockdeployer)(nil).GetAppResourcesByRegion), app, region)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppResourcesByRegion", reflect.TypeOf((*MockpipelineDeployer)(nil).GetAppResourcesByRegion), app, region)
 }
 
 // GetRegionalAppResources mocks base method
-func (m *Mockdeployer) GetRegionalAppResources(app *config.Application) ([]*stack.AppRegionalResources, error) {
+func (m *MockpipelineDeployer) GetRegionalAppResources(app *config.Application) ([]*stack.AppRegionalResources, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetRegionalAppResources", app)
 	ret0, _ := ret[0].([]*stack.AppRegionalResources)
@@ -3239,176 +3905,1659 @@ func (m *Mockdeployer) GetRegionalAppResources(app *config.Application) ([]*stac
 }
 
 // GetRegionalAppResources indicates an expected call of GetRegionalAppResources
-func (mr *MockdeployerMockRecorder) GetRegionalAppResources(app interface{}) *gomock.Call {
+func (mr *MockpipelineDeployerMockRecorder) GetRegionalAppResources(app interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegionalAppResources", reflect.TypeOf((*Mockdeployer)(nil).GetRegionalAppResources), app)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegionalAppResources", reflect.TypeOf((*MockpipelineDeployer)(nil).GetRegionalAppResources), app)
 }
 
-// MockdomainValidator is a mock of domainValidator interface
-type MockdomainValidator struct {
+// MockappDeployer is a mock of appDeployer interface
+type MockappDeployer struct {
 	ctrl     *gomock.Controller
-	recorder *MockdomainValidatorMockRecorder
+	recorder *MockappDeployerMockRecorder
 }
 
-// MockdomainValidatorMockRecorder is the mock recorder for MockdomainValidator
-type MockdomainValidatorMockRecorder struct {
-	mock *MockdomainValidator
+// MockappDeployerMockRecorder is the mock recorder for MockappDeployer
+type MockappDeployerMockRecorder struct {
+	mock *MockappDeployer
 }
 
-// NewMockdomainValidator creates a new mock instance
-func NewMockdomainValidator(ctrl *gomock.Controller) *MockdomainValidator {
-	mock := &MockdomainValidator{ctrl: ctrl}
-	mock.recorder = &MockdomainValidatorMockRecorder{mock}
+// NewMockappDeployer creates a new mock instance
+func NewMockappDeployer(ctrl *gomock.Controller) *MockappDeployer {
+	mock := &MockappDeployer{ctrl: ctrl}
+	mock.recorder = &MockappDeployerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockappDeployer) EXPECT() *MockappDeployerMockRecorder {
+	return m.recorder
+}
+
+// DeployApp mocks base method
+func (m *MockappDeployer) DeployApp(in *deploy.CreateAppInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeployApp", in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeployApp indicates an expected call of DeployApp
+func (mr *MockappDeployerMockRecorder) DeployApp(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployApp", reflect.TypeOf((*MockappDeployer)(nil).DeployApp), in)
+}
+
+// AddServiceToApp mocks base method
+func (m *MockappDeployer) AddServiceToApp(app *config.Application, svcName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddServiceToApp", app, svcName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddServiceToApp indicates an expected call of AddServiceToApp
+func (mr *MockappDeployerMockRecorder) AddServiceToApp(app, svcName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddServiceToApp", reflect.TypeOf((*MockappDeployer)(nil).AddServiceToApp), app, svcName)
+}
+
+// AddEnvToApp mocks base method
+func (m *MockappDeployer) AddEnvToApp(app *config.Application, env *config.Environment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddEnvToApp", app, env)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddEnvToApp indicates an expected call of AddEnvToApp
+func (mr *MockappDeployerMockRecorder) AddEnvToApp(app, env interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddEnvToApp", reflect.TypeOf((*MockappDeployer)(nil).AddEnvToApp), app, env)
+}
+
+// DelegateDNSPermissions mocks base method
+func (m *MockappDeployer) DelegateDNSPermissions(app *config.Application, accountID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DelegateDNSPermissions", app, accountID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DelegateDNSPermissions indicates an expected call of DelegateDNSPermissions
+func (mr *MockappDeployerMockRecorder) DelegateDNSPermissions(app, accountID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DelegateDNSPermissions", reflect.TypeOf((*MockappDeployer)(nil).DelegateDNSPermissions), app, accountID)
+}
+
+// DeleteApp mocks base method
+func (m *MockappDeployer) DeleteApp(name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteApp", name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteApp indicates an expected call of DeleteApp
+func (mr *MockappDeployerMockRecorder) DeleteApp(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteApp", reflect.TypeOf((*MockappDeployer)(nil).DeleteApp), name)
+}
+
+// MockappResourcesGetter is a mock of appResourcesGetter interface
+type MockappResourcesGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockappResourcesGetterMockRecorder
+}
+
+// MockappResourcesGetterMockRecorder is the mock recorder for MockappResourcesGetter
+type MockappResourcesGetterMockRecorder struct {
+	mock *MockappResourcesGetter
+}
+
+// NewMockappResourcesGetter creates a new mock instance
+func NewMockappResourcesGetter(ctrl *gomock.Controller) *MockappResourcesGetter {
+	mock := &MockappResourcesGetter{ctrl: ctrl}
+	mock.recorder = &MockappResourcesGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockappResourcesGetter) EXPECT() *MockappResourcesGetterMockRecorder {
+	return m.recorder
+}
+
+// GetAppResourcesByRegion mocks base method
+func (m *MockappResourcesGetter) GetAppResourcesByRegion(app *config.Application, region string) (*stack.AppRegionalResources, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAppResourcesByRegion", app, region)
+	ret0, _ := ret[0].(*stack.AppRegionalResources)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAppResourcesByRegion indicates an expected call of GetAppResourcesByRegion
+func (mr *MockappResourcesGetterMockRecorder) GetAppResourcesByRegion(app, region interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppResourcesByRegion", reflect.TypeOf((*MockappResourcesGetter)(nil).GetAppResourcesByRegion), app, region)
+}
+
+// GetRegionalAppResources mocks base method
+func (m *MockappResourcesGetter) GetRegionalAppResources(app *config.Application) ([]*stack.AppRegionalResources, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRegionalAppResources", app)
+	ret0, _ := ret[0].([]*stack.AppRegionalResources)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRegionalAppResources indicates an expected call of GetRegionalAppResources
+func (mr *MockappResourcesGetterMockRecorder) GetRegionalAppResources(app interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegionalAppResources", reflect.TypeOf((*MockappResourcesGetter)(nil).GetRegionalAppResources), app)
+}
+
+// MocktaskDeployer is a mock of taskDeployer interface
+type MocktaskDeployer struct {
+	ctrl     *gomock.Controller
+	recorder *MocktaskDeployerMockRecorder
+}
+
+// MocktaskDeployerMockRecorder is the mock recorder for MocktaskDeployer
+type MocktaskDeployerMockRecorder struct {
+	mock *MocktaskDeployer
+}
+
+// NewMocktaskDeployer creates a new mock instance
+func NewMocktaskDeployer(ctrl *gomock.Controller) *MocktaskDeployer {
+	mock := &MocktaskDeployer{ctrl: ctrl}
+	mock.recorder = &MocktaskDeployerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MocktaskDeployer) EXPECT() *MocktaskDeployerMockRecorder {
+	return m.recorder
+}
+
+// DeployTask mocks base method
+func (m *MocktaskDeployer) DeployTask(input *deploy.CreateTaskResourcesInput, opts ...cloudformation0.StackOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeployTask", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeployTask indicates an expected call of DeployTask
+func (mr *MocktaskDeployerMockRecorder) DeployTask(input interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployTask", reflect.TypeOf((*MocktaskDeployer)(nil).DeployTask), varargs...)
+}
+
+// MocktaskRunner is a mock of taskRunner interface
+type MocktaskRunner struct {
+	ctrl     *gomock.Controller
+	recorder *MocktaskRunnerMockRecorder
+}
+
+// MocktaskRunnerMockRecorder is the mock recorder for MocktaskRunner
+type MocktaskRunnerMockRecorder struct {
+	mock *MocktaskRunner
+}
+
+// NewMocktaskRunner creates a new mock instance
+func NewMocktaskRunner(ctrl *gomock.Controller) *MocktaskRunner {
+	mock := &MocktaskRunner{ctrl: ctrl}
+	mock.recorder = &MocktaskRunnerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MocktaskRunner) EXPECT() *MocktaskRunnerMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method
+func (m *MocktaskRunner) Run(ctx context.Context) ([]*task.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", ctx)
+	ret0, _ := ret[0].([]*task.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Run indicates an expected call of Run
+func (mr *MocktaskRunnerMockRecorder) Run(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MocktaskRunner)(nil).Run), ctx)
+}
+
+// MockrunningTasksLister is a mock of runningTasksLister interface
+type MockrunningTasksLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockrunningTasksListerMockRecorder
+}
+
+// MockrunningTasksListerMockRecorder is the mock recorder for MockrunningTasksLister
+type MockrunningTasksListerMockRecorder struct {
+	mock *MockrunningTasksLister
+}
+
+// NewMockrunningTasksLister creates a new mock instance
+func NewMockrunningTasksLister(ctrl *gomock.Controller) *MockrunningTasksLister {
+	mock := &MockrunningTasksLister{ctrl: ctrl}
+	mock.recorder = &MockrunningTasksListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockrunningTasksLister) EXPECT() *MockrunningTasksListerMockRecorder {
+	return m.recorder
+}
+
+// RunningTasks mocks base method
+func (m *MockrunningTasksLister) RunningTasks(cluster, startedBy string) ([]*ecs.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunningTasks", cluster, startedBy)
+	ret0, _ := ret[0].([]*ecs.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunningTasks indicates an expected call of RunningTasks
+func (mr *MockrunningTasksListerMockRecorder) RunningTasks(cluster, startedBy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunningTasks", reflect.TypeOf((*MockrunningTasksLister)(nil).RunningTasks), cluster, startedBy)
+}
+
+// MocktasksStopper is a mock of tasksStopper interface
+type MocktasksStopper struct {
+	ctrl     *gomock.Controller
+	recorder *MocktasksStopperMockRecorder
+}
+
+// MocktasksStopperMockRecorder is the mock recorder for MocktasksStopper
+type MocktasksStopperMockRecorder struct {
+	mock *MocktasksStopper
+}
+
+// NewMocktasksStopper creates a new mock instance
+func NewMocktasksStopper(ctrl *gomock.Controller) *MocktasksStopper {
+	mock := &MocktasksStopper{ctrl: ctrl}
+	mock.recorder = &MocktasksStopperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MocktasksStopper) EXPECT() *MocktasksStopperMockRecorder {
+	return m.recorder
+}
+
+// StopTasks mocks base method
+func (m *MocktasksStopper) StopTasks(cluster string, taskARNs []string, reason string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopTasks", cluster, taskARNs, reason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StopTasks indicates an expected call of StopTasks
+func (mr *MocktasksStopperMockRecorder) StopTasks(cluster, taskARNs, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopTasks", reflect.TypeOf((*MocktasksStopper)(nil).StopTasks), cluster, taskARNs, reason)
+}
+
+// MockdefaultClusterGetter is a mock of defaultClusterGetter interface
+type MockdefaultClusterGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockdefaultClusterGetterMockRecorder
+}
+
+// MockdefaultClusterGetterMockRecorder is the mock recorder for MockdefaultClusterGetter
+type MockdefaultClusterGetterMockRecorder struct {
+	mock *MockdefaultClusterGetter
+}
+
+// NewMockdefaultClusterGetter creates a new mock instance
+func NewMockdefaultClusterGetter(ctrl *gomock.Controller) *MockdefaultClusterGetter {
+	mock := &MockdefaultClusterGetter{ctrl: ctrl}
+	mock.recorder = &MockdefaultClusterGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockdefaultClusterGetter) EXPECT() *MockdefaultClusterGetterMockRecorder {
+	return m.recorder
+}
+
+// HasDefaultCluster mocks base method
+func (m *MockdefaultClusterGetter) HasDefaultCluster() (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasDefaultCluster")
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasDefaultCluster indicates an expected call of HasDefaultCluster
+func (mr *MockdefaultClusterGetterMockRecorder) HasDefaultCluster() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasDefaultCluster", reflect.TypeOf((*MockdefaultClusterGetter)(nil).HasDefaultCluster))
+}
+
+// Mockdeployer is a mock of deployer interface
+type Mockdeployer struct {
+	ctrl     *gomock.Controller
+	recorder *MockdeployerMockRecorder
+}
+
+// MockdeployerMockRecorder is the mock recorder for Mockdeployer
+type MockdeployerMockRecorder struct {
+	mock *Mockdeployer
+}
+
+// NewMockdeployer creates a new mock instance
+func NewMockdeployer(ctrl *gomock.Controller) *Mockdeployer {
+	mock := &Mockdeployer{ctrl: ctrl}
+	mock.recorder = &MockdeployerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *Mockdeployer) EXPECT() *MockdeployerMockRecorder {
+	return m.recorder
+}
+
+// DeployEnvironment mocks base method
+func (m *Mockdeployer) DeployEnvironment(env *deploy.CreateEnvironmentInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeployEnvironment", env)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeployEnvironment indicates an expected call of DeployEnvironment
+func (mr *MockdeployerMockRecorder) DeployEnvironment(env interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployEnvironment", reflect.TypeOf((*Mockdeployer)(nil).DeployEnvironment), env)
+}
+
+// StreamEnvironmentCreation mocks base method
+func (m *Mockdeployer) StreamEnvironmentCreation(env *deploy.CreateEnvironmentInput) (<-chan []deploy.ResourceEvent, <-chan deploy.CreateEnvironmentResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamEnvironmentCreation", env)
+	ret0, _ := ret[0].(<-chan []deploy.ResourceEvent)
+	ret1, _ := ret[1].(<-chan deploy.CreateEnvironmentResponse)
+	return ret0, ret1
+}
+
+// StreamEnvironmentCreation indicates an expected call of StreamEnvironmentCreation
+func (mr *MockdeployerMockRecorder) StreamEnvironmentCreation(env interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamEnvironmentCreation", reflect.TypeOf((*Mockdeployer)(nil).StreamEnvironmentCreation), env)
+}
+
+// DeleteEnvironment mocks base method
+func (m *Mockdeployer) DeleteEnvironment(appName, envName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEnvironment", appName, envName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteEnvironment indicates an expected call of DeleteEnvironment
+func (mr *MockdeployerMockRecorder) DeleteEnvironment(appName, envName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEnvironment", reflect.TypeOf((*Mockdeployer)(nil).DeleteEnvironment), appName, envName)
+}
+
+// GetEnvironment mocks base method
+func (m *Mockdeployer) GetEnvironment(appName, envName string) (*config.Environment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEnvironment", appName, envName)
+	ret0, _ := ret[0].(*config.Environment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEnvironment indicates an expected call of GetEnvironment
+func (mr *MockdeployerMockRecorder) GetEnvironment(appName, envName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEnvironment", reflect.TypeOf((*Mockdeployer)(nil).GetEnvironment), appName, envName)
+}
+
+// DeployApp mocks base method
+func (m *Mockdeployer) DeployApp(in *deploy.CreateAppInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeployApp", in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeployApp indicates an expected call of DeployApp
+func (mr *MockdeployerMockRecorder) DeployApp(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployApp", reflect.TypeOf((*Mockdeployer)(nil).DeployApp), in)
+}
+
+// AddServiceToApp mocks base method
+func (m *Mockdeployer) AddServiceToApp(app *config.Application, svcName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddServiceToApp", app, svcName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddServiceToApp indicates an expected call of AddServiceToApp
+func (mr *MockdeployerMockRecorder) AddServiceToApp(app, svcName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddServiceToApp", reflect.TypeOf((*Mockdeployer)(nil).AddServiceToApp), app, svcName)
+}
+
+// AddEnvToApp mocks base method
+func (m *Mockdeployer) AddEnvToApp(app *config.Application, env *config.Environment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddEnvToApp", app, env)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddEnvToApp indicates an expected call of AddEnvToApp
+func (mr *MockdeployerMockRecorder) AddEnvToApp(app, env interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddEnvToApp", reflect.TypeOf((*Mockdeployer)(nil).AddEnvToApp), app, env)
+}
+
+// DelegateDNSPermissions mocks base method
+func (m *Mockdeployer) DelegateDNSPermissions(app *config.Application, accountID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DelegateDNSPermissions", app, accountID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DelegateDNSPermissions indicates an expected call of DelegateDNSPermissions
+func (mr *MockdeployerMockRecorder) DelegateDNSPermissions(app, accountID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DelegateDNSPermissions", reflect.TypeOf((*Mockdeployer)(nil).DelegateDNSPermissions), app, accountID)
+}
+
+// DeleteApp mocks base method
+func (m *Mockdeployer) DeleteApp(name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteApp", name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteApp indicates an expected call of DeleteApp
+func (mr *MockdeployerMockRecorder) DeleteApp(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteApp", reflect.TypeOf((*Mockdeployer)(nil).DeleteApp), name)
+}
+
+// CreatePipeline mocks base method
+func (m *Mockdeployer) CreatePipeline(env *deploy.CreatePipelineInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePipeline", env)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePipeline indicates an expected call of CreatePipeline
+func (mr *MockdeployerMockRecorder) CreatePipeline(env interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePipeline", reflect.TypeOf((*Mockdeployer)(nil).CreatePipeline), env)
+}
+
+// UpdatePipeline mocks base method
+func (m *Mockdeployer) UpdatePipeline(env *deploy.CreatePipelineInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePipeline", env)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePipeline indicates an expected call of UpdatePipeline
+func (mr *MockdeployerMockRecorder) UpdatePipeline(env interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePipeline", reflect.TypeOf((*Mockdeployer)(nil).UpdatePipeline), env)
+}
+
+// PipelineExists mocks base method
+func (m *Mockdeployer) PipelineExists(env *deploy.CreatePipelineInput) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PipelineExists", env)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PipelineExists indicates an expected call of PipelineExists
+func (mr *MockdeployerMockRecorder) PipelineExists(env interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PipelineExists", reflect.TypeOf((*Mockdeployer)(nil).PipelineExists), env)
+}
+
+// DeletePipeline mocks base method
+func (m *Mockdeployer) DeletePipeline(pipelineName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePipeline", pipelineName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePipeline indicates an expected call of DeletePipeline
+func (mr *MockdeployerMockRecorder) DeletePipeline(pipelineName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePipeline", reflect.TypeOf((*Mockdeployer)(nil).DeletePipeline), pipelineName)
+}
+
+// AddPipelineResourcesToApp mocks base method
+func (m *Mockdeployer) AddPipelineResourcesToApp(app *config.Application, region string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddPipelineResourcesToApp", app, region)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddPipelineResourcesToApp indicates an expected call of AddPipelineResourcesToApp
+func (mr *MockdeployerMockRecorder) AddPipelineResourcesToApp(app, region interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPipelineResourcesToApp", reflect.TypeOf((*Mockdeployer)(nil).AddPipelineResourcesToApp), app, region)
+}
+
+// GetAppResourcesByRegion mocks base method
+func (m *Mockdeployer) GetAppResourcesByRegion(app *config.Application, region string) (*stack.AppRegionalResources, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAppResourcesByRegion", app, region)
+	ret0, _ := ret[0].(*stack.AppRegionalResources)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAppResourcesByRegion indicates an expected call of GetAppResourcesByRegion
+func (mr *MockdeployerMockRecorder) GetAppResourcesByRegion(app, region interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppResourcesByRegion", reflect.TypeOf((*Mockdeployer)(nil).GetAppResourcesByRegion), app, region)
+}
+
+// GetRegionalAppResources mocks base method
+func (m *Mockdeployer) GetRegionalAppResources(app *config.Application) ([]*stack.AppRegionalResources, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRegionalAppResources", app)
+	ret0, _ := ret[0].([]*stack.AppRegionalResources)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRegionalAppResources indicates an expected call of GetRegionalAppResources
+func (mr *MockdeployerMockRecorder) GetRegionalAppResources(app interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegionalAppResources", reflect.TypeOf((*Mockdeployer)(nil).GetRegionalAppResources), app)
+}
+
+// MockdomainValidator is a mock of domainValidator interface
+type MockdomainValidator struct {
+	ctrl     *gomock.Controller
+	recorder *MockdomainValidatorMockRecorder
+}
+
+// MockdomainValidatorMockRecorder is the mock recorder for MockdomainValidator
+type MockdomainValidatorMockRecorder struct {
+	mock *MockdomainValidator
+}
+
+// NewMockdomainValidator creates a new mock instance
+func NewMockdomainValidator(ctrl *gomock.Controller) *MockdomainValidator {
+	mock := &MockdomainValidator{ctrl: ctrl}
+	mock.recorder = &MockdomainValidatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockdomainValidator) EXPECT() *MockdomainValidatorMockRecorder {
+	return m.recorder
+}
+
+// DomainExists mocks base method
+func (m *MockdomainValidator) DomainExists(domainName string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DomainExists", domainName)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DomainExists indicates an expected call of DomainExists
+func (mr *MockdomainValidatorMockRecorder) DomainExists(domainName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DomainExists", reflect.TypeOf((*MockdomainValidator)(nil).DomainExists), domainName)
+}
+
+// MockaliasRecordDeployer is a mock of aliasRecordDeployer interface
+type MockaliasRecordDeployer struct {
+	ctrl     *gomock.Controller
+	recorder *MockaliasRecordDeployerMockRecorder
+}
+
+// MockaliasRecordDeployerMockRecorder is the mock recorder for MockaliasRecordDeployer
+type MockaliasRecordDeployerMockRecorder struct {
+	mock *MockaliasRecordDeployer
+}
+
+// NewMockaliasRecordDeployer creates a new mock instance
+func NewMockaliasRecordDeployer(ctrl *gomock.Controller) *MockaliasRecordDeployer {
+	mock := &MockaliasRecordDeployer{ctrl: ctrl}
+	mock.recorder = &MockaliasRecordDeployerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockaliasRecordDeployer) EXPECT() *MockaliasRecordDeployerMockRecorder {
+	return m.recorder
+}
+
+// HostedZoneName mocks base method
+func (m *MockaliasRecordDeployer) HostedZoneName(hostedZoneID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HostedZoneName", hostedZoneID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HostedZoneName indicates an expected call of HostedZoneName
+func (mr *MockaliasRecordDeployerMockRecorder) HostedZoneName(hostedZoneID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HostedZoneName", reflect.TypeOf((*MockaliasRecordDeployer)(nil).HostedZoneName), hostedZoneID)
+}
+
+// UpsertAliasRecord mocks base method
+func (m *MockaliasRecordDeployer) UpsertAliasRecord(record route53.AliasRecord) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertAliasRecord", record)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertAliasRecord indicates an expected call of UpsertAliasRecord
+func (mr *MockaliasRecordDeployerMockRecorder) UpsertAliasRecord(record interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertAliasRecord", reflect.TypeOf((*MockaliasRecordDeployer)(nil).UpsertAliasRecord), record)
+}
+
+// CreateHealthCheck mocks base method
+func (m *MockaliasRecordDeployer) CreateHealthCheck(hc route53.HealthCheck) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateHealthCheck", hc)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateHealthCheck indicates an expected call of CreateHealthCheck
+func (mr *MockaliasRecordDeployerMockRecorder) CreateHealthCheck(hc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateHealthCheck", reflect.TypeOf((*MockaliasRecordDeployer)(nil).CreateHealthCheck), hc)
+}
+
+// MockcertDescriber is a mock of certDescriber interface
+type MockcertDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockcertDescriberMockRecorder
+}
+
+// MockcertDescriberMockRecorder is the mock recorder for MockcertDescriber
+type MockcertDescriberMockRecorder struct {
+	mock *MockcertDescriber
+}
+
+// NewMockcertDescriber creates a new mock instance
+func NewMockcertDescriber(ctrl *gomock.Controller) *MockcertDescriber {
+	mock := &MockcertDescriber{ctrl: ctrl}
+	mock.recorder = &MockcertDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockcertDescriber) EXPECT() *MockcertDescriberMockRecorder {
+	return m.recorder
+}
+
+// DescribeCertificate mocks base method
+func (m *MockcertDescriber) DescribeCertificate(arn string) (*acm.Certificate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeCertificate", arn)
+	ret0, _ := ret[0].(*acm.Certificate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeCertificate indicates an expected call of DescribeCertificate
+func (mr *MockcertDescriberMockRecorder) DescribeCertificate(arn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeCertificate", reflect.TypeOf((*MockcertDescriber)(nil).DescribeCertificate), arn)
+}
+
+// MockenvOutputsGetter is a mock of envOutputsGetter interface
+type MockenvOutputsGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockenvOutputsGetterMockRecorder
+}
+
+// MockenvOutputsGetterMockRecorder is the mock recorder for MockenvOutputsGetter
+type MockenvOutputsGetterMockRecorder struct {
+	mock *MockenvOutputsGetter
+}
+
+// NewMockenvOutputsGetter creates a new mock instance
+func NewMockenvOutputsGetter(ctrl *gomock.Controller) *MockenvOutputsGetter {
+	mock := &MockenvOutputsGetter{ctrl: ctrl}
+	mock.recorder = &MockenvOutputsGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockenvOutputsGetter) EXPECT() *MockenvOutputsGetterMockRecorder {
+	return m.recorder
+}
+
+// EnvOutputs mocks base method
+func (m *MockenvOutputsGetter) EnvOutputs() (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnvOutputs")
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnvOutputs indicates an expected call of EnvOutputs
+func (mr *MockenvOutputsGetterMockRecorder) EnvOutputs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnvOutputs", reflect.TypeOf((*MockenvOutputsGetter)(nil).EnvOutputs))
+}
+
+// MockdockerfileParser is a mock of dockerfileParser interface
+type MockdockerfileParser struct {
+	ctrl     *gomock.Controller
+	recorder *MockdockerfileParserMockRecorder
+}
+
+// MockdockerfileParserMockRecorder is the mock recorder for MockdockerfileParser
+type MockdockerfileParserMockRecorder struct {
+	mock *MockdockerfileParser
+}
+
+// NewMockdockerfileParser creates a new mock instance
+func NewMockdockerfileParser(ctrl *gomock.Controller) *MockdockerfileParser {
+	mock := &MockdockerfileParser{ctrl: ctrl}
+	mock.recorder = &MockdockerfileParserMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockdockerfileParser) EXPECT() *MockdockerfileParserMockRecorder {
+	return m.recorder
+}
+
+// GetExposedPorts mocks base method
+func (m *MockdockerfileParser) GetExposedPorts() ([]uint16, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExposedPorts")
+	ret0, _ := ret[0].([]uint16)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExposedPorts indicates an expected call of GetExposedPorts
+func (mr *MockdockerfileParserMockRecorder) GetExposedPorts() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExposedPorts", reflect.TypeOf((*MockdockerfileParser)(nil).GetExposedPorts))
+}
+
+// GetHealthCheck mocks base method
+func (m *MockdockerfileParser) GetHealthCheck() (*dockerfile.HealthCheck, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHealthCheck")
+	ret0, _ := ret[0].(*dockerfile.HealthCheck)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHealthCheck indicates an expected call of GetHealthCheck
+func (mr *MockdockerfileParserMockRecorder) GetHealthCheck() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHealthCheck", reflect.TypeOf((*MockdockerfileParser)(nil).GetHealthCheck))
+}
+
+// MockserviceArnGetter is a mock of serviceArnGetter interface
+type MockserviceArnGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockserviceArnGetterMockRecorder
+}
+
+// MockserviceArnGetterMockRecorder is the mock recorder for MockserviceArnGetter
+type MockserviceArnGetterMockRecorder struct {
+	mock *MockserviceArnGetter
+}
+
+// NewMockserviceArnGetter creates a new mock instance
+func NewMockserviceArnGetter(ctrl *gomock.Controller) *MockserviceArnGetter {
+	mock := &MockserviceArnGetter{ctrl: ctrl}
+	mock.recorder = &MockserviceArnGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockserviceArnGetter) EXPECT() *MockserviceArnGetterMockRecorder {
+	return m.recorder
+}
+
+// GetServiceArn mocks base method
+func (m *MockserviceArnGetter) GetServiceArn() (*ecs.ServiceArn, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServiceArn")
+	ret0, _ := ret[0].(*ecs.ServiceArn)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetServiceArn indicates an expected call of GetServiceArn
+func (mr *MockserviceArnGetterMockRecorder) GetServiceArn() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceArn", reflect.TypeOf((*MockserviceArnGetter)(nil).GetServiceArn))
+}
+
+// MockserviceDeploymentForcer is a mock of serviceDeploymentForcer interface
+type MockserviceDeploymentForcer struct {
+	ctrl     *gomock.Controller
+	recorder *MockserviceDeploymentForcerMockRecorder
+}
+
+// MockserviceDeploymentForcerMockRecorder is the mock recorder for MockserviceDeploymentForcer
+type MockserviceDeploymentForcerMockRecorder struct {
+	mock *MockserviceDeploymentForcer
+}
+
+// NewMockserviceDeploymentForcer creates a new mock instance
+func NewMockserviceDeploymentForcer(ctrl *gomock.Controller) *MockserviceDeploymentForcer {
+	mock := &MockserviceDeploymentForcer{ctrl: ctrl}
+	mock.recorder = &MockserviceDeploymentForcerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockserviceDeploymentForcer) EXPECT() *MockserviceDeploymentForcerMockRecorder {
+	return m.recorder
+}
+
+// ForceNewDeployment mocks base method
+func (m *MockserviceDeploymentForcer) ForceNewDeployment(cluster, service string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForceNewDeployment", cluster, service)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ForceNewDeployment indicates an expected call of ForceNewDeployment
+func (mr *MockserviceDeploymentForcerMockRecorder) ForceNewDeployment(cluster, service interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForceNewDeployment", reflect.TypeOf((*MockserviceDeploymentForcer)(nil).ForceNewDeployment), cluster, service)
+}
+
+// MockserviceTaskExecuter is a mock of serviceTaskExecuter interface
+type MockserviceTaskExecuter struct {
+	ctrl     *gomock.Controller
+	recorder *MockserviceTaskExecuterMockRecorder
+}
+
+// MockserviceTaskExecuterMockRecorder is the mock recorder for MockserviceTaskExecuter
+type MockserviceTaskExecuterMockRecorder struct {
+	mock *MockserviceTaskExecuter
+}
+
+// NewMockserviceTaskExecuter creates a new mock instance
+func NewMockserviceTaskExecuter(ctrl *gomock.Controller) *MockserviceTaskExecuter {
+	mock := &MockserviceTaskExecuter{ctrl: ctrl}
+	mock.recorder = &MockserviceTaskExecuterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockserviceTaskExecuter) EXPECT() *MockserviceTaskExecuterMockRecorder {
+	return m.recorder
+}
+
+// ServiceTasks mocks base method
+func (m *MockserviceTaskExecuter) ServiceTasks(clusterName, serviceName string) ([]*ecs.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceTasks", clusterName, serviceName)
+	ret0, _ := ret[0].([]*ecs.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ServiceTasks indicates an expected call of ServiceTasks
+func (mr *MockserviceTaskExecuterMockRecorder) ServiceTasks(clusterName, serviceName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceTasks", reflect.TypeOf((*MockserviceTaskExecuter)(nil).ServiceTasks), clusterName, serviceName)
+}
+
+// ExecuteCommand mocks base method
+func (m *MockserviceTaskExecuter) ExecuteCommand(in ecs.ExecuteCommandInput) (*ecs.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteCommand", in)
+	ret0, _ := ret[0].(*ecs.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecuteCommand indicates an expected call of ExecuteCommand
+func (mr *MockserviceTaskExecuterMockRecorder) ExecuteCommand(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteCommand", reflect.TypeOf((*MockserviceTaskExecuter)(nil).ExecuteCommand), in)
+}
+
+// MocktaskExecuter is a mock of taskExecuter interface
+type MocktaskExecuter struct {
+	ctrl     *gomock.Controller
+	recorder *MocktaskExecuterMockRecorder
+}
+
+// MocktaskExecuterMockRecorder is the mock recorder for MocktaskExecuter
+type MocktaskExecuterMockRecorder struct {
+	mock *MocktaskExecuter
+}
+
+// NewMocktaskExecuter creates a new mock instance
+func NewMocktaskExecuter(ctrl *gomock.Controller) *MocktaskExecuter {
+	mock := &MocktaskExecuter{ctrl: ctrl}
+	mock.recorder = &MocktaskExecuterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MocktaskExecuter) EXPECT() *MocktaskExecuterMockRecorder {
+	return m.recorder
+}
+
+// DescribeTasks mocks base method
+func (m *MocktaskExecuter) DescribeTasks(cluster string, taskARNs []string) ([]*ecs.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeTasks", cluster, taskARNs)
+	ret0, _ := ret[0].([]*ecs.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeTasks indicates an expected call of DescribeTasks
+func (mr *MocktaskExecuterMockRecorder) DescribeTasks(cluster, taskARNs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTasks", reflect.TypeOf((*MocktaskExecuter)(nil).DescribeTasks), cluster, taskARNs)
+}
+
+// ExecuteCommand mocks base method
+func (m *MocktaskExecuter) ExecuteCommand(in ecs.ExecuteCommandInput) (*ecs.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteCommand", in)
+	ret0, _ := ret[0].(*ecs.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecuteCommand indicates an expected call of ExecuteCommand
+func (mr *MocktaskExecuterMockRecorder) ExecuteCommand(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteCommand", reflect.TypeOf((*MocktaskExecuter)(nil).ExecuteCommand), in)
+}
+
+// MockstatusDescriber is a mock of statusDescriber interface
+type MockstatusDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockstatusDescriberMockRecorder
+}
+
+// MockstatusDescriberMockRecorder is the mock recorder for MockstatusDescriber
+type MockstatusDescriberMockRecorder struct {
+	mock *MockstatusDescriber
+}
+
+// NewMockstatusDescriber creates a new mock instance
+func NewMockstatusDescriber(ctrl *gomock.Controller) *MockstatusDescriber {
+	mock := &MockstatusDescriber{ctrl: ctrl}
+	mock.recorder = &MockstatusDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockstatusDescriber) EXPECT() *MockstatusDescriberMockRecorder {
+	return m.recorder
+}
+
+// Describe mocks base method
+func (m *MockstatusDescriber) Describe() (*describe.ServiceStatusDesc, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Describe")
+	ret0, _ := ret[0].(*describe.ServiceStatusDesc)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Describe indicates an expected call of Describe
+func (mr *MockstatusDescriberMockRecorder) Describe() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockstatusDescriber)(nil).Describe))
+}
+
+// AlarmHistory mocks base method
+func (m *MockstatusDescriber) AlarmHistory(alarms []cloudwatch.AlarmStatus, startDate time.Time) ([]cloudwatch.AlarmHistoryItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AlarmHistory", alarms, startDate)
+	ret0, _ := ret[0].([]cloudwatch.AlarmHistoryItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AlarmHistory indicates an expected call of AlarmHistory
+func (mr *MockstatusDescriberMockRecorder) AlarmHistory(alarms, startDate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AlarmHistory", reflect.TypeOf((*MockstatusDescriber)(nil).AlarmHistory), alarms, startDate)
+}
+
+// StoppedTaskStatuses mocks base method
+func (m *MockstatusDescriber) StoppedTaskStatuses() ([]ecs.TaskStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoppedTaskStatuses")
+	ret0, _ := ret[0].([]ecs.TaskStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StoppedTaskStatuses indicates an expected call of StoppedTaskStatuses
+func (mr *MockstatusDescriberMockRecorder) StoppedTaskStatuses() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoppedTaskStatuses", reflect.TypeOf((*MockstatusDescriber)(nil).StoppedTaskStatuses))
+}
+
+// Autoscaling mocks base method
+func (m *MockstatusDescriber) Autoscaling() (*applicationautoscaling.ServiceScaling, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Autoscaling")
+	ret0, _ := ret[0].(*applicationautoscaling.ServiceScaling)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Autoscaling indicates an expected call of Autoscaling
+func (mr *MockstatusDescriberMockRecorder) Autoscaling() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Autoscaling", reflect.TypeOf((*MockstatusDescriber)(nil).Autoscaling))
+}
+
+// MocksecurityStatusDescriber is a mock of securityStatusDescriber interface
+type MocksecurityStatusDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MocksecurityStatusDescriberMockRecorder
+}
+
+// MocksecurityStatusDescriberMockRecorder is the mock recorder for MocksecurityStatusDescriber
+type MocksecurityStatusDescriberMockRecorder struct {
+	mock *MocksecurityStatusDescriber
+}
+
+// NewMocksecurityStatusDescriber creates a new mock instance
+func NewMocksecurityStatusDescriber(ctrl *gomock.Controller) *MocksecurityStatusDescriber {
+	mock := &MocksecurityStatusDescriber{ctrl: ctrl}
+	mock.recorder = &MocksecurityStatusDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MocksecurityStatusDescriber) EXPECT() *MocksecurityStatusDescriberMockRecorder {
+	return m.recorder
+}
+
+// Describe mocks base method
+func (m *MocksecurityStatusDescriber) Describe() (*describe.SecurityStatusDesc, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Describe")
+	ret0, _ := ret[0].(*describe.SecurityStatusDesc)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Describe indicates an expected call of Describe
+func (mr *MocksecurityStatusDescriberMockRecorder) Describe() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MocksecurityStatusDescriber)(nil).Describe))
+}
+
+// MockstoppedTasksGetter is a mock of stoppedTasksGetter interface
+type MockstoppedTasksGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockstoppedTasksGetterMockRecorder
+}
+
+// MockstoppedTasksGetterMockRecorder is the mock recorder for MockstoppedTasksGetter
+type MockstoppedTasksGetterMockRecorder struct {
+	mock *MockstoppedTasksGetter
+}
+
+// NewMockstoppedTasksGetter creates a new mock instance
+func NewMockstoppedTasksGetter(ctrl *gomock.Controller) *MockstoppedTasksGetter {
+	mock := &MockstoppedTasksGetter{ctrl: ctrl}
+	mock.recorder = &MockstoppedTasksGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockstoppedTasksGetter) EXPECT() *MockstoppedTasksGetterMockRecorder {
+	return m.recorder
+}
+
+// StoppedTasks mocks base method
+func (m *MockstoppedTasksGetter) StoppedTasks() ([]*ecs.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoppedTasks")
+	ret0, _ := ret[0].([]*ecs.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StoppedTasks indicates an expected call of StoppedTasks
+func (mr *MockstoppedTasksGetterMockRecorder) StoppedTasks() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoppedTasks", reflect.TypeOf((*MockstoppedTasksGetter)(nil).StoppedTasks))
+}
+
+// MockserviceEventsGetter is a mock of serviceEventsGetter interface
+type MockserviceEventsGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockserviceEventsGetterMockRecorder
+}
+
+// MockserviceEventsGetterMockRecorder is the mock recorder for MockserviceEventsGetter
+type MockserviceEventsGetterMockRecorder struct {
+	mock *MockserviceEventsGetter
+}
+
+// NewMockserviceEventsGetter creates a new mock instance
+func NewMockserviceEventsGetter(ctrl *gomock.Controller) *MockserviceEventsGetter {
+	mock := &MockserviceEventsGetter{ctrl: ctrl}
+	mock.recorder = &MockserviceEventsGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockserviceEventsGetter) EXPECT() *MockserviceEventsGetterMockRecorder {
+	return m.recorder
+}
+
+// ServiceEvents mocks base method
+func (m *MockserviceEventsGetter) ServiceEvents(since time.Time) ([]ecs.ServiceEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceEvents", since)
+	ret0, _ := ret[0].([]ecs.ServiceEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ServiceEvents indicates an expected call of ServiceEvents
+func (mr *MockserviceEventsGetterMockRecorder) ServiceEvents(since interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceEvents", reflect.TypeOf((*MockserviceEventsGetter)(nil).ServiceEvents), since)
+}
+
+// MockdeploymentWaiter is a mock of deploymentWaiter interface
+type MockdeploymentWaiter struct {
+	ctrl     *gomock.Controller
+	recorder *MockdeploymentWaiterMockRecorder
+}
+
+// MockdeploymentWaiterMockRecorder is the mock recorder for MockdeploymentWaiter
+type MockdeploymentWaiterMockRecorder struct {
+	mock *MockdeploymentWaiter
+}
+
+// NewMockdeploymentWaiter creates a new mock instance
+func NewMockdeploymentWaiter(ctrl *gomock.Controller) *MockdeploymentWaiter {
+	mock := &MockdeploymentWaiter{ctrl: ctrl}
+	mock.recorder = &MockdeploymentWaiterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockdeploymentWaiter) EXPECT() *MockdeploymentWaiterMockRecorder {
+	return m.recorder
+}
+
+// WaitForDeployment mocks base method
+func (m *MockdeploymentWaiter) WaitForDeployment(changeSetID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitForDeployment", changeSetID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WaitForDeployment indicates an expected call of WaitForDeployment
+func (mr *MockdeploymentWaiterMockRecorder) WaitForDeployment(changeSetID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitForDeployment", reflect.TypeOf((*MockdeploymentWaiter)(nil).WaitForDeployment), changeSetID)
+}
+
+// MockrunningTasksGetter is a mock of runningTasksGetter interface
+type MockrunningTasksGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockrunningTasksGetterMockRecorder
+}
+
+// MockrunningTasksGetterMockRecorder is the mock recorder for MockrunningTasksGetter
+type MockrunningTasksGetterMockRecorder struct {
+	mock *MockrunningTasksGetter
+}
+
+// NewMockrunningTasksGetter creates a new mock instance
+func NewMockrunningTasksGetter(ctrl *gomock.Controller) *MockrunningTasksGetter {
+	mock := &MockrunningTasksGetter{ctrl: ctrl}
+	mock.recorder = &MockrunningTasksGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockrunningTasksGetter) EXPECT() *MockrunningTasksGetterMockRecorder {
+	return m.recorder
+}
+
+// RunningTasks mocks base method
+func (m *MockrunningTasksGetter) RunningTasks() ([]*ecs.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunningTasks")
+	ret0, _ := ret[0].([]*ecs.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunningTasks indicates an expected call of RunningTasks
+func (mr *MockrunningTasksGetterMockRecorder) RunningTasks() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunningTasks", reflect.TypeOf((*MockrunningTasksGetter)(nil).RunningTasks))
+}
+
+// MocksvcOutputsGetter is a mock of svcOutputsGetter interface
+type MocksvcOutputsGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MocksvcOutputsGetterMockRecorder
+}
+
+// MocksvcOutputsGetterMockRecorder is the mock recorder for MocksvcOutputsGetter
+type MocksvcOutputsGetterMockRecorder struct {
+	mock *MocksvcOutputsGetter
+}
+
+// NewMocksvcOutputsGetter creates a new mock instance
+func NewMocksvcOutputsGetter(ctrl *gomock.Controller) *MocksvcOutputsGetter {
+	mock := &MocksvcOutputsGetter{ctrl: ctrl}
+	mock.recorder = &MocksvcOutputsGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MocksvcOutputsGetter) EXPECT() *MocksvcOutputsGetterMockRecorder {
+	return m.recorder
+}
+
+// Outputs mocks base method
+func (m *MocksvcOutputsGetter) Outputs() (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Outputs")
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Outputs indicates an expected call of Outputs
+func (mr *MocksvcOutputsGetterMockRecorder) Outputs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Outputs", reflect.TypeOf((*MocksvcOutputsGetter)(nil).Outputs))
+}
+
+// MockblueGreenDeployer is a mock of blueGreenDeployer interface
+type MockblueGreenDeployer struct {
+	ctrl     *gomock.Controller
+	recorder *MockblueGreenDeployerMockRecorder
+}
+
+// MockblueGreenDeployerMockRecorder is the mock recorder for MockblueGreenDeployer
+type MockblueGreenDeployerMockRecorder struct {
+	mock *MockblueGreenDeployer
+}
+
+// NewMockblueGreenDeployer creates a new mock instance
+func NewMockblueGreenDeployer(ctrl *gomock.Controller) *MockblueGreenDeployer {
+	mock := &MockblueGreenDeployer{ctrl: ctrl}
+	mock.recorder = &MockblueGreenDeployerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockblueGreenDeployer) EXPECT() *MockblueGreenDeployerMockRecorder {
+	return m.recorder
+}
+
+// CreateECSDeployment mocks base method
+func (m *MockblueGreenDeployer) CreateECSDeployment(in codedeploy.ECSDeploymentInput) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateECSDeployment", in)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateECSDeployment indicates an expected call of CreateECSDeployment
+func (mr *MockblueGreenDeployerMockRecorder) CreateECSDeployment(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateECSDeployment", reflect.TypeOf((*MockblueGreenDeployer)(nil).CreateECSDeployment), in)
+}
+
+// Deployment mocks base method
+func (m *MockblueGreenDeployer) Deployment(id string) (*codedeploy.Deployment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Deployment", id)
+	ret0, _ := ret[0].(*codedeploy.Deployment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Deployment indicates an expected call of Deployment
+func (mr *MockblueGreenDeployerMockRecorder) Deployment(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deployment", reflect.TypeOf((*MockblueGreenDeployer)(nil).Deployment), id)
+}
+
+// MockfargateQuotaGetter is a mock of fargateQuotaGetter interface
+type MockfargateQuotaGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockfargateQuotaGetterMockRecorder
+}
+
+// MockfargateQuotaGetterMockRecorder is the mock recorder for MockfargateQuotaGetter
+type MockfargateQuotaGetterMockRecorder struct {
+	mock *MockfargateQuotaGetter
+}
+
+// NewMockfargateQuotaGetter creates a new mock instance
+func NewMockfargateQuotaGetter(ctrl *gomock.Controller) *MockfargateQuotaGetter {
+	mock := &MockfargateQuotaGetter{ctrl: ctrl}
+	mock.recorder = &MockfargateQuotaGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockfargateQuotaGetter) EXPECT() *MockfargateQuotaGetterMockRecorder {
+	return m.recorder
+}
+
+// FargateVCPUQuota mocks base method
+func (m *MockfargateQuotaGetter) FargateVCPUQuota() (float64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FargateVCPUQuota")
+	ret0, _ := ret[0].(float64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FargateVCPUQuota indicates an expected call of FargateVCPUQuota
+func (mr *MockfargateQuotaGetterMockRecorder) FargateVCPUQuota() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FargateVCPUQuota", reflect.TypeOf((*MockfargateQuotaGetter)(nil).FargateVCPUQuota))
+}
+
+// MockfargateUsageGetter is a mock of fargateUsageGetter interface
+type MockfargateUsageGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockfargateUsageGetterMockRecorder
+}
+
+// MockfargateUsageGetterMockRecorder is the mock recorder for MockfargateUsageGetter
+type MockfargateUsageGetterMockRecorder struct {
+	mock *MockfargateUsageGetter
+}
+
+// NewMockfargateUsageGetter creates a new mock instance
+func NewMockfargateUsageGetter(ctrl *gomock.Controller) *MockfargateUsageGetter {
+	mock := &MockfargateUsageGetter{ctrl: ctrl}
+	mock.recorder = &MockfargateUsageGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockfargateUsageGetter) EXPECT() *MockfargateUsageGetterMockRecorder {
+	return m.recorder
+}
+
+// FargateVCPUUsage mocks base method
+func (m *MockfargateUsageGetter) FargateVCPUUsage() (float64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FargateVCPUUsage")
+	ret0, _ := ret[0].(float64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FargateVCPUUsage indicates an expected call of FargateVCPUUsage
+func (mr *MockfargateUsageGetterMockRecorder) FargateVCPUUsage() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FargateVCPUUsage", reflect.TypeOf((*MockfargateUsageGetter)(nil).FargateVCPUUsage))
+}
+
+// MocksvcDiffer is a mock of svcDiffer interface
+type MocksvcDiffer struct {
+	ctrl     *gomock.Controller
+	recorder *MocksvcDifferMockRecorder
+}
+
+// MocksvcDifferMockRecorder is the mock recorder for MocksvcDiffer
+type MocksvcDifferMockRecorder struct {
+	mock *MocksvcDiffer
+}
+
+// NewMocksvcDiffer creates a new mock instance
+func NewMocksvcDiffer(ctrl *gomock.Controller) *MocksvcDiffer {
+	mock := &MocksvcDiffer{ctrl: ctrl}
+	mock.recorder = &MocksvcDifferMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MocksvcDiffer) EXPECT() *MocksvcDifferMockRecorder {
+	return m.recorder
+}
+
+// Diff mocks base method
+func (m *MocksvcDiffer) Diff(fromEnv, toEnv string) (*describe.ServiceDiff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Diff", fromEnv, toEnv)
+	ret0, _ := ret[0].(*describe.ServiceDiff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Diff indicates an expected call of Diff
+func (mr *MocksvcDifferMockRecorder) Diff(fromEnv, toEnv interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Diff", reflect.TypeOf((*MocksvcDiffer)(nil).Diff), fromEnv, toEnv)
+}
+
+// MockappGrapher is a mock of appGrapher interface
+type MockappGrapher struct {
+	ctrl     *gomock.Controller
+	recorder *MockappGrapherMockRecorder
+}
+
+// MockappGrapherMockRecorder is the mock recorder for MockappGrapher
+type MockappGrapherMockRecorder struct {
+	mock *MockappGrapher
+}
+
+// NewMockappGrapher creates a new mock instance
+func NewMockappGrapher(ctrl *gomock.Controller) *MockappGrapher {
+	mock := &MockappGrapher{ctrl: ctrl}
+	mock.recorder = &MockappGrapherMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockdomainValidator) EXPECT() *MockdomainValidatorMockRecorder {
+func (m *MockappGrapher) EXPECT() *MockappGrapherMockRecorder {
 	return m.recorder
 }
 
-// DomainExists mocks base method
-func (m *MockdomainValidator) DomainExists(domainName string) (bool, error) {
+// Graph mocks base method
+func (m *MockappGrapher) Graph() (*describe.AppGraph, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DomainExists", domainName)
-	ret0, _ := ret[0].(bool)
+	ret := m.ctrl.Call(m, "Graph")
+	ret0, _ := ret[0].(*describe.AppGraph)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DomainExists indicates an expected call of DomainExists
-func (mr *MockdomainValidatorMockRecorder) DomainExists(domainName interface{}) *gomock.Call {
+// Graph indicates an expected call of Graph
+func (mr *MockappGrapherMockRecorder) Graph() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DomainExists", reflect.TypeOf((*MockdomainValidator)(nil).DomainExists), domainName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Graph", reflect.TypeOf((*MockappGrapher)(nil).Graph))
 }
 
-// MockdockerfileParser is a mock of dockerfileParser interface
-type MockdockerfileParser struct {
+// MockwebSvcURIDescriber is a mock of webSvcURIDescriber interface
+type MockwebSvcURIDescriber struct {
 	ctrl     *gomock.Controller
-	recorder *MockdockerfileParserMockRecorder
+	recorder *MockwebSvcURIDescriberMockRecorder
 }
 
-// MockdockerfileParserMockRecorder is the mock recorder for MockdockerfileParser
-type MockdockerfileParserMockRecorder struct {
-	mock *MockdockerfileParser
+// MockwebSvcURIDescriberMockRecorder is the mock recorder for MockwebSvcURIDescriber
+type MockwebSvcURIDescriberMockRecorder struct {
+	mock *MockwebSvcURIDescriber
 }
 
-// NewMockdockerfileParser creates a new mock instance
-func NewMockdockerfileParser(ctrl *gomock.Controller) *MockdockerfileParser {
-	mock := &MockdockerfileParser{ctrl: ctrl}
-	mock.recorder = &MockdockerfileParserMockRecorder{mock}
+// NewMockwebSvcURIDescriber creates a new mock instance
+func NewMockwebSvcURIDescriber(ctrl *gomock.Controller) *MockwebSvcURIDescriber {
+	mock := &MockwebSvcURIDescriber{ctrl: ctrl}
+	mock.recorder = &MockwebSvcURIDescriberMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockdockerfileParser) EXPECT() *MockdockerfileParserMockRecorder {
+func (m *MockwebSvcURIDescriber) EXPECT() *MockwebSvcURIDescriberMockRecorder {
 	return m.recorder
 }
 
-// GetExposedPorts mocks base method
-func (m *MockdockerfileParser) GetExposedPorts() ([]uint16, error) {
+// URI mocks base method
+func (m *MockwebSvcURIDescriber) URI(envName string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetExposedPorts")
-	ret0, _ := ret[0].([]uint16)
+	ret := m.ctrl.Call(m, "URI", envName)
+	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetExposedPorts indicates an expected call of GetExposedPorts
-func (mr *MockdockerfileParserMockRecorder) GetExposedPorts() *gomock.Call {
+// URI indicates an expected call of URI
+func (mr *MockwebSvcURIDescriberMockRecorder) URI(envName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExposedPorts", reflect.TypeOf((*MockdockerfileParser)(nil).GetExposedPorts))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "URI", reflect.TypeOf((*MockwebSvcURIDescriber)(nil).URI), envName)
 }
 
-// GetHealthCheck mocks base method
-func (m *MockdockerfileParser) GetHealthCheck() (*dockerfile.HealthCheck, error) {
+// HealthCheckURI mocks base method
+func (m *MockwebSvcURIDescriber) HealthCheckURI(envName string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetHealthCheck")
-	ret0, _ := ret[0].(*dockerfile.HealthCheck)
+	ret := m.ctrl.Call(m, "HealthCheckURI", envName)
+	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetHealthCheck indicates an expected call of GetHealthCheck
-func (mr *MockdockerfileParserMockRecorder) GetHealthCheck() *gomock.Call {
+// HealthCheckURI indicates an expected call of HealthCheckURI
+func (mr *MockwebSvcURIDescriberMockRecorder) HealthCheckURI(envName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHealthCheck", reflect.TypeOf((*MockdockerfileParser)(nil).GetHealthCheck))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HealthCheckURI", reflect.TypeOf((*MockwebSvcURIDescriber)(nil).HealthCheckURI), envName)
 }
 
-// MockserviceArnGetter is a mock of serviceArnGetter interface
-type MockserviceArnGetter struct {
+// Mockprober is a mock of prober interface
+type Mockprober struct {
 	ctrl     *gomock.Controller
-	recorder *MockserviceArnGetterMockRecorder
+	recorder *MockproberMockRecorder
 }
 
-// MockserviceArnGetterMockRecorder is the mock recorder for MockserviceArnGetter
-type MockserviceArnGetterMockRecorder struct {
-	mock *MockserviceArnGetter
+// MockproberMockRecorder is the mock recorder for Mockprober
+type MockproberMockRecorder struct {
+	mock *Mockprober
 }
 
-// NewMockserviceArnGetter creates a new mock instance
-func NewMockserviceArnGetter(ctrl *gomock.Controller) *MockserviceArnGetter {
-	mock := &MockserviceArnGetter{ctrl: ctrl}
-	mock.recorder = &MockserviceArnGetterMockRecorder{mock}
+// NewMockprober creates a new mock instance
+func NewMockprober(ctrl *gomock.Controller) *Mockprober {
+	mock := &Mockprober{ctrl: ctrl}
+	mock.recorder = &MockproberMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockserviceArnGetter) EXPECT() *MockserviceArnGetterMockRecorder {
+func (m *Mockprober) EXPECT() *MockproberMockRecorder {
 	return m.recorder
 }
 
-// GetServiceArn mocks base method
-func (m *MockserviceArnGetter) GetServiceArn() (*ecs.ServiceArn, error) {
+// Probe mocks base method
+func (m *Mockprober) Probe(url string) probe.Result {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetServiceArn")
-	ret0, _ := ret[0].(*ecs.ServiceArn)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "Probe", url)
+	ret0, _ := ret[0].(probe.Result)
+	return ret0
 }
 
-// GetServiceArn indicates an expected call of GetServiceArn
-func (mr *MockserviceArnGetterMockRecorder) GetServiceArn() *gomock.Call {
+// Probe indicates an expected call of Probe
+func (mr *MockproberMockRecorder) Probe(url interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceArn", reflect.TypeOf((*MockserviceArnGetter)(nil).GetServiceArn))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Probe", reflect.TypeOf((*Mockprober)(nil).Probe), url)
 }
 
-// MockstatusDescriber is a mock of statusDescriber interface
-type MockstatusDescriber struct {
+// MocktargetsHealthDescriber is a mock of targetsHealthDescriber interface
+type MocktargetsHealthDescriber struct {
 	ctrl     *gomock.Controller
-	recorder *MockstatusDescriberMockRecorder
+	recorder *MocktargetsHealthDescriberMockRecorder
 }
 
-// MockstatusDescriberMockRecorder is the mock recorder for MockstatusDescriber
-type MockstatusDescriberMockRecorder struct {
-	mock *MockstatusDescriber
+// MocktargetsHealthDescriberMockRecorder is the mock recorder for MocktargetsHealthDescriber
+type MocktargetsHealthDescriberMockRecorder struct {
+	mock *MocktargetsHealthDescriber
 }
 
-// NewMockstatusDescriber creates a new mock instance
-func NewMockstatusDescriber(ctrl *gomock.Controller) *MockstatusDescriber {
-	mock := &MockstatusDescriber{ctrl: ctrl}
-	mock.recorder = &MockstatusDescriberMockRecorder{mock}
+// NewMocktargetsHealthDescriber creates a new mock instance
+func NewMocktargetsHealthDescriber(ctrl *gomock.Controller) *MocktargetsHealthDescriber {
+	mock := &MocktargetsHealthDescriber{ctrl: ctrl}
+	mock.recorder = &MocktargetsHealthDescriberMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockstatusDescriber) EXPECT() *MockstatusDescriberMockRecorder {
+func (m *MocktargetsHealthDescriber) EXPECT() *MocktargetsHealthDescriberMockRecorder {
 	return m.recorder
 }
 
-// Describe mocks base method
-func (m *MockstatusDescriber) Describe() (*describe.ServiceStatusDesc, error) {
+// TargetsHealth mocks base method
+func (m *MocktargetsHealthDescriber) TargetsHealth(targetGroupARN string) ([]*elbv2.TargetHealth, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Describe")
-	ret0, _ := ret[0].(*describe.ServiceStatusDesc)
+	ret := m.ctrl.Call(m, "TargetsHealth", targetGroupARN)
+	ret0, _ := ret[0].([]*elbv2.TargetHealth)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Describe indicates an expected call of Describe
-func (mr *MockstatusDescriberMockRecorder) Describe() *gomock.Call {
+// TargetsHealth indicates an expected call of TargetsHealth
+func (mr *MocktargetsHealthDescriberMockRecorder) TargetsHealth(targetGroupARN interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockstatusDescriber)(nil).Describe))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TargetsHealth", reflect.TypeOf((*MocktargetsHealthDescriber)(nil).TargetsHealth), targetGroupARN)
 }
 
 // MockenvDescriber is a mock of envDescriber interface
@@ -3435,18 +5584,56 @@ func (m *MockenvDescriber) EXPECT() *MockenvDescriberMockRecorder {
 }
 
 // Describe mocks base method
-func (m *MockenvDescriber) Describe() (*describe.EnvDescription, error) {
+func (m *MockenvDescriber) Describe(ctx context.Context) (*describe.EnvDescription, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Describe")
+	ret := m.ctrl.Call(m, "Describe", ctx)
 	ret0, _ := ret[0].(*describe.EnvDescription)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Describe indicates an expected call of Describe
-func (mr *MockenvDescriberMockRecorder) Describe() *gomock.Call {
+func (mr *MockenvDescriberMockRecorder) Describe(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockenvDescriber)(nil).Describe), ctx)
+}
+
+// MockstackResourcesDescriber is a mock of stackResourcesDescriber interface
+type MockstackResourcesDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockstackResourcesDescriberMockRecorder
+}
+
+// MockstackResourcesDescriberMockRecorder is the mock recorder for MockstackResourcesDescriber
+type MockstackResourcesDescriberMockRecorder struct {
+	mock *MockstackResourcesDescriber
+}
+
+// NewMockstackResourcesDescriber creates a new mock instance
+func NewMockstackResourcesDescriber(ctrl *gomock.Controller) *MockstackResourcesDescriber {
+	mock := &MockstackResourcesDescriber{ctrl: ctrl}
+	mock.recorder = &MockstackResourcesDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockstackResourcesDescriber) EXPECT() *MockstackResourcesDescriberMockRecorder {
+	return m.recorder
+}
+
+// Describe mocks base method
+func (m *MockstackResourcesDescriber) Describe() (*describe.StackResourcesDesc, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Describe")
+	ret0, _ := ret[0].(*describe.StackResourcesDesc)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Describe indicates an expected call of Describe
+func (mr *MockstackResourcesDescriberMockRecorder) Describe() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockenvDescriber)(nil).Describe))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockstackResourcesDescriber)(nil).Describe))
 }
 
 // MockresourceGroupsClient is a mock of resourceGroupsClient interface
@@ -4014,46 +6201,335 @@ func (m *Mockec2Selector) EXPECT() *Mockec2SelectorMockRecorder {
 }
 
 // VPC mocks base method
-func (m *Mockec2Selector) VPC(prompt, help string) (string, error) {
+func (m *Mockec2Selector) VPC(ctx context.Context, prompt, help string, filters ...ec2.Filter) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "VPC", prompt, help)
+	varargs := []interface{}{ctx, prompt, help}
+	for _, a := range filters {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "VPC", varargs...)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // VPC indicates an expected call of VPC
-func (mr *Mockec2SelectorMockRecorder) VPC(prompt, help interface{}) *gomock.Call {
+func (mr *Mockec2SelectorMockRecorder) VPC(ctx, prompt, help interface{}, filters ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VPC", reflect.TypeOf((*Mockec2Selector)(nil).VPC), prompt, help)
+	varargs := append([]interface{}{ctx, prompt, help}, filters...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VPC", reflect.TypeOf((*Mockec2Selector)(nil).VPC), varargs...)
 }
 
 // PublicSubnets mocks base method
-func (m *Mockec2Selector) PublicSubnets(prompt, help, vpcID string) ([]string, error) {
+func (m *Mockec2Selector) PublicSubnets(ctx context.Context, prompt, help, vpcID string) ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PublicSubnets", prompt, help, vpcID)
+	ret := m.ctrl.Call(m, "PublicSubnets", ctx, prompt, help, vpcID)
 	ret0, _ := ret[0].([]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // PublicSubnets indicates an expected call of PublicSubnets
-func (mr *Mockec2SelectorMockRecorder) PublicSubnets(prompt, help, vpcID interface{}) *gomock.Call {
+func (mr *Mockec2SelectorMockRecorder) PublicSubnets(ctx, prompt, help, vpcID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublicSubnets", reflect.TypeOf((*Mockec2Selector)(nil).PublicSubnets), prompt, help, vpcID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublicSubnets", reflect.TypeOf((*Mockec2Selector)(nil).PublicSubnets), ctx, prompt, help, vpcID)
 }
 
 // PrivateSubnets mocks base method
-func (m *Mockec2Selector) PrivateSubnets(prompt, help, vpcID string) ([]string, error) {
+func (m *Mockec2Selector) PrivateSubnets(ctx context.Context, prompt, help, vpcID string) ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PrivateSubnets", prompt, help, vpcID)
+	ret := m.ctrl.Call(m, "PrivateSubnets", ctx, prompt, help, vpcID)
 	ret0, _ := ret[0].([]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // PrivateSubnets indicates an expected call of PrivateSubnets
-func (mr *Mockec2SelectorMockRecorder) PrivateSubnets(prompt, help, vpcID interface{}) *gomock.Call {
+func (mr *Mockec2SelectorMockRecorder) PrivateSubnets(ctx, prompt, help, vpcID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrivateSubnets", reflect.TypeOf((*Mockec2Selector)(nil).PrivateSubnets), ctx, prompt, help, vpcID)
+}
+
+// MocksubnetsDescriber is a mock of subnetsDescriber interface
+type MocksubnetsDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MocksubnetsDescriberMockRecorder
+}
+
+// MocksubnetsDescriberMockRecorder is the mock recorder for MocksubnetsDescriber
+type MocksubnetsDescriberMockRecorder struct {
+	mock *MocksubnetsDescriber
+}
+
+// NewMocksubnetsDescriber creates a new mock instance
+func NewMocksubnetsDescriber(ctrl *gomock.Controller) *MocksubnetsDescriber {
+	mock := &MocksubnetsDescriber{ctrl: ctrl}
+	mock.recorder = &MocksubnetsDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MocksubnetsDescriber) EXPECT() *MocksubnetsDescriberMockRecorder {
+	return m.recorder
+}
+
+// SubnetsDetailed mocks base method
+func (m *MocksubnetsDescriber) SubnetsDetailed(ctx context.Context, filters ...ec2.Filter) ([]ec2.Subnet, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range filters {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SubnetsDetailed", varargs...)
+	ret0, _ := ret[0].([]ec2.Subnet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubnetsDetailed indicates an expected call of SubnetsDetailed
+func (mr *MocksubnetsDescriberMockRecorder) SubnetsDetailed(ctx interface{}, filters ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, filters...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubnetsDetailed", reflect.TypeOf((*MocksubnetsDescriber)(nil).SubnetsDetailed), varargs...)
+}
+
+// ListAvailabilityZones mocks base method
+func (m *MocksubnetsDescriber) ListAvailabilityZones(ctx context.Context, filters ...ec2.Filter) ([]string, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range filters {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAvailabilityZones", varargs...)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAvailabilityZones indicates an expected call of ListAvailabilityZones
+func (mr *MocksubnetsDescriberMockRecorder) ListAvailabilityZones(ctx interface{}, filters ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, filters...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAvailabilityZones", reflect.TypeOf((*MocksubnetsDescriber)(nil).ListAvailabilityZones), varargs...)
+}
+
+// MocksubnetIPsGetter is a mock of subnetIPsGetter interface
+type MocksubnetIPsGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MocksubnetIPsGetterMockRecorder
+}
+
+// MocksubnetIPsGetterMockRecorder is the mock recorder for MocksubnetIPsGetter
+type MocksubnetIPsGetterMockRecorder struct {
+	mock *MocksubnetIPsGetter
+}
+
+// NewMocksubnetIPsGetter creates a new mock instance
+func NewMocksubnetIPsGetter(ctrl *gomock.Controller) *MocksubnetIPsGetter {
+	mock := &MocksubnetIPsGetter{ctrl: ctrl}
+	mock.recorder = &MocksubnetIPsGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MocksubnetIPsGetter) EXPECT() *MocksubnetIPsGetterMockRecorder {
+	return m.recorder
+}
+
+// SubnetsAvailableIPs mocks base method
+func (m *MocksubnetIPsGetter) SubnetsAvailableIPs(ctx context.Context, subnetIDs ...string) (map[string]int, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range subnetIDs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SubnetsAvailableIPs", varargs...)
+	ret0, _ := ret[0].(map[string]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubnetsAvailableIPs indicates an expected call of SubnetsAvailableIPs
+func (mr *MocksubnetIPsGetterMockRecorder) SubnetsAvailableIPs(ctx interface{}, subnetIDs ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, subnetIDs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubnetsAvailableIPs", reflect.TypeOf((*MocksubnetIPsGetter)(nil).SubnetsAvailableIPs), varargs...)
+}
+
+// MockvpcEndpointsLister is a mock of vpcEndpointsLister interface
+type MockvpcEndpointsLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockvpcEndpointsListerMockRecorder
+}
+
+// MockvpcEndpointsListerMockRecorder is the mock recorder for MockvpcEndpointsLister
+type MockvpcEndpointsListerMockRecorder struct {
+	mock *MockvpcEndpointsLister
+}
+
+// NewMockvpcEndpointsLister creates a new mock instance
+func NewMockvpcEndpointsLister(ctrl *gomock.Controller) *MockvpcEndpointsLister {
+	mock := &MockvpcEndpointsLister{ctrl: ctrl}
+	mock.recorder = &MockvpcEndpointsListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockvpcEndpointsLister) EXPECT() *MockvpcEndpointsListerMockRecorder {
+	return m.recorder
+}
+
+// ListVPCEndpoints mocks base method
+func (m *MockvpcEndpointsLister) ListVPCEndpoints(ctx context.Context, vpcID string) ([]ec2.VPCEndpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVPCEndpoints", ctx, vpcID)
+	ret0, _ := ret[0].([]ec2.VPCEndpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVPCEndpoints indicates an expected call of ListVPCEndpoints
+func (mr *MockvpcEndpointsListerMockRecorder) ListVPCEndpoints(ctx, vpcID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVPCEndpoints", reflect.TypeOf((*MockvpcEndpointsLister)(nil).ListVPCEndpoints), ctx, vpcID)
+}
+
+// MockvpcDNSConfigurer is a mock of vpcDNSConfigurer interface
+type MockvpcDNSConfigurer struct {
+	ctrl     *gomock.Controller
+	recorder *MockvpcDNSConfigurerMockRecorder
+}
+
+// MockvpcDNSConfigurerMockRecorder is the mock recorder for MockvpcDNSConfigurer
+type MockvpcDNSConfigurerMockRecorder struct {
+	mock *MockvpcDNSConfigurer
+}
+
+// NewMockvpcDNSConfigurer creates a new mock instance
+func NewMockvpcDNSConfigurer(ctrl *gomock.Controller) *MockvpcDNSConfigurer {
+	mock := &MockvpcDNSConfigurer{ctrl: ctrl}
+	mock.recorder = &MockvpcDNSConfigurerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockvpcDNSConfigurer) EXPECT() *MockvpcDNSConfigurerMockRecorder {
+	return m.recorder
+}
+
+// VPCDNSAttributes mocks base method
+func (m *MockvpcDNSConfigurer) VPCDNSAttributes(ctx context.Context, vpcID string) (*ec2.VPCDNSAttributes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VPCDNSAttributes", ctx, vpcID)
+	ret0, _ := ret[0].(*ec2.VPCDNSAttributes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VPCDNSAttributes indicates an expected call of VPCDNSAttributes
+func (mr *MockvpcDNSConfigurerMockRecorder) VPCDNSAttributes(ctx, vpcID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VPCDNSAttributes", reflect.TypeOf((*MockvpcDNSConfigurer)(nil).VPCDNSAttributes), ctx, vpcID)
+}
+
+// EnableVPCDNS mocks base method
+func (m *MockvpcDNSConfigurer) EnableVPCDNS(ctx context.Context, vpcID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableVPCDNS", ctx, vpcID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnableVPCDNS indicates an expected call of EnableVPCDNS
+func (mr *MockvpcDNSConfigurerMockRecorder) EnableVPCDNS(ctx, vpcID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableVPCDNS", reflect.TypeOf((*MockvpcDNSConfigurer)(nil).EnableVPCDNS), ctx, vpcID)
+}
+
+// UsesAmazonProvidedDNS mocks base method
+func (m *MockvpcDNSConfigurer) UsesAmazonProvidedDNS(ctx context.Context, vpcID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UsesAmazonProvidedDNS", ctx, vpcID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UsesAmazonProvidedDNS indicates an expected call of UsesAmazonProvidedDNS
+func (mr *MockvpcDNSConfigurerMockRecorder) UsesAmazonProvidedDNS(ctx, vpcID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UsesAmazonProvidedDNS", reflect.TypeOf((*MockvpcDNSConfigurer)(nil).UsesAmazonProvidedDNS), ctx, vpcID)
+}
+
+// MockvpcEgressDescriber is a mock of vpcEgressDescriber interface
+type MockvpcEgressDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockvpcEgressDescriberMockRecorder
+}
+
+// MockvpcEgressDescriberMockRecorder is the mock recorder for MockvpcEgressDescriber
+type MockvpcEgressDescriberMockRecorder struct {
+	mock *MockvpcEgressDescriber
+}
+
+// NewMockvpcEgressDescriber creates a new mock instance
+func NewMockvpcEgressDescriber(ctrl *gomock.Controller) *MockvpcEgressDescriber {
+	mock := &MockvpcEgressDescriber{ctrl: ctrl}
+	mock.recorder = &MockvpcEgressDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockvpcEgressDescriber) EXPECT() *MockvpcEgressDescriberMockRecorder {
+	return m.recorder
+}
+
+// SubnetsWithoutEgress mocks base method
+func (m *MockvpcEgressDescriber) SubnetsWithoutEgress(ctx context.Context, subnetIDs ...string) ([]string, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range subnetIDs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SubnetsWithoutEgress", varargs...)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubnetsWithoutEgress indicates an expected call of SubnetsWithoutEgress
+func (mr *MockvpcEgressDescriberMockRecorder) SubnetsWithoutEgress(ctx interface{}, subnetIDs ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, subnetIDs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubnetsWithoutEgress", reflect.TypeOf((*MockvpcEgressDescriber)(nil).SubnetsWithoutEgress), varargs...)
+}
+
+// ListNATGateways mocks base method
+func (m *MockvpcEgressDescriber) ListNATGateways(ctx context.Context, vpcID string) ([]ec2.NATGateway, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNATGateways", ctx, vpcID)
+	ret0, _ := ret[0].([]ec2.NATGateway)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNATGateways indicates an expected call of ListNATGateways
+func (mr *MockvpcEgressDescriberMockRecorder) ListNATGateways(ctx, vpcID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNATGateways", reflect.TypeOf((*MockvpcEgressDescriber)(nil).ListNATGateways), ctx, vpcID)
+}
+
+// ListElasticIPs mocks base method
+func (m *MockvpcEgressDescriber) ListElasticIPs(ctx context.Context) ([]ec2.ElasticIP, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListElasticIPs", ctx)
+	ret0, _ := ret[0].([]ec2.ElasticIP)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListElasticIPs indicates an expected call of ListElasticIPs
+func (mr *MockvpcEgressDescriberMockRecorder) ListElasticIPs(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrivateSubnets", reflect.TypeOf((*Mockec2Selector)(nil).PrivateSubnets), prompt, help, vpcID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListElasticIPs", reflect.TypeOf((*MockvpcEgressDescriber)(nil).ListElasticIPs), ctx)
 }
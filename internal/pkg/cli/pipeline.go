@@ -4,11 +4,20 @@
 package cli
 
 import (
+	"errors"
+	"fmt"
+
 	"github.com/aws/copilot-cli/cmd/copilot/template"
 	"github.com/aws/copilot-cli/internal/pkg/cli/group"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
 	"github.com/spf13/cobra"
 )
 
+const (
+	pipelineSelectManifestPrompt     = "Which pipeline manifest would you like to use?"
+	pipelineSelectManifestHelpPrompt = "A pipeline manifest under copilot/pipelines/ that defines the source and stages of a pipeline."
+)
+
 // BuildPipelineCmd is the top level command for pipelines
 func BuildPipelineCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -20,6 +29,7 @@ Continuous delivery pipelines to release services.`,
 	}
 
 	cmd.AddCommand(BuildPipelineInitCmd())
+	cmd.AddCommand(BuildPipelineListCmd())
 	cmd.AddCommand(BuildPipelineUpdateCmd())
 	cmd.AddCommand(BuildPipelineDeleteCmd())
 	cmd.AddCommand(BuildPipelineShowCmd())
@@ -32,3 +42,33 @@ Continuous delivery pipelines to release services.`,
 
 	return cmd
 }
+
+// readPipelineManifest returns the contents of the pipeline manifest in the workspace.
+// If name is provided, it reads the manifest under copilot/pipelines/{name}.yml.
+// Otherwise, it reads copilot/pipeline.yml and falls back to the manifests under copilot/pipelines/,
+// prompting the user to select one if there are several.
+func readPipelineManifest(ws wsPipelineManifestReader, prompt prompter, name string) ([]byte, error) {
+	if name != "" {
+		return ws.ReadNamedPipelineManifest(name)
+	}
+	data, err := ws.ReadPipelineManifest()
+	if !errors.Is(err, workspace.ErrNoPipelineInWorkspace) {
+		return data, err
+	}
+	names, err := ws.PipelineManifestNames()
+	if err != nil {
+		return nil, fmt.Errorf("list pipeline manifests: %w", err)
+	}
+	switch len(names) {
+	case 0:
+		return nil, workspace.ErrNoPipelineInWorkspace
+	case 1:
+		name = names[0]
+	default:
+		name, err = prompt.SelectOne(pipelineSelectManifestPrompt, pipelineSelectManifestHelpPrompt, names)
+		if err != nil {
+			return nil, fmt.Errorf("select pipeline manifest: %w", err)
+		}
+	}
+	return ws.ReadNamedPipelineManifest(name)
+}
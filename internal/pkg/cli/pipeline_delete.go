@@ -35,6 +35,7 @@ var (
 
 type deletePipelineVars struct {
 	*GlobalOpts
+	ManifestName     string
 	SkipConfirmation bool
 	DeleteSecret     bool
 }
@@ -127,7 +128,7 @@ func (o *deletePipelineOpts) Execute() error {
 }
 
 func (o *deletePipelineOpts) readPipelineManifest() error {
-	data, err := o.ws.ReadPipelineManifest()
+	data, err := readPipelineManifest(o.ws, o.prompt, o.ManifestName)
 	if err != nil {
 		if err == workspace.ErrNoPipelineInWorkspace {
 			return err
@@ -213,7 +214,9 @@ func BuildPipelineDeleteCmd() *cobra.Command {
 		Short: "Deletes the pipeline associated with your workspace.",
 		Example: `
   Delete the pipeline associated with your workspace.
-  /code $ copilot pipeline delete`,
+  /code $ copilot pipeline delete
+  Delete the pipeline defined in copilot/pipelines/release.yml.
+  /code $ copilot pipeline delete --name release`,
 
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newDeletePipelineOpts(vars)
@@ -223,6 +226,7 @@ func BuildPipelineDeleteCmd() *cobra.Command {
 			return opts.Run()
 		}),
 	}
+	cmd.Flags().StringVarP(&vars.ManifestName, nameFlag, nameFlagShort, "", pipelineManifestFlagDescription)
 	cmd.Flags().BoolVar(&vars.SkipConfirmation, yesFlag, false, yesFlagDescription)
 	cmd.Flags().BoolVar(&vars.DeleteSecret, deleteSecretFlag, false, deleteSecretFlagDescription)
 	return cmd
@@ -50,8 +50,9 @@ stages:
 `
 
 	testCases := map[string]struct {
-		inAppName string
-		callMocks func(m deletePipelineMocks)
+		inAppName      string
+		inManifestName string
+		callMocks      func(m deletePipelineMocks)
 
 		wantedError error
 	}{
@@ -63,10 +64,20 @@ stages:
 			wantedError: nil,
 		},
 
+		"reads the named pipeline manifest": {
+			inAppName:      testAppName,
+			inManifestName: "release",
+			callMocks: func(m deletePipelineMocks) {
+				m.ws.EXPECT().ReadNamedPipelineManifest("release").Return([]byte(pipelineData), nil)
+			},
+			wantedError: nil,
+		},
+
 		"pipeline manifest does not exist": {
 			inAppName: testAppName,
 			callMocks: func(m deletePipelineMocks) {
 				m.ws.EXPECT().ReadPipelineManifest().Return(nil, workspace.ErrNoPipelineInWorkspace)
+				m.ws.EXPECT().PipelineManifestNames().Return(nil, nil)
 			},
 
 			wantedError: workspace.ErrNoPipelineInWorkspace,
@@ -98,6 +109,7 @@ stages:
 					GlobalOpts: &GlobalOpts{
 						appName: tc.inAppName,
 					},
+					ManifestName: tc.inManifestName,
 				},
 				ws: mockWorkspace,
 			}
@@ -55,6 +55,7 @@ var (
 var errNoEnvsInApp = errors.New("there were no more environments found that can be added to your pipeline. Please run `copilot env init` to create a new environment")
 
 type initPipelineVars struct {
+	Name              string
 	Environments      []string
 	GitHubOwner       string
 	GitHubRepo        string
@@ -152,6 +153,11 @@ func (o *initPipelineOpts) Validate() error {
 	if o.AppName() == "" {
 		return errNoAppInWorkspace
 	}
+	if o.Name != "" {
+		if err := validatePipelineName(o.Name); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
@@ -226,15 +232,25 @@ func (o *initPipelineOpts) RecommendedActions() []string {
 		"Commit and push the generated buildspec and manifest file.",
 		fmt.Sprintf("Update the %s phase of your buildspec to unit test your services before pushing the images.", color.HighlightResource("build")),
 		fmt.Sprint("Update your pipeline manifest to add additional stages."),
-		fmt.Sprintf("Run %s to deploy your pipeline for the repository.", color.HighlightCode("copilot pipeline update")),
+		fmt.Sprintf("Run %s to deploy your pipeline for the repository.", color.HighlightCode(o.pipelineUpdateCmd())),
 	}
 }
 
+func (o *initPipelineOpts) pipelineUpdateCmd() string {
+	if o.Name != "" {
+		return fmt.Sprintf("copilot pipeline update --%s %s", nameFlag, o.Name)
+	}
+	return "copilot pipeline update"
+}
+
 func (o *initPipelineOpts) createSecretName() string {
 	return fmt.Sprintf("github-token-%s-%s", o.appName, o.GitHubRepo)
 }
 
 func (o *initPipelineOpts) createPipelineName() string {
+	if o.Name != "" {
+		return fmt.Sprintf("pipeline-%s-%s-%s-%s", o.appName, o.GitHubOwner, o.GitHubRepo, o.Name)
+	}
 	return fmt.Sprintf("pipeline-%s-%s-%s", o.appName, o.GitHubOwner, o.GitHubRepo)
 }
 
@@ -260,7 +276,12 @@ func (o *initPipelineOpts) createPipelineManifest() error {
 	}
 
 	var manifestExists bool
-	manifestPath, err := o.workspace.WritePipelineManifest(manifest)
+	var manifestPath string
+	if o.Name != "" {
+		manifestPath, err = o.workspace.WriteNamedPipelineManifest(manifest, o.Name)
+	} else {
+		manifestPath, err = o.workspace.WritePipelineManifest(manifest)
+	}
 	if err != nil {
 		e, ok := err.(*workspace.ErrFileExists)
 		if !ok {
@@ -511,7 +532,11 @@ func BuildPipelineInitCmd() *cobra.Command {
   /code $ copilot pipeline init \
   /code  --github-url https://github.com/gitHubUserName/myFrontendApp.git \
   /code  --github-access-token file://myGitHubToken \
-  /code  --environments "stage,prod"`,
+  /code  --environments "stage,prod"
+  Create an additional pipeline triggered by pushes to the "release" branch.
+  /code $ copilot pipeline init --name release \
+  /code  --git-branch release \
+  /code  --environments "prod"`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newInitPipelineOpts(vars)
 			if err != nil {
@@ -534,6 +559,7 @@ func BuildPipelineInitCmd() *cobra.Command {
 			return nil
 		}),
 	}
+	cmd.Flags().StringVarP(&vars.Name, nameFlag, nameFlagShort, "", pipelineInitNameFlagDescription)
 	cmd.Flags().StringVarP(&vars.GitHubURL, githubURLFlag, githubURLFlagShort, "", githubURLFlagDescription)
 	cmd.Flags().StringVarP(&vars.GitHubAccessToken, githubAccessTokenFlag, githubAccessTokenFlagShort, "", githubAccessTokenFlagDescription)
 	cmd.Flags().StringVarP(&vars.GitBranch, gitBranchFlag, gitBranchFlagShort, "", gitBranchFlagDescription)
@@ -259,6 +259,7 @@ func TestInitPipelineOpts_Ask(t *testing.T) {
 func TestInitPipelineOpts_Validate(t *testing.T) {
 	testCases := map[string]struct {
 		inAppName string
+		inName    string
 
 		expectedError error
 	}{
@@ -266,6 +267,15 @@ func TestInitPipelineOpts_Validate(t *testing.T) {
 			inAppName:     "",
 			expectedError: errNoAppInWorkspace,
 		},
+		"invalid pipeline name": {
+			inAppName:     "badgoose",
+			inName:        "Release",
+			expectedError: fmt.Errorf("pipeline name Release is invalid: %w", errValueBadFormat),
+		},
+		"valid pipeline name": {
+			inAppName: "badgoose",
+			inName:    "release",
+		},
 	}
 
 	for name, tc := range testCases {
@@ -276,6 +286,7 @@ func TestInitPipelineOpts_Validate(t *testing.T) {
 
 			opts := &initPipelineOpts{
 				initPipelineVars: initPipelineVars{
+					Name:       tc.inName,
 					GlobalOpts: &GlobalOpts{appName: tc.inAppName},
 				},
 			}
@@ -285,7 +296,7 @@ func TestInitPipelineOpts_Validate(t *testing.T) {
 
 			// THEN
 			if tc.expectedError != nil {
-				require.Equal(t, tc.expectedError, err)
+				require.EqualError(t, err, tc.expectedError.Error())
 			} else {
 				require.NoError(t, err)
 			}
@@ -297,6 +308,7 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 	buildspecExistsErr := &workspace.ErrFileExists{FileName: "/buildspec.yml"}
 	manifestExistsErr := &workspace.ErrFileExists{FileName: "/pipeline.yml"}
 	testCases := map[string]struct {
+		inName         string
 		inEnvironments []string
 		inGitHubToken  string
 		inGitHubRepo   string
@@ -348,6 +360,43 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 			},
 			expectedError: nil,
 		},
+		"writes the manifest under the pipelines directory if a name is provided": {
+			inName:         "release",
+			inEnvironments: []string{"prod"},
+			inGitHubToken:  "hunter2",
+			inGitHubRepo:   "goose",
+			inGitBranch:    "release",
+			inAppName:      "badgoose",
+
+			mockSecretsManager: func(m *mocks.MocksecretsManager) {
+				m.EXPECT().CreateSecret("github-token-badgoose-goose", "hunter2").Return("some-arn", nil)
+			},
+			mockWsWriter: func(m *mocks.MockwsPipelineWriter) {
+				m.EXPECT().WriteNamedPipelineManifest(gomock.Any(), "release").Return("/pipelines/release.yml", nil)
+				m.EXPECT().WritePipelineBuildspec(gomock.Any()).Return("", buildspecExistsErr)
+			},
+			mockParser: func(m *templatemocks.MockParser) {
+				m.EXPECT().Parse(buildspecTemplatePath, gomock.Any()).Return(&template.Content{
+					Buffer: bytes.NewBufferString("hello"),
+				}, nil)
+			},
+			mockStoreSvc: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("badgoose").Return(&config.Application{
+					Name: "badgoose",
+				}, nil)
+			},
+			mockRegionalResourcesGetter: func(m *mocks.MockappResourcesGetter) {
+				m.EXPECT().GetRegionalAppResources(&config.Application{
+					Name: "badgoose",
+				}).Return([]*stack.AppRegionalResources{
+					{
+						Region:   "us-west-2",
+						S3Bucket: "gooseBucket",
+					},
+				}, nil)
+			},
+			expectedError: nil,
+		},
 		"does not return an error if secret already exists": {
 			inEnvironments: []string{"test"},
 			inGitHubToken:  "hunter2",
@@ -579,6 +628,7 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 
 			opts := &initPipelineOpts{
 				initPipelineVars: initPipelineVars{
+					Name:              tc.inName,
 					Environments:      tc.inEnvironments,
 					GitHubRepo:        tc.inGitHubRepo,
 					GitHubAccessToken: tc.inGitHubToken,
@@ -609,6 +659,7 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 
 func TestInitPipelineOpts_createPipelineName(t *testing.T) {
 	testCases := map[string]struct {
+		inName       string
 		inGitHubRepo string
 		inAppName    string
 		inAppOwner   string
@@ -622,6 +673,14 @@ func TestInitPipelineOpts_createPipelineName(t *testing.T) {
 
 			expected: "pipeline-badgoose-david-goose",
 		},
+		"appends the pipeline name": {
+			inName:       "release",
+			inGitHubRepo: "goose",
+			inAppName:    "badgoose",
+			inAppOwner:   "david",
+
+			expected: "pipeline-badgoose-david-goose-release",
+		},
 	}
 
 	for name, tc := range testCases {
@@ -629,6 +688,7 @@ func TestInitPipelineOpts_createPipelineName(t *testing.T) {
 			// GIVEN
 			opts := &initPipelineOpts{
 				initPipelineVars: initPipelineVars{
+					Name:        tc.inName,
 					GitHubRepo:  tc.inGitHubRepo,
 					GlobalOpts:  &GlobalOpts{appName: tc.inAppName},
 					GitHubOwner: tc.inAppOwner,
@@ -0,0 +1,145 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	pipelineListAppNamePrompt = "Which application's pipelines would you like to list?"
+	pipelineListAppNameHelper = "An application is a collection of related services."
+)
+
+type listPipelineVars struct {
+	*GlobalOpts
+	ShouldOutputJSON bool
+}
+
+type listPipelineOpts struct {
+	listPipelineVars
+
+	// Interfaces to dependencies.
+	store       applicationGetter
+	pipelineSvc pipelineGetter
+	sel         appSelector
+	w           io.Writer
+}
+
+func newListPipelineOpts(vars listPipelineVars) (*listPipelineOpts, error) {
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("new config store client: %w", err)
+	}
+	defaultSession, err := sessions.NewProvider().Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %w", err)
+	}
+	return &listPipelineOpts{
+		listPipelineVars: vars,
+		store:            store,
+		pipelineSvc:      codepipeline.New(defaultSession),
+		sel:              selector.NewSelect(vars.prompt, store),
+		w:                os.Stdout,
+	}, nil
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *listPipelineOpts) Ask() error {
+	if o.AppName() != "" {
+		return nil
+	}
+	app, err := o.sel.Application(pipelineListAppNamePrompt, pipelineListAppNameHelper)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+// Execute lists the pipelines deployed for the application.
+func (o *listPipelineOpts) Execute() error {
+	// Ensure the application actually exists before we try to list its pipelines.
+	if _, err := o.store.GetApplication(o.AppName()); err != nil {
+		return fmt.Errorf("get application %s: %w", o.AppName(), err)
+	}
+
+	pipelines, err := o.pipelineSvc.ListPipelineNamesByTags(map[string]string{
+		deploy.AppTagKey: o.AppName(),
+	})
+	if err != nil {
+		return fmt.Errorf("list pipelines: %w", err)
+	}
+
+	var out string
+	if o.ShouldOutputJSON {
+		data, err := o.jsonOutput(pipelines)
+		if err != nil {
+			return err
+		}
+		out = data
+	} else {
+		out = o.humanOutput(pipelines)
+	}
+	fmt.Fprint(o.w, out)
+
+	return nil
+}
+
+func (o *listPipelineOpts) humanOutput(pipelines []string) string {
+	b := &strings.Builder{}
+	for _, pipeline := range pipelines {
+		fmt.Fprintln(b, pipeline)
+	}
+	return b.String()
+}
+
+func (o *listPipelineOpts) jsonOutput(pipelines []string) (string, error) {
+	type serializedPipelines struct {
+		Pipelines []string `json:"pipelines"`
+	}
+	b, err := json.Marshal(serializedPipelines{Pipelines: pipelines})
+	if err != nil {
+		return "", fmt.Errorf("marshal pipelines: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// BuildPipelineListCmd builds the command for listing the pipelines of an application.
+func BuildPipelineListCmd() *cobra.Command {
+	vars := listPipelineVars{
+		GlobalOpts: NewGlobalOpts(),
+	}
+	cmd := &cobra.Command{
+		Use:   "ls",
+		Short: "Lists all the deployed pipelines in an application.",
+		Example: `
+  Lists all the pipelines for the frontend application.
+  /code $ copilot pipeline ls -a frontend`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newListPipelineOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, "", appFlagDescription)
+	cmd.Flags().BoolVar(&vars.ShouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
@@ -0,0 +1,163 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPipelineList_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inputApp string
+
+		mockSelector func(m *mocks.MockappSelector)
+
+		wantedApp string
+		wantedErr error
+	}{
+		"with no flags set": {
+			mockSelector: func(m *mocks.MockappSelector) {
+				m.EXPECT().Application(pipelineListAppNamePrompt, pipelineListAppNameHelper).Return("my-app", nil)
+			},
+			wantedApp: "my-app",
+		},
+		"with app flag set": {
+			inputApp:     "my-app",
+			mockSelector: func(m *mocks.MockappSelector) {},
+			wantedApp:    "my-app",
+		},
+		"error if fail to select app": {
+			mockSelector: func(m *mocks.MockappSelector) {
+				m.EXPECT().Application(pipelineListAppNamePrompt, pipelineListAppNameHelper).Return("", errors.New("some error"))
+			},
+			wantedErr: fmt.Errorf("select application: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockSelector := mocks.NewMockappSelector(ctrl)
+			tc.mockSelector(mockSelector)
+
+			opts := &listPipelineOpts{
+				listPipelineVars: listPipelineVars{
+					GlobalOpts: &GlobalOpts{
+						appName: tc.inputApp,
+					},
+				},
+				sel: mockSelector,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedApp, opts.AppName(), "expected app names to match")
+			}
+		})
+	}
+}
+
+func TestPipelineList_Execute(t *testing.T) {
+	testTags := map[string]string{
+		deploy.AppTagKey: "coolapp",
+	}
+	testCases := map[string]struct {
+		shouldOutputJSON bool
+
+		mockStore    func(m *mocks.MockapplicationGetter)
+		mockPipeline func(m *mocks.MockpipelineGetter)
+
+		wantedContent string
+		wantedErr     error
+	}{
+		"wraps the error if the application does not exist": {
+			mockStore: func(m *mocks.MockapplicationGetter) {
+				m.EXPECT().GetApplication("coolapp").Return(nil, errors.New("some error"))
+			},
+			mockPipeline: func(m *mocks.MockpipelineGetter) {},
+			wantedErr:    fmt.Errorf("get application coolapp: some error"),
+		},
+		"wraps the error if pipelines cannot be listed": {
+			mockStore: func(m *mocks.MockapplicationGetter) {
+				m.EXPECT().GetApplication("coolapp").Return(&config.Application{}, nil)
+			},
+			mockPipeline: func(m *mocks.MockpipelineGetter) {
+				m.EXPECT().ListPipelineNamesByTags(testTags).Return(nil, errors.New("some error"))
+			},
+			wantedErr: fmt.Errorf("list pipelines: some error"),
+		},
+		"with human outputs": {
+			mockStore: func(m *mocks.MockapplicationGetter) {
+				m.EXPECT().GetApplication("coolapp").Return(&config.Application{}, nil)
+			},
+			mockPipeline: func(m *mocks.MockpipelineGetter) {
+				m.EXPECT().ListPipelineNamesByTags(testTags).Return([]string{"pipeline-coolapp-owner-repo", "pipeline-coolapp-owner-repo-release"}, nil)
+			},
+			wantedContent: "pipeline-coolapp-owner-repo\npipeline-coolapp-owner-repo-release\n",
+		},
+		"with json outputs": {
+			shouldOutputJSON: true,
+			mockStore: func(m *mocks.MockapplicationGetter) {
+				m.EXPECT().GetApplication("coolapp").Return(&config.Application{}, nil)
+			},
+			mockPipeline: func(m *mocks.MockpipelineGetter) {
+				m.EXPECT().ListPipelineNamesByTags(testTags).Return([]string{"pipeline-coolapp-owner-repo"}, nil)
+			},
+			wantedContent: "{\"pipelines\":[\"pipeline-coolapp-owner-repo\"]}\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockStore := mocks.NewMockapplicationGetter(ctrl)
+			mockPipeline := mocks.NewMockpipelineGetter(ctrl)
+			tc.mockStore(mockStore)
+			tc.mockPipeline(mockPipeline)
+			b := &bytes.Buffer{}
+
+			opts := &listPipelineOpts{
+				listPipelineVars: listPipelineVars{
+					GlobalOpts: &GlobalOpts{
+						appName: "coolapp",
+					},
+					ShouldOutputJSON: tc.shouldOutputJSON,
+				},
+				store:       mockStore,
+				pipelineSvc: mockPipeline,
+				w:           b,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedContent, b.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadPipelineManifest(t *testing.T) {
+	testCases := map[string]struct {
+		inName string
+
+		mockWs     func(m *mocks.MockwsPipelineManifestReader)
+		mockPrompt func(m *mocks.Mockprompter)
+
+		wantedContent string
+		wantedErr     error
+	}{
+		"reads the named manifest if a name is provided": {
+			inName: "release",
+			mockWs: func(m *mocks.MockwsPipelineManifestReader) {
+				m.EXPECT().ReadNamedPipelineManifest("release").Return([]byte("release"), nil)
+			},
+			mockPrompt:    func(m *mocks.Mockprompter) {},
+			wantedContent: "release",
+		},
+		"reads the default manifest if it exists": {
+			mockWs: func(m *mocks.MockwsPipelineManifestReader) {
+				m.EXPECT().ReadPipelineManifest().Return([]byte("default"), nil)
+			},
+			mockPrompt:    func(m *mocks.Mockprompter) {},
+			wantedContent: "default",
+		},
+		"returns the error if the default manifest cannot be read": {
+			mockWs: func(m *mocks.MockwsPipelineManifestReader) {
+				m.EXPECT().ReadPipelineManifest().Return(nil, errors.New("some error"))
+			},
+			mockPrompt: func(m *mocks.Mockprompter) {},
+			wantedErr:  errors.New("some error"),
+		},
+		"returns ErrNoPipelineInWorkspace if there are no manifests": {
+			mockWs: func(m *mocks.MockwsPipelineManifestReader) {
+				m.EXPECT().ReadPipelineManifest().Return(nil, workspace.ErrNoPipelineInWorkspace)
+				m.EXPECT().PipelineManifestNames().Return(nil, nil)
+			},
+			mockPrompt: func(m *mocks.Mockprompter) {},
+			wantedErr:  workspace.ErrNoPipelineInWorkspace,
+		},
+		"wraps the error if manifests cannot be listed": {
+			mockWs: func(m *mocks.MockwsPipelineManifestReader) {
+				m.EXPECT().ReadPipelineManifest().Return(nil, workspace.ErrNoPipelineInWorkspace)
+				m.EXPECT().PipelineManifestNames().Return(nil, errors.New("some error"))
+			},
+			mockPrompt: func(m *mocks.Mockprompter) {},
+			wantedErr:  fmt.Errorf("list pipeline manifests: some error"),
+		},
+		"reads the only named manifest without prompting": {
+			mockWs: func(m *mocks.MockwsPipelineManifestReader) {
+				m.EXPECT().ReadPipelineManifest().Return(nil, workspace.ErrNoPipelineInWorkspace)
+				m.EXPECT().PipelineManifestNames().Return([]string{"release"}, nil)
+				m.EXPECT().ReadNamedPipelineManifest("release").Return([]byte("release"), nil)
+			},
+			mockPrompt:    func(m *mocks.Mockprompter) {},
+			wantedContent: "release",
+		},
+		"prompts for the manifest if there are several": {
+			mockWs: func(m *mocks.MockwsPipelineManifestReader) {
+				m.EXPECT().ReadPipelineManifest().Return(nil, workspace.ErrNoPipelineInWorkspace)
+				m.EXPECT().PipelineManifestNames().Return([]string{"preview", "release"}, nil)
+				m.EXPECT().ReadNamedPipelineManifest("preview").Return([]byte("preview"), nil)
+			},
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().SelectOne(pipelineSelectManifestPrompt, pipelineSelectManifestHelpPrompt, []string{"preview", "release"}).Return("preview", nil)
+			},
+			wantedContent: "preview",
+		},
+		"wraps the error if the selection fails": {
+			mockWs: func(m *mocks.MockwsPipelineManifestReader) {
+				m.EXPECT().ReadPipelineManifest().Return(nil, workspace.ErrNoPipelineInWorkspace)
+				m.EXPECT().PipelineManifestNames().Return([]string{"preview", "release"}, nil)
+			},
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().SelectOne(pipelineSelectManifestPrompt, pipelineSelectManifestHelpPrompt, []string{"preview", "release"}).Return("", errors.New("some error"))
+			},
+			wantedErr: fmt.Errorf("select pipeline manifest: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockWs := mocks.NewMockwsPipelineManifestReader(ctrl)
+			mockPrompt := mocks.NewMockprompter(ctrl)
+			tc.mockWs(mockWs)
+			tc.mockPrompt(mockPrompt)
+
+			// WHEN
+			content, err := readPipelineManifest(mockWs, mockPrompt, tc.inName)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedContent, string(content))
+			}
+		})
+	}
+}
@@ -41,6 +41,7 @@ const (
 
 type updatePipelineVars struct {
 	PipelineName     string
+	ManifestName     string
 	SkipConfirmation bool
 	*GlobalOpts
 }
@@ -99,6 +100,15 @@ func (o *updatePipelineOpts) convertStages(manifestStages []manifest.PipelineSta
 	if err != nil {
 		return nil, fmt.Errorf("service names from workspace: %w", err)
 	}
+	deps, err := svcDependencies(o.ws, svcNames)
+	if err != nil {
+		return nil, err
+	}
+	// Deploy the services in the order of their dependencies, which also rejects circular dependencies.
+	svcNames, err = deploy.SortByDependencies(svcNames, deps)
+	if err != nil {
+		return nil, err
+	}
 
 	for _, stage := range manifestStages {
 		env, err := o.envStore.GetEnvironment(o.AppName(), stage.Name)
@@ -108,6 +118,7 @@ func (o *updatePipelineOpts) convertStages(manifestStages []manifest.PipelineSta
 
 		pipelineStage := deploy.PipelineStage{
 			LocalServices: svcNames,
+			DependsOn:     deps,
 			AssociatedEnvironment: &deploy.AssociatedEnvironment{
 				Name:      stage.Name,
 				Region:    env.Region,
@@ -199,7 +210,7 @@ func (o *updatePipelineOpts) Execute() error {
 	o.prog.Stop(log.Ssuccessf(fmtPipelineUpdateResourcesComplete, color.HighlightUserInput(o.AppName())))
 
 	// read pipeline manifest
-	data, err := o.ws.ReadPipelineManifest()
+	data, err := readPipelineManifest(o.ws, o.prompt, o.ManifestName)
 	if err != nil {
 		return fmt.Errorf("read pipeline manifest: %w", err)
 	}
@@ -252,7 +263,9 @@ func BuildPipelineUpdateCmd() *cobra.Command {
 		Long:  `Deploys a pipeline for the services in your workspace, using the environments associated with the application.`,
 		Example: `
   Deploys an updated pipeline for the services in your workspace.
-  /code $ copilot pipeline update`,
+  /code $ copilot pipeline update
+  Deploys the pipeline defined in copilot/pipelines/release.yml.
+  /code $ copilot pipeline update --name release`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newUpdatePipelineOpts(vars)
 			if err != nil {
@@ -264,6 +277,7 @@ func BuildPipelineUpdateCmd() *cobra.Command {
 			return opts.Execute()
 		}),
 	}
+	cmd.Flags().StringVarP(&vars.ManifestName, nameFlag, nameFlagShort, "", pipelineManifestFlagDescription)
 	cmd.Flags().BoolVar(&vars.SkipConfirmation, yesFlag, false, yesFlagDescription)
 
 	return cmd
@@ -66,11 +66,63 @@ func TestUpdatePipelineOpts_convertStages(t *testing.T) {
 						Prod:      false,
 					},
 					LocalServices: []string{"frontend", "backend"},
+					DependsOn:     map[string][]string{},
 					TestCommands:  []string{"make test", "echo \"made test\""},
 				},
 			},
 			expectedError: nil,
 		},
+		"orders services by their dependencies": {
+			stages: []manifest.PipelineStage{
+				{
+					Name: "test",
+				},
+			},
+			inAppName: "badgoose",
+			callMocks: func(m updatePipelineMocks) {
+				mockEnv := &config.Environment{
+					Name:      "test",
+					App:       "badgoose",
+					Region:    "us-west-2",
+					AccountID: "123456789012",
+				}
+				m.ws.EXPECT().ServiceNames().Return([]string{"frontend", "backend"}, nil)
+				m.ws.EXPECT().ReadServiceManifest("frontend").Return([]byte(`type: Load Balanced Web Service
+depends_on: [backend]`), nil)
+				m.envStore.EXPECT().GetEnvironment("badgoose", "test").Return(mockEnv, nil)
+			},
+
+			expectedStages: []deploy.PipelineStage{
+				{
+					AssociatedEnvironment: &deploy.AssociatedEnvironment{
+						Name:      "test",
+						Region:    "us-west-2",
+						AccountID: "123456789012",
+					},
+					LocalServices: []string{"backend", "frontend"},
+					DependsOn: map[string][]string{
+						"frontend": {"backend"},
+					},
+				},
+			},
+		},
+		"errors on circular dependencies": {
+			stages: []manifest.PipelineStage{
+				{
+					Name: "test",
+				},
+			},
+			inAppName: "badgoose",
+			callMocks: func(m updatePipelineMocks) {
+				m.ws.EXPECT().ServiceNames().Return([]string{"frontend", "backend"}, nil)
+				m.ws.EXPECT().ReadServiceManifest("frontend").Return([]byte(`type: Load Balanced Web Service
+depends_on: [backend]`), nil)
+				m.ws.EXPECT().ReadServiceManifest("backend").Return([]byte(`type: Backend Service
+depends_on: [frontend]`), nil)
+			},
+
+			expectedError: &deploy.ErrCircularDependency{Services: []string{"frontend", "backend", "frontend"}},
+		},
 		"converts stages without test commands": {
 			stages: []manifest.PipelineStage{
 				{
@@ -101,6 +153,7 @@ func TestUpdatePipelineOpts_convertStages(t *testing.T) {
 						Prod:      false,
 					},
 					LocalServices: []string{"frontend", "backend"},
+					DependsOn:     map[string][]string{},
 					TestCommands:  []string(nil),
 				},
 			},
@@ -122,6 +175,8 @@ func TestUpdatePipelineOpts_convertStages(t *testing.T) {
 			}
 
 			tc.callMocks(mocks)
+			// Unless a test case expects otherwise, services don't declare dependencies.
+			mockWorkspace.EXPECT().ReadServiceManifest(gomock.Any()).Return([]byte("type: Backend Service"), nil).AnyTimes()
 
 			opts := &updatePipelineOpts{
 				updatePipelineVars: updatePipelineVars{
@@ -562,6 +617,8 @@ stages:
 			}
 
 			tc.callMocks(mocks)
+			// Unless a test case expects otherwise, services don't declare dependencies.
+			mockWorkspace.EXPECT().ReadServiceManifest(gomock.Any()).Return([]byte("type: Backend Service"), nil).AnyTimes()
 
 			opts := &updatePipelineOpts{
 				updatePipelineVars: updatePipelineVars{
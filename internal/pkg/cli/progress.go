@@ -3,7 +3,14 @@
 
 package cli
 
-import termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+)
 
 // progress is the interface to inform the user that a long operation is taking place.
 type progress interface {
@@ -15,23 +22,53 @@ type progress interface {
 	Events([]termprogress.TabRow)
 }
 
+// throttleProgress displays the EC2 requests that are retried because they're throttled.
+type throttleProgress struct {
+	prog progress
+	// Whether prog is already started by the command, in which case the throttled requests
+	// are written as events under it instead of starting and stopping it.
+	running bool
+}
+
+// Throttled displays the throttled request until it's retried.
+func (p *throttleProgress) Throttled(e ec2.ThrottleEvent) {
+	label := fmt.Sprintf("EC2 is throttling %s requests, retrying in %s (%d/%d).",
+		e.Operation, e.Delay.Round(time.Millisecond), e.Attempt, e.MaxRetries)
+	if p.running {
+		p.prog.Events([]termprogress.TabRow{termprogress.TabRow("  " + label)})
+		return
+	}
+	p.prog.Start(label)
+}
+
+// Recovered removes the throttled requests once they complete.
+func (p *throttleProgress) Recovered() {
+	if p.running {
+		p.prog.Events(nil)
+		return
+	}
+	p.prog.Stop(log.Ssuccessln("Retried the requests throttled by EC2."))
+}
+
 var defaultResourceCounts = map[termprogress.Text]int{
-	textVPC:             1,
-	textInternetGateway: 2,
-	textPublicSubnets:   2,
-	textPrivateSubnets:  2,
-	textRouteTables:     4,
-	textECSCluster:      1,
-	textALB:             4,
+	textVPC:               1,
+	textInternetGateway:   2,
+	textPublicSubnets:     2,
+	textPrivateSubnets:    2,
+	textRouteTables:       4,
+	textECSCluster:        1,
+	textALB:               4,
+	textGlobalAccelerator: 3,
 }
 
 // Row descriptions displayed while deploying an environment.
 const (
-	textVPC             termprogress.Text = "- Virtual private cloud on 2 availability zones to hold your services"
-	textInternetGateway termprogress.Text = "  - Internet gateway to connect the network to the internet"
-	textPublicSubnets   termprogress.Text = "  - Public subnets for internet facing services "
-	textPrivateSubnets  termprogress.Text = "  - Private subnets for services that can't be reached from the internet"
-	textRouteTables     termprogress.Text = "  - Routing tables for services to talk with each other"
-	textECSCluster      termprogress.Text = "- ECS Cluster to hold your services "
-	textALB             termprogress.Text = "- Application load balancer to distribute traffic "
+	textVPC               termprogress.Text = "- Virtual private cloud on 2 availability zones to hold your services"
+	textInternetGateway   termprogress.Text = "  - Internet gateway to connect the network to the internet"
+	textPublicSubnets     termprogress.Text = "  - Public subnets for internet facing services "
+	textPrivateSubnets    termprogress.Text = "  - Private subnets for services that can't be reached from the internet"
+	textRouteTables       termprogress.Text = "  - Routing tables for services to talk with each other"
+	textECSCluster        termprogress.Text = "- ECS Cluster to hold your services "
+	textALB               termprogress.Text = "- Application load balancer to distribute traffic "
+	textGlobalAccelerator termprogress.Text = "- Global Accelerator to serve traffic from static IP addresses"
 )
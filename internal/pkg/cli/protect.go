@@ -0,0 +1,46 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+const (
+	fmtProtectedEnvPrompt  = "Environment %s is protected. Type its name to continue:"
+	protectedEnvHelpPrompt = "Deploying to or deleting from a protected environment requires confirming its name."
+)
+
+// errProtectedEnvNotConfirmed occurs when the name of a protected environment is neither passed with --confirm nor typed correctly.
+type errProtectedEnvNotConfirmed struct {
+	name string
+}
+
+func (e *errProtectedEnvNotConfirmed) Error() string {
+	return fmt.Sprintf("environment %s is protected: type its name or pass --%s %s to continue", e.name, confirmFlag, e.name)
+}
+
+// ExitCode returns the exit code of a user input error.
+func (e *errProtectedEnvNotConfirmed) ExitCode() int {
+	return ExitCodeUserError
+}
+
+// confirmProtectedEnv returns nil if the environment isn't protected, or if its name is one of the confirmed names.
+// Otherwise, the user is prompted to type the name of the environment.
+func confirmProtectedEnv(p prompter, env *config.Environment, confirmed []string) error {
+	if !env.Protected || contains(env.Name, confirmed) {
+		return nil
+	}
+	name, err := p.Get(fmt.Sprintf(fmtProtectedEnvPrompt, color.HighlightUserInput(env.Name)), protectedEnvHelpPrompt, nil)
+	if err != nil {
+		return fmt.Errorf("confirm protected environment %s: %w", env.Name, err)
+	}
+	if name != env.Name {
+		return &errProtectedEnvNotConfirmed{name: env.Name}
+	}
+	return nil
+}
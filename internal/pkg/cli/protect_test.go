@@ -0,0 +1,76 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfirmProtectedEnv(t *testing.T) {
+	testCases := map[string]struct {
+		inEnv       *config.Environment
+		inConfirmed []string
+		setupMocks  func(m *mocks.Mockprompter)
+
+		wantedErr      error
+		wantedExitCode int
+	}{
+		"doesn't prompt if the environment isn't protected": {
+			inEnv:      &config.Environment{Name: "test"},
+			setupMocks: func(m *mocks.Mockprompter) {},
+		},
+		"doesn't prompt if the environment is confirmed with the flag": {
+			inEnv:       &config.Environment{Name: "prod", Protected: true},
+			inConfirmed: []string{"staging", "prod"},
+			setupMocks:  func(m *mocks.Mockprompter) {},
+		},
+		"succeeds if the user types the name of the environment": {
+			inEnv:       &config.Environment{Name: "prod", Protected: true},
+			inConfirmed: []string{"staging"},
+			setupMocks: func(m *mocks.Mockprompter) {
+				m.EXPECT().Get(gomock.Any(), protectedEnvHelpPrompt, nil).Return("prod", nil)
+			},
+		},
+		"errors if the user types another name": {
+			inEnv: &config.Environment{Name: "prod", Protected: true},
+			setupMocks: func(m *mocks.Mockprompter) {
+				m.EXPECT().Get(gomock.Any(), protectedEnvHelpPrompt, nil).Return("pord", nil)
+			},
+			wantedErr:      errors.New("environment prod is protected: type its name or pass --confirm prod to continue"),
+			wantedExitCode: ExitCodeUserError,
+		},
+		"wraps the error of the prompt": {
+			inEnv: &config.Environment{Name: "prod", Protected: true},
+			setupMocks: func(m *mocks.Mockprompter) {
+				m.EXPECT().Get(gomock.Any(), protectedEnvHelpPrompt, nil).Return("", errors.New("some error"))
+			},
+			wantedErr:      errors.New("confirm protected environment prod: some error"),
+			wantedExitCode: ExitCodeError,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockprompter(ctrl)
+			tc.setupMocks(m)
+
+			err := confirmProtectedEnv(m, tc.inEnv, tc.inConfirmed)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				require.Equal(t, tc.wantedExitCode, ExitCode(err))
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
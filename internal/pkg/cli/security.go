@@ -0,0 +1,33 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"github.com/aws/copilot-cli/cmd/copilot/template"
+	"github.com/aws/copilot-cli/internal/pkg/cli/group"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// BuildSecurityCmd is the top level command for security.
+func BuildSecurityCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "security",
+		Short: "Commands for the security posture of your services.",
+		Long: `Commands for the security posture of your services.
+Surface the findings of AWS Security Hub and Amazon GuardDuty on the resources of your services.`,
+	}
+	// The flags bound by viper are available to all sub-commands through viper.GetString({flagName})
+	cmd.PersistentFlags().StringP(appFlag, appFlagShort, "" /* default */, appFlagDescription)
+	viper.BindPFlag(appFlag, cmd.PersistentFlags().Lookup(appFlag))
+
+	cmd.AddCommand(BuildSecurityStatusCmd())
+
+	cmd.SetUsageTemplate(template.Usage)
+
+	cmd.Annotations = map[string]string{
+		"group": group.Develop,
+	}
+	return cmd
+}
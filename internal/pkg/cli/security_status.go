@@ -0,0 +1,194 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/securityhub"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	securityStatusAppNamePrompt     = "Which application is the service in?"
+	securityStatusAppNameHelpPrompt = "An application groups all of your services together."
+	securityStatusNamePrompt        = "Which service's security findings would you like to show?"
+	securityStatusNameHelpPrompt    = "Displays the open findings of Security Hub and GuardDuty on the service's resources."
+
+	enableSecurityHubCmd = "aws securityhub enable-security-hub"
+)
+
+type securityStatusVars struct {
+	*GlobalOpts
+	shouldOutputJSON bool
+	noCache          bool
+	svcName          string
+	envName          string
+}
+
+type securityStatusOpts struct {
+	securityStatusVars
+
+	w             io.Writer
+	store         store
+	describer     securityStatusDescriber
+	sel           deploySelector
+	initDescriber func(*securityStatusOpts) error
+}
+
+func newSecurityStatusOpts(vars securityStatusVars) (*securityStatusOpts, error) {
+	c, err := newLocalCache(vars.noCache)
+	if err != nil {
+		return nil, err
+	}
+	configStore, err := config.NewCachedStore(c)
+	if err != nil {
+		return nil, fmt.Errorf("connect to environment datastore: %w", err)
+	}
+	deployStore, err := deploy.NewStore(configStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	return &securityStatusOpts{
+		securityStatusVars: vars,
+		store:              configStore,
+		w:                  log.OutputWriter,
+		sel:                selector.NewDeploySelect(vars.prompt, configStore, deployStore),
+		initDescriber: func(o *securityStatusOpts) error {
+			d, err := describe.NewSecurityStatus(&describe.NewSecurityStatusConfig{
+				App:         o.AppName(),
+				Env:         o.envName,
+				Svc:         o.svcName,
+				ConfigStore: configStore,
+			})
+			if err != nil {
+				return fmt.Errorf("creating security status describer for service %s in application %s: %w", o.svcName, o.AppName(), err)
+			}
+			o.describer = d
+			return nil
+		},
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *securityStatusOpts) Validate() error {
+	if o.AppName() != "" {
+		if _, err := o.store.GetApplication(o.AppName()); err != nil {
+			return err
+		}
+	}
+	if o.svcName != "" {
+		if _, err := o.store.GetService(o.AppName(), o.svcName); err != nil {
+			return err
+		}
+	}
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.AppName(), o.envName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *securityStatusOpts) Ask() error {
+	if err := o.askApp(); err != nil {
+		return err
+	}
+	return o.askSvcEnvName()
+}
+
+// Execute displays the open security findings of the service.
+func (o *securityStatusOpts) Execute() error {
+	if err := o.initDescriber(o); err != nil {
+		return err
+	}
+	status, err := o.describer.Describe()
+	if err != nil {
+		var errNotEnabled *securityhub.ErrNotEnabled
+		if errors.As(err, &errNotEnabled) {
+			log.Infof("Run %s in the account and region of environment %s, and enable its GuardDuty integration to surface GuardDuty findings.\n",
+				color.HighlightCode(enableSecurityHubCmd), color.HighlightUserInput(o.envName))
+		}
+		return fmt.Errorf("describe security status of service %s: %w", o.svcName, err)
+	}
+	if o.shouldOutputJSON {
+		data, err := status.JSONString()
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(o.w, data)
+	} else {
+		fmt.Fprintf(o.w, status.HumanString())
+	}
+	return nil
+}
+
+func (o *securityStatusOpts) askApp() error {
+	if o.AppName() != "" {
+		return nil
+	}
+	app, err := o.sel.Application(securityStatusAppNamePrompt, securityStatusAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *securityStatusOpts) askSvcEnvName() error {
+	deployedService, err := o.sel.DeployedService(securityStatusNamePrompt, securityStatusNameHelpPrompt, o.AppName(), selector.WithEnv(o.envName), selector.WithSvc(o.svcName))
+	if err != nil {
+		return fmt.Errorf("select deployed services for application %s: %w", o.AppName(), err)
+	}
+	o.svcName = deployedService.Svc
+	o.envName = deployedService.Env
+	return nil
+}
+
+// BuildSecurityStatusCmd builds the command for showing the open security findings of a deployed service.
+func BuildSecurityStatusCmd() *cobra.Command {
+	vars := securityStatusVars{
+		GlobalOpts: NewGlobalOpts(),
+	}
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "Shows the open security findings of a deployed service.",
+		Long: `Shows the open security findings of a deployed service.
+Findings are reported by AWS Security Hub, and by the products integrated with it such as Amazon GuardDuty,
+on the resources tagged with the service. Security Hub must be enabled in the environment's account and region.`,
+
+		Example: `
+  Shows the number of open findings by severity and the critical findings of the deployed service "my-svc".
+  /code $ copilot security status -n my-svc
+  Shows all the open findings of "my-svc" in the "prod" environment in JSON.
+  /code $ copilot security status -n my-svc -e prod --json`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSecurityStatusOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.svcName, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	cmd.Flags().BoolVar(&vars.noCache, noCacheFlag, false, noCacheFlagDescription)
+	return cmd
+}
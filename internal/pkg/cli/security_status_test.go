@@ -0,0 +1,169 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/securityhub"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecurityStatus_Ask(t *testing.T) {
+	mockError := errors.New("some error")
+	testCases := map[string]struct {
+		inputApp     string
+		mockSelector func(m *mocks.MockdeploySelector)
+
+		wantedSvc   string
+		wantedEnv   string
+		wantedError error
+	}{
+		"errors if failed to select application": {
+			mockSelector: func(m *mocks.MockdeploySelector) {
+				m.EXPECT().Application(securityStatusAppNamePrompt, securityStatusAppNameHelpPrompt).Return("", mockError)
+			},
+
+			wantedError: fmt.Errorf("select application: some error"),
+		},
+		"errors if failed to select deployed service": {
+			inputApp: "mockApp",
+			mockSelector: func(m *mocks.MockdeploySelector) {
+				m.EXPECT().DeployedService(securityStatusNamePrompt, securityStatusNameHelpPrompt, "mockApp", gomock.Any(), gomock.Any()).
+					Return(nil, mockError)
+			},
+
+			wantedError: fmt.Errorf("select deployed services for application mockApp: some error"),
+		},
+		"success": {
+			inputApp: "mockApp",
+			mockSelector: func(m *mocks.MockdeploySelector) {
+				m.EXPECT().DeployedService(securityStatusNamePrompt, securityStatusNameHelpPrompt, "mockApp", gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedService{
+						Env: "mockEnv",
+						Svc: "mockSvc",
+					}, nil)
+			},
+
+			wantedSvc: "mockSvc",
+			wantedEnv: "mockEnv",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSelector := mocks.NewMockdeploySelector(ctrl)
+			tc.mockSelector(mockSelector)
+
+			opts := &securityStatusOpts{
+				securityStatusVars: securityStatusVars{
+					GlobalOpts: &GlobalOpts{
+						appName: tc.inputApp,
+					},
+				},
+				sel: mockSelector,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedSvc, opts.svcName)
+			require.Equal(t, tc.wantedEnv, opts.envName)
+		})
+	}
+}
+
+func TestSecurityStatus_Execute(t *testing.T) {
+	mockStatus := &describe.SecurityStatusDesc{
+		Service: "mockSvc",
+		Counts:  map[string]int{"CRITICAL": 0, "HIGH": 0, "MEDIUM": 0, "LOW": 0, "INFORMATIONAL": 0},
+	}
+	testCases := map[string]struct {
+		shouldOutputJSON bool
+		mockDescriber    func(m *mocks.MocksecurityStatusDescriber)
+
+		wantedContent string
+		wantedError   error
+	}{
+		"errors if failed to describe the security status": {
+			mockDescriber: func(m *mocks.MocksecurityStatusDescriber) {
+				m.EXPECT().Describe().Return(nil, fmt.Errorf("get findings of service mockSvc: %w", &securityhub.ErrNotEnabled{}))
+			},
+
+			wantedError: fmt.Errorf("describe security status of service mockSvc: get findings of service mockSvc: Security Hub is not enabled in this account and region"),
+		},
+		"success with JSON output": {
+			shouldOutputJSON: true,
+			mockDescriber: func(m *mocks.MocksecurityStatusDescriber) {
+				m.EXPECT().Describe().Return(mockStatus, nil)
+			},
+
+			wantedContent: `{"service":"mockSvc","counts":{"CRITICAL":0,"HIGH":0,"INFORMATIONAL":0,"LOW":0,"MEDIUM":0},"findings":null}
+`,
+		},
+		"success with human output": {
+			mockDescriber: func(m *mocks.MocksecurityStatusDescriber) {
+				m.EXPECT().Describe().Return(mockStatus, nil)
+			},
+
+			wantedContent: mockStatus.HumanString(),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			b := &bytes.Buffer{}
+			mockDescriber := mocks.NewMocksecurityStatusDescriber(ctrl)
+			tc.mockDescriber(mockDescriber)
+
+			opts := &securityStatusOpts{
+				securityStatusVars: securityStatusVars{
+					svcName:          "mockSvc",
+					envName:          "mockEnv",
+					shouldOutputJSON: tc.shouldOutputJSON,
+					GlobalOpts: &GlobalOpts{
+						appName: "mockApp",
+					},
+				},
+				initDescriber: func(o *securityStatusOpts) error {
+					o.describer = mockDescriber
+					return nil
+				},
+				w: b,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, b.String())
+		})
+	}
+}
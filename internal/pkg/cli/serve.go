@@ -0,0 +1,166 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/aws/copilot-cli/cmd/copilot/template"
+	"github.com/aws/copilot-cli/internal/pkg/cli/group"
+	"github.com/aws/copilot-cli/internal/pkg/daemon"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/spf13/cobra"
+)
+
+const (
+	defaultServePort = 7007
+
+	// The API only listens on the loopback interface, since it can deploy services with the credentials of the user.
+	serveHost = "localhost"
+)
+
+type serveVars struct {
+	port int
+}
+
+type serveOpts struct {
+	serveVars
+
+	backend daemon.Backend
+	listen  func(addr string, handler http.Handler) error
+}
+
+func newServeOpts(vars serveVars) *serveOpts {
+	return &serveOpts{
+		serveVars: vars,
+
+		backend: &serveBackend{},
+		listen:  http.ListenAndServe,
+	}
+}
+
+// Validate returns an error if the port is invalid.
+func (o *serveOpts) Validate() error {
+	if o.port < 1 || o.port > 65535 {
+		return fmt.Errorf("--%s %d must be between 1 and 65535", svcPortFlag, o.port)
+	}
+	return nil
+}
+
+// Execute serves the API until the command is interrupted.
+func (o *serveOpts) Execute() error {
+	addr := fmt.Sprintf("%s:%d", serveHost, o.port)
+	log.Infof("Serving the copilot API on %s.\n", color.HighlightResource("http://"+addr))
+	if err := o.listen(addr, daemon.New(o.backend)); err != nil {
+		return fmt.Errorf("serve API on %s: %w", addr, err)
+	}
+	return nil
+}
+
+// serveBackend runs the operations of the API with the commands of the CLI.
+type serveBackend struct{}
+
+// Deploy deploys the service like "copilot svc deploy".
+func (b *serveBackend) Deploy(req daemon.DeployRequest) error {
+	opts, err := newSvcDeployOpts(deploySvcVars{
+		GlobalOpts:        &GlobalOpts{appName: req.App},
+		Name:              req.Service,
+		EnvName:           req.Env,
+		ImageTag:          req.ImageTag,
+		RollbackOnFailure: true,
+	})
+	if err != nil {
+		return err
+	}
+	if err := opts.Validate(); err != nil {
+		return err
+	}
+	return opts.Execute()
+}
+
+// Status returns the status of the service like "copilot svc status --json".
+func (b *serveBackend) Status(app, svc, env string) (json.RawMessage, error) {
+	opts, err := newSvcStatusOpts(svcStatusVars{
+		GlobalOpts:       &GlobalOpts{appName: app},
+		svcName:          svc,
+		envName:          env,
+		shouldOutputJSON: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	buf := &bytes.Buffer{}
+	opts.w = buf
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	if err := opts.Execute(); err != nil {
+		return nil, err
+	}
+	return json.RawMessage(buf.Bytes()), nil
+}
+
+// Logs returns the log events of the service like "copilot svc logs --json", wrapped in an "events" list.
+func (b *serveBackend) Logs(req daemon.LogsRequest) (json.RawMessage, error) {
+	opts, err := newSvcLogOpts(svcLogsVars{
+		GlobalOpts:       &GlobalOpts{appName: req.App},
+		svcName:          req.Service,
+		envName:          req.Env,
+		since:            req.Since,
+		limit:            req.Limit,
+		shouldOutputJSON: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	buf := &bytes.Buffer{}
+	opts.w = buf
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	if err := opts.Execute(); err != nil {
+		return nil, err
+	}
+	// Each log event is written as a JSON object on its own line.
+	events := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(events) == 1 && events[0] == "" {
+		events = nil
+	}
+	return json.RawMessage(fmt.Sprintf(`{"events":[%s]}`, strings.Join(events, ","))), nil
+}
+
+// BuildServeCmd builds the command to serve the operations of the CLI over a local HTTP API.
+func BuildServeCmd() *cobra.Command {
+	vars := serveVars{}
+	cmd := &cobra.Command{
+		Use:   "serve",
+		Short: "Serves the deploy, status and logs operations over a local HTTP API.",
+		Long: `Serves the deploy, status and logs operations over a local HTTP API.
+IDE plugins and internal portals can drive deployments without parsing the output of the CLI.
+Deployments run in the background: starting one returns an operation to poll until it's done.`,
+		Example: `
+  Serves the API on the default port, and deploys the "frontend" service to the "test" environment.
+  /code $ copilot serve
+  /code $ curl -X POST localhost:7007/v1/deployments -d '{"app": "phonetool", "service": "frontend", "env": "test", "imageTag": "v1"}'
+  /code $ curl localhost:7007/v1/operations/<id>`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts := newServeOpts(vars)
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+		Annotations: map[string]string{
+			"group": group.Release,
+		},
+	}
+	cmd.Flags().IntVar(&vars.port, svcPortFlag, defaultServePort, servePortFlagDescription)
+	cmd.SetUsageTemplate(template.Usage)
+	return cmd
+}
@@ -0,0 +1,86 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestServeOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inPort int
+
+		wantedError error
+	}{
+		"invalid port": {
+			inPort:      70000,
+			wantedError: errors.New("--port 70000 must be between 1 and 65535"),
+		},
+		"valid port": {
+			inPort: defaultServePort,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := serveOpts{
+				serveVars: serveVars{
+					port: tc.inPort,
+				},
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestServeOpts_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		listenErr error
+
+		wantedError error
+	}{
+		"errors if the API can't be served": {
+			listenErr:   errors.New("address already in use"),
+			wantedError: errors.New("serve API on localhost:7007: address already in use"),
+		},
+		"serves the API on localhost": {},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var gotAddr string
+			opts := serveOpts{
+				serveVars: serveVars{
+					port: defaultServePort,
+				},
+				backend: &serveBackend{},
+				listen: func(addr string, handler http.Handler) error {
+					gotAddr = addr
+					require.NotNil(t, handler)
+					return tc.listenErr
+				},
+			}
+
+			err := opts.Execute()
+
+			require.Equal(t, "localhost:7007", gotAddr)
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,195 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/aws/copilot-cli/cmd/copilot/template"
+	"github.com/aws/copilot-cli/internal/pkg/cli/group"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	showResourcesAppNamePrompt     = "Which application are the resources in?"
+	showResourcesAppNameHelpPrompt = "An application is a collection of related services."
+	showResourcesEnvNamePrompt     = "Which environment of %s would you like to show the resources of?"
+	showResourcesEnvNameHelpPrompt = "The resources created for the environment, or for a service deployed in it, will be shown."
+)
+
+type showResourcesVars struct {
+	*GlobalOpts
+	shouldOutputJSON bool
+	noCache          bool
+	envName          string
+	svcName          string
+}
+
+type showResourcesOpts struct {
+	showResourcesVars
+
+	w             io.Writer
+	store         store
+	describer     stackResourcesDescriber
+	sel           configSelector
+	initDescriber func() error
+}
+
+func newShowResourcesOpts(vars showResourcesVars) (*showResourcesOpts, error) {
+	c, err := newLocalCache(vars.noCache)
+	if err != nil {
+		return nil, err
+	}
+	configStore, err := config.NewCachedStore(c)
+	if err != nil {
+		return nil, fmt.Errorf("connect to copilot config store: %w", err)
+	}
+	opts := &showResourcesOpts{
+		showResourcesVars: vars,
+		store:             configStore,
+		w:                 log.OutputWriter,
+		sel:               selector.NewConfigSelect(vars.prompt, configStore),
+	}
+	opts.initDescriber = func() error {
+		d, err := describe.NewStackResourcesDescriber(describe.NewStackResourcesConfig{
+			App:         opts.AppName(),
+			Env:         opts.envName,
+			Svc:         opts.svcName,
+			ConfigStore: configStore,
+			Cache:       c,
+		})
+		if err != nil {
+			return fmt.Errorf("creating resources describer for environment %s in application %s: %w", opts.envName, opts.AppName(), err)
+		}
+		opts.describer = d
+		return nil
+	}
+	return opts, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *showResourcesOpts) Validate() error {
+	if o.AppName() != "" {
+		if _, err := o.store.GetApplication(o.AppName()); err != nil {
+			return err
+		}
+	}
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.AppName(), o.envName); err != nil {
+			return err
+		}
+	}
+	if o.svcName != "" {
+		if _, err := o.store.GetService(o.AppName(), o.svcName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Ask asks for fields that are required but not passed in.
+// The service is optional, so it isn't prompted for.
+func (o *showResourcesOpts) Ask() error {
+	if err := o.askApp(); err != nil {
+		return err
+	}
+	return o.askEnvName()
+}
+
+// Execute shows the resources of the service, or of the environment if no service is provided.
+func (o *showResourcesOpts) Execute() error {
+	if err := o.initDescriber(); err != nil {
+		return err
+	}
+	resources, err := o.describer.Describe()
+	if err != nil {
+		if o.svcName != "" {
+			return fmt.Errorf("describe resources of service %s in environment %s: %w", o.svcName, o.envName, err)
+		}
+		return fmt.Errorf("describe resources of environment %s: %w", o.envName, err)
+	}
+	if o.shouldOutputJSON {
+		data, err := resources.JSONString()
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(o.w, data)
+	} else {
+		fmt.Fprintf(o.w, resources.HumanString())
+	}
+	return nil
+}
+
+func (o *showResourcesOpts) askApp() error {
+	if o.AppName() != "" {
+		return nil
+	}
+	app, err := o.sel.Application(showResourcesAppNamePrompt, showResourcesAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *showResourcesOpts) askEnvName() error {
+	if o.envName != "" {
+		return nil
+	}
+	env, err := o.sel.Environment(fmt.Sprintf(showResourcesEnvNamePrompt, color.HighlightUserInput(o.AppName())), showResourcesEnvNameHelpPrompt, o.AppName())
+	if err != nil {
+		return fmt.Errorf("select environment for application %s: %w", o.AppName(), err)
+	}
+	o.envName = env
+	return nil
+}
+
+// BuildShowResourcesCmd builds the command for showing the resources created for a service or an environment.
+func BuildShowResourcesCmd() *cobra.Command {
+	vars := showResourcesVars{
+		GlobalOpts: NewGlobalOpts(),
+	}
+	cmd := &cobra.Command{
+		Use:   "show-resources",
+		Short: "Shows the AWS resources created for a service or an environment.",
+		Long: `Shows the AWS resources created for a service or an environment, including the resources of nested stacks
+such as addons, along with links to them in the AWS console.`,
+
+		Example: `
+  Shows the resources of the "test" environment.
+  /code $ copilot show-resources -e test
+
+  Shows the resources of the "api" service in the "test" environment, including its addons.
+  /code $ copilot show-resources -e test -s api --json`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newShowResourcesOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, "", appFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.svcName, svcFlag, svcFlagShort, "", showResourcesSvcFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	cmd.Flags().BoolVar(&vars.noCache, noCacheFlag, false, noCacheFlagDescription)
+	cmd.SetUsageTemplate(template.Usage)
+	cmd.Annotations = map[string]string{
+		"group": group.Develop,
+	}
+	return cmd
+}
@@ -0,0 +1,245 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShowResourcesOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inApp      string
+		inEnv      string
+		inSvc      string
+		setupMocks func(m *mocks.Mockstore)
+
+		wantedError error
+	}{
+		"valid application, environment and service": {
+			inApp: "my-app",
+			inEnv: "test",
+			inSvc: "api",
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+				m.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test"}, nil)
+				m.EXPECT().GetService("my-app", "api").Return(&config.Service{Name: "api"}, nil)
+			},
+		},
+		"invalid service": {
+			inApp: "my-app",
+			inSvc: "api",
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+				m.EXPECT().GetService("my-app", "api").Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockstore(ctrl)
+			tc.setupMocks(mockStore)
+
+			opts := &showResourcesOpts{
+				showResourcesVars: showResourcesVars{
+					GlobalOpts: &GlobalOpts{
+						appName: tc.inApp,
+					},
+					envName: tc.inEnv,
+					svcName: tc.inSvc,
+				},
+				store: mockStore,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestShowResourcesOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inApp      string
+		inEnv      string
+		setupMocks func(m *mocks.MockconfigSelector)
+
+		wantedApp   string
+		wantedEnv   string
+		wantedError error
+	}{
+		"doesn't prompt if the application and environment are provided": {
+			inApp:      "my-app",
+			inEnv:      "test",
+			setupMocks: func(m *mocks.MockconfigSelector) {},
+
+			wantedApp: "my-app",
+			wantedEnv: "test",
+		},
+		"prompts for the application and environment": {
+			setupMocks: func(m *mocks.MockconfigSelector) {
+				gomock.InOrder(
+					m.EXPECT().Application(showResourcesAppNamePrompt, showResourcesAppNameHelpPrompt).Return("my-app", nil),
+					m.EXPECT().Environment(fmt.Sprintf(showResourcesEnvNamePrompt, color.HighlightUserInput("my-app")), showResourcesEnvNameHelpPrompt, "my-app").Return("test", nil),
+				)
+			},
+
+			wantedApp: "my-app",
+			wantedEnv: "test",
+		},
+		"returns error if fail to select the application": {
+			setupMocks: func(m *mocks.MockconfigSelector) {
+				m.EXPECT().Application(showResourcesAppNamePrompt, showResourcesAppNameHelpPrompt).Return("", errors.New("some error"))
+			},
+
+			wantedError: errors.New("select application: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSel := mocks.NewMockconfigSelector(ctrl)
+			tc.setupMocks(mockSel)
+
+			opts := &showResourcesOpts{
+				showResourcesVars: showResourcesVars{
+					GlobalOpts: &GlobalOpts{
+						appName: tc.inApp,
+					},
+					envName: tc.inEnv,
+				},
+				sel: mockSel,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedApp, opts.AppName())
+			require.Equal(t, tc.wantedEnv, opts.envName)
+		})
+	}
+}
+
+func TestShowResourcesOpts_Execute(t *testing.T) {
+	mockResources := &describe.StackResourcesDesc{
+		Stack: "my-app-test-api",
+		Resources: []*describe.StackResource{
+			{
+				LogicalID:  "TaskRole",
+				Type:       "AWS::IAM::Role",
+				PhysicalID: "api-TaskRole",
+			},
+		},
+	}
+	testCases := map[string]struct {
+		inSvc            string
+		shouldOutputJSON bool
+		setupMocks       func(m *mocks.MockstackResourcesDescriber)
+
+		wantedContent string
+		wantedError   error
+	}{
+		"returns error if fail to describe the resources of a service": {
+			inSvc: "api",
+			setupMocks: func(m *mocks.MockstackResourcesDescriber) {
+				m.EXPECT().Describe().Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("describe resources of service api in environment test: some error"),
+		},
+		"returns error if fail to describe the resources of an environment": {
+			setupMocks: func(m *mocks.MockstackResourcesDescriber) {
+				m.EXPECT().Describe().Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("describe resources of environment test: some error"),
+		},
+		"writes the resources in a table": {
+			inSvc: "api",
+			setupMocks: func(m *mocks.MockstackResourcesDescriber) {
+				m.EXPECT().Describe().Return(mockResources, nil)
+			},
+
+			wantedContent: mockResources.HumanString(),
+		},
+		"writes the resources in JSON": {
+			inSvc:            "api",
+			shouldOutputJSON: true,
+			setupMocks: func(m *mocks.MockstackResourcesDescriber) {
+				m.EXPECT().Describe().Return(mockResources, nil)
+			},
+
+			wantedContent: `{"stack":"my-app-test-api","resources":[{"logicalID":"TaskRole","type":"AWS::IAM::Role","physicalID":"api-TaskRole"}]}` + "\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			b := &bytes.Buffer{}
+			mockDescriber := mocks.NewMockstackResourcesDescriber(ctrl)
+			tc.setupMocks(mockDescriber)
+
+			opts := &showResourcesOpts{
+				showResourcesVars: showResourcesVars{
+					GlobalOpts: &GlobalOpts{
+						appName: "my-app",
+					},
+					envName:          "test",
+					svcName:          tc.inSvc,
+					shouldOutputJSON: tc.shouldOutputJSON,
+				},
+				w:             b,
+				describer:     mockDescriber,
+				initDescriber: func() error { return nil },
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, b.String())
+		})
+	}
+}
@@ -19,6 +19,9 @@ Augment your services with S3 buckets, NoSQL and SQL databases.`,
 	}
 
 	cmd.AddCommand(BuildStorageInitCmd())
+	cmd.AddCommand(BuildStorageSnapshotCmd())
+	cmd.AddCommand(BuildStorageRestoreCmd())
+	cmd.AddCommand(BuildStorageSyncCmd())
 
 	cmd.SetUsageTemplate(template.Usage)
 
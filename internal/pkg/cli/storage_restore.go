@@ -0,0 +1,229 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/rds"
+	"github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+)
+
+const (
+	storageRestoreSvcNamePrompt     = "Which service's database would you like to restore?"
+	storageRestoreSvcNameHelpPrompt = "The database is one of the addons of the service."
+	storageRestoreSnapshotPrompt    = "Which snapshot would you like to restore?"
+	storageRestoreSnapshotHelp      = "The snapshot is restored into a new database, the existing database is left untouched."
+
+	maxDBIdentifierLength = 63 // Maximum number of characters in the identifier of a cluster or DB instance.
+)
+
+type storageRestoreVars struct {
+	*GlobalOpts
+	svcName    string
+	envName    string
+	dbName     string
+	snapshotID string
+}
+
+type storageRestoreOpts struct {
+	storageRestoreVars
+
+	store         store
+	sel           deploySelector
+	dbLister      addonDatabasesLister
+	restorer      dbRestorer
+	secretUpdater secretFieldsUpdater
+	spinner       progress
+	now           func() time.Time
+	initClients   func(*storageRestoreOpts) error
+}
+
+func newStorageRestoreOpts(vars storageRestoreVars) (*storageRestoreOpts, error) {
+	configStore, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("connect to environment datastore: %w", err)
+	}
+	deployStore, err := deploy.NewStore(configStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	return &storageRestoreOpts{
+		storageRestoreVars: vars,
+		store:              configStore,
+		sel:                selector.NewDeploySelect(vars.prompt, configStore, deployStore),
+		spinner:            termprogress.NewSpinner(),
+		now:                time.Now,
+		initClients: func(o *storageRestoreOpts) error {
+			env, err := o.store.GetEnvironment(o.AppName(), o.envName)
+			if err != nil {
+				return fmt.Errorf("get environment %s: %w", o.envName, err)
+			}
+			sess, err := sessions.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
+			if err != nil {
+				return fmt.Errorf("assume role for environment %s: %w", env.Name, err)
+			}
+			o.dbLister = cloudformation.New(sess)
+			o.restorer = rds.New(sess)
+			o.secretUpdater = secretsmanager.NewWithSession(sess)
+			return nil
+		},
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *storageRestoreOpts) Validate() error {
+	return validateStorageDatabaseFlags(o.store, o.AppName(), o.svcName, o.envName)
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *storageRestoreOpts) Ask() error {
+	if o.AppName() == "" {
+		app, err := o.sel.Application(storageSnapshotAppNamePrompt, storageSnapshotAppNameHelpPrompt)
+		if err != nil {
+			return fmt.Errorf("select application: %w", err)
+		}
+		o.appName = app
+	}
+	deployedService, err := o.sel.DeployedService(storageRestoreSvcNamePrompt, storageRestoreSvcNameHelpPrompt, o.AppName(), selector.WithEnv(o.envName), selector.WithSvc(o.svcName))
+	if err != nil {
+		return fmt.Errorf("select deployed services for application %s: %w", o.AppName(), err)
+	}
+	o.svcName = deployedService.Svc
+	o.envName = deployedService.Env
+	return nil
+}
+
+// Execute restores a snapshot of a database of the service's addons into a new database,
+// and points the secret of the database to the new one.
+func (o *storageRestoreOpts) Execute() error {
+	if err := o.initClients(o); err != nil {
+		return err
+	}
+	db, err := selectAddonDatabase(o.prompt, o.dbLister, o.AppName(), o.envName, o.svcName, o.dbName)
+	if err != nil {
+		return err
+	}
+	snapshot, err := o.selectSnapshot(db)
+	if err != nil {
+		return err
+	}
+	newID := restoredDBIdentifier(db.ID, o.now())
+	o.spinner.Start(fmt.Sprintf("Restoring snapshot %s into database %s.", color.HighlightResource(snapshot.ID), color.HighlightResource(newID)))
+	endpoint, err := o.restorer.Restore(*snapshot, newID)
+	if err != nil {
+		o.spinner.Stop(log.Serrorf("Failed to restore snapshot %s.\n", snapshot.ID))
+		return fmt.Errorf("restore snapshot %s: %w", snapshot.ID, err)
+	}
+	o.spinner.Stop(log.Ssuccessf("Restored snapshot %s into database %s.\n", color.HighlightResource(snapshot.ID), color.HighlightResource(newID)))
+
+	if db.SecretARN != "" {
+		idField := "dbInstanceIdentifier"
+		if db.Cluster {
+			idField = "dbClusterIdentifier"
+		}
+		if err := o.secretUpdater.UpdateSecretFields(db.SecretARN, map[string]interface{}{
+			"host":  endpoint.Host,
+			"port":  endpoint.Port,
+			idField: newID,
+		}); err != nil {
+			return fmt.Errorf("point secret of database %s to %s: %w", db.LogicalID, newID, err)
+		}
+		log.Successf("Pointed the secret of database %s to %s.\n", color.HighlightUserInput(db.LogicalID), color.HighlightResource(newID))
+		log.Infof("Run %s to restart the tasks of the service with the new secret.\n",
+			color.HighlightCode(fmt.Sprintf("copilot svc rebalance -n %s -e %s", o.svcName, o.envName)))
+	} else {
+		log.Infof("The new database is reachable at %s.\n", color.HighlightResource(fmt.Sprintf("%s:%d", endpoint.Host, endpoint.Port)))
+	}
+	log.Warningf("Database %s isn't managed by the addons stack of service %s. Update your addons template to keep using it after the next deployment.\n",
+		newID, o.svcName)
+	return nil
+}
+
+func (o *storageRestoreOpts) selectSnapshot(db *deploy.AddonDatabase) (*rds.Snapshot, error) {
+	snapshots, err := o.restorer.Snapshots(rdsDatabase(db, o.AppName(), o.envName, o.svcName))
+	if err != nil {
+		return nil, fmt.Errorf("list snapshots of database %s: %w", db.LogicalID, err)
+	}
+	if len(snapshots) == 0 {
+		return nil, fmt.Errorf("no snapshots found for database %s", db.LogicalID)
+	}
+	if o.snapshotID == "" {
+		var options []string
+		for _, s := range snapshots {
+			options = append(options, fmt.Sprintf("%s (%s)", s.ID, humanize.Time(s.CreatedAt)))
+		}
+		selected, err := o.prompt.SelectOne(storageRestoreSnapshotPrompt, storageRestoreSnapshotHelp, options, prompt.WithFinalMessage("Snapshot:"))
+		if err != nil {
+			return nil, fmt.Errorf("select snapshot: %w", err)
+		}
+		for i, option := range options {
+			if option == selected {
+				return &snapshots[i], nil
+			}
+		}
+	}
+	for i := range snapshots {
+		if snapshots[i].ID == o.snapshotID {
+			return &snapshots[i], nil
+		}
+	}
+	return nil, fmt.Errorf("snapshot %s not found for database %s", o.snapshotID, db.LogicalID)
+}
+
+// restoredDBIdentifier returns the identifier of the database restored from a snapshot of the database id.
+func restoredDBIdentifier(id string, now time.Time) string {
+	suffix := fmt.Sprintf("-restored-%s", now.UTC().Format(fmtSnapshotIDTimeLayout))
+	if len(id)+len(suffix) > maxDBIdentifierLength {
+		id = id[:maxDBIdentifierLength-len(suffix)]
+	}
+	return id + suffix
+}
+
+// BuildStorageRestoreCmd builds the command for restoring a snapshot of a database addon.
+func BuildStorageRestoreCmd() *cobra.Command {
+	vars := storageRestoreVars{
+		GlobalOpts: NewGlobalOpts(),
+	}
+	cmd := &cobra.Command{
+		Use:   "restore",
+		Short: "Restores a snapshot of a database addon into a new database.",
+		Long: `Restores a snapshot of an Aurora cluster or RDS DB instance in the addons of a deployed service
+into a new database, and points the secret of the database to the new one.`,
+
+		Example: `
+  Restores a snapshot of the database of the service "api" in the "prod" environment.
+  /code $ copilot storage restore -n api -e prod --snapshot orders-db-20201015-120000`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newStorageRestoreOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.svcName, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVar(&vars.dbName, databaseFlag, "", databaseFlagDescription)
+	cmd.Flags().StringVar(&vars.snapshotID, snapshotFlag, "", snapshotFlagDescription)
+	return cmd
+}
@@ -0,0 +1,164 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/rds"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type storageRestoreMocks struct {
+	prompt        *mocks.Mockprompter
+	dbLister      *mocks.MockaddonDatabasesLister
+	restorer      *mocks.MockdbRestorer
+	secretUpdater *mocks.MocksecretFieldsUpdater
+	spinner       *mocks.Mockprogress
+}
+
+func TestStorageRestoreOpts_Execute(t *testing.T) {
+	ordersDB := deploy.AddonDatabase{
+		LogicalID: "ordersDB",
+		ID:        "orders-db-abc",
+		Cluster:   true,
+		SecretARN: "arn:aws:secretsmanager:us-west-2:1234:secret:orders-abc",
+	}
+	snapshots := []rds.Snapshot{
+		{ID: "orders-db-abc-20201015-093000", Database: rds.Database{ID: "orders-db-abc", Cluster: true}, CreatedAt: time.Now().Add(-time.Hour)},
+		{ID: "orders-db-abc-20201014-093000", Database: rds.Database{ID: "orders-db-abc", Cluster: true}, CreatedAt: time.Now().Add(-25 * time.Hour)},
+	}
+	testCases := map[string]struct {
+		inSnapshotID string
+		setupMocks   func(m storageRestoreMocks)
+
+		wantedError string
+	}{
+		"errors if the database has no snapshots": {
+			setupMocks: func(m storageRestoreMocks) {
+				m.dbLister.EXPECT().ServiceAddonDatabases("phonetool", "test", "api").Return([]deploy.AddonDatabase{ordersDB}, nil)
+				m.restorer.EXPECT().Snapshots(rds.Database{ID: "orders-db-abc", Cluster: true, Tags: map[string]string{
+					"copilot-application": "phonetool",
+					"copilot-environment": "test",
+					"copilot-service":     "api",
+				}}).Return(nil, nil)
+			},
+			wantedError: "no snapshots found for database ordersDB",
+		},
+		"errors if the snapshot doesn't exist": {
+			inSnapshotID: "orders-db-abc-20200101-000000",
+			setupMocks: func(m storageRestoreMocks) {
+				m.dbLister.EXPECT().ServiceAddonDatabases("phonetool", "test", "api").Return([]deploy.AddonDatabase{ordersDB}, nil)
+				m.restorer.EXPECT().Snapshots(gomock.Any()).Return(snapshots, nil)
+			},
+			wantedError: "snapshot orders-db-abc-20200101-000000 not found for database ordersDB",
+		},
+		"wraps the error from restoring the snapshot": {
+			inSnapshotID: "orders-db-abc-20201014-093000",
+			setupMocks: func(m storageRestoreMocks) {
+				m.dbLister.EXPECT().ServiceAddonDatabases("phonetool", "test", "api").Return([]deploy.AddonDatabase{ordersDB}, nil)
+				m.restorer.EXPECT().Snapshots(gomock.Any()).Return(snapshots, nil)
+				m.spinner.EXPECT().Start(gomock.Any())
+				m.restorer.EXPECT().Restore(snapshots[1], "orders-db-abc-restored-20201015-120000").Return(nil, errors.New("some error"))
+				m.spinner.EXPECT().Stop(gomock.Any())
+			},
+			wantedError: "restore snapshot orders-db-abc-20201014-093000: some error",
+		},
+		"prompts for the snapshot and points the secret to the new database": {
+			setupMocks: func(m storageRestoreMocks) {
+				m.dbLister.EXPECT().ServiceAddonDatabases("phonetool", "test", "api").Return([]deploy.AddonDatabase{ordersDB}, nil)
+				m.restorer.EXPECT().Snapshots(gomock.Any()).Return(snapshots, nil)
+				m.prompt.EXPECT().SelectOne(storageRestoreSnapshotPrompt, gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_, _ string, options []string, _ ...interface{}) (string, error) {
+						return options[1], nil
+					})
+				m.spinner.EXPECT().Start(gomock.Any())
+				m.restorer.EXPECT().Restore(snapshots[1], "orders-db-abc-restored-20201015-120000").Return(&rds.Endpoint{
+					Host: "orders-db-abc-restored.cluster-xyz.us-west-2.rds.amazonaws.com",
+					Port: 5432,
+				}, nil)
+				m.spinner.EXPECT().Stop(gomock.Any())
+				m.secretUpdater.EXPECT().UpdateSecretFields(ordersDB.SecretARN, map[string]interface{}{
+					"host":                "orders-db-abc-restored.cluster-xyz.us-west-2.rds.amazonaws.com",
+					"port":                int64(5432),
+					"dbClusterIdentifier": "orders-db-abc-restored-20201015-120000",
+				}).Return(nil)
+			},
+		},
+		"wraps the error from updating the secret": {
+			inSnapshotID: "orders-db-abc-20201015-093000",
+			setupMocks: func(m storageRestoreMocks) {
+				m.dbLister.EXPECT().ServiceAddonDatabases("phonetool", "test", "api").Return([]deploy.AddonDatabase{ordersDB}, nil)
+				m.restorer.EXPECT().Snapshots(gomock.Any()).Return(snapshots, nil)
+				m.spinner.EXPECT().Start(gomock.Any())
+				m.restorer.EXPECT().Restore(snapshots[0], gomock.Any()).Return(&rds.Endpoint{}, nil)
+				m.spinner.EXPECT().Stop(gomock.Any())
+				m.secretUpdater.EXPECT().UpdateSecretFields(gomock.Any(), gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedError: "point secret of database ordersDB to orders-db-abc-restored-20201015-120000: some error",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := storageRestoreMocks{
+				prompt:        mocks.NewMockprompter(ctrl),
+				dbLister:      mocks.NewMockaddonDatabasesLister(ctrl),
+				restorer:      mocks.NewMockdbRestorer(ctrl),
+				secretUpdater: mocks.NewMocksecretFieldsUpdater(ctrl),
+				spinner:       mocks.NewMockprogress(ctrl),
+			}
+			tc.setupMocks(m)
+
+			opts := &storageRestoreOpts{
+				storageRestoreVars: storageRestoreVars{
+					svcName:    "api",
+					envName:    "test",
+					snapshotID: tc.inSnapshotID,
+					GlobalOpts: &GlobalOpts{
+						appName: "phonetool",
+						prompt:  m.prompt,
+					},
+				},
+				dbLister:      m.dbLister,
+				restorer:      m.restorer,
+				secretUpdater: m.secretUpdater,
+				spinner:       m.spinner,
+				now: func() time.Time {
+					return time.Date(2020, 10, 15, 12, 0, 0, 0, time.UTC)
+				},
+				initClients: func(*storageRestoreOpts) error { return nil },
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != "" {
+				require.EqualError(t, err, tc.wantedError)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestRestoredDBIdentifier(t *testing.T) {
+	now := time.Date(2020, 10, 15, 12, 0, 0, 0, time.UTC)
+
+	require.Equal(t, "orders-db-restored-20201015-120000", restoredDBIdentifier("orders-db", now))
+
+	id := restoredDBIdentifier(strings.Repeat("a", 60), now)
+	require.Len(t, id, maxDBIdentifierLength)
+	require.True(t, strings.HasSuffix(id, "-restored-20201015-120000"))
+}
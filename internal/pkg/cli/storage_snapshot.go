@@ -0,0 +1,277 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/rds"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+)
+
+const (
+	storageSnapshotAppNamePrompt     = "Which application is the service in?"
+	storageSnapshotAppNameHelpPrompt = "An application groups all of your services together."
+	storageSnapshotSvcNamePrompt     = "Which service's database would you like to snapshot?"
+	storageSnapshotSvcNameHelpPrompt = "The database is one of the addons of the service."
+
+	storageDatabasePrompt     = "Which database?"
+	storageDatabaseHelpPrompt = "The Aurora clusters and RDS DB instances in the addons of the service."
+
+	fmtSnapshotIDTimeLayout = "20060102-150405"
+)
+
+var errNoAddonDatabases = errors.New("no Aurora cluster or RDS DB instance found in the addons of the service")
+
+type storageSnapshotVars struct {
+	*GlobalOpts
+	svcName       string
+	envName       string
+	dbName        string
+	listSnapshots bool
+}
+
+type storageSnapshotOpts struct {
+	storageSnapshotVars
+
+	store       store
+	sel         deploySelector
+	dbLister    addonDatabasesLister
+	snapshotter dbSnapshotter
+	spinner     progress
+	w           io.Writer
+	now         func() time.Time
+	initClients func(*storageSnapshotOpts) error
+}
+
+func newStorageSnapshotOpts(vars storageSnapshotVars) (*storageSnapshotOpts, error) {
+	configStore, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("connect to environment datastore: %w", err)
+	}
+	deployStore, err := deploy.NewStore(configStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	return &storageSnapshotOpts{
+		storageSnapshotVars: vars,
+		store:               configStore,
+		sel:                 selector.NewDeploySelect(vars.prompt, configStore, deployStore),
+		spinner:             termprogress.NewSpinner(),
+		w:                   os.Stdout,
+		now:                 time.Now,
+		initClients: func(o *storageSnapshotOpts) error {
+			env, err := o.store.GetEnvironment(o.AppName(), o.envName)
+			if err != nil {
+				return fmt.Errorf("get environment %s: %w", o.envName, err)
+			}
+			sess, err := sessions.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
+			if err != nil {
+				return fmt.Errorf("assume role for environment %s: %w", env.Name, err)
+			}
+			o.dbLister = cloudformation.New(sess)
+			o.snapshotter = rds.New(sess)
+			return nil
+		},
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *storageSnapshotOpts) Validate() error {
+	return validateStorageDatabaseFlags(o.store, o.AppName(), o.svcName, o.envName)
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *storageSnapshotOpts) Ask() error {
+	if o.AppName() == "" {
+		app, err := o.sel.Application(storageSnapshotAppNamePrompt, storageSnapshotAppNameHelpPrompt)
+		if err != nil {
+			return fmt.Errorf("select application: %w", err)
+		}
+		o.appName = app
+	}
+	deployedService, err := o.sel.DeployedService(storageSnapshotSvcNamePrompt, storageSnapshotSvcNameHelpPrompt, o.AppName(), selector.WithEnv(o.envName), selector.WithSvc(o.svcName))
+	if err != nil {
+		return fmt.Errorf("select deployed services for application %s: %w", o.AppName(), err)
+	}
+	o.svcName = deployedService.Svc
+	o.envName = deployedService.Env
+	return nil
+}
+
+// Execute creates a snapshot of a database of the service's addons, or lists its snapshots.
+func (o *storageSnapshotOpts) Execute() error {
+	if err := o.initClients(o); err != nil {
+		return err
+	}
+	db, err := selectAddonDatabase(o.prompt, o.dbLister, o.AppName(), o.envName, o.svcName, o.dbName)
+	if err != nil {
+		return err
+	}
+	if o.listSnapshots {
+		snapshots, err := o.snapshotter.Snapshots(rdsDatabase(db, o.AppName(), o.envName, o.svcName))
+		if err != nil {
+			return fmt.Errorf("list snapshots of database %s: %w", db.LogicalID, err)
+		}
+		o.humanOutput(snapshots)
+		return nil
+	}
+	snapshotID := fmt.Sprintf("%s-%s", db.ID, o.now().UTC().Format(fmtSnapshotIDTimeLayout))
+	o.spinner.Start(fmt.Sprintf("Creating snapshot %s of database %s.", color.HighlightResource(snapshotID), color.HighlightUserInput(db.LogicalID)))
+	if err := o.snapshotter.CreateSnapshot(rdsDatabase(db, o.AppName(), o.envName, o.svcName), snapshotID); err != nil {
+		o.spinner.Stop(log.Serrorf("Failed to create snapshot %s of database %s.\n", snapshotID, db.LogicalID))
+		return fmt.Errorf("create snapshot of database %s: %w", db.LogicalID, err)
+	}
+	o.spinner.Stop(log.Ssuccessf("Created snapshot %s of database %s.\n", color.HighlightResource(snapshotID), color.HighlightUserInput(db.LogicalID)))
+	log.Infof("Run %s to restore it into a new database.\n",
+		color.HighlightCode(fmt.Sprintf("copilot storage restore -n %s -e %s --%s %s", o.svcName, o.envName, snapshotFlag, snapshotID)))
+	return nil
+}
+
+func (o *storageSnapshotOpts) humanOutput(snapshots []rds.Snapshot) {
+	if len(snapshots) == 0 {
+		log.Infoln("No snapshots found.")
+		return
+	}
+	writer := tabwriter.NewWriter(o.w, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	headers := []string{"Snapshot", "Type", "Status", "Created"}
+	rows := make([][]string, len(snapshots))
+	for i, s := range snapshots {
+		rows[i] = []string{s.ID, s.Type, s.Status, humanize.Time(s.CreatedAt)}
+	}
+	underlines := make([]string, len(headers))
+	for i, header := range headers {
+		width := len(header)
+		for _, row := range rows {
+			if len(row[i]) > width {
+				width = len(row[i])
+			}
+		}
+		underlines[i] = strings.Repeat("-", width)
+	}
+	fmt.Fprintf(writer, "%s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "%s\n", strings.Join(underlines, "\t"))
+	for _, row := range rows {
+		fmt.Fprintf(writer, "%s\n", strings.Join(row, "\t"))
+	}
+	writer.Flush()
+}
+
+// validateStorageDatabaseFlags returns an error if the application, service or environment don't exist.
+func validateStorageDatabaseFlags(s store, app, svc, env string) error {
+	if app != "" {
+		if _, err := s.GetApplication(app); err != nil {
+			return err
+		}
+	}
+	if svc != "" {
+		if _, err := s.GetService(app, svc); err != nil {
+			return err
+		}
+	}
+	if env != "" {
+		if _, err := s.GetEnvironment(app, env); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// selectAddonDatabase returns the database of the service's addons with the logical ID name.
+// If name is empty, the user is prompted to pick a database when the addons have more than one.
+func selectAddonDatabase(p prompter, lister addonDatabasesLister, app, env, svc, name string) (*deploy.AddonDatabase, error) {
+	dbs, err := lister.ServiceAddonDatabases(app, env, svc)
+	if err != nil {
+		return nil, fmt.Errorf("list databases of service %s in environment %s: %w", svc, env, err)
+	}
+	if len(dbs) == 0 {
+		return nil, errNoAddonDatabases
+	}
+	if name == "" && len(dbs) == 1 {
+		return &dbs[0], nil
+	}
+	if name == "" {
+		var names []string
+		for _, db := range dbs {
+			names = append(names, db.LogicalID)
+		}
+		name, err = p.SelectOne(storageDatabasePrompt, storageDatabaseHelpPrompt, names, prompt.WithFinalMessage("Database:"))
+		if err != nil {
+			return nil, fmt.Errorf("select database: %w", err)
+		}
+	}
+	for i := range dbs {
+		if dbs[i].LogicalID == name {
+			return &dbs[i], nil
+		}
+	}
+	return nil, fmt.Errorf("database %s not found in the addons of service %s", name, svc)
+}
+
+// rdsDatabase returns the database with the tags of the service, which the environment manager role
+// requires on the snapshots and restored databases that it creates.
+func rdsDatabase(db *deploy.AddonDatabase, app, env, svc string) rds.Database {
+	return rds.Database{
+		ID:      db.ID,
+		Cluster: db.Cluster,
+		Tags: map[string]string{
+			deploy.AppTagKey:     app,
+			deploy.EnvTagKey:     env,
+			deploy.ServiceTagKey: svc,
+		},
+	}
+}
+
+// BuildStorageSnapshotCmd builds the command for creating and listing the snapshots of a database addon.
+func BuildStorageSnapshotCmd() *cobra.Command {
+	vars := storageSnapshotVars{
+		GlobalOpts: NewGlobalOpts(),
+	}
+	cmd := &cobra.Command{
+		Use:   "snapshot",
+		Short: "Creates or lists the snapshots of a database addon.",
+		Long: `Creates or lists the snapshots of an Aurora cluster or RDS DB instance
+in the addons of a deployed service.`,
+
+		Example: `
+  Creates a snapshot of the database of the service "api" in the "prod" environment.
+  /code $ copilot storage snapshot -n api -e prod
+  Lists the snapshots of the "ordersDB" database.
+  /code $ copilot storage snapshot -n api -e prod --db ordersDB --list`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newStorageSnapshotOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.svcName, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVar(&vars.dbName, databaseFlag, "", databaseFlagDescription)
+	cmd.Flags().BoolVar(&vars.listSnapshots, listSnapshotsFlag, false, listSnapshotsFlagDescription)
+	return cmd
+}
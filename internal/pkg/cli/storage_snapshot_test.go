@@ -0,0 +1,153 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/rds"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type storageSnapshotMocks struct {
+	prompt      *mocks.Mockprompter
+	dbLister    *mocks.MockaddonDatabasesLister
+	snapshotter *mocks.MockdbSnapshotter
+	spinner     *mocks.Mockprogress
+}
+
+func TestStorageSnapshotOpts_Execute(t *testing.T) {
+	ordersDB := deploy.AddonDatabase{LogicalID: "ordersDB", ID: "orders-db-abc", Cluster: true}
+	usersDB := deploy.AddonDatabase{LogicalID: "usersDB", ID: "users-db-abc"}
+	testCases := map[string]struct {
+		inDBName        string
+		inListSnapshots bool
+		setupMocks      func(m storageSnapshotMocks)
+
+		wantedOutput string
+		wantedError  string
+	}{
+		"errors if the service has no databases": {
+			setupMocks: func(m storageSnapshotMocks) {
+				m.dbLister.EXPECT().ServiceAddonDatabases("phonetool", "test", "api").Return(nil, nil)
+			},
+			wantedError: errNoAddonDatabases.Error(),
+		},
+		"errors if the database doesn't exist": {
+			inDBName: "paymentsDB",
+			setupMocks: func(m storageSnapshotMocks) {
+				m.dbLister.EXPECT().ServiceAddonDatabases("phonetool", "test", "api").Return([]deploy.AddonDatabase{ordersDB}, nil)
+			},
+			wantedError: "database paymentsDB not found in the addons of service api",
+		},
+		"creates a snapshot of the only database": {
+			setupMocks: func(m storageSnapshotMocks) {
+				m.dbLister.EXPECT().ServiceAddonDatabases("phonetool", "test", "api").Return([]deploy.AddonDatabase{ordersDB}, nil)
+				m.prompt.EXPECT().SelectOne(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				m.spinner.EXPECT().Start(gomock.Any())
+				m.snapshotter.EXPECT().CreateSnapshot(rds.Database{ID: "orders-db-abc", Cluster: true, Tags: map[string]string{
+					"copilot-application": "phonetool",
+					"copilot-environment": "test",
+					"copilot-service":     "api",
+				}}, "orders-db-abc-20201015-093000").Return(nil)
+				m.spinner.EXPECT().Stop(gomock.Any())
+			},
+		},
+		"prompts for the database if there are several": {
+			setupMocks: func(m storageSnapshotMocks) {
+				m.dbLister.EXPECT().ServiceAddonDatabases("phonetool", "test", "api").Return([]deploy.AddonDatabase{ordersDB, usersDB}, nil)
+				m.prompt.EXPECT().SelectOne(storageDatabasePrompt, storageDatabaseHelpPrompt, []string{"ordersDB", "usersDB"}, gomock.Any()).Return("usersDB", nil)
+				m.spinner.EXPECT().Start(gomock.Any())
+				m.snapshotter.EXPECT().CreateSnapshot(rds.Database{ID: "users-db-abc", Tags: map[string]string{
+					"copilot-application": "phonetool",
+					"copilot-environment": "test",
+					"copilot-service":     "api",
+				}}, "users-db-abc-20201015-093000").Return(nil)
+				m.spinner.EXPECT().Stop(gomock.Any())
+			},
+		},
+		"wraps the error from creating the snapshot": {
+			inDBName: "ordersDB",
+			setupMocks: func(m storageSnapshotMocks) {
+				m.dbLister.EXPECT().ServiceAddonDatabases("phonetool", "test", "api").Return([]deploy.AddonDatabase{ordersDB, usersDB}, nil)
+				m.spinner.EXPECT().Start(gomock.Any())
+				m.snapshotter.EXPECT().CreateSnapshot(gomock.Any(), gomock.Any()).Return(errors.New("some error"))
+				m.spinner.EXPECT().Stop(gomock.Any())
+			},
+			wantedError: "create snapshot of database ordersDB: some error",
+		},
+		"lists the snapshots of the database": {
+			inListSnapshots: true,
+			setupMocks: func(m storageSnapshotMocks) {
+				m.dbLister.EXPECT().ServiceAddonDatabases("phonetool", "test", "api").Return([]deploy.AddonDatabase{ordersDB}, nil)
+				m.snapshotter.EXPECT().Snapshots(rds.Database{ID: "orders-db-abc", Cluster: true, Tags: map[string]string{
+					"copilot-application": "phonetool",
+					"copilot-environment": "test",
+					"copilot-service":     "api",
+				}}).Return([]rds.Snapshot{
+					{ID: "orders-db-abc-20201015-093000", Type: "manual", Status: "available", CreatedAt: time.Now().Add(-2 * time.Hour)},
+				}, nil)
+				m.snapshotter.EXPECT().CreateSnapshot(gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedOutput: `Snapshot                       Type                Status              Created
+-----------------------------  ------              ---------           -----------
+orders-db-abc-20201015-093000  manual              available           2 hours ago
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := storageSnapshotMocks{
+				prompt:      mocks.NewMockprompter(ctrl),
+				dbLister:    mocks.NewMockaddonDatabasesLister(ctrl),
+				snapshotter: mocks.NewMockdbSnapshotter(ctrl),
+				spinner:     mocks.NewMockprogress(ctrl),
+			}
+			tc.setupMocks(m)
+			b := &bytes.Buffer{}
+
+			opts := &storageSnapshotOpts{
+				storageSnapshotVars: storageSnapshotVars{
+					svcName:       "api",
+					envName:       "test",
+					dbName:        tc.inDBName,
+					listSnapshots: tc.inListSnapshots,
+					GlobalOpts: &GlobalOpts{
+						appName: "phonetool",
+						prompt:  m.prompt,
+					},
+				},
+				dbLister:    m.dbLister,
+				snapshotter: m.snapshotter,
+				spinner:     m.spinner,
+				w:           b,
+				now: func() time.Time {
+					return time.Date(2020, 10, 15, 9, 30, 0, 0, time.UTC)
+				},
+				initClients: func(*storageSnapshotOpts) error { return nil },
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != "" {
+				require.EqualError(t, err, tc.wantedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedOutput, b.String())
+		})
+	}
+}
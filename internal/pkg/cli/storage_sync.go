@@ -0,0 +1,347 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+const (
+	storageSyncSvcNamePrompt     = "Which service's bucket would you like to sync?"
+	storageSyncSvcNameHelpPrompt = "The bucket is one of the addons of the service."
+	storageSyncBucketPrompt      = "Which bucket?"
+	storageSyncBucketHelpPrompt  = "The S3 buckets in the addons of the service."
+
+	syncUploadConcurrency = 8 // Number of files that are uploaded in parallel.
+)
+
+var errNoAddonBuckets = errors.New("no S3 bucket found in the addons of the service")
+
+type storageSyncVars struct {
+	*GlobalOpts
+	svcName    string
+	envName    string
+	dir        string
+	bucketName string
+	include    []string
+	exclude    []string
+	delete     bool
+}
+
+type storageSyncOpts struct {
+	storageSyncVars
+
+	store         store
+	sel           deploySelector
+	fs            afero.Fs
+	bucketsLister addonBucketsLister
+	syncer        bucketSyncer
+	spinner       progress
+	initClients   func(*storageSyncOpts) error
+}
+
+func newStorageSyncOpts(vars storageSyncVars) (*storageSyncOpts, error) {
+	configStore, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("connect to environment datastore: %w", err)
+	}
+	deployStore, err := deploy.NewStore(configStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	return &storageSyncOpts{
+		storageSyncVars: vars,
+		store:           configStore,
+		sel:             selector.NewDeploySelect(vars.prompt, configStore, deployStore),
+		fs:              &afero.Afero{Fs: afero.NewOsFs()},
+		spinner:         termprogress.NewSpinner(),
+		initClients: func(o *storageSyncOpts) error {
+			env, err := o.store.GetEnvironment(o.AppName(), o.envName)
+			if err != nil {
+				return fmt.Errorf("get environment %s: %w", o.envName, err)
+			}
+			sess, err := sessions.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
+			if err != nil {
+				return fmt.Errorf("assume role for environment %s: %w", env.Name, err)
+			}
+			o.bucketsLister = cloudformation.New(sess)
+			o.syncer = s3.New(sess)
+			return nil
+		},
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *storageSyncOpts) Validate() error {
+	info, err := o.fs.Stat(o.dir)
+	if err != nil {
+		return fmt.Errorf("read directory %s: %w", o.dir, err)
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("%s is not a directory", o.dir)
+	}
+	for _, pattern := range append(append([]string{}, o.include...), o.exclude...) {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid pattern %s: %w", pattern, err)
+		}
+	}
+	return validateStorageDatabaseFlags(o.store, o.AppName(), o.svcName, o.envName)
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *storageSyncOpts) Ask() error {
+	if o.AppName() == "" {
+		app, err := o.sel.Application(storageSnapshotAppNamePrompt, storageSnapshotAppNameHelpPrompt)
+		if err != nil {
+			return fmt.Errorf("select application: %w", err)
+		}
+		o.appName = app
+	}
+	deployedService, err := o.sel.DeployedService(storageSyncSvcNamePrompt, storageSyncSvcNameHelpPrompt, o.AppName(), selector.WithEnv(o.envName), selector.WithSvc(o.svcName))
+	if err != nil {
+		return fmt.Errorf("select deployed services for application %s: %w", o.AppName(), err)
+	}
+	o.svcName = deployedService.Svc
+	o.envName = deployedService.Env
+	return nil
+}
+
+// Execute uploads the new and modified files of the directory to the bucket addon,
+// and deletes the objects that no longer exist locally if requested.
+func (o *storageSyncOpts) Execute() error {
+	if err := o.initClients(o); err != nil {
+		return err
+	}
+	bucket, err := o.selectBucket()
+	if err != nil {
+		return err
+	}
+	files, err := o.localFiles()
+	if err != nil {
+		return err
+	}
+	objects, err := o.syncer.Objects(bucket)
+	if err != nil {
+		return err
+	}
+
+	var uploads []string
+	for key, file := range files {
+		object, ok := objects[key]
+		if ok && object.Size == file.Size() && !file.ModTime().After(object.LastModified) {
+			continue
+		}
+		uploads = append(uploads, key)
+	}
+	sort.Strings(uploads)
+	var deletes []string
+	if o.delete {
+		for key := range objects {
+			if _, ok := files[key]; !ok && o.matches(key) {
+				deletes = append(deletes, key)
+			}
+		}
+		sort.Strings(deletes)
+	}
+
+	if len(uploads) > 0 {
+		o.spinner.Start(fmt.Sprintf("Uploading %d files to bucket %s.", len(uploads), color.HighlightResource(bucket)))
+		if err := o.upload(bucket, uploads); err != nil {
+			o.spinner.Stop(log.Serrorf("Failed to upload files to bucket %s.\n", bucket))
+			return err
+		}
+		o.spinner.Stop(log.Ssuccessf("Uploaded %d files to bucket %s.\n", len(uploads), color.HighlightResource(bucket)))
+	}
+	if len(deletes) > 0 {
+		o.spinner.Start(fmt.Sprintf("Deleting %d objects from bucket %s.", len(deletes), color.HighlightResource(bucket)))
+		if err := o.syncer.DeleteObjects(bucket, deletes); err != nil {
+			o.spinner.Stop(log.Serrorf("Failed to delete objects from bucket %s.\n", bucket))
+			return err
+		}
+		o.spinner.Stop(log.Ssuccessf("Deleted %d objects from bucket %s.\n", len(deletes), color.HighlightResource(bucket)))
+	}
+	if len(uploads) == 0 && len(deletes) == 0 {
+		log.Infof("Bucket %s is already in sync with %s.\n", color.HighlightResource(bucket), color.HighlightUserInput(o.dir))
+	}
+	return nil
+}
+
+// selectBucket returns the name of the bucket addon passed by the user, or prompts for one if the service has several.
+func (o *storageSyncOpts) selectBucket() (string, error) {
+	buckets, err := o.bucketsLister.ServiceAddonBuckets(o.AppName(), o.envName, o.svcName)
+	if err != nil {
+		return "", fmt.Errorf("list buckets of service %s in environment %s: %w", o.svcName, o.envName, err)
+	}
+	if len(buckets) == 0 {
+		return "", errNoAddonBuckets
+	}
+	var ids []string
+	for id := range buckets {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	name := o.bucketName
+	switch {
+	case name == "" && len(ids) == 1:
+		name = ids[0]
+	case name == "":
+		name, err = o.prompt.SelectOne(storageSyncBucketPrompt, storageSyncBucketHelpPrompt, ids, prompt.WithFinalMessage("Bucket:"))
+		if err != nil {
+			return "", fmt.Errorf("select bucket: %w", err)
+		}
+	}
+	// Addons created by "storage init" are named after the logical ID of their bucket without its dashes.
+	for _, id := range []string{name, template.StripNonAlphaNumFunc(name)} {
+		if bucket, ok := buckets[id]; ok {
+			return bucket, nil
+		}
+	}
+	return "", fmt.Errorf("bucket %s not found in the addons of service %s", name, o.svcName)
+}
+
+// localFiles returns the files of the directory that match the filters, keyed by their slash-separated path relative to the directory.
+func (o *storageSyncOpts) localFiles() (map[string]os.FileInfo, error) {
+	files := make(map[string]os.FileInfo)
+	err := afero.Walk(o.fs, o.dir, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(o.dir, p)
+		if err != nil {
+			return err
+		}
+		key := filepath.ToSlash(rel)
+		if o.matches(key) {
+			files[key] = info
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("walk directory %s: %w", o.dir, err)
+	}
+	return files, nil
+}
+
+// matches returns true if the key matches one of the include patterns, if any, and none of the exclude patterns.
+// Patterns are matched against both the key and its file name.
+func (o *storageSyncOpts) matches(key string) bool {
+	match := func(patterns []string) bool {
+		for _, pattern := range patterns {
+			if ok, _ := path.Match(pattern, key); ok {
+				return true
+			}
+			if ok, _ := path.Match(pattern, path.Base(key)); ok {
+				return true
+			}
+		}
+		return false
+	}
+	if len(o.include) > 0 && !match(o.include) {
+		return false
+	}
+	return !match(o.exclude)
+}
+
+// upload uploads the files with the keys to the bucket, several files at a time.
+func (o *storageSyncOpts) upload(bucket string, keys []string) error {
+	queue := make(chan string)
+	errs := make(chan error, len(keys))
+	var wg sync.WaitGroup
+	for i := 0; i < syncUploadConcurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for key := range queue {
+				errs <- o.uploadFile(bucket, key)
+			}
+		}()
+	}
+	for _, key := range keys {
+		queue <- key
+	}
+	close(queue)
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (o *storageSyncOpts) uploadFile(bucket, key string) error {
+	f, err := o.fs.Open(filepath.Join(o.dir, filepath.FromSlash(key)))
+	if err != nil {
+		return fmt.Errorf("open file %s: %w", key, err)
+	}
+	defer f.Close()
+	return o.syncer.UploadObject(bucket, key, f)
+}
+
+// BuildStorageSyncCmd builds the command for syncing a local directory to a bucket addon.
+func BuildStorageSyncCmd() *cobra.Command {
+	vars := storageSyncVars{
+		GlobalOpts: NewGlobalOpts(),
+	}
+	cmd := &cobra.Command{
+		Use:   "sync <local-dir> [<bucket-addon>]",
+		Short: "Uploads a local directory to an S3 bucket addon.",
+		Long: `Uploads the new and modified files of a local directory to an S3 bucket in the addons of a deployed service.
+Files are compared by size and modification time, large files are uploaded in parts.`,
+
+		Example: `
+  Uploads the "public" directory to the "assets" bucket of the service "web" in the "test" environment.
+  /code $ copilot storage sync ./public assets -n web -e test
+  Uploads only the images, and deletes the images in the bucket that no longer exist locally.
+  /code $ copilot storage sync ./public assets -n web -e test --include "*.png" --include "*.jpg" --delete`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			vars.dir = args[0]
+			if len(args) > 1 {
+				vars.bucketName = args[1]
+			}
+			opts, err := newStorageSyncOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.svcName, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringArrayVar(&vars.include, includeFlag, nil, includeFlagDescription)
+	cmd.Flags().StringArrayVar(&vars.exclude, excludeFlag, nil, excludeFlagDescription)
+	cmd.Flags().BoolVar(&vars.delete, deleteFlag, false, deleteFlagDescription)
+	return cmd
+}
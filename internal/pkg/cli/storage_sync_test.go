@@ -0,0 +1,232 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"io"
+	"io/ioutil"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+type storageSyncMocks struct {
+	prompt        *mocks.Mockprompter
+	bucketsLister *mocks.MockaddonBucketsLister
+	syncer        *mocks.MockbucketSyncer
+	spinner       *mocks.Mockprogress
+}
+
+func TestStorageSyncOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inDir     string
+		inExclude []string
+
+		wantedErr string
+	}{
+		"errors if the directory doesn't exist": {
+			inDir:     "missing",
+			wantedErr: "read directory missing: open missing: file does not exist",
+		},
+		"errors if the path is a file": {
+			inDir:     "public/index.html",
+			wantedErr: "public/index.html is not a directory",
+		},
+		"errors if a pattern is malformed": {
+			inDir:     "public",
+			inExclude: []string{"[a-"},
+			wantedErr: "invalid pattern [a-: syntax error in pattern",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			require.NoError(t, afero.WriteFile(fs, "public/index.html", []byte("<html></html>"), 0644))
+			opts := &storageSyncOpts{
+				storageSyncVars: storageSyncVars{
+					GlobalOpts: &GlobalOpts{},
+					dir:        tc.inDir,
+					exclude:    tc.inExclude,
+				},
+				fs: fs,
+			}
+
+			err := opts.Validate()
+
+			require.EqualError(t, err, tc.wantedErr)
+		})
+	}
+}
+
+func TestStorageSyncOpts_Execute(t *testing.T) {
+	buckets := map[string]string{
+		"assets":  "phonetool-test-web-assets",
+		"uploads": "phonetool-test-web-uploads",
+	}
+	past, future := time.Now().Add(-time.Hour), time.Now().Add(time.Hour)
+	testCases := map[string]struct {
+		inBucket   string
+		inInclude  []string
+		inExclude  []string
+		inDelete   bool
+		setupMocks func(m storageSyncMocks, uploaded *uploadedKeys)
+
+		wantedUploads []string
+		wantedErr     string
+	}{
+		"errors if the service has no buckets": {
+			setupMocks: func(m storageSyncMocks, _ *uploadedKeys) {
+				m.bucketsLister.EXPECT().ServiceAddonBuckets("phonetool", "test", "web").Return(nil, nil)
+			},
+			wantedErr: errNoAddonBuckets.Error(),
+		},
+		"errors if the bucket addon doesn't exist": {
+			inBucket: "images",
+			setupMocks: func(m storageSyncMocks, _ *uploadedKeys) {
+				m.bucketsLister.EXPECT().ServiceAddonBuckets("phonetool", "test", "web").Return(buckets, nil)
+			},
+			wantedErr: "bucket images not found in the addons of service web",
+		},
+		"uploads new and modified files only": {
+			inBucket: "assets",
+			setupMocks: func(m storageSyncMocks, uploaded *uploadedKeys) {
+				m.bucketsLister.EXPECT().ServiceAddonBuckets("phonetool", "test", "web").Return(buckets, nil)
+				m.syncer.EXPECT().Objects("phonetool-test-web-assets").Return(map[string]s3.Object{
+					"index.html":   {Key: "index.html", Size: 13, LastModified: future},  // Unchanged.
+					"css/app.css":  {Key: "css/app.css", Size: 11, LastModified: past},   // Modified since.
+					"old.html":     {Key: "old.html", Size: 5, LastModified: past},       // Not deleted without --delete.
+					"img/logo.png": {Key: "img/logo.png", Size: 1, LastModified: future}, // Different size.
+				}, nil)
+				m.spinner.EXPECT().Start("Uploading 3 files to bucket phonetool-test-web-assets.")
+				m.syncer.EXPECT().UploadObject("phonetool-test-web-assets", gomock.Any(), gomock.Any()).DoAndReturn(uploaded.record).Times(3)
+				m.spinner.EXPECT().Stop(gomock.Any())
+				m.syncer.EXPECT().DeleteObjects(gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedUploads: []string{"css/app.css", "img/logo.png", "js/app.js"},
+		},
+		"prompts for the bucket and filters the files": {
+			inInclude: []string{"*.html", "*.png"},
+			inExclude: []string{"img/*"},
+			setupMocks: func(m storageSyncMocks, uploaded *uploadedKeys) {
+				m.bucketsLister.EXPECT().ServiceAddonBuckets("phonetool", "test", "web").Return(buckets, nil)
+				m.prompt.EXPECT().SelectOne(storageSyncBucketPrompt, storageSyncBucketHelpPrompt, []string{"assets", "uploads"}, gomock.Any()).Return("uploads", nil)
+				m.syncer.EXPECT().Objects("phonetool-test-web-uploads").Return(nil, nil)
+				m.spinner.EXPECT().Start(gomock.Any())
+				m.syncer.EXPECT().UploadObject("phonetool-test-web-uploads", gomock.Any(), gomock.Any()).DoAndReturn(uploaded.record)
+				m.spinner.EXPECT().Stop(gomock.Any())
+			},
+			wantedUploads: []string{"index.html"},
+		},
+		"deletes the objects that don't exist locally and match the filters": {
+			inBucket:  "assets",
+			inExclude: []string{"*.css", "*.js", "*.png"},
+			inDelete:  true,
+			setupMocks: func(m storageSyncMocks, _ *uploadedKeys) {
+				m.bucketsLister.EXPECT().ServiceAddonBuckets("phonetool", "test", "web").Return(buckets, nil)
+				m.syncer.EXPECT().Objects("phonetool-test-web-assets").Return(map[string]s3.Object{
+					"index.html": {Key: "index.html", Size: 13, LastModified: future},
+					"old.html":   {Key: "old.html", Size: 5, LastModified: past},
+					"old.css":    {Key: "old.css", Size: 5, LastModified: past},
+				}, nil)
+				m.spinner.EXPECT().Start("Deleting 1 objects from bucket phonetool-test-web-assets.")
+				m.syncer.EXPECT().DeleteObjects("phonetool-test-web-assets", []string{"old.html"}).Return(nil)
+				m.spinner.EXPECT().Stop(gomock.Any())
+			},
+		},
+		"returns the error from uploading a file": {
+			inBucket: "assets",
+			setupMocks: func(m storageSyncMocks, _ *uploadedKeys) {
+				m.bucketsLister.EXPECT().ServiceAddonBuckets("phonetool", "test", "web").Return(buckets, nil)
+				m.syncer.EXPECT().Objects(gomock.Any()).Return(nil, nil)
+				m.spinner.EXPECT().Start(gomock.Any())
+				m.syncer.EXPECT().UploadObject(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("some error")).AnyTimes()
+				m.spinner.EXPECT().Stop(gomock.Any())
+			},
+			wantedErr: "some error",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := storageSyncMocks{
+				prompt:        mocks.NewMockprompter(ctrl),
+				bucketsLister: mocks.NewMockaddonBucketsLister(ctrl),
+				syncer:        mocks.NewMockbucketSyncer(ctrl),
+				spinner:       mocks.NewMockprogress(ctrl),
+			}
+			uploaded := &uploadedKeys{}
+			tc.setupMocks(m, uploaded)
+			fs := afero.NewMemMapFs()
+			require.NoError(t, afero.WriteFile(fs, "public/index.html", []byte("<html></html>"), 0644))
+			require.NoError(t, afero.WriteFile(fs, "public/css/app.css", []byte("body {}    "), 0644))
+			require.NoError(t, afero.WriteFile(fs, "public/js/app.js", []byte("alert(1)"), 0644))
+			require.NoError(t, afero.WriteFile(fs, "public/img/logo.png", []byte("png"), 0644))
+
+			opts := &storageSyncOpts{
+				storageSyncVars: storageSyncVars{
+					svcName:    "web",
+					envName:    "test",
+					dir:        "public",
+					bucketName: tc.inBucket,
+					include:    tc.inInclude,
+					exclude:    tc.inExclude,
+					delete:     tc.inDelete,
+					GlobalOpts: &GlobalOpts{
+						appName: "phonetool",
+						prompt:  m.prompt,
+					},
+				},
+				fs:            fs,
+				bucketsLister: m.bucketsLister,
+				syncer:        m.syncer,
+				spinner:       m.spinner,
+				initClients:   func(*storageSyncOpts) error { return nil },
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedUploads, uploaded.sorted())
+		})
+	}
+}
+
+// uploadedKeys records the keys uploaded by concurrent workers.
+type uploadedKeys struct {
+	mu   sync.Mutex
+	keys []string
+}
+
+func (u *uploadedKeys) record(_, key string, data io.Reader) error {
+	if _, err := ioutil.ReadAll(data); err != nil {
+		return err
+	}
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.keys = append(u.keys, key)
+	return nil
+}
+
+func (u *uploadedKeys) sorted() []string {
+	sort.Strings(u.keys)
+	return u.keys
+}
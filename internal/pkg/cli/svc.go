@@ -34,7 +34,11 @@ Services are long-running Amazon ECS services.`,
 	cmd.AddCommand(BuildSvcDeleteCmd())
 	cmd.AddCommand(BuildSvcShowCmd())
 	cmd.AddCommand(BuildSvcStatusCmd())
+	cmd.AddCommand(BuildSvcPsCmd())
+	cmd.AddCommand(BuildSvcRebalanceCmd())
+	cmd.AddCommand(BuildSvcDiffCmd())
 	cmd.AddCommand(BuildSvcLogsCmd())
+	cmd.AddCommand(BuildSvcExecCmd())
 
 	cmd.SetUsageTemplate(template.Usage)
 
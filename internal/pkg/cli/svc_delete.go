@@ -17,6 +17,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/dustin/go-humanize/english"
 	"github.com/spf13/cobra"
 )
 
@@ -36,6 +37,15 @@ const (
 	fmtSvcDeleteResourcesComplete = "Deleted service %s resources from application %s."
 )
 
+const (
+	fmtSvcDeleteRetainDataPrompt = "Service %s has addons that hold data in environment %s: %s. Would you like to keep their data?"
+	svcDeleteRetainDataHelp      = `Keeps the tables, buckets and file systems of the addons after the service is deleted,
+and takes a final snapshot of its databases before deleting them.`
+	fmtSvcRetainDataStart    = "Retaining the data of the addons of service %s in environment %s."
+	fmtSvcRetainDataFailed   = "Failed to retain the data of the addons of service %s in environment %s: %v."
+	fmtSvcRetainDataComplete = "Retained the data of the addons of service %s in environment %s."
+)
+
 var (
 	errSvcDeleteCancelled = errors.New("svc delete cancelled - no changes made")
 )
@@ -45,6 +55,8 @@ type deleteSvcVars struct {
 	SkipConfirmation bool
 	Name             string
 	EnvName          string
+	Confirm          []string
+	RetainData       bool
 }
 
 type deleteSvcOpts struct {
@@ -58,8 +70,11 @@ type deleteSvcOpts struct {
 	getSvcCFN func(session *awssession.Session) svcDeleter
 	getECR    func(session *awssession.Session) imageRemover
 
+	getAddonsRetainer func(session *awssession.Session) svcAddonsRetainer
+
 	// Internal state.
 	environments []*config.Environment
+	retained     map[string][]deploy.RetainedResource // Resources kept by environment name.
 }
 
 func newDeleteSvcOpts(vars deleteSvcVars) (*deleteSvcOpts, error) {
@@ -87,6 +102,9 @@ func newDeleteSvcOpts(vars deleteSvcVars) (*deleteSvcOpts, error) {
 		getECR: func(session *awssession.Session) imageRemover {
 			return ecr.New(session)
 		},
+		getAddonsRetainer: func(session *awssession.Session) svcAddonsRetainer {
+			return cloudformation.New(session)
+		},
 	}, nil
 }
 
@@ -150,10 +168,16 @@ func (o *deleteSvcOpts) Execute() error {
 	if err := o.appEnvironments(); err != nil {
 		return err
 	}
+	for _, env := range o.environments {
+		if err := confirmProtectedEnv(o.prompt, env, o.Confirm); err != nil {
+			return err
+		}
+	}
 
 	if err := o.deleteStacks(); err != nil {
 		return err
 	}
+	o.logRetainedResources()
 
 	// Skip removing the service from the application if
 	// we are only removing the stack from a particular environment.
@@ -260,13 +284,18 @@ func (o *deleteSvcOpts) deleteStacks() error {
 			return err
 		}
 
-		cfClient := o.getSvcCFN(sess)
-		o.spinner.Start(fmt.Sprintf(fmtSvcDeleteStart, o.Name, env.Name))
-		if err := cfClient.DeleteService(deploy.DeleteServiceInput{
+		in := deploy.DeleteServiceInput{
 			Name:    o.Name,
 			EnvName: env.Name,
 			AppName: o.appName,
-		}); err != nil {
+		}
+		if err := o.retainAddonsData(o.getAddonsRetainer(sess), in); err != nil {
+			return err
+		}
+
+		cfClient := o.getSvcCFN(sess)
+		o.spinner.Start(fmt.Sprintf(fmtSvcDeleteStart, o.Name, env.Name))
+		if err := cfClient.DeleteService(in); err != nil {
 			o.spinner.Stop(log.Serrorf(fmtSvcDeleteFailed, o.Name, env.Name, err))
 			return err
 		}
@@ -275,6 +304,68 @@ func (o *deleteSvcOpts) deleteStacks() error {
 	return nil
 }
 
+// retainAddonsData keeps the data of the stateful resources of the service's addons in the environment
+// if the user passed --retain-data or agrees to keep it.
+func (o *deleteSvcOpts) retainAddonsData(retainer svcAddonsRetainer, in deploy.DeleteServiceInput) error {
+	resources, err := retainer.ServiceStatefulAddons(in)
+	if err != nil {
+		return fmt.Errorf("get stateful addons of service %s in environment %s: %w", o.Name, in.EnvName, err)
+	}
+	if len(resources) == 0 {
+		return nil
+	}
+	if !o.RetainData {
+		if o.SkipConfirmation {
+			return nil
+		}
+		retain, err := o.prompt.Confirm(
+			fmt.Sprintf(fmtSvcDeleteRetainDataPrompt, color.HighlightUserInput(o.Name), color.HighlightUserInput(in.EnvName), english.WordSeries(resources, "and")),
+			svcDeleteRetainDataHelp)
+		if err != nil {
+			return fmt.Errorf("confirm retaining the data of service %s: %w", o.Name, err)
+		}
+		if !retain {
+			return nil
+		}
+	}
+	o.spinner.Start(fmt.Sprintf(fmtSvcRetainDataStart, o.Name, in.EnvName))
+	retained, err := retainer.RetainServiceStatefulAddons(in)
+	if err != nil {
+		o.spinner.Stop(log.Serrorf(fmtSvcRetainDataFailed, o.Name, in.EnvName, err))
+		return err
+	}
+	o.spinner.Stop(log.Ssuccessf(fmtSvcRetainDataComplete, o.Name, in.EnvName))
+	if o.retained == nil {
+		o.retained = make(map[string][]deploy.RetainedResource)
+	}
+	o.retained[in.EnvName] = retained
+	return nil
+}
+
+// logRetainedResources prints the resources that outlived the service and how to attach them to a service again.
+func (o *deleteSvcOpts) logRetainedResources() {
+	if len(o.retained) == 0 {
+		return
+	}
+	for _, env := range o.environments {
+		resources := o.retained[env.Name]
+		if len(resources) == 0 {
+			continue
+		}
+		log.Infof("Kept the data of the addons of service %s in environment %s:\n", color.HighlightUserInput(o.Name), color.HighlightUserInput(env.Name))
+		for _, resource := range resources {
+			if resource.DeletionPolicy == "Snapshot" {
+				log.Infof("- %s: final snapshot of database %s\n", resource.LogicalID, color.HighlightResource(resource.PhysicalID))
+				continue
+			}
+			log.Infof("- %s: %s\n", resource.LogicalID, color.HighlightResource(resource.PhysicalID))
+		}
+	}
+	log.Infof(`To attach them to a service again, reference them by name from its addons template or import them into its addons stack
+with %s, and restore databases from their final snapshot with %s.
+`, color.HighlightCode("aws cloudformation create-change-set --change-set-type IMPORT"), color.HighlightCode("SnapshotIdentifier"))
+}
+
 // This is to make mocking easier in unit tests
 func (o *deleteSvcOpts) emptyECRRepos() error {
 	var uniqueRegions []string
@@ -348,7 +439,10 @@ func BuildSvcDeleteCmd() *cobra.Command {
   /code $ copilot svc delete --name test --env prod
 
   Delete the "test" service without confirmation prompt.
-  /code $ copilot svc delete --name test --yes`,
+  /code $ copilot svc delete --name test --yes
+
+  Delete the "test" service from the prod environment, but keep the data of its addons.
+  /code $ copilot svc delete --name test --env prod --retain-data`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newDeleteSvcOpts(vars)
 			if err != nil {
@@ -375,5 +469,7 @@ func BuildSvcDeleteCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.Name, nameFlag, nameFlagShort, "", svcFlagDescription)
 	cmd.Flags().StringVarP(&vars.EnvName, envFlag, envFlagShort, "", envFlagDescription)
 	cmd.Flags().BoolVar(&vars.SkipConfirmation, yesFlag, false, yesFlagDescription)
+	cmd.Flags().StringSliceVar(&vars.Confirm, confirmFlag, nil, confirmFlagDescription)
+	cmd.Flags().BoolVar(&vars.RetainData, retainDataFlag, false, retainDataFlagDescription)
 	return cmd
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -290,6 +291,8 @@ type deleteSvcMocks struct {
 	spinner        *mocks.Mockprogress
 	svcCFN         *mocks.MocksvcDeleter
 	ecr            *mocks.MockimageRemover
+	retainer       *mocks.MocksvcAddonsRetainer
+	prompt         *mocks.Mockprompter
 }
 
 func TestDeleteSvcOpts_Execute(t *testing.T) {
@@ -310,10 +313,18 @@ func TestDeleteSvcOpts_Execute(t *testing.T) {
 	mockRepo := fmt.Sprintf("%s/%s", mockAppName, mockSvcName)
 	testError := errors.New("some error")
 
+	mockDeleteInput := deploy.DeleteServiceInput{
+		Name:    mockSvcName,
+		EnvName: mockEnvName,
+		AppName: mockAppName,
+	}
+
 	tests := map[string]struct {
-		inAppName string
-		inEnvName string
-		inSvcName string
+		inAppName          string
+		inEnvName          string
+		inSvcName          string
+		inRetainData       bool
+		inSkipConfirmation bool
 
 		setupMocks func(mocks deleteSvcMocks)
 
@@ -327,6 +338,7 @@ func TestDeleteSvcOpts_Execute(t *testing.T) {
 					// appEnvironments
 					mocks.store.EXPECT().ListEnvironments(gomock.Eq(mockAppName)).Times(1).Return(mockEnvs, nil),
 					// deleteStacks
+					mocks.retainer.EXPECT().ServiceStatefulAddons(gomock.Any()).Return(nil, nil),
 					mocks.spinner.EXPECT().Start(fmt.Sprintf(fmtSvcDeleteStart, mockSvcName, mockEnvName)),
 					mocks.svcCFN.EXPECT().DeleteService(gomock.Any()).Return(nil),
 					mocks.spinner.EXPECT().Stop(log.Ssuccessf(fmtSvcDeleteComplete, mockSvcName, mockEnvName)),
@@ -357,6 +369,7 @@ func TestDeleteSvcOpts_Execute(t *testing.T) {
 					// appEnvironments
 					mocks.store.EXPECT().GetEnvironment(mockAppName, mockEnvName).Times(1).Return(mockEnv, nil),
 					// deleteStacks
+					mocks.retainer.EXPECT().ServiceStatefulAddons(gomock.Any()).Return(nil, nil),
 					mocks.spinner.EXPECT().Start(fmt.Sprintf(fmtSvcDeleteStart, mockSvcName, mockEnvName)),
 					mocks.svcCFN.EXPECT().DeleteService(gomock.Any()).Return(nil),
 					mocks.spinner.EXPECT().Stop(log.Ssuccessf(fmtSvcDeleteComplete, mockSvcName, mockEnvName)),
@@ -382,6 +395,7 @@ func TestDeleteSvcOpts_Execute(t *testing.T) {
 					// appEnvironments
 					mocks.store.EXPECT().GetEnvironment(mockAppName, mockEnvName).Times(1).Return(mockEnv, nil),
 					// deleteStacks
+					mocks.retainer.EXPECT().ServiceStatefulAddons(gomock.Any()).Return(nil, nil),
 					mocks.spinner.EXPECT().Start(fmt.Sprintf(fmtSvcDeleteStart, mockSvcName, mockEnvName)),
 					mocks.svcCFN.EXPECT().DeleteService(gomock.Any()).Return(testError),
 					mocks.spinner.EXPECT().Stop(log.Serrorf(fmtSvcDeleteFailed, mockSvcName, mockEnvName, testError)),
@@ -389,6 +403,79 @@ func TestDeleteSvcOpts_Execute(t *testing.T) {
 			},
 			wantedError: testError,
 		},
+		"retains the data of the addons if the user agrees": {
+			inAppName: mockAppName,
+			inSvcName: mockSvcName,
+			inEnvName: mockEnvName,
+			setupMocks: func(mocks deleteSvcMocks) {
+				gomock.InOrder(
+					mocks.store.EXPECT().GetEnvironment(mockAppName, mockEnvName).Return(mockEnv, nil),
+					mocks.retainer.EXPECT().ServiceStatefulAddons(mockDeleteInput).Return([]string{"usersTable"}, nil),
+					mocks.prompt.EXPECT().Confirm(gomock.Any(), svcDeleteRetainDataHelp).Return(true, nil),
+					mocks.spinner.EXPECT().Start(fmt.Sprintf(fmtSvcRetainDataStart, mockSvcName, mockEnvName)),
+					mocks.retainer.EXPECT().RetainServiceStatefulAddons(mockDeleteInput).Return([]deploy.RetainedResource{
+						{
+							LogicalID:      "usersTable",
+							PhysicalID:     "badgoose-test-users",
+							DeletionPolicy: "Retain",
+						},
+					}, nil),
+					mocks.spinner.EXPECT().Stop(log.Ssuccessf(fmtSvcRetainDataComplete, mockSvcName, mockEnvName)),
+					mocks.spinner.EXPECT().Start(fmt.Sprintf(fmtSvcDeleteStart, mockSvcName, mockEnvName)),
+					mocks.svcCFN.EXPECT().DeleteService(mockDeleteInput).Return(nil),
+					mocks.spinner.EXPECT().Stop(log.Ssuccessf(fmtSvcDeleteComplete, mockSvcName, mockEnvName)),
+				)
+			},
+		},
+		"deletes the data of the addons if the user declines": {
+			inAppName: mockAppName,
+			inSvcName: mockSvcName,
+			inEnvName: mockEnvName,
+			setupMocks: func(mocks deleteSvcMocks) {
+				gomock.InOrder(
+					mocks.store.EXPECT().GetEnvironment(mockAppName, mockEnvName).Return(mockEnv, nil),
+					mocks.retainer.EXPECT().ServiceStatefulAddons(mockDeleteInput).Return([]string{"usersTable"}, nil),
+					mocks.prompt.EXPECT().Confirm(gomock.Any(), svcDeleteRetainDataHelp).Return(false, nil),
+					mocks.retainer.EXPECT().RetainServiceStatefulAddons(gomock.Any()).Times(0),
+					mocks.spinner.EXPECT().Start(fmt.Sprintf(fmtSvcDeleteStart, mockSvcName, mockEnvName)),
+					mocks.svcCFN.EXPECT().DeleteService(mockDeleteInput).Return(nil),
+					mocks.spinner.EXPECT().Stop(log.Ssuccessf(fmtSvcDeleteComplete, mockSvcName, mockEnvName)),
+				)
+			},
+		},
+		"does not prompt to retain the data with --yes": {
+			inAppName:          mockAppName,
+			inSvcName:          mockSvcName,
+			inEnvName:          mockEnvName,
+			inSkipConfirmation: true,
+			setupMocks: func(mocks deleteSvcMocks) {
+				gomock.InOrder(
+					mocks.store.EXPECT().GetEnvironment(mockAppName, mockEnvName).Return(mockEnv, nil),
+					mocks.retainer.EXPECT().ServiceStatefulAddons(mockDeleteInput).Return([]string{"usersTable"}, nil),
+					mocks.prompt.EXPECT().Confirm(gomock.Any(), gomock.Any()).Times(0),
+					mocks.spinner.EXPECT().Start(fmt.Sprintf(fmtSvcDeleteStart, mockSvcName, mockEnvName)),
+					mocks.svcCFN.EXPECT().DeleteService(mockDeleteInput).Return(nil),
+					mocks.spinner.EXPECT().Stop(log.Ssuccessf(fmtSvcDeleteComplete, mockSvcName, mockEnvName)),
+				)
+			},
+		},
+		"does not delete the service if its data can't be retained": {
+			inAppName:    mockAppName,
+			inSvcName:    mockSvcName,
+			inEnvName:    mockEnvName,
+			inRetainData: true,
+			setupMocks: func(mocks deleteSvcMocks) {
+				gomock.InOrder(
+					mocks.store.EXPECT().GetEnvironment(mockAppName, mockEnvName).Return(mockEnv, nil),
+					mocks.retainer.EXPECT().ServiceStatefulAddons(mockDeleteInput).Return([]string{"usersTable"}, nil),
+					mocks.spinner.EXPECT().Start(fmt.Sprintf(fmtSvcRetainDataStart, mockSvcName, mockEnvName)),
+					mocks.retainer.EXPECT().RetainServiceStatefulAddons(mockDeleteInput).Return(nil, testError),
+					mocks.spinner.EXPECT().Stop(log.Serrorf(fmtSvcRetainDataFailed, mockSvcName, mockEnvName, testError)),
+					mocks.svcCFN.EXPECT().DeleteService(gomock.Any()).Times(0),
+				)
+			},
+			wantedError: testError,
+		},
 	}
 
 	for name, test := range tests {
@@ -411,6 +498,8 @@ func TestDeleteSvcOpts_Execute(t *testing.T) {
 			mockGetImageRemover := func(_ *session.Session) imageRemover {
 				return mockImageRemover
 			}
+			mockRetainer := mocks.NewMocksvcAddonsRetainer(ctrl)
+			mockPrompt := mocks.NewMockprompter(ctrl)
 			mocks := deleteSvcMocks{
 				store:          mockstore,
 				secretsmanager: mockSecretsManager,
@@ -419,6 +508,8 @@ func TestDeleteSvcOpts_Execute(t *testing.T) {
 				spinner:        mockSpinner,
 				svcCFN:         mockSvcCFN,
 				ecr:            mockImageRemover,
+				retainer:       mockRetainer,
+				prompt:         mockPrompt,
 			}
 
 			test.setupMocks(mocks)
@@ -427,9 +518,12 @@ func TestDeleteSvcOpts_Execute(t *testing.T) {
 				deleteSvcVars: deleteSvcVars{
 					GlobalOpts: &GlobalOpts{
 						appName: test.inAppName,
+						prompt:  mockPrompt,
 					},
-					Name:    test.inSvcName,
-					EnvName: test.inEnvName,
+					Name:             test.inSvcName,
+					EnvName:          test.inEnvName,
+					RetainData:       test.inRetainData,
+					SkipConfirmation: test.inSkipConfirmation,
 				},
 				store:     mockstore,
 				sess:      mockSession,
@@ -437,6 +531,9 @@ func TestDeleteSvcOpts_Execute(t *testing.T) {
 				appCFN:    mockAppCFN,
 				getSvcCFN: mockGetSvcCFN,
 				getECR:    mockGetImageRemover,
+				getAddonsRetainer: func(_ *session.Session) svcAddonsRetainer {
+					return mockRetainer
+				},
 			}
 
 			// WHEN
@@ -4,35 +4,91 @@
 package cli
 
 import (
+	"context"
+	"crypto/sha256"
 	"errors"
 	"fmt"
+	"io"
+	"math"
 	"path/filepath"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/addon"
+	"github.com/aws/copilot-cli/internal/pkg/aws/acm"
 	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codedeploy"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
+	"github.com/aws/copilot-cli/internal/pkg/aws/route53"
 	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
+	"github.com/aws/copilot-cli/internal/pkg/aws/servicequotas"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/aws/tags"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/aws/copilot-cli/internal/pkg/docker"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/policy"
 	"github.com/aws/copilot-cli/internal/pkg/repository"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/command"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/vulnscan"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/dustin/go-humanize"
+	"github.com/dustin/go-humanize/english"
 	"github.com/spf13/cobra"
 )
 
 const (
 	inputImageTagPrompt = "Input an image tag value:"
+
+	// The pre-signed URL of a template only needs to be valid until CloudFormation creates the change set.
+	templateURLExpiry = time.Hour
+
+	cpuUnitsPerVCPU = 1024
+
+	// The events of the ECS service are polled during a deployment, and only the most recent ones are displayed.
+	serviceEventsPollInterval = 5 * time.Second
+	serviceEventsDisplayLimit = 5
+
+	// Precedes the reason in the event of the ECS service when the deployment circuit breaker stops a deployment.
+	circuitBreakerEventMarker = "deployment failed: "
+)
+
+// blueGreenPollInterval is how often the traffic shift of a blue/green deployment is polled.
+var blueGreenPollInterval = 10 * time.Second
+
+// Routing policies of an alias shared by the load balancers of several environments.
+const (
+	aliasRoutingWeighted = "weighted"
+	aliasRoutingLatency  = "latency"
+	aliasRoutingFailover = "failover"
+
+	aliasFailoverPrimary   = "primary"
+	aliasFailoverSecondary = "secondary"
+
+	aliasHealthCheckHTTPPort  = 80
+	aliasHealthCheckHTTPSPort = 443
+)
+
+const (
+	fmtSvcRecoveryPrompt  = "Stack %s is stuck in UPDATE_ROLLBACK_FAILED. How would you like to recover it?"
+	svcRecoveryHelpPrompt = `Continuing the rollback keeps the resources of the service, and skips the ones that can't be rolled back.
+Recreating the service deletes its stack before deploying it again, which interrupts the service.`
+	svcRecoveryContinueRollback = "Continue the rollback"
+	svcRecoveryRecreate         = "Delete and recreate the service stack"
+	svcRecoverySkipPrompt       = "Which resources should the rollback skip?"
+	svcRecoverySkipHelpPrompt   = "Skipped resources are marked as rolled back without being changed. You may need to fix them manually."
 )
 
 var (
@@ -41,16 +97,22 @@ var (
 
 type deploySvcVars struct {
 	*GlobalOpts
-	Name         string
-	EnvName      string
-	ImageTag     string
-	ResourceTags map[string]string
+	Name              string
+	EnvName           string
+	ImageTag          string
+	ResourceTags      map[string]string
+	Lax               bool
+	NoWait            bool
+	Confirm           []string
+	Force             bool
+	RollbackOnFailure bool
 }
 
 type deploySvcOpts struct {
 	deploySvcVars
 
 	store              store
+	deployStore        deployedEnvironmentLister
 	ws                 wsSvcDirReader
 	imageBuilderPusher imageBuilderPusher
 	unmarshal          func(in []byte) (interface{}, error)
@@ -59,15 +121,36 @@ type deploySvcOpts struct {
 	addons             templater
 	appCFN             appResourcesGetter
 	svcCFN             cloudformation.CloudFormation
+	stackRecoverer     svcStackRecoverer
 	sessProvider       sessionProvider
+	envDescriber       envOutputsGetter
+	newAliasDeployer   func(roleARN string) (aliasRecordDeployer, error)
+	certs              certDescriber
+	fargateQuota       fargateQuotaGetter
+	fargateUsage       fargateUsageGetter
+	runningTasks       runningTasksGetter
+	svcOutputs         svcOutputsGetter
+	blueGreen          blueGreenDeployer
+	stoppedTasks       stoppedTasksGetter
+	serviceEvents      serviceEventsGetter
+	subnetIPs          subnetIPsGetter
+	policies           policyFilesReader
+	cveAllowlist       cveAllowlistReader
+	policyChecker      policyChecker
 
 	spinner progress
 	sel     wsSelector
+	w       io.Writer
 
 	// cached variables
 	targetApp         *config.Application
 	targetEnvironment *config.Environment
 	targetSvc         *config.Service
+	alias             *manifest.Alias
+	healthCheckPath   string
+	rulePath          string
+	deployTimeout     time.Duration
+	isBlueGreen       bool
 }
 
 func newSvcDeployOpts(vars deploySvcVars) (*deploySvcOpts, error) {
@@ -80,17 +163,42 @@ func newSvcDeployOpts(vars deploySvcVars) (*deploySvcOpts, error) {
 	if err != nil {
 		return nil, fmt.Errorf("new workspace: %w", err)
 	}
-	return &deploySvcOpts{
+	deployStore, err := deploy.NewStore(store)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	opts := &deploySvcOpts{
 		deploySvcVars: vars,
 
 		store:        store,
+		deployStore:  deployStore,
 		ws:           ws,
+		policies:     ws,
+		cveAllowlist: ws,
 		unmarshal:    manifest.UnmarshalService,
 		spinner:      termprogress.NewSpinner(),
 		sel:          selector.NewWorkspaceSelect(vars.prompt, store, ws),
 		cmd:          command.New(),
 		sessProvider: sessions.NewProvider(),
-	}, nil
+		w:            log.OutputWriter,
+
+		policyChecker: policy.New(),
+	}
+	if vars.Lax {
+		opts.unmarshal = manifest.UnmarshalServiceLax
+	}
+	opts.newAliasDeployer = func(roleARN string) (aliasRecordDeployer, error) {
+		// Without a role, the hosted zone is expected to be in the same account as the application.
+		sess, err := opts.sessProvider.Default()
+		if roleARN != "" {
+			sess, err = opts.sessProvider.FromRole(roleARN, opts.targetEnvironment.Region)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("create session to manage alias records: %w", err)
+		}
+		return route53.New(sess), nil
+	}
+	return opts, nil
 }
 
 // Validate returns an error if the user inputs are invalid.
@@ -132,6 +240,9 @@ func (o *deploySvcOpts) Execute() error {
 		return err
 	}
 	o.targetEnvironment = env
+	if err := confirmProtectedEnv(o.prompt, env, o.Confirm); err != nil {
+		return err
+	}
 
 	app, err := o.store.GetApplication(o.AppName())
 	if err != nil {
@@ -145,10 +256,28 @@ func (o *deploySvcOpts) Execute() error {
 	}
 	o.targetSvc = svc
 
+	if err := o.checkDependencies(); err != nil {
+		return err
+	}
+
 	if err := o.configureClients(); err != nil {
 		return err
 	}
 
+	if o.Force {
+		if err := o.recoverSvcStack(); err != nil {
+			return err
+		}
+	}
+
+	if err := o.checkFargateCapacity(); err != nil {
+		return err
+	}
+
+	if err := o.checkSubnetCapacity(context.Background()); err != nil {
+		return err
+	}
+
 	if err := o.pushToECRRepo(); err != nil {
 		return err
 	}
@@ -163,6 +292,13 @@ func (o *deploySvcOpts) Execute() error {
 		return err
 	}
 
+	if err := o.deployAlias(); err != nil {
+		return err
+	}
+
+	if o.NoWait {
+		return nil
+	}
 	return o.showAppURI()
 }
 
@@ -271,6 +407,28 @@ func (o *deploySvcOpts) configureClients() error {
 
 	// CF client against env account profile AND target environment region
 	o.svcCFN = cloudformation.New(envSession)
+	o.stackRecoverer = o.svcCFN
+
+	// client to check that the certificates imported by the environment cover the alias of the service
+	o.certs = acm.New(envSession)
+
+	// clients to check that the tasks of the service fit in the Fargate quota of the env account
+	o.fargateQuota = servicequotas.New(envSession)
+	o.fargateUsage = cloudwatch.New(envSession)
+	// client to check that the subnets of the environment have IP addresses left for the tasks
+	o.subnetIPs = ec2.New(envSession)
+	status, err := describe.NewServiceStatus(&describe.NewServiceStatusConfig{
+		App:         o.AppName(),
+		Env:         o.targetEnvironment.Name,
+		Svc:         o.Name,
+		ConfigStore: o.store,
+	})
+	if err != nil {
+		return fmt.Errorf("create status describer for service %s: %w", o.Name, err)
+	}
+	o.runningTasks = status
+	o.stoppedTasks = status
+	o.serviceEvents = status
 
 	addonsSvc, err := addon.New(o.Name)
 	if err != nil {
@@ -284,6 +442,21 @@ func (o *deploySvcOpts) configureClients() error {
 		return fmt.Errorf("create default session: %w", err)
 	}
 	o.appCFN = cloudformation.New(defaultSess)
+
+	envDescriber, err := describe.NewServiceDescriber(describe.NewServiceConfig{
+		App:         o.AppName(),
+		Env:         o.targetEnvironment.Name,
+		Svc:         o.Name,
+		ConfigStore: o.store,
+	})
+	if err != nil {
+		return fmt.Errorf("create describer for environment %s: %w", o.targetEnvironment.Name, err)
+	}
+	o.envDescriber = envDescriber
+	o.svcOutputs = envDescriber
+
+	// client to shift the traffic of blue/green deployments to the new tasks
+	o.blueGreen = codedeploy.New(envSession)
 	return nil
 }
 
@@ -294,13 +467,48 @@ func (o *deploySvcOpts) pushToECRRepo() error {
 		return err
 	}
 
-	if err := o.imageBuilderPusher.BuildAndPush(docker.New(), dockerBuildInput); err != nil {
+	checker, err := o.imageChecker()
+	if err != nil {
+		return err
+	}
+
+	if err := o.imageBuilderPusher.BuildCheckAndPush(docker.New(), dockerBuildInput, checker); err != nil {
 		return fmt.Errorf("build and push image: %w", err)
 	}
 
 	return nil
 }
 
+// imageChecker returns the scanner that checks the image for vulnerabilities before it's pushed,
+// or nil if the manifest doesn't configure an image scan.
+func (o *deploySvcOpts) imageChecker() (repository.ImageChecker, error) {
+	type imageScanner interface {
+		ImageScanConfig() *manifest.ImageScan
+	}
+	mft, err := o.manifest()
+	if err != nil {
+		return nil, err
+	}
+	mf, ok := mft.(imageScanner)
+	if !ok || mf.ImageScanConfig() == nil {
+		return nil, nil
+	}
+	allowlist, err := o.cveAllowlist.ReadCVEAllowlist()
+	if err != nil {
+		return nil, fmt.Errorf("read vulnerability allowlist: %w", err)
+	}
+	conf := mf.ImageScanConfig()
+	scanner, err := vulnscan.New(aws.StringValue(conf.Scanner), vulnscan.Policy{
+		SeverityThreshold: aws.StringValue(conf.SeverityThreshold),
+		CVEs:              conf.CVEs,
+		Allowlist:         allowlist,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("image scan of service %s: %w", o.Name, err)
+	}
+	return scanner, nil
+}
+
 func (o *deploySvcOpts) getBuildArgs() (*docker.BuildArguments, error) {
 	type dfArgs interface {
 		BuildArgs(rootDirectory string) *manifest.DockerBuildArgs
@@ -359,6 +567,35 @@ func (o *deploySvcOpts) pushAddonsTemplateToS3Bucket() (string, error) {
 	return url, nil
 }
 
+// pushTemplateToS3Bucket uploads the service template to S3 if it's too large to be passed in the body of a request.
+// If the template is small enough, it returns the empty string and no errors.
+// Otherwise, it returns the URL of the S3 object storing the template. The URL is pre-signed if the environment is
+// in a different account than the application, since CloudFormation can't read from the bucket of another account.
+func (o *deploySvcOpts) pushTemplateToS3Bucket(conf templater) (string, error) {
+	template, err := conf.Template()
+	if err != nil {
+		return "", fmt.Errorf("generate template of service %s: %w", o.Name, err)
+	}
+	if len(template) <= awscloudformation.MaxTemplateBodySize {
+		return "", nil
+	}
+	resources, err := o.appCFN.GetAppResourcesByRegion(o.targetApp, o.targetEnvironment.Region)
+	if err != nil {
+		return "", fmt.Errorf("get app resources: %w", err)
+	}
+	fileName := fmt.Sprintf(config.ServiceCfnTemplateNameFormat, o.Name)
+	var url string
+	if o.targetEnvironment.AccountID != o.targetApp.AccountID {
+		url, err = o.s3.PutArtifactWithPresignedURL(resources.S3Bucket, fileName, strings.NewReader(template), templateURLExpiry)
+	} else {
+		url, err = o.s3.PutArtifact(resources.S3Bucket, fileName, strings.NewReader(template))
+	}
+	if err != nil {
+		return "", fmt.Errorf("put template artifact to bucket %s: %w", resources.S3Bucket, err)
+	}
+	return url, nil
+}
+
 func (o *deploySvcOpts) manifest() (interface{}, error) {
 	raw, err := o.ws.ReadServiceManifest(o.Name)
 	if err != nil {
@@ -371,6 +608,158 @@ func (o *deploySvcOpts) manifest() (interface{}, error) {
 	return mft, nil
 }
 
+// checkFargateCapacity returns an error if the tasks requested by the manifest need more vCPUs than the Fargate quota
+// of the account, or than what's left of the quota once the tasks of the other services are counted.
+// The check is skipped if the quota or the usage can't be retrieved, such as with the role of an older environment.
+func (o *deploySvcOpts) checkFargateCapacity() error {
+	count, cpu, err := o.taskCountAndCPU()
+	if err != nil {
+		return err
+	}
+	requested := float64(count*cpu) / cpuUnitsPerVCPU
+	if requested == 0 {
+		return nil
+	}
+	quota, err := o.fargateQuota.FargateVCPUQuota()
+	if err != nil {
+		log.Warningf("Skip checking the Fargate vCPU quota: %v\n", err)
+		return nil
+	}
+	quotaURL := servicequotas.FargateVCPUQuotaURL(o.targetEnvironment.Region)
+	if requested > quota {
+		return fmt.Errorf("%d tasks with %d CPU units need %g vCPUs, over the Fargate quota of %g vCPUs of the account; request an increase at %s",
+			count, cpu, requested, quota, quotaURL)
+	}
+	usage, err := o.fargateUsage.FargateVCPUUsage()
+	if err != nil {
+		log.Warningf("Skip checking the Fargate vCPU usage: %v\n", err)
+		return nil
+	}
+	current, err := o.currentVCPUs()
+	if err != nil {
+		log.Warningf("Skip checking the Fargate vCPU usage: %v\n", err)
+		return nil
+	}
+	// The tasks of the service that are running are replaced by the deployment.
+	if available := quota - (usage - current); requested > available {
+		return fmt.Errorf("%d tasks with %d CPU units need %g vCPUs, but only %g of the Fargate quota of %g vCPUs are available; request an increase at %s",
+			count, cpu, requested, math.Max(available, 0), quota, quotaURL)
+	}
+	return nil
+}
+
+// checkSubnetCapacity returns an error if the subnets that the tasks of the service are placed in don't have enough
+// available IP addresses for the new tasks, since each task gets its own network interface.
+func (o *deploySvcOpts) checkSubnetCapacity(ctx context.Context) error {
+	count, _, err := o.taskCountAndCPU()
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return nil
+	}
+	outputs, err := o.envDescriber.EnvOutputs()
+	if err != nil {
+		log.Warningf("Skip checking the available IP addresses: get outputs of environment %s: %v\n", o.targetEnvironment.Name, err)
+		return nil
+	}
+	var subnets []string
+	for _, id := range strings.Split(outputs[stack.EnvOutputPublicSubnets], ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			subnets = append(subnets, id)
+		}
+	}
+	// The tasks are placed in the first two public subnets of the environment.
+	if len(subnets) > 2 {
+		subnets = subnets[:2]
+	}
+	if len(subnets) == 0 {
+		return nil
+	}
+	ips, err := o.subnetIPs.SubnetsAvailableIPs(ctx, subnets...)
+	if err != nil {
+		log.Warningf("Skip checking the available IP addresses: %v\n", err)
+		return nil
+	}
+	available := 0
+	for _, id := range subnets {
+		available += ips[id]
+	}
+	// The running tasks keep their addresses until the new tasks replace them.
+	if count > available {
+		return fmt.Errorf("%d tasks need %d IP addresses, but only %d are available in subnets %s; stop other tasks or import larger subnets",
+			count, count, available, strings.Join(subnets, ", "))
+	}
+	return nil
+}
+
+// taskCountAndCPU returns the desired number of tasks of the service and the CPU units of each task in the target environment.
+// Both are zero for services whose tasks don't run on Fargate.
+func (o *deploySvcOpts) taskCountAndCPU() (count, cpu int, err error) {
+	mft, err := o.manifest()
+	if err != nil {
+		return 0, 0, err
+	}
+	switch t := mft.(type) {
+	case *manifest.LoadBalancedWebService:
+		envMft, err := t.ApplyEnv(o.targetEnvironment.Name)
+		if err != nil {
+			return 0, 0, fmt.Errorf("apply environment %s configuration: %w", o.targetEnvironment.Name, err)
+		}
+		return envMft.Count.Desired(), aws.IntValue(envMft.CPU), nil
+	case *manifest.BackendService:
+		envMft, err := t.ApplyEnv(o.targetEnvironment.Name)
+		if err != nil {
+			return 0, 0, fmt.Errorf("apply environment %s configuration: %w", o.targetEnvironment.Name, err)
+		}
+		return envMft.Count.Desired(), aws.IntValue(envMft.CPU), nil
+	default:
+		return 0, 0, nil
+	}
+}
+
+// currentVCPUs returns the number of vCPUs used by the running tasks of the service in the target environment.
+func (o *deploySvcOpts) currentVCPUs() (float64, error) {
+	deployed, err := o.deployStore.IsServiceDeployed(o.AppName(), o.targetEnvironment.Name, o.Name)
+	if err != nil {
+		return 0, fmt.Errorf("check if service %s is deployed in environment %s: %w", o.Name, o.targetEnvironment.Name, err)
+	}
+	if !deployed {
+		return 0, nil
+	}
+	tasks, err := o.runningTasks.RunningTasks()
+	if err != nil {
+		return 0, err
+	}
+	var units float64
+	for _, task := range tasks {
+		cpu, err := strconv.ParseFloat(aws.StringValue(task.Cpu), 64)
+		if err != nil {
+			return 0, fmt.Errorf("parse CPU %q of task %s: %w", aws.StringValue(task.Cpu), aws.StringValue(task.TaskArn), err)
+		}
+		units += cpu
+	}
+	return units / cpuUnitsPerVCPU, nil
+}
+
+// checkDependencies returns an error if a service that the service depends on is not deployed in the target environment.
+func (o *deploySvcOpts) checkDependencies() error {
+	deps, err := svcDependencies(o.ws, []string{o.Name})
+	if err != nil {
+		return err
+	}
+	for _, dep := range deps[o.Name] {
+		deployed, err := o.deployStore.IsServiceDeployed(o.AppName(), o.EnvName, dep)
+		if err != nil {
+			return fmt.Errorf("check if service %s is deployed in environment %s: %w", dep, o.EnvName, err)
+		}
+		if !deployed {
+			return fmt.Errorf("service %s depends on %s which is not deployed in environment %s", o.Name, dep, o.EnvName)
+		}
+	}
+	return nil
+}
+
 func (o *deploySvcOpts) runtimeConfig(addonsURL string) (*stack.RuntimeConfig, error) {
 	resources, err := o.appCFN.GetAppResourcesByRegion(o.targetApp, o.targetEnvironment.Region)
 	if err != nil {
@@ -389,6 +778,7 @@ func (o *deploySvcOpts) runtimeConfig(addonsURL string) (*stack.RuntimeConfig, e
 		ImageTag:          o.ImageTag,
 		AddonsTemplateURL: addonsURL,
 		AdditionalTags:    tags.Merge(o.targetApp.Tags, o.ResourceTags),
+		DisableRollback:   !o.RollbackOnFailure,
 	}, nil
 }
 
@@ -404,11 +794,22 @@ func (o *deploySvcOpts) stackConfiguration(addonsURL string) (cloudformation.Sta
 	var conf cloudformation.StackConfiguration
 	switch t := mft.(type) {
 	case *manifest.LoadBalancedWebService:
+		if err := o.cacheAlias(t); err != nil {
+			return nil, err
+		}
+		if err := o.cacheBlueGreen(t, rc); err != nil {
+			return nil, err
+		}
+		var lbConf *stack.LoadBalancedWebService
 		if o.targetApp.RequiresDNSDelegation() {
-			conf, err = stack.NewHTTPSLoadBalancedWebService(t, o.targetEnvironment.Name, o.targetEnvironment.App, *rc)
+			lbConf, err = stack.NewHTTPSLoadBalancedWebService(t, o.targetEnvironment.Name, o.targetEnvironment.App, *rc)
 		} else {
-			conf, err = stack.NewLoadBalancedWebService(t, o.targetEnvironment.Name, o.targetEnvironment.App, *rc)
+			lbConf, err = stack.NewLoadBalancedWebService(t, o.targetEnvironment.Name, o.targetEnvironment.App, *rc)
+		}
+		if err == nil && o.alias != nil && o.envImportsCerts() {
+			err = lbConf.ServeAliasOverHTTPS()
 		}
+		conf = lbConf
 	case *manifest.BackendService:
 		conf, err = stack.NewBackendService(t, o.targetEnvironment.Name, o.targetEnvironment.App, *rc)
 	default:
@@ -417,27 +818,575 @@ func (o *deploySvcOpts) stackConfiguration(addonsURL string) (cloudformation.Sta
 	if err != nil {
 		return nil, fmt.Errorf("create stack configuration: %w", err)
 	}
+	if err := o.cacheDeployTimeout(mft); err != nil {
+		return nil, err
+	}
 	return conf, nil
 }
 
+// cacheDeployTimeout stores how long the deployment can take before it's canceled, as set by the manifest.
+func (o *deploySvcOpts) cacheDeployTimeout(mft interface{}) error {
+	type timeouter interface {
+		DeploymentTimeout() time.Duration
+	}
+	t, ok := mft.(timeouter)
+	if !ok {
+		return nil
+	}
+	timeout := t.DeploymentTimeout()
+	if timeout < 0 {
+		return fmt.Errorf("deploy_timeout %s must be positive", timeout)
+	}
+	o.deployTimeout = timeout
+	return nil
+}
+
 func (o *deploySvcOpts) deploySvc(addonsURL string) error {
 	conf, err := o.stackConfiguration(addonsURL)
 	if err != nil {
 		return err
 	}
+	if err := o.checkPolicies(conf); err != nil {
+		return err
+	}
+	stackOpts := []awscloudformation.StackOption{awscloudformation.WithRoleARN(o.targetEnvironment.ExecutionRoleARN)}
+	templateURL, err := o.pushTemplateToS3Bucket(conf)
+	if err != nil {
+		return err
+	}
+	if templateURL != "" {
+		stackOpts = append(stackOpts, awscloudformation.WithTemplateURL(templateURL))
+	}
+	if o.NoWait {
+		if o.isBlueGreen {
+			return fmt.Errorf("service %s uses blue/green deployments, which can't be started without waiting for the stack", o.Name)
+		}
+		return o.startSvcDeployment(conf, stackOpts)
+	}
 	o.spinner.Start(
 		fmt.Sprintf("Deploying %s to %s.",
 			fmt.Sprintf("%s:%s", color.HighlightUserInput(o.Name), color.HighlightUserInput(o.ImageTag)),
 			color.HighlightUserInput(o.targetEnvironment.Name)))
 
-	if err := o.svcCFN.DeployService(conf, awscloudformation.WithRoleARN(o.targetEnvironment.ExecutionRoleARN)); err != nil {
+	start := time.Now()
+	stopEvents := o.streamServiceEvents(start)
+	if o.deployTimeout > 0 {
+		err = o.svcCFN.DeployServiceWithTimeout(conf, o.deployTimeout, stackOpts...)
+	} else {
+		err = o.svcCFN.DeployService(conf, stackOpts...)
+	}
+	stopEvents()
+	if err != nil {
 		o.spinner.Stop(log.Serrorf("Failed to deploy service.\n"))
+		var errTimeout *deploy.ErrDeploymentTimeout
+		if errors.As(err, &errTimeout) {
+			o.reportStoppedTasks()
+		} else if reason, ok := o.circuitBreakerReason(start); ok {
+			log.Errorf("The deployment circuit breaker stopped the deployment of service %s: %s\n", o.Name, reason)
+			o.reportStoppedTasks()
+		}
 		return fmt.Errorf("deploy service: %w", err)
 	}
 	o.spinner.Stop("\n")
+	if o.isBlueGreen {
+		return o.deployBlueGreen()
+	}
+	return nil
+}
+
+// cacheBlueGreen stores whether the service is deployed with CodeDeploy in the target environment.
+// CloudFormation can't update the task definition of such a service, so the stack keeps the one that the service
+// was created with while CodeDeploy rolls out the new revisions.
+func (o *deploySvcOpts) cacheBlueGreen(mft *manifest.LoadBalancedWebService, rc *stack.RuntimeConfig) error {
+	envMft, err := mft.ApplyEnv(o.targetEnvironment.Name)
+	if err != nil {
+		return fmt.Errorf("apply environment %s override: %w", o.targetEnvironment.Name, err)
+	}
+	o.isBlueGreen = envMft.Deployment.IsBlueGreen()
+	if !o.isBlueGreen {
+		return nil
+	}
+	deployed, err := o.deployStore.IsServiceDeployed(o.AppName(), o.targetEnvironment.Name, o.Name)
+	if err != nil {
+		return fmt.Errorf("check if service %s is deployed in environment %s: %w", o.Name, o.targetEnvironment.Name, err)
+	}
+	if !deployed {
+		return nil
+	}
+	outputs, err := o.svcOutputs.Outputs()
+	if err != nil {
+		return fmt.Errorf("get outputs of service %s: %w", o.Name, err)
+	}
+	rc.ServiceTaskDefinition = outputs[stack.LBWebServiceServiceTaskDefinitionOutputKey]
 	return nil
 }
 
+// deployBlueGreen starts a CodeDeploy deployment of the task definition of the stack if the service doesn't run it yet,
+// and waits until the traffic is shifted to the new tasks.
+func (o *deploySvcOpts) deployBlueGreen() error {
+	outputs, err := o.svcOutputs.Outputs()
+	if err != nil {
+		return fmt.Errorf("get outputs of service %s: %w", o.Name, err)
+	}
+	taskDef := outputs[stack.LBWebServiceTaskDefinitionOutputKey]
+	if taskDef == outputs[stack.LBWebServiceServiceTaskDefinitionOutputKey] {
+		// The service was just created with the task definition.
+		return nil
+	}
+	tasks, err := o.runningTasks.RunningTasks()
+	if err != nil {
+		return err
+	}
+	upToDate := len(tasks) != 0
+	for _, task := range tasks {
+		if aws.StringValue(task.TaskDefinitionArn) != taskDef {
+			upToDate = false
+		}
+	}
+	if upToDate {
+		return nil
+	}
+	port, err := strconv.Atoi(outputs[stack.LBWebServiceTargetPortOutputKey])
+	if err != nil {
+		return fmt.Errorf("parse target port %q of service %s: %w", outputs[stack.LBWebServiceTargetPortOutputKey], o.Name, err)
+	}
+	o.spinner.Start(fmt.Sprintf("Shifting traffic of %s to the new tasks.", color.HighlightUserInput(o.Name)))
+	id, err := o.blueGreen.CreateECSDeployment(codedeploy.ECSDeploymentInput{
+		Application:     outputs[stack.LBWebServiceCodeDeployAppOutputKey],
+		DeploymentGroup: outputs[stack.LBWebServiceDeploymentGroupOutputKey],
+		TaskDefinition:  taskDef,
+		ContainerName:   outputs[stack.LBWebServiceTargetContainerOutputKey],
+		ContainerPort:   port,
+	})
+	if err != nil {
+		o.spinner.Stop(log.Serrorf("Failed to start the blue/green deployment.\n"))
+		return fmt.Errorf("start blue/green deployment of service %s: %w", o.Name, err)
+	}
+	for {
+		deployment, err := o.blueGreen.Deployment(id)
+		if err != nil {
+			o.spinner.Stop(log.Serrorf("Failed to shift traffic to the new tasks.\n"))
+			return fmt.Errorf("wait for blue/green deployment of service %s: %w", o.Name, err)
+		}
+		if deployment.IsDone() {
+			if deployment.Succeeded() {
+				o.spinner.Stop(log.Ssuccessf("Shifted all traffic of %s to the new tasks.\n", color.HighlightUserInput(o.Name)))
+				return nil
+			}
+			o.spinner.Stop(log.Serrorf("Failed to shift traffic to the new tasks.\n"))
+			return fmt.Errorf("blue/green deployment %s of service %s %s: %s", id, o.Name, strings.ToLower(deployment.Status), deployment.ErrorMessage)
+		}
+		o.spinner.Events(blueGreenTrafficRows(deployment))
+		time.Sleep(blueGreenPollInterval)
+	}
+}
+
+// blueGreenTrafficRows returns the share of the production traffic served by the original and the new tasks.
+func blueGreenTrafficRows(deployment *codedeploy.Deployment) []termprogress.TabRow {
+	names := map[string]string{
+		codedeploy.TaskSetBlue:  "original tasks",
+		codedeploy.TaskSetGreen: "new tasks",
+	}
+	var rows []termprogress.TabRow
+	for _, set := range deployment.TaskSets {
+		rows = append(rows, termprogress.TabRow(fmt.Sprintf("  %s\t%.0f%% of traffic\t%d/%d running",
+			names[set.Label], set.TrafficWeight, set.RunningCount, set.DesiredCount)))
+	}
+	return rows
+}
+
+// checkPolicies evaluates the template of the service against the cfn-guard rules in the workspace.
+// Violations of the rules under copilot/policies/ block the deployment, while the ones under copilot/policies/warn/ are only reported.
+func (o *deploySvcOpts) checkPolicies(conf templater) error {
+	files, err := o.policies.ReadPoliciesDir()
+	if err != nil {
+		return fmt.Errorf("read policies directory: %w", err)
+	}
+	if len(files.Blocking) == 0 && len(files.Warning) == 0 {
+		return nil
+	}
+	tpl, err := conf.Template()
+	if err != nil {
+		return fmt.Errorf("generate template of service %s: %w", o.Name, err)
+	}
+	warnings, err := o.policyChecker.Check(tpl, files.Warning)
+	if err != nil {
+		return fmt.Errorf("check template of service %s against policies: %w", o.Name, err)
+	}
+	for _, v := range warnings {
+		log.Warningf("Service %s violates the rules in %s:\n%s\n", o.Name, v.RulesFile, v.Details)
+	}
+	violations, err := o.policyChecker.Check(tpl, files.Blocking)
+	if err != nil {
+		return fmt.Errorf("check template of service %s against policies: %w", o.Name, err)
+	}
+	for _, v := range violations {
+		log.Errorf("Service %s violates the rules in %s:\n%s\n", o.Name, v.RulesFile, v.Details)
+	}
+	if len(violations) != 0 {
+		return fmt.Errorf("service %s violates the rules of %d policy files", o.Name, len(violations))
+	}
+	return nil
+}
+
+// streamServiceEvents displays the events of the ECS service under the spinner while the service is deployed,
+// such as tasks that can't be placed, until the returned function is called.
+func (o *deploySvcOpts) streamServiceEvents(since time.Time) (stop func()) {
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		ticker := time.NewTicker(serviceEventsPollInterval)
+		defer ticker.Stop()
+		var rows []termprogress.TabRow
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+			}
+			latest, updatedRows := o.serviceEventRows(since, rows)
+			if latest.After(since) {
+				since, rows = latest, updatedRows
+				o.spinner.Events(rows)
+			}
+		}
+	}()
+	return func() {
+		close(done)
+		<-stopped
+	}
+}
+
+// serviceEventRows appends the events of the ECS service that happened after since to the rows to display,
+// and returns the time of the latest event.
+func (o *deploySvcOpts) serviceEventRows(since time.Time, rows []termprogress.TabRow) (time.Time, []termprogress.TabRow) {
+	events, err := o.serviceEvents.ServiceEvents(since)
+	if err != nil || len(events) == 0 {
+		// The ECS service doesn't exist until CloudFormation creates it.
+		return since, rows
+	}
+	for _, event := range events {
+		rows = append(rows, termprogress.TabRow(fmt.Sprintf("  %s\t%s", event.CreatedAt.Local().Format("15:04:05"), event.Message)))
+	}
+	if len(rows) > serviceEventsDisplayLimit {
+		rows = rows[len(rows)-serviceEventsDisplayLimit:]
+	}
+	return events[len(events)-1].CreatedAt, rows
+}
+
+// circuitBreakerReason returns why the deployment circuit breaker stopped the latest deployment of the service since
+// the given time, and false if it didn't stop any.
+func (o *deploySvcOpts) circuitBreakerReason(since time.Time) (string, bool) {
+	events, err := o.serviceEvents.ServiceEvents(since)
+	if err != nil {
+		return "", false
+	}
+	for i := len(events) - 1; i >= 0; i-- {
+		if idx := strings.Index(events[i].Message, circuitBreakerEventMarker); idx != -1 {
+			return events[i].Message[idx+len(circuitBreakerEventMarker):], true
+		}
+	}
+	return "", false
+}
+
+// reportStoppedTasks logs why the tasks of the service stopped, to explain why a deployment never completed.
+func (o *deploySvcOpts) reportStoppedTasks() {
+	tasks, err := o.stoppedTasks.StoppedTasks()
+	if err != nil {
+		log.Warningf("Couldn't get the stopped tasks of service %s: %v\n", o.Name, err)
+		return
+	}
+	reported := 0
+	for _, task := range tasks {
+		if reported == previousTasksLimit {
+			break
+		}
+		if strings.HasPrefix(aws.StringValue(task.StoppedReason), scaledInStoppedReasonPrefix) {
+			continue
+		}
+		status, err := task.TaskStatus()
+		if err != nil {
+			continue
+		}
+		log.Infof("Task %s stopped %s: %s\n", color.HighlightResource(status.ID),
+			humanize.Time(status.StoppedAt), status.StoppedReason)
+		reported++
+	}
+	if reported == 0 {
+		log.Infof("No task of service %s stopped, its tasks may still be starting or failing their health checks.\n", color.HighlightUserInput(o.Name))
+		return
+	}
+	log.Infof("Run %s to see the logs of the stopped tasks.\n",
+		color.HighlightCode(fmt.Sprintf("copilot svc logs -n %s -e %s --previous", o.Name, o.targetEnvironment.Name)))
+}
+
+// recoverSvcStack lets the user choose how to recover the service stack if it's stuck in UPDATE_ROLLBACK_FAILED,
+// since CloudFormation can't deploy a stack in that state.
+func (o *deploySvcOpts) recoverSvcStack() error {
+	stackName := stack.NameForService(o.AppName(), o.targetEnvironment.Name, o.Name)
+	failed, stuck, err := o.stackRecoverer.ServiceRollbackFailedResources(stackName)
+	if err != nil {
+		return err
+	}
+	if !stuck {
+		return nil
+	}
+	action, err := o.prompt.SelectOne(fmt.Sprintf(fmtSvcRecoveryPrompt, color.HighlightResource(stackName)),
+		svcRecoveryHelpPrompt, []string{svcRecoveryContinueRollback, svcRecoveryRecreate})
+	if err != nil {
+		return fmt.Errorf("select how to recover stack %s: %w", stackName, err)
+	}
+	if action == svcRecoveryRecreate {
+		return o.recreateSvcStack(stackName)
+	}
+	return o.continueSvcRollback(stackName, failed)
+}
+
+// continueSvcRollback rolls back the service stack, skipping the resources that failed to roll back that the user selects.
+func (o *deploySvcOpts) continueSvcRollback(stackName string, failed []string) error {
+	var skip []string
+	if len(failed) != 0 {
+		var err error
+		skip, err = o.prompt.MultiSelect(svcRecoverySkipPrompt, svcRecoverySkipHelpPrompt, failed)
+		if err != nil {
+			return fmt.Errorf("select resources to skip: %w", err)
+		}
+	}
+	o.spinner.Start(fmt.Sprintf("Rolling back stack %s.", color.HighlightResource(stackName)))
+	if err := o.stackRecoverer.ContinueServiceRollback(stackName, skip); err != nil {
+		o.spinner.Stop(log.Serrorf("Failed to roll back stack %s.\n", stackName))
+		return fmt.Errorf("recover service %s: %w", o.Name, err)
+	}
+	o.spinner.Stop(log.Ssuccessf("Rolled back stack %s.\n", stackName))
+	return nil
+}
+
+// recreateSvcStack deletes the service stack so that the deployment creates it again.
+// The ECR repository of the service belongs to the application stack and is kept, while the stateful resources
+// of the addons must be retained since they're deleted along with the service stack otherwise.
+func (o *deploySvcOpts) recreateSvcStack(stackName string) error {
+	tpl, err := o.addons.Template()
+	if err != nil {
+		var notExistErr *addon.ErrDirNotExist
+		if !errors.As(err, &notExistErr) {
+			return fmt.Errorf("retrieve addons template: %w", err)
+		}
+	}
+	if tpl != "" {
+		ids, err := addon.UnretainedStatefulResources(tpl)
+		if err != nil {
+			return err
+		}
+		if len(ids) != 0 {
+			return fmt.Errorf("recreating service %s would delete addons %s: set their DeletionPolicy to Retain or continue the rollback instead",
+				o.Name, english.WordSeries(ids, "and"))
+		}
+	}
+	o.spinner.Start(fmt.Sprintf("Deleting stack %s.", color.HighlightResource(stackName)))
+	if err := o.stackRecoverer.DeleteService(deploy.DeleteServiceInput{
+		Name:    o.Name,
+		EnvName: o.targetEnvironment.Name,
+		AppName: o.AppName(),
+	}); err != nil {
+		o.spinner.Stop(log.Serrorf("Failed to delete stack %s.\n", stackName))
+		return fmt.Errorf("recover service %s: %w", o.Name, err)
+	}
+	o.spinner.Stop(log.Ssuccessf("Deleted stack %s, the service will be recreated.\n", stackName))
+	return nil
+}
+
+// startSvcDeployment starts the deployment without waiting for it, and writes the ID to wait for it later.
+func (o *deploySvcOpts) startSvcDeployment(conf cloudformation.StackConfiguration, stackOpts []awscloudformation.StackOption) error {
+	o.spinner.Start(
+		fmt.Sprintf("Starting to deploy %s to %s.",
+			fmt.Sprintf("%s:%s", color.HighlightUserInput(o.Name), color.HighlightUserInput(o.ImageTag)),
+			color.HighlightUserInput(o.targetEnvironment.Name)))
+	id, err := o.svcCFN.StartServiceDeployment(conf, stackOpts...)
+	if err != nil {
+		o.spinner.Stop(log.Serrorf("Failed to start the deployment of the service.\n"))
+		return fmt.Errorf("start deployment of service: %w", err)
+	}
+	o.spinner.Stop(log.Ssuccessf("Started the deployment of %s.\n", color.HighlightUserInput(o.Name)))
+	log.Infof("Run %s to wait until the deployment is done.\n", color.HighlightCode(fmt.Sprintf("copilot deploy wait %s", id)))
+	fmt.Fprintln(o.w, id)
+	return nil
+}
+
+// cacheAlias stores the alias of the service in the target environment so that its record can be created once the service is deployed.
+func (o *deploySvcOpts) cacheAlias(mft *manifest.LoadBalancedWebService) error {
+	envMft, err := mft.ApplyEnv(o.targetEnvironment.Name)
+	if err != nil {
+		return fmt.Errorf("apply environment %s override: %w", o.targetEnvironment.Name, err)
+	}
+	alias := envMft.Alias
+	if alias == nil {
+		return nil
+	}
+	if aws.StringValue(alias.Name) == "" || aws.StringValue(alias.HostedZoneID) == "" {
+		return errors.New("alias requires both a name and a hostedZone")
+	}
+	if o.targetApp.RequiresDNSDelegation() && !o.envImportsCerts() {
+		// Services in environments with a domain are routed by host header, so requests to the alias wouldn't reach the service.
+		return fmt.Errorf("alias %s is not supported in environment %s since application %s has a domain", aws.StringValue(alias.Name), o.targetEnvironment.Name, o.targetApp.Name)
+	}
+	if err := validateAliasRouting(alias.Routing); err != nil {
+		return fmt.Errorf("validate routing of alias %s: %w", aws.StringValue(alias.Name), err)
+	}
+	if o.envImportsCerts() {
+		if err := o.validateAliasCertificate(aws.StringValue(alias.Name)); err != nil {
+			return err
+		}
+	}
+	o.alias = alias
+	o.healthCheckPath = aws.StringValue(envMft.HealthCheckPath)
+	o.rulePath = aws.StringValue(envMft.Path)
+	return nil
+}
+
+// envImportsCerts returns true if the HTTPS listener of the target environment serves imported certificates.
+func (o *deploySvcOpts) envImportsCerts() bool {
+	conf := o.targetEnvironment.CustomConfig
+	return conf != nil && len(conf.ImportCertARNs) > 0
+}
+
+// validateAliasCertificate returns an error if none of the certificates imported by the target environment covers the alias.
+func (o *deploySvcOpts) validateAliasCertificate(alias string) error {
+	for _, certARN := range o.targetEnvironment.CustomConfig.ImportCertARNs {
+		cert, err := o.certs.DescribeCertificate(certARN)
+		if err != nil {
+			return fmt.Errorf("validate certificates of alias %s: %w", alias, err)
+		}
+		if cert.Covers(alias) {
+			return nil
+		}
+	}
+	return fmt.Errorf("alias %s is not covered by any of the certificates imported by environment %s", alias, o.targetEnvironment.Name)
+}
+
+func validateAliasRouting(routing *manifest.AliasRouting) error {
+	if routing == nil {
+		return nil
+	}
+	switch policy := aws.StringValue(routing.Policy); policy {
+	case aliasRoutingWeighted:
+		if routing.Weight == nil {
+			return fmt.Errorf("weight is required by the %s policy", policy)
+		}
+		if weight := aws.Int64Value(routing.Weight); weight < 0 || weight > 255 {
+			return fmt.Errorf("weight %d must be between 0 and 255", weight)
+		}
+	case aliasRoutingLatency:
+	case aliasRoutingFailover:
+		failover := aws.StringValue(routing.Failover)
+		if failover != aliasFailoverPrimary && failover != aliasFailoverSecondary {
+			return fmt.Errorf("failover must be %s or %s for the %s policy", aliasFailoverPrimary, aliasFailoverSecondary, policy)
+		}
+	default:
+		return fmt.Errorf("policy must be one of %s, %s or %s", aliasRoutingWeighted, aliasRoutingLatency, aliasRoutingFailover)
+	}
+	return nil
+}
+
+// deployAlias points the alias of the service to the environment's load balancer and waits until the record propagates.
+func (o *deploySvcOpts) deployAlias() error {
+	if o.alias == nil {
+		return nil
+	}
+	name := strings.TrimSuffix(aws.StringValue(o.alias.Name), ".")
+	zoneID := aws.StringValue(o.alias.HostedZoneID)
+	deployer, err := o.newAliasDeployer(aws.StringValue(o.alias.RoleARN))
+	if err != nil {
+		return err
+	}
+	zoneName, err := deployer.HostedZoneName(zoneID)
+	if err != nil {
+		return fmt.Errorf("validate hosted zone of alias %s: %w", name, err)
+	}
+	if name != zoneName && !strings.HasSuffix(name, "."+zoneName) {
+		return fmt.Errorf("alias %s does not belong to hosted zone %s for domain %s", name, zoneID, zoneName)
+	}
+	outputs, err := o.envDescriber.EnvOutputs()
+	if err != nil {
+		return fmt.Errorf("get outputs of environment %s: %w", o.targetEnvironment.Name, err)
+	}
+	record := route53.AliasRecord{
+		HostedZoneID:       zoneID,
+		Name:               name,
+		TargetDNSName:      outputs[stack.EnvOutputPublicLoadBalancerDNSName],
+		TargetHostedZoneID: outputs[stack.EnvOutputPublicLoadBalancerZoneKey],
+	}
+	if err := o.applyAliasRouting(&record, deployer, outputs); err != nil {
+		return err
+	}
+	o.spinner.Start(fmt.Sprintf("Creating alias %s in hosted zone %s.", color.HighlightUserInput(name), color.HighlightResource(zoneID)))
+	if err := deployer.UpsertAliasRecord(record); err != nil {
+		o.spinner.Stop(log.Serrorf("Failed to create alias %s.\n", name))
+		return fmt.Errorf("create alias %s: %w", name, err)
+	}
+	o.spinner.Stop(log.Ssuccessf("Created alias %s.\n", name))
+	return nil
+}
+
+// applyAliasRouting makes the record one of the environments that the alias routes to.
+func (o *deploySvcOpts) applyAliasRouting(record *route53.AliasRecord, deployer aliasRecordDeployer, envOutputs map[string]string) error {
+	routing := o.alias.Routing
+	if routing == nil {
+		return nil
+	}
+	record.SetIdentifier = o.targetEnvironment.Name
+	switch aws.StringValue(routing.Policy) {
+	case aliasRoutingWeighted:
+		record.Weight = routing.Weight
+	case aliasRoutingLatency:
+		record.Region = o.targetEnvironment.Region
+	case aliasRoutingFailover:
+		record.Failover = strings.ToUpper(aws.StringValue(routing.Failover))
+	}
+	if !aws.BoolValue(routing.HealthCheck) {
+		return nil
+	}
+	hc := o.aliasHealthCheck(record.TargetDNSName, envOutputs[stack.EnvOutputSubdomain])
+	// The caller reference is limited to 64 characters, so we hash the fields that make the health check unique.
+	// A new health check is created whenever its configuration changes, since Route 53 rejects reusing a reference.
+	ref := sha256.Sum256([]byte(fmt.Sprintf("%s/%s/%s/%s/%s:%d%s/%t",
+		o.AppName(), o.targetEnvironment.Name, o.Name, record.Name, hc.DNSName, hc.Port, hc.Path, hc.HTTPS)))
+	hc.CallerReference = fmt.Sprintf("copilot-%x", ref[:16])
+	id, err := deployer.CreateHealthCheck(hc)
+	if err != nil {
+		return fmt.Errorf("create health check of alias %s in environment %s: %w", record.Name, o.targetEnvironment.Name, err)
+	}
+	record.HealthCheckID = id
+	return nil
+}
+
+// aliasHealthCheck returns the health check of the service through the load balancer of the target environment.
+// In environments with a domain, the HTTPS listener routes the subdomain of the service to it, so the health check
+// path is requested there. Otherwise, the HTTP listener routes the path of the service, so the health check path is
+// requested under it.
+func (o *deploySvcOpts) aliasHealthCheck(lbDNSName, envSubdomain string) route53.HealthCheck {
+	healthCheckPath := "/" + strings.TrimPrefix(o.healthCheckPath, "/")
+	if o.targetApp.RequiresDNSDelegation() {
+		return route53.HealthCheck{
+			DNSName: fmt.Sprintf("%s.%s", o.Name, envSubdomain),
+			Path:    healthCheckPath,
+			Port:    aliasHealthCheckHTTPSPort,
+			HTTPS:   true,
+		}
+	}
+	path := healthCheckPath
+	rulePath := "/" + strings.Trim(o.rulePath, "/")
+	if rulePath != "/" && path != rulePath && !strings.HasPrefix(path, rulePath+"/") {
+		// For example, the health check path "/healthz" of a service at "api" is requested at "/api/healthz".
+		path = rulePath + strings.TrimSuffix(path, "/")
+	}
+	return route53.HealthCheck{
+		DNSName: lbDNSName,
+		Path:    path,
+		Port:    aliasHealthCheckHTTPPort,
+	}
+}
+
 func (o *deploySvcOpts) showAppURI() error {
 	type identifier interface {
 		URI(string) (string, error)
@@ -517,6 +1466,11 @@ func BuildSvcDeployCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.EnvName, envFlag, envFlagShort, "", envFlagDescription)
 	cmd.Flags().StringVar(&vars.ImageTag, imageTagFlag, "", imageTagFlagDescription)
 	cmd.Flags().StringToStringVar(&vars.ResourceTags, resourceTagsFlag, nil, resourceTagsFlagDescription)
+	cmd.Flags().BoolVar(&vars.Lax, laxFlag, false, laxFlagDescription)
+	cmd.Flags().BoolVar(&vars.NoWait, noWaitFlag, false, noWaitFlagDescription)
+	cmd.Flags().StringSliceVar(&vars.Confirm, confirmFlag, nil, confirmFlagDescription)
+	cmd.Flags().BoolVar(&vars.Force, forceFlag, false, forceFlagDescription)
+	cmd.Flags().BoolVar(&vars.RollbackOnFailure, rollbackOnFailureFlag, true, rollbackOnFailureFlagDescription)
 
 	return cmd
 }
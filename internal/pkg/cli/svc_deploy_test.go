@@ -4,16 +4,30 @@
 package cli
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	addon "github.com/aws/copilot-cli/internal/pkg/addon"
+	"github.com/aws/copilot-cli/internal/pkg/aws/acm"
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codedeploy"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/route53"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/docker"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/policy"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
@@ -323,6 +337,100 @@ image:
 	}
 }
 
+func TestSvcDeployOpts_imageChecker(t *testing.T) {
+	const (
+		mftWithoutScan = `name: api
+type: Backend Service
+image:
+  build: api/Dockerfile
+`
+		mftWithScan = `name: api
+type: Backend Service
+image:
+  build: api/Dockerfile
+  scan:
+    scanner: grype
+    severity_threshold: high
+`
+		mftWithBadScanner = `name: api
+type: Backend Service
+image:
+  build: api/Dockerfile
+  scan:
+    scanner: clair
+`
+	)
+	testCases := map[string]struct {
+		inManifest  string
+		setupMocks  func(m *mocks.MockcveAllowlistReader)
+		wantChecker bool
+		wantErr     error
+	}{
+		"no checker if the manifest does not scan the image": {
+			inManifest: mftWithoutScan,
+			setupMocks: func(m *mocks.MockcveAllowlistReader) {
+				m.EXPECT().ReadCVEAllowlist().Times(0)
+			},
+		},
+		"errors if the allowlist can't be read": {
+			inManifest: mftWithScan,
+			setupMocks: func(m *mocks.MockcveAllowlistReader) {
+				m.EXPECT().ReadCVEAllowlist().Return(nil, errors.New("some error"))
+			},
+			wantErr: errors.New("read vulnerability allowlist: some error"),
+		},
+		"errors if the scanner is not supported": {
+			inManifest: mftWithBadScanner,
+			setupMocks: func(m *mocks.MockcveAllowlistReader) {
+				m.EXPECT().ReadCVEAllowlist().Return(nil, nil)
+			},
+			wantErr: errors.New("image scan of service api: scanner clair is not supported, must be one of trivy or grype"),
+		},
+		"returns the scanner": {
+			inManifest: mftWithScan,
+			setupMocks: func(m *mocks.MockcveAllowlistReader) {
+				m.EXPECT().ReadCVEAllowlist().Return([]string{"CVE-2020-1234"}, nil)
+			},
+			wantChecker: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockWs := mocks.NewMockwsSvcDirReader(ctrl)
+			mockWs.EXPECT().ReadServiceManifest("api").Return([]byte(tc.inManifest), nil)
+			mockAllowlist := mocks.NewMockcveAllowlistReader(ctrl)
+			tc.setupMocks(mockAllowlist)
+			opts := deploySvcOpts{
+				deploySvcVars: deploySvcVars{
+					Name: "api",
+				},
+				ws:           mockWs,
+				cveAllowlist: mockAllowlist,
+				unmarshal:    manifest.UnmarshalService,
+			}
+
+			// WHEN
+			checker, err := opts.imageChecker()
+
+			// THEN
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			if tc.wantChecker {
+				require.NotNil(t, checker)
+			} else {
+				require.Nil(t, checker)
+			}
+		})
+	}
+}
+
 func TestSvcDeployOpts_pushAddonsTemplateToS3Bucket(t *testing.T) {
 	mockError := errors.New("some error")
 	tests := map[string]struct {
@@ -475,3 +583,1462 @@ func TestSvcDeployOpts_pushAddonsTemplateToS3Bucket(t *testing.T) {
 		})
 	}
 }
+
+func TestSvcDeployOpts_pushTemplateToS3Bucket(t *testing.T) {
+	mockError := errors.New("some error")
+	largeTemplate := strings.Repeat("a", awscloudformation.MaxTemplateBodySize+1)
+	tests := map[string]struct {
+		inEnvironment *config.Environment
+
+		mockAppResourcesGetter func(m *mocks.MockappResourcesGetter)
+		mockS3Svc              func(m *mocks.MockartifactUploader)
+		mockConf               func(m *mocks.Mocktemplater)
+
+		wantURL string
+		wantErr error
+	}{
+		"should return error if fail to generate the template": {
+			mockConf: func(m *mocks.Mocktemplater) {
+				m.EXPECT().Template().Return("", mockError)
+			},
+			mockAppResourcesGetter: func(m *mocks.MockappResourcesGetter) {},
+			mockS3Svc:              func(m *mocks.MockartifactUploader) {},
+
+			wantErr: fmt.Errorf("generate template of service mockSvc: some error"),
+		},
+		"should return empty url if the template is small enough": {
+			mockConf: func(m *mocks.Mocktemplater) {
+				m.EXPECT().Template().Return("some data", nil)
+			},
+			mockAppResourcesGetter: func(m *mocks.MockappResourcesGetter) {
+				m.EXPECT().GetAppResourcesByRegion(gomock.Any(), gomock.Any()).Times(0)
+			},
+			mockS3Svc: func(m *mocks.MockartifactUploader) {
+				m.EXPECT().PutArtifact(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+
+			wantURL: "",
+		},
+		"should return error if fail to get app resources": {
+			inEnvironment: &config.Environment{
+				Name:      "mockEnv",
+				Region:    "us-west-2",
+				AccountID: "1234",
+			},
+			mockConf: func(m *mocks.Mocktemplater) {
+				m.EXPECT().Template().Return(largeTemplate, nil)
+			},
+			mockAppResourcesGetter: func(m *mocks.MockappResourcesGetter) {
+				m.EXPECT().GetAppResourcesByRegion(gomock.Any(), "us-west-2").Return(nil, mockError)
+			},
+			mockS3Svc: func(m *mocks.MockartifactUploader) {},
+
+			wantErr: fmt.Errorf("get app resources: some error"),
+		},
+		"should push large template to S3 bucket in the same account": {
+			inEnvironment: &config.Environment{
+				Name:      "mockEnv",
+				Region:    "us-west-2",
+				AccountID: "1234",
+			},
+			mockConf: func(m *mocks.Mocktemplater) {
+				m.EXPECT().Template().Return(largeTemplate, nil)
+			},
+			mockAppResourcesGetter: func(m *mocks.MockappResourcesGetter) {
+				m.EXPECT().GetAppResourcesByRegion(gomock.Any(), "us-west-2").Return(&stack.AppRegionalResources{
+					S3Bucket: "mockBucket",
+				}, nil)
+			},
+			mockS3Svc: func(m *mocks.MockartifactUploader) {
+				m.EXPECT().PutArtifact("mockBucket", "mockSvc.stack.yml", gomock.Any()).Return("https://mockS3DomainName/mockPath", nil)
+			},
+
+			wantURL: "https://mockS3DomainName/mockPath",
+		},
+		"should push large template with a pre-signed url to S3 bucket in a different account": {
+			inEnvironment: &config.Environment{
+				Name:      "mockEnv",
+				Region:    "us-west-2",
+				AccountID: "5678",
+			},
+			mockConf: func(m *mocks.Mocktemplater) {
+				m.EXPECT().Template().Return(largeTemplate, nil)
+			},
+			mockAppResourcesGetter: func(m *mocks.MockappResourcesGetter) {
+				m.EXPECT().GetAppResourcesByRegion(gomock.Any(), "us-west-2").Return(&stack.AppRegionalResources{
+					S3Bucket: "mockBucket",
+				}, nil)
+			},
+			mockS3Svc: func(m *mocks.MockartifactUploader) {
+				m.EXPECT().PutArtifactWithPresignedURL("mockBucket", "mockSvc.stack.yml", gomock.Any(), templateURLExpiry).Return("https://mockS3DomainName/mockPath?X-Amz-Signature=abc", nil)
+			},
+
+			wantURL: "https://mockS3DomainName/mockPath?X-Amz-Signature=abc",
+		},
+		"should return error if fail to upload to S3 bucket": {
+			inEnvironment: &config.Environment{
+				Name:      "mockEnv",
+				Region:    "us-west-2",
+				AccountID: "1234",
+			},
+			mockConf: func(m *mocks.Mocktemplater) {
+				m.EXPECT().Template().Return(largeTemplate, nil)
+			},
+			mockAppResourcesGetter: func(m *mocks.MockappResourcesGetter) {
+				m.EXPECT().GetAppResourcesByRegion(gomock.Any(), "us-west-2").Return(&stack.AppRegionalResources{
+					S3Bucket: "mockBucket",
+				}, nil)
+			},
+			mockS3Svc: func(m *mocks.MockartifactUploader) {
+				m.EXPECT().PutArtifact("mockBucket", "mockSvc.stack.yml", gomock.Any()).Return("", mockError)
+			},
+
+			wantErr: fmt.Errorf("put template artifact to bucket mockBucket: some error"),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAppResourcesGetter := mocks.NewMockappResourcesGetter(ctrl)
+			mockS3Svc := mocks.NewMockartifactUploader(ctrl)
+			mockConf := mocks.NewMocktemplater(ctrl)
+			tc.mockAppResourcesGetter(mockAppResourcesGetter)
+			tc.mockS3Svc(mockS3Svc)
+			tc.mockConf(mockConf)
+
+			opts := deploySvcOpts{
+				deploySvcVars: deploySvcVars{
+					Name: "mockSvc",
+				},
+				appCFN:            mockAppResourcesGetter,
+				s3:                mockS3Svc,
+				targetEnvironment: tc.inEnvironment,
+				targetApp: &config.Application{
+					Name:      "mockApp",
+					AccountID: "1234",
+				},
+			}
+
+			gotURL, gotErr := opts.pushTemplateToS3Bucket(mockConf)
+
+			if tc.wantErr != nil {
+				require.EqualError(t, gotErr, tc.wantErr.Error())
+			} else {
+				require.NoError(t, gotErr)
+				require.Equal(t, tc.wantURL, gotURL)
+			}
+		})
+	}
+}
+
+func TestSvcDeployOpts_checkDependencies(t *testing.T) {
+	const frontendMft = `name: frontend
+type: Load Balanced Web Service
+depends_on: [backend]`
+	testCases := map[string]struct {
+		mockWs          func(m *mocks.MockwsSvcDirReader)
+		mockDeployStore func(m *mocks.MockdeployedEnvironmentLister)
+
+		wantedError error
+	}{
+		"no dependencies": {
+			mockWs: func(m *mocks.MockwsSvcDirReader) {
+				m.EXPECT().ReadServiceManifest("frontend").Return([]byte("type: Load Balanced Web Service"), nil)
+			},
+			mockDeployStore: func(m *mocks.MockdeployedEnvironmentLister) {},
+		},
+		"error if fail to read the manifest": {
+			mockWs: func(m *mocks.MockwsSvcDirReader) {
+				m.EXPECT().ReadServiceManifest("frontend").Return(nil, errors.New("some error"))
+			},
+			mockDeployStore: func(m *mocks.MockdeployedEnvironmentLister) {},
+
+			wantedError: errors.New("read service frontend manifest from workspace: some error"),
+		},
+		"error if fail to check if the dependency is deployed": {
+			mockWs: func(m *mocks.MockwsSvcDirReader) {
+				m.EXPECT().ReadServiceManifest("frontend").Return([]byte(frontendMft), nil)
+			},
+			mockDeployStore: func(m *mocks.MockdeployedEnvironmentLister) {
+				m.EXPECT().IsServiceDeployed("phonetool", "test", "backend").Return(false, errors.New("some error"))
+			},
+
+			wantedError: errors.New("check if service backend is deployed in environment test: some error"),
+		},
+		"error if the dependency is not deployed": {
+			mockWs: func(m *mocks.MockwsSvcDirReader) {
+				m.EXPECT().ReadServiceManifest("frontend").Return([]byte(frontendMft), nil)
+			},
+			mockDeployStore: func(m *mocks.MockdeployedEnvironmentLister) {
+				m.EXPECT().IsServiceDeployed("phonetool", "test", "backend").Return(false, nil)
+			},
+
+			wantedError: errors.New("service frontend depends on backend which is not deployed in environment test"),
+		},
+		"success if the dependency is deployed": {
+			mockWs: func(m *mocks.MockwsSvcDirReader) {
+				m.EXPECT().ReadServiceManifest("frontend").Return([]byte(frontendMft), nil)
+			},
+			mockDeployStore: func(m *mocks.MockdeployedEnvironmentLister) {
+				m.EXPECT().IsServiceDeployed("phonetool", "test", "backend").Return(true, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockWs := mocks.NewMockwsSvcDirReader(ctrl)
+			mockDeployStore := mocks.NewMockdeployedEnvironmentLister(ctrl)
+			tc.mockWs(mockWs)
+			tc.mockDeployStore(mockDeployStore)
+
+			opts := &deploySvcOpts{
+				deploySvcVars: deploySvcVars{
+					GlobalOpts: &GlobalOpts{appName: "phonetool"},
+					Name:       "frontend",
+					EnvName:    "test",
+				},
+				ws:          mockWs,
+				deployStore: mockDeployStore,
+			}
+
+			// WHEN
+			err := opts.checkDependencies()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSvcDeployOpts_checkPolicies(t *testing.T) {
+	mockFiles := &workspace.PolicyFiles{
+		Blocking: []string{"/copilot/policies/encryption.guard"},
+		Warning:  []string{"/copilot/policies/warn/tags.guard"},
+	}
+	testCases := map[string]struct {
+		setupMocks func(ws *mocks.MockpolicyFilesReader, conf *mocks.Mocktemplater, checker *mocks.MockpolicyChecker)
+
+		wantedError error
+	}{
+		"errors if failed to read the policies directory": {
+			setupMocks: func(ws *mocks.MockpolicyFilesReader, conf *mocks.Mocktemplater, checker *mocks.MockpolicyChecker) {
+				ws.EXPECT().ReadPoliciesDir().Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("read policies directory: some error"),
+		},
+		"skips generating the template without policies": {
+			setupMocks: func(ws *mocks.MockpolicyFilesReader, conf *mocks.Mocktemplater, checker *mocks.MockpolicyChecker) {
+				ws.EXPECT().ReadPoliciesDir().Return(&workspace.PolicyFiles{}, nil)
+				conf.EXPECT().Template().Times(0)
+				checker.EXPECT().Check(gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		"errors if failed to evaluate the rules": {
+			setupMocks: func(ws *mocks.MockpolicyFilesReader, conf *mocks.Mocktemplater, checker *mocks.MockpolicyChecker) {
+				ws.EXPECT().ReadPoliciesDir().Return(mockFiles, nil)
+				conf.EXPECT().Template().Return("template", nil)
+				checker.EXPECT().Check("template", mockFiles.Warning).Return(nil, &policy.ErrCfnGuardNotFound{})
+			},
+			wantedError: fmt.Errorf("check template of service frontend against policies: %w", &policy.ErrCfnGuardNotFound{}),
+		},
+		"only warns about violations of the warning rules": {
+			setupMocks: func(ws *mocks.MockpolicyFilesReader, conf *mocks.Mocktemplater, checker *mocks.MockpolicyChecker) {
+				ws.EXPECT().ReadPoliciesDir().Return(mockFiles, nil)
+				conf.EXPECT().Template().Return("template", nil)
+				checker.EXPECT().Check("template", mockFiles.Warning).Return([]policy.Violation{
+					{RulesFile: "/copilot/policies/warn/tags.guard", Details: "resources_tagged FAIL"},
+				}, nil)
+				checker.EXPECT().Check("template", mockFiles.Blocking).Return(nil, nil)
+			},
+		},
+		"errors if the template violates the blocking rules": {
+			setupMocks: func(ws *mocks.MockpolicyFilesReader, conf *mocks.Mocktemplater, checker *mocks.MockpolicyChecker) {
+				ws.EXPECT().ReadPoliciesDir().Return(mockFiles, nil)
+				conf.EXPECT().Template().Return("template", nil)
+				checker.EXPECT().Check("template", mockFiles.Warning).Return(nil, nil)
+				checker.EXPECT().Check("template", mockFiles.Blocking).Return([]policy.Violation{
+					{RulesFile: "/copilot/policies/encryption.guard", Details: "s3_buckets_encrypted FAIL"},
+				}, nil)
+			},
+			wantedError: errors.New("service frontend violates the rules of 1 policy files"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockPolicies := mocks.NewMockpolicyFilesReader(ctrl)
+			mockConf := mocks.NewMocktemplater(ctrl)
+			mockChecker := mocks.NewMockpolicyChecker(ctrl)
+			tc.setupMocks(mockPolicies, mockConf, mockChecker)
+
+			opts := &deploySvcOpts{
+				deploySvcVars: deploySvcVars{
+					GlobalOpts: &GlobalOpts{appName: "phonetool"},
+					Name:       "frontend",
+					EnvName:    "test",
+				},
+				policies:      mockPolicies,
+				policyChecker: mockChecker,
+			}
+
+			// WHEN
+			err := opts.checkPolicies(mockConf)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSvcDeployOpts_checkFargateCapacity(t *testing.T) {
+	const (
+		mftWith4Tasks = `name: frontend
+type: Load Balanced Web Service
+cpu: 1024
+memory: 2048
+count: 4`
+		quotaURL = "https://console.aws.amazon.com/servicequotas/home?region=us-west-2#!/services/fargate/quotas/L-3032A538"
+	)
+	testCases := map[string]struct {
+		setupMocks func(m checkFargateCapacityMocks)
+
+		wantedError error
+	}{
+		"error if the tasks need more vCPUs than the quota": {
+			setupMocks: func(m checkFargateCapacityMocks) {
+				m.ws.EXPECT().ReadServiceManifest("frontend").Return([]byte(mftWith4Tasks), nil)
+				m.quota.EXPECT().FargateVCPUQuota().Return(float64(3), nil)
+			},
+
+			wantedError: errors.New("4 tasks with 1024 CPU units need 4 vCPUs, over the Fargate quota of 3 vCPUs of the account; request an increase at " + quotaURL),
+		},
+		"applies the configuration of the environment": {
+			setupMocks: func(m checkFargateCapacityMocks) {
+				m.ws.EXPECT().ReadServiceManifest("frontend").Return([]byte(mftWith4Tasks+`
+environments:
+  test:
+    count: 1`), nil)
+				m.quota.EXPECT().FargateVCPUQuota().Return(float64(3), nil)
+				m.usage.EXPECT().FargateVCPUUsage().Return(float64(0), nil)
+				m.deployStore.EXPECT().IsServiceDeployed("phonetool", "test", "frontend").Return(false, nil)
+			},
+		},
+		"error if the other tasks of the account leave too few vCPUs": {
+			setupMocks: func(m checkFargateCapacityMocks) {
+				m.ws.EXPECT().ReadServiceManifest("frontend").Return([]byte(mftWith4Tasks), nil)
+				m.quota.EXPECT().FargateVCPUQuota().Return(float64(10), nil)
+				m.usage.EXPECT().FargateVCPUUsage().Return(float64(8), nil)
+				m.deployStore.EXPECT().IsServiceDeployed("phonetool", "test", "frontend").Return(true, nil)
+				m.tasks.EXPECT().RunningTasks().Return([]*ecs.Task{
+					{Cpu: aws.String("512")},
+				}, nil)
+			},
+
+			wantedError: errors.New("4 tasks with 1024 CPU units need 4 vCPUs, but only 2.5 of the Fargate quota of 10 vCPUs are available; request an increase at " + quotaURL),
+		},
+		"success if the running tasks of the service free enough vCPUs": {
+			setupMocks: func(m checkFargateCapacityMocks) {
+				m.ws.EXPECT().ReadServiceManifest("frontend").Return([]byte(mftWith4Tasks), nil)
+				m.quota.EXPECT().FargateVCPUQuota().Return(float64(10), nil)
+				m.usage.EXPECT().FargateVCPUUsage().Return(float64(8), nil)
+				m.deployStore.EXPECT().IsServiceDeployed("phonetool", "test", "frontend").Return(true, nil)
+				m.tasks.EXPECT().RunningTasks().Return([]*ecs.Task{
+					{Cpu: aws.String("1024")},
+					{Cpu: aws.String("1024")},
+				}, nil)
+			},
+		},
+		"skips the check if the quota can't be retrieved": {
+			setupMocks: func(m checkFargateCapacityMocks) {
+				m.ws.EXPECT().ReadServiceManifest("frontend").Return([]byte(mftWith4Tasks), nil)
+				m.quota.EXPECT().FargateVCPUQuota().Return(float64(0), errors.New("access denied"))
+			},
+		},
+		"skips the check if the usage can't be retrieved": {
+			setupMocks: func(m checkFargateCapacityMocks) {
+				m.ws.EXPECT().ReadServiceManifest("frontend").Return([]byte(mftWith4Tasks), nil)
+				m.quota.EXPECT().FargateVCPUQuota().Return(float64(10), nil)
+				m.usage.EXPECT().FargateVCPUUsage().Return(float64(0), errors.New("access denied"))
+			},
+		},
+		"error if fail to read the manifest": {
+			setupMocks: func(m checkFargateCapacityMocks) {
+				m.ws.EXPECT().ReadServiceManifest("frontend").Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("read service frontend manifest from workspace: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := checkFargateCapacityMocks{
+				ws:          mocks.NewMockwsSvcDirReader(ctrl),
+				deployStore: mocks.NewMockdeployedEnvironmentLister(ctrl),
+				quota:       mocks.NewMockfargateQuotaGetter(ctrl),
+				usage:       mocks.NewMockfargateUsageGetter(ctrl),
+				tasks:       mocks.NewMockrunningTasksGetter(ctrl),
+			}
+			tc.setupMocks(m)
+
+			opts := &deploySvcOpts{
+				deploySvcVars: deploySvcVars{
+					GlobalOpts: &GlobalOpts{appName: "phonetool"},
+					Name:       "frontend",
+					EnvName:    "test",
+				},
+				ws:           m.ws,
+				deployStore:  m.deployStore,
+				unmarshal:    manifest.UnmarshalService,
+				fargateQuota: m.quota,
+				fargateUsage: m.usage,
+				runningTasks: m.tasks,
+				targetEnvironment: &config.Environment{
+					Name:   "test",
+					Region: "us-west-2",
+				},
+			}
+
+			// WHEN
+			err := opts.checkFargateCapacity()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+type checkFargateCapacityMocks struct {
+	ws          *mocks.MockwsSvcDirReader
+	deployStore *mocks.MockdeployedEnvironmentLister
+	quota       *mocks.MockfargateQuotaGetter
+	usage       *mocks.MockfargateUsageGetter
+	tasks       *mocks.MockrunningTasksGetter
+}
+
+func TestSvcDeployOpts_checkSubnetCapacity(t *testing.T) {
+	const mftWith4Tasks = `name: frontend
+type: Load Balanced Web Service
+cpu: 256
+count: 4`
+	testCases := map[string]struct {
+		setupMocks func(m checkSubnetCapacityMocks)
+
+		wantedError error
+	}{
+		"error if the public subnets don't have enough IP addresses for the tasks": {
+			setupMocks: func(m checkSubnetCapacityMocks) {
+				m.ws.EXPECT().ReadServiceManifest("frontend").Return([]byte(mftWith4Tasks), nil)
+				m.envDescriber.EXPECT().EnvOutputs().Return(map[string]string{
+					stack.EnvOutputPublicSubnets: "subnet-1,subnet-2,subnet-3",
+				}, nil)
+				m.subnetIPs.EXPECT().SubnetsAvailableIPs(gomock.Any(), "subnet-1", "subnet-2").Return(map[string]int{
+					"subnet-1": 2,
+					"subnet-2": 1,
+				}, nil)
+			},
+
+			wantedError: errors.New("4 tasks need 4 IP addresses, but only 3 are available in subnets subnet-1, subnet-2; stop other tasks or import larger subnets"),
+		},
+		"success if the public subnets have enough IP addresses": {
+			setupMocks: func(m checkSubnetCapacityMocks) {
+				m.ws.EXPECT().ReadServiceManifest("frontend").Return([]byte(mftWith4Tasks), nil)
+				m.envDescriber.EXPECT().EnvOutputs().Return(map[string]string{
+					stack.EnvOutputPublicSubnets: "subnet-1,subnet-2",
+				}, nil)
+				m.subnetIPs.EXPECT().SubnetsAvailableIPs(gomock.Any(), "subnet-1", "subnet-2").Return(map[string]int{
+					"subnet-1": 250,
+					"subnet-2": 250,
+				}, nil)
+			},
+		},
+		"skips the check if the environment outputs can't be retrieved": {
+			setupMocks: func(m checkSubnetCapacityMocks) {
+				m.ws.EXPECT().ReadServiceManifest("frontend").Return([]byte(mftWith4Tasks), nil)
+				m.envDescriber.EXPECT().EnvOutputs().Return(nil, errors.New("some error"))
+			},
+		},
+		"skips the check if the subnets can't be described": {
+			setupMocks: func(m checkSubnetCapacityMocks) {
+				m.ws.EXPECT().ReadServiceManifest("frontend").Return([]byte(mftWith4Tasks), nil)
+				m.envDescriber.EXPECT().EnvOutputs().Return(map[string]string{
+					stack.EnvOutputPublicSubnets: "subnet-1,subnet-2",
+				}, nil)
+				m.subnetIPs.EXPECT().SubnetsAvailableIPs(gomock.Any(), "subnet-1", "subnet-2").Return(nil, errors.New("access denied"))
+			},
+		},
+		"error if fail to read the manifest": {
+			setupMocks: func(m checkSubnetCapacityMocks) {
+				m.ws.EXPECT().ReadServiceManifest("frontend").Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("read service frontend manifest from workspace: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := checkSubnetCapacityMocks{
+				ws:           mocks.NewMockwsSvcDirReader(ctrl),
+				envDescriber: mocks.NewMockenvOutputsGetter(ctrl),
+				subnetIPs:    mocks.NewMocksubnetIPsGetter(ctrl),
+			}
+			tc.setupMocks(m)
+
+			opts := &deploySvcOpts{
+				deploySvcVars: deploySvcVars{
+					GlobalOpts: &GlobalOpts{appName: "phonetool"},
+					Name:       "frontend",
+					EnvName:    "test",
+				},
+				ws:           m.ws,
+				unmarshal:    manifest.UnmarshalService,
+				envDescriber: m.envDescriber,
+				subnetIPs:    m.subnetIPs,
+				targetEnvironment: &config.Environment{
+					Name:   "test",
+					Region: "us-west-2",
+				},
+			}
+
+			// WHEN
+			err := opts.checkSubnetCapacity(context.Background())
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+type checkSubnetCapacityMocks struct {
+	ws           *mocks.MockwsSvcDirReader
+	envDescriber *mocks.MockenvOutputsGetter
+	subnetIPs    *mocks.MocksubnetIPsGetter
+}
+
+type recoverSvcStackMocks struct {
+	recoverer *mocks.MocksvcStackRecoverer
+	prompt    *mocks.Mockprompter
+	addons    *mocks.Mocktemplater
+	spinner   *mocks.Mockprogress
+}
+
+func TestSvcDeployOpts_recoverSvcStack(t *testing.T) {
+	const stackName = "phonetool-test-frontend"
+	testCases := map[string]struct {
+		setupMocks func(m recoverSvcStackMocks)
+
+		wantedError error
+	}{
+		"does nothing if the stack isn't stuck": {
+			setupMocks: func(m recoverSvcStackMocks) {
+				m.recoverer.EXPECT().ServiceRollbackFailedResources(stackName).Return(nil, false, nil)
+				m.prompt.EXPECT().SelectOne(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		"continues the rollback skipping the selected resources": {
+			setupMocks: func(m recoverSvcStackMocks) {
+				m.recoverer.EXPECT().ServiceRollbackFailedResources(stackName).Return([]string{"Service", "HTTPListenerRule"}, true, nil)
+				m.prompt.EXPECT().SelectOne(gomock.Any(), gomock.Any(), []string{svcRecoveryContinueRollback, svcRecoveryRecreate}).
+					Return(svcRecoveryContinueRollback, nil)
+				m.prompt.EXPECT().MultiSelect(svcRecoverySkipPrompt, svcRecoverySkipHelpPrompt, []string{"Service", "HTTPListenerRule"}).
+					Return([]string{"Service"}, nil)
+				m.spinner.EXPECT().Start(gomock.Any())
+				m.recoverer.EXPECT().ContinueServiceRollback(stackName, []string{"Service"}).Return(nil)
+				m.spinner.EXPECT().Stop(gomock.Any())
+			},
+		},
+		"wraps error from continuing the rollback": {
+			setupMocks: func(m recoverSvcStackMocks) {
+				m.recoverer.EXPECT().ServiceRollbackFailedResources(stackName).Return(nil, true, nil)
+				m.prompt.EXPECT().SelectOne(gomock.Any(), gomock.Any(), gomock.Any()).Return(svcRecoveryContinueRollback, nil)
+				m.spinner.EXPECT().Start(gomock.Any())
+				m.recoverer.EXPECT().ContinueServiceRollback(stackName, nil).Return(errors.New("some error"))
+				m.spinner.EXPECT().Stop(gomock.Any())
+			},
+			wantedError: errors.New("recover service frontend: some error"),
+		},
+		"recreates the stack if the service has no addons": {
+			setupMocks: func(m recoverSvcStackMocks) {
+				m.recoverer.EXPECT().ServiceRollbackFailedResources(stackName).Return([]string{"Service"}, true, nil)
+				m.prompt.EXPECT().SelectOne(gomock.Any(), gomock.Any(), gomock.Any()).Return(svcRecoveryRecreate, nil)
+				m.addons.EXPECT().Template().Return("", &addon.ErrDirNotExist{})
+				m.spinner.EXPECT().Start(gomock.Any())
+				m.recoverer.EXPECT().DeleteService(deploy.DeleteServiceInput{
+					Name:    "frontend",
+					EnvName: "test",
+					AppName: "phonetool",
+				}).Return(nil)
+				m.spinner.EXPECT().Stop(gomock.Any())
+			},
+		},
+		"refuses to recreate the stack if addons would lose data": {
+			setupMocks: func(m recoverSvcStackMocks) {
+				m.recoverer.EXPECT().ServiceRollbackFailedResources(stackName).Return([]string{"Service"}, true, nil)
+				m.prompt.EXPECT().SelectOne(gomock.Any(), gomock.Any(), gomock.Any()).Return(svcRecoveryRecreate, nil)
+				m.addons.EXPECT().Template().Return(`Resources:
+  usersTable:
+    Type: AWS::DynamoDB::Table
+`, nil)
+				m.recoverer.EXPECT().DeleteService(gomock.Any()).Times(0)
+			},
+			wantedError: errors.New("recreating service frontend would delete addons usersTable: set their DeletionPolicy to Retain or continue the rollback instead"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := recoverSvcStackMocks{
+				recoverer: mocks.NewMocksvcStackRecoverer(ctrl),
+				prompt:    mocks.NewMockprompter(ctrl),
+				addons:    mocks.NewMocktemplater(ctrl),
+				spinner:   mocks.NewMockprogress(ctrl),
+			}
+			tc.setupMocks(m)
+
+			opts := &deploySvcOpts{
+				deploySvcVars: deploySvcVars{
+					GlobalOpts: &GlobalOpts{appName: "phonetool", prompt: m.prompt},
+					Name:       "frontend",
+					EnvName:    "test",
+					Force:      true,
+				},
+				stackRecoverer: m.recoverer,
+				addons:         m.addons,
+				spinner:        m.spinner,
+				targetEnvironment: &config.Environment{
+					Name: "test",
+				},
+			}
+
+			// WHEN
+			err := opts.recoverSvcStack()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSvcDeployOpts_cacheAlias(t *testing.T) {
+	alias := &manifest.Alias{
+		Name:         aws.String("api.example.com"),
+		HostedZoneID: aws.String("Z0873220N255IR3MTNR4"),
+	}
+	const mockCertARN = "arn:aws:acm:us-west-2:123456789012:certificate/1"
+	testCases := map[string]struct {
+		inApp      *config.Application
+		inMft      *manifest.LoadBalancedWebService
+		inCertARNs []string
+		mockCerts  func(m *mocks.MockcertDescriber)
+
+		wantedAlias *manifest.Alias
+		wantedError error
+	}{
+		"uses the alias overridden by the environment": {
+			inApp: &config.Application{Name: "phonetool"},
+			inMft: &manifest.LoadBalancedWebService{
+				Environments: map[string]*manifest.LoadBalancedWebServiceConfig{
+					"test": {
+						RoutingRule: manifest.RoutingRule{
+							Alias: alias,
+						},
+					},
+				},
+			},
+			wantedAlias: alias,
+		},
+		"skips services without an alias": {
+			inApp: &config.Application{Name: "phonetool"},
+			inMft: &manifest.LoadBalancedWebService{},
+		},
+		"errors if the hosted zone is missing": {
+			inApp: &config.Application{Name: "phonetool"},
+			inMft: &manifest.LoadBalancedWebService{
+				LoadBalancedWebServiceConfig: manifest.LoadBalancedWebServiceConfig{
+					RoutingRule: manifest.RoutingRule{
+						Alias: &manifest.Alias{
+							Name: aws.String("api.example.com"),
+						},
+					},
+				},
+			},
+			wantedError: errors.New("alias requires both a name and a hostedZone"),
+		},
+		"errors if the routing policy is invalid": {
+			inApp: &config.Application{Name: "phonetool"},
+			inMft: &manifest.LoadBalancedWebService{
+				LoadBalancedWebServiceConfig: manifest.LoadBalancedWebServiceConfig{
+					RoutingRule: manifest.RoutingRule{
+						Alias: &manifest.Alias{
+							Name:         aws.String("api.example.com"),
+							HostedZoneID: aws.String("Z0873220N255IR3MTNR4"),
+							Routing: &manifest.AliasRouting{
+								Policy: aws.String("geolocation"),
+							},
+						},
+					},
+				},
+			},
+			wantedError: errors.New("validate routing of alias api.example.com: policy must be one of weighted, latency or failover"),
+		},
+		"errors if the weight is missing": {
+			inApp: &config.Application{Name: "phonetool"},
+			inMft: &manifest.LoadBalancedWebService{
+				LoadBalancedWebServiceConfig: manifest.LoadBalancedWebServiceConfig{
+					RoutingRule: manifest.RoutingRule{
+						Alias: &manifest.Alias{
+							Name:         aws.String("api.example.com"),
+							HostedZoneID: aws.String("Z0873220N255IR3MTNR4"),
+							Routing: &manifest.AliasRouting{
+								Policy: aws.String("weighted"),
+							},
+						},
+					},
+				},
+			},
+			wantedError: errors.New("validate routing of alias api.example.com: weight is required by the weighted policy"),
+		},
+		"errors if the failover role is invalid": {
+			inApp: &config.Application{Name: "phonetool"},
+			inMft: &manifest.LoadBalancedWebService{
+				LoadBalancedWebServiceConfig: manifest.LoadBalancedWebServiceConfig{
+					RoutingRule: manifest.RoutingRule{
+						Alias: &manifest.Alias{
+							Name:         aws.String("api.example.com"),
+							HostedZoneID: aws.String("Z0873220N255IR3MTNR4"),
+							Routing: &manifest.AliasRouting{
+								Policy:   aws.String("failover"),
+								Failover: aws.String("tertiary"),
+							},
+						},
+					},
+				},
+			},
+			wantedError: errors.New("validate routing of alias api.example.com: failover must be primary or secondary for the failover policy"),
+		},
+		"errors if the application has a domain": {
+			inApp: &config.Application{Name: "phonetool", Domain: "phonetool.com"},
+			inMft: &manifest.LoadBalancedWebService{
+				LoadBalancedWebServiceConfig: manifest.LoadBalancedWebServiceConfig{
+					RoutingRule: manifest.RoutingRule{
+						Alias: alias,
+					},
+				},
+			},
+			wantedError: errors.New("alias api.example.com is not supported in environment test since application phonetool has a domain"),
+		},
+		"allows an alias in an application with a domain if a certificate covers it": {
+			inApp: &config.Application{Name: "phonetool", Domain: "phonetool.com"},
+			inMft: &manifest.LoadBalancedWebService{
+				LoadBalancedWebServiceConfig: manifest.LoadBalancedWebServiceConfig{
+					RoutingRule: manifest.RoutingRule{
+						Alias: alias,
+					},
+				},
+			},
+			inCertARNs: []string{mockCertARN},
+			mockCerts: func(m *mocks.MockcertDescriber) {
+				m.EXPECT().DescribeCertificate(mockCertARN).Return(&acm.Certificate{
+					DomainName: "*.example.com",
+				}, nil)
+			},
+			wantedAlias: alias,
+		},
+		"errors if the certificates can't be described": {
+			inApp: &config.Application{Name: "phonetool"},
+			inMft: &manifest.LoadBalancedWebService{
+				LoadBalancedWebServiceConfig: manifest.LoadBalancedWebServiceConfig{
+					RoutingRule: manifest.RoutingRule{
+						Alias: alias,
+					},
+				},
+			},
+			inCertARNs: []string{mockCertARN},
+			mockCerts: func(m *mocks.MockcertDescriber) {
+				m.EXPECT().DescribeCertificate(mockCertARN).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("validate certificates of alias api.example.com: some error"),
+		},
+		"errors if no imported certificate covers the alias": {
+			inApp: &config.Application{Name: "phonetool"},
+			inMft: &manifest.LoadBalancedWebService{
+				LoadBalancedWebServiceConfig: manifest.LoadBalancedWebServiceConfig{
+					RoutingRule: manifest.RoutingRule{
+						Alias: alias,
+					},
+				},
+			},
+			inCertARNs: []string{mockCertARN},
+			mockCerts: func(m *mocks.MockcertDescriber) {
+				m.EXPECT().DescribeCertificate(mockCertARN).Return(&acm.Certificate{
+					DomainName:              "example.com",
+					SubjectAlternativeNames: []string{"www.example.com"},
+				}, nil)
+			},
+			wantedError: errors.New("alias api.example.com is not covered by any of the certificates imported by environment test"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockCerts := mocks.NewMockcertDescriber(ctrl)
+			if tc.mockCerts != nil {
+				tc.mockCerts(mockCerts)
+			}
+			env := &config.Environment{Name: "test"}
+			if tc.inCertARNs != nil {
+				env.CustomConfig = &config.CustomizeEnv{ImportCertARNs: tc.inCertARNs}
+			}
+			opts := &deploySvcOpts{
+				targetApp:         tc.inApp,
+				targetEnvironment: env,
+				certs:             mockCerts,
+			}
+
+			// WHEN
+			err := opts.cacheAlias(tc.inMft)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedAlias, opts.alias)
+		})
+	}
+}
+
+func TestSvcDeployOpts_deployAlias(t *testing.T) {
+	const (
+		mockZoneID = "Z0873220N255IR3MTNR4"
+		mockRole   = "arn:aws:iam::123456789012:role/dns-admin"
+	)
+	mockErr := errors.New("some error")
+	testCases := map[string]struct {
+		inAlias    *manifest.Alias
+		setupMocks func(m deployAliasMocks)
+
+		wantedError error
+	}{
+		"skips services without an alias": {
+			setupMocks: func(m deployAliasMocks) {},
+		},
+		"creates the alias record in the hosted zone of another account": {
+			inAlias: &manifest.Alias{
+				Name:         aws.String("api.example.com."),
+				HostedZoneID: aws.String(mockZoneID),
+				RoleARN:      aws.String(mockRole),
+			},
+			setupMocks: func(m deployAliasMocks) {
+				m.deployer.EXPECT().HostedZoneName(mockZoneID).Return("example.com", nil)
+				m.envDescriber.EXPECT().EnvOutputs().Return(map[string]string{
+					stack.EnvOutputPublicLoadBalancerDNSName: "lb.us-west-2.elb.amazonaws.com",
+					stack.EnvOutputPublicLoadBalancerZoneKey: "Z1H1FL5HABSF5",
+				}, nil)
+				m.spinner.EXPECT().Start(gomock.Any())
+				m.deployer.EXPECT().UpsertAliasRecord(route53.AliasRecord{
+					HostedZoneID:       mockZoneID,
+					Name:               "api.example.com",
+					TargetDNSName:      "lb.us-west-2.elb.amazonaws.com",
+					TargetHostedZoneID: "Z1H1FL5HABSF5",
+				}).Return(nil)
+				m.spinner.EXPECT().Stop(log.Ssuccessf("Created alias %s.\n", "api.example.com"))
+			},
+		},
+		"creates a weighted record with a health check": {
+			inAlias: &manifest.Alias{
+				Name:         aws.String("api.example.com"),
+				HostedZoneID: aws.String(mockZoneID),
+				Routing: &manifest.AliasRouting{
+					Policy:      aws.String("weighted"),
+					Weight:      aws.Int64(80),
+					HealthCheck: aws.Bool(true),
+				},
+			},
+			setupMocks: func(m deployAliasMocks) {
+				m.deployer.EXPECT().HostedZoneName(mockZoneID).Return("example.com", nil)
+				m.envDescriber.EXPECT().EnvOutputs().Return(map[string]string{
+					stack.EnvOutputPublicLoadBalancerDNSName: "lb.us-west-2.elb.amazonaws.com",
+					stack.EnvOutputPublicLoadBalancerZoneKey: "Z1H1FL5HABSF5",
+				}, nil)
+				m.deployer.EXPECT().CreateHealthCheck(gomock.Any()).DoAndReturn(func(hc route53.HealthCheck) (string, error) {
+					require.Equal(t, "lb.us-west-2.elb.amazonaws.com", hc.DNSName)
+					require.Equal(t, "/api/healthz", hc.Path)
+					require.Equal(t, int64(80), hc.Port)
+					require.False(t, hc.HTTPS)
+					require.True(t, len(hc.CallerReference) <= 64)
+					return "mockHealthCheckID", nil
+				})
+				m.spinner.EXPECT().Start(gomock.Any())
+				m.deployer.EXPECT().UpsertAliasRecord(route53.AliasRecord{
+					HostedZoneID:       mockZoneID,
+					Name:               "api.example.com",
+					TargetDNSName:      "lb.us-west-2.elb.amazonaws.com",
+					TargetHostedZoneID: "Z1H1FL5HABSF5",
+					SetIdentifier:      "test",
+					Weight:             aws.Int64(80),
+					HealthCheckID:      "mockHealthCheckID",
+				}).Return(nil)
+				m.spinner.EXPECT().Stop(gomock.Any())
+			},
+		},
+		"creates a latency record": {
+			inAlias: &manifest.Alias{
+				Name:         aws.String("api.example.com"),
+				HostedZoneID: aws.String(mockZoneID),
+				Routing: &manifest.AliasRouting{
+					Policy: aws.String("latency"),
+				},
+			},
+			setupMocks: func(m deployAliasMocks) {
+				m.deployer.EXPECT().HostedZoneName(mockZoneID).Return("example.com", nil)
+				m.envDescriber.EXPECT().EnvOutputs().Return(map[string]string{}, nil)
+				m.spinner.EXPECT().Start(gomock.Any())
+				m.deployer.EXPECT().UpsertAliasRecord(route53.AliasRecord{
+					HostedZoneID:  mockZoneID,
+					Name:          "api.example.com",
+					SetIdentifier: "test",
+					Region:        "us-west-2",
+				}).Return(nil)
+				m.spinner.EXPECT().Stop(gomock.Any())
+			},
+		},
+		"errors if the health check cannot be created": {
+			inAlias: &manifest.Alias{
+				Name:         aws.String("api.example.com"),
+				HostedZoneID: aws.String(mockZoneID),
+				Routing: &manifest.AliasRouting{
+					Policy:      aws.String("failover"),
+					Failover:    aws.String("primary"),
+					HealthCheck: aws.Bool(true),
+				},
+			},
+			setupMocks: func(m deployAliasMocks) {
+				m.deployer.EXPECT().HostedZoneName(mockZoneID).Return("example.com", nil)
+				m.envDescriber.EXPECT().EnvOutputs().Return(map[string]string{}, nil)
+				m.deployer.EXPECT().CreateHealthCheck(gomock.Any()).Return("", mockErr)
+			},
+			wantedError: fmt.Errorf("create health check of alias api.example.com in environment test: some error"),
+		},
+		"errors if the alias is outside of the hosted zone": {
+			inAlias: &manifest.Alias{
+				Name:         aws.String("api.notexample.com"),
+				HostedZoneID: aws.String(mockZoneID),
+			},
+			setupMocks: func(m deployAliasMocks) {
+				m.deployer.EXPECT().HostedZoneName(mockZoneID).Return("example.com", nil)
+			},
+			wantedError: fmt.Errorf("alias api.notexample.com does not belong to hosted zone %s for domain example.com", mockZoneID),
+		},
+		"errors if the hosted zone cannot be retrieved": {
+			inAlias: &manifest.Alias{
+				Name:         aws.String("api.example.com"),
+				HostedZoneID: aws.String(mockZoneID),
+			},
+			setupMocks: func(m deployAliasMocks) {
+				m.deployer.EXPECT().HostedZoneName(mockZoneID).Return("", mockErr)
+			},
+			wantedError: fmt.Errorf("validate hosted zone of alias api.example.com: some error"),
+		},
+		"errors if the record fails to propagate": {
+			inAlias: &manifest.Alias{
+				Name:         aws.String("example.com"),
+				HostedZoneID: aws.String(mockZoneID),
+			},
+			setupMocks: func(m deployAliasMocks) {
+				m.deployer.EXPECT().HostedZoneName(mockZoneID).Return("example.com", nil)
+				m.envDescriber.EXPECT().EnvOutputs().Return(map[string]string{}, nil)
+				m.spinner.EXPECT().Start(gomock.Any())
+				m.deployer.EXPECT().UpsertAliasRecord(gomock.Any()).Return(mockErr)
+				m.spinner.EXPECT().Stop(log.Serrorf("Failed to create alias %s.\n", "example.com"))
+			},
+			wantedError: fmt.Errorf("create alias example.com: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := deployAliasMocks{
+				deployer:     mocks.NewMockaliasRecordDeployer(ctrl),
+				envDescriber: mocks.NewMockenvOutputsGetter(ctrl),
+				spinner:      mocks.NewMockprogress(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &deploySvcOpts{
+				deploySvcVars: deploySvcVars{
+					GlobalOpts: &GlobalOpts{appName: "phonetool"},
+					Name:       "frontend",
+				},
+				targetApp:         &config.Application{Name: "phonetool"},
+				targetEnvironment: &config.Environment{Name: "test", Region: "us-west-2"},
+				alias:             tc.inAlias,
+				healthCheckPath:   "healthz",
+				rulePath:          "api",
+				envDescriber:      m.envDescriber,
+				spinner:           m.spinner,
+				newAliasDeployer: func(roleARN string) (aliasRecordDeployer, error) {
+					require.Equal(t, aws.StringValue(tc.inAlias.RoleARN), roleARN)
+					return m.deployer, nil
+				},
+			}
+
+			// WHEN
+			err := opts.deployAlias()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSvcDeployOpts_aliasHealthCheck(t *testing.T) {
+	testCases := map[string]struct {
+		inDomain          string
+		inRulePath        string
+		inHealthCheckPath string
+
+		wanted route53.HealthCheck
+	}{
+		"requests the health check path of a service at the root path": {
+			inRulePath:        "/",
+			inHealthCheckPath: "healthz",
+			wanted: route53.HealthCheck{
+				DNSName: "lb.us-west-2.elb.amazonaws.com",
+				Path:    "/healthz",
+				Port:    80,
+			},
+		},
+		"requests the health check path under the path of the service": {
+			inRulePath:        "api",
+			inHealthCheckPath: "/healthz",
+			wanted: route53.HealthCheck{
+				DNSName: "lb.us-west-2.elb.amazonaws.com",
+				Path:    "/api/healthz",
+				Port:    80,
+			},
+		},
+		"requests the path of the service if the health check path is the root": {
+			inRulePath:        "api",
+			inHealthCheckPath: "/",
+			wanted: route53.HealthCheck{
+				DNSName: "lb.us-west-2.elb.amazonaws.com",
+				Path:    "/api",
+				Port:    80,
+			},
+		},
+		"keeps a health check path that is already under the path of the service": {
+			inRulePath:        "api",
+			inHealthCheckPath: "/api/healthz",
+			wanted: route53.HealthCheck{
+				DNSName: "lb.us-west-2.elb.amazonaws.com",
+				Path:    "/api/healthz",
+				Port:    80,
+			},
+		},
+		"requests the subdomain of the service over HTTPS in an application with a domain": {
+			inDomain:          "phonetool.com",
+			inRulePath:        "api",
+			inHealthCheckPath: "/healthz",
+			wanted: route53.HealthCheck{
+				DNSName: "frontend.test.phonetool.com",
+				Path:    "/healthz",
+				Port:    443,
+				HTTPS:   true,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			opts := &deploySvcOpts{
+				deploySvcVars: deploySvcVars{
+					Name: "frontend",
+				},
+				targetApp:       &config.Application{Name: "phonetool", Domain: tc.inDomain},
+				healthCheckPath: tc.inHealthCheckPath,
+				rulePath:        tc.inRulePath,
+			}
+
+			// WHEN
+			got := opts.aliasHealthCheck("lb.us-west-2.elb.amazonaws.com", "test.phonetool.com")
+
+			// THEN
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestSvcDeployOpts_applyAliasRoutingHealthCheckReference(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	deployer := mocks.NewMockaliasRecordDeployer(ctrl)
+	var refs []string
+	deployer.EXPECT().CreateHealthCheck(gomock.Any()).DoAndReturn(func(hc route53.HealthCheck) (string, error) {
+		refs = append(refs, hc.CallerReference)
+		return "mockHealthCheckID", nil
+	}).Times(3)
+	opts := &deploySvcOpts{
+		deploySvcVars: deploySvcVars{
+			GlobalOpts: &GlobalOpts{appName: "phonetool"},
+			Name:       "frontend",
+		},
+		targetApp:         &config.Application{Name: "phonetool"},
+		targetEnvironment: &config.Environment{Name: "test", Region: "us-west-2"},
+		alias: &manifest.Alias{
+			Routing: &manifest.AliasRouting{
+				Policy:      aws.String("latency"),
+				HealthCheck: aws.Bool(true),
+			},
+		},
+	}
+
+	// WHEN
+	for _, path := range []string{"/healthz", "/healthz", "/ready"} {
+		opts.healthCheckPath = path
+		require.NoError(t, opts.applyAliasRouting(&route53.AliasRecord{
+			Name:          "api.example.com",
+			TargetDNSName: "lb.us-west-2.elb.amazonaws.com",
+		}, deployer, nil))
+	}
+
+	// THEN
+	require.Equal(t, refs[0], refs[1], "the same health check is reused")
+	require.NotEqual(t, refs[0], refs[2], "a new health check is created when the path changes")
+}
+
+type deployAliasMocks struct {
+	deployer     *mocks.MockaliasRecordDeployer
+	envDescriber *mocks.MockenvOutputsGetter
+	spinner      *mocks.Mockprogress
+}
+
+func TestSvcDeployOpts_serviceEventRows(t *testing.T) {
+	since := time.Date(2020, 10, 15, 10, 0, 0, 0, time.Local)
+	event := func(minutes int, message string) ecs.ServiceEvent {
+		return ecs.ServiceEvent{CreatedAt: since.Add(time.Duration(minutes) * time.Minute), Message: message}
+	}
+	testCases := map[string]struct {
+		inRows     []termprogress.TabRow
+		setupMocks func(m *mocks.MockserviceEventsGetter)
+
+		wantedLatest time.Time
+		wantedRows   []termprogress.TabRow
+	}{
+		"keeps the rows if the service doesn't exist yet": {
+			inRows: []termprogress.TabRow{"  10:00:00\thas reached a steady state."},
+			setupMocks: func(m *mocks.MockserviceEventsGetter) {
+				m.EXPECT().ServiceEvents(since).Return(nil, errors.New("cannot find service arn in service stack resource"))
+			},
+			wantedLatest: since,
+			wantedRows:   []termprogress.TabRow{"  10:00:00\thas reached a steady state."},
+		},
+		"keeps the rows if there are no new events": {
+			setupMocks: func(m *mocks.MockserviceEventsGetter) {
+				m.EXPECT().ServiceEvents(since).Return(nil, nil)
+			},
+			wantedLatest: since,
+		},
+		"appends the new events and keeps the most recent ones": {
+			inRows: []termprogress.TabRow{
+				"  09:56:00\thas started 1 tasks.",
+				"  09:57:00\thas begun draining connections on 1 tasks.",
+				"  09:58:00\tderegistered 1 targets.",
+				"  09:59:00\thas stopped 1 running tasks.",
+			},
+			setupMocks: func(m *mocks.MockserviceEventsGetter) {
+				m.EXPECT().ServiceEvents(since).Return([]ecs.ServiceEvent{
+					event(1, "was unable to place a task because no container instance met all of its requirements."),
+					event(2, "has started 1 tasks."),
+				}, nil)
+			},
+			wantedLatest: since.Add(2 * time.Minute),
+			wantedRows: []termprogress.TabRow{
+				"  09:57:00\thas begun draining connections on 1 tasks.",
+				"  09:58:00\tderegistered 1 targets.",
+				"  09:59:00\thas stopped 1 running tasks.",
+				"  10:01:00\twas unable to place a task because no container instance met all of its requirements.",
+				"  10:02:00\thas started 1 tasks.",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockserviceEventsGetter(ctrl)
+			tc.setupMocks(m)
+			opts := &deploySvcOpts{
+				serviceEvents: m,
+			}
+
+			// WHEN
+			latest, rows := opts.serviceEventRows(since, tc.inRows)
+
+			// THEN
+			require.Equal(t, tc.wantedLatest, latest)
+			require.Equal(t, tc.wantedRows, rows)
+		})
+	}
+}
+
+func TestSvcDeployOpts_circuitBreakerReason(t *testing.T) {
+	since := time.Date(2020, 10, 15, 10, 0, 0, 0, time.Local)
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.MockserviceEventsGetter)
+
+		wantedReason  string
+		wantedStopped bool
+	}{
+		"not stopped if the events can't be retrieved": {
+			setupMocks: func(m *mocks.MockserviceEventsGetter) {
+				m.EXPECT().ServiceEvents(since).Return(nil, errors.New("some error"))
+			},
+		},
+		"not stopped if no deployment failed": {
+			setupMocks: func(m *mocks.MockserviceEventsGetter) {
+				m.EXPECT().ServiceEvents(since).Return([]ecs.ServiceEvent{
+					{CreatedAt: since, Message: "has started 1 tasks."},
+				}, nil)
+			},
+		},
+		"returns the reason of the latest failed deployment": {
+			setupMocks: func(m *mocks.MockserviceEventsGetter) {
+				m.EXPECT().ServiceEvents(since).Return([]ecs.ServiceEvent{
+					{CreatedAt: since, Message: "has started 1 tasks."},
+					{CreatedAt: since.Add(time.Minute), Message: "(deployment ecs-svc/1234567890) deployment failed: tasks failed to start."},
+					{CreatedAt: since.Add(2 * time.Minute), Message: "has stopped 1 running tasks."},
+				}, nil)
+			},
+			wantedReason:  "tasks failed to start.",
+			wantedStopped: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockserviceEventsGetter(ctrl)
+			tc.setupMocks(m)
+			opts := &deploySvcOpts{
+				serviceEvents: m,
+			}
+
+			// WHEN
+			reason, stopped := opts.circuitBreakerReason(since)
+
+			// THEN
+			require.Equal(t, tc.wantedReason, reason)
+			require.Equal(t, tc.wantedStopped, stopped)
+		})
+	}
+}
+
+type deployBlueGreenMocks struct {
+	outputs      *mocks.MocksvcOutputsGetter
+	runningTasks *mocks.MockrunningTasksGetter
+	deployer     *mocks.MockblueGreenDeployer
+	spinner      *mocks.Mockprogress
+}
+
+func TestSvcDeployOpts_deployBlueGreen(t *testing.T) {
+	const (
+		oldTaskDef = "arn:aws:ecs:us-west-2:123456789012:task-definition/phonetool-test-frontend:1"
+		newTaskDef = "arn:aws:ecs:us-west-2:123456789012:task-definition/phonetool-test-frontend:2"
+	)
+	outputs := map[string]string{
+		stack.LBWebServiceTaskDefinitionOutputKey:        newTaskDef,
+		stack.LBWebServiceServiceTaskDefinitionOutputKey: oldTaskDef,
+		stack.LBWebServiceTargetContainerOutputKey:       "frontend",
+		stack.LBWebServiceTargetPortOutputKey:            "80",
+		stack.LBWebServiceCodeDeployAppOutputKey:         "phonetool-test-frontend",
+		stack.LBWebServiceDeploymentGroupOutputKey:       "phonetool-test-frontend-group",
+	}
+	testCases := map[string]struct {
+		setupMocks func(m deployBlueGreenMocks)
+
+		wantedError error
+	}{
+		"does nothing if the service was just created": {
+			setupMocks: func(m deployBlueGreenMocks) {
+				m.outputs.EXPECT().Outputs().Return(map[string]string{
+					stack.LBWebServiceTaskDefinitionOutputKey:        oldTaskDef,
+					stack.LBWebServiceServiceTaskDefinitionOutputKey: oldTaskDef,
+				}, nil)
+				m.deployer.EXPECT().CreateECSDeployment(gomock.Any()).Times(0)
+			},
+		},
+		"does nothing if the tasks already run the task definition": {
+			setupMocks: func(m deployBlueGreenMocks) {
+				m.outputs.EXPECT().Outputs().Return(outputs, nil)
+				m.runningTasks.EXPECT().RunningTasks().Return([]*ecs.Task{
+					{TaskDefinitionArn: aws.String(newTaskDef)},
+				}, nil)
+				m.deployer.EXPECT().CreateECSDeployment(gomock.Any()).Times(0)
+			},
+		},
+		"wraps error from starting the deployment": {
+			setupMocks: func(m deployBlueGreenMocks) {
+				m.outputs.EXPECT().Outputs().Return(outputs, nil)
+				m.runningTasks.EXPECT().RunningTasks().Return([]*ecs.Task{
+					{TaskDefinitionArn: aws.String(oldTaskDef)},
+				}, nil)
+				m.spinner.EXPECT().Start(gomock.Any())
+				m.deployer.EXPECT().CreateECSDeployment(gomock.Any()).Return("", errors.New("some error"))
+				m.spinner.EXPECT().Stop(gomock.Any())
+			},
+			wantedError: errors.New("start blue/green deployment of service frontend: some error"),
+		},
+		"displays the traffic shift until the deployment succeeds": {
+			setupMocks: func(m deployBlueGreenMocks) {
+				m.outputs.EXPECT().Outputs().Return(outputs, nil)
+				m.runningTasks.EXPECT().RunningTasks().Return([]*ecs.Task{
+					{TaskDefinitionArn: aws.String(oldTaskDef)},
+				}, nil)
+				m.spinner.EXPECT().Start(gomock.Any())
+				m.deployer.EXPECT().CreateECSDeployment(codedeploy.ECSDeploymentInput{
+					Application:     "phonetool-test-frontend",
+					DeploymentGroup: "phonetool-test-frontend-group",
+					TaskDefinition:  newTaskDef,
+					ContainerName:   "frontend",
+					ContainerPort:   80,
+				}).Return("d-ABCDEF", nil)
+				gomock.InOrder(
+					m.deployer.EXPECT().Deployment("d-ABCDEF").Return(&codedeploy.Deployment{
+						ID:     "d-ABCDEF",
+						Status: "InProgress",
+						TaskSets: []codedeploy.TaskSet{
+							{Label: codedeploy.TaskSetBlue, TrafficWeight: 90, DesiredCount: 2, RunningCount: 2},
+							{Label: codedeploy.TaskSetGreen, TrafficWeight: 10, DesiredCount: 2, RunningCount: 2},
+						},
+					}, nil),
+					m.deployer.EXPECT().Deployment("d-ABCDEF").Return(&codedeploy.Deployment{
+						ID:     "d-ABCDEF",
+						Status: "Succeeded",
+					}, nil),
+				)
+				m.spinner.EXPECT().Events([]termprogress.TabRow{
+					"  original tasks\t90% of traffic\t2/2 running",
+					"  new tasks\t10% of traffic\t2/2 running",
+				})
+				m.spinner.EXPECT().Stop(gomock.Any())
+			},
+		},
+		"errors if the deployment fails": {
+			setupMocks: func(m deployBlueGreenMocks) {
+				m.outputs.EXPECT().Outputs().Return(outputs, nil)
+				m.runningTasks.EXPECT().RunningTasks().Return(nil, nil)
+				m.spinner.EXPECT().Start(gomock.Any())
+				m.deployer.EXPECT().CreateECSDeployment(gomock.Any()).Return("d-ABCDEF", nil)
+				m.deployer.EXPECT().Deployment("d-ABCDEF").Return(&codedeploy.Deployment{
+					ID:           "d-ABCDEF",
+					Status:       "Failed",
+					ErrorMessage: "The deployment failed because the replacement tasks failed their health checks",
+				}, nil)
+				m.spinner.EXPECT().Stop(gomock.Any())
+			},
+			wantedError: errors.New("blue/green deployment d-ABCDEF of service frontend failed: The deployment failed because the replacement tasks failed their health checks"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			defaultInterval := blueGreenPollInterval
+			blueGreenPollInterval = 0
+			defer func() { blueGreenPollInterval = defaultInterval }()
+
+			m := deployBlueGreenMocks{
+				outputs:      mocks.NewMocksvcOutputsGetter(ctrl),
+				runningTasks: mocks.NewMockrunningTasksGetter(ctrl),
+				deployer:     mocks.NewMockblueGreenDeployer(ctrl),
+				spinner:      mocks.NewMockprogress(ctrl),
+			}
+			tc.setupMocks(m)
+
+			opts := &deploySvcOpts{
+				deploySvcVars: deploySvcVars{
+					GlobalOpts: &GlobalOpts{appName: "phonetool"},
+					Name:       "frontend",
+				},
+				svcOutputs:   m.outputs,
+				runningTasks: m.runningTasks,
+				blueGreen:    m.deployer,
+				spinner:      m.spinner,
+			}
+
+			// WHEN
+			err := opts.deployBlueGreen()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,223 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcDiffAppNamePrompt        = "Which application is the service in?"
+	svcDiffAppNameHelpPrompt    = "An application groups all of your services together."
+	svcDiffNamePrompt           = "Which deployed service would you like to compare?"
+	svcDiffNameHelpPrompt       = "The configuration of the service in this environment is compared with another environment."
+	svcDiffCompareEnvPrompt     = "Which environment would you like to compare %s with?"
+	svcDiffCompareEnvHelpPrompt = "The environment's tasks, CPU, memory, variables and secrets are compared."
+)
+
+type svcDiffVars struct {
+	*GlobalOpts
+	shouldOutputJSON bool
+	svcName          string
+	envName          string
+	compareEnvName   string
+}
+
+type svcDiffOpts struct {
+	svcDiffVars
+
+	w           io.Writer
+	store       store
+	deployStore deployedEnvironmentLister
+	differ      svcDiffer
+	sel         deploySelector
+	initDiffer  func(*svcDiffOpts) error
+}
+
+func newSvcDiffOpts(vars svcDiffVars) (*svcDiffOpts, error) {
+	configStore, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("connect to environment datastore: %w", err)
+	}
+	deployStore, err := deploy.NewStore(configStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	return &svcDiffOpts{
+		svcDiffVars: vars,
+		store:       configStore,
+		deployStore: deployStore,
+		w:           log.OutputWriter,
+		sel:         selector.NewDeploySelect(vars.prompt, configStore, deployStore),
+		initDiffer: func(o *svcDiffOpts) error {
+			d, err := describe.NewServiceDiffer(describe.NewServiceConfig{
+				App:         o.AppName(),
+				Svc:         o.svcName,
+				ConfigStore: configStore,
+			})
+			if err != nil {
+				return fmt.Errorf("creating differ for service %s in application %s: %w", o.svcName, o.AppName(), err)
+			}
+			o.differ = d
+			return nil
+		},
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *svcDiffOpts) Validate() error {
+	if o.AppName() != "" {
+		if _, err := o.store.GetApplication(o.AppName()); err != nil {
+			return err
+		}
+	}
+	if o.svcName != "" {
+		if _, err := o.store.GetService(o.AppName(), o.svcName); err != nil {
+			return err
+		}
+	}
+	for _, env := range []string{o.envName, o.compareEnvName} {
+		if env == "" {
+			continue
+		}
+		if _, err := o.store.GetEnvironment(o.AppName(), env); err != nil {
+			return err
+		}
+	}
+	if o.envName != "" && o.envName == o.compareEnvName {
+		return fmt.Errorf("cannot compare environment %s with itself", o.envName)
+	}
+	return nil
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *svcDiffOpts) Ask() error {
+	if err := o.askApp(); err != nil {
+		return err
+	}
+	if err := o.askSvcEnvName(); err != nil {
+		return err
+	}
+	return o.askCompareEnvName()
+}
+
+// Execute displays the differences between the configuration of the service in the two environments.
+func (o *svcDiffOpts) Execute() error {
+	if err := o.initDiffer(o); err != nil {
+		return err
+	}
+	diff, err := o.differ.Diff(o.envName, o.compareEnvName)
+	if err != nil {
+		return fmt.Errorf("compare service %s between environments %s and %s: %w", o.svcName, o.envName, o.compareEnvName, err)
+	}
+	if o.shouldOutputJSON {
+		data, err := diff.JSONString()
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(o.w, data)
+	} else {
+		fmt.Fprintf(o.w, diff.HumanString())
+	}
+	return nil
+}
+
+func (o *svcDiffOpts) askApp() error {
+	if o.AppName() != "" {
+		return nil
+	}
+	app, err := o.sel.Application(svcDiffAppNamePrompt, svcDiffAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *svcDiffOpts) askSvcEnvName() error {
+	deployedService, err := o.sel.DeployedService(svcDiffNamePrompt, svcDiffNameHelpPrompt, o.AppName(), selector.WithEnv(o.envName), selector.WithSvc(o.svcName))
+	if err != nil {
+		return fmt.Errorf("select deployed services for application %s: %w", o.AppName(), err)
+	}
+	o.svcName = deployedService.Svc
+	o.envName = deployedService.Env
+	return nil
+}
+
+func (o *svcDiffOpts) askCompareEnvName() error {
+	if o.compareEnvName != "" {
+		if o.compareEnvName == o.envName {
+			return fmt.Errorf("cannot compare environment %s with itself", o.envName)
+		}
+		return nil
+	}
+	envs, err := o.deployStore.ListEnvironmentsDeployedTo(o.AppName(), o.svcName)
+	if err != nil {
+		return fmt.Errorf("list environments where service %s is deployed: %w", o.svcName, err)
+	}
+	var otherEnvs []string
+	for _, env := range envs {
+		if env != o.envName {
+			otherEnvs = append(otherEnvs, env)
+		}
+	}
+	if len(otherEnvs) == 0 {
+		return fmt.Errorf("service %s is only deployed in environment %s", o.svcName, o.envName)
+	}
+	if len(otherEnvs) == 1 {
+		log.Infof("Only found one other environment %s where service %s is deployed\n", color.HighlightUserInput(otherEnvs[0]), color.HighlightUserInput(o.svcName))
+		o.compareEnvName = otherEnvs[0]
+		return nil
+	}
+	env, err := o.prompt.SelectOne(fmt.Sprintf(svcDiffCompareEnvPrompt, color.HighlightUserInput(o.envName)), svcDiffCompareEnvHelpPrompt, otherEnvs)
+	if err != nil {
+		return fmt.Errorf("select environment to compare with: %w", err)
+	}
+	o.compareEnvName = env
+	return nil
+}
+
+// BuildSvcDiffCmd builds the command for comparing the configuration of a service between two environments.
+func BuildSvcDiffCmd() *cobra.Command {
+	vars := svcDiffVars{
+		GlobalOpts: NewGlobalOpts(),
+	}
+	cmd := &cobra.Command{
+		Use:   "diff",
+		Short: "Compares the configuration of a deployed service between two environments.",
+		Long: `Compares the configuration of a deployed service between two environments.
+The number of tasks, CPU, memory, environment variables and secrets that differ are shown.`,
+
+		Example: `
+  Shows why "my-svc" behaves differently in the "prod" environment than in "test"
+  /code $ copilot svc diff -n my-svc -e test --compare-env prod`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSvcDiffOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.svcName, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVar(&vars.compareEnvName, compareEnvFlag, "", compareEnvFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
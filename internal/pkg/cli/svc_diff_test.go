@@ -0,0 +1,249 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSvcDiff_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inputApp        string
+		inputSvc        string
+		inputEnv        string
+		inputCompareEnv string
+		mockStoreReader func(m *mocks.Mockstore)
+
+		wantedError error
+	}{
+		"invalid compare environment name": {
+			inputApp:        "my-app",
+			inputCompareEnv: "prod",
+
+			mockStoreReader: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+				m.EXPECT().GetEnvironment("my-app", "prod").Return(nil, errors.New("some error"))
+			},
+
+			wantedError: fmt.Errorf("some error"),
+		},
+		"errors if the environments are the same": {
+			inputApp:        "my-app",
+			inputEnv:        "test",
+			inputCompareEnv: "test",
+
+			mockStoreReader: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+				m.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test"}, nil).Times(2)
+			},
+
+			wantedError: fmt.Errorf("cannot compare environment test with itself"),
+		},
+		"success": {
+			inputApp:        "my-app",
+			inputSvc:        "my-svc",
+			inputEnv:        "test",
+			inputCompareEnv: "prod",
+
+			mockStoreReader: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+				m.EXPECT().GetService("my-app", "my-svc").Return(&config.Service{Name: "my-svc"}, nil)
+				m.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test"}, nil)
+				m.EXPECT().GetEnvironment("my-app", "prod").Return(&config.Environment{Name: "prod"}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStoreReader := mocks.NewMockstore(ctrl)
+			tc.mockStoreReader(mockStoreReader)
+
+			svcDiff := &svcDiffOpts{
+				svcDiffVars: svcDiffVars{
+					svcName:        tc.inputSvc,
+					envName:        tc.inputEnv,
+					compareEnvName: tc.inputCompareEnv,
+					GlobalOpts: &GlobalOpts{
+						appName: tc.inputApp,
+					},
+				},
+				store: mockStoreReader,
+			}
+
+			// WHEN
+			err := svcDiff.Validate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSvcDiff_Ask(t *testing.T) {
+	mockError := errors.New("some error")
+	testCases := map[string]struct {
+		inputCompareEnv string
+		setupMocks      func(sel *mocks.MockdeploySelector, deployStore *mocks.MockdeployedEnvironmentLister, prompt *mocks.Mockprompter)
+
+		wantedCompareEnv string
+		wantedError      error
+	}{
+		"errors if the compare environment is the selected environment": {
+			inputCompareEnv: "test",
+			setupMocks: func(sel *mocks.MockdeploySelector, _ *mocks.MockdeployedEnvironmentLister, _ *mocks.Mockprompter) {
+				sel.EXPECT().DeployedService(svcDiffNamePrompt, svcDiffNameHelpPrompt, "my-app", gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedService{Env: "test", Svc: "my-svc"}, nil)
+			},
+			wantedError: fmt.Errorf("cannot compare environment test with itself"),
+		},
+		"errors if the service is not deployed in another environment": {
+			setupMocks: func(sel *mocks.MockdeploySelector, deployStore *mocks.MockdeployedEnvironmentLister, _ *mocks.Mockprompter) {
+				sel.EXPECT().DeployedService(svcDiffNamePrompt, svcDiffNameHelpPrompt, "my-app", gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedService{Env: "test", Svc: "my-svc"}, nil)
+				deployStore.EXPECT().ListEnvironmentsDeployedTo("my-app", "my-svc").Return([]string{"test"}, nil)
+			},
+			wantedError: fmt.Errorf("service my-svc is only deployed in environment test"),
+		},
+		"uses the only other environment": {
+			setupMocks: func(sel *mocks.MockdeploySelector, deployStore *mocks.MockdeployedEnvironmentLister, _ *mocks.Mockprompter) {
+				sel.EXPECT().DeployedService(svcDiffNamePrompt, svcDiffNameHelpPrompt, "my-app", gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedService{Env: "test", Svc: "my-svc"}, nil)
+				deployStore.EXPECT().ListEnvironmentsDeployedTo("my-app", "my-svc").Return([]string{"test", "prod"}, nil)
+			},
+			wantedCompareEnv: "prod",
+		},
+		"prompts for the environment to compare with": {
+			setupMocks: func(sel *mocks.MockdeploySelector, deployStore *mocks.MockdeployedEnvironmentLister, prompt *mocks.Mockprompter) {
+				sel.EXPECT().DeployedService(svcDiffNamePrompt, svcDiffNameHelpPrompt, "my-app", gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedService{Env: "test", Svc: "my-svc"}, nil)
+				deployStore.EXPECT().ListEnvironmentsDeployedTo("my-app", "my-svc").Return([]string{"test", "staging", "prod"}, nil)
+				prompt.EXPECT().SelectOne(gomock.Any(), svcDiffCompareEnvHelpPrompt, []string{"staging", "prod"}).Return("", mockError)
+			},
+			wantedError: fmt.Errorf("select environment to compare with: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSelector := mocks.NewMockdeploySelector(ctrl)
+			mockDeployStore := mocks.NewMockdeployedEnvironmentLister(ctrl)
+			mockPrompter := mocks.NewMockprompter(ctrl)
+			tc.setupMocks(mockSelector, mockDeployStore, mockPrompter)
+
+			svcDiff := &svcDiffOpts{
+				svcDiffVars: svcDiffVars{
+					compareEnvName: tc.inputCompareEnv,
+					GlobalOpts: &GlobalOpts{
+						appName: "my-app",
+						prompt:  mockPrompter,
+					},
+				},
+				sel:         mockSelector,
+				deployStore: mockDeployStore,
+			}
+
+			// WHEN
+			err := svcDiff.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "my-svc", svcDiff.svcName)
+			require.Equal(t, "test", svcDiff.envName)
+			require.Equal(t, tc.wantedCompareEnv, svcDiff.compareEnvName)
+		})
+	}
+}
+
+func TestSvcDiff_Execute(t *testing.T) {
+	mockDiff := &describe.ServiceDiff{
+		Service: "my-svc",
+		From:    "test",
+		To:      "prod",
+		Configurations: []*describe.ConfigDiff{
+			{Name: "Tasks", From: aws.String("1"), To: aws.String("3")},
+		},
+	}
+	testCases := map[string]struct {
+		shouldOutputJSON bool
+		setupMocks       func(m *mocks.MocksvcDiffer)
+
+		wantedContent string
+		wantedError   error
+	}{
+		"errors if failed to compare the service": {
+			setupMocks: func(m *mocks.MocksvcDiffer) {
+				m.EXPECT().Diff("test", "prod").Return(nil, errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("compare service my-svc between environments test and prod: some error"),
+		},
+		"success with JSON output": {
+			shouldOutputJSON: true,
+			setupMocks: func(m *mocks.MocksvcDiffer) {
+				m.EXPECT().Diff("test", "prod").Return(mockDiff, nil)
+			},
+			wantedContent: "{\"service\":\"my-svc\",\"from\":\"test\",\"to\":\"prod\",\"configurations\":[{\"name\":\"Tasks\",\"from\":\"1\",\"to\":\"3\"}],\"variables\":null,\"secrets\":null}\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			b := &bytes.Buffer{}
+			mockDiffer := mocks.NewMocksvcDiffer(ctrl)
+			tc.setupMocks(mockDiffer)
+
+			svcDiff := &svcDiffOpts{
+				svcDiffVars: svcDiffVars{
+					svcName:          "my-svc",
+					envName:          "test",
+					compareEnvName:   "prod",
+					shouldOutputJSON: tc.shouldOutputJSON,
+					GlobalOpts:       &GlobalOpts{appName: "my-app"},
+				},
+				w:          b,
+				differ:     mockDiffer,
+				initDiffer: func(*svcDiffOpts) error { return nil },
+			}
+
+			// WHEN
+			err := svcDiff.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, b.String())
+		})
+	}
+}
@@ -0,0 +1,499 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"os/signal"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/command"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/dustin/go-humanize/english"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcExecAppNamePrompt     = "Which application is the service in?"
+	svcExecAppNameHelpPrompt = "An application groups all of your services together."
+	svcExecNamePrompt        = "Which service would you like to exec into?"
+	svcExecNameHelpPrompt    = "ECS Exec must be enabled with \"exec: true\" in the manifest of the service."
+	svcExecTaskPrompt        = "Which task would you like to exec into?"
+	svcExecTaskHelpPrompt    = "The running tasks of the service."
+
+	defaultExecCommand = "/bin/sh"
+
+	ssmPluginBinaryName     = "session-manager-plugin"
+	ssmPluginStartSession   = "StartSession"
+	ssmEndpointsID          = "ssm"
+	fmtExecuteCommandTarget = "ecs:%s_%s_%s" // Cluster name, task ID and container runtime ID.
+	ssmPluginInstallURL     = "https://docs.aws.amazon.com/systems-manager/latest/userguide/session-manager-working-with-install-plugin.html"
+
+	// The Session Manager plugin doesn't report the exit code of the remote command, so the command run in several
+	// tasks prints it after its output, behind this prefix.
+	execExitCodePrefix = "COPILOT_EXIT_CODE="
+	// maxConcurrentExecSessions caps the sessions opened at once when running a command in several tasks.
+	maxConcurrentExecSessions = 10
+)
+
+var execExitCodePattern = regexp.MustCompile(execExitCodePrefix + `(\d+)\r?\n?`)
+
+var (
+	errSSMPluginNotInstalled = fmt.Errorf("the Session Manager plugin is not installed, see %s", ssmPluginInstallURL)
+	errFanOutWithTaskID      = fmt.Errorf("cannot specify both --%s and --%s or --%s", taskIDFlag, allTasksFlag, percentFlag)
+	errFanOutWithoutCommand  = fmt.Errorf("--%s is required with --%s or --%s", commandFlag, allTasksFlag, percentFlag)
+	errInvalidPercent        = fmt.Errorf("--%s must be between 1 and 100", percentFlag)
+)
+
+type svcExecVars struct {
+	*GlobalOpts
+	svcName   string
+	envName   string
+	taskID    string
+	container string
+	command   string
+	allTasks  bool
+	percent   int
+}
+
+type svcExecOpts struct {
+	svcExecVars
+
+	w           io.Writer
+	store       store
+	sel         deploySelector
+	arnGetter   serviceArnGetter
+	executer    serviceTaskExecuter
+	runner      runner
+	lookPath    func(file string) (string, error)
+	initClients func(*svcExecOpts) error
+
+	// Cached variables.
+	targetEnv   *config.Environment
+	ssmEndpoint string
+}
+
+func newSvcExecOpts(vars svcExecVars) (*svcExecOpts, error) {
+	configStore, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("connect to environment datastore: %w", err)
+	}
+	deployStore, err := deploy.NewStore(configStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	return &svcExecOpts{
+		svcExecVars: vars,
+		w:           log.OutputWriter,
+		store:       configStore,
+		sel:         selector.NewDeploySelect(vars.prompt, configStore, deployStore),
+		runner:      command.New(),
+		lookPath:    exec.LookPath,
+		initClients: func(o *svcExecOpts) error {
+			env, err := o.store.GetEnvironment(o.AppName(), o.envName)
+			if err != nil {
+				return fmt.Errorf("get environment %s: %w", o.envName, err)
+			}
+			o.targetEnv = env
+			sess, err := sessions.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
+			if err != nil {
+				return fmt.Errorf("assume role for environment %s: %w", env.Name, err)
+			}
+			d, err := describe.NewServiceStatus(&describe.NewServiceStatusConfig{
+				App:         o.AppName(),
+				Env:         o.envName,
+				Svc:         o.svcName,
+				ConfigStore: configStore,
+			})
+			if err != nil {
+				return fmt.Errorf("creating status describer for service %s in application %s: %w", o.svcName, o.AppName(), err)
+			}
+			o.arnGetter = d
+			o.executer = ecs.New(sess)
+			o.ssmEndpoint = ssmEndpoint(sess)
+			return nil
+		},
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *svcExecOpts) Validate() error {
+	if o.AppName() != "" {
+		if _, err := o.store.GetApplication(o.AppName()); err != nil {
+			return err
+		}
+	}
+	if o.svcName != "" {
+		if _, err := o.store.GetService(o.AppName(), o.svcName); err != nil {
+			return err
+		}
+	}
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.AppName(), o.envName); err != nil {
+			return err
+		}
+	}
+	if o.fanOut() {
+		if o.taskID != "" {
+			return errFanOutWithTaskID
+		}
+		if o.command == defaultExecCommand {
+			return errFanOutWithoutCommand
+		}
+	}
+	if o.percent < 0 || o.percent > 100 {
+		return errInvalidPercent
+	}
+	if _, err := o.lookPath(ssmPluginBinaryName); err != nil {
+		return errSSMPluginNotInstalled
+	}
+	return nil
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *svcExecOpts) Ask() error {
+	if o.AppName() == "" {
+		app, err := o.sel.Application(svcExecAppNamePrompt, svcExecAppNameHelpPrompt)
+		if err != nil {
+			return fmt.Errorf("select application: %w", err)
+		}
+		o.appName = app
+	}
+	deployedService, err := o.sel.DeployedService(svcExecNamePrompt, svcExecNameHelpPrompt, o.AppName(), selector.WithEnv(o.envName), selector.WithSvc(o.svcName))
+	if err != nil {
+		return fmt.Errorf("select deployed services for application %s: %w", o.AppName(), err)
+	}
+	o.svcName = deployedService.Svc
+	o.envName = deployedService.Env
+	return nil
+}
+
+// Execute opens an interactive session into a container of a running task of the service,
+// or runs the command in the container of several tasks if --all-tasks or --percent is set.
+func (o *svcExecOpts) Execute() error {
+	if err := o.initClients(o); err != nil {
+		return err
+	}
+	serviceArn, err := o.arnGetter.GetServiceArn()
+	if err != nil {
+		return fmt.Errorf("get ARN of service %s: %w", o.svcName, err)
+	}
+	cluster, err := serviceArn.ClusterName()
+	if err != nil {
+		return fmt.Errorf("get cluster name: %w", err)
+	}
+	service, err := serviceArn.ServiceName()
+	if err != nil {
+		return fmt.Errorf("get service name: %w", err)
+	}
+	if o.fanOut() {
+		return o.runOnTasks(cluster, service)
+	}
+	task, err := o.selectTask(cluster, service)
+	if err != nil {
+		return err
+	}
+	container := o.mainContainer()
+	runtimeID, err := containerRuntimeID(task, container)
+	if err != nil {
+		return err
+	}
+	taskID := taskIDFromARN(aws.StringValue(task.TaskArn))
+	session, err := o.executer.ExecuteCommand(ecs.ExecuteCommandInput{
+		Cluster:   cluster,
+		Task:      taskID,
+		Container: container,
+		Command:   o.command,
+	})
+	if err != nil {
+		log.Infof("Make sure that %s is set in the manifest of service %s and that it's redeployed.\n",
+			color.HighlightCode("exec: true"), color.HighlightUserInput(o.svcName))
+		return err
+	}
+	log.Successf("Starting a session into container %s of task %s.\n", color.HighlightUserInput(container), color.HighlightResource(shortTaskID(taskID)))
+	return startExecSession(o.runner, o.targetEnv.Region, o.ssmEndpoint, session, fmt.Sprintf(fmtExecuteCommandTarget, cluster, taskID, runtimeID))
+}
+
+func (o *svcExecOpts) fanOut() bool {
+	return o.allTasks || o.percent != 0
+}
+
+func (o *svcExecOpts) mainContainer() string {
+	if o.container != "" {
+		return o.container
+	}
+	return o.svcName
+}
+
+type taskCommandResult struct {
+	taskID string
+	output string
+	err    error
+}
+
+// runOnTasks runs the command in the container of every task of the service, or of the requested percentage of them,
+// and prints the output of each task once all of them are done. The command fails in a task if it exits with a
+// non-zero code.
+func (o *svcExecOpts) runOnTasks(cluster, service string) error {
+	tasks, err := o.executer.ServiceTasks(cluster, service)
+	if err != nil {
+		return fmt.Errorf("list tasks of service %s: %w", o.svcName, err)
+	}
+	if len(tasks) == 0 {
+		return fmt.Errorf("no running tasks found for service %s in environment %s", o.svcName, o.envName)
+	}
+	percent := o.percent
+	if o.allTasks {
+		percent = 100
+	}
+	// Round up so that a percentage always targets at least one task.
+	tasks = tasks[:(len(tasks)*percent+99)/100]
+
+	results := make([]*taskCommandResult, len(tasks))
+	sem := make(chan struct{}, maxConcurrentExecSessions)
+	var wg sync.WaitGroup
+	for i, task := range tasks {
+		results[i] = &taskCommandResult{
+			taskID: taskIDFromARN(aws.StringValue(task.TaskArn)),
+		}
+		wg.Add(1)
+		go func(task *ecs.Task, res *taskCommandResult) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			res.output, res.err = o.runOnTask(cluster, task)
+		}(task, results[i])
+	}
+	wg.Wait()
+
+	var failed int
+	for _, res := range results {
+		fmt.Fprintf(o.w, "%s\n", color.Bold.Sprintf("Task %s", shortTaskID(res.taskID)))
+		fmt.Fprint(o.w, res.output)
+		if res.err != nil {
+			failed++
+			log.Errorf("%v\n", res.err)
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("command failed on %d of %s", failed, english.Plural(len(results), "task", ""))
+	}
+	log.Successf("Ran %s on %s of service %s.\n", color.HighlightCode(o.command),
+		english.Plural(len(results), "task", ""), color.HighlightUserInput(o.svcName))
+	return nil
+}
+
+// runOnTask runs the command in the container of the task without a terminal, and returns its output.
+func (o *svcExecOpts) runOnTask(cluster string, task *ecs.Task) (string, error) {
+	container := o.mainContainer()
+	runtimeID, err := containerRuntimeID(task, container)
+	if err != nil {
+		return "", err
+	}
+	taskID := taskIDFromARN(aws.StringValue(task.TaskArn))
+	session, err := o.executer.ExecuteCommand(ecs.ExecuteCommandInput{
+		Cluster:   cluster,
+		Task:      taskID,
+		Container: container,
+		Command:   exitCodeCommand(o.command),
+	})
+	if err != nil {
+		return "", fmt.Errorf("execute command in task %s: %w", shortTaskID(taskID), err)
+	}
+	args, err := sessionPluginArgs(o.targetEnv.Region, o.ssmEndpoint, session, fmt.Sprintf(fmtExecuteCommandTarget, cluster, taskID, runtimeID))
+	if err != nil {
+		return "", err
+	}
+	buf := &bytes.Buffer{}
+	if err := o.runner.Run(ssmPluginBinaryName, args, command.Stdout(buf), command.Stderr(buf)); err != nil {
+		return buf.String(), fmt.Errorf("run command in task %s with %s: %w", shortTaskID(taskID), ssmPluginBinaryName, err)
+	}
+	output, code, ok := splitExitCode(buf.String())
+	if !ok {
+		return output, fmt.Errorf("command in task %s ended without an exit code", shortTaskID(taskID))
+	}
+	if code != 0 {
+		return output, fmt.Errorf("command in task %s exited with code %d", shortTaskID(taskID), code)
+	}
+	return output, nil
+}
+
+// exitCodeCommand wraps the command in a shell that prints the exit code of the command after its output.
+func exitCodeCommand(cmd string) string {
+	return fmt.Sprintf(`/bin/sh -c '%s; echo "%s$?"'`, strings.ReplaceAll(cmd, `'`, `'\''`), execExitCodePrefix)
+}
+
+// splitExitCode removes the exit code printed by a command wrapped with exitCodeCommand from its output.
+// It returns false if the output doesn't end with an exit code, such as when the session was interrupted.
+func splitExitCode(output string) (string, int, bool) {
+	matches := execExitCodePattern.FindAllStringSubmatchIndex(output, -1)
+	if len(matches) == 0 {
+		return output, 0, false
+	}
+	last := matches[len(matches)-1]
+	code, err := strconv.Atoi(output[last[2]:last[3]])
+	if err != nil {
+		return output, 0, false
+	}
+	return output[:last[0]] + output[last[1]:], code, true
+}
+
+func (o *svcExecOpts) selectTask(cluster, service string) (*ecs.Task, error) {
+	tasks, err := o.executer.ServiceTasks(cluster, service)
+	if err != nil {
+		return nil, fmt.Errorf("list tasks of service %s: %w", o.svcName, err)
+	}
+	if len(tasks) == 0 {
+		return nil, fmt.Errorf("no running tasks found for service %s in environment %s", o.svcName, o.envName)
+	}
+	if o.taskID != "" {
+		for _, task := range tasks {
+			if strings.HasPrefix(taskIDFromARN(aws.StringValue(task.TaskArn)), o.taskID) {
+				return task, nil
+			}
+		}
+		return nil, fmt.Errorf("no running task found with ID %s for service %s", o.taskID, o.svcName)
+	}
+	if len(tasks) == 1 {
+		return tasks[0], nil
+	}
+	var ids []string
+	for _, task := range tasks {
+		ids = append(ids, shortTaskID(aws.StringValue(task.TaskArn)))
+	}
+	id, err := o.prompt.SelectOne(svcExecTaskPrompt, svcExecTaskHelpPrompt, ids, prompt.WithFinalMessage("Task:"))
+	if err != nil {
+		return nil, fmt.Errorf("select task: %w", err)
+	}
+	for i, task := range tasks {
+		if ids[i] == id {
+			return task, nil
+		}
+	}
+	return nil, fmt.Errorf("task %s not found", id)
+}
+
+// startExecSession hands the session over to the Session Manager plugin, which connects the terminal to the container.
+func startExecSession(runner runner, region, endpoint string, session *ecs.Session, target string) error {
+	args, err := sessionPluginArgs(region, endpoint, session, target)
+	if err != nil {
+		return err
+	}
+	// The interrupt signal is for the remote command, so the plugin exits only when the session ends.
+	signal.Ignore(os.Interrupt)
+	defer signal.Reset(os.Interrupt)
+	if err := runner.Run(ssmPluginBinaryName, args, command.Stdin(os.Stdin), command.Stdout(os.Stdout), command.Stderr(os.Stderr)); err != nil {
+		return fmt.Errorf("start session with %s: %w", ssmPluginBinaryName, err)
+	}
+	return nil
+}
+
+// sessionPluginArgs returns the arguments of the Session Manager plugin to connect to the target with the session.
+func sessionPluginArgs(region, endpoint string, session *ecs.Session, target string) ([]string, error) {
+	sessionJSON, err := json.Marshal(session)
+	if err != nil {
+		return nil, fmt.Errorf("marshal session: %w", err)
+	}
+	paramsJSON, err := json.Marshal(map[string]string{
+		"Target": target,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("marshal session parameters: %w", err)
+	}
+	return []string{
+		string(sessionJSON),
+		region,
+		ssmPluginStartSession,
+		"", // Profile, the session is already authenticated.
+		string(paramsJSON),
+		endpoint,
+	}, nil
+}
+
+// ssmEndpoint returns the Session Manager endpoint of the session.
+// It honors --endpoint-url and the partition of the session's region, such as aws-cn.
+func ssmEndpoint(sess *session.Session) string {
+	return sess.ClientConfig(ssmEndpointsID).Endpoint
+}
+
+// containerRuntimeID returns the runtime ID of the container of the task, which identifies it for Session Manager.
+func containerRuntimeID(task *ecs.Task, container string) (string, error) {
+	for _, c := range task.Containers {
+		if aws.StringValue(c.Name) != container {
+			continue
+		}
+		if aws.StringValue(c.RuntimeId) == "" {
+			return "", errors.New("the container is not running yet")
+		}
+		return aws.StringValue(c.RuntimeId), nil
+	}
+	return "", fmt.Errorf("container %s not found in task %s", container, shortTaskID(aws.StringValue(task.TaskArn)))
+}
+
+func taskIDFromARN(taskARN string) string {
+	return taskARN[strings.LastIndex(taskARN, "/")+1:]
+}
+
+// BuildSvcExecCmd builds the command for opening an interactive session into a container of a service.
+func BuildSvcExecCmd() *cobra.Command {
+	vars := svcExecVars{
+		GlobalOpts: NewGlobalOpts(),
+	}
+	cmd := &cobra.Command{
+		Use:   "exec",
+		Short: "Opens an interactive session into a running container of a service.",
+		Long: `Opens an interactive session into a running container of a service with ECS Exec.
+Requires "exec: true" in the manifest of the service and the Session Manager plugin for the AWS CLI.`,
+
+		Example: `
+  Opens a shell into the main container of a task of the "api" service in the "test" environment.
+  /code $ copilot svc exec -n api -e test
+  Runs "ls -la" in the "nginx" sidecar of a specific task.
+  /code $ copilot svc exec -n api -e test --task-id 8c38184 --container nginx --command "ls -la"
+  Flushes the cache of every task of the service.
+  /code $ copilot svc exec -n api -e test --all-tasks --command "./flush-cache.sh"
+  Runs "df -h" in half of the tasks of the service.
+  /code $ copilot svc exec -n api -e test --percent 50 --command "df -h"`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSvcExecOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.svcName, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVar(&vars.taskID, taskIDFlag, "", taskIDFlagDescription)
+	cmd.Flags().StringVar(&vars.container, containerFlag, "", containerFlagDescription)
+	cmd.Flags().StringVar(&vars.command, commandFlag, defaultExecCommand, execCommandFlagDescription)
+	cmd.Flags().BoolVar(&vars.allTasks, allTasksFlag, false, allTasksFlagDescription)
+	cmd.Flags().IntVar(&vars.percent, percentFlag, 0, percentFlagDescription)
+	return cmd
+}
@@ -0,0 +1,457 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"os/exec"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	sdkecs "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/command"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type svcExecMocks struct {
+	prompt    *mocks.Mockprompter
+	arnGetter *mocks.MockserviceArnGetter
+	executer  *mocks.MockserviceTaskExecuter
+	runner    *mocks.Mockrunner
+}
+
+func TestSvcExecOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inTaskID    string
+		inCommand   string
+		inAllTasks  bool
+		inPercent   int
+		lookPathErr error
+
+		wantedErr error
+	}{
+		"errors if a task ID is set with --all-tasks": {
+			inTaskID:   "8c38184",
+			inCommand:  "ps aux",
+			inAllTasks: true,
+			wantedErr:  errFanOutWithTaskID,
+		},
+		"errors if --all-tasks is set without a command": {
+			inCommand:  defaultExecCommand,
+			inAllTasks: true,
+			wantedErr:  errFanOutWithoutCommand,
+		},
+		"errors if the percentage is out of range": {
+			inCommand: "ps aux",
+			inPercent: 150,
+			wantedErr: errInvalidPercent,
+		},
+		"succeeds with a percentage of the tasks": {
+			inCommand: "ps aux",
+			inPercent: 50,
+		},
+		"errors if the Session Manager plugin isn't installed": {
+			lookPathErr: errors.New("executable file not found in $PATH"),
+			wantedErr:   errSSMPluginNotInstalled,
+		},
+		"succeeds if the Session Manager plugin is installed": {},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := &svcExecOpts{
+				svcExecVars: svcExecVars{
+					taskID:     tc.inTaskID,
+					command:    tc.inCommand,
+					allTasks:   tc.inAllTasks,
+					percent:    tc.inPercent,
+					GlobalOpts: &GlobalOpts{},
+				},
+				lookPath: func(file string) (string, error) {
+					require.Equal(t, ssmPluginBinaryName, file)
+					return "/usr/local/bin/session-manager-plugin", tc.lookPathErr
+				},
+			}
+
+			err := opts.Validate()
+
+			require.Equal(t, tc.wantedErr, err)
+		})
+	}
+}
+
+func TestSvcExecOpts_Execute(t *testing.T) {
+	mockServiceArn := ecs.ServiceArn("arn:aws:ecs:us-west-2:1234567890:service/mockCluster/mockService")
+	runningTask := func(id string) *ecs.Task {
+		return &ecs.Task{
+			TaskArn: aws.String("arn:aws:ecs:us-west-2:1234567890:task/mockCluster/" + id),
+			Containers: []*sdkecs.Container{
+				{Name: aws.String("api"), RuntimeId: aws.String(id + "-1234")},
+				{Name: aws.String("nginx"), RuntimeId: aws.String(id + "-5678")},
+			},
+		}
+	}
+	testCases := map[string]struct {
+		inTaskID    string
+		inContainer string
+		setupMocks  func(m svcExecMocks)
+
+		wantedErr string
+	}{
+		"errors if the service has no running tasks": {
+			setupMocks: func(m svcExecMocks) {
+				m.arnGetter.EXPECT().GetServiceArn().Return(&mockServiceArn, nil)
+				m.executer.EXPECT().ServiceTasks("mockCluster", "mockService").Return(nil, nil)
+			},
+			wantedErr: "no running tasks found for service api in environment test",
+		},
+		"errors if no task matches the task ID": {
+			inTaskID: "ffff",
+			setupMocks: func(m svcExecMocks) {
+				m.arnGetter.EXPECT().GetServiceArn().Return(&mockServiceArn, nil)
+				m.executer.EXPECT().ServiceTasks("mockCluster", "mockService").Return([]*ecs.Task{runningTask("8c381840")}, nil)
+			},
+			wantedErr: "no running task found with ID ffff for service api",
+		},
+		"errors if the container doesn't exist": {
+			inContainer: "envoy",
+			setupMocks: func(m svcExecMocks) {
+				m.arnGetter.EXPECT().GetServiceArn().Return(&mockServiceArn, nil)
+				m.executer.EXPECT().ServiceTasks("mockCluster", "mockService").Return([]*ecs.Task{runningTask("8c381840")}, nil)
+			},
+			wantedErr: "container envoy not found in task 8c381840",
+		},
+		"returns the error from executing the command": {
+			setupMocks: func(m svcExecMocks) {
+				m.arnGetter.EXPECT().GetServiceArn().Return(&mockServiceArn, nil)
+				m.executer.EXPECT().ServiceTasks("mockCluster", "mockService").Return([]*ecs.Task{runningTask("8c381840")}, nil)
+				m.executer.EXPECT().ExecuteCommand(gomock.Any()).Return(nil, errors.New("some error"))
+				m.runner.EXPECT().Run(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedErr: "some error",
+		},
+		"prompts for the task and starts a session into the container": {
+			inContainer: "nginx",
+			setupMocks: func(m svcExecMocks) {
+				m.arnGetter.EXPECT().GetServiceArn().Return(&mockServiceArn, nil)
+				m.executer.EXPECT().ServiceTasks("mockCluster", "mockService").Return([]*ecs.Task{runningTask("8c381840"), runningTask("4082490e")}, nil)
+				m.prompt.EXPECT().SelectOne(svcExecTaskPrompt, svcExecTaskHelpPrompt, []string{"8c381840", "4082490e"}, gomock.Any()).Return("4082490e", nil)
+				m.executer.EXPECT().ExecuteCommand(ecs.ExecuteCommandInput{
+					Cluster:   "mockCluster",
+					Task:      "4082490e",
+					Container: "nginx",
+					Command:   "/bin/sh",
+				}).Return(&ecs.Session{
+					SessionID:  "ecs-execute-command-123",
+					StreamURL:  "wss://ssmmessages.us-west-2.amazonaws.com",
+					TokenValue: "token",
+				}, nil)
+				m.runner.EXPECT().Run(ssmPluginBinaryName, []string{
+					`{"SessionId":"ecs-execute-command-123","StreamUrl":"wss://ssmmessages.us-west-2.amazonaws.com","TokenValue":"token"}`,
+					"us-west-2",
+					"StartSession",
+					"",
+					`{"Target":"ecs:mockCluster_4082490e_4082490e-5678"}`,
+					"https://ssm.us-west-2.amazonaws.com",
+				}, gomock.Any()).Return(nil)
+			},
+		},
+		"starts a session into the main container of the task with the ID prefix": {
+			inTaskID: "4082",
+			setupMocks: func(m svcExecMocks) {
+				m.arnGetter.EXPECT().GetServiceArn().Return(&mockServiceArn, nil)
+				m.executer.EXPECT().ServiceTasks("mockCluster", "mockService").Return([]*ecs.Task{runningTask("8c381840"), runningTask("4082490e")}, nil)
+				m.prompt.EXPECT().SelectOne(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				m.executer.EXPECT().ExecuteCommand(ecs.ExecuteCommandInput{
+					Cluster:   "mockCluster",
+					Task:      "4082490e",
+					Container: "api",
+					Command:   "/bin/sh",
+				}).Return(&ecs.Session{}, nil)
+				m.runner.EXPECT().Run(ssmPluginBinaryName, gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := svcExecMocks{
+				prompt:    mocks.NewMockprompter(ctrl),
+				arnGetter: mocks.NewMockserviceArnGetter(ctrl),
+				executer:  mocks.NewMockserviceTaskExecuter(ctrl),
+				runner:    mocks.NewMockrunner(ctrl),
+			}
+			tc.setupMocks(m)
+
+			opts := &svcExecOpts{
+				svcExecVars: svcExecVars{
+					svcName:   "api",
+					envName:   "test",
+					taskID:    tc.inTaskID,
+					container: tc.inContainer,
+					command:   defaultExecCommand,
+					GlobalOpts: &GlobalOpts{
+						appName: "phonetool",
+						prompt:  m.prompt,
+					},
+				},
+				arnGetter: m.arnGetter,
+				executer:  m.executer,
+				runner:    m.runner,
+				initClients: func(o *svcExecOpts) error {
+					o.targetEnv = &config.Environment{Name: "test", Region: "us-west-2"}
+					o.ssmEndpoint = "https://ssm.us-west-2.amazonaws.com"
+					return nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSvcExecOpts_ExecuteOnTasks(t *testing.T) {
+	mockServiceArn := ecs.ServiceArn("arn:aws:ecs:us-west-2:1234567890:service/mockCluster/mockService")
+	runningTask := func(id string) *ecs.Task {
+		return &ecs.Task{
+			TaskArn: aws.String("arn:aws:ecs:us-west-2:1234567890:task/mockCluster/" + id),
+			Containers: []*sdkecs.Container{
+				{Name: aws.String("api"), RuntimeId: aws.String(id + "-1234")},
+			},
+		}
+	}
+	tasks := []*ecs.Task{runningTask("8c381840"), runningTask("4082490e"), runningTask("a1b2c3d4")}
+	// prints returns a fake Session Manager plugin that writes the output to the stdout of the command.
+	prints := func(output string) func(string, []string, ...command.Option) error {
+		return func(name string, args []string, opts ...command.Option) error {
+			cmd := &exec.Cmd{}
+			for _, opt := range opts {
+				opt(cmd)
+			}
+			_, err := cmd.Stdout.Write([]byte(output))
+			return err
+		}
+	}
+	testCases := map[string]struct {
+		inAllTasks bool
+		inPercent  int
+		setupMocks func(m svcExecMocks)
+
+		wantedOutput string
+		wantedErr    string
+	}{
+		"errors if the service has no running tasks": {
+			inAllTasks: true,
+			setupMocks: func(m svcExecMocks) {
+				m.executer.EXPECT().ServiceTasks("mockCluster", "mockService").Return(nil, nil)
+			},
+			wantedErr: "no running tasks found for service api in environment test",
+		},
+		"runs the command in every task and prints the output of each task": {
+			inAllTasks: true,
+			setupMocks: func(m svcExecMocks) {
+				m.executer.EXPECT().ServiceTasks("mockCluster", "mockService").Return(tasks, nil)
+				m.executer.EXPECT().ExecuteCommand(gomock.Any()).Return(&ecs.Session{}, nil).Times(3)
+				m.runner.EXPECT().Run(ssmPluginBinaryName, gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(prints("flushed\r\nCOPILOT_EXIT_CODE=0\r\n")).Times(3)
+			},
+			wantedOutput: "Task 8c381840\nflushed\r\nTask 4082490e\nflushed\r\nTask a1b2c3d4\nflushed\r\n",
+		},
+		"runs the command in the percentage of the tasks rounded up": {
+			inPercent: 50,
+			setupMocks: func(m svcExecMocks) {
+				m.executer.EXPECT().ServiceTasks("mockCluster", "mockService").Return(tasks, nil)
+				m.executer.EXPECT().ExecuteCommand(ecs.ExecuteCommandInput{
+					Cluster:   "mockCluster",
+					Task:      "8c381840",
+					Container: "api",
+					Command:   `/bin/sh -c './flush-cache.sh; echo "COPILOT_EXIT_CODE=$?"'`,
+				}).Return(&ecs.Session{}, nil)
+				m.executer.EXPECT().ExecuteCommand(ecs.ExecuteCommandInput{
+					Cluster:   "mockCluster",
+					Task:      "4082490e",
+					Container: "api",
+					Command:   `/bin/sh -c './flush-cache.sh; echo "COPILOT_EXIT_CODE=$?"'`,
+				}).Return(&ecs.Session{}, nil)
+				m.runner.EXPECT().Run(ssmPluginBinaryName, []string{
+					`{"SessionId":"","StreamUrl":"","TokenValue":""}`,
+					"us-west-2",
+					"StartSession",
+					"",
+					`{"Target":"ecs:mockCluster_8c381840_8c381840-1234"}`,
+					"https://ssm.us-west-2.amazonaws.com",
+				}, gomock.Any(), gomock.Any()).DoAndReturn(prints("COPILOT_EXIT_CODE=0\n"))
+				m.runner.EXPECT().Run(ssmPluginBinaryName, gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(prints("COPILOT_EXIT_CODE=0\n"))
+			},
+			wantedOutput: "Task 8c381840\nTask 4082490e\n",
+		},
+		"reports the tasks where the command failed": {
+			inAllTasks: true,
+			setupMocks: func(m svcExecMocks) {
+				m.executer.EXPECT().ServiceTasks("mockCluster", "mockService").Return(tasks[:2], nil)
+				m.executer.EXPECT().ExecuteCommand(gomock.Any()).Return(&ecs.Session{}, nil)
+				m.executer.EXPECT().ExecuteCommand(gomock.Any()).Return(nil, errors.New("some error"))
+				m.runner.EXPECT().Run(ssmPluginBinaryName, gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(prints("COPILOT_EXIT_CODE=0\n"))
+			},
+			wantedErr: "command failed on 1 of 2 tasks",
+		},
+		"counts a non-zero exit code of the command as a failure": {
+			inAllTasks: true,
+			setupMocks: func(m svcExecMocks) {
+				m.executer.EXPECT().ServiceTasks("mockCluster", "mockService").Return(tasks[:2], nil)
+				m.executer.EXPECT().ExecuteCommand(gomock.Any()).Return(&ecs.Session{}, nil).Times(2)
+				m.runner.EXPECT().Run(ssmPluginBinaryName, gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(prints("COPILOT_EXIT_CODE=0\n"))
+				m.runner.EXPECT().Run(ssmPluginBinaryName, gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(prints("no such file\nCOPILOT_EXIT_CODE=127\n"))
+			},
+			wantedErr: "command failed on 1 of 2 tasks",
+		},
+		"counts a session that ends without an exit code as a failure": {
+			inAllTasks: true,
+			setupMocks: func(m svcExecMocks) {
+				m.executer.EXPECT().ServiceTasks("mockCluster", "mockService").Return(tasks[:1], nil)
+				m.executer.EXPECT().ExecuteCommand(gomock.Any()).Return(&ecs.Session{}, nil)
+				m.runner.EXPECT().Run(ssmPluginBinaryName, gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(prints("partial output\n"))
+			},
+			wantedErr: "command failed on 1 of 1 task",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := svcExecMocks{
+				prompt:    mocks.NewMockprompter(ctrl),
+				arnGetter: mocks.NewMockserviceArnGetter(ctrl),
+				executer:  mocks.NewMockserviceTaskExecuter(ctrl),
+				runner:    mocks.NewMockrunner(ctrl),
+			}
+			m.arnGetter.EXPECT().GetServiceArn().Return(&mockServiceArn, nil)
+			tc.setupMocks(m)
+			b := &bytes.Buffer{}
+
+			opts := &svcExecOpts{
+				svcExecVars: svcExecVars{
+					svcName:  "api",
+					envName:  "test",
+					command:  "./flush-cache.sh",
+					allTasks: tc.inAllTasks,
+					percent:  tc.inPercent,
+					GlobalOpts: &GlobalOpts{
+						appName: "phonetool",
+						prompt:  m.prompt,
+					},
+				},
+				w:         b,
+				arnGetter: m.arnGetter,
+				executer:  m.executer,
+				runner:    m.runner,
+				initClients: func(o *svcExecOpts) error {
+					o.targetEnv = &config.Environment{Name: "test", Region: "us-west-2"}
+					o.ssmEndpoint = "https://ssm.us-west-2.amazonaws.com"
+					return nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedOutput, b.String())
+		})
+	}
+}
+
+func TestSplitExitCode(t *testing.T) {
+	testCases := map[string]struct {
+		in string
+
+		wantedOutput string
+		wantedCode   int
+		wantedOk     bool
+	}{
+		"removes the exit code from the output": {
+			in:           "hello\r\nCOPILOT_EXIT_CODE=0\r\n",
+			wantedOutput: "hello\r\n",
+			wantedOk:     true,
+		},
+		"uses the last exit code of the output": {
+			in:           "COPILOT_EXIT_CODE=0\nCOPILOT_EXIT_CODE=2\nExiting session.\n",
+			wantedOutput: "COPILOT_EXIT_CODE=0\nExiting session.\n",
+			wantedCode:   2,
+			wantedOk:     true,
+		},
+		"returns false if the output has no exit code": {
+			in:           "hello\n",
+			wantedOutput: "hello\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			output, code, ok := splitExitCode(tc.in)
+
+			require.Equal(t, tc.wantedOutput, output)
+			require.Equal(t, tc.wantedCode, code)
+			require.Equal(t, tc.wantedOk, ok)
+		})
+	}
+}
+
+func TestExitCodeCommand(t *testing.T) {
+	require.Equal(t, `/bin/sh -c 'echo '\''hi'\''; echo "COPILOT_EXIT_CODE=$?"'`, exitCodeCommand("echo 'hi'"))
+}
+
+func TestSSMEndpoint(t *testing.T) {
+	testCases := map[string]struct {
+		inConfig *aws.Config
+
+		wanted string
+	}{
+		"uses the regional endpoint": {
+			inConfig: aws.NewConfig().WithRegion("us-west-2"),
+			wanted:   "https://ssm.us-west-2.amazonaws.com",
+		},
+		"uses the endpoint of the partition of the region": {
+			inConfig: aws.NewConfig().WithRegion("cn-north-1"),
+			wanted:   "https://ssm.cn-north-1.amazonaws.com.cn",
+		},
+		"uses the overridden endpoint": {
+			inConfig: aws.NewConfig().WithRegion("us-west-2").WithEndpoint("https://aws-gateway.example.com"),
+			wanted:   "https://aws-gateway.example.com",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			sess, err := session.NewSession(tc.inConfig)
+			require.NoError(t, err)
+
+			require.Equal(t, tc.wanted, ssmEndpoint(sess))
+		})
+	}
+}
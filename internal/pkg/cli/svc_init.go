@@ -12,6 +12,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/docker/dockerfile"
+	"github.com/aws/copilot-cli/internal/pkg/framework"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
@@ -38,6 +39,10 @@ Deployed resources (such as your service, logs) will contain this service's name
 	fmtSvcInitDockerfilePrompt  = "Which %s would you like to use for %s?"
 	svcInitDockerfileHelpPrompt = "Dockerfile to use for building your service's container image."
 
+	fmtSvcInitGenerateDockerfilePrompt     = "Would you like to generate a %s for your %s application?"
+	fmtSvcInitGenerateDockerfileHelpPrompt = `No Dockerfile was found in your workspace.
+Copilot can write a starter Dockerfile at %s that builds and runs your %s application.`
+
 	svcInitSvcPortPrompt     = "Which %s do you want customer traffic sent to?"
 	svcInitSvcPortHelpPrompt = `The port will be used by the load balancer to route incoming traffic to this service.
 You should set this to the port which your Dockerfile uses to communicate with the internet.`
@@ -51,6 +56,9 @@ const (
 
 const (
 	defaultSvcPortString = "80"
+
+	// generatedDockerfilePath is where the starter Dockerfile of a detected framework is written.
+	generatedDockerfilePath = "./Dockerfile"
 )
 
 type initSvcVars struct {
@@ -59,6 +67,7 @@ type initSvcVars struct {
 	Name           string
 	DockerfilePath string
 	Port           uint16
+	Compute        string
 }
 
 type initSvcOpts struct {
@@ -72,6 +81,9 @@ type initSvcOpts struct {
 	prog        progress
 	df          dockerfileParser
 
+	// Framework detected in the workspace when there are no Dockerfiles, nil otherwise.
+	framework *framework.Framework
+
 	// Outputs stored on successful actions.
 	manifestPath string
 
@@ -136,11 +148,19 @@ func (o *initSvcOpts) Validate() error {
 			return err
 		}
 	}
+	if o.Compute != "" {
+		if _, err := manifest.ComputePreset(o.Compute); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
 // Ask prompts for fields that are required but not passed in.
 func (o *initSvcOpts) Ask() error {
+	if err := o.detectFramework(); err != nil {
+		return err
+	}
 	if err := o.askSvcType(); err != nil {
 		return err
 	}
@@ -230,14 +250,22 @@ func (o *initSvcOpts) newManifest() (encoding.BinaryMarshaler, error) {
 }
 
 func (o *initSvcOpts) newLoadBalancedWebServiceManifest() (*manifest.LoadBalancedWebService, error) {
+	taskSize, err := o.taskSize()
+	if err != nil {
+		return nil, err
+	}
 	props := &manifest.LoadBalancedWebServiceProps{
 		ServiceProps: &manifest.ServiceProps{
 			Name:       o.Name,
 			Dockerfile: o.DockerfilePath,
+			TaskSize:   taskSize,
 		},
 		Port: o.Port,
 		Path: "/",
 	}
+	if o.framework != nil {
+		props.HealthCheckPath = o.framework.HealthCheckPath
+	}
 	existingSvcs, err := o.store.ListServices(o.AppName())
 	if err != nil {
 		return nil, err
@@ -258,16 +286,33 @@ func (o *initSvcOpts) newBackendServiceManifest() (*manifest.BackendService, err
 	if err != nil {
 		return nil, err
 	}
+	taskSize, err := o.taskSize()
+	if err != nil {
+		return nil, err
+	}
 	return manifest.NewBackendService(manifest.BackendServiceProps{
 		ServiceProps: manifest.ServiceProps{
 			Name:       o.Name,
 			Dockerfile: o.DockerfilePath,
+			TaskSize:   taskSize,
 		},
 		Port:        o.Port,
 		HealthCheck: hc,
 	}), nil
 }
 
+// taskSize returns the task size of the compute preset, or nil if the user didn't choose one.
+func (o *initSvcOpts) taskSize() (*manifest.TaskSize, error) {
+	if o.Compute == "" {
+		return nil, nil
+	}
+	size, err := manifest.ComputePreset(o.Compute)
+	if err != nil {
+		return nil, err
+	}
+	return &size, nil
+}
+
 func (o *initSvcOpts) askSvcType() error {
 	if o.ServiceType != "" {
 		return nil
@@ -278,7 +323,17 @@ func (o *initSvcOpts) askSvcType() error {
 		manifest.BackendServiceType,
 	)
 	msg := fmt.Sprintf(fmtSvcInitSvcTypePrompt, color.Emphasize("service type"))
-	t, err := o.prompt.SelectOne(msg, help, manifest.ServiceTypes, prompt.WithFinalMessage("Service type:"))
+	// Propose the service type that best fits the detected framework by listing it first.
+	types := manifest.ServiceTypes
+	if o.framework != nil && o.framework.ServiceType != "" {
+		types = []string{o.framework.ServiceType}
+		for _, t := range manifest.ServiceTypes {
+			if t != o.framework.ServiceType {
+				types = append(types, t)
+			}
+		}
+	}
+	t, err := o.prompt.SelectOne(msg, help, types, prompt.WithFinalMessage("Service type:"))
 	if err != nil {
 		return fmt.Errorf("select service type: %w", err)
 	}
@@ -303,12 +358,42 @@ func (o *initSvcOpts) askSvcName() error {
 	return nil
 }
 
+// detectFramework looks for a known framework in the workspace if the Dockerfile isn't provided and none exists.
+func (o *initSvcOpts) detectFramework() error {
+	if o.DockerfilePath != "" {
+		return nil
+	}
+	if _, err := listDockerfiles(o.fs, "."); err == nil {
+		return nil
+	}
+	fw, err := framework.Detect(o.fs, ".")
+	if err != nil {
+		return fmt.Errorf("detect framework: %w", err)
+	}
+	if fw == nil {
+		return nil
+	}
+	log.Infof("Detected a %s application in your workspace.\n", color.HighlightUserInput(fw.Name))
+	o.framework = fw
+	return nil
+}
+
 // askDockerfile prompts for the Dockerfile by looking at sub-directories with a Dockerfile.
 // If the user chooses to enter a custom path, then we prompt them for the path.
+// If a framework was detected instead, then we offer to generate a starter Dockerfile for it.
 func (o *initSvcOpts) askDockerfile() error {
 	if o.DockerfilePath != "" {
 		return nil
 	}
+	if o.framework != nil {
+		generated, err := o.generateDockerfile()
+		if err != nil {
+			return err
+		}
+		if generated {
+			return nil
+		}
+	}
 
 	// TODO https://github.com/aws/copilot-cli/issues/206
 	dockerfiles, err := listDockerfiles(o.fs, ".")
@@ -331,6 +416,27 @@ func (o *initSvcOpts) askDockerfile() error {
 	return nil
 }
 
+// generateDockerfile writes the starter Dockerfile of the detected framework if the user confirms.
+func (o *initSvcOpts) generateDockerfile() (bool, error) {
+	generate, err := o.prompt.Confirm(
+		fmt.Sprintf(fmtSvcInitGenerateDockerfilePrompt, color.Emphasize("Dockerfile"), o.framework.Name),
+		fmt.Sprintf(fmtSvcInitGenerateDockerfileHelpPrompt, generatedDockerfilePath, o.framework.Name),
+		prompt.WithTrueDefault(),
+		prompt.WithFinalMessage("Generate Dockerfile:"))
+	if err != nil {
+		return false, fmt.Errorf("confirm generating Dockerfile: %w", err)
+	}
+	if !generate {
+		return false, nil
+	}
+	if err := afero.WriteFile(o.fs, generatedDockerfilePath, []byte(o.framework.Dockerfile), 0644); err != nil {
+		return false, fmt.Errorf("write Dockerfile: %w", err)
+	}
+	log.Successf("Wrote a starter Dockerfile at %s\n", color.HighlightResource(generatedDockerfilePath))
+	o.DockerfilePath = generatedDockerfilePath
+	return true, nil
+}
+
 func (o *initSvcOpts) askSvcPort() error {
 	// Use flag before anything else
 	if o.Port != 0 {
@@ -419,7 +525,10 @@ This command is also run as part of "copilot init".`,
   /code $ copilot svc init --name frontend --svc-type "Load Balanced Web Service" --dockerfile ./frontend/Dockerfile
 
   Create a "subscribers" backend service.
-  /code $ copilot svc init --name subscribers --svc-type "Backend Service"`,
+  /code $ copilot svc init --name subscribers --svc-type "Backend Service"
+
+  Create a "reports" backend service with 1 vCPU and 2 GB of memory.
+  /code $ copilot svc init --name reports --svc-type "Backend Service" --compute medium`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newInitSvcOpts(vars)
 			if err != nil {
@@ -446,6 +555,7 @@ This command is also run as part of "copilot init".`,
 	cmd.Flags().StringVarP(&vars.ServiceType, svcTypeFlag, svcTypeFlagShort, "", svcTypeFlagDescription)
 	cmd.Flags().StringVarP(&vars.DockerfilePath, dockerFileFlag, dockerFileFlagShort, "", dockerFileFlagDescription)
 	cmd.Flags().Uint16Var(&vars.Port, svcPortFlag, 0, svcPortFlagDescription)
+	cmd.Flags().StringVar(&vars.Compute, computeFlag, "", computeFlagDescription)
 
 	// Bucket flags by service type.
 	requiredFlags := pflag.NewFlagSet("Required Flags", pflag.ContinueOnError)
@@ -455,9 +565,11 @@ This command is also run as part of "copilot init".`,
 
 	lbWebSvcFlags := pflag.NewFlagSet(manifest.LoadBalancedWebServiceType, pflag.ContinueOnError)
 	lbWebSvcFlags.AddFlag(cmd.Flags().Lookup(svcPortFlag))
+	lbWebSvcFlags.AddFlag(cmd.Flags().Lookup(computeFlag))
 
 	backendSvcFlags := pflag.NewFlagSet(manifest.BackendServiceType, pflag.ContinueOnError)
 	backendSvcFlags.AddFlag(cmd.Flags().Lookup(svcPortFlag))
+	backendSvcFlags.AddFlag(cmd.Flags().Lookup(computeFlag))
 
 	cmd.Annotations = map[string]string{
 		// The order of the sections we want to display.
@@ -26,6 +26,7 @@ func TestSvcInitOpts_Validate(t *testing.T) {
 		inDockerfilePath string
 		inAppName        string
 		inSvcPort        uint16
+		inCompute        string
 
 		mockFileSystem func(mockFS afero.Fs)
 		wantedErr      error
@@ -49,6 +50,11 @@ func TestSvcInitOpts_Validate(t *testing.T) {
 			inAppName: "",
 			wantedErr: errNoAppInWorkspace,
 		},
+		"invalid compute preset": {
+			inAppName: "phonetool",
+			inCompute: "huge",
+			wantedErr: errors.New("invalid compute preset huge: must be one of small, medium, large"),
+		},
 		"valid flags": {
 			inSvcName:        "frontend",
 			inSvcType:        "Load Balanced Web Service",
@@ -71,6 +77,7 @@ func TestSvcInitOpts_Validate(t *testing.T) {
 					Name:           tc.inSvcName,
 					DockerfilePath: tc.inDockerfilePath,
 					Port:           tc.inSvcPort,
+					Compute:        tc.inCompute,
 					GlobalOpts:     &GlobalOpts{appName: tc.inAppName},
 				},
 				fs: &afero.Afero{Fs: afero.NewMemMapFs()},
@@ -346,6 +353,131 @@ func TestSvcInitOpts_Ask(t *testing.T) {
 	}
 }
 
+func TestSvcInitOpts_AskDetectedFramework(t *testing.T) {
+	const packageJSON = `{"scripts": {"start": "node server.js"}, "dependencies": {"express": "^4.17.1"}}`
+	testCases := map[string]struct {
+		mockFileSystem func(mockFS afero.Fs)
+		mockPrompt     func(m *mocks.Mockprompter)
+
+		wantedSvcType        string
+		wantedDockerfilePath string
+		wantedPort           uint16
+		wantedDockerfile     string
+		wantedErr            error
+	}{
+		"generates the Dockerfile of the detected framework": {
+			mockFileSystem: func(mockFS afero.Fs) {
+				afero.WriteFile(mockFS, "package.json", []byte(packageJSON), 0644)
+			},
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().SelectOne(gomock.Any(), gomock.Any(), []string{manifest.LoadBalancedWebServiceType, manifest.BackendServiceType}, gomock.Any()).
+					Return(manifest.LoadBalancedWebServiceType, nil)
+				m.EXPECT().Confirm(fmt.Sprintf(fmtSvcInitGenerateDockerfilePrompt, "Dockerfile", "Node.js"), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(true, nil)
+			},
+			wantedSvcType:        manifest.LoadBalancedWebServiceType,
+			wantedDockerfilePath: "./Dockerfile",
+			wantedPort:           3000,
+			wantedDockerfile:     "EXPOSE 3000",
+		},
+		"proposes the service type of the detected framework first": {
+			mockFileSystem: func(mockFS afero.Fs) {
+				afero.WriteFile(mockFS, "package.json", []byte(`{"main": "worker.js"}`), 0644)
+			},
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().SelectOne(gomock.Any(), gomock.Any(), []string{manifest.BackendServiceType, manifest.LoadBalancedWebServiceType}, gomock.Any()).
+					Return(manifest.BackendServiceType, nil)
+				m.EXPECT().Confirm(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(true, nil)
+				m.EXPECT().Get(gomock.Eq(fmt.Sprintf(svcInitSvcPortPrompt, "port")), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return("8080", nil)
+			},
+			wantedSvcType:        manifest.BackendServiceType,
+			wantedDockerfilePath: "./Dockerfile",
+			wantedPort:           8080,
+			wantedDockerfile:     `CMD ["node", "worker.js"]`,
+		},
+		"falls back to existing Dockerfiles if the user declines": {
+			mockFileSystem: func(mockFS afero.Fs) {
+				afero.WriteFile(mockFS, "package.json", []byte(packageJSON), 0644)
+			},
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().SelectOne(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(manifest.LoadBalancedWebServiceType, nil)
+				m.EXPECT().Confirm(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(false, nil)
+			},
+			wantedErr: fmt.Errorf("no Dockerfiles found within . or a sub-directory level below"),
+		},
+		"doesn't detect the framework if a Dockerfile exists": {
+			mockFileSystem: func(mockFS afero.Fs) {
+				afero.WriteFile(mockFS, "package.json", []byte(packageJSON), 0644)
+				afero.WriteFile(mockFS, "Dockerfile", []byte("FROM nginx\nEXPOSE 80"), 0644)
+			},
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().SelectOne(gomock.Any(), gomock.Any(), gomock.Eq(manifest.ServiceTypes), gomock.Any()).
+					Return(manifest.LoadBalancedWebServiceType, nil)
+				m.EXPECT().Confirm(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				m.EXPECT().SelectOne(gomock.Any(), gomock.Any(), []string{"./Dockerfile"}, gomock.Any()).
+					Return("./Dockerfile", nil)
+			},
+			wantedSvcType:        manifest.LoadBalancedWebServiceType,
+			wantedDockerfilePath: "./Dockerfile",
+			wantedPort:           80,
+			wantedDockerfile:     "FROM nginx",
+		},
+		"returns an error if fail to confirm generating the Dockerfile": {
+			mockFileSystem: func(mockFS afero.Fs) {
+				afero.WriteFile(mockFS, "package.json", []byte(packageJSON), 0644)
+			},
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().SelectOne(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(manifest.LoadBalancedWebServiceType, nil)
+				m.EXPECT().Confirm(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(false, errors.New("some error"))
+			},
+			wantedErr: fmt.Errorf("confirm generating Dockerfile: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockPrompt := mocks.NewMockprompter(ctrl)
+			opts := &initSvcOpts{
+				initSvcVars: initSvcVars{
+					Name: "frontend",
+					GlobalOpts: &GlobalOpts{
+						prompt: mockPrompt,
+					},
+				},
+				fs: &afero.Afero{Fs: afero.NewMemMapFs()},
+				setupParser: func(o *initSvcOpts) {
+					o.df = dockerfile.New(o.fs, o.DockerfilePath)
+				},
+			}
+			tc.mockFileSystem(opts.fs)
+			tc.mockPrompt(mockPrompt)
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedSvcType, opts.ServiceType)
+			require.Equal(t, tc.wantedDockerfilePath, opts.DockerfilePath)
+			require.Equal(t, tc.wantedPort, opts.Port)
+			content, err := afero.ReadFile(opts.fs, tc.wantedDockerfilePath)
+			require.NoError(t, err)
+			require.Contains(t, string(content), tc.wantedDockerfile)
+		})
+	}
+}
+
 func TestAppInitOpts_Execute(t *testing.T) {
 	var (
 		testInterval    = 10 * time.Second
@@ -675,9 +807,11 @@ func TestAppInitOpts_createLoadBalancedAppManifest(t *testing.T) {
 		inSvcName        string
 		inDockerfilePath string
 		inAppName        string
+		inCompute        string
 		mockDependencies func(*gomock.Controller, *initSvcOpts)
 		wantedErr        error
 		wantedPath       string
+		wantedTaskSize   manifest.TaskSize
 	}{
 		"creates manifest with / as the path when there are no other apps": {
 			inAppName:        "app",
@@ -742,6 +876,20 @@ func TestAppInitOpts_createLoadBalancedAppManifest(t *testing.T) {
 				opts.store = mockstore
 			},
 		},
+		"creates manifest with the task size of the compute preset": {
+			inAppName:        "app",
+			inSvcName:        "frontend",
+			inSvcPort:        80,
+			inDockerfilePath: "frontend/Dockerfile",
+			inCompute:        manifest.ComputeMedium,
+			wantedPath:       "/",
+			wantedTaskSize:   manifest.TaskSize{CPU: 1024, Memory: 2048},
+			mockDependencies: func(ctrl *gomock.Controller, opts *initSvcOpts) {
+				mockstore := mocks.NewMockstore(ctrl)
+				mockstore.EXPECT().ListServices("app").Return(nil, nil)
+				opts.store = mockstore
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -756,6 +904,7 @@ func TestAppInitOpts_createLoadBalancedAppManifest(t *testing.T) {
 					Name:           tc.inSvcName,
 					Port:           tc.inSvcPort,
 					DockerfilePath: tc.inDockerfilePath,
+					Compute:        tc.inCompute,
 					GlobalOpts:     &GlobalOpts{appName: tc.inAppName},
 				},
 			}
@@ -770,6 +919,10 @@ func TestAppInitOpts_createLoadBalancedAppManifest(t *testing.T) {
 				require.Equal(t, tc.inSvcPort, aws.Uint16Value(manifest.Image.Port))
 				require.Equal(t, tc.inDockerfilePath, aws.StringValue(manifest.Image.Build.BuildArgs.Dockerfile))
 				require.Equal(t, tc.wantedPath, aws.StringValue(manifest.Path))
+				if tc.inCompute != "" {
+					require.Equal(t, tc.wantedTaskSize.CPU, aws.IntValue(manifest.CPU))
+					require.Equal(t, tc.wantedTaskSize.Memory, aws.IntValue(manifest.Memory))
+				}
 			} else {
 				require.EqualError(t, err, tc.wantedErr.Error())
 			}
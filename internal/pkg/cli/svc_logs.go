@@ -7,14 +7,22 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"strings"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/athena"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/dustin/go-humanize"
 	"github.com/spf13/cobra"
 )
 
@@ -27,8 +35,63 @@ const (
 	logGroupNamePattern    = "/copilot/%s-%s-%s"
 	cwGetLogEventsLimitMin = 1
 	cwGetLogEventsLimitMax = 10000
+
+	defaultQueryPeriod = time.Hour
+
+	// previousTasksLimit is the maximum number of stopped tasks whose logs are returned with --previous.
+	previousTasksLimit = 3
 )
 
+const (
+	// svcTargetGroupLogicalID is the target group of a Load Balanced Web Service, its ARN identifies the requests
+	// to the service in the access logs of the load balancer.
+	svcTargetGroupLogicalID = "TargetGroup"
+	// accessLogsDayFormat is the format of the day partitions of the access logs table.
+	accessLogsDayFormat = "2006/01/02"
+)
+
+// accessLogsRequestsQuery returns the latest requests to a service if no query is specified with --access.
+// It is formatted with the table, the conditions that select the requests to the service, and a limit.
+const accessLogsRequestsQuery = `SELECT time, client_ip, request_verb, request_url, elb_status_code, target_status_code, target_processing_time
+FROM %s
+WHERE %s
+ORDER BY time DESC
+LIMIT %d`
+
+// accessLogsQuery is a built-in Athena query on the access logs of a service.
+type accessLogsQuery struct {
+	name  string
+	query string // Formatted with the table and the conditions that select the requests to the service.
+}
+
+var accessLogsQueries = []accessLogsQuery{
+	{
+		name: "status-codes",
+		query: `SELECT elb_status_code, target_status_code, count(*) AS requests
+FROM %s
+WHERE %s
+GROUP BY elb_status_code, target_status_code
+ORDER BY requests DESC`,
+	},
+	{
+		// Target processing time is -1 if the load balancer couldn't send the request to a task.
+		name: "latency",
+		query: `SELECT url_extract_path(request_url) AS path, count(*) AS requests,
+  round(avg(target_processing_time) * 1000) AS avg_ms,
+  round(approx_percentile(target_processing_time, 0.9) * 1000) AS p90_ms,
+  round(approx_percentile(target_processing_time, 0.99) * 1000) AS p99_ms
+FROM %s
+WHERE %s AND target_processing_time >= 0
+GROUP BY url_extract_path(request_url)
+ORDER BY p99_ms DESC
+LIMIT 10`,
+	},
+}
+
+// scaledInStoppedReasonPrefix starts the stopped reason of the tasks stopped by ECS when scaling in or replacing tasks during a deployment.
+// These tasks didn't crash, so their logs aren't returned with --previous.
+const scaledInStoppedReasonPrefix = "Scaling activity initiated by"
+
 type svcLogsVars struct {
 	shouldOutputJSON bool
 	follow           bool
@@ -38,6 +101,10 @@ type svcLogsVars struct {
 	humanStartTime   string
 	humanEndTime     string
 	since            time.Duration
+	insights         string
+	previous         bool
+	access           bool
+	query            string
 	*GlobalOpts
 }
 
@@ -54,6 +121,13 @@ type svcLogsOpts struct {
 	sel           deploySelector
 	initCwLogsSvc func(*svcLogsOpts, string) error // Overriden in tests.
 	cwlogsSvc     map[string]cwlogService
+
+	initStoppedTasks func(*svcLogsOpts) error // Overriden in tests.
+	stoppedTasks     stoppedTasksGetter
+
+	initAccessLogs func(*svcLogsOpts) error // Overriden in tests.
+	accessLogs     accessLogsQuerier
+	svcResources   svcStackResourcesDescriber
 }
 
 func newSvcLogOpts(vars svcLogsVars) (*svcLogsOpts, error) {
@@ -84,6 +158,44 @@ func newSvcLogOpts(vars svcLogsVars) (*svcLogsOpts, error) {
 			return nil
 		},
 		cwlogsSvc: make(map[string]cwlogService),
+		initStoppedTasks: func(o *svcLogsOpts) error {
+			status, err := describe.NewServiceStatus(&describe.NewServiceStatusConfig{
+				App:         o.AppName(),
+				Env:         o.envName,
+				Svc:         o.svcName,
+				ConfigStore: o.configStore,
+			})
+			if err != nil {
+				return fmt.Errorf("create service status describer: %w", err)
+			}
+			o.stoppedTasks = status
+			return nil
+		},
+		initAccessLogs: func(o *svcLogsOpts) error {
+			env, err := o.configStore.GetEnvironment(o.AppName(), o.envName)
+			if err != nil {
+				return fmt.Errorf("get environment: %w", err)
+			}
+			if env.CustomConfig == nil || !env.CustomConfig.AccessLogs {
+				return fmt.Errorf(`access logs are not enabled in environment %s, set "http.access_logs" in its manifest and run "copilot env deploy"`, o.envName)
+			}
+			sess, err := sessions.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
+			if err != nil {
+				return err
+			}
+			d, err := describe.NewServiceDescriber(describe.NewServiceConfig{
+				App:         o.AppName(),
+				Env:         o.envName,
+				Svc:         o.svcName,
+				ConfigStore: o.configStore,
+			})
+			if err != nil {
+				return fmt.Errorf("create describer for service %s: %w", o.svcName, err)
+			}
+			o.accessLogs = athena.New(sess)
+			o.svcResources = d
+			return nil
+		},
 	}, nil
 }
 
@@ -104,6 +216,22 @@ func (o *svcLogsOpts) Validate() error {
 		return errors.New("only one of --follow or --end-time may be used")
 	}
 
+	if o.insights != "" && o.follow {
+		return errors.New("only one of --follow or --insights may be used")
+	}
+
+	if o.previous && (o.follow || o.insights != "") {
+		return errors.New("only one of --previous, --follow or --insights may be used")
+	}
+
+	if o.access && (o.follow || o.insights != "" || o.previous) {
+		return errors.New("only one of --access, --previous, --follow or --insights may be used")
+	}
+
+	if o.query != "" && !o.access {
+		return errors.New("--query must be used with --access")
+	}
+
 	if o.since != 0 {
 		if o.since < 0 {
 			return fmt.Errorf("--since must be greater than 0")
@@ -145,6 +273,9 @@ func (o *svcLogsOpts) Ask() error {
 
 // Execute outputs logs of the service.
 func (o *svcLogsOpts) Execute() error {
+	if o.access {
+		return o.runAccessLogsQuery()
+	}
 	logGroupName := fmt.Sprintf(logGroupNamePattern, o.AppName(), o.envName, o.svcName)
 	logEventsOutput := &cloudwatchlogs.LogEventsOutput{
 		LastEventTime: make(map[string]int64),
@@ -152,6 +283,12 @@ func (o *svcLogsOpts) Execute() error {
 	if err := o.initCwLogsSvc(o, o.envName); err != nil {
 		return err
 	}
+	if o.insights != "" {
+		return o.runInsightsQuery(logGroupName)
+	}
+	if o.previous {
+		return o.outputPreviousLogs(logGroupName)
+	}
 	var err error
 	for {
 		logEventsOutput, err = o.cwlogsSvc[o.envName].TaskLogEvents(logGroupName, logEventsOutput.LastEventTime, o.generateGetLogEventOpts()...)
@@ -172,6 +309,135 @@ func (o *svcLogsOpts) Execute() error {
 	}
 }
 
+// outputPreviousLogs outputs the logs of the tasks that stopped most recently, skipping the tasks stopped by scaling in.
+func (o *svcLogsOpts) outputPreviousLogs(logGroupName string) error {
+	if err := o.initStoppedTasks(o); err != nil {
+		return err
+	}
+	tasks, err := o.stoppedTasks.StoppedTasks()
+	if err != nil {
+		return fmt.Errorf("get stopped tasks of service %s: %w", o.svcName, err)
+	}
+	var taskIDs []string
+	for _, task := range tasks {
+		if len(taskIDs) == previousTasksLimit {
+			break
+		}
+		if strings.HasPrefix(aws.StringValue(task.StoppedReason), scaledInStoppedReasonPrefix) {
+			continue
+		}
+		status, err := task.TaskStatus()
+		if err != nil {
+			return fmt.Errorf("get status of task %s: %w", aws.StringValue(task.TaskArn), err)
+		}
+		taskIDs = append(taskIDs, status.ID)
+		log.Infof("Task %s stopped %s: %s\n", color.HighlightResource(status.ID),
+			humanize.Time(status.StoppedAt), status.StoppedReason)
+	}
+	if len(taskIDs) == 0 {
+		log.Infof("No task of service %s stopped within the last hour, other than by scaling in.\n", color.HighlightUserInput(o.svcName))
+		return nil
+	}
+	out, err := o.cwlogsSvc[o.envName].LogEventsOfTasks(logGroupName, taskIDs, o.generateGetLogEventOpts()...)
+	if err != nil {
+		return fmt.Errorf("get logs of stopped tasks: %w", err)
+	}
+	return o.outputLogs(out.Events)
+}
+
+func (o *svcLogsOpts) runInsightsQuery(logGroupName string) error {
+	query := o.insights
+	for _, saved := range template.LogsInsightsQueries {
+		if saved.Name == o.insights {
+			query = saved.Query
+			break
+		}
+	}
+	startTime, endTime := o.queryTimeRange()
+	results, err := o.cwlogsSvc[o.envName].Query(logGroupName, query, startTime, endTime)
+	if err != nil {
+		return fmt.Errorf("run query on logs of service %s: %w", o.svcName, err)
+	}
+	return o.outputQueryResults(results)
+}
+
+// runAccessLogsQuery runs an Athena query on the requests to the service in the access logs of the load balancer.
+func (o *svcLogsOpts) runAccessLogsQuery() error {
+	if err := o.initAccessLogs(o); err != nil {
+		return err
+	}
+	targetGroupARN, err := o.targetGroupARN()
+	if err != nil {
+		return err
+	}
+	startTime, endTime := o.queryTimeRange()
+	conditions := accessLogsConditions(targetGroupARN, startTime, endTime)
+	query := fmt.Sprintf(accessLogsRequestsQuery, stack.AccessLogsTableName, conditions, o.limit)
+	if o.query != "" {
+		query = o.query
+		for _, builtIn := range accessLogsQueries {
+			if builtIn.name == o.query {
+				query = fmt.Sprintf(builtIn.query, stack.AccessLogsTableName, conditions)
+				break
+			}
+		}
+	}
+	results, err := o.accessLogs.Query(stack.NameForAccessLogsWorkGroup(o.AppName(), o.envName),
+		stack.NameForAccessLogsDatabase(o.AppName(), o.envName), query)
+	if err != nil {
+		return fmt.Errorf("run query on access logs of service %s: %w", o.svcName, err)
+	}
+	return o.outputQueryResults(results)
+}
+
+func (o *svcLogsOpts) targetGroupARN() (string, error) {
+	resources, err := o.svcResources.ServiceStackResources()
+	if err != nil {
+		return "", fmt.Errorf("get resources of service %s: %w", o.svcName, err)
+	}
+	for _, resource := range resources {
+		if aws.StringValue(resource.LogicalResourceId) == svcTargetGroupLogicalID {
+			return aws.StringValue(resource.PhysicalResourceId), nil
+		}
+	}
+	return "", fmt.Errorf("service %s doesn't receive requests from the public load balancer", o.svcName)
+}
+
+// queryTimeRange returns the start and end times of a query in milliseconds since epoch, it defaults to the last hour.
+func (o *svcLogsOpts) queryTimeRange() (startTime, endTime int64) {
+	endTime = o.endTime
+	if endTime == 0 {
+		endTime = time.Now().Unix() * 1000
+	}
+	startTime = o.startTime
+	if startTime == 0 {
+		startTime = endTime - defaultQueryPeriod.Milliseconds()
+	}
+	return startTime, endTime
+}
+
+// accessLogsConditions selects the requests to a target group between startTime and endTime, in milliseconds since epoch.
+// The day partitions restrict the logs that Athena reads to the days in the time range.
+func accessLogsConditions(targetGroupARN string, startTime, endTime int64) string {
+	start := time.Unix(startTime/1000, 0).UTC()
+	end := time.Unix(endTime/1000, 0).UTC()
+	return fmt.Sprintf("target_group_arn = '%s' AND day BETWEEN '%s' AND '%s' AND from_iso8601_timestamp(time) BETWEEN from_unixtime(%d) AND from_unixtime(%d)",
+		targetGroupARN, start.Format(accessLogsDayFormat), end.Format(accessLogsDayFormat), start.Unix(), end.Unix())
+}
+
+func (o *svcLogsOpts) outputQueryResults(results describe.HumanJSONStringer) error {
+	if !o.shouldOutputJSON {
+		fmt.Fprint(o.w, results.HumanString())
+		return nil
+	}
+	data, err := results.JSONString()
+	if err != nil {
+		return err
+	}
+	fmt.Fprint(o.w, data)
+	return nil
+}
+
 func (o *svcLogsOpts) askApp() error {
 	if o.AppName() != "" {
 		return nil
@@ -224,6 +490,22 @@ func (o *svcLogsOpts) outputLogs(logs []*cloudwatchlogs.Event) error {
 	return nil
 }
 
+func insightsQueryNames() []string {
+	var names []string
+	for _, query := range template.LogsInsightsQueries {
+		names = append(names, query.Name)
+	}
+	return names
+}
+
+func accessLogsQueryNames() []string {
+	var names []string
+	for _, query := range accessLogsQueries {
+		names = append(names, query.name)
+	}
+	return names
+}
+
 func (o *svcLogsOpts) parseSince() int64 {
 	sinceSec := int64(o.since.Round(time.Second).Seconds())
 	timeNow := time.Now().Add(time.Duration(-sinceSec) * time.Second)
@@ -253,7 +535,17 @@ func BuildSvcLogsCmd() *cobra.Command {
   Displays logs in the last hour.
   /code $ copilot svc logs --since 1h
   Displays logs from 2006-01-02T15:04:05 to 2006-01-02T15:05:05.
-  /code $ copilot svc logs --start-time 2006-01-02T15:04:05+00:00 --end-time 2006-01-02T15:05:05+00:00`,
+  /code $ copilot svc logs --start-time 2006-01-02T15:04:05+00:00 --end-time 2006-01-02T15:05:05+00:00
+  Displays the number of errors every 5 minutes in the last day.
+  /code $ copilot svc logs --insights errors --since 24h
+  Runs a CloudWatch Logs Insights query on the logs of the last hour.
+  /code $ copilot svc logs --insights 'fields @timestamp, @message | filter @message like /timeout/'
+  Displays logs of the tasks that crashed most recently.
+  /code $ copilot svc logs --previous
+  Displays the latest requests to the service from the access logs of the load balancer.
+  /code $ copilot svc logs --access
+  Displays the number of requests by status code in the last day.
+  /code $ copilot svc logs --access --query status-codes --since 24h`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newSvcLogOpts(vars)
 			if err != nil {
@@ -277,5 +569,9 @@ func BuildSvcLogsCmd() *cobra.Command {
 	cmd.Flags().BoolVar(&vars.follow, followFlag, false, followFlagDescription)
 	cmd.Flags().DurationVar(&vars.since, sinceFlag, 0, sinceFlagDescription)
 	cmd.Flags().IntVar(&vars.limit, limitFlag, 10, limitFlagDescription)
+	cmd.Flags().StringVar(&vars.insights, insightsFlag, "", insightsFlagDescription)
+	cmd.Flags().BoolVar(&vars.previous, previousFlag, false, previousFlagDescription)
+	cmd.Flags().BoolVar(&vars.access, accessFlag, false, accessFlagDescription)
+	cmd.Flags().StringVar(&vars.query, queryFlag, "", queryFlagDescription)
 	return cmd
 }
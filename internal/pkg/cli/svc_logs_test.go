@@ -10,8 +10,13 @@ import (
 	"testing"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/athena"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
 
 	"github.com/golang/mock/gomock"
@@ -42,6 +47,10 @@ func TestSvcLogs_Validate(t *testing.T) {
 		inputStartTime string
 		inputEndTime   string
 		inputSince     time.Duration
+		inputInsights  string
+		inputPrevious  bool
+		inputAccess    bool
+		inputQuery     string
 
 		mockstore func(m *mocks.Mockstore)
 
@@ -80,6 +89,41 @@ func TestSvcLogs_Validate(t *testing.T) {
 
 			wantedError: fmt.Errorf("only one of --follow or --end-time may be used"),
 		},
+		"returns error if follow and insights flags are set together": {
+			inputLimit:    10,
+			inputFollow:   true,
+			inputInsights: "errors",
+
+			mockstore: func(m *mocks.Mockstore) {},
+
+			wantedError: fmt.Errorf("only one of --follow or --insights may be used"),
+		},
+		"returns error if previous and insights flags are set together": {
+			inputLimit:    10,
+			inputPrevious: true,
+			inputInsights: "errors",
+
+			mockstore: func(m *mocks.Mockstore) {},
+
+			wantedError: fmt.Errorf("only one of --previous, --follow or --insights may be used"),
+		},
+		"returns error if access and follow flags are set together": {
+			inputLimit:  10,
+			inputAccess: true,
+			inputFollow: true,
+
+			mockstore: func(m *mocks.Mockstore) {},
+
+			wantedError: fmt.Errorf("only one of --access, --previous, --follow or --insights may be used"),
+		},
+		"returns error if query flag is set without access": {
+			inputLimit: 10,
+			inputQuery: "status-codes",
+
+			mockstore: func(m *mocks.Mockstore) {},
+
+			wantedError: fmt.Errorf("--query must be used with --access"),
+		},
 		"returns error if invalid start time flag value": {
 			inputStartTime: mockBadStartTime,
 
@@ -133,6 +177,10 @@ func TestSvcLogs_Validate(t *testing.T) {
 					humanStartTime: tc.inputStartTime,
 					humanEndTime:   tc.inputEndTime,
 					since:          tc.inputSince,
+					insights:       tc.inputInsights,
+					previous:       tc.inputPrevious,
+					access:         tc.inputAccess,
+					query:          tc.inputQuery,
 					svcName:        tc.inputSvc,
 					GlobalOpts: &GlobalOpts{
 						appName: tc.inputApp,
@@ -297,7 +345,17 @@ firelens_log_router/fcfe4 10.0.0.00 - - [01/Jan/1970 01:01:01] "WARN some warnin
 		inputEnvName string
 		inputJSON    bool
 
+		inputInsights  string
+		inputPrevious  bool
+		inputAccess    bool
+		inputQuery     string
+		inputLimit     int
+		inputStartTime int64
+		inputEndTime   int64
+
 		mockcwlogService func(ctrl *gomock.Controller) map[string]cwlogService
+		mockStoppedTasks func(m *mocks.MockstoppedTasksGetter)
+		mockAccessLogs   func(q *mocks.MockaccessLogsQuerier, r *mocks.MocksvcStackResourcesDescriber)
 
 		wantedError   error
 		wantedContent string
@@ -386,6 +444,285 @@ firelens_log_router/fcfe4 10.0.0.00 - - [01/Jan/1970 01:01:01] "GET / HTTP/1.1"
 
 			wantedError: fmt.Errorf("some error"),
 		},
+		"with insights flag set to a saved query": {
+			inputApp:       "mockApp",
+			inputSvc:       "mockSvc",
+			inputEnvName:   "mockEnv",
+			inputInsights:  "errors",
+			inputStartTime: 1600000000000,
+			inputEndTime:   1600003600000,
+
+			mockcwlogService: func(ctrl *gomock.Controller) map[string]cwlogService {
+				m := mocks.NewMockcwlogService(ctrl)
+				m.EXPECT().Query(fmt.Sprintf(logGroupNamePattern, "mockApp", "mockEnv", "mockSvc"), template.LogsInsightsQueries[0].Query, int64(1600000000000), int64(1600003600000)).
+					Return(&cloudwatchlogs.QueryResults{
+						Fields: []string{"bin(5m)", "errors"},
+						Rows: []map[string]string{
+							{"bin(5m)": "2020-09-13 12:25:00.000", "errors": "3"},
+						},
+					}, nil)
+				return map[string]cwlogService{
+					"mockEnv": m,
+				}
+			},
+
+			wantedContent: `bin(5m)                  errors
+2020-09-13 12:25:00.000  3
+`,
+		},
+		"with insights and json flags set": {
+			inputApp:      "mockApp",
+			inputSvc:      "mockSvc",
+			inputEnvName:  "mockEnv",
+			inputInsights: "stats count(*) by @logStream",
+			inputJSON:     true,
+
+			mockcwlogService: func(ctrl *gomock.Controller) map[string]cwlogService {
+				m := mocks.NewMockcwlogService(ctrl)
+				m.EXPECT().Query(fmt.Sprintf(logGroupNamePattern, "mockApp", "mockEnv", "mockSvc"), "stats count(*) by @logStream", gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_, _ string, startTime, endTime int64) (*cloudwatchlogs.QueryResults, error) {
+						require.Equal(t, time.Hour.Milliseconds(), endTime-startTime, "defaults to the logs of the last hour")
+						return &cloudwatchlogs.QueryResults{
+							Fields: []string{"@logStream", "count(*)"},
+							Rows: []map[string]string{
+								{"@logStream": "copilot/mockSvc/1cc0685ad01d4d0f8e4e2c00d1775c56", "count(*)": "42"},
+							},
+						}, nil
+					})
+				return map[string]cwlogService{
+					"mockEnv": m,
+				}
+			},
+
+			wantedContent: `[{"@logStream":"copilot/mockSvc/1cc0685ad01d4d0f8e4e2c00d1775c56","count(*)":"42"}]
+`,
+		},
+		"returns error if fail to run the insights query": {
+			inputApp:      "mockApp",
+			inputSvc:      "mockSvc",
+			inputEnvName:  "mockEnv",
+			inputInsights: "errors",
+
+			mockcwlogService: func(ctrl *gomock.Controller) map[string]cwlogService {
+				m := mocks.NewMockcwlogService(ctrl)
+				m.EXPECT().Query(fmt.Sprintf(logGroupNamePattern, "mockApp", "mockEnv", "mockSvc"), template.LogsInsightsQueries[0].Query, gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("some error"))
+				return map[string]cwlogService{
+					"mockEnv": m,
+				}
+			},
+
+			wantedError: fmt.Errorf("run query on logs of service mockSvc: some error"),
+		},
+		"with previous flag set": {
+			inputApp:      "mockApp",
+			inputSvc:      "mockSvc",
+			inputEnvName:  "mockEnv",
+			inputPrevious: true,
+
+			mockStoppedTasks: func(m *mocks.MockstoppedTasksGetter) {
+				m.EXPECT().StoppedTasks().Return([]*ecs.Task{
+					{
+						TaskArn:       aws.String("arn:aws:ecs:us-west-2:123456789:task/mockCluster/scaledIn"),
+						StoppedReason: aws.String("Scaling activity initiated by (deployment ecs-svc/123)"),
+					},
+					{
+						TaskArn:       aws.String("arn:aws:ecs:us-west-2:123456789:task/mockCluster/crashed"),
+						StoppedReason: aws.String("Essential container in task exited"),
+					},
+				}, nil)
+			},
+			mockcwlogService: func(ctrl *gomock.Controller) map[string]cwlogService {
+				m := mocks.NewMockcwlogService(ctrl)
+				m.EXPECT().LogEventsOfTasks(fmt.Sprintf(logGroupNamePattern, "mockApp", "mockEnv", "mockSvc"), []string{"crashed"}, gomock.Any()).
+					Return(&cloudwatchlogs.LogEventsOutput{
+						Events: logEvents,
+					}, nil)
+				return map[string]cwlogService{
+					"mockEnv": m,
+				}
+			},
+
+			wantedContent: logEventsHumanString,
+		},
+		"with previous flag set and no crashed tasks": {
+			inputApp:      "mockApp",
+			inputSvc:      "mockSvc",
+			inputEnvName:  "mockEnv",
+			inputPrevious: true,
+
+			mockStoppedTasks: func(m *mocks.MockstoppedTasksGetter) {
+				m.EXPECT().StoppedTasks().Return([]*ecs.Task{
+					{
+						TaskArn:       aws.String("arn:aws:ecs:us-west-2:123456789:task/mockCluster/scaledIn"),
+						StoppedReason: aws.String("Scaling activity initiated by (deployment ecs-svc/123)"),
+					},
+				}, nil)
+			},
+			mockcwlogService: func(ctrl *gomock.Controller) map[string]cwlogService {
+				m := mocks.NewMockcwlogService(ctrl)
+				m.EXPECT().LogEventsOfTasks(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				return map[string]cwlogService{
+					"mockEnv": m,
+				}
+			},
+		},
+		"returns error if fail to get stopped tasks": {
+			inputApp:      "mockApp",
+			inputSvc:      "mockSvc",
+			inputEnvName:  "mockEnv",
+			inputPrevious: true,
+
+			mockStoppedTasks: func(m *mocks.MockstoppedTasksGetter) {
+				m.EXPECT().StoppedTasks().Return(nil, errors.New("some error"))
+			},
+			mockcwlogService: func(ctrl *gomock.Controller) map[string]cwlogService {
+				return map[string]cwlogService{
+					"mockEnv": mocks.NewMockcwlogService(ctrl),
+				}
+			},
+
+			wantedError: fmt.Errorf("get stopped tasks of service mockSvc: some error"),
+		},
+		"with access flag set": {
+			inputApp:       "mock-app",
+			inputSvc:       "mockSvc",
+			inputEnvName:   "mock-env",
+			inputAccess:    true,
+			inputLimit:     5,
+			inputStartTime: 1600000000000,
+			inputEndTime:   1600003600000,
+
+			mockcwlogService: func(ctrl *gomock.Controller) map[string]cwlogService {
+				return nil
+			},
+			mockAccessLogs: func(q *mocks.MockaccessLogsQuerier, r *mocks.MocksvcStackResourcesDescriber) {
+				r.EXPECT().ServiceStackResources().Return([]*sdkcloudformation.StackResource{
+					{
+						LogicalResourceId:  aws.String("Service"),
+						PhysicalResourceId: aws.String("arn:aws:ecs:us-west-2:123456789012:service/mock-app-mock-env-Cluster/mockSvc"),
+					},
+					{
+						LogicalResourceId:  aws.String("TargetGroup"),
+						PhysicalResourceId: aws.String("arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/mock/1234"),
+					},
+				}, nil)
+				q.EXPECT().Query("mock-app-mock-env-access-logs", "copilot_mock_app_mock_env", `SELECT time, client_ip, request_verb, request_url, elb_status_code, target_status_code, target_processing_time
+FROM alb_access_logs
+WHERE target_group_arn = 'arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/mock/1234' AND day BETWEEN '2020/09/13' AND '2020/09/13' AND from_iso8601_timestamp(time) BETWEEN from_unixtime(1600000000) AND from_unixtime(1600003600)
+ORDER BY time DESC
+LIMIT 5`).Return(&athena.QueryResults{
+					Fields: []string{"time", "request_url", "elb_status_code"},
+					Rows: []map[string]string{
+						{"time": "2020-09-13T13:20:00.123456Z", "request_url": "https://example.com:443/", "elb_status_code": "200"},
+					},
+				}, nil)
+			},
+
+			wantedContent: `time                         request_url               elb_status_code
+2020-09-13T13:20:00.123456Z  https://example.com:443/  200
+`,
+		},
+		"with access flag set and a built-in query": {
+			inputApp:       "mock-app",
+			inputSvc:       "mockSvc",
+			inputEnvName:   "mock-env",
+			inputAccess:    true,
+			inputQuery:     "status-codes",
+			inputJSON:      true,
+			inputStartTime: 1600000000000,
+			inputEndTime:   1600003600000,
+
+			mockcwlogService: func(ctrl *gomock.Controller) map[string]cwlogService {
+				return nil
+			},
+			mockAccessLogs: func(q *mocks.MockaccessLogsQuerier, r *mocks.MocksvcStackResourcesDescriber) {
+				r.EXPECT().ServiceStackResources().Return([]*sdkcloudformation.StackResource{
+					{
+						LogicalResourceId:  aws.String("TargetGroup"),
+						PhysicalResourceId: aws.String("arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/mock/1234"),
+					},
+				}, nil)
+				q.EXPECT().Query("mock-app-mock-env-access-logs", "copilot_mock_app_mock_env", `SELECT elb_status_code, target_status_code, count(*) AS requests
+FROM alb_access_logs
+WHERE target_group_arn = 'arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/mock/1234' AND day BETWEEN '2020/09/13' AND '2020/09/13' AND from_iso8601_timestamp(time) BETWEEN from_unixtime(1600000000) AND from_unixtime(1600003600)
+GROUP BY elb_status_code, target_status_code
+ORDER BY requests DESC`).Return(&athena.QueryResults{
+					Fields: []string{"elb_status_code", "target_status_code", "requests"},
+					Rows: []map[string]string{
+						{"elb_status_code": "200", "target_status_code": "200", "requests": "1042"},
+					},
+				}, nil)
+			},
+
+			wantedContent: `[{"elb_status_code":"200","requests":"1042","target_status_code":"200"}]
+`,
+		},
+		"with access flag set and a SQL query": {
+			inputApp:     "mock-app",
+			inputSvc:     "mockSvc",
+			inputEnvName: "mock-env",
+			inputAccess:  true,
+			inputQuery:   "SELECT count(*) FROM alb_access_logs",
+
+			mockcwlogService: func(ctrl *gomock.Controller) map[string]cwlogService {
+				return nil
+			},
+			mockAccessLogs: func(q *mocks.MockaccessLogsQuerier, r *mocks.MocksvcStackResourcesDescriber) {
+				r.EXPECT().ServiceStackResources().Return([]*sdkcloudformation.StackResource{
+					{
+						LogicalResourceId:  aws.String("TargetGroup"),
+						PhysicalResourceId: aws.String("arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/mock/1234"),
+					},
+				}, nil)
+				q.EXPECT().Query("mock-app-mock-env-access-logs", "copilot_mock_app_mock_env", "SELECT count(*) FROM alb_access_logs").
+					Return(&athena.QueryResults{}, nil)
+			},
+
+			wantedContent: "No results found.\n",
+		},
+		"returns error if the service isn't behind the load balancer": {
+			inputApp:     "mock-app",
+			inputSvc:     "mockSvc",
+			inputEnvName: "mock-env",
+			inputAccess:  true,
+
+			mockcwlogService: func(ctrl *gomock.Controller) map[string]cwlogService {
+				return nil
+			},
+			mockAccessLogs: func(q *mocks.MockaccessLogsQuerier, r *mocks.MocksvcStackResourcesDescriber) {
+				r.EXPECT().ServiceStackResources().Return([]*sdkcloudformation.StackResource{
+					{
+						LogicalResourceId: aws.String("Service"),
+					},
+				}, nil)
+				q.EXPECT().Query(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+
+			wantedError: fmt.Errorf("service mockSvc doesn't receive requests from the public load balancer"),
+		},
+		"returns error if fail to run the query on access logs": {
+			inputApp:     "mock-app",
+			inputSvc:     "mockSvc",
+			inputEnvName: "mock-env",
+			inputAccess:  true,
+			inputQuery:   "latency",
+
+			mockcwlogService: func(ctrl *gomock.Controller) map[string]cwlogService {
+				return nil
+			},
+			mockAccessLogs: func(q *mocks.MockaccessLogsQuerier, r *mocks.MocksvcStackResourcesDescriber) {
+				r.EXPECT().ServiceStackResources().Return([]*sdkcloudformation.StackResource{
+					{
+						LogicalResourceId:  aws.String("TargetGroup"),
+						PhysicalResourceId: aws.String("arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/mock/1234"),
+					},
+				}, nil)
+				q.EXPECT().Query(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("some error"))
+			},
+
+			wantedError: fmt.Errorf("run query on access logs of service mockSvc: some error"),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -393,6 +730,15 @@ firelens_log_router/fcfe4 10.0.0.00 - - [01/Jan/1970 01:01:01] "GET / HTTP/1.1"
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
+			mockStoppedTasks := mocks.NewMockstoppedTasksGetter(ctrl)
+			if tc.mockStoppedTasks != nil {
+				tc.mockStoppedTasks(mockStoppedTasks)
+			}
+			mockAccessLogs := mocks.NewMockaccessLogsQuerier(ctrl)
+			mockSvcResources := mocks.NewMocksvcStackResourcesDescriber(ctrl)
+			if tc.mockAccessLogs != nil {
+				tc.mockAccessLogs(mockAccessLogs, mockSvcResources)
+			}
 			b := &bytes.Buffer{}
 			svcLogs := &svcLogsOpts{
 				svcLogsVars: svcLogsVars{
@@ -400,13 +746,27 @@ firelens_log_router/fcfe4 10.0.0.00 - - [01/Jan/1970 01:01:01] "GET / HTTP/1.1"
 					envName:          tc.inputEnvName,
 					svcName:          tc.inputSvc,
 					shouldOutputJSON: tc.inputJSON,
+					insights:         tc.inputInsights,
+					previous:         tc.inputPrevious,
+					access:           tc.inputAccess,
+					query:            tc.inputQuery,
+					limit:            tc.inputLimit,
 					GlobalOpts: &GlobalOpts{
 						appName: tc.inputApp,
 					},
 				},
+				startTime:     tc.inputStartTime,
+				endTime:       tc.inputEndTime,
 				initCwLogsSvc: func(*svcLogsOpts, string) error { return nil },
 				cwlogsSvc:     tc.mockcwlogService(ctrl),
 				w:             b,
+
+				initStoppedTasks: func(*svcLogsOpts) error { return nil },
+				stoppedTasks:     mockStoppedTasks,
+
+				initAccessLogs: func(*svcLogsOpts) error { return nil },
+				accessLogs:     mockAccessLogs,
+				svcResources:   mockSvcResources,
 			}
 
 			// WHEN
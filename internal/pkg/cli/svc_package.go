@@ -10,6 +10,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/aws/copilot-cli/internal/pkg/addon"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
@@ -17,9 +18,12 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/merge"
 	"github.com/aws/copilot-cli/internal/pkg/term/command"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/dustin/go-humanize/english"
 	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 )
@@ -27,6 +31,10 @@ import (
 const (
 	svcPackageSvcNamePrompt = "Which service would you like to generate a CloudFormation template for?"
 	svcPackageEnvNamePrompt = "Which environment would you like to package this stack for?"
+
+	// The template generated by the last run is kept next to the stack template in the output directory,
+	// so that the edits made to the stack template can be merged with the next generated template.
+	svcPackageBaseTemplateNameFormat = ".%s.stack.base.yml"
 )
 
 var initPackageAddonsSvc = func(o *packageSvcOpts) error {
@@ -44,6 +52,8 @@ type packageSvcVars struct {
 	EnvName   string
 	Tag       string
 	OutputDir string
+	Strategy  string
+	Lax       bool
 }
 
 type packageSvcOpts struct {
@@ -140,6 +150,9 @@ func (o *packageSvcOpts) Validate() error {
 			return err
 		}
 	}
+	if o.Strategy != "" && !contains(o.Strategy, merge.Strategies) {
+		return fmt.Errorf("invalid strategy %s: must be one of %s", o.Strategy, strings.Join(merge.Strategies, ", "))
+	}
 	return nil
 }
 
@@ -171,7 +184,12 @@ func (o *packageSvcOpts) Execute() error {
 	if err != nil {
 		return err
 	}
-	if _, err = o.stackWriter.Write([]byte(appTemplates.stack)); err != nil {
+	if o.OutputDir != "" {
+		err = o.writeStackTemplate(appTemplates.stack)
+	} else {
+		_, err = o.stackWriter.Write([]byte(appTemplates.stack))
+	}
+	if err != nil {
 		return err
 	}
 	if _, err = o.paramsWriter.Write([]byte(appTemplates.configuration)); err != nil {
@@ -260,7 +278,11 @@ func (o *packageSvcOpts) getSvcTemplates(env *config.Environment) (*svcCfnTempla
 	if err != nil {
 		return nil, err
 	}
-	mft, err := manifest.UnmarshalService(raw)
+	unmarshal := manifest.UnmarshalService
+	if o.Lax {
+		unmarshal = manifest.UnmarshalServiceLax
+	}
+	mft, err := unmarshal(raw)
 	if err != nil {
 		return nil, err
 	}
@@ -301,20 +323,12 @@ func (o *packageSvcOpts) getSvcTemplates(env *config.Environment) (*svcCfnTempla
 	return &svcCfnTemplates{stack: tpl, configuration: params}, nil
 }
 
-// setOutputFileWriters creates the output directory, and updates the template and param writers to file writers in the directory.
+// setOutputFileWriters creates the output directory, and updates the param writer to a file writer in the directory.
 func (o *packageSvcOpts) setOutputFileWriters() error {
 	if err := o.fs.MkdirAll(o.OutputDir, 0755); err != nil {
 		return fmt.Errorf("create directory %s: %w", o.OutputDir, err)
 	}
 
-	templatePath := filepath.Join(o.OutputDir,
-		fmt.Sprintf(config.ServiceCfnTemplateNameFormat, o.Name))
-	templateFile, err := o.fs.Create(templatePath)
-	if err != nil {
-		return fmt.Errorf("create file %s: %w", templatePath, err)
-	}
-	o.stackWriter = templateFile
-
 	paramsPath := filepath.Join(o.OutputDir,
 		fmt.Sprintf(config.ServiceCfnTemplateConfigurationNameFormat, o.Name, o.EnvName))
 	paramsFile, err := o.fs.Create(paramsPath)
@@ -326,6 +340,59 @@ func (o *packageSvcOpts) setOutputFileWriters() error {
 	return nil
 }
 
+// writeStackTemplate writes the generated template to the output directory. If the stack template written by a previous
+// run was edited, the edits are merged into the generated template instead of being overwritten.
+func (o *packageSvcOpts) writeStackTemplate(generated string) error {
+	templatePath := filepath.Join(o.OutputDir, fmt.Sprintf(config.ServiceCfnTemplateNameFormat, o.Name))
+	basePath := filepath.Join(o.OutputDir, fmt.Sprintf(svcPackageBaseTemplateNameFormat, o.Name))
+	content, conflicts, err := o.mergeStackTemplate(templatePath, basePath, generated)
+	if err != nil {
+		return err
+	}
+	if err := afero.WriteFile(o.fs, templatePath, []byte(content), 0644); err != nil {
+		return fmt.Errorf("write file %s: %w", templatePath, err)
+	}
+	if err := afero.WriteFile(o.fs, basePath, []byte(generated), 0644); err != nil {
+		return fmt.Errorf("write file %s: %w", basePath, err)
+	}
+	if conflicts > 0 {
+		return &errTemplateConflicts{path: templatePath, conflicts: conflicts}
+	}
+	return nil
+}
+
+// mergeStackTemplate returns the content of the stack template with the edits made since the last run,
+// and the number of conflicts left in it.
+func (o *packageSvcOpts) mergeStackTemplate(templatePath, basePath, generated string) (string, int, error) {
+	current, err := afero.ReadFile(o.fs, templatePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return generated, 0, nil
+		}
+		return "", 0, fmt.Errorf("read file %s: %w", templatePath, err)
+	}
+	base, err := afero.ReadFile(o.fs, basePath)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return "", 0, fmt.Errorf("read file %s: %w", basePath, err)
+		}
+		// Written by an older version of Copilot, there is nothing to tell the edits apart from.
+		if string(current) != generated {
+			log.Warningf("Overwrote %s since it was written without a base template to merge its changes with.\n", templatePath)
+		}
+		return generated, 0, nil
+	}
+	strategy := o.Strategy
+	if strategy == "" {
+		strategy = merge.StrategyMerge
+	}
+	res, err := merge.ThreeWay(string(base), string(current), generated, strategy)
+	if err != nil {
+		return "", 0, fmt.Errorf("merge the changes to %s: %w", templatePath, err)
+	}
+	return res.Content, res.Conflicts, nil
+}
+
 func (o *packageSvcOpts) setAddonsFileWriter() error {
 	addonsPath := filepath.Join(o.OutputDir,
 		fmt.Sprintf(config.AddonsCfnTemplateNameFormat, o.Name))
@@ -357,6 +424,16 @@ func (e *errRepoNotFound) Error() string {
 	return fmt.Sprintf("ECR repository not found for service %s in region %s and account %s", e.svcName, e.envRegion, e.appAccountID)
 }
 
+type errTemplateConflicts struct {
+	path      string
+	conflicts int
+}
+
+func (e *errTemplateConflicts) Error() string {
+	return fmt.Sprintf("%s has %s between your changes and the regenerated template: resolve the lines between the conflict markers, or run the command again with --%s %s or %s",
+		e.path, english.Plural(e.conflicts, "conflict", ""), mergeStrategyFlag, merge.StrategyOurs, merge.StrategyTheirs)
+}
+
 func (e *errRepoNotFound) Is(target error) bool {
 	t, ok := target.(*errRepoNotFound)
 	if !ok {
@@ -404,5 +481,7 @@ func BuildSvcPackageCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.EnvName, envFlag, envFlagShort, "", envFlagDescription)
 	cmd.Flags().StringVar(&vars.Tag, imageTagFlag, "", imageTagFlagDescription)
 	cmd.Flags().StringVar(&vars.OutputDir, stackOutputDirFlag, "", stackOutputDirFlagDescription)
+	cmd.Flags().StringVar(&vars.Strategy, mergeStrategyFlag, merge.StrategyMerge, mergeStrategyFlagDescription)
+	cmd.Flags().BoolVar(&vars.Lax, laxFlag, false, laxFlagDescription)
 	return cmd
 }
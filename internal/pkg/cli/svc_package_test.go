@@ -13,6 +13,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
 	"github.com/stretchr/testify/require"
 )
 
@@ -23,9 +24,10 @@ func TestPackageSvcOpts_Validate(t *testing.T) {
 	)
 
 	testCases := map[string]struct {
-		inAppName string
-		inEnvName string
-		inSvcName string
+		inAppName  string
+		inEnvName  string
+		inSvcName  string
+		inStrategy string
 
 		setupMocks func()
 
@@ -75,6 +77,13 @@ func TestPackageSvcOpts_Validate(t *testing.T) {
 				EnvironmentName: "test",
 			}).Error(),
 		},
+		"invalid merge strategy": {
+			inAppName:  "phonetool",
+			inStrategy: "rebase",
+			setupMocks: func() {},
+
+			wantedErrorS: "invalid strategy rebase: must be one of merge, ours, theirs",
+		},
 	}
 
 	for name, tc := range testCases {
@@ -92,6 +101,7 @@ func TestPackageSvcOpts_Validate(t *testing.T) {
 				packageSvcVars: packageSvcVars{
 					Name:       tc.inSvcName,
 					EnvName:    tc.inEnvName,
+					Strategy:   tc.inStrategy,
 					GlobalOpts: &GlobalOpts{appName: tc.inAppName},
 				},
 				ws:    mockWorkspace,
@@ -359,3 +369,146 @@ count: 1`), nil)
 		})
 	}
 }
+
+func TestPackageSvcOpts_writeStackTemplate(t *testing.T) {
+	const (
+		templatePath = "infrastructure/api.stack.yml"
+		basePath     = "infrastructure/.api.stack.base.yml"
+
+		base = `Resources:
+  LogGroup:
+    Properties:
+      RetentionInDays: 30
+  TaskDefinition:
+    Properties:
+      Cpu: 256
+`
+		edited = `Resources:
+  LogGroup:
+    Properties:
+      RetentionInDays: 90
+  TaskDefinition:
+    Properties:
+      Cpu: 256
+`
+	)
+	testCases := map[string]struct {
+		inFiles     map[string]string
+		inStrategy  string
+		inGenerated string
+
+		wantedTemplate string
+		wantedErr      string
+	}{
+		"writes the generated template and its base the first time": {
+			inGenerated:    base,
+			wantedTemplate: base,
+		},
+		"overwrites a template written without a base": {
+			inFiles: map[string]string{
+				templatePath: edited,
+			},
+			inGenerated:    base,
+			wantedTemplate: base,
+		},
+		"keeps the edits that don't conflict with the generated template": {
+			inFiles: map[string]string{
+				templatePath: edited,
+				basePath:     base,
+			},
+			inGenerated: `Resources:
+  LogGroup:
+    Properties:
+      RetentionInDays: 30
+  TaskDefinition:
+    Properties:
+      Cpu: 512
+`,
+			wantedTemplate: `Resources:
+  LogGroup:
+    Properties:
+      RetentionInDays: 90
+  TaskDefinition:
+    Properties:
+      Cpu: 512
+`,
+		},
+		"writes conflict markers and errors on conflicts": {
+			inFiles: map[string]string{
+				templatePath: edited,
+				basePath:     base,
+			},
+			inGenerated: `Resources:
+  LogGroup:
+    Properties:
+      RetentionInDays: !Ref LogRetention
+  TaskDefinition:
+    Properties:
+      Cpu: 256
+`,
+			wantedTemplate: `Resources:
+  LogGroup:
+    Properties:
+<<<<<<< yours
+      RetentionInDays: 90
+=======
+      RetentionInDays: !Ref LogRetention
+>>>>>>> generated
+  TaskDefinition:
+    Properties:
+      Cpu: 256
+`,
+			wantedErr: "infrastructure/api.stack.yml has 1 conflict between your changes and the regenerated template: resolve the lines between the conflict markers, or run the command again with --strategy ours or theirs",
+		},
+		"keeps the edits on conflicts with the ours strategy": {
+			inFiles: map[string]string{
+				templatePath: edited,
+				basePath:     base,
+			},
+			inStrategy: "ours",
+			inGenerated: `Resources:
+  LogGroup:
+    Properties:
+      RetentionInDays: !Ref LogRetention
+  TaskDefinition:
+    Properties:
+      Cpu: 256
+`,
+			wantedTemplate: edited,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			fs := afero.NewMemMapFs()
+			for path, content := range tc.inFiles {
+				require.NoError(t, afero.WriteFile(fs, path, []byte(content), 0644))
+			}
+			opts := &packageSvcOpts{
+				packageSvcVars: packageSvcVars{
+					Name:      "api",
+					OutputDir: "infrastructure",
+					Strategy:  tc.inStrategy,
+				},
+				fs: fs,
+			}
+
+			// WHEN
+			err := opts.writeStackTemplate(tc.inGenerated)
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+			} else {
+				require.NoError(t, err)
+			}
+			tpl, err := afero.ReadFile(fs, templatePath)
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedTemplate, string(tpl))
+			generated, err := afero.ReadFile(fs, basePath)
+			require.NoError(t, err)
+			require.Equal(t, tc.inGenerated, string(generated))
+		})
+	}
+}
@@ -0,0 +1,186 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcPsAppNamePrompt     = "Which application is the service in?"
+	svcPsAppNameHelpPrompt = "An application groups all of your services together."
+	svcPsNamePrompt        = "Which service's tasks would you like to list?"
+	svcPsNameHelpPrompt    = "Lists the tasks of the service that are running in the environment."
+)
+
+type svcPsVars struct {
+	*GlobalOpts
+	shouldOutputJSON bool
+	noCache          bool
+	svcName          string
+	envName          string
+	sortBy           string
+}
+
+type svcPsOpts struct {
+	svcPsVars
+
+	w               io.Writer
+	store           store
+	tasksGetter     runningTasksGetter
+	sel             deploySelector
+	initTasksGetter func(*svcPsOpts) error
+}
+
+func newSvcPsOpts(vars svcPsVars) (*svcPsOpts, error) {
+	c, err := newLocalCache(vars.noCache)
+	if err != nil {
+		return nil, err
+	}
+	configStore, err := config.NewCachedStore(c)
+	if err != nil {
+		return nil, fmt.Errorf("connect to environment datastore: %w", err)
+	}
+	deployStore, err := deploy.NewStore(configStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	return &svcPsOpts{
+		svcPsVars: vars,
+		store:     configStore,
+		w:         log.OutputWriter,
+		sel:       selector.NewDeploySelect(vars.prompt, configStore, deployStore),
+		initTasksGetter: func(o *svcPsOpts) error {
+			d, err := describe.NewServiceStatus(&describe.NewServiceStatusConfig{
+				App:         o.AppName(),
+				Env:         o.envName,
+				Svc:         o.svcName,
+				ConfigStore: configStore,
+			})
+			if err != nil {
+				return fmt.Errorf("creating status describer for service %s in application %s: %w", o.svcName, o.AppName(), err)
+			}
+			o.tasksGetter = d
+			return nil
+		},
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *svcPsOpts) Validate() error {
+	if !contains(o.sortBy, describe.TaskSortColumns) {
+		return fmt.Errorf("sort column %s is invalid: %w", o.sortBy, errInvalidValue("value must be one of "+prettify(describe.TaskSortColumns)))
+	}
+	if o.AppName() != "" {
+		if _, err := o.store.GetApplication(o.AppName()); err != nil {
+			return err
+		}
+	}
+	if o.svcName != "" {
+		if _, err := o.store.GetService(o.AppName(), o.svcName); err != nil {
+			return err
+		}
+	}
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.AppName(), o.envName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *svcPsOpts) Ask() error {
+	if o.AppName() == "" {
+		app, err := o.sel.Application(svcPsAppNamePrompt, svcPsAppNameHelpPrompt)
+		if err != nil {
+			return fmt.Errorf("select application: %w", err)
+		}
+		o.appName = app
+	}
+	deployedService, err := o.sel.DeployedService(svcPsNamePrompt, svcPsNameHelpPrompt, o.AppName(), selector.WithEnv(o.envName), selector.WithSvc(o.svcName))
+	if err != nil {
+		return fmt.Errorf("select deployed services for application %s: %w", o.AppName(), err)
+	}
+	o.svcName = deployedService.Svc
+	o.envName = deployedService.Env
+	return nil
+}
+
+// Execute lists the running tasks of the service.
+func (o *svcPsOpts) Execute() error {
+	if err := o.initTasksGetter(o); err != nil {
+		return err
+	}
+	tasks, err := o.tasksGetter.RunningTasks()
+	if err != nil {
+		return fmt.Errorf("list tasks of service %s: %w", o.svcName, err)
+	}
+	desc, err := describe.NewServiceTasksDesc(o.svcName, tasks)
+	if err != nil {
+		return err
+	}
+	if err := desc.Sort(o.sortBy); err != nil {
+		return err
+	}
+	if o.shouldOutputJSON {
+		data, err := desc.JSONString()
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(o.w, data)
+		return nil
+	}
+	if len(desc.Tasks) == 0 {
+		log.Infof("No tasks of service %s are running in environment %s.\n", o.svcName, o.envName)
+		return nil
+	}
+	fmt.Fprint(o.w, desc.HumanString())
+	return nil
+}
+
+// BuildSvcPsCmd builds the command for listing the running tasks of a service.
+func BuildSvcPsCmd() *cobra.Command {
+	vars := svcPsVars{
+		GlobalOpts: NewGlobalOpts(),
+	}
+	cmd := &cobra.Command{
+		Use:   "ps",
+		Short: "Lists the running tasks of a deployed service.",
+		Long:  "Lists the running tasks of a deployed service with their status, health, availability zone, resources, image tag and uptime.",
+
+		Example: `
+  Lists the tasks of the service "my-svc" in the "test" environment.
+  /code $ copilot svc ps -n my-svc -e test
+  Lists the tasks of "my-svc" grouped by availability zone, as JSON.
+  /code $ copilot svc ps -n my-svc -e test --sort az --json`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSvcPsOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.svcName, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVar(&vars.sortBy, sortFlag, describe.TaskSortByUptime, sortTasksFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	cmd.Flags().BoolVar(&vars.noCache, noCacheFlag, false, noCacheFlagDescription)
+	return cmd
+}
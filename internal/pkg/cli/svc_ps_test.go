@@ -0,0 +1,141 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSvcPs_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inSortBy string
+
+		wantedError error
+	}{
+		"valid sort column": {
+			inSortBy: "az",
+		},
+		"invalid sort column": {
+			inSortBy:    "name",
+			wantedError: fmt.Errorf(`sort column name is invalid: value must be one of "id", "status", "health", "az", "cpu", "memory", "image", "uptime"`),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := &svcPsOpts{
+				svcPsVars: svcPsVars{
+					sortBy:     tc.inSortBy,
+					GlobalOpts: &GlobalOpts{},
+				},
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				require.Equal(t, ExitCodeValidation, ExitCode(err))
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSvcPs_Execute(t *testing.T) {
+	mockTasks := []*ecs.Task{
+		{
+			TaskArn:    aws.String("arn:aws:ecs:us-west-2:123456789012:task/my-project-test-Cluster-9F7Y0RLP60R7/4082490ee6c245e09d2145010aa1ba8d"),
+			LastStatus: aws.String("RUNNING"),
+			Cpu:        aws.String("1024"),
+		},
+		{
+			TaskArn:    aws.String("arn:aws:ecs:us-west-2:123456789012:task/my-project-test-Cluster-9F7Y0RLP60R7/1ad8bb1f2a0b45e1a6a43b1c8dbd2b4c"),
+			LastStatus: aws.String("RUNNING"),
+			Cpu:        aws.String("256"),
+		},
+	}
+	testCases := map[string]struct {
+		shouldOutputJSON bool
+		mockTasksGetter  func(m *mocks.MockrunningTasksGetter)
+
+		wantedContent string
+		wantedError   error
+	}{
+		"errors if failed to list the tasks": {
+			mockTasksGetter: func(m *mocks.MockrunningTasksGetter) {
+				m.EXPECT().RunningTasks().Return(nil, errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("list tasks of service mockSvc: some error"),
+		},
+		"writes nothing if no tasks are running": {
+			mockTasksGetter: func(m *mocks.MockrunningTasksGetter) {
+				m.EXPECT().RunningTasks().Return(nil, nil)
+			},
+		},
+		"sorted JSON output": {
+			shouldOutputJSON: true,
+			mockTasksGetter: func(m *mocks.MockrunningTasksGetter) {
+				m.EXPECT().RunningTasks().Return(mockTasks, nil)
+			},
+			wantedContent: `{"tasks":[{"id":"1ad8bb1f2a0b45e1a6a43b1c8dbd2b4c","status":"RUNNING","health":"","availabilityZone":"","cpu":"256","memory":"","startedAt":"0001-01-01T00:00:00Z"},{"id":"4082490ee6c245e09d2145010aa1ba8d","status":"RUNNING","health":"","availabilityZone":"","cpu":"1024","memory":"","startedAt":"0001-01-01T00:00:00Z"}]}
+`,
+		},
+		"sorted human output": {
+			mockTasksGetter: func(m *mocks.MockrunningTasksGetter) {
+				m.EXPECT().RunningTasks().Return(mockTasks, nil)
+			},
+			wantedContent: `ID        Status   Health  AZ  CPU   Memory  Image  Uptime
+1ad8bb1f  RUNNING  -       -   256   -       -      -
+4082490e  RUNNING  -       -   1024  -       -      -
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			b := &bytes.Buffer{}
+			mockTasksGetter := mocks.NewMockrunningTasksGetter(ctrl)
+			tc.mockTasksGetter(mockTasksGetter)
+
+			opts := &svcPsOpts{
+				svcPsVars: svcPsVars{
+					svcName:          "mockSvc",
+					envName:          "mockEnv",
+					sortBy:           "cpu",
+					shouldOutputJSON: tc.shouldOutputJSON,
+					GlobalOpts: &GlobalOpts{
+						appName: "mockApp",
+					},
+				},
+				tasksGetter:     mockTasksGetter,
+				initTasksGetter: func(*svcPsOpts) error { return nil },
+				w:               b,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedContent, b.String())
+			}
+		})
+	}
+}
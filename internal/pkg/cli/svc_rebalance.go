@@ -0,0 +1,179 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcRebalanceAppNamePrompt     = "Which application is the service in?"
+	svcRebalanceAppNameHelpPrompt = "An application groups all of your services together."
+	svcRebalanceNamePrompt        = "Which service's tasks would you like to rebalance?"
+	svcRebalanceNameHelpPrompt    = "Replaces the tasks of the service so that they are spread across availability zones."
+)
+
+type svcRebalanceVars struct {
+	*GlobalOpts
+	svcName string
+	envName string
+}
+
+type svcRebalanceOpts struct {
+	svcRebalanceVars
+
+	store       store
+	sel         deploySelector
+	arnGetter   serviceArnGetter
+	deployer    serviceDeploymentForcer
+	initClients func(*svcRebalanceOpts) error
+}
+
+func newSvcRebalanceOpts(vars svcRebalanceVars) (*svcRebalanceOpts, error) {
+	configStore, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("connect to environment datastore: %w", err)
+	}
+	deployStore, err := deploy.NewStore(configStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	return &svcRebalanceOpts{
+		svcRebalanceVars: vars,
+		store:            configStore,
+		sel:              selector.NewDeploySelect(vars.prompt, configStore, deployStore),
+		initClients: func(o *svcRebalanceOpts) error {
+			env, err := o.store.GetEnvironment(o.AppName(), o.envName)
+			if err != nil {
+				return fmt.Errorf("get environment %s: %w", o.envName, err)
+			}
+			sess, err := sessions.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
+			if err != nil {
+				return fmt.Errorf("assume role for environment %s: %w", env.Name, err)
+			}
+			d, err := describe.NewServiceStatus(&describe.NewServiceStatusConfig{
+				App:         o.AppName(),
+				Env:         o.envName,
+				Svc:         o.svcName,
+				ConfigStore: configStore,
+			})
+			if err != nil {
+				return fmt.Errorf("creating status describer for service %s in application %s: %w", o.svcName, o.AppName(), err)
+			}
+			o.arnGetter = d
+			o.deployer = ecs.New(sess)
+			return nil
+		},
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *svcRebalanceOpts) Validate() error {
+	if o.AppName() != "" {
+		if _, err := o.store.GetApplication(o.AppName()); err != nil {
+			return err
+		}
+	}
+	if o.svcName != "" {
+		if _, err := o.store.GetService(o.AppName(), o.svcName); err != nil {
+			return err
+		}
+	}
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.AppName(), o.envName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *svcRebalanceOpts) Ask() error {
+	if o.AppName() == "" {
+		app, err := o.sel.Application(svcRebalanceAppNamePrompt, svcRebalanceAppNameHelpPrompt)
+		if err != nil {
+			return fmt.Errorf("select application: %w", err)
+		}
+		o.appName = app
+	}
+	deployedService, err := o.sel.DeployedService(svcRebalanceNamePrompt, svcRebalanceNameHelpPrompt, o.AppName(), selector.WithEnv(o.envName), selector.WithSvc(o.svcName))
+	if err != nil {
+		return fmt.Errorf("select deployed services for application %s: %w", o.AppName(), err)
+	}
+	o.svcName = deployedService.Svc
+	o.envName = deployedService.Env
+	return nil
+}
+
+// Execute starts a deployment of the service that replaces its tasks, which spreads them across availability zones.
+func (o *svcRebalanceOpts) Execute() error {
+	if err := o.initClients(o); err != nil {
+		return err
+	}
+	serviceArn, err := o.arnGetter.GetServiceArn()
+	if err != nil {
+		return fmt.Errorf("get ARN of service %s: %w", o.svcName, err)
+	}
+	cluster, err := serviceArn.ClusterName()
+	if err != nil {
+		return fmt.Errorf("get cluster name: %w", err)
+	}
+	service, err := serviceArn.ServiceName()
+	if err != nil {
+		return fmt.Errorf("get service name: %w", err)
+	}
+	id, err := o.deployer.ForceNewDeployment(cluster, service)
+	if err != nil {
+		return fmt.Errorf("rebalance service %s: %w", o.svcName, err)
+	}
+	log.Successf("Started deployment %s to replace the tasks of service %s in environment %s.\n",
+		id, color.HighlightUserInput(o.svcName), color.HighlightUserInput(o.envName))
+	log.Infof("Run %s to follow the new tasks as they are spread across availability zones.\n",
+		color.HighlightCode(fmt.Sprintf("copilot svc ps -n %s -e %s", o.svcName, o.envName)))
+	return nil
+}
+
+// BuildSvcRebalanceCmd builds the command for spreading the tasks of a service across availability zones.
+func BuildSvcRebalanceCmd() *cobra.Command {
+	vars := svcRebalanceVars{
+		GlobalOpts: NewGlobalOpts(),
+	}
+	cmd := &cobra.Command{
+		Use:   "rebalance",
+		Short: "Spreads the tasks of a deployed service across availability zones.",
+		Long: `Spreads the tasks of a deployed service across availability zones.
+Starts a deployment with the same task definition that replaces the tasks of the service,
+so that they are placed again across the availability zones of the environment.`,
+
+		Example: `
+  Spreads the tasks of the service "my-svc" in the "prod" environment across availability zones.
+  /code $ copilot svc rebalance -n my-svc -e prod`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSvcRebalanceOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.svcName, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	return cmd
+}
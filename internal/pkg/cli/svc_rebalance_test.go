@@ -0,0 +1,82 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSvcRebalance_Execute(t *testing.T) {
+	mockServiceArn := ecs.ServiceArn("arn:aws:ecs:us-west-2:1234567890:service/mockCluster/mockService")
+	testCases := map[string]struct {
+		setupMocks func(arnGetter *mocks.MockserviceArnGetter, deployer *mocks.MockserviceDeploymentForcer)
+
+		wantedError error
+	}{
+		"errors if failed to get the service ARN": {
+			setupMocks: func(arnGetter *mocks.MockserviceArnGetter, deployer *mocks.MockserviceDeploymentForcer) {
+				arnGetter.EXPECT().GetServiceArn().Return(nil, errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("get ARN of service mockSvc: some error"),
+		},
+		"errors if failed to force a new deployment": {
+			setupMocks: func(arnGetter *mocks.MockserviceArnGetter, deployer *mocks.MockserviceDeploymentForcer) {
+				gomock.InOrder(
+					arnGetter.EXPECT().GetServiceArn().Return(&mockServiceArn, nil),
+					deployer.EXPECT().ForceNewDeployment("mockCluster", "mockService").Return("", errors.New("some error")),
+				)
+			},
+			wantedError: fmt.Errorf("rebalance service mockSvc: some error"),
+		},
+		"success": {
+			setupMocks: func(arnGetter *mocks.MockserviceArnGetter, deployer *mocks.MockserviceDeploymentForcer) {
+				gomock.InOrder(
+					arnGetter.EXPECT().GetServiceArn().Return(&mockServiceArn, nil),
+					deployer.EXPECT().ForceNewDeployment("mockCluster", "mockService").Return("ecs-svc/123", nil),
+				)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockArnGetter := mocks.NewMockserviceArnGetter(ctrl)
+			mockDeployer := mocks.NewMockserviceDeploymentForcer(ctrl)
+			tc.setupMocks(mockArnGetter, mockDeployer)
+
+			opts := &svcRebalanceOpts{
+				svcRebalanceVars: svcRebalanceVars{
+					svcName: "mockSvc",
+					envName: "mockEnv",
+					GlobalOpts: &GlobalOpts{
+						appName: "mockApp",
+					},
+				},
+				arnGetter:   mockArnGetter,
+				deployer:    mockDeployer,
+				initClients: func(*svcRebalanceOpts) error { return nil },
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
 	"github.com/spf13/cobra"
 )
 
@@ -28,6 +29,7 @@ type showSvcVars struct {
 	*GlobalOpts
 	shouldOutputJSON      bool
 	shouldOutputResources bool
+	noCache               bool
 	svcName               string
 }
 
@@ -36,13 +38,18 @@ type showSvcOpts struct {
 
 	w             io.Writer
 	store         store
+	ws            wsAppSvcReader
 	describer     describer
 	sel           configSelector
 	initDescriber func() error // Overriden in tests.
 }
 
 func newShowSvcOpts(vars showSvcVars) (*showSvcOpts, error) {
-	ssmStore, err := config.NewStore()
+	c, err := newLocalCache(vars.noCache)
+	if err != nil {
+		return nil, err
+	}
+	ssmStore, err := config.NewCachedStore(c)
 	if err != nil {
 		return nil, fmt.Errorf("connect to config store: %w", err)
 	}
@@ -50,10 +57,15 @@ func newShowSvcOpts(vars showSvcVars) (*showSvcOpts, error) {
 	if err != nil {
 		return nil, fmt.Errorf("connect to deploy store: %w", err)
 	}
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace: %w", err)
+	}
 
 	opts := &showSvcOpts{
 		showSvcVars: vars,
 		store:       ssmStore,
+		ws:          ws,
 		w:           log.OutputWriter,
 		sel:         selector.NewConfigSelect(vars.prompt, ssmStore),
 	}
@@ -70,9 +82,11 @@ func newShowSvcOpts(vars showSvcVars) (*showSvcOpts, error) {
 					App:         opts.AppName(),
 					Svc:         opts.svcName,
 					ConfigStore: ssmStore,
+					Cache:       c,
 				},
 				DeployStore:     deployStore,
 				EnableResources: opts.shouldOutputResources,
+				Dependencies:    workspaceDependencies(opts.ws, opts.AppName()),
 			})
 		case manifest.BackendServiceType:
 			d, err = describe.NewBackendServiceDescriber(describe.NewBackendServiceConfig{
@@ -80,9 +94,11 @@ func newShowSvcOpts(vars showSvcVars) (*showSvcOpts, error) {
 					App:         opts.AppName(),
 					Svc:         opts.svcName,
 					ConfigStore: ssmStore,
+					Cache:       c,
 				},
 				DeployStore:     deployStore,
 				EnableResources: opts.shouldOutputResources,
+				Dependencies:    workspaceDependencies(opts.ws, opts.AppName()),
 			})
 		default:
 			return fmt.Errorf("invalid service type %s", svc.Type)
@@ -204,6 +220,7 @@ func BuildSvcShowCmd() *cobra.Command {
 	// The flags bound by viper are available to all sub-commands through viper.GetString({flagName})
 	cmd.Flags().StringVarP(&vars.svcName, nameFlag, nameFlagShort, "", svcFlagDescription)
 	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	cmd.Flags().BoolVar(&vars.noCache, noCacheFlag, false, noCacheFlagDescription)
 	cmd.Flags().BoolVar(&vars.shouldOutputResources, resourcesFlag, false, svcResourcesFlagDescription)
 	return cmd
 }
@@ -11,6 +11,7 @@ import (
 
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
@@ -326,3 +327,51 @@ func TestSvcShow_Execute(t *testing.T) {
 		})
 	}
 }
+
+func TestWorkspaceDependencies(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.MockwsAppSvcReader)
+
+		wantedDeps map[string][]string
+	}{
+		"returns nil outside of a workspace": {
+			setupMocks: func(m *mocks.MockwsAppSvcReader) {
+				m.EXPECT().Summary().Return(nil, errors.New("couldn't find an application associated with this workspace"))
+			},
+		},
+		"returns nil if the workspace belongs to another application": {
+			setupMocks: func(m *mocks.MockwsAppSvcReader) {
+				m.EXPECT().Summary().Return(&workspace.Summary{Application: "other-app"}, nil)
+			},
+		},
+		"returns the dependencies of the services in the workspace": {
+			setupMocks: func(m *mocks.MockwsAppSvcReader) {
+				m.EXPECT().Summary().Return(&workspace.Summary{Application: "my-app"}, nil)
+				m.EXPECT().ServiceNames().Return([]string{"frontend", "backend"}, nil)
+				m.EXPECT().ReadServiceManifest("frontend").Return([]byte(`type: Load Balanced Web Service
+depends_on: [backend]`), nil)
+				m.EXPECT().ReadServiceManifest("backend").Return([]byte("type: Backend Service"), nil)
+			},
+			wantedDeps: map[string][]string{
+				"frontend": {"backend"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockWs := mocks.NewMockwsAppSvcReader(ctrl)
+			tc.setupMocks(mockWs)
+
+			// WHEN
+			deps := workspaceDependencies(mockWs, "my-app")
+
+			// THEN
+			require.Equal(t, tc.wantedDeps, deps)
+		})
+	}
+}
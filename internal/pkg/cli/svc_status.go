@@ -6,12 +6,21 @@ package cli
 import (
 	"fmt"
 	"io"
+	"strings"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/probe"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
 	"github.com/spf13/cobra"
 )
 
@@ -20,11 +29,21 @@ const (
 	svcStatusAppNameHelpPrompt = "An application groups all of your services together."
 	svcStatusNamePrompt        = "Which service's status would you like to show?"
 	svcStatusNameHelpPrompt    = "Displays the service's task status, most recent deployment and alarm statuses."
+
+	alarmHistoryDuration = 24 * time.Hour
+
+	// svcGreenTargetGroupLogicalID is the second target group of a Load Balanced Web Service deployed with blue/green,
+	// the tasks are registered with whichever of the two serves production traffic.
+	svcGreenTargetGroupLogicalID = "GreenTargetGroup"
 )
 
 type svcStatusVars struct {
 	*GlobalOpts
 	shouldOutputJSON bool
+	shouldProbe      bool
+	showAlarms       bool
+	showStoppedTasks bool
+	noCache          bool
 	svcName          string
 	envName          string
 }
@@ -34,13 +53,24 @@ type svcStatusOpts struct {
 
 	w                   io.Writer
 	store               store
+	ws                  wsAppSvcReader
 	statusDescriber     statusDescriber
+	uriDescriber        webSvcURIDescriber
+	prober              prober
+	svcResources        svcStackResourcesDescriber
+	targetHealth        targetsHealthDescriber
 	sel                 deploySelector
 	initStatusDescriber func(*svcStatusOpts) error
+	initURIDescriber    func(*svcStatusOpts) error
+	initTargetHealth    func(*svcStatusOpts) error
 }
 
 func newSvcStatusOpts(vars svcStatusVars) (*svcStatusOpts, error) {
-	configStore, err := config.NewStore()
+	c, err := newLocalCache(vars.noCache)
+	if err != nil {
+		return nil, err
+	}
+	configStore, err := config.NewCachedStore(c)
 	if err != nil {
 		return nil, fmt.Errorf("connect to environment datastore: %w", err)
 	}
@@ -48,9 +78,14 @@ func newSvcStatusOpts(vars svcStatusVars) (*svcStatusOpts, error) {
 	if err != nil {
 		return nil, fmt.Errorf("connect to deploy store: %w", err)
 	}
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace: %w", err)
+	}
 	return &svcStatusOpts{
 		svcStatusVars: vars,
 		store:         configStore,
+		ws:            ws,
 		w:             log.OutputWriter,
 		sel:           selector.NewDeploySelect(vars.prompt, configStore, deployStore),
 		initStatusDescriber: func(o *svcStatusOpts) error {
@@ -58,6 +93,7 @@ func newSvcStatusOpts(vars svcStatusVars) (*svcStatusOpts, error) {
 				App:         o.AppName(),
 				Env:         o.envName,
 				Svc:         o.svcName,
+				AlarmNames:  workspaceAlarmNames(o.ws, o.AppName(), o.svcName),
 				ConfigStore: configStore,
 			})
 			if err != nil {
@@ -66,6 +102,45 @@ func newSvcStatusOpts(vars svcStatusVars) (*svcStatusOpts, error) {
 			o.statusDescriber = d
 			return nil
 		},
+		initURIDescriber: func(o *svcStatusOpts) error {
+			d, err := describe.NewWebServiceDescriber(describe.NewWebServiceConfig{
+				NewServiceConfig: describe.NewServiceConfig{
+					App:         o.AppName(),
+					Svc:         o.svcName,
+					ConfigStore: configStore,
+					Cache:       c,
+				},
+				DeployStore: deployStore,
+			})
+			if err != nil {
+				return fmt.Errorf("creating describer for service %s in application %s: %w", o.svcName, o.AppName(), err)
+			}
+			o.uriDescriber = d
+			return nil
+		},
+		initTargetHealth: func(o *svcStatusOpts) error {
+			env, err := configStore.GetEnvironment(o.AppName(), o.envName)
+			if err != nil {
+				return fmt.Errorf("get environment %s: %w", o.envName, err)
+			}
+			sess, err := sessions.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
+			if err != nil {
+				return err
+			}
+			d, err := describe.NewServiceDescriber(describe.NewServiceConfig{
+				App:         o.AppName(),
+				Env:         o.envName,
+				Svc:         o.svcName,
+				ConfigStore: configStore,
+			})
+			if err != nil {
+				return fmt.Errorf("creating describer for service %s in application %s: %w", o.svcName, o.AppName(), err)
+			}
+			o.svcResources = d
+			o.targetHealth = elbv2.New(sess)
+			return nil
+		},
+		prober: probe.New(),
 	}, nil
 }
 
@@ -107,6 +182,33 @@ func (o *svcStatusOpts) Execute() error {
 	if err != nil {
 		return fmt.Errorf("describe status of service %s: %w", o.svcName, err)
 	}
+	scaling, err := o.statusDescriber.Autoscaling()
+	if err != nil {
+		return fmt.Errorf("get autoscaling of service %s: %w", o.svcName, err)
+	}
+	svcStatus.Autoscaling = scaling
+	if o.shouldProbe {
+		probes, targets, err := o.probeEndpoints()
+		if err != nil {
+			return err
+		}
+		svcStatus.Probes = probes
+		svcStatus.Targets = targets
+	}
+	if o.showAlarms {
+		history, err := o.statusDescriber.AlarmHistory(svcStatus.Alarms, time.Now().Add(-alarmHistoryDuration))
+		if err != nil {
+			return fmt.Errorf("get alarm history of service %s: %w", o.svcName, err)
+		}
+		svcStatus.AlarmHistory = history
+	}
+	if o.showStoppedTasks {
+		stopped, err := o.statusDescriber.StoppedTaskStatuses()
+		if err != nil {
+			return fmt.Errorf("get stopped tasks of service %s: %w", o.svcName, err)
+		}
+		svcStatus.StoppedTasks = stopped
+	}
 	if o.shouldOutputJSON {
 		data, err := svcStatus.JSONString()
 		if err != nil {
@@ -115,11 +217,78 @@ func (o *svcStatusOpts) Execute() error {
 		fmt.Fprintf(o.w, data)
 	} else {
 		fmt.Fprintf(o.w, svcStatus.HumanString())
+		if zone := svcStatus.PackedAZ(); zone != "" {
+			log.Warningf("All running tasks of service %s are in availability zone %s, so an outage of the zone would stop the service.\n", o.svcName, zone)
+			log.Infof("Run %s to spread them across availability zones.\n", color.HighlightCode(fmt.Sprintf("copilot svc rebalance -n %s -e %s", o.svcName, o.envName)))
+		}
 	}
 
 	return nil
 }
 
+// probeEndpoints sends requests to the public endpoint and the health check path of a load balanced web service.
+// Unlike the ECS health checks, the requests go through DNS and TLS so misconfigured records or certificates are surfaced.
+// It also returns the health of each task registered with the load balancer.
+func (o *svcStatusOpts) probeEndpoints() ([]probe.Result, []*elbv2.TargetHealth, error) {
+	svc, err := o.store.GetService(o.AppName(), o.svcName)
+	if err != nil {
+		return nil, nil, fmt.Errorf("get service %s: %w", o.svcName, err)
+	}
+	if svc.Type != manifest.LoadBalancedWebServiceType {
+		log.Warningf("Skipping endpoint probes since service %s is not a %s.\n", o.svcName, manifest.LoadBalancedWebServiceType)
+		return nil, nil, nil
+	}
+	if err := o.initURIDescriber(o); err != nil {
+		return nil, nil, err
+	}
+	uri, err := o.uriDescriber.URI(o.envName)
+	if err != nil {
+		return nil, nil, fmt.Errorf("get uri of service %s in environment %s: %w", o.svcName, o.envName, err)
+	}
+	healthCheckURI, err := o.uriDescriber.HealthCheckURI(o.envName)
+	if err != nil {
+		return nil, nil, fmt.Errorf("get health check uri of service %s in environment %s: %w", o.svcName, o.envName, err)
+	}
+	urls := []string{uri}
+	if strings.TrimSuffix(healthCheckURI, "/") != strings.TrimSuffix(uri, "/") {
+		urls = append(urls, healthCheckURI)
+	}
+	var results []probe.Result
+	for _, url := range urls {
+		results = append(results, o.prober.Probe(url))
+	}
+	targets, err := o.registeredTargetsHealth()
+	if err != nil {
+		return nil, nil, err
+	}
+	return results, targets, nil
+}
+
+// registeredTargetsHealth returns the health of the service's tasks as seen by the public load balancer.
+func (o *svcStatusOpts) registeredTargetsHealth() ([]*elbv2.TargetHealth, error) {
+	if err := o.initTargetHealth(o); err != nil {
+		return nil, err
+	}
+	resources, err := o.svcResources.ServiceStackResources()
+	if err != nil {
+		return nil, fmt.Errorf("get resources of service %s: %w", o.svcName, err)
+	}
+	var targets []*elbv2.TargetHealth
+	for _, resource := range resources {
+		switch aws.StringValue(resource.LogicalResourceId) {
+		case svcTargetGroupLogicalID, svcGreenTargetGroupLogicalID:
+		default:
+			continue
+		}
+		health, err := o.targetHealth.TargetsHealth(aws.StringValue(resource.PhysicalResourceId))
+		if err != nil {
+			return nil, fmt.Errorf("get health of the targets of service %s: %w", o.svcName, err)
+		}
+		targets = append(targets, health...)
+	}
+	return targets, nil
+}
+
 func (o *svcStatusOpts) askApp() error {
 	if o.AppName() != "" {
 		return nil
@@ -154,7 +323,13 @@ func BuildSvcStatusCmd() *cobra.Command {
 
 		Example: `
   Shows status of the deployed service "my-svc"
-  /code $ copilot svc status -n my-svc`,
+  /code $ copilot svc status -n my-svc
+  Shows status of "my-svc" in the "test" environment along with the response of its public endpoints
+  /code $ copilot svc status -n my-svc -e test --probe
+  Shows status of "my-svc" in the "test" environment along with the recent state changes of its alarms
+  /code $ copilot svc status -n my-svc -e test --alarms
+  Shows status of "my-svc" in the "test" environment along with why its tasks stopped in the last hour
+  /code $ copilot svc status -n my-svc -e test --stopped-tasks`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newSvcStatusOpts(vars)
 			if err != nil {
@@ -173,5 +348,9 @@ func BuildSvcStatusCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.svcName, nameFlag, nameFlagShort, "", svcFlagDescription)
 	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
 	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	cmd.Flags().BoolVar(&vars.noCache, noCacheFlag, false, noCacheFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldProbe, probeFlag, false, probeFlagDescription)
+	cmd.Flags().BoolVar(&vars.showAlarms, alarmsFlag, false, alarmsFlagDescription)
+	cmd.Flags().BoolVar(&vars.showStoppedTasks, stoppedTasksFlag, false, stoppedTasksFlagDescription)
 	return cmd
 }
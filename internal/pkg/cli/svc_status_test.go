@@ -9,10 +9,17 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
+	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/probe"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
@@ -191,6 +198,8 @@ func TestSvcStatus_Execute(t *testing.T) {
 	mockServiceStatus := &describe.ServiceStatusDesc{}
 	testCases := map[string]struct {
 		shouldOutputJSON    bool
+		showAlarms          bool
+		showStoppedTasks    bool
 		mockStatusDescriber func(m *mocks.MockstatusDescriber)
 		wantedError         error
 	}{
@@ -200,16 +209,70 @@ func TestSvcStatus_Execute(t *testing.T) {
 			},
 			wantedError: fmt.Errorf("describe status of service mockSvc: some error"),
 		},
+		"errors if failed to get the autoscaling of the service": {
+			mockStatusDescriber: func(m *mocks.MockstatusDescriber) {
+				m.EXPECT().Describe().Return(&describe.ServiceStatusDesc{}, nil)
+				m.EXPECT().Autoscaling().Return(nil, mockError)
+			},
+			wantedError: fmt.Errorf("get autoscaling of service mockSvc: some error"),
+		},
 		"success with JSON output": {
 			shouldOutputJSON: true,
 
 			mockStatusDescriber: func(m *mocks.MockstatusDescriber) {
 				m.EXPECT().Describe().Return(mockServiceStatus, nil)
+				m.EXPECT().Autoscaling().Return(nil, nil)
 			},
 		},
 		"success with HumanString": {
 			mockStatusDescriber: func(m *mocks.MockstatusDescriber) {
 				m.EXPECT().Describe().Return(mockServiceStatus, nil)
+				m.EXPECT().Autoscaling().Return(nil, nil)
+			},
+		},
+		"errors if failed to get the alarm history": {
+			showAlarms: true,
+
+			mockStatusDescriber: func(m *mocks.MockstatusDescriber) {
+				m.EXPECT().Describe().Return(&describe.ServiceStatusDesc{}, nil)
+				m.EXPECT().Autoscaling().Return(nil, nil)
+				m.EXPECT().AlarmHistory(nil, gomock.Any()).Return(nil, mockError)
+			},
+			wantedError: fmt.Errorf("get alarm history of service mockSvc: some error"),
+		},
+		"success with alarm history": {
+			showAlarms: true,
+
+			mockStatusDescriber: func(m *mocks.MockstatusDescriber) {
+				m.EXPECT().Describe().Return(&describe.ServiceStatusDesc{}, nil)
+				m.EXPECT().Autoscaling().Return(nil, nil)
+				m.EXPECT().AlarmHistory(nil, gomock.Any()).Return(nil, nil)
+			},
+		},
+		"errors if failed to get the stopped tasks": {
+			showStoppedTasks: true,
+
+			mockStatusDescriber: func(m *mocks.MockstatusDescriber) {
+				m.EXPECT().Describe().Return(&describe.ServiceStatusDesc{}, nil)
+				m.EXPECT().Autoscaling().Return(nil, nil)
+				m.EXPECT().StoppedTaskStatuses().Return(nil, mockError)
+			},
+			wantedError: fmt.Errorf("get stopped tasks of service mockSvc: some error"),
+		},
+		"success with stopped tasks": {
+			showStoppedTasks: true,
+
+			mockStatusDescriber: func(m *mocks.MockstatusDescriber) {
+				m.EXPECT().Describe().Return(&describe.ServiceStatusDesc{}, nil)
+				m.EXPECT().Autoscaling().Return(nil, nil)
+				m.EXPECT().StoppedTaskStatuses().Return([]ecs.TaskStatus{
+					{
+						ID:            "1234567890123456789",
+						LastStatus:    "STOPPED",
+						StoppedReason: "Essential container in task exited",
+						ExitCodes:     map[string]int64{"frontend": 1},
+					},
+				}, nil)
 			},
 		},
 	}
@@ -228,6 +291,8 @@ func TestSvcStatus_Execute(t *testing.T) {
 					svcName:          "mockSvc",
 					envName:          "mockEnv",
 					shouldOutputJSON: tc.shouldOutputJSON,
+					showAlarms:       tc.showAlarms,
+					showStoppedTasks: tc.showStoppedTasks,
 					GlobalOpts: &GlobalOpts{
 						appName: "mockApp",
 					},
@@ -250,3 +315,255 @@ func TestSvcStatus_Execute(t *testing.T) {
 		})
 	}
 }
+
+func TestSvcStatus_ExecuteWithProbe(t *testing.T) {
+	const (
+		testURI            = "https://frontend.test.phonetool.com"
+		testHealthCheckURI = "https://frontend.test.phonetool.com/healthz"
+	)
+	mockError := errors.New("some error")
+	testResources := []*sdkcloudformation.StackResource{
+		{
+			LogicalResourceId:  aws.String("Service"),
+			PhysicalResourceId: aws.String("arn:aws:ecs:us-west-2:123456789012:service/frontend"),
+		},
+		{
+			LogicalResourceId:  aws.String("TargetGroup"),
+			PhysicalResourceId: aws.String("arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/blue/1"),
+		},
+		{
+			LogicalResourceId:  aws.String("GreenTargetGroup"),
+			PhysicalResourceId: aws.String("arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/green/2"),
+		},
+	}
+	testCases := map[string]struct {
+		setupMocks func(m svcStatusProbeMocks)
+
+		wantedProbes  []probe.Result
+		wantedTargets []*elbv2.TargetHealth
+		wantedError   error
+	}{
+		"probes the public endpoint and the health check path": {
+			setupMocks: func(m svcStatusProbeMocks) {
+				m.store.EXPECT().GetService("mockApp", "mockSvc").Return(&config.Service{
+					Type: manifest.LoadBalancedWebServiceType,
+				}, nil)
+				m.uriDescriber.EXPECT().URI("mockEnv").Return(testURI, nil)
+				m.uriDescriber.EXPECT().HealthCheckURI("mockEnv").Return(testHealthCheckURI, nil)
+				m.prober.EXPECT().Probe(testURI).Return(probe.Result{URL: testURI, StatusCode: 200, LatencyMs: 12})
+				m.prober.EXPECT().Probe(testHealthCheckURI).Return(probe.Result{URL: testHealthCheckURI, StatusCode: 503, LatencyMs: 8})
+				m.svcResources.EXPECT().ServiceStackResources().Return(testResources[:2], nil)
+				m.targetHealth.EXPECT().TargetsHealth("arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/blue/1").Return([]*elbv2.TargetHealth{
+					{Target: elbv2.Target{ID: "10.0.0.12", Port: 80}, State: "healthy"},
+					{Target: elbv2.Target{ID: "10.0.1.34", Port: 80}, State: "unhealthy", Reason: "Target.ResponseCodeMismatch", Description: "Health checks failed with these codes: [503]"},
+				}, nil)
+			},
+			wantedProbes: []probe.Result{
+				{URL: testURI, StatusCode: 200, LatencyMs: 12},
+				{URL: testHealthCheckURI, StatusCode: 503, LatencyMs: 8},
+			},
+			wantedTargets: []*elbv2.TargetHealth{
+				{Target: elbv2.Target{ID: "10.0.0.12", Port: 80}, State: "healthy"},
+				{Target: elbv2.Target{ID: "10.0.1.34", Port: 80}, State: "unhealthy", Reason: "Target.ResponseCodeMismatch", Description: "Health checks failed with these codes: [503]"},
+			},
+		},
+		"reports the targets of both target groups of a blue/green service": {
+			setupMocks: func(m svcStatusProbeMocks) {
+				m.store.EXPECT().GetService("mockApp", "mockSvc").Return(&config.Service{
+					Type: manifest.LoadBalancedWebServiceType,
+				}, nil)
+				m.uriDescriber.EXPECT().URI("mockEnv").Return(testURI, nil)
+				m.uriDescriber.EXPECT().HealthCheckURI("mockEnv").Return(testURI, nil)
+				m.prober.EXPECT().Probe(testURI).Return(probe.Result{URL: testURI, StatusCode: 200, LatencyMs: 12})
+				m.svcResources.EXPECT().ServiceStackResources().Return(testResources, nil)
+				m.targetHealth.EXPECT().TargetsHealth("arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/blue/1").Return(nil, nil)
+				m.targetHealth.EXPECT().TargetsHealth("arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/green/2").Return([]*elbv2.TargetHealth{
+					{Target: elbv2.Target{ID: "10.0.0.56", Port: 80}, State: "initial"},
+				}, nil)
+			},
+			wantedProbes: []probe.Result{
+				{URL: testURI, StatusCode: 200, LatencyMs: 12},
+			},
+			wantedTargets: []*elbv2.TargetHealth{
+				{Target: elbv2.Target{ID: "10.0.0.56", Port: 80}, State: "initial"},
+			},
+		},
+		"errors if failed to get the resources of the service": {
+			setupMocks: func(m svcStatusProbeMocks) {
+				m.store.EXPECT().GetService("mockApp", "mockSvc").Return(&config.Service{
+					Type: manifest.LoadBalancedWebServiceType,
+				}, nil)
+				m.uriDescriber.EXPECT().URI("mockEnv").Return(testURI, nil)
+				m.uriDescriber.EXPECT().HealthCheckURI("mockEnv").Return(testURI, nil)
+				m.prober.EXPECT().Probe(testURI).Return(probe.Result{URL: testURI, StatusCode: 200, LatencyMs: 12})
+				m.svcResources.EXPECT().ServiceStackResources().Return(nil, mockError)
+			},
+			wantedError: fmt.Errorf("get resources of service mockSvc: some error"),
+		},
+		"errors if failed to get the health of the targets": {
+			setupMocks: func(m svcStatusProbeMocks) {
+				m.store.EXPECT().GetService("mockApp", "mockSvc").Return(&config.Service{
+					Type: manifest.LoadBalancedWebServiceType,
+				}, nil)
+				m.uriDescriber.EXPECT().URI("mockEnv").Return(testURI, nil)
+				m.uriDescriber.EXPECT().HealthCheckURI("mockEnv").Return(testURI, nil)
+				m.prober.EXPECT().Probe(testURI).Return(probe.Result{URL: testURI, StatusCode: 200, LatencyMs: 12})
+				m.svcResources.EXPECT().ServiceStackResources().Return(testResources[:2], nil)
+				m.targetHealth.EXPECT().TargetsHealth(gomock.Any()).Return(nil, mockError)
+			},
+			wantedError: fmt.Errorf("get health of the targets of service mockSvc: some error"),
+		},
+		"probes the endpoint once if the health check path is the root": {
+			setupMocks: func(m svcStatusProbeMocks) {
+				m.store.EXPECT().GetService("mockApp", "mockSvc").Return(&config.Service{
+					Type: manifest.LoadBalancedWebServiceType,
+				}, nil)
+				m.uriDescriber.EXPECT().URI("mockEnv").Return(testURI, nil)
+				m.uriDescriber.EXPECT().HealthCheckURI("mockEnv").Return(testURI+"/", nil)
+				m.prober.EXPECT().Probe(testURI).Return(probe.Result{URL: testURI, Error: "x509: certificate has expired"})
+				m.svcResources.EXPECT().ServiceStackResources().Return(nil, nil)
+			},
+			wantedProbes: []probe.Result{
+				{URL: testURI, Error: "x509: certificate has expired"},
+			},
+		},
+		"skips probing services that are not load balanced": {
+			setupMocks: func(m svcStatusProbeMocks) {
+				m.store.EXPECT().GetService("mockApp", "mockSvc").Return(&config.Service{
+					Type: manifest.BackendServiceType,
+				}, nil)
+			},
+		},
+		"errors if failed to get the uri of the service": {
+			setupMocks: func(m svcStatusProbeMocks) {
+				m.store.EXPECT().GetService("mockApp", "mockSvc").Return(&config.Service{
+					Type: manifest.LoadBalancedWebServiceType,
+				}, nil)
+				m.uriDescriber.EXPECT().URI("mockEnv").Return("", mockError)
+			},
+			wantedError: fmt.Errorf("get uri of service mockSvc in environment mockEnv: some error"),
+		},
+		"errors if failed to get the health check uri of the service": {
+			setupMocks: func(m svcStatusProbeMocks) {
+				m.store.EXPECT().GetService("mockApp", "mockSvc").Return(&config.Service{
+					Type: manifest.LoadBalancedWebServiceType,
+				}, nil)
+				m.uriDescriber.EXPECT().URI("mockEnv").Return(testURI, nil)
+				m.uriDescriber.EXPECT().HealthCheckURI("mockEnv").Return("", mockError)
+			},
+			wantedError: fmt.Errorf("get health check uri of service mockSvc in environment mockEnv: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := svcStatusProbeMocks{
+				store:        mocks.NewMockstore(ctrl),
+				uriDescriber: mocks.NewMockwebSvcURIDescriber(ctrl),
+				prober:       mocks.NewMockprober(ctrl),
+				svcResources: mocks.NewMocksvcStackResourcesDescriber(ctrl),
+				targetHealth: mocks.NewMocktargetsHealthDescriber(ctrl),
+			}
+			tc.setupMocks(m)
+			status := &describe.ServiceStatusDesc{}
+			mockStatusDescriber := mocks.NewMockstatusDescriber(ctrl)
+			mockStatusDescriber.EXPECT().Describe().Return(status, nil)
+			mockStatusDescriber.EXPECT().Autoscaling().Return(nil, nil)
+
+			svcStatus := &svcStatusOpts{
+				svcStatusVars: svcStatusVars{
+					svcName:     "mockSvc",
+					envName:     "mockEnv",
+					shouldProbe: true,
+					GlobalOpts: &GlobalOpts{
+						appName: "mockApp",
+					},
+				},
+				store:               m.store,
+				statusDescriber:     mockStatusDescriber,
+				prober:              m.prober,
+				initStatusDescriber: func(*svcStatusOpts) error { return nil },
+				initURIDescriber: func(o *svcStatusOpts) error {
+					o.uriDescriber = m.uriDescriber
+					return nil
+				},
+				initTargetHealth: func(o *svcStatusOpts) error {
+					o.svcResources = m.svcResources
+					o.targetHealth = m.targetHealth
+					return nil
+				},
+				w: &bytes.Buffer{},
+			}
+
+			// WHEN
+			err := svcStatus.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedProbes, status.Probes)
+			require.Equal(t, tc.wantedTargets, status.Targets)
+		})
+	}
+}
+
+type svcStatusProbeMocks struct {
+	store        *mocks.Mockstore
+	uriDescriber *mocks.MockwebSvcURIDescriber
+	prober       *mocks.Mockprober
+	svcResources *mocks.MocksvcStackResourcesDescriber
+	targetHealth *mocks.MocktargetsHealthDescriber
+}
+
+func TestWorkspaceAlarmNames(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.MockwsAppSvcReader)
+
+		wantedNames []string
+	}{
+		"returns nil outside of a workspace": {
+			setupMocks: func(m *mocks.MockwsAppSvcReader) {
+				m.EXPECT().Summary().Return(nil, errors.New("couldn't find an application associated with this workspace"))
+			},
+		},
+		"returns nil if the service is not in the workspace": {
+			setupMocks: func(m *mocks.MockwsAppSvcReader) {
+				m.EXPECT().Summary().Return(&workspace.Summary{Application: "my-app"}, nil)
+				m.EXPECT().ServiceNames().Return([]string{"backend"}, nil)
+			},
+		},
+		"returns the alarms in the manifest of the service": {
+			setupMocks: func(m *mocks.MockwsAppSvcReader) {
+				m.EXPECT().Summary().Return(&workspace.Summary{Application: "my-app"}, nil)
+				m.EXPECT().ServiceNames().Return([]string{"frontend", "backend"}, nil)
+				m.EXPECT().ReadServiceManifest("frontend").Return([]byte(`type: Load Balanced Web Service
+alarms: [frontend-high-latency]`), nil)
+			},
+			wantedNames: []string{"frontend-high-latency"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockWs := mocks.NewMockwsAppSvcReader(ctrl)
+			tc.setupMocks(mockWs)
+
+			// WHEN
+			names := workspaceAlarmNames(mockWs, "my-app", "frontend")
+
+			// THEN
+			require.Equal(t, tc.wantedNames, names)
+		})
+	}
+}
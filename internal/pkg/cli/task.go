@@ -4,8 +4,15 @@
 package cli
 
 import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/copilot-cli/cmd/copilot/template"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/cli/group"
+	"github.com/aws/copilot-cli/internal/pkg/task"
 	"github.com/spf13/cobra"
 )
 
@@ -18,6 +25,10 @@ One-off Amazon ECS tasks that terminate once their work is done.`,
 	}
 
 	cmd.AddCommand(BuildTaskRunCmd())
+	cmd.AddCommand(BuildTaskListCmd())
+	cmd.AddCommand(BuildTaskLogsCmd())
+	cmd.AddCommand(BuildTaskStopCmd())
+	cmd.AddCommand(BuildTaskExecCmd())
 
 	cmd.SetUsageTemplate(template.Usage)
 	cmd.Annotations = map[string]string{
@@ -25,3 +36,48 @@ One-off Amazon ECS tasks that terminate once their work is done.`,
 	}
 	return cmd
 }
+
+// validateTaskEnv returns an error if the environment that one-off tasks run in doesn't exist.
+// Tasks that don't run in an environment run in the default cluster.
+func validateTaskEnv(store store, app, env string) error {
+	if env == "" {
+		return nil
+	}
+	if app == "" {
+		return errNoAppInWorkspace
+	}
+	if _, err := store.GetEnvironment(app, env); err != nil {
+		return fmt.Errorf("get environment %s config: %w", env, err)
+	}
+	return nil
+}
+
+// taskCluster returns a session and the ARN of the cluster that the one-off tasks run in.
+// If env is empty, it's the default cluster of the default session.
+func taskCluster(store store, app, env string) (*session.Session, string, error) {
+	provider := sessions.NewProvider()
+	if env == "" {
+		sess, err := provider.Default()
+		if err != nil {
+			return nil, "", fmt.Errorf("get default session: %w", err)
+		}
+		cluster, err := ecs.New(sess).DefaultCluster()
+		if err != nil {
+			return nil, "", err
+		}
+		return sess, cluster, nil
+	}
+	e, err := store.GetEnvironment(app, env)
+	if err != nil {
+		return nil, "", fmt.Errorf("get environment %s config: %w", env, err)
+	}
+	sess, err := provider.FromRole(e.ManagerRoleARN, e.Region)
+	if err != nil {
+		return nil, "", fmt.Errorf("get session from role %s and region %s: %w", e.ManagerRoleARN, e.Region, err)
+	}
+	cluster, err := task.EnvCluster(resourcegroups.New(sess), app, env)
+	if err != nil {
+		return nil, "", err
+	}
+	return sess, cluster, nil
+}
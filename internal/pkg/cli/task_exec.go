@@ -0,0 +1,219 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/task"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/command"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/spf13/cobra"
+)
+
+var (
+	taskExecGroupNamePrompt     = fmt.Sprintf("Which %s would you like to exec into?", color.Emphasize("task group"))
+	taskExecGroupNameHelpPrompt = "The group name of the tasks started with copilot task run."
+	taskExecTaskPrompt          = "Which task would you like to exec into?"
+	taskExecTaskHelpPrompt      = "The running tasks of the task group."
+)
+
+type execTaskVars struct {
+	*GlobalOpts
+	env       string
+	groupName string
+	taskID    string
+	command   string
+}
+
+type execTaskOpts struct {
+	execTaskVars
+
+	store    store
+	runner   runner
+	lookPath func(file string) (string, error)
+
+	// Fields below are configured at runtime.
+	cluster     string
+	region      string
+	ssmEndpoint string
+	lister      runningTasksLister
+	executer    taskExecuter
+	initClients func() error // Overriden in tests.
+}
+
+func newExecTaskOpts(vars execTaskVars) (*execTaskOpts, error) {
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("new config store: %w", err)
+	}
+	opts := &execTaskOpts{
+		execTaskVars: vars,
+		store:        store,
+		runner:       command.New(),
+		lookPath:     exec.LookPath,
+	}
+	opts.initClients = func() error {
+		sess, cluster, err := taskCluster(opts.store, opts.AppName(), opts.env)
+		if err != nil {
+			return err
+		}
+		client := ecs.New(sess)
+		opts.cluster = cluster
+		opts.region = aws.StringValue(sess.Config.Region)
+		opts.ssmEndpoint = ssmEndpoint(sess)
+		opts.lister = client
+		opts.executer = client
+		return nil
+	}
+	return opts, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *execTaskOpts) Validate() error {
+	if o.groupName != "" {
+		if err := basicNameValidation(o.groupName); err != nil {
+			return err
+		}
+	}
+	if err := validateTaskEnv(o.store, o.AppName(), o.env); err != nil {
+		return err
+	}
+	if _, err := o.lookPath(ssmPluginBinaryName); err != nil {
+		return errSSMPluginNotInstalled
+	}
+	return nil
+}
+
+// Ask prompts for the task group if it's not provided.
+func (o *execTaskOpts) Ask() error {
+	if o.groupName != "" {
+		return nil
+	}
+	name, err := o.prompt.Get(taskExecGroupNamePrompt, taskExecGroupNameHelpPrompt, basicNameValidation)
+	if err != nil {
+		return fmt.Errorf("get task group name: %w", err)
+	}
+	o.groupName = name
+	return nil
+}
+
+// Execute opens an interactive session into the container of a running task of the task group.
+func (o *execTaskOpts) Execute() error {
+	if err := o.initClients(); err != nil {
+		return err
+	}
+	tasks, err := task.RunningTasks(o.lister, o.cluster, o.groupName)
+	if err != nil {
+		return fmt.Errorf("list running tasks of group %s: %w", o.groupName, err)
+	}
+	if len(tasks) == 0 {
+		return fmt.Errorf("no running tasks found in group %s", o.groupName)
+	}
+	taskARN, err := o.selectTask(tasks)
+	if err != nil {
+		return err
+	}
+	described, err := o.executer.DescribeTasks(o.cluster, []string{taskARN})
+	if err != nil {
+		return fmt.Errorf("describe task %s: %w", shortTaskID(taskARN), err)
+	}
+	if len(described) == 0 {
+		return fmt.Errorf("task %s not found", shortTaskID(taskARN))
+	}
+	// The container of a one-off task is named after its task group.
+	container := o.groupName
+	runtimeID, err := containerRuntimeID(described[0], container)
+	if err != nil {
+		return err
+	}
+	taskID := taskIDFromARN(taskARN)
+	session, err := o.executer.ExecuteCommand(ecs.ExecuteCommandInput{
+		Cluster:   o.cluster,
+		Task:      taskID,
+		Container: container,
+		Command:   o.command,
+	})
+	if err != nil {
+		log.Infof("Tasks started by an earlier version of %s don't have ECS Exec enabled, run them again to exec into them.\n",
+			color.HighlightCode("copilot task run"))
+		return err
+	}
+	log.Successf("Starting a session into task %s of group %s.\n", color.HighlightResource(shortTaskID(taskARN)), color.HighlightUserInput(o.groupName))
+	clusterName := o.cluster[strings.LastIndex(o.cluster, "/")+1:]
+	return startExecSession(o.runner, o.region, o.ssmEndpoint, session, fmt.Sprintf(fmtExecuteCommandTarget, clusterName, taskID, runtimeID))
+}
+
+// selectTask returns the ARN of the task matching the task ID flag, or prompts for one if the group has several tasks.
+func (o *execTaskOpts) selectTask(tasks []*task.Task) (string, error) {
+	if o.taskID != "" {
+		for _, t := range tasks {
+			if strings.HasPrefix(taskIDFromARN(t.TaskARN), o.taskID) {
+				return t.TaskARN, nil
+			}
+		}
+		return "", fmt.Errorf("no running task found with ID %s in group %s", o.taskID, o.groupName)
+	}
+	if len(tasks) == 1 {
+		return tasks[0].TaskARN, nil
+	}
+	ids := make([]string, len(tasks))
+	for i, t := range tasks {
+		ids[i] = shortTaskID(t.TaskARN)
+	}
+	id, err := o.prompt.SelectOne(taskExecTaskPrompt, taskExecTaskHelpPrompt, ids, prompt.WithFinalMessage("Task:"))
+	if err != nil {
+		return "", fmt.Errorf("select task: %w", err)
+	}
+	for i, t := range tasks {
+		if ids[i] == id {
+			return t.TaskARN, nil
+		}
+	}
+	return "", fmt.Errorf("task %s not found", id)
+}
+
+// BuildTaskExecCmd builds the command for opening an interactive session into a running one-off task.
+func BuildTaskExecCmd() *cobra.Command {
+	vars := execTaskVars{
+		GlobalOpts: NewGlobalOpts(),
+	}
+	cmd := &cobra.Command{
+		Use:   "exec",
+		Short: "Opens an interactive session into a running one-off task.",
+		Long: `Opens an interactive session into a running one-off task with ECS Exec.
+Requires the Session Manager plugin for the AWS CLI.`,
+		Example: `
+  Opens a shell into a running task of the "db-migrate" task group in the "default" cluster.
+  /code $ copilot task exec -n db-migrate
+  Runs "ps aux" in a specific task of the group in the "test" environment.
+  /code $ copilot task exec -n db-migrate --env test --task-id 8c38184 --command "ps aux"`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newExecTaskOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.groupName, taskGroupNameFlag, nameFlagShort, "", taskTargetGroupFlagDescription)
+	cmd.Flags().StringVar(&vars.appName, appFlag, "", appFlagDescription)
+	cmd.Flags().StringVar(&vars.env, envFlag, "", taskTargetEnvFlagDescription)
+	cmd.Flags().StringVar(&vars.taskID, taskIDFlag, "", taskExecTaskIDFlagDescription)
+	cmd.Flags().StringVar(&vars.command, commandFlag, defaultExecCommand, execCommandFlagDescription)
+	return cmd
+}
@@ -0,0 +1,207 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	sdkecs "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type execTaskMocks struct {
+	prompt   *mocks.Mockprompter
+	lister   *mocks.MockrunningTasksLister
+	executer *mocks.MocktaskExecuter
+	runner   *mocks.Mockrunner
+}
+
+func TestExecTaskOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inGroupName string
+		lookPathErr error
+
+		wantedErr error
+	}{
+		"errors if the group name is invalid": {
+			inGroupName: "db migrate",
+			wantedErr:   errValueBadFormat,
+		},
+		"errors if the Session Manager plugin is not installed": {
+			inGroupName: "db-migrate",
+			lookPathErr: errors.New("executable file not found in $PATH"),
+			wantedErr:   errSSMPluginNotInstalled,
+		},
+		"valid": {
+			inGroupName: "db-migrate",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			opts := &execTaskOpts{
+				execTaskVars: execTaskVars{
+					groupName:  tc.inGroupName,
+					GlobalOpts: &GlobalOpts{},
+				},
+				lookPath: func(string) (string, error) {
+					return "", tc.lookPathErr
+				},
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestExecTaskOpts_Execute(t *testing.T) {
+	const testCluster = "arn:aws:ecs:us-west-2:1234567890:cluster/phonetool-test-Cluster"
+	taskARN := func(id string) string {
+		return "arn:aws:ecs:us-west-2:1234567890:task/phonetool-test-Cluster/" + id
+	}
+	runningTask := func(id string) *ecs.Task {
+		return &ecs.Task{
+			TaskArn:           aws.String(taskARN(id)),
+			TaskDefinitionArn: aws.String("arn:aws:ecs:us-west-2:1234567890:task-definition/copilot-db-migrate:3"),
+		}
+	}
+	describedTask := func(id string) *ecs.Task {
+		return &ecs.Task{
+			TaskArn: aws.String(taskARN(id)),
+			Containers: []*sdkecs.Container{
+				{Name: aws.String("db-migrate"), RuntimeId: aws.String(id + "-1234")},
+			},
+		}
+	}
+	testCases := map[string]struct {
+		inTaskID   string
+		setupMocks func(m execTaskMocks)
+
+		wantedErr string
+	}{
+		"errors if the group has no running tasks": {
+			setupMocks: func(m execTaskMocks) {
+				m.lister.EXPECT().RunningTasks(testCluster, "copilot-task").Return(nil, nil)
+			},
+			wantedErr: "no running tasks found in group db-migrate",
+		},
+		"errors if no task matches the task ID": {
+			inTaskID: "ffff",
+			setupMocks: func(m execTaskMocks) {
+				m.lister.EXPECT().RunningTasks(testCluster, "copilot-task").Return([]*ecs.Task{runningTask("8c381840")}, nil)
+			},
+			wantedErr: "no running task found with ID ffff in group db-migrate",
+		},
+		"errors if the task can't be described": {
+			setupMocks: func(m execTaskMocks) {
+				m.lister.EXPECT().RunningTasks(testCluster, "copilot-task").Return([]*ecs.Task{runningTask("8c381840")}, nil)
+				m.executer.EXPECT().DescribeTasks(testCluster, []string{taskARN("8c381840")}).Return(nil, errors.New("some error"))
+			},
+			wantedErr: "describe task 8c381840: some error",
+		},
+		"returns the error from executing the command": {
+			setupMocks: func(m execTaskMocks) {
+				m.lister.EXPECT().RunningTasks(testCluster, "copilot-task").Return([]*ecs.Task{runningTask("8c381840")}, nil)
+				m.executer.EXPECT().DescribeTasks(testCluster, []string{taskARN("8c381840")}).Return([]*ecs.Task{describedTask("8c381840")}, nil)
+				m.executer.EXPECT().ExecuteCommand(gomock.Any()).Return(nil, errors.New("some error"))
+				m.runner.EXPECT().Run(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedErr: "some error",
+		},
+		"prompts for the task and starts a session into its container": {
+			setupMocks: func(m execTaskMocks) {
+				m.lister.EXPECT().RunningTasks(testCluster, "copilot-task").Return([]*ecs.Task{runningTask("8c381840"), runningTask("4082490e")}, nil)
+				m.prompt.EXPECT().SelectOne(taskExecTaskPrompt, taskExecTaskHelpPrompt, []string{"8c381840", "4082490e"}, gomock.Any()).Return("4082490e", nil)
+				m.executer.EXPECT().DescribeTasks(testCluster, []string{taskARN("4082490e")}).Return([]*ecs.Task{describedTask("4082490e")}, nil)
+				m.executer.EXPECT().ExecuteCommand(ecs.ExecuteCommandInput{
+					Cluster:   testCluster,
+					Task:      "4082490e",
+					Container: "db-migrate",
+					Command:   "/bin/sh",
+				}).Return(&ecs.Session{
+					SessionID:  "ecs-execute-command-123",
+					StreamURL:  "wss://ssmmessages.us-west-2.amazonaws.com",
+					TokenValue: "token",
+				}, nil)
+				m.runner.EXPECT().Run(ssmPluginBinaryName, []string{
+					`{"SessionId":"ecs-execute-command-123","StreamUrl":"wss://ssmmessages.us-west-2.amazonaws.com","TokenValue":"token"}`,
+					"us-west-2",
+					"StartSession",
+					"",
+					`{"Target":"ecs:phonetool-test-Cluster_4082490e_4082490e-1234"}`,
+					"https://ssm.us-west-2.amazonaws.com",
+				}, gomock.Any()).Return(nil)
+			},
+		},
+		"starts a session into the task with the ID prefix": {
+			inTaskID: "4082",
+			setupMocks: func(m execTaskMocks) {
+				m.lister.EXPECT().RunningTasks(testCluster, "copilot-task").Return([]*ecs.Task{runningTask("8c381840"), runningTask("4082490e")}, nil)
+				m.prompt.EXPECT().SelectOne(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				m.executer.EXPECT().DescribeTasks(testCluster, []string{taskARN("4082490e")}).Return([]*ecs.Task{describedTask("4082490e")}, nil)
+				m.executer.EXPECT().ExecuteCommand(gomock.Any()).Return(&ecs.Session{}, nil)
+				m.runner.EXPECT().Run(ssmPluginBinaryName, gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := execTaskMocks{
+				prompt:   mocks.NewMockprompter(ctrl),
+				lister:   mocks.NewMockrunningTasksLister(ctrl),
+				executer: mocks.NewMocktaskExecuter(ctrl),
+				runner:   mocks.NewMockrunner(ctrl),
+			}
+			tc.setupMocks(m)
+
+			opts := &execTaskOpts{
+				execTaskVars: execTaskVars{
+					groupName: "db-migrate",
+					taskID:    tc.inTaskID,
+					command:   defaultExecCommand,
+					GlobalOpts: &GlobalOpts{
+						prompt: m.prompt,
+					},
+				},
+				runner: m.runner,
+				initClients: func() error {
+					return nil
+				},
+			}
+			opts.cluster = testCluster
+			opts.region = "us-west-2"
+			opts.ssmEndpoint = "https://ssm.us-west-2.amazonaws.com"
+			opts.lister = m.lister
+			opts.executer = m.executer
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
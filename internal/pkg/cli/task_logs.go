@@ -0,0 +1,215 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/task"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/dustin/go-humanize/english"
+	"github.com/spf13/cobra"
+)
+
+var (
+	taskLogsGroupNamePrompt     = fmt.Sprintf("Which %s's logs would you like to show?", color.Emphasize("task group"))
+	taskLogsGroupNameHelpPrompt = "The logs of all the tasks of the task group will be shown."
+)
+
+type taskLogsVars struct {
+	*GlobalOpts
+	env              string
+	groupName        string
+	follow           bool
+	since            time.Duration
+	limit            int
+	shouldOutputJSON bool
+}
+
+type taskLogsOpts struct {
+	taskLogsVars
+
+	store store
+	w     io.Writer
+
+	// Fields below are configured at runtime.
+	cluster      string
+	lister       runningTasksLister
+	logsSvc      cwlogService
+	eventsWriter eventsWriter
+
+	initClients func() error // Overriden in tests.
+	// NOTE: configureEventsWriter is only called when tailing logs (i.e. --follow is specified)
+	configureEventsWriter func(tasks []*task.Task)
+}
+
+func newTaskLogsOpts(vars taskLogsVars) (*taskLogsOpts, error) {
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("new config store: %w", err)
+	}
+	opts := &taskLogsOpts{
+		taskLogsVars: vars,
+		store:        store,
+		w:            log.OutputWriter,
+	}
+	opts.initClients = func() error {
+		sess, cluster, err := taskCluster(opts.store, opts.AppName(), opts.env)
+		if err != nil {
+			return err
+		}
+		opts.cluster = cluster
+		opts.lister = ecs.New(sess)
+		logsSvc := cloudwatchlogs.New(sess)
+		opts.logsSvc = logsSvc
+		opts.configureEventsWriter = func(tasks []*task.Task) {
+			opts.eventsWriter = &task.EventsWriter{
+				GroupName: fmt.Sprintf(fmtTaskLogGroupName, opts.groupName),
+				Tasks:     tasks,
+
+				Describer:    ecs.New(sess),
+				EventsLogger: logsSvc,
+				Writer:       opts.w,
+			}
+		}
+		return nil
+	}
+	return opts, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *taskLogsOpts) Validate() error {
+	if o.groupName != "" {
+		if err := basicNameValidation(o.groupName); err != nil {
+			return err
+		}
+	}
+	if o.follow && o.shouldOutputJSON {
+		return errors.New("only one of --follow or --json may be used")
+	}
+	if o.since < 0 {
+		return errors.New("--since must be greater than 0")
+	}
+	if o.limit < cwGetLogEventsLimitMin || o.limit > cwGetLogEventsLimitMax {
+		return fmt.Errorf("--limit %d is out-of-bounds, value must be between %d and %d", o.limit, cwGetLogEventsLimitMin, cwGetLogEventsLimitMax)
+	}
+	return validateTaskEnv(o.store, o.AppName(), o.env)
+}
+
+// Ask prompts for the task group if it's not provided.
+func (o *taskLogsOpts) Ask() error {
+	if o.groupName != "" {
+		return nil
+	}
+	name, err := o.prompt.Get(taskLogsGroupNamePrompt, taskLogsGroupNameHelpPrompt, basicNameValidation)
+	if err != nil {
+		return fmt.Errorf("get task group name: %w", err)
+	}
+	o.groupName = name
+	return nil
+}
+
+// Execute shows the logs of the tasks of the task group.
+// With --follow, it streams the logs until all the running tasks of the group have stopped.
+func (o *taskLogsOpts) Execute() error {
+	if err := o.initClients(); err != nil {
+		return err
+	}
+	if !o.follow {
+		return o.writeLogs()
+	}
+	tasks, err := task.RunningTasks(o.lister, o.cluster, o.groupName)
+	if err != nil {
+		return fmt.Errorf("list running tasks of group %s: %w", o.groupName, err)
+	}
+	var started []*task.Task
+	for _, t := range tasks {
+		// Tasks that are still pending don't have logs yet.
+		if t.StartedAt != nil {
+			started = append(started, t)
+		}
+	}
+	if len(started) == 0 {
+		log.Infof("No running tasks found in group %s.\n", o.groupName)
+		return nil
+	}
+	o.configureEventsWriter(started)
+	if err := o.eventsWriter.WriteEventsUntilStopped(); err != nil {
+		return fmt.Errorf("write events: %w", err)
+	}
+	log.Infof("%s %s stopped.\n",
+		english.PluralWord(len(started), "Task", ""),
+		english.PluralWord(len(started), "has", "have"))
+	return nil
+}
+
+func (o *taskLogsOpts) writeLogs() error {
+	opts := []cloudwatchlogs.GetLogEventsOpts{
+		cloudwatchlogs.WithLimit(o.limit),
+	}
+	if o.since != 0 {
+		opts = append(opts, cloudwatchlogs.WithStartTime(time.Now().Add(-o.since).Unix()*1000))
+	}
+	logGroupName := fmt.Sprintf(fmtTaskLogGroupName, o.groupName)
+	out, err := o.logsSvc.TaskLogEvents(logGroupName, make(map[string]int64), opts...)
+	if err != nil {
+		return fmt.Errorf("get logs of task group %s: %w", o.groupName, err)
+	}
+	for _, event := range out.Events {
+		if !o.shouldOutputJSON {
+			fmt.Fprint(o.w, event.HumanString())
+			continue
+		}
+		data, err := event.JSONString()
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(o.w, data)
+	}
+	return nil
+}
+
+// BuildTaskLogsCmd builds the command for displaying the logs of a task group.
+func BuildTaskLogsCmd() *cobra.Command {
+	vars := taskLogsVars{
+		GlobalOpts: NewGlobalOpts(),
+	}
+	cmd := &cobra.Command{
+		Use:   "logs",
+		Short: "Displays logs of the one-off tasks of a task group.",
+		Example: `
+  Displays the latest logs of the "db-migrate" task group in the "default" cluster.
+  /code $ copilot task logs -n db-migrate
+  Streams the logs of the running tasks of the "db-migrate" task group in the "test" environment until they stop.
+  /code $ copilot task logs -n db-migrate --env test --follow`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newTaskLogsOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.groupName, taskGroupNameFlag, nameFlagShort, "", taskTargetGroupFlagDescription)
+	cmd.Flags().StringVar(&vars.appName, appFlag, "", appFlagDescription)
+	cmd.Flags().StringVar(&vars.env, envFlag, "", taskTargetEnvFlagDescription)
+	cmd.Flags().BoolVar(&vars.follow, followFlag, false, followFlagDescription)
+	cmd.Flags().DurationVar(&vars.since, sinceFlag, 0, sinceFlagDescription)
+	cmd.Flags().IntVar(&vars.limit, limitFlag, 10, limitFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
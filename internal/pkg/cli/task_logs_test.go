@@ -0,0 +1,231 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/task"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskLogsOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inGroupName string
+		inFollow    bool
+		inJSON      bool
+		inSince     time.Duration
+		inLimit     int
+
+		wantedError error
+	}{
+		"invalid task group name": {
+			inGroupName: "DB_Migrate",
+			inLimit:     10,
+
+			wantedError: errValueBadFormat,
+		},
+		"both --follow and --json": {
+			inFollow: true,
+			inJSON:   true,
+			inLimit:  10,
+
+			wantedError: errors.New("only one of --follow or --json may be used"),
+		},
+		"negative --since": {
+			inSince: -time.Minute,
+			inLimit: 10,
+
+			wantedError: errors.New("--since must be greater than 0"),
+		},
+		"out-of-bounds --limit": {
+			inLimit: 0,
+
+			wantedError: errors.New("--limit 0 is out-of-bounds, value must be between 1 and 10000"),
+		},
+		"valid flags": {
+			inGroupName: "db-migrate",
+			inSince:     time.Hour,
+			inLimit:     10,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			opts := &taskLogsOpts{
+				taskLogsVars: taskLogsVars{
+					GlobalOpts:       &GlobalOpts{},
+					groupName:        tc.inGroupName,
+					follow:           tc.inFollow,
+					shouldOutputJSON: tc.inJSON,
+					since:            tc.inSince,
+					limit:            tc.inLimit,
+				},
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestTaskLogsOpts_Execute(t *testing.T) {
+	startedAt := time.Date(2020, time.September, 13, 12, 0, 0, 0, time.UTC)
+	mockTasks := []*ecs.Task{
+		{
+			TaskArn:           aws.String("arn:aws:ecs:us-west-2:123456789012:task/default/4082490ee6c245e09d2145010aa1ba8d"),
+			TaskDefinitionArn: aws.String("arn:aws:ecs:us-west-2:123456789012:task-definition/copilot-db-migrate:3"),
+			LastStatus:        aws.String("RUNNING"),
+			StartedAt:         &startedAt,
+		},
+		{
+			TaskArn:           aws.String("arn:aws:ecs:us-west-2:123456789012:task/default/9a1f2c3d4e5f60718293a4b5c6d7e8f9"),
+			TaskDefinitionArn: aws.String("arn:aws:ecs:us-west-2:123456789012:task-definition/copilot-db-migrate:3"),
+			LastStatus:        aws.String("PENDING"),
+		},
+	}
+	logEvents := []*cloudwatchlogs.Event{
+		{
+			LogStreamName: "copilot-task/db-migrate/4082490ee6c245e09d2145010aa1ba8d",
+			Message:       "migrated 3 tables",
+		},
+	}
+	testCases := map[string]struct {
+		inFollow   bool
+		inJSON     bool
+		setupMocks func(lister *mocks.MockrunningTasksLister, logsSvc *mocks.MockcwlogService, writer *mocks.MockeventsWriter)
+
+		wantedTasks   []*task.Task
+		wantedContent string
+		wantedError   error
+	}{
+		"returns error if fail to get the logs": {
+			setupMocks: func(lister *mocks.MockrunningTasksLister, logsSvc *mocks.MockcwlogService, writer *mocks.MockeventsWriter) {
+				logsSvc.EXPECT().TaskLogEvents("/copilot/db-migrate", make(map[string]int64), gomock.Any()).Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("get logs of task group db-migrate: some error"),
+		},
+		"writes the logs": {
+			setupMocks: func(lister *mocks.MockrunningTasksLister, logsSvc *mocks.MockcwlogService, writer *mocks.MockeventsWriter) {
+				logsSvc.EXPECT().TaskLogEvents("/copilot/db-migrate", make(map[string]int64), gomock.Any()).Return(&cloudwatchlogs.LogEventsOutput{
+					Events: logEvents,
+				}, nil)
+			},
+
+			wantedContent: "copilot-task/db-migrate/4 migrated 3 tables\n",
+		},
+		"writes the logs in JSON": {
+			inJSON: true,
+			setupMocks: func(lister *mocks.MockrunningTasksLister, logsSvc *mocks.MockcwlogService, writer *mocks.MockeventsWriter) {
+				logsSvc.EXPECT().TaskLogEvents("/copilot/db-migrate", make(map[string]int64), gomock.Any()).Return(&cloudwatchlogs.LogEventsOutput{
+					Events: logEvents,
+				}, nil)
+			},
+
+			wantedContent: `{"logStreamName":"copilot-task/db-migrate/4082490ee6c245e09d2145010aa1ba8d","ingestionTime":0,"message":"migrated 3 tables","timestamp":0}` + "\n",
+		},
+		"returns error if fail to list running tasks when following": {
+			inFollow: true,
+			setupMocks: func(lister *mocks.MockrunningTasksLister, logsSvc *mocks.MockcwlogService, writer *mocks.MockeventsWriter) {
+				lister.EXPECT().RunningTasks("cluster", "copilot-task").Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("list running tasks of group db-migrate: some error"),
+		},
+		"does not follow if no tasks of the group have started": {
+			inFollow: true,
+			setupMocks: func(lister *mocks.MockrunningTasksLister, logsSvc *mocks.MockcwlogService, writer *mocks.MockeventsWriter) {
+				lister.EXPECT().RunningTasks("cluster", "copilot-task").Return(mockTasks[1:], nil)
+			},
+		},
+		"follows the logs of the started tasks": {
+			inFollow: true,
+			setupMocks: func(lister *mocks.MockrunningTasksLister, logsSvc *mocks.MockcwlogService, writer *mocks.MockeventsWriter) {
+				lister.EXPECT().RunningTasks("cluster", "copilot-task").Return(mockTasks, nil)
+				writer.EXPECT().WriteEventsUntilStopped().Return(nil)
+			},
+
+			wantedTasks: []*task.Task{
+				{
+					TaskARN:    "arn:aws:ecs:us-west-2:123456789012:task/default/4082490ee6c245e09d2145010aa1ba8d",
+					StartedAt:  &startedAt,
+					GroupName:  "db-migrate",
+					LastStatus: "RUNNING",
+				},
+			},
+		},
+		"returns error if fail to follow the logs": {
+			inFollow: true,
+			setupMocks: func(lister *mocks.MockrunningTasksLister, logsSvc *mocks.MockcwlogService, writer *mocks.MockeventsWriter) {
+				lister.EXPECT().RunningTasks("cluster", "copilot-task").Return(mockTasks, nil)
+				writer.EXPECT().WriteEventsUntilStopped().Return(errors.New("some error"))
+			},
+
+			wantedError: errors.New("write events: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			b := &bytes.Buffer{}
+			mockLister := mocks.NewMockrunningTasksLister(ctrl)
+			mockLogsSvc := mocks.NewMockcwlogService(ctrl)
+			mockWriter := mocks.NewMockeventsWriter(ctrl)
+			tc.setupMocks(mockLister, mockLogsSvc, mockWriter)
+
+			var followedTasks []*task.Task
+			opts := &taskLogsOpts{
+				taskLogsVars: taskLogsVars{
+					GlobalOpts:       &GlobalOpts{},
+					groupName:        "db-migrate",
+					follow:           tc.inFollow,
+					shouldOutputJSON: tc.inJSON,
+					limit:            10,
+				},
+				w:           b,
+				cluster:     "cluster",
+				lister:      mockLister,
+				logsSvc:     mockLogsSvc,
+				initClients: func() error { return nil },
+			}
+			opts.configureEventsWriter = func(tasks []*task.Task) {
+				followedTasks = tasks
+				opts.eventsWriter = mockWriter
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, b.String())
+			require.Equal(t, tc.wantedTasks, followedTasks)
+		})
+	}
+}
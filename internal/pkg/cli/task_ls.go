@@ -0,0 +1,200 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/task"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+)
+
+const (
+	shortTaskIDLength = 8
+)
+
+type listTaskVars struct {
+	*GlobalOpts
+	env              string
+	groupName        string
+	shouldOutputJSON bool
+}
+
+type listTaskOpts struct {
+	listTaskVars
+
+	store store
+	w     io.Writer
+
+	// Fields below are configured at runtime.
+	cluster    string
+	lister     runningTasksLister
+	initLister func() error // Overriden in tests.
+}
+
+func newListTaskOpts(vars listTaskVars) (*listTaskOpts, error) {
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("new config store: %w", err)
+	}
+	opts := &listTaskOpts{
+		listTaskVars: vars,
+		store:        store,
+		w:            log.OutputWriter,
+	}
+	opts.initLister = func() error {
+		sess, cluster, err := taskCluster(opts.store, opts.AppName(), opts.env)
+		if err != nil {
+			return err
+		}
+		opts.cluster = cluster
+		opts.lister = ecs.New(sess)
+		return nil
+	}
+	return opts, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *listTaskOpts) Validate() error {
+	if o.groupName != "" {
+		if err := basicNameValidation(o.groupName); err != nil {
+			return err
+		}
+	}
+	return validateTaskEnv(o.store, o.AppName(), o.env)
+}
+
+// Execute lists the one-off tasks that haven't stopped yet.
+func (o *listTaskOpts) Execute() error {
+	if err := o.initLister(); err != nil {
+		return err
+	}
+	tasks, err := task.RunningTasks(o.lister, o.cluster, o.groupName)
+	if err != nil {
+		return fmt.Errorf("list running tasks: %w", err)
+	}
+	if o.shouldOutputJSON {
+		data, err := o.jsonOutput(tasks)
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(o.w, data)
+		return nil
+	}
+	o.humanOutput(tasks)
+	return nil
+}
+
+type taskSummary struct {
+	Group     string `json:"group"`
+	ARN       string `json:"arn"`
+	Status    string `json:"status"`
+	StartedAt string `json:"startedAt,omitempty"`
+}
+
+func (o *listTaskOpts) jsonOutput(tasks []*task.Task) (string, error) {
+	out := struct {
+		Tasks []taskSummary `json:"tasks"`
+	}{
+		Tasks: []taskSummary{},
+	}
+	for _, t := range tasks {
+		summary := taskSummary{
+			Group:  t.GroupName,
+			ARN:    t.TaskARN,
+			Status: t.LastStatus,
+		}
+		if t.StartedAt != nil {
+			summary.StartedAt = t.StartedAt.Format(time.RFC3339)
+		}
+		out.Tasks = append(out.Tasks, summary)
+	}
+	b, err := json.Marshal(out)
+	if err != nil {
+		return "", fmt.Errorf("marshal tasks: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+func (o *listTaskOpts) humanOutput(tasks []*task.Task) {
+	if len(tasks) == 0 {
+		log.Infoln("No running tasks found.")
+		return
+	}
+	writer := tabwriter.NewWriter(o.w, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	headers := []string{"Group", "Task ID", "Status", "Started At"}
+	rows := make([][]string, len(tasks))
+	for i, t := range tasks {
+		startedAt := "-"
+		if t.StartedAt != nil {
+			startedAt = humanize.Time(*t.StartedAt)
+		}
+		rows[i] = []string{t.GroupName, shortTaskID(t.TaskARN), t.LastStatus, startedAt}
+	}
+	underlines := make([]string, len(headers))
+	for i, header := range headers {
+		width := len(header)
+		for _, row := range rows {
+			if len(row[i]) > width {
+				width = len(row[i])
+			}
+		}
+		underlines[i] = strings.Repeat("-", width)
+	}
+	fmt.Fprintf(writer, "%s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "%s\n", strings.Join(underlines, "\t"))
+	for _, row := range rows {
+		fmt.Fprintf(writer, "%s\n", strings.Join(row, "\t"))
+	}
+	writer.Flush()
+}
+
+// shortTaskID returns the first characters of the ID of a task from its ARN.
+func shortTaskID(taskARN string) string {
+	id := taskARN[strings.LastIndex(taskARN, "/")+1:]
+	if len(id) > shortTaskIDLength {
+		return id[:shortTaskIDLength]
+	}
+	return id
+}
+
+// BuildTaskListCmd builds the command for listing the one-off tasks that haven't stopped yet.
+func BuildTaskListCmd() *cobra.Command {
+	vars := listTaskVars{
+		GlobalOpts: NewGlobalOpts(),
+	}
+	cmd := &cobra.Command{
+		Use:   "ls",
+		Short: "Lists the one-off tasks that are still running.",
+		Example: `
+  Lists the running tasks in the "default" cluster.
+  /code $ copilot task ls
+  Lists the running tasks of the "db-migrate" task group in the "test" environment.
+  /code $ copilot task ls -n db-migrate --env test`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newListTaskOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.groupName, taskGroupNameFlag, nameFlagShort, "", taskListGroupFlagDescription)
+	cmd.Flags().StringVar(&vars.appName, appFlag, "", appFlagDescription)
+	cmd.Flags().StringVar(&vars.env, envFlag, "", taskTargetEnvFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
@@ -0,0 +1,191 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListTaskOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inAppName   string
+		inEnv       string
+		inGroupName string
+		setupMocks  func(m *mocks.Mockstore)
+
+		wantedError error
+	}{
+		"invalid task group name": {
+			inGroupName: "DB_Migrate",
+			setupMocks:  func(m *mocks.Mockstore) {},
+
+			wantedError: errValueBadFormat,
+		},
+		"environment without an application": {
+			inEnv:      "test",
+			setupMocks: func(m *mocks.Mockstore) {},
+
+			wantedError: errNoAppInWorkspace,
+		},
+		"environment does not exist": {
+			inAppName: "phonetool",
+			inEnv:     "test",
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("get environment test config: some error"),
+		},
+		"valid group name and environment": {
+			inAppName:   "phonetool",
+			inEnv:       "test",
+			inGroupName: "db-migrate",
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{}, nil)
+			},
+		},
+		"no environment": {
+			setupMocks: func(m *mocks.Mockstore) {},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockstore(ctrl)
+			tc.setupMocks(mockStore)
+
+			opts := &listTaskOpts{
+				listTaskVars: listTaskVars{
+					GlobalOpts: &GlobalOpts{
+						appName: tc.inAppName,
+					},
+					env:       tc.inEnv,
+					groupName: tc.inGroupName,
+				},
+				store: mockStore,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestListTaskOpts_Execute(t *testing.T) {
+	startedAt := time.Now().Add(-2 * time.Hour)
+	mockTasks := []*ecs.Task{
+		{
+			TaskArn:           aws.String("arn:aws:ecs:us-west-2:123456789012:task/default/4082490ee6c245e09d2145010aa1ba8d"),
+			TaskDefinitionArn: aws.String("arn:aws:ecs:us-west-2:123456789012:task-definition/copilot-db-migrate:3"),
+			LastStatus:        aws.String("RUNNING"),
+			StartedAt:         &startedAt,
+		},
+		{
+			TaskArn:           aws.String("arn:aws:ecs:us-west-2:123456789012:task/default/9a1f2c3d4e5f60718293a4b5c6d7e8f9"),
+			TaskDefinitionArn: aws.String("arn:aws:ecs:us-west-2:123456789012:task-definition/copilot-seed:1"),
+			LastStatus:        aws.String("PENDING"),
+		},
+	}
+	testCases := map[string]struct {
+		inGroupName string
+		inJSON      bool
+		setupMocks  func(m *mocks.MockrunningTasksLister)
+
+		wantedContent string
+		wantedError   error
+	}{
+		"returns error if fail to list running tasks": {
+			setupMocks: func(m *mocks.MockrunningTasksLister) {
+				m.EXPECT().RunningTasks("cluster", "copilot-task").Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("list running tasks: some error"),
+		},
+		"writes the tasks in a table": {
+			setupMocks: func(m *mocks.MockrunningTasksLister) {
+				m.EXPECT().RunningTasks("cluster", "copilot-task").Return(mockTasks, nil)
+			},
+
+			wantedContent: `Group               Task ID             Status              Started At
+----------          --------            -------             -----------
+db-migrate          4082490e            RUNNING             2 hours ago
+seed                9a1f2c3d            PENDING             -
+`,
+		},
+		"writes the tasks of a task group in JSON": {
+			inGroupName: "db-migrate",
+			inJSON:      true,
+			setupMocks: func(m *mocks.MockrunningTasksLister) {
+				m.EXPECT().RunningTasks("cluster", "copilot-task").Return(mockTasks, nil)
+			},
+
+			wantedContent: fmt.Sprintf(`{"tasks":[{"group":"db-migrate","arn":"arn:aws:ecs:us-west-2:123456789012:task/default/4082490ee6c245e09d2145010aa1ba8d","status":"RUNNING","startedAt":"%s"}]}`+"\n", startedAt.Format(time.RFC3339)),
+		},
+		"writes an empty list in JSON if there are no running tasks": {
+			inJSON: true,
+			setupMocks: func(m *mocks.MockrunningTasksLister) {
+				m.EXPECT().RunningTasks("cluster", "copilot-task").Return(nil, nil)
+			},
+
+			wantedContent: `{"tasks":[]}` + "\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			b := &bytes.Buffer{}
+			mockLister := mocks.NewMockrunningTasksLister(ctrl)
+			tc.setupMocks(mockLister)
+
+			opts := &listTaskOpts{
+				listTaskVars: listTaskVars{
+					GlobalOpts:       &GlobalOpts{},
+					groupName:        tc.inGroupName,
+					shouldOutputJSON: tc.inJSON,
+				},
+				w:          b,
+				cluster:    "cluster",
+				lister:     mockLister,
+				initLister: func() error { return nil },
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, b.String())
+		})
+	}
+}
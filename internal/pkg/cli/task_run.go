@@ -21,13 +21,16 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/docker"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/repository"
 	"github.com/aws/copilot-cli/internal/pkg/task"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 
 	"github.com/dustin/go-humanize/english"
@@ -69,9 +72,10 @@ task definition and ECR repository.`
 
 type runTaskVars struct {
 	*GlobalOpts
-	count  int
-	cpu    int
-	memory int
+	count   int
+	cpu     int
+	memory  int
+	compute string
 
 	groupName string
 
@@ -92,6 +96,8 @@ type runTaskVars struct {
 	resourceTags map[string]string
 
 	follow bool
+
+	preset string
 }
 
 type runTaskOpts struct {
@@ -101,6 +107,7 @@ type runTaskOpts struct {
 	// Interfaces to interact with dependencies.
 	fs      afero.Fs
 	store   store
+	ws      wsTaskPresetReader
 	sel     appEnvSelector
 	spinner progress
 
@@ -135,6 +142,13 @@ func newTaskRunOpts(vars runTaskVars) (*runTaskOpts, error) {
 		sel:     selector.NewSelect(vars.prompt, store),
 		spinner: termprogress.NewSpinner(),
 	}
+	if vars.preset != "" {
+		ws, err := workspace.New()
+		if err != nil {
+			return nil, fmt.Errorf("new workspace: %w", err)
+		}
+		opts.ws = ws
+	}
 
 	opts.configureRuntimeOpts = func() error {
 		opts.runner = opts.configureRunner()
@@ -169,7 +183,10 @@ func newTaskRunOpts(vars runTaskVars) (*runTaskOpts, error) {
 }
 
 func (o *runTaskOpts) configureRunner() taskRunner {
-	vpcGetter := ec2.New(o.sess)
+	// The spinner waiting for the tasks is displayed while the subnets and security groups are looked up.
+	vpcGetter := ec2.New(o.sess, ec2.WithThrottlingRetries(ec2.RetryConfig{
+		Observer: &throttleProgress{prog: o.spinner, running: true},
+	}))
 	ecsService := ecs.New(o.sess)
 
 	if o.env != "" {
@@ -229,6 +246,97 @@ func (o *runTaskOpts) configureSessAndEnv() error {
 	return nil
 }
 
+// applyPreset sets the values of the task preset for the flags that aren't set by the user.
+func (o *runTaskOpts) applyPreset(isSet func(flag string) bool) error {
+	if o.preset == "" {
+		return nil
+	}
+	data, err := o.ws.ReadTaskPreset(o.preset)
+	if err != nil {
+		return fmt.Errorf("read task preset %s: %w", o.preset, err)
+	}
+	preset, err := manifest.UnmarshalTaskPreset(data)
+	if err != nil {
+		return fmt.Errorf("unmarshal task preset %s: %w", o.preset, err)
+	}
+
+	if !isSet(taskGroupNameFlag) {
+		o.groupName = o.preset
+		if preset.Name != nil {
+			o.groupName = aws.StringValue(preset.Name)
+		}
+	}
+	// The image and the Dockerfile of the preset are ignored if either of them is set by the user.
+	if !isSet(imageFlag) && !isSet(dockerFileFlag) {
+		if preset.Image != nil {
+			o.image = aws.StringValue(preset.Image)
+		}
+		if preset.Dockerfile != nil {
+			o.dockerfilePath = aws.StringValue(preset.Dockerfile)
+			o.isDockerfileSet = true
+		}
+	}
+	// The environment of the preset is ignored if the user chose where to run the task.
+	if !isSet(envFlag) && !isSet(taskDefaultFlag) && !isSet(subnetsFlag) && !isSet(securityGroupsFlag) && preset.Environment != nil {
+		o.env = aws.StringValue(preset.Environment)
+	}
+	presetString(&o.imageTag, preset.ImageTag, isSet(imageTagFlag))
+	presetString(&o.command, preset.Command, isSet(commandFlag))
+	presetString(&o.taskRole, preset.TaskRole, isSet(taskRoleFlag))
+	presetString(&o.executionRole, preset.ExecutionRole, isSet(executionRoleFlag))
+	presetInt(&o.count, preset.Count, isSet(countFlag))
+	presetInt(&o.cpu, preset.CPU, isSet(cpuFlag))
+	presetInt(&o.memory, preset.Memory, isSet(memoryFlag))
+	o.envVars = mergeStringMaps(preset.Variables, o.envVars)
+	o.resourceTags = mergeStringMaps(preset.Tags, o.resourceTags)
+	return nil
+}
+
+// applyCompute sets the CPU units and memory of the compute preset.
+func (o *runTaskOpts) applyCompute(isSet func(flag string) bool) error {
+	if o.compute == "" {
+		return nil
+	}
+	if isSet(cpuFlag) || isSet(memoryFlag) {
+		return fmt.Errorf("cannot specify `--%s` with `--%s` or `--%s`", computeFlag, cpuFlag, memoryFlag)
+	}
+	size, err := manifest.ComputePreset(o.compute)
+	if err != nil {
+		return err
+	}
+	o.cpu, o.memory = size.CPU, size.Memory
+	return nil
+}
+
+func presetString(flag *string, value *string, isFlagSet bool) {
+	if isFlagSet || value == nil {
+		return
+	}
+	*flag = *value
+}
+
+func presetInt(flag *int, value *int, isFlagSet bool) {
+	if isFlagSet || value == nil {
+		return
+	}
+	*flag = *value
+}
+
+// mergeStringMaps returns the key value pairs of both maps, the values of overrides take precedence.
+func mergeStringMaps(base, overrides map[string]string) map[string]string {
+	if len(base) == 0 {
+		return overrides
+	}
+	merged := make(map[string]string)
+	for k, v := range base {
+		merged[k] = v
+	}
+	for k, v := range overrides {
+		merged[k] = v
+	}
+	return merged
+}
+
 // Validate returns an error if the flag values passed by the user are invalid.
 func (o *runTaskOpts) Validate() error {
 	if o.count <= 0 {
@@ -243,6 +351,10 @@ func (o *runTaskOpts) Validate() error {
 		return errMemNotPositive
 	}
 
+	if err := manifest.ValidateTaskSize(o.cpu, o.memory); err != nil {
+		return err
+	}
+
 	if o.groupName != "" {
 		if err := basicNameValidation(o.groupName); err != nil {
 			return err
@@ -447,7 +559,9 @@ func (o *runTaskOpts) displayLogStream() error {
 
 func (o *runTaskOpts) runTask() ([]*task.Task, error) {
 	o.spinner.Start(fmt.Sprintf("Waiting for %s to be running for %s.", english.Plural(o.count, "task", ""), o.groupName))
-	tasks, err := o.runner.Run()
+	ctx, stop := interruptContext()
+	defer stop()
+	tasks, err := o.runner.Run(ctx)
 	if err != nil {
 		o.spinner.Stop(log.Serrorf("Failed to run %s.\n", o.groupName))
 		return nil, fmt.Errorf("run task %s: %w", o.groupName, err)
@@ -599,12 +713,16 @@ Run a task named "db-migrate" in the "test" environment under the current worksp
 /code $ copilot task run -n db-migrate --env test
 Run 4 tasks with 2GB memory, an existing image, and a custom task role.
 /code $ copilot task run --num 4 --memory 2048 --image=rds-migrate --task-role migrate-role
+Run a task with 4 vCPUs and 8GB memory.
+/code $ copilot task run --compute large
 Run a task with environment variables.
 /code $ copilot task run --env-vars name=myName,user=myUser
 Run a task using the current workspace with specific subnets and security groups.
 /code $ copilot task run --subnets subnet-123,subnet-456 --security-groups sg-123,sg-456
 Run a task with a command.
-/code $ copilot task run --command "python migrate-script.py"`,
+/code $ copilot task run --command "python migrate-script.py"
+Run a task with the configuration of the preset in copilot/tasks/migrate.yml, using 2 tasks instead.
+/code $ copilot task run --preset migrate --count 2`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newTaskRunOpts(vars)
 			if err != nil {
@@ -615,6 +733,14 @@ Run a task with a command.
 				opts.isDockerfileSet = true
 			}
 
+			if err := opts.applyPreset(cmd.Flags().Changed); err != nil {
+				return err
+			}
+
+			if err := opts.applyCompute(cmd.Flags().Changed); err != nil {
+				return err
+			}
+
 			if err := opts.Validate(); err != nil {
 				return err
 			}
@@ -633,6 +759,7 @@ Run a task with a command.
 	cmd.Flags().IntVar(&vars.count, countFlag, 1, countFlagDescription)
 	cmd.Flags().IntVar(&vars.cpu, cpuFlag, 256, cpuFlagDescription)
 	cmd.Flags().IntVar(&vars.memory, memoryFlag, 512, memoryFlagDescription)
+	cmd.Flags().StringVar(&vars.compute, computeFlag, "", computeFlagDescription)
 
 	cmd.Flags().StringVarP(&vars.groupName, taskGroupNameFlag, nameFlagShort, "", taskGroupFlagDescription)
 
@@ -654,5 +781,6 @@ Run a task with a command.
 	cmd.Flags().StringToStringVar(&vars.resourceTags, resourceTagsFlag, nil, resourceTagsFlagDescription)
 
 	cmd.Flags().BoolVar(&vars.follow, followFlag, false, followFlagDescription)
+	cmd.Flags().StringVar(&vars.preset, taskPresetFlag, "", taskPresetFlagDescription)
 	return cmd
 }
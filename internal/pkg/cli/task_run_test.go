@@ -150,6 +150,14 @@ func TestTaskRunOpts_Validate(t *testing.T) {
 			},
 			wantedError: errMemNotPositive,
 		},
+		"task size not supported by Fargate": {
+			basicOpts: basicOpts{
+				inCount:  1,
+				inCPU:    256,
+				inMemory: 4096,
+			},
+			wantedError: errors.New("cpu 256 and memory 4096 is not a task size supported by Fargate, try cpu 256 and memory 2048 or cpu 512 and memory 4096"),
+		},
 		"both dockerfile and image name specified": {
 			basicOpts: defaultOpts,
 
@@ -599,7 +607,7 @@ func TestTaskRunOpts_Execute(t *testing.T) {
 				m.defaultClusterGetter.EXPECT().HasDefaultCluster().Return(true, nil)
 				m.deployer.EXPECT().DeployTask(gomock.Any()).Return(nil).AnyTimes()
 				mockRepositoryAnytime(m)
-				m.runner.EXPECT().Run().AnyTimes()
+				m.runner.EXPECT().Run(gomock.Any()).AnyTimes()
 			},
 		},
 		"do not check for default cluster if deploying to environment": {
@@ -613,7 +621,7 @@ func TestTaskRunOpts_Execute(t *testing.T) {
 					}, nil)
 				m.deployer.EXPECT().DeployTask(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 				mockRepositoryAnytime(m)
-				m.runner.EXPECT().Run().AnyTimes()
+				m.runner.EXPECT().Run(gomock.Any()).AnyTimes()
 			},
 		},
 		"error deploying resources": {
@@ -649,7 +657,7 @@ func TestTaskRunOpts_Execute(t *testing.T) {
 				m.store.EXPECT().GetEnvironment(gomock.Any(), gomock.Any()).AnyTimes()
 				m.deployer.EXPECT().DeployTask(gomock.Any()).Return(nil).Times(2)
 				mockRepositoryAnytime(m)
-				m.runner.EXPECT().Run().Return(nil, errors.New("error running"))
+				m.runner.EXPECT().Run(gomock.Any()).Return(nil, errors.New("error running"))
 				mockHasDefaultCluster(m)
 			},
 			wantedError: errors.New("run task my-task: error running"),
@@ -663,7 +671,7 @@ func TestTaskRunOpts_Execute(t *testing.T) {
 					}, nil)
 				m.deployer.EXPECT().DeployTask(gomock.Any(), gomock.Len(1)).AnyTimes() // NOTE: matching length because gomock is unable to match function arguments.
 				mockRepositoryAnytime(m)
-				m.runner.EXPECT().Run().AnyTimes()
+				m.runner.EXPECT().Run(gomock.Any()).AnyTimes()
 				m.defaultClusterGetter.EXPECT().HasDefaultCluster().Times(0)
 			},
 		},
@@ -672,7 +680,7 @@ func TestTaskRunOpts_Execute(t *testing.T) {
 				m.store.EXPECT().GetEnvironment(gomock.Any(), gomock.Any()).Times(0)
 				m.deployer.EXPECT().DeployTask(gomock.Any(), gomock.Len(0)).AnyTimes() // NOTE: matching length because gomock is unable to match function arguments.
 				mockRepositoryAnytime(m)
-				m.runner.EXPECT().Run().AnyTimes()
+				m.runner.EXPECT().Run(gomock.Any()).AnyTimes()
 				mockHasDefaultCluster(m)
 			},
 		},
@@ -689,7 +697,7 @@ func TestTaskRunOpts_Execute(t *testing.T) {
 					}),
 				)
 				m.repository.EXPECT().URI().AnyTimes()
-				m.runner.EXPECT().Run().AnyTimes()
+				m.runner.EXPECT().Run(gomock.Any()).AnyTimes()
 				mockHasDefaultCluster(m)
 			},
 		},
@@ -706,7 +714,7 @@ func TestTaskRunOpts_Execute(t *testing.T) {
 					Name:  inGroupName,
 					Image: "uri/repo:latest",
 				}).Times(1).Return(nil)
-				m.runner.EXPECT().Run().AnyTimes()
+				m.runner.EXPECT().Run(gomock.Any()).AnyTimes()
 				mockHasDefaultCluster(m)
 			},
 		},
@@ -715,7 +723,7 @@ func TestTaskRunOpts_Execute(t *testing.T) {
 			inImage: "image",
 			setupMocks: func(m runTaskMocks) {
 				m.deployer.EXPECT().DeployTask(gomock.Any()).AnyTimes()
-				m.runner.EXPECT().Run().Return([]*task.Task{
+				m.runner.EXPECT().Run(gomock.Any()).Return([]*task.Task{
 					{
 						TaskARN: "task-1",
 					},
@@ -785,3 +793,210 @@ func TestTaskRunOpts_Execute(t *testing.T) {
 		})
 	}
 }
+
+func TestTaskRunOpts_applyPreset(t *testing.T) {
+	presetContent := `
+dockerfile: ./migrate/Dockerfile
+command: python migrate.py
+cpu: 512
+memory: 1024
+environment: test
+variables:
+  LOG_LEVEL: debug
+  DRY_RUN: "false"
+`
+	testCases := map[string]struct {
+		inVars     runTaskVars
+		inSetFlags []string
+		setupMocks func(m *mocks.MockwsTaskPresetReader)
+
+		wantedVars          runTaskVars
+		wantedDockerfileSet bool
+		wantedError         error
+	}{
+		"does nothing without a preset": {
+			inVars:     runTaskVars{count: 1},
+			setupMocks: func(m *mocks.MockwsTaskPresetReader) {},
+
+			wantedVars: runTaskVars{count: 1},
+		},
+		"returns error if fail to read the preset": {
+			inVars: runTaskVars{preset: "migrate"},
+			setupMocks: func(m *mocks.MockwsTaskPresetReader) {
+				m.EXPECT().ReadTaskPreset("migrate").Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("read task preset migrate: some error"),
+		},
+		"returns error if fail to unmarshal the preset": {
+			inVars: runTaskVars{preset: "migrate"},
+			setupMocks: func(m *mocks.MockwsTaskPresetReader) {
+				m.EXPECT().ReadTaskPreset("migrate").Return([]byte("image: rds\ndockerfile: Dockerfile"), nil)
+			},
+
+			wantedError: errors.New(`unmarshal task preset migrate: must specify one of "image" or "dockerfile"`),
+		},
+		"uses the values of the preset for the flags that are not set": {
+			inVars: runTaskVars{
+				preset:         "migrate",
+				count:          1,
+				cpu:            256,
+				memory:         512,
+				dockerfilePath: defaultDockerfilePath,
+			},
+			setupMocks: func(m *mocks.MockwsTaskPresetReader) {
+				m.EXPECT().ReadTaskPreset("migrate").Return([]byte(presetContent), nil)
+			},
+
+			wantedVars: runTaskVars{
+				preset:         "migrate",
+				groupName:      "migrate",
+				count:          1,
+				cpu:            512,
+				memory:         1024,
+				dockerfilePath: "./migrate/Dockerfile",
+				command:        "python migrate.py",
+				env:            "test",
+				envVars: map[string]string{
+					"LOG_LEVEL": "debug",
+					"DRY_RUN":   "false",
+				},
+			},
+			wantedDockerfileSet: true,
+		},
+		"flags override the values of the preset": {
+			inVars: runTaskVars{
+				preset:            "migrate",
+				groupName:         "db-migrate",
+				count:             1,
+				cpu:               256,
+				memory:            2048,
+				image:             "rds-migrate",
+				dockerfilePath:    defaultDockerfilePath,
+				useDefaultSubnets: true,
+				envVars: map[string]string{
+					"DRY_RUN": "true",
+				},
+			},
+			inSetFlags: []string{taskGroupNameFlag, memoryFlag, imageFlag, taskDefaultFlag, envVarsFlag},
+			setupMocks: func(m *mocks.MockwsTaskPresetReader) {
+				m.EXPECT().ReadTaskPreset("migrate").Return([]byte(presetContent), nil)
+			},
+
+			wantedVars: runTaskVars{
+				preset:            "migrate",
+				groupName:         "db-migrate",
+				count:             1,
+				cpu:               512,
+				memory:            2048,
+				image:             "rds-migrate",
+				dockerfilePath:    defaultDockerfilePath,
+				useDefaultSubnets: true,
+				command:           "python migrate.py",
+				envVars: map[string]string{
+					"LOG_LEVEL": "debug",
+					"DRY_RUN":   "true",
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockWs := mocks.NewMockwsTaskPresetReader(ctrl)
+			tc.setupMocks(mockWs)
+
+			opts := &runTaskOpts{
+				runTaskVars: tc.inVars,
+				ws:          mockWs,
+			}
+			isSet := func(flag string) bool {
+				for _, f := range tc.inSetFlags {
+					if f == flag {
+						return true
+					}
+				}
+				return false
+			}
+
+			// WHEN
+			err := opts.applyPreset(isSet)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedVars, opts.runTaskVars)
+			require.Equal(t, tc.wantedDockerfileSet, opts.isDockerfileSet)
+		})
+	}
+}
+
+func TestTaskRunOpts_applyCompute(t *testing.T) {
+	testCases := map[string]struct {
+		inCompute  string
+		inSetFlags []string
+
+		wantedCPU    int
+		wantedMemory int
+		wantedError  error
+	}{
+		"does nothing without a compute preset": {
+			wantedCPU:    256,
+			wantedMemory: 512,
+		},
+		"returns error if the cpu is also set": {
+			inCompute:   "large",
+			inSetFlags:  []string{cpuFlag},
+			wantedError: errors.New("cannot specify `--compute` with `--cpu` or `--memory`"),
+		},
+		"returns error if the compute preset doesn't exist": {
+			inCompute:   "huge",
+			wantedError: errors.New("invalid compute preset huge: must be one of small, medium, large"),
+		},
+		"sets the cpu and memory of the compute preset": {
+			inCompute:    "medium",
+			wantedCPU:    1024,
+			wantedMemory: 2048,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			opts := &runTaskOpts{
+				runTaskVars: runTaskVars{
+					cpu:     256,
+					memory:  512,
+					compute: tc.inCompute,
+				},
+			}
+			isSet := func(flag string) bool {
+				for _, f := range tc.inSetFlags {
+					if f == flag {
+						return true
+					}
+				}
+				return false
+			}
+
+			// WHEN
+			err := opts.applyCompute(isSet)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedCPU, opts.cpu)
+			require.Equal(t, tc.wantedMemory, opts.memory)
+		})
+	}
+}
@@ -0,0 +1,168 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/task"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/dustin/go-humanize/english"
+	"github.com/spf13/cobra"
+)
+
+const (
+	taskStopReason = "Stopped by copilot task stop."
+)
+
+var (
+	taskStopGroupNamePrompt     = fmt.Sprintf("Which %s would you like to stop?", color.Emphasize("task group"))
+	taskStopGroupNameHelpPrompt = "All the running tasks of the task group will be stopped."
+
+	fmtTaskStopConfirmPrompt  = "Are you sure you want to stop all running tasks of group %s?"
+	taskStopConfirmHelpPrompt = "The tasks are stopped without waiting for their work to be done."
+
+	errTaskStopCancelled = errors.New("task stop cancelled - no tasks stopped")
+)
+
+type stopTaskVars struct {
+	*GlobalOpts
+	env              string
+	groupName        string
+	skipConfirmation bool
+}
+
+type stopTaskOpts struct {
+	stopTaskVars
+
+	store   store
+	spinner progress
+
+	// Fields below are configured at runtime.
+	cluster     string
+	lister      runningTasksLister
+	stopper     tasksStopper
+	initClients func() error // Overriden in tests.
+}
+
+func newStopTaskOpts(vars stopTaskVars) (*stopTaskOpts, error) {
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("new config store: %w", err)
+	}
+	opts := &stopTaskOpts{
+		stopTaskVars: vars,
+		store:        store,
+		spinner:      termprogress.NewSpinner(),
+	}
+	opts.initClients = func() error {
+		sess, cluster, err := taskCluster(opts.store, opts.AppName(), opts.env)
+		if err != nil {
+			return err
+		}
+		client := ecs.New(sess)
+		opts.cluster = cluster
+		opts.lister = client
+		opts.stopper = client
+		return nil
+	}
+	return opts, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *stopTaskOpts) Validate() error {
+	if o.groupName != "" {
+		if err := basicNameValidation(o.groupName); err != nil {
+			return err
+		}
+	}
+	return validateTaskEnv(o.store, o.AppName(), o.env)
+}
+
+// Ask prompts for the task group if it's not provided and confirms that its tasks should be stopped.
+func (o *stopTaskOpts) Ask() error {
+	if o.groupName == "" {
+		name, err := o.prompt.Get(taskStopGroupNamePrompt, taskStopGroupNameHelpPrompt, basicNameValidation)
+		if err != nil {
+			return fmt.Errorf("get task group name: %w", err)
+		}
+		o.groupName = name
+	}
+	if o.skipConfirmation {
+		return nil
+	}
+	confirmed, err := o.prompt.Confirm(fmt.Sprintf(fmtTaskStopConfirmPrompt, o.groupName), taskStopConfirmHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("task stop confirmation prompt: %w", err)
+	}
+	if !confirmed {
+		return errTaskStopCancelled
+	}
+	return nil
+}
+
+// Execute stops the running tasks of the task group.
+func (o *stopTaskOpts) Execute() error {
+	if err := o.initClients(); err != nil {
+		return err
+	}
+	tasks, err := task.RunningTasks(o.lister, o.cluster, o.groupName)
+	if err != nil {
+		return fmt.Errorf("list running tasks of group %s: %w", o.groupName, err)
+	}
+	if len(tasks) == 0 {
+		log.Infof("No running tasks found in group %s.\n", o.groupName)
+		return nil
+	}
+	taskARNs := make([]string, len(tasks))
+	for i, t := range tasks {
+		taskARNs[i] = t.TaskARN
+	}
+	o.spinner.Start(fmt.Sprintf("Stopping %s of group %s.", english.Plural(len(tasks), "task", ""), o.groupName))
+	if err := o.stopper.StopTasks(o.cluster, taskARNs, taskStopReason); err != nil {
+		o.spinner.Stop(log.Serrorf("Failed to stop the tasks of group %s.\n", o.groupName))
+		return fmt.Errorf("stop tasks of group %s: %w", o.groupName, err)
+	}
+	o.spinner.Stop(log.Ssuccessf("Stopped %s of group %s.\n", english.Plural(len(tasks), "task", ""), o.groupName))
+	return nil
+}
+
+// BuildTaskStopCmd builds the command for stopping the running tasks of a task group.
+func BuildTaskStopCmd() *cobra.Command {
+	vars := stopTaskVars{
+		GlobalOpts: NewGlobalOpts(),
+	}
+	cmd := &cobra.Command{
+		Use:   "stop",
+		Short: "Stops the running one-off tasks of a task group.",
+		Example: `
+  Stops the running tasks of the "db-migrate" task group in the "default" cluster.
+  /code $ copilot task stop -n db-migrate
+  Stops the running tasks of the "db-migrate" task group in the "test" environment without confirmation.
+  /code $ copilot task stop -n db-migrate --env test --yes`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newStopTaskOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.groupName, taskGroupNameFlag, nameFlagShort, "", taskTargetGroupFlagDescription)
+	cmd.Flags().StringVar(&vars.appName, appFlag, "", appFlagDescription)
+	cmd.Flags().StringVar(&vars.env, envFlag, "", taskTargetEnvFlagDescription)
+	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	return cmd
+}
@@ -0,0 +1,182 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStopTaskOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inGroupName        string
+		inSkipConfirmation bool
+		setupMocks         func(m *mocks.Mockprompter)
+
+		wantedGroupName string
+		wantedError     error
+	}{
+		"prompts for the task group and confirmation": {
+			setupMocks: func(m *mocks.Mockprompter) {
+				m.EXPECT().Get(taskStopGroupNamePrompt, taskStopGroupNameHelpPrompt, gomock.Any()).Return("db-migrate", nil)
+				m.EXPECT().Confirm(fmt.Sprintf(fmtTaskStopConfirmPrompt, "db-migrate"), taskStopConfirmHelpPrompt).Return(true, nil)
+			},
+
+			wantedGroupName: "db-migrate",
+		},
+		"skips confirmation if --yes is provided": {
+			inGroupName:        "db-migrate",
+			inSkipConfirmation: true,
+			setupMocks:         func(m *mocks.Mockprompter) {},
+
+			wantedGroupName: "db-migrate",
+		},
+		"returns error if fail to get the task group": {
+			setupMocks: func(m *mocks.Mockprompter) {
+				m.EXPECT().Get(taskStopGroupNamePrompt, taskStopGroupNameHelpPrompt, gomock.Any()).Return("", errors.New("some error"))
+			},
+
+			wantedError: errors.New("get task group name: some error"),
+		},
+		"returns error if fail to confirm": {
+			inGroupName: "db-migrate",
+			setupMocks: func(m *mocks.Mockprompter) {
+				m.EXPECT().Confirm(fmt.Sprintf(fmtTaskStopConfirmPrompt, "db-migrate"), taskStopConfirmHelpPrompt).Return(false, errors.New("some error"))
+			},
+
+			wantedError: errors.New("task stop confirmation prompt: some error"),
+		},
+		"returns error if the user cancels": {
+			inGroupName: "db-migrate",
+			setupMocks: func(m *mocks.Mockprompter) {
+				m.EXPECT().Confirm(fmt.Sprintf(fmtTaskStopConfirmPrompt, "db-migrate"), taskStopConfirmHelpPrompt).Return(false, nil)
+			},
+
+			wantedError: errTaskStopCancelled,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockPrompter := mocks.NewMockprompter(ctrl)
+			tc.setupMocks(mockPrompter)
+
+			opts := &stopTaskOpts{
+				stopTaskVars: stopTaskVars{
+					GlobalOpts: &GlobalOpts{
+						prompt: mockPrompter,
+					},
+					groupName:        tc.inGroupName,
+					skipConfirmation: tc.inSkipConfirmation,
+				},
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedGroupName, opts.groupName)
+		})
+	}
+}
+
+func TestStopTaskOpts_Execute(t *testing.T) {
+	mockTasks := []*ecs.Task{
+		{
+			TaskArn:           aws.String("arn:aws:ecs:us-west-2:123456789012:task/default/4082490ee6c245e09d2145010aa1ba8d"),
+			TaskDefinitionArn: aws.String("arn:aws:ecs:us-west-2:123456789012:task-definition/copilot-db-migrate:3"),
+		},
+		{
+			TaskArn:           aws.String("arn:aws:ecs:us-west-2:123456789012:task/default/9a1f2c3d4e5f60718293a4b5c6d7e8f9"),
+			TaskDefinitionArn: aws.String("arn:aws:ecs:us-west-2:123456789012:task-definition/copilot-seed:1"),
+		},
+	}
+	testCases := map[string]struct {
+		setupMocks func(lister *mocks.MockrunningTasksLister, stopper *mocks.MocktasksStopper, spinner *mocks.Mockprogress)
+
+		wantedError error
+	}{
+		"returns error if fail to list running tasks": {
+			setupMocks: func(lister *mocks.MockrunningTasksLister, stopper *mocks.MocktasksStopper, spinner *mocks.Mockprogress) {
+				lister.EXPECT().RunningTasks("cluster", "copilot-task").Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("list running tasks of group db-migrate: some error"),
+		},
+		"does nothing if there are no running tasks in the group": {
+			setupMocks: func(lister *mocks.MockrunningTasksLister, stopper *mocks.MocktasksStopper, spinner *mocks.Mockprogress) {
+				lister.EXPECT().RunningTasks("cluster", "copilot-task").Return(mockTasks[1:], nil)
+			},
+		},
+		"returns error if fail to stop the tasks": {
+			setupMocks: func(lister *mocks.MockrunningTasksLister, stopper *mocks.MocktasksStopper, spinner *mocks.Mockprogress) {
+				lister.EXPECT().RunningTasks("cluster", "copilot-task").Return(mockTasks, nil)
+				spinner.EXPECT().Start("Stopping 1 task of group db-migrate.")
+				stopper.EXPECT().StopTasks("cluster", []string{aws.StringValue(mockTasks[0].TaskArn)}, taskStopReason).Return(errors.New("some error"))
+				spinner.EXPECT().Stop(gomock.Any())
+			},
+
+			wantedError: errors.New("stop tasks of group db-migrate: some error"),
+		},
+		"stops the running tasks of the group": {
+			setupMocks: func(lister *mocks.MockrunningTasksLister, stopper *mocks.MocktasksStopper, spinner *mocks.Mockprogress) {
+				lister.EXPECT().RunningTasks("cluster", "copilot-task").Return(mockTasks, nil)
+				spinner.EXPECT().Start("Stopping 1 task of group db-migrate.")
+				stopper.EXPECT().StopTasks("cluster", []string{aws.StringValue(mockTasks[0].TaskArn)}, taskStopReason).Return(nil)
+				spinner.EXPECT().Stop(gomock.Any())
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockLister := mocks.NewMockrunningTasksLister(ctrl)
+			mockStopper := mocks.NewMocktasksStopper(ctrl)
+			mockSpinner := mocks.NewMockprogress(ctrl)
+			tc.setupMocks(mockLister, mockStopper, mockSpinner)
+
+			opts := &stopTaskOpts{
+				stopTaskVars: stopTaskVars{
+					GlobalOpts: &GlobalOpts{},
+					groupName:  "db-migrate",
+				},
+				spinner:     mockSpinner,
+				cluster:     "cluster",
+				lister:      mockLister,
+				stopper:     mockStopper,
+				initClients: func() error { return nil },
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
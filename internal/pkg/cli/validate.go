@@ -4,7 +4,6 @@
 package cli
 
 import (
-	"errors"
 	"fmt"
 	"net"
 	"regexp"
@@ -17,25 +16,25 @@ import (
 )
 
 var (
-	errValueEmpty                         = errors.New("value must not be empty")
-	errValueTooLong                       = errors.New("value must not exceed 255 characters")
-	errValueBadFormat                     = errors.New("value must start with a letter and contain only lower-case letters, numbers, and hyphens")
-	errValueNotAString                    = errors.New("value must be a string")
-	errValueNotAStringSlice               = errors.New("value must be a string slice")
-	errValueNotAnIPNet                    = errors.New("value must be a valid IP address range (example: 10.0.0.0/16)")
-	errValueNotIPNetSlice                 = errors.New("value must be a valid slice of IP address range (example: 10.0.0.0/16,10.0.1.0/16)")
-	errInvalidGitHubRepo                  = errors.New("value must be a valid GitHub repository, e.g. https://github.com/myCompany/myRepo")
-	errPortInvalid                        = errors.New("value must be in range 1-65535")
-	errS3ValueBadSize                     = errors.New("value must be between 3 and 63 characters in length")
-	errS3ValueBadFormat                   = errors.New("value must not contain consecutive periods or dashes, or be formatted as IP address")
-	errS3ValueTrailingDash                = errors.New("value must not have trailing -")
-	errValueBadFormatWithPeriod           = errors.New("value must contain only lowercase alphanumeric characters and .-")
-	errDDBValueBadSize                    = errors.New("value must be between 3 and 255 characters in length")
-	errValueBadFormatWithPeriodUnderscore = errors.New("value must contain only alphanumeric characters and ._-")
-	errDDBAttributeBadFormat              = errors.New("value must be of the form <name>:<T> where T is one of S, N, or B")
-	errLSIAttributeNotPresent             = errors.New("lsi must be present in list of attributes")
-	errTooManyLSIKeys                     = errors.New("number of specified LSI sort keys must be 5 or less")
-	errDomainInvalid                      = errors.New("value must contain at least one '.' character")
+	errValueEmpty                         = errInvalidValue("value must not be empty")
+	errValueTooLong                       = errInvalidValue("value must not exceed 255 characters")
+	errValueBadFormat                     = errInvalidValue("value must start with a letter and contain only lower-case letters, numbers, and hyphens")
+	errValueNotAString                    = errInvalidValue("value must be a string")
+	errValueNotAStringSlice               = errInvalidValue("value must be a string slice")
+	errValueNotAnIPNet                    = errInvalidValue("value must be a valid IP address range (example: 10.0.0.0/16)")
+	errValueNotIPNetSlice                 = errInvalidValue("value must be a valid slice of IP address range (example: 10.0.0.0/16,10.0.1.0/16)")
+	errInvalidGitHubRepo                  = errInvalidValue("value must be a valid GitHub repository, e.g. https://github.com/myCompany/myRepo")
+	errPortInvalid                        = errInvalidValue("value must be in range 1-65535")
+	errS3ValueBadSize                     = errInvalidValue("value must be between 3 and 63 characters in length")
+	errS3ValueBadFormat                   = errInvalidValue("value must not contain consecutive periods or dashes, or be formatted as IP address")
+	errS3ValueTrailingDash                = errInvalidValue("value must not have trailing -")
+	errValueBadFormatWithPeriod           = errInvalidValue("value must contain only lowercase alphanumeric characters and .-")
+	errDDBValueBadSize                    = errInvalidValue("value must be between 3 and 255 characters in length")
+	errValueBadFormatWithPeriodUnderscore = errInvalidValue("value must contain only alphanumeric characters and ._-")
+	errDDBAttributeBadFormat              = errInvalidValue("value must be of the form <name>:<T> where T is one of S, N, or B")
+	errLSIAttributeNotPresent             = errInvalidValue("lsi must be present in list of attributes")
+	errTooManyLSIKeys                     = errInvalidValue("number of specified LSI sort keys must be 5 or less")
+	errDomainInvalid                      = errInvalidValue("value must contain at least one '.' character")
 )
 
 var (
@@ -146,6 +145,13 @@ func validateEnvironmentName(val interface{}) error {
 	return nil
 }
 
+func validatePipelineName(val interface{}) error {
+	if err := basicNameValidation(val); err != nil {
+		return fmt.Errorf("pipeline name %v is invalid: %w", val, err)
+	}
+	return nil
+}
+
 func basicNameValidation(val interface{}) error {
 	s, ok := val.(string)
 	if !ok {
@@ -0,0 +1,155 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/cache"
+)
+
+// CachedStore is a Store that memoizes the applications, environments and services it reads from SSM.
+// It is meant for read-only commands where the same configuration is looked up multiple times within
+// a single invocation, and is safe for concurrent use.
+// If a local cache is provided, the configuration is also reused across invocations until it expires.
+type CachedStore struct {
+	*Store
+
+	mu    sync.Mutex
+	mem   map[string][]byte // JSON serialized values so that callers can't modify the cached ones.
+	local *cache.Cache
+}
+
+// NewCachedStore returns a new store that caches the configuration it reads in memory, and in the local cache if it's not nil.
+func NewCachedStore(c *cache.Cache) (*CachedStore, error) {
+	sess, err := sessions.NewProvider().Default()
+	if err != nil {
+		return nil, err
+	}
+	s := newCachedStore(newStore(sess))
+	s.local = c.ForSession(sess)
+	return s, nil
+}
+
+func newCachedStore(s *Store) *CachedStore {
+	return &CachedStore{
+		Store: s,
+		mem:   make(map[string][]byte),
+	}
+}
+
+// GetApplication returns the application by name, reading it from SSM only the first time.
+func (s *CachedStore) GetApplication(appName string) (*Application, error) {
+	var app Application
+	if err := s.cached(fmt.Sprintf(fmtApplicationPath, appName), &app, func() (interface{}, error) {
+		return s.Store.GetApplication(appName)
+	}); err != nil {
+		return nil, err
+	}
+	return &app, nil
+}
+
+// GetEnvironment returns the environment of the application by name, reading it from SSM only the first time.
+func (s *CachedStore) GetEnvironment(appName, envName string) (*Environment, error) {
+	var env Environment
+	if err := s.cached(fmt.Sprintf(fmtEnvParamPath, appName, envName), &env, func() (interface{}, error) {
+		return s.Store.GetEnvironment(appName, envName)
+	}); err != nil {
+		return nil, err
+	}
+	return &env, nil
+}
+
+// GetService returns the service of the application by name, reading it from SSM only the first time.
+func (s *CachedStore) GetService(appName, svcName string) (*Service, error) {
+	var svc Service
+	if err := s.cached(fmt.Sprintf(fmtSvcParamPath, appName, svcName), &svc, func() (interface{}, error) {
+		return s.Store.GetService(appName, svcName)
+	}); err != nil {
+		return nil, err
+	}
+	return &svc, nil
+}
+
+// ListEnvironments returns the environments of the application, reading them from SSM only the first time.
+// The listed environments also populate the cache of GetEnvironment.
+func (s *CachedStore) ListEnvironments(appName string) ([]*Environment, error) {
+	var envs []*Environment
+	if err := s.cached(fmt.Sprintf(rootEnvParamPath, appName), &envs, func() (interface{}, error) {
+		envs, err := s.Store.ListEnvironments(appName)
+		if err != nil {
+			return nil, err
+		}
+		for _, env := range envs {
+			if _, err := s.memoize(fmt.Sprintf(fmtEnvParamPath, appName, env.Name), env); err != nil {
+				return nil, err
+			}
+		}
+		return envs, nil
+	}); err != nil {
+		return nil, err
+	}
+	return envs, nil
+}
+
+// ListServices returns the services of the application, reading them from SSM only the first time.
+// The listed services also populate the cache of GetService.
+func (s *CachedStore) ListServices(appName string) ([]*Service, error) {
+	var svcs []*Service
+	if err := s.cached(fmt.Sprintf(rootSvcParamPath, appName), &svcs, func() (interface{}, error) {
+		svcs, err := s.Store.ListServices(appName)
+		if err != nil {
+			return nil, err
+		}
+		for _, svc := range svcs {
+			if _, err := s.memoize(fmt.Sprintf(fmtSvcParamPath, appName, svc.Name), svc); err != nil {
+				return nil, err
+			}
+		}
+		return svcs, nil
+	}); err != nil {
+		return nil, err
+	}
+	return svcs, nil
+}
+
+// cached unmarshals the value stored under key into v.
+// The value is looked up in memory, then in the local cache, and is fetched only if neither has it.
+// Errors from fetch are returned as is and are not cached.
+func (s *CachedStore) cached(key string, v interface{}, fetch func() (interface{}, error)) error {
+	s.mu.Lock()
+	data, ok := s.mem[key]
+	s.mu.Unlock()
+	if ok {
+		return json.Unmarshal(data, v)
+	}
+	if s.local.Get(key, v) {
+		_, err := s.memoize(key, v)
+		return err
+	}
+	val, err := fetch()
+	if err != nil {
+		return err
+	}
+	s.local.Put(key, val)
+	data, err = s.memoize(key, val)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+func (s *CachedStore) memoize(key string, v interface{}) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("marshal %s: %w", key, err)
+	}
+	s.mu.Lock()
+	s.mem[key] = data
+	s.mu.Unlock()
+	return data, nil
+}
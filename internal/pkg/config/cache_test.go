@@ -0,0 +1,159 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/cache"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCachedStore_GetEnvironment(t *testing.T) {
+	testEnvironment := Environment{Name: "test", AccountID: "12345", App: "chicken", Region: "us-west-2"}
+	testEnvironmentString, err := marshal(testEnvironment)
+	require.NoError(t, err, "Marshal environment should not fail")
+	testEnvironmentPath := fmt.Sprintf(fmtEnvParamPath, testEnvironment.App, testEnvironment.Name)
+
+	testCases := map[string]struct {
+		mockGetParameter func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error)
+
+		wantedCalls int
+		wantedErr   error
+	}{
+		"reads the environment from SSM only once": {
+			mockGetParameter: func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+				require.Equal(t, testEnvironmentPath, *param.Name)
+				return &ssm.GetParameterOutput{
+					Parameter: &ssm.Parameter{
+						Name:  aws.String(testEnvironmentPath),
+						Value: aws.String(testEnvironmentString),
+					},
+				}, nil
+			},
+			wantedCalls: 1,
+		},
+		"doesn't cache errors": {
+			mockGetParameter: func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+				return nil, errors.New("some error")
+			},
+			wantedCalls: 2,
+			wantedErr:   errors.New("get environment test in application chicken: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			var calls int
+			store := newCachedStore(&Store{
+				ssmClient: &mockSSM{
+					t: t,
+					mockGetParameter: func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+						calls++
+						return tc.mockGetParameter(t, param)
+					},
+				},
+			})
+
+			// WHEN
+			for i := 0; i < 2; i++ {
+				env, err := store.GetEnvironment("chicken", "test")
+
+				// THEN
+				if tc.wantedErr != nil {
+					require.EqualError(t, err, tc.wantedErr.Error())
+					continue
+				}
+				require.NoError(t, err)
+				require.Equal(t, testEnvironment, *env)
+				env.Region = "modified" // Callers must not be able to change the cached environment.
+			}
+			require.Equal(t, tc.wantedCalls, calls)
+		})
+	}
+}
+
+func TestCachedStore_ListEnvironments(t *testing.T) {
+	testEnvironment := Environment{Name: "test", AccountID: "12345", App: "chicken", Region: "us-west-2"}
+	testEnvironmentString, err := marshal(testEnvironment)
+	require.NoError(t, err, "Marshal environment should not fail")
+	testEnvironmentPath := fmt.Sprintf(fmtEnvParamPath, testEnvironment.App, testEnvironment.Name)
+
+	var calls int
+	store := newCachedStore(&Store{
+		ssmClient: &mockSSM{
+			t: t,
+			mockGetParametersByPath: func(t *testing.T, param *ssm.GetParametersByPathInput) (*ssm.GetParametersByPathOutput, error) {
+				calls++
+				return &ssm.GetParametersByPathOutput{
+					Parameters: []*ssm.Parameter{
+						{
+							Name:  aws.String(testEnvironmentPath),
+							Value: aws.String(testEnvironmentString),
+						},
+					},
+				}, nil
+			},
+			mockGetParameter: func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+				require.FailNow(t, "listed environments should be served from the cache")
+				return nil, nil
+			},
+		},
+	})
+
+	for i := 0; i < 2; i++ {
+		envs, err := store.ListEnvironments("chicken")
+		require.NoError(t, err)
+		require.Equal(t, []*Environment{&testEnvironment}, envs)
+	}
+	env, err := store.GetEnvironment("chicken", "test")
+	require.NoError(t, err)
+	require.Equal(t, testEnvironment, *env)
+	require.Equal(t, 1, calls)
+}
+
+func TestCachedStore_localCache(t *testing.T) {
+	testEnvironment := Environment{Name: "test", AccountID: "12345", App: "chicken", Region: "us-west-2"}
+	testEnvironmentString, err := marshal(testEnvironment)
+	require.NoError(t, err, "Marshal environment should not fail")
+	dir, err := ioutil.TempDir("", "config")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	local := cache.New(dir, time.Minute)
+
+	var calls int
+	newStore := func() *CachedStore {
+		s := newCachedStore(&Store{
+			ssmClient: &mockSSM{
+				t: t,
+				mockGetParameter: func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+					calls++
+					return &ssm.GetParameterOutput{
+						Parameter: &ssm.Parameter{
+							Value: aws.String(testEnvironmentString),
+						},
+					}, nil
+				},
+			},
+		})
+		s.local = local
+		return s
+	}
+
+	// Each store represents a separate invocation of a command.
+	for i := 0; i < 2; i++ {
+		env, err := newStore().GetEnvironment("chicken", "test")
+		require.NoError(t, err)
+		require.Equal(t, testEnvironment, *env)
+	}
+	require.Equal(t, 1, calls)
+}
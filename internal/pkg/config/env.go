@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"sort"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -23,6 +24,64 @@ type Environment struct {
 	RegistryURL      string `json:"registryURL"`      // URL For ECR Registry for this environment.
 	ExecutionRoleARN string `json:"executionRoleARN"` // ARN used by CloudFormation to make modification to the environment stack.
 	ManagerRoleARN   string `json:"managerRoleARN"`   // ARN for the manager role assumed to manipulate the environment and its services.
+
+	Preview      bool          `json:"preview,omitempty"`      // Whether or not this environment is an ephemeral preview environment.
+	CustomConfig *CustomizeEnv `json:"customConfig,omitempty"` // Custom VPC configuration provided when the environment was created.
+	ExpiresAt    *time.Time    `json:"expiresAt,omitempty"`    // Time after which the environment can be garbage collected.
+	Protected    bool          `json:"protected,omitempty"`    // Whether deploying to or deleting from the environment requires confirming its name.
+}
+
+// IsExpired returns true if the environment has an expiry time that is before t.
+func (e *Environment) IsExpired(t time.Time) bool {
+	if e.ExpiresAt == nil {
+		return false
+	}
+	return e.ExpiresAt.Before(t)
+}
+
+// IsEphemeral returns true if the environment and its services can be torn down without confirmation of each service.
+func (e *Environment) IsEphemeral() bool {
+	return e.Preview || e.ExpiresAt != nil
+}
+
+// CustomizeEnv represents the custom VPC and Global Accelerator configuration of an environment.
+type CustomizeEnv struct {
+	ImportVPC              *ImportVPC         `json:"importVPC,omitempty"`
+	VPCConfig              *AdjustVPC         `json:"adjustVPC,omitempty"`
+	GlobalAccelerator      *GlobalAccelerator `json:"globalAccelerator,omitempty"`
+	EgressEIPAllocationIDs []string           `json:"egressEIPAllocationIDs,omitempty"` // Elastic IPs attached to the NAT gateways.
+	ContainerInsights      bool               `json:"containerInsights,omitempty"`
+	ImportCertARNs         []string           `json:"importCertARNs,omitempty"` // Existing ACM certificates served by the HTTPS listener.
+	AccessLogs             bool               `json:"accessLogs,omitempty"`     // Whether the load balancer stores its access logs in S3.
+	CloudFrontOnly         bool               `json:"cloudFrontOnly,omitempty"` // Whether the load balancer only accepts requests from CloudFront.
+	FlowLogs               *FlowLogs          `json:"flowLogs,omitempty"`
+}
+
+// FlowLogs holds the settings of the flow logs that capture the IP traffic of an environment's VPC.
+type FlowLogs struct {
+	Destination         string `json:"destination"` // Either "cloudwatch" or "s3".
+	AggregationInterval int    `json:"aggregationInterval"`
+	TrafficType         string `json:"trafficType"`
+}
+
+// GlobalAccelerator holds the health check settings of the Global Accelerator in front of an environment's load balancer.
+type GlobalAccelerator struct {
+	HealthCheckPath     string `json:"healthCheckPath"`
+	HealthCheckInterval int    `json:"healthCheckInterval"`
+}
+
+// ImportVPC holds the fields of the existing VPC resources imported into an environment.
+type ImportVPC struct {
+	ID               string   `json:"id"`
+	PublicSubnetIDs  []string `json:"publicSubnetIDs"`
+	PrivateSubnetIDs []string `json:"privateSubnetIDs"`
+}
+
+// AdjustVPC holds the fields used to configure the default VPC resources of an environment.
+type AdjustVPC struct {
+	CIDR               string   `json:"cidr"`
+	PublicSubnetCIDRs  []string `json:"publicSubnetCIDRs"`
+	PrivateSubnetCIDRs []string `json:"privateSubnetCIDRs"`
 }
 
 // CreateEnvironment instantiates a new environment within an existing App. Skip if
@@ -56,6 +115,27 @@ func (s *Store) CreateEnvironment(environment *Environment) error {
 	return nil
 }
 
+// UpdateEnvironment overwrites the configuration of an existing environment.
+func (s *Store) UpdateEnvironment(environment *Environment) error {
+	environmentPath := fmt.Sprintf(fmtEnvParamPath, environment.App, environment.Name)
+	data, err := marshal(environment)
+	if err != nil {
+		return fmt.Errorf("serializing environment %s: %w", environment.Name, err)
+	}
+
+	_, err = s.ssmClient.PutParameter(&ssm.PutParameterInput{
+		Name:        aws.String(environmentPath),
+		Description: aws.String(fmt.Sprintf("The %s deployment stage", environment.Name)),
+		Type:        aws.String(ssm.ParameterTypeString),
+		Value:       aws.String(data),
+		Overwrite:   aws.Bool(true),
+	})
+	if err != nil {
+		return fmt.Errorf("update environment %s in application %s: %w", environment.Name, environment.App, err)
+	}
+	return nil
+}
+
 // GetEnvironment gets an environment belonging to a particular application by name. If no environment is found
 // it returns ErrNoSuchEnvironment.
 func (s *Store) GetEnvironment(appName string, environmentName string) (*Environment, error) {
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -160,6 +161,29 @@ func TestStore_GetEnvironment(t *testing.T) {
 			wantedEnvironment: testEnvironment,
 			wantedErr:         nil,
 		},
+		"with existing preview environment with custom configuration": {
+			mockGetParameter: func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+				require.Equal(t, testEnvironmentPath, *param.Name)
+				return &ssm.GetParameterOutput{
+					Parameter: &ssm.Parameter{
+						Name:  aws.String(testEnvironmentPath),
+						Value: aws.String(`{"app":"chicken","name":"test","preview":true,"customConfig":{"adjustVPC":{"cidr":"10.1.0.0/16","publicSubnetCIDRs":["10.1.0.0/24"],"privateSubnetCIDRs":["10.1.1.0/24"]}}}`),
+					},
+				}, nil
+			},
+			wantedEnvironment: Environment{
+				App:     "chicken",
+				Name:    "test",
+				Preview: true,
+				CustomConfig: &CustomizeEnv{
+					VPCConfig: &AdjustVPC{
+						CIDR:               "10.1.0.0/16",
+						PublicSubnetCIDRs:  []string{"10.1.0.0/24"},
+						PrivateSubnetCIDRs: []string{"10.1.1.0/24"},
+					},
+				},
+			},
+		},
 		"with no existing environment": {
 			mockGetParameter: func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
 				require.Equal(t, testEnvironmentPath, *param.Name)
@@ -308,6 +332,57 @@ func TestStore_CreateEnvironment(t *testing.T) {
 	}
 }
 
+func TestStore_UpdateEnvironment(t *testing.T) {
+	testEnvironment := Environment{Name: "test", App: "chicken", AccountID: "1234", Region: "us-west-2", CustomConfig: &CustomizeEnv{ContainerInsights: true}}
+	testEnvironmentString, err := marshal(testEnvironment)
+	testEnvironmentPath := fmt.Sprintf(fmtEnvParamPath, testEnvironment.App, testEnvironment.Name)
+	require.NoError(t, err, "Marshal environment should not fail")
+
+	testCases := map[string]struct {
+		mockPutParameter func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error)
+		wantedErr        error
+	}{
+		"overwrites the environment": {
+			mockPutParameter: func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				require.Equal(t, testEnvironmentPath, *param.Name)
+				require.Equal(t, testEnvironmentString, *param.Value)
+				require.True(t, *param.Overwrite)
+				return &ssm.PutParameterOutput{
+					Version: aws.Int64(2),
+				}, nil
+			},
+		},
+		"with SSM error": {
+			mockPutParameter: func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				return nil, fmt.Errorf("broken")
+			},
+			wantedErr: fmt.Errorf("update environment test in application chicken: broken"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			store := &Store{
+				ssmClient: &mockSSM{
+					t:                t,
+					mockPutParameter: tc.mockPutParameter,
+				},
+			}
+
+			// WHEN
+			err := store.UpdateEnvironment(&testEnvironment)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestStore_DeleteEnvironment(t *testing.T) {
 	testCases := map[string]struct {
 		inApplicationName string
@@ -364,3 +439,33 @@ func TestStore_DeleteEnvironment(t *testing.T) {
 		})
 	}
 }
+
+func TestEnvironment_IsExpired(t *testing.T) {
+	now := time.Date(2020, 8, 1, 12, 0, 0, 0, time.UTC)
+	before := now.Add(-time.Hour)
+	after := now.Add(time.Hour)
+
+	testCases := map[string]struct {
+		in     Environment
+		wanted bool
+	}{
+		"without expiry": {
+			in:     Environment{Name: "test"},
+			wanted: false,
+		},
+		"expired": {
+			in:     Environment{Name: "test", ExpiresAt: &before},
+			wanted: true,
+		},
+		"not yet expired": {
+			in:     Environment{Name: "test", ExpiresAt: &after},
+			wanted: false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.in.IsExpired(now))
+		})
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
@@ -59,11 +60,15 @@ func NewStore() (*Store, error) {
 		return nil, err
 	}
 
+	return newStore(sess), nil
+}
+
+func newStore(sess *session.Session) *Store {
 	return &Store{
 		idClient:      identity.New(sess),
 		ssmClient:     ssm.New(sess),
 		sessionRegion: *sess.Config.Region,
-	}, nil
+	}
 }
 
 func (s *Store) listParams(path string) ([]*string, error) {
@@ -0,0 +1,321 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package daemon serves the deploy, status and logs operations of the CLI over a local HTTP API,
+// so that tools such as IDE plugins can drive deployments without parsing the output of the CLI.
+//
+// Deployments are long-running: starting one returns an operation whose status can be polled.
+//
+//	POST /v1/deployments                            Start deploying a service, returns the operation.
+//	GET  /v1/operations                             List the operations.
+//	GET  /v1/operations/{id}                        Describe an operation.
+//	GET  /v1/status?app=&service=&env=              Describe the status of a deployed service.
+//	GET  /v1/logs?app=&service=&env=&since=&limit=  Get the recent logs of a deployed service.
+package daemon
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Statuses of an operation.
+const (
+	OperationRunning   = "RUNNING"
+	OperationSucceeded = "SUCCEEDED"
+	OperationFailed    = "FAILED"
+)
+
+const (
+	operationKindDeploy = "deploy"
+
+	defaultLogsLimit = 10
+)
+
+// DeployRequest is the body of a request to deploy a service.
+type DeployRequest struct {
+	App      string `json:"app"`
+	Service  string `json:"service"`
+	Env      string `json:"env"`
+	ImageTag string `json:"imageTag"`
+}
+
+// LogsRequest holds the parameters of a request for the logs of a service.
+type LogsRequest struct {
+	App     string
+	Service string
+	Env     string
+	Since   time.Duration // Only return logs newer than this duration, if set.
+	Limit   int           // Maximum number of log events to return.
+}
+
+// Operation is a long-running operation started through the API.
+type Operation struct {
+	ID        string     `json:"id"`
+	Kind      string     `json:"kind"`
+	App       string     `json:"app"`
+	Service   string     `json:"service"`
+	Env       string     `json:"env"`
+	Status    string     `json:"status"`
+	Error     string     `json:"error,omitempty"`
+	StartedAt time.Time  `json:"startedAt"`
+	EndedAt   *time.Time `json:"endedAt,omitempty"`
+}
+
+// Backend runs the operations of the API.
+type Backend interface {
+	Deploy(req DeployRequest) error
+	Status(app, svc, env string) (json.RawMessage, error)
+	Logs(req LogsRequest) (json.RawMessage, error)
+}
+
+// Server handles the requests of the API.
+type Server struct {
+	backend Backend
+	mux     *http.ServeMux
+	newID   func() string
+	now     func() time.Time
+
+	mu  sync.Mutex
+	ops map[string]*Operation
+	wg  sync.WaitGroup
+}
+
+// New returns a Server that runs the operations with the backend.
+func New(backend Backend) *Server {
+	s := &Server{
+		backend: backend,
+		mux:     http.NewServeMux(),
+		newID: func() string {
+			return uuid.New().String()
+		},
+		now: time.Now,
+		ops: make(map[string]*Operation),
+	}
+	s.mux.HandleFunc("/v1/deployments", s.handleDeployments)
+	s.mux.HandleFunc("/v1/operations", s.handleOperations)
+	s.mux.HandleFunc("/v1/operations/", s.handleOperation)
+	s.mux.HandleFunc("/v1/status", s.handleStatus)
+	s.mux.HandleFunc("/v1/logs", s.handleLogs)
+	return s
+}
+
+// ServeHTTP routes the request to its handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+// Wait blocks until all the running operations are done.
+func (s *Server) Wait() {
+	s.wg.Wait()
+}
+
+func (s *Server) handleDeployments(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s is not allowed", r.Method))
+		return
+	}
+	var req DeployRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("decode request body: %w", err))
+		return
+	}
+	if err := req.validate(); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	op, err := s.startOperation(operationKindDeploy, req)
+	if err != nil {
+		writeError(w, http.StatusConflict, err)
+		return
+	}
+	writeJSON(w, http.StatusAccepted, op)
+}
+
+func (s *Server) handleOperations(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s is not allowed", r.Method))
+		return
+	}
+	s.mu.Lock()
+	ops := make([]Operation, 0, len(s.ops))
+	for _, op := range s.ops {
+		ops = append(ops, *op)
+	}
+	s.mu.Unlock()
+	sort.SliceStable(ops, func(i, j int) bool {
+		return ops[i].StartedAt.Before(ops[j].StartedAt)
+	})
+	writeJSON(w, http.StatusOK, struct {
+		Operations []Operation `json:"operations"`
+	}{
+		Operations: ops,
+	})
+}
+
+func (s *Server) handleOperation(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s is not allowed", r.Method))
+		return
+	}
+	id := strings.TrimPrefix(r.URL.Path, "/v1/operations/")
+	s.mu.Lock()
+	op, ok := s.ops[id]
+	var out Operation
+	if ok {
+		out = *op
+	}
+	s.mu.Unlock()
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Errorf("operation %s not found", id))
+		return
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+func (s *Server) handleStatus(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s is not allowed", r.Method))
+		return
+	}
+	q := r.URL.Query()
+	app, svc, env := q.Get("app"), q.Get("service"), q.Get("env")
+	if err := requireParams(map[string]string{"app": app, "service": svc, "env": env}); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	status, err := s.backend.Status(app, svc, env)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeRawJSON(w, status)
+}
+
+func (s *Server) handleLogs(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s is not allowed", r.Method))
+		return
+	}
+	q := r.URL.Query()
+	req := LogsRequest{
+		App:     q.Get("app"),
+		Service: q.Get("service"),
+		Env:     q.Get("env"),
+		Limit:   defaultLogsLimit,
+	}
+	if err := requireParams(map[string]string{"app": req.App, "service": req.Service, "env": req.Env}); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	if since := q.Get("since"); since != "" {
+		d, err := time.ParseDuration(since)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, fmt.Errorf("since %s must be a duration, such as 10m", since))
+			return
+		}
+		req.Since = d
+	}
+	if limit := q.Get("limit"); limit != "" {
+		n, err := strconv.Atoi(limit)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, fmt.Errorf("limit %s must be a number", limit))
+			return
+		}
+		req.Limit = n
+	}
+	logs, err := s.backend.Logs(req)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeRawJSON(w, logs)
+}
+
+// startOperation runs the deployment in the background and returns its operation.
+// It returns an error if the service is already being deployed to the environment.
+func (s *Server) startOperation(kind string, req DeployRequest) (Operation, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, op := range s.ops {
+		if op.Status == OperationRunning && op.App == req.App && op.Service == req.Service && op.Env == req.Env {
+			return Operation{}, fmt.Errorf("service %s is already being deployed to environment %s by operation %s", req.Service, req.Env, op.ID)
+		}
+	}
+	op := &Operation{
+		ID:        s.newID(),
+		Kind:      kind,
+		App:       req.App,
+		Service:   req.Service,
+		Env:       req.Env,
+		Status:    OperationRunning,
+		StartedAt: s.now(),
+	}
+	s.ops[op.ID] = op
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		err := s.backend.Deploy(req)
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		ended := s.now()
+		op.EndedAt = &ended
+		op.Status = OperationSucceeded
+		if err != nil {
+			op.Status = OperationFailed
+			op.Error = err.Error()
+		}
+	}()
+	return *op, nil
+}
+
+func (r DeployRequest) validate() error {
+	return requireParams(map[string]string{
+		"app":      r.App,
+		"service":  r.Service,
+		"env":      r.Env,
+		"imageTag": r.ImageTag,
+	})
+}
+
+func requireParams(params map[string]string) error {
+	var missing []string
+	for name, val := range params {
+		if val == "" {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	sort.Strings(missing)
+	return errors.New("missing required parameters: " + strings.Join(missing, ", "))
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeRawJSON(w http.ResponseWriter, data json.RawMessage) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
+func writeError(w http.ResponseWriter, code int, err error) {
+	writeJSON(w, code, struct {
+		Error string `json:"error"`
+	}{
+		Error: err.Error(),
+	})
+}
@@ -0,0 +1,248 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package daemon
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type mockBackend struct {
+	deploy func(req DeployRequest) error
+	status func(app, svc, env string) (json.RawMessage, error)
+	logs   func(req LogsRequest) (json.RawMessage, error)
+}
+
+func (m *mockBackend) Deploy(req DeployRequest) error {
+	return m.deploy(req)
+}
+
+func (m *mockBackend) Status(app, svc, env string) (json.RawMessage, error) {
+	return m.status(app, svc, env)
+}
+
+func (m *mockBackend) Logs(req LogsRequest) (json.RawMessage, error) {
+	return m.logs(req)
+}
+
+func newTestServer(backend Backend) *Server {
+	s := New(backend)
+	s.newID = func() string {
+		return "op-1"
+	}
+	s.now = func() time.Time {
+		return time.Date(2020, 11, 1, 12, 0, 0, 0, time.UTC)
+	}
+	return s
+}
+
+func TestServer_Deployments(t *testing.T) {
+	testCases := map[string]struct {
+		inMethod     string
+		inBody       string
+		backend      *mockBackend
+		inRunningOps []*Operation
+
+		wantedCode      int
+		wantedBody      string
+		wantedOperation *Operation
+	}{
+		"method not allowed": {
+			inMethod:   http.MethodGet,
+			wantedCode: http.StatusMethodNotAllowed,
+			wantedBody: `{"error":"method GET is not allowed"}`,
+		},
+		"missing parameters": {
+			inMethod:   http.MethodPost,
+			inBody:     `{"app": "phonetool", "service": "frontend"}`,
+			wantedCode: http.StatusBadRequest,
+			wantedBody: `{"error":"missing required parameters: env, imageTag"}`,
+		},
+		"service is already being deployed": {
+			inMethod: http.MethodPost,
+			inBody:   `{"app": "phonetool", "service": "frontend", "env": "test", "imageTag": "v2"}`,
+			inRunningOps: []*Operation{
+				{ID: "op-0", App: "phonetool", Service: "frontend", Env: "test", Status: OperationRunning},
+			},
+			wantedCode: http.StatusConflict,
+			wantedBody: `{"error":"service frontend is already being deployed to environment test by operation op-0"}`,
+		},
+		"failed deployment": {
+			inMethod: http.MethodPost,
+			inBody:   `{"app": "phonetool", "service": "frontend", "env": "test", "imageTag": "v1"}`,
+			backend: &mockBackend{
+				deploy: func(req DeployRequest) error {
+					require.Equal(t, DeployRequest{App: "phonetool", Service: "frontend", Env: "test", ImageTag: "v1"}, req)
+					return errors.New("some error")
+				},
+			},
+			wantedCode: http.StatusAccepted,
+			wantedBody: `{"id":"op-1","kind":"deploy","app":"phonetool","service":"frontend","env":"test","status":"RUNNING","startedAt":"2020-11-01T12:00:00Z"}`,
+			wantedOperation: &Operation{
+				ID:      "op-1",
+				Kind:    "deploy",
+				App:     "phonetool",
+				Service: "frontend",
+				Env:     "test",
+				Status:  OperationFailed,
+				Error:   "some error",
+			},
+		},
+		"successful deployment": {
+			inMethod: http.MethodPost,
+			inBody:   `{"app": "phonetool", "service": "frontend", "env": "test", "imageTag": "v1"}`,
+			inRunningOps: []*Operation{
+				{ID: "op-0", App: "phonetool", Service: "frontend", Env: "prod", Status: OperationRunning},
+			},
+			backend: &mockBackend{
+				deploy: func(req DeployRequest) error {
+					return nil
+				},
+			},
+			wantedCode: http.StatusAccepted,
+			wantedBody: `{"id":"op-1","kind":"deploy","app":"phonetool","service":"frontend","env":"test","status":"RUNNING","startedAt":"2020-11-01T12:00:00Z"}`,
+			wantedOperation: &Operation{
+				ID:      "op-1",
+				Kind:    "deploy",
+				App:     "phonetool",
+				Service: "frontend",
+				Env:     "test",
+				Status:  OperationSucceeded,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			s := newTestServer(tc.backend)
+			for _, op := range tc.inRunningOps {
+				s.ops[op.ID] = op
+			}
+			rec := httptest.NewRecorder()
+
+			// WHEN
+			s.ServeHTTP(rec, httptest.NewRequest(tc.inMethod, "/v1/deployments", strings.NewReader(tc.inBody)))
+			s.Wait()
+
+			// THEN
+			require.Equal(t, tc.wantedCode, rec.Code)
+			require.JSONEq(t, tc.wantedBody, rec.Body.String())
+			if tc.wantedOperation != nil {
+				op := *s.ops["op-1"]
+				require.NotNil(t, op.EndedAt)
+				op.StartedAt, op.EndedAt = time.Time{}, nil
+				require.Equal(t, *tc.wantedOperation, op)
+			}
+		})
+	}
+}
+
+func TestServer_Operations(t *testing.T) {
+	s := newTestServer(nil)
+	started := time.Date(2020, 11, 1, 12, 0, 0, 0, time.UTC)
+	s.ops["op-2"] = &Operation{ID: "op-2", Status: OperationRunning, StartedAt: started.Add(time.Minute)}
+	s.ops["op-1"] = &Operation{ID: "op-1", Status: OperationSucceeded, StartedAt: started}
+
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/operations", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var list struct {
+		Operations []Operation `json:"operations"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &list))
+	require.Equal(t, "op-1", list.Operations[0].ID)
+	require.Equal(t, "op-2", list.Operations[1].ID)
+
+	rec = httptest.NewRecorder()
+	s.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/operations/op-2", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.JSONEq(t, `{"id":"op-2","kind":"","app":"","service":"","env":"","status":"RUNNING","startedAt":"2020-11-01T12:01:00Z"}`, rec.Body.String())
+
+	rec = httptest.NewRecorder()
+	s.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/operations/op-3", nil))
+	require.Equal(t, http.StatusNotFound, rec.Code)
+	require.JSONEq(t, `{"error":"operation op-3 not found"}`, rec.Body.String())
+}
+
+func TestServer_StatusAndLogs(t *testing.T) {
+	testCases := map[string]struct {
+		inURL   string
+		backend *mockBackend
+
+		wantedCode int
+		wantedBody string
+	}{
+		"status requires the service": {
+			inURL:      "/v1/status?app=phonetool&env=test",
+			wantedCode: http.StatusBadRequest,
+			wantedBody: `{"error":"missing required parameters: service"}`,
+		},
+		"status fails": {
+			inURL: "/v1/status?app=phonetool&service=frontend&env=test",
+			backend: &mockBackend{
+				status: func(app, svc, env string) (json.RawMessage, error) {
+					return nil, errors.New("some error")
+				},
+			},
+			wantedCode: http.StatusInternalServerError,
+			wantedBody: `{"error":"some error"}`,
+		},
+		"returns the status": {
+			inURL: "/v1/status?app=phonetool&service=frontend&env=test",
+			backend: &mockBackend{
+				status: func(app, svc, env string) (json.RawMessage, error) {
+					require.Equal(t, []string{"phonetool", "frontend", "test"}, []string{app, svc, env})
+					return json.RawMessage(`{"tasks":[]}`), nil
+				},
+			},
+			wantedCode: http.StatusOK,
+			wantedBody: `{"tasks":[]}`,
+		},
+		"logs with invalid since": {
+			inURL:      "/v1/logs?app=phonetool&service=frontend&env=test&since=yesterday",
+			wantedCode: http.StatusBadRequest,
+			wantedBody: `{"error":"since yesterday must be a duration, such as 10m"}`,
+		},
+		"returns the logs": {
+			inURL: "/v1/logs?app=phonetool&service=frontend&env=test&since=5m&limit=20",
+			backend: &mockBackend{
+				logs: func(req LogsRequest) (json.RawMessage, error) {
+					require.Equal(t, LogsRequest{
+						App:     "phonetool",
+						Service: "frontend",
+						Env:     "test",
+						Since:   5 * time.Minute,
+						Limit:   20,
+					}, req)
+					return json.RawMessage(`{"events":[]}`), nil
+				},
+			},
+			wantedCode: http.StatusOK,
+			wantedBody: `{"events":[]}`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			s := newTestServer(tc.backend)
+			rec := httptest.NewRecorder()
+
+			// WHEN
+			s.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tc.inURL, nil))
+
+			// THEN
+			require.Equal(t, tc.wantedCode, rec.Code)
+			require.JSONEq(t, tc.wantedBody, rec.Body.String())
+		})
+	}
+}
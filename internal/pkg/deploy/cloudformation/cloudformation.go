@@ -5,6 +5,7 @@
 package cloudformation
 
 import (
+	"path"
 	"strings"
 	"time"
 
@@ -18,6 +19,8 @@ import (
 	"github.com/gobuffalo/packd"
 )
 
+const nestedStackResourceType = "AWS::CloudFormation::Stack"
+
 // StackConfiguration represents the set of methods needed to deploy a cloudformation stack.
 type StackConfiguration interface {
 	StackName() string
@@ -36,6 +39,12 @@ type cfnClient interface {
 	DeleteAndWait(stackName string) error
 	Describe(stackName string) (*cloudformation.StackDescription, error)
 	Events(stackName string) ([]cloudformation.StackEvent, error)
+	WaitForChangeSetExecution(changeSetID string) error
+	CancelUpdate(stackName string) error
+	TemplateBody(stackName string) (string, error)
+	FailedResources(stackName string) ([]string, error)
+	ContinueRollbackAndWait(stackName string, resourcesToSkip []string) error
+	PhysicalResourceIDs(stackName string) (map[string]string, error)
 }
 
 type stackSetClient interface {
@@ -70,27 +79,16 @@ func New(sess *session.Session) CloudFormation {
 }
 
 // streamResourceEvents sends a list of ResourceEvent every 3 seconds to the events channel.
+// The events of nested stacks are discovered as they get created and follow the events of their parent stack.
 // The events channel is closed only when the done channel receives a message.
 // If an error occurs while describing stack events, it is ignored so that the stream is not interrupted.
 func (cf CloudFormation) streamResourceEvents(done <-chan struct{}, events chan []deploy.ResourceEvent, stackName string) {
 	sendStatusUpdates := func() {
 		// Send a list of ResourceEvent to events if there was no error.
-		cfEvents, err := cf.cfnClient.Events(stackName)
+		transformedEvents, err := cf.resourceEvents(stackName, "")
 		if err != nil {
 			return
 		}
-		var transformedEvents []deploy.ResourceEvent
-		for _, cfEvent := range cfEvents {
-			transformedEvents = append(transformedEvents, deploy.ResourceEvent{
-				Resource: deploy.Resource{
-					LogicalName: aws.StringValue(cfEvent.LogicalResourceId),
-					Type:        aws.StringValue(cfEvent.ResourceType),
-				},
-				Status: aws.StringValue(cfEvent.ResourceStatus),
-				// CFN error messages end with a '.' and only the first sentence is useful, the rest is error codes.
-				StatusReason: strings.Split(aws.StringValue(cfEvent.ResourceStatusReason), ".")[0],
-			})
-		}
 		events <- transformedEvents
 	}
 	for {
@@ -106,6 +104,52 @@ func (cf CloudFormation) streamResourceEvents(done <-chan struct{}, events chan
 	}
 }
 
+// resourceEvents returns the events of the stack followed by the events of its nested stacks, in chronological order.
+// The resources of a nested stack are attributed to the path of the nested stack, such as "Parent/Child".
+func (cf CloudFormation) resourceEvents(stackName, parentStack string) ([]deploy.ResourceEvent, error) {
+	cfEvents, err := cf.cfnClient.Events(stackName)
+	if err != nil {
+		return nil, err
+	}
+	var events []deploy.ResourceEvent
+	var nestedStackIDs, nestedStackPaths []string
+	seen := make(map[string]bool)
+	for _, cfEvent := range cfEvents {
+		logicalName := aws.StringValue(cfEvent.LogicalResourceId)
+		physicalID := aws.StringValue(cfEvent.PhysicalResourceId)
+		isSelf := physicalID == aws.StringValue(cfEvent.StackId)
+		if isSelf && parentStack != "" {
+			// The nested stack's own events are already reported by its parent.
+			continue
+		}
+		events = append(events, deploy.ResourceEvent{
+			Resource: deploy.Resource{
+				LogicalName: logicalName,
+				Type:        aws.StringValue(cfEvent.ResourceType),
+				ParentStack: parentStack,
+			},
+			Status: aws.StringValue(cfEvent.ResourceStatus),
+			// CFN error messages end with a '.' and only the first sentence is useful, the rest is error codes.
+			StatusReason: strings.Split(aws.StringValue(cfEvent.ResourceStatusReason), ".")[0],
+		})
+		// The physical ID of a nested stack is its ID, which is only known once CloudFormation starts creating it.
+		if aws.StringValue(cfEvent.ResourceType) != nestedStackResourceType || isSelf || physicalID == "" || seen[physicalID] {
+			continue
+		}
+		seen[physicalID] = true
+		nestedStackIDs = append(nestedStackIDs, physicalID)
+		nestedStackPaths = append(nestedStackPaths, path.Join(parentStack, logicalName))
+	}
+	for i, id := range nestedStackIDs {
+		nestedEvents, err := cf.resourceEvents(id, nestedStackPaths[i])
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, nestedEvents...)
+	}
+	return events, nil
+}
+
 func toStack(config StackConfiguration) (*cloudformation.Stack, error) {
 	template, err := config.Template()
 	if err != nil {
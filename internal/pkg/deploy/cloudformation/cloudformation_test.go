@@ -4,8 +4,17 @@
 package cloudformation
 
 import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/gobuffalo/packd"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
 )
 
 const (
@@ -19,3 +28,122 @@ func boxWithTemplateFile() packd.Box {
 
 	return box
 }
+
+func TestCloudFormation_resourceEvents(t *testing.T) {
+	const (
+		mockStackID       = "arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-test/1"
+		mockNestedStackID = "arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-test-NetworkStack/2"
+	)
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.MockcfnClient)
+
+		wantedEvents []deploy.ResourceEvent
+		wantedError  error
+	}{
+		"returns error if fail to get the events of a nested stack": {
+			setupMocks: func(m *mocks.MockcfnClient) {
+				m.EXPECT().Events("phonetool-test").Return([]cloudformation.StackEvent{
+					{
+						StackId:            aws.String(mockStackID),
+						LogicalResourceId:  aws.String("NetworkStack"),
+						PhysicalResourceId: aws.String(mockNestedStackID),
+						ResourceType:       aws.String("AWS::CloudFormation::Stack"),
+						ResourceStatus:     aws.String("CREATE_IN_PROGRESS"),
+					},
+				}, nil)
+				m.EXPECT().Events(mockNestedStackID).Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("some error"),
+		},
+		"attributes the events of nested stacks to their parent path": {
+			setupMocks: func(m *mocks.MockcfnClient) {
+				m.EXPECT().Events("phonetool-test").Return([]cloudformation.StackEvent{
+					{
+						StackId:            aws.String(mockStackID),
+						LogicalResourceId:  aws.String("phonetool-test"),
+						PhysicalResourceId: aws.String(mockStackID),
+						ResourceType:       aws.String("AWS::CloudFormation::Stack"),
+						ResourceStatus:     aws.String("CREATE_IN_PROGRESS"),
+					},
+					{
+						StackId:           aws.String(mockStackID),
+						LogicalResourceId: aws.String("NetworkStack"),
+						ResourceType:      aws.String("AWS::CloudFormation::Stack"),
+						ResourceStatus:    aws.String("CREATE_IN_PROGRESS"),
+					},
+					{
+						StackId:              aws.String(mockStackID),
+						LogicalResourceId:    aws.String("NetworkStack"),
+						PhysicalResourceId:   aws.String(mockNestedStackID),
+						ResourceType:         aws.String("AWS::CloudFormation::Stack"),
+						ResourceStatus:       aws.String("CREATE_FAILED"),
+						ResourceStatusReason: aws.String("Embedded stack was not successfully created. Rollback requested by user."),
+					},
+				}, nil)
+				m.EXPECT().Events(mockNestedStackID).Return([]cloudformation.StackEvent{
+					{
+						StackId:            aws.String(mockNestedStackID),
+						LogicalResourceId:  aws.String("phonetool-test-NetworkStack"),
+						PhysicalResourceId: aws.String(mockNestedStackID),
+						ResourceType:       aws.String("AWS::CloudFormation::Stack"),
+						ResourceStatus:     aws.String("CREATE_IN_PROGRESS"),
+					},
+					{
+						StackId:              aws.String(mockNestedStackID),
+						LogicalResourceId:    aws.String("VPC"),
+						ResourceType:         aws.String("AWS::EC2::VPC"),
+						ResourceStatus:       aws.String("CREATE_FAILED"),
+						ResourceStatusReason: aws.String("The CIDR is invalid. Status Code: 400"),
+					},
+				}, nil)
+			},
+
+			wantedEvents: []deploy.ResourceEvent{
+				{
+					Resource: deploy.Resource{LogicalName: "phonetool-test", Type: "AWS::CloudFormation::Stack"},
+					Status:   "CREATE_IN_PROGRESS",
+				},
+				{
+					Resource: deploy.Resource{LogicalName: "NetworkStack", Type: "AWS::CloudFormation::Stack"},
+					Status:   "CREATE_IN_PROGRESS",
+				},
+				{
+					Resource:     deploy.Resource{LogicalName: "NetworkStack", Type: "AWS::CloudFormation::Stack"},
+					Status:       "CREATE_FAILED",
+					StatusReason: "Embedded stack was not successfully created",
+				},
+				{
+					Resource:     deploy.Resource{LogicalName: "VPC", Type: "AWS::EC2::VPC", ParentStack: "NetworkStack"},
+					Status:       "CREATE_FAILED",
+					StatusReason: "The CIDR is invalid",
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mocks.NewMockcfnClient(ctrl)
+			tc.setupMocks(m)
+			cf := CloudFormation{
+				cfnClient: m,
+			}
+
+			// WHEN
+			events, err := cf.resourceEvents("phonetool-test", "")
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedEvents, events)
+		})
+	}
+}
@@ -24,6 +24,26 @@ func (cf CloudFormation) DeployEnvironment(env *deploy.CreateEnvironmentInput) e
 	return cf.cfnClient.Create(s)
 }
 
+// UpdateEnvironment updates the CloudFormation stack of an existing environment and waits until the update completes.
+//
+// If the template and parameters of the stack don't change, returns a ErrChangeSetEmpty.
+func (cf CloudFormation) UpdateEnvironment(env *deploy.CreateEnvironmentInput) error {
+	s, err := toStack(stack.NewEnvStackConfig(env))
+	if err != nil {
+		return err
+	}
+	return cf.cfnClient.UpdateAndWait(s)
+}
+
+// EnvironmentTemplate returns the template that the environment's CloudFormation stack was last deployed with.
+func (cf CloudFormation) EnvironmentTemplate(appName, envName string) (string, error) {
+	conf := stack.NewEnvStackConfig(&deploy.CreateEnvironmentInput{
+		AppName: appName,
+		Name:    envName,
+	})
+	return cf.cfnClient.TemplateBody(conf.StackName())
+}
+
 // StreamEnvironmentCreation streams resource update events while a deployment is taking place.
 // Once the CloudFormation stack operation halts, the update channel is closed and a
 // CreateEnvironmentResponse is sent to the second channel.
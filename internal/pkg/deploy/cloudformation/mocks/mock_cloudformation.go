@@ -244,6 +244,93 @@ func (mr *MockcfnClientMockRecorder) Events(stackName interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Events", reflect.TypeOf((*MockcfnClient)(nil).Events), stackName)
 }
 
+// WaitForChangeSetExecution mocks base method
+func (m *MockcfnClient) WaitForChangeSetExecution(changeSetID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitForChangeSetExecution", changeSetID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WaitForChangeSetExecution indicates an expected call of WaitForChangeSetExecution
+func (mr *MockcfnClientMockRecorder) WaitForChangeSetExecution(changeSetID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitForChangeSetExecution", reflect.TypeOf((*MockcfnClient)(nil).WaitForChangeSetExecution), changeSetID)
+}
+
+// CancelUpdate mocks base method
+func (m *MockcfnClient) CancelUpdate(stackName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelUpdate", stackName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelUpdate indicates an expected call of CancelUpdate
+func (mr *MockcfnClientMockRecorder) CancelUpdate(stackName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelUpdate", reflect.TypeOf((*MockcfnClient)(nil).CancelUpdate), stackName)
+}
+
+// TemplateBody mocks base method
+func (m *MockcfnClient) TemplateBody(stackName string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TemplateBody", stackName)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TemplateBody indicates an expected call of TemplateBody
+func (mr *MockcfnClientMockRecorder) TemplateBody(stackName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TemplateBody", reflect.TypeOf((*MockcfnClient)(nil).TemplateBody), stackName)
+}
+
+// FailedResources mocks base method
+func (m *MockcfnClient) FailedResources(stackName string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FailedResources", stackName)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FailedResources indicates an expected call of FailedResources
+func (mr *MockcfnClientMockRecorder) FailedResources(stackName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FailedResources", reflect.TypeOf((*MockcfnClient)(nil).FailedResources), stackName)
+}
+
+// ContinueRollbackAndWait mocks base method
+func (m *MockcfnClient) ContinueRollbackAndWait(stackName string, resourcesToSkip []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContinueRollbackAndWait", stackName, resourcesToSkip)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ContinueRollbackAndWait indicates an expected call of ContinueRollbackAndWait
+func (mr *MockcfnClientMockRecorder) ContinueRollbackAndWait(stackName, resourcesToSkip interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContinueRollbackAndWait", reflect.TypeOf((*MockcfnClient)(nil).ContinueRollbackAndWait), stackName, resourcesToSkip)
+}
+
+// PhysicalResourceIDs mocks base method
+func (m *MockcfnClient) PhysicalResourceIDs(stackName string) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PhysicalResourceIDs", stackName)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PhysicalResourceIDs indicates an expected call of PhysicalResourceIDs
+func (mr *MockcfnClientMockRecorder) PhysicalResourceIDs(stackName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PhysicalResourceIDs", reflect.TypeOf((*MockcfnClient)(nil).PhysicalResourceIDs), stackName)
+}
+
 // MockstackSetClient is a mock of stackSetClient interface
 type MockstackSetClient struct {
 	ctrl     *gomock.Controller
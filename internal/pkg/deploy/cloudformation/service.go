@@ -6,7 +6,12 @@ package cloudformation
 import (
 	"errors"
 	"fmt"
+	"sort"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/addon"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 )
@@ -15,13 +20,10 @@ import (
 // If the service stack doesn't exist, then it creates the stack.
 // If the service stack already exists, it updates the stack.
 func (cf CloudFormation) DeployService(conf StackConfiguration, opts ...cloudformation.StackOption) error {
-	stack, err := toStack(conf)
+	stack, err := toServiceStack(conf, opts...)
 	if err != nil {
 		return err
 	}
-	for _, opt := range opts {
-		opt(stack)
-	}
 
 	err = cf.cfnClient.CreateAndWait(stack)
 	if err == nil { // Created a new stack, stop execution.
@@ -35,7 +37,264 @@ func (cf CloudFormation) DeployService(conf StackConfiguration, opts ...cloudfor
 	return cf.cfnClient.UpdateAndWait(stack)
 }
 
+// DeployServiceWithTimeout deploys a service stack like DeployService, but cancels the deployment if it isn't done
+// within the timeout, such as when the tasks of the service never stabilize. The update of an existing stack is canceled
+// so that CloudFormation rolls it back, while a new stack is deleted. Once the stack is rolled back, it returns
+// a *deploy.ErrDeploymentTimeout.
+func (cf CloudFormation) DeployServiceWithTimeout(conf StackConfiguration, timeout time.Duration, opts ...cloudformation.StackOption) error {
+	done := make(chan error, 1)
+	go func() {
+		done <- cf.DeployService(conf, opts...)
+	}()
+	select {
+	case err := <-done:
+		return err
+	case <-time.After(timeout):
+	}
+
+	stackName := conf.StackName()
+	descr, err := cf.cfnClient.Describe(stackName)
+	if err != nil {
+		return fmt.Errorf("get status of stack %s to cancel its deployment: %w", stackName, err)
+	}
+	switch aws.StringValue(descr.StackStatus) {
+	case sdkcloudformation.StackStatusUpdateInProgress:
+		err = cf.cfnClient.CancelUpdate(stackName)
+	case sdkcloudformation.StackStatusCreateInProgress:
+		err = cf.cfnClient.Delete(stackName)
+	default:
+		// The stack isn't being deployed anymore, such as when it's cleaning up after a successful update.
+		return <-done
+	}
+	if err != nil {
+		return err
+	}
+	// Wait for the rollback so that the service can be deployed again right away.
+	<-done
+	return &deploy.ErrDeploymentTimeout{
+		StackName: stackName,
+		Timeout:   timeout,
+	}
+}
+
+// StartServiceDeployment starts to deploy a service stack like DeployService, but returns without waiting
+// for the deployment to be done. It returns the ID of the change set that deploys the stack, to wait for it later.
+func (cf CloudFormation) StartServiceDeployment(conf StackConfiguration, opts ...cloudformation.StackOption) (string, error) {
+	stack, err := toServiceStack(conf, opts...)
+	if err != nil {
+		return "", err
+	}
+
+	err = cf.cfnClient.Create(stack)
+	if err != nil {
+		var errAlreadyExists *cloudformation.ErrStackAlreadyExists
+		if !errors.As(err, &errAlreadyExists) {
+			return "", err
+		}
+		if err := cf.cfnClient.Update(stack); err != nil {
+			return "", err
+		}
+	}
+	// The stack references the change set that was executed last, which is the one that was just created.
+	descr, err := cf.cfnClient.Describe(stack.Name)
+	if err != nil {
+		return "", err
+	}
+	return aws.StringValue(descr.ChangeSetId), nil
+}
+
+// WaitForDeployment blocks until the deployment started by StartServiceDeployment with the change set ID is done.
+func (cf CloudFormation) WaitForDeployment(changeSetID string) error {
+	return cf.cfnClient.WaitForChangeSetExecution(changeSetID)
+}
+
+func toServiceStack(conf StackConfiguration, opts ...cloudformation.StackOption) (*cloudformation.Stack, error) {
+	stack, err := toStack(conf)
+	if err != nil {
+		return nil, err
+	}
+	for _, opt := range opts {
+		opt(stack)
+	}
+	return stack, nil
+}
+
+// ServiceRollbackFailedResources returns the resources that failed to roll back if the service stack is stuck
+// in UPDATE_ROLLBACK_FAILED. It returns false if the stack doesn't exist or isn't stuck.
+func (cf CloudFormation) ServiceRollbackFailedResources(stackName string) (resources []string, stuck bool, err error) {
+	descr, err := cf.cfnClient.Describe(stackName)
+	if err != nil {
+		var errNotFound *cloudformation.ErrStackNotFound
+		if errors.As(err, &errNotFound) {
+			return nil, false, nil
+		}
+		return nil, false, fmt.Errorf("get status of stack %s: %w", stackName, err)
+	}
+	if aws.StringValue(descr.StackStatus) != sdkcloudformation.StackStatusUpdateRollbackFailed {
+		return nil, false, nil
+	}
+	resources, err = cf.cfnClient.FailedResources(stackName)
+	if err != nil {
+		return nil, false, err
+	}
+	return resources, true, nil
+}
+
+// ContinueServiceRollback rolls back a service stack stuck in UPDATE_ROLLBACK_FAILED, skipping the resources
+// that can't be rolled back, so that the service can be deployed again.
+func (cf CloudFormation) ContinueServiceRollback(stackName string, resourcesToSkip []string) error {
+	return cf.cfnClient.ContinueRollbackAndWait(stackName, resourcesToSkip)
+}
+
+// ServiceStatefulAddons returns the logical IDs of the resources of the addons of a service that hold data,
+// and that would be deleted along with the service. It returns nothing if the service has no addons stack.
+func (cf CloudFormation) ServiceStatefulAddons(in deploy.DeleteServiceInput) ([]string, error) {
+	addonsStack, err := cf.serviceAddonsStack(serviceStackName(in.AppName, in.EnvName, in.Name))
+	if err != nil || addonsStack == "" {
+		return nil, err
+	}
+	template, err := cf.cfnClient.TemplateBody(addonsStack)
+	if err != nil {
+		return nil, err
+	}
+	return addon.UnretainedStatefulResources(template)
+}
+
+// RetainServiceStatefulAddons updates the addons stack of a service so that the resources that hold data
+// outlive the service: databases are deleted after a final snapshot, and the other resources are retained.
+// It returns the resources whose DeletionPolicy was set.
+func (cf CloudFormation) RetainServiceStatefulAddons(in deploy.DeleteServiceInput) ([]deploy.RetainedResource, error) {
+	addonsStack, err := cf.serviceAddonsStack(serviceStackName(in.AppName, in.EnvName, in.Name))
+	if err != nil || addonsStack == "" {
+		return nil, err
+	}
+	template, err := cf.cfnClient.TemplateBody(addonsStack)
+	if err != nil {
+		return nil, err
+	}
+	retainedTemplate, policies, err := addon.RetainStatefulResources(template)
+	if err != nil {
+		return nil, err
+	}
+	if len(policies) == 0 {
+		return nil, nil
+	}
+	if len(retainedTemplate) > cloudformation.MaxTemplateBodySize {
+		return nil, fmt.Errorf("addons template of service %s is larger than %d bytes: set the DeletionPolicy of its resources manually", in.Name, cloudformation.MaxTemplateBodySize)
+	}
+	descr, err := cf.cfnClient.Describe(addonsStack)
+	if err != nil {
+		return nil, fmt.Errorf("describe addons stack of service %s: %w", in.Name, err)
+	}
+	params := make(map[string]string)
+	for _, param := range descr.Parameters {
+		params[aws.StringValue(param.ParameterKey)] = aws.StringValue(param.ParameterValue)
+	}
+	// The addons stack is updated directly instead of through the service stack, since the service stack is deleted right after.
+	err = cf.cfnClient.UpdateAndWait(cloudformation.NewStack(addonsStack, retainedTemplate, cloudformation.WithParameters(params)))
+	if err != nil {
+		var errEmpty *cloudformation.ErrChangeSetEmpty
+		if !errors.As(err, &errEmpty) {
+			return nil, fmt.Errorf("retain stateful resources of the addons of service %s: %w", in.Name, err)
+		}
+	}
+	ids, err := cf.cfnClient.PhysicalResourceIDs(addonsStack)
+	if err != nil {
+		return nil, err
+	}
+	var retained []deploy.RetainedResource
+	for logicalID, policy := range policies {
+		retained = append(retained, deploy.RetainedResource{
+			LogicalID:      logicalID,
+			PhysicalID:     ids[logicalID],
+			DeletionPolicy: policy,
+		})
+	}
+	sort.Slice(retained, func(i, j int) bool {
+		return retained[i].LogicalID < retained[j].LogicalID
+	})
+	return retained, nil
+}
+
+// ServiceAddonDatabases returns the Aurora clusters and RDS DB instances of the addons of a deployed service.
+func (cf CloudFormation) ServiceAddonDatabases(app, env, svc string) ([]deploy.AddonDatabase, error) {
+	addonsStack, err := cf.serviceAddonsStack(serviceStackName(app, env, svc))
+	if err != nil || addonsStack == "" {
+		return nil, err
+	}
+	template, err := cf.cfnClient.TemplateBody(addonsStack)
+	if err != nil {
+		return nil, err
+	}
+	dbs, err := addon.Databases(template)
+	if err != nil || len(dbs) == 0 {
+		return nil, err
+	}
+	ids, err := cf.cfnClient.PhysicalResourceIDs(addonsStack)
+	if err != nil {
+		return nil, err
+	}
+	var databases []deploy.AddonDatabase
+	for _, db := range dbs {
+		databases = append(databases, deploy.AddonDatabase{
+			LogicalID: db.LogicalID,
+			ID:        ids[db.LogicalID],
+			Cluster:   db.Cluster,
+			SecretARN: ids[db.SecretLogicalID],
+		})
+	}
+	return databases, nil
+}
+
+// ServiceAddonBuckets returns the names of the S3 buckets of the addons of a deployed service, keyed by logical ID.
+func (cf CloudFormation) ServiceAddonBuckets(app, env, svc string) (map[string]string, error) {
+	addonsStack, err := cf.serviceAddonsStack(serviceStackName(app, env, svc))
+	if err != nil || addonsStack == "" {
+		return nil, err
+	}
+	template, err := cf.cfnClient.TemplateBody(addonsStack)
+	if err != nil {
+		return nil, err
+	}
+	ids, err := addon.Buckets(template)
+	if err != nil || len(ids) == 0 {
+		return nil, err
+	}
+	physicalIDs, err := cf.cfnClient.PhysicalResourceIDs(addonsStack)
+	if err != nil {
+		return nil, err
+	}
+	buckets := make(map[string]string)
+	for _, id := range ids {
+		buckets[id] = physicalIDs[id]
+	}
+	return buckets, nil
+}
+
+// ServiceTemplate returns the template that the CloudFormation stack of a service was last deployed with.
+func (cf CloudFormation) ServiceTemplate(app, env, svc string) (string, error) {
+	return cf.cfnClient.TemplateBody(serviceStackName(app, env, svc))
+}
+
+// serviceAddonsStack returns the ID of the nested addons stack of a service stack, or an empty string
+// if the service isn't deployed or doesn't have addons.
+func (cf CloudFormation) serviceAddonsStack(stackName string) (string, error) {
+	ids, err := cf.cfnClient.PhysicalResourceIDs(stackName)
+	if err != nil {
+		var errNotFound *cloudformation.ErrStackNotFound
+		if errors.As(err, &errNotFound) {
+			return "", nil
+		}
+		return "", err
+	}
+	return ids[addon.StackName], nil
+}
+
+func serviceStackName(app, env, svc string) string {
+	return fmt.Sprintf("%s-%s-%s", app, env, svc)
+}
+
 // DeleteService removes the CloudFormation stack of a deployed service.
 func (cf CloudFormation) DeleteService(in deploy.DeleteServiceInput) error {
-	return cf.cfnClient.DeleteAndWait(fmt.Sprintf("%s-%s-%s", in.AppName, in.EnvName, in.Name))
+	return cf.cfnClient.DeleteAndWait(serviceStackName(in.AppName, in.EnvName, in.Name))
 }
@@ -4,7 +4,9 @@
 package cloudformation
 
 import (
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
@@ -112,6 +114,602 @@ func TestCloudFormation_DeployService(t *testing.T) {
 	}
 }
 
+func TestCloudFormation_DeployServiceWithTimeout(t *testing.T) {
+	testCases := map[string]struct {
+		inTimeout  time.Duration
+		createMock func(ctrl *gomock.Controller) cfnClient
+
+		wantedErr error
+	}{
+		"returns the result of a deployment done in time": {
+			inTimeout: time.Hour,
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().CreateAndWait(gomock.Any()).Return(errors.New("some error"))
+				return m
+			},
+			wantedErr: errors.New("some error"),
+		},
+		"cancels an update that takes too long": {
+			inTimeout: time.Millisecond,
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				canceled := make(chan struct{})
+				m.EXPECT().CreateAndWait(gomock.Any()).Return(&cloudformation.ErrStackAlreadyExists{
+					Name: "webhook",
+				})
+				m.EXPECT().UpdateAndWait(gomock.Any()).DoAndReturn(func(_ *cloudformation.Stack) error {
+					<-canceled
+					return errors.New("stack webhook was rolled back")
+				})
+				m.EXPECT().Describe("webhook").Return(&cloudformation.StackDescription{
+					StackStatus: aws.String(sdkcloudformation.StackStatusUpdateInProgress),
+				}, nil)
+				m.EXPECT().CancelUpdate("webhook").DoAndReturn(func(_ string) error {
+					close(canceled)
+					return nil
+				})
+				return m
+			},
+			wantedErr: &deploy.ErrDeploymentTimeout{StackName: "webhook", Timeout: time.Millisecond},
+		},
+		"deletes a new stack that takes too long": {
+			inTimeout: time.Millisecond,
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				deleted := make(chan struct{})
+				m.EXPECT().CreateAndWait(gomock.Any()).DoAndReturn(func(_ *cloudformation.Stack) error {
+					<-deleted
+					return errors.New("stack webhook was deleted")
+				})
+				m.EXPECT().Describe("webhook").Return(&cloudformation.StackDescription{
+					StackStatus: aws.String(sdkcloudformation.StackStatusCreateInProgress),
+				}, nil)
+				m.EXPECT().Delete("webhook").DoAndReturn(func(_ string) error {
+					close(deleted)
+					return nil
+				})
+				return m
+			},
+			wantedErr: &deploy.ErrDeploymentTimeout{StackName: "webhook", Timeout: time.Millisecond},
+		},
+		"returns the result of a deployment that's no longer in progress": {
+			inTimeout: time.Millisecond,
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				described := make(chan struct{})
+				m.EXPECT().CreateAndWait(gomock.Any()).DoAndReturn(func(_ *cloudformation.Stack) error {
+					<-described
+					return nil
+				})
+				m.EXPECT().Describe("webhook").DoAndReturn(func(_ string) (*cloudformation.StackDescription, error) {
+					close(described)
+					return &cloudformation.StackDescription{
+						StackStatus: aws.String(sdkcloudformation.StackStatusUpdateCompleteCleanupInProgress),
+					}, nil
+				})
+				return m
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := CloudFormation{
+				cfnClient: tc.createMock(ctrl),
+			}
+			conf := &mockStackConfig{
+				name:     "webhook",
+				template: "template",
+			}
+
+			// WHEN
+			err := c.DeployServiceWithTimeout(conf, tc.inTimeout)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCloudFormation_StartServiceDeployment(t *testing.T) {
+	const mockChangeSetID = "arn:aws:cloudformation:us-west-2:123456789012:changeSet/ecscli-1234/5678"
+	testCases := map[string]struct {
+		createMock func(ctrl *gomock.Controller) cfnClient
+
+		wantedID  string
+		wantedErr error
+	}{
+		"returns the change set ID of a new stack": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Create(gomock.Any()).Return(nil)
+				m.EXPECT().Update(gomock.Any()).Times(0)
+				m.EXPECT().Describe("webhook").Return(&cloudformation.StackDescription{
+					ChangeSetId: aws.String(mockChangeSetID),
+				}, nil)
+				return m
+			},
+			wantedID: mockChangeSetID,
+		},
+		"calls update if the stack already exists": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Create(gomock.Any()).Return(&cloudformation.ErrStackAlreadyExists{
+					Name: "webhook",
+				})
+				m.EXPECT().Update(gomock.Any()).Return(nil)
+				m.EXPECT().Describe("webhook").Return(&cloudformation.StackDescription{
+					ChangeSetId: aws.String(mockChangeSetID),
+				}, nil)
+				return m
+			},
+			wantedID: mockChangeSetID,
+		},
+		"returns the error of the update": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Create(gomock.Any()).Return(&cloudformation.ErrStackAlreadyExists{
+					Name: "webhook",
+				})
+				m.EXPECT().Update(gomock.Any()).Return(errors.New("some error"))
+				return m
+			},
+			wantedErr: errors.New("some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := CloudFormation{
+				cfnClient: tc.createMock(ctrl),
+			}
+			conf := &mockStackConfig{
+				name:     "webhook",
+				template: "template",
+			}
+
+			// WHEN
+			id, err := c.StartServiceDeployment(conf, cloudformation.WithRoleARN("myrole"))
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedID, id)
+		})
+	}
+}
+
+func TestCloudFormation_ServiceRollbackFailedResources(t *testing.T) {
+	testCases := map[string]struct {
+		createMock func(ctrl *gomock.Controller) cfnClient
+
+		wantedResources []string
+		wantedStuck     bool
+		wantedErr       string
+	}{
+		"not stuck if the stack doesn't exist": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe("kudos-test-webhook").Return(nil, &cloudformation.ErrStackNotFound{})
+				return m
+			},
+		},
+		"not stuck if the stack was rolled back": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe("kudos-test-webhook").Return(&cloudformation.StackDescription{
+					StackStatus: aws.String(sdkcloudformation.StackStatusUpdateRollbackComplete),
+				}, nil)
+				m.EXPECT().FailedResources(gomock.Any()).Times(0)
+				return m
+			},
+		},
+		"wraps error from describing the stack": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe("kudos-test-webhook").Return(nil, errors.New("some error"))
+				return m
+			},
+			wantedErr: "get status of stack kudos-test-webhook: some error",
+		},
+		"returns the resources that failed to roll back": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe("kudos-test-webhook").Return(&cloudformation.StackDescription{
+					StackStatus: aws.String(sdkcloudformation.StackStatusUpdateRollbackFailed),
+				}, nil)
+				m.EXPECT().FailedResources("kudos-test-webhook").Return([]string{"Service"}, nil)
+				return m
+			},
+			wantedResources: []string{"Service"},
+			wantedStuck:     true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := CloudFormation{
+				cfnClient: tc.createMock(ctrl),
+			}
+
+			// WHEN
+			resources, stuck, err := c.ServiceRollbackFailedResources("kudos-test-webhook")
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedResources, resources)
+			require.Equal(t, tc.wantedStuck, stuck)
+		})
+	}
+}
+
+func TestCloudFormation_ServiceStatefulAddons(t *testing.T) {
+	const addonsStackID = "arn:aws:cloudformation:us-west-2:1234:stack/kudos-test-webhook-AddonsStack/abc"
+	testCases := map[string]struct {
+		createMock func(ctrl *gomock.Controller) cfnClient
+
+		wantedResources []string
+		wantedErr       string
+	}{
+		"returns nothing if the service isn't deployed": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().PhysicalResourceIDs("kudos-test-webhook").Return(nil, &cloudformation.ErrStackNotFound{})
+				return m
+			},
+		},
+		"returns nothing if the service has no addons": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().PhysicalResourceIDs("kudos-test-webhook").Return(map[string]string{"Service": "webhook"}, nil)
+				m.EXPECT().TemplateBody(gomock.Any()).Times(0)
+				return m
+			},
+		},
+		"returns the stateful resources of the addons": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().PhysicalResourceIDs("kudos-test-webhook").Return(map[string]string{"AddonsStack": addonsStackID}, nil)
+				m.EXPECT().TemplateBody(addonsStackID).Return(`Resources:
+  usersTable:
+    Type: AWS::DynamoDB::Table
+`, nil)
+				return m
+			},
+			wantedResources: []string{"usersTable"},
+		},
+		"returns the error from getting the addons template": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().PhysicalResourceIDs("kudos-test-webhook").Return(map[string]string{"AddonsStack": addonsStackID}, nil)
+				m.EXPECT().TemplateBody(addonsStackID).Return("", errors.New("some error"))
+				return m
+			},
+			wantedErr: "some error",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := CloudFormation{
+				cfnClient: tc.createMock(ctrl),
+			}
+
+			// WHEN
+			resources, err := c.ServiceStatefulAddons(deploy.DeleteServiceInput{
+				Name:    "webhook",
+				EnvName: "test",
+				AppName: "kudos",
+			})
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedResources, resources)
+		})
+	}
+}
+
+func TestCloudFormation_RetainServiceStatefulAddons(t *testing.T) {
+	const addonsStackID = "arn:aws:cloudformation:us-west-2:1234:stack/kudos-test-webhook-AddonsStack/abc"
+	const template = `Resources:
+  usersTable:
+    Type: AWS::DynamoDB::Table
+  ordersDB:
+    Type: AWS::RDS::DBInstance
+`
+	testCases := map[string]struct {
+		createMock func(ctrl *gomock.Controller) cfnClient
+
+		wantedResources []deploy.RetainedResource
+		wantedErr       string
+	}{
+		"does nothing if every stateful resource is retained": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().PhysicalResourceIDs("kudos-test-webhook").Return(map[string]string{"AddonsStack": addonsStackID}, nil)
+				m.EXPECT().TemplateBody(addonsStackID).Return(`Resources:
+  usersTable:
+    Type: AWS::DynamoDB::Table
+    DeletionPolicy: Retain
+`, nil)
+				m.EXPECT().UpdateAndWait(gomock.Any()).Times(0)
+				return m
+			},
+		},
+		"wraps the error from updating the addons stack": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().PhysicalResourceIDs("kudos-test-webhook").Return(map[string]string{"AddonsStack": addonsStackID}, nil)
+				m.EXPECT().TemplateBody(addonsStackID).Return(template, nil)
+				m.EXPECT().Describe(addonsStackID).Return(&cloudformation.StackDescription{}, nil)
+				m.EXPECT().UpdateAndWait(gomock.Any()).Return(errors.New("some error"))
+				return m
+			},
+			wantedErr: "retain stateful resources of the addons of service webhook: some error",
+		},
+		"updates the addons stack with the previous parameters and returns the retained resources": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().PhysicalResourceIDs("kudos-test-webhook").Return(map[string]string{"AddonsStack": addonsStackID}, nil)
+				m.EXPECT().TemplateBody(addonsStackID).Return(template, nil)
+				m.EXPECT().Describe(addonsStackID).Return(&cloudformation.StackDescription{
+					Parameters: []*sdkcloudformation.Parameter{
+						{
+							ParameterKey:   aws.String("App"),
+							ParameterValue: aws.String("kudos"),
+						},
+					},
+				}, nil)
+				m.EXPECT().UpdateAndWait(gomock.Any()).DoAndReturn(func(stack *cloudformation.Stack) error {
+					require.Equal(t, addonsStackID, stack.Name)
+					require.Contains(t, stack.Template, "DeletionPolicy: Retain")
+					require.Contains(t, stack.Template, "DeletionPolicy: Snapshot")
+					require.Equal(t, []*sdkcloudformation.Parameter{
+						{
+							ParameterKey:   aws.String("App"),
+							ParameterValue: aws.String("kudos"),
+						},
+					}, stack.Parameters)
+					return nil
+				})
+				m.EXPECT().PhysicalResourceIDs(addonsStackID).Return(map[string]string{
+					"usersTable": "kudos-test-users",
+					"ordersDB":   "kudos-orders-db",
+				}, nil)
+				return m
+			},
+			wantedResources: []deploy.RetainedResource{
+				{
+					LogicalID:      "ordersDB",
+					PhysicalID:     "kudos-orders-db",
+					DeletionPolicy: "Snapshot",
+				},
+				{
+					LogicalID:      "usersTable",
+					PhysicalID:     "kudos-test-users",
+					DeletionPolicy: "Retain",
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := CloudFormation{
+				cfnClient: tc.createMock(ctrl),
+			}
+
+			// WHEN
+			resources, err := c.RetainServiceStatefulAddons(deploy.DeleteServiceInput{
+				Name:    "webhook",
+				EnvName: "test",
+				AppName: "kudos",
+			})
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedResources, resources)
+		})
+	}
+}
+
+func TestCloudFormation_ServiceAddonDatabases(t *testing.T) {
+	const addonsStackID = "arn:aws:cloudformation:us-west-2:1234:stack/kudos-test-webhook-AddonsStack/abc"
+	testCases := map[string]struct {
+		createMock func(ctrl *gomock.Controller) cfnClient
+
+		wantedDatabases []deploy.AddonDatabase
+		wantedErr       string
+	}{
+		"returns nothing if the service has no addons": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().PhysicalResourceIDs("kudos-test-webhook").Return(map[string]string{"Service": "webhook"}, nil)
+				m.EXPECT().TemplateBody(gomock.Any()).Times(0)
+				return m
+			},
+		},
+		"returns nothing if the addons have no databases": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().PhysicalResourceIDs("kudos-test-webhook").Return(map[string]string{"AddonsStack": addonsStackID}, nil)
+				m.EXPECT().TemplateBody(addonsStackID).Return(`Resources:
+  usersTable:
+    Type: AWS::DynamoDB::Table
+`, nil)
+				return m
+			},
+		},
+		"returns the databases with their identifiers and secrets": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().PhysicalResourceIDs("kudos-test-webhook").Return(map[string]string{"AddonsStack": addonsStackID}, nil)
+				m.EXPECT().TemplateBody(addonsStackID).Return(`Resources:
+  ordersCluster:
+    Type: AWS::RDS::DBCluster
+  ordersSecret:
+    Type: AWS::SecretsManager::Secret
+  ordersSecretAttachment:
+    Type: AWS::SecretsManager::SecretTargetAttachment
+    Properties:
+      SecretId: !Ref ordersSecret
+      TargetId: !Ref ordersCluster
+      TargetType: AWS::RDS::DBCluster
+`, nil)
+				m.EXPECT().PhysicalResourceIDs(addonsStackID).Return(map[string]string{
+					"ordersCluster": "orders-cluster-abc",
+					"ordersSecret":  "arn:aws:secretsmanager:us-west-2:1234:secret:orders-abc",
+				}, nil)
+				return m
+			},
+			wantedDatabases: []deploy.AddonDatabase{
+				{
+					LogicalID: "ordersCluster",
+					ID:        "orders-cluster-abc",
+					Cluster:   true,
+					SecretARN: "arn:aws:secretsmanager:us-west-2:1234:secret:orders-abc",
+				},
+			},
+		},
+		"returns the error from getting the addons template": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().PhysicalResourceIDs("kudos-test-webhook").Return(map[string]string{"AddonsStack": addonsStackID}, nil)
+				m.EXPECT().TemplateBody(addonsStackID).Return("", errors.New("some error"))
+				return m
+			},
+			wantedErr: "some error",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := CloudFormation{
+				cfnClient: tc.createMock(ctrl),
+			}
+
+			// WHEN
+			dbs, err := c.ServiceAddonDatabases("kudos", "test", "webhook")
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedDatabases, dbs)
+		})
+	}
+}
+
+func TestCloudFormation_ServiceAddonBuckets(t *testing.T) {
+	const addonsStackID = "arn:aws:cloudformation:us-west-2:1234:stack/kudos-test-webhook-AddonsStack/abc"
+	testCases := map[string]struct {
+		createMock func(ctrl *gomock.Controller) cfnClient
+
+		wantedBuckets map[string]string
+		wantedErr     string
+	}{
+		"returns nothing if the service has no addons": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().PhysicalResourceIDs("kudos-test-webhook").Return(map[string]string{"Service": "webhook"}, nil)
+				m.EXPECT().TemplateBody(gomock.Any()).Times(0)
+				return m
+			},
+		},
+		"returns the names of the buckets": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().PhysicalResourceIDs("kudos-test-webhook").Return(map[string]string{"AddonsStack": addonsStackID}, nil)
+				m.EXPECT().TemplateBody(addonsStackID).Return(`Resources:
+  assets:
+    Type: AWS::S3::Bucket
+  assetsBucketPolicy:
+    Type: AWS::S3::BucketPolicy
+`, nil)
+				m.EXPECT().PhysicalResourceIDs(addonsStackID).Return(map[string]string{
+					"assets":             "kudos-test-webhook-assets",
+					"assetsBucketPolicy": "policy-id",
+				}, nil)
+				return m
+			},
+			wantedBuckets: map[string]string{
+				"assets": "kudos-test-webhook-assets",
+			},
+		},
+		"returns the error from getting the addons template": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().PhysicalResourceIDs("kudos-test-webhook").Return(map[string]string{"AddonsStack": addonsStackID}, nil)
+				m.EXPECT().TemplateBody(addonsStackID).Return("", errors.New("some error"))
+				return m
+			},
+			wantedErr: "some error",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := CloudFormation{
+				cfnClient: tc.createMock(ctrl),
+			}
+
+			// WHEN
+			buckets, err := c.ServiceAddonBuckets("kudos", "test", "webhook")
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedBuckets, buckets)
+		})
+	}
+}
+
 func TestCloudFormation_DeleteService(t *testing.T) {
 	testCases := map[string]struct {
 		in         deploy.DeleteServiceInput
@@ -65,17 +65,52 @@ func (s *BackendService) Template() (string, error) {
 	if err != nil {
 		return "", err
 	}
+	if err := s.manifest.ValidateSidecarResources(); err != nil {
+		return "", fmt.Errorf("validate the sidecar resources for service %s: %w", s.name, err)
+	}
+	if err := s.manifest.ValidateSidecarDependencies(); err != nil {
+		return "", fmt.Errorf("validate the sidecar dependencies for service %s: %w", s.name, err)
+	}
 	sidecars, err := s.manifest.Sidecar.SidecarsOpts()
 	if err != nil {
 		return "", fmt.Errorf("convert the sidecar configuration for service %s: %w", s.name, err)
 	}
+	events, err := s.manifest.Events.EventsOpts()
+	if err != nil {
+		return "", fmt.Errorf("convert the events configuration for service %s: %w", s.name, err)
+	}
+	deployment, err := s.manifest.Deployment.DeploymentConfigOpts()
+	if err != nil {
+		return "", fmt.Errorf("convert the deployment configuration for service %s: %w", s.name, err)
+	}
+	if s.manifest.Deployment.IsBlueGreen() {
+		return "", fmt.Errorf("service %s: %s deployments require a load balancer and are only supported by %s", s.name, manifest.DeploymentTypeBlueGreen, manifest.LoadBalancedWebServiceType)
+	}
+	autoscaling, err := s.manifest.Count.AutoscalingOpts()
+	if err != nil {
+		return "", fmt.Errorf("convert the autoscaling configuration for service %s: %w", s.name, err)
+	}
+	if autoscaling != nil && autoscaling.Requests != nil {
+		return "", fmt.Errorf("service %s: autoscaling on requests requires a load balancer and is only supported by %s", s.name, manifest.LoadBalancedWebServiceType)
+	}
+	storage, err := s.manifest.StorageOpts()
+	if err != nil {
+		return "", fmt.Errorf("convert the storage configuration for service %s: %w", s.name, err)
+	}
 	content, err := s.parser.ParseBackendService(template.ServiceOpts{
-		Variables:   s.manifest.BackendServiceConfig.Variables,
-		Secrets:     s.manifest.BackendServiceConfig.Secrets,
-		NestedStack: outputs,
-		Sidecars:    sidecars,
-		HealthCheck: s.manifest.BackendServiceConfig.Image.HealthCheckOpts(),
-		LogConfig:   s.manifest.LogConfigOpts(),
+		Variables:         s.manifest.BackendServiceConfig.Variables,
+		Secrets:           s.manifest.BackendServiceConfig.Secrets,
+		NestedStack:       outputs,
+		Sidecars:          sidecars,
+		HealthCheck:       s.manifest.BackendServiceConfig.Image.HealthCheckOpts(),
+		LogConfig:         s.manifest.LogConfigOpts(),
+		Events:            events,
+		Deployment:        deployment,
+		CircuitBreaker:    s.circuitBreakerOpts(),
+		ExecuteCommand:    s.manifest.ExecuteCommandEnabled(),
+		CapacityProviders: s.manifest.Count.CapacityProviderOpts(),
+		Autoscaling:       autoscaling,
+		Storage:           storage,
 	})
 	if err != nil {
 		return "", fmt.Errorf("parse backend service template: %w", err)
@@ -57,6 +57,116 @@ func TestBackendService_Template(t *testing.T) {
 			Port: aws.String("80/80/80"),
 		},
 	}}
+	badResourcesBackendSvcManifest := manifest.NewBackendService(manifest.BackendServiceProps{
+		ServiceProps: manifest.ServiceProps{
+			Name:       "frontend",
+			Dockerfile: "./frontend/Dockerfile",
+		},
+		Port: 8080,
+	})
+	badResourcesBackendSvcManifest.Sidecar = manifest.Sidecar{Sidecars: map[string]*manifest.SidecarConfig{
+		"xray": {
+			ContainerResources: manifest.ContainerResources{
+				CPU: aws.Int(256),
+			},
+		},
+	}}
+	badDependenciesBackendSvcManifest := manifest.NewBackendService(manifest.BackendServiceProps{
+		ServiceProps: manifest.ServiceProps{
+			Name:       "frontend",
+			Dockerfile: "./frontend/Dockerfile",
+		},
+		Port: 8080,
+	})
+	badDependenciesBackendSvcManifest.Sidecar = manifest.Sidecar{Sidecars: map[string]*manifest.SidecarConfig{
+		"xray": {
+			DependsOn: map[string]string{"envoy": "START"},
+		},
+	}}
+	badEventsBackendSvcManifest := manifest.NewBackendService(manifest.BackendServiceProps{
+		ServiceProps: manifest.ServiceProps{
+			Name:       "frontend",
+			Dockerfile: "./frontend/Dockerfile",
+		},
+		Port: 8080,
+	})
+	execBackendSvcManifest := manifest.NewBackendService(manifest.BackendServiceProps{
+		ServiceProps: manifest.ServiceProps{
+			Name:       "frontend",
+			Dockerfile: "./frontend/Dockerfile",
+		},
+		Port: 8080,
+	})
+	execBackendSvcManifest.ExecuteCommand = aws.Bool(true)
+	spotBackendSvcManifest := manifest.NewBackendService(manifest.BackendServiceProps{
+		ServiceProps: manifest.ServiceProps{
+			Name:       "frontend",
+			Dockerfile: "./frontend/Dockerfile",
+		},
+		Port: 8080,
+	})
+	spotBackendSvcManifest.Count = manifest.Count{OnDemand: aws.Int(1), Spot: aws.Int(2)}
+	autoscalingBackendSvcManifest := manifest.NewBackendService(manifest.BackendServiceProps{
+		ServiceProps: manifest.ServiceProps{
+			Name:       "frontend",
+			Dockerfile: "./frontend/Dockerfile",
+		},
+		Port: 8080,
+	})
+	autoscalingBackendSvcManifest.Count = manifest.Count{Range: aws.String("1-10"), CPU: aws.Int(70)}
+	badAutoscalingBackendSvcManifest := manifest.NewBackendService(manifest.BackendServiceProps{
+		ServiceProps: manifest.ServiceProps{
+			Name:       "frontend",
+			Dockerfile: "./frontend/Dockerfile",
+		},
+		Port: 8080,
+	})
+	badAutoscalingBackendSvcManifest.Count = manifest.Count{Range: aws.String("10-1"), CPU: aws.Int(70)}
+	requestsBackendSvcManifest := manifest.NewBackendService(manifest.BackendServiceProps{
+		ServiceProps: manifest.ServiceProps{
+			Name:       "frontend",
+			Dockerfile: "./frontend/Dockerfile",
+		},
+		Port: 8080,
+	})
+	requestsBackendSvcManifest.Count = manifest.Count{Range: aws.String("1-10"), Requests: aws.Int(500)}
+	badStorageBackendSvcManifest := manifest.NewBackendService(manifest.BackendServiceProps{
+		ServiceProps: manifest.ServiceProps{
+			Name:       "frontend",
+			Dockerfile: "./frontend/Dockerfile",
+		},
+		Port: 8080,
+	})
+	badStorageBackendSvcManifest.Storage = &manifest.Storage{Volumes: map[string]*manifest.Volume{
+		"data": {
+			Path: aws.String("var/data"),
+		},
+	}}
+	badEventsBackendSvcManifest.Events = manifest.Events{Events: []manifest.EventRule{
+		{
+			Name: aws.String("orders"),
+		},
+	}}
+	badDeploymentBackendSvcManifest := manifest.NewBackendService(manifest.BackendServiceProps{
+		ServiceProps: manifest.ServiceProps{
+			Name:       "frontend",
+			Dockerfile: "./frontend/Dockerfile",
+		},
+		Port: 8080,
+	})
+	badDeploymentBackendSvcManifest.Deployment = manifest.DeploymentConfig{
+		MaxPercent: aws.Int(50),
+	}
+	blueGreenBackendSvcManifest := manifest.NewBackendService(manifest.BackendServiceProps{
+		ServiceProps: manifest.ServiceProps{
+			Name:       "frontend",
+			Dockerfile: "./frontend/Dockerfile",
+		},
+		Port: 8080,
+	})
+	blueGreenBackendSvcManifest.Deployment = manifest.DeploymentConfig{
+		Type: aws.String("blue/green"),
+	}
 	testCases := map[string]struct {
 		mockDependencies func(t *testing.T, ctrl *gomock.Controller, svc *BackendService)
 		manifest         *manifest.BackendService
@@ -81,6 +191,62 @@ func TestBackendService_Template(t *testing.T) {
 			},
 			wantedErr: fmt.Errorf("convert the sidecar configuration for service frontend: %w", errors.New("cannot parse port mapping from 80/80/80")),
 		},
+		"failed validating sidecar resources": {
+			manifest: badResourcesBackendSvcManifest,
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *BackendService) {
+				svc.addons = mockTemplater{}
+			},
+			wantedErr: fmt.Errorf("validate the sidecar resources for service frontend: %w", errors.New("sidecars reserve 256 CPU units out of the 256 of the task, leaving none for the main container")),
+		},
+		"failed validating sidecar dependencies": {
+			manifest: badDependenciesBackendSvcManifest,
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *BackendService) {
+				svc.addons = mockTemplater{}
+			},
+			wantedErr: fmt.Errorf("validate the sidecar dependencies for service frontend: %w", errors.New("sidecar xray depends on container envoy that doesn't exist")),
+		},
+		"failed parsing events": {
+			manifest: badEventsBackendSvcManifest,
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *BackendService) {
+				svc.addons = mockTemplater{}
+			},
+			wantedErr: fmt.Errorf("convert the events configuration for service frontend: %w", errors.New("event rule orders must have a pattern")),
+		},
+		"failed parsing deployment configuration": {
+			manifest: badDeploymentBackendSvcManifest,
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *BackendService) {
+				svc.addons = mockTemplater{}
+			},
+			wantedErr: fmt.Errorf("convert the deployment configuration for service frontend: %w", errors.New("maximum_percent 50 must be at least 100")),
+		},
+		"blue/green deployment": {
+			manifest: blueGreenBackendSvcManifest,
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *BackendService) {
+				svc.addons = mockTemplater{}
+			},
+			wantedErr: errors.New("service frontend: blue/green deployments require a load balancer and are only supported by Load Balanced Web Service"),
+		},
+		"failed parsing autoscaling configuration": {
+			manifest: badAutoscalingBackendSvcManifest,
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *BackendService) {
+				svc.addons = mockTemplater{}
+			},
+			wantedErr: fmt.Errorf("convert the autoscaling configuration for service frontend: %w", errors.New(`count "range" 10-1 must have a minimum between 0 and its maximum`)),
+		},
+		"autoscaling on requests": {
+			manifest: requestsBackendSvcManifest,
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *BackendService) {
+				svc.addons = mockTemplater{}
+			},
+			wantedErr: errors.New("service frontend: autoscaling on requests requires a load balancer and is only supported by Load Balanced Web Service"),
+		},
+		"failed parsing storage configuration": {
+			manifest: badStorageBackendSvcManifest,
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *BackendService) {
+				svc.addons = mockTemplater{}
+			},
+			wantedErr: fmt.Errorf("convert the storage configuration for service frontend: %w", errors.New(`path "var/data" of volume data must be absolute`)),
+		},
 		"failed parsing svc template": {
 			manifest: testBackendSvcManifest,
 			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *BackendService) {
@@ -112,6 +278,7 @@ func TestBackendService_Template(t *testing.T) {
 						StackName:       addon.StackName,
 						VariableOutputs: []string{"Hello"},
 					},
+					CircuitBreaker: &template.CircuitBreakerOpts{Rollback: true},
 				}).Return(&template.Content{Buffer: bytes.NewBufferString("template")}, nil)
 				svc.parser = m
 				svc.addons = mockTemplater{
@@ -122,6 +289,66 @@ func TestBackendService_Template(t *testing.T) {
 			},
 			wantedTemplate: "template",
 		},
+		"render template without rolling back failed deployments": {
+			manifest: testBackendSvcManifest,
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *BackendService) {
+				m := mocks.NewMockbackendSvcReadParser(ctrl)
+				m.EXPECT().ParseBackendService(gomock.Any()).DoAndReturn(func(opts template.ServiceOpts) (*template.Content, error) {
+					require.Equal(t, &template.CircuitBreakerOpts{Rollback: false}, opts.CircuitBreaker)
+					return &template.Content{Buffer: bytes.NewBufferString("template")}, nil
+				})
+				svc.parser = m
+				svc.addons = mockTemplater{err: &addon.ErrDirNotExist{}}
+				svc.rc.DisableRollback = true
+			},
+			wantedTemplate: "template",
+		},
+		"render template with ECS Exec enabled": {
+			manifest: execBackendSvcManifest,
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *BackendService) {
+				m := mocks.NewMockbackendSvcReadParser(ctrl)
+				m.EXPECT().ParseBackendService(gomock.Any()).DoAndReturn(func(opts template.ServiceOpts) (*template.Content, error) {
+					require.True(t, opts.ExecuteCommand)
+					return &template.Content{Buffer: bytes.NewBufferString("template")}, nil
+				})
+				svc.parser = m
+				svc.addons = mockTemplater{err: &addon.ErrDirNotExist{}}
+			},
+			wantedTemplate: "template",
+		},
+		"render template with tasks on Fargate Spot": {
+			manifest: spotBackendSvcManifest,
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *BackendService) {
+				m := mocks.NewMockbackendSvcReadParser(ctrl)
+				m.EXPECT().ParseBackendService(gomock.Any()).DoAndReturn(func(opts template.ServiceOpts) (*template.Content, error) {
+					require.Equal(t, []*template.CapacityProviderStrategyOpts{
+						{CapacityProvider: "FARGATE", Base: 1},
+						{CapacityProvider: "FARGATE_SPOT", Weight: 1},
+					}, opts.CapacityProviders)
+					return &template.Content{Buffer: bytes.NewBufferString("template")}, nil
+				})
+				svc.parser = m
+				svc.addons = mockTemplater{err: &addon.ErrDirNotExist{}}
+			},
+			wantedTemplate: "template",
+		},
+		"render template with autoscaling": {
+			manifest: autoscalingBackendSvcManifest,
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *BackendService) {
+				m := mocks.NewMockbackendSvcReadParser(ctrl)
+				m.EXPECT().ParseBackendService(gomock.Any()).DoAndReturn(func(opts template.ServiceOpts) (*template.Content, error) {
+					require.Equal(t, &template.AutoscalingOpts{
+						MinCapacity: 1,
+						MaxCapacity: 10,
+						CPU:         aws.Int(70),
+					}, opts.Autoscaling)
+					return &template.Content{Buffer: bytes.NewBufferString("template")}, nil
+				})
+				svc.parser = m
+				svc.addons = mockTemplater{err: &addon.ErrDirNotExist{}}
+			},
+			wantedTemplate: "template",
+		},
 	}
 
 	for name, tc := range testCases {
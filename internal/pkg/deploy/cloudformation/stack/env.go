@@ -39,10 +39,13 @@ const (
 	envParamAppDNSDelegationRoleKey  = "AppDNSDelegationRole"
 
 	// Output keys.
+	EnvOutputVPCID                     = "VpcId"
 	EnvOutputCFNExecutionRoleARN       = "CFNExecutionRoleARN"
 	EnvOutputManagerRoleKey            = "EnvironmentManagerRoleARN"
 	EnvOutputPublicLoadBalancerDNSName = "PublicLoadBalancerDNSName"
+	EnvOutputPublicLoadBalancerZoneKey = "PublicLoadBalancerHostedZone"
 	EnvOutputSubdomain                 = "EnvironmentSubdomain"
+	EnvOutputPublicSubnets             = "PublicSubnets"
 
 	// Default parameter values
 	DefaultVPCCIDR            = "10.0.0.0/16"
@@ -82,6 +85,14 @@ func (e *EnvStackConfig) Template() (string, error) {
 	if e.AdjustVPCOpts() != nil {
 		vpcConf = e.AdjustVPCOpts()
 	}
+	var accessLogs *template.AccessLogsOpts
+	if e.AccessLogs {
+		accessLogs = &template.AccessLogsOpts{
+			Database:  NameForAccessLogsDatabase(e.AppName, e.Name),
+			Table:     AccessLogsTableName,
+			WorkGroup: NameForAccessLogsWorkGroup(e.AppName, e.Name),
+		}
+	}
 
 	content, err := e.parser.ParseEnv(template.EnvOpts{
 		ACMValidationLambda:       acmLambda.String(),
@@ -89,8 +100,16 @@ func (e *EnvStackConfig) Template() (string, error) {
 		EnableLongARNFormatLambda: enableLongARNsLambda.String(),
 		ImportVPC:                 e.ImportVPCOpts(),
 		VPCConfig:                 vpcConf,
+		GlobalAccelerator:         e.GlobalAcceleratorOpts(),
+		EgressEIPAllocationIDs:    e.EgressEIPAllocationIDs,
+		ContainerInsights:         e.ContainerInsights,
+		ImportCertARNs:            e.ImportCertARNs,
+		AccessLogs:                accessLogs,
+		FlowLogs:                  e.FlowLogsOpts(),
+		CloudFrontPrefixListID:    e.CloudFrontPrefixListID,
 	}, template.WithFuncs(map[string]interface{}{
-		"inc": template.IncFunc,
+		"inc":    template.IncFunc,
+		"certID": certificateID,
 	}))
 	if err != nil {
 		return "", err
@@ -137,6 +156,11 @@ func (e *EnvStackConfig) Tags() []*cloudformation.Tag {
 	})
 }
 
+// certificateID returns the alphanumeric ID of an ACM certificate from its ARN, so that it can be used in logical IDs.
+func certificateID(certARN string) string {
+	return template.StripNonAlphaNumFunc(certARN[strings.LastIndex(certARN, "/")+1:])
+}
+
 func (e *EnvStackConfig) dnsDelegationRole() string {
 	if e.ToolsAccountPrincipalARN == "" || e.AppDNSName == "" {
 		return ""
@@ -56,6 +56,58 @@ func TestEnvTemplate(t *testing.T) {
 			},
 			expectedOutput: mockTemplate,
 		},
+		"should render a global accelerator, NAT gateways, Container Insights, imported certificates, access logs, flow logs and CloudFront ingress when configured": {
+			mockDependencies: func(ctrl *gomock.Controller, e *EnvStackConfig) {
+				e.GlobalAcceleratorConfig = &deploy.GlobalAcceleratorConfig{
+					HealthCheckPath:            "/healthz",
+					HealthCheckIntervalSeconds: 10,
+				}
+				e.EgressEIPAllocationIDs = []string{"eipalloc-1", "eipalloc-2"}
+				e.ContainerInsights = true
+				e.ImportCertARNs = []string{"arn:aws:acm:us-west-2:123456789012:certificate/1", "arn:aws:acm:us-west-2:123456789012:certificate/2"}
+				e.AccessLogs = true
+				e.FlowLogsConfig = &deploy.FlowLogsConfig{
+					Destination:                "s3",
+					AggregationIntervalSeconds: 60,
+					TrafficType:                "REJECT",
+				}
+				e.CloudFrontPrefixListID = "pl-82a045eb"
+				m := mocks.NewMockenvReadParser(ctrl)
+				m.EXPECT().Read(dnsDelegationTemplatePath).Return(&template.Content{Buffer: bytes.NewBufferString("customresources")}, nil)
+				m.EXPECT().Read(acmValidationTemplatePath).Return(&template.Content{Buffer: bytes.NewBufferString("customresources")}, nil)
+				m.EXPECT().Read(enableLongARNsTemplatePath).Return(&template.Content{Buffer: bytes.NewBufferString("customresources")}, nil)
+				m.EXPECT().ParseEnv(template.EnvOpts{
+					ACMValidationLambda:       "customresources",
+					DNSDelegationLambda:       "customresources",
+					EnableLongARNFormatLambda: "customresources",
+					VPCConfig: &template.AdjustVPCOpts{
+						CIDR:               DefaultVPCCIDR,
+						PrivateSubnetCIDRs: strings.Split(DefaultPrivateSubnetCIDRs, ","),
+						PublicSubnetCIDRs:  strings.Split(DefaultPublicSubnetCIDRs, ","),
+					},
+					GlobalAccelerator: &template.GlobalAcceleratorOpts{
+						HealthCheckPath:            "/healthz",
+						HealthCheckIntervalSeconds: 10,
+					},
+					EgressEIPAllocationIDs: []string{"eipalloc-1", "eipalloc-2"},
+					ContainerInsights:      true,
+					ImportCertARNs:         []string{"arn:aws:acm:us-west-2:123456789012:certificate/1", "arn:aws:acm:us-west-2:123456789012:certificate/2"},
+					AccessLogs: &template.AccessLogsOpts{
+						Database:  "copilot_project_env",
+						Table:     "alb_access_logs",
+						WorkGroup: "project-env-access-logs",
+					},
+					FlowLogs: &template.FlowLogsOpts{
+						Destination:                "s3",
+						AggregationIntervalSeconds: 60,
+						TrafficType:                "REJECT",
+					},
+					CloudFrontPrefixListID: "pl-82a045eb",
+				}, gomock.Any()).Return(&template.Content{Buffer: bytes.NewBufferString("mockTemplate")}, nil)
+				e.parser = m
+			},
+			expectedOutput: mockTemplate,
+		},
 	}
 
 	for name, tc := range testCases {
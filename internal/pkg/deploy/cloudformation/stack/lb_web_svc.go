@@ -6,6 +6,7 @@ package stack
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
@@ -27,6 +28,17 @@ const (
 	LBWebServiceHealthCheckPathParamKey = "HealthCheckPath"
 	LBWebServiceTargetContainerParamKey = "TargetContainer"
 	LBWebServiceTargetPortParamKey      = "TargetPort"
+	LBWebServiceTaskDefinitionParamKey  = "ServiceTaskDefinition"
+)
+
+// Output logical IDs of a load balanced web service deployed with blue/green deployments.
+const (
+	LBWebServiceTaskDefinitionOutputKey        = "TaskDefinition"
+	LBWebServiceServiceTaskDefinitionOutputKey = "ServiceTaskDefinition"
+	LBWebServiceTargetContainerOutputKey       = "TargetContainer"
+	LBWebServiceTargetPortOutputKey            = "TargetPort"
+	LBWebServiceCodeDeployAppOutputKey         = "CodeDeployApplication"
+	LBWebServiceDeploymentGroupOutputKey       = "CodeDeployDeploymentGroup"
 )
 
 type loadBalancedWebSvcReadParser interface {
@@ -39,6 +51,7 @@ type LoadBalancedWebService struct {
 	*svc
 	manifest     *manifest.LoadBalancedWebService
 	httpsEnabled bool
+	httpsAlias   string
 
 	parser loadBalancedWebSvcReadParser
 }
@@ -83,6 +96,16 @@ func NewHTTPSLoadBalancedWebService(mft *manifest.LoadBalancedWebService, env, a
 	return webSvc, nil
 }
 
+// ServeAliasOverHTTPS routes requests to the alias of the service through the HTTPS listener of the environment.
+// It assumes that the environment imports a certificate that covers the alias.
+func (s *LoadBalancedWebService) ServeAliasOverHTTPS() error {
+	if s.manifest.Alias == nil || aws.StringValue(s.manifest.Alias.Name) == "" {
+		return fmt.Errorf("service %s doesn't have an alias in environment %s", s.name, s.env)
+	}
+	s.httpsAlias = strings.TrimSuffix(aws.StringValue(s.manifest.Alias.Name), ".")
+	return nil
+}
+
 // Template returns the CloudFormation template for the service parametrized for the environment.
 func (s *LoadBalancedWebService) Template() (string, error) {
 	rulePriorityLambda, err := s.parser.Read(lbWebSvcRulePriorityGeneratorPath)
@@ -93,17 +116,61 @@ func (s *LoadBalancedWebService) Template() (string, error) {
 	if err != nil {
 		return "", err
 	}
+	if err := s.manifest.ValidateSidecarResources(); err != nil {
+		return "", fmt.Errorf("validate the sidecar resources for service %s: %w", s.name, err)
+	}
+	if err := s.manifest.ValidateSidecarDependencies(); err != nil {
+		return "", fmt.Errorf("validate the sidecar dependencies for service %s: %w", s.name, err)
+	}
 	sidecars, err := s.manifest.Sidecar.SidecarsOpts()
 	if err != nil {
 		return "", fmt.Errorf("convert the sidecar configuration for service %s: %w", s.name, err)
 	}
+	events, err := s.manifest.Events.EventsOpts()
+	if err != nil {
+		return "", fmt.Errorf("convert the events configuration for service %s: %w", s.name, err)
+	}
+	deployment, err := s.manifest.Deployment.DeploymentConfigOpts()
+	if err != nil {
+		return "", fmt.Errorf("convert the deployment configuration for service %s: %w", s.name, err)
+	}
+	blueGreen, err := s.manifest.Deployment.BlueGreenOpts()
+	if err != nil {
+		return "", fmt.Errorf("convert the blue/green deployment configuration for service %s: %w", s.name, err)
+	}
+	var circuitBreaker *template.CircuitBreakerOpts
+	if blueGreen == nil {
+		circuitBreaker = s.circuitBreakerOpts()
+	}
+	gracePeriod, err := s.manifest.HealthCheckGracePeriodSeconds()
+	if err != nil {
+		return "", fmt.Errorf("convert the health check grace period for service %s: %w", s.name, err)
+	}
+	autoscaling, err := s.manifest.Count.AutoscalingOpts()
+	if err != nil {
+		return "", fmt.Errorf("convert the autoscaling configuration for service %s: %w", s.name, err)
+	}
+	storage, err := s.manifest.StorageOpts()
+	if err != nil {
+		return "", fmt.Errorf("convert the storage configuration for service %s: %w", s.name, err)
+	}
 	content, err := s.parser.ParseLoadBalancedWebService(template.ServiceOpts{
-		Variables:          s.manifest.Variables,
-		Secrets:            s.manifest.Secrets,
-		NestedStack:        outputs,
-		Sidecars:           sidecars,
-		LogConfig:          s.manifest.LogConfigOpts(),
-		RulePriorityLambda: rulePriorityLambda.String(),
+		Variables:              s.manifest.Variables,
+		Secrets:                s.manifest.Secrets,
+		NestedStack:            outputs,
+		Sidecars:               sidecars,
+		LogConfig:              s.manifest.LogConfigOpts(),
+		Events:                 events,
+		Deployment:             deployment,
+		CircuitBreaker:         circuitBreaker,
+		ExecuteCommand:         s.manifest.ExecuteCommandEnabled(),
+		CapacityProviders:      s.manifest.Count.CapacityProviderOpts(),
+		Autoscaling:            autoscaling,
+		Storage:                storage,
+		HealthCheckGracePeriod: gracePeriod,
+		RulePriorityLambda:     rulePriorityLambda.String(),
+		HTTPSAlias:             s.httpsAlias,
+		BlueGreen:              blueGreen,
 	})
 	if err != nil {
 		return "", err
@@ -136,7 +203,7 @@ func (s *LoadBalancedWebService) Parameters() ([]*cloudformation.Parameter, erro
 	if err != nil {
 		return nil, err
 	}
-	return append(s.svc.Parameters(), []*cloudformation.Parameter{
+	params := append(s.svc.Parameters(), []*cloudformation.Parameter{
 		{
 			ParameterKey:   aws.String(LBWebServiceContainerPortParamKey),
 			ParameterValue: aws.String(strconv.FormatUint(uint64(aws.Uint16Value(s.manifest.Image.Port)), 10)),
@@ -161,7 +228,14 @@ func (s *LoadBalancedWebService) Parameters() ([]*cloudformation.Parameter, erro
 			ParameterKey:   aws.String(LBWebServiceTargetPortParamKey),
 			ParameterValue: targetPort,
 		},
-	}...), nil
+	}...)
+	if s.manifest.Deployment.IsBlueGreen() {
+		params = append(params, &cloudformation.Parameter{
+			ParameterKey:   aws.String(LBWebServiceTaskDefinitionParamKey),
+			ParameterValue: aws.String(s.rc.ServiceTaskDefinition),
+		})
+	}
+	return params, nil
 }
 
 // SerializedParameters returns the CloudFormation stack's parameters serialized
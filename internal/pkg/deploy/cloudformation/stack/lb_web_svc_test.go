@@ -142,6 +142,7 @@ func TestLoadBalancedWebService_Template(t *testing.T) {
 				m.EXPECT().Read(lbWebSvcRulePriorityGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("lambda")}, nil)
 				m.EXPECT().ParseLoadBalancedWebService(template.ServiceOpts{
 					RulePriorityLambda: "lambda",
+					CircuitBreaker:     &template.CircuitBreakerOpts{Rollback: true},
 				}).Return(&template.Content{Buffer: bytes.NewBufferString("template")}, nil)
 
 				addons := mockTemplater{err: &addon.ErrDirNotExist{}}
@@ -151,6 +152,24 @@ func TestLoadBalancedWebService_Template(t *testing.T) {
 
 			wantedTemplate: "template",
 		},
+		"render template with an HTTPS alias": {
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, c *LoadBalancedWebService) {
+				m := mocks.NewMockloadBalancedWebSvcReadParser(ctrl)
+				m.EXPECT().Read(lbWebSvcRulePriorityGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("lambda")}, nil)
+				m.EXPECT().ParseLoadBalancedWebService(template.ServiceOpts{
+					RulePriorityLambda: "lambda",
+					CircuitBreaker:     &template.CircuitBreakerOpts{Rollback: true},
+					HTTPSAlias:         "api.example.com",
+				}).Return(&template.Content{Buffer: bytes.NewBufferString("template")}, nil)
+
+				addons := mockTemplater{err: &addon.ErrDirNotExist{}}
+				c.parser = m
+				c.svc.addons = addons
+				c.httpsAlias = "api.example.com"
+			},
+
+			wantedTemplate: "template",
+		},
 		"render template with addons": {
 			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, c *LoadBalancedWebService) {
 				m := mocks.NewMockloadBalancedWebSvcReadParser(ctrl)
@@ -163,6 +182,7 @@ func TestLoadBalancedWebService_Template(t *testing.T) {
 						PolicyOutputs:   []string{"AdditionalResourcesPolicyArn"},
 					},
 					RulePriorityLambda: "lambda",
+					CircuitBreaker:     &template.CircuitBreakerOpts{Rollback: true},
 				}).Return(&template.Content{Buffer: bytes.NewBufferString("template")}, nil)
 				addons := mockTemplater{
 					tpl: `Resources:
@@ -259,6 +279,15 @@ func TestLoadBalancedWebService_Parameters(t *testing.T) {
 		Port: 80,
 	})
 	testLBWebServiceManifestWithBadSidecar.TargetContainer = aws.String("xray")
+	testLBWebServiceManifestWithBlueGreen := manifest.NewLoadBalancedWebService(&manifest.LoadBalancedWebServiceProps{
+		ServiceProps: &manifest.ServiceProps{
+			Name:       "frontend",
+			Dockerfile: "frontend/Dockerfile",
+		},
+		Path: "frontend",
+		Port: 80,
+	})
+	testLBWebServiceManifestWithBlueGreen.Deployment.Type = aws.String("blue/green")
 	expectedParams := []*cloudformation.Parameter{
 		{
 			ParameterKey:   aws.String(ServiceAppNameParamKey),
@@ -310,8 +339,9 @@ func TestLoadBalancedWebService_Parameters(t *testing.T) {
 		},
 	}
 	testCases := map[string]struct {
-		httpsEnabled bool
-		manifest     *manifest.LoadBalancedWebService
+		httpsEnabled            bool
+		manifest                *manifest.LoadBalancedWebService
+		inServiceTaskDefinition string
 
 		expectedParams []*cloudformation.Parameter
 		expectedErr    error
@@ -373,6 +403,30 @@ func TestLoadBalancedWebService_Parameters(t *testing.T) {
 				},
 			}...),
 		},
+		"with blue/green deployment": {
+			httpsEnabled:            false,
+			manifest:                testLBWebServiceManifestWithBlueGreen,
+			inServiceTaskDefinition: "arn:aws:ecs:us-west-2:123456789012:task-definition/phonetool-test-frontend:1",
+
+			expectedParams: append(expectedParams, []*cloudformation.Parameter{
+				{
+					ParameterKey:   aws.String(LBWebServiceHTTPSParamKey),
+					ParameterValue: aws.String("false"),
+				},
+				{
+					ParameterKey:   aws.String(LBWebServiceTargetContainerParamKey),
+					ParameterValue: aws.String("frontend"),
+				},
+				{
+					ParameterKey:   aws.String(LBWebServiceTargetPortParamKey),
+					ParameterValue: aws.String("80"),
+				},
+				{
+					ParameterKey:   aws.String(LBWebServiceTaskDefinitionParamKey),
+					ParameterValue: aws.String("arn:aws:ecs:us-west-2:123456789012:task-definition/phonetool-test-frontend:1"),
+				},
+			}...),
+		},
 		"with bad sidecar container": {
 			httpsEnabled: true,
 			manifest:     testLBWebServiceManifestWithBadSidecar,
@@ -391,8 +445,9 @@ func TestLoadBalancedWebService_Parameters(t *testing.T) {
 					app:  testAppName,
 					tc:   tc.manifest.TaskConfig,
 					rc: RuntimeConfig{
-						ImageRepoURL: testImageRepoURL,
-						ImageTag:     testImageTag,
+						ImageRepoURL:          testImageRepoURL,
+						ImageTag:              testImageTag,
+						ServiceTaskDefinition: tc.inServiceTaskDefinition,
 					},
 				},
 				manifest: tc.manifest,
@@ -516,3 +571,52 @@ func TestLoadBalancedWebService_Tags(t *testing.T) {
 		},
 	}, tags)
 }
+
+func TestLoadBalancedWebService_ServeAliasOverHTTPS(t *testing.T) {
+	testCases := map[string]struct {
+		inAlias *manifest.Alias
+
+		wantedAlias string
+		wantedError error
+	}{
+		"errors if the service doesn't have an alias": {
+			wantedError: errors.New("service frontend doesn't have an alias in environment test"),
+		},
+		"routes the alias without its trailing dot": {
+			inAlias: &manifest.Alias{
+				Name: aws.String("api.example.com."),
+			},
+			wantedAlias: "api.example.com",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			conf := &LoadBalancedWebService{
+				svc: &svc{
+					name: "frontend",
+					env:  "test",
+				},
+				manifest: &manifest.LoadBalancedWebService{
+					LoadBalancedWebServiceConfig: manifest.LoadBalancedWebServiceConfig{
+						RoutingRule: manifest.RoutingRule{
+							Alias: tc.inAlias,
+						},
+					},
+				},
+			}
+
+			// WHEN
+			err := conf.ServeAliasOverHTTPS()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedAlias, conf.httpsAlias)
+		})
+	}
+}
@@ -3,7 +3,13 @@
 
 package stack
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
+
+// AccessLogsTableName is the name of the table over the access logs of the public load balancer of an environment.
+const AccessLogsTableName = "alb_access_logs"
 
 // NameForService returns the stack name for a service.
 func NameForService(app, env, svc string) string {
@@ -22,6 +28,17 @@ func NameForEnv(app, env string) string {
 	return fmt.Sprintf("%s-%s", app, env)
 }
 
+// NameForAccessLogsDatabase returns the name of the Glue database that holds the access logs table of an environment.
+func NameForAccessLogsDatabase(app, env string) string {
+	// Athena only allows underscores in the names of databases.
+	return strings.ReplaceAll(fmt.Sprintf("copilot_%s_%s", app, env), "-", "_")
+}
+
+// NameForAccessLogsWorkGroup returns the name of the Athena workgroup that queries the access logs of an environment.
+func NameForAccessLogsWorkGroup(app, env string) string {
+	return fmt.Sprintf("%s-%s-access-logs", app, env)
+}
+
 // NameForTask returns the stack name for a task.
 func NameForTask(task string) string {
 	return fmt.Sprintf("task-%s", task)
@@ -41,6 +41,10 @@ type RuntimeConfig struct {
 	ImageTag          string            // ImageTag is the container image's unique tag.
 	AddonsTemplateURL string            // Optional. S3 object URL for the addons template.
 	AdditionalTags    map[string]string // AdditionalTags are labels applied to resources in the service stack.
+	// Optional. Task definition that the ECS service of a blue/green deployment was created with, which CloudFormation must not update.
+	ServiceTaskDefinition string
+	// Optional. Keeps the service on the failed deployment when the circuit breaker stops it, instead of rolling back.
+	DisableRollback bool
 }
 
 type templater interface {
@@ -92,7 +96,7 @@ func (s *svc) Parameters() []*cloudformation.Parameter {
 		},
 		{
 			ParameterKey:   aws.String(ServiceTaskCountParamKey),
-			ParameterValue: aws.String(strconv.Itoa(*s.tc.Count)),
+			ParameterValue: aws.String(strconv.Itoa(s.tc.Count.Desired())),
 		},
 		{
 			ParameterKey:   aws.String(ServiceLogRetentionParamKey),
@@ -190,3 +194,11 @@ func envVarOutputNames(outputs []addon.Output) []string {
 	}
 	return envVars
 }
+
+// circuitBreakerOpts returns the deployment circuit breaker of the service, which stops a deployment whose tasks keep
+// failing to start instead of retrying until the stack update times out.
+func (s *svc) circuitBreakerOpts() *template.CircuitBreakerOpts {
+	return &template.CircuitBreakerOpts{
+		Rollback: !s.rc.DisableRollback,
+	}
+}
@@ -21,6 +21,8 @@ const (
 	ServiceTagKey = "copilot-service"
 	// TaskTagKey is tag key for Copilot task.
 	TaskTagKey = "copilot-task"
+	// EnvExpiryTagKey is tag key for the expiry time of a Copilot env.
+	EnvExpiryTagKey = "copilot-expires-at"
 )
 
 const (
@@ -31,6 +33,9 @@ const (
 type Resource struct {
 	LogicalName string
 	Type        string
+	// ParentStack is the path of logical names of the nested stack that the resource belongs to, such as "AddonsStack".
+	// It's empty for the resources of the top-level stack.
+	ParentStack string
 }
 
 // ResourceEvent represents a status update for an AWS resource during a deployment.
@@ -21,6 +21,13 @@ type CreateEnvironmentInput struct {
 	AdditionalTags           map[string]string // AdditionalTags are labels applied to resources under the application.
 	ImportVPCConfig          *ImportVPCConfig
 	AdjustVPCConfig          *AdjustVPCConfig
+	GlobalAcceleratorConfig  *GlobalAcceleratorConfig
+	EgressEIPAllocationIDs   []string // Pre-allocated Elastic IPs to attach to the NAT gateways, one per public subnet.
+	ContainerInsights        bool     // Whether or not CloudWatch Container Insights is enabled on the cluster.
+	ImportCertARNs           []string // ARNs of existing ACM certificates to serve on the HTTPS listener.
+	AccessLogs               bool     // Whether or not the public load balancer stores its access logs in S3.
+	CloudFrontPrefixListID   string   // Managed prefix list of CloudFront in the region, restricts the ingress of the public load balancer if set.
+	FlowLogsConfig           *FlowLogsConfig
 }
 
 // ImportVPCOpts converts the environment's vpc importing configuration into a format parsable by the templates pkg.
@@ -47,6 +54,29 @@ func (e CreateEnvironmentInput) AdjustVPCOpts() *template.AdjustVPCOpts {
 	}
 }
 
+// GlobalAcceleratorOpts converts the environment's Global Accelerator configuration into a format parsable by the templates pkg.
+func (e CreateEnvironmentInput) GlobalAcceleratorOpts() *template.GlobalAcceleratorOpts {
+	if e.GlobalAcceleratorConfig == nil {
+		return nil
+	}
+	return &template.GlobalAcceleratorOpts{
+		HealthCheckPath:            e.GlobalAcceleratorConfig.HealthCheckPath,
+		HealthCheckIntervalSeconds: e.GlobalAcceleratorConfig.HealthCheckIntervalSeconds,
+	}
+}
+
+// FlowLogsOpts converts the environment's VPC flow logs configuration into a format parsable by the templates pkg.
+func (e CreateEnvironmentInput) FlowLogsOpts() *template.FlowLogsOpts {
+	if e.FlowLogsConfig == nil {
+		return nil
+	}
+	return &template.FlowLogsOpts{
+		Destination:                e.FlowLogsConfig.Destination,
+		AggregationIntervalSeconds: e.FlowLogsConfig.AggregationIntervalSeconds,
+		TrafficType:                e.FlowLogsConfig.TrafficType,
+	}
+}
+
 // ImportVPCConfig holds the fields to import VPC resources.
 type ImportVPCConfig struct {
 	ID               string // ID for the VPC.
@@ -61,6 +91,20 @@ type AdjustVPCConfig struct {
 	PrivateSubnetCIDRs []string
 }
 
+// GlobalAcceleratorConfig holds the fields to provision a Global Accelerator with static IP addresses
+// in front of the environment's public load balancer.
+type GlobalAcceleratorConfig struct {
+	HealthCheckPath            string // Path of the load balancer that Global Accelerator health checks.
+	HealthCheckIntervalSeconds int    // Either 10 or 30 seconds.
+}
+
+// FlowLogsConfig holds the fields to capture the IP traffic of the environment's VPC with flow logs.
+type FlowLogsConfig struct {
+	Destination                string // Either "cloudwatch" or "s3".
+	AggregationIntervalSeconds int    // Either 60 or 600 seconds.
+	TrafficType                string // One of "ALL", "ACCEPT" or "REJECT".
+}
+
 // CreateEnvironmentResponse holds the created environment on successful deployment.
 // Otherwise, the environment is set to nil and a descriptive error is returned.
 type CreateEnvironmentResponse struct {
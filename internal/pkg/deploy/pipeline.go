@@ -164,9 +164,59 @@ func (s *Source) Owner() (string, error) {
 type PipelineStage struct {
 	*AssociatedEnvironment
 	LocalServices []string
+	DependsOn     map[string][]string // Local services that a local service must be deployed after.
 	TestCommands  []string
 }
 
+// The approval action runs first in a stage, followed by the service deployments.
+const firstServiceRunOrder = 2
+
+// ServiceRunOrder returns the run order of the action deploying the service in the stage.
+// A service is deployed after the services it depends on, and services that don't depend on each other are deployed in parallel.
+func (s *PipelineStage) ServiceRunOrder(svcName string) int {
+	return firstServiceRunOrder + s.dependencyDepth(svcName, make(map[string]bool))
+}
+
+// TestCommandsRunOrder returns the run order of the test commands action, which runs once all the services are deployed.
+func (s *PipelineStage) TestCommandsRunOrder() int {
+	last := firstServiceRunOrder
+	for _, svc := range s.LocalServices {
+		if order := s.ServiceRunOrder(svc); order > last {
+			last = order
+		}
+	}
+	return last + 1
+}
+
+// dependencyDepth returns the length of the longest chain of local services that the service depends on.
+func (s *PipelineStage) dependencyDepth(svcName string, visiting map[string]bool) int {
+	if visiting[svcName] {
+		// Circular dependencies are rejected before the pipeline is deployed.
+		return 0
+	}
+	visiting[svcName] = true
+	defer delete(visiting, svcName)
+	var depth int
+	for _, dep := range s.DependsOn[svcName] {
+		if !s.isLocalService(dep) {
+			continue
+		}
+		if d := s.dependencyDepth(dep, visiting) + 1; d > depth {
+			depth = d
+		}
+	}
+	return depth
+}
+
+func (s *PipelineStage) isLocalService(svcName string) bool {
+	for _, svc := range s.LocalServices {
+		if svc == svcName {
+			return true
+		}
+	}
+	return false
+}
+
 // ServiceTemplatePath returns the full path to the service CFN template
 // built during the build stage.
 func (s *PipelineStage) ServiceTemplatePath(svcName string) string {
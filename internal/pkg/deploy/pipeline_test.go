@@ -77,3 +77,49 @@ func TestParseOwnerAndRepo(t *testing.T) {
 		})
 	}
 }
+
+func TestPipelineStage_RunOrders(t *testing.T) {
+	testCases := map[string]struct {
+		inStage PipelineStage
+
+		wantedServiceRunOrders  map[string]int
+		wantedTestCommandsOrder int
+	}{
+		"services without dependencies are deployed in parallel": {
+			inStage: PipelineStage{
+				LocalServices: []string{"frontend", "backend"},
+			},
+			wantedServiceRunOrders: map[string]int{
+				"frontend": 2,
+				"backend":  2,
+			},
+			wantedTestCommandsOrder: 3,
+		},
+		"services are deployed after their dependencies": {
+			inStage: PipelineStage{
+				LocalServices: []string{"frontend", "api", "worker", "db"},
+				DependsOn: map[string][]string{
+					"frontend": {"api"},
+					"api":      {"db", "worker"},
+					"worker":   {"db", "external"},
+				},
+			},
+			wantedServiceRunOrders: map[string]int{
+				"frontend": 5,
+				"api":      4,
+				"worker":   3,
+				"db":       2,
+			},
+			wantedTestCommandsOrder: 6,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			for svc, wanted := range tc.wantedServiceRunOrders {
+				require.Equal(t, wanted, tc.inStage.ServiceRunOrder(svc), "run order of service %s", svc)
+			}
+			require.Equal(t, tc.wantedTestCommandsOrder, tc.inStage.TestCommandsRunOrder())
+		})
+	}
+}
@@ -5,9 +5,99 @@
 // This file defines service deployment resources.
 package deploy
 
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
 // DeleteServiceInput holds the fields required to delete a service.
 type DeleteServiceInput struct {
 	Name    string // Name of the service that needs to be deleted.
 	EnvName string // Name of the environment the service is deployed in.
 	AppName string // Name of the application the service belongs to.
 }
+
+// RetainedResource is a stateful resource of the addons of a service that's kept after the service is deleted.
+type RetainedResource struct {
+	LogicalID      string // Logical ID of the resource in the addons template.
+	PhysicalID     string // Physical ID of the resource, such as the name of a DynamoDB table.
+	DeletionPolicy string // "Retain" if the resource is kept, or "Snapshot" if a final snapshot of the database is kept.
+}
+
+// AddonDatabase is an Aurora cluster or an RDS DB instance of the addons of a service.
+type AddonDatabase struct {
+	LogicalID string // Logical ID of the database in the addons template.
+	ID        string // Identifier of the cluster or of the DB instance.
+	Cluster   bool   // True if the database is an Aurora cluster.
+	SecretARN string // ARN of the Secrets Manager secret attached to the database, if any.
+}
+
+// ErrCircularDependency occurs when services depend on each other so that none of them can be deployed first.
+type ErrCircularDependency struct {
+	Services []string // Services in the cycle, starting and ending with the same service.
+}
+
+func (e *ErrCircularDependency) Error() string {
+	return fmt.Sprintf("circular dependency between services: %s", strings.Join(e.Services, " -> "))
+}
+
+// ErrDeploymentTimeout occurs when the stack of a service isn't deployed within the timeout, so its deployment was canceled.
+type ErrDeploymentTimeout struct {
+	StackName string
+	Timeout   time.Duration
+}
+
+func (e *ErrDeploymentTimeout) Error() string {
+	return fmt.Sprintf("stack %s was not deployed within %s, so the deployment was canceled and rolled back", e.StackName, e.Timeout)
+}
+
+// SortByDependencies returns the services ordered so that each service comes after the services it depends on.
+// Otherwise, the services keep their relative order. Dependencies on services that are not in svcs are ignored.
+func SortByDependencies(svcs []string, dependsOn map[string][]string) ([]string, error) {
+	included := make(map[string]bool)
+	for _, svc := range svcs {
+		included[svc] = true
+	}
+	const (
+		visiting = iota + 1
+		visited
+	)
+	state := make(map[string]int)
+	var path, sorted []string
+	var visit func(svc string) error
+	visit = func(svc string) error {
+		switch state[svc] {
+		case visited:
+			return nil
+		case visiting:
+			for i, s := range path {
+				if s == svc {
+					return &ErrCircularDependency{
+						Services: append(append([]string{}, path[i:]...), svc),
+					}
+				}
+			}
+		}
+		state[svc] = visiting
+		path = append(path, svc)
+		for _, dep := range dependsOn[svc] {
+			if !included[dep] {
+				continue
+			}
+			if err := visit(dep); err != nil {
+				return err
+			}
+		}
+		path = path[:len(path)-1]
+		state[svc] = visited
+		sorted = append(sorted, svc)
+		return nil
+	}
+	for _, svc := range svcs {
+		if err := visit(svc); err != nil {
+			return nil, err
+		}
+	}
+	return sorted, nil
+}
@@ -0,0 +1,73 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package deploy
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSortByDependencies(t *testing.T) {
+	testCases := map[string]struct {
+		inSvcs      []string
+		inDependsOn map[string][]string
+
+		wantedSvcs []string
+		wantedErr  error
+	}{
+		"keeps the order of independent services": {
+			inSvcs:     []string{"frontend", "backend"},
+			wantedSvcs: []string{"frontend", "backend"},
+		},
+		"orders services after their dependencies": {
+			inSvcs: []string{"frontend", "api", "worker", "db"},
+			inDependsOn: map[string][]string{
+				"frontend": {"api"},
+				"api":      {"worker", "db"},
+				"worker":   {"db"},
+			},
+			wantedSvcs: []string{"db", "worker", "api", "frontend"},
+		},
+		"ignores dependencies on other services": {
+			inSvcs: []string{"frontend"},
+			inDependsOn: map[string][]string{
+				"frontend": {"api"},
+			},
+			wantedSvcs: []string{"frontend"},
+		},
+		"errors on circular dependencies": {
+			inSvcs: []string{"frontend", "api", "worker"},
+			inDependsOn: map[string][]string{
+				"frontend": {"api"},
+				"api":      {"worker"},
+				"worker":   {"api"},
+			},
+			wantedErr: errors.New("circular dependency between services: api -> worker -> api"),
+		},
+		"errors if a service depends on itself": {
+			inSvcs: []string{"frontend"},
+			inDependsOn: map[string][]string{
+				"frontend": {"frontend"},
+			},
+			wantedErr: errors.New("circular dependency between services: frontend -> frontend"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			svcs, err := SortByDependencies(tc.inSvcs, tc.inDependsOn)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedSvcs, svcs)
+		})
+	}
+}
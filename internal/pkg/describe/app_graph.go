@@ -0,0 +1,267 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/cache"
+)
+
+const (
+	// Shapes of the nodes in the graph.
+	serviceNodeShape   = "service"
+	datastoreNodeShape = "datastore"
+	messagingNodeShape = "messaging"
+)
+
+// graphResourceShapes are the addons resources shown in the graph and their shapes.
+var graphResourceShapes = map[string]string{
+	"AWS::DynamoDB::Table": datastoreNodeShape,
+	"AWS::RDS::DBCluster":  datastoreNodeShape,
+	"AWS::RDS::DBInstance": datastoreNodeShape,
+	"AWS::S3::Bucket":      datastoreNodeShape,
+	"AWS::SQS::Queue":      messagingNodeShape,
+	"AWS::SNS::Topic":      messagingNodeShape,
+}
+
+var nonAlphanumericRegexp = regexp.MustCompile(`[^a-zA-Z0-9]`)
+
+type addonsResourcesDescriber interface {
+	AddonsResources() ([]*cloudformation.StackResource, error)
+}
+
+// AppGrapher retrieves the topology of an application.
+type AppGrapher struct {
+	app          string
+	dependencies map[string][]string
+
+	store                ConfigStoreSvc
+	deployStore          DeployedEnvServicesLister
+	svcDescriber         map[string]addonsResourcesDescriber
+	initServiceDescriber func(env, svc string) error
+}
+
+// NewAppGraphConfig contains fields that initiates AppGrapher struct.
+type NewAppGraphConfig struct {
+	App          string
+	ConfigStore  ConfigStoreSvc
+	DeployStore  DeployedEnvServicesLister
+	Dependencies map[string][]string // Optional. Services that each service depends on.
+	Cache        *cache.Cache        // Optional. Caches the described stacks if set.
+}
+
+// NewAppGrapher instantiates a new application grapher.
+func NewAppGrapher(opt NewAppGraphConfig) (*AppGrapher, error) {
+	grapher := &AppGrapher{
+		app:          opt.App,
+		dependencies: opt.Dependencies,
+		store:        opt.ConfigStore,
+		deployStore:  opt.DeployStore,
+		svcDescriber: make(map[string]addonsResourcesDescriber),
+	}
+	grapher.initServiceDescriber = func(env, svc string) error {
+		key := graphNodeID(env, svc)
+		if _, ok := grapher.svcDescriber[key]; ok {
+			return nil
+		}
+		d, err := NewServiceDescriber(NewServiceConfig{
+			App:         opt.App,
+			Env:         env,
+			Svc:         svc,
+			ConfigStore: opt.ConfigStore,
+			Cache:       opt.Cache,
+		})
+		if err != nil {
+			return err
+		}
+		grapher.svcDescriber[key] = d
+		return nil
+	}
+	return grapher, nil
+}
+
+// Graph returns the environments of the application, the services deployed in them and the resources of their addons.
+func (g *AppGrapher) Graph() (*AppGraph, error) {
+	envs, err := g.store.ListEnvironments(g.app)
+	if err != nil {
+		return nil, fmt.Errorf("list environments in application %s: %w", g.app, err)
+	}
+	svcs, err := g.store.ListServices(g.app)
+	if err != nil {
+		return nil, fmt.Errorf("list services in application %s: %w", g.app, err)
+	}
+	svcTypes := make(map[string]string)
+	for _, svc := range svcs {
+		svcTypes[svc.Name] = svc.Type
+	}
+	graph := &AppGraph{
+		App: g.app,
+	}
+	for _, env := range envs {
+		deployed, err := g.deployStore.ListDeployedServices(g.app, env.Name)
+		if err != nil {
+			return nil, fmt.Errorf("list services deployed in environment %s: %w", env.Name, err)
+		}
+		graphEnv := &GraphEnvironment{
+			Name: env.Name,
+		}
+		isDeployed := make(map[string]bool)
+		for _, svc := range deployed {
+			isDeployed[svc] = true
+		}
+		for _, svc := range deployed {
+			graphSvc, err := g.service(env.Name, svc, svcTypes[svc])
+			if err != nil {
+				return nil, err
+			}
+			for _, dep := range g.dependencies[svc] {
+				// Services reach their dependencies through service discovery, so they must be in the same environment.
+				if isDeployed[dep] {
+					graphSvc.DependsOn = append(graphSvc.DependsOn, dep)
+				}
+			}
+			graphEnv.Services = append(graphEnv.Services, graphSvc)
+		}
+		graph.Environments = append(graph.Environments, graphEnv)
+	}
+	return graph, nil
+}
+
+func (g *AppGrapher) service(env, svc, svcType string) (*GraphService, error) {
+	if err := g.initServiceDescriber(env, svc); err != nil {
+		return nil, err
+	}
+	resources, err := g.svcDescriber[graphNodeID(env, svc)].AddonsResources()
+	if err != nil {
+		return nil, fmt.Errorf("retrieve addons resources of service %s in environment %s: %w", svc, env, err)
+	}
+	graphSvc := &GraphService{
+		Name: svc,
+		Type: svcType,
+	}
+	for _, resource := range resources {
+		if _, ok := graphResourceShapes[aws.StringValue(resource.ResourceType)]; !ok {
+			continue
+		}
+		graphSvc.Resources = append(graphSvc.Resources, &GraphResource{
+			LogicalID: aws.StringValue(resource.LogicalResourceId),
+			Type:      aws.StringValue(resource.ResourceType),
+		})
+	}
+	sort.SliceStable(graphSvc.Resources, func(i, j int) bool {
+		return graphSvc.Resources[i].LogicalID < graphSvc.Resources[j].LogicalID
+	})
+	return graphSvc, nil
+}
+
+// AppGraph contains the topology of an application.
+type AppGraph struct {
+	App          string
+	Environments []*GraphEnvironment
+}
+
+// GraphEnvironment is an environment along with the services deployed in it.
+type GraphEnvironment struct {
+	Name     string
+	Services []*GraphService
+}
+
+// GraphService is a deployed service, the resources created by its addons and the services it depends on.
+type GraphService struct {
+	Name      string
+	Type      string
+	Resources []*GraphResource
+	DependsOn []string
+}
+
+// GraphResource is a queue, topic or datastore created by a service's addons.
+type GraphResource struct {
+	LogicalID string
+	Type      string
+}
+
+// DOT returns the graph in the Graphviz DOT language.
+func (a *AppGraph) DOT() string {
+	shapes := map[string]string{
+		serviceNodeShape:   "box",
+		datastoreNodeShape: "cylinder",
+		messagingNodeShape: "parallelogram",
+	}
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "digraph %q {\n", a.App)
+	fmt.Fprintf(&b, "  rankdir=LR;\n")
+	for _, env := range a.Environments {
+		fmt.Fprintf(&b, "  subgraph %q {\n", "cluster_"+env.Name)
+		fmt.Fprintf(&b, "    label=%q;\n", env.Name)
+		for _, svc := range env.Services {
+			fmt.Fprintf(&b, "    %q [label=%q, shape=%s];\n", graphNodeID(env.Name, svc.Name), svc.Name+"\n"+svc.Type, shapes[serviceNodeShape])
+			for _, resource := range svc.Resources {
+				fmt.Fprintf(&b, "    %q [label=%q, shape=%s];\n", graphNodeID(env.Name, svc.Name, resource.LogicalID), resource.LogicalID+"\n"+resource.Type, shapes[graphResourceShapes[resource.Type]])
+			}
+		}
+		fmt.Fprintf(&b, "  }\n")
+	}
+	for _, env := range a.Environments {
+		for _, svc := range env.Services {
+			for _, dep := range svc.DependsOn {
+				fmt.Fprintf(&b, "  %q -> %q;\n", graphNodeID(env.Name, svc.Name), graphNodeID(env.Name, dep))
+			}
+			for _, resource := range svc.Resources {
+				fmt.Fprintf(&b, "  %q -> %q;\n", graphNodeID(env.Name, svc.Name), graphNodeID(env.Name, svc.Name, resource.LogicalID))
+			}
+		}
+	}
+	fmt.Fprintf(&b, "}\n")
+	return b.String()
+}
+
+// Mermaid returns the graph as a Mermaid flowchart.
+func (a *AppGraph) Mermaid() string {
+	shapes := map[string]string{
+		serviceNodeShape:   `%s["%s"]`,
+		datastoreNodeShape: `%s[("%s")]`,
+		messagingNodeShape: `%s[/"%s"/]`,
+	}
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "graph LR\n")
+	for _, env := range a.Environments {
+		fmt.Fprintf(&b, "  subgraph %s [%s]\n", mermaidNodeID(env.Name), env.Name)
+		for _, svc := range env.Services {
+			fmt.Fprintf(&b, "    "+shapes[serviceNodeShape]+"\n", mermaidNodeID(env.Name, svc.Name), fmt.Sprintf("%s<br/>%s", svc.Name, svc.Type))
+			for _, resource := range svc.Resources {
+				fmt.Fprintf(&b, "    "+shapes[graphResourceShapes[resource.Type]]+"\n", mermaidNodeID(env.Name, svc.Name, resource.LogicalID), fmt.Sprintf("%s<br/>%s", resource.LogicalID, resource.Type))
+			}
+		}
+		fmt.Fprintf(&b, "  end\n")
+	}
+	for _, env := range a.Environments {
+		for _, svc := range env.Services {
+			for _, dep := range svc.DependsOn {
+				fmt.Fprintf(&b, "  %s --> %s\n", mermaidNodeID(env.Name, svc.Name), mermaidNodeID(env.Name, dep))
+			}
+			for _, resource := range svc.Resources {
+				fmt.Fprintf(&b, "  %s --> %s\n", mermaidNodeID(env.Name, svc.Name), mermaidNodeID(env.Name, svc.Name, resource.LogicalID))
+			}
+		}
+	}
+	return b.String()
+}
+
+// graphNodeID returns a unique identifier for a node from the names of its parents and its own name.
+func graphNodeID(names ...string) string {
+	return strings.Join(names, "/")
+}
+
+// mermaidNodeID returns a unique identifier for a node that only contains characters that Mermaid accepts.
+// Environment and service names can't contain underscores, so the identifiers don't collide.
+func mermaidNodeID(names ...string) string {
+	return nonAlphanumericRegexp.ReplaceAllString(strings.Join(names, "__"), "_")
+}
@@ -0,0 +1,202 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type appGrapherMocks struct {
+	store       *mocks.MockConfigStoreSvc
+	deployStore *mocks.MockDeployedEnvServicesLister
+	frontend    *mocks.MockaddonsResourcesDescriber
+	backend     *mocks.MockaddonsResourcesDescriber
+}
+
+func TestAppGrapher_Graph(t *testing.T) {
+	const testApp = "phonetool"
+	testEnvs := []*config.Environment{{Name: "test"}}
+	testSvcs := []*config.Service{
+		{Name: "frontend", Type: manifest.LoadBalancedWebServiceType},
+		{Name: "backend", Type: manifest.BackendServiceType},
+	}
+	mockErr := errors.New("some error")
+	testCases := map[string]struct {
+		setupMocks func(m appGrapherMocks)
+
+		wantedGraph *AppGraph
+		wantedError error
+	}{
+		"return error if fail to list environments": {
+			setupMocks: func(m appGrapherMocks) {
+				m.store.EXPECT().ListEnvironments(testApp).Return(nil, mockErr)
+			},
+			wantedError: fmt.Errorf("list environments in application phonetool: some error"),
+		},
+		"return error if fail to list deployed services": {
+			setupMocks: func(m appGrapherMocks) {
+				m.store.EXPECT().ListEnvironments(testApp).Return(testEnvs, nil)
+				m.store.EXPECT().ListServices(testApp).Return(testSvcs, nil)
+				m.deployStore.EXPECT().ListDeployedServices(testApp, "test").Return(nil, mockErr)
+			},
+			wantedError: fmt.Errorf("list services deployed in environment test: some error"),
+		},
+		"return error if fail to retrieve addons resources": {
+			setupMocks: func(m appGrapherMocks) {
+				m.store.EXPECT().ListEnvironments(testApp).Return(testEnvs, nil)
+				m.store.EXPECT().ListServices(testApp).Return(testSvcs, nil)
+				m.deployStore.EXPECT().ListDeployedServices(testApp, "test").Return([]string{"frontend"}, nil)
+				m.frontend.EXPECT().AddonsResources().Return(nil, mockErr)
+			},
+			wantedError: fmt.Errorf("retrieve addons resources of service frontend in environment test: some error"),
+		},
+		"success": {
+			setupMocks: func(m appGrapherMocks) {
+				m.store.EXPECT().ListEnvironments(testApp).Return(testEnvs, nil)
+				m.store.EXPECT().ListServices(testApp).Return(testSvcs, nil)
+				m.deployStore.EXPECT().ListDeployedServices(testApp, "test").Return([]string{"frontend", "backend"}, nil)
+				m.frontend.EXPECT().AddonsResources().Return(nil, nil)
+				m.backend.EXPECT().AddonsResources().Return([]*cloudformation.StackResource{
+					{
+						LogicalResourceId: aws.String("OrdersTable"),
+						ResourceType:      aws.String("AWS::DynamoDB::Table"),
+					},
+					{
+						LogicalResourceId: aws.String("OrdersAccessPolicy"),
+						ResourceType:      aws.String("AWS::IAM::ManagedPolicy"),
+					},
+					{
+						LogicalResourceId: aws.String("EventsQueue"),
+						ResourceType:      aws.String("AWS::SQS::Queue"),
+					},
+				}, nil)
+			},
+			wantedGraph: &AppGraph{
+				App: testApp,
+				Environments: []*GraphEnvironment{
+					{
+						Name: "test",
+						Services: []*GraphService{
+							{
+								Name:      "frontend",
+								Type:      manifest.LoadBalancedWebServiceType,
+								DependsOn: []string{"backend"},
+							},
+							{
+								Name: "backend",
+								Type: manifest.BackendServiceType,
+								Resources: []*GraphResource{
+									{LogicalID: "EventsQueue", Type: "AWS::SQS::Queue"},
+									{LogicalID: "OrdersTable", Type: "AWS::DynamoDB::Table"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := appGrapherMocks{
+				store:       mocks.NewMockConfigStoreSvc(ctrl),
+				deployStore: mocks.NewMockDeployedEnvServicesLister(ctrl),
+				frontend:    mocks.NewMockaddonsResourcesDescriber(ctrl),
+				backend:     mocks.NewMockaddonsResourcesDescriber(ctrl),
+			}
+			tc.setupMocks(m)
+
+			g := &AppGrapher{
+				app: testApp,
+				dependencies: map[string][]string{
+					"frontend": {"backend", "payments"},
+				},
+				store:       m.store,
+				deployStore: m.deployStore,
+				svcDescriber: map[string]addonsResourcesDescriber{
+					"test/frontend": m.frontend,
+					"test/backend":  m.backend,
+				},
+				initServiceDescriber: func(string, string) error { return nil },
+			}
+
+			// WHEN
+			graph, err := g.Graph()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedGraph, graph)
+		})
+	}
+}
+
+func TestAppGraph_String(t *testing.T) {
+	graph := &AppGraph{
+		App: "phonetool",
+		Environments: []*GraphEnvironment{
+			{
+				Name: "test",
+				Services: []*GraphService{
+					{
+						Name:      "frontend",
+						Type:      manifest.LoadBalancedWebServiceType,
+						DependsOn: []string{"backend"},
+					},
+					{
+						Name: "backend",
+						Type: manifest.BackendServiceType,
+						Resources: []*GraphResource{
+							{LogicalID: "EventsQueue", Type: "AWS::SQS::Queue"},
+							{LogicalID: "OrdersTable", Type: "AWS::DynamoDB::Table"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	require.Equal(t, `digraph "phonetool" {
+  rankdir=LR;
+  subgraph "cluster_test" {
+    label="test";
+    "test/frontend" [label="frontend\nLoad Balanced Web Service", shape=box];
+    "test/backend" [label="backend\nBackend Service", shape=box];
+    "test/backend/EventsQueue" [label="EventsQueue\nAWS::SQS::Queue", shape=parallelogram];
+    "test/backend/OrdersTable" [label="OrdersTable\nAWS::DynamoDB::Table", shape=cylinder];
+  }
+  "test/frontend" -> "test/backend";
+  "test/backend" -> "test/backend/EventsQueue";
+  "test/backend" -> "test/backend/OrdersTable";
+}
+`, graph.DOT())
+	require.Equal(t, `graph LR
+  subgraph test [test]
+    test__frontend["frontend<br/>Load Balanced Web Service"]
+    test__backend["backend<br/>Backend Service"]
+    test__backend__EventsQueue[/"EventsQueue<br/>AWS::SQS::Queue"/]
+    test__backend__OrdersTable[("OrdersTable<br/>AWS::DynamoDB::Table")]
+  end
+  test__frontend --> test__backend
+  test__backend --> test__backend__EventsQueue
+  test__backend --> test__backend__OrdersTable
+`, graph.Mermaid())
+}
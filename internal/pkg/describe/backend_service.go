@@ -20,6 +20,7 @@ type BackendServiceDescriber struct {
 	app             string
 	svc             string
 	enableResources bool
+	dependencies    map[string][]string
 
 	store                DeployedEnvServicesLister
 	svcDescriber         map[string]svcDescriber
@@ -31,6 +32,7 @@ type NewBackendServiceConfig struct {
 	NewServiceConfig
 	EnableResources bool
 	DeployStore     DeployedEnvServicesLister
+	Dependencies    map[string][]string // Services that each service in the workspace depends on, if available.
 }
 
 // NewBackendServiceDescriber instantiates a backend service describer.
@@ -39,6 +41,7 @@ func NewBackendServiceDescriber(opt NewBackendServiceConfig) (*BackendServiceDes
 		app:             opt.App,
 		svc:             opt.Svc,
 		enableResources: opt.EnableResources,
+		dependencies:    opt.Dependencies,
 		store:           opt.DeployStore,
 		svcDescriber:    make(map[string]svcDescriber),
 	}
@@ -51,6 +54,7 @@ func NewBackendServiceDescriber(opt NewBackendServiceConfig) (*BackendServiceDes
 			Env:         env,
 			Svc:         opt.Svc,
 			ConfigStore: opt.ConfigStore,
+			Cache:       opt.Cache,
 		})
 		if err != nil {
 			return err
@@ -89,6 +93,7 @@ func (d *BackendServiceDescriber) Describe() (HumanJSONStringer, error) {
 	var configs []*ServiceConfig
 	var services []*ServiceDiscovery
 	var envVars []*EnvVars
+	var outputs []*StackOutput
 	for _, env := range environments {
 		err := d.initServiceDescriber(env)
 		if err != nil {
@@ -115,9 +120,16 @@ func (d *BackendServiceDescriber) Describe() (HumanJSONStringer, error) {
 			return nil, fmt.Errorf("retrieve environment variables: %w", err)
 		}
 		envVars = append(envVars, flattenEnvVars(env, backendSvcEnvVars)...)
+		backendSvcOutputs, err := d.svcDescriber[env].Outputs()
+		if err != nil {
+			return nil, fmt.Errorf("retrieve service outputs: %w", err)
+		}
+		outputs = append(outputs, flattenOutputs(env, backendSvcOutputs)...)
 	}
 	sort.SliceStable(envVars, func(i, j int) bool { return envVars[i].Environment < envVars[j].Environment })
 	sort.SliceStable(envVars, func(i, j int) bool { return envVars[i].Name < envVars[j].Name })
+	sort.SliceStable(outputs, func(i, j int) bool { return outputs[i].Environment < outputs[j].Environment })
+	sort.SliceStable(outputs, func(i, j int) bool { return outputs[i].Name < outputs[j].Name })
 
 	resources := make(map[string][]*CfnResource)
 	if d.enableResources {
@@ -141,6 +153,8 @@ func (d *BackendServiceDescriber) Describe() (HumanJSONStringer, error) {
 		Configurations:   configs,
 		ServiceDiscovery: services,
 		Variables:        envVars,
+		Outputs:          outputs,
+		Dependencies:     dependencyTree(d.svc, d.dependencies),
 		Resources:        resources,
 	}, nil
 }
@@ -153,6 +167,8 @@ type backendSvcDesc struct {
 	Configurations   configurations     `json:"configurations"`
 	ServiceDiscovery serviceDiscoveries `json:"serviceDiscovery"`
 	Variables        envVars            `json:"variables"`
+	Outputs          stackOutputs       `json:"outputs,omitempty"`
+	Dependencies     *Dependency        `json:"dependencies,omitempty"`
 	Resources        cfnResources       `json:"resources,omitempty"`
 }
 
@@ -183,6 +199,16 @@ func (w *backendSvcDesc) HumanString() string {
 	fmt.Fprintf(writer, color.Bold.Sprint("\nVariables\n\n"))
 	writer.Flush()
 	w.Variables.humanString(writer)
+	if len(w.Outputs) != 0 {
+		fmt.Fprintf(writer, color.Bold.Sprint("\nOutputs\n\n"))
+		writer.Flush()
+		w.Outputs.humanString(writer)
+	}
+	if w.Dependencies != nil {
+		fmt.Fprintf(writer, color.Bold.Sprint("\nDependencies\n\n"))
+		writer.Flush()
+		w.Dependencies.humanString(writer)
+	}
 	if len(w.Resources) != 0 {
 		fmt.Fprintf(writer, color.Bold.Sprint("\nResources\n"))
 		writer.Flush()
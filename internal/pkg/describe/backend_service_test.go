@@ -71,6 +71,24 @@ func TestBackendServiceDescriber_Describe(t *testing.T) {
 			},
 			wantedError: fmt.Errorf("retrieve environment variables: some error"),
 		},
+		"return error if fail to retrieve service outputs": {
+			setupMocks: func(m backendSvcDescriberMocks) {
+				gomock.InOrder(
+					m.storeSvc.EXPECT().ListEnvironmentsDeployedTo(testApp, testSvc).Return([]string{testEnv}, nil),
+					m.svcDescriber.EXPECT().Params().Return(map[string]string{
+						stack.LBWebServiceContainerPortParamKey: "80",
+						stack.ServiceTaskCountParamKey:          "1",
+						stack.ServiceTaskCPUParamKey:            "256",
+						stack.ServiceTaskMemoryParamKey:         "512",
+					}, nil),
+					m.svcDescriber.EXPECT().EnvVars().Return(map[string]string{
+						"COPILOT_ENVIRONMENT_NAME": testEnv,
+					}, nil),
+					m.svcDescriber.EXPECT().Outputs().Return(nil, mockErr),
+				)
+			},
+			wantedError: fmt.Errorf("retrieve service outputs: some error"),
+		},
 		"success": {
 			shouldOutputResources: true,
 			setupMocks: func(m backendSvcDescriberMocks) {
@@ -87,6 +105,10 @@ func TestBackendServiceDescriber_Describe(t *testing.T) {
 						map[string]string{
 							"COPILOT_ENVIRONMENT_NAME": testEnv,
 						}, nil),
+					m.svcDescriber.EXPECT().Outputs().Return(
+						map[string]string{
+							"JobsTableName": "phonetool-test-jobs",
+						}, nil),
 
 					m.svcDescriber.EXPECT().Params().Return(map[string]string{
 						stack.LBWebServiceContainerPortParamKey: "5000",
@@ -98,6 +120,10 @@ func TestBackendServiceDescriber_Describe(t *testing.T) {
 						map[string]string{
 							"COPILOT_ENVIRONMENT_NAME": prodEnv,
 						}, nil),
+					m.svcDescriber.EXPECT().Outputs().Return(
+						map[string]string{
+							"JobsTableName": "phonetool-prod-jobs",
+						}, nil),
 
 					m.svcDescriber.EXPECT().ServiceStackResources().Return([]*cloudformation.StackResource{
 						{
@@ -151,6 +177,18 @@ func TestBackendServiceDescriber_Describe(t *testing.T) {
 						Value:       "test",
 					},
 				},
+				Outputs: []*StackOutput{
+					{
+						Environment: "prod",
+						Name:        "JobsTableName",
+						Value:       "phonetool-prod-jobs",
+					},
+					{
+						Environment: "test",
+						Name:        "JobsTableName",
+						Value:       "phonetool-test-jobs",
+					},
+				},
 				Resources: map[string][]*CfnResource{
 					"test": {
 						{
@@ -238,6 +276,12 @@ Variables
   COPILOT_ENVIRONMENT_NAME  prod                prod
   -                         test                test
 
+Outputs
+
+  Name              Environment         Value
+  JobsTableName     prod                my-app-prod-jobs
+  JobsTableName     test                my-app-test-jobs
+
 Resources
 
   test
@@ -246,7 +290,7 @@ Resources
   prod
     AWS::EC2::SecurityGroupIngress  ContainerSecurityGroupIngressFromPublicALB
 `,
-			wantedJSONString: "{\"service\":\"my-svc\",\"type\":\"Backend Service\",\"application\":\"my-app\",\"configurations\":[{\"environment\":\"test\",\"port\":\"80\",\"tasks\":\"1\",\"cpu\":\"256\",\"memory\":\"512\"},{\"environment\":\"prod\",\"port\":\"5000\",\"tasks\":\"3\",\"cpu\":\"512\",\"memory\":\"1024\"}],\"serviceDiscovery\":[{\"environment\":[\"test\",\"prod\"],\"namespace\":\"http://my-svc.my-app.local:5000\"}],\"variables\":[{\"environment\":\"prod\",\"name\":\"COPILOT_ENVIRONMENT_NAME\",\"value\":\"prod\"},{\"environment\":\"test\",\"name\":\"COPILOT_ENVIRONMENT_NAME\",\"value\":\"test\"}],\"resources\":{\"prod\":[{\"type\":\"AWS::EC2::SecurityGroupIngress\",\"physicalID\":\"ContainerSecurityGroupIngressFromPublicALB\"}],\"test\":[{\"type\":\"AWS::EC2::SecurityGroup\",\"physicalID\":\"sg-0758ed6b233743530\"}]}}\n",
+			wantedJSONString: "{\"service\":\"my-svc\",\"type\":\"Backend Service\",\"application\":\"my-app\",\"configurations\":[{\"environment\":\"test\",\"port\":\"80\",\"tasks\":\"1\",\"cpu\":\"256\",\"memory\":\"512\"},{\"environment\":\"prod\",\"port\":\"5000\",\"tasks\":\"3\",\"cpu\":\"512\",\"memory\":\"1024\"}],\"serviceDiscovery\":[{\"environment\":[\"test\",\"prod\"],\"namespace\":\"http://my-svc.my-app.local:5000\"}],\"variables\":[{\"environment\":\"prod\",\"name\":\"COPILOT_ENVIRONMENT_NAME\",\"value\":\"prod\"},{\"environment\":\"test\",\"name\":\"COPILOT_ENVIRONMENT_NAME\",\"value\":\"test\"}],\"outputs\":[{\"environment\":\"prod\",\"name\":\"JobsTableName\",\"value\":\"my-app-prod-jobs\"},{\"environment\":\"test\",\"name\":\"JobsTableName\",\"value\":\"my-app-test-jobs\"}],\"resources\":{\"prod\":[{\"type\":\"AWS::EC2::SecurityGroupIngress\",\"physicalID\":\"ContainerSecurityGroupIngressFromPublicALB\"}],\"test\":[{\"type\":\"AWS::EC2::SecurityGroup\",\"physicalID\":\"sg-0758ed6b233743530\"}]}}\n",
 		},
 	}
 
@@ -286,6 +330,18 @@ Resources
 					Namespace:   "http://my-svc.my-app.local:5000",
 				},
 			}
+			outputs := []*StackOutput{
+				{
+					Environment: "prod",
+					Name:        "JobsTableName",
+					Value:       "my-app-prod-jobs",
+				},
+				{
+					Environment: "test",
+					Name:        "JobsTableName",
+					Value:       "my-app-test-jobs",
+				},
+			}
 			resources := map[string][]*CfnResource{
 				"test": {
 					{
@@ -307,6 +363,7 @@ Resources
 				App:              "my-app",
 				Variables:        envVars,
 				ServiceDiscovery: sds,
+				Outputs:          outputs,
 				Resources:        resources,
 			}
 			human := backendSvc.HumanString()
@@ -0,0 +1,86 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/acm"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+)
+
+const (
+	// envCertificateResourceType is the type of the custom resource that requests the certificate of an environment's HTTPS listener.
+	envCertificateResourceType = "Custom::CertificateValidationFunction"
+
+	certificateIssuedStatus = "ISSUED"
+)
+
+type certDescriber interface {
+	DescribeCertificate(arn string) (*acm.Certificate, error)
+}
+
+// Certificate contains the status of an ACM certificate attached to an environment's HTTPS listener.
+type Certificate struct {
+	Environment string `json:"environment"`
+	*acm.Certificate
+}
+
+type certificates []*Certificate
+
+func (c certificates) humanString(w io.Writer) {
+	fmt.Fprintf(w, "  %s\t%s\t%s\t%s\t%s\n", "Environment", "Domain", "Status", "Expires", "Renewal")
+	for _, cert := range c {
+		renewal := cert.RenewalStatus
+		if renewal == "" {
+			renewal = "-"
+		}
+		fmt.Fprintf(w, "  %s\t%s\t%s\t%s\t%s\n", cert.Environment, cert.DomainName, certStatusColor(cert.Status), humanizeTime(cert.NotAfter), renewal)
+	}
+	for _, cert := range c {
+		for _, record := range cert.MissingValidationRecords {
+			fmt.Fprintf(w, "  %s DNS validation record for %s is missing in environment %s: CNAME %s %s\n",
+				color.Yellow.Sprint("!"), record.DomainName, cert.Environment, record.Name, record.Value)
+		}
+	}
+}
+
+func certStatusColor(status string) string {
+	if status == certificateIssuedStatus {
+		return color.Green.Sprint(status)
+	}
+	return color.Red.Sprint(status)
+}
+
+// envCertificates describes the certificates requested by an environment stack.
+// The certificates are optional details, so the ones that can't be described are skipped with a warning,
+// for example if the environment manager role predates the permission to describe them.
+func envCertificates(envName string, envResources []*cloudformation.StackResource, d certDescriber) []*Certificate {
+	var certs []*Certificate
+	for _, resource := range envResources {
+		if aws.StringValue(resource.ResourceType) != envCertificateResourceType {
+			continue
+		}
+		arn := aws.StringValue(resource.PhysicalResourceId)
+		// The custom resource only reports an ARN once the certificate was requested successfully.
+		if !strings.HasPrefix(arn, "arn:") {
+			continue
+		}
+		cert, err := d.DescribeCertificate(arn)
+		if err != nil {
+			log.Warningf("Couldn't describe certificate %s of environment %s: %v\n", arn, envName, err)
+			continue
+		}
+		certs = append(certs, &Certificate{
+			Environment: envName,
+			Certificate: cert,
+		})
+	}
+	return certs
+}
@@ -0,0 +1,117 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/acm"
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/dustin/go-humanize"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvCertificates(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cert := &acm.Certificate{
+		ARN:        "arn:aws:acm:us-west-2:123456789012:certificate/abc",
+		DomainName: "test.phonetool.com",
+		Status:     "ISSUED",
+	}
+	m := mocks.NewMockcertDescriber(ctrl)
+	m.EXPECT().DescribeCertificate("arn:aws:acm:us-west-2:123456789012:certificate/abc").Return(cert, nil)
+	m.EXPECT().DescribeCertificate("arn:aws:acm:us-west-2:123456789012:certificate/def").Return(nil, errors.New("AccessDenied"))
+
+	// WHEN
+	certs := envCertificates("test", []*cloudformation.StackResource{
+		{
+			ResourceType:       aws.String("AWS::ElasticLoadBalancingV2::LoadBalancer"),
+			PhysicalResourceId: aws.String("arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/lb"),
+		},
+		{
+			// The certificate request failed so the custom resource reports its log stream instead.
+			ResourceType:       aws.String(envCertificateResourceType),
+			PhysicalResourceId: aws.String("2020/08/01/[$LATEST]abc"),
+		},
+		{
+			ResourceType:       aws.String(envCertificateResourceType),
+			PhysicalResourceId: aws.String("arn:aws:acm:us-west-2:123456789012:certificate/abc"),
+		},
+		{
+			// The certificate can't be described, for example by an environment manager role without the permission.
+			ResourceType:       aws.String(envCertificateResourceType),
+			PhysicalResourceId: aws.String("arn:aws:acm:us-west-2:123456789012:certificate/def"),
+		},
+	}, m)
+
+	// THEN
+	require.Equal(t, []*Certificate{
+		{
+			Environment: "test",
+			Certificate: cert,
+		},
+	}, certs)
+}
+
+func TestCertificates_HumanString(t *testing.T) {
+	oldHumanize := humanizeTime
+	humanizeTime = func(then time.Time) string {
+		now, _ := time.Parse(time.RFC3339, "2020-08-01T00:00:00+00:00")
+		return humanize.RelTime(then, now, "ago", "from now")
+	}
+	defer func() {
+		humanizeTime = oldHumanize
+	}()
+	notAfter, _ := time.Parse(time.RFC3339, "2020-10-01T00:00:00+00:00")
+
+	certs := certificates{
+		{
+			Environment: "test",
+			Certificate: &acm.Certificate{
+				DomainName:    "test.phonetool.com",
+				Status:        "ISSUED",
+				NotAfter:      notAfter,
+				RenewalStatus: "PENDING_AUTO_RENEWAL",
+				MissingValidationRecords: []acm.ValidationRecord{
+					{
+						DomainName: "test.phonetool.com",
+						Name:       "_x1.test.phonetool.com.",
+						Value:      "_x2.acm-validations.aws.",
+					},
+				},
+			},
+		},
+		{
+			Environment: "prod",
+			Certificate: &acm.Certificate{
+				DomainName: "prod.phonetool.com",
+				Status:     "ISSUED",
+				NotAfter:   notAfter,
+			},
+		},
+	}
+	wanted := `  Environment       Domain              Status              Expires             Renewal
+  test              test.phonetool.com  ISSUED              2 months from now   PENDING_AUTO_RENEWAL
+  prod              prod.phonetool.com  ISSUED              2 months from now   -
+  ! DNS validation record for test.phonetool.com is missing in environment test: CNAME _x1.test.phonetool.com. _x2.acm-validations.aws.
+`
+
+	// WHEN
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	certs.humanString(writer)
+	writer.Flush()
+
+	// THEN
+	require.Equal(t, wanted, b.String())
+}
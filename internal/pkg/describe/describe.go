@@ -61,6 +61,79 @@ func flattenEnvVars(envName string, m map[string]string) []*EnvVars {
 	return envVarList
 }
 
+// StackOutput contains serialized output values of a service stack and its addons.
+type StackOutput struct {
+	Environment string `json:"environment"`
+	Name        string `json:"name"`
+	Value       string `json:"value"`
+}
+
+type stackOutputs []*StackOutput
+
+func (s stackOutputs) humanString(w io.Writer) {
+	fmt.Fprintf(w, "  %s\t%s\t%s\n", "Name", "Environment", "Value")
+	for _, out := range s {
+		fmt.Fprintf(w, "  %s\t%s\t%s\n", out.Name, out.Environment, out.Value)
+	}
+}
+
+func flattenOutputs(envName string, m map[string]string) []*StackOutput {
+	var outputs []*StackOutput
+	for k, v := range m {
+		outputs = append(outputs, &StackOutput{
+			Environment: envName,
+			Name:        k,
+			Value:       v,
+		})
+	}
+	return outputs
+}
+
+// Dependency is a service along with the services that it depends on.
+type Dependency struct {
+	Name      string        `json:"name"`
+	DependsOn []*Dependency `json:"dependsOn,omitempty"`
+}
+
+// newDependency returns the tree of services that svc depends on in the graph.
+// A service that's already in its own path isn't expanded again so that circular dependencies are displayed once.
+func newDependency(svc string, graph map[string][]string, path map[string]bool) *Dependency {
+	dep := &Dependency{Name: svc}
+	if path[svc] {
+		return dep
+	}
+	path[svc] = true
+	for _, next := range graph[svc] {
+		dep.DependsOn = append(dep.DependsOn, newDependency(next, graph, path))
+	}
+	delete(path, svc)
+	return dep
+}
+
+func (d *Dependency) humanString(w io.Writer) {
+	fmt.Fprintf(w, "  %s\n", d.Name)
+	d.writeDependsOn(w, "  ")
+}
+
+func (d *Dependency) writeDependsOn(w io.Writer, prefix string) {
+	for i, dep := range d.DependsOn {
+		branch, indent := "├── ", "│   "
+		if i == len(d.DependsOn)-1 {
+			branch, indent = "└── ", "    "
+		}
+		fmt.Fprintf(w, "%s%s%s\n", prefix, branch, dep.Name)
+		dep.writeDependsOn(w, prefix+indent)
+	}
+}
+
+// dependencyTree returns the services that svc depends on, or nil if it has no dependencies.
+func dependencyTree(svc string, graph map[string][]string) *Dependency {
+	if len(graph[svc]) == 0 {
+		return nil
+	}
+	return newDependency(svc, graph, make(map[string]bool))
+}
+
 // HumanString returns the stringified CfnResource struct with human readable format.
 func (c CfnResource) HumanString() string {
 	return fmt.Sprintf("    %s\t%s\n", c.Type, c.PhysicalID)
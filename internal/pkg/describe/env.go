@@ -5,12 +5,21 @@ package describe
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"sort"
 	"text/tabwriter"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/acm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/servicequotas"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/cache"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
@@ -18,14 +27,47 @@ import (
 
 const (
 	cloudformationResourceType = "cloudformation:stack"
+	envClusterResourceType     = "AWS::ECS::Cluster"
+
+	containerInsightsAccountSetting = "containerInsights"
 )
 
+type egressIPsGetter interface {
+	EgressIPs(ctx context.Context, vpcID string) ([]string, error)
+}
+
+type clusterCapacityGetter interface {
+	ClusterCapacity(cluster string) (*ecs.ClusterCapacity, error)
+	AccountSetting(name string) (string, error)
+}
+
+type fargateQuotaGetter interface {
+	FargateVCPUQuota() (float64, error)
+}
+
+type fargateUsageGetter interface {
+	FargateVCPUUsage() (float64, error)
+}
+
 // EnvDescription contains the information about an environment.
 type EnvDescription struct {
-	Environment *config.Environment `json:"environment"`
-	Services    []*config.Service   `json:"services"`
-	Tags        map[string]string   `json:"tags,omitempty"`
-	Resources   []*CfnResource      `json:"resources,omitempty"`
+	Environment  *config.Environment `json:"environment"`
+	Services     []*config.Service   `json:"services"`
+	Certificates certificates        `json:"certificates,omitempty"`
+	Tags         map[string]string   `json:"tags,omitempty"`
+	Resources    []*CfnResource      `json:"resources,omitempty"`
+	EgressIPs    []string            `json:"egressIPs,omitempty"`
+	Capacity     *EnvCapacity        `json:"capacity,omitempty"`
+}
+
+// EnvCapacity contains the tasks of the cluster of an environment, and how much of the Fargate vCPU quota of the
+// account is used.
+type EnvCapacity struct {
+	ecs.ClusterCapacity
+	FargateVCPUUsage float64 `json:"fargateVCPUUsage"`
+	FargateVCPUQuota float64 `json:"fargateVCPUQuota"`
+	// ContainerInsights is the account's default for whether new clusters have Container Insights enabled.
+	ContainerInsights string `json:"containerInsights,omitempty"`
 }
 
 // EnvDescriber retrieves information about an environment.
@@ -33,10 +75,17 @@ type EnvDescriber struct {
 	app             string
 	env             *config.Environment
 	enableResources bool
+	enableEgressIPs bool
+	enableCapacity  bool
 
-	configStore    ConfigStoreSvc
-	deployStore    DeployedEnvServicesLister
-	stackDescriber stackAndResourcesDescriber
+	configStore     ConfigStoreSvc
+	deployStore     DeployedEnvServicesLister
+	stackDescriber  stackAndResourcesDescriber
+	certDescriber   certDescriber
+	egressIPsGetter egressIPsGetter
+	clusterGetter   clusterCapacityGetter
+	quotaGetter     fargateQuotaGetter
+	usageGetter     fargateUsageGetter
 }
 
 // NewEnvDescriberConfig contains fields that initiates EnvDescriber struct.
@@ -44,8 +93,11 @@ type NewEnvDescriberConfig struct {
 	App             string
 	Env             string
 	EnableResources bool
+	EnableEgressIPs bool
+	EnableCapacity  bool
 	ConfigStore     ConfigStoreSvc
 	DeployStore     DeployedEnvServicesLister
+	Cache           *cache.Cache // Optional. Caches the described stacks if set.
 }
 
 // NewEnvDescriber instantiates an environment describer.
@@ -58,56 +110,126 @@ func NewEnvDescriber(opt NewEnvDescriberConfig) (*EnvDescriber, error) {
 	if err != nil {
 		return nil, fmt.Errorf("assume role for environment %s: %w", env.ManagerRoleARN, err)
 	}
-	d := newStackDescriber(sess)
+	d := newStackDescriber(sess, opt.Cache)
 	return &EnvDescriber{
 		app:             opt.App,
 		env:             env,
 		enableResources: opt.EnableResources,
+		enableEgressIPs: opt.EnableEgressIPs,
+		enableCapacity:  opt.EnableCapacity,
 
-		configStore:    opt.ConfigStore,
-		deployStore:    opt.DeployStore,
-		stackDescriber: stackAndResourcesDescriber(d),
+		configStore:     opt.ConfigStore,
+		deployStore:     opt.DeployStore,
+		stackDescriber:  stackAndResourcesDescriber(d),
+		certDescriber:   acm.New(sess),
+		egressIPsGetter: ec2.New(sess),
+		clusterGetter:   ecs.New(sess),
+		quotaGetter:     servicequotas.New(sess),
+		usageGetter:     cloudwatch.New(sess),
 	}, nil
 }
 
 // Describe returns info about an application's environment.
-func (e *EnvDescriber) Describe() (*EnvDescription, error) {
+func (e *EnvDescriber) Describe(ctx context.Context) (*EnvDescription, error) {
 	svcs, err := e.filterDeployedSvcs()
 	if err != nil {
 		return nil, err
 	}
 
-	tags, err := e.stackTags()
+	envStack, err := e.stackDescriber.Stack(stack.NameForEnv(e.app, e.env.Name))
 	if err != nil {
 		return nil, fmt.Errorf("retrieve environment tags: %w", err)
 	}
 
+	envResources, err := e.stackDescriber.StackResources(stack.NameForEnv(e.app, e.env.Name))
+	if err != nil {
+		return nil, fmt.Errorf("retrieve environment resources: %w", err)
+	}
+	certs := envCertificates(e.env.Name, envResources, e.certDescriber)
+
 	var stackResources []*CfnResource
 	if e.enableResources {
-		stackResources, err = e.envOutputs()
+		stackResources = flattenResources(envResources)
+	}
+	var egressIPs []string
+	if e.enableEgressIPs {
+		egressIPs, err = e.egressIPs(ctx, envStack)
 		if err != nil {
-			return nil, fmt.Errorf("retrieve environment resources: %w", err)
+			return nil, fmt.Errorf("retrieve environment egress IPs: %w", err)
+		}
+	}
+	var capacity *EnvCapacity
+	if e.enableCapacity {
+		capacity, err = e.capacity(envResources)
+		if err != nil {
+			return nil, fmt.Errorf("retrieve environment capacity: %w", err)
 		}
 	}
 
 	return &EnvDescription{
-		Environment: e.env,
-		Services:    svcs,
-		Tags:        tags,
-		Resources:   stackResources,
+		Environment:  e.env,
+		Services:     svcs,
+		Certificates: certs,
+		Tags:         stackTags(envStack),
+		Resources:    stackResources,
+		EgressIPs:    egressIPs,
+		Capacity:     capacity,
 	}, nil
 }
 
-func (e *EnvDescriber) stackTags() (map[string]string, error) {
-	tags := make(map[string]string)
-	envStack, err := e.stackDescriber.Stack(stack.NameForEnv(e.app, e.env.Name))
+// capacity returns the number of tasks in the cluster of the environment, and the Fargate vCPUs used by the account
+// out of its quota.
+func (e *EnvDescriber) capacity(envResources []*cloudformation.StackResource) (*EnvCapacity, error) {
+	var cluster string
+	for _, resource := range envResources {
+		if aws.StringValue(resource.ResourceType) == envClusterResourceType {
+			cluster = aws.StringValue(resource.PhysicalResourceId)
+			break
+		}
+	}
+	if cluster == "" {
+		return nil, fmt.Errorf("resource %s not found in environment stack", envClusterResourceType)
+	}
+	clusterCapacity, err := e.clusterGetter.ClusterCapacity(cluster)
+	if err != nil {
+		return nil, err
+	}
+	quota, err := e.quotaGetter.FargateVCPUQuota()
+	if err != nil {
+		return nil, err
+	}
+	usage, err := e.usageGetter.FargateVCPUUsage()
 	if err != nil {
 		return nil, err
 	}
+	containerInsights, err := e.clusterGetter.AccountSetting(containerInsightsAccountSetting)
+	if err != nil {
+		return nil, err
+	}
+	return &EnvCapacity{
+		ClusterCapacity:   *clusterCapacity,
+		FargateVCPUUsage:  usage,
+		FargateVCPUQuota:  quota,
+		ContainerInsights: containerInsights,
+	}, nil
+}
+
+// egressIPs returns the public IP addresses of the NAT gateways in the environment's VPC.
+func (e *EnvDescriber) egressIPs(ctx context.Context, envStack *cloudformation.Stack) ([]string, error) {
+	for _, output := range envStack.Outputs {
+		if aws.StringValue(output.OutputKey) == stack.EnvOutputVPCID {
+			return e.egressIPsGetter.EgressIPs(ctx, aws.StringValue(output.OutputValue))
+		}
+	}
+	return nil, fmt.Errorf("output %s not found in environment stack", stack.EnvOutputVPCID)
+}
+
+func stackTags(envStack *cloudformation.Stack) map[string]string {
+	tags := make(map[string]string)
 	for _, tag := range envStack.Tags {
 		tags[*tag.Key] = *tag.Value
 	}
-	return tags, nil
+	return tags
 }
 
 func (e *EnvDescriber) filterDeployedSvcs() ([]*config.Service, error) {
@@ -130,15 +252,6 @@ func (e *EnvDescriber) filterDeployedSvcs() ([]*config.Service, error) {
 	return deployedSvcs, nil
 }
 
-func (e *EnvDescriber) envOutputs() ([]*CfnResource, error) {
-	envStack, err := e.stackDescriber.StackResources(stack.NameForEnv(e.app, e.env.Name))
-	if err != nil {
-		return nil, err
-	}
-	outputs := flattenResources(envStack)
-	return outputs, nil
-}
-
 // JSONString returns the stringified EnvDescription struct with json format.
 func (e *EnvDescription) JSONString() (string, error) {
 	b, err := json.Marshal(e)
@@ -168,6 +281,30 @@ func (e *EnvDescription) HumanString() string {
 		fmt.Fprintf(writer, "  %s\t%s\n", svc.Name, svc.Type)
 	}
 	writer.Flush()
+	if len(e.Certificates) != 0 {
+		fmt.Fprintf(writer, color.Bold.Sprint("\nCertificates\n\n"))
+		writer.Flush()
+		e.Certificates.humanString(writer)
+	}
+	writer.Flush()
+	if len(e.EgressIPs) != 0 {
+		fmt.Fprintf(writer, color.Bold.Sprint("\nEgress IPs\n\n"))
+		writer.Flush()
+		for _, ip := range e.EgressIPs {
+			fmt.Fprintf(writer, "  %s\n", ip)
+		}
+	}
+	writer.Flush()
+	if e.Capacity != nil {
+		fmt.Fprintf(writer, color.Bold.Sprint("\nCapacity\n\n"))
+		writer.Flush()
+		fmt.Fprintf(writer, "  %s\t%d\n", "Running Tasks", e.Capacity.RunningTasks)
+		fmt.Fprintf(writer, "  %s\t%d\n", "Pending Tasks", e.Capacity.PendingTasks)
+		fmt.Fprintf(writer, "  %s\t%d\n", "Active Services", e.Capacity.ActiveServices)
+		fmt.Fprintf(writer, "  %s\t%g / %g used by the account\n", "Fargate vCPUs", e.Capacity.FargateVCPUUsage, e.Capacity.FargateVCPUQuota)
+		fmt.Fprintf(writer, "  %s\t%s\n", "Container Insights Default", dashIfEmpty(e.Capacity.ContainerInsights))
+	}
+	writer.Flush()
 	if len(e.Tags) != 0 {
 		fmt.Fprintf(writer, color.Bold.Sprint("\nTags\n\n"))
 		writer.Flush()
@@ -0,0 +1,96 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+// ServiceHealth contains the health of a service deployed in an environment.
+type ServiceHealth struct {
+	Name    string   `json:"name"`
+	Healthy bool     `json:"healthy"`
+	Issues  []string `json:"issues,omitempty"`
+}
+
+// EnvStatusDesc contains the health of the services deployed in an environment.
+type EnvStatusDesc struct {
+	Environment string          `json:"environment"`
+	Healthy     bool            `json:"healthy"`
+	Services    []ServiceHealth `json:"services"`
+}
+
+// NewEnvStatusDesc returns the health of an environment from the status of its services, keyed by service name.
+// The environment is healthy if all of its services are healthy.
+func NewEnvStatusDesc(env string, svcs map[string]*ServiceStatusDesc, now time.Time) *EnvStatusDesc {
+	var names []string
+	for name := range svcs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	desc := &EnvStatusDesc{
+		Environment: env,
+		Healthy:     true,
+		Services:    []ServiceHealth{},
+	}
+	for _, name := range names {
+		issues := svcs[name].Issues(now)
+		desc.Services = append(desc.Services, ServiceHealth{
+			Name:    name,
+			Healthy: len(issues) == 0,
+			Issues:  issues,
+		})
+		if len(issues) != 0 {
+			desc.Healthy = false
+		}
+	}
+	return desc
+}
+
+// JSONString returns the stringified EnvStatusDesc struct with json format.
+func (e *EnvStatusDesc) JSONString() (string, error) {
+	b, err := json.Marshal(e)
+	if err != nil {
+		return "", fmt.Errorf("marshal environment status: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the stringified EnvStatusDesc struct with human readable format.
+func (e *EnvStatusDesc) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprintf(writer, color.Bold.Sprint("Environment Status\n\n"))
+	writer.Flush()
+	fmt.Fprintf(writer, "  %s\t%s\n", e.Environment, healthColor(e.Healthy))
+	fmt.Fprintf(writer, color.Bold.Sprint("\nServices\n\n"))
+	writer.Flush()
+	fmt.Fprintf(writer, "  %s\t%s\t%s\n", "Name", "Health", "Issues")
+	for _, svc := range e.Services {
+		if len(svc.Issues) == 0 {
+			fmt.Fprintf(writer, "  %s\t%s\t%s\n", svc.Name, healthColor(svc.Healthy), "-")
+			continue
+		}
+		fmt.Fprintf(writer, "  %s\t%s\t%s\n", svc.Name, healthColor(svc.Healthy), svc.Issues[0])
+		for _, issue := range svc.Issues[1:] {
+			fmt.Fprintf(writer, "  %s\t%s\t%s\n", "", "", issue)
+		}
+	}
+	writer.Flush()
+	return b.String()
+}
+
+func healthColor(healthy bool) string {
+	if healthy {
+		return color.Green.Sprint("healthy")
+	}
+	return color.Red.Sprint("unhealthy")
+}
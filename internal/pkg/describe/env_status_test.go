@@ -0,0 +1,107 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvStatusDesc_String(t *testing.T) {
+	now, _ := time.Parse(time.RFC3339, "2020-01-01T01:00:00+00:00")
+	healthy := &ServiceStatusDesc{
+		Service: ecs.ServiceStatus{
+			Status:       "ACTIVE",
+			DesiredCount: 1,
+			RunningCount: 1,
+		},
+	}
+	unhealthy := &ServiceStatusDesc{
+		Service: ecs.ServiceStatus{
+			Status:       "ACTIVE",
+			DesiredCount: 2,
+			RunningCount: 1,
+		},
+		Alarms: []cloudwatch.AlarmStatus{
+			{Name: "mockAlarm", Status: "ALARM"},
+		},
+	}
+	testCases := map[string]struct {
+		svcs map[string]*ServiceStatusDesc
+
+		wantedHealthy bool
+		human         string
+		json          string
+	}{
+		"healthy environment": {
+			svcs: map[string]*ServiceStatusDesc{
+				"frontend": healthy,
+				"api":      healthy,
+			},
+
+			wantedHealthy: true,
+			human: `Environment Status
+
+  test              healthy
+
+Services
+
+  Name              Health              Issues
+  api               healthy             -
+  frontend          healthy             -
+`,
+			json: `{"environment":"test","healthy":true,"services":[{"name":"api","healthy":true},{"name":"frontend","healthy":true}]}` + "\n",
+		},
+		"unhealthy environment": {
+			svcs: map[string]*ServiceStatusDesc{
+				"frontend": healthy,
+				"api":      unhealthy,
+			},
+
+			wantedHealthy: false,
+			human: `Environment Status
+
+  test              unhealthy
+
+Services
+
+  Name              Health              Issues
+  api               unhealthy           1/2 running tasks
+                                        alarm mockAlarm is in ALARM
+  frontend          healthy             -
+`,
+			json: `{"environment":"test","healthy":false,"services":[{"name":"api","healthy":false,"issues":["1/2 running tasks","alarm mockAlarm is in ALARM"]},{"name":"frontend","healthy":true}]}` + "\n",
+		},
+		"environment without services": {
+			wantedHealthy: true,
+			human: `Environment Status
+
+  test              healthy
+
+Services
+
+  Name              Health              Issues
+`,
+			json: `{"environment":"test","healthy":true,"services":[]}` + "\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			desc := NewEnvStatusDesc("test", tc.svcs, now)
+			json, err := desc.JSONString()
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedHealthy, desc.Healthy)
+			require.Equal(t, tc.human, desc.HumanString())
+			require.Equal(t, tc.json, json)
+		})
+	}
+}
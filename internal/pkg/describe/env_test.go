@@ -4,12 +4,15 @@
 package describe
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/acm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
 	"github.com/golang/mock/gomock"
@@ -20,6 +23,11 @@ type envDescriberMocks struct {
 	configStoreSvc *mocks.MockConfigStoreSvc
 	deployStoreSvc *mocks.MockDeployedEnvServicesLister
 	stackDescriber *mocks.MockstackAndResourcesDescriber
+	certDescriber  *mocks.MockcertDescriber
+	egressIPs      *mocks.MockegressIPsGetter
+	cluster        *mocks.MockclusterCapacityGetter
+	quota          *mocks.MockfargateQuotaGetter
+	usage          *mocks.MockfargateUsageGetter
 }
 
 var wantedResources = []*CfnResource{
@@ -78,10 +86,25 @@ func TestEnvDescriber_Describe(t *testing.T) {
 		PhysicalResourceId: aws.String("AWS::ECS::Cluster-jI63pYBWU6BZ"),
 		ResourceType:       aws.String("testApp-testEnv-Cluster"),
 	}
+	mockClusterResource := &cloudformation.StackResource{
+		PhysicalResourceId: aws.String("testApp-testEnv-Cluster-jI63pYBWU6BZ"),
+		ResourceType:       aws.String("AWS::ECS::Cluster"),
+	}
+	mockCertResource := &cloudformation.StackResource{
+		PhysicalResourceId: aws.String("arn:aws:acm:us-west-2:123456789012:certificate/abc"),
+		ResourceType:       aws.String("Custom::CertificateValidationFunction"),
+	}
+	mockCert := &acm.Certificate{
+		ARN:        "arn:aws:acm:us-west-2:123456789012:certificate/abc",
+		DomainName: "testEnv.testApp.example.com",
+		Status:     "ISSUED",
+	}
 	envSvcs := []*config.Service{testSvc1, testSvc2}
 	mockError := errors.New("some error")
 	testCases := map[string]struct {
 		shouldOutputResources bool
+		shouldOutputEgressIPs bool
+		shouldOutputCapacity  bool
 
 		setupMocks func(mocks envDescriberMocks)
 
@@ -139,6 +162,29 @@ func TestEnvDescriber_Describe(t *testing.T) {
 			},
 			wantedError: fmt.Errorf("retrieve environment resources: some error"),
 		},
+		"skips the certificates that fail to be described": {
+			setupMocks: func(m envDescriberMocks) {
+				gomock.InOrder(
+					m.configStoreSvc.EXPECT().ListServices(testApp).Return([]*config.Service{
+						testSvc1, testSvc2, testSvc3,
+					}, nil),
+					m.deployStoreSvc.EXPECT().ListDeployedServices(testApp, testEnv.Name).
+						Return([]string{"testSvc1", "testSvc2"}, nil),
+					m.stackDescriber.EXPECT().Stack("testApp-testEnv").Return(&cloudformation.Stack{
+						Tags: stackTags,
+					}, nil),
+					m.stackDescriber.EXPECT().StackResources("testApp-testEnv").Return([]*cloudformation.StackResource{
+						mockCertResource,
+					}, nil),
+					m.certDescriber.EXPECT().DescribeCertificate("arn:aws:acm:us-west-2:123456789012:certificate/abc").Return(nil, mockError),
+				)
+			},
+			wantedEnv: &EnvDescription{
+				Environment: testEnv,
+				Services:    envSvcs,
+				Tags:        map[string]string{"copilot-application": "testApp", "copilot-environment": "testEnv"},
+			},
+		},
 		"success without resources": {
 			shouldOutputResources: false,
 			setupMocks: func(m envDescriberMocks) {
@@ -151,6 +197,10 @@ func TestEnvDescriber_Describe(t *testing.T) {
 					m.stackDescriber.EXPECT().Stack("testApp-testEnv").Return(&cloudformation.Stack{
 						Tags: stackTags,
 					}, nil),
+					m.stackDescriber.EXPECT().StackResources("testApp-testEnv").Return([]*cloudformation.StackResource{
+						mockResource1,
+						mockResource2,
+					}, nil),
 				)
 			},
 			wantedEnv: &EnvDescription{
@@ -184,6 +234,145 @@ func TestEnvDescriber_Describe(t *testing.T) {
 				Resources:   wantedResources,
 			},
 		},
+		"error if the VPC ID is missing from the env stack": {
+			shouldOutputEgressIPs: true,
+			setupMocks: func(m envDescriberMocks) {
+				gomock.InOrder(
+					m.configStoreSvc.EXPECT().ListServices(testApp).Return([]*config.Service{
+						testSvc1, testSvc2, testSvc3,
+					}, nil),
+					m.deployStoreSvc.EXPECT().ListDeployedServices(testApp, testEnv.Name).
+						Return([]string{"testSvc1", "testSvc2"}, nil),
+					m.stackDescriber.EXPECT().Stack("testApp-testEnv").Return(&cloudformation.Stack{
+						Tags: stackTags,
+					}, nil),
+					m.stackDescriber.EXPECT().StackResources("testApp-testEnv").Return(nil, nil),
+				)
+			},
+			wantedError: fmt.Errorf("retrieve environment egress IPs: output VpcId not found in environment stack"),
+		},
+		"success with egress IPs": {
+			shouldOutputEgressIPs: true,
+			setupMocks: func(m envDescriberMocks) {
+				gomock.InOrder(
+					m.configStoreSvc.EXPECT().ListServices(testApp).Return([]*config.Service{
+						testSvc1, testSvc2, testSvc3,
+					}, nil),
+					m.deployStoreSvc.EXPECT().ListDeployedServices(testApp, testEnv.Name).
+						Return([]string{"testSvc1", "testSvc2"}, nil),
+					m.stackDescriber.EXPECT().Stack("testApp-testEnv").Return(&cloudformation.Stack{
+						Tags: stackTags,
+						Outputs: []*cloudformation.Output{
+							{
+								OutputKey:   aws.String("VpcId"),
+								OutputValue: aws.String("vpc-1"),
+							},
+						},
+					}, nil),
+					m.stackDescriber.EXPECT().StackResources("testApp-testEnv").Return(nil, nil),
+					m.egressIPs.EXPECT().EgressIPs(gomock.Any(), "vpc-1").Return([]string{"3.3.3.3", "4.4.4.4"}, nil),
+				)
+			},
+			wantedEnv: &EnvDescription{
+				Environment: testEnv,
+				Services:    envSvcs,
+				Tags:        map[string]string{"copilot-application": "testApp", "copilot-environment": "testEnv"},
+				EgressIPs:   []string{"3.3.3.3", "4.4.4.4"},
+			},
+		},
+		"error if fail to get the Fargate vCPU quota": {
+			shouldOutputCapacity: true,
+			setupMocks: func(m envDescriberMocks) {
+				gomock.InOrder(
+					m.configStoreSvc.EXPECT().ListServices(testApp).Return([]*config.Service{
+						testSvc1, testSvc2, testSvc3,
+					}, nil),
+					m.deployStoreSvc.EXPECT().ListDeployedServices(testApp, testEnv.Name).
+						Return([]string{"testSvc1", "testSvc2"}, nil),
+					m.stackDescriber.EXPECT().Stack("testApp-testEnv").Return(&cloudformation.Stack{
+						Tags: stackTags,
+					}, nil),
+					m.stackDescriber.EXPECT().StackResources("testApp-testEnv").Return([]*cloudformation.StackResource{
+						mockClusterResource,
+					}, nil),
+					m.cluster.EXPECT().ClusterCapacity("testApp-testEnv-Cluster-jI63pYBWU6BZ").Return(&ecs.ClusterCapacity{}, nil),
+					m.quota.EXPECT().FargateVCPUQuota().Return(float64(0), mockError),
+				)
+			},
+			wantedError: fmt.Errorf("retrieve environment capacity: some error"),
+		},
+		"success with capacity": {
+			shouldOutputCapacity: true,
+			setupMocks: func(m envDescriberMocks) {
+				gomock.InOrder(
+					m.configStoreSvc.EXPECT().ListServices(testApp).Return([]*config.Service{
+						testSvc1, testSvc2, testSvc3,
+					}, nil),
+					m.deployStoreSvc.EXPECT().ListDeployedServices(testApp, testEnv.Name).
+						Return([]string{"testSvc1", "testSvc2"}, nil),
+					m.stackDescriber.EXPECT().Stack("testApp-testEnv").Return(&cloudformation.Stack{
+						Tags: stackTags,
+					}, nil),
+					m.stackDescriber.EXPECT().StackResources("testApp-testEnv").Return([]*cloudformation.StackResource{
+						mockResource1,
+						mockClusterResource,
+					}, nil),
+					m.cluster.EXPECT().ClusterCapacity("testApp-testEnv-Cluster-jI63pYBWU6BZ").Return(&ecs.ClusterCapacity{
+						RunningTasks:   3,
+						PendingTasks:   1,
+						ActiveServices: 2,
+					}, nil),
+					m.quota.EXPECT().FargateVCPUQuota().Return(float64(6), nil),
+					m.usage.EXPECT().FargateVCPUUsage().Return(2.5, nil),
+					m.cluster.EXPECT().AccountSetting("containerInsights").Return("enabled", nil),
+				)
+			},
+			wantedEnv: &EnvDescription{
+				Environment: testEnv,
+				Services:    envSvcs,
+				Tags:        map[string]string{"copilot-application": "testApp", "copilot-environment": "testEnv"},
+				Capacity: &EnvCapacity{
+					ClusterCapacity: ecs.ClusterCapacity{
+						RunningTasks:   3,
+						PendingTasks:   1,
+						ActiveServices: 2,
+					},
+					FargateVCPUUsage:  2.5,
+					FargateVCPUQuota:  6,
+					ContainerInsights: "enabled",
+				},
+			},
+		},
+		"success with certificates": {
+			setupMocks: func(m envDescriberMocks) {
+				gomock.InOrder(
+					m.configStoreSvc.EXPECT().ListServices(testApp).Return([]*config.Service{
+						testSvc1, testSvc2, testSvc3,
+					}, nil),
+					m.deployStoreSvc.EXPECT().ListDeployedServices(testApp, testEnv.Name).
+						Return([]string{"testSvc1", "testSvc2"}, nil),
+					m.stackDescriber.EXPECT().Stack("testApp-testEnv").Return(&cloudformation.Stack{
+						Tags: stackTags,
+					}, nil),
+					m.stackDescriber.EXPECT().StackResources("testApp-testEnv").Return([]*cloudformation.StackResource{
+						mockResource1,
+						mockCertResource,
+					}, nil),
+					m.certDescriber.EXPECT().DescribeCertificate("arn:aws:acm:us-west-2:123456789012:certificate/abc").Return(mockCert, nil),
+				)
+			},
+			wantedEnv: &EnvDescription{
+				Environment: testEnv,
+				Services:    envSvcs,
+				Certificates: []*Certificate{
+					{
+						Environment: "testEnv",
+						Certificate: mockCert,
+					},
+				},
+				Tags: map[string]string{"copilot-application": "testApp", "copilot-environment": "testEnv"},
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -195,10 +384,20 @@ func TestEnvDescriber_Describe(t *testing.T) {
 			mockConfigStoreSvc := mocks.NewMockConfigStoreSvc(ctrl)
 			mockDeployedEnvServicesLister := mocks.NewMockDeployedEnvServicesLister(ctrl)
 			mockStackDescriber := mocks.NewMockstackAndResourcesDescriber(ctrl)
+			mockCertDescriber := mocks.NewMockcertDescriber(ctrl)
+			mockEgressIPsGetter := mocks.NewMockegressIPsGetter(ctrl)
+			mockClusterGetter := mocks.NewMockclusterCapacityGetter(ctrl)
+			mockQuotaGetter := mocks.NewMockfargateQuotaGetter(ctrl)
+			mockUsageGetter := mocks.NewMockfargateUsageGetter(ctrl)
 			mocks := envDescriberMocks{
 				configStoreSvc: mockConfigStoreSvc,
 				deployStoreSvc: mockDeployedEnvServicesLister,
 				stackDescriber: mockStackDescriber,
+				certDescriber:  mockCertDescriber,
+				egressIPs:      mockEgressIPsGetter,
+				cluster:        mockClusterGetter,
+				quota:          mockQuotaGetter,
+				usage:          mockUsageGetter,
 			}
 
 			tc.setupMocks(mocks)
@@ -207,14 +406,21 @@ func TestEnvDescriber_Describe(t *testing.T) {
 				env:             testEnv,
 				app:             testApp,
 				enableResources: tc.shouldOutputResources,
-
-				configStore:    mockConfigStoreSvc,
-				deployStore:    mockDeployedEnvServicesLister,
-				stackDescriber: mockStackDescriber,
+				enableEgressIPs: tc.shouldOutputEgressIPs,
+				enableCapacity:  tc.shouldOutputCapacity,
+
+				configStore:     mockConfigStoreSvc,
+				deployStore:     mockDeployedEnvServicesLister,
+				stackDescriber:  mockStackDescriber,
+				certDescriber:   mockCertDescriber,
+				egressIPsGetter: mockEgressIPsGetter,
+				clusterGetter:   mockClusterGetter,
+				quotaGetter:     mockQuotaGetter,
+				usageGetter:     mockUsageGetter,
 			}
 
 			// WHEN
-			actual, err := d.Describe()
+			actual, err := d.Describe(context.Background())
 
 			// THEN
 			if tc.wantedError != nil {
@@ -325,6 +531,19 @@ Services
   testSvc2          load-balanced
   testSvc3          load-balanced
 
+Egress IPs
+
+  3.3.3.3
+  4.4.4.4
+
+Capacity
+
+  Running Tasks               3
+  Pending Tasks               0
+  Active Services             2
+  Fargate vCPUs               2.5 / 6 used by the account
+  Container Insights Default  disabled
+
 Tags
 
   Key               Value
@@ -346,6 +565,16 @@ Resources
 		Services:    allSvcs,
 		Tags:        testApp.Tags,
 		Resources:   wantedResources,
+		EgressIPs:   []string{"3.3.3.3", "4.4.4.4"},
+		Capacity: &EnvCapacity{
+			ClusterCapacity: ecs.ClusterCapacity{
+				RunningTasks:   3,
+				ActiveServices: 2,
+			},
+			FargateVCPUUsage:  2.5,
+			FargateVCPUQuota:  6,
+			ContainerInsights: "disabled",
+		},
 	}
 
 	// WHEN
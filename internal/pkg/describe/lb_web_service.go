@@ -16,9 +16,11 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/acm"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
 )
 
 // WebServiceURI represents the unique identifier to access a web service.
@@ -59,7 +61,9 @@ type svcDescriber interface {
 	Params() (map[string]string, error)
 	EnvOutputs() (map[string]string, error)
 	EnvVars() (map[string]string, error)
+	Outputs() (map[string]string, error)
 	ServiceStackResources() ([]*cloudformation.StackResource, error)
+	EnvCertificates() ([]*acm.Certificate, error)
 }
 
 // WebServiceDescriber retrieves information about a load balanced web service.
@@ -67,6 +71,7 @@ type WebServiceDescriber struct {
 	app             string
 	svc             string
 	enableResources bool
+	dependencies    map[string][]string
 
 	store                DeployedEnvServicesLister
 	svcDescriber         map[string]svcDescriber
@@ -81,6 +86,7 @@ type NewWebServiceConfig struct {
 	NewServiceConfig
 	EnableResources bool
 	DeployStore     DeployedEnvServicesLister
+	Dependencies    map[string][]string // Services that each service in the workspace depends on, if available.
 }
 
 // NewWebServiceDescriber instantiates a load balanced service describer.
@@ -89,6 +95,7 @@ func NewWebServiceDescriber(opt NewWebServiceConfig) (*WebServiceDescriber, erro
 		app:             opt.App,
 		svc:             opt.Svc,
 		enableResources: opt.EnableResources,
+		dependencies:    opt.Dependencies,
 		store:           opt.DeployStore,
 		svcDescriber:    make(map[string]svcDescriber),
 	}
@@ -101,6 +108,7 @@ func NewWebServiceDescriber(opt NewWebServiceConfig) (*WebServiceDescriber, erro
 			Env:         env,
 			Svc:         opt.Svc,
 			ConfigStore: opt.ConfigStore,
+			Cache:       opt.Cache,
 		})
 		if err != nil {
 			return err
@@ -119,9 +127,11 @@ func (d *WebServiceDescriber) Describe() (HumanJSONStringer, error) {
 	}
 
 	var routes []*WebServiceRoute
+	var certs []*Certificate
 	var configs []*ServiceConfig
 	var serviceDiscoveries []*ServiceDiscovery
 	var envVars []*EnvVars
+	var outputs []*StackOutput
 	for _, env := range environments {
 		err := d.initServiceDescriber(env)
 		if err != nil {
@@ -135,6 +145,19 @@ func (d *WebServiceDescriber) Describe() (HumanJSONStringer, error) {
 			Environment: env,
 			URL:         webServiceURI,
 		})
+		if strings.HasPrefix(webServiceURI, "https://") {
+			// The certificates are optional details of the description.
+			envCerts, err := d.svcDescriber[env].EnvCertificates()
+			if err != nil {
+				log.Warningf("Couldn't retrieve certificates of environment %s: %v\n", env, err)
+			}
+			for _, cert := range envCerts {
+				certs = append(certs, &Certificate{
+					Environment: env,
+					Certificate: cert,
+				})
+			}
+		}
 		configs = append(configs, &ServiceConfig{
 			Environment: env,
 			Port:        d.svcParams[stack.LBWebServiceContainerPortParamKey],
@@ -152,9 +175,16 @@ func (d *WebServiceDescriber) Describe() (HumanJSONStringer, error) {
 			return nil, fmt.Errorf("retrieve environment variables: %w", err)
 		}
 		envVars = append(envVars, flattenEnvVars(env, webSvcEnvVars)...)
+		webSvcOutputs, err := d.svcDescriber[env].Outputs()
+		if err != nil {
+			return nil, fmt.Errorf("retrieve service outputs: %w", err)
+		}
+		outputs = append(outputs, flattenOutputs(env, webSvcOutputs)...)
 	}
 	sort.SliceStable(envVars, func(i, j int) bool { return envVars[i].Environment < envVars[j].Environment })
 	sort.SliceStable(envVars, func(i, j int) bool { return envVars[i].Name < envVars[j].Name })
+	sort.SliceStable(outputs, func(i, j int) bool { return outputs[i].Environment < outputs[j].Environment })
+	sort.SliceStable(outputs, func(i, j int) bool { return outputs[i].Name < outputs[j].Name })
 
 	resources := make(map[string][]*CfnResource)
 	if d.enableResources {
@@ -177,8 +207,11 @@ func (d *WebServiceDescriber) Describe() (HumanJSONStringer, error) {
 		App:              d.app,
 		Configurations:   configs,
 		Routes:           routes,
+		Certificates:     certs,
 		ServiceDiscovery: serviceDiscoveries,
 		Variables:        envVars,
+		Outputs:          outputs,
+		Dependencies:     dependencyTree(d.svc, d.dependencies),
 		Resources:        resources,
 	}, nil
 }
@@ -214,6 +247,41 @@ func (d *WebServiceDescriber) URI(envName string) (string, error) {
 	return uri.String(), nil
 }
 
+// HealthCheckURI returns the URI of the service's health check path through the public load balancer given an environment name.
+func (d *WebServiceDescriber) HealthCheckURI(envName string) (string, error) {
+	err := d.initServiceDescriber(envName)
+	if err != nil {
+		return "", err
+	}
+
+	envOutputs, err := d.svcDescriber[envName].EnvOutputs()
+	if err != nil {
+		return "", fmt.Errorf("get output for environment %s: %w", envName, err)
+	}
+	svcParams, err := d.svcDescriber[envName].Params()
+	if err != nil {
+		return "", fmt.Errorf("get parameters for service %s: %w", d.svc, err)
+	}
+	d.svcParams = svcParams
+
+	path := "/" + strings.TrimPrefix(svcParams[stack.LBWebServiceHealthCheckPathParamKey], "/")
+	if subdomain, isHTTPS := envOutputs[stack.EnvOutputSubdomain]; isHTTPS {
+		return fmt.Sprintf("https://%s.%s%s", d.svc, subdomain, path), nil
+	}
+	return fmt.Sprintf("http://%s%s", envOutputs[stack.EnvOutputPublicLoadBalancerDNSName], rulePathHealthCheck(svcParams[stack.LBWebServiceRulePathParamKey], path)), nil
+}
+
+// rulePathHealthCheck returns the path that the health check path is requested at through the load balancer,
+// where only the requests under the rule path of the service are forwarded to it.
+func rulePathHealthCheck(rulePath, healthCheckPath string) string {
+	rulePath = "/" + strings.Trim(rulePath, "/")
+	if rulePath == "/" || healthCheckPath == rulePath || strings.HasPrefix(healthCheckPath, rulePath+"/") {
+		return healthCheckPath
+	}
+	// For example, the health check path "/healthz" of a service at "api" is requested at "/api/healthz".
+	return rulePath + strings.TrimSuffix(healthCheckPath, "/")
+}
+
 // EnvVars contains serialized environment variables for a service.
 type EnvVars struct {
 	Environment string `json:"environment"`
@@ -275,8 +343,11 @@ type webSvcDesc struct {
 	App              string             `json:"application"`
 	Configurations   configurations     `json:"configurations"`
 	Routes           []*WebServiceRoute `json:"routes"`
+	Certificates     certificates       `json:"certificates,omitempty"`
 	ServiceDiscovery serviceDiscoveries `json:"serviceDiscovery"`
 	Variables        envVars            `json:"variables"`
+	Outputs          stackOutputs       `json:"outputs,omitempty"`
+	Dependencies     *Dependency        `json:"dependencies,omitempty"`
 	Resources        cfnResources       `json:"resources,omitempty"`
 }
 
@@ -307,12 +378,27 @@ func (w *webSvcDesc) HumanString() string {
 	for _, route := range w.Routes {
 		fmt.Fprintf(writer, "  %s\t%s\n", route.Environment, route.URL)
 	}
+	if len(w.Certificates) != 0 {
+		fmt.Fprintf(writer, color.Bold.Sprint("\nCertificates\n\n"))
+		writer.Flush()
+		w.Certificates.humanString(writer)
+	}
 	fmt.Fprintf(writer, color.Bold.Sprint("\nService Discovery\n\n"))
 	writer.Flush()
 	w.ServiceDiscovery.humanString(writer)
 	fmt.Fprintf(writer, color.Bold.Sprint("\nVariables\n\n"))
 	writer.Flush()
 	w.Variables.humanString(writer)
+	if len(w.Outputs) != 0 {
+		fmt.Fprintf(writer, color.Bold.Sprint("\nOutputs\n\n"))
+		writer.Flush()
+		w.Outputs.humanString(writer)
+	}
+	if w.Dependencies != nil {
+		fmt.Fprintf(writer, color.Bold.Sprint("\nDependencies\n\n"))
+		writer.Flush()
+		w.Dependencies.humanString(writer)
+	}
 	if len(w.Resources) != 0 {
 		fmt.Fprintf(writer, color.Bold.Sprint("\nResources\n"))
 		writer.Flush()
@@ -163,6 +163,148 @@ func TestWebServiceDescriber_URI(t *testing.T) {
 	}
 }
 
+func TestWebServiceDescriber_HealthCheckURI(t *testing.T) {
+	const (
+		testApp          = "phonetool"
+		testEnv          = "test"
+		testSvc          = "jobs"
+		testEnvSubdomain = "test.phonetool.com"
+		testEnvLBDNSName = "abc.us-west-1.elb.amazonaws.com"
+	)
+	mockErr := errors.New("some error")
+	testCases := map[string]struct {
+		setupMocks func(mocks webSvcDescriberMocks)
+
+		wantedURI   string
+		wantedError error
+	}{
+		"fail to get output of environment stack": {
+			setupMocks: func(m webSvcDescriberMocks) {
+				m.svcDescriber.EXPECT().EnvOutputs().Return(nil, mockErr)
+			},
+			wantedError: fmt.Errorf("get output for environment test: some error"),
+		},
+		"fail to get parameters of service stack": {
+			setupMocks: func(m webSvcDescriberMocks) {
+				gomock.InOrder(
+					m.svcDescriber.EXPECT().EnvOutputs().Return(map[string]string{}, nil),
+					m.svcDescriber.EXPECT().Params().Return(nil, mockErr),
+				)
+			},
+			wantedError: fmt.Errorf("get parameters for service jobs: some error"),
+		},
+		"https web service": {
+			setupMocks: func(m webSvcDescriberMocks) {
+				gomock.InOrder(
+					m.svcDescriber.EXPECT().EnvOutputs().Return(map[string]string{
+						stack.EnvOutputPublicLoadBalancerDNSName: testEnvLBDNSName,
+						stack.EnvOutputSubdomain:                 testEnvSubdomain,
+					}, nil),
+					m.svcDescriber.EXPECT().Params().Return(map[string]string{
+						stack.LBWebServiceHealthCheckPathParamKey: "/healthz",
+					}, nil),
+				)
+			},
+
+			wantedURI: "https://jobs.test.phonetool.com/healthz",
+		},
+		"http web service": {
+			setupMocks: func(m webSvcDescriberMocks) {
+				gomock.InOrder(
+					m.svcDescriber.EXPECT().EnvOutputs().Return(map[string]string{
+						stack.EnvOutputPublicLoadBalancerDNSName: testEnvLBDNSName,
+					}, nil),
+					m.svcDescriber.EXPECT().Params().Return(map[string]string{
+						stack.LBWebServiceHealthCheckPathParamKey: "jobs/healthz",
+					}, nil),
+				)
+			},
+
+			wantedURI: "http://abc.us-west-1.elb.amazonaws.com/jobs/healthz",
+		},
+		"http web service with the health check path under the rule path": {
+			setupMocks: func(m webSvcDescriberMocks) {
+				gomock.InOrder(
+					m.svcDescriber.EXPECT().EnvOutputs().Return(map[string]string{
+						stack.EnvOutputPublicLoadBalancerDNSName: testEnvLBDNSName,
+					}, nil),
+					m.svcDescriber.EXPECT().Params().Return(map[string]string{
+						stack.LBWebServiceRulePathParamKey:        "jobs",
+						stack.LBWebServiceHealthCheckPathParamKey: "/jobs/healthz",
+					}, nil),
+				)
+			},
+
+			wantedURI: "http://abc.us-west-1.elb.amazonaws.com/jobs/healthz",
+		},
+		"http web service prefixes the health check path with the rule path": {
+			setupMocks: func(m webSvcDescriberMocks) {
+				gomock.InOrder(
+					m.svcDescriber.EXPECT().EnvOutputs().Return(map[string]string{
+						stack.EnvOutputPublicLoadBalancerDNSName: testEnvLBDNSName,
+					}, nil),
+					m.svcDescriber.EXPECT().Params().Return(map[string]string{
+						stack.LBWebServiceRulePathParamKey:        "jobs",
+						stack.LBWebServiceHealthCheckPathParamKey: "/",
+					}, nil),
+				)
+			},
+
+			wantedURI: "http://abc.us-west-1.elb.amazonaws.com/jobs",
+		},
+		"http web service at the root path": {
+			setupMocks: func(m webSvcDescriberMocks) {
+				gomock.InOrder(
+					m.svcDescriber.EXPECT().EnvOutputs().Return(map[string]string{
+						stack.EnvOutputPublicLoadBalancerDNSName: testEnvLBDNSName,
+					}, nil),
+					m.svcDescriber.EXPECT().Params().Return(map[string]string{
+						stack.LBWebServiceRulePathParamKey:        "/",
+						stack.LBWebServiceHealthCheckPathParamKey: "/healthz",
+					}, nil),
+				)
+			},
+
+			wantedURI: "http://abc.us-west-1.elb.amazonaws.com/healthz",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSvcDescriber := mocks.NewMocksvcDescriber(ctrl)
+			mocks := webSvcDescriberMocks{
+				svcDescriber: mockSvcDescriber,
+			}
+
+			tc.setupMocks(mocks)
+
+			d := &WebServiceDescriber{
+				app: testApp,
+				svc: testSvc,
+				svcDescriber: map[string]svcDescriber{
+					"test": mockSvcDescriber,
+				},
+				initServiceDescriber: func(string) error { return nil },
+			}
+
+			// WHEN
+			actual, err := d.HealthCheckURI(testEnv)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedURI, actual)
+			}
+		})
+	}
+}
+
 func TestWebServiceDescriber_Describe(t *testing.T) {
 	const (
 		testApp          = "phonetool"
@@ -200,6 +342,23 @@ func TestWebServiceDescriber_Describe(t *testing.T) {
 			},
 			wantedError: fmt.Errorf("retrieve service URI: get output for environment test: some error"),
 		},
+		"continue if fail to retrieve certificates of an https environment": {
+			setupMocks: func(m webSvcDescriberMocks) {
+				gomock.InOrder(
+					m.storeSvc.EXPECT().ListEnvironmentsDeployedTo(testApp, testSvc).Return([]string{testEnv}, nil),
+					m.svcDescriber.EXPECT().EnvOutputs().Return(map[string]string{
+						stack.EnvOutputPublicLoadBalancerDNSName: testEnvLBDNSName,
+						stack.EnvOutputSubdomain:                 "test.phonetool.com",
+					}, nil),
+					m.svcDescriber.EXPECT().Params().Return(map[string]string{
+						stack.LBWebServiceRulePathParamKey: testSvcPath,
+					}, nil),
+					m.svcDescriber.EXPECT().EnvCertificates().Return(nil, mockErr),
+					m.svcDescriber.EXPECT().EnvVars().Return(nil, mockErr),
+				)
+			},
+			wantedError: fmt.Errorf("retrieve environment variables: some error"),
+		},
 		"return error if fail to retrieve service deployment configuration": {
 			setupMocks: func(m webSvcDescriberMocks) {
 				gomock.InOrder(
@@ -238,6 +397,10 @@ func TestWebServiceDescriber_Describe(t *testing.T) {
 						map[string]string{
 							"COPILOT_ENVIRONMENT_NAME": testEnv,
 						}, nil),
+					m.svcDescriber.EXPECT().Outputs().Return(
+						map[string]string{
+							"JobsTableName": "phonetool-test-jobs",
+						}, nil),
 					m.svcDescriber.EXPECT().ServiceStackResources().Return(nil, mockErr),
 				)
 			},
@@ -263,6 +426,10 @@ func TestWebServiceDescriber_Describe(t *testing.T) {
 						map[string]string{
 							"COPILOT_ENVIRONMENT_NAME": testEnv,
 						}, nil),
+					m.svcDescriber.EXPECT().Outputs().Return(
+						map[string]string{
+							"JobsTableName": "phonetool-test-jobs",
+						}, nil),
 
 					m.svcDescriber.EXPECT().EnvOutputs().Return(map[string]string{
 						stack.EnvOutputPublicLoadBalancerDNSName: prodEnvLBDNSName,
@@ -278,6 +445,10 @@ func TestWebServiceDescriber_Describe(t *testing.T) {
 						map[string]string{
 							"COPILOT_ENVIRONMENT_NAME": prodEnv,
 						}, nil),
+					m.svcDescriber.EXPECT().Outputs().Return(
+						map[string]string{
+							"JobsTableName": "phonetool-prod-jobs",
+						}, nil),
 
 					m.svcDescriber.EXPECT().ServiceStackResources().Return([]*cloudformation.StackResource{
 						{
@@ -341,6 +512,18 @@ func TestWebServiceDescriber_Describe(t *testing.T) {
 						Value:       "test",
 					},
 				},
+				Outputs: []*StackOutput{
+					{
+						Environment: "prod",
+						Name:        "JobsTableName",
+						Value:       "phonetool-prod-jobs",
+					},
+					{
+						Environment: "test",
+						Name:        "JobsTableName",
+						Value:       "phonetool-test-jobs",
+					},
+				},
 				Resources: map[string][]*CfnResource{
 					"test": {
 						{
@@ -434,6 +617,13 @@ Variables
   COPILOT_ENVIRONMENT_NAME  prod                prod
   -                         test                test
 
+Dependencies
+
+  my-svc
+  ├── api
+  │   └── db
+  └── cache
+
 Resources
 
   test
@@ -442,7 +632,7 @@ Resources
   prod
     AWS::EC2::SecurityGroupIngress  ContainerSecurityGroupIngressFromPublicALB
 `,
-			wantedJSONString: "{\"service\":\"my-svc\",\"type\":\"Load Balanced Web Service\",\"application\":\"my-app\",\"configurations\":[{\"environment\":\"test\",\"port\":\"80\",\"tasks\":\"1\",\"cpu\":\"256\",\"memory\":\"512\"},{\"environment\":\"prod\",\"port\":\"5000\",\"tasks\":\"3\",\"cpu\":\"512\",\"memory\":\"1024\"}],\"routes\":[{\"environment\":\"test\",\"url\":\"http://my-pr-Publi.us-west-2.elb.amazonaws.com/frontend\"},{\"environment\":\"prod\",\"url\":\"http://my-pr-Publi.us-west-2.elb.amazonaws.com/backend\"}],\"serviceDiscovery\":[{\"environment\":[\"test\",\"prod\"],\"namespace\":\"http://my-svc.my-app.local:5000\"}],\"variables\":[{\"environment\":\"prod\",\"name\":\"COPILOT_ENVIRONMENT_NAME\",\"value\":\"prod\"},{\"environment\":\"test\",\"name\":\"COPILOT_ENVIRONMENT_NAME\",\"value\":\"test\"}],\"resources\":{\"prod\":[{\"type\":\"AWS::EC2::SecurityGroupIngress\",\"physicalID\":\"ContainerSecurityGroupIngressFromPublicALB\"}],\"test\":[{\"type\":\"AWS::EC2::SecurityGroup\",\"physicalID\":\"sg-0758ed6b233743530\"}]}}\n",
+			wantedJSONString: "{\"service\":\"my-svc\",\"type\":\"Load Balanced Web Service\",\"application\":\"my-app\",\"configurations\":[{\"environment\":\"test\",\"port\":\"80\",\"tasks\":\"1\",\"cpu\":\"256\",\"memory\":\"512\"},{\"environment\":\"prod\",\"port\":\"5000\",\"tasks\":\"3\",\"cpu\":\"512\",\"memory\":\"1024\"}],\"routes\":[{\"environment\":\"test\",\"url\":\"http://my-pr-Publi.us-west-2.elb.amazonaws.com/frontend\"},{\"environment\":\"prod\",\"url\":\"http://my-pr-Publi.us-west-2.elb.amazonaws.com/backend\"}],\"serviceDiscovery\":[{\"environment\":[\"test\",\"prod\"],\"namespace\":\"http://my-svc.my-app.local:5000\"}],\"variables\":[{\"environment\":\"prod\",\"name\":\"COPILOT_ENVIRONMENT_NAME\",\"value\":\"prod\"},{\"environment\":\"test\",\"name\":\"COPILOT_ENVIRONMENT_NAME\",\"value\":\"test\"}],\"dependencies\":{\"name\":\"my-svc\",\"dependsOn\":[{\"name\":\"api\",\"dependsOn\":[{\"name\":\"db\"}]},{\"name\":\"cache\"}]},\"resources\":{\"prod\":[{\"type\":\"AWS::EC2::SecurityGroupIngress\",\"physicalID\":\"ContainerSecurityGroupIngressFromPublicALB\"}],\"test\":[{\"type\":\"AWS::EC2::SecurityGroup\",\"physicalID\":\"sg-0758ed6b233743530\"}]}}\n",
 		},
 	}
 
@@ -514,7 +704,11 @@ Resources
 				Variables:        envVars,
 				Routes:           routes,
 				ServiceDiscovery: sds,
-				Resources:        resources,
+				Dependencies: dependencyTree("my-svc", map[string][]string{
+					"my-svc": {"api", "cache"},
+					"api":    {"db"},
+				}),
+				Resources: resources,
 			}
 			human := webSvc.HumanString()
 			json, _ := webSvc.JSONString()
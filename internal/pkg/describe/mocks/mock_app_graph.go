@@ -0,0 +1,49 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/describe/app_graph.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	cloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MockaddonsResourcesDescriber is a mock of addonsResourcesDescriber interface
+type MockaddonsResourcesDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockaddonsResourcesDescriberMockRecorder
+}
+
+// MockaddonsResourcesDescriberMockRecorder is the mock recorder for MockaddonsResourcesDescriber
+type MockaddonsResourcesDescriberMockRecorder struct {
+	mock *MockaddonsResourcesDescriber
+}
+
+// NewMockaddonsResourcesDescriber creates a new mock instance
+func NewMockaddonsResourcesDescriber(ctrl *gomock.Controller) *MockaddonsResourcesDescriber {
+	mock := &MockaddonsResourcesDescriber{ctrl: ctrl}
+	mock.recorder = &MockaddonsResourcesDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockaddonsResourcesDescriber) EXPECT() *MockaddonsResourcesDescriberMockRecorder {
+	return m.recorder
+}
+
+// AddonsResources mocks base method
+func (m *MockaddonsResourcesDescriber) AddonsResources() ([]*cloudformation.StackResource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddonsResources")
+	ret0, _ := ret[0].([]*cloudformation.StackResource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddonsResources indicates an expected call of AddonsResources
+func (mr *MockaddonsResourcesDescriberMockRecorder) AddonsResources() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddonsResources", reflect.TypeOf((*MockaddonsResourcesDescriber)(nil).AddonsResources))
+}
@@ -0,0 +1,49 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/describe/certificate.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	acm "github.com/aws/copilot-cli/internal/pkg/aws/acm"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MockcertDescriber is a mock of certDescriber interface
+type MockcertDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockcertDescriberMockRecorder
+}
+
+// MockcertDescriberMockRecorder is the mock recorder for MockcertDescriber
+type MockcertDescriberMockRecorder struct {
+	mock *MockcertDescriber
+}
+
+// NewMockcertDescriber creates a new mock instance
+func NewMockcertDescriber(ctrl *gomock.Controller) *MockcertDescriber {
+	mock := &MockcertDescriber{ctrl: ctrl}
+	mock.recorder = &MockcertDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockcertDescriber) EXPECT() *MockcertDescriberMockRecorder {
+	return m.recorder
+}
+
+// DescribeCertificate mocks base method
+func (m *MockcertDescriber) DescribeCertificate(arn string) (*acm.Certificate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeCertificate", arn)
+	ret0, _ := ret[0].(*acm.Certificate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeCertificate indicates an expected call of DescribeCertificate
+func (mr *MockcertDescriberMockRecorder) DescribeCertificate(arn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeCertificate", reflect.TypeOf((*MockcertDescriber)(nil).DescribeCertificate), arn)
+}
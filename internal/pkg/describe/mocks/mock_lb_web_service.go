@@ -6,6 +6,7 @@ package mocks
 
 import (
 	cloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
+	acm "github.com/aws/copilot-cli/internal/pkg/aws/acm"
 	gomock "github.com/golang/mock/gomock"
 	reflect "reflect"
 )
@@ -78,6 +79,21 @@ func (mr *MocksvcDescriberMockRecorder) EnvVars() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnvVars", reflect.TypeOf((*MocksvcDescriber)(nil).EnvVars))
 }
 
+// Outputs mocks base method
+func (m *MocksvcDescriber) Outputs() (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Outputs")
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Outputs indicates an expected call of Outputs
+func (mr *MocksvcDescriberMockRecorder) Outputs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Outputs", reflect.TypeOf((*MocksvcDescriber)(nil).Outputs))
+}
+
 // ServiceStackResources mocks base method
 func (m *MocksvcDescriber) ServiceStackResources() ([]*cloudformation.StackResource, error) {
 	m.ctrl.T.Helper()
@@ -92,3 +108,18 @@ func (mr *MocksvcDescriberMockRecorder) ServiceStackResources() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceStackResources", reflect.TypeOf((*MocksvcDescriber)(nil).ServiceStackResources))
 }
+
+// EnvCertificates mocks base method
+func (m *MocksvcDescriber) EnvCertificates() ([]*acm.Certificate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnvCertificates")
+	ret0, _ := ret[0].([]*acm.Certificate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnvCertificates indicates an expected call of EnvCertificates
+func (mr *MocksvcDescriberMockRecorder) EnvCertificates() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnvCertificates", reflect.TypeOf((*MocksvcDescriber)(nil).EnvCertificates))
+}
@@ -0,0 +1,49 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/describe/security_status.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	securityhub "github.com/aws/copilot-cli/internal/pkg/aws/securityhub"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MockfindingsGetter is a mock of findingsGetter interface
+type MockfindingsGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockfindingsGetterMockRecorder
+}
+
+// MockfindingsGetterMockRecorder is the mock recorder for MockfindingsGetter
+type MockfindingsGetterMockRecorder struct {
+	mock *MockfindingsGetter
+}
+
+// NewMockfindingsGetter creates a new mock instance
+func NewMockfindingsGetter(ctrl *gomock.Controller) *MockfindingsGetter {
+	mock := &MockfindingsGetter{ctrl: ctrl}
+	mock.recorder = &MockfindingsGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockfindingsGetter) EXPECT() *MockfindingsGetterMockRecorder {
+	return m.recorder
+}
+
+// OpenFindings mocks base method
+func (m *MockfindingsGetter) OpenFindings(resourceARNs []string) ([]securityhub.Finding, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenFindings", resourceARNs)
+	ret0, _ := ret[0].([]securityhub.Finding)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OpenFindings indicates an expected call of OpenFindings
+func (mr *MockfindingsGetterMockRecorder) OpenFindings(resourceARNs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenFindings", reflect.TypeOf((*MockfindingsGetter)(nil).OpenFindings), resourceARNs)
+}
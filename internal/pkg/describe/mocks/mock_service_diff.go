@@ -0,0 +1,78 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/describe/service_diff.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MocksvcConfigDescriber is a mock of svcConfigDescriber interface
+type MocksvcConfigDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MocksvcConfigDescriberMockRecorder
+}
+
+// MocksvcConfigDescriberMockRecorder is the mock recorder for MocksvcConfigDescriber
+type MocksvcConfigDescriberMockRecorder struct {
+	mock *MocksvcConfigDescriber
+}
+
+// NewMocksvcConfigDescriber creates a new mock instance
+func NewMocksvcConfigDescriber(ctrl *gomock.Controller) *MocksvcConfigDescriber {
+	mock := &MocksvcConfigDescriber{ctrl: ctrl}
+	mock.recorder = &MocksvcConfigDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MocksvcConfigDescriber) EXPECT() *MocksvcConfigDescriberMockRecorder {
+	return m.recorder
+}
+
+// Params mocks base method
+func (m *MocksvcConfigDescriber) Params() (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Params")
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Params indicates an expected call of Params
+func (mr *MocksvcConfigDescriberMockRecorder) Params() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Params", reflect.TypeOf((*MocksvcConfigDescriber)(nil).Params))
+}
+
+// EnvVars mocks base method
+func (m *MocksvcConfigDescriber) EnvVars() (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnvVars")
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnvVars indicates an expected call of EnvVars
+func (mr *MocksvcConfigDescriberMockRecorder) EnvVars() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnvVars", reflect.TypeOf((*MocksvcConfigDescriber)(nil).EnvVars))
+}
+
+// Secrets mocks base method
+func (m *MocksvcConfigDescriber) Secrets() (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Secrets")
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Secrets indicates an expected call of Secrets
+func (mr *MocksvcConfigDescriberMockRecorder) Secrets() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Secrets", reflect.TypeOf((*MocksvcConfigDescriber)(nil).Secrets))
+}
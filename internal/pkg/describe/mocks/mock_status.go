@@ -5,11 +5,14 @@
 package mocks
 
 import (
+	applicationautoscaling "github.com/aws/copilot-cli/internal/pkg/aws/applicationautoscaling"
 	cloudwatch "github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
+	cloudwatchlogs "github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
 	ecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	resourcegroups "github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
 	gomock "github.com/golang/mock/gomock"
 	reflect "reflect"
+	time "time"
 )
 
 // MockalarmStatusGetter is a mock of alarmStatusGetter interface
@@ -50,6 +53,66 @@ func (mr *MockalarmStatusGetterMockRecorder) GetAlarmsWithTags(tags interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlarmsWithTags", reflect.TypeOf((*MockalarmStatusGetter)(nil).GetAlarmsWithTags), tags)
 }
 
+// GetAlarmsWithNames mocks base method
+func (m *MockalarmStatusGetter) GetAlarmsWithNames(names []string) ([]cloudwatch.AlarmStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAlarmsWithNames", names)
+	ret0, _ := ret[0].([]cloudwatch.AlarmStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAlarmsWithNames indicates an expected call of GetAlarmsWithNames
+func (mr *MockalarmStatusGetterMockRecorder) GetAlarmsWithNames(names interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlarmsWithNames", reflect.TypeOf((*MockalarmStatusGetter)(nil).GetAlarmsWithNames), names)
+}
+
+// GetAlarmsWithNamePrefix mocks base method
+func (m *MockalarmStatusGetter) GetAlarmsWithNamePrefix(prefix string) ([]cloudwatch.AlarmStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAlarmsWithNamePrefix", prefix)
+	ret0, _ := ret[0].([]cloudwatch.AlarmStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAlarmsWithNamePrefix indicates an expected call of GetAlarmsWithNamePrefix
+func (mr *MockalarmStatusGetterMockRecorder) GetAlarmsWithNamePrefix(prefix interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlarmsWithNamePrefix", reflect.TypeOf((*MockalarmStatusGetter)(nil).GetAlarmsWithNamePrefix), prefix)
+}
+
+// GetAlarmsWithDimensions mocks base method
+func (m *MockalarmStatusGetter) GetAlarmsWithDimensions(dimensions map[string]string) ([]cloudwatch.AlarmStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAlarmsWithDimensions", dimensions)
+	ret0, _ := ret[0].([]cloudwatch.AlarmStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAlarmsWithDimensions indicates an expected call of GetAlarmsWithDimensions
+func (mr *MockalarmStatusGetterMockRecorder) GetAlarmsWithDimensions(dimensions interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlarmsWithDimensions", reflect.TypeOf((*MockalarmStatusGetter)(nil).GetAlarmsWithDimensions), dimensions)
+}
+
+// AlarmHistory mocks base method
+func (m *MockalarmStatusGetter) AlarmHistory(alarmName string, startDate time.Time) ([]cloudwatch.AlarmHistoryItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AlarmHistory", alarmName, startDate)
+	ret0, _ := ret[0].([]cloudwatch.AlarmHistoryItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AlarmHistory indicates an expected call of AlarmHistory
+func (mr *MockalarmStatusGetterMockRecorder) AlarmHistory(alarmName, startDate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AlarmHistory", reflect.TypeOf((*MockalarmStatusGetter)(nil).AlarmHistory), alarmName, startDate)
+}
+
 // MockresourcesGetter is a mock of resourcesGetter interface
 type MockresourcesGetter struct {
 	ctrl     *gomock.Controller
@@ -88,6 +151,59 @@ func (mr *MockresourcesGetterMockRecorder) GetResourcesByTags(resourceType, tags
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResourcesByTags", reflect.TypeOf((*MockresourcesGetter)(nil).GetResourcesByTags), resourceType, tags)
 }
 
+// MockcontainerInsightsQuerier is a mock of containerInsightsQuerier interface
+type MockcontainerInsightsQuerier struct {
+	ctrl     *gomock.Controller
+	recorder *MockcontainerInsightsQuerierMockRecorder
+}
+
+// MockcontainerInsightsQuerierMockRecorder is the mock recorder for MockcontainerInsightsQuerier
+type MockcontainerInsightsQuerierMockRecorder struct {
+	mock *MockcontainerInsightsQuerier
+}
+
+// NewMockcontainerInsightsQuerier creates a new mock instance
+func NewMockcontainerInsightsQuerier(ctrl *gomock.Controller) *MockcontainerInsightsQuerier {
+	mock := &MockcontainerInsightsQuerier{ctrl: ctrl}
+	mock.recorder = &MockcontainerInsightsQuerierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockcontainerInsightsQuerier) EXPECT() *MockcontainerInsightsQuerierMockRecorder {
+	return m.recorder
+}
+
+// LogGroupExists mocks base method
+func (m *MockcontainerInsightsQuerier) LogGroupExists(logGroupName string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LogGroupExists", logGroupName)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LogGroupExists indicates an expected call of LogGroupExists
+func (mr *MockcontainerInsightsQuerierMockRecorder) LogGroupExists(logGroupName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogGroupExists", reflect.TypeOf((*MockcontainerInsightsQuerier)(nil).LogGroupExists), logGroupName)
+}
+
+// Query mocks base method
+func (m *MockcontainerInsightsQuerier) Query(logGroupName, query string, startTime, endTime int64) (*cloudwatchlogs.QueryResults, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Query", logGroupName, query, startTime, endTime)
+	ret0, _ := ret[0].(*cloudwatchlogs.QueryResults)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Query indicates an expected call of Query
+func (mr *MockcontainerInsightsQuerierMockRecorder) Query(logGroupName, query, startTime, endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Query", reflect.TypeOf((*MockcontainerInsightsQuerier)(nil).Query), logGroupName, query, startTime, endTime)
+}
+
 // MockecsServiceGetter is a mock of ecsServiceGetter interface
 type MockecsServiceGetter struct {
 	ctrl     *gomock.Controller
@@ -126,6 +242,21 @@ func (mr *MockecsServiceGetterMockRecorder) ServiceTasks(clusterName, serviceNam
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceTasks", reflect.TypeOf((*MockecsServiceGetter)(nil).ServiceTasks), clusterName, serviceName)
 }
 
+// StoppedServiceTasks mocks base method
+func (m *MockecsServiceGetter) StoppedServiceTasks(clusterName, serviceName string) ([]*ecs.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoppedServiceTasks", clusterName, serviceName)
+	ret0, _ := ret[0].([]*ecs.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StoppedServiceTasks indicates an expected call of StoppedServiceTasks
+func (mr *MockecsServiceGetterMockRecorder) StoppedServiceTasks(clusterName, serviceName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoppedServiceTasks", reflect.TypeOf((*MockecsServiceGetter)(nil).StoppedServiceTasks), clusterName, serviceName)
+}
+
 // Service mocks base method
 func (m *MockecsServiceGetter) Service(clusterName, serviceName string) (*ecs.Service, error) {
 	m.ctrl.T.Helper()
@@ -140,3 +271,56 @@ func (mr *MockecsServiceGetterMockRecorder) Service(clusterName, serviceName int
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Service", reflect.TypeOf((*MockecsServiceGetter)(nil).Service), clusterName, serviceName)
 }
+
+// ServiceEvents mocks base method
+func (m *MockecsServiceGetter) ServiceEvents(clusterName, serviceName string, since time.Time) ([]ecs.ServiceEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceEvents", clusterName, serviceName, since)
+	ret0, _ := ret[0].([]ecs.ServiceEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ServiceEvents indicates an expected call of ServiceEvents
+func (mr *MockecsServiceGetterMockRecorder) ServiceEvents(clusterName, serviceName, since interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceEvents", reflect.TypeOf((*MockecsServiceGetter)(nil).ServiceEvents), clusterName, serviceName, since)
+}
+
+// MockautoscalingGetter is a mock of autoscalingGetter interface
+type MockautoscalingGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockautoscalingGetterMockRecorder
+}
+
+// MockautoscalingGetterMockRecorder is the mock recorder for MockautoscalingGetter
+type MockautoscalingGetterMockRecorder struct {
+	mock *MockautoscalingGetter
+}
+
+// NewMockautoscalingGetter creates a new mock instance
+func NewMockautoscalingGetter(ctrl *gomock.Controller) *MockautoscalingGetter {
+	mock := &MockautoscalingGetter{ctrl: ctrl}
+	mock.recorder = &MockautoscalingGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockautoscalingGetter) EXPECT() *MockautoscalingGetterMockRecorder {
+	return m.recorder
+}
+
+// ECSServiceScaling mocks base method
+func (m *MockautoscalingGetter) ECSServiceScaling(clusterName, serviceName string) (*applicationautoscaling.ServiceScaling, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ECSServiceScaling", clusterName, serviceName)
+	ret0, _ := ret[0].(*applicationautoscaling.ServiceScaling)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ECSServiceScaling indicates an expected call of ECSServiceScaling
+func (mr *MockautoscalingGetterMockRecorder) ECSServiceScaling(clusterName, serviceName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ECSServiceScaling", reflect.TypeOf((*MockautoscalingGetter)(nil).ECSServiceScaling), clusterName, serviceName)
+}
@@ -47,7 +47,7 @@ func NewPipelineDescriber(pipelineName string, showResources bool) (*PipelineDes
 		return nil, err
 	}
 
-	describer := newStackDescriber(sess)
+	describer := newStackDescriber(sess, nil)
 	pipelineSvc := codepipeline.New(sess)
 
 	return &PipelineDescriber{
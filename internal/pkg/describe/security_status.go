@@ -0,0 +1,178 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	rg "github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
+	"github.com/aws/copilot-cli/internal/pkg/aws/securityhub"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+const (
+	severityCritical = "CRITICAL"
+	severityHigh     = "HIGH"
+)
+
+// severityLabels are the severities of findings from the most to the least severe.
+var severityLabels = []string{severityCritical, severityHigh, "MEDIUM", "LOW", "INFORMATIONAL"}
+
+type findingsGetter interface {
+	OpenFindings(resourceARNs []string) ([]securityhub.Finding, error)
+}
+
+// SecurityStatus retrieves the open security findings on the resources of a service.
+type SecurityStatus struct {
+	app string
+	env string
+	svc string
+
+	rgSvc    resourcesGetter
+	findings findingsGetter
+}
+
+// NewSecurityStatusConfig contains fields that initiates SecurityStatus struct.
+type NewSecurityStatusConfig struct {
+	App         string
+	Env         string
+	Svc         string
+	ConfigStore ConfigStoreSvc
+}
+
+// NewSecurityStatus instantiates a new SecurityStatus struct.
+func NewSecurityStatus(opt *NewSecurityStatusConfig) (*SecurityStatus, error) {
+	env, err := opt.ConfigStore.GetEnvironment(opt.App, opt.Env)
+	if err != nil {
+		return nil, fmt.Errorf("get environment %s: %w", opt.Env, err)
+	}
+	sess, err := sessions.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
+	if err != nil {
+		return nil, fmt.Errorf("session for role %s and region %s: %w", env.ManagerRoleARN, env.Region, err)
+	}
+	return &SecurityStatus{
+		app:      opt.App,
+		env:      opt.Env,
+		svc:      opt.Svc,
+		rgSvc:    rg.New(sess),
+		findings: securityhub.New(sess),
+	}, nil
+}
+
+// SecurityStatusDesc contains the open security findings of a service.
+type SecurityStatusDesc struct {
+	Service  string                `json:"service"`
+	Counts   map[string]int        `json:"counts"` // Number of open findings by severity.
+	Findings []securityhub.Finding `json:"findings"`
+}
+
+// Describe returns the open findings of Security Hub, and of the products integrated with it such as GuardDuty,
+// on the resources that are tagged with the service, sorted from the most to the least severe.
+func (s *SecurityStatus) Describe() (*SecurityStatusDesc, error) {
+	resources, err := s.rgSvc.GetResourcesByTags("", map[string]string{
+		deploy.AppTagKey:     s.app,
+		deploy.EnvTagKey:     s.env,
+		deploy.ServiceTagKey: s.svc,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get resources of service %s: %w", s.svc, err)
+	}
+	desc := &SecurityStatusDesc{
+		Service: s.svc,
+		Counts:  make(map[string]int),
+	}
+	for _, severity := range severityLabels {
+		desc.Counts[severity] = 0
+	}
+	if len(resources) == 0 {
+		return desc, nil
+	}
+	arns := make([]string, len(resources))
+	for i, resource := range resources {
+		arns[i] = resource.ARN
+	}
+	findings, err := s.findings.OpenFindings(arns)
+	if err != nil {
+		return nil, fmt.Errorf("get findings of service %s: %w", s.svc, err)
+	}
+	for _, finding := range findings {
+		desc.Counts[finding.Severity]++
+	}
+	sort.SliceStable(findings, func(i, j int) bool {
+		if findings[i].Severity != findings[j].Severity {
+			return severityRank(findings[i].Severity) < severityRank(findings[j].Severity)
+		}
+		return findings[i].UpdatedAt.After(findings[j].UpdatedAt)
+	})
+	desc.Findings = findings
+	return desc, nil
+}
+
+func severityRank(severity string) int {
+	for i, label := range severityLabels {
+		if label == severity {
+			return i
+		}
+	}
+	return len(severityLabels)
+}
+
+// JSONString returns the stringified SecurityStatusDesc struct with json format.
+func (s *SecurityStatusDesc) JSONString() (string, error) {
+	b, err := json.Marshal(s)
+	if err != nil {
+		return "", fmt.Errorf("marshal security status: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the number of open findings by severity, along with the critical findings.
+func (s *SecurityStatusDesc) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprintf(writer, color.Bold.Sprint("Open Findings\n\n"))
+	writer.Flush()
+	var headers, counts []string
+	for _, severity := range severityLabels {
+		headers = append(headers, strings.Title(strings.ToLower(severity)))
+		counts = append(counts, severityColor(severity, s.Counts[severity]))
+	}
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "  %s\n", strings.Join(counts, "\t"))
+	fmt.Fprintf(writer, color.Bold.Sprint("\nCritical Findings\n\n"))
+	writer.Flush()
+	if s.Counts[severityCritical] == 0 {
+		fmt.Fprintf(writer, "  No open critical findings.\n")
+		writer.Flush()
+		return b.String()
+	}
+	fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\n", "Title", "Product", "Resource", "Last Updated")
+	for _, finding := range s.Findings {
+		if finding.Severity != severityCritical {
+			continue
+		}
+		fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\n", finding.Title, finding.Product, strings.Join(finding.ResourceIDs, ", "), humanizeTime(finding.UpdatedAt))
+	}
+	writer.Flush()
+	return b.String()
+}
+
+func severityColor(severity string, count int) string {
+	if count == 0 {
+		return "0"
+	}
+	switch severity {
+	case severityCritical, severityHigh:
+		return color.Red.Sprint(count)
+	default:
+		return fmt.Sprint(count)
+	}
+}
@@ -0,0 +1,193 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	rg "github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
+	"github.com/aws/copilot-cli/internal/pkg/aws/securityhub"
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/dustin/go-humanize"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type securityStatusMocks struct {
+	rg       *mocks.MockresourcesGetter
+	findings *mocks.MockfindingsGetter
+}
+
+func TestSecurityStatus_Describe(t *testing.T) {
+	const (
+		mockServiceARN = "arn:aws:ecs:us-west-2:123456789012:service/phonetool-test-Cluster/phonetool-test-frontend"
+		mockTaskDefARN = "arn:aws:ecs:us-west-2:123456789012:task-definition/phonetool-test-frontend:3"
+	)
+	wantedTags := map[string]string{
+		"copilot-application": "phonetool",
+		"copilot-environment": "test",
+		"copilot-service":     "frontend",
+	}
+	older := time.Date(2020, 11, 1, 0, 0, 0, 0, time.UTC)
+	newer := time.Date(2020, 11, 2, 0, 0, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		setupMocks func(m securityStatusMocks)
+
+		wantedDesc *SecurityStatusDesc
+		wantedErr  error
+	}{
+		"errors if fail to get the resources of the service": {
+			setupMocks: func(m securityStatusMocks) {
+				m.rg.EXPECT().GetResourcesByTags("", wantedTags).Return(nil, errors.New("some error"))
+			},
+			wantedErr: fmt.Errorf("get resources of service frontend: some error"),
+		},
+		"errors if fail to get the findings": {
+			setupMocks: func(m securityStatusMocks) {
+				m.rg.EXPECT().GetResourcesByTags("", wantedTags).Return([]*rg.Resource{{ARN: mockServiceARN}}, nil)
+				m.findings.EXPECT().OpenFindings([]string{mockServiceARN}).Return(nil, &securityhub.ErrNotEnabled{})
+			},
+			wantedErr: fmt.Errorf("get findings of service frontend: Security Hub is not enabled in this account and region"),
+		},
+		"returns no findings without querying Security Hub if the service has no resources": {
+			setupMocks: func(m securityStatusMocks) {
+				m.rg.EXPECT().GetResourcesByTags("", wantedTags).Return(nil, nil)
+			},
+			wantedDesc: &SecurityStatusDesc{
+				Service: "frontend",
+				Counts:  map[string]int{"CRITICAL": 0, "HIGH": 0, "MEDIUM": 0, "LOW": 0, "INFORMATIONAL": 0},
+			},
+		},
+		"counts the findings by severity and sorts them": {
+			setupMocks: func(m securityStatusMocks) {
+				m.rg.EXPECT().GetResourcesByTags("", wantedTags).Return([]*rg.Resource{{ARN: mockServiceARN}, {ARN: mockTaskDefARN}}, nil)
+				m.findings.EXPECT().OpenFindings([]string{mockServiceARN, mockTaskDefARN}).Return([]securityhub.Finding{
+					{ID: "1", Severity: "LOW", UpdatedAt: newer},
+					{ID: "2", Severity: "CRITICAL", UpdatedAt: older},
+					{ID: "3", Severity: "HIGH", UpdatedAt: newer},
+					{ID: "4", Severity: "CRITICAL", UpdatedAt: newer},
+				}, nil)
+			},
+			wantedDesc: &SecurityStatusDesc{
+				Service: "frontend",
+				Counts:  map[string]int{"CRITICAL": 2, "HIGH": 1, "MEDIUM": 0, "LOW": 1, "INFORMATIONAL": 0},
+				Findings: []securityhub.Finding{
+					{ID: "4", Severity: "CRITICAL", UpdatedAt: newer},
+					{ID: "2", Severity: "CRITICAL", UpdatedAt: older},
+					{ID: "3", Severity: "HIGH", UpdatedAt: newer},
+					{ID: "1", Severity: "LOW", UpdatedAt: newer},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := securityStatusMocks{
+				rg:       mocks.NewMockresourcesGetter(ctrl),
+				findings: mocks.NewMockfindingsGetter(ctrl),
+			}
+			tc.setupMocks(m)
+			s := &SecurityStatus{
+				app:      "phonetool",
+				env:      "test",
+				svc:      "frontend",
+				rgSvc:    m.rg,
+				findings: m.findings,
+			}
+
+			// WHEN
+			desc, err := s.Describe()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedDesc, desc)
+		})
+	}
+}
+
+func TestSecurityStatusDesc_String(t *testing.T) {
+	oldHumanize := humanizeTime
+	humanizeTime = func(then time.Time) string {
+		now, _ := time.Parse(time.RFC3339, "2020-11-03T00:00:00+00:00")
+		return humanize.RelTime(then, now, "ago", "from now")
+	}
+	defer func() {
+		humanizeTime = oldHumanize
+	}()
+	testCases := map[string]struct {
+		desc *SecurityStatusDesc
+
+		wantedHumanString string
+		wantedJSONString  string
+	}{
+		"without critical findings": {
+			desc: &SecurityStatusDesc{
+				Service: "frontend",
+				Counts:  map[string]int{"CRITICAL": 0, "HIGH": 0, "MEDIUM": 0, "LOW": 1, "INFORMATIONAL": 0},
+				Findings: []securityhub.Finding{
+					{ID: "1", Title: "Unused security group", Severity: "LOW", Product: "Security Hub", ResourceIDs: []string{"sg-1"}},
+				},
+			},
+			wantedHumanString: `Open Findings
+
+  Critical          High                Medium              Low                 Informational
+  0                 0                   0                   1                   0
+
+Critical Findings
+
+  No open critical findings.
+`,
+			wantedJSONString: `{"service":"frontend","counts":{"CRITICAL":0,"HIGH":0,"INFORMATIONAL":0,"LOW":1,"MEDIUM":0},"findings":[{"id":"1","title":"Unused security group","severity":"LOW","product":"Security Hub","resourceIDs":["sg-1"],"updatedAt":"0001-01-01T00:00:00Z"}]}
+`,
+		},
+		"with critical findings": {
+			desc: &SecurityStatusDesc{
+				Service: "frontend",
+				Counts:  map[string]int{"CRITICAL": 1, "HIGH": 0, "MEDIUM": 0, "LOW": 0, "INFORMATIONAL": 0},
+				Findings: []securityhub.Finding{
+					{
+						ID:          "1",
+						Title:       "Backdoor communication",
+						Severity:    "CRITICAL",
+						Product:     "GuardDuty",
+						ResourceIDs: []string{"arn:aws:ecs:us-west-2:123456789012:task/abc"},
+						UpdatedAt:   time.Date(2020, 11, 2, 0, 0, 0, 0, time.UTC),
+					},
+				},
+			},
+			wantedHumanString: `Open Findings
+
+  Critical          High                Medium              Low                 Informational
+  1                 0                   0                   0                   0
+
+Critical Findings
+
+  Title                   Product             Resource                                     Last Updated
+  Backdoor communication  GuardDuty           arn:aws:ecs:us-west-2:123456789012:task/abc  1 day ago
+`,
+			wantedJSONString: `{"service":"frontend","counts":{"CRITICAL":1,"HIGH":0,"INFORMATIONAL":0,"LOW":0,"MEDIUM":0},"findings":[{"id":"1","title":"Backdoor communication","severity":"CRITICAL","product":"GuardDuty","resourceIDs":["arn:aws:ecs:us-west-2:123456789012:task/abc"],"updatedAt":"2020-11-02T00:00:00Z"}]}
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			json, err := tc.desc.JSONString()
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedJSONString, json)
+			require.Equal(t, tc.wantedHumanString, tc.desc.HumanString())
+		})
+	}
+}
@@ -9,8 +9,10 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/acm"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/cache"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 )
@@ -22,6 +24,7 @@ const (
 	waitConditionHandle  = "AWS::CloudFormation::WaitConditionHandle"
 
 	serviceLogicalID = "Service"
+	addonsLogicalID  = "AddonsStack"
 )
 
 type stackAndResourcesDescriber interface {
@@ -72,6 +75,7 @@ type ServiceDescriber struct {
 
 	ecsClient      ecsClient
 	stackDescriber stackAndResourcesDescriber
+	certDescriber  certDescriber
 }
 
 // NewServiceConfig contains fields that initiates ServiceDescriber struct.
@@ -80,6 +84,7 @@ type NewServiceConfig struct {
 	Env         string
 	Svc         string
 	ConfigStore ConfigStoreSvc
+	Cache       *cache.Cache // Optional. Caches the described stacks if set.
 }
 
 // NewServiceDescriber instantiates a new service.
@@ -92,7 +97,7 @@ func NewServiceDescriber(opt NewServiceConfig) (*ServiceDescriber, error) {
 	if err != nil {
 		return nil, err
 	}
-	d := newStackDescriber(sess)
+	d := newStackDescriber(sess, opt.Cache)
 	return &ServiceDescriber{
 		app:     opt.App,
 		service: opt.Svc,
@@ -100,6 +105,7 @@ func NewServiceDescriber(opt NewServiceConfig) (*ServiceDescriber, error) {
 
 		ecsClient:      ecs.New(sess),
 		stackDescriber: d,
+		certDescriber:  acm.New(sess),
 	}, nil
 }
 
@@ -115,6 +121,16 @@ func (d *ServiceDescriber) EnvVars() (map[string]string, error) {
 	return envVars, nil
 }
 
+// Secrets returns the secrets of the task definition along with the parameters they're referenced from.
+func (d *ServiceDescriber) Secrets() (map[string]string, error) {
+	taskDefName := fmt.Sprintf("%s-%s-%s", d.app, d.env, d.service)
+	taskDefinition, err := d.ecsClient.TaskDefinition(taskDefName)
+	if err != nil {
+		return nil, err
+	}
+	return taskDefinition.Secrets(), nil
+}
+
 // ServiceStackResources returns the filtered service stack resources created by CloudFormation.
 func (d *ServiceDescriber) ServiceStackResources() ([]*cloudformation.StackResource, error) {
 	svcResources, err := d.stackDescriber.StackResources(stack.NameForService(d.app, d.env, d.service))
@@ -150,6 +166,61 @@ func (d *ServiceDescriber) EnvOutputs() (map[string]string, error) {
 	return outputs, nil
 }
 
+// Outputs returns the outputs of the service stack, and of its nested addons stack if the service has addons.
+func (d *ServiceDescriber) Outputs() (map[string]string, error) {
+	svcStackName := stack.NameForService(d.app, d.env, d.service)
+	svcStack, err := d.stackDescriber.Stack(svcStackName)
+	if err != nil {
+		return nil, err
+	}
+	outputs := make(map[string]string)
+	for _, out := range svcStack.Outputs {
+		outputs[aws.StringValue(out.OutputKey)] = aws.StringValue(out.OutputValue)
+	}
+	addonsStackID, err := d.addonsStackID()
+	if err != nil {
+		return nil, err
+	}
+	if addonsStackID == "" {
+		return outputs, nil
+	}
+	addonsStack, err := d.stackDescriber.Stack(addonsStackID)
+	if err != nil {
+		return nil, err
+	}
+	for _, out := range addonsStack.Outputs {
+		outputs[aws.StringValue(out.OutputKey)] = aws.StringValue(out.OutputValue)
+	}
+	return outputs, nil
+}
+
+// AddonsResources returns the resources created by the service's nested addons stack, or nil if the service has no addons.
+func (d *ServiceDescriber) AddonsResources() ([]*cloudformation.StackResource, error) {
+	addonsStackID, err := d.addonsStackID()
+	if err != nil {
+		return nil, err
+	}
+	if addonsStackID == "" {
+		return nil, nil
+	}
+	return d.stackDescriber.StackResources(addonsStackID)
+}
+
+// addonsStackID returns the ID of the service's nested addons stack, or an empty string if the service has no addons.
+// The physical ID of a nested stack is its ARN, which CloudFormation accepts as a stack name.
+func (d *ServiceDescriber) addonsStackID() (string, error) {
+	svcResources, err := d.stackDescriber.StackResources(stack.NameForService(d.app, d.env, d.service))
+	if err != nil {
+		return "", err
+	}
+	for _, resource := range svcResources {
+		if aws.StringValue(resource.LogicalResourceId) == addonsLogicalID {
+			return aws.StringValue(resource.PhysicalResourceId), nil
+		}
+	}
+	return "", nil
+}
+
 // Params returns the parameters of the service stack.
 func (d *ServiceDescriber) Params() (map[string]string, error) {
 	svcStack, err := d.stackDescriber.Stack(stack.NameForService(d.app, d.env, d.service))
@@ -162,3 +233,16 @@ func (d *ServiceDescriber) Params() (map[string]string, error) {
 	}
 	return params, nil
 }
+
+// EnvCertificates returns the certificates of the environment's HTTPS listener that serve the service's alias.
+func (d *ServiceDescriber) EnvCertificates() ([]*acm.Certificate, error) {
+	envResources, err := d.stackDescriber.StackResources(stack.NameForEnv(d.app, d.env))
+	if err != nil {
+		return nil, err
+	}
+	var out []*acm.Certificate
+	for _, cert := range envCertificates(d.env, envResources, d.certDescriber) {
+		out = append(out, cert.Certificate)
+	}
+	return out, nil
+}
@@ -0,0 +1,225 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+type svcConfigDescriber interface {
+	Params() (map[string]string, error)
+	EnvVars() (map[string]string, error)
+	Secrets() (map[string]string, error)
+}
+
+// ServiceDiffer compares the configuration of a service deployed in two environments.
+type ServiceDiffer struct {
+	app string
+	svc string
+
+	svcDescriber         map[string]svcConfigDescriber
+	initServiceDescriber func(string) error
+}
+
+// NewServiceDiffer instantiates a new service differ. The Env field of the config is ignored.
+func NewServiceDiffer(opt NewServiceConfig) (*ServiceDiffer, error) {
+	differ := &ServiceDiffer{
+		app:          opt.App,
+		svc:          opt.Svc,
+		svcDescriber: make(map[string]svcConfigDescriber),
+	}
+	differ.initServiceDescriber = func(env string) error {
+		if _, ok := differ.svcDescriber[env]; ok {
+			return nil
+		}
+		d, err := NewServiceDescriber(NewServiceConfig{
+			App:         opt.App,
+			Env:         env,
+			Svc:         opt.Svc,
+			ConfigStore: opt.ConfigStore,
+			Cache:       opt.Cache,
+		})
+		if err != nil {
+			return err
+		}
+		differ.svcDescriber[env] = d
+		return nil
+	}
+	return differ, nil
+}
+
+// Diff returns the differences between the configuration of the service deployed in the fromEnv and toEnv environments.
+func (d *ServiceDiffer) Diff(fromEnv, toEnv string) (*ServiceDiff, error) {
+	from, err := d.config(fromEnv)
+	if err != nil {
+		return nil, err
+	}
+	to, err := d.config(toEnv)
+	if err != nil {
+		return nil, err
+	}
+	return &ServiceDiff{
+		Service:        d.svc,
+		From:           fromEnv,
+		To:             toEnv,
+		Configurations: diffMaps(from.configurations, to.configurations),
+		Variables:      diffMaps(from.variables, to.variables),
+		Secrets:        diffMaps(from.secrets, to.secrets),
+	}, nil
+}
+
+type svcEnvConfig struct {
+	configurations map[string]string
+	variables      map[string]string
+	secrets        map[string]string
+}
+
+func (d *ServiceDiffer) config(env string) (*svcEnvConfig, error) {
+	if err := d.initServiceDescriber(env); err != nil {
+		return nil, err
+	}
+	params, err := d.svcDescriber[env].Params()
+	if err != nil {
+		return nil, fmt.Errorf("retrieve service deployment configuration in environment %s: %w", env, err)
+	}
+	envVars, err := d.svcDescriber[env].EnvVars()
+	if err != nil {
+		return nil, fmt.Errorf("retrieve environment variables in environment %s: %w", env, err)
+	}
+	secrets, err := d.svcDescriber[env].Secrets()
+	if err != nil {
+		return nil, fmt.Errorf("retrieve secrets in environment %s: %w", env, err)
+	}
+	configs := make(map[string]string)
+	for name, key := range map[string]string{
+		"Tasks":        stack.ServiceTaskCountParamKey,
+		"CPU (vCPU)":   stack.ServiceTaskCPUParamKey,
+		"Memory (MiB)": stack.ServiceTaskMemoryParamKey,
+		"Port":         stack.LBWebServiceContainerPortParamKey,
+	} {
+		val, ok := params[key]
+		if !ok {
+			continue
+		}
+		if key == stack.ServiceTaskCPUParamKey {
+			val = cpuToString(val)
+		}
+		configs[name] = val
+	}
+	return &svcEnvConfig{
+		configurations: configs,
+		variables:      envVars,
+		secrets:        secrets,
+	}, nil
+}
+
+// ConfigDiff is a setting whose value differs between two environments.
+// From or To is nil if the setting only exists in the other environment.
+type ConfigDiff struct {
+	Name string  `json:"name"`
+	From *string `json:"from,omitempty"`
+	To   *string `json:"to,omitempty"`
+}
+
+type configDiffs []*ConfigDiff
+
+func (c configDiffs) humanString(w io.Writer, from, to string) {
+	fmt.Fprintf(w, "    %s\t%s\t%s\n", "Name", from, to)
+	for _, diff := range c {
+		symbol := color.Yellow.Sprint("~")
+		if diff.From == nil {
+			symbol = color.Green.Sprint("+")
+		}
+		if diff.To == nil {
+			symbol = color.Red.Sprint("-")
+		}
+		fmt.Fprintf(w, "  %s %s\t%s\t%s\n", symbol, diff.Name, diffValue(diff.From), diffValue(diff.To))
+	}
+}
+
+func diffValue(val *string) string {
+	if val == nil {
+		return "-"
+	}
+	return *val
+}
+
+// diffMaps returns the keys whose values differ between from and to, sorted by name.
+func diffMaps(from, to map[string]string) []*ConfigDiff {
+	var diffs []*ConfigDiff
+	for name, fromVal := range from {
+		toVal, ok := to[name]
+		if !ok {
+			diffs = append(diffs, &ConfigDiff{Name: name, From: aws.String(fromVal)})
+			continue
+		}
+		if fromVal != toVal {
+			diffs = append(diffs, &ConfigDiff{Name: name, From: aws.String(fromVal), To: aws.String(toVal)})
+		}
+	}
+	for name, toVal := range to {
+		if _, ok := from[name]; !ok {
+			diffs = append(diffs, &ConfigDiff{Name: name, To: aws.String(toVal)})
+		}
+	}
+	sort.SliceStable(diffs, func(i, j int) bool { return diffs[i].Name < diffs[j].Name })
+	return diffs
+}
+
+// ServiceDiff contains serialized differences of a service's configuration between two environments.
+type ServiceDiff struct {
+	Service        string      `json:"service"`
+	From           string      `json:"from"`
+	To             string      `json:"to"`
+	Configurations configDiffs `json:"configurations"`
+	Variables      configDiffs `json:"variables"`
+	Secrets        configDiffs `json:"secrets"`
+}
+
+// JSONString returns the stringified ServiceDiff struct with json format.
+func (s *ServiceDiff) JSONString() (string, error) {
+	b, err := json.Marshal(s)
+	if err != nil {
+		return "", fmt.Errorf("marshal service diff: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the stringified ServiceDiff struct with human readable format.
+func (s *ServiceDiff) HumanString() string {
+	if len(s.Configurations) == 0 && len(s.Variables) == 0 && len(s.Secrets) == 0 {
+		return fmt.Sprintf("Service %s has the same configuration in environments %s and %s.\n", s.Service, s.From, s.To)
+	}
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	for _, section := range []struct {
+		title string
+		diffs configDiffs
+	}{
+		{"Configurations", s.Configurations},
+		{"Variables", s.Variables},
+		{"Secrets", s.Secrets},
+	} {
+		if len(section.diffs) == 0 {
+			continue
+		}
+		if b.Len() != 0 {
+			fmt.Fprint(writer, "\n")
+		}
+		fmt.Fprintf(writer, color.Bold.Sprintf("%s\n\n", section.title))
+		writer.Flush()
+		section.diffs.humanString(writer, s.From, s.To)
+		writer.Flush()
+	}
+	return b.String()
+}
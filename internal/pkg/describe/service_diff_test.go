@@ -0,0 +1,188 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServiceDiffer_Diff(t *testing.T) {
+	const (
+		testApp = "phonetool"
+		testSvc = "jobs"
+		testEnv = "test"
+		prodEnv = "prod"
+	)
+	testParams := map[string]string{
+		stack.LBWebServiceContainerPortParamKey: "80",
+		stack.ServiceTaskCountParamKey:          "1",
+		stack.ServiceTaskCPUParamKey:            "256",
+		stack.ServiceTaskMemoryParamKey:         "512",
+	}
+	mockErr := errors.New("some error")
+	testCases := map[string]struct {
+		setupMocks func(test, prod *mocks.MocksvcConfigDescriber)
+
+		wantedDiff  *ServiceDiff
+		wantedError error
+	}{
+		"return error if fail to retrieve the parameters": {
+			setupMocks: func(test, prod *mocks.MocksvcConfigDescriber) {
+				test.EXPECT().Params().Return(nil, mockErr)
+			},
+			wantedError: fmt.Errorf("retrieve service deployment configuration in environment test: some error"),
+		},
+		"return error if fail to retrieve the environment variables": {
+			setupMocks: func(test, prod *mocks.MocksvcConfigDescriber) {
+				test.EXPECT().Params().Return(testParams, nil)
+				test.EXPECT().EnvVars().Return(nil, mockErr)
+			},
+			wantedError: fmt.Errorf("retrieve environment variables in environment test: some error"),
+		},
+		"return error if fail to retrieve the secrets": {
+			setupMocks: func(test, prod *mocks.MocksvcConfigDescriber) {
+				test.EXPECT().Params().Return(testParams, nil)
+				test.EXPECT().EnvVars().Return(map[string]string{}, nil)
+				test.EXPECT().Secrets().Return(nil, mockErr)
+			},
+			wantedError: fmt.Errorf("retrieve secrets in environment test: some error"),
+		},
+		"success": {
+			setupMocks: func(test, prod *mocks.MocksvcConfigDescriber) {
+				test.EXPECT().Params().Return(testParams, nil)
+				test.EXPECT().EnvVars().Return(map[string]string{
+					"COPILOT_ENVIRONMENT_NAME": "test",
+					"LOG_LEVEL":                "debug",
+					"REGION":                   "us-west-2",
+				}, nil)
+				test.EXPECT().Secrets().Return(map[string]string{
+					"DB_PASSWORD": "/copilot/phonetool/test/secrets/db",
+				}, nil)
+				prod.EXPECT().Params().Return(map[string]string{
+					stack.LBWebServiceContainerPortParamKey: "80",
+					stack.ServiceTaskCountParamKey:          "3",
+					stack.ServiceTaskCPUParamKey:            "256",
+					stack.ServiceTaskMemoryParamKey:         "1024",
+				}, nil)
+				prod.EXPECT().EnvVars().Return(map[string]string{
+					"COPILOT_ENVIRONMENT_NAME": "prod",
+					"REGION":                   "us-west-2",
+					"FEATURE_FLAG":             "on",
+				}, nil)
+				prod.EXPECT().Secrets().Return(map[string]string{
+					"DB_PASSWORD": "/copilot/phonetool/test/secrets/db",
+				}, nil)
+			},
+			wantedDiff: &ServiceDiff{
+				Service: testSvc,
+				From:    testEnv,
+				To:      prodEnv,
+				Configurations: []*ConfigDiff{
+					{Name: "Memory (MiB)", From: aws.String("512"), To: aws.String("1024")},
+					{Name: "Tasks", From: aws.String("1"), To: aws.String("3")},
+				},
+				Variables: []*ConfigDiff{
+					{Name: "COPILOT_ENVIRONMENT_NAME", From: aws.String("test"), To: aws.String("prod")},
+					{Name: "FEATURE_FLAG", To: aws.String("on")},
+					{Name: "LOG_LEVEL", From: aws.String("debug")},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockTestDescriber := mocks.NewMocksvcConfigDescriber(ctrl)
+			mockProdDescriber := mocks.NewMocksvcConfigDescriber(ctrl)
+			tc.setupMocks(mockTestDescriber, mockProdDescriber)
+
+			d := &ServiceDiffer{
+				app: testApp,
+				svc: testSvc,
+				svcDescriber: map[string]svcConfigDescriber{
+					testEnv: mockTestDescriber,
+					prodEnv: mockProdDescriber,
+				},
+				initServiceDescriber: func(string) error { return nil },
+			}
+
+			// WHEN
+			diff, err := d.Diff(testEnv, prodEnv)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedDiff, diff)
+		})
+	}
+}
+
+func TestServiceDiff_String(t *testing.T) {
+	testCases := map[string]struct {
+		inDiff *ServiceDiff
+
+		wantedHumanString string
+		wantedJSONString  string
+	}{
+		"no differences": {
+			inDiff: &ServiceDiff{
+				Service: "jobs",
+				From:    "test",
+				To:      "prod",
+			},
+			wantedHumanString: "Service jobs has the same configuration in environments test and prod.\n",
+			wantedJSONString:  "{\"service\":\"jobs\",\"from\":\"test\",\"to\":\"prod\",\"configurations\":null,\"variables\":null,\"secrets\":null}\n",
+		},
+		"with differences": {
+			inDiff: &ServiceDiff{
+				Service: "jobs",
+				From:    "test",
+				To:      "prod",
+				Configurations: []*ConfigDiff{
+					{Name: "Tasks", From: aws.String("1"), To: aws.String("3")},
+				},
+				Secrets: []*ConfigDiff{
+					{Name: "DB_PASSWORD", From: aws.String("/copilot/phonetool/test/secrets/db")},
+					{Name: "API_KEY", To: aws.String("/copilot/phonetool/prod/secrets/api-key")},
+				},
+			},
+			wantedHumanString: `Configurations
+
+    Name            test                prod
+  ~ Tasks           1                   3
+
+Secrets
+
+    Name            test                                prod
+  - DB_PASSWORD     /copilot/phonetool/test/secrets/db  -
+  + API_KEY         -                                   /copilot/phonetool/prod/secrets/api-key
+`,
+			wantedJSONString: "{\"service\":\"jobs\",\"from\":\"test\",\"to\":\"prod\",\"configurations\":[{\"name\":\"Tasks\",\"from\":\"1\",\"to\":\"3\"}],\"variables\":null,\"secrets\":[{\"name\":\"DB_PASSWORD\",\"from\":\"/copilot/phonetool/test/secrets/db\"},{\"name\":\"API_KEY\",\"to\":\"/copilot/phonetool/prod/secrets/api-key\"}]}\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			human := tc.inDiff.HumanString()
+			json, _ := tc.inDiff.JSONString()
+
+			require.Equal(t, tc.wantedHumanString, human)
+			require.Equal(t, tc.wantedJSONString, json)
+		})
+	}
+}
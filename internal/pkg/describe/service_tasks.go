@@ -0,0 +1,192 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+)
+
+// Columns that the tasks of a service can be sorted by.
+const (
+	TaskSortByID     = "id"
+	TaskSortByStatus = "status"
+	TaskSortByHealth = "health"
+	TaskSortByAZ     = "az"
+	TaskSortByCPU    = "cpu"
+	TaskSortByMemory = "memory"
+	TaskSortByImage  = "image"
+	TaskSortByUptime = "uptime"
+)
+
+// TaskSortColumns are the columns that the tasks of a service can be sorted by.
+var TaskSortColumns = []string{TaskSortByID, TaskSortByStatus, TaskSortByHealth, TaskSortByAZ, TaskSortByCPU, TaskSortByMemory, TaskSortByImage, TaskSortByUptime}
+
+const shortTaskIDLength = 8
+
+// timeSince is overriden in tests so that the uptime of tasks is constant as time passes.
+var timeSince = time.Since
+
+// TaskSummary is a compact view of a running task of a service.
+type TaskSummary struct {
+	ID               string    `json:"id"`
+	Status           string    `json:"status"`
+	Health           string    `json:"health"`
+	AvailabilityZone string    `json:"availabilityZone"`
+	CPU              string    `json:"cpu"`    // CPU units reserved for the task.
+	Memory           string    `json:"memory"` // Memory in MiB reserved for the task.
+	ImageTag         string    `json:"imageTag,omitempty"`
+	StartedAt        time.Time `json:"startedAt"`
+}
+
+// ServiceTasksDesc contains the running tasks of a service.
+type ServiceTasksDesc struct {
+	Tasks []TaskSummary `json:"tasks"`
+}
+
+// NewServiceTasksDesc summarizes the tasks of a service.
+// The image tag of a task is the one of the container named after the service, or of its first container otherwise.
+func NewServiceTasksDesc(svc string, tasks []*ecs.Task) (*ServiceTasksDesc, error) {
+	summaries := []TaskSummary{}
+	for _, task := range tasks {
+		status, err := task.TaskStatus()
+		if err != nil {
+			return nil, fmt.Errorf("get status for task %s: %w", aws.StringValue(task.TaskArn), err)
+		}
+		summaries = append(summaries, TaskSummary{
+			ID:               status.ID,
+			Status:           status.LastStatus,
+			Health:           status.Health,
+			AvailabilityZone: aws.StringValue(task.AvailabilityZone),
+			CPU:              aws.StringValue(task.Cpu),
+			Memory:           aws.StringValue(task.Memory),
+			ImageTag:         imageTag(svc, task),
+			StartedAt:        status.StartedAt,
+		})
+	}
+	return &ServiceTasksDesc{
+		Tasks: summaries,
+	}, nil
+}
+
+// Sort orders the tasks by the column in ascending order, so sorting by uptime lists the most recently started tasks first.
+func (d *ServiceTasksDesc) Sort(column string) error {
+	var less func(a, b TaskSummary) bool
+	switch column {
+	case TaskSortByID:
+		less = func(a, b TaskSummary) bool { return a.ID < b.ID }
+	case TaskSortByStatus:
+		less = func(a, b TaskSummary) bool { return a.Status < b.Status }
+	case TaskSortByHealth:
+		less = func(a, b TaskSummary) bool { return a.Health < b.Health }
+	case TaskSortByAZ:
+		less = func(a, b TaskSummary) bool { return a.AvailabilityZone < b.AvailabilityZone }
+	case TaskSortByCPU:
+		less = func(a, b TaskSummary) bool { return atoi(a.CPU) < atoi(b.CPU) }
+	case TaskSortByMemory:
+		less = func(a, b TaskSummary) bool { return atoi(a.Memory) < atoi(b.Memory) }
+	case TaskSortByImage:
+		less = func(a, b TaskSummary) bool { return a.ImageTag < b.ImageTag }
+	case TaskSortByUptime:
+		less = func(a, b TaskSummary) bool { return a.StartedAt.After(b.StartedAt) }
+	default:
+		return fmt.Errorf("cannot sort tasks by %s: must be one of %s", column, strings.Join(TaskSortColumns, ", "))
+	}
+	sort.SliceStable(d.Tasks, func(i, j int) bool {
+		return less(d.Tasks[i], d.Tasks[j])
+	})
+	return nil
+}
+
+// JSONString returns the stringified ServiceTasksDesc struct with json format.
+func (d *ServiceTasksDesc) JSONString() (string, error) {
+	b, err := json.Marshal(d)
+	if err != nil {
+		return "", fmt.Errorf("marshal tasks: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the stringified ServiceTasksDesc struct with human readable format.
+// Example output:
+//
+//	ID        Status   Health   AZ          CPU  Memory  Image   Uptime
+//	6ca7a60d  RUNNING  HEALTHY  us-west-2a  256  512     v1.2.0  3h12m
+func (d *ServiceTasksDesc) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, 0, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprintf(writer, "%s\n", strings.Join([]string{"ID", "Status", "Health", "AZ", "CPU", "Memory", "Image", "Uptime"}, "\t"))
+	for _, task := range d.Tasks {
+		id := task.ID
+		if len(id) > shortTaskIDLength {
+			id = id[:shortTaskIDLength]
+		}
+		uptime := "-"
+		if !task.StartedAt.IsZero() {
+			uptime = formatUptime(timeSince(task.StartedAt))
+		}
+		fmt.Fprintf(writer, "%s\n", strings.Join([]string{id, task.Status, dashIfEmpty(task.Health), dashIfEmpty(task.AvailabilityZone),
+			dashIfEmpty(task.CPU), dashIfEmpty(task.Memory), dashIfEmpty(task.ImageTag), uptime}, "\t"))
+	}
+	writer.Flush()
+	return b.String()
+}
+
+// imageTag returns the tag of the image of the main container of a task, or an empty string if the image isn't tagged.
+func imageTag(svc string, task *ecs.Task) string {
+	if len(task.Containers) == 0 {
+		return ""
+	}
+	image := aws.StringValue(task.Containers[0].Image)
+	for _, container := range task.Containers {
+		if aws.StringValue(container.Name) == svc {
+			image = aws.StringValue(container.Image)
+			break
+		}
+	}
+	if strings.Contains(image, "@") {
+		return ""
+	}
+	i := strings.LastIndex(image, ":")
+	if i == -1 || strings.Contains(image[i:], "/") {
+		// The colon separates the port of the registry rather than the tag.
+		return ""
+	}
+	return image[i+1:]
+}
+
+// formatUptime returns a compact representation of how long a task has been running, such as 45s, 3h12m or 2d4h.
+func formatUptime(d time.Duration) string {
+	switch {
+	case d < time.Minute:
+		return fmt.Sprintf("%ds", int(d.Seconds()))
+	case d < time.Hour:
+		return fmt.Sprintf("%dm", int(d.Minutes()))
+	case d < 24*time.Hour:
+		return fmt.Sprintf("%dh%dm", int(d.Hours()), int(d.Minutes())%60)
+	default:
+		return fmt.Sprintf("%dd%dh", int(d.Hours())/24, int(d.Hours())%24)
+	}
+}
+
+func dashIfEmpty(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+func atoi(s string) int {
+	i, _ := strconv.Atoi(s)
+	return i
+}
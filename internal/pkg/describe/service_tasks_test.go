@@ -0,0 +1,192 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsecs "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewServiceTasksDesc(t *testing.T) {
+	startedAt := time.Date(2020, 11, 3, 10, 0, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		inTasks []*ecs.Task
+
+		wantedTasks []TaskSummary
+		wantedErr   string
+	}{
+		"summarizes the tasks with the tag of the main container": {
+			inTasks: []*ecs.Task{
+				{
+					TaskArn:          aws.String("arn:aws:ecs:us-west-2:123456789012:task/my-project-test-Cluster-9F7Y0RLP60R7/4082490ee6c245e09d2145010aa1ba8d"),
+					LastStatus:       aws.String("RUNNING"),
+					HealthStatus:     aws.String("HEALTHY"),
+					AvailabilityZone: aws.String("us-west-2a"),
+					Cpu:              aws.String("256"),
+					Memory:           aws.String("512"),
+					StartedAt:        &startedAt,
+					Containers: []*awsecs.Container{
+						{
+							Name:  aws.String("firelens_log_router"),
+							Image: aws.String("amazon/aws-for-fluent-bit:latest"),
+						},
+						{
+							Name:  aws.String("frontend"),
+							Image: aws.String("123456789012.dkr.ecr.us-west-2.amazonaws.com/my-project/frontend:v1.2.0"),
+						},
+					},
+				},
+				{
+					TaskArn:    aws.String("arn:aws:ecs:us-west-2:123456789012:task/my-project-test-Cluster-9F7Y0RLP60R7/1ad8bb1f2a0b45e1a6a43b1c8dbd2b4c"),
+					LastStatus: aws.String("PROVISIONING"),
+					Containers: []*awsecs.Container{
+						{
+							Name:  aws.String("sidecar"),
+							Image: aws.String("localhost:5000/sidecar"),
+						},
+					},
+				},
+			},
+			wantedTasks: []TaskSummary{
+				{
+					ID:               "4082490ee6c245e09d2145010aa1ba8d",
+					Status:           "RUNNING",
+					Health:           "HEALTHY",
+					AvailabilityZone: "us-west-2a",
+					CPU:              "256",
+					Memory:           "512",
+					ImageTag:         "v1.2.0",
+					StartedAt:        startedAt,
+				},
+				{
+					ID:     "1ad8bb1f2a0b45e1a6a43b1c8dbd2b4c",
+					Status: "PROVISIONING",
+				},
+			},
+		},
+		"errors if a task ARN is invalid": {
+			inTasks: []*ecs.Task{
+				{
+					TaskArn: aws.String("badARN"),
+				},
+			},
+			wantedErr: "get status for task badARN: arn: invalid prefix",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			desc, err := NewServiceTasksDesc("frontend", tc.inTasks)
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedTasks, desc.Tasks)
+		})
+	}
+}
+
+func TestServiceTasksDesc_Sort(t *testing.T) {
+	now := time.Date(2020, 11, 3, 10, 0, 0, 0, time.UTC)
+	tasks := func() []TaskSummary {
+		return []TaskSummary{
+			{ID: "b", Health: "HEALTHY", CPU: "1024", StartedAt: now.Add(-2 * time.Hour)},
+			{ID: "c", Health: "UNHEALTHY", CPU: "256", StartedAt: now.Add(-time.Hour)},
+			{ID: "a", Health: "UNKNOWN", CPU: "512", StartedAt: now.Add(-3 * time.Hour)},
+		}
+	}
+	testCases := map[string]struct {
+		inColumn string
+
+		wantedIDs []string
+		wantedErr string
+	}{
+		"by id": {
+			inColumn:  TaskSortByID,
+			wantedIDs: []string{"a", "b", "c"},
+		},
+		"by health": {
+			inColumn:  TaskSortByHealth,
+			wantedIDs: []string{"b", "c", "a"},
+		},
+		"by cpu as numbers": {
+			inColumn:  TaskSortByCPU,
+			wantedIDs: []string{"c", "a", "b"},
+		},
+		"by uptime from the most recently started": {
+			inColumn:  TaskSortByUptime,
+			wantedIDs: []string{"c", "b", "a"},
+		},
+		"errors on an unknown column": {
+			inColumn:  "name",
+			wantedErr: "cannot sort tasks by name: must be one of id, status, health, az, cpu, memory, image, uptime",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			desc := &ServiceTasksDesc{Tasks: tasks()}
+
+			err := desc.Sort(tc.inColumn)
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			var ids []string
+			for _, task := range desc.Tasks {
+				ids = append(ids, task.ID)
+			}
+			require.Equal(t, tc.wantedIDs, ids)
+		})
+	}
+}
+
+func TestServiceTasksDesc_String(t *testing.T) {
+	startedAt := time.Date(2020, 11, 3, 10, 0, 0, 0, time.UTC)
+	oldTimeSince := timeSince
+	timeSince = func(t time.Time) time.Duration {
+		return startedAt.Add(3*time.Hour + 12*time.Minute).Sub(t)
+	}
+	defer func() {
+		timeSince = oldTimeSince
+	}()
+	desc := &ServiceTasksDesc{
+		Tasks: []TaskSummary{
+			{
+				ID:               "4082490ee6c245e09d2145010aa1ba8d",
+				Status:           "RUNNING",
+				Health:           "HEALTHY",
+				AvailabilityZone: "us-west-2a",
+				CPU:              "256",
+				Memory:           "512",
+				ImageTag:         "v1.2.0",
+				StartedAt:        startedAt,
+			},
+			{
+				ID:     "1ad8bb1f2a0b45e1a6a43b1c8dbd2b4c",
+				Status: "PROVISIONING",
+			},
+		},
+	}
+
+	human := desc.HumanString()
+	json, err := desc.JSONString()
+
+	require.NoError(t, err)
+	require.Equal(t, `ID        Status        Health   AZ          CPU  Memory  Image   Uptime
+4082490e  RUNNING       HEALTHY  us-west-2a  256  512     v1.2.0  3h12m
+1ad8bb1f  PROVISIONING  -        -           -    -       -       -
+`, human)
+	require.Equal(t, `{"tasks":[{"id":"4082490ee6c245e09d2145010aa1ba8d","status":"RUNNING","health":"HEALTHY","availabilityZone":"us-west-2a","cpu":"256","memory":"512","imageTag":"v1.2.0","startedAt":"2020-11-03T10:00:00Z"},{"id":"1ad8bb1f2a0b45e1a6a43b1c8dbd2b4c","status":"PROVISIONING","health":"","availabilityZone":"","cpu":"","memory":"","startedAt":"0001-01-01T00:00:00Z"}]}
+`, json)
+}
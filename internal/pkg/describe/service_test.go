@@ -201,3 +201,221 @@ func TestServiceDescriber_ServiceStackResources(t *testing.T) {
 		})
 	}
 }
+
+func TestServiceDescriber_Outputs(t *testing.T) {
+	const (
+		testApp            = "phonetool"
+		testEnv            = "test"
+		testSvc            = "jobs"
+		testAddonsStackARN = "arn:aws:cloudformation:us-west-2:1111:stack/phonetool-test-jobs-AddonsStack-1/abc"
+	)
+	testSvcStackName := stack.NameForService(testApp, testEnv, testSvc)
+	testCases := map[string]struct {
+		setupMocks func(mocks svcDescriberMocks)
+
+		wantedOutputs map[string]string
+		wantedError   error
+	}{
+		"returns error when fail to describe the service stack": {
+			setupMocks: func(m svcDescriberMocks) {
+				m.mockStackDescriber.EXPECT().Stack(testSvcStackName).Return(nil, errors.New("some error"))
+			},
+
+			wantedError: fmt.Errorf("some error"),
+		},
+		"returns the service stack outputs if there are no addons": {
+			setupMocks: func(m svcDescriberMocks) {
+				gomock.InOrder(
+					m.mockStackDescriber.EXPECT().Stack(testSvcStackName).Return(&cloudformation.Stack{
+						Outputs: []*cloudformation.Output{
+							{
+								OutputKey:   aws.String("DiscoveryServiceARN"),
+								OutputValue: aws.String("arn:aws:servicediscovery:us-west-2:1111:service/srv-1"),
+							},
+						},
+					}, nil),
+					m.mockStackDescriber.EXPECT().StackResources(testSvcStackName).Return([]*cloudformation.StackResource{
+						{
+							LogicalResourceId:  aws.String(serviceLogicalID),
+							PhysicalResourceId: aws.String("arn:aws:ecs:us-west-2:1111:service/phonetool-test-jobs"),
+						},
+					}, nil),
+				)
+			},
+
+			wantedOutputs: map[string]string{
+				"DiscoveryServiceARN": "arn:aws:servicediscovery:us-west-2:1111:service/srv-1",
+			},
+		},
+		"includes the outputs of the addons stack": {
+			setupMocks: func(m svcDescriberMocks) {
+				gomock.InOrder(
+					m.mockStackDescriber.EXPECT().Stack(testSvcStackName).Return(&cloudformation.Stack{}, nil),
+					m.mockStackDescriber.EXPECT().StackResources(testSvcStackName).Return([]*cloudformation.StackResource{
+						{
+							LogicalResourceId:  aws.String(addonsLogicalID),
+							PhysicalResourceId: aws.String(testAddonsStackARN),
+						},
+					}, nil),
+					m.mockStackDescriber.EXPECT().Stack(testAddonsStackARN).Return(&cloudformation.Stack{
+						Outputs: []*cloudformation.Output{
+							{
+								OutputKey:   aws.String("JobsTableName"),
+								OutputValue: aws.String("phonetool-test-jobs"),
+							},
+							{
+								OutputKey:   aws.String("JobsQueueURL"),
+								OutputValue: aws.String("https://sqs.us-west-2.amazonaws.com/1111/jobs"),
+							},
+						},
+					}, nil),
+				)
+			},
+
+			wantedOutputs: map[string]string{
+				"JobsTableName": "phonetool-test-jobs",
+				"JobsQueueURL":  "https://sqs.us-west-2.amazonaws.com/1111/jobs",
+			},
+		},
+		"returns error when fail to describe the addons stack": {
+			setupMocks: func(m svcDescriberMocks) {
+				gomock.InOrder(
+					m.mockStackDescriber.EXPECT().Stack(testSvcStackName).Return(&cloudformation.Stack{}, nil),
+					m.mockStackDescriber.EXPECT().StackResources(testSvcStackName).Return([]*cloudformation.StackResource{
+						{
+							LogicalResourceId:  aws.String(addonsLogicalID),
+							PhysicalResourceId: aws.String(testAddonsStackARN),
+						},
+					}, nil),
+					m.mockStackDescriber.EXPECT().Stack(testAddonsStackARN).Return(nil, errors.New("some error")),
+				)
+			},
+
+			wantedError: fmt.Errorf("some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStackDescriber := mocks.NewMockstackAndResourcesDescriber(ctrl)
+			mocks := svcDescriberMocks{
+				mockStackDescriber: mockStackDescriber,
+			}
+
+			tc.setupMocks(mocks)
+
+			d := &ServiceDescriber{
+				app:            testApp,
+				service:        testSvc,
+				env:            testEnv,
+				stackDescriber: mockStackDescriber,
+			}
+
+			// WHEN
+			actual, err := d.Outputs()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedOutputs, actual)
+			}
+		})
+	}
+}
+
+func TestServiceDescriber_AddonsResources(t *testing.T) {
+	const (
+		testApp            = "phonetool"
+		testEnv            = "test"
+		testSvc            = "jobs"
+		testAddonsStackARN = "arn:aws:cloudformation:us-west-2:1111:stack/phonetool-test-jobs-AddonsStack-1/abc"
+	)
+	testSvcStackName := stack.NameForService(testApp, testEnv, testSvc)
+	testCases := map[string]struct {
+		setupMocks func(mocks svcDescriberMocks)
+
+		wantedResources []*cloudformation.StackResource
+		wantedError     error
+	}{
+		"returns error when fail to list the service stack resources": {
+			setupMocks: func(m svcDescriberMocks) {
+				m.mockStackDescriber.EXPECT().StackResources(testSvcStackName).Return(nil, errors.New("some error"))
+			},
+
+			wantedError: fmt.Errorf("some error"),
+		},
+		"returns nil if there are no addons": {
+			setupMocks: func(m svcDescriberMocks) {
+				m.mockStackDescriber.EXPECT().StackResources(testSvcStackName).Return([]*cloudformation.StackResource{
+					{
+						LogicalResourceId: aws.String(serviceLogicalID),
+					},
+				}, nil)
+			},
+		},
+		"returns the resources of the addons stack": {
+			setupMocks: func(m svcDescriberMocks) {
+				gomock.InOrder(
+					m.mockStackDescriber.EXPECT().StackResources(testSvcStackName).Return([]*cloudformation.StackResource{
+						{
+							LogicalResourceId:  aws.String(addonsLogicalID),
+							PhysicalResourceId: aws.String(testAddonsStackARN),
+						},
+					}, nil),
+					m.mockStackDescriber.EXPECT().StackResources(testAddonsStackARN).Return([]*cloudformation.StackResource{
+						{
+							LogicalResourceId: aws.String("JobsQueue"),
+							ResourceType:      aws.String("AWS::SQS::Queue"),
+						},
+					}, nil),
+				)
+			},
+
+			wantedResources: []*cloudformation.StackResource{
+				{
+					LogicalResourceId: aws.String("JobsQueue"),
+					ResourceType:      aws.String("AWS::SQS::Queue"),
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStackDescriber := mocks.NewMockstackAndResourcesDescriber(ctrl)
+			mocks := svcDescriberMocks{
+				mockStackDescriber: mockStackDescriber,
+			}
+
+			tc.setupMocks(mocks)
+
+			d := &ServiceDescriber{
+				app:            testApp,
+				service:        testSvc,
+				env:            testEnv,
+				stackDescriber: mockStackDescriber,
+			}
+
+			// WHEN
+			actual, err := d.AddonsResources()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedResources, actual)
+			}
+		})
+	}
+}
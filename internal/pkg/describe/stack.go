@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/cache"
 )
 
 type cfnStackDescriber interface {
@@ -19,17 +20,24 @@ type cfnStackDescriber interface {
 // stackDescriber retrieves information of a CloudFormation Stack.
 type stackDescriber struct {
 	stackDescribers cfnStackDescriber
+	cache           *cache.Cache
 }
 
-// newStackDescriber instantiates a new StackDescriber struct.
-func newStackDescriber(s *session.Session) *stackDescriber {
+// newStackDescriber instantiates a new StackDescriber struct that caches the stacks it describes in c if it's not nil.
+func newStackDescriber(s *session.Session, c *cache.Cache) *stackDescriber {
 	return &stackDescriber{
 		stackDescribers: cloudformation.New(s),
+		cache:           c.ForSession(s),
 	}
 }
 
 // Stack returns the CloudFormation stack information.
 func (d *stackDescriber) Stack(stackName string) (*cloudformation.Stack, error) {
+	cacheKey := fmt.Sprintf("cloudformation/stacks/%s", stackName)
+	var stack cloudformation.Stack
+	if d.cache.Get(cacheKey, &stack) {
+		return &stack, nil
+	}
 	out, err := d.stackDescribers.DescribeStacks(&cloudformation.DescribeStacksInput{
 		StackName: aws.String(stackName),
 	})
@@ -39,6 +47,7 @@ func (d *stackDescriber) Stack(stackName string) (*cloudformation.Stack, error)
 	if len(out.Stacks) == 0 {
 		return nil, fmt.Errorf("stack %s not found", stackName)
 	}
+	d.cache.Put(cacheKey, out.Stacks[0])
 	return out.Stacks[0], nil
 }
 
@@ -0,0 +1,199 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/cache"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+const (
+	nestedStackResourceType = "AWS::CloudFormation::Stack"
+
+	consoleURL = "https://console.aws.amazon.com"
+)
+
+// StackResource is a physical resource created by CloudFormation.
+// The logical ID of a resource in a nested stack is prefixed by the logical IDs of its parent stacks, such as "AddonsStack/MyTable".
+type StackResource struct {
+	LogicalID  string `json:"logicalID"`
+	Type       string `json:"type"`
+	PhysicalID string `json:"physicalID"`
+	ConsoleURL string `json:"consoleURL,omitempty"`
+}
+
+// StackResourcesDesc contains the resources of a service or an environment stack.
+type StackResourcesDesc struct {
+	Stack     string           `json:"stack"`
+	Resources []*StackResource `json:"resources"`
+}
+
+// StackResourcesDescriber retrieves the resources of the stack of a service or an environment, and of its nested stacks.
+type StackResourcesDescriber struct {
+	stackName string
+	region    string
+
+	stackDescriber stackAndResourcesDescriber
+}
+
+// NewStackResourcesConfig contains fields that initiates StackResourcesDescriber struct.
+type NewStackResourcesConfig struct {
+	App         string
+	Env         string
+	Svc         string // Optional. The resources of the environment are described if empty.
+	ConfigStore ConfigStoreSvc
+	Cache       *cache.Cache // Optional. Caches the described stacks if set.
+}
+
+// NewStackResourcesDescriber instantiates a new StackResourcesDescriber struct.
+func NewStackResourcesDescriber(opt NewStackResourcesConfig) (*StackResourcesDescriber, error) {
+	env, err := opt.ConfigStore.GetEnvironment(opt.App, opt.Env)
+	if err != nil {
+		return nil, fmt.Errorf("get environment %s: %w", opt.Env, err)
+	}
+	sess, err := sessions.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
+	if err != nil {
+		return nil, err
+	}
+	stackName := stack.NameForEnv(opt.App, opt.Env)
+	if opt.Svc != "" {
+		stackName = stack.NameForService(opt.App, opt.Env, opt.Svc)
+	}
+	return &StackResourcesDescriber{
+		stackName:      stackName,
+		region:         env.Region,
+		stackDescriber: newStackDescriber(sess, opt.Cache),
+	}, nil
+}
+
+// Describe returns the resources of the stack, followed by the resources of each of its nested stacks.
+func (d *StackResourcesDescriber) Describe() (*StackResourcesDesc, error) {
+	resources, err := d.resources(d.stackName, "")
+	if err != nil {
+		return nil, err
+	}
+	return &StackResourcesDesc{
+		Stack:     d.stackName,
+		Resources: resources,
+	}, nil
+}
+
+func (d *StackResourcesDescriber) resources(stackName, logicalIDPrefix string) ([]*StackResource, error) {
+	stackResources, err := d.stackDescriber.StackResources(stackName)
+	if err != nil {
+		return nil, err
+	}
+	var resources []*StackResource
+	for _, sr := range stackResources {
+		resource := &StackResource{
+			LogicalID:  logicalIDPrefix + aws.StringValue(sr.LogicalResourceId),
+			Type:       aws.StringValue(sr.ResourceType),
+			PhysicalID: aws.StringValue(sr.PhysicalResourceId),
+		}
+		resource.ConsoleURL = resourceConsoleURL(d.region, resource.Type, resource.PhysicalID)
+		resources = append(resources, resource)
+		// The physical ID of a nested stack is its ARN, which CloudFormation accepts as a stack name.
+		if resource.Type == nestedStackResourceType && resource.PhysicalID != "" {
+			nested, err := d.resources(resource.PhysicalID, resource.LogicalID+"/")
+			if err != nil {
+				return nil, err
+			}
+			resources = append(resources, nested...)
+		}
+	}
+	return resources, nil
+}
+
+// resourceConsoleURL returns the link to the resource in the AWS console, or an empty string if the type of the
+// resource isn't supported.
+func resourceConsoleURL(region, resourceType, physicalID string) string {
+	if physicalID == "" {
+		return ""
+	}
+	switch resourceType {
+	case nestedStackResourceType:
+		return fmt.Sprintf("%s/cloudformation/home?region=%s#/stacks/stackinfo?stackId=%s", consoleURL, region, url.QueryEscape(physicalID))
+	case "AWS::ECS::Cluster":
+		return fmt.Sprintf("%s/ecs/home?region=%s#/clusters/%s", consoleURL, region, physicalID)
+	case "AWS::ECS::Service":
+		// The physical ID of a service is its ARN, such as "arn:aws:ecs:us-west-2:123456789012:service/cluster/svc".
+		parsed, err := arn.Parse(physicalID)
+		if err != nil {
+			return ""
+		}
+		parts := strings.Split(parsed.Resource, "/")
+		if len(parts) != 3 {
+			return ""
+		}
+		return fmt.Sprintf("%s/ecs/home?region=%s#/clusters/%s/services/%s/details", consoleURL, region, parts[1], parts[2])
+	case "AWS::ECS::TaskDefinition":
+		// The physical ID of a task definition is its ARN, such as "arn:aws:ecs:us-west-2:123456789012:task-definition/family:1".
+		parsed, err := arn.Parse(physicalID)
+		if err != nil {
+			return ""
+		}
+		family := strings.Replace(strings.TrimPrefix(parsed.Resource, "task-definition/"), ":", "/", 1)
+		return fmt.Sprintf("%s/ecs/home?region=%s#/taskDefinitions/%s", consoleURL, region, family)
+	case "AWS::Logs::LogGroup":
+		// The CloudWatch console expects the log group name to be escaped twice, with "$" in place of "%".
+		escaped := strings.ReplaceAll(url.QueryEscape(url.QueryEscape(physicalID)), "%", "$")
+		return fmt.Sprintf("%s/cloudwatch/home?region=%s#logsV2:log-groups/log-group/%s", consoleURL, region, escaped)
+	case "AWS::IAM::Role":
+		return fmt.Sprintf("%s/iam/home#/roles/%s", consoleURL, physicalID)
+	case "AWS::EC2::VPC":
+		return fmt.Sprintf("%s/vpc/home?region=%s#VpcDetails:VpcId=%s", consoleURL, region, physicalID)
+	case "AWS::EC2::Subnet":
+		return fmt.Sprintf("%s/vpc/home?region=%s#SubnetDetails:subnetId=%s", consoleURL, region, physicalID)
+	case "AWS::EC2::SecurityGroup":
+		return fmt.Sprintf("%s/ec2/v2/home?region=%s#SecurityGroup:groupId=%s", consoleURL, region, physicalID)
+	case "AWS::ElasticLoadBalancingV2::LoadBalancer":
+		return fmt.Sprintf("%s/ec2/v2/home?region=%s#LoadBalancer:loadBalancerArn=%s", consoleURL, region, physicalID)
+	case "AWS::ElasticLoadBalancingV2::TargetGroup":
+		return fmt.Sprintf("%s/ec2/v2/home?region=%s#TargetGroup:targetGroupArn=%s", consoleURL, region, physicalID)
+	case "AWS::DynamoDB::Table":
+		return fmt.Sprintf("%s/dynamodb/home?region=%s#tables:selected=%s", consoleURL, region, physicalID)
+	case "AWS::S3::Bucket":
+		return fmt.Sprintf("https://s3.console.aws.amazon.com/s3/buckets/%s?region=%s", physicalID, region)
+	default:
+		return ""
+	}
+}
+
+// JSONString returns the stringified StackResourcesDesc struct with json format.
+func (s *StackResourcesDesc) JSONString() (string, error) {
+	b, err := json.Marshal(s)
+	if err != nil {
+		return "", fmt.Errorf("marshal stack resources: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the stringified StackResourcesDesc struct with human readable format.
+func (s *StackResourcesDesc) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprintf(writer, color.Bold.Sprintf("Resources of stack %s\n\n", s.Stack))
+	writer.Flush()
+	fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\n", "Logical ID", "Type", "Physical ID", "Console")
+	for _, resource := range s.Resources {
+		link := resource.ConsoleURL
+		if link == "" {
+			link = "-"
+		}
+		fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\n", resource.LogicalID, resource.Type, resource.PhysicalID, link)
+	}
+	writer.Flush()
+	return b.String()
+}
@@ -0,0 +1,222 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStackResourcesDescriber_Describe(t *testing.T) {
+	const (
+		mockStack       = "phonetool-test-api"
+		mockAddonsStack = "arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-test-api-AddonsStack/1234"
+	)
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.MockstackAndResourcesDescriber)
+
+		wantedResources []*StackResource
+		wantedError     error
+	}{
+		"returns error if fail to describe the resources of the stack": {
+			setupMocks: func(m *mocks.MockstackAndResourcesDescriber) {
+				m.EXPECT().StackResources(mockStack).Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("some error"),
+		},
+		"returns error if fail to describe the resources of a nested stack": {
+			setupMocks: func(m *mocks.MockstackAndResourcesDescriber) {
+				gomock.InOrder(
+					m.EXPECT().StackResources(mockStack).Return([]*cloudformation.StackResource{
+						{
+							LogicalResourceId:  aws.String("AddonsStack"),
+							ResourceType:       aws.String("AWS::CloudFormation::Stack"),
+							PhysicalResourceId: aws.String(mockAddonsStack),
+						},
+					}, nil),
+					m.EXPECT().StackResources(mockAddonsStack).Return(nil, errors.New("some error")),
+				)
+			},
+
+			wantedError: errors.New("some error"),
+		},
+		"returns the resources of the stack and its nested stacks": {
+			setupMocks: func(m *mocks.MockstackAndResourcesDescriber) {
+				gomock.InOrder(
+					m.EXPECT().StackResources(mockStack).Return([]*cloudformation.StackResource{
+						{
+							LogicalResourceId:  aws.String("AddonsStack"),
+							ResourceType:       aws.String("AWS::CloudFormation::Stack"),
+							PhysicalResourceId: aws.String(mockAddonsStack),
+						},
+						{
+							LogicalResourceId:  aws.String("LogGroup"),
+							ResourceType:       aws.String("AWS::Logs::LogGroup"),
+							PhysicalResourceId: aws.String("/copilot/phonetool-test-api"),
+						},
+					}, nil),
+					m.EXPECT().StackResources(mockAddonsStack).Return([]*cloudformation.StackResource{
+						{
+							LogicalResourceId:  aws.String("MyTable"),
+							ResourceType:       aws.String("AWS::DynamoDB::Table"),
+							PhysicalResourceId: aws.String("phonetool-test-api-MyTable"),
+						},
+						{
+							LogicalResourceId:  aws.String("MyTableAccessPolicy"),
+							ResourceType:       aws.String("AWS::IAM::ManagedPolicy"),
+							PhysicalResourceId: aws.String("arn:aws:iam::123456789012:policy/MyTableAccessPolicy"),
+						},
+					}, nil),
+				)
+			},
+
+			wantedResources: []*StackResource{
+				{
+					LogicalID:  "AddonsStack",
+					Type:       "AWS::CloudFormation::Stack",
+					PhysicalID: mockAddonsStack,
+					ConsoleURL: "https://console.aws.amazon.com/cloudformation/home?region=us-west-2#/stacks/stackinfo?stackId=arn%3Aaws%3Acloudformation%3Aus-west-2%3A123456789012%3Astack%2Fphonetool-test-api-AddonsStack%2F1234",
+				},
+				{
+					LogicalID:  "AddonsStack/MyTable",
+					Type:       "AWS::DynamoDB::Table",
+					PhysicalID: "phonetool-test-api-MyTable",
+					ConsoleURL: "https://console.aws.amazon.com/dynamodb/home?region=us-west-2#tables:selected=phonetool-test-api-MyTable",
+				},
+				{
+					LogicalID:  "AddonsStack/MyTableAccessPolicy",
+					Type:       "AWS::IAM::ManagedPolicy",
+					PhysicalID: "arn:aws:iam::123456789012:policy/MyTableAccessPolicy",
+				},
+				{
+					LogicalID:  "LogGroup",
+					Type:       "AWS::Logs::LogGroup",
+					PhysicalID: "/copilot/phonetool-test-api",
+					ConsoleURL: "https://console.aws.amazon.com/cloudwatch/home?region=us-west-2#logsV2:log-groups/log-group/$252Fcopilot$252Fphonetool-test-api",
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStackDescriber := mocks.NewMockstackAndResourcesDescriber(ctrl)
+			tc.setupMocks(mockStackDescriber)
+
+			d := &StackResourcesDescriber{
+				stackName:      mockStack,
+				region:         "us-west-2",
+				stackDescriber: mockStackDescriber,
+			}
+
+			// WHEN
+			desc, err := d.Describe()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, mockStack, desc.Stack)
+			require.Equal(t, tc.wantedResources, desc.Resources)
+		})
+	}
+}
+
+func TestResourceConsoleURL(t *testing.T) {
+	testCases := map[string]struct {
+		inType       string
+		inPhysicalID string
+
+		wantedURL string
+	}{
+		"ecs service": {
+			inType:       "AWS::ECS::Service",
+			inPhysicalID: "arn:aws:ecs:us-west-2:123456789012:service/phonetool-test-Cluster/phonetool-test-api-Service",
+
+			wantedURL: "https://console.aws.amazon.com/ecs/home?region=us-west-2#/clusters/phonetool-test-Cluster/services/phonetool-test-api-Service/details",
+		},
+		"ecs service with the old arn format": {
+			inType:       "AWS::ECS::Service",
+			inPhysicalID: "arn:aws:ecs:us-west-2:123456789012:service/phonetool-test-api-Service",
+		},
+		"task definition": {
+			inType:       "AWS::ECS::TaskDefinition",
+			inPhysicalID: "arn:aws:ecs:us-west-2:123456789012:task-definition/phonetool-test-api:3",
+
+			wantedURL: "https://console.aws.amazon.com/ecs/home?region=us-west-2#/taskDefinitions/phonetool-test-api/3",
+		},
+		"iam role": {
+			inType:       "AWS::IAM::Role",
+			inPhysicalID: "phonetool-test-api-TaskRole",
+
+			wantedURL: "https://console.aws.amazon.com/iam/home#/roles/phonetool-test-api-TaskRole",
+		},
+		"s3 bucket": {
+			inType:       "AWS::S3::Bucket",
+			inPhysicalID: "my-bucket",
+
+			wantedURL: "https://s3.console.aws.amazon.com/s3/buckets/my-bucket?region=us-west-2",
+		},
+		"unsupported type": {
+			inType:       "AWS::ServiceDiscovery::Service",
+			inPhysicalID: "srv-1234",
+		},
+		"resource without physical id": {
+			inType: "AWS::IAM::Role",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wantedURL, resourceConsoleURL("us-west-2", tc.inType, tc.inPhysicalID))
+		})
+	}
+}
+
+func TestStackResourcesDesc_String(t *testing.T) {
+	// GIVEN
+	desc := &StackResourcesDesc{
+		Stack: "phonetool-test-api",
+		Resources: []*StackResource{
+			{
+				LogicalID:  "TaskRole",
+				Type:       "AWS::IAM::Role",
+				PhysicalID: "api-TaskRole",
+				ConsoleURL: "https://console.aws.amazon.com/iam/home#/roles/api-TaskRole",
+			},
+			{
+				LogicalID:  "DiscoveryService",
+				Type:       "AWS::ServiceDiscovery::Service",
+				PhysicalID: "srv-1234",
+			},
+		},
+	}
+
+	// WHEN
+	human := desc.HumanString()
+	json, err := desc.JSONString()
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, `Resources of stack phonetool-test-api
+
+  Logical ID        Type                            Physical ID         Console
+  TaskRole          AWS::IAM::Role                  api-TaskRole        https://console.aws.amazon.com/iam/home#/roles/api-TaskRole
+  DiscoveryService  AWS::ServiceDiscovery::Service  srv-1234            -
+`, human)
+	require.Equal(t, `{"stack":"phonetool-test-api","resources":[{"logicalID":"TaskRole","type":"AWS::IAM::Role","physicalID":"api-TaskRole","consoleURL":"https://console.aws.amazon.com/iam/home#/roles/api-TaskRole"},{"logicalID":"DiscoveryService","type":"AWS::ServiceDiscovery::Service","physicalID":"srv-1234"}]}`+"\n", json)
+}
@@ -6,10 +6,14 @@ package describe
 import (
 	"errors"
 	"fmt"
+	"io/ioutil"
+	"os"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/cache"
 	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -101,6 +105,37 @@ func TestStackDescriber_Stack(t *testing.T) {
 	}
 }
 
+func TestStackDescriber_Stack_cache(t *testing.T) {
+	const mockStackName = "phonetool-test-jobs"
+	dir, err := ioutil.TempDir("", "describe")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockStackDescriber := mocks.NewMockcfnStackDescriber(ctrl)
+	mockStackDescriber.EXPECT().DescribeStacks(&cloudformation.DescribeStacksInput{
+		StackName: aws.String(mockStackName),
+	}).Return(&cloudformation.DescribeStacksOutput{
+		Stacks: []*cloudformation.Stack{
+			{
+				StackName: aws.String(mockStackName),
+			},
+		},
+	}, nil).Times(1)
+	d := &stackDescriber{
+		stackDescribers: mockStackDescriber,
+		cache:           cache.New(dir, time.Minute),
+	}
+
+	for i := 0; i < 2; i++ {
+		actual, err := d.Stack(mockStackName)
+		require.NoError(t, err)
+		require.Equal(t, &cloudformation.Stack{
+			StackName: aws.String(mockStackName),
+		}, actual)
+	}
+}
+
 func TestStackDescriber_StackResources(t *testing.T) {
 	const mockStackName = "phonetool-test-jobs"
 	mockErr := errors.New("some error")
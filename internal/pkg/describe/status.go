@@ -7,31 +7,76 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
 	"text/tabwriter"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/applicationautoscaling"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
 	rg "github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/probe"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 )
 
 const (
 	ecsServiceResourceType = "ecs:service"
+
+	// Alarms created by the addons of a service are prefixed by the name of the service stack.
+	fmtAlarmNamePrefix = "%s-%s-%s-"
+
+	ecsClusterNameDimension = "ClusterName"
+	ecsServiceNameDimension = "ServiceName"
+
+	ecsServiceActiveStatus = "ACTIVE"
+	taskStatusRunning      = "RUNNING"
+	capacityProviderSpot   = "FARGATE_SPOT"
+	alarmStateAlarm        = "ALARM"
+
+	// A deployment that hasn't replaced the previous ones after this long is considered stuck.
+	stuckDeploymentTimeout = 30 * time.Minute
+
+	// Container Insights writes the performance of the containers of a cluster to this log group once a minute.
+	fmtContainerInsightsLogGroup = "/aws/ecs/containerinsights/%s/performance"
+	fmtContainerStatsQuery       = `filter Type = "Container" and TaskId in [%s]
+| stats avg(CpuUtilized) as cpu, avg(MemoryUtilized) as memory by TaskId, ContainerName`
+	containerStatsPeriod = 5 * time.Minute
 )
 
 type alarmStatusGetter interface {
 	GetAlarmsWithTags(tags map[string]string) ([]cloudwatch.AlarmStatus, error)
+	GetAlarmsWithNames(names []string) ([]cloudwatch.AlarmStatus, error)
+	GetAlarmsWithNamePrefix(prefix string) ([]cloudwatch.AlarmStatus, error)
+	GetAlarmsWithDimensions(dimensions map[string]string) ([]cloudwatch.AlarmStatus, error)
+	AlarmHistory(alarmName string, startDate time.Time) ([]cloudwatch.AlarmHistoryItem, error)
 }
 
 type resourcesGetter interface {
 	GetResourcesByTags(resourceType string, tags map[string]string) ([]*rg.Resource, error)
 }
 
+type containerInsightsQuerier interface {
+	LogGroupExists(logGroupName string) (bool, error)
+	Query(logGroupName, query string, startTime, endTime int64) (*cloudwatchlogs.QueryResults, error)
+}
+
 type ecsServiceGetter interface {
 	ServiceTasks(clusterName, serviceName string) ([]*ecs.Task, error)
+	StoppedServiceTasks(clusterName, serviceName string) ([]*ecs.Task, error)
 	Service(clusterName, serviceName string) (*ecs.Service, error)
+	ServiceEvents(clusterName, serviceName string, since time.Time) ([]ecs.ServiceEvent, error)
+}
+
+type autoscalingGetter interface {
+	ECSServiceScaling(clusterName, serviceName string) (*applicationautoscaling.ServiceScaling, error)
 }
 
 // ServiceStatus retrieves status of a service.
@@ -39,10 +84,14 @@ type ServiceStatus struct {
 	AppName string
 	EnvName string
 	SvcName string
+	// AlarmNames are the names of the alarms associated with the service in its manifest.
+	AlarmNames []string
 
-	EcsSvc ecsServiceGetter
-	CwSvc  alarmStatusGetter
-	rgSvc  resourcesGetter
+	EcsSvc      ecsServiceGetter
+	CwSvc       alarmStatusGetter
+	InsightsSvc containerInsightsQuerier
+	ScalingSvc  autoscalingGetter
+	rgSvc       resourcesGetter
 }
 
 // ServiceStatusDesc contains the status for a service.
@@ -50,6 +99,12 @@ type ServiceStatusDesc struct {
 	Service ecs.ServiceStatus        `json:",flow"`
 	Tasks   []ecs.TaskStatus         `json:"tasks"`
 	Alarms  []cloudwatch.AlarmStatus `json:"alarms"`
+	Probes  []probe.Result           `json:"probes,omitempty"`
+	Targets []*elbv2.TargetHealth    `json:"targets,omitempty"` // Health of the tasks registered with the load balancer.
+
+	AlarmHistory []cloudwatch.AlarmHistoryItem          `json:"alarmHistory,omitempty"`
+	StoppedTasks []ecs.TaskStatus                       `json:"stoppedTasks,omitempty"`
+	Autoscaling  *applicationautoscaling.ServiceScaling `json:"autoscaling,omitempty"`
 }
 
 // NewServiceStatusConfig contains fields that initiates ServiceStatus struct.
@@ -57,6 +112,7 @@ type NewServiceStatusConfig struct {
 	App         string
 	Env         string
 	Svc         string
+	AlarmNames  []string
 	ConfigStore ConfigStoreSvc
 }
 
@@ -71,16 +127,20 @@ func NewServiceStatus(opt *NewServiceStatusConfig) (*ServiceStatus, error) {
 		return nil, fmt.Errorf("session for role %s and region %s: %w", env.ManagerRoleARN, env.Region, err)
 	}
 	return &ServiceStatus{
-		AppName: opt.App,
-		EnvName: opt.Env,
-		SvcName: opt.Svc,
-		rgSvc:   rg.New(sess),
-		CwSvc:   cloudwatch.New(sess),
-		EcsSvc:  ecs.New(sess),
+		AppName:     opt.App,
+		EnvName:     opt.Env,
+		SvcName:     opt.Svc,
+		AlarmNames:  opt.AlarmNames,
+		rgSvc:       rg.New(sess),
+		CwSvc:       cloudwatch.New(sess),
+		InsightsSvc: cloudwatchlogs.New(sess),
+		ScalingSvc:  applicationautoscaling.New(sess),
+		EcsSvc:      ecs.New(sess),
 	}, nil
 }
 
-func (s *ServiceStatus) getServiceArn() (*ecs.ServiceArn, error) {
+// GetServiceArn returns the ARN of the ECS service of the service.
+func (s *ServiceStatus) GetServiceArn() (*ecs.ServiceArn, error) {
 	svcResources, err := s.rgSvc.GetResourcesByTags(ecsServiceResourceType, map[string]string{
 		deploy.AppTagKey:     s.AppName,
 		deploy.EnvTagKey:     s.EnvName,
@@ -96,49 +156,303 @@ func (s *ServiceStatus) getServiceArn() (*ecs.ServiceArn, error) {
 	return &serviceArn, nil
 }
 
-// Describe returns status of a service.
-func (s *ServiceStatus) Describe() (*ServiceStatusDesc, error) {
-	serviceArn, err := s.getServiceArn()
+// clusterAndServiceName returns the name of the ECS cluster and of the ECS service of the service.
+func (s *ServiceStatus) clusterAndServiceName() (string, string, error) {
+	serviceArn, err := s.GetServiceArn()
 	if err != nil {
-		return nil, fmt.Errorf("get service ARN: %w", err)
+		return "", "", fmt.Errorf("get service ARN: %w", err)
 	}
 	clusterName, err := serviceArn.ClusterName()
 	if err != nil {
-		return nil, fmt.Errorf("get cluster name: %w", err)
+		return "", "", fmt.Errorf("get cluster name: %w", err)
 	}
 	serviceName, err := serviceArn.ServiceName()
 	if err != nil {
-		return nil, fmt.Errorf("get service name: %w", err)
+		return "", "", fmt.Errorf("get service name: %w", err)
 	}
-	service, err := s.EcsSvc.Service(clusterName, serviceName)
+	return clusterName, serviceName, nil
+}
+
+// Describe returns status of a service.
+func (s *ServiceStatus) Describe() (*ServiceStatusDesc, error) {
+	clusterName, serviceName, err := s.clusterAndServiceName()
 	if err != nil {
-		return nil, fmt.Errorf("get service %s: %w", serviceName, err)
+		return nil, err
 	}
-	tasks, err := s.EcsSvc.ServiceTasks(clusterName, serviceName)
+
+	// The service, its tasks and its alarms are independent so we fetch them concurrently.
+	var (
+		wg                              sync.WaitGroup
+		service                         *ecs.Service
+		tasks                           []*ecs.Task
+		alarms                          []cloudwatch.AlarmStatus
+		serviceErr, tasksErr, alarmsErr error
+	)
+	wg.Add(3)
+	go func() {
+		defer wg.Done()
+		service, serviceErr = s.EcsSvc.Service(clusterName, serviceName)
+	}()
+	go func() {
+		defer wg.Done()
+		tasks, tasksErr = s.EcsSvc.ServiceTasks(clusterName, serviceName)
+	}()
+	go func() {
+		defer wg.Done()
+		alarms, alarmsErr = s.alarms(clusterName, serviceName)
+	}()
+	wg.Wait()
+	if serviceErr != nil {
+		return nil, fmt.Errorf("get service %s: %w", serviceName, serviceErr)
+	}
+	if tasksErr != nil {
+		return nil, fmt.Errorf("get tasks for service %s: %w", serviceName, tasksErr)
+	}
+	if alarmsErr != nil {
+		return nil, fmt.Errorf("get CloudWatch alarms: %w", alarmsErr)
+	}
+	taskStatus, err := taskStatuses(tasks)
 	if err != nil {
-		return nil, fmt.Errorf("get tasks for service %s: %w", serviceName, err)
+		return nil, err
+	}
+	if err := s.addContainerStats(clusterName, taskStatus); err != nil {
+		return nil, err
+	}
+	return &ServiceStatusDesc{
+		Service: service.ServiceStatus(),
+		Tasks:   taskStatus,
+		Alarms:  alarms,
+	}, nil
+}
+
+// StoppedTasks returns the tasks of the service that stopped within the last hour, most recently stopped first.
+func (s *ServiceStatus) StoppedTasks() ([]*ecs.Task, error) {
+	clusterName, serviceName, err := s.clusterAndServiceName()
+	if err != nil {
+		return nil, err
+	}
+	tasks, err := s.EcsSvc.StoppedServiceTasks(clusterName, serviceName)
+	if err != nil {
+		return nil, fmt.Errorf("get stopped tasks for service %s: %w", serviceName, err)
+	}
+	return tasks, nil
+}
+
+// ServiceEvents returns the events of the ECS service of the service that happened after since, oldest first.
+func (s *ServiceStatus) ServiceEvents(since time.Time) ([]ecs.ServiceEvent, error) {
+	clusterName, serviceName, err := s.clusterAndServiceName()
+	if err != nil {
+		return nil, err
 	}
-	var taskStatus []ecs.TaskStatus
+	events, err := s.EcsSvc.ServiceEvents(clusterName, serviceName, since)
+	if err != nil {
+		return nil, fmt.Errorf("get events for service %s: %w", serviceName, err)
+	}
+	return events, nil
+}
+
+// Autoscaling returns the bounds of the desired count of the service and its latest scaling activity.
+// It returns nil if the service isn't autoscaled.
+func (s *ServiceStatus) Autoscaling() (*applicationautoscaling.ServiceScaling, error) {
+	clusterName, serviceName, err := s.clusterAndServiceName()
+	if err != nil {
+		return nil, err
+	}
+	scaling, err := s.ScalingSvc.ECSServiceScaling(clusterName, serviceName)
+	if err != nil {
+		return nil, fmt.Errorf("get autoscaling of service %s: %w", serviceName, err)
+	}
+	return scaling, nil
+}
+
+// StoppedTaskStatuses returns the status of the tasks of the service that stopped within the last hour,
+// including why they stopped and the exit codes of their containers, most recently stopped first.
+func (s *ServiceStatus) StoppedTaskStatuses() ([]ecs.TaskStatus, error) {
+	tasks, err := s.StoppedTasks()
+	if err != nil {
+		return nil, err
+	}
+	return taskStatuses(tasks)
+}
+
+func taskStatuses(tasks []*ecs.Task) ([]ecs.TaskStatus, error) {
+	var statuses []ecs.TaskStatus
 	for _, task := range tasks {
 		status, err := task.TaskStatus()
 		if err != nil {
-			return nil, fmt.Errorf("get status for task %s: %w", *task.TaskArn, err)
+			return nil, fmt.Errorf("get status for task %s: %w", aws.StringValue(task.TaskArn), err)
 		}
-		taskStatus = append(taskStatus, *status)
+		statuses = append(statuses, *status)
+	}
+	return statuses, nil
+}
+
+// addContainerStats sets the average CPU and memory used by the containers of the tasks over the last minutes,
+// from the performance logs of Container Insights. The stats are left unset if Container Insights isn't enabled on the cluster.
+func (s *ServiceStatus) addContainerStats(clusterName string, tasks []ecs.TaskStatus) error {
+	if len(tasks) == 0 {
+		return nil
+	}
+	logGroupName := fmt.Sprintf(fmtContainerInsightsLogGroup, clusterName)
+	enabled, err := s.InsightsSvc.LogGroupExists(logGroupName)
+	if err != nil {
+		return fmt.Errorf("check if Container Insights is enabled on cluster %s: %w", clusterName, err)
 	}
-	alarms, err := s.CwSvc.GetAlarmsWithTags(map[string]string{
+	if !enabled {
+		return nil
+	}
+	var ids []string
+	for _, task := range tasks {
+		ids = append(ids, strconv.Quote(task.ID))
+	}
+	now := time.Now()
+	results, err := s.InsightsSvc.Query(logGroupName, fmt.Sprintf(fmtContainerStatsQuery, strings.Join(ids, ", ")),
+		now.Add(-containerStatsPeriod).UnixNano()/int64(time.Millisecond), now.UnixNano()/int64(time.Millisecond))
+	if err != nil {
+		return fmt.Errorf("get container stats from Container Insights: %w", err)
+	}
+	for _, row := range results.Rows {
+		for i := range tasks {
+			if tasks[i].ID != row["TaskId"] {
+				continue
+			}
+			for j := range tasks[i].Containers {
+				container := &tasks[i].Containers[j]
+				if container.Name != row["ContainerName"] {
+					continue
+				}
+				container.CPUUtilized = parseStat(row["cpu"])
+				container.MemoryUtilized = parseStat(row["memory"])
+			}
+		}
+	}
+	return nil
+}
+
+// RunningTasks returns the tasks of the service that are running.
+func (s *ServiceStatus) RunningTasks() ([]*ecs.Task, error) {
+	clusterName, serviceName, err := s.clusterAndServiceName()
+	if err != nil {
+		return nil, err
+	}
+	tasks, err := s.EcsSvc.ServiceTasks(clusterName, serviceName)
+	if err != nil {
+		return nil, fmt.Errorf("get running tasks for service %s: %w", serviceName, err)
+	}
+	return tasks, nil
+}
+
+// alarms returns the CloudWatch alarms of the service.
+// Besides the alarms tagged with the service, the alarms created without the tags, such as by addons or manually,
+// are found by their name prefix, the dimensions of their metric, or their name in the manifest.
+func (s *ServiceStatus) alarms(clusterName, serviceName string) ([]cloudwatch.AlarmStatus, error) {
+	tagged, err := s.CwSvc.GetAlarmsWithTags(map[string]string{
 		deploy.AppTagKey:     s.AppName,
 		deploy.EnvTagKey:     s.EnvName,
 		deploy.ServiceTagKey: s.SvcName,
 	})
 	if err != nil {
-		return nil, fmt.Errorf("get CloudWatch alarms: %w", err)
+		return nil, err
 	}
-	return &ServiceStatusDesc{
-		Service: service.ServiceStatus(),
-		Tasks:   taskStatus,
-		Alarms:  alarms,
-	}, nil
+	prefixed, err := s.CwSvc.GetAlarmsWithNamePrefix(fmt.Sprintf(fmtAlarmNamePrefix, s.AppName, s.EnvName, s.SvcName))
+	if err != nil {
+		return nil, err
+	}
+	onServiceMetrics, err := s.CwSvc.GetAlarmsWithDimensions(map[string]string{
+		ecsClusterNameDimension: clusterName,
+		ecsServiceNameDimension: serviceName,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var named []cloudwatch.AlarmStatus
+	if len(s.AlarmNames) != 0 {
+		named, err = s.CwSvc.GetAlarmsWithNames(s.AlarmNames)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var alarms []cloudwatch.AlarmStatus
+	seen := make(map[string]bool)
+	for _, found := range [][]cloudwatch.AlarmStatus{tagged, prefixed, onServiceMetrics, named} {
+		for _, alarm := range found {
+			if seen[alarm.Arn] {
+				continue
+			}
+			seen[alarm.Arn] = true
+			alarms = append(alarms, alarm)
+		}
+	}
+	return alarms, nil
+}
+
+// AlarmHistory returns the state transitions of the alarms since startDate.
+// The transitions are grouped by alarm, from the most recent one.
+func (s *ServiceStatus) AlarmHistory(alarms []cloudwatch.AlarmStatus, startDate time.Time) ([]cloudwatch.AlarmHistoryItem, error) {
+	var history []cloudwatch.AlarmHistoryItem
+	for _, alarm := range alarms {
+		items, err := s.CwSvc.AlarmHistory(alarm.Name, startDate)
+		if err != nil {
+			return nil, fmt.Errorf("get history of CloudWatch alarm %s: %w", alarm.Name, err)
+		}
+		history = append(history, items...)
+	}
+	return history, nil
+}
+
+// Issues returns the reasons why the service is unhealthy, or nil if it is healthy.
+// A service is healthy if it is in steady state, none of its alarms are in ALARM, and its latest deployment
+// isn't stuck.
+func (s *ServiceStatusDesc) Issues(now time.Time) []string {
+	var issues []string
+	if s.Service.Status != ecsServiceActiveStatus {
+		issues = append(issues, fmt.Sprintf("service is %s", s.Service.Status))
+	}
+	if s.Service.RunningCount != s.Service.DesiredCount {
+		issues = append(issues, fmt.Sprintf("%d/%d running tasks", s.Service.RunningCount, s.Service.DesiredCount))
+	}
+	if inProgress := now.Sub(s.Service.LastDeploymentAt); s.Service.ActiveDeployments > 0 && inProgress > stuckDeploymentTimeout {
+		issues = append(issues, fmt.Sprintf("deployment in progress for %s", inProgress.Truncate(time.Minute)))
+	}
+	for _, alarm := range s.Alarms {
+		if alarm.Status == alarmStateAlarm {
+			issues = append(issues, fmt.Sprintf("alarm %s is in %s", alarm.Name, alarmStateAlarm))
+		}
+	}
+	return issues
+}
+
+// PackedAZ returns the availability zone that all the running tasks of the service are placed in,
+// or an empty string if the service runs less than two tasks or they are spread across availability zones.
+func (s *ServiceStatusDesc) PackedAZ() string {
+	var zone string
+	var running int
+	for _, task := range s.Tasks {
+		if task.LastStatus != taskStatusRunning || task.AvailabilityZone == "" {
+			continue
+		}
+		if zone != "" && task.AvailabilityZone != zone {
+			return ""
+		}
+		zone = task.AvailabilityZone
+		running++
+	}
+	if running < 2 {
+		return ""
+	}
+	return zone
+}
+
+// runningSpotTasks returns the number of running tasks of the service that are placed on Fargate Spot.
+func (s *ServiceStatusDesc) runningSpotTasks() int {
+	var spot int
+	for _, task := range s.Tasks {
+		if task.LastStatus == taskStatusRunning && task.CapacityProvider == capacityProviderSpot {
+			spot++
+		}
+	}
+	return spot
 }
 
 // JSONString returns the stringified ServiceStatusDesc struct with json format.
@@ -158,6 +472,17 @@ func (s *ServiceStatusDesc) HumanString() string {
 	writer.Flush()
 	fmt.Fprintf(writer, "  %s %v / %v running tasks (%v pending)\n", statusColor(s.Service.Status),
 		s.Service.RunningCount, s.Service.DesiredCount, s.Service.DesiredCount-s.Service.RunningCount)
+	if spot := s.runningSpotTasks(); spot > 0 {
+		fmt.Fprintf(writer, "  %d running on Fargate Spot\n", spot)
+	}
+	if s.Autoscaling != nil {
+		fmt.Fprintf(writer, color.Bold.Sprint("\nAutoscaling\n\n"))
+		writer.Flush()
+		fmt.Fprintf(writer, "  %s\t%d (min %d, max %d)\n", "Desired Count", s.Service.DesiredCount, s.Autoscaling.MinCapacity, s.Autoscaling.MaxCapacity)
+		if activity := s.Autoscaling.LastActivity; activity != nil {
+			fmt.Fprintf(writer, "  %s\t%s\t%s\n", "Last Activity", humanizeTime(activity.StartTime), activity.Description)
+		}
+	}
 	fmt.Fprintf(writer, color.Bold.Sprint("\nLast Deployment\n\n"))
 	writer.Flush()
 	fmt.Fprintf(writer, "  %s\t%s\n", "Updated At", humanizeTime(s.Service.LastDeploymentAt))
@@ -168,6 +493,25 @@ func (s *ServiceStatusDesc) HumanString() string {
 	for _, task := range s.Tasks {
 		fmt.Fprintf(writer, task.HumanString())
 	}
+	if s.hasContainers() {
+		fmt.Fprintf(writer, color.Bold.Sprint("\nContainers\n\n"))
+		writer.Flush()
+		fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\t%s\t%s\t%s\n", "Task", "Capacity Provider", "Name", "Last Status", "Health Status", "CPU", "Memory")
+		for _, task := range s.Tasks {
+			for _, container := range task.Containers {
+				fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\t%s\t%s\t%s\n", shortTaskID(task.ID), dashIfEmpty(task.CapacityProvider), container.Name,
+					dashIfEmpty(container.LastStatus), dashIfEmpty(container.Health), formatStat(container.CPUUtilized, "%.0f units"), formatStat(container.MemoryUtilized, "%.0f MiB"))
+			}
+		}
+	}
+	if len(s.StoppedTasks) != 0 {
+		fmt.Fprintf(writer, color.Bold.Sprint("\nStopped Tasks\n\n"))
+		writer.Flush()
+		fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\n", "ID", "Stopped At", "Exit Codes", "Reason")
+		for _, task := range s.StoppedTasks {
+			fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\n", shortTaskID(task.ID), humanizeTime(task.StoppedAt), exitCodes(task.ExitCodes), task.StoppedReason)
+		}
+	}
 	fmt.Fprintf(writer, color.Bold.Sprint("\nAlarms\n\n"))
 	writer.Flush()
 	fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\n", "Name", "Health", "Last Updated", "Reason")
@@ -175,13 +519,96 @@ func (s *ServiceStatusDesc) HumanString() string {
 		updatedTimeSince := humanizeTime(alarm.UpdatedTimes)
 		fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\n", alarm.Name, alarm.Status, updatedTimeSince, alarm.Reason)
 	}
+	if len(s.AlarmHistory) != 0 {
+		fmt.Fprintf(writer, color.Bold.Sprint("\nAlarm History\n"))
+		writer.Flush()
+		var alarmName string
+		for _, item := range s.AlarmHistory {
+			if item.Name != alarmName {
+				alarmName = item.Name
+				fmt.Fprintf(writer, "\n  %s\n", alarmName)
+			}
+			fmt.Fprintf(writer, "    %s\t%s -> %s\t%s\n", humanizeTime(item.Timestamp), item.OldState, item.NewState, item.Reason)
+		}
+	}
+	if len(s.Probes) != 0 {
+		fmt.Fprintf(writer, color.Bold.Sprint("\nEndpoint Probes\n\n"))
+		writer.Flush()
+		fmt.Fprintf(writer, "  %s\t%s\t%s\n", "URL", "Status", "Latency")
+		for _, result := range s.Probes {
+			fmt.Fprintf(writer, "  %s\t%s\t%dms\n", result.URL, probeStatus(result), result.LatencyMs)
+		}
+	}
+	if len(s.Targets) != 0 {
+		fmt.Fprintf(writer, color.Bold.Sprint("\nTarget Health\n\n"))
+		writer.Flush()
+		fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\n", "Target", "Port", "State", "Reason")
+		for _, target := range s.Targets {
+			reason := "-"
+			if target.Description != "" {
+				reason = target.Description
+			}
+			fmt.Fprintf(writer, "  %s\t%d\t%s\t%s\n", target.ID, target.Port, targetHealthState(target), reason)
+		}
+	}
 	writer.Flush()
 	return b.String()
 }
 
+func (s *ServiceStatusDesc) hasContainers() bool {
+	for _, task := range s.Tasks {
+		if len(task.Containers) != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// shortTaskID returns the first characters of the ID of a task, as shown by the ECS console.
+func shortTaskID(id string) string {
+	if len(id) < shortTaskIDLength {
+		return id
+	}
+	return id[:shortTaskIDLength]
+}
+
+// exitCodes returns the exit codes of the containers of a task sorted by container name, such as "frontend: 137, sidecar: 0".
+func exitCodes(codes map[string]int64) string {
+	if len(codes) == 0 {
+		return "-"
+	}
+	var names []string
+	for name := range codes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var formatted []string
+	for _, name := range names {
+		formatted = append(formatted, fmt.Sprintf("%s: %d", name, codes[name]))
+	}
+	return strings.Join(formatted, ", ")
+}
+
+// parseStat returns the value of a stat from the results of a Logs Insights query, or nil if it's missing.
+func parseStat(value string) *float64 {
+	stat, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return nil
+	}
+	return &stat
+}
+
+// formatStat returns the stat formatted with format, or a dash if it's unknown.
+func formatStat(stat *float64, format string) string {
+	if stat == nil {
+		return "-"
+	}
+	return fmt.Sprintf(format, *stat)
+}
+
 func statusColor(status string) string {
 	switch status {
-	case "ACTIVE":
+	case ecsServiceActiveStatus:
 		return color.Green.Sprint(status)
 	case "DRAINING":
 		return color.Yellow.Sprint(status)
@@ -189,3 +616,25 @@ func statusColor(status string) string {
 		return color.Red.Sprint(status)
 	}
 }
+
+func probeStatus(result probe.Result) string {
+	if result.Error != "" {
+		return color.Red.Sprint(result.Error)
+	}
+	status := strconv.Itoa(result.StatusCode)
+	if result.Healthy() {
+		return color.Green.Sprint(status)
+	}
+	return color.Red.Sprint(status)
+}
+
+func targetHealthState(target *elbv2.TargetHealth) string {
+	switch {
+	case target.IsHealthy():
+		return color.Green.Sprint(target.State)
+	case target.State == elbv2.TargetHealthStateUnhealthy:
+		return color.Red.Sprint(target.State)
+	default:
+		return color.Yellow.Sprint(target.State)
+	}
+}
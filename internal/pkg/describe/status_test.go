@@ -11,8 +11,12 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	ecsapi "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/applicationautoscaling"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
+	"github.com/aws/copilot-cli/internal/pkg/probe"
 
 	rg "github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
@@ -27,6 +31,7 @@ type serviceStatusMocks struct {
 	ecsServiceGetter  *mocks.MockecsServiceGetter
 	alarmStatusGetter *mocks.MockalarmStatusGetter
 	resourcesGetter   *mocks.MockresourcesGetter
+	insightsQuerier   *mocks.MockcontainerInsightsQuerier
 }
 
 func TestServiceStatus_Describe(t *testing.T) {
@@ -37,165 +42,267 @@ func TestServiceStatus_Describe(t *testing.T) {
 	}
 	badMockServiceArn := "badMockArn"
 	mockServiceArn := "arn:aws:ecs:us-west-2:1234567890:service/mockCluster/mockService"
+	mockDimensions := map[string]string{
+		"ClusterName": "mockCluster",
+		"ServiceName": "mockService",
+	}
 	startTime, _ := time.Parse(time.RFC3339, "2006-01-02T15:04:05+00:00")
 	stopTime, _ := time.Parse(time.RFC3339, "2006-01-02T16:04:05+00:00")
 	updateTime, _ := time.Parse(time.RFC3339, "2020-03-13T19:50:30+00:00")
 	mockError := errors.New("some error")
 	testCases := map[string]struct {
-		setupMocks func(mocks serviceStatusMocks)
+		inAlarmNames []string
+		setupMocks   func(mocks serviceStatusMocks)
 
 		wantedError   error
 		wantedContent *ServiceStatusDesc
 	}{
 		"errors if failed to get service ARN": {
 			setupMocks: func(m serviceStatusMocks) {
-				gomock.InOrder(
-					m.resourcesGetter.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockTags).Return(nil, mockError),
-				)
+				m.resourcesGetter.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockTags).Return(nil, mockError)
 			},
 
 			wantedError: fmt.Errorf("get service ARN: some error"),
 		},
 		"errors if failed to get cluster name": {
 			setupMocks: func(m serviceStatusMocks) {
-				gomock.InOrder(
-					m.resourcesGetter.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockTags).Return([]*rg.Resource{
-						{
-							ARN: badMockServiceArn,
-						},
-					}, nil),
-				)
+				m.resourcesGetter.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockTags).Return([]*rg.Resource{
+					{
+						ARN: badMockServiceArn,
+					},
+				}, nil)
 			},
 
 			wantedError: fmt.Errorf("get cluster name: arn: invalid prefix"),
 		},
 		"errors if failed to get service info": {
 			setupMocks: func(m serviceStatusMocks) {
-				gomock.InOrder(
-					m.resourcesGetter.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockTags).Return([]*rg.Resource{
-						{
-							ARN: mockServiceArn,
-						},
-					}, nil),
-					m.ecsServiceGetter.EXPECT().Service("mockCluster", "mockService").Return(nil, mockError),
-				)
+				m.resourcesGetter.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockTags).Return([]*rg.Resource{
+					{
+						ARN: mockServiceArn,
+					},
+				}, nil)
+				m.ecsServiceGetter.EXPECT().Service("mockCluster", "mockService").Return(nil, mockError)
+				m.ecsServiceGetter.EXPECT().ServiceTasks("mockCluster", "mockService").Return(nil, nil)
+				m.alarmStatusGetter.EXPECT().GetAlarmsWithTags(mockTags).Return(nil, nil)
+				m.alarmStatusGetter.EXPECT().GetAlarmsWithNamePrefix("mockApp-mockEnv-mockSvc-").Return(nil, nil)
+				m.alarmStatusGetter.EXPECT().GetAlarmsWithDimensions(mockDimensions).Return(nil, nil)
 			},
 
 			wantedError: fmt.Errorf("get service mockService: some error"),
 		},
 		"errors if failed to get running tasks info": {
 			setupMocks: func(m serviceStatusMocks) {
-				gomock.InOrder(
-					m.resourcesGetter.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockTags).Return([]*rg.Resource{
-						{
-							ARN: mockServiceArn,
-						},
-					}, nil),
-					m.ecsServiceGetter.EXPECT().Service("mockCluster", "mockService").Return(&ecs.Service{}, nil),
-					m.ecsServiceGetter.EXPECT().ServiceTasks("mockCluster", "mockService").Return(nil, mockError),
-				)
+				m.resourcesGetter.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockTags).Return([]*rg.Resource{
+					{
+						ARN: mockServiceArn,
+					},
+				}, nil)
+				m.ecsServiceGetter.EXPECT().Service("mockCluster", "mockService").Return(&ecs.Service{}, nil)
+				m.ecsServiceGetter.EXPECT().ServiceTasks("mockCluster", "mockService").Return(nil, mockError)
+				m.alarmStatusGetter.EXPECT().GetAlarmsWithTags(mockTags).Return(nil, nil)
+				m.alarmStatusGetter.EXPECT().GetAlarmsWithNamePrefix("mockApp-mockEnv-mockSvc-").Return(nil, nil)
+				m.alarmStatusGetter.EXPECT().GetAlarmsWithDimensions(mockDimensions).Return(nil, nil)
 			},
 
 			wantedError: fmt.Errorf("get tasks for service mockService: some error"),
 		},
 		"errors if failed to get running tasks status": {
 			setupMocks: func(m serviceStatusMocks) {
-				gomock.InOrder(
-					m.resourcesGetter.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockTags).Return([]*rg.Resource{
-						{
-							ARN: mockServiceArn,
-						},
-					}, nil),
-					m.ecsServiceGetter.EXPECT().Service("mockCluster", "mockService").Return(&ecs.Service{}, nil),
-					m.ecsServiceGetter.EXPECT().ServiceTasks("mockCluster", "mockService").Return([]*ecs.Task{
-						{
-							TaskArn: aws.String("badMockTaskArn"),
-						},
-					}, nil),
-				)
+				m.resourcesGetter.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockTags).Return([]*rg.Resource{
+					{
+						ARN: mockServiceArn,
+					},
+				}, nil)
+				m.ecsServiceGetter.EXPECT().Service("mockCluster", "mockService").Return(&ecs.Service{}, nil)
+				m.ecsServiceGetter.EXPECT().ServiceTasks("mockCluster", "mockService").Return([]*ecs.Task{
+					{
+						TaskArn: aws.String("badMockTaskArn"),
+					},
+				}, nil)
+				m.alarmStatusGetter.EXPECT().GetAlarmsWithTags(mockTags).Return(nil, nil)
+				m.alarmStatusGetter.EXPECT().GetAlarmsWithNamePrefix("mockApp-mockEnv-mockSvc-").Return(nil, nil)
+				m.alarmStatusGetter.EXPECT().GetAlarmsWithDimensions(mockDimensions).Return(nil, nil)
 			},
 
 			wantedError: fmt.Errorf("get status for task badMockTaskArn: arn: invalid prefix"),
 		},
 		"errors if failed to get CloudWatch alarms": {
 			setupMocks: func(m serviceStatusMocks) {
-				gomock.InOrder(
-					m.resourcesGetter.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockTags).Return([]*rg.Resource{
-						{
-							ARN: mockServiceArn,
-						},
-					}, nil),
-					m.ecsServiceGetter.EXPECT().Service("mockCluster", "mockService").Return(&ecs.Service{}, nil),
-					m.ecsServiceGetter.EXPECT().ServiceTasks("mockCluster", "mockService").Return([]*ecs.Task{
-						{
-							TaskArn:   aws.String("arn:aws:ecs:us-west-2:123456789012:task/mockCluster/1234567890123456789"),
-							StartedAt: &startTime,
-						},
-					}, nil),
-					m.alarmStatusGetter.EXPECT().GetAlarmsWithTags(map[string]string{
-						"copilot-application": "mockApp",
-						"copilot-environment": "mockEnv",
-						"copilot-service":     "mockSvc",
-					}).Return(nil, mockError),
-				)
+				m.resourcesGetter.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockTags).Return([]*rg.Resource{
+					{
+						ARN: mockServiceArn,
+					},
+				}, nil)
+				m.ecsServiceGetter.EXPECT().Service("mockCluster", "mockService").Return(&ecs.Service{}, nil)
+				m.ecsServiceGetter.EXPECT().ServiceTasks("mockCluster", "mockService").Return([]*ecs.Task{
+					{
+						TaskArn:   aws.String("arn:aws:ecs:us-west-2:123456789012:task/mockCluster/1234567890123456789"),
+						StartedAt: &startTime,
+					},
+				}, nil)
+				m.alarmStatusGetter.EXPECT().GetAlarmsWithTags(map[string]string{
+					"copilot-application": "mockApp",
+					"copilot-environment": "mockEnv",
+					"copilot-service":     "mockSvc",
+				}).Return(nil, mockError)
 			},
 
 			wantedError: fmt.Errorf("get CloudWatch alarms: some error"),
 		},
+		"errors if failed to get CloudWatch alarms by name prefix": {
+			setupMocks: func(m serviceStatusMocks) {
+				m.resourcesGetter.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockTags).Return([]*rg.Resource{
+					{
+						ARN: mockServiceArn,
+					},
+				}, nil)
+				m.ecsServiceGetter.EXPECT().Service("mockCluster", "mockService").Return(&ecs.Service{}, nil)
+				m.ecsServiceGetter.EXPECT().ServiceTasks("mockCluster", "mockService").Return(nil, nil)
+				m.alarmStatusGetter.EXPECT().GetAlarmsWithTags(mockTags).Return(nil, nil)
+				m.alarmStatusGetter.EXPECT().GetAlarmsWithNamePrefix("mockApp-mockEnv-mockSvc-").Return(nil, mockError)
+			},
+
+			wantedError: fmt.Errorf("get CloudWatch alarms: some error"),
+		},
+		"errors if failed to get CloudWatch alarms by name": {
+			inAlarmNames: []string{"mockExternalAlarm"},
+			setupMocks: func(m serviceStatusMocks) {
+				m.resourcesGetter.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockTags).Return([]*rg.Resource{
+					{
+						ARN: mockServiceArn,
+					},
+				}, nil)
+				m.ecsServiceGetter.EXPECT().Service("mockCluster", "mockService").Return(&ecs.Service{}, nil)
+				m.ecsServiceGetter.EXPECT().ServiceTasks("mockCluster", "mockService").Return(nil, nil)
+				m.alarmStatusGetter.EXPECT().GetAlarmsWithTags(mockTags).Return(nil, nil)
+				m.alarmStatusGetter.EXPECT().GetAlarmsWithNamePrefix("mockApp-mockEnv-mockSvc-").Return(nil, nil)
+				m.alarmStatusGetter.EXPECT().GetAlarmsWithDimensions(mockDimensions).Return(nil, nil)
+				m.alarmStatusGetter.EXPECT().GetAlarmsWithNames([]string{"mockExternalAlarm"}).Return(nil, mockError)
+			},
+
+			wantedError: fmt.Errorf("get CloudWatch alarms: some error"),
+		},
+		"errors if failed to query Container Insights": {
+			setupMocks: func(m serviceStatusMocks) {
+				m.resourcesGetter.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockTags).Return([]*rg.Resource{
+					{
+						ARN: mockServiceArn,
+					},
+				}, nil)
+				m.ecsServiceGetter.EXPECT().Service("mockCluster", "mockService").Return(&ecs.Service{}, nil)
+				m.ecsServiceGetter.EXPECT().ServiceTasks("mockCluster", "mockService").Return([]*ecs.Task{
+					{
+						TaskArn: aws.String("arn:aws:ecs:us-west-2:123456789012:task/mockCluster/1234567890123456789"),
+					},
+				}, nil)
+				m.alarmStatusGetter.EXPECT().GetAlarmsWithTags(mockTags).Return(nil, nil)
+				m.alarmStatusGetter.EXPECT().GetAlarmsWithNamePrefix("mockApp-mockEnv-mockSvc-").Return(nil, nil)
+				m.alarmStatusGetter.EXPECT().GetAlarmsWithDimensions(mockDimensions).Return(nil, nil)
+				m.insightsQuerier.EXPECT().LogGroupExists("/aws/ecs/containerinsights/mockCluster/performance").Return(true, nil)
+				m.insightsQuerier.EXPECT().Query("/aws/ecs/containerinsights/mockCluster/performance", gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, mockError)
+			},
+
+			wantedError: fmt.Errorf("get container stats from Container Insights: some error"),
+		},
 		"success": {
+			inAlarmNames: []string{"mockExternalAlarm"},
 			setupMocks: func(m serviceStatusMocks) {
-				gomock.InOrder(
-					m.resourcesGetter.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockTags).Return([]*rg.Resource{
+				m.resourcesGetter.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockTags).Return([]*rg.Resource{
+					{
+						ARN: mockServiceArn,
+					},
+				}, nil)
+				m.ecsServiceGetter.EXPECT().Service("mockCluster", "mockService").Return(&ecs.Service{
+					Status:       aws.String("ACTIVE"),
+					DesiredCount: aws.Int64(1),
+					RunningCount: aws.Int64(1),
+					Deployments: []*ecsapi.Deployment{
 						{
-							ARN: mockServiceArn,
+							UpdatedAt:      &startTime,
+							TaskDefinition: aws.String("mockTaskDefinition"),
 						},
-					}, nil),
-					m.ecsServiceGetter.EXPECT().Service("mockCluster", "mockService").Return(&ecs.Service{
-						Status:       aws.String("ACTIVE"),
-						DesiredCount: aws.Int64(1),
-						RunningCount: aws.Int64(1),
-						Deployments: []*ecsapi.Deployment{
+					},
+				}, nil)
+				m.ecsServiceGetter.EXPECT().ServiceTasks("mockCluster", "mockService").Return([]*ecs.Task{
+					{
+						TaskArn:              aws.String("arn:aws:ecs:us-west-2:123456789012:task/mockCluster/1234567890123456789"),
+						StartedAt:            &startTime,
+						HealthStatus:         aws.String("HEALTHY"),
+						LastStatus:           aws.String("RUNNING"),
+						CapacityProviderName: aws.String("FARGATE_SPOT"),
+						Containers: []*ecsapi.Container{
 							{
-								UpdatedAt:      &startTime,
-								TaskDefinition: aws.String("mockTaskDefinition"),
+								Name:         aws.String("mockSvc"),
+								Image:        aws.String("mockImageID1"),
+								ImageDigest:  aws.String("69671a968e8ec3648e2697417750e"),
+								LastStatus:   aws.String("RUNNING"),
+								HealthStatus: aws.String("HEALTHY"),
 							},
-						},
-					}, nil),
-					m.ecsServiceGetter.EXPECT().ServiceTasks("mockCluster", "mockService").Return([]*ecs.Task{
-						{
-							TaskArn:      aws.String("arn:aws:ecs:us-west-2:123456789012:task/mockCluster/1234567890123456789"),
-							StartedAt:    &startTime,
-							HealthStatus: aws.String("HEALTHY"),
-							LastStatus:   aws.String("RUNNING"),
-							Containers: []*ecsapi.Container{
-								{
-									Image:       aws.String("mockImageID1"),
-									ImageDigest: aws.String("69671a968e8ec3648e2697417750e"),
-								},
-								{
-									Image:       aws.String("mockImageID2"),
-									ImageDigest: aws.String("ca27a44e25ce17fea7b07940ad793"),
-								},
+							{
+								Name:         aws.String("nginx"),
+								Image:        aws.String("mockImageID2"),
+								ImageDigest:  aws.String("ca27a44e25ce17fea7b07940ad793"),
+								LastStatus:   aws.String("RUNNING"),
+								HealthStatus: aws.String("UNKNOWN"),
 							},
-							StoppedAt:     &stopTime,
-							StoppedReason: aws.String("some reason"),
-						},
-					}, nil),
-					m.alarmStatusGetter.EXPECT().GetAlarmsWithTags(map[string]string{
-						"copilot-application": "mockApp",
-						"copilot-environment": "mockEnv",
-						"copilot-service":     "mockSvc",
-					}).Return([]cloudwatch.AlarmStatus{
-						{
-							Arn:          "mockAlarmArn",
-							Name:         "mockAlarm",
-							Reason:       "Threshold Crossed",
-							Status:       "OK",
-							Type:         "Metric",
-							UpdatedTimes: updateTime,
 						},
-					}, nil),
-				)
+						StoppedAt:     &stopTime,
+						StoppedReason: aws.String("some reason"),
+					},
+				}, nil)
+				m.alarmStatusGetter.EXPECT().GetAlarmsWithTags(map[string]string{
+					"copilot-application": "mockApp",
+					"copilot-environment": "mockEnv",
+					"copilot-service":     "mockSvc",
+				}).Return([]cloudwatch.AlarmStatus{
+					{
+						Arn:          "mockAlarmArn",
+						Name:         "mockAlarm",
+						Reason:       "Threshold Crossed",
+						Status:       "OK",
+						Type:         "Metric",
+						UpdatedTimes: updateTime,
+					},
+				}, nil)
+				m.alarmStatusGetter.EXPECT().GetAlarmsWithNamePrefix("mockApp-mockEnv-mockSvc-").Return([]cloudwatch.AlarmStatus{
+					{
+						Arn:  "mockAlarmArn",
+						Name: "mockAlarm",
+					},
+					{
+						Arn:          "mockAddonAlarmArn",
+						Name:         "mockApp-mockEnv-mockSvc-AddonsStack-mockAlarm",
+						Status:       "OK",
+						Type:         "Metric",
+						UpdatedTimes: updateTime,
+					},
+				}, nil)
+				m.alarmStatusGetter.EXPECT().GetAlarmsWithDimensions(mockDimensions).Return([]cloudwatch.AlarmStatus{
+					{
+						Arn:          "mockManualAlarmArn",
+						Name:         "HighCPU",
+						Status:       "ALARM",
+						Type:         "Metric",
+						UpdatedTimes: updateTime,
+					},
+				}, nil)
+				m.alarmStatusGetter.EXPECT().GetAlarmsWithNames([]string{"mockExternalAlarm"}).Return([]cloudwatch.AlarmStatus{
+					{
+						Arn:          "mockExternalAlarmArn",
+						Name:         "mockExternalAlarm",
+						Status:       "OK",
+						Type:         "Composite",
+						UpdatedTimes: updateTime,
+					},
+				}, nil)
+				m.insightsQuerier.EXPECT().LogGroupExists("/aws/ecs/containerinsights/mockCluster/performance").Return(true, nil)
+				m.insightsQuerier.EXPECT().Query("/aws/ecs/containerinsights/mockCluster/performance", `filter Type = "Container" and TaskId in ["1234567890123456789"]
+| stats avg(CpuUtilized) as cpu, avg(MemoryUtilized) as memory by TaskId, ContainerName`, gomock.Any(), gomock.Any()).Return(&cloudwatchlogs.QueryResults{
+					Rows: []map[string]string{
+						{"TaskId": "1234567890123456789", "ContainerName": "mockSvc", "cpu": "12.5", "memory": "256"},
+					},
+				}, nil)
 			},
 
 			wantedContent: &ServiceStatusDesc{
@@ -215,6 +322,27 @@ func TestServiceStatus_Describe(t *testing.T) {
 						Type:         "Metric",
 						UpdatedTimes: updateTime,
 					},
+					{
+						Arn:          "mockAddonAlarmArn",
+						Name:         "mockApp-mockEnv-mockSvc-AddonsStack-mockAlarm",
+						Status:       "OK",
+						Type:         "Metric",
+						UpdatedTimes: updateTime,
+					},
+					{
+						Arn:          "mockManualAlarmArn",
+						Name:         "HighCPU",
+						Status:       "ALARM",
+						Type:         "Metric",
+						UpdatedTimes: updateTime,
+					},
+					{
+						Arn:          "mockExternalAlarmArn",
+						Name:         "mockExternalAlarm",
+						Status:       "OK",
+						Type:         "Composite",
+						UpdatedTimes: updateTime,
+					},
 				},
 				Tasks: []ecs.TaskStatus{
 					{
@@ -231,9 +359,24 @@ func TestServiceStatus_Describe(t *testing.T) {
 								Digest: "ca27a44e25ce17fea7b07940ad793",
 							},
 						},
-						StartedAt:     startTime,
-						StoppedAt:     stopTime,
-						StoppedReason: "some reason",
+						StartedAt:        startTime,
+						StoppedAt:        stopTime,
+						StoppedReason:    "some reason",
+						CapacityProvider: "FARGATE_SPOT",
+						Containers: []ecs.ContainerStatus{
+							{
+								Name:           "mockSvc",
+								LastStatus:     "RUNNING",
+								Health:         "HEALTHY",
+								CPUUtilized:    aws.Float64(12.5),
+								MemoryUtilized: aws.Float64(256),
+							},
+							{
+								Name:       "nginx",
+								LastStatus: "RUNNING",
+								Health:     "UNKNOWN",
+							},
+						},
 					},
 				},
 			},
@@ -246,35 +389,530 @@ func TestServiceStatus_Describe(t *testing.T) {
 			defer ctrl.Finish()
 
 			mockecsSvc := mocks.NewMockecsServiceGetter(ctrl)
-			mockcwSvc := mocks.NewMockalarmStatusGetter(ctrl)
+			mockcwSvc := mocks.NewMockalarmStatusGetter(ctrl)
+			mockrgSvc := mocks.NewMockresourcesGetter(ctrl)
+			mockInsightsSvc := mocks.NewMockcontainerInsightsQuerier(ctrl)
+			mocks := serviceStatusMocks{
+				ecsServiceGetter:  mockecsSvc,
+				alarmStatusGetter: mockcwSvc,
+				resourcesGetter:   mockrgSvc,
+				insightsQuerier:   mockInsightsSvc,
+			}
+
+			tc.setupMocks(mocks)
+
+			svcStatus := &ServiceStatus{
+				SvcName:     "mockSvc",
+				EnvName:     "mockEnv",
+				AppName:     "mockApp",
+				AlarmNames:  tc.inAlarmNames,
+				CwSvc:       mockcwSvc,
+				EcsSvc:      mockecsSvc,
+				InsightsSvc: mockInsightsSvc,
+				rgSvc:       mockrgSvc,
+			}
+
+			// WHEN
+			statusDesc, err := svcStatus.Describe()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedContent, statusDesc, "expected output content match")
+			}
+		})
+	}
+}
+
+func TestServiceStatus_AlarmHistory(t *testing.T) {
+	startDate, _ := time.Parse(time.RFC3339, "2020-03-13T19:50:30+00:00")
+	alarms := []cloudwatch.AlarmStatus{
+		{Name: "mockAlarm"},
+		{Name: "mockOtherAlarm"},
+	}
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.MockalarmStatusGetter)
+
+		wantedHistory []cloudwatch.AlarmHistoryItem
+		wantedError   error
+	}{
+		"errors if failed to get the history of an alarm": {
+			setupMocks: func(m *mocks.MockalarmStatusGetter) {
+				m.EXPECT().AlarmHistory("mockAlarm", startDate).Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("get history of CloudWatch alarm mockAlarm: some error"),
+		},
+		"returns the history of the alarms in order": {
+			setupMocks: func(m *mocks.MockalarmStatusGetter) {
+				m.EXPECT().AlarmHistory("mockAlarm", startDate).Return([]cloudwatch.AlarmHistoryItem{
+					{Name: "mockAlarm", OldState: "OK", NewState: "ALARM"},
+				}, nil)
+				m.EXPECT().AlarmHistory("mockOtherAlarm", startDate).Return(nil, nil)
+			},
+
+			wantedHistory: []cloudwatch.AlarmHistoryItem{
+				{Name: "mockAlarm", OldState: "OK", NewState: "ALARM"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockcwSvc := mocks.NewMockalarmStatusGetter(ctrl)
+			tc.setupMocks(mockcwSvc)
+
+			svcStatus := &ServiceStatus{
+				CwSvc: mockcwSvc,
+			}
+
+			// WHEN
+			history, err := svcStatus.AlarmHistory(alarms, startDate)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedHistory, history)
+		})
+	}
+}
+
+func TestServiceStatus_StoppedTasks(t *testing.T) {
+	mockTags := map[string]string{
+		deploy.AppTagKey:     "mockApp",
+		deploy.EnvTagKey:     "mockEnv",
+		deploy.ServiceTagKey: "mockSvc",
+	}
+	mockServiceArn := "arn:aws:ecs:us-west-2:1234567890:service/mockCluster/mockService"
+	testCases := map[string]struct {
+		setupMocks func(m serviceStatusMocks)
+
+		wantedTasks []*ecs.Task
+		wantedError error
+	}{
+		"errors if failed to get service ARN": {
+			setupMocks: func(m serviceStatusMocks) {
+				m.resourcesGetter.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockTags).Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("get service ARN: some error"),
+		},
+		"errors if failed to get stopped tasks": {
+			setupMocks: func(m serviceStatusMocks) {
+				m.resourcesGetter.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockTags).Return([]*rg.Resource{
+					{ARN: mockServiceArn},
+				}, nil)
+				m.ecsServiceGetter.EXPECT().StoppedServiceTasks("mockCluster", "mockService").Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("get stopped tasks for service mockService: some error"),
+		},
+		"returns the stopped tasks of the service": {
+			setupMocks: func(m serviceStatusMocks) {
+				m.resourcesGetter.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockTags).Return([]*rg.Resource{
+					{ARN: mockServiceArn},
+				}, nil)
+				m.ecsServiceGetter.EXPECT().StoppedServiceTasks("mockCluster", "mockService").Return([]*ecs.Task{
+					{TaskArn: aws.String("mockTaskArn")},
+				}, nil)
+			},
+
+			wantedTasks: []*ecs.Task{
+				{TaskArn: aws.String("mockTaskArn")},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockecsSvc := mocks.NewMockecsServiceGetter(ctrl)
+			mockrgSvc := mocks.NewMockresourcesGetter(ctrl)
+			tc.setupMocks(serviceStatusMocks{
+				ecsServiceGetter: mockecsSvc,
+				resourcesGetter:  mockrgSvc,
+			})
+
+			svcStatus := &ServiceStatus{
+				SvcName: "mockSvc",
+				EnvName: "mockEnv",
+				AppName: "mockApp",
+				EcsSvc:  mockecsSvc,
+				rgSvc:   mockrgSvc,
+			}
+
+			// WHEN
+			tasks, err := svcStatus.StoppedTasks()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedTasks, tasks)
+		})
+	}
+}
+
+func TestServiceStatus_ServiceEvents(t *testing.T) {
+	mockTags := map[string]string{
+		deploy.AppTagKey:     "mockApp",
+		deploy.EnvTagKey:     "mockEnv",
+		deploy.ServiceTagKey: "mockSvc",
+	}
+	mockServiceArn := "arn:aws:ecs:us-west-2:1234567890:service/mockCluster/mockService"
+	since := time.Date(2020, 10, 15, 10, 0, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		setupMocks func(m serviceStatusMocks)
+
+		wantedEvents []ecs.ServiceEvent
+		wantedError  error
+	}{
+		"errors if failed to get service ARN": {
+			setupMocks: func(m serviceStatusMocks) {
+				m.resourcesGetter.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockTags).Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("get service ARN: some error"),
+		},
+		"errors if failed to get events": {
+			setupMocks: func(m serviceStatusMocks) {
+				m.resourcesGetter.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockTags).Return([]*rg.Resource{
+					{ARN: mockServiceArn},
+				}, nil)
+				m.ecsServiceGetter.EXPECT().ServiceEvents("mockCluster", "mockService", since).Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("get events for service mockService: some error"),
+		},
+		"returns the events of the service": {
+			setupMocks: func(m serviceStatusMocks) {
+				m.resourcesGetter.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockTags).Return([]*rg.Resource{
+					{ARN: mockServiceArn},
+				}, nil)
+				m.ecsServiceGetter.EXPECT().ServiceEvents("mockCluster", "mockService", since).Return([]ecs.ServiceEvent{
+					{ID: "1", Message: "(service mockService) has reached a steady state."},
+				}, nil)
+			},
+
+			wantedEvents: []ecs.ServiceEvent{
+				{ID: "1", Message: "(service mockService) has reached a steady state."},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockecsSvc := mocks.NewMockecsServiceGetter(ctrl)
+			mockrgSvc := mocks.NewMockresourcesGetter(ctrl)
+			tc.setupMocks(serviceStatusMocks{
+				ecsServiceGetter: mockecsSvc,
+				resourcesGetter:  mockrgSvc,
+			})
+
+			svcStatus := &ServiceStatus{
+				SvcName: "mockSvc",
+				EnvName: "mockEnv",
+				AppName: "mockApp",
+				EcsSvc:  mockecsSvc,
+				rgSvc:   mockrgSvc,
+			}
+
+			// WHEN
+			events, err := svcStatus.ServiceEvents(since)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedEvents, events)
+		})
+	}
+}
+
+func TestServiceStatus_StoppedTaskStatuses(t *testing.T) {
+	mockTags := map[string]string{
+		deploy.AppTagKey:     "mockApp",
+		deploy.EnvTagKey:     "mockEnv",
+		deploy.ServiceTagKey: "mockSvc",
+	}
+	mockServiceArn := "arn:aws:ecs:us-west-2:1234567890:service/mockCluster/mockService"
+	stopTime, _ := time.Parse(time.RFC3339, "2006-01-02T16:04:05+00:00")
+	testCases := map[string]struct {
+		setupMocks func(m serviceStatusMocks)
+
+		wantedStatuses []ecs.TaskStatus
+		wantedError    error
+	}{
+		"errors if failed to get stopped tasks": {
+			setupMocks: func(m serviceStatusMocks) {
+				m.resourcesGetter.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockTags).Return([]*rg.Resource{
+					{ARN: mockServiceArn},
+				}, nil)
+				m.ecsServiceGetter.EXPECT().StoppedServiceTasks("mockCluster", "mockService").Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("get stopped tasks for service mockService: some error"),
+		},
+		"errors if the ARN of a task is invalid": {
+			setupMocks: func(m serviceStatusMocks) {
+				m.resourcesGetter.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockTags).Return([]*rg.Resource{
+					{ARN: mockServiceArn},
+				}, nil)
+				m.ecsServiceGetter.EXPECT().StoppedServiceTasks("mockCluster", "mockService").Return([]*ecs.Task{
+					{TaskArn: aws.String("badTaskArn")},
+				}, nil)
+			},
+
+			wantedError: errors.New("get status for task badTaskArn: arn: invalid prefix"),
+		},
+		"returns the status of the stopped tasks with their exit codes": {
+			setupMocks: func(m serviceStatusMocks) {
+				m.resourcesGetter.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockTags).Return([]*rg.Resource{
+					{ARN: mockServiceArn},
+				}, nil)
+				m.ecsServiceGetter.EXPECT().StoppedServiceTasks("mockCluster", "mockService").Return([]*ecs.Task{
+					{
+						TaskArn:       aws.String("arn:aws:ecs:us-west-2:1234567890:task/mockCluster/1234567890123456789"),
+						LastStatus:    aws.String("STOPPED"),
+						StoppedAt:     aws.Time(stopTime),
+						StoppedReason: aws.String("Essential container in task exited"),
+						Containers: []*ecsapi.Container{
+							{
+								Name:     aws.String("frontend"),
+								Image:    aws.String("mockImage"),
+								ExitCode: aws.Int64(1),
+							},
+						},
+					},
+				}, nil)
+			},
+
+			wantedStatuses: []ecs.TaskStatus{
+				{
+					ID:            "1234567890123456789",
+					Images:        []ecs.Image{{ID: "mockImage"}},
+					LastStatus:    "STOPPED",
+					StoppedAt:     stopTime,
+					StoppedReason: "Essential container in task exited",
+					ExitCodes:     map[string]int64{"frontend": 1},
+					Containers:    []ecs.ContainerStatus{{Name: "frontend"}},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockecsSvc := mocks.NewMockecsServiceGetter(ctrl)
+			mockrgSvc := mocks.NewMockresourcesGetter(ctrl)
+			tc.setupMocks(serviceStatusMocks{
+				ecsServiceGetter: mockecsSvc,
+				resourcesGetter:  mockrgSvc,
+			})
+
+			svcStatus := &ServiceStatus{
+				SvcName: "mockSvc",
+				EnvName: "mockEnv",
+				AppName: "mockApp",
+				EcsSvc:  mockecsSvc,
+				rgSvc:   mockrgSvc,
+			}
+
+			// WHEN
+			statuses, err := svcStatus.StoppedTaskStatuses()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedStatuses, statuses)
+		})
+	}
+}
+
+func TestServiceStatus_Autoscaling(t *testing.T) {
+	mockTags := map[string]string{
+		deploy.AppTagKey:     "mockApp",
+		deploy.EnvTagKey:     "mockEnv",
+		deploy.ServiceTagKey: "mockSvc",
+	}
+	mockServiceArn := "arn:aws:ecs:us-west-2:1234567890:service/mockCluster/mockService"
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.MockresourcesGetter, scaling *mocks.MockautoscalingGetter)
+
+		wantedScaling *applicationautoscaling.ServiceScaling
+		wantedError   error
+	}{
+		"errors if failed to get the service ARN": {
+			setupMocks: func(m *mocks.MockresourcesGetter, scaling *mocks.MockautoscalingGetter) {
+				m.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockTags).Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("get service ARN: some error"),
+		},
+		"errors if failed to get the autoscaling of the service": {
+			setupMocks: func(m *mocks.MockresourcesGetter, scaling *mocks.MockautoscalingGetter) {
+				m.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockTags).Return([]*rg.Resource{
+					{ARN: mockServiceArn},
+				}, nil)
+				scaling.EXPECT().ECSServiceScaling("mockCluster", "mockService").Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("get autoscaling of service mockService: some error"),
+		},
+		"returns the autoscaling of the service": {
+			setupMocks: func(m *mocks.MockresourcesGetter, scaling *mocks.MockautoscalingGetter) {
+				m.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockTags).Return([]*rg.Resource{
+					{ARN: mockServiceArn},
+				}, nil)
+				scaling.EXPECT().ECSServiceScaling("mockCluster", "mockService").Return(&applicationautoscaling.ServiceScaling{
+					MinCapacity: 1,
+					MaxCapacity: 10,
+				}, nil)
+			},
+
+			wantedScaling: &applicationautoscaling.ServiceScaling{
+				MinCapacity: 1,
+				MaxCapacity: 10,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockrgSvc := mocks.NewMockresourcesGetter(ctrl)
+			mockScalingSvc := mocks.NewMockautoscalingGetter(ctrl)
+			tc.setupMocks(mockrgSvc, mockScalingSvc)
+
+			svcStatus := &ServiceStatus{
+				SvcName:    "mockSvc",
+				EnvName:    "mockEnv",
+				AppName:    "mockApp",
+				ScalingSvc: mockScalingSvc,
+				rgSvc:      mockrgSvc,
+			}
+
+			// WHEN
+			scaling, err := svcStatus.Autoscaling()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedScaling, scaling)
+		})
+	}
+}
+
+func TestServiceStatus_RunningTasks(t *testing.T) {
+	mockTags := map[string]string{
+		deploy.AppTagKey:     "mockApp",
+		deploy.EnvTagKey:     "mockEnv",
+		deploy.ServiceTagKey: "mockSvc",
+	}
+	mockServiceArn := "arn:aws:ecs:us-west-2:1234567890:service/mockCluster/mockService"
+	testCases := map[string]struct {
+		setupMocks func(m serviceStatusMocks)
+
+		wantedTasks []*ecs.Task
+		wantedError error
+	}{
+		"errors if failed to get service ARN": {
+			setupMocks: func(m serviceStatusMocks) {
+				m.resourcesGetter.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockTags).Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("get service ARN: some error"),
+		},
+		"errors if failed to get running tasks": {
+			setupMocks: func(m serviceStatusMocks) {
+				m.resourcesGetter.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockTags).Return([]*rg.Resource{
+					{ARN: mockServiceArn},
+				}, nil)
+				m.ecsServiceGetter.EXPECT().ServiceTasks("mockCluster", "mockService").Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("get running tasks for service mockService: some error"),
+		},
+		"returns the running tasks of the service": {
+			setupMocks: func(m serviceStatusMocks) {
+				m.resourcesGetter.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockTags).Return([]*rg.Resource{
+					{ARN: mockServiceArn},
+				}, nil)
+				m.ecsServiceGetter.EXPECT().ServiceTasks("mockCluster", "mockService").Return([]*ecs.Task{
+					{TaskArn: aws.String("mockTaskArn")},
+				}, nil)
+			},
+
+			wantedTasks: []*ecs.Task{
+				{TaskArn: aws.String("mockTaskArn")},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockecsSvc := mocks.NewMockecsServiceGetter(ctrl)
 			mockrgSvc := mocks.NewMockresourcesGetter(ctrl)
-			mocks := serviceStatusMocks{
-				ecsServiceGetter:  mockecsSvc,
-				alarmStatusGetter: mockcwSvc,
-				resourcesGetter:   mockrgSvc,
-			}
-
-			tc.setupMocks(mocks)
+			tc.setupMocks(serviceStatusMocks{
+				ecsServiceGetter: mockecsSvc,
+				resourcesGetter:  mockrgSvc,
+			})
 
 			svcStatus := &ServiceStatus{
 				SvcName: "mockSvc",
 				EnvName: "mockEnv",
 				AppName: "mockApp",
-				CwSvc:   mockcwSvc,
 				EcsSvc:  mockecsSvc,
 				rgSvc:   mockrgSvc,
 			}
 
 			// WHEN
-			statusDesc, err := svcStatus.Describe()
+			tasks, err := svcStatus.RunningTasks()
 
 			// THEN
 			if tc.wantedError != nil {
 				require.EqualError(t, err, tc.wantedError.Error())
-			} else {
-				require.NoError(t, err)
-				require.Equal(t, tc.wantedContent, statusDesc, "expected output content match")
+				return
 			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedTasks, tasks)
 		})
 	}
 }
@@ -408,6 +1046,287 @@ Alarms
 `,
 			json: "{\"Service\":{\"desiredCount\":1,\"runningCount\":1,\"status\":\"ACTIVE\",\"lastDeploymentAt\":\"2006-01-02T15:04:05Z\",\"taskDefinition\":\"mockTaskDefinition\"},\"tasks\":[{\"health\":\"HEALTHY\",\"id\":\"1234567890123456789\",\"images\":[{\"ID\":\"mockImageID1\",\"Digest\":\"69671a968e8ec3648e2697417750e\"},{\"ID\":\"mockImageID2\",\"Digest\":\"ca27a44e25ce17fea7b07940ad793\"}],\"lastStatus\":\"RUNNING\",\"startedAt\":\"2006-01-02T15:04:05Z\",\"stoppedAt\":\"2006-01-02T16:04:05Z\",\"stoppedReason\":\"some reason\"}],\"alarms\":[{\"arn\":\"mockAlarmArn\",\"name\":\"mockAlarm\",\"reason\":\"Threshold Crossed\",\"status\":\"OK\",\"type\":\"Metric\",\"updatedTimes\":\"2020-03-13T19:50:30Z\"}]}\n",
 		},
+		"with endpoint probes": {
+			desc: &ServiceStatusDesc{
+				Service: ecs.ServiceStatus{
+					DesiredCount:     1,
+					RunningCount:     1,
+					Status:           "ACTIVE",
+					LastDeploymentAt: startTime,
+					TaskDefinition:   "mockTaskDefinition",
+				},
+				Probes: []probe.Result{
+					{
+						URL:        "https://frontend.test.phonetool.com",
+						StatusCode: 200,
+						LatencyMs:  42,
+					},
+					{
+						URL:       "https://frontend.test.phonetool.com/healthz",
+						LatencyMs: 10,
+						Error:     "x509: certificate has expired",
+					},
+				},
+				Targets: []*elbv2.TargetHealth{
+					{
+						Target: elbv2.Target{ID: "10.0.0.12", Port: 80},
+						State:  "healthy",
+					},
+					{
+						Target:      elbv2.Target{ID: "10.0.1.34", Port: 80},
+						State:       "unhealthy",
+						Reason:      "Target.Timeout",
+						Description: "Request timed out",
+					},
+				},
+			},
+			human: `Service Status
+
+  ACTIVE 1 / 1 running tasks (0 pending)
+
+Last Deployment
+
+  Updated At        14 years ago
+  Task Definition   mockTaskDefinition
+
+Task Status
+
+  ID                Image Digest        Last Status         Health Status       Started At          Stopped At
+
+Alarms
+
+  Name              Health              Last Updated        Reason
+
+Endpoint Probes
+
+  URL                                          Status                         Latency
+  https://frontend.test.phonetool.com          200                            42ms
+  https://frontend.test.phonetool.com/healthz  x509: certificate has expired  10ms
+
+Target Health
+
+  Target            Port                State               Reason
+  10.0.0.12         80                  healthy             -
+  10.0.1.34         80                  unhealthy           Request timed out
+`,
+			json: "{\"Service\":{\"desiredCount\":1,\"runningCount\":1,\"status\":\"ACTIVE\",\"lastDeploymentAt\":\"2006-01-02T15:04:05Z\",\"taskDefinition\":\"mockTaskDefinition\"},\"tasks\":null,\"alarms\":null,\"probes\":[{\"url\":\"https://frontend.test.phonetool.com\",\"statusCode\":200,\"latencyMs\":42},{\"url\":\"https://frontend.test.phonetool.com/healthz\",\"latencyMs\":10,\"error\":\"x509: certificate has expired\"}],\"targets\":[{\"id\":\"10.0.0.12\",\"port\":80,\"state\":\"healthy\"},{\"id\":\"10.0.1.34\",\"port\":80,\"state\":\"unhealthy\",\"reason\":\"Target.Timeout\",\"description\":\"Request timed out\"}]}\n",
+		},
+		"with autoscaling": {
+			desc: &ServiceStatusDesc{
+				Service: ecs.ServiceStatus{
+					DesiredCount:     3,
+					RunningCount:     3,
+					Status:           "ACTIVE",
+					LastDeploymentAt: startTime,
+					TaskDefinition:   "mockTaskDefinition",
+				},
+				Autoscaling: &applicationautoscaling.ServiceScaling{
+					MinCapacity: 1,
+					MaxCapacity: 10,
+					LastActivity: &applicationautoscaling.ScalingActivity{
+						Description: "Setting desired count to 3.",
+						Cause:       "monitor alarm AlarmHigh in state ALARM triggered policy cpu",
+						Status:      "Successful",
+						StartTime:   updateTime,
+					},
+				},
+			},
+			human: `Service Status
+
+  ACTIVE 3 / 3 running tasks (0 pending)
+
+Autoscaling
+
+  Desired Count     3 (min 1, max 10)
+  Last Activity     2 months from now   Setting desired count to 3.
+
+Last Deployment
+
+  Updated At        14 years ago
+  Task Definition   mockTaskDefinition
+
+Task Status
+
+  ID                Image Digest        Last Status         Health Status       Started At          Stopped At
+
+Alarms
+
+  Name              Health              Last Updated        Reason
+`,
+			json: "{\"Service\":{\"desiredCount\":3,\"runningCount\":3,\"status\":\"ACTIVE\",\"lastDeploymentAt\":\"2006-01-02T15:04:05Z\",\"taskDefinition\":\"mockTaskDefinition\"},\"tasks\":null,\"alarms\":null,\"autoscaling\":{\"minCapacity\":1,\"maxCapacity\":10,\"lastActivity\":{\"description\":\"Setting desired count to 3.\",\"cause\":\"monitor alarm AlarmHigh in state ALARM triggered policy cpu\",\"status\":\"Successful\",\"startTime\":\"2020-03-13T19:50:30Z\"}}}\n",
+		},
+		"with stopped tasks": {
+			desc: &ServiceStatusDesc{
+				Service: ecs.ServiceStatus{
+					DesiredCount:     1,
+					RunningCount:     0,
+					Status:           "ACTIVE",
+					LastDeploymentAt: startTime,
+					TaskDefinition:   "mockTaskDefinition",
+				},
+				StoppedTasks: []ecs.TaskStatus{
+					{
+						ID:            "1234567890123456789",
+						LastStatus:    "STOPPED",
+						StoppedAt:     stopTime,
+						StoppedReason: "Essential container in task exited",
+						ExitCodes: map[string]int64{
+							"sidecar":  0,
+							"frontend": 137,
+						},
+					},
+					{
+						ID:            "abcdefghijklmnopq",
+						LastStatus:    "STOPPED",
+						StoppedAt:     startTime,
+						StoppedReason: "Task failed ELB health checks",
+					},
+				},
+			},
+			human: `Service Status
+
+  ACTIVE 0 / 1 running tasks (1 pending)
+
+Last Deployment
+
+  Updated At        14 years ago
+  Task Definition   mockTaskDefinition
+
+Task Status
+
+  ID                Image Digest        Last Status         Health Status       Started At          Stopped At
+
+Stopped Tasks
+
+  ID                Stopped At          Exit Codes                 Reason
+  12345678          14 years ago        frontend: 137, sidecar: 0  Essential container in task exited
+  abcdefgh          14 years ago        -                          Task failed ELB health checks
+
+Alarms
+
+  Name              Health              Last Updated        Reason
+`,
+			json: "{\"Service\":{\"desiredCount\":1,\"runningCount\":0,\"status\":\"ACTIVE\",\"lastDeploymentAt\":\"2006-01-02T15:04:05Z\",\"taskDefinition\":\"mockTaskDefinition\"},\"tasks\":null,\"alarms\":null,\"stoppedTasks\":[{\"health\":\"\",\"id\":\"1234567890123456789\",\"images\":null,\"lastStatus\":\"STOPPED\",\"startedAt\":\"0001-01-01T00:00:00Z\",\"stoppedAt\":\"2006-01-02T16:04:05Z\",\"stoppedReason\":\"Essential container in task exited\",\"exitCodes\":{\"frontend\":137,\"sidecar\":0}},{\"health\":\"\",\"id\":\"abcdefghijklmnopq\",\"images\":null,\"lastStatus\":\"STOPPED\",\"startedAt\":\"0001-01-01T00:00:00Z\",\"stoppedAt\":\"2006-01-02T15:04:05Z\",\"stoppedReason\":\"Task failed ELB health checks\"}]}\n",
+		},
+		"with containers": {
+			desc: &ServiceStatusDesc{
+				Service: ecs.ServiceStatus{
+					DesiredCount:     1,
+					RunningCount:     1,
+					Status:           "ACTIVE",
+					LastDeploymentAt: startTime,
+					TaskDefinition:   "mockTaskDefinition",
+				},
+				Tasks: []ecs.TaskStatus{
+					{
+						ID:               "1234567890123456789",
+						LastStatus:       "RUNNING",
+						Health:           "HEALTHY",
+						CapacityProvider: "FARGATE_SPOT",
+						Containers: []ecs.ContainerStatus{
+							{
+								Name:           "frontend",
+								LastStatus:     "RUNNING",
+								Health:         "HEALTHY",
+								CPUUtilized:    aws.Float64(12.4),
+								MemoryUtilized: aws.Float64(256),
+							},
+							{
+								Name:       "sidecar",
+								LastStatus: "RUNNING",
+							},
+						},
+					},
+				},
+			},
+			human: `Service Status
+
+  ACTIVE 1 / 1 running tasks (0 pending)
+  1 running on Fargate Spot
+
+Last Deployment
+
+  Updated At        14 years ago
+  Task Definition   mockTaskDefinition
+
+Task Status
+
+  ID                Image Digest        Last Status         Health Status       Started At          Stopped At
+  12345678          -                   RUNNING             HEALTHY             -                   -
+
+Containers
+
+  Task              Capacity Provider   Name                Last Status         Health Status       CPU                 Memory
+  12345678          FARGATE_SPOT        frontend            RUNNING             HEALTHY             12 units            256 MiB
+  12345678          FARGATE_SPOT        sidecar             RUNNING             -                   -                   -
+
+Alarms
+
+  Name              Health              Last Updated        Reason
+`,
+			json: "{\"Service\":{\"desiredCount\":1,\"runningCount\":1,\"status\":\"ACTIVE\",\"lastDeploymentAt\":\"2006-01-02T15:04:05Z\",\"taskDefinition\":\"mockTaskDefinition\"},\"tasks\":[{\"health\":\"HEALTHY\",\"id\":\"1234567890123456789\",\"images\":null,\"lastStatus\":\"RUNNING\",\"startedAt\":\"0001-01-01T00:00:00Z\",\"stoppedAt\":\"0001-01-01T00:00:00Z\",\"stoppedReason\":\"\",\"capacityProvider\":\"FARGATE_SPOT\",\"containers\":[{\"name\":\"frontend\",\"lastStatus\":\"RUNNING\",\"health\":\"HEALTHY\",\"cpuUtilized\":12.4,\"memoryUtilized\":256},{\"name\":\"sidecar\",\"lastStatus\":\"RUNNING\",\"health\":\"\"}]}],\"alarms\":null}\n",
+		},
+		"with alarm history": {
+			desc: &ServiceStatusDesc{
+				Service: ecs.ServiceStatus{
+					DesiredCount:     1,
+					RunningCount:     1,
+					Status:           "ACTIVE",
+					LastDeploymentAt: startTime,
+					TaskDefinition:   "mockTaskDefinition",
+				},
+				AlarmHistory: []cloudwatch.AlarmHistoryItem{
+					{
+						Name:      "mockAlarm",
+						OldState:  "ALARM",
+						NewState:  "OK",
+						Reason:    "Threshold Crossed",
+						Timestamp: stopTime,
+					},
+					{
+						Name:      "mockAlarm",
+						OldState:  "OK",
+						NewState:  "ALARM",
+						Reason:    "Threshold Crossed",
+						Timestamp: startTime,
+					},
+					{
+						Name:      "mockOtherAlarm",
+						OldState:  "INSUFFICIENT_DATA",
+						NewState:  "OK",
+						Reason:    "Threshold Crossed",
+						Timestamp: startTime,
+					},
+				},
+			},
+			human: `Service Status
+
+  ACTIVE 1 / 1 running tasks (0 pending)
+
+Last Deployment
+
+  Updated At        14 years ago
+  Task Definition   mockTaskDefinition
+
+Task Status
+
+  ID                Image Digest        Last Status         Health Status       Started At          Stopped At
+
+Alarms
+
+  Name              Health              Last Updated        Reason
+
+Alarm History
+
+  mockAlarm
+    14 years ago    ALARM -> OK         Threshold Crossed
+    14 years ago    OK -> ALARM         Threshold Crossed
+
+  mockOtherAlarm
+    14 years ago    INSUFFICIENT_DATA -> OK  Threshold Crossed
+`,
+			json: "{\"Service\":{\"desiredCount\":1,\"runningCount\":1,\"status\":\"ACTIVE\",\"lastDeploymentAt\":\"2006-01-02T15:04:05Z\",\"taskDefinition\":\"mockTaskDefinition\"},\"tasks\":null,\"alarms\":null,\"alarmHistory\":[{\"name\":\"mockAlarm\",\"oldState\":\"ALARM\",\"newState\":\"OK\",\"reason\":\"Threshold Crossed\",\"timestamp\":\"2006-01-02T16:04:05Z\"},{\"name\":\"mockAlarm\",\"oldState\":\"OK\",\"newState\":\"ALARM\",\"reason\":\"Threshold Crossed\",\"timestamp\":\"2006-01-02T15:04:05Z\"},{\"name\":\"mockOtherAlarm\",\"oldState\":\"INSUFFICIENT_DATA\",\"newState\":\"OK\",\"reason\":\"Threshold Crossed\",\"timestamp\":\"2006-01-02T15:04:05Z\"}]}\n",
+		},
 	}
 
 	for name, tc := range testCases {
@@ -419,3 +1338,107 @@ Alarms
 		})
 	}
 }
+
+func TestServiceStatusDesc_Issues(t *testing.T) {
+	now, _ := time.Parse(time.RFC3339, "2020-01-01T01:00:00+00:00")
+	deployedAt, _ := time.Parse(time.RFC3339, "2020-01-01T00:00:00+00:00")
+	testCases := map[string]struct {
+		desc *ServiceStatusDesc
+
+		wantedIssues []string
+	}{
+		"healthy service": {
+			desc: &ServiceStatusDesc{
+				Service: ecs.ServiceStatus{
+					Status:           "ACTIVE",
+					DesiredCount:     2,
+					RunningCount:     2,
+					LastDeploymentAt: deployedAt,
+				},
+				Alarms: []cloudwatch.AlarmStatus{
+					{Name: "mockAlarm", Status: "OK"},
+				},
+			},
+		},
+		"deployment in progress within the timeout": {
+			desc: &ServiceStatusDesc{
+				Service: ecs.ServiceStatus{
+					Status:            "ACTIVE",
+					DesiredCount:      2,
+					RunningCount:      2,
+					LastDeploymentAt:  now.Add(-10 * time.Minute),
+					ActiveDeployments: 1,
+				},
+			},
+		},
+		"unhealthy service": {
+			desc: &ServiceStatusDesc{
+				Service: ecs.ServiceStatus{
+					Status:            "DRAINING",
+					DesiredCount:      2,
+					RunningCount:      1,
+					LastDeploymentAt:  deployedAt,
+					ActiveDeployments: 1,
+				},
+				Alarms: []cloudwatch.AlarmStatus{
+					{Name: "mockAlarm", Status: "ALARM"},
+					{Name: "mockOtherAlarm", Status: "INSUFFICIENT_DATA"},
+				},
+			},
+
+			wantedIssues: []string{
+				"service is DRAINING",
+				"1/2 running tasks",
+				"deployment in progress for 1h0m0s",
+				"alarm mockAlarm is in ALARM",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			issues := tc.desc.Issues(now)
+
+			// THEN
+			require.Equal(t, tc.wantedIssues, issues)
+		})
+	}
+}
+
+func TestServiceStatusDesc_PackedAZ(t *testing.T) {
+	testCases := map[string]struct {
+		tasks []ecs.TaskStatus
+
+		wantedAZ string
+	}{
+		"no tasks": {},
+		"single task": {
+			tasks: []ecs.TaskStatus{
+				{LastStatus: "RUNNING", AvailabilityZone: "us-west-2a"},
+			},
+		},
+		"tasks spread across zones": {
+			tasks: []ecs.TaskStatus{
+				{LastStatus: "RUNNING", AvailabilityZone: "us-west-2a"},
+				{LastStatus: "RUNNING", AvailabilityZone: "us-west-2b"},
+			},
+		},
+		"tasks packed in one zone": {
+			tasks: []ecs.TaskStatus{
+				{LastStatus: "RUNNING", AvailabilityZone: "us-west-2a"},
+				{LastStatus: "RUNNING", AvailabilityZone: "us-west-2a"},
+				{LastStatus: "PROVISIONING", AvailabilityZone: "us-west-2b"},
+			},
+			wantedAZ: "us-west-2a",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			desc := &ServiceStatusDesc{Tasks: tc.tasks}
+
+			require.Equal(t, tc.wantedAZ, desc.PackedAZ())
+		})
+	}
+}
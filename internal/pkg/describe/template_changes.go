@@ -0,0 +1,335 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"gopkg.in/yaml.v3"
+)
+
+// Categories of a template change, from the least to the most impactful.
+const (
+	// TemplateChangeBenign is a resource that is created, or updated without interrupting the service.
+	TemplateChangeBenign = "benign"
+	// TemplateChangeDisruptive is a resource that is deleted, or updated in a way that rolls out new tasks or changes how traffic is routed.
+	TemplateChangeDisruptive = "disruptive"
+	// TemplateChangeReplacement is a resource that CloudFormation replaces with a new one, which gets a new physical ID.
+	TemplateChangeReplacement = "replacement"
+)
+
+// Actions that CloudFormation takes on a resource of a template change.
+const (
+	TemplateChangeAdd    = "Add"
+	TemplateChangeModify = "Modify"
+	TemplateChangeRemove = "Remove"
+)
+
+var templateChangeSeverity = map[string]int{
+	TemplateChangeBenign:      0,
+	TemplateChangeDisruptive:  1,
+	TemplateChangeReplacement: 2,
+}
+
+// replacementProperties are the properties of the resources created by Copilot that can't be updated in place.
+// See https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/aws-template-resource-type-ref.html
+var replacementProperties = map[string]map[string]bool{
+	"AWS::ECS::Service": {
+		"Cluster":              true,
+		"DeploymentController": true,
+		"LaunchType":           true,
+		"LoadBalancers":        true,
+		"Role":                 true,
+		"SchedulingStrategy":   true,
+		"ServiceName":          true,
+		"ServiceRegistries":    true,
+	},
+	"AWS::ElasticLoadBalancingV2::TargetGroup": {
+		"Name":       true,
+		"Port":       true,
+		"Protocol":   true,
+		"TargetType": true,
+		"VpcId":      true,
+	},
+	"AWS::ElasticLoadBalancingV2::ListenerRule": {
+		"ListenerArn": true,
+	},
+	"AWS::Logs::LogGroup": {
+		"LogGroupName": true,
+	},
+	"AWS::IAM::Role": {
+		"Path":     true,
+		"RoleName": true,
+	},
+	"AWS::ServiceDiscovery::Service": {
+		"Name":        true,
+		"NamespaceId": true,
+	},
+	"AWS::Lambda::Function": {
+		"FunctionName": true,
+	},
+	"AWS::ApplicationAutoScaling::ScalableTarget": {
+		"ResourceId":        true,
+		"ScalableDimension": true,
+		"ServiceNamespace":  true,
+	},
+	"AWS::ApplicationAutoScaling::ScalingPolicy": {
+		"PolicyName":        true,
+		"ResourceId":        true,
+		"ScalableDimension": true,
+		"ServiceNamespace":  true,
+	},
+}
+
+// disruptiveResourceTypes are the types of the resources whose updates interrupt the service.
+// An update to the task definition registers a new revision, which replaces all the running tasks.
+var disruptiveResourceTypes = map[string]bool{
+	"AWS::ECS::TaskDefinition":                  true,
+	"AWS::ECS::Service":                         true,
+	"AWS::ElasticLoadBalancingV2::ListenerRule": true,
+	"AWS::ElasticLoadBalancingV2::TargetGroup":  true,
+	"AWS::ServiceDiscovery::Service":            true,
+}
+
+// benignProperties are the properties whose updates never interrupt the service.
+var benignProperties = map[string]bool{
+	"Tags": true,
+}
+
+// TemplateChange is a resource that differs between two CloudFormation templates of a stack.
+type TemplateChange struct {
+	LogicalID string `json:"logicalID"`
+	Type      string `json:"type"`
+	Action    string `json:"action"`
+	Category  string `json:"category"`
+	// Properties are the properties, or resource attributes such as DependsOn, that are modified.
+	Properties []string `json:"properties,omitempty"`
+}
+
+type cfnResource struct {
+	Type       string
+	Properties map[string]*yaml.Node
+	Attributes map[string]*yaml.Node
+}
+
+// CompareTemplates returns the resources that differ between the deployed and the rendered CloudFormation templates,
+// from the most to the least impactful change, then in alphabetical order of logical ID.
+func CompareTemplates(deployed, rendered string) ([]*TemplateChange, error) {
+	from, err := templateResources(deployed)
+	if err != nil {
+		return nil, fmt.Errorf("parse deployed template: %w", err)
+	}
+	to, err := templateResources(rendered)
+	if err != nil {
+		return nil, fmt.Errorf("parse rendered template: %w", err)
+	}
+	var changes []*TemplateChange
+	for id, old := range from {
+		resource, ok := to[id]
+		if !ok {
+			changes = append(changes, &TemplateChange{
+				LogicalID: id,
+				Type:      old.Type,
+				Action:    TemplateChangeRemove,
+				Category:  TemplateChangeDisruptive,
+			})
+			continue
+		}
+		if change := compareResources(id, old, resource); change != nil {
+			changes = append(changes, change)
+		}
+	}
+	for id, resource := range to {
+		if _, ok := from[id]; !ok {
+			changes = append(changes, &TemplateChange{
+				LogicalID: id,
+				Type:      resource.Type,
+				Action:    TemplateChangeAdd,
+				Category:  TemplateChangeBenign,
+			})
+		}
+	}
+	sort.SliceStable(changes, func(i, j int) bool {
+		if changes[i].Category != changes[j].Category {
+			return templateChangeSeverity[changes[i].Category] > templateChangeSeverity[changes[j].Category]
+		}
+		return changes[i].LogicalID < changes[j].LogicalID
+	})
+	return changes, nil
+}
+
+// compareResources returns the change between the two versions of a resource, or nil if they're the same.
+func compareResources(id string, from, to *cfnResource) *TemplateChange {
+	change := &TemplateChange{
+		LogicalID: id,
+		Type:      to.Type,
+		Action:    TemplateChangeModify,
+		Category:  TemplateChangeBenign,
+	}
+	if from.Type != to.Type {
+		change.Properties = []string{"Type"}
+		change.Category = TemplateChangeReplacement
+		return change
+	}
+	for _, name := range changedKeys(from.Properties, to.Properties) {
+		change.Properties = append(change.Properties, name)
+		category := TemplateChangeBenign
+		switch {
+		case replacementProperties[to.Type][name]:
+			category = TemplateChangeReplacement
+		case benignProperties[name]:
+		case disruptiveResourceTypes[to.Type]:
+			category = TemplateChangeDisruptive
+		}
+		if templateChangeSeverity[category] > templateChangeSeverity[change.Category] {
+			change.Category = category
+		}
+	}
+	// Resource attributes such as DependsOn or DeletionPolicy only change how CloudFormation manages the resource.
+	change.Properties = append(change.Properties, changedKeys(from.Attributes, to.Attributes)...)
+	if len(change.Properties) == 0 {
+		return nil
+	}
+	return change
+}
+
+// changedKeys returns the keys, in alphabetical order, whose values differ between the two mappings.
+func changedKeys(from, to map[string]*yaml.Node) []string {
+	var keys []string
+	for key, val := range from {
+		if other, ok := to[key]; !ok || !isSameNode(val, other) {
+			keys = append(keys, key)
+		}
+	}
+	for key := range to {
+		if _, ok := from[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// isSameNode returns true if the two nodes have the same values, regardless of their style or of the
+// resolved type of their scalars since CloudFormation treats 80 and "80" the same.
+// Intrinsic functions in their short form, such as !Ref, are compared by their tags.
+func isSameNode(a, b *yaml.Node) bool {
+	if a.Kind == yaml.AliasNode {
+		a = a.Alias
+	}
+	if b.Kind == yaml.AliasNode {
+		b = b.Alias
+	}
+	if a.Kind != b.Kind || a.Value != b.Value || len(a.Content) != len(b.Content) {
+		return false
+	}
+	if isIntrinsicTag(a.Tag) || isIntrinsicTag(b.Tag) {
+		if a.Tag != b.Tag {
+			return false
+		}
+	}
+	for i := range a.Content {
+		if !isSameNode(a.Content[i], b.Content[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// isIntrinsicTag returns true if the tag is a local tag such as !Ref, instead of a tag resolved by the YAML parser.
+func isIntrinsicTag(tag string) bool {
+	return strings.HasPrefix(tag, "!") && !strings.HasPrefix(tag, "!!")
+}
+
+// templateResources returns the resources of a CloudFormation template keyed by logical ID.
+func templateResources(template string) (map[string]*cfnResource, error) {
+	var tpl struct {
+		Resources map[string]map[string]yaml.Node `yaml:"Resources"`
+	}
+	if err := yaml.Unmarshal([]byte(template), &tpl); err != nil {
+		return nil, err
+	}
+	resources := make(map[string]*cfnResource)
+	for id, fields := range tpl.Resources {
+		resource := &cfnResource{
+			Properties: make(map[string]*yaml.Node),
+			Attributes: make(map[string]*yaml.Node),
+		}
+		for name, val := range fields {
+			val := val
+			switch name {
+			case "Type":
+				resource.Type = val.Value
+			case "Properties":
+				for i := 0; i+1 < len(val.Content); i += 2 {
+					resource.Properties[val.Content[i].Value] = val.Content[i+1]
+				}
+			default:
+				resource.Attributes[name] = &val
+			}
+		}
+		resources[id] = resource
+	}
+	return resources, nil
+}
+
+// TemplateChanges contains the resources of a service stack that change when it's deployed with the current template.
+type TemplateChanges struct {
+	Service     string            `json:"service"`
+	Environment string            `json:"environment"`
+	Changes     []*TemplateChange `json:"changes"`
+}
+
+// JSONString returns the stringified TemplateChanges struct with json format.
+func (t *TemplateChanges) JSONString() (string, error) {
+	b, err := json.Marshal(t)
+	if err != nil {
+		return "", fmt.Errorf("marshal template changes: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the stringified TemplateChanges struct with human readable format.
+func (t *TemplateChanges) HumanString() string {
+	if len(t.Changes) == 0 {
+		return fmt.Sprintf("The template of service %s in environment %s doesn't change with this version of Copilot.\n", t.Service, t.Environment)
+	}
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	for _, section := range []struct {
+		title    string
+		category string
+	}{
+		{"Replacement", TemplateChangeReplacement},
+		{"Disruptive", TemplateChangeDisruptive},
+		{"Benign", TemplateChangeBenign},
+	} {
+		var changes []*TemplateChange
+		for _, change := range t.Changes {
+			if change.Category == section.category {
+				changes = append(changes, change)
+			}
+		}
+		if len(changes) == 0 {
+			continue
+		}
+		if b.Len() != 0 {
+			fmt.Fprint(writer, "\n")
+		}
+		fmt.Fprintf(writer, color.Bold.Sprintf("%s\n\n", section.title))
+		writer.Flush()
+		fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\n", "Logical ID", "Type", "Action", "Properties")
+		for _, change := range changes {
+			fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\n", change.LogicalID, change.Type, change.Action, dashIfEmpty(strings.Join(change.Properties, ", ")))
+		}
+		writer.Flush()
+	}
+	return b.String()
+}
@@ -0,0 +1,228 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompareTemplates(t *testing.T) {
+	deployed := `Resources:
+  LogGroup:
+    Type: AWS::Logs::LogGroup
+    Properties:
+      LogGroupName: !Sub /copilot/${AppName}-${EnvName}-${WorkloadName}
+      RetentionInDays: 30
+  TaskDefinition:
+    Type: AWS::ECS::TaskDefinition
+    Properties:
+      Cpu: !Ref TaskCPU
+      Memory: !Ref TaskMemory
+  TargetGroup:
+    Type: AWS::ElasticLoadBalancingV2::TargetGroup
+    Properties:
+      Port: 80
+      Tags:
+        - Key: copilot-service
+          Value: api
+  ExecutionRole:
+    Type: AWS::IAM::Role
+    Properties:
+      Path: /
+  DiscoveryService:
+    Type: AWS::ServiceDiscovery::Service
+    Properties:
+      Name: api
+`
+	testCases := map[string]struct {
+		inDeployed string
+		inRendered string
+
+		wantedChanges []*TemplateChange
+		wantedErr     string
+	}{
+		"errors if the deployed template is malformed": {
+			inDeployed: "Resources: [",
+			inRendered: deployed,
+			wantedErr:  "parse deployed template: yaml: line 1: did not find expected node content",
+		},
+		"returns nothing if only the style of the values changes": {
+			inDeployed: deployed,
+			inRendered: `Resources:
+  DiscoveryService:
+    Type: AWS::ServiceDiscovery::Service
+    Properties:
+      Name: "api"
+  ExecutionRole:
+    Type: AWS::IAM::Role
+    Properties:
+      Path: '/'
+  LogGroup:
+    Type: AWS::Logs::LogGroup
+    Properties:
+      RetentionInDays: "30"
+      LogGroupName: !Sub '/copilot/${AppName}-${EnvName}-${WorkloadName}'
+  TargetGroup:
+    Type: AWS::ElasticLoadBalancingV2::TargetGroup
+    Properties:
+      Port: "80"
+      Tags:
+        - Key: copilot-service
+          Value: api
+  TaskDefinition:
+    Type: AWS::ECS::TaskDefinition
+    Properties:
+      Cpu: !Ref TaskCPU
+      Memory: !Ref TaskMemory
+`,
+		},
+		"categorizes the changes by their impact": {
+			inDeployed: deployed,
+			inRendered: `Resources:
+  LogGroup:
+    Type: AWS::Logs::LogGroup
+    Properties:
+      LogGroupName: !Sub /copilot/${AppName}-${EnvName}-${WorkloadName}
+      RetentionInDays: !Ref LogRetention
+  TaskDefinition:
+    Type: AWS::ECS::TaskDefinition
+    DependsOn: LogGroup
+    Properties:
+      Cpu: !GetAtt TaskCPU
+      Memory: !Ref TaskMemory
+  TargetGroup:
+    Type: AWS::ElasticLoadBalancingV2::TargetGroup
+    Properties:
+      Port: 8080
+      Tags:
+        - Key: copilot-service
+          Value: frontend
+  ExecutionRole:
+    Type: AWS::IAM::Role
+    Properties:
+      Path: /
+  EnvControllerAction:
+    Type: Custom::EnvControllerFunction
+`,
+			wantedChanges: []*TemplateChange{
+				{
+					LogicalID:  "TargetGroup",
+					Type:       "AWS::ElasticLoadBalancingV2::TargetGroup",
+					Action:     TemplateChangeModify,
+					Category:   TemplateChangeReplacement,
+					Properties: []string{"Port", "Tags"},
+				},
+				{
+					LogicalID: "DiscoveryService",
+					Type:      "AWS::ServiceDiscovery::Service",
+					Action:    TemplateChangeRemove,
+					Category:  TemplateChangeDisruptive,
+				},
+				{
+					LogicalID:  "TaskDefinition",
+					Type:       "AWS::ECS::TaskDefinition",
+					Action:     TemplateChangeModify,
+					Category:   TemplateChangeDisruptive,
+					Properties: []string{"Cpu", "DependsOn"},
+				},
+				{
+					LogicalID: "EnvControllerAction",
+					Type:      "Custom::EnvControllerFunction",
+					Action:    TemplateChangeAdd,
+					Category:  TemplateChangeBenign,
+				},
+				{
+					LogicalID:  "LogGroup",
+					Type:       "AWS::Logs::LogGroup",
+					Action:     TemplateChangeModify,
+					Category:   TemplateChangeBenign,
+					Properties: []string{"RetentionInDays"},
+				},
+			},
+		},
+		"replaces a resource whose type changes": {
+			inDeployed: `Resources:
+  Queue:
+    Type: AWS::SQS::Queue
+`,
+			inRendered: `Resources:
+  Queue:
+    Type: AWS::SNS::Topic
+`,
+			wantedChanges: []*TemplateChange{
+				{
+					LogicalID:  "Queue",
+					Type:       "AWS::SNS::Topic",
+					Action:     TemplateChangeModify,
+					Category:   TemplateChangeReplacement,
+					Properties: []string{"Type"},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			changes, err := CompareTemplates(tc.inDeployed, tc.inRendered)
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedChanges, changes)
+		})
+	}
+}
+
+func TestTemplateChanges_String(t *testing.T) {
+	testCases := map[string]struct {
+		inChanges *TemplateChanges
+
+		wantedHumanString string
+		wantedJSONString  string
+	}{
+		"no changes": {
+			inChanges: &TemplateChanges{
+				Service:     "api",
+				Environment: "prod",
+			},
+			wantedHumanString: "The template of service api in environment prod doesn't change with this version of Copilot.\n",
+			wantedJSONString:  "{\"service\":\"api\",\"environment\":\"prod\",\"changes\":null}\n",
+		},
+		"with changes": {
+			inChanges: &TemplateChanges{
+				Service:     "api",
+				Environment: "prod",
+				Changes: []*TemplateChange{
+					{LogicalID: "TargetGroup", Type: "AWS::ElasticLoadBalancingV2::TargetGroup", Action: TemplateChangeModify, Category: TemplateChangeReplacement, Properties: []string{"Port", "Tags"}},
+					{LogicalID: "EnvControllerAction", Type: "Custom::EnvControllerFunction", Action: TemplateChangeAdd, Category: TemplateChangeBenign},
+				},
+			},
+			wantedHumanString: `Replacement
+
+  Logical ID        Type                                      Action              Properties
+  TargetGroup       AWS::ElasticLoadBalancingV2::TargetGroup  Modify              Port, Tags
+
+Benign
+
+  Logical ID           Type                           Action              Properties
+  EnvControllerAction  Custom::EnvControllerFunction  Add                 -
+`,
+			wantedJSONString: "{\"service\":\"api\",\"environment\":\"prod\",\"changes\":[{\"logicalID\":\"TargetGroup\",\"type\":\"AWS::ElasticLoadBalancingV2::TargetGroup\",\"action\":\"Modify\",\"category\":\"replacement\",\"properties\":[\"Port\",\"Tags\"]},{\"logicalID\":\"EnvControllerAction\",\"type\":\"Custom::EnvControllerFunction\",\"action\":\"Add\",\"category\":\"benign\"}]}\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			human := tc.inChanges.HumanString()
+			json, _ := tc.inChanges.JSONString()
+
+			require.Equal(t, tc.wantedHumanString, human)
+			require.Equal(t, tc.wantedJSONString, json)
+		})
+	}
+}
@@ -5,14 +5,35 @@
 package docker
 
 import (
+	"bytes"
 	"fmt"
+	"io"
+	"os"
 	"path/filepath"
 	"sort"
 	"strings"
 
 	"github.com/aws/copilot-cli/internal/pkg/term/command"
+	"github.com/aws/copilot-cli/internal/pkg/trace"
 )
 
+// proxyEnvVars are the proxy settings forwarded to `docker build`. Docker accepts them as build arguments
+// without a matching ARG instruction in the Dockerfile.
+var proxyEnvVars = []string{"HTTP_PROXY", "http_proxy", "HTTPS_PROXY", "https_proxy", "NO_PROXY", "no_proxy"}
+
+var lookupEnv = os.LookupEnv
+
+// ErrUntrustedCertificate occurs when the Docker daemon doesn't trust the certificate presented by the registry,
+// typically because a corporate proxy intercepts TLS traffic.
+type ErrUntrustedCertificate struct {
+	Registry string
+}
+
+func (e *ErrUntrustedCertificate) Error() string {
+	return fmt.Sprintf(`docker does not trust the certificate presented by %s.
+If your network intercepts TLS traffic, add your proxy's CA certificate to the Docker daemon's trusted certificates, for example under /etc/docker/certs.d/%s/ca.crt`, e.Registry, e.Registry)
+}
+
 // Runner represents a command that can be run.
 type Runner struct {
 	runner
@@ -65,9 +86,21 @@ func (r Runner) Build(in *BuildArguments) error {
 		args = append(args, "--build-arg", fmt.Sprintf("%s=%s", k, in.Args[k]))
 	}
 
+	// Forward the proxy settings from the environment unless the manifest overrides them.
+	for _, k := range proxyEnvVars {
+		if _, ok := in.Args[k]; ok {
+			continue
+		}
+		if _, ok := lookupEnv(k); ok {
+			args = append(args, "--build-arg", k)
+		}
+	}
+
 	args = append(args, dfDir, "-f", in.Dockerfile)
 
+	span := trace.Start("docker build", trace.Attr("image", imageName(in.URI, in.ImageTag)))
 	err := r.Run("docker", args)
+	span.End(err)
 	if err != nil {
 		return fmt.Errorf("building image: %w", err)
 	}
@@ -77,12 +110,16 @@ func (r Runner) Build(in *BuildArguments) error {
 
 // Login will run a `docker login` command against the Service repository URI with the input uri and auth data.
 func (r Runner) Login(uri, username, password string) error {
+	stderr := &bytes.Buffer{}
+	span := trace.Start("docker login", trace.Attr("registry", uri))
 	err := r.Run("docker",
 		[]string{"login", "-u", username, "--password-stdin", uri},
-		command.Stdin(strings.NewReader(password)))
+		command.Stdin(strings.NewReader(password)),
+		command.Stderr(io.MultiWriter(os.Stderr, stderr)))
+	span.End(err)
 
 	if err != nil {
-		return fmt.Errorf("authenticate to ECR: %w", err)
+		return fmt.Errorf("authenticate to ECR: %w", certificateErr(uri, stderr.String(), err))
 	}
 
 	return nil
@@ -93,15 +130,29 @@ func (r Runner) Push(uri, imageTag string, additionalTags ...string) error {
 	for _, imageTag := range append(additionalTags, imageTag) {
 		path := imageName(uri, imageTag)
 
-		err := r.Run("docker", []string{"push", path})
+		stderr := &bytes.Buffer{}
+		span := trace.Start("docker push", trace.Attr("image", path))
+		err := r.Run("docker", []string{"push", path}, command.Stderr(io.MultiWriter(os.Stderr, stderr)))
+		span.End(err)
 		if err != nil {
-			return fmt.Errorf("docker push %s: %w", path, err)
+			return fmt.Errorf("docker push %s: %w", path, certificateErr(uri, stderr.String(), err))
 		}
 	}
 
 	return nil
 }
 
+// certificateErr returns an ErrUntrustedCertificate if the docker output reports a certificate verification failure,
+// otherwise it returns the original error.
+func certificateErr(uri, output string, err error) error {
+	if !strings.Contains(output, "x509:") {
+		return err
+	}
+	return &ErrUntrustedCertificate{
+		Registry: strings.SplitN(uri, "/", 2)[0],
+	}
+}
+
 func imageName(uri, tag string) string {
 	return fmt.Sprintf("%s:%s", uri, tag)
 }
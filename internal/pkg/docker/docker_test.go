@@ -5,9 +5,11 @@ package docker
 import (
 	"errors"
 	"fmt"
+	"os/exec"
 	"testing"
 
 	"github.com/aws/copilot-cli/internal/pkg/docker/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/term/command"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
@@ -30,6 +32,7 @@ func TestBuild(t *testing.T) {
 		context        string
 		additionalTags []string
 		args           map[string]string
+		env            map[string]string
 		setupMocks     func(controller *gomock.Controller)
 
 		wantedError error
@@ -102,12 +105,34 @@ func TestBuild(t *testing.T) {
 					"mockPath/to", "-f", "mockPath/to/mockDockerfile"}).Return(nil)
 			},
 		},
+		"forwards proxy settings that are not overridden": {
+			path: mockPath,
+			args: map[string]string{
+				"NO_PROXY": "localhost",
+			},
+			env: map[string]string{
+				"HTTPS_PROXY": "http://proxy.example.com:3128",
+				"NO_PROXY":    "169.254.169.254",
+			},
+			setupMocks: func(c *gomock.Controller) {
+				mockRunner = mocks.NewMockrunner(c)
+				mockRunner.EXPECT().Run("docker", []string{"build",
+					"-t", mockURI + ":" + mockTag1,
+					"--build-arg", "NO_PROXY=localhost",
+					"--build-arg", "HTTPS_PROXY",
+					"mockPath/to", "-f", "mockPath/to/mockDockerfile"}).Return(nil)
+			},
+		},
 	}
 
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
 			controller := gomock.NewController(t)
 			tc.setupMocks(controller)
+			lookupEnv = func(key string) (string, bool) {
+				v, ok := tc.env[key]
+				return v, ok
+			}
 			s := Runner{
 				runner: mockRunner,
 			}
@@ -189,6 +214,7 @@ func TestPush(t *testing.T) {
 
 	tests := map[string]struct {
 		setupMocks func(controller *gomock.Controller)
+		inURI      string
 
 		want error
 	}{
@@ -196,17 +222,36 @@ func TestPush(t *testing.T) {
 			setupMocks: func(controller *gomock.Controller) {
 				mockRunner = mocks.NewMockrunner(controller)
 
-				mockRunner.EXPECT().Run("docker", []string{"push", mockURI + ":" + mockTag1}).Return(mockError).Times(1)
-				mockRunner.EXPECT().Run("docker", []string{"push", mockURI + ":" + mockTag2}).Times(0)
+				mockRunner.EXPECT().Run("docker", []string{"push", mockURI + ":" + mockTag1}, gomock.Any()).Return(mockError).Times(1)
+				mockRunner.EXPECT().Run("docker", []string{"push", mockURI + ":" + mockTag2}, gomock.Any()).Times(0)
 			},
 			want: fmt.Errorf("docker push %s: %w", mockURI+":"+mockTag1, mockError),
 		},
+		"error if the registry certificate is not trusted": {
+			setupMocks: func(controller *gomock.Controller) {
+				mockRunner = mocks.NewMockrunner(controller)
+
+				mockRunner.EXPECT().Run("docker", []string{"push", "123456789012.dkr.ecr.us-west-2.amazonaws.com/app:" + mockTag1}, gomock.Any()).
+					DoAndReturn(func(name string, args []string, opts ...command.Option) error {
+						cmd := &exec.Cmd{}
+						for _, opt := range opts {
+							opt(cmd)
+						}
+						fmt.Fprint(cmd.Stderr, "x509: certificate signed by unknown authority")
+						return mockError
+					})
+			},
+			inURI: "123456789012.dkr.ecr.us-west-2.amazonaws.com/app",
+			want: fmt.Errorf("docker push %s: %w", "123456789012.dkr.ecr.us-west-2.amazonaws.com/app:"+mockTag1, &ErrUntrustedCertificate{
+				Registry: "123456789012.dkr.ecr.us-west-2.amazonaws.com",
+			}),
+		},
 		"success": {
 			setupMocks: func(controller *gomock.Controller) {
 				mockRunner = mocks.NewMockrunner(controller)
 
-				mockRunner.EXPECT().Run("docker", []string{"push", mockURI + ":" + mockTag1}).Return(nil)
-				mockRunner.EXPECT().Run("docker", []string{"push", mockURI + ":" + mockTag2}).Return(nil)
+				mockRunner.EXPECT().Run("docker", []string{"push", mockURI + ":" + mockTag1}, gomock.Any()).Return(nil)
+				mockRunner.EXPECT().Run("docker", []string{"push", mockURI + ":" + mockTag2}, gomock.Any()).Return(nil)
 			},
 			want: nil,
 		},
@@ -220,7 +265,11 @@ func TestPush(t *testing.T) {
 				runner: mockRunner,
 			}
 
-			got := s.Push(mockURI, mockTag2, mockTag1)
+			uri := mockURI
+			if test.inURI != "" {
+				uri = test.inURI
+			}
+			got := s.Push(uri, mockTag2, mockTag1)
 
 			require.Equal(t, test.want, got)
 		})
@@ -1,6 +1,6 @@
 // Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
 
-// Package dockerfile provides simple Dockerfile parsing functionality.
+// Package dockerfile provides simple Dockerfile parsing and generation functionality.
 package dockerfile
 
 import (
@@ -0,0 +1,295 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+
+package dockerfile
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"text/template"
+
+	"github.com/spf13/afero"
+)
+
+// Languages that starter Dockerfiles can be generated for.
+const (
+	LanguageGo     = "Go"
+	LanguageNode   = "Node.js"
+	LanguagePython = "Python"
+	LanguageJava   = "Java"
+	LanguageRuby   = "Ruby"
+)
+
+// Default versions of the language toolchains if the application doesn't pin one.
+const (
+	defaultGoVersion     = "1.15"
+	defaultNodeVersion   = "14"
+	defaultPythonVersion = "3.8"
+	defaultJavaVersion   = "11"
+	defaultRubyVersion   = "2.7"
+)
+
+var (
+	versionRegexp = regexp.MustCompile(`^\d+(\.\d+)*$`)
+
+	goModVersionRegexp   = regexp.MustCompile(`(?m)^go\s+(\S+)`)
+	pomJavaVersionRegexp = regexp.MustCompile(`<(?:java\.version|maven\.compiler\.source|maven\.compiler\.release)>\s*(?:1\.)?([\d.]+)\s*<`)
+	gemfileRubyRegexp    = regexp.MustCompile(`(?m)^\s*ruby\s+['"]([^'"]+)['"]`)
+)
+
+// StarterConfig holds the parameters of a generated Dockerfile.
+type StarterConfig struct {
+	Language string
+	Port     uint16   // Port the application listens on, no port is exposed if 0.
+	Cmd      []string // Command that starts the application, defaults to the conventional one of the language.
+}
+
+// starterData is the data used to render the template of a starter Dockerfile.
+type starterData struct {
+	Version string
+	Port    uint16
+	Cmd     string
+	Gradle  bool // Whether a Java application is built with Gradle instead of Maven.
+}
+
+type language struct {
+	tpl        *template.Template
+	defaultCmd []string
+	version    func(fs afero.Fs, dir string) (string, error)
+}
+
+var languages = map[string]language{
+	LanguageGo: {
+		tpl:        template.Must(template.New(LanguageGo).Parse(goStarterTemplate)),
+		defaultCmd: []string{"/app"},
+		version:    goVersion,
+	},
+	LanguageNode: {
+		tpl:        template.Must(template.New(LanguageNode).Parse(nodeStarterTemplate)),
+		defaultCmd: []string{"npm", "start"},
+		version: func(fs afero.Fs, dir string) (string, error) {
+			return versionFromFiles(fs, dir, defaultNodeVersion, "v", ".nvmrc", ".node-version")
+		},
+	},
+	LanguagePython: {
+		tpl:        template.Must(template.New(LanguagePython).Parse(pythonStarterTemplate)),
+		defaultCmd: []string{"python", "app.py"},
+		version: func(fs afero.Fs, dir string) (string, error) {
+			return versionFromFiles(fs, dir, defaultPythonVersion, "python-", ".python-version", "runtime.txt")
+		},
+	},
+	LanguageJava: {
+		tpl:        template.Must(template.New(LanguageJava).Parse(javaStarterTemplate)),
+		defaultCmd: []string{"java", "-jar", "/app/app.jar"},
+		version:    javaVersion,
+	},
+	LanguageRuby: {
+		tpl:        template.Must(template.New(LanguageRuby).Parse(rubyStarterTemplate)),
+		defaultCmd: []string{"bundle", "exec", "ruby", "app.rb"},
+		version:    rubyVersion,
+	},
+}
+
+// GenerateStarter returns the contents of a starter Dockerfile for the application in the directory.
+// The Dockerfile builds the application in a separate stage, and runs it as a non-root user in a small final image.
+// The version of the base images is read from the version files of the application, such as go.mod or .nvmrc.
+func GenerateStarter(fs afero.Fs, dir string, conf StarterConfig) (string, error) {
+	lang, ok := languages[conf.Language]
+	if !ok {
+		return "", fmt.Errorf("unsupported language %s", conf.Language)
+	}
+	version, err := lang.version(fs, dir)
+	if err != nil {
+		return "", err
+	}
+	cmd := conf.Cmd
+	if len(cmd) == 0 {
+		cmd = lang.defaultCmd
+	}
+	rawCmd, err := json.Marshal(cmd)
+	if err != nil {
+		return "", fmt.Errorf("marshal command: %w", err)
+	}
+	gradle := false
+	if conf.Language == LanguageJava {
+		pom, err := readFile(fs, dir, "pom.xml")
+		if err != nil {
+			return "", err
+		}
+		gradle = pom == ""
+	}
+	buf := new(bytes.Buffer)
+	if err := lang.tpl.Execute(buf, starterData{
+		Version: version,
+		Port:    conf.Port,
+		Cmd:     strings.ReplaceAll(string(rawCmd), `","`, `", "`),
+		Gradle:  gradle,
+	}); err != nil {
+		return "", fmt.Errorf("render %s Dockerfile: %w", conf.Language, err)
+	}
+	return buf.String(), nil
+}
+
+func goVersion(fs afero.Fs, dir string) (string, error) {
+	mod, err := readFile(fs, dir, "go.mod")
+	if err != nil {
+		return "", err
+	}
+	if m := goModVersionRegexp.FindStringSubmatch(mod); m != nil && versionRegexp.MatchString(m[1]) {
+		return m[1], nil
+	}
+	return defaultGoVersion, nil
+}
+
+func javaVersion(fs afero.Fs, dir string) (string, error) {
+	version, err := versionFromFiles(fs, dir, "", "", ".java-version")
+	if err != nil || version != "" {
+		return version, err
+	}
+	pom, err := readFile(fs, dir, "pom.xml")
+	if err != nil {
+		return "", err
+	}
+	if m := pomJavaVersionRegexp.FindStringSubmatch(pom); m != nil && versionRegexp.MatchString(m[1]) {
+		return m[1], nil
+	}
+	return defaultJavaVersion, nil
+}
+
+func rubyVersion(fs afero.Fs, dir string) (string, error) {
+	version, err := versionFromFiles(fs, dir, "", "ruby-", ".ruby-version")
+	if err != nil || version != "" {
+		return version, err
+	}
+	gemfile, err := readFile(fs, dir, "Gemfile")
+	if err != nil {
+		return "", err
+	}
+	if m := gemfileRubyRegexp.FindStringSubmatch(gemfile); m != nil && versionRegexp.MatchString(m[1]) {
+		return m[1], nil
+	}
+	return defaultRubyVersion, nil
+}
+
+// versionFromFiles returns the version pinned by the first of the files that exists, without the prefix.
+// If none of the files pins a version, the default version is returned.
+func versionFromFiles(fs afero.Fs, dir, defaultVersion, prefix string, names ...string) (string, error) {
+	for _, name := range names {
+		content, err := readFile(fs, dir, name)
+		if err != nil {
+			return "", err
+		}
+		version := strings.TrimPrefix(strings.TrimSpace(content), prefix)
+		if versionRegexp.MatchString(version) {
+			return version, nil
+		}
+	}
+	return defaultVersion, nil
+}
+
+// readFile returns the contents of the file in the directory, or an empty string if the file doesn't exist.
+func readFile(fs afero.Fs, dir, name string) (string, error) {
+	content, err := afero.ReadFile(fs, filepath.Join(dir, name))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", nil
+		}
+		return "", fmt.Errorf("read %s: %w", name, err)
+	}
+	return string(content), nil
+}
+
+const (
+	goStarterTemplate = `FROM golang:{{.Version}}-alpine AS build
+WORKDIR /src
+COPY go.* ./
+RUN go mod download
+COPY . .
+RUN CGO_ENABLED=0 go build -o /app .
+
+FROM gcr.io/distroless/static:nonroot
+COPY --from=build /app /app
+USER nonroot:nonroot
+{{- if .Port}}
+EXPOSE {{.Port}}
+{{- end}}
+CMD {{.Cmd}}
+`
+
+	nodeStarterTemplate = `FROM node:{{.Version}}-alpine AS build
+WORKDIR /app
+COPY package*.json ./
+RUN npm install --production
+
+FROM node:{{.Version}}-alpine
+WORKDIR /app
+COPY --from=build --chown=node:node /app/node_modules ./node_modules
+COPY --chown=node:node . .
+USER node
+{{- if .Port}}
+EXPOSE {{.Port}}
+{{- end}}
+CMD {{.Cmd}}
+`
+
+	pythonStarterTemplate = `FROM python:{{.Version}}-slim AS build
+COPY requirements.txt .
+RUN pip install --no-cache-dir --prefix=/install -r requirements.txt
+
+FROM python:{{.Version}}-slim
+WORKDIR /app
+COPY --from=build /install /usr/local
+RUN useradd --system app
+COPY --chown=app:app . .
+USER app
+{{- if .Port}}
+EXPOSE {{.Port}}
+{{- end}}
+CMD {{.Cmd}}
+`
+
+	javaStarterTemplate = `{{- if .Gradle -}}
+FROM gradle:6-jdk{{.Version}} AS build
+WORKDIR /src
+COPY . .
+RUN gradle bootJar --no-daemon && cp build/libs/*.jar /app.jar
+{{- else -}}
+FROM maven:3-openjdk-{{.Version}} AS build
+WORKDIR /src
+COPY pom.xml .
+RUN mvn -q dependency:go-offline
+COPY src ./src
+RUN mvn -q package -DskipTests && cp target/*.jar /app.jar
+{{- end}}
+
+FROM openjdk:{{.Version}}-jre-slim
+RUN useradd --system app
+COPY --from=build /app.jar /app/app.jar
+USER app
+{{- if .Port}}
+EXPOSE {{.Port}}
+{{- end}}
+CMD {{.Cmd}}
+`
+
+	rubyStarterTemplate = `FROM ruby:{{.Version}} AS build
+WORKDIR /app
+COPY Gemfile* ./
+RUN bundle config set --local without 'development test' && bundle install
+
+FROM ruby:{{.Version}}-slim
+WORKDIR /app
+COPY --from=build /usr/local/bundle /usr/local/bundle
+RUN useradd --system app
+COPY --chown=app:app . .
+USER app
+{{- if .Port}}
+EXPOSE {{.Port}}
+{{- end}}
+CMD {{.Cmd}}
+`
+)
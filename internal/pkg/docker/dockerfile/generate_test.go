@@ -0,0 +1,178 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+
+package dockerfile
+
+import (
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateStarter(t *testing.T) {
+	testCases := map[string]struct {
+		files map[string]string
+		conf  StarterConfig
+
+		wantedDockerfile string
+		wantedErr        string
+	}{
+		"Go with the version of go.mod": {
+			files: map[string]string{
+				"go.mod": "module example.com/api\n\ngo 1.14\n",
+			},
+			conf: StarterConfig{Language: LanguageGo, Port: 8080},
+			wantedDockerfile: `FROM golang:1.14-alpine AS build
+WORKDIR /src
+COPY go.* ./
+RUN go mod download
+COPY . .
+RUN CGO_ENABLED=0 go build -o /app .
+
+FROM gcr.io/distroless/static:nonroot
+COPY --from=build /app /app
+USER nonroot:nonroot
+EXPOSE 8080
+CMD ["/app"]
+`,
+		},
+		"Node.js with the version of .nvmrc and a custom command": {
+			files: map[string]string{
+				".nvmrc": "v12\n",
+			},
+			conf: StarterConfig{Language: LanguageNode, Cmd: []string{"node", "worker.js"}},
+			wantedDockerfile: `FROM node:12-alpine AS build
+WORKDIR /app
+COPY package*.json ./
+RUN npm install --production
+
+FROM node:12-alpine
+WORKDIR /app
+COPY --from=build --chown=node:node /app/node_modules ./node_modules
+COPY --chown=node:node . .
+USER node
+CMD ["node", "worker.js"]
+`,
+		},
+		"Node.js ignores aliases in .nvmrc": {
+			files: map[string]string{
+				".nvmrc":        "lts/*",
+				".node-version": "15.2.0",
+			},
+			conf: StarterConfig{Language: LanguageNode},
+			wantedDockerfile: `FROM node:15.2.0-alpine AS build
+WORKDIR /app
+COPY package*.json ./
+RUN npm install --production
+
+FROM node:15.2.0-alpine
+WORKDIR /app
+COPY --from=build --chown=node:node /app/node_modules ./node_modules
+COPY --chown=node:node . .
+USER node
+CMD ["npm", "start"]
+`,
+		},
+		"Python with the version of runtime.txt": {
+			files: map[string]string{
+				"runtime.txt": "python-3.7.9",
+			},
+			conf: StarterConfig{Language: LanguagePython, Port: 5000},
+			wantedDockerfile: `FROM python:3.7.9-slim AS build
+COPY requirements.txt .
+RUN pip install --no-cache-dir --prefix=/install -r requirements.txt
+
+FROM python:3.7.9-slim
+WORKDIR /app
+COPY --from=build /install /usr/local
+RUN useradd --system app
+COPY --chown=app:app . .
+USER app
+EXPOSE 5000
+CMD ["python", "app.py"]
+`,
+		},
+		"Java built with Maven with the version of pom.xml": {
+			files: map[string]string{
+				"pom.xml": "<properties>\n  <java.version>1.8</java.version>\n</properties>",
+			},
+			conf: StarterConfig{Language: LanguageJava, Port: 8080},
+			wantedDockerfile: `FROM maven:3-openjdk-8 AS build
+WORKDIR /src
+COPY pom.xml .
+RUN mvn -q dependency:go-offline
+COPY src ./src
+RUN mvn -q package -DskipTests && cp target/*.jar /app.jar
+
+FROM openjdk:8-jre-slim
+RUN useradd --system app
+COPY --from=build /app.jar /app/app.jar
+USER app
+EXPOSE 8080
+CMD ["java", "-jar", "/app/app.jar"]
+`,
+		},
+		"Java built with Gradle with the version of .java-version": {
+			files: map[string]string{
+				"build.gradle":  "plugins { id 'org.springframework.boot' version '2.3.4.RELEASE' }",
+				".java-version": "14",
+			},
+			conf: StarterConfig{Language: LanguageJava},
+			wantedDockerfile: `FROM gradle:6-jdk14 AS build
+WORKDIR /src
+COPY . .
+RUN gradle bootJar --no-daemon && cp build/libs/*.jar /app.jar
+
+FROM openjdk:14-jre-slim
+RUN useradd --system app
+COPY --from=build /app.jar /app/app.jar
+USER app
+CMD ["java", "-jar", "/app/app.jar"]
+`,
+		},
+		"Ruby with the version of the Gemfile": {
+			files: map[string]string{
+				"Gemfile": "source 'https://rubygems.org'\nruby '2.6.6'\n",
+			},
+			conf: StarterConfig{Language: LanguageRuby},
+			wantedDockerfile: `FROM ruby:2.6.6 AS build
+WORKDIR /app
+COPY Gemfile* ./
+RUN bundle config set --local without 'development test' && bundle install
+
+FROM ruby:2.6.6-slim
+WORKDIR /app
+COPY --from=build /usr/local/bundle /usr/local/bundle
+RUN useradd --system app
+COPY --chown=app:app . .
+USER app
+CMD ["bundle", "exec", "ruby", "app.rb"]
+`,
+		},
+		"errors on an unsupported language": {
+			conf:      StarterConfig{Language: "COBOL"},
+			wantedErr: "unsupported language COBOL",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			fs := afero.NewMemMapFs()
+			for name, content := range tc.files {
+				require.NoError(t, afero.WriteFile(fs, "app/"+name, []byte(content), 0644))
+			}
+
+			// WHEN
+			content, err := GenerateStarter(fs, "app", tc.conf)
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedDockerfile, content)
+		})
+	}
+}
@@ -0,0 +1,249 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package framework detects the web framework, or else the language, of an application from its files,
+// and provides the defaults to run it in a container.
+package framework
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/docker/dockerfile"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/spf13/afero"
+)
+
+const (
+	defaultHealthCheckPath = "/"
+	actuatorHealthPath     = "/actuator/health"
+)
+
+var (
+	gemRailsRegexp = regexp.MustCompile(`(?m)^\s*gem\s+['"]rails['"]`)
+
+	// nodeWebDependencies are the npm packages of HTTP servers.
+	nodeWebDependencies = []string{"express", "koa", "fastify", "@hapi/hapi", "next", "@nestjs/core"}
+)
+
+// Framework is the framework of an application, along with the defaults to run the application in a container.
+type Framework struct {
+	Name            string
+	ServiceType     string // Type of service that best fits the application, empty if unknown.
+	Port            uint16 // Port the application listens on, 0 if the application doesn't receive requests.
+	HealthCheckPath string // Path of the load balancer health check, empty if the service isn't load balanced.
+	Dockerfile      string // Contents of a starter Dockerfile that builds and runs the application.
+}
+
+type detector func(fs afero.Fs, dir string) (*Framework, error)
+
+// Detect returns the framework of the application in the directory, or nil if none of the supported frameworks are found.
+// Spring Boot, Rails, Django, Flask and Node.js applications are supported, and looked for in that order.
+// Otherwise, Go, Python, Java and Ruby applications are detected by their language alone.
+func Detect(fs afero.Fs, dir string) (*Framework, error) {
+	detectors := []detector{detectSpringBoot, detectRails, detectDjango, detectFlask, detectNode,
+		detectGo, detectPython, detectJava, detectRuby}
+	for _, detect := range detectors {
+		fw, err := detect(fs, dir)
+		if err != nil {
+			return nil, err
+		}
+		if fw != nil {
+			return fw, nil
+		}
+	}
+	return nil, nil
+}
+
+func detectSpringBoot(fs afero.Fs, dir string) (*Framework, error) {
+	pom, err := readFile(fs, dir, "pom.xml")
+	if err != nil {
+		return nil, err
+	}
+	if strings.Contains(pom, "spring-boot") {
+		return springBoot(fs, dir, pom)
+	}
+	for _, name := range []string{"build.gradle", "build.gradle.kts"} {
+		build, err := readFile(fs, dir, name)
+		if err != nil {
+			return nil, err
+		}
+		if strings.Contains(build, "org.springframework.boot") {
+			return springBoot(fs, dir, build)
+		}
+	}
+	return nil, nil
+}
+
+func springBoot(fs afero.Fs, dir, build string) (*Framework, error) {
+	fw := &Framework{
+		Name:            "Spring Boot",
+		ServiceType:     manifest.LoadBalancedWebServiceType,
+		Port:            8080,
+		HealthCheckPath: defaultHealthCheckPath,
+	}
+	if strings.Contains(build, "spring-boot-starter-actuator") {
+		fw.HealthCheckPath = actuatorHealthPath
+	}
+	return withDockerfile(fs, dir, fw, dockerfile.LanguageJava)
+}
+
+func detectRails(fs afero.Fs, dir string) (*Framework, error) {
+	gemfile, err := readFile(fs, dir, "Gemfile")
+	if err != nil {
+		return nil, err
+	}
+	if !gemRailsRegexp.MatchString(gemfile) {
+		return nil, nil
+	}
+	return withDockerfile(fs, dir, &Framework{
+		Name:            "Rails",
+		ServiceType:     manifest.LoadBalancedWebServiceType,
+		Port:            3000,
+		HealthCheckPath: defaultHealthCheckPath,
+	}, dockerfile.LanguageRuby, "bundle", "exec", "rails", "server", "-b", "0.0.0.0", "-p", "3000")
+}
+
+func detectDjango(fs afero.Fs, dir string) (*Framework, error) {
+	reqs, err := readFile(fs, dir, "requirements.txt")
+	if err != nil {
+		return nil, err
+	}
+	manage, err := readFile(fs, dir, "manage.py")
+	if err != nil {
+		return nil, err
+	}
+	if manage == "" || !hasRequirement(reqs, "django") {
+		return nil, nil
+	}
+	return withDockerfile(fs, dir, &Framework{
+		Name:            "Django",
+		ServiceType:     manifest.LoadBalancedWebServiceType,
+		Port:            8000,
+		HealthCheckPath: defaultHealthCheckPath,
+	}, dockerfile.LanguagePython, "python", "manage.py", "runserver", "0.0.0.0:8000")
+}
+
+func detectFlask(fs afero.Fs, dir string) (*Framework, error) {
+	reqs, err := readFile(fs, dir, "requirements.txt")
+	if err != nil {
+		return nil, err
+	}
+	if !hasRequirement(reqs, "flask") {
+		return nil, nil
+	}
+	return withDockerfile(fs, dir, &Framework{
+		Name:            "Flask",
+		ServiceType:     manifest.LoadBalancedWebServiceType,
+		Port:            5000,
+		HealthCheckPath: defaultHealthCheckPath,
+	}, dockerfile.LanguagePython, "flask", "run", "--host=0.0.0.0", "--port=5000")
+}
+
+func detectNode(fs afero.Fs, dir string) (*Framework, error) {
+	raw, err := readFile(fs, dir, "package.json")
+	if err != nil {
+		return nil, err
+	}
+	if raw == "" {
+		return nil, nil
+	}
+	var pkg struct {
+		Main         string            `json:"main"`
+		Scripts      map[string]string `json:"scripts"`
+		Dependencies map[string]string `json:"dependencies"`
+	}
+	if err := json.Unmarshal([]byte(raw), &pkg); err != nil {
+		return nil, fmt.Errorf("unmarshal package.json: %w", err)
+	}
+	var cmd []string // Defaults to "npm start".
+	if _, ok := pkg.Scripts["start"]; !ok {
+		main := pkg.Main
+		if main == "" {
+			main = "index.js"
+		}
+		cmd = []string{"node", main}
+	}
+	fw := &Framework{
+		Name:        "Node.js",
+		ServiceType: manifest.BackendServiceType,
+	}
+	for _, dep := range nodeWebDependencies {
+		if _, ok := pkg.Dependencies[dep]; ok {
+			fw.ServiceType = manifest.LoadBalancedWebServiceType
+			fw.Port = 3000
+			fw.HealthCheckPath = defaultHealthCheckPath
+			break
+		}
+	}
+	return withDockerfile(fs, dir, fw, dockerfile.LanguageNode, cmd...)
+}
+
+func detectGo(fs afero.Fs, dir string) (*Framework, error) {
+	return detectLanguage(fs, dir, "go.mod", dockerfile.LanguageGo)
+}
+
+func detectPython(fs afero.Fs, dir string) (*Framework, error) {
+	return detectLanguage(fs, dir, "requirements.txt", dockerfile.LanguagePython)
+}
+
+func detectJava(fs afero.Fs, dir string) (*Framework, error) {
+	return detectLanguage(fs, dir, "pom.xml", dockerfile.LanguageJava)
+}
+
+func detectRuby(fs afero.Fs, dir string) (*Framework, error) {
+	return detectLanguage(fs, dir, "Gemfile", dockerfile.LanguageRuby)
+}
+
+// detectLanguage returns an application of the language without a known framework if the file exists.
+func detectLanguage(fs afero.Fs, dir, file, lang string) (*Framework, error) {
+	exists, err := afero.Exists(fs, filepath.Join(dir, file))
+	if err != nil {
+		return nil, fmt.Errorf("check if %s exists: %w", file, err)
+	}
+	if !exists {
+		return nil, nil
+	}
+	return withDockerfile(fs, dir, &Framework{Name: lang}, lang)
+}
+
+// withDockerfile sets the starter Dockerfile of the framework, that runs the command, or the default one of the language.
+func withDockerfile(fs afero.Fs, dir string, fw *Framework, lang string, cmd ...string) (*Framework, error) {
+	content, err := dockerfile.GenerateStarter(fs, dir, dockerfile.StarterConfig{
+		Language: lang,
+		Port:     fw.Port,
+		Cmd:      cmd,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("generate %s Dockerfile: %w", fw.Name, err)
+	}
+	fw.Dockerfile = content
+	return fw, nil
+}
+
+// hasRequirement returns true if the pip requirements file lists the package, with or without a version specifier.
+func hasRequirement(reqs, pkg string) bool {
+	re := regexp.MustCompile(fmt.Sprintf(`^%s([<>=!~;\[\s]|$)`, regexp.QuoteMeta(pkg)))
+	for _, line := range strings.Split(reqs, "\n") {
+		if re.MatchString(strings.ToLower(strings.TrimSpace(line))) {
+			return true
+		}
+	}
+	return false
+}
+
+// readFile returns the contents of the file in the directory, or an empty string if the file doesn't exist.
+func readFile(fs afero.Fs, dir, name string) (string, error) {
+	content, err := afero.ReadFile(fs, filepath.Join(dir, name))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", nil
+		}
+		return "", fmt.Errorf("read %s: %w", name, err)
+	}
+	return string(content), nil
+}
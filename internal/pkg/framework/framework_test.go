@@ -0,0 +1,153 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetect(t *testing.T) {
+	testCases := map[string]struct {
+		files map[string]string
+
+		wantedName            string
+		wantedServiceType     string
+		wantedPort            uint16
+		wantedHealthCheckPath string
+		wantedDockerfile      []string // Lines expected in the Dockerfile.
+		wantedErr             string
+	}{
+		"returns nil without any known framework": {
+			files: map[string]string{
+				"main.go": "package main",
+			},
+		},
+		"detects Spring Boot with the actuator through Maven": {
+			files: map[string]string{
+				"pom.xml": `<parent><artifactId>spring-boot-starter-parent</artifactId></parent>
+<dependency><artifactId>spring-boot-starter-actuator</artifactId></dependency>`,
+			},
+			wantedName:            "Spring Boot",
+			wantedServiceType:     manifest.LoadBalancedWebServiceType,
+			wantedPort:            8080,
+			wantedHealthCheckPath: "/actuator/health",
+			wantedDockerfile:      []string{"FROM maven:3-openjdk-11 AS build", "EXPOSE 8080", `CMD ["java", "-jar", "/app/app.jar"]`},
+		},
+		"detects Spring Boot through Gradle": {
+			files: map[string]string{
+				"build.gradle.kts": `plugins { id("org.springframework.boot") version "2.3.4.RELEASE" }`,
+			},
+			wantedName:            "Spring Boot",
+			wantedServiceType:     manifest.LoadBalancedWebServiceType,
+			wantedPort:            8080,
+			wantedHealthCheckPath: "/",
+			wantedDockerfile:      []string{"FROM gradle:6-jdk11 AS build", "EXPOSE 8080"},
+		},
+		"detects Rails": {
+			files: map[string]string{
+				"Gemfile": "source 'https://rubygems.org'\ngem 'rails', '~> 6.0.3'\n",
+			},
+			wantedName:            "Rails",
+			wantedServiceType:     manifest.LoadBalancedWebServiceType,
+			wantedPort:            3000,
+			wantedHealthCheckPath: "/",
+			wantedDockerfile:      []string{"FROM ruby:2.7-slim", "EXPOSE 3000", `CMD ["bundle", "exec", "rails", "server", "-b", "0.0.0.0", "-p", "3000"]`},
+		},
+		"detects Django over Flask": {
+			files: map[string]string{
+				"manage.py":        "#!/usr/bin/env python",
+				"requirements.txt": "Django==3.1\nflask\n",
+			},
+			wantedName:            "Django",
+			wantedServiceType:     manifest.LoadBalancedWebServiceType,
+			wantedPort:            8000,
+			wantedHealthCheckPath: "/",
+			wantedDockerfile:      []string{"FROM python:3.8-slim", "EXPOSE 8000", `CMD ["python", "manage.py", "runserver", "0.0.0.0:8000"]`},
+		},
+		"detects Flask": {
+			files: map[string]string{
+				"requirements.txt": "flask-cors\nFlask>=1.1\n",
+			},
+			wantedName:            "Flask",
+			wantedServiceType:     manifest.LoadBalancedWebServiceType,
+			wantedPort:            5000,
+			wantedHealthCheckPath: "/",
+			wantedDockerfile:      []string{"EXPOSE 5000", `CMD ["flask", "run", "--host=0.0.0.0", "--port=5000"]`},
+		},
+		"detects Python without a known framework": {
+			files: map[string]string{
+				"requirements.txt": "flask-cors\n",
+				".python-version":  "3.9.0\n",
+			},
+			wantedName:       "Python",
+			wantedDockerfile: []string{"FROM python:3.9.0-slim", `CMD ["python", "app.py"]`},
+		},
+		"detects Go": {
+			files: map[string]string{
+				"go.mod": "module example.com/api\n\ngo 1.14\n",
+			},
+			wantedName:       "Go",
+			wantedDockerfile: []string{"FROM golang:1.14-alpine AS build", `CMD ["/app"]`},
+		},
+		"detects a Node.js web server started with npm": {
+			files: map[string]string{
+				"package.json": `{"scripts": {"start": "node server.js"}, "dependencies": {"express": "^4.17.1"}}`,
+			},
+			wantedName:            "Node.js",
+			wantedServiceType:     manifest.LoadBalancedWebServiceType,
+			wantedPort:            3000,
+			wantedHealthCheckPath: "/",
+			wantedDockerfile:      []string{"FROM node:14-alpine", "EXPOSE 3000", `CMD ["npm", "start"]`},
+		},
+		"detects a Node.js worker without a start script": {
+			files: map[string]string{
+				"package.json": `{"main": "worker.js", "dependencies": {"aws-sdk": "^2.7"}}`,
+			},
+			wantedName:        "Node.js",
+			wantedServiceType: manifest.BackendServiceType,
+			wantedDockerfile:  []string{`CMD ["node", "worker.js"]`},
+		},
+		"errors if package.json is malformed": {
+			files: map[string]string{
+				"package.json": `{`,
+			},
+			wantedErr: "unmarshal package.json: unexpected end of JSON input",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			fs := afero.NewMemMapFs()
+			for name, content := range tc.files {
+				require.NoError(t, afero.WriteFile(fs, "app/"+name, []byte(content), 0644))
+			}
+
+			// WHEN
+			fw, err := Detect(fs, "app")
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			if tc.wantedName == "" {
+				require.Nil(t, fw)
+				return
+			}
+			require.Equal(t, tc.wantedName, fw.Name)
+			require.Equal(t, tc.wantedServiceType, fw.ServiceType)
+			require.Equal(t, tc.wantedPort, fw.Port)
+			require.Equal(t, tc.wantedHealthCheckPath, fw.HealthCheckPath)
+			for _, line := range tc.wantedDockerfile {
+				require.Contains(t, fw.Dockerfile, line+"\n")
+			}
+		})
+	}
+}
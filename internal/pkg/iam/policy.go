@@ -0,0 +1,135 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package iam generates IAM policies that grant least-privilege access to the resources of a Copilot application.
+package iam
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+)
+
+const (
+	policyVersion = "2012-10-17"
+	effectAllow   = "Allow"
+
+	conditionStringEquals = "StringEquals"
+	fmtResourceTagKey     = "aws:ResourceTag/%s"
+)
+
+// Policy is an IAM policy document.
+type Policy struct {
+	Version   string      `json:"Version"`
+	Statement []Statement `json:"Statement"`
+}
+
+// Statement is a statement of an IAM policy document.
+type Statement struct {
+	Sid       string                       `json:"Sid"`
+	Effect    string                       `json:"Effect"`
+	Action    []string                     `json:"Action"`
+	Resource  []string                     `json:"Resource"`
+	Condition map[string]map[string]string `json:"Condition,omitempty"`
+}
+
+// DeployerPolicyInput holds the resources of an environment that a deployer policy grants access to.
+type DeployerPolicyInput struct {
+	App            string
+	AppAccountID   string // Account of the application, where the images and artifacts of the services are stored.
+	Env            string
+	EnvRegion      string
+	ManagerRoleARN string // Role assumed to deploy to the environment.
+}
+
+// NewDeployerPolicy returns a policy that allows deploying and deleting the services of the application in the environment only.
+// The role of the environment can only be assumed if it's tagged with the names of the application and environment,
+// so that the policy doesn't grant access to a role that was re-created for another environment.
+func NewDeployerPolicy(in DeployerPolicyInput) *Policy {
+	appTagCondition := map[string]map[string]string{
+		conditionStringEquals: {
+			fmt.Sprintf(fmtResourceTagKey, deploy.AppTagKey): in.App,
+		},
+	}
+	return &Policy{
+		Version: policyVersion,
+		Statement: []Statement{
+			{
+				Sid:    "ReadApplicationConfiguration",
+				Effect: effectAllow,
+				Action: []string{"ssm:GetParameter", "ssm:GetParametersByPath"},
+				Resource: []string{
+					fmt.Sprintf("arn:aws:ssm:*:%s:parameter/copilot/applications/%s", in.AppAccountID, in.App),
+					fmt.Sprintf("arn:aws:ssm:*:%s:parameter/copilot/applications/%s/*", in.AppAccountID, in.App),
+				},
+			},
+			{
+				Sid:    "ReadApplicationResources",
+				Effect: effectAllow,
+				Action: []string{"cloudformation:ListStackInstances", "cloudformation:DescribeStacks"},
+				Resource: []string{
+					fmt.Sprintf("arn:aws:cloudformation:*:%s:stackset/%s-infrastructure:*", in.AppAccountID, in.App),
+					fmt.Sprintf("arn:aws:cloudformation:%s:%s:stack/StackSet-%s-infrastructure-*", in.EnvRegion, in.AppAccountID, in.App),
+				},
+			},
+			{
+				Sid:    "AssumeEnvironmentManagerRole",
+				Effect: effectAllow,
+				Action: []string{"sts:AssumeRole"},
+				Resource: []string{
+					in.ManagerRoleARN,
+				},
+				Condition: map[string]map[string]string{
+					conditionStringEquals: {
+						fmt.Sprintf(fmtResourceTagKey, deploy.AppTagKey): in.App,
+						fmt.Sprintf(fmtResourceTagKey, deploy.EnvTagKey): in.Env,
+					},
+				},
+			},
+			{
+				Sid:    "PushImages",
+				Effect: effectAllow,
+				Action: []string{
+					"ecr:BatchCheckLayerAvailability",
+					"ecr:BatchGetImage",
+					"ecr:CompleteLayerUpload",
+					"ecr:DescribeImages",
+					"ecr:DescribeRepositories",
+					"ecr:InitiateLayerUpload",
+					"ecr:PutImage",
+					"ecr:UploadLayerPart",
+				},
+				Resource: []string{
+					fmt.Sprintf("arn:aws:ecr:%s:%s:repository/%s/*", in.EnvRegion, in.AppAccountID, in.App),
+				},
+				Condition: appTagCondition,
+			},
+			{
+				Sid:    "UploadArtifacts",
+				Effect: effectAllow,
+				Action: []string{"s3:GetObject", "s3:PutObject"},
+				// The artifact bucket is named after the stack instance of the application's stack set.
+				Resource: []string{
+					fmt.Sprintf("arn:aws:s3:::stackset-%s-*/*", strings.ToLower(in.App)),
+				},
+			},
+			{
+				Sid:      "GetCredentials",
+				Effect:   effectAllow,
+				Action:   []string{"ecr:GetAuthorizationToken", "sts:GetCallerIdentity"},
+				Resource: []string{"*"},
+			},
+		},
+	}
+}
+
+// JSONString returns the indented JSON document of the policy.
+func (p *Policy) JSONString() (string, error) {
+	b, err := json.MarshalIndent(p, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("marshal policy: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
@@ -0,0 +1,84 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package iam
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDeployerPolicy(t *testing.T) {
+	// GIVEN
+	in := DeployerPolicyInput{
+		App:            "Phonetool",
+		AppAccountID:   "111111111111",
+		Env:            "prod",
+		EnvRegion:      "us-west-2",
+		ManagerRoleARN: "arn:aws:iam::222222222222:role/Phonetool-prod-EnvManagerRole",
+	}
+
+	// WHEN
+	policy := NewDeployerPolicy(in)
+
+	// THEN
+	statements := make(map[string]Statement)
+	for _, s := range policy.Statement {
+		statements[s.Sid] = s
+	}
+	require.Equal(t, "2012-10-17", policy.Version)
+	require.Equal(t, Statement{
+		Sid:      "AssumeEnvironmentManagerRole",
+		Effect:   "Allow",
+		Action:   []string{"sts:AssumeRole"},
+		Resource: []string{"arn:aws:iam::222222222222:role/Phonetool-prod-EnvManagerRole"},
+		Condition: map[string]map[string]string{
+			"StringEquals": {
+				"aws:ResourceTag/copilot-application": "Phonetool",
+				"aws:ResourceTag/copilot-environment": "prod",
+			},
+		},
+	}, statements["AssumeEnvironmentManagerRole"])
+	require.Equal(t, []string{"arn:aws:ecr:us-west-2:111111111111:repository/Phonetool/*"}, statements["PushImages"].Resource)
+	require.Equal(t, []string{"arn:aws:s3:::stackset-phonetool-*/*"}, statements["UploadArtifacts"].Resource)
+}
+
+func TestPolicy_JSONString(t *testing.T) {
+	// GIVEN
+	policy := &Policy{
+		Version: "2012-10-17",
+		Statement: []Statement{
+			{
+				Sid:      "GetCredentials",
+				Effect:   "Allow",
+				Action:   []string{"sts:GetCallerIdentity"},
+				Resource: []string{"*"},
+			},
+		},
+	}
+
+	// WHEN
+	out, err := policy.JSONString()
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "GetCredentials",
+      "Effect": "Allow",
+      "Action": [
+        "sts:GetCallerIdentity"
+      ],
+      "Resource": [
+        "*"
+      ]
+    }
+  ]
+}
+`, out)
+	require.True(t, json.Valid([]byte(out)))
+}
@@ -39,6 +39,9 @@ type BackendServiceConfig struct {
 	TaskConfig `yaml:",inline"`
 	*LogConfig `yaml:"logging,flow"`
 	Sidecar    `yaml:",inline"`
+	Events     `yaml:",inline"`
+	Deployment DeploymentConfig `yaml:"deployment,flow"`
+	Storage    *Storage         `yaml:"storage"`
 }
 
 // LogConfigOpts converts the service's Firelens configuration into a format parsable by the templates pkg.
@@ -49,6 +52,22 @@ func (bc *BackendServiceConfig) LogConfigOpts() *template.LogConfigOpts {
 	return bc.logConfigOpts()
 }
 
+// StorageOpts converts the volumes of the service and of its sidecars into a format parsable by the templates pkg.
+func (bc *BackendServiceConfig) StorageOpts() (*template.StorageOpts, error) {
+	return storageOpts(bc.Storage, bc.Sidecar)
+}
+
+// ValidateSidecarDependencies returns an error if the sidecars depend on containers that don't exist or can't
+// satisfy the conditions.
+func (s *BackendService) ValidateSidecarDependencies() error {
+	return validateSidecarDependencies(aws.StringValue(s.Name), s.Image.HealthCheck != nil, s.LogConfig, s.Sidecars)
+}
+
+// ValidateSidecarResources returns an error if the CPU and memory of the log router and sidecars don't fit the task size.
+func (bc *BackendServiceConfig) ValidateSidecarResources() error {
+	return validateContainerResources(bc.TaskConfig, bc.LogConfig, bc.Sidecars)
+}
+
 type imageWithPortAndHealthcheck struct {
 	ServiceImageWithPort `yaml:",inline"`
 	HealthCheck          *ContainerHealthCheck `yaml:"healthcheck"`
@@ -75,10 +94,15 @@ func NewBackendService(props BackendServiceProps) *BackendService {
 		healthCheck.apply(props.HealthCheck)
 	}
 	// Apply overrides.
+	svc.Version = LatestServiceSchemaVersion
 	svc.Name = aws.String(props.Name)
 	svc.BackendServiceConfig.Image.Build.BuildArgs.Dockerfile = aws.String(props.Dockerfile)
 	svc.BackendServiceConfig.Image.Port = aws.Uint16(props.Port)
 	svc.BackendServiceConfig.Image.HealthCheck = healthCheck
+	if props.TaskSize != nil {
+		svc.BackendServiceConfig.CPU = aws.Int(props.TaskSize.CPU)
+		svc.BackendServiceConfig.Memory = aws.Int(props.TaskSize.Memory)
+	}
 	svc.parser = template.New()
 	return svc
 }
@@ -102,6 +126,11 @@ func (s *BackendService) BuildArgs(wsRoot string) *DockerBuildArgs {
 	return s.Image.BuildConfig(wsRoot)
 }
 
+// ImageScanConfig returns the configuration to scan the image of the service for vulnerabilities, or nil if it's not scanned.
+func (s *BackendService) ImageScanConfig() *ImageScan {
+	return s.Image.Scan
+}
+
 // ApplyEnv returns the service manifest with environment overrides.
 // If the environment passed in does not have any overrides then it returns itself.
 func (s BackendService) ApplyEnv(envName string) (*BackendService, error) {
@@ -116,10 +145,25 @@ func (s BackendService) ApplyEnv(envName string) (*BackendService, error) {
 	if err != nil {
 		return nil, err
 	}
+	if !overrideConfig.Count.IsEmpty() {
+		// The count is either an integer or a split between Fargate and Fargate Spot, so it's replaced instead of merged.
+		s.Count = overrideConfig.Count
+	}
 	s.Environments = nil
 	return &s, nil
 }
 
+// validateTaskSizes returns an error if Fargate doesn't support the task size of the service in any of its environments.
+func (s *BackendService) validateTaskSizes() error {
+	overrides := make(map[string]TaskConfig, len(s.Environments))
+	for env, config := range s.Environments {
+		if config != nil {
+			overrides[env] = config.TaskConfig
+		}
+	}
+	return validateTaskSizes(s.TaskConfig, overrides)
+}
+
 // newDefaultBackendService returns a backend service with minimal task sizes and a single replica.
 func newDefaultBackendService() *BackendService {
 	return &BackendService{
@@ -131,7 +175,7 @@ func newDefaultBackendService() *BackendService {
 			TaskConfig: TaskConfig{
 				CPU:    aws.Int(256),
 				Memory: aws.Int(512),
-				Count:  aws.Int(1),
+				Count:  Count{Value: aws.Int(1)},
 			},
 		},
 	}
@@ -192,11 +236,16 @@ func (i imageWithPortAndHealthcheck) HealthCheckOpts() *ecs.HealthCheck {
 	if i.HealthCheck == nil {
 		return nil
 	}
+	return i.HealthCheck.opts()
+}
+
+// opts converts the healthcheck into a format parsable by the templates pkg, all its fields must be set.
+func (hc *ContainerHealthCheck) opts() *ecs.HealthCheck {
 	return &ecs.HealthCheck{
-		Command:     aws.StringSlice(i.HealthCheck.Command),
-		Interval:    aws.Int64(int64(i.HealthCheck.Interval.Seconds())),
-		Retries:     aws.Int64(int64(*i.HealthCheck.Retries)),
-		StartPeriod: aws.Int64(int64(i.HealthCheck.StartPeriod.Seconds())),
-		Timeout:     aws.Int64(int64(i.HealthCheck.Timeout.Seconds())),
+		Command:     aws.StringSlice(hc.Command),
+		Interval:    aws.Int64(int64(hc.Interval.Seconds())),
+		Retries:     aws.Int64(int64(*hc.Retries)),
+		StartPeriod: aws.Int64(int64(hc.StartPeriod.Seconds())),
+		Timeout:     aws.Int64(int64(hc.Timeout.Seconds())),
 	}
 }
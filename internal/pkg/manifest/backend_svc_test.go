@@ -30,8 +30,9 @@ func TestNewBackendSvc(t *testing.T) {
 			},
 			wantedManifest: &BackendService{
 				Service: Service{
-					Name: aws.String("subscribers"),
-					Type: aws.String(BackendServiceType),
+					Version: LatestServiceSchemaVersion,
+					Name:    aws.String("subscribers"),
+					Type:    aws.String(BackendServiceType),
 				},
 				BackendServiceConfig: BackendServiceConfig{
 					Image: imageWithPortAndHealthcheck{
@@ -49,7 +50,7 @@ func TestNewBackendSvc(t *testing.T) {
 					TaskConfig: TaskConfig{
 						CPU:    aws.Int(256),
 						Memory: aws.Int(512),
-						Count:  aws.Int(1),
+						Count:  Count{Value: aws.Int(1)},
 					},
 				},
 			},
@@ -67,8 +68,9 @@ func TestNewBackendSvc(t *testing.T) {
 			},
 			wantedManifest: &BackendService{
 				Service: Service{
-					Name: aws.String("subscribers"),
-					Type: aws.String(BackendServiceType),
+					Version: LatestServiceSchemaVersion,
+					Name:    aws.String("subscribers"),
+					Type:    aws.String(BackendServiceType),
 				},
 				BackendServiceConfig: BackendServiceConfig{
 					Image: imageWithPortAndHealthcheck{
@@ -93,7 +95,7 @@ func TestNewBackendSvc(t *testing.T) {
 					TaskConfig: TaskConfig{
 						CPU:    aws.Int(256),
 						Memory: aws.Int(512),
-						Count:  aws.Int(1),
+						Count:  Count{Value: aws.Int(1)},
 					},
 				},
 			},
@@ -197,7 +199,7 @@ func TestBackendSvc_ApplyEnv(t *testing.T) {
 			TaskConfig: TaskConfig{
 				CPU:    aws.Int(256),
 				Memory: aws.Int(256),
-				Count:  aws.Int(1),
+				Count:  Count{Value: aws.Int(1)},
 			},
 		},
 	}
@@ -229,7 +231,7 @@ func TestBackendSvc_ApplyEnv(t *testing.T) {
 			TaskConfig: TaskConfig{
 				CPU:    aws.Int(256),
 				Memory: aws.Int(256),
-				Count:  aws.Int(1),
+				Count:  Count{Value: aws.Int(1)},
 			},
 			Sidecar: Sidecar{
 				Sidecars: map[string]*SidecarConfig{
@@ -249,7 +251,7 @@ func TestBackendSvc_ApplyEnv(t *testing.T) {
 		Environments: map[string]*BackendServiceConfig{
 			"test": {
 				TaskConfig: TaskConfig{
-					Count: aws.Int(0),
+					Count: Count{Value: aws.Int(0)},
 					CPU:   aws.Int(512),
 					Variables: map[string]string{
 						"LOG_LEVEL": "",
@@ -271,6 +273,20 @@ func TestBackendSvc_ApplyEnv(t *testing.T) {
 			},
 		},
 	}
+	mockBackendServiceWithSpotOverride := BackendService{
+		BackendServiceConfig: BackendServiceConfig{
+			TaskConfig: TaskConfig{
+				Count: Count{Value: aws.Int(1)},
+			},
+		},
+		Environments: map[string]*BackendServiceConfig{
+			"test": {
+				TaskConfig: TaskConfig{
+					Count: Count{Spot: aws.Int(2)},
+				},
+			},
+		},
+	}
 	testCases := map[string]struct {
 		svc       *BackendService
 		inEnvName string
@@ -314,7 +330,7 @@ func TestBackendSvc_ApplyEnv(t *testing.T) {
 					TaskConfig: TaskConfig{
 						CPU:    aws.Int(512),
 						Memory: aws.Int(256),
-						Count:  aws.Int(0),
+						Count:  Count{Value: aws.Int(0)},
 						Variables: map[string]string{
 							"LOG_LEVEL": "",
 						},
@@ -339,6 +355,19 @@ func TestBackendSvc_ApplyEnv(t *testing.T) {
 			},
 			original: &mockBackendServiceWithAllOverride,
 		},
+		"replaces the count instead of merging it": {
+			svc:       &mockBackendServiceWithSpotOverride,
+			inEnvName: "test",
+
+			wanted: &BackendService{
+				BackendServiceConfig: BackendServiceConfig{
+					TaskConfig: TaskConfig{
+						Count: Count{Spot: aws.Int(2)},
+					},
+				},
+			},
+			original: &mockBackendServiceWithSpotOverride,
+		},
 	}
 
 	for name, tc := range testCases {
@@ -352,3 +381,136 @@ func TestBackendSvc_ApplyEnv(t *testing.T) {
 		})
 	}
 }
+
+func TestBackendService_ValidateSidecarDependencies(t *testing.T) {
+	testCases := map[string]struct {
+		inContent string
+
+		wantedErr string
+	}{
+		"waits for the main container and a non-essential sidecar": {
+			inContent: `
+name: api
+image:
+  port: 8080
+sidecars:
+  migrations:
+    image: migrations
+    essential: false
+  xray:
+    image: xray
+    essential: false
+    dependsOn:
+      api: start
+      migrations: SUCCESS`,
+		},
+		"waits for the main container to be healthy": {
+			inContent: `
+name: api
+image:
+  port: 8080
+  healthcheck:
+    command: ["CMD-SHELL", "curl localhost:8080"]
+sidecars:
+  xray:
+    image: xray
+    dependsOn:
+      api: HEALTHY`,
+		},
+		"waits for a sidecar with a health check to be healthy": {
+			inContent: `
+name: api
+sidecars:
+  envoy:
+    image: envoy
+    healthcheck:
+      command: ["CMD-SHELL", "curl localhost:9901/ready"]
+  xray:
+    image: xray
+    dependsOn:
+      envoy: HEALTHY`,
+		},
+		"depends on itself": {
+			inContent: `
+name: api
+sidecars:
+  xray:
+    image: xray
+    dependsOn:
+      xray: START`,
+			wantedErr: "sidecar xray cannot depend on itself",
+		},
+		"depends on a container that doesn't exist": {
+			inContent: `
+name: api
+sidecars:
+  xray:
+    image: xray
+    dependsOn:
+      envoy: START`,
+			wantedErr: "sidecar xray depends on container envoy that doesn't exist",
+		},
+		"invalid condition": {
+			inContent: `
+name: api
+sidecars:
+  xray:
+    image: xray
+    dependsOn:
+      api: RUNNING`,
+			wantedErr: "sidecar xray depends on container api with condition RUNNING: must be one of START, COMPLETE, SUCCESS, HEALTHY",
+		},
+		"waits for an essential container to complete": {
+			inContent: `
+name: api
+sidecars:
+  migrations:
+    image: migrations
+  xray:
+    image: xray
+    dependsOn:
+      migrations: COMPLETE`,
+			wantedErr: "sidecar xray cannot wait for essential container migrations to complete: set essential to false on migrations",
+		},
+		"waits for the log router to succeed": {
+			inContent: `
+name: api
+logging:
+  destination:
+    Name: cloudwatch
+sidecars:
+  xray:
+    image: xray
+    dependsOn:
+      firelens_log_router: SUCCESS`,
+			wantedErr: "sidecar xray cannot wait for essential container firelens_log_router to succeed: set essential to false on firelens_log_router",
+		},
+		"waits for a container without a health check to be healthy": {
+			inContent: `
+name: api
+sidecars:
+  xray:
+    image: xray
+    dependsOn:
+      api: HEALTHY`,
+			wantedErr: "sidecar xray cannot wait for container api to be healthy: api has no health check",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			var svc BackendService
+			require.NoError(t, yaml.Unmarshal([]byte(tc.inContent), &svc))
+
+			// WHEN
+			err := svc.ValidateSidecarDependencies()
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,166 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Compute presets that set the CPU and memory of a task.
+const (
+	ComputeSmall  = "small"
+	ComputeMedium = "medium"
+	ComputeLarge  = "large"
+)
+
+// ComputePresets are the supported compute presets, from the smallest to the largest.
+var ComputePresets = []string{
+	ComputeSmall,
+	ComputeMedium,
+	ComputeLarge,
+}
+
+var computePresetSizes = map[string]TaskSize{
+	ComputeSmall:  {CPU: 256, Memory: 512},
+	ComputeMedium: {CPU: 1024, Memory: 2048},
+	ComputeLarge:  {CPU: 4096, Memory: 8192},
+}
+
+// fargateTaskSizes are the memory values in MiB supported by Fargate for each CPU value.
+// See https://docs.aws.amazon.com/AmazonECS/latest/developerguide/task-cpu-memory-error.html
+var fargateTaskSizes = []struct {
+	cpu                  int
+	minMemory, maxMemory int
+}{
+	{cpu: 256, minMemory: 512, maxMemory: 2048},
+	{cpu: 512, minMemory: 1024, maxMemory: 4096},
+	{cpu: 1024, minMemory: 2048, maxMemory: 8192},
+	{cpu: 2048, minMemory: 4096, maxMemory: 16384},
+	{cpu: 4096, minMemory: 8192, maxMemory: 30720},
+}
+
+// fargateMemoryIncrement is the step between two supported memory values of a CPU value, except for 512 MiB with 256 CPU units.
+const fargateMemoryIncrement = 1024
+
+// TaskSize is the CPU units and the memory in MiB of a task.
+type TaskSize struct {
+	CPU    int
+	Memory int
+}
+
+func (s TaskSize) String() string {
+	return fmt.Sprintf("cpu %d and memory %d", s.CPU, s.Memory)
+}
+
+// ComputePreset returns the task size of the compute preset.
+func ComputePreset(name string) (TaskSize, error) {
+	size, ok := computePresetSizes[name]
+	if !ok {
+		return TaskSize{}, fmt.Errorf("invalid compute preset %s: must be one of %s", name, strings.Join(ComputePresets, ", "))
+	}
+	return size, nil
+}
+
+// ValidateTaskSize returns an ErrInvalidTaskSize if Fargate doesn't support the combination of CPU units and memory.
+func ValidateTaskSize(cpu, memory int) error {
+	size := TaskSize{CPU: cpu, Memory: memory}
+	for _, s := range fargateTaskSizes {
+		if s.cpu == cpu && isFargateMemory(memory, s.minMemory, s.maxMemory) {
+			return nil
+		}
+	}
+	return &ErrInvalidTaskSize{
+		Size:        size,
+		Suggestions: suggestTaskSizes(size),
+	}
+}
+
+// validateTaskSizes validates the task size of the service, and of each environment with the overrides applied.
+func validateTaskSizes(base TaskConfig, overrides map[string]TaskConfig) error {
+	if base.CPU != nil && base.Memory != nil {
+		if err := ValidateTaskSize(*base.CPU, *base.Memory); err != nil {
+			return err
+		}
+	}
+	var envs []string
+	for env := range overrides {
+		envs = append(envs, env)
+	}
+	sort.Strings(envs)
+	for _, env := range envs {
+		override := overrides[env]
+		if override.CPU == nil && override.Memory == nil {
+			continue
+		}
+		cpu, memory := base.CPU, base.Memory
+		if override.CPU != nil {
+			cpu = override.CPU
+		}
+		if override.Memory != nil {
+			memory = override.Memory
+		}
+		if cpu == nil || memory == nil {
+			continue
+		}
+		if err := ValidateTaskSize(*cpu, *memory); err != nil {
+			return fmt.Errorf("environment %s: %w", env, err)
+		}
+	}
+	return nil
+}
+
+func isFargateMemory(memory, minMemory, maxMemory int) bool {
+	if memory < minMemory || memory > maxMemory {
+		return false
+	}
+	return memory == minMemory || memory%fargateMemoryIncrement == 0
+}
+
+// suggestTaskSizes returns the supported task sizes closest to the invalid one: the closest memory
+// for the CPU units, and the smallest CPU units that support the memory.
+func suggestTaskSizes(size TaskSize) []TaskSize {
+	var suggestions []TaskSize
+	row := fargateTaskSizes[len(fargateTaskSizes)-1]
+	for _, s := range fargateTaskSizes {
+		if s.cpu >= size.CPU {
+			row = s
+			break
+		}
+	}
+	suggestions = append(suggestions, TaskSize{
+		CPU:    row.cpu,
+		Memory: closestFargateMemory(size.Memory, row.minMemory, row.maxMemory),
+	})
+	for _, s := range fargateTaskSizes {
+		if !isFargateMemory(size.Memory, s.minMemory, s.maxMemory) {
+			continue
+		}
+		if suggestion := (TaskSize{CPU: s.cpu, Memory: size.Memory}); suggestion != suggestions[0] {
+			suggestions = append(suggestions, suggestion)
+		}
+		break
+	}
+	return suggestions
+}
+
+// closestFargateMemory returns the supported memory value between min and max that is closest to memory, rounding up on ties.
+func closestFargateMemory(memory, minMemory, maxMemory int) int {
+	if memory <= minMemory {
+		return minMemory
+	}
+	if memory >= maxMemory {
+		return maxMemory
+	}
+	lower := memory / fargateMemoryIncrement * fargateMemoryIncrement
+	if lower < minMemory {
+		lower = minMemory
+	}
+	upper := (memory + fargateMemoryIncrement - 1) / fargateMemoryIncrement * fargateMemoryIncrement
+	if memory-lower < upper-memory {
+		return lower
+	}
+	return upper
+}
@@ -0,0 +1,120 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestComputePreset(t *testing.T) {
+	testCases := map[string]struct {
+		inName string
+
+		wantedSize TaskSize
+		wantedErr  string
+	}{
+		"small": {
+			inName:     "small",
+			wantedSize: TaskSize{CPU: 256, Memory: 512},
+		},
+		"large": {
+			inName:     "large",
+			wantedSize: TaskSize{CPU: 4096, Memory: 8192},
+		},
+		"unknown preset": {
+			inName:    "xlarge",
+			wantedErr: "invalid compute preset xlarge: must be one of small, medium, large",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			size, err := ComputePreset(tc.inName)
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedSize, size)
+		})
+	}
+}
+
+func TestValidateTaskSize(t *testing.T) {
+	testCases := map[string]struct {
+		inCPU    int
+		inMemory int
+
+		wantedSuggestions []TaskSize
+	}{
+		"smallest task size": {
+			inCPU:    256,
+			inMemory: 512,
+		},
+		"memory in increments of 1024": {
+			inCPU:    2048,
+			inMemory: 7168,
+		},
+		"largest task size": {
+			inCPU:    4096,
+			inMemory: 30720,
+		},
+		"too much memory for the cpu": {
+			inCPU:    256,
+			inMemory: 4096,
+			wantedSuggestions: []TaskSize{
+				{CPU: 256, Memory: 2048},
+				{CPU: 512, Memory: 4096},
+			},
+		},
+		"too little memory for the cpu": {
+			inCPU:    1024,
+			inMemory: 512,
+			wantedSuggestions: []TaskSize{
+				{CPU: 1024, Memory: 2048},
+				{CPU: 256, Memory: 512},
+			},
+		},
+		"memory not in increments of 1024 rounds to the closest": {
+			inCPU:    1024,
+			inMemory: 3000,
+			wantedSuggestions: []TaskSize{
+				{CPU: 1024, Memory: 3072},
+			},
+		},
+		"unsupported cpu rounds up": {
+			inCPU:    768,
+			inMemory: 2048,
+			wantedSuggestions: []TaskSize{
+				{CPU: 1024, Memory: 2048},
+				{CPU: 256, Memory: 2048},
+			},
+		},
+		"cpu larger than supported": {
+			inCPU:    8192,
+			inMemory: 65536,
+			wantedSuggestions: []TaskSize{
+				{CPU: 4096, Memory: 30720},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateTaskSize(tc.inCPU, tc.inMemory)
+
+			if tc.wantedSuggestions == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.Equal(t, &ErrInvalidTaskSize{
+				Size:        TaskSize{CPU: tc.inCPU, Memory: tc.inMemory},
+				Suggestions: tc.wantedSuggestions,
+			}, err)
+		})
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Destinations of the VPC flow logs of an environment.
+const (
+	FlowLogsDestinationCloudWatch = "cloudwatch"
+	FlowLogsDestinationS3         = "s3"
+)
+
+var (
+	flowLogsDestinations = []string{FlowLogsDestinationCloudWatch, FlowLogsDestinationS3}
+	flowLogsTrafficTypes = []string{"ALL", "ACCEPT", "REJECT"}
+)
+
+// Environment holds the configuration of an environment that is applied with "copilot env deploy".
+// Fields that are not set keep the configuration that the environment was created with.
+type Environment struct {
+	Name          *string                  `yaml:"name"`
+	Protected     *bool                    `yaml:"protected"` // Whether deploying to or deleting from the environment requires confirming its name.
+	Network       EnvironmentNetwork       `yaml:"network"`
+	HTTP          EnvironmentHTTP          `yaml:"http"`
+	Observability EnvironmentObservability `yaml:"observability"`
+}
+
+// EnvironmentNetwork holds the network resources of an environment.
+type EnvironmentNetwork struct {
+	VPC        *EnvironmentVPC      `yaml:"vpc"`
+	EgressEIPs []string             `yaml:"egress_eips"` // Elastic IP allocation IDs of the NAT gateways, one per public subnet.
+	FlowLogs   *EnvironmentFlowLogs `yaml:"flow_logs"`
+}
+
+// EnvironmentFlowLogs holds the configuration of the flow logs that capture the IP traffic of the VPC.
+type EnvironmentFlowLogs struct {
+	Enabled             *bool   `yaml:"enabled"`
+	Destination         *string `yaml:"destination"`          // Either "cloudwatch" or "s3".
+	AggregationInterval *int    `yaml:"aggregation_interval"` // Seconds during which a flow is captured, either 60 or 600.
+	TrafficType         *string `yaml:"traffic_type"`         // One of "ALL", "ACCEPT" or "REJECT".
+}
+
+// EnvironmentVPC holds either an existing VPC to import, or the CIDR ranges of the VPC that Copilot creates.
+type EnvironmentVPC struct {
+	ID   *string `yaml:"id"`
+	CIDR *string `yaml:"cidr"`
+	// Subnets are IDs of existing subnets if the VPC is imported, CIDR ranges otherwise.
+	PublicSubnets  []string `yaml:"public_subnets"`
+	PrivateSubnets []string `yaml:"private_subnets"`
+}
+
+// EnvironmentHTTP holds the configuration of the HTTPS listener of the public load balancer.
+type EnvironmentHTTP struct {
+	// Certificates are ARNs of existing ACM certificates. The first one is the default certificate
+	// of the listener, the others are selected by SNI.
+	Certificates []string `yaml:"certificates"`
+	// AccessLogs stores the access logs of the load balancer in S3, to be queried with Athena.
+	AccessLogs *bool `yaml:"access_logs"`
+	// CloudFrontOnly restricts the ingress of the load balancer to the origin-facing servers of CloudFront.
+	CloudFrontOnly *bool `yaml:"cloudfront_only"`
+}
+
+// EnvironmentObservability holds the monitoring features of an environment.
+type EnvironmentObservability struct {
+	ContainerInsights *bool `yaml:"container_insights"`
+}
+
+// IsImported returns true if the VPC already exists and isn't created by Copilot.
+func (v *EnvironmentVPC) IsImported() bool {
+	return v.ID != nil
+}
+
+// UnmarshalEnvironment deserializes the YAML input stream into an environment manifest object.
+func UnmarshalEnvironment(in []byte) (*Environment, error) {
+	env := Environment{}
+	if err := yaml.Unmarshal(in, &env); err != nil {
+		return nil, err
+	}
+	if err := env.Network.FlowLogs.validate(); err != nil {
+		return nil, err
+	}
+	vpc := env.Network.VPC
+	if vpc == nil {
+		return &env, nil
+	}
+	if vpc.ID != nil && vpc.CIDR != nil {
+		return nil, errors.New(`must specify one of "network.vpc.id" or "network.vpc.cidr"`)
+	}
+	if vpc.IsImported() && len(env.Network.EgressEIPs) > 0 {
+		return nil, errors.New(`cannot specify "network.egress_eips" with an imported VPC`)
+	}
+	return &env, nil
+}
+
+func (f *EnvironmentFlowLogs) validate() error {
+	if f == nil {
+		return nil
+	}
+	if f.Destination != nil && !contains(*f.Destination, flowLogsDestinations) {
+		return fmt.Errorf(`"network.flow_logs.destination" must be one of %s`, strings.Join(flowLogsDestinations, ", "))
+	}
+	if f.TrafficType != nil && !contains(*f.TrafficType, flowLogsTrafficTypes) {
+		return fmt.Errorf(`"network.flow_logs.traffic_type" must be one of %s`, strings.Join(flowLogsTrafficTypes, ", "))
+	}
+	if f.AggregationInterval != nil && *f.AggregationInterval != 60 && *f.AggregationInterval != 600 {
+		return errors.New(`"network.flow_logs.aggregation_interval" must be either 60 or 600 seconds`)
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnmarshalEnvironment(t *testing.T) {
+	testCases := map[string]struct {
+		inContent string
+
+		wantedEnv *Environment
+		wantedErr error
+	}{
+		"unmarshals a configured VPC": {
+			inContent: `
+name: test
+protected: true
+network:
+  vpc:
+    cidr: 10.1.0.0/16
+    public_subnets: [10.1.0.0/24, 10.1.1.0/24]
+    private_subnets: [10.1.2.0/24, 10.1.3.0/24]
+  egress_eips: [eipalloc-0123456789abcdef0, eipalloc-0123456789abcdef1]
+  flow_logs:
+    enabled: true
+    destination: s3
+    aggregation_interval: 60
+    traffic_type: REJECT
+http:
+  certificates:
+    - arn:aws:acm:us-west-2:123456789012:certificate/1
+    - arn:aws:acm:us-west-2:123456789012:certificate/2
+  access_logs: true
+observability:
+  container_insights: true
+`,
+			wantedEnv: &Environment{
+				Name:      aws.String("test"),
+				Protected: aws.Bool(true),
+				Network: EnvironmentNetwork{
+					VPC: &EnvironmentVPC{
+						CIDR:           aws.String("10.1.0.0/16"),
+						PublicSubnets:  []string{"10.1.0.0/24", "10.1.1.0/24"},
+						PrivateSubnets: []string{"10.1.2.0/24", "10.1.3.0/24"},
+					},
+					EgressEIPs: []string{"eipalloc-0123456789abcdef0", "eipalloc-0123456789abcdef1"},
+					FlowLogs: &EnvironmentFlowLogs{
+						Enabled:             aws.Bool(true),
+						Destination:         aws.String("s3"),
+						AggregationInterval: aws.Int(60),
+						TrafficType:         aws.String("REJECT"),
+					},
+				},
+				HTTP: EnvironmentHTTP{
+					Certificates: []string{"arn:aws:acm:us-west-2:123456789012:certificate/1", "arn:aws:acm:us-west-2:123456789012:certificate/2"},
+					AccessLogs:   aws.Bool(true),
+				},
+				Observability: EnvironmentObservability{
+					ContainerInsights: aws.Bool(true),
+				},
+			},
+		},
+		"unmarshals an imported VPC": {
+			inContent: `
+network:
+  vpc:
+    id: vpc-0123
+    public_subnets: [subnet-1, subnet-2]
+    private_subnets: [subnet-3, subnet-4]
+`,
+			wantedEnv: &Environment{
+				Network: EnvironmentNetwork{
+					VPC: &EnvironmentVPC{
+						ID:             aws.String("vpc-0123"),
+						PublicSubnets:  []string{"subnet-1", "subnet-2"},
+						PrivateSubnets: []string{"subnet-3", "subnet-4"},
+					},
+				},
+			},
+		},
+		"both an imported and a configured VPC": {
+			inContent: `
+network:
+  vpc:
+    id: vpc-0123
+    cidr: 10.1.0.0/16
+`,
+			wantedErr: errors.New(`must specify one of "network.vpc.id" or "network.vpc.cidr"`),
+		},
+		"Elastic IPs with an imported VPC": {
+			inContent: `
+network:
+  vpc:
+    id: vpc-0123
+  egress_eips: [eipalloc-0123456789abcdef0]
+`,
+			wantedErr: errors.New(`cannot specify "network.egress_eips" with an imported VPC`),
+		},
+		"invalid flow logs destination": {
+			inContent: `
+network:
+  flow_logs:
+    destination: kinesis
+`,
+			wantedErr: errors.New(`"network.flow_logs.destination" must be one of cloudwatch, s3`),
+		},
+		"invalid flow logs traffic type": {
+			inContent: `
+network:
+  flow_logs:
+    traffic_type: all
+`,
+			wantedErr: errors.New(`"network.flow_logs.traffic_type" must be one of ALL, ACCEPT, REJECT`),
+		},
+		"invalid flow logs aggregation interval": {
+			inContent: `
+network:
+  flow_logs:
+    aggregation_interval: 300
+`,
+			wantedErr: errors.New(`"network.flow_logs.aggregation_interval" must be either 60 or 600 seconds`),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			env, err := UnmarshalEnvironment([]byte(tc.inContent))
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedEnv, env)
+		})
+	}
+}
@@ -5,6 +5,7 @@ package manifest
 
 import (
 	"fmt"
+	"strings"
 )
 
 // ErrInvalidSvcManifestType occurs when a user requested a manifest template type that doesn't exist.
@@ -16,6 +17,56 @@ func (e *ErrInvalidSvcManifestType) Error() string {
 	return fmt.Sprintf("invalid manifest type: %s", e.Type)
 }
 
+// ErrUnsupportedSvcManifestVersion occurs when a service manifest is of a newer version of the schema than the CLI supports.
+type ErrUnsupportedSvcManifestVersion struct {
+	Version ServiceSchemaVersion
+}
+
+func (e *ErrUnsupportedSvcManifestVersion) Error() string {
+	return fmt.Sprintf("manifest version %d is newer than the latest version %d supported by this version of Copilot, please upgrade Copilot",
+		e.Version, LatestServiceSchemaVersion)
+}
+
+// ErrOutdatedSvcManifestVersion occurs when a service manifest is of a version of the schema that can no longer be deployed.
+type ErrOutdatedSvcManifestVersion struct {
+	Version ServiceSchemaVersion
+}
+
+func (e *ErrOutdatedSvcManifestVersion) Error() string {
+	return fmt.Sprintf("manifest version %d is no longer supported, run `copilot manifest upgrade` to upgrade it to version %d",
+		e.Version, LatestServiceSchemaVersion)
+}
+
+// ErrUnknownFields occurs when a service manifest contains fields that aren't part of its type.
+type ErrUnknownFields struct {
+	Fields []*UnknownField
+}
+
+func (e *ErrUnknownFields) Error() string {
+	if len(e.Fields) == 1 {
+		return e.Fields[0].String()
+	}
+	lines := make([]string, len(e.Fields))
+	for i, f := range e.Fields {
+		lines[i] = "  " + f.String()
+	}
+	return fmt.Sprintf("%d unknown fields in manifest:\n%s", len(e.Fields), strings.Join(lines, "\n"))
+}
+
+// ErrInvalidTaskSize occurs when Fargate doesn't support the combination of CPU units and memory of a task.
+type ErrInvalidTaskSize struct {
+	Size        TaskSize
+	Suggestions []TaskSize // Closest supported task sizes.
+}
+
+func (e *ErrInvalidTaskSize) Error() string {
+	suggestions := make([]string, len(e.Suggestions))
+	for i, s := range e.Suggestions {
+		suggestions[i] = s.String()
+	}
+	return fmt.Sprintf("%s is not a task size supported by Fargate, try %s", e.Size, strings.Join(suggestions, " or "))
+}
+
 // ErrInvalidPipelineManifestVersion occurs when the pipeline.yml file
 // contains invalid schema version during unmarshalling.
 type ErrInvalidPipelineManifestVersion struct {
@@ -4,7 +4,9 @@
 package manifest
 
 import (
+	"fmt"
 	"path/filepath"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/template"
@@ -36,6 +38,9 @@ type LoadBalancedWebServiceConfig struct {
 	TaskConfig  `yaml:",inline"`
 	*LogConfig  `yaml:"logging,flow"`
 	Sidecar     `yaml:",inline"`
+	Events      `yaml:",inline"`
+	Deployment  DeploymentConfig `yaml:"deployment,flow"`
+	Storage     *Storage         `yaml:"storage"`
 }
 
 // LogConfigOpts converts the service's Firelens configuration into a format parsable by the templates pkg.
@@ -46,12 +51,61 @@ func (lc *LoadBalancedWebServiceConfig) LogConfigOpts() *template.LogConfigOpts
 	return lc.logConfigOpts()
 }
 
+// StorageOpts converts the volumes of the service and of its sidecars into a format parsable by the templates pkg.
+func (lc *LoadBalancedWebServiceConfig) StorageOpts() (*template.StorageOpts, error) {
+	return storageOpts(lc.Storage, lc.Sidecar)
+}
+
+// ValidateSidecarResources returns an error if the CPU and memory of the log router and sidecars don't fit the task size.
+func (lc *LoadBalancedWebServiceConfig) ValidateSidecarResources() error {
+	return validateContainerResources(lc.TaskConfig, lc.LogConfig, lc.Sidecars)
+}
+
+// ValidateSidecarDependencies returns an error if the sidecars depend on containers that don't exist or can't
+// satisfy the conditions.
+func (s *LoadBalancedWebService) ValidateSidecarDependencies() error {
+	return validateSidecarDependencies(aws.StringValue(s.Name), false, s.LogConfig, s.Sidecars)
+}
+
 // RoutingRule holds the path to route requests to the service.
 type RoutingRule struct {
 	Path            *string `yaml:"path"`
 	HealthCheckPath *string `yaml:"healthcheck"`
 	// TargetContainer is the container load balancer routes traffic to.
 	TargetContainer *string `yaml:"targetContainer"`
+	// Alias is an additional domain name for the service in a hosted zone that isn't managed by the application.
+	Alias *Alias `yaml:"alias,flow"`
+	// HealthCheckGracePeriod is how long the load balancer health checks are ignored after a task starts.
+	HealthCheckGracePeriod *time.Duration `yaml:"healthcheck_grace_period"`
+}
+
+// HealthCheckGracePeriodSeconds returns the health check grace period in seconds, or nil if it isn't set.
+func (r *RoutingRule) HealthCheckGracePeriodSeconds() (*int64, error) {
+	if r.HealthCheckGracePeriod == nil {
+		return nil, nil
+	}
+	if *r.HealthCheckGracePeriod < 0 {
+		return nil, fmt.Errorf("healthcheck_grace_period %s must not be negative", *r.HealthCheckGracePeriod)
+	}
+	return aws.Int64(int64(r.HealthCheckGracePeriod.Seconds())), nil
+}
+
+// Alias holds a record to create in an existing hosted zone that points to the service's load balancer.
+type Alias struct {
+	Name         *string `yaml:"name"`
+	HostedZoneID *string `yaml:"hostedZone"`
+	// RoleARN is the IAM role assumed to manage records if the hosted zone belongs to another account.
+	RoleARN *string       `yaml:"role"`
+	Routing *AliasRouting `yaml:"routing,flow"`
+}
+
+// AliasRouting holds the Route 53 routing policy used when the alias points to the load balancers of several environments.
+type AliasRouting struct {
+	Policy   *string `yaml:"policy"`   // One of "weighted", "latency" or "failover".
+	Weight   *int64  `yaml:"weight"`   // Required by the "weighted" policy.
+	Failover *string `yaml:"failover"` // Either "primary" or "secondary", required by the "failover" policy.
+	// HealthCheck creates a Route 53 health check against the service's health check path through the load balancer.
+	HealthCheck *bool `yaml:"healthcheck"`
 }
 
 // LoadBalancedWebServiceProps contains properties for creating a new load balanced fargate service manifest.
@@ -59,6 +113,8 @@ type LoadBalancedWebServiceProps struct {
 	*ServiceProps
 	Path string
 	Port uint16
+
+	HealthCheckPath string // Optional path for the load balancer health check, defaults to "/".
 }
 
 // NewLoadBalancedWebService creates a new public load balanced web service, receives all the requests from the load balancer,
@@ -66,8 +122,9 @@ type LoadBalancedWebServiceProps struct {
 func NewLoadBalancedWebService(input *LoadBalancedWebServiceProps) *LoadBalancedWebService {
 	defaultLbManifest := newDefaultLoadBalancedWebService()
 	defaultLbManifest.Service = Service{
-		Name: aws.String(input.Name),
-		Type: aws.String(LoadBalancedWebServiceType),
+		Version: LatestServiceSchemaVersion,
+		Name:    aws.String(input.Name),
+		Type:    aws.String(LoadBalancedWebServiceType),
 	}
 	defaultLbManifest.Image = ServiceImageWithPort{
 		ServiceImage: ServiceImage{
@@ -79,7 +136,14 @@ func NewLoadBalancedWebService(input *LoadBalancedWebServiceProps) *LoadBalanced
 		},
 		Port: aws.Uint16(input.Port),
 	}
+	if input.TaskSize != nil {
+		defaultLbManifest.CPU = aws.Int(input.TaskSize.CPU)
+		defaultLbManifest.Memory = aws.Int(input.TaskSize.Memory)
+	}
 	defaultLbManifest.RoutingRule.Path = aws.String(input.Path)
+	if input.HealthCheckPath != "" {
+		defaultLbManifest.RoutingRule.HealthCheckPath = aws.String(input.HealthCheckPath)
+	}
 	defaultLbManifest.parser = template.New()
 	return defaultLbManifest
 }
@@ -96,7 +160,7 @@ func newDefaultLoadBalancedWebService() *LoadBalancedWebService {
 			TaskConfig: TaskConfig{
 				CPU:    aws.Int(256),
 				Memory: aws.Int(512),
-				Count:  aws.Int(1),
+				Count:  Count{Value: aws.Int(1)},
 			},
 		},
 	}
@@ -106,7 +170,8 @@ func newDefaultLoadBalancedWebService() *LoadBalancedWebService {
 // Implements the encoding.BinaryMarshaler interface.
 func (s *LoadBalancedWebService) MarshalBinary() ([]byte, error) {
 	content, err := s.parser.Parse(lbWebSvcManifestPath, *s, template.WithFuncs(map[string]interface{}{
-		"dirName": tplDirName,
+		"dirName":     tplDirName,
+		"stringValue": aws.StringValue,
 	}))
 	if err != nil {
 		return nil, err
@@ -123,6 +188,11 @@ func (s *LoadBalancedWebService) BuildArgs(wsRoot string) *DockerBuildArgs {
 	return s.Image.BuildConfig(wsRoot)
 }
 
+// ImageScanConfig returns the configuration to scan the image of the service for vulnerabilities, or nil if it's not scanned.
+func (s *LoadBalancedWebService) ImageScanConfig() *ImageScan {
+	return s.Image.Scan
+}
+
 // ApplyEnv returns the service manifest with environment overrides.
 // If the environment passed in does not have any overrides then it returns itself.
 func (s LoadBalancedWebService) ApplyEnv(envName string) (*LoadBalancedWebService, error) {
@@ -137,6 +207,21 @@ func (s LoadBalancedWebService) ApplyEnv(envName string) (*LoadBalancedWebServic
 	if err != nil {
 		return nil, err
 	}
+	if !overrideConfig.Count.IsEmpty() {
+		// The count is either an integer or a split between Fargate and Fargate Spot, so it's replaced instead of merged.
+		s.Count = overrideConfig.Count
+	}
 	s.Environments = nil
 	return &s, nil
 }
+
+// validateTaskSizes returns an error if Fargate doesn't support the task size of the service in any of its environments.
+func (s *LoadBalancedWebService) validateTaskSizes() error {
+	overrides := make(map[string]TaskConfig, len(s.Environments))
+	for env, config := range s.Environments {
+		if config != nil {
+			overrides[env] = config.TaskConfig
+		}
+	}
+	return validateTaskSizes(s.TaskConfig, overrides)
+}
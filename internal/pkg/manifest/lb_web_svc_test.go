@@ -7,6 +7,7 @@ import (
 	"bytes"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/template"
@@ -92,7 +93,7 @@ func TestLoadBalancedWebSvc_ApplyEnv(t *testing.T) {
 					TaskConfig: TaskConfig{
 						CPU:    aws.Int(1024),
 						Memory: aws.Int(1024),
-						Count:  aws.Int(1),
+						Count:  Count{Value: aws.Int(1)},
 					},
 				},
 			},
@@ -121,7 +122,7 @@ func TestLoadBalancedWebSvc_ApplyEnv(t *testing.T) {
 					TaskConfig: TaskConfig{
 						CPU:    aws.Int(1024),
 						Memory: aws.Int(1024),
-						Count:  aws.Int(1),
+						Count:  Count{Value: aws.Int(1)},
 					},
 				},
 			},
@@ -150,7 +151,7 @@ func TestLoadBalancedWebSvc_ApplyEnv(t *testing.T) {
 					TaskConfig: TaskConfig{
 						CPU:    aws.Int(1024),
 						Memory: aws.Int(1024),
-						Count:  aws.Int(1),
+						Count:  Count{Value: aws.Int(1)},
 						Variables: map[string]string{
 							"LOG_LEVEL":      "DEBUG",
 							"DDB_TABLE_NAME": "awards",
@@ -190,7 +191,7 @@ func TestLoadBalancedWebSvc_ApplyEnv(t *testing.T) {
 						},
 						TaskConfig: TaskConfig{
 							CPU:   aws.Int(2046),
-							Count: aws.Int(0),
+							Count: Count{Value: aws.Int(0)},
 							Variables: map[string]string{
 								"DDB_TABLE_NAME": "awards-prod",
 							},
@@ -236,7 +237,7 @@ func TestLoadBalancedWebSvc_ApplyEnv(t *testing.T) {
 					TaskConfig: TaskConfig{
 						CPU:    aws.Int(2046),
 						Memory: aws.Int(1024),
-						Count:  aws.Int(0),
+						Count:  Count{Value: aws.Int(0)},
 						Variables: map[string]string{
 							"LOG_LEVEL":      "DEBUG",
 							"DDB_TABLE_NAME": "awards-prod",
@@ -278,3 +279,41 @@ func TestLoadBalancedWebSvc_ApplyEnv(t *testing.T) {
 		})
 	}
 }
+
+func TestRoutingRule_HealthCheckGracePeriodSeconds(t *testing.T) {
+	testCases := map[string]struct {
+		inGracePeriod *time.Duration
+
+		wantedSeconds *int64
+		wantedErr     error
+	}{
+		"no grace period": {},
+		"converts the grace period to seconds": {
+			inGracePeriod: durationp(3 * time.Minute),
+			wantedSeconds: aws.Int64(180),
+		},
+		"negative grace period": {
+			inGracePeriod: durationp(-time.Second),
+			wantedErr:     errors.New("healthcheck_grace_period -1s must not be negative"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			rule := RoutingRule{
+				HealthCheckGracePeriod: tc.inGracePeriod,
+			}
+
+			// WHEN
+			seconds, err := rule.HealthCheckGracePeriodSeconds()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedSeconds, seconds)
+		})
+	}
+}
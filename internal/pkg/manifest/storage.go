@@ -0,0 +1,196 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"gopkg.in/yaml.v3"
+)
+
+var errUnmarshalEFSOpts = errors.New("can't unmarshal efs field into a boolean or a map with id, root_dir, auth, uid and gid")
+
+// Storage holds the volumes of the tasks of a service.
+type Storage struct {
+	Volumes map[string]*Volume `yaml:"volumes"`
+}
+
+// Volume is a volume of the task that's mounted into the main container. It's backed by an EFS file system
+// if EFS is set, otherwise it's scratch space that's deleted when the task stops.
+type Volume struct {
+	Path     *string         `yaml:"path"` // Absolute path in the main container to mount the volume at.
+	ReadOnly *bool           `yaml:"read_only"`
+	EFS      EFSConfigOrBool `yaml:"efs"`
+}
+
+// EFSConfigOrBool is a custom type which supports unmarshaling yaml which
+// can either be of type bool or type EFSVolumeConfiguration.
+type EFSConfigOrBool struct {
+	Enabled  *bool // Creates a file system for the service if true.
+	Advanced EFSVolumeConfiguration
+}
+
+// EFSVolumeConfiguration holds the EFS file system that backs a volume. Copilot creates the file system
+// unless the ID of an existing one is set.
+type EFSVolumeConfiguration struct {
+	FileSystemID  *string              `yaml:"id"`
+	RootDirectory *string              `yaml:"root_dir"` // Directory of the existing file system to mount, defaults to "/".
+	AuthConfig    *AuthorizationConfig `yaml:"auth"`
+	UID           *uint32              `yaml:"uid"` // POSIX user and group that own the files of a file system created by Copilot.
+	GID           *uint32              `yaml:"gid"`
+}
+
+// AuthorizationConfig holds how the tasks are authorized to access an existing file system.
+type AuthorizationConfig struct {
+	IAM           *bool   `yaml:"iam"` // Mounts the file system with the task role.
+	AccessPointID *string `yaml:"access_point_id"`
+}
+
+// UnmarshalYAML overrides the default YAML unmarshaling logic for the EFSConfigOrBool
+// struct, allowing it to be either a boolean or a map.
+// This method implements the yaml.Unmarshaler (v2) interface.
+func (e *EFSConfigOrBool) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if err := unmarshal(&e.Advanced); err != nil {
+		switch err.(type) {
+		case *yaml.TypeError:
+			break
+		default:
+			return err
+		}
+	}
+	if !e.Advanced.isEmpty() {
+		// Unmarshaled successfully to e.Advanced, return.
+		return nil
+	}
+	if err := unmarshal(&e.Enabled); err != nil {
+		return errUnmarshalEFSOpts
+	}
+	return nil
+}
+
+func (e *EFSConfigOrBool) mappingType() reflect.Type {
+	return reflect.TypeOf(EFSVolumeConfiguration{})
+}
+
+func (e *EFSConfigOrBool) isEmpty() bool {
+	return e.Enabled == nil && e.Advanced.isEmpty()
+}
+
+// managed returns true if Copilot creates the file system of the volume.
+func (e *EFSConfigOrBool) managed() bool {
+	if e.Advanced.FileSystemID != nil {
+		return false
+	}
+	return aws.BoolValue(e.Enabled) || !e.Advanced.isEmpty()
+}
+
+func (c *EFSVolumeConfiguration) isEmpty() bool {
+	return c.FileSystemID == nil && c.RootDirectory == nil && c.AuthConfig == nil && c.UID == nil && c.GID == nil
+}
+
+// storageOpts converts the volumes of the service, and the scratch volumes that only its sidecars mount,
+// into a format parsable by the templates pkg. It returns nil if the task has no volumes.
+func storageOpts(storage *Storage, sidecar Sidecar) (*template.StorageOpts, error) {
+	var names []string
+	if storage != nil {
+		for name := range storage.Volumes {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	opts := &template.StorageOpts{}
+	for _, name := range names {
+		volume := storage.Volumes[name]
+		if volume == nil {
+			continue
+		}
+		if !volumeNameRegexp.MatchString(name) {
+			return nil, fmt.Errorf(`volume name "%s" must contain only letters, numbers, dashes and underscores`, name)
+		}
+		path := aws.StringValue(volume.Path)
+		if !strings.HasPrefix(path, "/") {
+			return nil, fmt.Errorf(`path "%s" of volume %s must be absolute`, path, name)
+		}
+		efs, err := volume.efsOpts(name)
+		if err != nil {
+			return nil, err
+		}
+		if efs != nil {
+			efs.ReadOnly = aws.BoolValue(volume.ReadOnly)
+			opts.ManagedEFS = opts.ManagedEFS || efs.Managed
+		}
+		opts.Volumes = append(opts.Volumes, &template.VolumeOpts{
+			Name: name,
+			EFS:  efs,
+		})
+		opts.MountPoints = append(opts.MountPoints, &template.MountPointOpts{
+			SourceVolume:  name,
+			ContainerPath: path,
+			ReadOnly:      aws.BoolValue(volume.ReadOnly),
+		})
+	}
+	for _, name := range sidecar.Volumes() {
+		if storage != nil && storage.Volumes[name] != nil {
+			// The sidecar mounts a volume of the service.
+			continue
+		}
+		opts.Volumes = append(opts.Volumes, &template.VolumeOpts{
+			Name: name,
+		})
+	}
+	if len(opts.Volumes) == 0 {
+		return nil, nil
+	}
+	sort.SliceStable(opts.Volumes, func(i, j int) bool {
+		return opts.Volumes[i].Name < opts.Volumes[j].Name
+	})
+	return opts, nil
+}
+
+func (v *Volume) efsOpts(name string) (*template.EFSVolumeOpts, error) {
+	if v.EFS.isEmpty() || (v.EFS.Enabled != nil && !*v.EFS.Enabled) {
+		return nil, nil
+	}
+	conf := v.EFS.Advanced
+	if (conf.UID == nil) != (conf.GID == nil) {
+		return nil, fmt.Errorf("uid and gid of volume %s must be set together", name)
+	}
+	if v.EFS.managed() {
+		if conf.RootDirectory != nil || conf.AuthConfig != nil {
+			return nil, fmt.Errorf("root_dir and auth of volume %s can only be set with the id of an existing file system", name)
+		}
+		return &template.EFSVolumeOpts{
+			Managed: true,
+			IAM:     true,
+			UID:     conf.UID,
+			GID:     conf.GID,
+		}, nil
+	}
+	if conf.UID != nil {
+		return nil, fmt.Errorf("uid and gid of volume %s can only be set on a file system created by Copilot", name)
+	}
+	rootDir := aws.StringValue(conf.RootDirectory)
+	if rootDir != "" && !strings.HasPrefix(rootDir, "/") {
+		return nil, fmt.Errorf(`root_dir "%s" of volume %s must be absolute`, rootDir, name)
+	}
+	opts := &template.EFSVolumeOpts{
+		FileSystemID:  aws.StringValue(conf.FileSystemID),
+		RootDirectory: rootDir,
+	}
+	if conf.AuthConfig != nil {
+		opts.IAM = aws.BoolValue(conf.AuthConfig.IAM)
+		opts.AccessPointID = aws.StringValue(conf.AuthConfig.AccessPointID)
+	}
+	if opts.AccessPointID != "" && rootDir != "" && rootDir != "/" {
+		// The access point sets the directory that's mounted.
+		return nil, fmt.Errorf(`root_dir of volume %s must be empty or "/" when it's mounted with an access point`, name)
+	}
+	return opts, nil
+}
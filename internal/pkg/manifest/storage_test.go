@@ -0,0 +1,259 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestEFSConfigOrBool_UnmarshalYAML(t *testing.T) {
+	testCases := map[string]struct {
+		inContent string
+
+		wantedStruct EFSConfigOrBool
+		wantedErr    error
+	}{
+		"boolean": {
+			inContent: `efs: true`,
+			wantedStruct: EFSConfigOrBool{
+				Enabled: aws.Bool(true),
+			},
+		},
+		"existing file system": {
+			inContent: `efs:
+  id: fs-1234
+  root_dir: /shared
+  auth:
+    iam: true`,
+			wantedStruct: EFSConfigOrBool{
+				Advanced: EFSVolumeConfiguration{
+					FileSystemID:  aws.String("fs-1234"),
+					RootDirectory: aws.String("/shared"),
+					AuthConfig: &AuthorizationConfig{
+						IAM: aws.Bool(true),
+					},
+				},
+			},
+		},
+		"error if unmarshalable": {
+			inContent: `efs:
+  - fs-1234`,
+			wantedErr: errUnmarshalEFSOpts,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			v := Volume{}
+
+			// WHEN
+			err := yaml.Unmarshal([]byte(tc.inContent), &v)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedStruct, v.EFS)
+		})
+	}
+}
+
+func TestBackendServiceConfig_StorageOpts(t *testing.T) {
+	testCases := map[string]struct {
+		inContent string
+
+		wantedOpts *template.StorageOpts
+		wantedErr  error
+	}{
+		"no volumes": {
+			inContent: `
+cpu: 256`,
+		},
+		"invalid volume name": {
+			inContent: `
+storage:
+  volumes:
+    my data:
+      path: /var/data`,
+			wantedErr: errors.New(`volume name "my data" must contain only letters, numbers, dashes and underscores`),
+		},
+		"relative path": {
+			inContent: `
+storage:
+  volumes:
+    data:
+      path: var/data`,
+			wantedErr: errors.New(`path "var/data" of volume data must be absolute`),
+		},
+		"uid without a gid": {
+			inContent: `
+storage:
+  volumes:
+    data:
+      path: /var/data
+      efs:
+        uid: 1000`,
+			wantedErr: errors.New("uid and gid of volume data must be set together"),
+		},
+		"auth on a file system created by Copilot": {
+			inContent: `
+storage:
+  volumes:
+    data:
+      path: /var/data
+      efs:
+        auth:
+          iam: true`,
+			wantedErr: errors.New("root_dir and auth of volume data can only be set with the id of an existing file system"),
+		},
+		"uid on an existing file system": {
+			inContent: `
+storage:
+  volumes:
+    data:
+      path: /var/data
+      efs:
+        id: fs-1234
+        uid: 1000
+        gid: 1000`,
+			wantedErr: errors.New("uid and gid of volume data can only be set on a file system created by Copilot"),
+		},
+		"relative root directory": {
+			inContent: `
+storage:
+  volumes:
+    data:
+      path: /var/data
+      efs:
+        id: fs-1234
+        root_dir: shared`,
+			wantedErr: errors.New(`root_dir "shared" of volume data must be absolute`),
+		},
+		"root directory with an access point": {
+			inContent: `
+storage:
+  volumes:
+    data:
+      path: /var/data
+      efs:
+        id: fs-1234
+        root_dir: /shared
+        auth:
+          access_point_id: fsap-1234`,
+			wantedErr: errors.New(`root_dir of volume data must be empty or "/" when it's mounted with an access point`),
+		},
+		"managed, existing and scratch volumes with the sidecar volumes": {
+			inContent: `
+storage:
+  volumes:
+    data:
+      path: /var/data
+      efs: true
+    cache:
+      path: /var/cache
+      read_only: true
+      efs:
+        uid: 1000
+        gid: 1000
+    shared:
+      path: /mnt/shared
+      efs:
+        id: fs-1234
+        auth:
+          iam: true
+          access_point_id: fsap-1234
+    tmp:
+      path: /tmp/scratch
+      efs: false
+sidecars:
+  xray:
+    mount_points:
+      - source_volume: data
+        path: /data
+      - source_volume: logs
+        path: /logs`,
+			wantedOpts: &template.StorageOpts{
+				Volumes: []*template.VolumeOpts{
+					{
+						Name: "cache",
+						EFS: &template.EFSVolumeOpts{
+							Managed:  true,
+							IAM:      true,
+							ReadOnly: true,
+							UID:      aws.Uint32(1000),
+							GID:      aws.Uint32(1000),
+						},
+					},
+					{
+						Name: "data",
+						EFS: &template.EFSVolumeOpts{
+							Managed: true,
+							IAM:     true,
+						},
+					},
+					{
+						Name: "logs",
+					},
+					{
+						Name: "shared",
+						EFS: &template.EFSVolumeOpts{
+							FileSystemID:  "fs-1234",
+							AccessPointID: "fsap-1234",
+							IAM:           true,
+						},
+					},
+					{
+						Name: "tmp",
+					},
+				},
+				MountPoints: []*template.MountPointOpts{
+					{
+						SourceVolume:  "cache",
+						ContainerPath: "/var/cache",
+						ReadOnly:      true,
+					},
+					{
+						SourceVolume:  "data",
+						ContainerPath: "/var/data",
+					},
+					{
+						SourceVolume:  "shared",
+						ContainerPath: "/mnt/shared",
+					},
+					{
+						SourceVolume:  "tmp",
+						ContainerPath: "/tmp/scratch",
+					},
+				},
+				ManagedEFS: true,
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			var conf BackendServiceConfig
+			require.NoError(t, yaml.Unmarshal([]byte(tc.inContent), &conf))
+
+			// WHEN
+			opts, err := conf.StorageOpts()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedOpts, opts)
+		})
+	}
+}
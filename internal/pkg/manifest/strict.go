@@ -0,0 +1,225 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// maxSuggestionDistance is the maximum number of edits between an unknown field and a known field
+// for the known field to be suggested. Short fields need fewer edits, so that unrelated fields aren't suggested.
+const maxSuggestionDistance = 2
+
+var (
+	unmarshalerType         = reflect.TypeOf((*yaml.Unmarshaler)(nil)).Elem()
+	obsoleteUnmarshalerType = reflect.TypeOf((*interface {
+		UnmarshalYAML(unmarshal func(interface{}) error) error
+	})(nil)).Elem()
+)
+
+// mappingUnmarshaler is implemented by the types that unmarshal themselves from either a scalar or a mapping.
+// mappingType returns the type that a mapping is unmarshaled into, so that its fields can be checked.
+type mappingUnmarshaler interface {
+	mappingType() reflect.Type
+}
+
+// UnknownField is a field in a manifest that doesn't match any of the fields of the manifest type.
+type UnknownField struct {
+	Line       int
+	Path       string // Path to the field from the root of the manifest, such as "image.prot".
+	Suggestion string // Path to the closest known field, empty if none of the known fields are close.
+}
+
+func (f *UnknownField) String() string {
+	if f.Suggestion == "" {
+		return fmt.Sprintf("line %d: unknown field %q", f.Line, f.Path)
+	}
+	return fmt.Sprintf("line %d: unknown field %q, did you mean %q?", f.Line, f.Path, f.Suggestion)
+}
+
+// checkKnownFields returns an ErrUnknownFields if the YAML document in has fields that can't be unmarshaled into v.
+func checkKnownFields(in []byte, v interface{}) error {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(in, &doc); err != nil {
+		return err
+	}
+	if len(doc.Content) == 0 {
+		return nil
+	}
+	fields := unknownFields(doc.Content[0], reflect.TypeOf(v), "")
+	if len(fields) == 0 {
+		return nil
+	}
+	return &ErrUnknownFields{Fields: fields}
+}
+
+// unknownFields returns the fields of the node that don't match the yaml fields of typ.
+// Types that unmarshal themselves are only inspected if they implement mappingUnmarshaler.
+func unknownFields(node *yaml.Node, typ reflect.Type, path string) []*UnknownField {
+	if node.Kind == yaml.AliasNode {
+		node = node.Alias
+	}
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	if ptr := reflect.PtrTo(typ); ptr.Implements(unmarshalerType) || ptr.Implements(obsoleteUnmarshalerType) {
+		u, ok := reflect.Zero(ptr).Interface().(mappingUnmarshaler)
+		if !ok || node.Kind != yaml.MappingNode {
+			return nil
+		}
+		return unknownFields(node, u.mappingType(), path)
+	}
+	var unknown []*UnknownField
+	switch typ.Kind() {
+	case reflect.Struct:
+		if node.Kind != yaml.MappingNode {
+			return nil
+		}
+		known := yamlFields(typ)
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, val := node.Content[i], node.Content[i+1]
+			if key.Value == "<<" {
+				// Merge the fields of the anchored mappings into this one.
+				merged := []*yaml.Node{val}
+				if val.Kind == yaml.SequenceNode {
+					merged = val.Content
+				}
+				for _, m := range merged {
+					unknown = append(unknown, unknownFields(m, typ, path)...)
+				}
+				continue
+			}
+			fieldType, ok := known[key.Value]
+			if !ok {
+				field := &UnknownField{
+					Line: key.Line,
+					Path: joinPath(path, key.Value),
+				}
+				if suggestion := closestField(key.Value, known); suggestion != "" {
+					field.Suggestion = joinPath(path, suggestion)
+				}
+				unknown = append(unknown, field)
+				continue
+			}
+			unknown = append(unknown, unknownFields(val, fieldType, joinPath(path, key.Value))...)
+		}
+	case reflect.Map:
+		if node.Kind != yaml.MappingNode {
+			return nil
+		}
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			unknown = append(unknown, unknownFields(node.Content[i+1], typ.Elem(), joinPath(path, node.Content[i].Value))...)
+		}
+	case reflect.Slice:
+		if node.Kind != yaml.SequenceNode {
+			return nil
+		}
+		for i, elem := range node.Content {
+			unknown = append(unknown, unknownFields(elem, typ.Elem(), fmt.Sprintf("%s[%d]", path, i))...)
+		}
+	}
+	return unknown
+}
+
+// yamlFields returns the types of the fields of a struct keyed by their yaml name, including the fields of inlined structs.
+func yamlFields(typ reflect.Type) map[string]reflect.Type {
+	fields := make(map[string]reflect.Type)
+	for i := 0; i < typ.NumField(); i++ {
+		f := typ.Field(i)
+		if f.PkgPath != "" && !f.Anonymous {
+			// Unexported fields aren't unmarshaled.
+			continue
+		}
+		opts := strings.Split(f.Tag.Get("yaml"), ",")
+		name := opts[0]
+		if name == "-" {
+			continue
+		}
+		if contains("inline", opts[1:]) {
+			inlined := f.Type
+			for inlined.Kind() == reflect.Ptr {
+				inlined = inlined.Elem()
+			}
+			for k, v := range yamlFields(inlined) {
+				fields[k] = v
+			}
+			continue
+		}
+		if name == "" {
+			name = strings.ToLower(f.Name)
+		}
+		fields[name] = f.Type
+	}
+	return fields
+}
+
+// closestField returns the known field with the fewest edits from name, or an empty string if none are close enough.
+func closestField(name string, known map[string]reflect.Type) string {
+	var candidates []string
+	for k := range known {
+		candidates = append(candidates, k)
+	}
+	sort.Strings(candidates)
+	closest, minDistance := "", minInt(maxSuggestionDistance, (len(name)-1)/2)+1
+	for _, candidate := range candidates {
+		if d := editDistance(strings.ToLower(name), strings.ToLower(candidate)); d < minDistance {
+			closest, minDistance = candidate, d
+		}
+	}
+	return closest
+}
+
+// editDistance returns the number of insertions, deletions, substitutions and transpositions of adjacent characters
+// needed to turn a into b.
+func editDistance(a, b string) int {
+	d := make([][]int, len(a)+1)
+	for i := range d {
+		d[i] = make([]int, len(b)+1)
+		d[i][0] = i
+	}
+	for j := range d[0] {
+		d[0][j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			d[i][j] = minInt(d[i-1][j]+1, minInt(d[i][j-1]+1, d[i-1][j-1]+cost))
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				d[i][j] = minInt(d[i][j], d[i-2][j-2]+1)
+			}
+		}
+	}
+	return d[len(a)][len(b)]
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func joinPath(path, field string) string {
+	if path == "" {
+		return field
+	}
+	return path + "." + field
+}
+
+func contains(s string, items []string) bool {
+	for _, item := range items {
+		if s == item {
+			return true
+		}
+	}
+	return false
+}
@@ -5,14 +5,19 @@
 package manifest
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	"gopkg.in/yaml.v3"
 )
@@ -25,15 +30,36 @@ const (
 
 	defaultSidecarPort    = "80"
 	defaultFluentbitImage = "amazon/aws-for-fluent-bit:latest"
+	firelensContainerName = "firelens_log_router"
+
+	// Conditions that a container can wait for on another container of the task before starting.
+	containerConditionStart    = "START"
+	containerConditionComplete = "COMPLETE"
+	containerConditionSuccess  = "SUCCESS"
+	containerConditionHealthy  = "HEALTHY"
+
+	// Capacity providers of the environment clusters.
+	capacityProviderFargate     = "FARGATE"
+	capacityProviderFargateSpot = "FARGATE_SPOT"
+
+	defaultMinHealthyPercent = 100
+	defaultMaxPercent        = 200
 )
 
 var (
 	errUnmarshalBuildOpts = errors.New("can't unmarshal build field into string or compose-style map")
+	errUnmarshalCount     = errors.New("can't unmarshal count field into an integer or a map with onDemand and spot, or with range")
 	errNoDockerfile       = errors.New("must specify a Dockerfile path")
 )
 
 var dockerfileDefaultName = "Dockerfile"
 
+var (
+	eventRuleNameRegexp        = regexp.MustCompile("^[a-zA-Z0-9-]+$")
+	scheduledScalingNameRegexp = regexp.MustCompile("^[a-zA-Z0-9-]+$")
+	volumeNameRegexp           = regexp.MustCompile("^[a-zA-Z0-9_-]+$")
+)
+
 // ServiceTypes are the supported service manifest types.
 var ServiceTypes = []string{
 	LoadBalancedWebServiceType,
@@ -42,13 +68,44 @@ var ServiceTypes = []string{
 
 // Service holds the basic data that every service manifest file needs to have.
 type Service struct {
-	Name *string `yaml:"name"`
-	Type *string `yaml:"type"` // must be one of the supported manifest types.
+	Version   ServiceSchemaVersion `yaml:"version"`
+	Name      *string              `yaml:"name"`
+	Type      *string              `yaml:"type"`       // must be one of the supported manifest types.
+	DependsOn []string             `yaml:"depends_on"` // names of the services to deploy before this one.
+	Alarms    []string             `yaml:"alarms"`     // names of the CloudWatch alarms to show in the service's status.
+	// DeployTimeout is how long a deployment can take before it's canceled and rolled back, such as when tasks never stabilize.
+	DeployTimeout *time.Duration `yaml:"deploy_timeout"`
+}
+
+// Dependencies returns the names of the services that must be deployed before this service.
+func (s Service) Dependencies() []string {
+	return s.DependsOn
+}
+
+// AlarmNames returns the names of the CloudWatch alarms that are associated with the service.
+func (s Service) AlarmNames() []string {
+	return s.Alarms
+}
+
+// DeploymentTimeout returns how long a deployment of the service can take before it's canceled, or 0 if it's never canceled.
+func (s Service) DeploymentTimeout() time.Duration {
+	if s.DeployTimeout == nil {
+		return 0
+	}
+	return *s.DeployTimeout
 }
 
 // ServiceImage represents the service's container image.
 type ServiceImage struct {
 	Build BuildArgsOrString `yaml:"build"` // Path to the Dockerfile.
+	Scan  *ImageScan        `yaml:"scan"`  // Vulnerability scan of the image before it's pushed.
+}
+
+// ImageScan holds the configuration to scan the built image for vulnerabilities with a local scanner.
+type ImageScan struct {
+	Scanner           *string  `yaml:"scanner"`            // Either "trivy" or "grype".
+	SeverityThreshold *string  `yaml:"severity_threshold"` // Lowest severity of the vulnerabilities that block the deployment, such as "HIGH".
+	CVEs              []string `yaml:"cves"`               // Vulnerabilities that block the deployment regardless of their severity.
 }
 
 // BuildConfig populates a docker.BuildArguments struct from the fields available in the manifest.
@@ -147,6 +204,10 @@ func (b *BuildArgsOrString) UnmarshalYAML(unmarshal func(interface{}) error) err
 	return nil
 }
 
+func (b *BuildArgsOrString) mappingType() reflect.Type {
+	return reflect.TypeOf(DockerBuildArgs{})
+}
+
 // DockerBuildArgs represents the options specifiable under the "build" field
 // of Docker Compose services. For more information, see:
 // https://docs.docker.com/compose/compose-file/#build
@@ -176,6 +237,8 @@ type LogConfig struct {
 	EnableMetadata *bool             `yaml:"enableMetadata"`
 	SecretOptions  map[string]string `yaml:"secretOptions"`
 	ConfigFile     *string           `yaml:"configFilePath"`
+
+	ContainerResources `yaml:",inline"`
 }
 
 func (lc *LogConfig) logConfigOpts() *template.LogConfigOpts {
@@ -185,6 +248,10 @@ func (lc *LogConfig) logConfigOpts() *template.LogConfigOpts {
 		EnableMetadata: lc.enableMetadata(),
 		Destination:    lc.Destination,
 		SecretOptions:  lc.SecretOptions,
+
+		CPU:               lc.CPU,
+		Memory:            lc.Memory,
+		MemoryReservation: lc.MemoryReservation,
 	}
 }
 
@@ -219,61 +286,666 @@ func (s *Sidecar) SidecarsOpts() ([]*template.SidecarOpts, error) {
 		if err != nil {
 			return nil, err
 		}
+		healthCheck, err := config.healthCheckOpts(name)
+		if err != nil {
+			return nil, err
+		}
+		mountPoints, err := config.mountPointsOpts(name)
+		if err != nil {
+			return nil, err
+		}
 		sidecars = append(sidecars, &template.SidecarOpts{
-			Name:       aws.String(name),
-			Image:      config.Image,
-			Port:       port,
-			Protocol:   protocol,
-			CredsParam: config.CredsParam,
+			Name:        aws.String(name),
+			Image:       config.Image,
+			Port:        port,
+			Protocol:    protocol,
+			CredsParam:  config.CredsParam,
+			Essential:   config.Essential,
+			DependsOn:   config.dependsOnOpts(),
+			Variables:   config.Variables,
+			Secrets:     config.Secrets,
+			HealthCheck: healthCheck,
+			MountPoints: mountPoints,
+
+			CPU:               config.CPU,
+			Memory:            config.Memory,
+			MemoryReservation: config.MemoryReservation,
 		})
 	}
 	return sidecars, nil
 }
 
+// Volumes returns the names of the volumes mounted by the sidecars, sorted by name.
+func (s *Sidecar) Volumes() []string {
+	seen := make(map[string]bool)
+	var volumes []string
+	for _, config := range s.Sidecars {
+		if config == nil {
+			continue
+		}
+		for _, mp := range config.MountPoints {
+			volume := aws.StringValue(mp.SourceVolume)
+			if volume == "" || seen[volume] {
+				continue
+			}
+			seen[volume] = true
+			volumes = append(volumes, volume)
+		}
+	}
+	sort.Strings(volumes)
+	return volumes
+}
+
+// Events holds the EventBridge rules whose events are delivered to the service.
+type Events struct {
+	Events []EventRule `yaml:"events"`
+}
+
+// EventsOpts converts the service's EventBridge rules into a format parsable by the templates pkg.
+func (e *Events) EventsOpts() (*template.EventsOpts, error) {
+	if len(e.Events) == 0 {
+		return nil, nil
+	}
+	opts := &template.EventsOpts{}
+	seen := make(map[string]bool)
+	for _, rule := range e.Events {
+		name := aws.StringValue(rule.Name)
+		if !eventRuleNameRegexp.MatchString(name) {
+			return nil, fmt.Errorf(`event rule name "%s" must contain only letters, numbers and dashes`, name)
+		}
+		if seen[name] {
+			return nil, fmt.Errorf(`event rule name "%s" must be unique`, name)
+		}
+		seen[name] = true
+		if len(rule.Pattern) == 0 {
+			return nil, fmt.Errorf("event rule %s must have a pattern", name)
+		}
+		pattern, err := json.Marshal(rule.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("marshal the pattern of event rule %s: %w", name, err)
+		}
+		opts.Rules = append(opts.Rules, &template.EventRuleOpts{
+			Name:    name,
+			Bus:     rule.Bus,
+			Pattern: string(pattern),
+		})
+	}
+	return opts, nil
+}
+
+// Deployment types of a service.
+const (
+	DeploymentTypeRolling   = "rolling"
+	DeploymentTypeBlueGreen = "blue/green"
+)
+
+// Traffic shifts of a blue/green deployment.
+const (
+	TrafficShiftAllAtOnce = "all_at_once"
+	TrafficShiftLinear    = "linear"
+	TrafficShiftCanary    = "canary"
+)
+
+// trafficShiftConfigs are the predefined CodeDeploy deployment configurations of each traffic shift.
+// See https://docs.aws.amazon.com/codedeploy/latest/userguide/deployment-configurations.html#deployment-configuration-ecs
+var trafficShiftConfigs = map[string]string{
+	TrafficShiftAllAtOnce: "CodeDeployDefault.ECSAllAtOnce",
+	TrafficShiftLinear:    "CodeDeployDefault.ECSLinear10PercentEvery1Minutes",
+	TrafficShiftCanary:    "CodeDeployDefault.ECSCanary10Percent5Minutes",
+}
+
+// DeploymentConfig holds how the tasks of the service are replaced during a deployment.
+type DeploymentConfig struct {
+	Type              *string `yaml:"type"`                    // Defaults to rolling.
+	MinHealthyPercent *int    `yaml:"minimum_healthy_percent"` // Defaults to 100.
+	MaxPercent        *int    `yaml:"maximum_percent"`         // Defaults to 200.
+	// TrafficShift is how CodeDeploy shifts the traffic to the new tasks of a blue/green deployment. Defaults to all_at_once.
+	TrafficShift *string `yaml:"traffic_shift"`
+}
+
+// IsBlueGreen returns true if the service is deployed by CodeDeploy, which shifts the traffic from the
+// original tasks to a replacement set of tasks, instead of ECS replacing the tasks one batch at a time.
+func (d *DeploymentConfig) IsBlueGreen() bool {
+	return aws.StringValue(d.Type) == DeploymentTypeBlueGreen
+}
+
+// BlueGreenOpts converts the service's blue/green deployment configuration into a format parsable by the templates pkg.
+// It returns nil if the service isn't deployed with blue/green deployments.
+func (d *DeploymentConfig) BlueGreenOpts() (*template.BlueGreenOpts, error) {
+	if err := d.validateType(); err != nil {
+		return nil, err
+	}
+	if !d.IsBlueGreen() {
+		if d.TrafficShift != nil {
+			return nil, fmt.Errorf("traffic_shift can only be set with a %s deployment", DeploymentTypeBlueGreen)
+		}
+		return nil, nil
+	}
+	shift := TrafficShiftAllAtOnce
+	if d.TrafficShift != nil {
+		shift = *d.TrafficShift
+	}
+	config, ok := trafficShiftConfigs[shift]
+	if !ok {
+		return nil, fmt.Errorf("invalid traffic_shift %s: must be one of %s, %s or %s", shift, TrafficShiftAllAtOnce, TrafficShiftLinear, TrafficShiftCanary)
+	}
+	return &template.BlueGreenOpts{
+		DeploymentConfigName: config,
+	}, nil
+}
+
+func (d *DeploymentConfig) validateType() error {
+	if d.Type == nil {
+		return nil
+	}
+	if t := *d.Type; t != DeploymentTypeRolling && t != DeploymentTypeBlueGreen {
+		return fmt.Errorf("invalid deployment type %s: must be %s or %s", t, DeploymentTypeRolling, DeploymentTypeBlueGreen)
+	}
+	return nil
+}
+
+// DeploymentConfigOpts converts the service's deployment configuration into a format parsable by the templates pkg.
+func (d *DeploymentConfig) DeploymentConfigOpts() (*template.DeploymentConfigOpts, error) {
+	if err := d.validateType(); err != nil {
+		return nil, err
+	}
+	if d.MinHealthyPercent == nil && d.MaxPercent == nil {
+		return nil, nil
+	}
+	opts := &template.DeploymentConfigOpts{
+		MinHealthyPercent: defaultMinHealthyPercent,
+		MaxPercent:        defaultMaxPercent,
+	}
+	if d.MinHealthyPercent != nil {
+		opts.MinHealthyPercent = *d.MinHealthyPercent
+	}
+	if d.MaxPercent != nil {
+		opts.MaxPercent = *d.MaxPercent
+	}
+	if opts.MinHealthyPercent < 0 || opts.MinHealthyPercent > 100 {
+		return nil, fmt.Errorf("minimum_healthy_percent %d must be between 0 and 100", opts.MinHealthyPercent)
+	}
+	if opts.MaxPercent < 100 {
+		return nil, fmt.Errorf("maximum_percent %d must be at least 100", opts.MaxPercent)
+	}
+	return opts, nil
+}
+
+// EventRule represents the configurable options for subscribing the service to events on an EventBridge event bus.
+type EventRule struct {
+	Name    *string                `yaml:"name"`
+	Bus     *string                `yaml:"bus"`     // Name of the event bus, defaults to the account's default event bus.
+	Pattern map[string]interface{} `yaml:"pattern"` // See https://docs.aws.amazon.com/eventbridge/latest/userguide/eventbridge-and-event-patterns.html
+}
+
 // SidecarConfig represents the configurable options for setting up a sidecar container.
 type SidecarConfig struct {
 	Port       *string `yaml:"port"`
 	Image      *string `yaml:"image"`
 	CredsParam *string `yaml:"credentialsParameter"`
+	// Essential defaults to true, the task stops if an essential container exits.
+	Essential *bool `yaml:"essential"`
+	// DependsOn maps the names of the containers to wait for before starting the sidecar to their conditions.
+	DependsOn   map[string]string     `yaml:"dependsOn"`
+	Variables   map[string]string     `yaml:"variables"`
+	Secrets     map[string]string     `yaml:"secrets"`
+	HealthCheck *ContainerHealthCheck `yaml:"healthcheck"` // The command is required, the other fields have the same defaults as the main container's.
+	// MountPoints mount volumes shared between the sidecars of a task, they're scratch space that's deleted when the task stops.
+	MountPoints []SidecarMountPoint `yaml:"mount_points"`
+
+	ContainerResources `yaml:",inline"`
+}
+
+// SidecarMountPoint mounts a volume of the task into a sidecar.
+type SidecarMountPoint struct {
+	SourceVolume *string `yaml:"source_volume"` // Name of the volume, the volume is created for the task if it doesn't exist yet.
+	Path         *string `yaml:"path"`          // Absolute path in the container to mount the volume at.
+	ReadOnly     *bool   `yaml:"read_only"`
+}
+
+func (c *SidecarConfig) essential() bool {
+	return c.Essential == nil || *c.Essential
+}
+
+func (c *SidecarConfig) healthCheckOpts(name string) (*ecs.HealthCheck, error) {
+	if c.HealthCheck == nil {
+		return nil, nil
+	}
+	if len(c.HealthCheck.Command) == 0 {
+		return nil, fmt.Errorf("healthcheck of sidecar %s must have a command", name)
+	}
+	hc := newDefaultContainerHealthCheck()
+	hc.apply(c.HealthCheck)
+	return hc.opts(), nil
+}
+
+func (c *SidecarConfig) mountPointsOpts(name string) ([]*template.MountPointOpts, error) {
+	var opts []*template.MountPointOpts
+	for _, mp := range c.MountPoints {
+		volume, path := aws.StringValue(mp.SourceVolume), aws.StringValue(mp.Path)
+		if !volumeNameRegexp.MatchString(volume) {
+			return nil, fmt.Errorf(`source_volume "%s" of sidecar %s must contain only letters, numbers, dashes and underscores`, volume, name)
+		}
+		if !strings.HasPrefix(path, "/") {
+			return nil, fmt.Errorf(`path "%s" of volume %s in sidecar %s must be absolute`, path, volume, name)
+		}
+		opts = append(opts, &template.MountPointOpts{
+			SourceVolume:  volume,
+			ContainerPath: path,
+			ReadOnly:      aws.BoolValue(mp.ReadOnly),
+		})
+	}
+	return opts, nil
+}
+
+func (c *SidecarConfig) dependsOnOpts() map[string]string {
+	if len(c.DependsOn) == 0 {
+		return nil
+	}
+	opts := make(map[string]string, len(c.DependsOn))
+	for container, condition := range c.DependsOn {
+		opts[container] = strings.ToUpper(condition)
+	}
+	return opts
+}
+
+// validateSidecarDependencies returns an error if a sidecar depends on a container that doesn't exist, or with a
+// condition that the container can't satisfy. A container can only be waited on to complete or succeed if it
+// isn't essential, since the task stops when an essential container exits, and to be healthy if it has a health check.
+func validateSidecarDependencies(mainContainer string, mainHealthCheck bool, logging *LogConfig, sidecars map[string]*SidecarConfig) error {
+	type container struct {
+		essential   bool
+		healthCheck bool
+	}
+	containers := map[string]container{
+		mainContainer: {essential: true, healthCheck: mainHealthCheck},
+	}
+	if logging != nil {
+		containers[firelensContainerName] = container{essential: true}
+	}
+	var names []string
+	for name, sidecar := range sidecars {
+		if sidecar == nil {
+			continue
+		}
+		containers[name] = container{essential: sidecar.essential(), healthCheck: sidecar.HealthCheck != nil}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		var targets []string
+		for target := range sidecars[name].DependsOn {
+			targets = append(targets, target)
+		}
+		sort.Strings(targets)
+		for _, target := range targets {
+			condition := strings.ToUpper(sidecars[name].DependsOn[target])
+			if target == name {
+				return fmt.Errorf("sidecar %s cannot depend on itself", name)
+			}
+			dependency, ok := containers[target]
+			if !ok {
+				return fmt.Errorf("sidecar %s depends on container %s that doesn't exist", name, target)
+			}
+			switch condition {
+			case containerConditionStart:
+			case containerConditionComplete, containerConditionSuccess:
+				if dependency.essential {
+					verb := "complete"
+					if condition == containerConditionSuccess {
+						verb = "succeed"
+					}
+					return fmt.Errorf("sidecar %s cannot wait for essential container %s to %s: set essential to false on %s",
+						name, target, verb, target)
+				}
+			case containerConditionHealthy:
+				if !dependency.healthCheck {
+					return fmt.Errorf("sidecar %s cannot wait for container %s to be healthy: %s has no health check", name, target, target)
+				}
+			default:
+				return fmt.Errorf("sidecar %s depends on container %s with condition %s: must be one of %s", name, target, sidecars[name].DependsOn[target],
+					strings.Join([]string{containerConditionStart, containerConditionComplete, containerConditionSuccess, containerConditionHealthy}, ", "))
+			}
+		}
+	}
+	return nil
+}
+
+// ContainerResources represents the share of the task size reserved for a container other than the main one.
+type ContainerResources struct {
+	CPU               *int `yaml:"cpu"`               // CPU units reserved for the container.
+	Memory            *int `yaml:"memory"`            // Hard limit in MiB, the container is killed if it exceeds it.
+	MemoryReservation *int `yaml:"memoryReservation"` // Soft limit in MiB reserved for the container.
+}
+
+// reservedMemory returns the memory in MiB reserved for the container out of the task's memory.
+func (r ContainerResources) reservedMemory() int {
+	if r.MemoryReservation != nil {
+		return *r.MemoryReservation
+	}
+	return aws.IntValue(r.Memory)
+}
+
+// validateContainerResources returns an error if the resources of the log router or of a sidecar are invalid,
+// or if together they don't leave any CPU or memory of the task for the main container.
+func validateContainerResources(task TaskConfig, logging *LogConfig, sidecars map[string]*SidecarConfig) error {
+	resources := make(map[string]ContainerResources)
+	if logging != nil {
+		resources[firelensContainerName] = logging.ContainerResources
+	}
+	for name, sidecar := range sidecars {
+		if sidecar != nil {
+			resources[name] = sidecar.ContainerResources
+		}
+	}
+	var names []string
+	for name := range resources {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	taskCPU, taskMemory := aws.IntValue(task.CPU), aws.IntValue(task.Memory)
+	var cpu, memory int
+	for _, name := range names {
+		r := resources[name]
+		if r.Memory != nil && r.MemoryReservation != nil && *r.MemoryReservation > *r.Memory {
+			return fmt.Errorf("container %s: memoryReservation %d must not exceed memory %d", name, *r.MemoryReservation, *r.Memory)
+		}
+		if r.Memory != nil && *r.Memory > taskMemory {
+			return fmt.Errorf("container %s: memory %d must not exceed the memory %d of the task", name, *r.Memory, taskMemory)
+		}
+		cpu += aws.IntValue(r.CPU)
+		memory += r.reservedMemory()
+	}
+	if cpu >= taskCPU && cpu != 0 {
+		return fmt.Errorf("sidecars reserve %d CPU units out of the %d of the task, leaving none for the main container", cpu, taskCPU)
+	}
+	if memory >= taskMemory && memory != 0 {
+		return fmt.Errorf("sidecars reserve %d MiB of memory out of the %d of the task, leaving none for the main container", memory, taskMemory)
+	}
+	return nil
 }
 
 // TaskConfig represents the resource boundaries and environment variables for the containers in the task.
 type TaskConfig struct {
-	CPU       *int              `yaml:"cpu"`
-	Memory    *int              `yaml:"memory"`
-	Count     *int              `yaml:"count"` // 0 is a valid value, so we want the default value to be nil.
-	Variables map[string]string `yaml:"variables"`
-	Secrets   map[string]string `yaml:"secrets"`
+	CPU            *int              `yaml:"cpu"`
+	Memory         *int              `yaml:"memory"`
+	Count          Count             `yaml:"count"`
+	ExecuteCommand *bool             `yaml:"exec"` // Enables "copilot svc exec" into the tasks.
+	Variables      map[string]string `yaml:"variables"`
+	Secrets        map[string]string `yaml:"secrets"`
+}
+
+// Count is the number of tasks of a service. It's either an integer for tasks launched on Fargate,
+// the number of tasks to place on Fargate and on Fargate Spot, or a range that the service autoscales within.
+type Count struct {
+	Value    *int // 0 is a valid value, so we want the default value to be nil.
+	OnDemand *int // Number of tasks launched on Fargate when the tasks are split with Fargate Spot.
+	Spot     *int // Number of tasks placed on Fargate Spot.
+
+	Range    *string // Minimum and maximum number of tasks such as "1-10".
+	CPU      *int    // Target average CPU utilization of the service in percent.
+	Memory   *int    // Target average memory utilization of the service in percent.
+	Requests *int    // Target number of requests per task received from the load balancer.
+	// Ranges of tasks that the service scales to on a schedule, such as more tasks during business hours.
+	Schedules []ScheduledScaling
+}
+
+// ScheduledScaling changes the range of tasks of an autoscaled service on a cron schedule.
+type ScheduledScaling struct {
+	Name  *string `yaml:"name"`
+	Cron  *string `yaml:"cron"`  // Cron expression in UTC with six fields, such as "0 9 ? * MON-FRI *".
+	Range *string `yaml:"range"` // Minimum and maximum number of tasks from then on, such as "10-20".
+}
+
+// UnmarshalYAML overrides the default YAML unmarshaling logic for the Count
+// struct, allowing it to be either an integer or a map.
+// This method implements the yaml.Unmarshaler (v2) interface.
+func (c *Count) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var split advancedCount
+	if err := unmarshal(&split); err != nil {
+		switch err.(type) {
+		case *yaml.TypeError:
+			break
+		default:
+			return err
+		}
+	}
+	if split.OnDemand != nil || split.Spot != nil || split.Range != nil || split.CPU != nil || split.Memory != nil || split.Requests != nil ||
+		split.Schedules != nil {
+		// Unmarshaled successfully to the split between Fargate and Fargate Spot or to the autoscaling range, return.
+		c.OnDemand, c.Spot = split.OnDemand, split.Spot
+		c.Range, c.CPU, c.Memory, c.Requests = split.Range, split.CPU, split.Memory, split.Requests
+		c.Schedules = split.Schedules
+		return nil
+	}
+	if err := unmarshal(&c.Value); err != nil {
+		return errUnmarshalCount
+	}
+	return nil
+}
+
+func (c *Count) mappingType() reflect.Type {
+	return reflect.TypeOf(advancedCount{})
+}
+
+// advancedCount is the mapping form of a Count.
+type advancedCount struct {
+	OnDemand *int    `yaml:"onDemand"`
+	Spot     *int    `yaml:"spot"`
+	Range    *string `yaml:"range"`
+	CPU      *int    `yaml:"cpu_percentage"`
+	Memory   *int    `yaml:"memory_percentage"`
+	Requests *int    `yaml:"requests"`
+
+	Schedules []ScheduledScaling `yaml:"schedules"`
+}
+
+// IsEmpty returns true if the count isn't set.
+func (c Count) IsEmpty() bool {
+	return c.Value == nil && c.OnDemand == nil && c.Spot == nil &&
+		c.Range == nil && c.CPU == nil && c.Memory == nil && c.Requests == nil && c.Schedules == nil
+}
+
+// Desired returns the total number of tasks of the service.
+// If the service autoscales, it's the minimum of the range and it returns 0 if the range is invalid.
+func (c Count) Desired() int {
+	if c.Range != nil {
+		min, _, err := parseCountRange(aws.StringValue(c.Range))
+		if err != nil {
+			return 0
+		}
+		return min
+	}
+	if c.OnDemand != nil || c.Spot != nil {
+		return aws.IntValue(c.OnDemand) + aws.IntValue(c.Spot)
+	}
+	return aws.IntValue(c.Value)
+}
+
+// CapacityProviderOpts converts the split of the tasks between Fargate and Fargate Spot into a format parsable by the templates pkg.
+// It returns nil if none of the tasks are placed on Fargate Spot.
+func (c Count) CapacityProviderOpts() []*template.CapacityProviderStrategyOpts {
+	if aws.IntValue(c.Spot) == 0 {
+		return nil
+	}
+	spot := &template.CapacityProviderStrategyOpts{
+		CapacityProvider: capacityProviderFargateSpot,
+		Weight:           1,
+	}
+	if aws.IntValue(c.OnDemand) == 0 {
+		return []*template.CapacityProviderStrategyOpts{spot}
+	}
+	// Fargate gets exactly the on-demand tasks as its base, and with no weight the rest of the tasks go to Fargate Spot.
+	return []*template.CapacityProviderStrategyOpts{
+		{
+			CapacityProvider: capacityProviderFargate,
+			Base:             aws.IntValue(c.OnDemand),
+		},
+		spot,
+	}
+}
+
+// AutoscalingOpts converts the range and the target metrics of the count into a format parsable by the templates pkg.
+// It returns nil if the service doesn't autoscale.
+func (c Count) AutoscalingOpts() (*template.AutoscalingOpts, error) {
+	if c.Range == nil {
+		if c.CPU != nil || c.Memory != nil || c.Requests != nil || len(c.Schedules) != 0 {
+			return nil, errors.New(`count "range" must be set to autoscale the service`)
+		}
+		return nil, nil
+	}
+	if c.OnDemand != nil || c.Spot != nil {
+		return nil, errors.New(`count "range" can't be combined with "onDemand" and "spot"`)
+	}
+	min, max, err := parseCountRange(aws.StringValue(c.Range))
+	if err != nil {
+		return nil, err
+	}
+	if c.CPU == nil && c.Memory == nil && c.Requests == nil && len(c.Schedules) == 0 {
+		return nil, errors.New(`count "range" requires at least one of "cpu_percentage", "memory_percentage", "requests" or "schedules"`)
+	}
+	if c.CPU != nil && (*c.CPU <= 0 || *c.CPU > 100) {
+		return nil, fmt.Errorf(`count "cpu_percentage" must be between 1 and 100, got %d`, *c.CPU)
+	}
+	if c.Memory != nil && (*c.Memory <= 0 || *c.Memory > 100) {
+		return nil, fmt.Errorf(`count "memory_percentage" must be between 1 and 100, got %d`, *c.Memory)
+	}
+	if c.Requests != nil && *c.Requests <= 0 {
+		return nil, fmt.Errorf(`count "requests" must be positive, got %d`, *c.Requests)
+	}
+	schedules, err := c.scheduledScalingOpts()
+	if err != nil {
+		return nil, err
+	}
+	return &template.AutoscalingOpts{
+		MinCapacity: min,
+		MaxCapacity: max,
+		CPU:         c.CPU,
+		Memory:      c.Memory,
+		Requests:    c.Requests,
+		Schedules:   schedules,
+	}, nil
+}
+
+func (c Count) scheduledScalingOpts() ([]*template.ScheduledScalingOpts, error) {
+	var opts []*template.ScheduledScalingOpts
+	seen := make(map[string]bool)
+	for _, s := range c.Schedules {
+		name := aws.StringValue(s.Name)
+		if !scheduledScalingNameRegexp.MatchString(name) {
+			return nil, fmt.Errorf(`count schedule name "%s" must contain only letters, numbers and dashes`, name)
+		}
+		if seen[name] {
+			return nil, fmt.Errorf(`count schedule name "%s" must be unique`, name)
+		}
+		seen[name] = true
+		cron := strings.TrimSpace(aws.StringValue(s.Cron))
+		if fields := strings.Fields(cron); len(fields) != 6 {
+			return nil, fmt.Errorf(`count schedule %s must have a "cron" expression with 6 fields, got "%s"`, name, cron)
+		}
+		if s.Range == nil {
+			return nil, fmt.Errorf(`count schedule %s must have a "range"`, name)
+		}
+		min, max, err := parseCountRange(aws.StringValue(s.Range))
+		if err != nil {
+			return nil, fmt.Errorf("count schedule %s: %w", name, err)
+		}
+		opts = append(opts, &template.ScheduledScalingOpts{
+			Name:        name,
+			Schedule:    fmt.Sprintf("cron(%s)", cron),
+			MinCapacity: min,
+			MaxCapacity: max,
+		})
+	}
+	return opts, nil
+}
+
+// parseCountRange parses a range of tasks such as "1-10" into its minimum and maximum.
+func parseCountRange(r string) (min, max int, err error) {
+	bounds := strings.Split(r, "-")
+	if len(bounds) != 2 {
+		return 0, 0, fmt.Errorf(`count "range" %s must be in the format "min-max"`, r)
+	}
+	if min, err = strconv.Atoi(strings.TrimSpace(bounds[0])); err != nil {
+		return 0, 0, fmt.Errorf(`parse minimum of count "range" %s: %w`, r, err)
+	}
+	if max, err = strconv.Atoi(strings.TrimSpace(bounds[1])); err != nil {
+		return 0, 0, fmt.Errorf(`parse maximum of count "range" %s: %w`, r, err)
+	}
+	if min < 0 || min > max {
+		return 0, 0, fmt.Errorf(`count "range" %s must have a minimum between 0 and its maximum`, r)
+	}
+	return min, max, nil
+}
+
+// ExecuteCommandEnabled returns true if ECS Exec is enabled for the tasks.
+func (tc TaskConfig) ExecuteCommandEnabled() bool {
+	return aws.BoolValue(tc.ExecuteCommand)
 }
 
 // ServiceProps contains properties for creating a new service manifest.
 type ServiceProps struct {
 	Name       string
 	Dockerfile string
+	TaskSize   *TaskSize // Optional, defaults to the smallest task size.
 }
 
 // UnmarshalService deserializes the YAML input stream into a service manifest object.
 // If an error occurs during deserialization, then returns the error.
 // If the service type in the manifest is invalid, then returns an ErrInvalidManifestType.
+// If the manifest's version can't be deployed, then returns an ErrUnsupportedSvcManifestVersion or ErrOutdatedSvcManifestVersion.
+// If the manifest contains fields that aren't part of the service type, then returns an ErrUnknownFields.
 func UnmarshalService(in []byte) (interface{}, error) {
+	return unmarshalService(in, true)
+}
+
+// UnmarshalServiceLax is like UnmarshalService, but ignores the fields that aren't part of the service type.
+// It lets manifests written for newer versions of the CLI be read.
+func UnmarshalServiceLax(in []byte) (interface{}, error) {
+	return unmarshalService(in, false)
+}
+
+func unmarshalService(in []byte, strict bool) (interface{}, error) {
 	am := Service{}
 	if err := yaml.Unmarshal(in, &am); err != nil {
 		return nil, fmt.Errorf("unmarshal to service manifest: %w", err)
 	}
+	if err := validateServiceVersion(am.Version); err != nil {
+		return nil, err
+	}
 	typeVal := aws.StringValue(am.Type)
 
 	switch typeVal {
 	case LoadBalancedWebServiceType:
 		m := newDefaultLoadBalancedWebService()
+		if strict {
+			// Check the fields first, since a misspelled field can make the value of a custom type fail to unmarshal.
+			if err := checkKnownFields(in, m); err != nil {
+				return nil, err
+			}
+		}
 		if err := yaml.Unmarshal(in, m); err != nil {
 			return nil, fmt.Errorf("unmarshal to load balanced web service: %w", err)
 		}
+		if err := m.validateTaskSizes(); err != nil {
+			return nil, err
+		}
 		return m, nil
 	case BackendServiceType:
 		m := newDefaultBackendService()
+		if strict {
+			if err := checkKnownFields(in, m); err != nil {
+				return nil, err
+			}
+		}
 		if err := yaml.Unmarshal(in, m); err != nil {
 			return nil, fmt.Errorf("unmarshal to backend service: %w", err)
 		}
+		if err := m.validateTaskSizes(); err != nil {
+			return nil, err
+		}
 		if m.BackendServiceConfig.Image.HealthCheck != nil {
 			// Make sure that unset fields in the healthcheck gets a default value.
 			m.BackendServiceConfig.Image.HealthCheck.applyIfNotSet(newDefaultContainerHealthCheck())
@@ -4,11 +4,14 @@
 package manifest
 
 import (
+	"errors"
 	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/stretchr/testify/require"
 	"gopkg.in/yaml.v3"
 )
@@ -16,15 +19,20 @@ import (
 func TestUnmarshalSvc(t *testing.T) {
 	testCases := map[string]struct {
 		inContent string
+		lax       bool
 
 		requireCorrectValues func(t *testing.T, i interface{})
 		wantedErr            error
 	}{
 		"load balanced web service": {
 			inContent: `
-version: 1.0
 name: frontend
 type: "Load Balanced Web Service"
+depends_on:
+  - api
+alarms:
+  - frontend-high-latency
+deploy_timeout: 20m
 image:
   build: frontend/Dockerfile
   port: 80
@@ -55,12 +63,28 @@ logging:
 environments:
   test:
     count: 3
+  prod:
+    http:
+      alias:
+        name: api.example.com
+        hostedZone: Z0873220N255IR3MTNR4
+        role: arn:aws:iam::123456789012:role/dns-admin
+        routing:
+          policy: weighted
+          weight: 80
+          healthcheck: true
 `,
 			requireCorrectValues: func(t *testing.T, i interface{}) {
 				actualManifest, ok := i.(*LoadBalancedWebService)
 				require.True(t, ok)
 				wantedManifest := &LoadBalancedWebService{
-					Service: Service{Name: aws.String("frontend"), Type: aws.String(LoadBalancedWebServiceType)},
+					Service: Service{
+						Name:          aws.String("frontend"),
+						Type:          aws.String(LoadBalancedWebServiceType),
+						DependsOn:     []string{"api"},
+						Alarms:        []string{"frontend-high-latency"},
+						DeployTimeout: durationp(20 * time.Minute),
+					},
 					LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
 						Image: ServiceImageWithPort{ServiceImage: ServiceImage{Build: BuildArgsOrString{
 							BuildString: aws.String("frontend/Dockerfile"),
@@ -73,7 +97,7 @@ environments:
 						TaskConfig: TaskConfig{
 							CPU:    aws.Int(512),
 							Memory: aws.Int(1024),
-							Count:  aws.Int(1),
+							Count:  Count{Value: aws.Int(1)},
 							Variables: map[string]string{
 								"LOG_LEVEL": "WARN",
 							},
@@ -106,7 +130,21 @@ environments:
 					Environments: map[string]*LoadBalancedWebServiceConfig{
 						"test": {
 							TaskConfig: TaskConfig{
-								Count: aws.Int(3),
+								Count: Count{Value: aws.Int(3)},
+							},
+						},
+						"prod": {
+							RoutingRule: RoutingRule{
+								Alias: &Alias{
+									Name:         aws.String("api.example.com"),
+									HostedZoneID: aws.String("Z0873220N255IR3MTNR4"),
+									RoleARN:      aws.String("arn:aws:iam::123456789012:role/dns-admin"),
+									Routing: &AliasRouting{
+										Policy:      aws.String("weighted"),
+										Weight:      aws.Int64(80),
+										HealthCheck: aws.Bool(true),
+									},
+								},
 							},
 						},
 					},
@@ -124,7 +162,7 @@ image:
   healthcheck:
     command: ['CMD-SHELL', 'curl http://localhost:5000/ || exit 1']
 cpu: 1024
-memory: 1024
+memory: 2048
 secrets:
   API_TOKEN: SUBS_API_TOKEN`,
 			requireCorrectValues: func(t *testing.T, i interface{}) {
@@ -155,8 +193,8 @@ secrets:
 						},
 						TaskConfig: TaskConfig{
 							CPU:    aws.Int(1024),
-							Memory: aws.Int(1024),
-							Count:  aws.Int(1),
+							Memory: aws.Int(2048),
+							Count:  Count{Value: aws.Int(1)},
 							Secrets: map[string]string{
 								"API_TOKEN": "SUBS_API_TOKEN",
 							},
@@ -166,6 +204,28 @@ secrets:
 				require.Equal(t, wantedManifest, actualManifest)
 			},
 		},
+		"backend service with an image scan": {
+			inContent: `
+name: api
+type: "Backend Service"
+image:
+  build: api/Dockerfile
+  scan:
+    scanner: trivy
+    severity_threshold: HIGH
+    cves:
+      - CVE-2021-44228
+`,
+			requireCorrectValues: func(t *testing.T, i interface{}) {
+				actualManifest, ok := i.(*BackendService)
+				require.True(t, ok)
+				require.Equal(t, &ImageScan{
+					Scanner:           aws.String("trivy"),
+					SeverityThreshold: aws.String("HIGH"),
+					CVEs:              []string{"CVE-2021-44228"},
+				}, actualManifest.ImageScanConfig())
+			},
+		},
 		"invalid svc type": {
 			inContent: `
 name: CowSvc
@@ -173,11 +233,117 @@ type: 'OH NO'
 `,
 			wantedErr: &ErrInvalidSvcManifestType{Type: "OH NO"},
 		},
+		"newer manifest version": {
+			inContent: `
+version: 2
+name: CowSvc
+type: 'Backend Service'
+`,
+			wantedErr: &ErrUnsupportedSvcManifestVersion{Version: 2},
+		},
+		"unknown fields": {
+			inContent: `
+name: frontend
+type: "Load Balanced Web Service"
+image:
+  build: frontend/Dockerfile
+  prot: 80
+memroy: 1024
+base: &base
+  count: 1
+environments:
+  test:
+    <<: *base
+    http:
+      path: "svc"
+      target: "frontend"
+`,
+			wantedErr: errors.New(`4 unknown fields in manifest:
+  line 6: unknown field "image.prot", did you mean "image.port"?
+  line 7: unknown field "memroy", did you mean "memory"?
+  line 8: unknown field "base"
+  line 15: unknown field "environments.test.http.target"`),
+		},
+		"unknown fields of custom types": {
+			inContent: `
+name: frontend
+type: "Load Balanced Web Service"
+image:
+  build:
+    dockerfile: frontend/Dockerfile
+    contxt: frontend
+  port: 80
+count:
+  rnage: 1-10
+  cpu_percentage: 70
+storage:
+  volumes:
+    data:
+      path: /etc/data
+      efs:
+        id: fs-1234
+        rootdir: /data
+`,
+			wantedErr: errors.New(`3 unknown fields in manifest:
+  line 7: unknown field "image.build.contxt", did you mean "image.build.context"?
+  line 10: unknown field "count.rnage", did you mean "count.range"?
+  line 18: unknown field "storage.volumes.data.efs.rootdir", did you mean "storage.volumes.data.efs.root_dir"?`),
+		},
+		"unknown field of a count that fails to unmarshal": {
+			inContent: `
+name: api
+type: "Backend Service"
+count:
+  rnage: 1-10
+`,
+			wantedErr: errors.New(`line 5: unknown field "count.rnage", did you mean "count.range"?`),
+		},
+		"task size not supported by Fargate": {
+			inContent: `
+name: api
+type: "Backend Service"
+cpu: 256
+memory: 4096
+`,
+			wantedErr: errors.New("cpu 256 and memory 4096 is not a task size supported by Fargate, try cpu 256 and memory 2048 or cpu 512 and memory 4096"),
+		},
+		"task size of an environment not supported by Fargate": {
+			inContent: `
+name: frontend
+type: "Load Balanced Web Service"
+cpu: 512
+memory: 1024
+environments:
+  prod:
+    cpu: 2048
+`,
+			wantedErr: errors.New("environment prod: cpu 2048 and memory 1024 is not a task size supported by Fargate, try cpu 2048 and memory 4096 or cpu 256 and memory 1024"),
+		},
+		"unknown fields are ignored when lax": {
+			inContent: `
+name: api
+type: "Backend Service"
+image:
+  build: api/Dockerfile
+  port: 8080
+memroy: 1024
+`,
+			lax: true,
+			requireCorrectValues: func(t *testing.T, i interface{}) {
+				actualManifest, ok := i.(*BackendService)
+				require.True(t, ok)
+				require.Equal(t, aws.Uint16(8080), actualManifest.Image.Port)
+			},
+		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			m, err := UnmarshalService([]byte(tc.inContent))
+			unmarshal := UnmarshalService
+			if tc.lax {
+				unmarshal = UnmarshalServiceLax
+			}
+			m, err := unmarshal([]byte(tc.inContent))
 
 			if tc.wantedErr != nil {
 				require.EqualError(t, err, tc.wantedErr.Error())
@@ -255,6 +421,268 @@ func TestBuildArgsUnmarshalYAML(t *testing.T) {
 	}
 }
 
+func TestCountUnmarshalYAML(t *testing.T) {
+	testCases := map[string]struct {
+		inContent []byte
+
+		wantedCount Count
+		wantedError error
+	}{
+		"integer": {
+			inContent: []byte(`count: 3`),
+
+			wantedCount: Count{
+				Value: aws.Int(3),
+			},
+		},
+		"tasks on Fargate Spot": {
+			inContent: []byte(`count:
+  spot: 3`),
+
+			wantedCount: Count{
+				Spot: aws.Int(3),
+			},
+		},
+		"tasks split between Fargate and Fargate Spot": {
+			inContent: []byte(`count:
+  onDemand: 1
+  spot: 3`),
+
+			wantedCount: Count{
+				OnDemand: aws.Int(1),
+				Spot:     aws.Int(3),
+			},
+		},
+		"autoscaling range": {
+			inContent: []byte(`count:
+  range: 1-10
+  cpu_percentage: 70
+  requests: 500`),
+
+			wantedCount: Count{
+				Range:    aws.String("1-10"),
+				CPU:      aws.Int(70),
+				Requests: aws.Int(500),
+			},
+		},
+		"scheduled scaling": {
+			inContent: []byte(`count:
+  range: 2-10
+  schedules:
+    - name: business-hours
+      cron: "0 9 ? * MON-FRI *"
+      range: 10-20`),
+
+			wantedCount: Count{
+				Range: aws.String("2-10"),
+				Schedules: []ScheduledScaling{
+					{
+						Name:  aws.String("business-hours"),
+						Cron:  aws.String("0 9 ? * MON-FRI *"),
+						Range: aws.String("10-20"),
+					},
+				},
+			},
+		},
+		"Error if unmarshalable": {
+			inContent: []byte(`count:
+  spott: 3`),
+			wantedError: errUnmarshalCount,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var task TaskConfig
+			err := yaml.Unmarshal(tc.inContent, &task)
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedCount, task.Count)
+		})
+	}
+}
+
+func TestCount_CapacityProviderOpts(t *testing.T) {
+	testCases := map[string]struct {
+		in Count
+
+		wantedDesired int
+		wantedOpts    []*template.CapacityProviderStrategyOpts
+	}{
+		"tasks launched on Fargate": {
+			in: Count{Value: aws.Int(2)},
+
+			wantedDesired: 2,
+		},
+		"tasks on Fargate Spot": {
+			in: Count{Spot: aws.Int(3)},
+
+			wantedDesired: 3,
+			wantedOpts: []*template.CapacityProviderStrategyOpts{
+				{CapacityProvider: "FARGATE_SPOT", Weight: 1},
+			},
+		},
+		"tasks split between Fargate and Fargate Spot": {
+			in: Count{OnDemand: aws.Int(1), Spot: aws.Int(3)},
+
+			wantedDesired: 4,
+			wantedOpts: []*template.CapacityProviderStrategyOpts{
+				{CapacityProvider: "FARGATE", Base: 1},
+				{CapacityProvider: "FARGATE_SPOT", Weight: 1},
+			},
+		},
+		"no tasks on Fargate Spot": {
+			in: Count{OnDemand: aws.Int(2), Spot: aws.Int(0)},
+
+			wantedDesired: 2,
+		},
+		"autoscaling range": {
+			in: Count{Range: aws.String("2-10"), CPU: aws.Int(70)},
+
+			wantedDesired: 2,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wantedDesired, tc.in.Desired())
+			require.Equal(t, tc.wantedOpts, tc.in.CapacityProviderOpts())
+		})
+	}
+}
+
+func TestCount_AutoscalingOpts(t *testing.T) {
+	testCases := map[string]struct {
+		in Count
+
+		wantedOpts *template.AutoscalingOpts
+		wantedErr  string
+	}{
+		"not autoscaled": {
+			in: Count{Value: aws.Int(2)},
+		},
+		"error if target metrics are set without a range": {
+			in: Count{Value: aws.Int(2), CPU: aws.Int(70)},
+
+			wantedErr: `count "range" must be set to autoscale the service`,
+		},
+		"error if the range is combined with Fargate Spot": {
+			in: Count{Range: aws.String("1-10"), Spot: aws.Int(2), CPU: aws.Int(70)},
+
+			wantedErr: `count "range" can't be combined with "onDemand" and "spot"`,
+		},
+		"error if the range isn't in the min-max format": {
+			in: Count{Range: aws.String("10"), CPU: aws.Int(70)},
+
+			wantedErr: `count "range" 10 must be in the format "min-max"`,
+		},
+		"error if the minimum isn't a number": {
+			in: Count{Range: aws.String("one-10"), CPU: aws.Int(70)},
+
+			wantedErr: `parse minimum of count "range" one-10: strconv.Atoi: parsing "one": invalid syntax`,
+		},
+		"error if the minimum is greater than the maximum": {
+			in: Count{Range: aws.String("10-1"), CPU: aws.Int(70)},
+
+			wantedErr: `count "range" 10-1 must have a minimum between 0 and its maximum`,
+		},
+		"error if there are no target metrics": {
+			in: Count{Range: aws.String("1-10")},
+
+			wantedErr: `count "range" requires at least one of "cpu_percentage", "memory_percentage", "requests" or "schedules"`,
+		},
+		"error if schedules are set without a range": {
+			in: Count{Value: aws.Int(2), Schedules: []ScheduledScaling{{Name: aws.String("nightly")}}},
+
+			wantedErr: `count "range" must be set to autoscale the service`,
+		},
+		"error if a schedule name is invalid": {
+			in: Count{Range: aws.String("1-10"), Schedules: []ScheduledScaling{
+				{Name: aws.String("business hours"), Cron: aws.String("0 9 ? * MON-FRI *"), Range: aws.String("10-20")},
+			}},
+
+			wantedErr: `count schedule name "business hours" must contain only letters, numbers and dashes`,
+		},
+		"error if schedule names are duplicated": {
+			in: Count{Range: aws.String("1-10"), Schedules: []ScheduledScaling{
+				{Name: aws.String("nightly"), Cron: aws.String("0 0 * * ? *"), Range: aws.String("1-2")},
+				{Name: aws.String("nightly"), Cron: aws.String("0 1 * * ? *"), Range: aws.String("1-2")},
+			}},
+
+			wantedErr: `count schedule name "nightly" must be unique`,
+		},
+		"error if a cron expression doesn't have 6 fields": {
+			in: Count{Range: aws.String("1-10"), Schedules: []ScheduledScaling{
+				{Name: aws.String("nightly"), Cron: aws.String("0 0 * * *"), Range: aws.String("1-2")},
+			}},
+
+			wantedErr: `count schedule nightly must have a "cron" expression with 6 fields, got "0 0 * * *"`,
+		},
+		"error if a schedule doesn't have a range": {
+			in: Count{Range: aws.String("1-10"), Schedules: []ScheduledScaling{
+				{Name: aws.String("nightly"), Cron: aws.String("0 0 * * ? *")},
+			}},
+
+			wantedErr: `count schedule nightly must have a "range"`,
+		},
+		"error if the range of a schedule is invalid": {
+			in: Count{Range: aws.String("1-10"), Schedules: []ScheduledScaling{
+				{Name: aws.String("nightly"), Cron: aws.String("0 0 * * ? *"), Range: aws.String("2")},
+			}},
+
+			wantedErr: `count schedule nightly: count "range" 2 must be in the format "min-max"`,
+		},
+		"autoscaled on a schedule": {
+			in: Count{Range: aws.String("2-10"), Schedules: []ScheduledScaling{
+				{Name: aws.String("business-hours"), Cron: aws.String("0 9 ? * MON-FRI *"), Range: aws.String("10-20")},
+				{Name: aws.String("overnight"), Cron: aws.String(" 0 17 ? * MON-FRI * "), Range: aws.String("2-10")},
+			}},
+
+			wantedOpts: &template.AutoscalingOpts{
+				MinCapacity: 2,
+				MaxCapacity: 10,
+				Schedules: []*template.ScheduledScalingOpts{
+					{Name: "business-hours", Schedule: "cron(0 9 ? * MON-FRI *)", MinCapacity: 10, MaxCapacity: 20},
+					{Name: "overnight", Schedule: "cron(0 17 ? * MON-FRI *)", MinCapacity: 2, MaxCapacity: 10},
+				},
+			},
+		},
+		"error if a percentage is out of bounds": {
+			in: Count{Range: aws.String("1-10"), Memory: aws.Int(120)},
+
+			wantedErr: `count "memory_percentage" must be between 1 and 100, got 120`,
+		},
+		"error if the requests aren't positive": {
+			in: Count{Range: aws.String("1-10"), Requests: aws.Int(0)},
+
+			wantedErr: `count "requests" must be positive, got 0`,
+		},
+		"autoscaled on every metric": {
+			in: Count{Range: aws.String("1 - 10"), CPU: aws.Int(70), Memory: aws.Int(80), Requests: aws.Int(500)},
+
+			wantedOpts: &template.AutoscalingOpts{
+				MinCapacity: 1,
+				MaxCapacity: 10,
+				CPU:         aws.Int(70),
+				Memory:      aws.Int(80),
+				Requests:    aws.Int(500),
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts, err := tc.in.AutoscalingOpts()
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedOpts, opts)
+		})
+	}
+}
+
 func TestBuildConfig(t *testing.T) {
 	mockWsRoot := "/root/dir"
 	testCases := map[string]struct {
@@ -340,3 +768,411 @@ func TestBuildConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestEvents_EventsOpts(t *testing.T) {
+	testCases := map[string]struct {
+		inContent string
+
+		wantedOpts *template.EventsOpts
+		wantedErr  error
+	}{
+		"no events": {
+			inContent: `name: api`,
+		},
+		"invalid rule name": {
+			inContent: `
+events:
+  - name: orders.created
+    pattern:
+      source: ["com.example.orders"]`,
+			wantedErr: errors.New(`event rule name "orders.created" must contain only letters, numbers and dashes`),
+		},
+		"duplicated rule name": {
+			inContent: `
+events:
+  - name: orders
+    pattern:
+      source: ["com.example.orders"]
+  - name: orders
+    pattern:
+      source: ["com.example.returns"]`,
+			wantedErr: errors.New(`event rule name "orders" must be unique`),
+		},
+		"missing pattern": {
+			inContent: `
+events:
+  - name: orders`,
+			wantedErr: errors.New("event rule orders must have a pattern"),
+		},
+		"converts the rules": {
+			inContent: `
+events:
+  - name: orders-created
+    bus: orders
+    pattern:
+      source: ["com.example.orders"]
+      detail-type: ["OrderCreated"]
+  - name: uploads
+    pattern:
+      source: ["aws.s3"]
+      detail:
+        bucket:
+          name: ["my-bucket"]`,
+			wantedOpts: &template.EventsOpts{
+				Rules: []*template.EventRuleOpts{
+					{
+						Name:    "orders-created",
+						Bus:     aws.String("orders"),
+						Pattern: `{"detail-type":["OrderCreated"],"source":["com.example.orders"]}`,
+					},
+					{
+						Name:    "uploads",
+						Pattern: `{"detail":{"bucket":{"name":["my-bucket"]}},"source":["aws.s3"]}`,
+					},
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			var events Events
+			require.NoError(t, yaml.Unmarshal([]byte(tc.inContent), &events))
+
+			// WHEN
+			opts, err := events.EventsOpts()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedOpts, opts)
+		})
+	}
+}
+
+func TestDeploymentConfig_DeploymentConfigOpts(t *testing.T) {
+	testCases := map[string]struct {
+		inContent string
+
+		wantedOpts *template.DeploymentConfigOpts
+		wantedErr  error
+	}{
+		"no deployment configuration": {
+			inContent: `{}`,
+		},
+		"defaults the maximum percent": {
+			inContent: `minimum_healthy_percent: 50`,
+			wantedOpts: &template.DeploymentConfigOpts{
+				MinHealthyPercent: 50,
+				MaxPercent:        200,
+			},
+		},
+		"defaults the minimum healthy percent": {
+			inContent: `maximum_percent: 150`,
+			wantedOpts: &template.DeploymentConfigOpts{
+				MinHealthyPercent: 100,
+				MaxPercent:        150,
+			},
+		},
+		"minimum healthy percent over 100": {
+			inContent: `minimum_healthy_percent: 120`,
+			wantedErr: errors.New("minimum_healthy_percent 120 must be between 0 and 100"),
+		},
+		"maximum percent under 100": {
+			inContent: `maximum_percent: 90`,
+			wantedErr: errors.New("maximum_percent 90 must be at least 100"),
+		},
+		"invalid deployment type": {
+			inContent: `type: recreate`,
+			wantedErr: errors.New("invalid deployment type recreate: must be rolling or blue/green"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			var conf DeploymentConfig
+			require.NoError(t, yaml.Unmarshal([]byte(tc.inContent), &conf))
+
+			// WHEN
+			opts, err := conf.DeploymentConfigOpts()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedOpts, opts)
+		})
+	}
+}
+
+func TestDeploymentConfig_BlueGreenOpts(t *testing.T) {
+	testCases := map[string]struct {
+		inContent string
+
+		wantedOpts *template.BlueGreenOpts
+		wantedErr  error
+	}{
+		"rolling deployment": {
+			inContent: `type: rolling`,
+		},
+		"traffic shift without blue/green deployment": {
+			inContent: `traffic_shift: linear`,
+			wantedErr: errors.New("traffic_shift can only be set with a blue/green deployment"),
+		},
+		"defaults to shifting all traffic at once": {
+			inContent: `type: blue/green`,
+			wantedOpts: &template.BlueGreenOpts{
+				DeploymentConfigName: "CodeDeployDefault.ECSAllAtOnce",
+			},
+		},
+		"canary traffic shift": {
+			inContent: `
+type: blue/green
+traffic_shift: canary`,
+			wantedOpts: &template.BlueGreenOpts{
+				DeploymentConfigName: "CodeDeployDefault.ECSCanary10Percent5Minutes",
+			},
+		},
+		"invalid traffic shift": {
+			inContent: `
+type: blue/green
+traffic_shift: exponential`,
+			wantedErr: errors.New("invalid traffic_shift exponential: must be one of all_at_once, linear or canary"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			var conf DeploymentConfig
+			require.NoError(t, yaml.Unmarshal([]byte(tc.inContent), &conf))
+
+			// WHEN
+			opts, err := conf.BlueGreenOpts()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedOpts, opts)
+		})
+	}
+}
+
+func TestBackendServiceConfig_ValidateSidecarResources(t *testing.T) {
+	testCases := map[string]struct {
+		inContent string
+
+		wantedErr error
+	}{
+		"no sidecar resources": {
+			inContent: `
+cpu: 256
+memory: 512
+sidecars:
+  xray:
+    port: 2000`,
+		},
+		"sidecars and log router fit the task size": {
+			inContent: `
+cpu: 1024
+memory: 2048
+logging:
+  cpu: 128
+  memoryReservation: 256
+sidecars:
+  xray:
+    cpu: 256
+    memory: 512
+    memoryReservation: 256`,
+		},
+		"memory reservation over the hard limit": {
+			inContent: `
+cpu: 1024
+memory: 2048
+sidecars:
+  xray:
+    memory: 256
+    memoryReservation: 512`,
+			wantedErr: errors.New("container xray: memoryReservation 512 must not exceed memory 256"),
+		},
+		"hard memory limit over the task memory": {
+			inContent: `
+cpu: 1024
+memory: 2048
+sidecars:
+  xray:
+    memory: 4096
+    memoryReservation: 128`,
+			wantedErr: errors.New("container xray: memory 4096 must not exceed the memory 2048 of the task"),
+		},
+		"no CPU left for the main container": {
+			inContent: `
+cpu: 512
+memory: 1024
+logging:
+  cpu: 256
+sidecars:
+  xray:
+    cpu: 256`,
+			wantedErr: errors.New("sidecars reserve 512 CPU units out of the 512 of the task, leaving none for the main container"),
+		},
+		"no memory left for the main container": {
+			inContent: `
+cpu: 512
+memory: 1024
+logging:
+  memory: 512
+sidecars:
+  xray:
+    memoryReservation: 512`,
+			wantedErr: errors.New("sidecars reserve 1024 MiB of memory out of the 1024 of the task, leaving none for the main container"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			var conf BackendServiceConfig
+			require.NoError(t, yaml.Unmarshal([]byte(tc.inContent), &conf))
+
+			// WHEN
+			err := conf.ValidateSidecarResources()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSidecar_SidecarsOpts(t *testing.T) {
+	testCases := map[string]struct {
+		inContent string
+
+		wantedOpts []*template.SidecarOpts
+		wantedErr  error
+	}{
+		"health check without a command": {
+			inContent: `
+sidecars:
+  xray:
+    image: xray
+    healthcheck:
+      retries: 3`,
+			wantedErr: errors.New("healthcheck of sidecar xray must have a command"),
+		},
+		"invalid volume name": {
+			inContent: `
+sidecars:
+  xray:
+    image: xray
+    mount_points:
+      - source_volume: my volume
+        path: /var/data`,
+			wantedErr: errors.New(`source_volume "my volume" of sidecar xray must contain only letters, numbers, dashes and underscores`),
+		},
+		"relative container path": {
+			inContent: `
+sidecars:
+  xray:
+    image: xray
+    mount_points:
+      - source_volume: data
+        path: var/data`,
+			wantedErr: errors.New(`path "var/data" of volume data in sidecar xray must be absolute`),
+		},
+		"sidecar with variables, secrets, a health check and a volume": {
+			inContent: `
+sidecars:
+  xray:
+    image: xray
+    port: 2000/udp
+    variables:
+      LOG_LEVEL: debug
+    secrets:
+      API_KEY: /copilot/api-key
+    healthcheck:
+      command: ["CMD-SHELL", "curl localhost:2000"]
+      retries: 3
+    mount_points:
+      - source_volume: data
+        path: /var/data
+        read_only: true`,
+			wantedOpts: []*template.SidecarOpts{
+				{
+					Name:      aws.String("xray"),
+					Image:     aws.String("xray"),
+					Port:      aws.String("2000"),
+					Protocol:  aws.String("udp"),
+					Variables: map[string]string{"LOG_LEVEL": "debug"},
+					Secrets:   map[string]string{"API_KEY": "/copilot/api-key"},
+					HealthCheck: &ecs.HealthCheck{
+						Command:     aws.StringSlice([]string{"CMD-SHELL", "curl localhost:2000"}),
+						Interval:    aws.Int64(10),
+						Retries:     aws.Int64(3),
+						Timeout:     aws.Int64(5),
+						StartPeriod: aws.Int64(0),
+					},
+					MountPoints: []*template.MountPointOpts{
+						{
+							SourceVolume:  "data",
+							ContainerPath: "/var/data",
+							ReadOnly:      true,
+						},
+					},
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			var sidecar Sidecar
+			require.NoError(t, yaml.Unmarshal([]byte(tc.inContent), &sidecar))
+
+			// WHEN
+			opts, err := sidecar.SidecarsOpts()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedOpts, opts)
+		})
+	}
+}
+
+func TestSidecar_Volumes(t *testing.T) {
+	// GIVEN
+	var sidecar Sidecar
+	require.NoError(t, yaml.Unmarshal([]byte(`
+sidecars:
+  xray:
+    mount_points:
+      - source_volume: logs
+        path: /var/log
+      - source_volume: data
+        path: /var/data
+  nginx:
+    mount_points:
+      - source_volume: logs
+        path: /logs
+        read_only: true`), &sidecar))
+
+	// WHEN
+	volumes := sidecar.Volumes()
+
+	// THEN
+	require.Equal(t, []string{"data", "logs"}, volumes)
+}
@@ -0,0 +1,144 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ServiceSchemaVersion is the version of the schema of a service manifest.
+// Manifests written before the schema was versioned don't have a version, and are of version 0.
+type ServiceSchemaVersion int
+
+const (
+	// LatestServiceSchemaVersion is the version of the schema of the service manifests written by this version of the CLI.
+	LatestServiceSchemaVersion ServiceSchemaVersion = 1
+
+	// minServiceSchemaVersion is the oldest version of the schema that can still be deployed.
+	// Manifests of older versions must be upgraded first, as the fields they use changed meaning.
+	minServiceSchemaVersion ServiceSchemaVersion = 0
+
+	svcVersionComment = "# The version of the manifest's schema. Run \"copilot manifest upgrade\" to upgrade the manifest to the latest version."
+)
+
+var svcVersionRegexp = regexp.MustCompile(`(?m)^version:.*$`)
+
+// serviceMigration rewrites a service manifest of a version into the next version.
+type serviceMigration struct {
+	from        ServiceSchemaVersion
+	description string
+	migrate     func(doc *yaml.Node) error // Optional. The version of the manifest is updated by the upgrade.
+}
+
+// serviceMigrations are the migrations between each version of the schema, in order.
+var serviceMigrations = []serviceMigration{
+	{
+		from:        0,
+		description: "Set the version of the manifest's schema.",
+	},
+}
+
+// ServiceUpgrade is the result of upgrading a service manifest to the latest version of the schema.
+type ServiceUpgrade struct {
+	From     ServiceSchemaVersion
+	To       ServiceSchemaVersion
+	Changes  []string // Descriptions of the migrations applied to the manifest.
+	Manifest []byte   // Contents of the upgraded manifest.
+}
+
+// UpgradeService rewrites the YAML input stream of a service manifest to the latest version of the schema.
+// The comments and formatting of the manifest are kept, unless a migration needs to change the fields around them.
+// If the manifest is of a newer version than the CLI supports, then returns an ErrUnsupportedSvcManifestVersion.
+func UpgradeService(in []byte) (*ServiceUpgrade, error) {
+	am := Service{}
+	if err := yaml.Unmarshal(in, &am); err != nil {
+		return nil, fmt.Errorf("unmarshal to service manifest: %w", err)
+	}
+	if am.Version > LatestServiceSchemaVersion {
+		return nil, &ErrUnsupportedSvcManifestVersion{Version: am.Version}
+	}
+	upgrade := &ServiceUpgrade{
+		From:     am.Version,
+		To:       am.Version,
+		Manifest: in,
+	}
+	for _, m := range serviceMigrations {
+		if m.from < upgrade.To {
+			continue
+		}
+		if m.migrate != nil {
+			out, err := migrateService(upgrade.Manifest, m.migrate)
+			if err != nil {
+				return nil, fmt.Errorf("upgrade manifest from version %d: %w", m.from, err)
+			}
+			upgrade.Manifest = out
+		}
+		upgrade.To = m.from + 1
+		upgrade.Changes = append(upgrade.Changes, m.description)
+	}
+	if upgrade.To != upgrade.From {
+		upgrade.Manifest = setServiceVersion(upgrade.Manifest, upgrade.To)
+	}
+	return upgrade, nil
+}
+
+// migrateService applies the migration to the YAML document of the manifest.
+func migrateService(in []byte, migrate func(doc *yaml.Node) error) ([]byte, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(in, &doc); err != nil {
+		return nil, err
+	}
+	if err := migrate(&doc); err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(&doc); err != nil {
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// setServiceVersion sets the version field of the manifest. The field is edited in place so that the rest of the
+// manifest is left untouched. If the manifest doesn't have a version yet, it's added after the leading comments.
+func setServiceVersion(in []byte, version ServiceSchemaVersion) []byte {
+	field := fmt.Sprintf("version: %d", version)
+	if svcVersionRegexp.Match(in) {
+		return svcVersionRegexp.ReplaceAll(in, []byte(field))
+	}
+	lines := strings.Split(string(in), "\n")
+	header := 0
+	for header < len(lines) && strings.HasPrefix(lines[header], "#") {
+		header++
+	}
+	block := []string{svcVersionComment, field, ""}
+	if header > 0 && header < len(lines) && strings.TrimSpace(lines[header]) == "" {
+		// Keep the blank line between the header and the version.
+		header++
+	}
+	out := append([]string{}, lines[:header]...)
+	out = append(out, block...)
+	out = append(out, lines[header:]...)
+	return []byte(strings.Join(out, "\n"))
+}
+
+// validateServiceVersion returns an error if the manifest's version can't be deployed by this version of the CLI.
+func validateServiceVersion(version ServiceSchemaVersion) error {
+	if version > LatestServiceSchemaVersion {
+		return &ErrUnsupportedSvcManifestVersion{Version: version}
+	}
+	if version < minServiceSchemaVersion {
+		return &ErrOutdatedSvcManifestVersion{Version: version}
+	}
+	return nil
+}
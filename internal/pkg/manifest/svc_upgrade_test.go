@@ -0,0 +1,133 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestUpgradeService(t *testing.T) {
+	testCases := map[string]struct {
+		inContent string
+
+		wantedUpgrade *ServiceUpgrade
+		wantedErr     error
+	}{
+		"adds the version after the header of an unversioned manifest": {
+			inContent: `# The manifest for the "api" service.
+
+# Your service name will be used in naming your resources like log groups, ECS services, etc.
+name: api
+type: Backend Service
+`,
+			wantedUpgrade: &ServiceUpgrade{
+				From:    0,
+				To:      1,
+				Changes: []string{"Set the version of the manifest's schema."},
+				Manifest: []byte(`# The manifest for the "api" service.
+
+# The version of the manifest's schema. Run "copilot manifest upgrade" to upgrade the manifest to the latest version.
+version: 1
+
+# Your service name will be used in naming your resources like log groups, ECS services, etc.
+name: api
+type: Backend Service
+`),
+			},
+		},
+		"adds the version at the top of a manifest without header": {
+			inContent: `name: api
+type: Backend Service
+`,
+			wantedUpgrade: &ServiceUpgrade{
+				From:    0,
+				To:      1,
+				Changes: []string{"Set the version of the manifest's schema."},
+				Manifest: []byte(`# The version of the manifest's schema. Run "copilot manifest upgrade" to upgrade the manifest to the latest version.
+version: 1
+
+name: api
+type: Backend Service
+`),
+			},
+		},
+		"replaces the version of a manifest": {
+			inContent: `version: 0
+name: api
+type: Backend Service
+`,
+			wantedUpgrade: &ServiceUpgrade{
+				From:    0,
+				To:      1,
+				Changes: []string{"Set the version of the manifest's schema."},
+				Manifest: []byte(`version: 1
+name: api
+type: Backend Service
+`),
+			},
+		},
+		"leaves a manifest of the latest version untouched": {
+			inContent: `version: 1
+name: api
+type: Backend Service
+`,
+			wantedUpgrade: &ServiceUpgrade{
+				From: 1,
+				To:   1,
+				Manifest: []byte(`version: 1
+name: api
+type: Backend Service
+`),
+			},
+		},
+		"errors if the manifest is of a newer version": {
+			inContent: `version: 2
+name: api
+`,
+			wantedErr: &ErrUnsupportedSvcManifestVersion{Version: 2},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			upgrade, err := UpgradeService([]byte(tc.inContent))
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedUpgrade.From, upgrade.From)
+				require.Equal(t, tc.wantedUpgrade.To, upgrade.To)
+				require.Equal(t, tc.wantedUpgrade.Changes, upgrade.Changes)
+				require.Equal(t, string(tc.wantedUpgrade.Manifest), string(upgrade.Manifest))
+			}
+		})
+	}
+}
+
+func TestMigrateService(t *testing.T) {
+	in := []byte(`# The manifest for the "api" service.
+name: api
+http:
+  # Requests to this path will be forwarded to your service.
+  path: 'api'
+`)
+
+	out, err := migrateService(in, func(doc *yaml.Node) error {
+		http := doc.Content[0].Content[3]
+		http.Content[0].Value = "route"
+		return nil
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, `# The manifest for the "api" service.
+name: api
+http:
+  # Requests to this path will be forwarded to your service.
+  route: 'api'
+`, string(out))
+}
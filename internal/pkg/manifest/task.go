@@ -0,0 +1,40 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"errors"
+
+	"gopkg.in/yaml.v3"
+)
+
+// TaskPreset holds the configuration of a one-off task that is run with "copilot task run --preset".
+// Fields that are not set fall back to the flags of the command.
+type TaskPreset struct {
+	Name          *string           `yaml:"name"`
+	Image         *string           `yaml:"image"`
+	Dockerfile    *string           `yaml:"dockerfile"`
+	ImageTag      *string           `yaml:"image_tag"`
+	Command       *string           `yaml:"command"`
+	Count         *int              `yaml:"count"`
+	CPU           *int              `yaml:"cpu"`
+	Memory        *int              `yaml:"memory"`
+	TaskRole      *string           `yaml:"task_role"`
+	ExecutionRole *string           `yaml:"execution_role"`
+	Environment   *string           `yaml:"environment"`
+	Variables     map[string]string `yaml:"variables"`
+	Tags          map[string]string `yaml:"tags"`
+}
+
+// UnmarshalTaskPreset deserializes the YAML input stream into a task preset object.
+func UnmarshalTaskPreset(in []byte) (*TaskPreset, error) {
+	preset := TaskPreset{}
+	if err := yaml.Unmarshal(in, &preset); err != nil {
+		return nil, err
+	}
+	if preset.Image != nil && preset.Dockerfile != nil {
+		return nil, errors.New(`must specify one of "image" or "dockerfile"`)
+	}
+	return &preset, nil
+}
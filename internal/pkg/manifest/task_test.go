@@ -0,0 +1,86 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnmarshalTaskPreset(t *testing.T) {
+	testCases := map[string]struct {
+		inContent string
+
+		wantedPreset *TaskPreset
+		wantedErr    error
+	}{
+		"unmarshals all fields": {
+			inContent: `
+name: db-migrate
+dockerfile: ./migrate/Dockerfile
+image_tag: v1
+command: python migrate.py
+count: 2
+cpu: 512
+memory: 1024
+task_role: migrate-role
+execution_role: migrate-execution-role
+environment: test
+variables:
+  LOG_LEVEL: debug
+tags:
+  team: payments
+`,
+			wantedPreset: &TaskPreset{
+				Name:          aws.String("db-migrate"),
+				Dockerfile:    aws.String("./migrate/Dockerfile"),
+				ImageTag:      aws.String("v1"),
+				Command:       aws.String("python migrate.py"),
+				Count:         aws.Int(2),
+				CPU:           aws.Int(512),
+				Memory:        aws.Int(1024),
+				TaskRole:      aws.String("migrate-role"),
+				ExecutionRole: aws.String("migrate-execution-role"),
+				Environment:   aws.String("test"),
+				Variables: map[string]string{
+					"LOG_LEVEL": "debug",
+				},
+				Tags: map[string]string{
+					"team": "payments",
+				},
+			},
+		},
+		"leaves unset fields empty": {
+			inContent: `image: rds-migrate`,
+			wantedPreset: &TaskPreset{
+				Image: aws.String("rds-migrate"),
+			},
+		},
+		"returns error if both image and dockerfile are set": {
+			inContent: `
+image: rds-migrate
+dockerfile: ./Dockerfile
+`,
+			wantedErr: errors.New(`must specify one of "image" or "dockerfile"`),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			preset, err := UnmarshalTaskPreset([]byte(tc.inContent))
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedPreset, preset)
+		})
+	}
+}
@@ -0,0 +1,182 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package merge provides a line-based three-way merge of text files, such as templates edited by users.
+package merge
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Strategies to resolve the lines that were changed differently on both sides.
+const (
+	// StrategyMerge keeps both versions of the lines between conflict markers.
+	StrategyMerge = "merge"
+	// StrategyOurs keeps the lines of our version, such as the user's edits.
+	StrategyOurs = "ours"
+	// StrategyTheirs keeps the lines of their version, such as a regenerated file.
+	StrategyTheirs = "theirs"
+)
+
+// Strategies is the list of strategies to resolve conflicts.
+var Strategies = []string{StrategyMerge, StrategyOurs, StrategyTheirs}
+
+// Conflict markers surrounding the two versions of conflicting lines.
+const (
+	MarkerOurs   = "<<<<<<< yours"
+	MarkerSep    = "======="
+	MarkerTheirs = ">>>>>>> generated"
+)
+
+// Result is the outcome of a three-way merge.
+type Result struct {
+	Content   string
+	Conflicts int // Number of conflicts left between markers, only with StrategyMerge.
+}
+
+// ThreeWay merges the changes made to base by ours and by theirs. Lines changed on only one side are taken
+// from that side, while lines changed differently on both sides are resolved with the strategy.
+func ThreeWay(base, ours, theirs, strategy string) (*Result, error) {
+	switch strategy {
+	case StrategyMerge, StrategyOurs, StrategyTheirs:
+	default:
+		return nil, fmt.Errorf("invalid strategy %s: must be one of %s", strategy, strings.Join(Strategies, ", "))
+	}
+	o, a, b := splitLines(base), splitLines(ours), splitLines(theirs)
+	matchA, matchB := lcsMatches(o, a), lcsMatches(o, b)
+
+	var out strings.Builder
+	conflicts := 0
+	i, ia, ib := 0, 0, 0
+	for i < len(o) || ia < len(a) || ib < len(b) {
+		// Copy the lines that are unchanged on both sides.
+		j := 0
+		for i+j < len(o) && matchA[i+j] == ia+j && matchB[i+j] == ib+j {
+			j++
+		}
+		if j > 0 {
+			writeLines(&out, o[i:i+j])
+			i, ia, ib = i+j, ia+j, ib+j
+			continue
+		}
+		// Find the next base line kept by both sides, the lines before it changed on at least one side.
+		k := i
+		for k < len(o) && (matchA[k] == -1 || matchB[k] == -1) {
+			k++
+		}
+		endA, endB := len(a), len(b)
+		if k < len(o) {
+			endA, endB = matchA[k], matchB[k]
+		}
+		if resolveChunk(&out, o[i:k], a[ia:endA], b[ib:endB], strategy) {
+			conflicts++
+		}
+		i, ia, ib = k, endA, endB
+	}
+	return &Result{
+		Content:   out.String(),
+		Conflicts: conflicts,
+	}, nil
+}
+
+// resolveChunk writes the merged lines of a chunk that changed on at least one side,
+// and returns true if it left a conflict.
+func resolveChunk(out *strings.Builder, base, ours, theirs []string, strategy string) bool {
+	switch {
+	case equalLines(base, ours), equalLines(ours, theirs):
+		writeLines(out, theirs)
+		return false
+	case equalLines(base, theirs):
+		writeLines(out, ours)
+		return false
+	}
+	switch strategy {
+	case StrategyOurs:
+		writeLines(out, ours)
+		return false
+	case StrategyTheirs:
+		writeLines(out, theirs)
+		return false
+	}
+	out.WriteString(MarkerOurs + "\n")
+	writeLines(out, withLineBreak(ours))
+	out.WriteString(MarkerSep + "\n")
+	writeLines(out, withLineBreak(theirs))
+	out.WriteString(MarkerTheirs + "\n")
+	return true
+}
+
+// lcsMatches returns, for each line of from, the index of the line of to it's matched with
+// in a longest common subsequence of the two, or -1 if it's not part of it.
+func lcsMatches(from, to []string) []int {
+	// lengths[i][j] is the length of the longest common subsequence of from[i:] and to[j:].
+	lengths := make([][]int, len(from)+1)
+	for i := range lengths {
+		lengths[i] = make([]int, len(to)+1)
+	}
+	for i := len(from) - 1; i >= 0; i-- {
+		for j := len(to) - 1; j >= 0; j-- {
+			if from[i] == to[j] {
+				lengths[i][j] = lengths[i+1][j+1] + 1
+			} else if lengths[i+1][j] >= lengths[i][j+1] {
+				lengths[i][j] = lengths[i+1][j]
+			} else {
+				lengths[i][j] = lengths[i][j+1]
+			}
+		}
+	}
+	matches := make([]int, len(from))
+	i, j := 0, 0
+	for i < len(from) {
+		switch {
+		case j < len(to) && from[i] == to[j]:
+			matches[i] = j
+			i, j = i+1, j+1
+		case j < len(to) && lengths[i][j+1] > lengths[i+1][j]:
+			j++
+		default:
+			matches[i] = -1
+			i++
+		}
+	}
+	return matches
+}
+
+// splitLines splits the text into lines that keep their line break.
+func splitLines(s string) []string {
+	lines := strings.SplitAfter(s, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+func writeLines(out *strings.Builder, lines []string) {
+	for _, line := range lines {
+		out.WriteString(line)
+	}
+}
+
+// withLineBreak adds a line break to the last line if it's missing, so that a conflict marker
+// written after the lines starts on its own line.
+func withLineBreak(lines []string) []string {
+	if len(lines) == 0 || strings.HasSuffix(lines[len(lines)-1], "\n") {
+		return lines
+	}
+	fixed := append([]string{}, lines...)
+	fixed[len(fixed)-1] += "\n"
+	return fixed
+}
+
+func equalLines(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
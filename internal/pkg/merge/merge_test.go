@@ -0,0 +1,227 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package merge
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestThreeWay(t *testing.T) {
+	const base = `Resources:
+  LogGroup:
+    Type: AWS::Logs::LogGroup
+    Properties:
+      RetentionInDays: 30
+  TaskDefinition:
+    Type: AWS::ECS::TaskDefinition
+    Properties:
+      Cpu: 256
+`
+	testCases := map[string]struct {
+		inOurs     string
+		inTheirs   string
+		inStrategy string
+
+		wantedContent   string
+		wantedConflicts int
+		wantedErr       string
+	}{
+		"errors on an unknown strategy": {
+			inOurs:     base,
+			inTheirs:   base,
+			inStrategy: "union",
+			wantedErr:  "invalid strategy union: must be one of merge, ours, theirs",
+		},
+		"keeps our changes and their changes to different lines": {
+			inOurs: `Resources:
+  LogGroup:
+    Type: AWS::Logs::LogGroup
+    Properties:
+      RetentionInDays: 90
+  TaskDefinition:
+    Type: AWS::ECS::TaskDefinition
+    Properties:
+      Cpu: 256
+`,
+			inTheirs: `Resources:
+  LogGroup:
+    Type: AWS::Logs::LogGroup
+    Properties:
+      RetentionInDays: 30
+  TaskDefinition:
+    Type: AWS::ECS::TaskDefinition
+    Properties:
+      Cpu: 256
+      Memory: 512
+`,
+			inStrategy: StrategyMerge,
+			wantedContent: `Resources:
+  LogGroup:
+    Type: AWS::Logs::LogGroup
+    Properties:
+      RetentionInDays: 90
+  TaskDefinition:
+    Type: AWS::ECS::TaskDefinition
+    Properties:
+      Cpu: 256
+      Memory: 512
+`,
+		},
+		"takes the same change once": {
+			inOurs: `Resources:
+  LogGroup:
+    Type: AWS::Logs::LogGroup
+    Properties:
+      RetentionInDays: 90
+  TaskDefinition:
+    Type: AWS::ECS::TaskDefinition
+    Properties:
+      Cpu: 256
+`,
+			inTheirs: `Resources:
+  LogGroup:
+    Type: AWS::Logs::LogGroup
+    Properties:
+      RetentionInDays: 90
+  TaskDefinition:
+    Type: AWS::ECS::TaskDefinition
+    Properties:
+      Cpu: 256
+`,
+			inStrategy: StrategyMerge,
+			wantedContent: `Resources:
+  LogGroup:
+    Type: AWS::Logs::LogGroup
+    Properties:
+      RetentionInDays: 90
+  TaskDefinition:
+    Type: AWS::ECS::TaskDefinition
+    Properties:
+      Cpu: 256
+`,
+		},
+		"surrounds conflicting changes with markers": {
+			inOurs: `Resources:
+  LogGroup:
+    Type: AWS::Logs::LogGroup
+    Properties:
+      RetentionInDays: 90
+  TaskDefinition:
+    Type: AWS::ECS::TaskDefinition
+    Properties:
+      Cpu: 256
+`,
+			inTheirs: `Resources:
+  LogGroup:
+    Type: AWS::Logs::LogGroup
+    Properties:
+      RetentionInDays: !Ref LogRetention
+  TaskDefinition:
+    Type: AWS::ECS::TaskDefinition
+    Properties:
+      Cpu: 256
+`,
+			inStrategy: StrategyMerge,
+			wantedContent: `Resources:
+  LogGroup:
+    Type: AWS::Logs::LogGroup
+    Properties:
+<<<<<<< yours
+      RetentionInDays: 90
+=======
+      RetentionInDays: !Ref LogRetention
+>>>>>>> generated
+  TaskDefinition:
+    Type: AWS::ECS::TaskDefinition
+    Properties:
+      Cpu: 256
+`,
+			wantedConflicts: 1,
+		},
+		"resolves conflicts with our changes": {
+			inOurs: `Resources:
+  LogGroup:
+    Type: AWS::Logs::LogGroup
+    Properties:
+      RetentionInDays: 90
+  TaskDefinition:
+    Type: AWS::ECS::TaskDefinition
+    Properties:
+      Cpu: 256
+`,
+			inTheirs: `Resources:
+  LogGroup:
+    Type: AWS::Logs::LogGroup
+    Properties:
+      RetentionInDays: !Ref LogRetention
+  TaskDefinition:
+    Type: AWS::ECS::TaskDefinition
+    Properties:
+      Cpu: 512
+`,
+			inStrategy: StrategyOurs,
+			wantedContent: `Resources:
+  LogGroup:
+    Type: AWS::Logs::LogGroup
+    Properties:
+      RetentionInDays: 90
+  TaskDefinition:
+    Type: AWS::ECS::TaskDefinition
+    Properties:
+      Cpu: 512
+`,
+		},
+		"resolves conflicts with their changes": {
+			inOurs: `Resources:
+  LogGroup:
+    Type: AWS::Logs::LogGroup
+    Properties:
+      RetentionInDays: 90
+  TaskDefinition:
+    Type: AWS::ECS::TaskDefinition
+    Properties:
+      Cpu: 256
+  Bucket:
+    Type: AWS::S3::Bucket`,
+			inTheirs: `Resources:
+  LogGroup:
+    Type: AWS::Logs::LogGroup
+    Properties:
+      RetentionInDays: !Ref LogRetention
+  TaskDefinition:
+    Type: AWS::ECS::TaskDefinition
+    Properties:
+      Cpu: 256
+`,
+			inStrategy: StrategyTheirs,
+			wantedContent: `Resources:
+  LogGroup:
+    Type: AWS::Logs::LogGroup
+    Properties:
+      RetentionInDays: !Ref LogRetention
+  TaskDefinition:
+    Type: AWS::ECS::TaskDefinition
+    Properties:
+      Cpu: 256
+  Bucket:
+    Type: AWS::S3::Bucket`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			res, err := ThreeWay(base, tc.inOurs, tc.inTheirs, tc.inStrategy)
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, res.Content)
+			require.Equal(t, tc.wantedConflicts, res.Conflicts)
+		})
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package policy evaluates CloudFormation templates against policy-as-code rules with cfn-guard.
+package policy
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/term/command"
+	"github.com/aws/copilot-cli/internal/pkg/trace"
+)
+
+const (
+	cfnGuardBinary = "cfn-guard"
+
+	// cfn-guard exits with this code when the data doesn't comply with the rules.
+	cfnGuardViolationExitCode = 19
+)
+
+// ErrCfnGuardNotFound occurs when there are rules to evaluate but cfn-guard isn't installed.
+type ErrCfnGuardNotFound struct{}
+
+func (e *ErrCfnGuardNotFound) Error() string {
+	return "cfn-guard is not installed, see https://github.com/aws-cloudformation/cloudformation-guard to install it"
+}
+
+// Violation is the report of a rules file whose rules the template doesn't comply with.
+type Violation struct {
+	RulesFile string // Path to the rules file.
+	Details   string // Output of cfn-guard that describes the failed rules.
+}
+
+// Checker evaluates templates with cfn-guard.
+type Checker struct {
+	runner
+}
+
+type runner interface {
+	Run(name string, args []string, options ...command.Option) error
+}
+
+// New returns a Checker.
+func New() Checker {
+	return Checker{
+		runner: command.New(),
+	}
+}
+
+// Check runs `cfn-guard validate` on the template against each rules file, and returns the violations.
+// No violations are returned if the template complies with all the rules.
+func (c Checker) Check(template string, rulesFiles []string) ([]Violation, error) {
+	if len(rulesFiles) == 0 {
+		return nil, nil
+	}
+	f, err := ioutil.TempFile("", "copilot-template-*.yml")
+	if err != nil {
+		return nil, fmt.Errorf("create temporary file for the template: %w", err)
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.WriteString(template); err != nil {
+		f.Close()
+		return nil, fmt.Errorf("write template to %s: %w", f.Name(), err)
+	}
+	if err := f.Close(); err != nil {
+		return nil, fmt.Errorf("close %s: %w", f.Name(), err)
+	}
+
+	var violations []Violation
+	for _, rules := range rulesFiles {
+		out := &bytes.Buffer{}
+		span := trace.Start("cfn-guard validate", trace.Attr("rules", rules))
+		err := c.Run(cfnGuardBinary,
+			[]string{"validate", "--rules", rules, "--data", f.Name(), "--show-summary", "fail"},
+			command.Stdout(out), command.Stderr(out))
+		span.End(err)
+		if err == nil {
+			continue
+		}
+		if errors.Is(err, exec.ErrNotFound) {
+			return nil, &ErrCfnGuardNotFound{}
+		}
+		var exitErr interface{ ExitCode() int }
+		if !errors.As(err, &exitErr) || exitErr.ExitCode() != cfnGuardViolationExitCode {
+			if msg := strings.TrimSpace(out.String()); msg != "" {
+				return nil, fmt.Errorf("evaluate rules in %s: %w: %s", rules, err, msg)
+			}
+			return nil, fmt.Errorf("evaluate rules in %s: %w", rules, err)
+		}
+		violations = append(violations, Violation{
+			RulesFile: rules,
+			Details:   strings.TrimSpace(out.String()),
+		})
+	}
+	return violations, nil
+}
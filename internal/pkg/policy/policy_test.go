@@ -0,0 +1,118 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package policy
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os/exec"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/policy/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/term/command"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockExitError struct {
+	code int
+}
+
+func (e *mockExitError) Error() string {
+	return fmt.Sprintf("exit status %d", e.code)
+}
+
+func (e *mockExitError) ExitCode() int {
+	return e.code
+}
+
+// runWithOutput returns a function that writes the output to the command's stdout and returns the error.
+func runWithOutput(output string, err error) func(name string, args []string, opts ...command.Option) error {
+	return func(name string, args []string, opts ...command.Option) error {
+		cmd := &exec.Cmd{}
+		for _, opt := range opts {
+			opt(cmd)
+		}
+		fmt.Fprint(cmd.Stdout, output)
+		return err
+	}
+}
+
+func TestChecker_Check(t *testing.T) {
+	testCases := map[string]struct {
+		inRulesFiles []string
+		setupMocks   func(m *mocks.Mockrunner)
+
+		wantedViolations []Violation
+		wantedError      error
+	}{
+		"does not run cfn-guard without rules files": {
+			setupMocks: func(m *mocks.Mockrunner) {
+				m.EXPECT().Run(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		"errors if cfn-guard is not installed": {
+			inRulesFiles: []string{"/copilot/policies/encryption.guard"},
+			setupMocks: func(m *mocks.Mockrunner) {
+				m.EXPECT().Run("cfn-guard", gomock.Any(), gomock.Any()).Return(&exec.Error{Name: "cfn-guard", Err: exec.ErrNotFound})
+			},
+			wantedError: &ErrCfnGuardNotFound{},
+		},
+		"errors if cfn-guard fails to evaluate the rules": {
+			inRulesFiles: []string{"/copilot/policies/encryption.guard"},
+			setupMocks: func(m *mocks.Mockrunner) {
+				m.EXPECT().Run("cfn-guard", gomock.Any(), gomock.Any()).
+					DoAndReturn(runWithOutput("Parser Error when parsing rules file\n", &mockExitError{code: 5}))
+			},
+			wantedError: errors.New("evaluate rules in /copilot/policies/encryption.guard: exit status 5: Parser Error when parsing rules file"),
+		},
+		"returns the violations of each rules file": {
+			inRulesFiles: []string{"/copilot/policies/encryption.guard", "/copilot/policies/tags.guard"},
+			setupMocks: func(m *mocks.Mockrunner) {
+				gomock.InOrder(
+					m.EXPECT().Run("cfn-guard", gomock.Any(), gomock.Any()).
+						DoAndReturn(func(name string, args []string, opts ...command.Option) error {
+							require.Equal(t, []string{"validate", "--rules", "/copilot/policies/encryption.guard", "--data"}, args[:4])
+							data, err := ioutil.ReadFile(args[4])
+							require.NoError(t, err)
+							require.Equal(t, "Resources: {}", string(data))
+							return runWithOutput("s3_buckets_encrypted FAIL\n", &mockExitError{code: 19})(name, args, opts...)
+						}),
+					m.EXPECT().Run("cfn-guard", gomock.Any(), gomock.Any()).Return(nil),
+				)
+			},
+			wantedViolations: []Violation{
+				{
+					RulesFile: "/copilot/policies/encryption.guard",
+					Details:   "s3_buckets_encrypted FAIL",
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockRunner := mocks.NewMockrunner(ctrl)
+			tc.setupMocks(mockRunner)
+			c := Checker{
+				runner: mockRunner,
+			}
+
+			// WHEN
+			violations, err := c.Check("Resources: {}", tc.inRulesFiles)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedViolations, violations)
+		})
+	}
+}
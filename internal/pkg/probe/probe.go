@@ -0,0 +1,77 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package probe sends HTTP(S) requests to service endpoints and reports how they respond.
+package probe
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+)
+
+const defaultTimeout = 10 * time.Second
+
+type httpDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Prober sends HTTP(S) requests to endpoints.
+type Prober struct {
+	client httpDoer
+	now    func() time.Time
+}
+
+// Result holds the outcome of probing an endpoint.
+type Result struct {
+	URL        string `json:"url"`
+	StatusCode int    `json:"statusCode,omitempty"`
+	LatencyMs  int64  `json:"latencyMs"`
+	Error      string `json:"error,omitempty"` // Set if no response was received, for example due to DNS or certificate issues.
+}
+
+// New returns a Prober that times out requests after 10 seconds.
+func New() *Prober {
+	return &Prober{
+		client: &http.Client{
+			Timeout: defaultTimeout,
+		},
+		now: time.Now,
+	}
+}
+
+// Probe sends a GET request to the url and records the status code and latency of the response.
+func (p *Prober) Probe(url string) Result {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return Result{
+			URL:   url,
+			Error: fmt.Sprintf("create request: %v", err),
+		}
+	}
+	start := p.now()
+	resp, err := p.client.Do(req)
+	latency := p.now().Sub(start)
+	if err != nil {
+		return Result{
+			URL:       url,
+			LatencyMs: latency.Milliseconds(),
+			Error:     err.Error(),
+		}
+	}
+	defer resp.Body.Close()
+	// Drain the body so that the connection can be reused.
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+	return Result{
+		URL:        url,
+		StatusCode: resp.StatusCode,
+		LatencyMs:  latency.Milliseconds(),
+	}
+}
+
+// Healthy returns true if the endpoint responded with a status code below 400.
+func (r Result) Healthy() bool {
+	return r.Error == "" && r.StatusCode > 0 && r.StatusCode < http.StatusBadRequest
+}
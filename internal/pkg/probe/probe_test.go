@@ -0,0 +1,108 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package probe
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type mockHTTPClient struct {
+	resp *http.Response
+	err  error
+}
+
+func (c *mockHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	return c.resp, c.err
+}
+
+func TestProber_Probe(t *testing.T) {
+	testCases := map[string]struct {
+		inURL  string
+		client *mockHTTPClient
+
+		wanted        Result
+		wantedHealthy bool
+	}{
+		"healthy endpoint": {
+			inURL: "https://frontend.test.phonetool.com",
+			client: &mockHTTPClient{
+				resp: &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       ioutil.NopCloser(strings.NewReader("ok")),
+				},
+			},
+			wanted: Result{
+				URL:        "https://frontend.test.phonetool.com",
+				StatusCode: http.StatusOK,
+				LatencyMs:  25,
+			},
+			wantedHealthy: true,
+		},
+		"unhealthy endpoint": {
+			inURL: "http://lb.us-west-2.amazon.com/frontend",
+			client: &mockHTTPClient{
+				resp: &http.Response{
+					StatusCode: http.StatusBadGateway,
+					Body:       ioutil.NopCloser(strings.NewReader("")),
+				},
+			},
+			wanted: Result{
+				URL:        "http://lb.us-west-2.amazon.com/frontend",
+				StatusCode: http.StatusBadGateway,
+				LatencyMs:  25,
+			},
+		},
+		"request error": {
+			inURL: "https://frontend.test.phonetool.com",
+			client: &mockHTTPClient{
+				err: errors.New("x509: certificate has expired or is not yet valid"),
+			},
+			wanted: Result{
+				URL:       "https://frontend.test.phonetool.com",
+				LatencyMs: 25,
+				Error:     "x509: certificate has expired or is not yet valid",
+			},
+		},
+		"invalid url": {
+			inURL:  "://frontend",
+			client: &mockHTTPClient{},
+			wanted: Result{
+				URL:   "://frontend",
+				Error: `create request: parse "://frontend": missing protocol scheme`,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			start := time.Date(2020, 8, 1, 12, 0, 0, 0, time.UTC)
+			calls := 0
+			p := &Prober{
+				client: tc.client,
+				now: func() time.Time {
+					calls++
+					if calls == 1 {
+						return start
+					}
+					return start.Add(25 * time.Millisecond)
+				},
+			}
+
+			// WHEN
+			got := p.Probe(tc.inURL)
+
+			// THEN
+			require.Equal(t, tc.wanted, got)
+			require.Equal(t, tc.wantedHealthy, got.Healthy())
+		})
+	}
+}
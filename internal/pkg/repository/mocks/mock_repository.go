@@ -80,6 +80,43 @@ func (mr *MockContainerLoginBuildPusherMockRecorder) Push(uri, imageTag interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Push", reflect.TypeOf((*MockContainerLoginBuildPusher)(nil).Push), varargs...)
 }
 
+// MockImageChecker is a mock of ImageChecker interface
+type MockImageChecker struct {
+	ctrl     *gomock.Controller
+	recorder *MockImageCheckerMockRecorder
+}
+
+// MockImageCheckerMockRecorder is the mock recorder for MockImageChecker
+type MockImageCheckerMockRecorder struct {
+	mock *MockImageChecker
+}
+
+// NewMockImageChecker creates a new mock instance
+func NewMockImageChecker(ctrl *gomock.Controller) *MockImageChecker {
+	mock := &MockImageChecker{ctrl: ctrl}
+	mock.recorder = &MockImageCheckerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockImageChecker) EXPECT() *MockImageCheckerMockRecorder {
+	return m.recorder
+}
+
+// CheckImage mocks base method
+func (m *MockImageChecker) CheckImage(image string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckImage", image)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckImage indicates an expected call of CheckImage
+func (mr *MockImageCheckerMockRecorder) CheckImage(image interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckImage", reflect.TypeOf((*MockImageChecker)(nil).CheckImage), image)
+}
+
 // MockRegistry is a mock of Registry interface
 type MockRegistry struct {
 	ctrl     *gomock.Controller
@@ -17,6 +17,11 @@ type ContainerLoginBuildPusher interface {
 	Push(uri, imageTag string, additionalTags ...string) error
 }
 
+// ImageChecker checks an image before it's pushed to the repository.
+type ImageChecker interface {
+	CheckImage(image string) error
+}
+
 // Registry gets information of repositories.
 type Registry interface {
 	RepositoryURI(name string) (string, error)
@@ -47,6 +52,12 @@ func New(name string, registry Registry) (*Repository, error) {
 
 // BuildAndPush builds the image from Dockerfile and pushes it to the repository with tags.
 func (r *Repository) BuildAndPush(docker ContainerLoginBuildPusher, args *docker.BuildArguments) error {
+	return r.BuildCheckAndPush(docker, args, nil)
+}
+
+// BuildCheckAndPush builds the image from Dockerfile, checks it with the checker if there is one,
+// and pushes it to the repository with tags only if the check succeeds.
+func (r *Repository) BuildCheckAndPush(docker ContainerLoginBuildPusher, args *docker.BuildArguments, checker ImageChecker) error {
 	if args.URI == "" {
 		args.URI = r.uri
 	}
@@ -54,6 +65,13 @@ func (r *Repository) BuildAndPush(docker ContainerLoginBuildPusher, args *docker
 		return fmt.Errorf("build Dockerfile at %s: %w", args.Dockerfile, err)
 	}
 
+	if checker != nil {
+		image := fmt.Sprintf("%s:%s", args.URI, args.ImageTag)
+		if err := checker.CheckImage(image); err != nil {
+			return fmt.Errorf("check image %s: %w", image, err)
+		}
+	}
+
 	username, password, err := r.registry.Auth()
 	if err != nil {
 		return fmt.Errorf("get auth: %w", err)
@@ -132,3 +132,78 @@ func TestRepository_BuildAndPush(t *testing.T) {
 		})
 	}
 }
+
+func TestRepository_BuildCheckAndPush(t *testing.T) {
+	const (
+		inRepoName       = "my-repo"
+		inDockerfilePath = "path/to/dockerfile"
+		mockRepoURI      = "mockURI"
+	)
+	testCases := map[string]struct {
+		mockChecker  func(m *mocks.MockImageChecker)
+		inMockDocker func(m *mocks.MockContainerLoginBuildPusher)
+		mockRegistry func(m *mocks.MockRegistry)
+
+		wantedError error
+	}{
+		"does not push if the check fails": {
+			mockChecker: func(m *mocks.MockImageChecker) {
+				m.EXPECT().CheckImage("mockURI:tag1").Return(errors.New("some error"))
+			},
+			inMockDocker: func(m *mocks.MockContainerLoginBuildPusher) {
+				m.EXPECT().Build(gomock.Any()).Return(nil)
+				m.EXPECT().Login(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				m.EXPECT().Push(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			mockRegistry: func(m *mocks.MockRegistry) {
+				m.EXPECT().Auth().Times(0)
+			},
+			wantedError: errors.New("check image mockURI:tag1: some error"),
+		},
+		"pushes if the check succeeds": {
+			mockChecker: func(m *mocks.MockImageChecker) {
+				m.EXPECT().CheckImage("mockURI:tag1").Return(nil)
+			},
+			inMockDocker: func(m *mocks.MockContainerLoginBuildPusher) {
+				gomock.InOrder(
+					m.EXPECT().Build(gomock.Any()).Return(nil),
+					m.EXPECT().Login(mockRepoURI, "my-name", "my-pwd").Return(nil),
+					m.EXPECT().Push(mockRepoURI, "tag1").Return(nil),
+				)
+			},
+			mockRegistry: func(m *mocks.MockRegistry) {
+				m.EXPECT().Auth().Return("my-name", "my-pwd", nil)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRegistry := mocks.NewMockRegistry(ctrl)
+			mockDocker := mocks.NewMockContainerLoginBuildPusher(ctrl)
+			mockChecker := mocks.NewMockImageChecker(ctrl)
+			tc.mockRegistry(mockRegistry)
+			tc.inMockDocker(mockDocker)
+			tc.mockChecker(mockChecker)
+
+			repo := &Repository{
+				name:     inRepoName,
+				registry: mockRegistry,
+
+				uri: mockRepoURI,
+			}
+
+			err := repo.BuildCheckAndPush(mockDocker, &docker.BuildArguments{
+				Dockerfile: inDockerfilePath,
+				ImageTag:   "tag1",
+			}, mockChecker)
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
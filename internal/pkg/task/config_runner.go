@@ -4,6 +4,7 @@
 package task
 
 import (
+	"context"
 	"fmt"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
@@ -34,7 +35,7 @@ type NetworkConfigRunner struct {
 
 // Run runs tasks in the subnets and the security groups, and returns the tasks.
 // If subnets are not provided, it uses the default subnets.
-func (r *NetworkConfigRunner) Run() ([]*Task, error) {
+func (r *NetworkConfigRunner) Run(ctx context.Context) ([]*Task, error) {
 	if err := r.validateDependencies(); err != nil {
 		return nil, err
 	}
@@ -47,7 +48,7 @@ func (r *NetworkConfigRunner) Run() ([]*Task, error) {
 	}
 
 	if r.Subnets == nil {
-		subnets, err := r.VPCGetter.SubnetIDs(ec2.FilterForDefaultVPCSubnets)
+		subnets, err := r.VPCGetter.SubnetIDs(ctx, ec2.FilterForDefaultVPCSubnets)
 		if err != nil {
 			return nil, fmt.Errorf(fmtErrDefaultSubnets, err)
 		}
@@ -65,6 +66,7 @@ func (r *NetworkConfigRunner) Run() ([]*Task, error) {
 		SecurityGroups: r.SecurityGroups,
 		TaskFamilyName: taskFamilyName(r.GroupName),
 		StartedBy:      startedBy,
+		EnableExec:     true,
 	})
 	if err != nil {
 		return nil, &errRunTask{
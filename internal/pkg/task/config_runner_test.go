@@ -4,6 +4,7 @@
 package task
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"github.com/aws/aws-sdk-go/aws"
@@ -37,8 +38,8 @@ func TestNetworkConfigRunner_Run(t *testing.T) {
 				m.EXPECT().DefaultCluster().Return("", errors.New("error getting default cluster"))
 			},
 			mockVPCGetter: func(m *mocks.MockVPCGetter) {
-				m.EXPECT().SubnetIDs().AnyTimes()
-				m.EXPECT().SecurityGroups().AnyTimes()
+				m.EXPECT().SubnetIDs(gomock.Any()).AnyTimes()
+				m.EXPECT().SecurityGroups(gomock.Any()).AnyTimes()
 			},
 			mockStarter: func(m *mocks.MockTaskRunner) {
 				m.EXPECT().RunTask(gomock.Any()).Times(0)
@@ -58,7 +59,7 @@ func TestNetworkConfigRunner_Run(t *testing.T) {
 				m.EXPECT().DefaultCluster().Return("cluster-1", nil)
 			},
 			mockVPCGetter: func(m *mocks.MockVPCGetter) {
-				m.EXPECT().SubnetIDs([]ec2.Filter{ec2.FilterForDefaultVPCSubnets}).Times(0)
+				m.EXPECT().SubnetIDs(gomock.Any(), []ec2.Filter{ec2.FilterForDefaultVPCSubnets}).Times(0)
 			},
 			mockStarter: func(m *mocks.MockTaskRunner) {
 				m.EXPECT().RunTask(gomock.Any()).Return(nil, errors.New("error running task"))
@@ -80,7 +81,7 @@ func TestNetworkConfigRunner_Run(t *testing.T) {
 				m.EXPECT().DefaultCluster().Return("cluster-1", nil)
 			},
 			mockVPCGetter: func(m *mocks.MockVPCGetter) {
-				m.EXPECT().SubnetIDs([]ec2.Filter{ec2.FilterForDefaultVPCSubnets}).Times(0)
+				m.EXPECT().SubnetIDs(gomock.Any(), []ec2.Filter{ec2.FilterForDefaultVPCSubnets}).Times(0)
 			},
 			mockStarter: func(m *mocks.MockTaskRunner) {
 				m.EXPECT().RunTask(ecs.RunTaskInput{
@@ -90,6 +91,7 @@ func TestNetworkConfigRunner_Run(t *testing.T) {
 					SecurityGroups: []string{"sg-1", "sg-2"},
 					TaskFamilyName: taskFamilyName("my-task"),
 					StartedBy:      startedBy,
+					EnableExec:     true,
 				}).Return([]*ecs.Task{
 					{
 						TaskArn: aws.String("task-1"),
@@ -108,7 +110,7 @@ func TestNetworkConfigRunner_Run(t *testing.T) {
 				m.EXPECT().DefaultCluster().AnyTimes()
 			},
 			mockVPCGetter: func(m *mocks.MockVPCGetter) {
-				m.EXPECT().SubnetIDs([]ec2.Filter{ec2.FilterForDefaultVPCSubnets}).Return(nil, errors.New("error getting subnets"))
+				m.EXPECT().SubnetIDs(gomock.Any(), []ec2.Filter{ec2.FilterForDefaultVPCSubnets}).Return(nil, errors.New("error getting subnets"))
 			},
 			mockStarter: func(m *mocks.MockTaskRunner) {
 				m.EXPECT().RunTask(gomock.Any()).Times(0)
@@ -125,7 +127,7 @@ func TestNetworkConfigRunner_Run(t *testing.T) {
 				m.EXPECT().DefaultCluster().Return("cluster-1", nil)
 			},
 			mockVPCGetter: func(m *mocks.MockVPCGetter) {
-				m.EXPECT().SubnetIDs([]ec2.Filter{ec2.FilterForDefaultVPCSubnets}).
+				m.EXPECT().SubnetIDs(gomock.Any(), []ec2.Filter{ec2.FilterForDefaultVPCSubnets}).
 					Return([]string{"default-subnet-1", "default-subnet-2"}, nil)
 			},
 			mockStarter: func(m *mocks.MockTaskRunner) {
@@ -136,6 +138,7 @@ func TestNetworkConfigRunner_Run(t *testing.T) {
 					SecurityGroups: []string{"sg-1", "sg-2"},
 					TaskFamilyName: taskFamilyName("my-task"),
 					StartedBy:      startedBy,
+					EnableExec:     true,
 				}).Return([]*ecs.Task{
 					{
 						TaskArn: aws.String("task-1"),
@@ -176,7 +179,7 @@ func TestNetworkConfigRunner_Run(t *testing.T) {
 				Starter:       mockStarter,
 			}
 
-			tasks, err := task.Run()
+			tasks, err := task.Run(context.Background())
 			if tc.wantedError != nil {
 				require.EqualError(t, tc.wantedError, err.Error())
 			} else {
@@ -4,6 +4,7 @@
 package task
 
 import (
+	"context"
 	"fmt"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
@@ -44,7 +45,7 @@ type EnvRunner struct {
 }
 
 // Run runs tasks in the environment of the application, and returns the tasks.
-func (r *EnvRunner) Run() ([]*Task, error) {
+func (r *EnvRunner) Run(ctx context.Context) ([]*Task, error) {
 	if err := r.validateDependencies(); err != nil {
 		return nil, err
 	}
@@ -56,7 +57,7 @@ func (r *EnvRunner) Run() ([]*Task, error) {
 
 	filters := r.filtersForVPCFromAppEnv()
 
-	subnets, err := r.VPCGetter.PublicSubnetIDs(filters...)
+	subnets, err := r.VPCGetter.PublicSubnetIDs(ctx, filters...)
 	if err != nil {
 		return nil, fmt.Errorf(fmtErrPublicSubnetsFromEnv, r.Env, err)
 	}
@@ -64,7 +65,7 @@ func (r *EnvRunner) Run() ([]*Task, error) {
 		return nil, errNoSubnetFound
 	}
 
-	securityGroups, err := r.VPCGetter.SecurityGroups(filters...)
+	securityGroups, err := r.VPCGetter.SecurityGroups(ctx, filters...)
 	if err != nil {
 		return nil, fmt.Errorf(fmtErrSecurityGroupsFromEnv, r.Env, err)
 	}
@@ -76,6 +77,7 @@ func (r *EnvRunner) Run() ([]*Task, error) {
 		SecurityGroups: securityGroups,
 		TaskFamilyName: taskFamilyName(r.GroupName),
 		StartedBy:      startedBy,
+		EnableExec:     true,
 	})
 	if err != nil {
 		return nil, &errRunTask{
@@ -87,7 +89,12 @@ func (r *EnvRunner) Run() ([]*Task, error) {
 }
 
 func (r *EnvRunner) cluster(app, env string) (string, error) {
-	clusters, err := r.ClusterGetter.GetResourcesByTags(clusterResourceType, map[string]string{
+	return EnvCluster(r.ClusterGetter, app, env)
+}
+
+// EnvCluster returns the ARN of the cluster of an environment.
+func EnvCluster(getter ResourceGetter, app, env string) (string, error) {
+	clusters, err := getter.GetResourcesByTags(clusterResourceType, map[string]string{
 		deploy.AppTagKey: app,
 		deploy.EnvTagKey: env,
 	})
@@ -102,7 +109,7 @@ func (r *EnvRunner) cluster(app, env string) (string, error) {
 
 	// NOTE: only one cluster is associated with an application and an environment
 	if len(clusters) > 1 {
-		return "", fmt.Errorf(fmtErrMoreThanOneClusterFromEnv, r.Env)
+		return "", fmt.Errorf(fmtErrMoreThanOneClusterFromEnv, env)
 	}
 	return clusters[0].ARN, nil
 }
@@ -4,6 +4,7 @@
 package task
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"github.com/aws/aws-sdk-go/aws"
@@ -42,8 +43,8 @@ func TestEnvRunner_Run(t *testing.T) {
 		}, nil)
 	}
 	mockVPCGetterAny := func(m *mocks.MockVPCGetter) {
-		m.EXPECT().SubnetIDs(gomock.Any()).AnyTimes()
-		m.EXPECT().SecurityGroups(gomock.Any()).AnyTimes()
+		m.EXPECT().SubnetIDs(gomock.Any(), gomock.Any()).AnyTimes()
+		m.EXPECT().SecurityGroups(gomock.Any(), gomock.Any()).AnyTimes()
 	}
 	mockStarterNotRun := func(m *mocks.MockTaskRunner) {
 		m.EXPECT().RunTask(gomock.Any()).Times(0)
@@ -97,9 +98,9 @@ func TestEnvRunner_Run(t *testing.T) {
 		"failed to get subnets": {
 			mockResourceGetter: mockResourceGetterWithCluster,
 			mockVPCGetter: func(m *mocks.MockVPCGetter) {
-				m.EXPECT().PublicSubnetIDs(filtersForVPCFromAppEnv).
+				m.EXPECT().PublicSubnetIDs(gomock.Any(), filtersForVPCFromAppEnv).
 					Return(nil, errors.New("error getting subnets"))
-				m.EXPECT().SecurityGroups(gomock.Any()).AnyTimes()
+				m.EXPECT().SecurityGroups(gomock.Any(), gomock.Any()).AnyTimes()
 			},
 			mockStarter: mockStarterNotRun,
 			wantedError: fmt.Errorf(fmtErrPublicSubnetsFromEnv, inEnv, errors.New("error getting subnets")),
@@ -107,9 +108,9 @@ func TestEnvRunner_Run(t *testing.T) {
 		"no subnet is found": {
 			mockResourceGetter: mockResourceGetterWithCluster,
 			mockVPCGetter: func(m *mocks.MockVPCGetter) {
-				m.EXPECT().PublicSubnetIDs(filtersForVPCFromAppEnv).
+				m.EXPECT().PublicSubnetIDs(gomock.Any(), filtersForVPCFromAppEnv).
 					Return([]string{}, nil)
-				m.EXPECT().SecurityGroups(gomock.Any()).AnyTimes()
+				m.EXPECT().SecurityGroups(gomock.Any(), gomock.Any()).AnyTimes()
 			},
 			mockStarter: mockStarterNotRun,
 			wantedError: errNoSubnetFound,
@@ -117,8 +118,8 @@ func TestEnvRunner_Run(t *testing.T) {
 		"failed to get security groups": {
 			mockResourceGetter: mockResourceGetterWithCluster,
 			mockVPCGetter: func(m *mocks.MockVPCGetter) {
-				m.EXPECT().PublicSubnetIDs(gomock.Any()).Return([]string{"subnet-1"}, nil)
-				m.EXPECT().SecurityGroups(filtersForVPCFromAppEnv).
+				m.EXPECT().PublicSubnetIDs(gomock.Any(), gomock.Any()).Return([]string{"subnet-1"}, nil)
+				m.EXPECT().SecurityGroups(gomock.Any(), filtersForVPCFromAppEnv).
 					Return(nil, errors.New("error getting security groups"))
 			},
 			mockStarter: mockStarterNotRun,
@@ -130,8 +131,8 @@ func TestEnvRunner_Run(t *testing.T) {
 
 			mockResourceGetter: mockResourceGetterWithCluster,
 			mockVPCGetter: func(m *mocks.MockVPCGetter) {
-				m.EXPECT().PublicSubnetIDs(filtersForVPCFromAppEnv).Return([]string{"subnet-1", "subnet-2"}, nil)
-				m.EXPECT().SecurityGroups(filtersForVPCFromAppEnv).Return([]string{"sg-1", "sg-2"}, nil)
+				m.EXPECT().PublicSubnetIDs(gomock.Any(), filtersForVPCFromAppEnv).Return([]string{"subnet-1", "subnet-2"}, nil)
+				m.EXPECT().SecurityGroups(gomock.Any(), filtersForVPCFromAppEnv).Return([]string{"sg-1", "sg-2"}, nil)
 			},
 			mockStarter: func(m *mocks.MockTaskRunner) {
 				m.EXPECT().RunTask(ecs.RunTaskInput{
@@ -141,6 +142,7 @@ func TestEnvRunner_Run(t *testing.T) {
 					SecurityGroups: []string{"sg-1", "sg-2"},
 					TaskFamilyName: taskFamilyName("my-task"),
 					StartedBy:      startedBy,
+					EnableExec:     true,
 				}).Return(nil, errors.New("error running task"))
 			},
 
@@ -155,8 +157,8 @@ func TestEnvRunner_Run(t *testing.T) {
 
 			mockResourceGetter: mockResourceGetterWithCluster,
 			mockVPCGetter: func(m *mocks.MockVPCGetter) {
-				m.EXPECT().PublicSubnetIDs(filtersForVPCFromAppEnv).Return([]string{"subnet-1", "subnet-2"}, nil)
-				m.EXPECT().SecurityGroups(filtersForVPCFromAppEnv).Return([]string{"sg-1", "sg-2"}, nil)
+				m.EXPECT().PublicSubnetIDs(gomock.Any(), filtersForVPCFromAppEnv).Return([]string{"subnet-1", "subnet-2"}, nil)
+				m.EXPECT().SecurityGroups(gomock.Any(), filtersForVPCFromAppEnv).Return([]string{"sg-1", "sg-2"}, nil)
 			},
 			mockStarter: func(m *mocks.MockTaskRunner) {
 				m.EXPECT().RunTask(ecs.RunTaskInput{
@@ -166,6 +168,7 @@ func TestEnvRunner_Run(t *testing.T) {
 					SecurityGroups: []string{"sg-1", "sg-2"},
 					TaskFamilyName: taskFamilyName("my-task"),
 					StartedBy:      startedBy,
+					EnableExec:     true,
 				}).Return([]*ecs.Task{
 					{
 						TaskArn: aws.String("task-1"),
@@ -204,7 +207,7 @@ func TestEnvRunner_Run(t *testing.T) {
 				Starter:       mockStarter,
 			}
 
-			tasks, err := task.Run()
+			tasks, err := task.Run(context.Background())
 			if tc.wantedError != nil {
 				require.EqualError(t, tc.wantedError, err.Error())
 			} else {
@@ -5,6 +5,7 @@
 package mocks
 
 import (
+	context "context"
 	ec2 "github.com/aws/copilot-cli/internal/pkg/aws/ec2"
 	ecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	resourcegroups "github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
@@ -36,9 +37,9 @@ func (m *MockVPCGetter) EXPECT() *MockVPCGetterMockRecorder {
 }
 
 // SubnetIDs mocks base method
-func (m *MockVPCGetter) SubnetIDs(filters ...ec2.Filter) ([]string, error) {
+func (m *MockVPCGetter) SubnetIDs(ctx context.Context, filters ...ec2.Filter) ([]string, error) {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{}
+	varargs := []interface{}{ctx}
 	for _, a := range filters {
 		varargs = append(varargs, a)
 	}
@@ -49,15 +50,16 @@ func (m *MockVPCGetter) SubnetIDs(filters ...ec2.Filter) ([]string, error) {
 }
 
 // SubnetIDs indicates an expected call of SubnetIDs
-func (mr *MockVPCGetterMockRecorder) SubnetIDs(filters ...interface{}) *gomock.Call {
+func (mr *MockVPCGetterMockRecorder) SubnetIDs(ctx interface{}, filters ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubnetIDs", reflect.TypeOf((*MockVPCGetter)(nil).SubnetIDs), filters...)
+	varargs := append([]interface{}{ctx}, filters...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubnetIDs", reflect.TypeOf((*MockVPCGetter)(nil).SubnetIDs), varargs...)
 }
 
 // SecurityGroups mocks base method
-func (m *MockVPCGetter) SecurityGroups(filters ...ec2.Filter) ([]string, error) {
+func (m *MockVPCGetter) SecurityGroups(ctx context.Context, filters ...ec2.Filter) ([]string, error) {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{}
+	varargs := []interface{}{ctx}
 	for _, a := range filters {
 		varargs = append(varargs, a)
 	}
@@ -68,15 +70,16 @@ func (m *MockVPCGetter) SecurityGroups(filters ...ec2.Filter) ([]string, error)
 }
 
 // SecurityGroups indicates an expected call of SecurityGroups
-func (mr *MockVPCGetterMockRecorder) SecurityGroups(filters ...interface{}) *gomock.Call {
+func (mr *MockVPCGetterMockRecorder) SecurityGroups(ctx interface{}, filters ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SecurityGroups", reflect.TypeOf((*MockVPCGetter)(nil).SecurityGroups), filters...)
+	varargs := append([]interface{}{ctx}, filters...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SecurityGroups", reflect.TypeOf((*MockVPCGetter)(nil).SecurityGroups), varargs...)
 }
 
 // PublicSubnetIDs mocks base method
-func (m *MockVPCGetter) PublicSubnetIDs(filters ...ec2.Filter) ([]string, error) {
+func (m *MockVPCGetter) PublicSubnetIDs(ctx context.Context, filters ...ec2.Filter) ([]string, error) {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{}
+	varargs := []interface{}{ctx}
 	for _, a := range filters {
 		varargs = append(varargs, a)
 	}
@@ -87,9 +90,10 @@ func (m *MockVPCGetter) PublicSubnetIDs(filters ...ec2.Filter) ([]string, error)
 }
 
 // PublicSubnetIDs indicates an expected call of PublicSubnetIDs
-func (mr *MockVPCGetterMockRecorder) PublicSubnetIDs(filters ...interface{}) *gomock.Call {
+func (mr *MockVPCGetterMockRecorder) PublicSubnetIDs(ctx interface{}, filters ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublicSubnetIDs", reflect.TypeOf((*MockVPCGetter)(nil).PublicSubnetIDs), filters...)
+	varargs := append([]interface{}{ctx}, filters...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublicSubnetIDs", reflect.TypeOf((*MockVPCGetter)(nil).PublicSubnetIDs), varargs...)
 }
 
 // MockResourceGetter is a mock of ResourceGetter interface
@@ -205,3 +209,41 @@ func (mr *MockTaskRunnerMockRecorder) RunTask(input interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunTask", reflect.TypeOf((*MockTaskRunner)(nil).RunTask), input)
 }
+
+// MockRunningTasksLister is a mock of RunningTasksLister interface
+type MockRunningTasksLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockRunningTasksListerMockRecorder
+}
+
+// MockRunningTasksListerMockRecorder is the mock recorder for MockRunningTasksLister
+type MockRunningTasksListerMockRecorder struct {
+	mock *MockRunningTasksLister
+}
+
+// NewMockRunningTasksLister creates a new mock instance
+func NewMockRunningTasksLister(ctrl *gomock.Controller) *MockRunningTasksLister {
+	mock := &MockRunningTasksLister{ctrl: ctrl}
+	mock.recorder = &MockRunningTasksListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockRunningTasksLister) EXPECT() *MockRunningTasksListerMockRecorder {
+	return m.recorder
+}
+
+// RunningTasks mocks base method
+func (m *MockRunningTasksLister) RunningTasks(cluster, startedBy string) ([]*ecs.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunningTasks", cluster, startedBy)
+	ret0, _ := ret[0].([]*ecs.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunningTasks indicates an expected call of RunningTasks
+func (mr *MockRunningTasksListerMockRecorder) RunningTasks(cluster, startedBy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunningTasks", reflect.TypeOf((*MockRunningTasksLister)(nil).RunningTasks), cluster, startedBy)
+}
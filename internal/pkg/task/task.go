@@ -5,19 +5,21 @@
 package task
 
 import (
+	"context"
 	"fmt"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
+	"strings"
 	"time"
 )
 
 // VpcGetter gets subnets and security groups.
 type VPCGetter interface {
-	SubnetIDs(filters ...ec2.Filter) ([]string, error)
-	SecurityGroups(filters ...ec2.Filter) ([]string, error)
-	PublicSubnetIDs(filters ...ec2.Filter) ([]string, error)
+	SubnetIDs(ctx context.Context, filters ...ec2.Filter) ([]string, error)
+	SecurityGroups(ctx context.Context, filters ...ec2.Filter) ([]string, error)
+	PublicSubnetIDs(ctx context.Context, filters ...ec2.Filter) ([]string, error)
 }
 
 // ResourceGetter gets resources by tags.
@@ -35,11 +37,18 @@ type TaskRunner interface {
 	RunTask(input ecs.RunTaskInput) ([]*ecs.Task, error)
 }
 
+// RunningTasksLister lists the tasks in a cluster that haven't stopped yet.
+type RunningTasksLister interface {
+	RunningTasks(cluster, startedBy string) ([]*ecs.Task, error)
+}
+
 // Task represents a one-off workload that runs until completed or an error occurs.
 type Task struct {
 	TaskARN    string
 	ClusterARN string
 	StartedAt  *time.Time
+	GroupName  string
+	LastStatus string
 }
 
 const (
@@ -54,11 +63,40 @@ func taskFamilyName(groupName string) string {
 	return fmt.Sprintf(fmtTaskFamilyName, groupName)
 }
 
+// groupName returns the name of the task group from a task definition ARN such as
+// arn:aws:ecs:us-west-2:123456789012:task-definition/copilot-db-migrate:3.
+func groupName(taskDefARN string) string {
+	family := taskDefARN[strings.LastIndex(taskDefARN, "/")+1:]
+	if i := strings.LastIndex(family, ":"); i != -1 {
+		family = family[:i]
+	}
+	return strings.TrimPrefix(family, taskFamilyName(""))
+}
+
+// RunningTasks returns the one-off tasks started by Copilot that haven't stopped yet in the cluster.
+// If groupName is not empty, only the tasks of that task group are returned.
+func RunningTasks(lister RunningTasksLister, cluster, groupName string) ([]*Task, error) {
+	ecsTasks, err := lister.RunningTasks(cluster, startedBy)
+	if err != nil {
+		return nil, err
+	}
+	var tasks []*Task
+	for _, task := range convertECSTasks(ecsTasks) {
+		if groupName != "" && task.GroupName != groupName {
+			continue
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, nil
+}
+
 func newTaskFromECS(ecsTask *ecs.Task) *Task {
 	return &Task{
 		TaskARN:    aws.StringValue(ecsTask.TaskArn),
 		ClusterARN: aws.StringValue(ecsTask.ClusterArn),
 		StartedAt:  ecsTask.StartedAt,
+		GroupName:  groupName(aws.StringValue(ecsTask.TaskDefinitionArn)),
+		LastStatus: aws.StringValue(ecsTask.LastStatus),
 	}
 }
 
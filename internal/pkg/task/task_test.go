@@ -0,0 +1,101 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package task
+
+import (
+	"errors"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/task/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestRunningTasks(t *testing.T) {
+	startTime := time.Unix(1600000000, 0)
+	ecsTasks := []*ecs.Task{
+		{
+			TaskArn:           aws.String("arn:aws:ecs:us-west-2:123456789012:task/4082490ee6c245e09d2145010aa1ba8d"),
+			ClusterArn:        aws.String("cluster-1"),
+			TaskDefinitionArn: aws.String("arn:aws:ecs:us-west-2:123456789012:task-definition/copilot-db-migrate:3"),
+			LastStatus:        aws.String("RUNNING"),
+			StartedAt:         &startTime,
+		},
+		{
+			TaskArn:           aws.String("arn:aws:ecs:us-west-2:123456789012:task/0a6c1f9b1c4a4e8c9e9dcbcb4e8a0f2d"),
+			ClusterArn:        aws.String("cluster-1"),
+			TaskDefinitionArn: aws.String("arn:aws:ecs:us-west-2:123456789012:task-definition/copilot-seed:1"),
+			LastStatus:        aws.String("PENDING"),
+		},
+	}
+	testCases := map[string]struct {
+		inGroupName string
+		setupMocks  func(m *mocks.MockRunningTasksLister)
+
+		wantedTasks []*Task
+		wantedError error
+	}{
+		"returns error if fail to list running tasks": {
+			setupMocks: func(m *mocks.MockRunningTasksLister) {
+				m.EXPECT().RunningTasks("cluster-1", "copilot-task").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"returns the tasks of every group": {
+			setupMocks: func(m *mocks.MockRunningTasksLister) {
+				m.EXPECT().RunningTasks("cluster-1", "copilot-task").Return(ecsTasks, nil)
+			},
+			wantedTasks: []*Task{
+				{
+					TaskARN:    "arn:aws:ecs:us-west-2:123456789012:task/4082490ee6c245e09d2145010aa1ba8d",
+					ClusterARN: "cluster-1",
+					StartedAt:  &startTime,
+					GroupName:  "db-migrate",
+					LastStatus: "RUNNING",
+				},
+				{
+					TaskARN:    "arn:aws:ecs:us-west-2:123456789012:task/0a6c1f9b1c4a4e8c9e9dcbcb4e8a0f2d",
+					ClusterARN: "cluster-1",
+					GroupName:  "seed",
+					LastStatus: "PENDING",
+				},
+			},
+		},
+		"returns the tasks of a group": {
+			inGroupName: "seed",
+			setupMocks: func(m *mocks.MockRunningTasksLister) {
+				m.EXPECT().RunningTasks("cluster-1", "copilot-task").Return(ecsTasks, nil)
+			},
+			wantedTasks: []*Task{
+				{
+					TaskARN:    "arn:aws:ecs:us-west-2:123456789012:task/0a6c1f9b1c4a4e8c9e9dcbcb4e8a0f2d",
+					ClusterARN: "cluster-1",
+					GroupName:  "seed",
+					LastStatus: "PENDING",
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockLister := mocks.NewMockRunningTasksLister(ctrl)
+			tc.setupMocks(mockLister)
+
+			tasks, err := RunningTasks(mockLister, "cluster-1", tc.inGroupName)
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedTasks, tasks)
+		})
+	}
+}
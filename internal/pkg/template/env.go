@@ -17,11 +17,15 @@ const (
 var (
 	// Template names under "environment/cf/".
 	envCFSubTemplateNames = []string{
+		"access-logs",
 		"cfn-execution-role",
 		"custom-resources",
 		"custom-resources-role",
 		"environment-manager-role",
+		"flow-logs",
+		"global-accelerator",
 		"lambdas",
+		"nat-gateways",
 		"vpc-resources",
 	}
 )
@@ -33,6 +37,13 @@ type EnvOpts struct {
 	EnableLongARNFormatLambda string
 	ImportVPC                 *ImportVPCOpts
 	VPCConfig                 *AdjustVPCOpts
+	GlobalAccelerator         *GlobalAcceleratorOpts
+	EgressEIPAllocationIDs    []string // Elastic IPs of the NAT gateways that route traffic out of the private subnets.
+	ContainerInsights         bool
+	ImportCertARNs            []string // Existing ACM certificates served by the HTTPS listener, the first one is its default.
+	AccessLogs                *AccessLogsOpts
+	FlowLogs                  *FlowLogsOpts
+	CloudFrontPrefixListID    string // Only the servers of this prefix list can reach the public load balancer if set.
 }
 
 // ImportVPCOpts holds the fields to import VPC resources.
@@ -49,6 +60,26 @@ type AdjustVPCOpts struct {
 	PrivateSubnetCIDRs []string
 }
 
+// GlobalAcceleratorOpts holds the fields to place a Global Accelerator in front of the public load balancer.
+type GlobalAcceleratorOpts struct {
+	HealthCheckPath            string // Path of the load balancer that the endpoint group health checks.
+	HealthCheckIntervalSeconds int
+}
+
+// AccessLogsOpts holds the fields to store the access logs of the public load balancer in S3 and query them with Athena.
+type AccessLogsOpts struct {
+	Database  string // Name of the Glue database of the access logs table.
+	Table     string
+	WorkGroup string // Name of the Athena workgroup that stores the results of queries in the access logs bucket.
+}
+
+// FlowLogsOpts holds the fields to publish the flow logs of the VPC to CloudWatch Logs or S3.
+type FlowLogsOpts struct {
+	Destination                string // Either "cloudwatch" or "s3".
+	AggregationIntervalSeconds int
+	TrafficType                string // One of "ALL", "ACCEPT" or "REJECT".
+}
+
 // ParseEnv parses an environment's CloudFormation template with the specified data object and returns its content.
 func (t *Template) ParseEnv(data interface{}, options ...ParseOption) (*Content, error) {
 	tpl, err := t.parse("base", EnvCFTemplatePath, options...)
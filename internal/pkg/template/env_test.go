@@ -5,10 +5,13 @@ package template
 
 import (
 	"fmt"
+	"io/ioutil"
+	"path/filepath"
 	"testing"
 
 	"github.com/gobuffalo/packd"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
 )
 
 func TestTemplate_ParseEnv(t *testing.T) {
@@ -25,20 +28,28 @@ func TestTemplate_ParseEnv(t *testing.T) {
 					baseContent += fmt.Sprintf(`{{include "%s" . | indent 2}}`+"\n", name)
 				}
 				mockBox.AddString("environment/cf.yml", baseContent)
+				mockBox.AddString("environment/cf/access-logs.yml", "access-logs")
 				mockBox.AddString("environment/cf/cfn-execution-role.yml", "cfn-execution-role")
 				mockBox.AddString("environment/cf/custom-resources.yml", "custom-resources")
 				mockBox.AddString("environment/cf/custom-resources-role.yml", "custom-resources-role")
 				mockBox.AddString("environment/cf/environment-manager-role.yml", "environment-manager-role")
+				mockBox.AddString("environment/cf/flow-logs.yml", "flow-logs")
+				mockBox.AddString("environment/cf/global-accelerator.yml", "global-accelerator")
 				mockBox.AddString("environment/cf/lambdas.yml", "lambdas")
+				mockBox.AddString("environment/cf/nat-gateways.yml", "nat-gateways")
 				mockBox.AddString("environment/cf/vpc-resources.yml", "vpc-resources")
 
 				t.box = mockBox
 			},
-			wantedContent: `  cfn-execution-role
+			wantedContent: `  access-logs
+  cfn-execution-role
   custom-resources
   custom-resources-role
   environment-manager-role
+  flow-logs
+  global-accelerator
   lambdas
+  nat-gateways
   vpc-resources
 `,
 		},
@@ -61,3 +72,53 @@ func TestTemplate_ParseEnv(t *testing.T) {
 		})
 	}
 }
+
+func TestTemplate_ParseEnv_ImportCertARNs(t *testing.T) {
+	// GIVEN
+	mockBox := packd.NewMemoryBox()
+	paths := []string{EnvCFTemplatePath}
+	for _, name := range envCFSubTemplateNames {
+		paths = append(paths, fmt.Sprintf(fmtEnvCFSubTemplatePath, name))
+	}
+	for _, path := range paths {
+		content, err := ioutil.ReadFile(filepath.Join("..", "..", "..", "templates", path))
+		require.NoError(t, err)
+		mockBox.AddBytes(path, content)
+	}
+	tpl := &Template{box: mockBox}
+
+	// WHEN
+	c, err := tpl.ParseEnv(EnvOpts{
+		VPCConfig: &AdjustVPCOpts{
+			CIDR:               "10.0.0.0/16",
+			PublicSubnetCIDRs:  []string{"10.0.0.0/24", "10.0.1.0/24"},
+			PrivateSubnetCIDRs: []string{"10.0.2.0/24", "10.0.3.0/24"},
+		},
+		ImportCertARNs: []string{
+			"arn:aws:acm:us-west-2:123456789012:certificate/first",
+			"arn:aws:acm:us-west-2:123456789012:certificate/second",
+		},
+	}, WithFuncs(map[string]interface{}{
+		"inc": IncFunc,
+		"certID": func(arn string) string {
+			return StripNonAlphaNumFunc(filepath.Base(arn))
+		},
+	}))
+
+	// THEN
+	require.NoError(t, err)
+	var cfn struct {
+		Conditions map[string]interface{} `yaml:"Conditions"`
+		Resources  map[string]struct {
+			Condition string `yaml:"Condition"`
+		} `yaml:"Resources"`
+	}
+	require.NoError(t, yaml.Unmarshal(c.Bytes(), &cfn), c.String())
+	// Without a public load balancer, none of the resources that reference it can be created.
+	require.Contains(t, cfn.Conditions, "ExportHTTPSListener")
+	require.Contains(t, cfn.Conditions, "AttachFirstImportedCert")
+	require.Equal(t, "ExportHTTPSListener", cfn.Resources["HTTPSListener"].Condition)
+	// The first certificate is already the default certificate of the listener if the application doesn't have a domain.
+	require.Equal(t, "AttachFirstImportedCert", cfn.Resources["HTTPSListenerCertificatefirst"].Condition)
+	require.Equal(t, "ExportHTTPSListener", cfn.Resources["HTTPSListenerCertificatesecond"].Condition)
+}
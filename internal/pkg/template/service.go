@@ -30,6 +30,10 @@ var (
 		"addons",
 		"sidecars",
 		"logconfig",
+		"events",
+		"deploymentconfig",
+		"autoscaling",
+		"efs",
 	}
 )
 
@@ -39,6 +43,42 @@ const (
 	backendSvcTplName = "backend"
 )
 
+// LogsInsightsQuery is a CloudWatch Logs Insights query that is saved in every service stack.
+type LogsInsightsQuery struct {
+	Name      string // Short name of the query, also accepted by "svc logs --insights".
+	LogicalID string // Logical ID of the query definition in the service stack.
+	Query     string
+}
+
+// LogsInsightsQueries are the queries saved for the log group of every service.
+var LogsInsightsQueries = []LogsInsightsQuery{
+	{
+		Name:      "errors",
+		LogicalID: "ErrorsQueryDefinition",
+		Query: `fields @timestamp, @message
+| filter @message like /(?i)(error|fatal|exception)/
+| stats count(*) as errors by bin(5m)`,
+	},
+	{
+		Name:      "latency",
+		LogicalID: "LatencyQueryDefinition",
+		Query: `fields @timestamp, @message
+| parse @message /(?<latency>\d+(\.\d+)?)\s?ms/
+| filter ispresent(latency)
+| stats avg(latency) as avg, pct(latency, 90) as p90, pct(latency, 99) as p99 by bin(5m)`,
+	},
+	{
+		Name:      "exceptions",
+		LogicalID: "ExceptionsQueryDefinition",
+		Query: `fields @message
+| parse @message /(?<exception>[\w.$]+(Exception|Error))/
+| filter ispresent(exception)
+| stats count(*) as occurrences by exception
+| sort occurrences desc
+| limit 10`,
+	},
+}
+
 // ServiceNestedStackOpts holds configuration that's needed if the service stack has a nested stack.
 type ServiceNestedStackOpts struct {
 	StackName string
@@ -50,11 +90,53 @@ type ServiceNestedStackOpts struct {
 
 // SidecarOpts holds configuration that's needed if the service has sidecar containers.
 type SidecarOpts struct {
-	Name       *string
-	Image      *string
-	Port       *string
-	Protocol   *string
-	CredsParam *string
+	Name        *string
+	Image       *string
+	Port        *string
+	Protocol    *string
+	CredsParam  *string
+	Essential   *bool
+	DependsOn   map[string]string // Container name to the condition to wait for.
+	Variables   map[string]string
+	Secrets     map[string]string
+	HealthCheck *ecs.HealthCheck
+	MountPoints []*MountPointOpts
+
+	CPU               *int
+	Memory            *int
+	MemoryReservation *int
+}
+
+// MountPointOpts holds where a volume of the task is mounted in a container.
+type MountPointOpts struct {
+	SourceVolume  string
+	ContainerPath string
+	ReadOnly      bool
+}
+
+// StorageOpts holds the volumes of the task and where the main container mounts them.
+type StorageOpts struct {
+	Volumes     []*VolumeOpts
+	MountPoints []*MountPointOpts // Mount points of the main container.
+	ManagedEFS  bool              // Creates an EFS file system with mount targets in the environment's private subnets.
+}
+
+// VolumeOpts holds a volume of the task, it's scratch space that's deleted when the task stops unless it's backed by EFS.
+type VolumeOpts struct {
+	Name string
+	EFS  *EFSVolumeOpts
+}
+
+// EFSVolumeOpts holds the EFS file system that backs a volume.
+type EFSVolumeOpts struct {
+	Managed       bool   // Mounts an access point, rooted at the name of the volume, of the file system created by Copilot.
+	FileSystemID  string // ID of an existing file system.
+	RootDirectory string
+	AccessPointID string
+	IAM           bool    // Mounts the file system with the task role.
+	ReadOnly      bool    // Only grants the task role read access to the file system.
+	UID           *uint32 // POSIX user and group of the access point created by Copilot.
+	GID           *uint32
 }
 
 // LogConfigOpts holds configuration that's needed if the service is configured with Firelens to route
@@ -65,20 +147,88 @@ type LogConfigOpts struct {
 	EnableMetadata *string
 	SecretOptions  map[string]string
 	ConfigFile     *string
+
+	CPU               *int
+	Memory            *int
+	MemoryReservation *int
+}
+
+// EventsOpts holds configuration that's needed if the service subscribes to EventBridge events.
+type EventsOpts struct {
+	Rules []*EventRuleOpts
+}
+
+// EventRuleOpts holds configuration for an EventBridge rule that delivers events to the service's queue.
+type EventRuleOpts struct {
+	Name    string
+	Bus     *string
+	Pattern string // JSON encoded event pattern.
+}
+
+// DeploymentConfigOpts holds how many tasks of the service can be stopped and started at once during a deployment.
+type DeploymentConfigOpts struct {
+	MinHealthyPercent int
+	MaxPercent        int
+}
+
+// CircuitBreakerOpts holds what ECS does when the deployment circuit breaker stops a deployment whose tasks fail to start.
+type CircuitBreakerOpts struct {
+	Rollback bool // Rolls back to the last deployment that completed.
+}
+
+// CapacityProviderStrategyOpts holds how many of the tasks of the service are placed on a Fargate capacity provider.
+type CapacityProviderStrategyOpts struct {
+	CapacityProvider string
+	Base             int // Number of tasks placed on the capacity provider before the others get any.
+	Weight           int // Relative share of the tasks placed on the capacity provider after the bases are met.
+}
+
+// AutoscalingOpts holds the bounds of the desired count of the service and the target metrics it autoscales on.
+type AutoscalingOpts struct {
+	MinCapacity int
+	MaxCapacity int
+	CPU         *int // Target average CPU utilization in percent.
+	Memory      *int // Target average memory utilization in percent.
+	Requests    *int // Target number of requests per task from the load balancer.
+	Schedules   []*ScheduledScalingOpts
+}
+
+// ScheduledScalingOpts holds the range of the desired count that the service scales to on a schedule.
+type ScheduledScalingOpts struct {
+	Name        string
+	Schedule    string // Schedule expression such as "cron(0 9 ? * MON-FRI *)".
+	MinCapacity int
+	MaxCapacity int
+}
+
+// BlueGreenOpts holds configuration that's needed if the service is deployed by CodeDeploy with blue/green deployments.
+type BlueGreenOpts struct {
+	DeploymentConfigName string // Predefined CodeDeploy configuration that sets how the traffic is shifted.
 }
 
 // ServiceOpts holds optional data that can be provided to enable features in a service stack template.
 type ServiceOpts struct {
 	// Additional options that're common between **all** service templates.
-	Variables   map[string]string
-	Secrets     map[string]string
-	NestedStack *ServiceNestedStackOpts // Outputs from nested stacks such as the addons stack.
-	Sidecars    []*SidecarOpts
-	LogConfig   *LogConfigOpts
+	Variables      map[string]string
+	Secrets        map[string]string
+	NestedStack    *ServiceNestedStackOpts // Outputs from nested stacks such as the addons stack.
+	Sidecars       []*SidecarOpts
+	LogConfig      *LogConfigOpts
+	Events         *EventsOpts
+	Deployment     *DeploymentConfigOpts
+	CircuitBreaker *CircuitBreakerOpts // Not supported by services deployed with CodeDeploy.
+	ExecuteCommand bool                // Enables ECS Exec on the service and grants the task role the SSM permissions it needs.
+	// Places the tasks on Fargate Spot, the tasks are launched on Fargate if it's empty.
+	CapacityProviders []*CapacityProviderStrategyOpts
+	Autoscaling       *AutoscalingOpts // Registers the service as a scalable target with target tracking policies.
+	Storage           *StorageOpts
 
 	// Additional options that're not shared across all service templates.
-	HealthCheck        *ecs.HealthCheck
-	RulePriorityLambda string
+	HealthCheck            *ecs.HealthCheck
+	HealthCheckGracePeriod *int64 // In seconds.
+	RulePriorityLambda     string
+	HTTPSAlias             string // Domain routed by the HTTPS listener that serves the certificates imported by the environment.
+	BlueGreen              *BlueGreenOpts
 }
 
 // ParseLoadBalancedWebService parses a load balanced web service's CloudFormation template
@@ -118,10 +268,15 @@ func (t *Template) parseSvc(name string, data interface{}, options ...ParseOptio
 func withSvcParsingFuncs() ParseOption {
 	return func(t *template.Template) *template.Template {
 		return t.Funcs(map[string]interface{}{
-			"toSnakeCase": ToSnakeCaseFunc,
-			"hasSecrets":  hasSecrets,
-			"fmtSlice":    FmtSliceFunc,
-			"quoteSlice":  QuotePSliceFunc,
+			"toSnakeCase":   ToSnakeCaseFunc,
+			"logicalIDSafe": ReplaceDashesFunc,
+			"hasSecrets":    hasSecrets,
+			"fmtSlice":      FmtSliceFunc,
+			"quoteSlice":    QuotePSliceFunc,
+			"inc":           IncFunc,
+			"logsInsightsQueries": func() []LogsInsightsQuery {
+				return LogsInsightsQueries
+			},
 		})
 	}
 }
@@ -38,6 +38,10 @@ func TestTemplate_ParseSvc(t *testing.T) {
 				mockBox.AddString("services/common/cf/addons.yml", "addons")
 				mockBox.AddString("services/common/cf/sidecars.yml", "sidecars")
 				mockBox.AddString("services/common/cf/logconfig.yml", "logconfig")
+				mockBox.AddString("services/common/cf/events.yml", "events")
+				mockBox.AddString("services/common/cf/deploymentconfig.yml", "deploymentconfig")
+				mockBox.AddString("services/common/cf/autoscaling.yml", "autoscaling")
+				mockBox.AddString("services/common/cf/efs.yml", "efs")
 
 				t.box = mockBox
 			},
@@ -51,6 +55,10 @@ func TestTemplate_ParseSvc(t *testing.T) {
   addons
   sidecars
   logconfig
+  events
+  deploymentconfig
+  autoscaling
+  efs
 `,
 		},
 	}
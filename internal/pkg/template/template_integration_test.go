@@ -39,6 +39,11 @@ func TestTemplate_ParseLoadBalancedWebService(t *testing.T) {
 				},
 			},
 		},
+		"renders a valid template with ECS Exec enabled": {
+			opts: template.ServiceOpts{
+				ExecuteCommand: true,
+			},
+		},
 	}
 
 	for name, tc := range testCases {
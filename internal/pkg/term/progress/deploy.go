@@ -5,6 +5,7 @@ package progress
 
 import (
 	"fmt"
+	"path"
 	"strings"
 
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
@@ -68,6 +69,55 @@ func HumanizeResourceEvents(orderedTexts []Text, resourceEvents []deploy.Resourc
 	return rows
 }
 
+// HumanizeNestedStackEvents returns a row for each nested stack that resources events belong to, in the order the
+// nested stacks are first seen. The status of a nested stack is the status of its own events in the parent stack,
+// unless one of its resources failed: then the row is followed by the first failure attributed to the resource's path,
+// so that the failures within nested stacks aren't hidden behind the generic failure of the nested stack.
+func HumanizeNestedStackEvents(resourceEvents []deploy.ResourceEvent) []TabRow {
+	var paths []string
+	statuses := make(map[string]Status)
+	failures := make(map[string]string)
+	for _, resourceEvent := range resourceEvents {
+		if resourceEvent.ParentStack == "" {
+			continue
+		}
+		if _, ok := statuses[resourceEvent.ParentStack]; !ok {
+			paths = append(paths, resourceEvent.ParentStack)
+			statuses[resourceEvent.ParentStack] = StatusInProgress
+		}
+		if _, ok := failures[resourceEvent.ParentStack]; !ok && toStatus(resourceEvent.Status) == StatusFailed {
+			failures[resourceEvent.ParentStack] = fmt.Sprintf("%s: %s",
+				path.Join(resourceEvent.ParentStack, resourceEvent.LogicalName), resourceEvent.StatusReason)
+		}
+	}
+	for _, resourceEvent := range resourceEvents {
+		stackPath := path.Join(resourceEvent.ParentStack, resourceEvent.LogicalName)
+		if _, ok := statuses[stackPath]; ok && statuses[stackPath] != StatusFailed {
+			statuses[stackPath] = toStatus(resourceEvent.Status)
+		}
+	}
+
+	var rows []TabRow
+	for _, stackPath := range paths {
+		status := statuses[stackPath]
+		if _, ok := failures[stackPath]; ok {
+			status = StatusFailed
+		}
+		coloredStatus := fmt.Sprintf("[%s]", status)
+		if status == StatusInProgress {
+			coloredStatus = color.Grey.Sprint(coloredStatus)
+		}
+		if status == StatusFailed {
+			coloredStatus = color.Red.Sprint(coloredStatus)
+		}
+		rows = append(rows, TabRow(fmt.Sprintf("%s\t%s", color.Grey.Sprintf("- Nested stack %s", stackPath), coloredStatus)))
+		if reason, ok := failures[stackPath]; ok {
+			rows = append(rows, TabRow(fmt.Sprintf("  %s\t", reason)))
+		}
+	}
+	return rows
+}
+
 func toStatus(s string) Status {
 	if strings.HasSuffix(s, "FAILED") {
 		return StatusFailed
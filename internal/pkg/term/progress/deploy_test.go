@@ -56,3 +56,104 @@ func TestHumanizeResourceEvents(t *testing.T) {
 		})
 	}
 }
+
+func TestHumanizeNestedStackEvents(t *testing.T) {
+	testCases := map[string]struct {
+		inResourceEvents []deploy.ResourceEvent
+
+		wantedEvents []TabRow
+	}{
+		"no nested stacks": {
+			inResourceEvents: []deploy.ResourceEvent{
+				{
+					Resource: deploy.Resource{
+						LogicalName: "VPC",
+						Type:        "AWS::EC2::VPC",
+					},
+					Status: "CREATE_COMPLETE",
+				},
+			},
+		},
+		"reports the status of each nested stack": {
+			inResourceEvents: []deploy.ResourceEvent{
+				{
+					Resource: deploy.Resource{
+						LogicalName: "NetworkStack",
+						Type:        "AWS::CloudFormation::Stack",
+					},
+					Status: "CREATE_COMPLETE",
+				},
+				{
+					Resource: deploy.Resource{
+						LogicalName: "AddonsStack",
+						Type:        "AWS::CloudFormation::Stack",
+					},
+					Status: "CREATE_IN_PROGRESS",
+				},
+				{
+					Resource: deploy.Resource{
+						LogicalName: "VPC",
+						Type:        "AWS::EC2::VPC",
+						ParentStack: "NetworkStack",
+					},
+					Status: "CREATE_COMPLETE",
+				},
+				{
+					Resource: deploy.Resource{
+						LogicalName: "MyTable",
+						Type:        "AWS::DynamoDB::Table",
+						ParentStack: "AddonsStack",
+					},
+					Status: "CREATE_IN_PROGRESS",
+				},
+			},
+
+			wantedEvents: []TabRow{"- Nested stack NetworkStack\t[Complete]", "- Nested stack AddonsStack\t[In Progress]"},
+		},
+		"attributes the first failure to the path of the resource": {
+			inResourceEvents: []deploy.ResourceEvent{
+				{
+					Resource: deploy.Resource{
+						LogicalName: "NetworkStack",
+						Type:        "AWS::CloudFormation::Stack",
+					},
+					Status:       "CREATE_FAILED",
+					StatusReason: "Embedded stack was not successfully created",
+				},
+				{
+					Resource: deploy.Resource{
+						LogicalName: "SubnetsStack",
+						Type:        "AWS::CloudFormation::Stack",
+						ParentStack: "NetworkStack",
+					},
+					Status:       "CREATE_FAILED",
+					StatusReason: "Embedded stack was not successfully created",
+				},
+				{
+					Resource: deploy.Resource{
+						LogicalName: "PublicSubnet1",
+						Type:        "AWS::EC2::Subnet",
+						ParentStack: "NetworkStack/SubnetsStack",
+					},
+					Status:       "CREATE_FAILED",
+					StatusReason: "The CIDR is invalid",
+				},
+			},
+
+			wantedEvents: []TabRow{
+				"- Nested stack NetworkStack\t[Failed]",
+				"  NetworkStack/SubnetsStack: Embedded stack was not successfully created\t",
+				"- Nested stack NetworkStack/SubnetsStack\t[Failed]",
+				"  NetworkStack/SubnetsStack/PublicSubnet1: The CIDR is invalid\t",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := HumanizeNestedStackEvents(tc.inResourceEvents)
+
+			require.Equal(t, tc.wantedEvents, got)
+		})
+	}
+}
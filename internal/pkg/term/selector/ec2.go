@@ -5,6 +5,7 @@
 package selector
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
@@ -20,8 +21,8 @@ var (
 
 // VPCSubnetLister list VPCs and subnets.
 type VPCSubnetLister interface {
-	ListVPC() ([]string, error)
-	ListVPCSubnets(vpcID string, opts ...ec2.ListVPCSubnetsOpts) ([]string, error)
+	ListVPCsDetailed(ctx context.Context, filters ...ec2.Filter) ([]ec2.VPC, error)
+	ListVPCSubnetsDetailed(ctx context.Context, vpcID string, opts ...ec2.ListVPCSubnetsOpts) ([]ec2.Subnet, error)
 }
 
 // EC2Select is a selector for Ec2 resources.
@@ -38,47 +39,65 @@ func NewEC2Select(prompt Prompter, ec2Client VPCSubnetLister) *EC2Select {
 	}
 }
 
-// VPC has the user select an available VPC.
-func (s *EC2Select) VPC(prompt, help string) (string, error) {
-	vpcIDs, err := s.ec2Svc.ListVPC()
+// VPC has the user select an available VPC, optionally among the VPCs that match the filters.
+func (s *EC2Select) VPC(ctx context.Context, prompt, help string, filters ...ec2.Filter) (string, error) {
+	vpcs, err := s.ec2Svc.ListVPCsDetailed(ctx, filters...)
 	if err != nil {
-		return "", fmt.Errorf("list VPC ID: %w", err)
+		return "", fmt.Errorf("list VPCs: %w", err)
 	}
-	if len(vpcIDs) == 0 {
+	if len(vpcs) == 0 {
 		return "", ErrVPCNotFound
 	}
-	vpcID, err := s.prompt.SelectOne(
+	// Show the name and CIDR range of each VPC, but return its ID.
+	options := make([]string, len(vpcs))
+	idForOption := make(map[string]string, len(vpcs))
+	for i, vpc := range vpcs {
+		options[i] = vpc.String()
+		idForOption[options[i]] = vpc.ID
+	}
+	ans, err := s.prompt.SelectOne(
 		prompt, help,
-		vpcIDs)
+		options)
 	if err != nil {
 		return "", fmt.Errorf("select VPC: %w", err)
 	}
-	return vpcID, nil
+	return idForOption[ans], nil
 }
 
 // PublicSubnets has the user multiselect public subnets given the VPC ID.
-func (s *EC2Select) PublicSubnets(prompt, help, vpcID string) ([]string, error) {
-	return s.subnet(prompt, help, vpcID, ec2.FilterForPublicSubnets())
+func (s *EC2Select) PublicSubnets(ctx context.Context, prompt, help, vpcID string) ([]string, error) {
+	return s.subnet(ctx, prompt, help, vpcID, ec2.FilterForPublicSubnets())
 }
 
 // PrivateSubnets has the user multiselect private subnets given the VPC ID.
-func (s *EC2Select) PrivateSubnets(prompt, help, vpcID string) ([]string, error) {
-	return s.subnet(prompt, help, vpcID, ec2.FilterForPrivateSubnets())
+func (s *EC2Select) PrivateSubnets(ctx context.Context, prompt, help, vpcID string) ([]string, error) {
+	return s.subnet(ctx, prompt, help, vpcID, ec2.FilterForPrivateSubnets())
 }
 
-func (s *EC2Select) subnet(prompt, help string, vpcID string, filter ec2.ListVPCSubnetsOpts) ([]string, error) {
-	subnets, err := s.ec2Svc.ListVPCSubnets(vpcID, filter)
+func (s *EC2Select) subnet(ctx context.Context, prompt, help string, vpcID string, filter ec2.ListVPCSubnetsOpts) ([]string, error) {
+	subnets, err := s.ec2Svc.ListVPCSubnetsDetailed(ctx, vpcID, filter)
 	if err != nil {
 		return nil, fmt.Errorf("list subnets for VPC %s: %w", vpcID, err)
 	}
 	if len(subnets) == 0 {
 		return nil, ErrSubnetsNotFound
 	}
+	// Show the availability zone, CIDR range and name of each subnet, but return their IDs.
+	options := make([]string, len(subnets))
+	idForOption := make(map[string]string, len(subnets))
+	for i, subnet := range subnets {
+		options[i] = subnet.String()
+		idForOption[options[i]] = subnet.ID
+	}
 	ans, err := s.prompt.MultiSelect(
 		prompt, help,
-		subnets)
+		options)
 	if err != nil {
 		return nil, err
 	}
-	return ans, nil
+	ids := make([]string, len(ans))
+	for i, option := range ans {
+		ids[i] = idForOption[option]
+	}
+	return ids, nil
 }
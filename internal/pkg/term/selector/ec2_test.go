@@ -4,6 +4,7 @@
 package selector
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"testing"
@@ -21,7 +22,20 @@ type ec2SelectMocks struct {
 
 func TestEc2Select_VPC(t *testing.T) {
 	mockErr := errors.New("some error")
+	mockVPCs := []ec2.VPC{
+		{
+			ID:   "mockVPC1",
+			Name: "prod",
+			CIDR: "10.0.0.0/16",
+		},
+		{
+			ID:        "mockVPC2",
+			CIDR:      "172.31.0.0/16",
+			IsDefault: true,
+		},
+	}
 	testCases := map[string]struct {
+		inFilters  []ec2.Filter
 		setupMocks func(mocks ec2SelectMocks)
 
 		wantErr error
@@ -29,22 +43,22 @@ func TestEc2Select_VPC(t *testing.T) {
 	}{
 		"return error if fail to list VPCs": {
 			setupMocks: func(m ec2SelectMocks) {
-				m.ec2Svc.EXPECT().ListVPC().Return(nil, mockErr)
+				m.ec2Svc.EXPECT().ListVPCsDetailed(gomock.Any()).Return(nil, mockErr)
 
 			},
-			wantErr: fmt.Errorf("list VPC ID: some error"),
+			wantErr: fmt.Errorf("list VPCs: some error"),
 		},
 		"return error if no VPC found": {
 			setupMocks: func(m ec2SelectMocks) {
-				m.ec2Svc.EXPECT().ListVPC().Return([]string{}, nil)
+				m.ec2Svc.EXPECT().ListVPCsDetailed(gomock.Any()).Return([]ec2.VPC{}, nil)
 
 			},
 			wantErr: ErrVPCNotFound,
 		},
 		"return error if fail to select a VPC": {
 			setupMocks: func(m ec2SelectMocks) {
-				m.ec2Svc.EXPECT().ListVPC().Return([]string{"mockVPC1", "mockVPC2"}, nil)
-				m.prompt.EXPECT().SelectOne("Select a VPC", "Help text", []string{"mockVPC1", "mockVPC2"}).
+				m.ec2Svc.EXPECT().ListVPCsDetailed(gomock.Any()).Return(mockVPCs, nil)
+				m.prompt.EXPECT().SelectOne("Select a VPC", "Help text", []string{"mockVPC1 (prod): 10.0.0.0/16", "mockVPC2: 172.31.0.0/16, default"}).
 					Return("", mockErr)
 
 			},
@@ -52,9 +66,19 @@ func TestEc2Select_VPC(t *testing.T) {
 		},
 		"success": {
 			setupMocks: func(m ec2SelectMocks) {
-				m.ec2Svc.EXPECT().ListVPC().Return([]string{"mockVPC1", "mockVPC2"}, nil)
-				m.prompt.EXPECT().SelectOne("Select a VPC", "Help text", []string{"mockVPC1", "mockVPC2"}).
-					Return("mockVPC1", nil)
+				m.ec2Svc.EXPECT().ListVPCsDetailed(gomock.Any()).Return(mockVPCs, nil)
+				m.prompt.EXPECT().SelectOne("Select a VPC", "Help text", []string{"mockVPC1 (prod): 10.0.0.0/16", "mockVPC2: 172.31.0.0/16, default"}).
+					Return("mockVPC1 (prod): 10.0.0.0/16", nil)
+
+			},
+			wantVPC: "mockVPC1",
+		},
+		"success with tag filters": {
+			inFilters: []ec2.Filter{ec2.FilterForTags("team", "payments")},
+			setupMocks: func(m ec2SelectMocks) {
+				m.ec2Svc.EXPECT().ListVPCsDetailed(gomock.Any(), ec2.FilterForTags("team", "payments")).Return(mockVPCs[:1], nil)
+				m.prompt.EXPECT().SelectOne("Select a VPC", "Help text", []string{"mockVPC1 (prod): 10.0.0.0/16"}).
+					Return("mockVPC1 (prod): 10.0.0.0/16", nil)
 
 			},
 			wantVPC: "mockVPC1",
@@ -78,7 +102,7 @@ func TestEc2Select_VPC(t *testing.T) {
 				prompt: mockprompt,
 				ec2Svc: mockec2Svc,
 			}
-			vpc, err := sel.VPC("Select a VPC", "Help text")
+			vpc, err := sel.VPC(context.Background(), "Select a VPC", "Help text", tc.inFilters...)
 			if tc.wantErr != nil {
 				require.EqualError(t, tc.wantErr, err.Error())
 			} else {
@@ -91,6 +115,20 @@ func TestEc2Select_VPC(t *testing.T) {
 func TestEc2Select_subnets(t *testing.T) {
 	mockErr := errors.New("some error")
 	mockVPC := "mockVPC"
+	mockSubnets := []ec2.Subnet{
+		{
+			ID:   "mockSubnet1",
+			Name: "public-a",
+			AZ:   "us-west-2a",
+			CIDR: "10.0.0.0/24",
+		},
+		{
+			ID:   "mockSubnet2",
+			AZ:   "us-west-2b",
+			CIDR: "10.0.1.0/24",
+		},
+	}
+	mockOptions := []string{"mockSubnet1 (public-a): us-west-2a, 10.0.0.0/24", "mockSubnet2: us-west-2b, 10.0.1.0/24"}
 	testCases := map[string]struct {
 		filter     ec2.ListVPCSubnetsOpts
 		setupMocks func(mocks ec2SelectMocks)
@@ -101,22 +139,22 @@ func TestEc2Select_subnets(t *testing.T) {
 		"return error if fail to list subnets": {
 			filter: ec2.FilterForPrivateSubnets(),
 			setupMocks: func(m ec2SelectMocks) {
-				m.ec2Svc.EXPECT().ListVPCSubnets(mockVPC, gomock.Any()).Return(nil, mockErr)
+				m.ec2Svc.EXPECT().ListVPCSubnetsDetailed(gomock.Any(), mockVPC, gomock.Any()).Return(nil, mockErr)
 			},
 			wantErr: fmt.Errorf("list subnets for VPC mockVPC: some error"),
 		},
 		"return error if no subnets found": {
 			filter: ec2.FilterForPrivateSubnets(),
 			setupMocks: func(m ec2SelectMocks) {
-				m.ec2Svc.EXPECT().ListVPCSubnets(mockVPC, gomock.Any()).Return([]string{}, nil)
+				m.ec2Svc.EXPECT().ListVPCSubnetsDetailed(gomock.Any(), mockVPC, gomock.Any()).Return([]ec2.Subnet{}, nil)
 			},
 			wantErr: ErrSubnetsNotFound,
 		},
 		"return error if fail to select": {
 			filter: ec2.FilterForPrivateSubnets(),
 			setupMocks: func(m ec2SelectMocks) {
-				m.ec2Svc.EXPECT().ListVPCSubnets(mockVPC, gomock.Any()).Return([]string{"mockSubnet1", "mockSubnet2"}, nil)
-				m.prompt.EXPECT().MultiSelect("Select a subnet", "Help text", []string{"mockSubnet1", "mockSubnet2"}).
+				m.ec2Svc.EXPECT().ListVPCSubnetsDetailed(gomock.Any(), mockVPC, gomock.Any()).Return(mockSubnets, nil)
+				m.prompt.EXPECT().MultiSelect("Select a subnet", "Help text", mockOptions).
 					Return(nil, mockErr)
 			},
 			wantErr: fmt.Errorf("some error"),
@@ -124,11 +162,11 @@ func TestEc2Select_subnets(t *testing.T) {
 		"success for public subnets": {
 			filter: ec2.FilterForPublicSubnets(),
 			setupMocks: func(m ec2SelectMocks) {
-				m.ec2Svc.EXPECT().ListVPCSubnets(mockVPC, gomock.Any()).Return([]string{"mockSubnet1", "mockSubnet2"}, nil)
-				m.prompt.EXPECT().MultiSelect("Select a subnet", "Help text", []string{"mockSubnet1", "mockSubnet2"}).
-					Return([]string{"mockSubnet1", "mockSubnet2"}, nil)
+				m.ec2Svc.EXPECT().ListVPCSubnetsDetailed(gomock.Any(), mockVPC, gomock.Any()).Return(mockSubnets, nil)
+				m.prompt.EXPECT().MultiSelect("Select a subnet", "Help text", mockOptions).
+					Return([]string{"mockSubnet2: us-west-2b, 10.0.1.0/24"}, nil)
 			},
-			wantSubnets: []string{"mockSubnet1", "mockSubnet2"},
+			wantSubnets: []string{"mockSubnet2"},
 		},
 	}
 
@@ -149,7 +187,7 @@ func TestEc2Select_subnets(t *testing.T) {
 				prompt: mockprompt,
 				ec2Svc: mockec2Svc,
 			}
-			subnets, err := sel.subnet("Select a subnet", "Help text", mockVPC, tc.filter)
+			subnets, err := sel.subnet(context.Background(), "Select a subnet", "Help text", mockVPC, tc.filter)
 			if tc.wantErr != nil {
 				require.EqualError(t, tc.wantErr, err.Error())
 			} else {
@@ -5,6 +5,7 @@
 package mocks
 
 import (
+	context "context"
 	ec2 "github.com/aws/copilot-cli/internal/pkg/aws/ec2"
 	gomock "github.com/golang/mock/gomock"
 	reflect "reflect"
@@ -33,37 +34,42 @@ func (m *MockVPCSubnetLister) EXPECT() *MockVPCSubnetListerMockRecorder {
 	return m.recorder
 }
 
-// ListVPC mocks base method
-func (m *MockVPCSubnetLister) ListVPC() ([]string, error) {
+// ListVPCsDetailed mocks base method
+func (m *MockVPCSubnetLister) ListVPCsDetailed(ctx context.Context, filters ...ec2.Filter) ([]ec2.VPC, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListVPC")
-	ret0, _ := ret[0].([]string)
+	varargs := []interface{}{ctx}
+	for _, a := range filters {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVPCsDetailed", varargs...)
+	ret0, _ := ret[0].([]ec2.VPC)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListVPC indicates an expected call of ListVPC
-func (mr *MockVPCSubnetListerMockRecorder) ListVPC() *gomock.Call {
+// ListVPCsDetailed indicates an expected call of ListVPCsDetailed
+func (mr *MockVPCSubnetListerMockRecorder) ListVPCsDetailed(ctx interface{}, filters ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVPC", reflect.TypeOf((*MockVPCSubnetLister)(nil).ListVPC))
+	varargs := append([]interface{}{ctx}, filters...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVPCsDetailed", reflect.TypeOf((*MockVPCSubnetLister)(nil).ListVPCsDetailed), varargs...)
 }
 
-// ListVPCSubnets mocks base method
-func (m *MockVPCSubnetLister) ListVPCSubnets(vpcID string, opts ...ec2.ListVPCSubnetsOpts) ([]string, error) {
+// ListVPCSubnetsDetailed mocks base method
+func (m *MockVPCSubnetLister) ListVPCSubnetsDetailed(ctx context.Context, vpcID string, opts ...ec2.ListVPCSubnetsOpts) ([]ec2.Subnet, error) {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{vpcID}
+	varargs := []interface{}{ctx, vpcID}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "ListVPCSubnets", varargs...)
-	ret0, _ := ret[0].([]string)
+	ret := m.ctrl.Call(m, "ListVPCSubnetsDetailed", varargs...)
+	ret0, _ := ret[0].([]ec2.Subnet)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListVPCSubnets indicates an expected call of ListVPCSubnets
-func (mr *MockVPCSubnetListerMockRecorder) ListVPCSubnets(vpcID interface{}, opts ...interface{}) *gomock.Call {
+// ListVPCSubnetsDetailed indicates an expected call of ListVPCSubnetsDetailed
+func (mr *MockVPCSubnetListerMockRecorder) ListVPCSubnetsDetailed(ctx, vpcID interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{vpcID}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVPCSubnets", reflect.TypeOf((*MockVPCSubnetLister)(nil).ListVPCSubnets), varargs...)
+	varargs := append([]interface{}{ctx, vpcID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVPCSubnetsDetailed", reflect.TypeOf((*MockVPCSubnetLister)(nil).ListVPCSubnetsDetailed), varargs...)
 }
@@ -0,0 +1,230 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package trace records spans for the long running operations of the CLI, such as building images or waiting
+// for stacks, and exports them in the OpenTelemetry protocol (OTLP) JSON format.
+package trace
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/version"
+)
+
+const (
+	serviceName = "copilot"
+
+	spanKindInternal = 1
+	statusCodeError  = 2
+
+	collectorTimeout = 10 * time.Second
+)
+
+// Attribute is a key value pair that describes a span.
+type Attribute struct {
+	Key   string
+	Value string
+}
+
+// Attr returns an attribute with the given key and value.
+func Attr(key, value string) Attribute {
+	return Attribute{Key: key, Value: value}
+}
+
+// Span is an operation of the CLI.
+// The methods of a nil Span do nothing so that callers don't need to check if tracing is enabled.
+type Span struct {
+	tracer *Tracer
+
+	name     string
+	spanID   string
+	parentID string
+	start    time.Time
+	end      time.Time
+	attrs    []Attribute
+	err      error
+}
+
+// Start records the start of a child span of s.
+func (s *Span) Start(name string, attrs ...Attribute) *Span {
+	if s == nil {
+		return nil
+	}
+	return s.tracer.start(s.spanID, name, attrs)
+}
+
+// End records the end of the span. A non-nil err marks the span as failed.
+func (s *Span) End(err error) {
+	if s == nil {
+		return
+	}
+	s.tracer.end(s, err)
+}
+
+// Tracer records the spans of a single trace.
+type Tracer struct {
+	mu      sync.Mutex
+	traceID string
+	ended   []*Span
+
+	now   func() time.Time
+	newID func(size int) string
+}
+
+// New returns a Tracer for a new trace.
+func New() *Tracer {
+	t := &Tracer{
+		now:   time.Now,
+		newID: randomID,
+	}
+	t.traceID = t.newID(16)
+	return t
+}
+
+// Start records the start of a root span of the trace.
+// Use Span.Start to record the operations that are part of it.
+func (t *Tracer) Start(name string, attrs ...Attribute) *Span {
+	if t == nil {
+		return nil
+	}
+	return t.start("", name, attrs)
+}
+
+func (t *Tracer) start(parentID, name string, attrs []Attribute) *Span {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return &Span{
+		tracer:   t,
+		name:     name,
+		spanID:   t.newID(8),
+		parentID: parentID,
+		start:    t.now(),
+		attrs:    attrs,
+	}
+}
+
+func (t *Tracer) end(span *Span, err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if !span.end.IsZero() {
+		return
+	}
+	span.end = t.now()
+	span.err = err
+	t.ended = append(t.ended, span)
+}
+
+// JSON returns the ended spans as an OTLP JSON request.
+func (t *Tracer) JSON() ([]byte, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var spans []otlpSpan
+	for _, span := range t.ended {
+		s := otlpSpan{
+			TraceID:           t.traceID,
+			SpanID:            span.spanID,
+			ParentSpanID:      span.parentID,
+			Name:              span.name,
+			Kind:              spanKindInternal,
+			StartTimeUnixNano: strconv.FormatInt(span.start.UnixNano(), 10),
+			EndTimeUnixNano:   strconv.FormatInt(span.end.UnixNano(), 10),
+			Attributes:        otlpAttributes(span.attrs),
+		}
+		if span.err != nil {
+			s.Status = &otlpStatus{
+				Code:    statusCodeError,
+				Message: span.err.Error(),
+			}
+		}
+		spans = append(spans, s)
+	}
+	req := otlpRequest{
+		ResourceSpans: []otlpResourceSpans{
+			{
+				Resource: otlpResource{
+					Attributes: otlpAttributes([]Attribute{
+						Attr("service.name", serviceName),
+						Attr("service.version", version.Version),
+					}),
+				},
+				ScopeSpans: []otlpScopeSpans{
+					{
+						Scope: otlpScope{Name: serviceName},
+						Spans: spans,
+					},
+				},
+			},
+		},
+	}
+	data, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("marshal spans: %w", err)
+	}
+	return data, nil
+}
+
+// Export writes the ended spans to dest.
+// If dest is an HTTP URL, such as http://localhost:4318/v1/traces, the spans are sent to the OTLP/HTTP endpoint
+// of a collector. Otherwise dest is the path of the file to write the spans to.
+func (t *Tracer) Export(dest string) error {
+	data, err := t.JSON()
+	if err != nil {
+		return err
+	}
+	if !strings.HasPrefix(dest, "http://") && !strings.HasPrefix(dest, "https://") {
+		if err := ioutil.WriteFile(dest, data, 0644); err != nil {
+			return fmt.Errorf("write spans to %s: %w", dest, err)
+		}
+		return nil
+	}
+	client := &http.Client{Timeout: collectorTimeout}
+	resp, err := client.Post(dest, "application/json", bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("send spans to collector %s: %w", dest, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("send spans to collector %s: unexpected status %s", dest, resp.Status)
+	}
+	return nil
+}
+
+func randomID(size int) string {
+	b := make([]byte, size)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+var (
+	defaultMu   sync.Mutex
+	defaultRoot *Span
+)
+
+// Enable starts recording the spans of the CLI in a new trace. It returns the tracer and the root span of the
+// trace, named after the command, which the spans started with Start are children of.
+func Enable(name string) (*Tracer, *Span) {
+	defaultMu.Lock()
+	defer defaultMu.Unlock()
+	t := New()
+	defaultRoot = t.Start(name)
+	return t, defaultRoot
+}
+
+// Start records the start of a child span of the root span if tracing is enabled, otherwise it returns nil.
+// Spans started concurrently are siblings.
+func Start(name string, attrs ...Attribute) *Span {
+	defaultMu.Lock()
+	root := defaultRoot
+	defaultMu.Unlock()
+	return root.Start(name, attrs...)
+}
@@ -0,0 +1,230 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestTracer() *Tracer {
+	var ids, ticks int
+	return &Tracer{
+		traceID: "trace",
+		now: func() time.Time {
+			ticks++
+			return time.Unix(0, int64(ticks))
+		},
+		newID: func(int) string {
+			ids++
+			return fmt.Sprintf("span%d", ids)
+		},
+	}
+}
+
+func TestTracer_JSON(t *testing.T) {
+	// GIVEN
+	tracer := newTestTracer()
+
+	// WHEN
+	root := tracer.Start("copilot svc deploy")
+	build := root.Start("docker build", Attr("image", "frontend:latest"))
+	build.End(nil)
+	wait := root.Start("wait for stack update", Attr("stack", "phonetool-test-frontend"))
+	wait.End(errors.New("some error"))
+	root.End(nil)
+	data, err := tracer.JSON()
+
+	// THEN
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+  "resourceSpans": [
+    {
+      "resource": {
+        "attributes": [
+          {"key": "service.name", "value": {"stringValue": "copilot"}},
+          {"key": "service.version", "value": {"stringValue": ""}}
+        ]
+      },
+      "scopeSpans": [
+        {
+          "scope": {"name": "copilot"},
+          "spans": [
+            {
+              "traceId": "trace", "spanId": "span2", "parentSpanId": "span1", "name": "docker build", "kind": 1,
+              "startTimeUnixNano": "2", "endTimeUnixNano": "3",
+              "attributes": [{"key": "image", "value": {"stringValue": "frontend:latest"}}]
+            },
+            {
+              "traceId": "trace", "spanId": "span3", "parentSpanId": "span1", "name": "wait for stack update", "kind": 1,
+              "startTimeUnixNano": "4", "endTimeUnixNano": "5",
+              "attributes": [{"key": "stack", "value": {"stringValue": "phonetool-test-frontend"}}],
+              "status": {"code": 2, "message": "some error"}
+            },
+            {
+              "traceId": "trace", "spanId": "span1", "name": "copilot svc deploy", "kind": 1,
+              "startTimeUnixNano": "1", "endTimeUnixNano": "6"
+            }
+          ]
+        }
+      ]
+    }
+  ]
+}`, string(data))
+}
+
+func TestSpan_Start(t *testing.T) {
+	t.Run("spans started concurrently are siblings", func(t *testing.T) {
+		// GIVEN
+		tracer := newTestTracer()
+		root := tracer.Start("copilot svc status")
+		started := make(chan struct{})
+		release := make(chan struct{})
+
+		// WHEN
+		var wg sync.WaitGroup
+		children := make([]*Span, 2)
+		for i := range children {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				children[i] = root.Start(fmt.Sprintf("describe service %d", i))
+				started <- struct{}{}
+				<-release // Keep both spans in progress at the same time.
+				children[i].End(nil)
+			}(i)
+		}
+		<-started
+		<-started
+		close(release)
+		wg.Wait()
+		root.End(nil)
+
+		// THEN
+		for _, child := range children {
+			require.Equal(t, root.spanID, child.parentID)
+		}
+		require.Len(t, tracer.ended, 3)
+	})
+
+	t.Run("a span of a span is its child", func(t *testing.T) {
+		// GIVEN
+		tracer := newTestTracer()
+		root := tracer.Start("copilot svc deploy")
+
+		// WHEN
+		push := root.Start("docker push")
+		login := push.Start("docker login")
+
+		// THEN
+		require.Equal(t, "", root.parentID)
+		require.Equal(t, root.spanID, push.parentID)
+		require.Equal(t, push.spanID, login.parentID)
+	})
+
+	t.Run("ending a span twice records it once", func(t *testing.T) {
+		// GIVEN
+		tracer := newTestTracer()
+		root := tracer.Start("copilot svc deploy")
+
+		// WHEN
+		root.End(nil)
+		root.End(errors.New("some error"))
+
+		// THEN
+		require.Len(t, tracer.ended, 1)
+		require.NoError(t, root.err)
+	})
+}
+
+func TestEnable(t *testing.T) {
+	// GIVEN
+	tracer, root := Enable("copilot svc deploy")
+	defer func() { defaultRoot = nil }()
+
+	// WHEN
+	span := Start("docker build")
+
+	// THEN
+	require.NotNil(t, tracer)
+	require.Equal(t, root.spanID, span.parentID)
+}
+
+func TestTracer_Export(t *testing.T) {
+	t.Run("writes the spans to a file", func(t *testing.T) {
+		// GIVEN
+		dir, err := ioutil.TempDir("", "trace")
+		require.NoError(t, err)
+		defer os.RemoveAll(dir)
+		tracer := newTestTracer()
+		tracer.Start("copilot svc deploy").End(nil)
+		wanted, err := tracer.JSON()
+		require.NoError(t, err)
+
+		// WHEN
+		err = tracer.Export(filepath.Join(dir, "trace.json"))
+
+		// THEN
+		require.NoError(t, err)
+		actual, err := ioutil.ReadFile(filepath.Join(dir, "trace.json"))
+		require.NoError(t, err)
+		require.Equal(t, wanted, actual)
+	})
+
+	t.Run("sends the spans to a collector", func(t *testing.T) {
+		// GIVEN
+		var received []byte
+		collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, "/v1/traces", r.URL.Path)
+			require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+			received, _ = ioutil.ReadAll(r.Body)
+		}))
+		defer collector.Close()
+		tracer := newTestTracer()
+		tracer.Start("copilot svc deploy").End(nil)
+		wanted, err := tracer.JSON()
+		require.NoError(t, err)
+
+		// WHEN
+		err = tracer.Export(collector.URL + "/v1/traces")
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, wanted, received)
+	})
+
+	t.Run("returns an error if the collector rejects the spans", func(t *testing.T) {
+		// GIVEN
+		collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+		}))
+		defer collector.Close()
+		tracer := newTestTracer()
+
+		// WHEN
+		err := tracer.Export(collector.URL)
+
+		// THEN
+		require.EqualError(t, err, fmt.Sprintf("send spans to collector %s: unexpected status 400 Bad Request", collector.URL))
+	})
+}
+
+func TestStart_Disabled(t *testing.T) {
+	// WHEN
+	span := Start("docker build")
+
+	// THEN
+	require.Nil(t, span)
+	span.End(nil) // Ending a span while tracing is disabled is a no-op.
+}
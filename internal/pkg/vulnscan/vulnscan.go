@@ -0,0 +1,262 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package vulnscan scans container images for vulnerabilities with a local scanner such as Trivy or Grype.
+package vulnscan
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/term/command"
+	"github.com/aws/copilot-cli/internal/pkg/trace"
+)
+
+// Supported scanners.
+const (
+	ScannerTrivy = "trivy"
+	ScannerGrype = "grype"
+)
+
+// Severities of vulnerabilities, from the lowest to the highest.
+const (
+	SeverityLow      = "LOW"
+	SeverityMedium   = "MEDIUM"
+	SeverityHigh     = "HIGH"
+	SeverityCritical = "CRITICAL"
+)
+
+const (
+	defaultScanner           = ScannerTrivy
+	defaultSeverityThreshold = SeverityCritical
+)
+
+var (
+	scannerURLs = map[string]string{
+		ScannerTrivy: "https://github.com/aquasecurity/trivy",
+		ScannerGrype: "https://github.com/anchore/grype",
+	}
+	severityRanks = map[string]int{
+		SeverityLow:      1,
+		SeverityMedium:   2,
+		SeverityHigh:     3,
+		SeverityCritical: 4,
+	}
+)
+
+// ErrScannerNotFound occurs when the scanner to run isn't installed.
+type ErrScannerNotFound struct {
+	scanner string
+}
+
+func (e *ErrScannerNotFound) Error() string {
+	return fmt.Sprintf("%s is not installed, see %s to install it", e.scanner, scannerURLs[e.scanner])
+}
+
+// ErrVulnerabilitiesFound occurs when an image has vulnerabilities that aren't allowed by the policy.
+type ErrVulnerabilitiesFound struct {
+	Image           string
+	Vulnerabilities []Vulnerability
+}
+
+func (e *ErrVulnerabilitiesFound) Error() string {
+	var vulns []string
+	for _, v := range e.Vulnerabilities {
+		vulns = append(vulns, v.String())
+	}
+	return fmt.Sprintf("image %s has %d blocking vulnerabilities:\n%s", e.Image, len(e.Vulnerabilities), strings.Join(vulns, "\n"))
+}
+
+// Vulnerability is a vulnerability found in a package of an image.
+type Vulnerability struct {
+	ID       string // Such as "CVE-2020-1234".
+	Package  string
+	Version  string // Installed version of the package.
+	Severity string // One of the Severity constants, or the severity reported by the scanner if it's unknown.
+}
+
+// String returns a one-line description of the vulnerability, such as "CVE-2020-1234 (HIGH) in openssl 1.1.1g".
+func (v Vulnerability) String() string {
+	return fmt.Sprintf("%s (%s) in %s %s", v.ID, v.Severity, v.Package, v.Version)
+}
+
+// Policy decides which vulnerabilities block an image.
+type Policy struct {
+	SeverityThreshold string   // Vulnerabilities at or above this severity are blocking. Defaults to "CRITICAL".
+	CVEs              []string // Vulnerabilities that are blocking regardless of their severity.
+	Allowlist         []string // Vulnerabilities that are never blocking.
+}
+
+// Scanner scans images with a local scanner and blocks them according to a policy.
+type Scanner struct {
+	runner
+
+	name   string
+	policy Policy
+}
+
+type runner interface {
+	Run(name string, args []string, options ...command.Option) error
+}
+
+// New returns a Scanner that runs the named scanner, "trivy" if empty.
+// It returns an error if the scanner or the severity threshold of the policy isn't supported.
+func New(scanner string, policy Policy) (*Scanner, error) {
+	if scanner == "" {
+		scanner = defaultScanner
+	}
+	if _, ok := scannerURLs[scanner]; !ok {
+		return nil, fmt.Errorf("scanner %s is not supported, must be one of %s or %s", scanner, ScannerTrivy, ScannerGrype)
+	}
+	policy.SeverityThreshold = strings.ToUpper(policy.SeverityThreshold)
+	if policy.SeverityThreshold == "" {
+		policy.SeverityThreshold = defaultSeverityThreshold
+	}
+	if _, ok := severityRanks[policy.SeverityThreshold]; !ok {
+		return nil, fmt.Errorf("severity threshold %s is not supported, must be one of %s, %s, %s or %s",
+			policy.SeverityThreshold, SeverityLow, SeverityMedium, SeverityHigh, SeverityCritical)
+	}
+	return &Scanner{
+		runner: command.New(),
+		name:   scanner,
+		policy: policy,
+	}, nil
+}
+
+// CheckImage scans the image and returns an ErrVulnerabilitiesFound if any of its vulnerabilities is blocking.
+func (s *Scanner) CheckImage(image string) error {
+	vulns, err := s.Scan(image)
+	if err != nil {
+		return err
+	}
+	var blocking []Vulnerability
+	for _, v := range vulns {
+		if s.isBlocking(v) {
+			blocking = append(blocking, v)
+		}
+	}
+	if len(blocking) > 0 {
+		return &ErrVulnerabilitiesFound{
+			Image:           image,
+			Vulnerabilities: blocking,
+		}
+	}
+	return nil
+}
+
+// Scan returns all the vulnerabilities the scanner finds in the image.
+func (s *Scanner) Scan(image string) ([]Vulnerability, error) {
+	args := []string{"image", "--format", "json", "--quiet", image}
+	parse := parseTrivy
+	if s.name == ScannerGrype {
+		args = []string{image, "-o", "json", "-q"}
+		parse = parseGrype
+	}
+
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+	span := trace.Start(s.name, trace.Attr("image", image))
+	err := s.Run(s.name, args, command.Stdout(stdout), command.Stderr(stderr))
+	span.End(err)
+	if err != nil {
+		if errors.Is(err, exec.ErrNotFound) {
+			return nil, &ErrScannerNotFound{scanner: s.name}
+		}
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return nil, fmt.Errorf("scan image %s with %s: %w: %s", image, s.name, err, msg)
+		}
+		return nil, fmt.Errorf("scan image %s with %s: %w", image, s.name, err)
+	}
+	vulns, err := parse(stdout.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("parse %s report: %w", s.name, err)
+	}
+	return vulns, nil
+}
+
+func (s *Scanner) isBlocking(v Vulnerability) bool {
+	if contains(v.ID, s.policy.Allowlist) {
+		return false
+	}
+	if contains(v.ID, s.policy.CVEs) {
+		return true
+	}
+	return severityRanks[v.Severity] >= severityRanks[s.policy.SeverityThreshold]
+}
+
+type trivyResult struct {
+	Vulnerabilities []struct {
+		VulnerabilityID  string
+		PkgName          string
+		InstalledVersion string
+		Severity         string
+	}
+}
+
+// parseTrivy parses the JSON report of trivy. Older versions of trivy print the list of results
+// while newer versions wrap them in a "Results" field.
+func parseTrivy(report []byte) ([]Vulnerability, error) {
+	var results []trivyResult
+	if err := json.Unmarshal(report, &results); err != nil {
+		var wrapped struct {
+			Results []trivyResult
+		}
+		if err := json.Unmarshal(report, &wrapped); err != nil {
+			return nil, err
+		}
+		results = wrapped.Results
+	}
+	var vulns []Vulnerability
+	for _, r := range results {
+		for _, v := range r.Vulnerabilities {
+			vulns = append(vulns, Vulnerability{
+				ID:       v.VulnerabilityID,
+				Package:  v.PkgName,
+				Version:  v.InstalledVersion,
+				Severity: strings.ToUpper(v.Severity),
+			})
+		}
+	}
+	return vulns, nil
+}
+
+// parseGrype parses the JSON report of grype.
+func parseGrype(report []byte) ([]Vulnerability, error) {
+	var out struct {
+		Matches []struct {
+			Vulnerability struct {
+				ID       string `json:"id"`
+				Severity string `json:"severity"`
+			} `json:"vulnerability"`
+			Artifact struct {
+				Name    string `json:"name"`
+				Version string `json:"version"`
+			} `json:"artifact"`
+		} `json:"matches"`
+	}
+	if err := json.Unmarshal(report, &out); err != nil {
+		return nil, err
+	}
+	var vulns []Vulnerability
+	for _, m := range out.Matches {
+		vulns = append(vulns, Vulnerability{
+			ID:       m.Vulnerability.ID,
+			Package:  m.Artifact.Name,
+			Version:  m.Artifact.Version,
+			Severity: strings.ToUpper(m.Vulnerability.Severity),
+		})
+	}
+	return vulns, nil
+}
+
+func contains(s string, items []string) bool {
+	for _, item := range items {
+		if s == item {
+			return true
+		}
+	}
+	return false
+}
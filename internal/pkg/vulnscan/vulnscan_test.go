@@ -0,0 +1,204 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package vulnscan
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/term/command"
+	"github.com/aws/copilot-cli/internal/pkg/vulnscan/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+// runWithOutput returns a function that writes the outputs to the command's stdout and stderr and returns the error.
+func runWithOutput(stdout, stderr string, err error) func(name string, args []string, opts ...command.Option) error {
+	return func(name string, args []string, opts ...command.Option) error {
+		cmd := &exec.Cmd{}
+		for _, opt := range opts {
+			opt(cmd)
+		}
+		fmt.Fprint(cmd.Stdout, stdout)
+		fmt.Fprint(cmd.Stderr, stderr)
+		return err
+	}
+}
+
+func TestNew(t *testing.T) {
+	testCases := map[string]struct {
+		inScanner string
+		inPolicy  Policy
+
+		wantedScanner string
+		wantedPolicy  Policy
+		wantedError   error
+	}{
+		"defaults to trivy and critical vulnerabilities": {
+			wantedScanner: ScannerTrivy,
+			wantedPolicy: Policy{
+				SeverityThreshold: SeverityCritical,
+			},
+		},
+		"accepts lowercase severity threshold": {
+			inScanner: ScannerGrype,
+			inPolicy: Policy{
+				SeverityThreshold: "high",
+			},
+			wantedScanner: ScannerGrype,
+			wantedPolicy: Policy{
+				SeverityThreshold: SeverityHigh,
+			},
+		},
+		"errors on unsupported scanner": {
+			inScanner:   "clair",
+			wantedError: errors.New("scanner clair is not supported, must be one of trivy or grype"),
+		},
+		"errors on unsupported severity threshold": {
+			inPolicy: Policy{
+				SeverityThreshold: "urgent",
+			},
+			wantedError: errors.New("severity threshold URGENT is not supported, must be one of LOW, MEDIUM, HIGH or CRITICAL"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			s, err := New(tc.inScanner, tc.inPolicy)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedScanner, s.name)
+			require.Equal(t, tc.wantedPolicy, s.policy)
+		})
+	}
+}
+
+func TestScanner_CheckImage(t *testing.T) {
+	const (
+		image       = "aws_account_id.dkr.ecr.us-west-2.amazonaws.com/my-svc:abc123"
+		trivyReport = `{
+  "Results": [
+    {
+      "Target": "alpine:3.12",
+      "Vulnerabilities": [
+        {"VulnerabilityID": "CVE-2020-1111", "PkgName": "openssl", "InstalledVersion": "1.1.1g", "Severity": "CRITICAL"},
+        {"VulnerabilityID": "CVE-2020-2222", "PkgName": "musl", "InstalledVersion": "1.1.24", "Severity": "HIGH"},
+        {"VulnerabilityID": "CVE-2020-3333", "PkgName": "zlib", "InstalledVersion": "1.2.11", "Severity": "LOW"}
+      ]
+    }
+  ]
+}`
+		grypeReport = `{
+  "matches": [
+    {"vulnerability": {"id": "CVE-2020-2222", "severity": "High"}, "artifact": {"name": "musl", "version": "1.1.24"}},
+    {"vulnerability": {"id": "CVE-2020-3333", "severity": "Low"}, "artifact": {"name": "zlib", "version": "1.2.11"}}
+  ]
+}`
+	)
+	testCases := map[string]struct {
+		inScanner  string
+		inPolicy   Policy
+		setupMocks func(m *mocks.Mockrunner)
+
+		wantedError error
+	}{
+		"errors if the scanner is not installed": {
+			setupMocks: func(m *mocks.Mockrunner) {
+				m.EXPECT().Run("trivy", gomock.Any(), gomock.Any()).Return(&exec.Error{Name: "trivy", Err: exec.ErrNotFound})
+			},
+			wantedError: &ErrScannerNotFound{scanner: ScannerTrivy},
+		},
+		"errors if the scanner fails": {
+			setupMocks: func(m *mocks.Mockrunner) {
+				m.EXPECT().Run("trivy", gomock.Any(), gomock.Any()).
+					DoAndReturn(runWithOutput("", "unable to inspect the image\n", errors.New("exit status 1")))
+			},
+			wantedError: fmt.Errorf("scan image %s with trivy: exit status 1: unable to inspect the image", image),
+		},
+		"errors if the report is not valid json": {
+			setupMocks: func(m *mocks.Mockrunner) {
+				m.EXPECT().Run("trivy", gomock.Any(), gomock.Any()).DoAndReturn(runWithOutput("not json", "", nil))
+			},
+			wantedError: errors.New("parse trivy report: invalid character 'o' in literal null (expecting 'u')"),
+		},
+		"blocks vulnerabilities at or above the severity threshold found by trivy": {
+			inPolicy: Policy{
+				SeverityThreshold: SeverityHigh,
+				Allowlist:         []string{"CVE-2020-2222"},
+			},
+			setupMocks: func(m *mocks.Mockrunner) {
+				m.EXPECT().Run("trivy", []string{"image", "--format", "json", "--quiet", image}, gomock.Any()).
+					DoAndReturn(runWithOutput(trivyReport, "", nil))
+			},
+			wantedError: &ErrVulnerabilitiesFound{
+				Image: image,
+				Vulnerabilities: []Vulnerability{
+					{ID: "CVE-2020-1111", Package: "openssl", Version: "1.1.1g", Severity: SeverityCritical},
+				},
+			},
+		},
+		"blocks listed vulnerabilities found by grype regardless of their severity": {
+			inScanner: ScannerGrype,
+			inPolicy: Policy{
+				CVEs: []string{"CVE-2020-3333"},
+			},
+			setupMocks: func(m *mocks.Mockrunner) {
+				m.EXPECT().Run("grype", []string{image, "-o", "json", "-q"}, gomock.Any()).
+					DoAndReturn(runWithOutput(grypeReport, "", nil))
+			},
+			wantedError: &ErrVulnerabilitiesFound{
+				Image: image,
+				Vulnerabilities: []Vulnerability{
+					{ID: "CVE-2020-3333", Package: "zlib", Version: "1.2.11", Severity: SeverityLow},
+				},
+			},
+		},
+		"success if no vulnerability is blocking": {
+			inScanner: ScannerGrype,
+			setupMocks: func(m *mocks.Mockrunner) {
+				m.EXPECT().Run("grype", gomock.Any(), gomock.Any()).DoAndReturn(runWithOutput(grypeReport, "", nil))
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockrunner(ctrl)
+			tc.setupMocks(m)
+			s, err := New(tc.inScanner, tc.inPolicy)
+			require.NoError(t, err)
+			s.runner = m
+
+			// WHEN
+			err = s.CheckImage(image)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestParseTrivy_List(t *testing.T) {
+	vulns, err := parseTrivy([]byte(`[{"Target": "alpine:3.12", "Vulnerabilities": [{"VulnerabilityID": "CVE-2020-1111", "PkgName": "openssl", "InstalledVersion": "1.1.1g", "Severity": "CRITICAL"}]}]`))
+
+	require.NoError(t, err)
+	require.Equal(t, []Vulnerability{
+		{ID: "CVE-2020-1111", Package: "openssl", Version: "1.1.1g", Severity: SeverityCritical},
+	}, vulns)
+}
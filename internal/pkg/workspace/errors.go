@@ -6,11 +6,51 @@ package workspace
 import (
 	"errors"
 	"fmt"
+	"strings"
 )
 
 // ErrNoPipelineInWorkspace means there was no pipeline manifest in the workspace dir.
 var ErrNoPipelineInWorkspace = errors.New("no pipeline manifest found in the workspace")
 
+// ErrNoNamedPipelineInWorkspace means there was no pipeline manifest with the given name under copilot/pipelines/.
+type ErrNoNamedPipelineInWorkspace struct {
+	Name string
+}
+
+func (e *ErrNoNamedPipelineInWorkspace) Error() string {
+	return fmt.Sprintf("no pipeline manifest named %s found in the workspace", e.Name)
+}
+
+// ErrNoTaskPresetInWorkspace means there was no task preset with the given name under copilot/tasks/.
+type ErrNoTaskPresetInWorkspace struct {
+	Name string
+}
+
+func (e *ErrNoTaskPresetInWorkspace) Error() string {
+	return fmt.Sprintf("no task preset named %s found in the workspace", e.Name)
+}
+
+// ErrNoEnvironmentManifestInWorkspace means there was no environment manifest with the given name under copilot/environments/.
+type ErrNoEnvironmentManifestInWorkspace struct {
+	Name string
+}
+
+func (e *ErrNoEnvironmentManifestInWorkspace) Error() string {
+	return fmt.Sprintf("no environment manifest named %s found in the workspace", e.Name)
+}
+
+// ErrUndefinedVariables means a manifest references variables that aren't defined in copilot/variables.yml.
+type ErrUndefinedVariables struct {
+	Names []string
+}
+
+func (e *ErrUndefinedVariables) Error() string {
+	if len(e.Names) == 1 {
+		return fmt.Sprintf("variable %s is not defined in %s", e.Names[0], variablesFileName)
+	}
+	return fmt.Sprintf("variables %s are not defined in %s", strings.Join(e.Names, ", "), variablesFileName)
+}
+
 // ErrFileExists means we tried to create an existing file.
 type ErrFileExists struct {
 	FileName string
@@ -7,10 +7,14 @@
 //  .
 //  ├── copilot                        (application directory)
 //  │   ├── .workspace                 (workspace summary)
+//  │   ├── variables.yml              (variables shared by service manifests)
+//  │   ├── .cveignore                 (vulnerabilities allowed in images)
 //  │   └── my-service
 //  │   │   └── manifest.yml           (service manifest)
 //  │   ├── buildspec.yml              (buildspec for the pipeline's build stage)
-//  │   └── pipeline.yml               (pipeline manifest)
+//  │   ├── pipeline.yml               (pipeline manifest)
+//  │   └── pipelines
+//  │       └── release.yml            (additional pipeline manifests)
 //  └── my-service-src                 (customer service code)
 package workspace
 
@@ -20,6 +24,8 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"regexp"
+	"strings"
 
 	"github.com/spf13/afero"
 	"gopkg.in/yaml.v3"
@@ -32,14 +38,25 @@ const (
 	SummaryFileName = ".workspace"
 
 	addonsDirName             = "addons"
+	pipelinesDirName          = "pipelines"
+	tasksDirName              = "tasks"
+	environmentsDirName       = "environments"
+	policiesDirName           = "policies"
+	warnPoliciesDirName       = "warn"
 	maximumParentDirsToSearch = 5
 	pipelineFileName          = "pipeline.yml"
 	manifestFileName          = "manifest.yml"
 	buildspecFileName         = "buildspec.yml"
+	variablesFileName         = "variables.yml"
+	cveAllowlistFileName      = ".cveignore"
 
-	ymlFileExtension = ".yml"
+	ymlFileExtension   = ".yml"
+	guardFileExtension = ".guard"
 )
 
+// variableRegexp matches references to workspace variables in a manifest, such as "${var.domain}".
+var variableRegexp = regexp.MustCompile(`\$\{var\.([a-zA-Z0-9_-]+)\}`)
+
 // Summary is a description of what's associated with this workspace.
 type Summary struct {
 	Application string `yaml:"application"` // Name of the application.
@@ -140,10 +157,39 @@ func (ws *Workspace) ServiceNames() ([]string, error) {
 }
 
 // ReadServiceManifest returns the contents of the service manifest under copilot/{name}/manifest.yml.
+// References to variables such as "${var.domain}" are replaced by their values in copilot/variables.yml.
 func (ws *Workspace) ReadServiceManifest(name string) ([]byte, error) {
+	raw, err := ws.ReadRawServiceManifest(name)
+	if err != nil {
+		return nil, err
+	}
+	vars, err := ws.variables()
+	if err != nil {
+		return nil, err
+	}
+	return substituteVariables(raw, vars)
+}
+
+// ReadRawServiceManifest returns the contents of the service manifest under copilot/{name}/manifest.yml
+// as written, without replacing the references to variables.
+func (ws *Workspace) ReadRawServiceManifest(name string) ([]byte, error) {
 	return ws.read(name, manifestFileName)
 }
 
+// OverwriteServiceManifest replaces the contents of the service manifest under copilot/{name}/manifest.yml.
+// If successful returns the full path of the file, otherwise returns an empty string and the error.
+func (ws *Workspace) OverwriteServiceManifest(data []byte, name string) (string, error) {
+	copilotPath, err := ws.CopilotDirPath()
+	if err != nil {
+		return "", err
+	}
+	filename := filepath.Join(copilotPath, name, manifestFileName)
+	if err := ws.fsUtils.WriteFile(filename, data, 0644 /* -rw-r--r-- */); err != nil {
+		return "", fmt.Errorf("write manifest file: %w", err)
+	}
+	return filename, nil
+}
+
 // ReadPipelineManifest returns the contents of the pipeline manifest under copilot/pipeline.yml.
 func (ws *Workspace) ReadPipelineManifest() ([]byte, error) {
 	pmPath, err := ws.pipelineManifestPath()
@@ -161,6 +207,83 @@ func (ws *Workspace) ReadPipelineManifest() ([]byte, error) {
 	return ws.read(pipelineFileName)
 }
 
+// PipelineManifestNames returns the names of the additional pipeline manifests under copilot/pipelines/.
+// For example, the manifest copilot/pipelines/release.yml is named "release".
+func (ws *Workspace) PipelineManifestNames() ([]string, error) {
+	copilotPath, err := ws.CopilotDirPath()
+	if err != nil {
+		return nil, err
+	}
+	pipelinesPath := filepath.Join(copilotPath, pipelinesDirName)
+	exists, err := ws.fsUtils.DirExists(pipelinesPath)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, nil
+	}
+	files, err := ws.fsUtils.ReadDir(pipelinesPath)
+	if err != nil {
+		return nil, fmt.Errorf("read directory %s: %w", pipelinesPath, err)
+	}
+	var names []string
+	for _, f := range files {
+		if f.IsDir() || filepath.Ext(f.Name()) != ymlFileExtension {
+			continue
+		}
+		names = append(names, strings.TrimSuffix(f.Name(), ymlFileExtension))
+	}
+	return names, nil
+}
+
+// ReadNamedPipelineManifest returns the contents of the pipeline manifest under copilot/pipelines/{name}.yml.
+func (ws *Workspace) ReadNamedPipelineManifest(name string) ([]byte, error) {
+	copilotPath, err := ws.CopilotDirPath()
+	if err != nil {
+		return nil, err
+	}
+	manifestExists, err := ws.fsUtils.Exists(filepath.Join(copilotPath, pipelinesDirName, name+ymlFileExtension))
+	if err != nil {
+		return nil, err
+	}
+	if !manifestExists {
+		return nil, &ErrNoNamedPipelineInWorkspace{Name: name}
+	}
+	return ws.read(pipelinesDirName, name+ymlFileExtension)
+}
+
+// ReadTaskPreset returns the contents of the one-off task preset under copilot/tasks/{name}.yml.
+func (ws *Workspace) ReadTaskPreset(name string) ([]byte, error) {
+	copilotPath, err := ws.CopilotDirPath()
+	if err != nil {
+		return nil, err
+	}
+	presetExists, err := ws.fsUtils.Exists(filepath.Join(copilotPath, tasksDirName, name+ymlFileExtension))
+	if err != nil {
+		return nil, err
+	}
+	if !presetExists {
+		return nil, &ErrNoTaskPresetInWorkspace{Name: name}
+	}
+	return ws.read(tasksDirName, name+ymlFileExtension)
+}
+
+// ReadEnvironmentManifest returns the contents of the environment manifest under copilot/environments/{name}.yml.
+func (ws *Workspace) ReadEnvironmentManifest(name string) ([]byte, error) {
+	copilotPath, err := ws.CopilotDirPath()
+	if err != nil {
+		return nil, err
+	}
+	manifestExists, err := ws.fsUtils.Exists(filepath.Join(copilotPath, environmentsDirName, name+ymlFileExtension))
+	if err != nil {
+		return nil, err
+	}
+	if !manifestExists {
+		return nil, &ErrNoEnvironmentManifestInWorkspace{Name: name}
+	}
+	return ws.read(environmentsDirName, name+ymlFileExtension)
+}
+
 // WriteServiceManifest writes the service's manifest under the copilot/{name}/ directory.
 func (ws *Workspace) WriteServiceManifest(marshaler encoding.BinaryMarshaler, name string) (string, error) {
 	data, err := marshaler.MarshalBinary()
@@ -190,6 +313,16 @@ func (ws *Workspace) WritePipelineManifest(marshaler encoding.BinaryMarshaler) (
 	return ws.write(data, pipelineFileName)
 }
 
+// WriteNamedPipelineManifest writes the pipeline manifest under copilot/pipelines/{name}.yml.
+// If successful returns the full path of the file, otherwise returns an empty string and the error.
+func (ws *Workspace) WriteNamedPipelineManifest(marshaler encoding.BinaryMarshaler, name string) (string, error) {
+	data, err := marshaler.MarshalBinary()
+	if err != nil {
+		return "", fmt.Errorf("marshal pipeline manifest %s to binary: %w", name, err)
+	}
+	return ws.write(data, pipelinesDirName, name+ymlFileExtension)
+}
+
 // DeleteWorkspaceFile removes the .workspace file under copilot/ directory.
 // This will be called during app delete, we do not want to delete any other generated files
 func (ws *Workspace) DeleteWorkspaceFile() error {
@@ -230,6 +363,62 @@ func (ws *Workspace) WriteAddon(content encoding.BinaryMarshaler, svc, name stri
 	return ws.write(data, svc, addonsDirName, fname)
 }
 
+// PolicyFiles holds the absolute paths of the cfn-guard rules files in the workspace.
+type PolicyFiles struct {
+	Blocking []string // Files directly under copilot/policies/, a violation of their rules blocks the deployment.
+	Warning  []string // Files under copilot/policies/warn/, a violation of their rules is only reported.
+}
+
+// ReadPoliciesDir returns the paths of the ".guard" files under the "policies/" directory and its "warn/" subdirectory.
+// If the directory doesn't exist, no files are returned.
+func (ws *Workspace) ReadPoliciesDir() (*PolicyFiles, error) {
+	copilotPath, err := ws.CopilotDirPath()
+	if err != nil {
+		return nil, err
+	}
+	blocking, err := ws.guardFiles(filepath.Join(copilotPath, policiesDirName))
+	if err != nil {
+		return nil, err
+	}
+	warning, err := ws.guardFiles(filepath.Join(copilotPath, policiesDirName, warnPoliciesDirName))
+	if err != nil {
+		return nil, err
+	}
+	return &PolicyFiles{
+		Blocking: blocking,
+		Warning:  warning,
+	}, nil
+}
+
+// ReadCVEAllowlist returns the vulnerability IDs listed in the ".cveignore" file, one per line.
+// Blank lines and lines starting with "#" are ignored. If the file doesn't exist, no IDs are returned.
+func (ws *Workspace) ReadCVEAllowlist() ([]string, error) {
+	copilotPath, err := ws.CopilotDirPath()
+	if err != nil {
+		return nil, err
+	}
+	exists, err := ws.fsUtils.Exists(filepath.Join(copilotPath, cveAllowlistFileName))
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, nil
+	}
+	raw, err := ws.read(cveAllowlistFileName)
+	if err != nil {
+		return nil, fmt.Errorf("read %s: %w", cveAllowlistFileName, err)
+	}
+	var ids []string
+	for _, line := range strings.Split(string(raw), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		ids = append(ids, line)
+	}
+	return ids, nil
+}
+
 // FileStat wraps the os.Stat function.
 type FileStat interface {
 	Stat(name string) (os.FileInfo, error)
@@ -259,6 +448,75 @@ func (ws *Workspace) writeSummary(appName string) error {
 	return ws.fsUtils.WriteFile(summaryPath, serializedWorkspaceSummary, 0644)
 }
 
+// variables returns the variables defined in copilot/variables.yml, or nil if the file doesn't exist.
+func (ws *Workspace) variables() (map[string]string, error) {
+	copilotPath, err := ws.CopilotDirPath()
+	if err != nil {
+		return nil, err
+	}
+	exists, err := ws.fsUtils.Exists(filepath.Join(copilotPath, variablesFileName))
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, nil
+	}
+	raw, err := ws.read(variablesFileName)
+	if err != nil {
+		return nil, err
+	}
+	vars := make(map[string]string)
+	if err := yaml.Unmarshal(raw, &vars); err != nil {
+		return nil, fmt.Errorf("unmarshal %s: %w", variablesFileName, err)
+	}
+	return vars, nil
+}
+
+// substituteVariables replaces the references to variables in the manifest by their values.
+func substituteVariables(manifest []byte, vars map[string]string) ([]byte, error) {
+	var undefined []string
+	seen := make(map[string]bool)
+	out := variableRegexp.ReplaceAllFunc(manifest, func(ref []byte) []byte {
+		name := string(variableRegexp.FindSubmatch(ref)[1])
+		val, ok := vars[name]
+		if !ok {
+			if !seen[name] {
+				undefined = append(undefined, name)
+				seen[name] = true
+			}
+			return ref
+		}
+		return []byte(val)
+	})
+	if len(undefined) != 0 {
+		return nil, &ErrUndefinedVariables{Names: undefined}
+	}
+	return out, nil
+}
+
+// guardFiles returns the paths of the ".guard" files directly under a directory.
+func (ws *Workspace) guardFiles(dir string) ([]string, error) {
+	exists, err := ws.fsUtils.DirExists(dir)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, nil
+	}
+	files, err := ws.fsUtils.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("read directory %s: %w", dir, err)
+	}
+	var paths []string
+	for _, f := range files {
+		if f.IsDir() || filepath.Ext(f.Name()) != guardFileExtension {
+			continue
+		}
+		paths = append(paths, filepath.Join(dir, f.Name()))
+	}
+	return paths, nil
+}
+
 func (ws *Workspace) pipelineManifestPath() (string, error) {
 	copilotPath, err := ws.CopilotDirPath()
 	if err != nil {
@@ -483,6 +483,104 @@ func TestWorkspace_ReadAddonsDir(t *testing.T) {
 	}
 }
 
+func TestWorkspace_ReadPoliciesDir(t *testing.T) {
+	testCases := map[string]struct {
+		fs func() afero.Fs
+
+		wantedFiles *PolicyFiles
+	}{
+		"dir not exist": {
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.MkdirAll("/copilot", 0755)
+				return fs
+			},
+			wantedFiles: &PolicyFiles{},
+		},
+		"retrieves the blocking and warning rules files": {
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.MkdirAll("/copilot/policies/warn", 0755)
+				afero.WriteFile(fs, "/copilot/policies/encryption.guard", []byte(""), 0644)
+				afero.WriteFile(fs, "/copilot/policies/README.md", []byte(""), 0644)
+				afero.WriteFile(fs, "/copilot/policies/warn/tags.guard", []byte(""), 0644)
+				return fs
+			},
+			wantedFiles: &PolicyFiles{
+				Blocking: []string{"/copilot/policies/encryption.guard"},
+				Warning:  []string{"/copilot/policies/warn/tags.guard"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ws := &Workspace{
+				copilotDir: "/copilot",
+				fsUtils: &afero.Afero{
+					Fs: tc.fs(),
+				},
+			}
+
+			// WHEN
+			files, err := ws.ReadPoliciesDir()
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedFiles, files)
+		})
+	}
+}
+
+func TestWorkspace_ReadCVEAllowlist(t *testing.T) {
+	testCases := map[string]struct {
+		fs func() afero.Fs
+
+		wantedIDs []string
+	}{
+		"file not exist": {
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.MkdirAll("/copilot", 0755)
+				return fs
+			},
+		},
+		"skips comments and blank lines": {
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.MkdirAll("/copilot", 0755)
+				afero.WriteFile(fs, "/copilot/.cveignore", []byte(`# Not exploitable in our setup.
+CVE-2020-1234
+
+  CVE-2020-5678  
+`), 0644)
+				return fs
+			},
+			wantedIDs: []string{"CVE-2020-1234", "CVE-2020-5678"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ws := &Workspace{
+				copilotDir: "/copilot",
+				fsUtils: &afero.Afero{
+					Fs: tc.fs(),
+				},
+			}
+
+			// WHEN
+			ids, err := ws.ReadCVEAllowlist()
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedIDs, ids)
+		})
+	}
+}
+
 func TestWorkspace_WriteAddon(t *testing.T) {
 	testCases := map[string]struct {
 		marshaler   mockBinaryMarshaler
@@ -592,6 +690,323 @@ func TestWorkspace_ReadPipelineManifest(t *testing.T) {
 	}
 }
 
+func TestWorkspace_PipelineManifestNames(t *testing.T) {
+	testCases := map[string]struct {
+		fs func() afero.Fs
+
+		wantedNames []string
+	}{
+		"returns nil if there is no pipelines directory": {
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.MkdirAll("/copilot", 0755)
+				return fs
+			},
+		},
+		"returns the names of the yml files under the pipelines directory": {
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.MkdirAll("/copilot/pipelines/nested", 0755)
+				fs.Create("/copilot/pipelines/release.yml")
+				fs.Create("/copilot/pipelines/preview.yml")
+				fs.Create("/copilot/pipelines/README.md")
+				return fs
+			},
+			wantedNames: []string{"preview", "release"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ws := &Workspace{
+				copilotDir: "/copilot",
+				fsUtils:    &afero.Afero{Fs: tc.fs()},
+			}
+
+			// WHEN
+			names, err := ws.PipelineManifestNames()
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedNames, names)
+		})
+	}
+}
+
+func TestWorkspace_ReadNamedPipelineManifest(t *testing.T) {
+	testCases := map[string]struct {
+		fs func() afero.Fs
+
+		wantedContent string
+		wantedErr     error
+	}{
+		"reads existing pipeline manifest": {
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.MkdirAll("/copilot/pipelines", 0755)
+				afero.WriteFile(fs, "/copilot/pipelines/release.yml", []byte("hello"), 0644)
+				return fs
+			},
+			wantedContent: "hello",
+		},
+		"when no pipeline file exists": {
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.MkdirAll("/copilot/pipelines", 0755)
+				return fs
+			},
+			wantedErr: &ErrNoNamedPipelineInWorkspace{Name: "release"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ws := &Workspace{
+				copilotDir: "/copilot",
+				fsUtils:    &afero.Afero{Fs: tc.fs()},
+			}
+
+			// WHEN
+			content, err := ws.ReadNamedPipelineManifest("release")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedContent, string(content))
+			}
+		})
+	}
+}
+
+func TestWorkspace_ReadServiceManifest(t *testing.T) {
+	testCases := map[string]struct {
+		fs func() afero.Fs
+
+		wantedContent string
+		wantedErr     error
+	}{
+		"reads manifest without variables file": {
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.MkdirAll("/copilot/api", 0755)
+				afero.WriteFile(fs, "/copilot/api/manifest.yml", []byte("name: api"), 0644)
+				return fs
+			},
+			wantedContent: "name: api",
+		},
+		"substitutes variables in manifest": {
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.MkdirAll("/copilot/api", 0755)
+				afero.WriteFile(fs, "/copilot/variables.yml", []byte(`domain: example.com
+port: 80`), 0644)
+				afero.WriteFile(fs, "/copilot/api/manifest.yml", []byte(`name: api
+image:
+  port: ${var.port}
+variables:
+  URL: https://api.${var.domain}
+  ADMIN_URL: https://admin.${var.domain}
+  HOME: ${HOME}`), 0644)
+				return fs
+			},
+			wantedContent: `name: api
+image:
+  port: 80
+variables:
+  URL: https://api.example.com
+  ADMIN_URL: https://admin.example.com
+  HOME: ${HOME}`,
+		},
+		"errors if a variable is not defined": {
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.MkdirAll("/copilot/api", 0755)
+				afero.WriteFile(fs, "/copilot/variables.yml", []byte("domain: example.com"), 0644)
+				afero.WriteFile(fs, "/copilot/api/manifest.yml", []byte(`image:
+  location: ${var.registry}/api
+  port: ${var.port}
+sidecars:
+  nginx:
+    image: ${var.registry}/nginx`), 0644)
+				return fs
+			},
+			wantedErr: &ErrUndefinedVariables{Names: []string{"registry", "port"}},
+		},
+		"errors if variables file is malformed": {
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.MkdirAll("/copilot/api", 0755)
+				afero.WriteFile(fs, "/copilot/variables.yml", []byte("- example.com"), 0644)
+				afero.WriteFile(fs, "/copilot/api/manifest.yml", []byte("name: api"), 0644)
+				return fs
+			},
+			wantedErr: errors.New("unmarshal variables.yml: yaml: unmarshal errors:\n  line 1: cannot unmarshal !!seq into map[string]string"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ws := &Workspace{
+				copilotDir: "/copilot",
+				fsUtils:    &afero.Afero{Fs: tc.fs()},
+			}
+
+			// WHEN
+			content, err := ws.ReadServiceManifest("api")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedContent, string(content))
+			}
+		})
+	}
+}
+
+func TestWorkspace_OverwriteServiceManifest(t *testing.T) {
+	// GIVEN
+	fs := afero.NewMemMapFs()
+	fs.MkdirAll("/copilot/api", 0755)
+	afero.WriteFile(fs, "/copilot/api/manifest.yml", []byte("name: api"), 0644)
+	ws := &Workspace{
+		copilotDir: "/copilot",
+		fsUtils:    &afero.Afero{Fs: fs},
+	}
+
+	// WHEN
+	path, err := ws.OverwriteServiceManifest([]byte("version: 1\nname: api"), "api")
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, "/copilot/api/manifest.yml", path)
+	content, err := afero.ReadFile(fs, "/copilot/api/manifest.yml")
+	require.NoError(t, err)
+	require.Equal(t, "version: 1\nname: api", string(content))
+}
+
+func TestWorkspace_ReadTaskPreset(t *testing.T) {
+	testCases := map[string]struct {
+		fs func() afero.Fs
+
+		wantedContent string
+		wantedErr     error
+	}{
+		"reads existing task preset": {
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.MkdirAll("/copilot/tasks", 0755)
+				afero.WriteFile(fs, "/copilot/tasks/migrate.yml", []byte("hello"), 0644)
+				return fs
+			},
+			wantedContent: "hello",
+		},
+		"when no task preset exists": {
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.MkdirAll("/copilot", 0755)
+				return fs
+			},
+			wantedErr: &ErrNoTaskPresetInWorkspace{Name: "migrate"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ws := &Workspace{
+				copilotDir: "/copilot",
+				fsUtils:    &afero.Afero{Fs: tc.fs()},
+			}
+
+			// WHEN
+			content, err := ws.ReadTaskPreset("migrate")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedContent, string(content))
+			}
+		})
+	}
+}
+
+func TestWorkspace_ReadEnvironmentManifest(t *testing.T) {
+	testCases := map[string]struct {
+		fs func() afero.Fs
+
+		wantedContent string
+		wantedErr     error
+	}{
+		"reads existing environment manifest": {
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.MkdirAll("/copilot/environments", 0755)
+				afero.WriteFile(fs, "/copilot/environments/test.yml", []byte("hello"), 0644)
+				return fs
+			},
+			wantedContent: "hello",
+		},
+		"when no environment manifest exists": {
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.MkdirAll("/copilot", 0755)
+				return fs
+			},
+			wantedErr: &ErrNoEnvironmentManifestInWorkspace{Name: "test"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ws := &Workspace{
+				copilotDir: "/copilot",
+				fsUtils:    &afero.Afero{Fs: tc.fs()},
+			}
+
+			// WHEN
+			content, err := ws.ReadEnvironmentManifest("test")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedContent, string(content))
+			}
+		})
+	}
+}
+
+func TestWorkspace_WriteNamedPipelineManifest(t *testing.T) {
+	// GIVEN
+	fs := afero.NewMemMapFs()
+	fs.MkdirAll("/copilot", 0755)
+	ws := &Workspace{
+		copilotDir: "/copilot",
+		fsUtils:    &afero.Afero{Fs: fs},
+	}
+
+	// WHEN
+	path, err := ws.WriteNamedPipelineManifest(mockBinaryMarshaler{content: []byte("hello")}, "release")
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, "/copilot/pipelines/release.yml", path)
+	content, err := afero.ReadFile(fs, path)
+	require.NoError(t, err)
+	require.Equal(t, "hello", string(content))
+}
+
 func TestWorkspace_DeleteWorkspaceFile(t *testing.T) {
 	testCases := map[string]struct {
 		copilotDir string
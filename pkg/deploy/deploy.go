@@ -0,0 +1,101 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package deploy deploys the CloudFormation stacks of services to their environments.
+// It is part of the public packages that let other tools embed copilot's deployment engine.
+package deploy
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	cfn "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/pkg/stack"
+)
+
+// ErrDeploymentTimeout occurs when a deployment is canceled because it took longer than its timeout.
+type ErrDeploymentTimeout struct {
+	StackName string
+	Timeout   time.Duration
+}
+
+func (e *ErrDeploymentTimeout) Error() string {
+	return fmt.Sprintf("stack %s was not deployed within %s, so the deployment was canceled and rolled back", e.StackName, e.Timeout)
+}
+
+type options struct {
+	stackOpts []cloudformation.StackOption
+	timeout   time.Duration
+}
+
+// Option customizes the deployment of a service.
+type Option func(*options)
+
+// WithRoleARN deploys the stack with the IAM role, usually the execution role of the environment.
+func WithRoleARN(roleARN string) Option {
+	return func(o *options) {
+		o.stackOpts = append(o.stackOpts, cloudformation.WithRoleARN(roleARN))
+	}
+}
+
+// WithTemplateURL deploys the template stored in S3 at the URL instead of the template of the stack,
+// for templates larger than CloudFormation accepts in a request.
+func WithTemplateURL(url string) Option {
+	return func(o *options) {
+		o.stackOpts = append(o.stackOpts, cloudformation.WithTemplateURL(url))
+	}
+}
+
+// WithTimeout cancels the deployment and rolls back the service if it isn't done after the timeout.
+func WithTimeout(timeout time.Duration) Option {
+	return func(o *options) {
+		o.timeout = timeout
+	}
+}
+
+// Deployer deploys service stacks with CloudFormation.
+type Deployer struct {
+	cfn cfn.CloudFormation
+}
+
+// New returns a Deployer that deploys stacks with the session, usually one for the region of the environment.
+func New(sess *session.Session) *Deployer {
+	return &Deployer{
+		cfn: cfn.New(sess),
+	}
+}
+
+// DeployService creates or updates the stack of the service, and waits until the deployment is done.
+// If the deployment takes longer than the timeout of WithTimeout, it returns an *ErrDeploymentTimeout.
+func (d *Deployer) DeployService(conf stack.Configuration, opts ...Option) error {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	if o.timeout == 0 {
+		return d.cfn.DeployService(conf, o.stackOpts...)
+	}
+	err := d.cfn.DeployServiceWithTimeout(conf, o.timeout, o.stackOpts...)
+	var errTimeout *deploy.ErrDeploymentTimeout
+	if errors.As(err, &errTimeout) {
+		return &ErrDeploymentTimeout{
+			StackName: errTimeout.StackName,
+			Timeout:   errTimeout.Timeout,
+		}
+	}
+	return err
+}
+
+// StartServiceDeployment creates or updates the stack of the service without waiting for the deployment to be done.
+// It returns the ID of the change set that deploys the stack.
+func (d *Deployer) StartServiceDeployment(conf stack.Configuration, opts ...Option) (string, error) {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return d.cfn.StartServiceDeployment(conf, o.stackOpts...)
+}
@@ -0,0 +1,90 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package manifest parses the service manifests of a copilot workspace.
+// It is part of the public packages that let other tools embed copilot's deployment engine.
+package manifest
+
+import (
+	"fmt"
+
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+)
+
+// Service types.
+const (
+	LoadBalancedWebServiceType = manifest.LoadBalancedWebServiceType
+	BackendServiceType         = manifest.BackendServiceType
+)
+
+// Service is the manifest of a service, created by the functions of this package.
+// Its stack can be generated with the stack package.
+type Service interface {
+	// MarshalBinary serializes the manifest into YAML.
+	MarshalBinary() ([]byte, error)
+}
+
+// LoadBalancedWebServiceProps contains the properties to create the manifest of a service
+// exposed to the internet by the environment's load balancer.
+type LoadBalancedWebServiceProps struct {
+	Name       string
+	Dockerfile string // Path to the Dockerfile of the service, relative to the root of the workspace.
+	Path       string // Path of the load balancer's listener rule that forwards requests to the service.
+	Port       uint16
+
+	HealthCheckPath string // Optional path for the load balancer health check, defaults to "/".
+}
+
+// BackendServiceProps contains the properties to create the manifest of a service
+// only reachable from other services of the environment.
+type BackendServiceProps struct {
+	Name       string
+	Dockerfile string // Path to the Dockerfile of the service, relative to the root of the workspace.
+	Port       uint16 // Optional, the service doesn't listen on a port if it's zero.
+}
+
+// NewLoadBalancedWebService returns the manifest of a load balanced web service with default values.
+func NewLoadBalancedWebService(props LoadBalancedWebServiceProps) Service {
+	return manifest.NewLoadBalancedWebService(&manifest.LoadBalancedWebServiceProps{
+		ServiceProps: &manifest.ServiceProps{
+			Name:       props.Name,
+			Dockerfile: props.Dockerfile,
+		},
+		Path:            props.Path,
+		Port:            props.Port,
+		HealthCheckPath: props.HealthCheckPath,
+	})
+}
+
+// NewBackendService returns the manifest of a backend service with default values.
+func NewBackendService(props BackendServiceProps) Service {
+	return manifest.NewBackendService(manifest.BackendServiceProps{
+		ServiceProps: manifest.ServiceProps{
+			Name:       props.Name,
+			Dockerfile: props.Dockerfile,
+		},
+		Port: props.Port,
+	})
+}
+
+// Unmarshal deserializes a service manifest depending on its "type".
+// It returns an error if the manifest contains fields that aren't part of its service type.
+func Unmarshal(in []byte) (Service, error) {
+	return toService(manifest.UnmarshalService(in))
+}
+
+// UnmarshalLax is like Unmarshal, but ignores the fields that aren't part of the service type.
+func UnmarshalLax(in []byte) (Service, error) {
+	return toService(manifest.UnmarshalServiceLax(in))
+}
+
+func toService(mft interface{}, err error) (Service, error) {
+	if err != nil {
+		return nil, err
+	}
+	svc, ok := mft.(Service)
+	if !ok {
+		return nil, fmt.Errorf("unknown manifest type %T", mft)
+	}
+	return svc, nil
+}
@@ -0,0 +1,81 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnmarshal(t *testing.T) {
+	testCases := map[string]struct {
+		inContent string
+		unmarshal func(in []byte) (Service, error)
+
+		requireCorrectValues func(t *testing.T, svc Service)
+		wantedErr            bool
+	}{
+		"load balanced web service": {
+			inContent: `
+name: frontend
+type: Load Balanced Web Service
+image:
+  build: frontend/Dockerfile
+  port: 80
+http:
+  path: '/'
+`,
+			unmarshal: Unmarshal,
+			requireCorrectValues: func(t *testing.T, svc Service) {
+				mft, ok := svc.(*manifest.LoadBalancedWebService)
+				require.True(t, ok)
+				require.Equal(t, "frontend", aws.StringValue(mft.Name))
+			},
+		},
+		"errors on unknown fields": {
+			inContent: `
+name: api
+type: Backend Service
+image:
+  build: api/Dockerfile
+memroy: 512
+`,
+			unmarshal: Unmarshal,
+			wantedErr: true,
+		},
+		"ignores unknown fields in lax mode": {
+			inContent: `
+name: api
+type: Backend Service
+image:
+  build: api/Dockerfile
+memroy: 512
+`,
+			unmarshal: UnmarshalLax,
+			requireCorrectValues: func(t *testing.T, svc Service) {
+				mft, ok := svc.(*manifest.BackendService)
+				require.True(t, ok)
+				require.Equal(t, "api", aws.StringValue(mft.Name))
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			mft, err := tc.unmarshal([]byte(tc.inContent))
+
+			// THEN
+			if tc.wantedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			tc.requireCorrectValues(t, mft)
+		})
+	}
+}
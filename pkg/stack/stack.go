@@ -0,0 +1,88 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package stack generates the CloudFormation stacks of services from their manifests.
+// It is part of the public packages that let other tools embed copilot's deployment engine.
+package stack
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	pubmanifest "github.com/aws/copilot-cli/pkg/manifest"
+)
+
+// RuntimeConfig holds the values of a service's stack that aren't in its manifest, such as the image to deploy.
+type RuntimeConfig struct {
+	ImageRepoURL      string            // ECR repository URL that the image of the service is pushed to.
+	ImageTag          string            // Unique tag of the image of the service.
+	AddonsTemplateURL string            // Optional. S3 object URL for the addons template.
+	AdditionalTags    map[string]string // Optional. Labels applied to the resources of the stack.
+}
+
+// Configuration is the CloudFormation stack of a service, ready to be deployed.
+type Configuration interface {
+	StackName() string
+	Template() (string, error)
+	Parameters() ([]*cloudformation.Parameter, error)
+	Tags() []*cloudformation.Tag
+}
+
+type options struct {
+	https bool
+}
+
+// Option customizes the stack of a service.
+type Option func(*options)
+
+// WithHTTPS serves a load balanced web service over HTTPS, for applications with a domain.
+// It has no effect on other service types.
+func WithHTTPS() Option {
+	return func(o *options) {
+		o.https = true
+	}
+}
+
+// New returns the stack of the service described by the manifest in the environment of the application.
+// The addons of the service are read from the copilot workspace in the working directory.
+func New(mft pubmanifest.Service, env, app string, rc RuntimeConfig, opts ...Option) (Configuration, error) {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	conf := stack.RuntimeConfig{
+		ImageRepoURL:      rc.ImageRepoURL,
+		ImageTag:          rc.ImageTag,
+		AddonsTemplateURL: rc.AddonsTemplateURL,
+		AdditionalTags:    rc.AdditionalTags,
+	}
+	var s Configuration
+	var err error
+	switch t := mft.(type) {
+	case *manifest.LoadBalancedWebService:
+		if o.https {
+			s, err = stack.NewHTTPSLoadBalancedWebService(t, env, app, conf)
+		} else {
+			s, err = stack.NewLoadBalancedWebService(t, env, app, conf)
+		}
+	case *manifest.BackendService:
+		s, err = stack.NewBackendService(t, env, app, conf)
+	default:
+		return nil, fmt.Errorf("unknown manifest type %T", mft)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("create stack of service: %w", err)
+	}
+	return s, nil
+}
+
+// Template returns the CloudFormation template of the service described by the manifest in the environment of the application.
+func Template(mft pubmanifest.Service, env, app string, rc RuntimeConfig, opts ...Option) (string, error) {
+	conf, err := New(mft, env, app, rc, opts...)
+	if err != nil {
+		return "", err
+	}
+	return conf.Template()
+}
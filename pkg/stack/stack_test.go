@@ -0,0 +1,64 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stack
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/pkg/manifest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	testCases := map[string]struct {
+		inManifest manifest.Service
+
+		wantedStackName string
+		wantedError     error
+	}{
+		"load balanced web service": {
+			inManifest: manifest.NewLoadBalancedWebService(manifest.LoadBalancedWebServiceProps{
+				Name:       "frontend",
+				Dockerfile: "frontend/Dockerfile",
+				Path:       "/",
+				Port:       80,
+			}),
+			wantedStackName: "phonetool-test-frontend",
+		},
+		"backend service": {
+			inManifest: manifest.NewBackendService(manifest.BackendServiceProps{
+				Name:       "api",
+				Dockerfile: "api/Dockerfile",
+				Port:       8080,
+			}),
+			wantedStackName: "phonetool-test-api",
+		},
+		"unknown manifest type": {
+			inManifest:  unknownService{},
+			wantedError: errors.New("unknown manifest type stack.unknownService"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			conf, err := New(tc.inManifest, "test", "phonetool", RuntimeConfig{ImageTag: "v1"}, WithHTTPS())
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedStackName, conf.StackName())
+		})
+	}
+}
+
+type unknownService struct{}
+
+func (unknownService) MarshalBinary() ([]byte, error) {
+	return nil, nil
+}